@@ -432,6 +432,7 @@ func (p *AccountService) Login(ctx context.Context, req *account_proto.LoginRequ
 			Type:    rsp_org.Data.Organisation.Type,
 			Owner:   rsp_org.Data.Organisation.Owner,
 			Modules: rsp_org.Data.Organisation.Modules,
+			Setting: rsp_org.Data.Organisation.Setting,
 		}
 		if _, err := p.OrganisationClient.PutOrgInfo(ctx, &organisation_proto.PutOrgInfoRequest{OrgId: orgid, OrgInfo: oi}); err != nil {
 			common.NotFound(common.AccountSrv, p.Login, err, "PutOrgInfo is failed")
@@ -442,7 +443,8 @@ func (p *AccountService) Login(ctx context.Context, req *account_proto.LoginRequ
 	}
 
 	//PutEmployeeInfo will return nil, if the user is not an employee
-	if _, err := p.TeamClient.PutEmployeeInfo(ctx, &team_proto.PutEmployeeInfoRequest{UserId: userid, OrgId: orgid}); err != nil {
+	rsp_employee, err := p.TeamClient.PutEmployeeInfo(ctx, &team_proto.PutEmployeeInfoRequest{UserId: userid, OrgId: orgid})
+	if err != nil {
 		return common.InternalServerError(common.AccountSrv, p.Login, err, "PutEmployeeInfo is failed")
 	}
 
@@ -507,11 +509,40 @@ func (p *AccountService) Login(ctx context.Context, req *account_proto.LoginRequ
 			OrgId:  orgid,
 		},
 	}
+
+	// jwt tokens of the login, the clients keep using the session until the signing key is set
+	if common.TokensEnabled() {
+		claims := &common.TokenClaims{
+			Session:   uuid.NewUUID().String(),
+			AccountId: account.Id,
+			UserId:    userid,
+			OrgId:     orgid,
+		}
+		if rsp_employee != nil && rsp_employee.Data != nil && rsp_employee.Data.Employee != nil {
+			claims.Employee = rsp_employee.Data.Employee.Id
+		}
+		tokens, err := issueTokens(claims)
+		if err != nil {
+			return common.InternalServerError(common.AccountSrv, p.Login, err, "issue tokens is failed")
+		}
+		rsp.Data.Tokens = tokens
+	}
 	return nil
 }
 
 func (p *AccountService) Logout(ctx context.Context, req *account_proto.LogoutRequest, rsp *account_proto.LogoutResponse) error {
 	log.Info("Received Account.Logout request")
+	// neither the refresh tokens nor the access tokens of the session can be used anymore
+	if len(req.TokenSession) > 0 {
+		log.Info("Revoking token session for user: ", req.UserId)
+		if _, err := p.revokeToken(ctx, req.TokenSession, time.Now().Add(common.RefreshTokenTTL).Unix()); err != nil {
+			return common.InternalServerError(common.AccountSrv, p.Logout, err, "RevokeToken is failed")
+		}
+		if len(req.SessionId) == 0 {
+			return p.removeEmployeeInfo(ctx, req.UserId)
+		}
+	}
+
 	rsp_kv, err := p.KvClient.ReadSession(ctx, &kv_proto.ReadSessionRequest{common.SESSION_INDEX, req.SessionId})
 	if err != nil {
 		return err
@@ -532,13 +563,111 @@ func (p *AccountService) Logout(ctx context.Context, req *account_proto.LogoutRe
 		return common.InternalServerError(common.AccountSrv, p.Logout, err, "RemoveSession is failed")
 	}
 
+	return p.removeEmployeeInfo(ctx, req.UserId)
+}
+
+func (p *AccountService) removeEmployeeInfo(ctx context.Context, userId string) error {
 	//remove employee info
-	log.Info("Removing employee info for user: ", req.UserId)
-	req_kv := &kv_proto.DelExRequest{Index: common.EMPLOYEE_INFO_INDEX, Key: req.UserId}
+	log.Info("Removing employee info for user: ", userId)
+	req_kv := &kv_proto.DelExRequest{Index: common.EMPLOYEE_INFO_INDEX, Key: userId}
 	if _, err := p.KvClient.DelEx(context.TODO(), req_kv); err != nil {
 		return common.InternalServerError(common.AccountSrv, p.Logout, err, "DelEx is failed")
 	}
+	return nil
+}
+
+// issueTokens signs a new pair of access and refresh tokens of the claims
+func issueTokens(claims *common.TokenClaims) (*account_proto.Tokens, error) {
+	now := time.Now()
+	access := common.NewTokenClaims(claims, common.AccessToken, now)
+	refresh := common.NewTokenClaims(claims, common.RefreshToken, now)
+	access_token, err := common.SignToken(access)
+	if err != nil {
+		return nil, err
+	}
+	refresh_token, err := common.SignToken(refresh)
+	if err != nil {
+		return nil, err
+	}
+	return &account_proto.Tokens{
+		AccessToken:      access_token,
+		ExpiresAt:        access.ExpiresAt,
+		RefreshToken:     refresh_token,
+		RefreshExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
+
+// revokeToken adds a token id or a token session to the denylist until expiresAt, it returns whether it was already
+// revoked
+func (p *AccountService) revokeToken(ctx context.Context, id string, expiresAt int64) (bool, error) {
+	expiration := time.Until(time.Unix(expiresAt, 0))
+	if expiration <= 0 {
+		return false, nil
+	}
+	rsp, err := p.KvClient.RevokeToken(ctx, &kv_proto.RevokeTokenRequest{Index: common.TOKEN_REVOKED_INDEX, Id: id, Expiration: int64(expiration)})
+	if err != nil {
+		return false, err
+	}
+	return rsp.Revoked, nil
+}
+
+// reusedToken revokes the session of a refresh token used twice, it may have been stolen
+func (p *AccountService) reusedToken(ctx context.Context, claims *common.TokenClaims) error {
+	log.WithField("account_id", claims.AccountId).Warn("Revoked refresh token is used")
+	if _, err := p.revokeToken(ctx, claims.Session, claims.ExpiresAt); err != nil {
+		return common.InternalServerError(common.AccountSrv, p.Refresh, err, "RevokeToken is failed")
+	}
+	return common.Unauthorized(common.AccountSrv, p.Refresh, nil, "revoked_token")
+}
+
+func (p *AccountService) Refresh(ctx context.Context, req *account_proto.RefreshRequest, rsp *account_proto.RefreshResponse) error {
+	log.Info("Received Account.Refresh request")
+	claims, err := common.VerifyToken(req.RefreshToken, common.RefreshToken, time.Now())
+	if err != nil {
+		return common.Unauthorized(common.AccountSrv, p.Refresh, err, "invalid_token")
+	}
+
+	rsp_revoked, err := p.KvClient.IsTokenRevoked(ctx, &kv_proto.IsTokenRevokedRequest{Index: common.TOKEN_REVOKED_INDEX, Ids: []string{claims.Id, claims.Session}})
+	if err != nil {
+		return common.InternalServerError(common.AccountSrv, p.Refresh, err, "IsTokenRevoked is failed")
+	}
+	if rsp_revoked.Revoked {
+		return p.reusedToken(ctx, claims)
+	}
+
+	// the account may have been locked or suspended since the login
+	account, err := db.Read(ctx, &account_proto.Account{Id: claims.AccountId})
+	if err != nil {
+		return common.Unauthorized(common.AccountSrv, p.Refresh, err, "account not found")
+	}
+	if account.Status != account_proto.AccountStatus_ACTIVE {
+		return common.Forbidden(common.AccountSrv, p.Refresh, nil, "inactive_account")
+	}
 
+	// the user may have become or stopped being an employee since the last refresh
+	rsp_employee, err := p.TeamClient.PutEmployeeInfo(ctx, &team_proto.PutEmployeeInfoRequest{UserId: claims.UserId, OrgId: claims.OrgId})
+	if err != nil {
+		return common.InternalServerError(common.AccountSrv, p.Refresh, err, "PutEmployeeInfo is failed")
+	}
+	claims.Employee = ""
+	if rsp_employee != nil && rsp_employee.Data != nil && rsp_employee.Data.Employee != nil {
+		claims.Employee = rsp_employee.Data.Employee.Id
+	}
+
+	// rotation, the refresh token can only be used once: of two concurrent refreshes only the first one claims its id,
+	// the other one is a reuse
+	revoked, err := p.revokeToken(ctx, claims.Id, claims.ExpiresAt)
+	if err != nil {
+		return common.InternalServerError(common.AccountSrv, p.Refresh, err, "RevokeToken is failed")
+	}
+	if revoked {
+		return p.reusedToken(ctx, claims)
+	}
+	tokens, err := issueTokens(claims)
+	if err != nil {
+		return common.InternalServerError(common.AccountSrv, p.Refresh, err, "issue tokens is failed")
+	}
+	rsp.Data = &account_proto.RefreshResponse_Data{Tokens: tokens}
 	return nil
 }
 
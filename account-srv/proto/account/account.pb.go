@@ -50,6 +50,9 @@ It has these top-level messages:
 	AccountInfo
 	AccountStatusInfo
 	Session
+	Tokens
+	RefreshRequest
+	RefreshResponse
 	ConfirmVerifyRequest
 	ConfirmVerifyResponse
 */
@@ -525,6 +528,7 @@ func (m *LoginResponse) GetMessage() string {
 type LoginResponse_Data struct {
 	Session     *Session     `protobuf:"bytes,1,opt,name=session" json:"session,omitempty"`
 	AccountInfo *AccountInfo `protobuf:"bytes,2,opt,name=account_info,json=accountInfo" json:"account_info,omitempty"`
+	Tokens      *Tokens      `protobuf:"bytes,3,opt,name=tokens" json:"tokens,omitempty"`
 }
 
 func (m *LoginResponse_Data) Reset()                    { *m = LoginResponse_Data{} }
@@ -546,10 +550,19 @@ func (m *LoginResponse_Data) GetAccountInfo() *AccountInfo {
 	return nil
 }
 
+func (m *LoginResponse_Data) GetTokens() *Tokens {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
 type LogoutRequest struct {
 	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId" json:"session_id,omitempty"`
 	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 	OrgId     string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	// session of the tokens, revokes the refresh token of the login
+	TokenSession string `protobuf:"bytes,4,opt,name=token_session,json=tokenSession" json:"token_session,omitempty"`
 }
 
 func (m *LogoutRequest) Reset()                    { *m = LogoutRequest{} }
@@ -578,6 +591,13 @@ func (m *LogoutRequest) GetOrgId() string {
 	return ""
 }
 
+func (m *LogoutRequest) GetTokenSession() string {
+	if m != nil {
+		return m.TokenSession
+	}
+	return ""
+}
+
 type LogoutResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -1338,6 +1358,112 @@ func (m *Session) GetExpiresAt() int64 {
 	return 0
 }
 
+// jwt tokens of a login, they are only issued if the signing key is set
+type Tokens struct {
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken" json:"access_token,omitempty"`
+	ExpiresAt   int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+	// rotated by Refresh, a refresh token can only be used once
+	RefreshToken     string `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	RefreshExpiresAt int64  `protobuf:"varint,4,opt,name=refresh_expires_at,json=refreshExpiresAt" json:"refresh_expires_at,omitempty"`
+}
+
+func (m *Tokens) Reset()                    { *m = Tokens{} }
+func (m *Tokens) String() string            { return proto.CompactTextString(m) }
+func (*Tokens) ProtoMessage()               {}
+func (*Tokens) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *Tokens) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+func (m *Tokens) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Tokens) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *Tokens) GetRefreshExpiresAt() int64 {
+	if m != nil {
+		return m.RefreshExpiresAt
+	}
+	return 0
+}
+
+type RefreshRequest struct {
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+}
+
+func (m *RefreshRequest) Reset()                    { *m = RefreshRequest{} }
+func (m *RefreshRequest) String() string            { return proto.CompactTextString(m) }
+func (*RefreshRequest) ProtoMessage()               {}
+func (*RefreshRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *RefreshRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RefreshResponse struct {
+	Data    *RefreshResponse_Data `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                 `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RefreshResponse) Reset()                    { *m = RefreshResponse{} }
+func (m *RefreshResponse) String() string            { return proto.CompactTextString(m) }
+func (*RefreshResponse) ProtoMessage()               {}
+func (*RefreshResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *RefreshResponse) GetData() *RefreshResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RefreshResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RefreshResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type RefreshResponse_Data struct {
+	Tokens *Tokens `protobuf:"bytes,1,opt,name=tokens" json:"tokens,omitempty"`
+}
+
+func (m *RefreshResponse_Data) Reset()                    { *m = RefreshResponse_Data{} }
+func (m *RefreshResponse_Data) String() string            { return proto.CompactTextString(m) }
+func (*RefreshResponse_Data) ProtoMessage()               {}
+func (*RefreshResponse_Data) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44, 0} }
+
+func (m *RefreshResponse_Data) GetTokens() *Tokens {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
 type ConfirmVerifyRequest struct {
 	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
 }
@@ -1345,7 +1471,7 @@ type ConfirmVerifyRequest struct {
 func (m *ConfirmVerifyRequest) Reset()                    { *m = ConfirmVerifyRequest{} }
 func (m *ConfirmVerifyRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConfirmVerifyRequest) ProtoMessage()               {}
-func (*ConfirmVerifyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*ConfirmVerifyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *ConfirmVerifyRequest) GetToken() string {
 	if m != nil {
@@ -1362,7 +1488,7 @@ type ConfirmVerifyResponse struct {
 func (m *ConfirmVerifyResponse) Reset()                    { *m = ConfirmVerifyResponse{} }
 func (m *ConfirmVerifyResponse) String() string            { return proto.CompactTextString(m) }
 func (*ConfirmVerifyResponse) ProtoMessage()               {}
-func (*ConfirmVerifyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*ConfirmVerifyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *ConfirmVerifyResponse) GetCode() int64 {
 	if m != nil {
@@ -1425,6 +1551,10 @@ func init() {
 	proto.RegisterType((*AccountInfo)(nil), "go.micro.srv.account.AccountInfo")
 	proto.RegisterType((*AccountStatusInfo)(nil), "go.micro.srv.account.AccountStatusInfo")
 	proto.RegisterType((*Session)(nil), "go.micro.srv.account.Session")
+	proto.RegisterType((*Tokens)(nil), "go.micro.srv.account.Tokens")
+	proto.RegisterType((*RefreshRequest)(nil), "go.micro.srv.account.RefreshRequest")
+	proto.RegisterType((*RefreshResponse)(nil), "go.micro.srv.account.RefreshResponse")
+	proto.RegisterType((*RefreshResponse_Data)(nil), "go.micro.srv.account.RefreshResponse.Data")
 	proto.RegisterType((*ConfirmVerifyRequest)(nil), "go.micro.srv.account.ConfirmVerifyRequest")
 	proto.RegisterType((*ConfirmVerifyResponse)(nil), "go.micro.srv.account.ConfirmVerifyResponse")
 	proto.RegisterEnum("go.micro.srv.account.AccountStatus", AccountStatus_name, AccountStatus_value)
@@ -1446,6 +1576,7 @@ type AccountServiceClient interface {
 	ConfirmResend(ctx context.Context, in *ConfirmResendRequest, opts ...client.CallOption) (*ConfirmResendResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...client.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...client.CallOption) (*LogoutResponse, error)
+	Refresh(ctx context.Context, in *RefreshRequest, opts ...client.CallOption) (*RefreshResponse, error)
 	RecoverPassword(ctx context.Context, in *RecoverPasswordRequest, opts ...client.CallOption) (*RecoverPasswordResponse, error)
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...client.CallOption) (*UpdatePasswordResponse, error)
 	Lock(ctx context.Context, in *LockRequest, opts ...client.CallOption) (*LockResponse, error)
@@ -1556,6 +1687,16 @@ func (c *accountServiceClient) Logout(ctx context.Context, in *LogoutRequest, op
 	return out, nil
 }
 
+func (c *accountServiceClient) Refresh(ctx context.Context, in *RefreshRequest, opts ...client.CallOption) (*RefreshResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "AccountService.Refresh", in)
+	out := new(RefreshResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) RecoverPassword(ctx context.Context, in *RecoverPasswordRequest, opts ...client.CallOption) (*RecoverPasswordResponse, error) {
 	req := c.c.NewRequest(c.serviceName, "AccountService.RecoverPassword", in)
 	out := new(RecoverPasswordResponse)
@@ -1667,6 +1808,7 @@ type AccountServiceHandler interface {
 	ConfirmResend(context.Context, *ConfirmResendRequest, *ConfirmResendResponse) error
 	Login(context.Context, *LoginRequest, *LoginResponse) error
 	Logout(context.Context, *LogoutRequest, *LogoutResponse) error
+	Refresh(context.Context, *RefreshRequest, *RefreshResponse) error
 	RecoverPassword(context.Context, *RecoverPasswordRequest, *RecoverPasswordResponse) error
 	UpdatePassword(context.Context, *UpdatePasswordRequest, *UpdatePasswordResponse) error
 	Lock(context.Context, *LockRequest, *LockResponse) error
@@ -1719,6 +1861,10 @@ func (h *AccountService) Logout(ctx context.Context, in *LogoutRequest, out *Log
 	return h.AccountServiceHandler.Logout(ctx, in, out)
 }
 
+func (h *AccountService) Refresh(ctx context.Context, in *RefreshRequest, out *RefreshResponse) error {
+	return h.AccountServiceHandler.Refresh(ctx, in, out)
+}
+
 func (h *AccountService) RecoverPassword(ctx context.Context, in *RecoverPasswordRequest, out *RecoverPasswordResponse) error {
 	return h.AccountServiceHandler.RecoverPassword(ctx, in, out)
 }
@@ -1762,99 +1908,107 @@ func (h *AccountService) PassVerify(ctx context.Context, in *ConfirmVerifyReques
 func init() { proto.RegisterFile("server/account-srv/proto/account/account.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xcf, 0xf9, 0x6f, 0x32, 0x49, 0x8c, 0xb3, 0x4d, 0x63, 0xd7, 0x50, 0xa9, 0xbd, 0x52, 0x91,
-	0xb6, 0x89, 0x5b, 0xa5, 0x42, 0x04, 0x51, 0x40, 0x21, 0x76, 0x5d, 0x43, 0x95, 0x86, 0x73, 0x53,
-	0x09, 0x09, 0x61, 0x5d, 0x7d, 0x6b, 0x73, 0x34, 0xbe, 0xbb, 0xde, 0x9d, 0x5d, 0x2a, 0x1e, 0x10,
-	0x42, 0x15, 0x2f, 0x3c, 0xf2, 0xc6, 0x03, 0xdf, 0x84, 0x77, 0xbe, 0x03, 0x1f, 0x82, 0x8f, 0x80,
-	0x76, 0x6f, 0xf7, 0xfe, 0xf9, 0xf6, 0x6c, 0x5f, 0xdb, 0x27, 0xdf, 0xee, 0xce, 0xce, 0xcc, 0xfe,
-	0xe6, 0xcf, 0xce, 0xac, 0xa1, 0xe9, 0x60, 0x7b, 0x8a, 0xed, 0xdb, 0xea, 0x60, 0x60, 0x4e, 0x0c,
-	0x77, 0xdf, 0xb1, 0xa7, 0xb7, 0x2d, 0xdb, 0x74, 0x4d, 0x3e, 0xc3, 0x7f, 0x9b, 0x74, 0x16, 0x6d,
-	0x8f, 0xcc, 0xe6, 0x58, 0x1f, 0xd8, 0x66, 0xd3, 0xb1, 0xa7, 0x4d, 0xb6, 0x26, 0xdf, 0x05, 0xa4,
-	0x60, 0x55, 0xeb, 0x61, 0xc7, 0xd1, 0x4d, 0x43, 0xc1, 0xcf, 0x27, 0xd8, 0x71, 0xd1, 0x65, 0x00,
-	0xc7, 0x9b, 0xe9, 0xeb, 0x5a, 0x5d, 0xba, 0x22, 0xed, 0xae, 0x29, 0x6b, 0x6c, 0xa6, 0xab, 0xc9,
-	0x6d, 0xb8, 0x10, 0xd9, 0xe4, 0x58, 0xa6, 0xe1, 0x60, 0x54, 0x83, 0xf2, 0xc4, 0xc1, 0x76, 0xb0,
-	0xa5, 0x44, 0x86, 0x5d, 0x0d, 0x5d, 0x84, 0x92, 0x69, 0x8f, 0xc8, 0x7c, 0x8e, 0xce, 0x17, 0x4d,
-	0x7b, 0xd4, 0xd5, 0xe4, 0xfb, 0xb0, 0x7e, 0xe4, 0xa9, 0xd1, 0x52, 0x5d, 0x15, 0x7d, 0x04, 0x65,
-	0xa6, 0x15, 0xdd, 0xbe, 0x7e, 0x70, 0xb9, 0x99, 0xa4, 0x72, 0x93, 0xed, 0x51, 0x38, 0x35, 0xe1,
-	0x43, 0xd4, 0xe1, 0xca, 0x67, 0xe6, 0xe3, 0xc0, 0x86, 0xc7, 0x87, 0x9d, 0xe7, 0x43, 0x28, 0x68,
-	0xaa, 0xab, 0x32, 0x2e, 0x57, 0x53, 0xb9, 0x90, 0x13, 0x28, 0x94, 0x1c, 0x21, 0x28, 0x0c, 0x4c,
-	0x0d, 0xd3, 0xb3, 0xe6, 0x15, 0xfa, 0x8d, 0xea, 0x50, 0x1e, 0x63, 0xc7, 0x51, 0x47, 0xb8, 0x9e,
-	0xa7, 0x10, 0xf0, 0xa1, 0xfc, 0x00, 0x36, 0x8f, 0x6d, 0xac, 0xba, 0xf8, 0xb5, 0xd5, 0xef, 0x43,
-	0x85, 0x73, 0x62, 0x07, 0xc8, 0xca, 0x0a, 0x6d, 0x43, 0xd1, 0x35, 0x9f, 0x61, 0x83, 0xdb, 0x8b,
-	0x0e, 0x88, 0xaa, 0x67, 0x96, 0xf6, 0x26, 0x54, 0xfd, 0x5b, 0x82, 0x0a, 0x67, 0xc5, 0x74, 0xfd,
-	0x34, 0x02, 0xf6, 0x8d, 0x64, 0x46, 0xd1, 0x3d, 0xcd, 0xac, 0xa0, 0x37, 0x3e, 0x87, 0xc2, 0xeb,
-	0xb9, 0xdc, 0xcf, 0xb0, 0x73, 0x6c, 0x1a, 0x43, 0xdd, 0x1e, 0x2b, 0x78, 0xa4, 0x3b, 0x2e, 0xb6,
-	0x39, 0x26, 0xfb, 0x80, 0xa6, 0xd8, 0xd6, 0x87, 0xfa, 0x40, 0x75, 0x49, 0xfc, 0x78, 0x38, 0x7a,
-	0xf1, 0xb0, 0x15, 0x5e, 0x79, 0x4c, 0x16, 0x50, 0x03, 0x56, 0x2d, 0xd5, 0x71, 0x5e, 0x98, 0x36,
-	0x0f, 0x0e, 0x7f, 0xcc, 0xd7, 0xe8, 0xb9, 0xf2, 0xc1, 0x1a, 0x19, 0xcb, 0x1d, 0xa8, 0xcd, 0x28,
-	0xc0, 0x90, 0xe4, 0x50, 0x48, 0xc9, 0x50, 0xe4, 0xa2, 0xfe, 0xf7, 0x05, 0x6c, 0xfb, 0x8c, 0x1c,
-	0x6c, 0xf8, 0x51, 0xb4, 0x0d, 0x45, 0x3c, 0x56, 0xf5, 0x73, 0xa6, 0xba, 0x37, 0x20, 0xb3, 0xd6,
-	0xf7, 0xa6, 0xc1, 0xb9, 0x78, 0x03, 0xb9, 0x0d, 0x17, 0x63, 0x3c, 0x32, 0xa9, 0xf2, 0x2a, 0x07,
-	0x1b, 0x0f, 0xcd, 0x91, 0x6e, 0xa4, 0xeb, 0x90, 0x06, 0x99, 0xaf, 0x5f, 0x3e, 0xa4, 0x5f, 0x04,
-	0xc8, 0x42, 0x14, 0x48, 0x74, 0x15, 0x36, 0x34, 0x3c, 0xd5, 0x07, 0x98, 0x59, 0xaa, 0x48, 0xd7,
-	0xd7, 0xbd, 0xb9, 0xc0, 0x46, 0xe7, 0xaa, 0x3b, 0x34, 0xed, 0x71, 0xbd, 0x74, 0x45, 0xda, 0x2d,
-	0x2a, 0xfe, 0x18, 0xdd, 0x82, 0xad, 0x89, 0xa1, 0x3f, 0x9f, 0xe0, 0xbe, 0xae, 0x61, 0xc3, 0xd5,
-	0x87, 0x3a, 0xb6, 0xeb, 0x65, 0xca, 0xa3, 0xea, 0x2d, 0x74, 0xfd, 0x79, 0x74, 0x1d, 0x2a, 0xaa,
-	0x65, 0x85, 0x29, 0x57, 0x29, 0xe5, 0xa6, 0x6a, 0x59, 0x01, 0x99, 0xfc, 0x6b, 0x0e, 0x36, 0x19,
-	0x0e, 0x0c, 0xc7, 0x7b, 0x91, 0xe0, 0xd8, 0x4d, 0x76, 0xd2, 0xc8, 0x96, 0xec, 0xb1, 0xf1, 0x4a,
-	0x0a, 0x82, 0x83, 0xa5, 0xfc, 0xf4, 0xe0, 0xe0, 0xd7, 0x00, 0xa7, 0x46, 0x2d, 0xd8, 0x60, 0x6b,
-	0x7d, 0xdd, 0x18, 0x9a, 0x54, 0xee, 0xbc, 0xfc, 0xd9, 0x35, 0x86, 0xa6, 0xb2, 0xae, 0x06, 0x03,
-	0xf9, 0x3b, 0x0a, 0x82, 0x39, 0x71, 0x17, 0xbb, 0x94, 0xc2, 0xb7, 0x4f, 0x4e, 0x70, 0xfb, 0xe4,
-	0xc3, 0xb7, 0xcf, 0x67, 0x50, 0xe1, 0xfc, 0x33, 0x79, 0x6b, 0x0b, 0x76, 0x14, 0x3c, 0x30, 0xa7,
-	0xd8, 0x3e, 0x65, 0xde, 0x97, 0x25, 0x74, 0xfe, 0x91, 0xa0, 0x36, 0xc3, 0x86, 0xe9, 0x73, 0x3f,
-	0x62, 0xf5, 0x83, 0x64, 0xfc, 0x04, 0x9b, 0xb3, 0xdb, 0xff, 0x90, 0x99, 0xff, 0x0e, 0x6c, 0xf3,
-	0xb0, 0xea, 0xdb, 0xd8, 0xc1, 0x6e, 0x24, 0x95, 0x21, 0x2b, 0x10, 0x88, 0x5d, 0x1a, 0x27, 0xf2,
-	0x2f, 0x12, 0x5c, 0xf4, 0x32, 0x74, 0x1c, 0x91, 0xa5, 0x79, 0x65, 0xce, 0x8b, 0xf7, 0x61, 0x27,
-	0xae, 0x42, 0x26, 0xeb, 0xee, 0xc1, 0xfa, 0x43, 0x73, 0xf0, 0x2c, 0xe4, 0x7b, 0xbe, 0x4b, 0xfb,
-	0xbe, 0xc7, 0xbd, 0x55, 0x93, 0xef, 0x91, 0xc4, 0x45, 0xa8, 0x33, 0xc9, 0xea, 0xc1, 0x4e, 0xd7,
-	0x70, 0xb1, 0x6d, 0xa8, 0xe7, 0x7e, 0x1a, 0x5d, 0x44, 0x6c, 0x1a, 0x48, 0xf2, 0x25, 0xa8, 0xcd,
-	0x30, 0xf5, 0xb4, 0x93, 0x9f, 0x42, 0xad, 0x83, 0x5d, 0x16, 0x78, 0x3d, 0x57, 0x75, 0x27, 0x0e,
-	0x17, 0xb8, 0x64, 0x09, 0x47, 0xe8, 0x5d, 0xac, 0x8e, 0x83, 0xe0, 0x2a, 0x91, 0x61, 0x57, 0x93,
-	0xff, 0x95, 0xa0, 0x3e, 0x2b, 0x84, 0xc1, 0xd3, 0x89, 0x38, 0xf6, 0xdd, 0x64, 0xc7, 0x16, 0xed,
-	0xce, 0xee, 0xd9, 0x5d, 0xe6, 0xd9, 0x47, 0xf1, 0x5b, 0xff, 0x83, 0xd4, 0xd4, 0xe4, 0x89, 0xa7,
-	0x09, 0xca, 0xbf, 0xff, 0xff, 0x92, 0xa0, 0xd6, 0x7b, 0xcb, 0x18, 0xa2, 0x4f, 0xa0, 0xe4, 0x50,
-	0xce, 0xf4, 0xd2, 0xaa, 0x1c, 0x5c, 0x5b, 0x40, 0x4d, 0x85, 0x6d, 0x91, 0x1f, 0x40, 0xbd, 0x27,
-	0xc2, 0x7f, 0x39, 0xf7, 0xfc, 0x53, 0x82, 0x3a, 0x8d, 0xcc, 0x33, 0x67, 0x36, 0xd7, 0xbd, 0xb1,
-	0xc3, 0x86, 0x7d, 0xb9, 0x90, 0x12, 0xf0, 0xc5, 0x58, 0xc0, 0x77, 0xe1, 0x52, 0x82, 0x72, 0x99,
-	0x0e, 0xfa, 0x9b, 0x04, 0xf2, 0x09, 0x7e, 0xc1, 0x30, 0x63, 0x51, 0x13, 0xd4, 0x6a, 0xfc, 0xc8,
-	0x08, 0x0a, 0x63, 0xce, 0xb4, 0xa8, 0xd0, 0xef, 0xe4, 0x82, 0x39, 0x5c, 0x5e, 0xe6, 0x97, 0x2a,
-	0x2f, 0xaf, 0xc3, 0xb5, 0x54, 0x45, 0x58, 0x20, 0x1f, 0x92, 0xbb, 0x43, 0xd5, 0x18, 0x5d, 0x44,
-	0xc9, 0x39, 0x09, 0xeb, 0x0e, 0x31, 0x69, 0x7c, 0x27, 0x03, 0xcd, 0x3f, 0x8b, 0x14, 0x2e, 0xfe,
-	0x7f, 0xcf, 0x41, 0x99, 0x91, 0xa3, 0x0a, 0xe4, 0x7c, 0xa6, 0x39, 0x5d, 0x23, 0x90, 0x0e, 0x68,
-	0xe7, 0xa1, 0xb1, 0x48, 0xe4, 0x43, 0xb2, 0x32, 0xa1, 0xe9, 0xd8, 0x33, 0x77, 0x5e, 0xe1, 0xc3,
-	0xe0, 0x92, 0x2c, 0x88, 0x6a, 0xbb, 0xa2, 0xa8, 0xb6, 0x2b, 0x89, 0x6a, 0xbb, 0x72, 0xac, 0xb6,
-	0x7b, 0x0f, 0xd6, 0x06, 0x1e, 0x78, 0x58, 0xa3, 0xa5, 0xd6, 0xaa, 0x12, 0x4c, 0x84, 0xc2, 0x6b,
-	0x6d, 0xf9, 0xf0, 0xfa, 0x1a, 0xb6, 0x9e, 0xcc, 0x14, 0xf3, 0x73, 0xd2, 0xf5, 0x65, 0x00, 0xfc,
-	0xa3, 0xa5, 0xdb, 0xd8, 0xe9, 0xab, 0x2e, 0x43, 0x6a, 0x8d, 0xcd, 0x1c, 0xb9, 0xf2, 0x97, 0x70,
-	0x21, 0xcc, 0xf2, 0x28, 0xde, 0x8b, 0x85, 0xcd, 0x31, 0x8f, 0xd7, 0x4f, 0xb0, 0xce, 0xca, 0x32,
-	0x92, 0xb7, 0xe6, 0x29, 0xb6, 0x64, 0xe9, 0x14, 0x13, 0x5e, 0x88, 0x0b, 0xff, 0xc6, 0xef, 0xeb,
-	0xa9, 0xf0, 0x65, 0x53, 0x44, 0x03, 0x56, 0xf1, 0xd8, 0x3a, 0x37, 0x5f, 0x62, 0xff, 0x7a, 0xe7,
-	0x63, 0xf9, 0x14, 0xb6, 0x66, 0xb2, 0x72, 0xc8, 0x90, 0xd2, 0xf2, 0x86, 0x3c, 0x84, 0x32, 0x43,
-	0x6a, 0xc6, 0xad, 0xe7, 0x60, 0xbc, 0xe7, 0x77, 0x4e, 0xd4, 0x6c, 0x2f, 0x43, 0xe5, 0x5f, 0x42,
-	0xfc, 0x04, 0x3d, 0x12, 0xa7, 0xce, 0x92, 0xa3, 0x6e, 0x7e, 0x0b, 0x9b, 0x91, 0x73, 0xa0, 0x1d,
-	0x40, 0x91, 0x89, 0xfe, 0xc9, 0xa3, 0x93, 0x76, 0x75, 0x05, 0x6d, 0xc0, 0x6a, 0xf7, 0xe4, 0xe8,
-	0xf8, 0x71, 0xf7, 0x49, 0xbb, 0x2a, 0x21, 0x80, 0x12, 0xfb, 0xce, 0x91, 0xef, 0x87, 0x8f, 0x8e,
-	0xbf, 0x6a, 0xb7, 0xaa, 0x79, 0xb4, 0x09, 0x6b, 0xbd, 0xb3, 0xde, 0x69, 0xfb, 0xa4, 0xd5, 0x6e,
-	0x55, 0x0b, 0x07, 0xff, 0x55, 0xa0, 0xc2, 0xb9, 0x61, 0x9b, 0x74, 0x40, 0x48, 0xf3, 0x1e, 0x57,
-	0x38, 0x46, 0xbb, 0xa2, 0x2a, 0x34, 0xfe, 0x86, 0xd4, 0xb8, 0xb1, 0x00, 0x25, 0xcb, 0x63, 0x2b,
-	0xe8, 0x11, 0x14, 0xc8, 0x02, 0xba, 0x2a, 0xde, 0xc4, 0xf9, 0xca, 0x69, 0x24, 0x3e, 0xc3, 0x33,
-	0x28, 0x79, 0x8f, 0x21, 0x48, 0xe0, 0x0d, 0x91, 0x47, 0x97, 0xc6, 0xfb, 0xe9, 0x44, 0x61, 0xb6,
-	0x5e, 0x79, 0x29, 0x62, 0x1b, 0x79, 0x20, 0x11, 0xb1, 0x8d, 0x3e, 0x63, 0xc8, 0x2b, 0xc8, 0x82,
-	0x77, 0x62, 0xdd, 0x3c, 0xda, 0x13, 0x68, 0x94, 0xf8, 0xea, 0xd0, 0xd8, 0x5f, 0x90, 0xda, 0x97,
-	0xf8, 0x03, 0x6c, 0x46, 0x5a, 0x76, 0x74, 0x73, 0x0e, 0x87, 0xd0, 0xdb, 0x40, 0xe3, 0xd6, 0x42,
-	0xb4, 0xbe, 0x2c, 0x05, 0x8a, 0xb4, 0x37, 0x45, 0x72, 0x6a, 0xe3, 0xea, 0xf1, 0xbe, 0xb6, 0x40,
-	0x73, 0xeb, 0x19, 0xc2, 0xeb, 0xde, 0x90, 0x78, 0x43, 0xd0, 0x3b, 0x8a, 0x0c, 0x11, 0x6d, 0x00,
-	0x3d, 0x43, 0xc4, 0x1a, 0x2a, 0x91, 0x21, 0x92, 0x7b, 0x3f, 0x91, 0x21, 0x04, 0x5d, 0x9a, 0xbc,
-	0x82, 0xc6, 0xfc, 0x25, 0xcc, 0x17, 0x78, 0x2b, 0xcd, 0x69, 0xe2, 0xf2, 0xf6, 0x16, 0x23, 0x0e,
-	0x07, 0x1a, 0xe9, 0x54, 0x44, 0x81, 0x16, 0xea, 0x79, 0x1a, 0x72, 0x1a, 0x49, 0x18, 0xb1, 0x58,
-	0x9f, 0x21, 0x42, 0x2c, 0xb9, 0xc7, 0x11, 0x21, 0x26, 0x6a, 0x5e, 0x56, 0x90, 0x03, 0xd5, 0x78,
-	0x65, 0x8b, 0xf6, 0x45, 0x4f, 0x13, 0x89, 0x25, 0x7a, 0xa3, 0xb9, 0x28, 0x79, 0x58, 0x68, 0x67,
-	0x41, 0xa1, 0x9d, 0xe5, 0x84, 0x76, 0xc4, 0x42, 0xa7, 0xb0, 0x35, 0x53, 0xdb, 0xa2, 0xa6, 0xc8,
-	0xc3, 0x92, 0x2b, 0xf4, 0xc6, 0xed, 0x85, 0xe9, 0x7d, 0xb9, 0x7f, 0x48, 0xf0, 0x6e, 0x4a, 0xfd,
-	0x89, 0x0e, 0x93, 0x59, 0xce, 0xaf, 0x9d, 0x1b, 0x1f, 0x67, 0xd8, 0x99, 0x90, 0xb3, 0xbc, 0x2b,
-	0x74, 0x4e, 0xce, 0x8a, 0xdc, 0xca, 0x73, 0x72, 0x56, 0xf4, 0x4e, 0x96, 0x57, 0xd0, 0x08, 0x80,
-	0x00, 0xf3, 0xd6, 0x05, 0x3d, 0x2d, 0xd1, 0x7f, 0x67, 0xee, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff,
-	0x7a, 0xa8, 0x33, 0x21, 0xcf, 0x19, 0x00, 0x00,
+	// 1631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5f, 0x6f, 0xdc, 0x44,
+	0x10, 0xaf, 0xef, 0x6f, 0x32, 0x77, 0xb9, 0x26, 0xdb, 0x34, 0xb9, 0x9a, 0x56, 0x6a, 0x9d, 0x54,
+	0xa4, 0x4d, 0x72, 0xad, 0x52, 0x2a, 0x82, 0x28, 0x45, 0x21, 0xb9, 0xa6, 0x07, 0x55, 0x5a, 0x9c,
+	0xa6, 0x02, 0x09, 0xe9, 0xe4, 0x9e, 0x37, 0xa9, 0x69, 0xce, 0xbe, 0x7a, 0x7d, 0x57, 0x2a, 0x1e,
+	0x10, 0x42, 0x88, 0x17, 0x1e, 0x79, 0x43, 0x88, 0xcf, 0x81, 0x10, 0xbc, 0xc3, 0x67, 0xe0, 0xc3,
+	0x20, 0xef, 0x1f, 0xff, 0x3b, 0xdb, 0xe7, 0x73, 0xdb, 0xa7, 0xdc, 0xee, 0xce, 0xfe, 0x66, 0xfc,
+	0xdb, 0x99, 0xd9, 0x99, 0x0d, 0xb4, 0x08, 0xb6, 0x47, 0xd8, 0xbe, 0xa1, 0xf5, 0x7a, 0xd6, 0xd0,
+	0x74, 0x36, 0x89, 0x3d, 0xba, 0x31, 0xb0, 0x2d, 0xc7, 0x12, 0x33, 0xe2, 0x6f, 0x8b, 0xce, 0xa2,
+	0xc5, 0x13, 0xab, 0xd5, 0x37, 0x7a, 0xb6, 0xd5, 0x22, 0xf6, 0xa8, 0xc5, 0xd7, 0x94, 0x5b, 0x80,
+	0x54, 0xac, 0xe9, 0x87, 0x98, 0x10, 0xc3, 0x32, 0x55, 0xfc, 0x62, 0x88, 0x89, 0x83, 0x2e, 0x01,
+	0x10, 0x36, 0xd3, 0x35, 0xf4, 0xa6, 0x74, 0x59, 0x5a, 0x9b, 0x55, 0x67, 0xf9, 0x4c, 0x47, 0x57,
+	0xda, 0x70, 0x2e, 0xb4, 0x89, 0x0c, 0x2c, 0x93, 0x60, 0xb4, 0x0c, 0xd5, 0x21, 0xc1, 0xb6, 0xbf,
+	0xa5, 0xe2, 0x0e, 0x3b, 0x3a, 0x3a, 0x0f, 0x15, 0xcb, 0x3e, 0x71, 0xe7, 0x0b, 0x74, 0xbe, 0x6c,
+	0xd9, 0x27, 0x1d, 0x5d, 0xb9, 0x07, 0xb5, 0x1d, 0x66, 0xc6, 0x9e, 0xe6, 0x68, 0xe8, 0x7d, 0xa8,
+	0x72, 0xab, 0xe8, 0xf6, 0xda, 0xd6, 0xa5, 0x56, 0x9c, 0xc9, 0x2d, 0xbe, 0x47, 0x15, 0xd2, 0x2e,
+	0x8e, 0x6b, 0x8e, 0x30, 0x3e, 0x37, 0x0e, 0x81, 0x3a, 0xc3, 0xe1, 0xdf, 0x73, 0x1b, 0x4a, 0xba,
+	0xe6, 0x68, 0x1c, 0xe5, 0x4a, 0x2a, 0x8a, 0xfb, 0x05, 0x2a, 0x15, 0x47, 0x08, 0x4a, 0x3d, 0x4b,
+	0xc7, 0xf4, 0x5b, 0x8b, 0x2a, 0xfd, 0x8d, 0x9a, 0x50, 0xed, 0x63, 0x42, 0xb4, 0x13, 0xdc, 0x2c,
+	0x52, 0x0a, 0xc4, 0x50, 0xb9, 0x0f, 0x73, 0xbb, 0x36, 0xd6, 0x1c, 0xfc, 0xda, 0xe6, 0x77, 0xa1,
+	0x21, 0x90, 0xf8, 0x07, 0xe4, 0x85, 0x42, 0x8b, 0x50, 0x76, 0xac, 0xe7, 0xd8, 0x14, 0xe7, 0x45,
+	0x07, 0xae, 0xa9, 0x47, 0x03, 0xfd, 0x4d, 0x98, 0xfa, 0xb7, 0x04, 0x0d, 0x01, 0xc5, 0x6d, 0xfd,
+	0x28, 0x44, 0xf6, 0xb5, 0x78, 0xa0, 0xf0, 0x9e, 0x56, 0x5e, 0xd2, 0xe5, 0x8f, 0xa1, 0xf4, 0x7a,
+	0x2e, 0xf7, 0x1d, 0x2c, 0xed, 0x5a, 0xe6, 0xb1, 0x61, 0xf7, 0x55, 0x7c, 0x62, 0x10, 0x07, 0xdb,
+	0x82, 0x93, 0x4d, 0x40, 0x23, 0x6c, 0x1b, 0xc7, 0x46, 0x4f, 0x73, 0xdc, 0xf8, 0x61, 0x3c, 0xb2,
+	0x78, 0x58, 0x08, 0xae, 0x3c, 0x76, 0x17, 0x90, 0x0c, 0x33, 0x03, 0x8d, 0x90, 0x97, 0x96, 0x2d,
+	0x82, 0xc3, 0x1b, 0x8b, 0x35, 0xfa, 0x5d, 0x45, 0x7f, 0xcd, 0x1d, 0x2b, 0xfb, 0xb0, 0x3c, 0x66,
+	0x00, 0x67, 0x52, 0x50, 0x21, 0xc5, 0x53, 0x51, 0x08, 0xfb, 0xdf, 0x27, 0xb0, 0xe8, 0x01, 0x11,
+	0x6c, 0x7a, 0x51, 0xb4, 0x08, 0x65, 0xdc, 0xd7, 0x8c, 0x53, 0x6e, 0x3a, 0x1b, 0xb8, 0xb3, 0x83,
+	0x67, 0x96, 0x29, 0x50, 0xd8, 0x40, 0x69, 0xc3, 0xf9, 0x08, 0x46, 0x2e, 0x53, 0x7e, 0x2c, 0x40,
+	0xfd, 0x81, 0x75, 0x62, 0x98, 0xe9, 0x36, 0xa4, 0x51, 0xe6, 0xd9, 0x57, 0x0c, 0xd8, 0x17, 0x22,
+	0xb2, 0x14, 0x26, 0x12, 0x5d, 0x81, 0xba, 0x8e, 0x47, 0x46, 0x0f, 0xf3, 0x93, 0x2a, 0xd3, 0xf5,
+	0x1a, 0x9b, 0xf3, 0xcf, 0xe8, 0x54, 0x73, 0x8e, 0x2d, 0xbb, 0xdf, 0xac, 0x5c, 0x96, 0xd6, 0xca,
+	0xaa, 0x37, 0x46, 0xeb, 0xb0, 0x30, 0x34, 0x8d, 0x17, 0x43, 0xdc, 0x35, 0x74, 0x6c, 0x3a, 0xc6,
+	0xb1, 0x81, 0xed, 0x66, 0x95, 0x62, 0xcc, 0xb3, 0x85, 0x8e, 0x37, 0x8f, 0xae, 0x42, 0x43, 0x1b,
+	0x0c, 0x82, 0x92, 0x33, 0x54, 0x72, 0x4e, 0x1b, 0x0c, 0x7c, 0x31, 0xe5, 0x8f, 0x02, 0xcc, 0x71,
+	0x1e, 0x38, 0x8f, 0x77, 0x42, 0xc1, 0xb1, 0x16, 0xef, 0xa4, 0xa1, 0x2d, 0xf9, 0x63, 0xe3, 0x4f,
+	0xc9, 0x0f, 0x0e, 0x9e, 0xf2, 0xd3, 0x83, 0x43, 0x5c, 0x03, 0x42, 0x1a, 0xed, 0x41, 0x9d, 0xaf,
+	0x75, 0x0d, 0xf3, 0xd8, 0x6a, 0x16, 0x32, 0xe4, 0xcf, 0x8e, 0x79, 0x6c, 0xa9, 0x35, 0xcd, 0x1f,
+	0xa0, 0xf7, 0xa0, 0x42, 0x4f, 0x84, 0x50, 0x03, 0x6b, 0x5b, 0x17, 0xe3, 0xf7, 0xd3, 0x23, 0x22,
+	0x2a, 0x97, 0x55, 0x7e, 0x90, 0x28, 0x77, 0xd6, 0xd0, 0xc9, 0x76, 0x97, 0x05, 0x2f, 0xad, 0x42,
+	0xc2, 0xa5, 0x55, 0x0c, 0x5c, 0x5a, 0x68, 0x05, 0xe6, 0xa8, 0xaa, 0xae, 0xe0, 0x86, 0x39, 0x54,
+	0x9d, 0x4e, 0x72, 0x2a, 0x94, 0xbb, 0xd0, 0x10, 0x46, 0xe4, 0x8a, 0x84, 0x3d, 0x58, 0x52, 0x71,
+	0xcf, 0x1a, 0x61, 0xfb, 0x11, 0xf7, 0xec, 0x3c, 0x61, 0xf9, 0x8f, 0x04, 0xcb, 0x63, 0x30, 0xdc,
+	0x9e, 0x7b, 0x21, 0x8f, 0xda, 0x8a, 0xe7, 0x36, 0x61, 0x73, 0x7e, 0xdf, 0xda, 0xe6, 0xae, 0x75,
+	0x13, 0x16, 0x45, 0xc8, 0x76, 0x6d, 0x4c, 0xb0, 0x13, 0x4a, 0x93, 0x68, 0xe0, 0x2b, 0xc4, 0x0e,
+	0x3d, 0x60, 0xe5, 0x7b, 0x09, 0xce, 0xb3, 0xec, 0x1f, 0x65, 0x64, 0x6a, 0xac, 0xdc, 0x39, 0xf7,
+	0x1e, 0x2c, 0x45, 0x4d, 0xc8, 0x75, 0xba, 0x1b, 0x50, 0x7b, 0x60, 0xf5, 0x9e, 0x07, 0x1c, 0xd4,
+	0x0b, 0x17, 0xcf, 0x41, 0x45, 0x24, 0xe8, 0xca, 0x1d, 0xa8, 0x33, 0xe9, 0x5c, 0xba, 0x0e, 0x61,
+	0xa9, 0x63, 0x3a, 0xd8, 0x36, 0xb5, 0x53, 0x2f, 0x45, 0x67, 0x51, 0x9b, 0x46, 0x92, 0x72, 0x01,
+	0x96, 0xc7, 0x40, 0x99, 0x75, 0xca, 0x53, 0x58, 0xde, 0xc7, 0x0e, 0x0f, 0xea, 0x43, 0x47, 0x73,
+	0x86, 0x44, 0x28, 0x9c, 0xb2, 0x3c, 0x74, 0xe5, 0x1d, 0xac, 0xf5, 0xfd, 0x08, 0xac, 0xb8, 0xc3,
+	0x8e, 0xae, 0xfc, 0x27, 0x41, 0x73, 0x5c, 0x09, 0xa7, 0x67, 0x3f, 0xe4, 0xd8, 0xb7, 0xe2, 0x1d,
+	0x3b, 0x69, 0x77, 0x7e, 0xcf, 0xee, 0x70, 0xcf, 0xde, 0x89, 0x56, 0x14, 0xef, 0xa6, 0xa6, 0x3d,
+	0xa6, 0x9e, 0x26, 0x3f, 0xaf, 0xb6, 0xf8, 0x5d, 0x82, 0xe5, 0xc3, 0xb7, 0xcc, 0x21, 0xfa, 0x10,
+	0x2a, 0x84, 0x22, 0xd3, 0xfc, 0xd5, 0xd8, 0x5a, 0xc9, 0x60, 0xa6, 0xca, 0xb7, 0x28, 0xf7, 0xa1,
+	0x79, 0x98, 0xc4, 0xff, 0x74, 0xee, 0xf9, 0xab, 0x04, 0x4d, 0x1a, 0x99, 0x47, 0x64, 0x3c, 0xd7,
+	0xbd, 0xb1, 0x8f, 0x0d, 0xfa, 0x72, 0x29, 0x25, 0xe0, 0xcb, 0x91, 0x80, 0xef, 0xc0, 0x85, 0x18,
+	0xe3, 0x72, 0x7d, 0xe8, 0x4f, 0x12, 0x28, 0x07, 0xf8, 0x25, 0xe7, 0x8c, 0x47, 0x8d, 0x5f, 0x07,
+	0x8a, 0x4f, 0x46, 0x50, 0xea, 0x0b, 0xd0, 0xb2, 0x4a, 0x7f, 0xc7, 0x17, 0xe3, 0xc1, 0xd2, 0xb5,
+	0x38, 0x55, 0xe9, 0x7a, 0x15, 0x56, 0x52, 0x0d, 0xe1, 0x81, 0xbc, 0xed, 0xde, 0x1d, 0x9a, 0xce,
+	0xe5, 0x42, 0x46, 0x4e, 0x48, 0x58, 0x37, 0xa1, 0x39, 0xbe, 0x93, 0x93, 0xe6, 0x7d, 0x8b, 0x14,
+	0x6c, 0x2c, 0x7e, 0x2e, 0x40, 0x95, 0x8b, 0xa3, 0x06, 0x14, 0x3c, 0xd0, 0x82, 0xa1, 0xbb, 0x94,
+	0xf6, 0x68, 0x57, 0xa3, 0xf3, 0x48, 0x14, 0x43, 0x77, 0x65, 0x48, 0xd3, 0x31, 0x3b, 0xee, 0xa2,
+	0x2a, 0x86, 0xfe, 0x25, 0x59, 0x4a, 0xaa, 0x1b, 0xcb, 0x49, 0x75, 0x63, 0x25, 0xa9, 0x6e, 0xac,
+	0x46, 0xea, 0xc6, 0x8b, 0x30, 0xdb, 0x63, 0xe4, 0x61, 0x9d, 0x96, 0x71, 0x33, 0xaa, 0x3f, 0x11,
+	0x08, 0xaf, 0xd9, 0xe9, 0xc3, 0xeb, 0x73, 0x58, 0x78, 0x32, 0xd6, 0x28, 0x4c, 0x48, 0xd7, 0x97,
+	0x00, 0xf0, 0x37, 0x03, 0xc3, 0xc6, 0xa4, 0xab, 0x39, 0x9c, 0xa9, 0x59, 0x3e, 0xb3, 0xe3, 0x28,
+	0x9f, 0xc2, 0xb9, 0x20, 0xe4, 0x4e, 0xb4, 0xcf, 0x0b, 0x1e, 0xc7, 0x24, 0xac, 0x6f, 0xa1, 0xc6,
+	0xeb, 0x1c, 0x5a, 0xa7, 0x4d, 0x30, 0x6c, 0xda, 0xfa, 0x2a, 0xac, 0xbc, 0x14, 0x55, 0xfe, 0xa5,
+	0xf7, 0x66, 0x40, 0x95, 0x4f, 0x9b, 0x22, 0x64, 0x98, 0xc1, 0xfd, 0xc1, 0xa9, 0xf5, 0x0a, 0x7b,
+	0xd7, 0xbb, 0x18, 0x2b, 0x8f, 0x60, 0x61, 0x2c, 0x2b, 0x07, 0x0e, 0x52, 0x9a, 0xfe, 0x20, 0xb7,
+	0xa1, 0xca, 0x99, 0x1a, 0x73, 0xeb, 0x09, 0x1c, 0xff, 0x26, 0x41, 0x85, 0x55, 0xb6, 0x6e, 0x83,
+	0xa2, 0xf5, 0x7a, 0x98, 0x90, 0x50, 0x5d, 0x53, 0x63, 0x73, 0x8f, 0x33, 0x1c, 0x98, 0x5b, 0xb2,
+	0xda, 0xf8, 0xd8, 0xc6, 0xe4, 0x19, 0x87, 0x60, 0x5f, 0x5e, 0xe7, 0x93, 0x0c, 0x63, 0x03, 0x90,
+	0x10, 0x1a, 0xe3, 0x7f, 0x9e, 0xaf, 0xb4, 0x3d, 0xfb, 0x6e, 0x43, 0x43, 0x65, 0x73, 0x22, 0x29,
+	0x8c, 0x29, 0x91, 0xc6, 0x95, 0x28, 0x7f, 0x49, 0x70, 0xd6, 0xdb, 0xc7, 0x53, 0xc2, 0xdd, 0xd0,
+	0x85, 0x7d, 0x3d, 0xa9, 0x12, 0x0d, 0x6d, 0xca, 0x7f, 0x4f, 0xdf, 0xe1, 0xf7, 0xb4, 0xdf, 0x5d,
+	0x48, 0x53, 0x74, 0x17, 0x1b, 0x5e, 0xb3, 0x4c, 0xa3, 0xe9, 0x55, 0xa0, 0x2a, 0x8f, 0x49, 0x6b,
+	0x7e, 0x5b, 0x2c, 0xa4, 0xf3, 0x5c, 0x1d, 0xd7, 0xbf, 0x82, 0xb9, 0x90, 0x7b, 0xa1, 0x25, 0x40,
+	0xa1, 0x89, 0xee, 0xc1, 0xc3, 0x83, 0xf6, 0xfc, 0x19, 0x54, 0x87, 0x99, 0xce, 0xc1, 0xce, 0xee,
+	0xe3, 0xce, 0x93, 0xf6, 0xbc, 0x84, 0x00, 0x2a, 0xfc, 0x77, 0xc1, 0xfd, 0xfd, 0xe0, 0xe1, 0xee,
+	0x67, 0xed, 0xbd, 0xf9, 0x22, 0x9a, 0x83, 0xd9, 0xc3, 0xa3, 0xc3, 0x47, 0xed, 0x83, 0xbd, 0xf6,
+	0xde, 0x7c, 0x69, 0xeb, 0xdf, 0xb3, 0xd0, 0x10, 0x68, 0xd8, 0x76, 0x9b, 0x5e, 0xa4, 0xb3, 0xf7,
+	0x34, 0xe1, 0xba, 0x6b, 0x49, 0x47, 0x12, 0x7d, 0x36, 0x94, 0xaf, 0x65, 0x90, 0xe4, 0xd7, 0xcb,
+	0x19, 0xf4, 0x10, 0x4a, 0xee, 0x02, 0xba, 0x92, 0xbc, 0x49, 0xe0, 0x2a, 0x69, 0x22, 0x1e, 0xe0,
+	0x11, 0x54, 0xd8, 0xfb, 0x17, 0x4a, 0x08, 0xd2, 0xd0, 0x3b, 0x9b, 0xbc, 0x9a, 0x2e, 0x14, 0x84,
+	0x65, 0x55, 0x7f, 0x12, 0x6c, 0xe8, 0x4d, 0x4c, 0x5e, 0x4d, 0x17, 0xf2, 0x60, 0x07, 0x70, 0x36,
+	0xf2, 0x80, 0x83, 0x36, 0x12, 0x2c, 0x8a, 0x7d, 0x68, 0x92, 0x37, 0x33, 0x4a, 0x7b, 0x1a, 0xbf,
+	0x86, 0xb9, 0xd0, 0x2b, 0x0d, 0xba, 0x3e, 0x01, 0x21, 0xf0, 0x1c, 0x24, 0xaf, 0x67, 0x92, 0xf5,
+	0x74, 0xa9, 0x50, 0xa6, 0xcf, 0x11, 0x48, 0x49, 0x7d, 0xab, 0x60, 0xd8, 0x2b, 0x19, 0xde, 0x33,
+	0xd8, 0x41, 0xb0, 0xa6, 0x1a, 0x25, 0x6f, 0xf0, 0xfb, 0x7e, 0x79, 0x35, 0x5d, 0xc8, 0x83, 0xfd,
+	0x02, 0xaa, 0x3c, 0xbb, 0xa0, 0xd5, 0x09, 0xc9, 0x87, 0x01, 0x5f, 0xcd, 0x94, 0xa2, 0xd8, 0x11,
+	0x47, 0x3a, 0xe8, 0xa4, 0x23, 0x8e, 0x6f, 0xf6, 0xe5, 0xcd, 0x8c, 0xd2, 0x9e, 0xc6, 0xbe, 0x78,
+	0x56, 0xf5, 0x14, 0xae, 0xa7, 0xb9, 0x63, 0x54, 0xdf, 0x46, 0x36, 0xe1, 0x60, 0x08, 0xbb, 0xad,
+	0x69, 0x52, 0x08, 0x07, 0x9a, 0x5c, 0x59, 0x49, 0x13, 0x09, 0x32, 0x16, 0x69, 0x2c, 0x93, 0x18,
+	0x8b, 0x6f, 0x6a, 0xe5, 0xcd, 0x8c, 0xd2, 0x9e, 0x46, 0x02, 0xf3, 0xd1, 0x56, 0x06, 0x6d, 0x26,
+	0xbd, 0x73, 0xc5, 0xf6, 0x64, 0x72, 0x2b, 0xab, 0x78, 0x50, 0xe9, 0x7e, 0x46, 0xa5, 0xfb, 0xd3,
+	0x29, 0xdd, 0x4f, 0x56, 0x3a, 0x82, 0x85, 0xb1, 0x66, 0x06, 0xb5, 0x92, 0x3c, 0x2c, 0xbe, 0x25,
+	0x93, 0x6f, 0x64, 0x96, 0xf7, 0xf4, 0xfe, 0x22, 0xc1, 0x3b, 0x29, 0x0d, 0x07, 0xda, 0x8e, 0x87,
+	0x9c, 0xdc, 0x2c, 0xc9, 0x1f, 0xe4, 0xd8, 0x19, 0x93, 0x0d, 0xd9, 0xe5, 0x3c, 0x21, 0x1b, 0x86,
+	0xee, 0x7b, 0x79, 0x3d, 0x93, 0xac, 0xa7, 0xeb, 0x04, 0xc0, 0x25, 0xe6, 0xad, 0x2b, 0x7a, 0x5a,
+	0xa1, 0xff, 0xea, 0xbb, 0xf5, 0xff, 0x00, 0xbd, 0x90, 0x0a, 0x42, 0x1c, 0x1c, 0x00, 0x00,
 }
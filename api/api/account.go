@@ -25,6 +25,7 @@ func (p AccountService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/account")
+	ws.Doc("Account")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.AccountSrv,
@@ -32,38 +33,78 @@ func (p AccountService) Register() {
 	}
 
 	ws.Route(ws.POST("/confirm").To(p.ConfirmRegister).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Confirm user account"))
+		Doc("Confirm user account").
+		Operation("ConfirmRegister").
+		Reads(account_proto.ConfirmRegisterRequest{}).
+		Writes(account_proto.ConfirmRegisterResponse{}))
 
 	ws.Route(ws.POST("/confirm/resend").To(p.ConfirmResend).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Resend account verification token"))
+		Doc("Resend account verification token").
+		Operation("ConfirmResend").
+		Reads(account_proto.ConfirmResendRequest{}).
+		Writes(account_proto.ConfirmResendResponse{}))
 
 	ws.Route(ws.POST("/login").To(p.Login).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("User login"))
+		Doc("User login").
+		Operation("AccountLogin").
+		Reads(account_proto.LoginRequest{}).
+		Writes(account_proto.LoginResponse{}))
+
+	ws.Route(ws.POST("/refresh").To(p.Refresh).
+		Filter(p.Auth.RateLimit).
+		// Filter(p.Audit.Clone(audit).AuditFilter).
+		Doc("Refresh the tokens of a login").
+		Operation("Refresh").
+		Reads(account_proto.RefreshRequest{}).
+		Writes(account_proto.RefreshResponse{}))
 
 	ws.Route(ws.GET("/logout").To(p.Logout).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Logout the user"))
+		Doc("Logout the user").
+		Operation("AccountLogout").
+		Writes(account_proto.LogoutResponse{}))
 
 	ws.Route(ws.POST("/pass/recover").To(p.RecoverPassword).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Request password recovery"))
+		Doc("Request password recovery").
+		Operation("RecoverPassword").
+		Reads(account_proto.RecoverPasswordRequest{}).
+		Writes(account_proto.RecoverPasswordResponse{}))
 
 	ws.Route(ws.POST("/pass/update").To(p.UpdatePassword).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Update a user password"))
+		Doc("Update a user password").
+		Operation("UpdatePassword").
+		Reads(account_proto.UpdatePasswordRequest{}).
+		Writes(account_proto.UpdatePasswordResponse{}))
 
 	ws.Route(ws.POST("/confirm/verify").To(p.ConfirmVerify).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Confirm verify token"))
+		Doc("Confirm verify token").
+		Operation("ConfirmVerify").
+		Reads(account_proto.ConfirmVerifyRequest{}).
+		Writes(account_proto.ConfirmVerifyResponse{}))
 
 	ws.Route(ws.POST("/pass/verify").To(p.PassVerify).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Pass verify token"))
+		Doc("Pass verify token").
+		Operation("PassVerify").
+		Reads(account_proto.ConfirmVerifyRequest{}).
+		Writes(account_proto.ConfirmVerifyResponse{}))
 
 	restful.Add(ws)
 }
@@ -251,7 +292,13 @@ func (p *AccountService) ConfirmResend(req *restful.Request, rsp *restful.Respon
 *       "id": "f01ckVcMHLjgmsGXyKJbLdlovJyw-71C4HshATxe6tE=",
 *       "expires_at": 153252466
 *     },
-*     "user": { User }
+*     "user": { User },
+*     "tokens": {
+*       "access_token": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+*       "expires_at": 153166966,
+*       "refresh_token": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+*       "refresh_expires_at": 155758066
+*     }
 *   },
 *   "code": 200,
 *   "message": "Login successfully"
@@ -296,6 +343,76 @@ func (p *AccountService) Login(req *restful.Request, rsp *restful.Response) {
 	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
 }
 
+/**
+* @api {post} /server/account/refresh Refresh the tokens of a login
+* @apiVersion 0.1.0
+* @apiName Refresh
+* @apiGroup Account
+*
+* @apiDescription The functionality is to exchange a refresh token for a new access token and refresh token. A refresh token can only be used once, using it again revokes every token of the login. The access token is sent as Authorization: Bearer header.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/account/refresh
+*
+* @apiParamExample {json} Request-Example:
+* {
+*   "refresh_token": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+* }
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "tokens": {
+*       "access_token": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+*       "expires_at": 153166966,
+*       "refresh_token": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...",
+*       "refresh_expires_at": 155758066
+*     }
+*   },
+*   "code": 200,
+*   "message": "Refresh successfully"
+* }
+*
+* @apiError NoAuthorized 	The refresh token is invalid, expired or revoked.
+* @apiError BadRequest   	BindError
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 401 Unauthorized
+*     {
+*       "code": 401,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.account.Refresh",
+*           "reason": "{\"id\":\"go.micro.srv.account\",\"code\":401,\"detail\":\"revoked_token\",\"status\":\"Unauthorized\"}"
+*         }
+*       ]
+*     }
+ */
+
+func (p *AccountService) Refresh(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Account.Refresh API request")
+	req_refresh := new(account_proto.RefreshRequest)
+	err := req.ReadEntity(req_refresh)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.account.Refresh", "BindError")
+		return
+	}
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.AccountClient.Refresh(ctx, req_refresh)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.account.Refresh", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Refresh successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
 /**
 * @api {get} /server/account/logout Logout a user
 * @apiVersion 0.1.0
@@ -306,6 +423,7 @@ func (p *AccountService) Login(req *restful.Request, rsp *restful.Response) {
 *
 * @apiExample Example usage:
 * curl -i http://BASE_SERVER_URL/server/account/logout?session={session_id}
+* curl -i -H "Authorization: Bearer {access_token}" http://BASE_SERVER_URL/server/account/logout
 *
 * @apiSuccessExample Success-Response:
 * HTTP/1.1 200 OK
@@ -337,6 +455,7 @@ func (p *AccountService) Logout(req *restful.Request, rsp *restful.Response) {
 	req_logout.SessionId = req.QueryParameter("session")
 	req_logout.UserId = req.Attribute(UserIdAttrName).(string)
 	req_logout.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_logout.TokenSession, _ = req.Attribute(TokenSessionAttrName).(string)
 	log.WithField("session_id", req_logout.SessionId).Warn("Received session")
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -23,14 +23,20 @@ func (p AuditService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/audits")
+	ws.Doc("Audits")
 
 	ws.Route(ws.POST("/read").To(p.FilterAudits).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
-		Doc("Logout the user"))
+		Doc("Logout the user").
+		Do(Paginated, Sorted).
+		Operation("FilterAudits").
+		Writes(audit_proto.FilterAuditsResponse{}))
 
 	restful.Add(ws)
 }
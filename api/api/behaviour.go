@@ -35,6 +35,7 @@ func (p BehaviourService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/behaviours")
+	ws.Doc("Behaviours")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.BehaviourSrv,
@@ -45,193 +46,401 @@ func (p BehaviourService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all goals"))
+		Doc("List all goals").
+		Do(Paginated, Sorted).
+		Operation("BehaviourAllGoals").
+		Writes(behaviour_proto.AllGoalsResponse{}))
 
 	ws.Route(ws.GET("/challenges/all").To(p.AllChallenges).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all challenges"))
+		Doc("List all challenges").
+		Do(Paginated, Sorted).
+		Operation("BehaviourAllChallenges").
+		Writes(behaviour_proto.AllChallengesResponse{}))
 
 	ws.Route(ws.GET("/habits/all").To(p.AllHabits).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all habits"))
+		Doc("List all habits").
+		Do(Paginated, Sorted).
+		Operation("BehaviourAllHabits").
+		Writes(behaviour_proto.AllHabitsResponse{}))
 
 	ws.Route(ws.POST("/goal").To(p.CreateGoal).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a goal"))
+		Doc("Create or update a goal").
+		Operation("CreateGoal").
+		Reads(behaviour_proto.CreateGoalRequest{}).
+		Writes(behaviour_proto.CreateGoalResponse{}))
 
 	ws.Route(ws.POST("/challenge").To(p.CreateChallenge).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a challenge"))
+		Doc("Create or update a challenge").
+		Operation("CreateChallenge").
+		Reads(behaviour_proto.CreateChallengeRequest{}).
+		Writes(behaviour_proto.CreateChallengeResponse{}))
 
 	ws.Route(ws.POST("/habit").To(p.CreateHabit).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a habit"))
+		Doc("Create or update a habit").
+		Operation("CreateHabit").
+		Reads(behaviour_proto.CreateHabitRequest{}).
+		Writes(behaviour_proto.CreateHabitResponse{}))
 
 	ws.Route(ws.GET("/goal/{goal_id}").To(p.ReadGoal).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a goal"))
+		Doc("Read a goal").
+		Operation("ReadGoal").
+		Writes(behaviour_proto.ReadGoalResponse{}))
+
+	ws.Route(ws.PUT("/goal/{goal_id}").To(p.UpdateGoal).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		// Filter(p.Audit.Clone(audit).AuditFilter).
+		Doc("Update a goal").
+		Operation("UpdateGoal").
+		Reads(behaviour_proto.UpdateGoalRequest{}).
+		Writes(behaviour_proto.UpdateGoalResponse{}))
 
 	ws.Route(ws.GET("/challenge/{challenge_id}").To(p.ReadChallenge).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		// Filter(p.Audit.Clone(audit).AuditFilter).
+		Doc("Read a challenge").
+		Operation("ReadChallenge").
+		Writes(behaviour_proto.ReadChallengeResponse{}))
+
+	ws.Route(ws.PUT("/challenge/{challenge_id}").To(p.UpdateChallenge).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a challenge"))
+		Doc("Update a challenge").
+		Operation("UpdateChallenge").
+		Reads(behaviour_proto.UpdateChallengeRequest{}).
+		Writes(behaviour_proto.UpdateChallengeResponse{}))
 
 	ws.Route(ws.GET("/habit/{habit_id}").To(p.ReadHabit).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a habit"))
+		Doc("Read a habit").
+		Operation("ReadHabit").
+		Writes(behaviour_proto.ReadHabitResponse{}))
+
+	ws.Route(ws.PUT("/habit/{habit_id}").To(p.UpdateHabit).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		// Filter(p.Audit.Clone(audit).AuditFilter).
+		Doc("Update a habit").
+		Operation("UpdateHabit").
+		Reads(behaviour_proto.UpdateHabitRequest{}).
+		Writes(behaviour_proto.UpdateHabitResponse{}))
 
 	ws.Route(ws.DELETE("/goal/{goal_id}").To(p.DeleteGoal).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a goal"))
+		Doc("Delete a goal").
+		Operation("DeleteGoal").
+		Writes(behaviour_proto.DeleteGoalResponse{}))
 
 	ws.Route(ws.DELETE("/challenge/{challenge_id}").To(p.DeleteChallenge).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
-		Doc("Delete a challenge"))
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Delete a challenge").
+		Operation("DeleteChallenge").
+		Writes(behaviour_proto.DeleteChallengeResponse{}))
 
 	ws.Route(ws.DELETE("/habit/{habit_id}").To(p.DeleteHabit).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a habit"))
+		Doc("Delete a habit").
+		Operation("DeleteHabit").
+		Writes(behaviour_proto.DeleteHabitResponse{}))
 
 	ws.Route(ws.POST("/filter").To(p.Filter).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter behaviours"))
+		Doc("Filter behaviours").
+		Do(Paginated, Sorted).
+		Operation("BehaviourFilter").
+		Reads(behaviour_proto.FilterRequest{}).
+		Writes(behaviour_proto.FilterResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.Search).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search behaviours"))
-
-	ws.Route(ws.POST("/search").To(p.Search).
-		Filter(p.Auth.BasicAuthenticate).
-		// Filter(p.Auth.EmployeeAuthenticate).
-		Filter(p.Auth.OrganisationAuthenticate).
-		Filter(p.Auth.Paginate).
-		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search behaviours"))
+		Doc("Search behaviours").
+		Do(Paginated, Sorted).
+		Operation("BehaviourSearch").
+		Reads(behaviour_proto.SearchRequest{}).
+		Writes(behaviour_proto.SearchResponse{}))
 
 	ws.Route(ws.POST("/goal/search/autocomplete").To(p.AutocompleteGoalSearch).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete goal text"))
+		Doc("Search autocomplete goal text").
+		Operation("AutocompleteGoalSearch").
+		Reads(behaviour_proto.AutocompleteSearchRequest{}).
+		Writes(behaviour_proto.AutocompleteSearchResponse{}))
 
 	ws.Route(ws.POST("/challenge/search/autocomplete").To(p.AutocompleteChallengeSearch).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete challenge text"))
+		Doc("Search autocomplete challenge text").
+		Operation("AutocompleteChallengeSearch").
+		Reads(behaviour_proto.AutocompleteSearchRequest{}).
+		Writes(behaviour_proto.AutocompleteSearchResponse{}))
 
 	ws.Route(ws.POST("/habit/search/autocomplete").To(p.AutocompleteHabitSearch).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete habit text"))
+		Doc("Search autocomplete habit text").
+		Operation("AutocompleteHabitSearch").
+		Reads(behaviour_proto.AutocompleteSearchRequest{}).
+		Writes(behaviour_proto.AutocompleteSearchResponse{}))
 
 	ws.Route(ws.GET("/goals/tags/top/{n}").To(p.GetTopGoalTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return top N tags for Goal"))
+		Doc("Return top N tags for Goal").
+		Operation("GetTopGoalTags").
+		Writes(behaviour_proto.GetTopTagsResponse{}))
 
 	ws.Route(ws.GET("/challenges/tags/top/{n}").To(p.GetTopChallengeTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return top N tags for Challenge"))
+		Doc("Return top N tags for Challenge").
+		Operation("GetTopChallengeTags").
+		Writes(behaviour_proto.GetTopTagsResponse{}))
 
 	ws.Route(ws.GET("/habits/tags/top/{n}").To(p.GetTopHabitTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return top N tags for Habit"))
+		Doc("Return top N tags for Habit").
+		Operation("GetTopHabitTags").
+		Writes(behaviour_proto.GetTopTagsResponse{}))
 
 	ws.Route(ws.POST("/goals/tags/autocomplete").To(p.AutocompleteGoalTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete for tags for Goal"))
+		Doc("Autocomplete for tags for Goal").
+		Operation("AutocompleteGoalTags").
+		Reads(behaviour_proto.AutocompleteTagsRequest{}).
+		Writes(behaviour_proto.AutocompleteTagsResponse{}))
 
 	ws.Route(ws.POST("/challenges/tags/autocomplete").To(p.AutocompleteChallengeTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
-		Doc("Autocomplete for tags for Challenge"))
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Autocomplete for tags for Challenge").
+		Operation("AutocompleteChallengeTags").
+		Reads(behaviour_proto.AutocompleteTagsRequest{}).
+		Writes(behaviour_proto.AutocompleteTagsResponse{}))
 
 	ws.Route(ws.POST("/habits/tags/autocomplete").To(p.AutocompleteHabitTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete for tags for Habit"))
+		Doc("Autocomplete for tags for Habit").
+		Operation("AutocompleteHabitTags").
+		Reads(behaviour_proto.AutocompleteTagsRequest{}).
+		Writes(behaviour_proto.AutocompleteTagsResponse{}))
 
 	ws.Route(ws.POST("/goals/upload").To(p.UploadGoals).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Upload csv for Goals"))
+		Doc("Upload csv for Goals").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadGoals").
+		Writes(behaviour_proto.UploadGoalsResponse{}))
 
 	ws.Route(ws.POST("/challenges/upload").To(p.UploadChallenges).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Upload csv for Challenges"))
+		Doc("Upload csv for Challenges").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadChallenges").
+		Writes(behaviour_proto.UploadGoalsResponse{}))
 
 	ws.Route(ws.POST("/habits/upload").To(p.UploadHabits).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Upload csv for Habits"))
+		Doc("Upload csv for Habits").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadHabits").
+		Writes(behaviour_proto.UploadGoalsResponse{}))
+
+	ws.Route(ws.GET("/trash").To(p.Trash).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("List deleted goals, challenges and habits").
+		Do(Paginated).
+		Operation("BehaviourTrash").
+		Writes(behaviour_proto.TrashResponse{}))
+
+	ws.Route(ws.POST("/trash/{collection}/{id}/restore").To(p.Restore).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Restore a deleted record").
+		Operation("BehaviourRestore").
+		Writes(behaviour_proto.RestoreResponse{}))
+
+	ws.Route(ws.GET("/versions/{collection}/{id}/versions").To(p.ListVersions).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("List the versions of a goal, challenge or habit").
+		Do(Paginated).
+		Operation("BehaviourListVersions").
+		Writes(behaviour_proto.ListVersionsResponse{}))
+
+	ws.Route(ws.GET("/versions/{collection}/{id}/versions/{version}").To(p.ReadVersion).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Read a goal, challenge or habit as it was at a version").
+		Operation("BehaviourReadVersion").
+		Writes(behaviour_proto.ReadVersionResponse{}))
+
+	ws.Route(ws.POST("/versions/{collection}/{id}/versions/{version}/revert").To(p.RevertToVersion).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Revert a goal, challenge or habit to a version").
+		Operation("BehaviourRevertToVersion").
+		Writes(behaviour_proto.RevertToVersionResponse{}))
 
 	restful.Add(ws)
 }
@@ -854,6 +1063,93 @@ func (p *BehaviourService) ReadGoal(req *restful.Request, rsp *restful.Response)
 	resp.Message = "Read goal successfully"
 	data := utils.MarshalAny(rsp, resp)
 
+	utils.SetETag(rsp, resp.Data.Goal.Revision)
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, data)
+}
+
+/**
+* @api {put} /server/behaviours/goal/{goal_id}?session={session_id} Update a goal
+* @apiVersion 0.1.0
+* @apiName UpdateGoal
+* @apiGroup Behaviour
+*
+* @apiDescription Update a goal, the goal is replaced by the one of the request. If the If-Match header is set to
+* the ETag of a read, the update fails with 412 if the goal was updated since.
+*
+* @apiExample Example usage:
+* curl -i -X PUT -H 'If-Match: "_Wq3k1-2---"' http://BASE_SERVER_URL/server/behaviours/goal/g111?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+* {
+*   "goal": {
+*     "title": "g_title",
+*     "summary": "summary",
+*     "description": "description",
+*     ... ...
+*   }
+* }
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* ETag: "_Wq3k1-6---"
+* {
+*   "data": {
+*     "goal": {
+*       "id": "g111",
+*       "title": "g_title",
+*       "revision": "_Wq3k1-6---",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Updated goal successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The goal was not found.
+* @apiError PreconditionFailed   	The goal was updated since the revision of If-Match.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 412 Precondition Failed
+*     {
+*       "code": 412,
+*       "message": "UpdateError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.UpdateGoal",
+*           "reason": "{\"id\":\"go.micro.srv.behaviour\",\"code\":409,\"detail\":\"goal was updated since its revision\",\"status\":\"Conflict\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) UpdateGoal(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.UpdateGoal API request")
+	req_goal := new(behaviour_proto.UpdateGoalRequest)
+	if err := utils.UnmarshalAny(req, rsp, req_goal); err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.UpdateGoal", "BindError")
+		return
+	}
+	if req_goal.Goal == nil {
+		req_goal.Goal = &behaviour_proto.Goal{}
+	}
+	req_goal.Goal.Id = req.PathParameter("goal_id")
+	if revision := utils.IfMatch(req); len(revision) > 0 {
+		req_goal.Goal.Revision = revision
+	}
+	req_goal.UserId = req.Attribute(UserIdAttrName).(string)
+	req_goal.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_goal.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.UpdateGoal(ctx, req_goal)
+	if err != nil {
+		utils.WriteUpdateErrorResponse(rsp, err, "go.micro.srv.behaviour.UpdateGoal", "UpdateError")
+		return
+	}
+	resp.Code = http.StatusOK
+	resp.Message = "Updated goal successfully"
+	data := utils.MarshalAny(rsp, resp)
+
+	utils.SetETag(rsp, resp.Data.Goal.Revision)
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, data)
 }
@@ -936,6 +1232,93 @@ func (p *BehaviourService) ReadChallenge(req *restful.Request, rsp *restful.Resp
 	resp.Message = "Read challenge successfully"
 	data := utils.MarshalAny(rsp, resp)
 
+	utils.SetETag(rsp, resp.Data.Challenge.Revision)
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, data)
+}
+
+/**
+* @api {put} /server/behaviours/challenge/{challenge_id}?session={session_id} Update a challenge
+* @apiVersion 0.1.0
+* @apiName UpdateChallenge
+* @apiGroup Behaviour
+*
+* @apiDescription Update a challenge, the challenge is replaced by the one of the request. If the If-Match header is set to
+* the ETag of a read, the update fails with 412 if the challenge was updated since.
+*
+* @apiExample Example usage:
+* curl -i -X PUT -H 'If-Match: "_Wq3k1-2---"' http://BASE_SERVER_URL/server/behaviours/challenge/c111?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+* {
+*   "challenge": {
+*     "title": "c_title",
+*     "summary": "summary",
+*     "description": "description",
+*     ... ...
+*   }
+* }
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* ETag: "_Wq3k1-6---"
+* {
+*   "data": {
+*     "challenge": {
+*       "id": "c111",
+*       "title": "c_title",
+*       "revision": "_Wq3k1-6---",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Updated challenge successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The challenge was not found.
+* @apiError PreconditionFailed   	The challenge was updated since the revision of If-Match.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 412 Precondition Failed
+*     {
+*       "code": 412,
+*       "message": "UpdateError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.UpdateChallenge",
+*           "reason": "{\"id\":\"go.micro.srv.behaviour\",\"code\":409,\"detail\":\"challenge was updated since its revision\",\"status\":\"Conflict\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) UpdateChallenge(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.UpdateChallenge API request")
+	req_challenge := new(behaviour_proto.UpdateChallengeRequest)
+	if err := utils.UnmarshalAny(req, rsp, req_challenge); err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.UpdateChallenge", "BindError")
+		return
+	}
+	if req_challenge.Challenge == nil {
+		req_challenge.Challenge = &behaviour_proto.Challenge{}
+	}
+	req_challenge.Challenge.Id = req.PathParameter("challenge_id")
+	if revision := utils.IfMatch(req); len(revision) > 0 {
+		req_challenge.Challenge.Revision = revision
+	}
+	req_challenge.UserId = req.Attribute(UserIdAttrName).(string)
+	req_challenge.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_challenge.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.UpdateChallenge(ctx, req_challenge)
+	if err != nil {
+		utils.WriteUpdateErrorResponse(rsp, err, "go.micro.srv.behaviour.UpdateChallenge", "UpdateError")
+		return
+	}
+	resp.Code = http.StatusOK
+	resp.Message = "Updated challenge successfully"
+	data := utils.MarshalAny(rsp, resp)
+
+	utils.SetETag(rsp, resp.Data.Challenge.Revision)
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, data)
 }
@@ -1018,6 +1401,93 @@ func (p *BehaviourService) ReadHabit(req *restful.Request, rsp *restful.Response
 	resp.Message = "Read habit successfully"
 	data := utils.MarshalAny(rsp, resp)
 
+	utils.SetETag(rsp, resp.Data.Habit.Revision)
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, data)
+}
+
+/**
+* @api {put} /server/behaviours/habit/{habit_id}?session={session_id} Update a habit
+* @apiVersion 0.1.0
+* @apiName UpdateHabit
+* @apiGroup Behaviour
+*
+* @apiDescription Update a habit, the habit is replaced by the one of the request. If the If-Match header is set to
+* the ETag of a read, the update fails with 412 if the habit was updated since.
+*
+* @apiExample Example usage:
+* curl -i -X PUT -H 'If-Match: "_Wq3k1-2---"' http://BASE_SERVER_URL/server/behaviours/habit/h111?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+* {
+*   "habit": {
+*     "title": "h_title",
+*     "summary": "summary",
+*     "description": "description",
+*     ... ...
+*   }
+* }
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* ETag: "_Wq3k1-6---"
+* {
+*   "data": {
+*     "habit": {
+*       "id": "h111",
+*       "title": "h_title",
+*       "revision": "_Wq3k1-6---",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Updated habit successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The habit was not found.
+* @apiError PreconditionFailed   	The habit was updated since the revision of If-Match.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 412 Precondition Failed
+*     {
+*       "code": 412,
+*       "message": "UpdateError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.UpdateHabit",
+*           "reason": "{\"id\":\"go.micro.srv.behaviour\",\"code\":409,\"detail\":\"habit was updated since its revision\",\"status\":\"Conflict\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) UpdateHabit(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.UpdateHabit API request")
+	req_habit := new(behaviour_proto.UpdateHabitRequest)
+	if err := utils.UnmarshalAny(req, rsp, req_habit); err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.UpdateHabit", "BindError")
+		return
+	}
+	if req_habit.Habit == nil {
+		req_habit.Habit = &behaviour_proto.Habit{}
+	}
+	req_habit.Habit.Id = req.PathParameter("habit_id")
+	if revision := utils.IfMatch(req); len(revision) > 0 {
+		req_habit.Habit.Revision = revision
+	}
+	req_habit.UserId = req.Attribute(UserIdAttrName).(string)
+	req_habit.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_habit.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.UpdateHabit(ctx, req_habit)
+	if err != nil {
+		utils.WriteUpdateErrorResponse(rsp, err, "go.micro.srv.behaviour.UpdateHabit", "UpdateError")
+		return
+	}
+	resp.Code = http.StatusOK
+	resp.Message = "Updated habit successfully"
+	data := utils.MarshalAny(rsp, resp)
+
+	utils.SetETag(rsp, resp.Data.Habit.Revision)
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, data)
 }
@@ -1061,6 +1531,7 @@ func (p *BehaviourService) DeleteGoal(req *restful.Request, rsp *restful.Respons
 	req_goal := new(behaviour_proto.DeleteGoalRequest)
 	req_goal.GoalId = req.PathParameter("goal_id")
 	req_goal.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_goal.UserId = req.Attribute(UserIdAttrName).(string)
 	req_goal.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1115,6 +1586,7 @@ func (p *BehaviourService) DeleteChallenge(req *restful.Request, rsp *restful.Re
 	req_challenge := new(behaviour_proto.DeleteChallengeRequest)
 	req_challenge.ChallengeId = req.PathParameter("challenge_id")
 	req_challenge.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_challenge.UserId = req.Attribute(UserIdAttrName).(string)
 	req_challenge.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1169,6 +1641,7 @@ func (p *BehaviourService) DeleteHabit(req *restful.Request, rsp *restful.Respon
 	req_habit := new(behaviour_proto.DeleteHabitRequest)
 	req_habit.HabitId = req.PathParameter("habit_id")
 	req_habit.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_habit.UserId = req.Attribute(UserIdAttrName).(string)
 	req_habit.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -2433,3 +2906,370 @@ func (p *BehaviourService) UploadHabits(req *restful.Request, rsp *restful.Respo
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
 }
+
+/**
+* @api {get} /server/behaviours/trash?session={session_id}&collection={collection}&offset={offset}&limit={limit} List deleted records
+* @apiVersion 0.1.0
+* @apiName Trash
+* @apiGroup Behaviour
+*
+* @apiDescription List the deleted goals, challenges and habits of the organisation, the latest deleted first. They are restored until
+* they are purged after the trash retention of the organisation setting. The collection parameter can be repeated,
+* the records of every collection are listed if it's not set.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/behaviours/trash?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="&collection=goal
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "items": [
+*       {
+*         "id": "111",
+*         "collection": "goal",
+*         "name": "name",
+*         "org_id": "orgid",
+*         "created": 1517891917,
+*         "deleted_at": 1517991917,
+*         "deleted_by": "userid"
+*       },
+*       ... ...
+*     ]
+*   },
+*   "code": 200,
+*   "message": "Read trash successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError BadRequest   	The collection is invalid.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 400 Bad Request
+*     {
+*       "code": 400,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.Trash",
+*           "reason": "{\"id\":\"go.micro.srv.behaviour\",\"code\":400,\"detail\":\"collection is invalid\",\"status\":\"Bad Request\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) Trash(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.Trash API request")
+	req_trash := new(behaviour_proto.TrashRequest)
+	req_trash.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_trash.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_trash.Collections = req.Request.URL.Query()["collection"]
+	req_trash.Limit = req.Attribute(PaginateLimitParameter).(int64)
+	req_trash.Offset = req.Attribute(PaginateOffsetParameter).(int64)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.Trash(ctx, req_trash)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.Trash", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read trash successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {post} /server/behaviours/trash/{collection}/{id}/restore?session={session_id} Restore a deleted record
+* @apiVersion 0.1.0
+* @apiName Restore
+* @apiGroup Behaviour
+*
+* @apiDescription Restore a deleted record with the links it had when it was deleted
+*
+* @apiExample Example usage:
+* curl -i -X POST http://BASE_SERVER_URL/server/behaviours/trash/goal/111/restore?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "item": {
+*       "id": "111",
+*       "collection": "goal",
+*       "name": "name",
+*       "org_id": "orgid",
+*       "created": 1517891917
+*     }
+*   },
+*   "code": 200,
+*   "message": "Restored successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The record isn't in the trash.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "RestoreError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.Restore",
+*           "reason": "{\"id\":\"go.micro.srv.db.DB.Restore\",\"code\":404,\"detail\":\"not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) Restore(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.Restore API request")
+	req_restore := new(behaviour_proto.RestoreRequest)
+	req_restore.Id = req.PathParameter("id")
+	req_restore.Collection = req.PathParameter("collection")
+	req_restore.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_restore.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.Restore(ctx, req_restore)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.Restore", "RestoreError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Restored successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {get} /server/behaviours/versions/{collection}/{id}/versions?session={session_id}&offset={offset}&limit={limit} List the versions of a goal, challenge or habit
+* @apiVersion 0.1.0
+* @apiName ListVersions
+* @apiGroup Behaviour
+*
+* @apiDescription List the versions of a goal, challenge or habit, the latest first. A version is saved by every write changing the goal, challenge or habit
+* with the changes since the previous version, the value of a change is json and empty if the attribute didn't exist. The collection is goal, challenge
+* or habit.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/behaviours/versions/goal/111/versions?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "versions": [
+*       {
+*         "id": "goal:111:2",
+*         "collection": "goal",
+*         "document_id": "111",
+*         "version": 2,
+*         "org_id": "orgid",
+*         "author_id": "userid",
+*         "created": 1517991917,
+*         "changes": [
+*           {
+*             "path": "data.title",
+*             "old_value": "\"title\"",
+*             "new_value": "\"new title\""
+*           }
+*         ]
+*       },
+*       ... ...
+*     ]
+*   },
+*   "code": 200,
+*   "message": "Read versions successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 500 Internal Server Error
+*     {
+*       "code": 500,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.ListVersions",
+*           "reason": "{\"id\":\"go.micro.srv.behaviour\",\"code\":500,\"detail\":\"versions error\",\"status\":\"Internal Server Error\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) ListVersions(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.ListVersions API request")
+	req_version := new(behaviour_proto.ListVersionsRequest)
+	req_version.Id = req.PathParameter("id")
+	req_version.Collection = req.PathParameter("collection")
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_version.Limit = req.Attribute(PaginateLimitParameter).(int64)
+	req_version.Offset = req.Attribute(PaginateOffsetParameter).(int64)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.ListVersions(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.ListVersions", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read versions successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {get} /server/behaviours/versions/{collection}/{id}/versions/{version}?session={session_id} Read a goal, challenge or habit at a version
+* @apiVersion 0.1.0
+* @apiName ReadVersion
+* @apiGroup Behaviour
+*
+* @apiDescription Read a goal, challenge or habit as it was at a version with the changes of the version
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/behaviours/versions/goal/111/versions/1?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "version": {
+*       "id": "goal:111:1",
+*       "collection": "goal",
+*       "document_id": "111",
+*       "version": 1,
+*       "org_id": "orgid",
+*       "author_id": "userid",
+*       "created": 1517891917
+*     },
+*     "goal": {
+*       "id": "111",
+*       "title": "title",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Read version successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The version doesn't exist.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "ReadError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.ReadVersion",
+*           "reason": "{\"id\":\"go.micro.srv.behaviour\",\"code\":404,\"detail\":\"version not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) ReadVersion(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.ReadVersion API request")
+	req_version := new(behaviour_proto.ReadVersionRequest)
+	req_version.Id = req.PathParameter("id")
+	req_version.Collection = req.PathParameter("collection")
+	req_version.Version, _ = strconv.ParseInt(req.PathParameter("version"), 10, 64)
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.ReadVersion(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.ReadVersion", "ReadError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read version successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {post} /server/behaviours/versions/{collection}/{id}/versions/{version}/revert?session={session_id} Revert a goal, challenge or habit to a version
+* @apiVersion 0.1.0
+* @apiName RevertToVersion
+* @apiGroup Behaviour
+*
+* @apiDescription Revert a goal, challenge or habit to a version, the reverted goal, challenge or habit is saved as a new version which is returned
+*
+* @apiExample Example usage:
+* curl -i -X POST http://BASE_SERVER_URL/server/behaviours/versions/goal/111/versions/1/revert?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "version": {
+*       "id": "goal:111:3",
+*       "collection": "goal",
+*       "document_id": "111",
+*       "version": 3,
+*       "org_id": "orgid",
+*       "author_id": "userid",
+*       "created": 1518091917,
+*       "changes": [
+*         {
+*           "path": "data.title",
+*           "old_value": "\"new title\"",
+*           "new_value": "\"title\""
+*         }
+*       ]
+*     },
+*     "goal": {
+*       "id": "111",
+*       "title": "title",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Reverted successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The version doesn't exist.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "RevertError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.behaviour.RevertToVersion",
+*           "reason": "{\"id\":\"go.micro.srv.behaviour\",\"code\":404,\"detail\":\"version not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *BehaviourService) RevertToVersion(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Behaviour.RevertToVersion API request")
+	req_version := new(behaviour_proto.RevertToVersionRequest)
+	req_version.Id = req.PathParameter("id")
+	req_version.Collection = req.PathParameter("collection")
+	req_version.Version, _ = strconv.ParseInt(req.PathParameter("version"), 10, 64)
+	req_version.UserId = req.Attribute(UserIdAttrName).(string)
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.BehaviourClient.RevertToVersion(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.behaviour.RevertToVersion", "RevertError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Reverted successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
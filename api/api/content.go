@@ -28,6 +28,7 @@ func (p ContentService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/content")
+	ws.Doc("Content")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.ContentSrv,
@@ -38,220 +39,383 @@ func (p ContentService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all sources"))
+		Doc("List all sources").
+		Do(Paginated, Sorted).
+		Operation("AllSources").
+		Writes(content_proto.AllSourcesResponse{}))
 
 	ws.Route(ws.POST("/source").To(p.CreateSource).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a source"))
+		Doc("Create or update a source").
+		Operation("CreateSource").
+		Reads(content_proto.CreateSourceRequest{}).
+		Writes(content_proto.CreateSourceResponse{}))
 
 	ws.Route(ws.GET("/source/{source_id}").To(p.ReadSource).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a source"))
+		Doc("Read a source").
+		Operation("ReadSource").
+		Writes(content_proto.ReadSourceResponse{}))
 
 	ws.Route(ws.DELETE("/source/{source_id}").To(p.DeleteSource).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a source"))
+		Doc("Delete a source").
+		Operation("DeleteSource").
+		Writes(content_proto.DeleteSourceResponse{}))
 
 	ws.Route(ws.GET("/taxonomys/all").To(p.AllTaxonomys).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all taxonomys"))
+		Doc("List all taxonomys").
+		Do(Paginated, Sorted).
+		Operation("AllTaxonomys").
+		Writes(content_proto.AllTaxonomysResponse{}))
 
 	ws.Route(ws.POST("/taxonomy").To(p.CreateTaxonomy).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a taxonomy"))
+		Doc("Create or update a taxonomy").
+		Operation("CreateTaxonomy").
+		Reads(content_proto.CreateTaxonomyRequest{}).
+		Writes(content_proto.CreateTaxonomyResponse{}))
 
 	ws.Route(ws.GET("/taxonomy/{taxonomy_id}").To(p.ReadTaxonomy).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a taxonomy"))
+		Doc("Read a taxonomy").
+		Operation("ReadTaxonomy").
+		Writes(content_proto.ReadTaxonomyResponse{}))
 
 	ws.Route(ws.DELETE("/taxonomy/{taxonomy_id}").To(p.DeleteTaxonomy).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a taxonomy"))
+		Doc("Delete a taxonomy").
+		Operation("DeleteTaxonomy").
+		Writes(content_proto.DeleteTaxonomyResponse{}))
 
 	ws.Route(ws.GET("/category/items/all").To(p.AllContentCategoryItems).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all contentCategoryItems"))
+		Doc("List all contentCategoryItems").
+		Do(Paginated, Sorted).
+		Operation("AllContentCategoryItems").
+		Writes(content_proto.AllContentCategoryItemsResponse{}))
 
 	ws.Route(ws.POST("/category/item").To(p.CreateContentCategoryItem).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a contentCategoryItem"))
+		Doc("Create or update a contentCategoryItem").
+		Operation("CreateContentCategoryItem").
+		Reads(content_proto.CreateContentCategoryItemRequest{}).
+		Writes(content_proto.CreateContentCategoryItemResponse{}))
 
 	ws.Route(ws.GET("/category/item/{contentCategoryItem_id}").To(p.ReadContentCategoryItem).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a contentCategoryItem"))
+		Doc("Read a contentCategoryItem").
+		Do(Paginated).
+		Operation("ReadContentCategoryItem").
+		Writes(content_proto.ReadContentCategoryItemResponse{}))
 
 	ws.Route(ws.DELETE("/category/item/{contentCategoryItem_id}").To(p.DeleteContentCategoryItem).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a contentCategoryItem"))
+		Doc("Delete a contentCategoryItem").
+		Operation("DeleteContentCategoryItem").
+		Writes(content_proto.DeleteContentCategoryItemResponse{}))
 
 	ws.Route(ws.GET("/contents/all").To(p.AllContents).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all contents"))
+		Doc("List all contents").
+		Do(Paginated, Sorted).
+		Operation("AllContents").
+		Writes(content_proto.AllContentsResponse{}))
 
 	ws.Route(ws.POST("/content").To(p.CreateContent).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
-		Doc("Create or update a content"))
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Create or update a content").
+		Operation("CreateContent").
+		Reads(content_proto.CreateContentRequest{}).
+		Writes(content_proto.CreateContentResponse{}))
 
 	ws.Route(ws.GET("/content/{content_id}").To(p.ReadContent).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a content"))
+		Doc("Read a content").
+		Do(Paginated).
+		Operation("ReadContent").
+		Writes(content_proto.ReadContentResponse{}))
 
 	ws.Route(ws.DELETE("/content/{content_id}").To(p.DeleteContent).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a content"))
+		Doc("Delete a content").
+		Operation("DeleteContent").
+		Writes(content_proto.DeleteContentResponse{}))
 
 	ws.Route(ws.GET("/rules/all").To(p.AllContentRules).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all contentRules"))
+		Doc("List all contentRules").
+		Do(Paginated, Sorted).
+		Operation("AllContentRules").
+		Writes(content_proto.AllContentRulesResponse{}))
 
 	ws.Route(ws.POST("/rule").To(p.CreateContentRule).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a contentRule"))
+		Doc("Create or update a contentRule").
+		Operation("CreateContentRule").
+		Reads(content_proto.CreateContentRuleRequest{}).
+		Writes(content_proto.CreateContentRuleResponse{}))
 
 	ws.Route(ws.GET("/rule/{contentRule_id}").To(p.ReadContentRule).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a contentRule"))
+		Doc("Read a contentRule").
+		Operation("ReadContentRule").
+		Writes(content_proto.ReadContentRuleResponse{}))
 
 	ws.Route(ws.DELETE("/rule/{contentRule_id}").To(p.DeleteContentRule).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a contentRule"))
+		Doc("Delete a contentRule").
+		Operation("DeleteContentRule").
+		Writes(content_proto.DeleteContentRuleResponse{}))
 
 	ws.Route(ws.POST("/filter").To(p.FilterContent).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter contents"))
+		Doc("Filter contents").
+		Do(Paginated, Sorted).
+		Operation("FilterContent").
+		Reads(content_proto.FilterContentRequest{}).
+		Writes(content_proto.FilterContentResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.SearchContent).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search contents"))
+		Doc("Search contents").
+		Do(Paginated).
+		Operation("SearchContent").
+		Reads(content_proto.SearchContentRequest{}).
+		Writes(content_proto.SearchContentResponse{}))
 
 	ws.Route(ws.POST("/share").To(p.ShareContent).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Share contents"))
+		Doc("Share contents").
+		Operation("ContentShareContent").
+		Reads(content_proto.ShareContentRequest{}).
+		Writes(content_proto.ShareContentResponse{}))
 
 	ws.Route(ws.GET("/user/shared/{user_id}").To(p.GetAllSharedContents).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all shared content with a particular user so far"))
+		Doc("Get all shared content with a particular user so far").
+		Do(Paginated, Sorted).
+		Operation("GetAllSharedContents").
+		Writes(content_proto.GetAllSharedContentsResponse{}))
 
 	ws.Route(ws.GET("/recommendations/{user_id}").To(p.GetContentRecommendations).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get content recommendations"))
+		Doc("Get content recommendations").
+		Do(Sorted).
+		Operation("GetContentRecommendations").
+		Writes(content_proto.GetContentRecommendationsResponse{}))
 
 	ws.Route(ws.GET("/recommendations/{user_id}/filters").To(p.GetContentFiltersByPreference).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get content filters based on user preferences"))
+		Doc("Get content filters based on user preferences").
+		Do(Paginated).
+		Operation("GetContentFiltersByPreference").
+		Writes(content_proto.GetContentFiltersByPreferenceResponse{}))
 
 	ws.Route(ws.POST("/recommendations/{user_id}/filter").To(p.FilterContentRecommendations).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter content recommendations"))
+		Doc("Filter content recommendations").
+		Do(Paginated, Sorted).
+		Operation("FilterContentRecommendations").
+		Reads(content_proto.FilterContentRecommendationsRequest{}).
+		Writes(content_proto.FilterContentRecommendationsResponse{}))
 
 	ws.Route(ws.GET("/tags/top/{n}").To(p.GetTopContentTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return top N tags for Content"))
+		Doc("Return top N tags for Content").
+		Do(Paginated, Sorted).
+		Operation("GetTopContentTags").
+		Writes(content_proto.GetTopTagsResponse{}))
 
 	ws.Route(ws.POST("/tags/autocomplete").To(p.AutocompleteContentTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete for tags for Content"))
+		Doc("Autocomplete for tags for Content").
+		Do(Sorted).
+		Operation("AutocompleteContentTags").
+		Reads(content_proto.AutocompleteTagsRequest{}).
+		Writes(content_proto.AutocompleteTagsResponse{}))
+	ws.Route(ws.GET("/trash").To(p.Trash).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("List deleted sources, taxonomies, content category items, contents and content rules").
+		Do(Paginated).
+		Operation("ContentTrash").
+		Writes(content_proto.TrashResponse{}))
+
+	ws.Route(ws.POST("/trash/{collection}/{id}/restore").To(p.Restore).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Restore a deleted record").
+		Operation("ContentRestore").
+		Writes(content_proto.RestoreResponse{}))
+
 	restful.Add(ws)
 }
 
@@ -554,6 +718,7 @@ func (p *ContentService) DeleteSource(req *restful.Request, rsp *restful.Respons
 	req_source := new(content_proto.DeleteSourceRequest)
 	req_source.Id = req.PathParameter("source_id")
 	req_source.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_source.UserId = req.Attribute(UserIdAttrName).(string)
 	req_source.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -860,6 +1025,7 @@ func (p *ContentService) DeleteTaxonomy(req *restful.Request, rsp *restful.Respo
 	req_taxonomy := new(content_proto.DeleteTaxonomyRequest)
 	req_taxonomy.Id = req.PathParameter("taxonomy_id")
 	req_taxonomy.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_taxonomy.UserId = req.Attribute(UserIdAttrName).(string)
 	req_taxonomy.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1188,6 +1354,7 @@ func (p *ContentService) DeleteContentCategoryItem(req *restful.Request, rsp *re
 	req_contentCategoryItem := new(content_proto.DeleteContentCategoryItemRequest)
 	req_contentCategoryItem.Id = req.PathParameter("contentCategoryItem_id")
 	req_contentCategoryItem.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_contentCategoryItem.UserId = req.Attribute(UserIdAttrName).(string)
 	req_contentCategoryItem.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1526,6 +1693,7 @@ func (p *ContentService) DeleteContent(req *restful.Request, rsp *restful.Respon
 
 	req_content.Id = req.PathParameter("content_id")
 	req_content.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_content.UserId = req.Attribute(UserIdAttrName).(string)
 	req_content.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1848,6 +2016,7 @@ func (p *ContentService) DeleteContentRule(req *restful.Request, rsp *restful.Re
 	req_contentRule := new(content_proto.DeleteContentRuleRequest)
 	req_contentRule.Id = req.PathParameter("contentRule_id")
 	req_contentRule.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_contentRule.UserId = req.Attribute(UserIdAttrName).(string)
 	req_contentRule.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -2620,3 +2789,139 @@ func (p *ContentService) AutocompleteContentTags(req *restful.Request, rsp *rest
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
 }
+
+/**
+* @api {get} /server/content/trash?session={session_id}&collection={collection}&offset={offset}&limit={limit} List deleted records
+* @apiVersion 0.1.0
+* @apiName Trash
+* @apiGroup Content
+*
+* @apiDescription List the deleted sources, taxonomies, content category items, contents and content rules of the organisation, the latest deleted first. They are restored until
+* they are purged after the trash retention of the organisation setting. The collection parameter can be repeated,
+* the records of every collection are listed if it's not set.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/content/trash?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="&collection=content
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "items": [
+*       {
+*         "id": "111",
+*         "collection": "content",
+*         "name": "name",
+*         "org_id": "orgid",
+*         "created": 1517891917,
+*         "deleted_at": 1517991917,
+*         "deleted_by": "userid"
+*       },
+*       ... ...
+*     ]
+*   },
+*   "code": 200,
+*   "message": "Read trash successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError BadRequest   	The collection is invalid.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 400 Bad Request
+*     {
+*       "code": 400,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.content.Trash",
+*           "reason": "{\"id\":\"go.micro.srv.content\",\"code\":400,\"detail\":\"collection is invalid\",\"status\":\"Bad Request\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *ContentService) Trash(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Content.Trash API request")
+	req_trash := new(content_proto.TrashRequest)
+	req_trash.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_trash.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_trash.Collections = req.Request.URL.Query()["collection"]
+	req_trash.Limit = req.Attribute(PaginateLimitParameter).(int64)
+	req_trash.Offset = req.Attribute(PaginateOffsetParameter).(int64)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.ContentClient.Trash(ctx, req_trash)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.content.Trash", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read trash successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {post} /server/content/trash/{collection}/{id}/restore?session={session_id} Restore a deleted record
+* @apiVersion 0.1.0
+* @apiName Restore
+* @apiGroup Content
+*
+* @apiDescription Restore a deleted record with the links it had when it was deleted
+*
+* @apiExample Example usage:
+* curl -i -X POST http://BASE_SERVER_URL/server/content/trash/content/111/restore?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "item": {
+*       "id": "111",
+*       "collection": "content",
+*       "name": "name",
+*       "org_id": "orgid",
+*       "created": 1517891917
+*     }
+*   },
+*   "code": 200,
+*   "message": "Restored successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The record isn't in the trash.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "RestoreError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.content.Restore",
+*           "reason": "{\"id\":\"go.micro.srv.db.DB.Restore\",\"code\":404,\"detail\":\"not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *ContentService) Restore(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Content.Restore API request")
+	req_restore := new(content_proto.RestoreRequest)
+	req_restore.Id = req.PathParameter("id")
+	req_restore.Collection = req.PathParameter("collection")
+	req_restore.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_restore.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.ContentClient.Restore(ctx, req_restore)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.content.Restore", "RestoreError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Restored successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
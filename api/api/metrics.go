@@ -1,6 +1,8 @@
 package api
 
 import (
+	"server/api/breaker"
+
 	"github.com/emicklei/go-restful"
 	"log"
 	"github.com/micro/go-os/metrics"
@@ -16,9 +18,14 @@ func (r MetricsService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/metrics")
+	ws.Doc("Metrics")
 	ws.Route(ws.GET("/").To(r.Varz).
 		Filter(r.FilterMiddle.BasicAuthenticate).
-		Doc("Shows current values of metrics"))
+		Filter(r.FilterMiddle.Authorize).
+		Filter(r.FilterMiddle.RateLimit).
+		Doc("Shows current values of metrics").
+		Operation("Varz").
+		Writes(map[string]string{}))
 
 	restful.Add(ws)
 }
@@ -27,7 +34,12 @@ func (r MetricsService) Register() {
 func (r *MetricsService) Varz(req *restful.Request, rsp *restful.Response) {
 	log.Print("Received Server.Varz API request")
 
-	rsp.WriteEntity(map[string]string{
+	varz := map[string]string{
 		"metrics": r.ServerMetrics.String(),
-	})
+	}
+	// the states of the circuit breakers of the services called
+	for service, state := range breaker.States() {
+		varz["breaker."+service] = state
+	}
+	rsp.WriteEntity(varz)
 }
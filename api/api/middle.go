@@ -8,9 +8,12 @@ import (
 	"io/ioutil"
 	"reflect"
 	account_proto "server/account-srv/proto/account"
+	"server/api/ratelimit"
+	"server/api/rbac"
 	"server/api/utils"
 	audit_proto "server/audit-srv/proto/audit"
 	"server/common"
+	kv_proto "server/kv-srv/proto/kv"
 	organisation_proto "server/organisation-srv/proto/organisation"
 	team_proto "server/team-srv/proto/team"
 	user_proto "server/user-srv/proto/user"
@@ -21,6 +24,7 @@ import (
 	"github.com/micro/go-micro/broker"
 	_ "github.com/micro/go-plugins/broker/nats"
 	_ "github.com/micro/go-plugins/transport/nats"
+	log "github.com/sirupsen/logrus"
 )
 
 const (
@@ -36,11 +40,11 @@ const (
 	// internal employee_id retrieved from session key
 	EmployeeIdAttrName = "employee_id"
 
-	// Power level for the room
-	UserPowerLevelAttrName = "power_id"
+	// organisation info read by OrganisationAuthenticate
+	OrgInfoAttrName = "org_info"
 
-	// Power level for the organization
-	OrgPowerLevelAttrName = "org_power_id"
+	// employee info read by EmployeeAuthenticate
+	EmployeeInfoAttrName = "employee_info"
 
 	// Search username query parameter
 	UserNameSearchParameter = "name"
@@ -51,6 +55,9 @@ const (
 	// session auth parameter
 	SessionParameter = "session"
 
+	// session of the bearer token of the request
+	TokenSessionAttrName = "token_session"
+
 	// Pagination from unix timestemp
 	PaginateFromParameter = "from"
 
@@ -81,10 +88,23 @@ type Filters struct {
 	TeamClient         team_proto.TeamServiceClient
 	UserClient         user_proto.UserServiceClient
 	OrganisationClient organisation_proto.OrganisationServiceClient
+	KvClient           kv_proto.KvServiceClient
 }
 
-// Reads a session get-parameter and checks user session (if user has logged in)
+// Removes the tenant headers sent by the client, the tenant of a request is only set from its session
+func (r Filters) TenantFilter(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	req.Request.Header.Del(common.TenantOrgKey)
+	req.Request.Header.Del(common.TenantAdminKey)
+	chain.ProcessFilter(req, resp)
+}
+
+// Reads an Authorization: Bearer access token, or a session get-parameter, and checks user session (if user has logged in)
 func (r Filters) BasicAuthenticate(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	if token := common.BearerToken(req.HeaderParameter("Authorization")); len(token) > 0 {
+		r.TokenAuthenticate(token, req, resp, chain)
+		return
+	}
+
 	sessionId := req.QueryParameter(SessionParameter)
 	if len(sessionId) == 0 {
 		resp.AddHeader("WWW-Authenticate", "Basic realm=Protected Area")
@@ -103,39 +123,42 @@ func (r Filters) BasicAuthenticate(req *restful.Request, resp *restful.Response,
 
 	req.SetAttribute(UserIdAttrName, session_resp.UserId)
 	req.SetAttribute(OrgIdAttrName, session_resp.OrgId)
+	// the db queries of the request are scoped to the organisation of the session
+	common.SetTenantHeader(req.Request.Header, session_resp.OrgId)
 	// log.Println(session_resp.UserId, session_resp.OrgId)
 	chain.ProcessFilter(req, resp)
 }
 
-// // Returns closure with given action that checks the power level of the action.
-// func (r Filters) RoomPowerLevel(action string) func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
-// 	return func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
-// 		powerlevel := req.Attribute(UserPowerLevelAttrName).(int64)
-// 		ok, err := common.EnoughPowerLevel(action, powerlevel)
-// 		if !ok && err != nil {
-// 			resp.AddHeader("Content-Type", "text/plain")
-// 			resp.WriteErrorString(http.StatusInternalServerError, err.Error())
-// 			return
-// 		}
-// 		chain.ProcessFilter(req, resp)
-// 	}
-// }
+// Checks a jwt access token with the signing key and its session against the denylist of account-srv, the access
+// tokens of a logged out or revoked session are rejected
+func (r Filters) TokenAuthenticate(token string, req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	claims, err := common.VerifyToken(token, common.AccessToken, time.Now())
+	if err != nil {
+		resp.AddHeader("WWW-Authenticate", `Bearer realm="Protected Area", error="invalid_token"`)
+		utils.NoAuthorizedResponse(resp, err, "basic.auth.error", "Invalid Token")
+		return
+	}
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	rsp_revoked, err := r.KvClient.IsTokenRevoked(ctx, &kv_proto.IsTokenRevokedRequest{Index: common.TOKEN_REVOKED_INDEX, Ids: []string{claims.Session}})
+	if err != nil || rsp_revoked.Revoked {
+		if err == nil {
+			err = errors.New("Revoked Token")
+		}
+		resp.AddHeader("WWW-Authenticate", `Bearer realm="Protected Area", error="invalid_token"`)
+		utils.NoAuthorizedResponse(resp, err, "basic.auth.error", "Invalid Token")
+		return
+	}
 
-// // Returns closure with given action that checks the power level of the action.
-// func (r Filters) OrgPowerLevel(action string) func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
-// 	return func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
-// 		powerlevel := req.Attribute(OrgPowerLevelAttrName).(int64)
-// 		ok, err := common.EnoughPowerLevel(action, powerlevel)
-// 		if !ok && err != nil {
-// 			resp.AddHeader("Content-Type", "text/plain")
-// 			resp.WriteErrorString(http.StatusInternalServerError, err.Error())
-// 			return
-// 		}
-// 		chain.ProcessFilter(req, resp)
-// 	}
-// }
+	req.SetAttribute(UserIdAttrName, claims.UserId)
+	req.SetAttribute(OrgIdAttrName, claims.OrgId)
+	req.SetAttribute(EmployeeIdAttrName, claims.Employee)
+	req.SetAttribute(TokenSessionAttrName, claims.Session)
+	// the db queries of the request are scoped to the organisation of the token
+	common.SetTenantHeader(req.Request.Header, claims.OrgId)
+	chain.ProcessFilter(req, resp)
+}
 
-// Gets user power level within the organisation
+// Checks the organisation of the user
 func (r Filters) OrganisationAuthenticate(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
 
@@ -150,53 +173,23 @@ func (r Filters) OrganisationAuthenticate(req *restful.Request, resp *restful.Re
 		return
 	}
 
-	// will add power level later
+	req.SetAttribute(OrgInfoAttrName, resp_org.OrgInfo)
 	chain.ProcessFilter(req, resp)
 }
 
-// Gets user power level within the organization
-// func (r Filters) OrganizationAuthenticate(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
-// 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
-
-// 	req_search := new(organisation_proto.SearchMembersRequest)
-// 	req_search.Userid = req.Attribute(UserIdAttrName).(string)
-// 	req_search.Orgid = req.Attribute(OrgIdAttrName).(string)
-// 	resp_search, err := r.OrganisationClient.SearchMembers(ctx, req_search)
-// 	if err != nil {
-// 		resp.AddHeader("Content-Type", "text/plain")
-// 		resp.WriteErrorString(http.StatusInternalServerError, err.Error())
-// 		return
-// 	}
-
-// 	if len(resp_search.Members) > 0 {
-// 		userlevel := resp_search.Members[0].Level
-
-// 		req_search_team_member := new(organisation_proto.SearchTeamMembersRequest)
-// 		req_search_team_member.Userid = req.Attribute(UserIdAttrName).(string)
-// 		req_search_team_member.Orgid = req.Attribute(OrgIdAttrName).(string)
-// 		resp_search_team_member, err := r.OrganisationClient.SearchTeamMembers(ctx, req_search_team_member)
-// 		if err == nil && len(resp_search_team_member.TeamMembers) != 0 {
-// 			req_read_team := new(organisation_proto.ReadTeamRequest)
-// 			req_read_team.Id = resp_search_team_member.TeamMembers[0].Teamid
-// 			resp_read_team, err := r.OrganisationClient.ReadTeam(ctx, req_read_team)
-// 			if err != nil {
-// 				if userlevel < resp_read_team.Team.Level {
-// 					userlevel = resp_read_team.Team.Level
-// 				}
-
-// 			}
-// 		}
-
-// 		req.SetAttribute(OrgPowerLevelAttrName, userlevel)
-// 	} else {
-// 		req.SetAttribute(OrgPowerLevelAttrName, int64(common.PowerlevelAdmin))
-// 	}
-
-// 	chain.ProcessFilter(req, resp)
-// }
-
 // Check whether this is a valid employee
 func (r Filters) EmployeeAuthenticate(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	// the employee claim of an access token was read by account-srv at login
+	if _, ok := req.Attribute(TokenSessionAttrName).(string); ok {
+		if employee, _ := req.Attribute(EmployeeIdAttrName).(string); len(employee) == 0 {
+			utils.NoAuthorizedResponse(resp, errors.New("Not Authorized"), "employee.auth.error", "Not Authorized")
+			return
+		}
+		req.SetAttribute(TeamIdAttrName, req.Attribute(UserIdAttrName).(string))
+		chain.ProcessFilter(req, resp)
+		return
+	}
+
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
 
 	//ReadEmployeeInfo - from kv
@@ -211,10 +204,102 @@ func (r Filters) EmployeeAuthenticate(req *restful.Request, resp *restful.Respon
 	// 	utils.NoAuthorizedResponse(resp, err, "employee.auth.error", "Not Authorized")
 	// }
 	req.SetAttribute(TeamIdAttrName, resp_employee.Employee.Employee.User.Id)
+	req.SetAttribute(EmployeeInfoAttrName, resp_employee.Employee)
 
 	chain.ProcessFilter(req, resp)
 }
 
+// Checks the permission of the route, see rbac.Routes. It follows the authentication filters of the route so the
+// employee and organisation infos they read are reused.
+func (r Filters) Authorize(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	route := req.Request.Method + " " + req.SelectedRoutePath()
+	rule, ok := rbac.Routes[route]
+	if !ok {
+		if err := rbac.Unknown(route); err != nil {
+			utils.ForbiddenResponse(resp, err, "rbac.auth.error", "Forbidden")
+			return
+		}
+		chain.ProcessFilter(req, resp)
+		return
+	}
+	if rule.Access != rbac.AccessEmployee || rbac.DefaultMode == rbac.ModeOff {
+		chain.ProcessFilter(req, resp)
+		return
+	}
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	userId := req.Attribute(UserIdAttrName).(string)
+	org, _ := req.Attribute(OrgInfoAttrName).(*organisation_proto.OrgInfo)
+	if org == nil {
+		if resp_org, err := r.OrganisationClient.ReadOrgInfo(ctx, &organisation_proto.ReadOrgInfoRequest{req.Attribute(OrgIdAttrName).(string)}); err == nil {
+			org = resp_org.OrgInfo
+			req.SetAttribute(OrgInfoAttrName, org)
+		}
+	}
+	// the requests of an access token don't read the employee info in EmployeeAuthenticate
+	employee, _ := req.Attribute(EmployeeInfoAttrName).(*team_proto.EmployeeInfo)
+	if employee == nil {
+		if resp_employee, err := r.TeamClient.ReadEmployeeInfo(ctx, &team_proto.ReadEmployeeInfoRequest{userId}); err == nil {
+			employee = resp_employee.Employee
+		}
+	}
+
+	teamId := ""
+	if len(rule.Team) > 0 {
+		teamId = req.PathParameter(rule.Team)
+	}
+	if err := rbac.Authorize(route, rbac.Resolve(userId, employee, org), rule, teamId); err != nil {
+		utils.ForbiddenResponse(resp, err, "rbac.auth.error", "Forbidden")
+		return
+	}
+	chain.ProcessFilter(req, resp)
+}
+
+// Limits the requests per user and organisation, see ratelimit. It follows the authentication filters of the route,
+// the requests of the public routes are limited per client address. The requests aren't limited if kv-srv fails.
+func (r Filters) RateLimit(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	if ratelimit.DefaultMode == ratelimit.ModeOff {
+		chain.ProcessFilter(req, resp)
+		return
+	}
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	class := ratelimit.ClassPublic
+	user := ratelimit.ClientAddress(req.Request)
+	orgId := ""
+	var setting *organisation_proto.RateLimit
+	if userId, ok := req.Attribute(UserIdAttrName).(string); ok && len(userId) > 0 {
+		class = ratelimit.RouteClass(req.Request.Method)
+		user = userId
+		orgId, _ = req.Attribute(OrgIdAttrName).(string)
+		org, _ := req.Attribute(OrgInfoAttrName).(*organisation_proto.OrgInfo)
+		if org == nil && len(orgId) > 0 {
+			if resp_org, err := r.OrganisationClient.ReadOrgInfo(ctx, &organisation_proto.ReadOrgInfoRequest{orgId}); err == nil {
+				org = resp_org.OrgInfo
+				req.SetAttribute(OrgInfoAttrName, org)
+			}
+		}
+		if org != nil && org.Setting != nil {
+			setting = org.Setting.RateLimit
+		}
+	}
+
+	limit, err := ratelimit.Check(ctx, r.KvClient, class, user, orgId, ratelimit.Quotas(class, setting))
+	if err != nil {
+		log.WithField("class", class).Warn("Rate limit isn't checked: ", err)
+		chain.ProcessFilter(req, resp)
+		return
+	}
+	if limit != nil {
+		limit.SetHeaders(resp.Header())
+	}
+	if ratelimit.Reject(req.Request.Method+" "+req.SelectedRoutePath(), limit) {
+		utils.TooManyRequestsResponse(resp, limit.Error(), "ratelimit.error", "Too Many Requests")
+		return
+	}
+	chain.ProcessFilter(req, resp)
+}
+
 // Helper to extract int from string parameters
 func parameterToValue(parameter string) int64 {
 	if len(parameter) != 0 {
@@ -240,6 +325,20 @@ func (r Filters) Paginate(req *restful.Request, resp *restful.Response, chain *r
 	chain.ProcessFilter(req, resp)
 }
 
+// Paginated documents the parameters of the Paginate filter on a route
+func Paginated(b *restful.RouteBuilder) {
+	b.Param(restful.QueryParameter(PaginateFromParameter, "Unix timestamp from").DataType("integer")).
+		Param(restful.QueryParameter(PaginateToParameter, "Unix timestamp to").DataType("integer")).
+		Param(restful.QueryParameter(PaginateLimitParameter, "Maximum count of items").DataType("integer")).
+		Param(restful.QueryParameter(PaginateOffsetParameter, "Count of items to skip").DataType("integer"))
+}
+
+// Sorted documents the parameters of the SortFilter filter on a route
+func Sorted(b *restful.RouteBuilder) {
+	b.Param(restful.QueryParameter(SortParameter, "Field of the data to sort the items by, updated by default")).
+		Param(restful.QueryParameter(SortDirection, "ASC or DESC, DESC by default"))
+}
+
 // Cuts SortFilter parameters: sortParameter, sortDirection
 func (r Filters) SortFilter(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
 	sortParameter := req.QueryParameter(SortParameter)
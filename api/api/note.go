@@ -25,6 +25,7 @@ func (p NoteService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/notes")
+	ws.Doc("Notes")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.NoteSrv,
@@ -35,67 +36,107 @@ func (p NoteService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all notes"))
+		Doc("List all notes").
+		Do(Paginated, Sorted).
+		Operation("AllNotes").
+		Writes(note_proto.AllResponse{}))
 
 	ws.Route(ws.POST("/note").To(p.CreateNote).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("create data"))
+		Doc("create data").
+		Operation("CreateNote").
+		Reads(note_proto.CreateRequest{}).
+		Writes(note_proto.CreateResponse{}))
 
 	ws.Route(ws.GET("/note/{note_id}").To(p.ReadNote).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Note detail"))
+		Doc("View Note detail").
+		Operation("ReadNote").
+		Writes(note_proto.ReadResponse{}))
 
 	ws.Route(ws.DELETE("/note/{note_id}").To(p.DeleteNote).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Note detail"))
+		Doc("View Note detail").
+		Operation("DeleteNote").
+		Writes(note_proto.DeleteResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.SearchNotes).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all notes"))
+		Doc("List all notes").
+		Do(Paginated, Sorted).
+		Operation("SearchNotes").
+		Reads(note_proto.SearchRequest{}).
+		Writes(note_proto.SearchResponse{}))
 
 	ws.Route(ws.GET("/creator/{user_id}").To(p.ByCreator).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all notes where the status is draft"))
+		Doc("Get all notes where the status is draft").
+		Do(Paginated, Sorted).
+		Operation("NoteByCreator").
+		Writes(note_proto.ByCreatorResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}").To(p.ByUser).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all notes where the status is draft"))
+		Doc("Get all notes where the status is draft").
+		Do(Paginated, Sorted).
+		Operation("NoteByUser").
+		Writes(note_proto.ByUserResponse{}))
 
 	ws.Route(ws.POST("/filter").To(p.Filter).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter notes by one or more category, one or more tags"))
+		Doc("Filter notes by one or more category, one or more tags").
+		Do(Paginated, Sorted).
+		Operation("NoteFilter").
+		Reads(note_proto.FilterRequest{}).
+		Writes(note_proto.FilterResponse{}))
 
 	restful.Add(ws)
 }
@@ -26,6 +26,7 @@ func (p OrganisationService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/organisations")
+	ws.Doc("Organisations")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.OrganisationSrv,
@@ -35,50 +36,87 @@ func (p OrganisationService) Register() {
 	ws.Route(ws.GET("/all").To(p.AllOrganisations).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all orgs"))
+		Doc("List all orgs").
+		Do(Paginated, Sorted).
+		Operation("AllOrganisations").
+		Writes(organisation_proto.AllResponse{}))
 
 	ws.Route(ws.POST("/organisation").To(p.CreateOrganisation).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create one org"))
+		Doc("Create one org").
+		Operation("CreateOrganisation").
+		Reads(organisation_proto.CreateRequest{}).
+		Writes(organisation_proto.CreateResponse{}))
 
 	ws.Route(ws.PUT("/organisation").To(p.UpdateOrganisation).
 		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Update one org"))
+		Doc("Update one org").
+		Operation("UpdateOrganisation").
+		Reads(organisation_proto.UpdateRequest{}).
+		Writes(organisation_proto.UpdateResponse{}))
 
 	ws.Route(ws.GET("/organisation/{org_id}").To(p.ReadOrganisation).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read one org"))
+		Doc("Read one org").
+		Operation("ReadOrganisation").
+		Writes(organisation_proto.ReadResponse{}))
 
 	ws.Route(ws.POST("/profile").To(p.CreateOrganisationProfile).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Created org profile"))
+		Doc("Created org profile").
+		Operation("CreateOrganisationProfile").
+		Reads(organisation_proto.CreateOrganisationProfileRequest{}).
+		Writes(organisation_proto.CreateOrganisationProfileResponse{}))
 
 	ws.Route(ws.POST("/setting").To(p.CreateOrganisationSetting).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Created org setting"))
+		Doc("Created org setting").
+		Operation("CreateOrganisationSetting").
+		Reads(organisation_proto.CreateOrganisationSettingRequest{}).
+		Writes(organisation_proto.CreateOrganisationSettingResponse{}))
 
 	ws.Route(ws.POST("/modules").To(p.UpdateModules).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("update modules"))
+		Doc("update modules").
+		Operation("UpdateModules").
+		Reads(organisation_proto.UpdateModulesRequest{}).
+		Writes(organisation_proto.UpdateModulesResponse{}))
 
 	ws.Route(ws.GET("/modules").To(p.GetModulesByOrg).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("get organisation modules"))
+		Doc("get organisation modules").
+		Operation("GetModulesByOrg").
+		Writes(organisation_proto.GetModulesByOrgResponse{}))
 
 	restful.Add(ws)
 }
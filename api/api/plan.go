@@ -29,6 +29,7 @@ func (p PlanService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/plans")
+	ws.Doc("Plans")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.PlanSrv,
@@ -39,114 +40,230 @@ func (p PlanService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all plans"))
+		Doc("List all plans").
+		Do(Paginated, Sorted).
+		Operation("AllPlans").
+		Writes(plan_proto.AllResponse{}))
 
 	ws.Route(ws.POST("/plan").To(p.CreatePlan).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("create data"))
+		Doc("create data").
+		Operation("CreatePlan").
+		Reads(plan_proto.CreateRequest{}).
+		Writes(plan_proto.CreateResponse{}))
 
 	ws.Route(ws.GET("/plan/{plan_id}").To(p.ReadPlan).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Plan detail"))
+		Doc("View Plan detail").
+		Operation("ReadPlan").
+		Writes(plan_proto.ReadResponse{}))
+
+	ws.Route(ws.PUT("/plan/{plan_id}").To(p.UpdatePlan).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		// Filter(p.Audit.Clone(audit).AuditFilter).
+		Doc("Update a plan").
+		Operation("UpdatePlan").
+		Reads(plan_proto.UpdateRequest{}).
+		Writes(plan_proto.UpdateResponse{}))
 
 	ws.Route(ws.DELETE("/plan/{plan_id}").To(p.DeletePlan).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Plan detail"))
+		Doc("View Plan detail").
+		Operation("DeletePlan").
+		Writes(plan_proto.DeleteResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.SearchPlans).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return searched plans"))
+		Doc("Return searched plans").
+		Do(Paginated, Sorted).
+		Operation("SearchPlans").
+		Reads(plan_proto.SearchRequest{}).
+		Writes(plan_proto.SearchResponse{}))
 
 	ws.Route(ws.GET("/templates").To(p.Templates).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all templates"))
+		Doc("Get all templates").
+		Do(Paginated, Sorted).
+		Operation("PlanTemplates").
+		Writes(plan_proto.TemplatesResponse{}))
 
 	ws.Route(ws.GET("/drafts").To(p.Drafts).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all plans where the status is draft"))
+		Doc("Get all plans where the status is draft").
+		Do(Paginated, Sorted).
+		Operation("Drafts").
+		Writes(plan_proto.DraftsResponse{}))
 
 	ws.Route(ws.GET("/creator/{user_id}").To(p.ByCreator).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all plans created by a particular team member"))
+		Doc("Get all plans created by a particular team member").
+		Do(Paginated, Sorted).
+		Operation("PlanByCreator").
+		Writes(plan_proto.ByCreatorResponse{}))
 
 	ws.Route(ws.GET("/filters/all").To(p.Filters).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all plan filters"))
+		Doc("Get all plan filters").
+		Do(Paginated, Sorted).
+		Operation("Filters").
+		Writes(plan_proto.FiltersResponse{}))
 
 	ws.Route(ws.GET("/filter/time").To(p.TimeFilters).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all plans by time period"))
+		Doc("Get all plans by time period").
+		Do(Paginated, Sorted).
+		Param(ws.QueryParameter("start_date", "Unix timestamp of the start").DataType("integer")).
+		Param(ws.QueryParameter("end_date", "Unix timestamp of the end").DataType("integer")).
+		Operation("TimeFilters").
+		Writes(plan_proto.TimeFiltersResponse{}))
 
 	ws.Route(ws.GET("/filter/goal").To(p.GoalFilters).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all plans by goal category"))
+		Doc("Get all plans by goal category").
+		Do(Paginated, Sorted).
+		Param(ws.QueryParameter("filter", "Goal ids separated by commas")).
+		Operation("GoalFilters").
+		Writes(plan_proto.GoalFiltersResponse{}))
 
 	ws.Route(ws.POST("/plan/search/autocomplete").To(p.AutocompletePlanSearch).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete plan text"))
+		Doc("Search autocomplete plan text").
+		Do(Sorted).
+		Operation("AutocompletePlanSearch").
+		Reads(plan_proto.AutocompleteSearchRequest{}).
+		Writes(plan_proto.AutocompleteSearchResponse{}))
 
 	ws.Route(ws.GET("/tags/top/{n}").To(p.GetTopPlanTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return top N tags for Plan"))
+		Doc("Return top N tags for Plan").
+		Operation("GetTopPlanTags").
+		Writes(plan_proto.GetTopTagsResponse{}))
 
 	ws.Route(ws.POST("/tags/autocomplete").To(p.AutocompletePlanTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete for tags for Plan"))
+		Doc("Autocomplete for tags for Plan").
+		Operation("AutocompletePlanTags").
+		Reads(plan_proto.AutocompleteTagsRequest{}).
+		Writes(plan_proto.AutocompleteTagsResponse{}))
+
+	ws.Route(ws.GET("/plan/{plan_id}/versions").To(p.ListVersions).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("List the versions of a plan").
+		Do(Paginated).
+		Operation("PlanListVersions").
+		Writes(plan_proto.ListVersionsResponse{}))
+
+	ws.Route(ws.GET("/plan/{plan_id}/versions/{version}").To(p.ReadVersion).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Read a plan as it was at a version").
+		Operation("PlanReadVersion").
+		Writes(plan_proto.ReadVersionResponse{}))
+
+	ws.Route(ws.POST("/plan/{plan_id}/versions/{version}/revert").To(p.RevertToVersion).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Revert a plan to a version").
+		Operation("PlanRevertToVersion").
+		Writes(plan_proto.RevertToVersionResponse{}))
 
 	restful.Add(ws)
 }
@@ -705,10 +822,96 @@ func (p *PlanService) ReadPlan(req *restful.Request, rsp *restful.Response) {
 	}
 	read_resp.Code = http.StatusOK
 	read_resp.Message = "Read plan succesfully"
+	utils.SetETag(rsp, read_resp.Data.Plan.Revision)
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, read_resp)
 }
 
+/**
+* @api {put} /server/plans/plan/{plan_id}?session={session_id} Update a plan
+* @apiVersion 0.1.0
+* @apiName UpdatePlan
+* @apiGroup Plan
+*
+* @apiDescription Update a plan, the plan is replaced by the one of the request. If the If-Match header is set to
+* the ETag of a read, the update fails with 412 if the plan was updated since.
+*
+* @apiExample Example usage:
+* curl -i -X PUT -H 'If-Match: "_Wq3k1-2---"' http://BASE_SERVER_URL/server/plans/plan/111?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+* {
+*   "plan": {
+*     "name": "plan1",
+*     "description": "hello world",
+*     ... ...
+*   }
+* }
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* ETag: "_Wq3k1-6---"
+* {
+*   "data": {
+*     "plan": {
+*       "id": "111",
+*       "name": "plan1",
+*       "revision": "_Wq3k1-6---",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Updated plan succesfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The plan was not found.
+* @apiError PreconditionFailed   	The plan was updated since the revision of If-Match.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 412 Precondition Failed
+*     {
+*       "code": 412,
+*       "message": "UpdateError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.plan.UpdatePlan",
+*           "reason": "{\"id\":\"go.micro.srv.plan\",\"code\":409,\"detail\":\"plan was updated since its revision\",\"status\":\"Conflict\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *PlanService) UpdatePlan(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Plan.Update API request")
+	req_plan := new(plan_proto.UpdateRequest)
+	if err := utils.UnmarshalAny(req, rsp, req_plan); err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.plan.UpdatePlan", "BindError")
+		return
+	}
+	if req_plan.Plan == nil {
+		req_plan.Plan = &plan_proto.Plan{}
+	}
+	req_plan.Plan.Id = req.PathParameter("plan_id")
+	if revision := utils.IfMatch(req); len(revision) > 0 {
+		req_plan.Plan.Revision = revision
+	}
+	req_plan.UserId = req.Attribute(UserIdAttrName).(string)
+	req_plan.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_plan.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	update_resp, err := p.PlanClient.Update(ctx, req_plan)
+	if err != nil {
+		utils.WriteUpdateErrorResponse(rsp, err, "go.micro.srv.plan.UpdatePlan", "UpdateError")
+		return
+	}
+	update_resp.Code = http.StatusOK
+	update_resp.Message = "Updated plan succesfully"
+	data := utils.MarshalAny(rsp, update_resp)
+
+	utils.SetETag(rsp, update_resp.Data.Plan.Revision)
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, data)
+}
+
 /**
 * @api {delete} /server/plans/plan/{plan_id}?session={session_id} Delete a plan
 * @apiVersion 0.1.0
@@ -1638,3 +1841,230 @@ func (p *PlanService) AutocompletePlanTags(req *restful.Request, rsp *restful.Re
 	rsp.AddHeader("Plan-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
 }
+
+/**
+* @api {get} /server/plans/plan/{plan_id}/versions?session={session_id}&offset={offset}&limit={limit} List the versions of a plan
+* @apiVersion 0.1.0
+* @apiName ListVersions
+* @apiGroup Plan
+*
+* @apiDescription List the versions of a plan, the latest first. A version is saved by every write changing the plan
+* with the changes since the previous version, the value of a change is json and empty if the attribute didn't exist.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/plans/plan/111/versions?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "versions": [
+*       {
+*         "id": "plan:111:2",
+*         "collection": "plan",
+*         "document_id": "111",
+*         "version": 2,
+*         "org_id": "orgid",
+*         "author_id": "userid",
+*         "created": 1517991917,
+*         "changes": [
+*           {
+*             "path": "data.title",
+*             "old_value": "\"title\"",
+*             "new_value": "\"new title\""
+*           }
+*         ]
+*       },
+*       ... ...
+*     ]
+*   },
+*   "code": 200,
+*   "message": "Read versions successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 500 Internal Server Error
+*     {
+*       "code": 500,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.plan.ListVersions",
+*           "reason": "{\"id\":\"go.micro.srv.plan\",\"code\":500,\"detail\":\"versions error\",\"status\":\"Internal Server Error\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *PlanService) ListVersions(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Plan.ListVersions API request")
+	req_version := new(plan_proto.ListVersionsRequest)
+	req_version.Id = req.PathParameter("plan_id")
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_version.Limit = req.Attribute(PaginateLimitParameter).(int64)
+	req_version.Offset = req.Attribute(PaginateOffsetParameter).(int64)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.PlanClient.ListVersions(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.plan.ListVersions", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read versions successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {get} /server/plans/plan/{plan_id}/versions/{version}?session={session_id} Read a plan at a version
+* @apiVersion 0.1.0
+* @apiName ReadVersion
+* @apiGroup Plan
+*
+* @apiDescription Read a plan as it was at a version with the changes of the version
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/plans/plan/111/versions/1?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "version": {
+*       "id": "plan:111:1",
+*       "collection": "plan",
+*       "document_id": "111",
+*       "version": 1,
+*       "org_id": "orgid",
+*       "author_id": "userid",
+*       "created": 1517891917
+*     },
+*     "plan": {
+*       "id": "111",
+*       "title": "title",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Read version successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The version doesn't exist.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "ReadError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.plan.ReadVersion",
+*           "reason": "{\"id\":\"go.micro.srv.plan\",\"code\":404,\"detail\":\"version not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *PlanService) ReadVersion(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Plan.ReadVersion API request")
+	req_version := new(plan_proto.ReadVersionRequest)
+	req_version.Id = req.PathParameter("plan_id")
+	req_version.Version, _ = strconv.ParseInt(req.PathParameter("version"), 10, 64)
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.PlanClient.ReadVersion(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.plan.ReadVersion", "ReadError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read version successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {post} /server/plans/plan/{plan_id}/versions/{version}/revert?session={session_id} Revert a plan to a version
+* @apiVersion 0.1.0
+* @apiName RevertToVersion
+* @apiGroup Plan
+*
+* @apiDescription Revert a plan to a version, the reverted plan is saved as a new version which is returned
+*
+* @apiExample Example usage:
+* curl -i -X POST http://BASE_SERVER_URL/server/plans/plan/111/versions/1/revert?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "version": {
+*       "id": "plan:111:3",
+*       "collection": "plan",
+*       "document_id": "111",
+*       "version": 3,
+*       "org_id": "orgid",
+*       "author_id": "userid",
+*       "created": 1518091917,
+*       "changes": [
+*         {
+*           "path": "data.title",
+*           "old_value": "\"new title\"",
+*           "new_value": "\"title\""
+*         }
+*       ]
+*     },
+*     "plan": {
+*       "id": "111",
+*       "title": "title",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Reverted successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The version doesn't exist.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "RevertError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.plan.RevertToVersion",
+*           "reason": "{\"id\":\"go.micro.srv.plan\",\"code\":404,\"detail\":\"version not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *PlanService) RevertToVersion(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Plan.RevertToVersion API request")
+	req_version := new(plan_proto.RevertToVersionRequest)
+	req_version.Id = req.PathParameter("plan_id")
+	req_version.Version, _ = strconv.ParseInt(req.PathParameter("version"), 10, 64)
+	req_version.UserId = req.Attribute(UserIdAttrName).(string)
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.PlanClient.RevertToVersion(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.plan.RevertToVersion", "RevertError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Reverted successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
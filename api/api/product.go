@@ -25,6 +25,7 @@ func (p ProductService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/products")
+	ws.Doc("Products")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.ProductSrv,
@@ -35,105 +36,169 @@ func (p ProductService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all products"))
+		Doc("List all products").
+		Do(Paginated, Sorted).
+		Operation("AllProducts").
+		Writes(product_proto.AllProductsResponse{}))
 
 	ws.Route(ws.POST("/product").To(p.CreateProduct).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a product"))
+		Doc("Create or update a product").
+		Operation("CreateProduct").
+		Reads(product_proto.CreateProductRequest{}).
+		Writes(product_proto.CreateProductResponse{}))
 
 	ws.Route(ws.GET("/product/{product_id}").To(p.ReadProduct).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a product"))
+		Doc("Read a product").
+		Operation("ReadProduct").
+		Writes(product_proto.ReadProductResponse{}))
 
 	ws.Route(ws.DELETE("/product/{product_id}").To(p.DeleteProduct).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a product"))
+		Doc("Delete a product").
+		Operation("DeleteProduct").
+		Writes(product_proto.DeleteProductResponse{}))
 
 	ws.Route(ws.POST("/autocomplete/product").To(p.AutocompleteProduct).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete search products"))
+		Doc("Autocomplete search products").
+		Operation("AutocompleteProduct").
+		Reads(product_proto.AutocompleteProductRequest{}).
+		Writes(product_proto.AutocompleteProductResponse{}))
 
 	ws.Route(ws.GET("/services/all").To(p.AllServices).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all services"))
+		Doc("List all services").
+		Do(Paginated, Sorted).
+		Operation("AllServices").
+		Writes(product_proto.AllServicesResponse{}))
 
 	ws.Route(ws.POST("/service").To(p.CreateService).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a service"))
+		Doc("Create or update a service").
+		Operation("CreateService").
+		Reads(product_proto.CreateServiceRequest{}).
+		Writes(product_proto.CreateServiceResponse{}))
 
 	ws.Route(ws.GET("/service/{service_id}").To(p.ReadService).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a service"))
+		Doc("Read a service").
+		Operation("ReadService").
+		Writes(product_proto.ReadServiceResponse{}))
 
 	ws.Route(ws.DELETE("/service/{service_id}").To(p.DeleteService).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a service"))
+		Doc("Delete a service").
+		Operation("DeleteService").
+		Writes(product_proto.DeleteServiceResponse{}))
 
 	ws.Route(ws.POST("/autocomplete/service").To(p.AutocompleteService).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete search services"))
+		Doc("Autocomplete search services").
+		Operation("AutocompleteService").
+		Reads(product_proto.AutocompleteServiceRequest{}).
+		Writes(product_proto.AutocompleteServiceResponse{}))
 
 	ws.Route(ws.GET("/batches/all").To(p.AllBatches).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all batches"))
+		Doc("List all batches").
+		Do(Paginated, Sorted).
+		Operation("AllBatches").
+		Writes(product_proto.AllBatchesResponse{}))
 
 	ws.Route(ws.POST("/batch").To(p.CreateBatch).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a batch"))
+		Doc("Create or update a batch").
+		Operation("CreateBatch").
+		Reads(product_proto.CreateBatchRequest{}).
+		Writes(product_proto.CreateBatchResponse{}))
 
 	ws.Route(ws.GET("/batch/{batch_id}").To(p.ReadBatch).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a batch"))
+		Doc("Read a batch").
+		Operation("ReadBatch").
+		Writes(product_proto.ReadBatchResponse{}))
 
 	ws.Route(ws.DELETE("/batch/{batch_id}").To(p.DeleteBatch).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a batch"))
+		Doc("Delete a batch").
+		Operation("DeleteBatch").
+		Writes(product_proto.DeleteBatchResponse{}))
 
 	restful.Add(ws)
 }
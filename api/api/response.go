@@ -28,6 +28,7 @@ func (p ResponseService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/responses")
+	ws.Doc("Responses")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.ResponseSrv,
@@ -35,89 +36,144 @@ func (p ResponseService) Register() {
 	}
 
 	ws.Route(ws.GET("/{hash}/check").To(p.Check).
-		Doc("Check response auth"))
+		Filter(p.Auth.RateLimit).
+		Doc("Check response auth").
+		Operation("Check").
+		Writes(resp_proto.CheckResponse{}))
 
 	ws.Route(ws.GET("/survey/{survey_id}/questions/all").To(p.AllQuestion).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all survey questions"))
+		Doc("Get all survey questions").
+		Do(Paginated, Sorted).
+		Operation("AllQuestion").
+		Writes(survey_proto.QuestionsResponse{}))
 
 	ws.Route(ws.GET("/survey/{survey_id}/questions/{question_id}").To(p.ReadQuestion).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get survey question by question id"))
+		Doc("Get survey question by question id").
+		Operation("ReadQuestion").
+		Writes(survey_proto.QuestionRefResponse{}))
 
 	ws.Route(ws.GET("/open/survey/{survey_id}/questions/all").To(p.OpenAllQuestion).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all survey questions (authentication NOT required)"))
+		Doc("Get all survey questions (authentication NOT required)").
+		Do(Paginated, Sorted).
+		Operation("OpenAllQuestion").
+		Writes(survey_proto.QuestionsResponse{}))
 
 	ws.Route(ws.GET("/open/survey/{survey_id}/questions/{question_id}").To(p.OpenReadQuestion).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get survey question by question id"))
+		Doc("Get survey question by question id").
+		Do(Paginated).
+		Operation("OpenReadQuestion").
+		Writes(survey_proto.QuestionRefResponse{}))
 	// maybe added groupby
 	// maybe added certain timeperiod
 	ws.Route(ws.GET("/{survey_id}/all").To(p.All).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Returns a list of submitted survey responses"))
+		Doc("Returns a list of submitted survey responses").
+		Do(Paginated, Sorted).
+		Param(ws.QueryParameter("groupby", "question to count the answers per question")).
+		Operation("ResponseAll").
+		Writes(resp_proto.AllResponse{}))
 
 	//TODO:need to create a response endpoint which doesn't require authentication
 	ws.Route(ws.POST("/{survey_id}/response").To(p.Create).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Returns a created response"))
+		Doc("Returns a created response").
+		Operation("ResponseCreate").
+		Reads(resp_proto.CreateRequest{}).
+		Writes(resp_proto.CreateResponse{}))
 
 	ws.Route(ws.POST("/{survey_id}/response/state").To(p.UpdateState).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Update response state"))
+		Doc("Update response state").
+		Operation("UpdateState").
+		Reads(resp_proto.UpdateStateRequest{}).
+		Writes(resp_proto.UpdateStateResponse{}))
 
 	ws.Route(ws.GET("/{survey_id}/all/state/{response_state}").To(p.AllState).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all responses by response state"))
+		Doc("List all responses by response state").
+		Do(Paginated, Sorted).
+		Operation("AllState").
+		Writes(resp_proto.AllStateResponse{}))
 
 	ws.Route(ws.GET("/{survey_id}/response/stats").To(p.ReadStats).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Returns a list of submitted survey responses"))
+		Doc("Returns a list of submitted survey responses").
+		Operation("ReadStats").
+		Writes(resp_proto.ReadStatsResponse{}))
 
 	ws.Route(ws.GET("/{survey_id}/response/by/{user_id}").To(p.ByUser).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Returns a list of submitted survey responses"))
+		Doc("Returns a list of submitted survey responses").
+		Do(Paginated, Sorted).
+		Operation("ResponseByUser").
+		Writes(resp_proto.ByUserResponse{}))
 
 	ws.Route(ws.GET("/{survey_id}/response/anon").To(p.ByAnyUser).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Returns a list of submitted survey responses"))
+		Doc("Returns a list of submitted survey responses").
+		Do(Paginated, Sorted).
+		Operation("ByAnyUser").
+		Writes(resp_proto.ByAnyUserResponse{}))
 
 	restful.Add(ws)
 }
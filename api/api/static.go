@@ -33,6 +33,7 @@ func (p StaticService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/static")
+	ws.Doc("Static")
 
 	audit := &audit_proto.Audit{
 		ActionService: common.StaticSrv,
@@ -42,661 +43,1050 @@ func (p StaticService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all apps"))
+		Doc("List all apps").
+		Do(Paginated, Sorted).
+		Operation("AllApps").
+		Writes(static_proto.AllAppsResponse{}))
 
 	ws.Route(ws.POST("/app").To(p.CreateApp).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a app"))
+		Doc("Create or update a app").
+		Operation("CreateApp").
+		Reads(static_proto.CreateAppRequest{}).
+		Writes(static_proto.CreateAppResponse{}))
 
 	ws.Route(ws.GET("/app/{app_id}").To(p.ReadApp).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a app"))
+		Doc("Read a app").
+		Operation("ReadApp").
+		Writes(static_proto.ReadAppResponse{}))
 
 	ws.Route(ws.DELETE("/app/{app_id}").To(p.DeleteApp).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a app"))
+		Doc("Delete a app").
+		Operation("DeleteApp").
+		Writes(static_proto.DeleteAppResponse{}))
 
 	ws.Route(ws.GET("/platforms/all").To(p.AllPlatforms).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all platforms"))
+		Doc("List all platforms").
+		Do(Paginated, Sorted).
+		Operation("AllPlatforms").
+		Writes(static_proto.AllPlatformsResponse{}))
 
 	ws.Route(ws.POST("/platform").To(p.CreatePlatform).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a platform"))
+		Doc("Create or update a platform").
+		Operation("CreatePlatform").
+		Reads(static_proto.CreatePlatformRequest{}).
+		Writes(static_proto.CreatePlatformResponse{}))
 
 	ws.Route(ws.GET("/platform/{platform_id}").To(p.ReadPlatform).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a platform"))
+		Doc("Read a platform").
+		Operation("ReadPlatform").
+		Writes(static_proto.ReadPlatformResponse{}))
 
 	ws.Route(ws.DELETE("/platform/{platform_id}").To(p.DeletePlatform).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a platform"))
+		Doc("Delete a platform").
+		Operation("DeletePlatform").
+		Writes(static_proto.DeletePlatformResponse{}))
 
 	ws.Route(ws.GET("/wearables/all").To(p.AllWearables).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all wearables"))
+		Doc("List all wearables").
+		Do(Paginated, Sorted).
+		Operation("AllWearables").
+		Writes(static_proto.AllWearablesResponse{}))
 
 	ws.Route(ws.POST("/wearable").To(p.CreateWearable).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a wearable"))
+		Doc("Create or update a wearable").
+		Operation("CreateWearable").
+		Reads(static_proto.CreateWearableRequest{}).
+		Writes(static_proto.CreateWearableResponse{}))
 
 	ws.Route(ws.GET("/wearable/{wearable_id}").To(p.ReadWearable).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a wearable"))
+		Doc("Read a wearable").
+		Operation("ReadWearable").
+		Writes(static_proto.ReadWearableResponse{}))
 
 	ws.Route(ws.DELETE("/wearable/{wearable_id}").To(p.DeleteWearable).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a wearable"))
+		Doc("Delete a wearable").
+		Operation("DeleteWearable").
+		Writes(static_proto.DeleteWearableResponse{}))
 
 	ws.Route(ws.GET("/devices/all").To(p.AllDevices).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all devices"))
+		Doc("List all devices").
+		Do(Paginated, Sorted).
+		Operation("AllDevices").
+		Writes(static_proto.AllDevicesResponse{}))
 
 	ws.Route(ws.POST("/device").To(p.CreateDevice).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a device"))
+		Doc("Create or update a device").
+		Operation("CreateDevice").
+		Reads(static_proto.CreateDeviceRequest{}).
+		Writes(static_proto.CreateDeviceResponse{}))
 
 	ws.Route(ws.GET("/device/{device_id}").To(p.ReadDevice).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a device"))
+		Doc("Read a device").
+		Operation("ReadDevice").
+		Writes(static_proto.ReadDeviceResponse{}))
 
 	ws.Route(ws.DELETE("/device/{device_id}").To(p.DeleteDevice).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a device"))
+		Doc("Delete a device").
+		Operation("DeleteDevice").
+		Writes(static_proto.DeleteDeviceResponse{}))
 
 	ws.Route(ws.GET("/markers/all").To(p.AllMarkers).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all markers"))
+		Doc("List all markers").
+		Do(Paginated, Sorted).
+		Operation("AllMarkers").
+		Writes(static_proto.AllMarkersResponse{}))
 
 	ws.Route(ws.POST("/marker").To(p.CreateMarker).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
-		Doc("Create or update a marker"))
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Create or update a marker").
+		Operation("CreateMarker").
+		Reads(static_proto.CreateMarkerRequest{}).
+		Writes(static_proto.CreateMarkerResponse{}))
 
 	ws.Route(ws.GET("/marker/{marker_id}").To(p.ReadMarker).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a marker"))
+		Doc("Read a marker").
+		Operation("ReadMarker").
+		Writes(static_proto.ReadMarkerResponse{}))
 
 	ws.Route(ws.DELETE("/marker/{marker_id}").To(p.DeleteMarker).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a marker"))
+		Doc("Delete a marker").
+		Operation("DeleteMarker").
+		Writes(static_proto.DeleteMarkerResponse{}))
 
 	ws.Route(ws.POST("/markers/filter").To(p.FilterMarker).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter marker"))
+		Doc("Filter marker").
+		Do(Paginated, Sorted).
+		Operation("FilterMarker").
+		Reads(static_proto.FilterMarkerRequest{}).
+		Writes(static_proto.FilterMarkerResponse{}))
 
 	ws.Route(ws.GET("/modules/all").To(p.AllModules).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all modules"))
+		Doc("List all modules").
+		Do(Paginated, Sorted).
+		Operation("AllModules").
+		Writes(static_proto.AllModulesResponse{}))
 
 	ws.Route(ws.POST("/module").To(p.CreateModule).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a module"))
+		Doc("Create or update a module").
+		Operation("CreateModule").
+		Reads(static_proto.CreateModuleRequest{}).
+		Writes(static_proto.CreateModuleResponse{}))
 
 	ws.Route(ws.GET("/module/{module_id}").To(p.ReadModule).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a module"))
+		Doc("Read a module").
+		Operation("ReadModule").
+		Writes(static_proto.ReadModuleResponse{}))
 
 	ws.Route(ws.DELETE("/module/{module_id}").To(p.DeleteModule).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a module"))
+		Doc("Delete a module").
+		Operation("DeleteModule").
+		Writes(static_proto.DeleteModuleResponse{}))
 
 	ws.Route(ws.GET("/behaviour/categorys/all").To(p.AllBehaviourCategories).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all behaviourcategories"))
+		Doc("List all behaviourcategories").
+		Do(Paginated, Sorted).
+		Operation("AllBehaviourCategories").
+		Writes(static_proto.AllBehaviourCategoriesResponse{}))
 
 	ws.Route(ws.POST("/behaviour/category").To(p.CreateBehaviourCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a behaviour category"))
+		Doc("Create or update a behaviour category").
+		Operation("CreateBehaviourCategory").
+		Reads(static_proto.CreateBehaviourCategoryRequest{}).
+		Writes(static_proto.CreateBehaviourCategoryResponse{}))
 
 	ws.Route(ws.GET("/behaviour/category/{category_id}").To(p.ReadBehaviourCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a behaviour category"))
+		Doc("Read a behaviour category").
+		Operation("ReadBehaviourCategory").
+		Writes(static_proto.ReadBehaviourCategoryResponse{}))
 
 	ws.Route(ws.DELETE("/behaviour/category/{category_id}").To(p.DeleteBehaviourCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a behaviour category"))
+		Doc("Delete a behaviour category").
+		Operation("DeleteBehaviourCategory").
+		Writes(static_proto.DeleteBehaviourCategoryResponse{}))
 
 	ws.Route(ws.POST("/behaviour/categorys/filter").To(p.FilterBehaviourCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter behaviour category"))
+		Doc("Filter behaviour category").
+		Do(Paginated, Sorted).
+		Operation("FilterBehaviourCategory").
+		Reads(static_proto.FilterBehaviourCategoryRequest{}).
+		Writes(static_proto.FilterBehaviourCategoryResponse{}))
 
 	ws.Route(ws.GET("/socialTypes/all").To(p.AllSocialTypes).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all socialTypes"))
+		Doc("List all socialTypes").
+		Do(Paginated, Sorted).
+		Operation("AllSocialTypes").
+		Writes(static_proto.AllSocialTypesResponse{}))
 
 	ws.Route(ws.POST("/socialType").To(p.CreateSocialType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a socialType"))
+		Doc("Create or update a socialType").
+		Operation("CreateSocialType").
+		Reads(static_proto.CreateSocialTypeRequest{}).
+		Writes(static_proto.CreateSocialTypeResponse{}))
 
 	ws.Route(ws.GET("/socialType/{socialType_id}").To(p.ReadSocialType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a socialType"))
+		Doc("Read a socialType").
+		Operation("ReadSocialType").
+		Writes(static_proto.ReadSocialTypeResponse{}))
 
 	ws.Route(ws.DELETE("/socialType/{socialType_id}").To(p.DeleteSocialType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a socialType"))
+		Doc("Delete a socialType").
+		Operation("DeleteSocialType").
+		Writes(static_proto.DeleteSocialTypeResponse{}))
 
 	ws.Route(ws.GET("/notifications/all").To(p.AllNotifications).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all notifications"))
+		Doc("List all notifications").
+		Do(Paginated, Sorted).
+		Operation("AllNotifications").
+		Writes(static_proto.AllNotificationsResponse{}))
 
 	ws.Route(ws.POST("/notification").To(p.CreateNotification).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a notification"))
+		Doc("Create or update a notification").
+		Operation("CreateNotification").
+		Reads(static_proto.CreateNotificationRequest{}).
+		Writes(static_proto.CreateNotificationResponse{}))
 
 	ws.Route(ws.GET("/notification/{notification_id}").To(p.ReadNotification).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a notification"))
+		Doc("Read a notification").
+		Operation("ReadNotification").
+		Writes(static_proto.ReadNotificationResponse{}))
 
 	ws.Route(ws.DELETE("/notification/{notification_id}").To(p.DeleteNotification).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a notification"))
+		Doc("Delete a notification").
+		Operation("DeleteNotification").
+		Writes(static_proto.DeleteNotificationResponse{}))
 
 	ws.Route(ws.GET("/trackerMethods/all").To(p.AllTrackerMethods).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all trackerMethods"))
+		Doc("List all trackerMethods").
+		Do(Paginated, Sorted).
+		Operation("AllTrackerMethods").
+		Writes(static_proto.AllTrackerMethodsResponse{}))
 
 	ws.Route(ws.POST("/trackerMethod").To(p.CreateTrackerMethod).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a trackerMethod"))
+		Doc("Create or update a trackerMethod").
+		Operation("CreateTrackerMethod").
+		Reads(static_proto.CreateTrackerMethodRequest{}).
+		Writes(static_proto.CreateTrackerMethodResponse{}))
 
 	ws.Route(ws.GET("/trackerMethod/{trackerMethod_id}").To(p.ReadTrackerMethod).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a trackerMethod"))
+		Doc("Read a trackerMethod").
+		Operation("ReadTrackerMethod").
+		Writes(static_proto.ReadTrackerMethodResponse{}))
 
 	ws.Route(ws.DELETE("/trackerMethod/{trackerMethod_id}").To(p.DeleteTrackerMethod).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a trackerMethod"))
+		Doc("Delete a trackerMethod").
+		Operation("DeleteTrackerMethod").
+		Writes(static_proto.DeleteTrackerMethodResponse{}))
 
 	ws.Route(ws.POST("/trackerMethod/filter").To(p.FilterTrackerMethod).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter trackerMethod"))
+		Doc("Filter trackerMethod").
+		Do(Paginated, Sorted).
+		Operation("FilterTrackerMethod").
+		Reads(static_proto.FilterTrackerMethodRequest{}).
+		Writes(static_proto.FilterTrackerMethodResponse{}))
 
 	ws.Route(ws.GET("/behaviourCategoryAims/all").To(p.AllBehaviourCategoryAims).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all behaviourCategoryAims"))
+		Doc("List all behaviourCategoryAims").
+		Do(Paginated, Sorted).
+		Operation("AllBehaviourCategoryAims").
+		Writes(static_proto.AllBehaviourCategoryAimsResponse{}))
 
 	ws.Route(ws.POST("/behaviourCategoryAim").To(p.CreateBehaviourCategoryAim).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a behaviourCategoryAim"))
+		Doc("Create or update a behaviourCategoryAim").
+		Operation("CreateBehaviourCategoryAim").
+		Reads(static_proto.CreateBehaviourCategoryAimRequest{}).
+		Writes(static_proto.CreateBehaviourCategoryAimResponse{}))
 
 	ws.Route(ws.GET("/behaviourCategoryAim/{behaviourCategoryAim_id}").To(p.ReadBehaviourCategoryAim).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a behaviourCategoryAim"))
+		Doc("Read a behaviourCategoryAim").
+		Operation("ReadBehaviourCategoryAim").
+		Writes(static_proto.ReadBehaviourCategoryAimResponse{}))
 
 	ws.Route(ws.DELETE("/behaviourCategoryAim/{behaviourCategoryAim_id}").To(p.DeleteBehaviourCategoryAim).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a behaviourCategoryAim"))
+		Doc("Delete a behaviourCategoryAim").
+		Operation("DeleteBehaviourCategoryAim").
+		Writes(static_proto.DeleteBehaviourCategoryAimResponse{}))
 
 	ws.Route(ws.GET("/content/category/parents/all").To(p.AllContentParentCategories).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all contentParentCategories"))
+		Doc("List all contentParentCategories").
+		Do(Paginated, Sorted).
+		Operation("AllContentParentCategories").
+		Writes(static_proto.AllContentParentCategoriesResponse{}))
 
 	ws.Route(ws.POST("/content/category/parent").To(p.CreateContentParentCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a contentParentCategory"))
+		Doc("Create or update a contentParentCategory").
+		Operation("CreateContentParentCategory").
+		Reads(static_proto.CreateContentParentCategoryRequest{}).
+		Writes(static_proto.CreateContentParentCategoryResponse{}))
 
 	ws.Route(ws.GET("/content/category/parent/{contentParentCategory_id}").To(p.ReadContentParentCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a contentParentCategory"))
+		Doc("Read a contentParentCategory").
+		Operation("ReadContentParentCategory").
+		Writes(static_proto.ReadContentParentCategoryResponse{}))
 
 	ws.Route(ws.DELETE("/content/category/parent/{contentParentCategory_id}").To(p.DeleteContentParentCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a contentParentCategory"))
+		Doc("Delete a contentParentCategory").
+		Operation("DeleteContentParentCategory").
+		Writes(static_proto.DeleteContentParentCategoryResponse{}))
 
 	ws.Route(ws.GET("/content/categorys/all").To(p.AllContentCategories).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all contentCategories"))
+		Doc("List all contentCategories").
+		Do(Paginated, Sorted).
+		Operation("AllContentCategories").
+		Writes(static_proto.AllContentCategoriesResponse{}))
 
 	ws.Route(ws.POST("/content/category").To(p.CreateContentCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a contentCategory"))
+		Doc("Create or update a contentCategory").
+		Operation("CreateContentCategory").
+		Reads(static_proto.CreateContentCategoryRequest{}).
+		Writes(static_proto.CreateContentCategoryResponse{}))
 
 	ws.Route(ws.GET("/content/category/{contentCategory_id}").To(p.ReadContentCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a contentCategory"))
+		Doc("Read a contentCategory").
+		Operation("ReadContentCategory").
+		Writes(static_proto.ReadContentCategoryResponse{}))
 
 	ws.Route(ws.DELETE("/content/category/{contentCategory_id}").To(p.DeleteContentCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a contentCategory"))
+		Doc("Delete a contentCategory").
+		Operation("DeleteContentCategory").
+		Writes(static_proto.DeleteContentCategoryResponse{}))
 
 	ws.Route(ws.GET("/content/types/all").To(p.AllContentTypes).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all contentTypes"))
+		Doc("List all contentTypes").
+		Do(Paginated, Sorted).
+		Operation("AllContentTypes").
+		Writes(static_proto.AllContentTypesResponse{}))
 
 	ws.Route(ws.POST("/content/type").To(p.CreateContentType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a contentType"))
+		Doc("Create or update a contentType").
+		Operation("CreateContentType").
+		Reads(static_proto.CreateContentTypeRequest{}).
+		Writes(static_proto.CreateContentTypeResponse{}))
 
 	ws.Route(ws.GET("/content/type/{contentType_id}").To(p.ReadContentType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a contentType"))
+		Doc("Read a contentType").
+		Operation("ReadContentType").
+		Writes(static_proto.ReadContentTypeResponse{}))
 
 	ws.Route(ws.DELETE("/content/type/{contentType_id}").To(p.DeleteContentType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a contentType"))
+		Doc("Delete a contentType").
+		Operation("DeleteContentType").
+		Writes(static_proto.DeleteContentTypeResponse{}))
 
 	ws.Route(ws.GET("/content/source/types/all").To(p.AllContentSourceTypes).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all contentSourceTypes"))
+		Doc("List all contentSourceTypes").
+		Do(Paginated, Sorted).
+		Operation("AllContentSourceTypes").
+		Writes(static_proto.AllContentSourceTypesResponse{}))
 
 	ws.Route(ws.POST("/content/source/type").To(p.CreateContentSourceType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a contentSourceType"))
+		Doc("Create or update a contentSourceType").
+		Operation("CreateContentSourceType").
+		Reads(static_proto.CreateContentSourceTypeRequest{}).
+		Writes(static_proto.CreateContentSourceTypeResponse{}))
 
 	ws.Route(ws.GET("/content/source/type/{contentSourceType_id}").To(p.ReadContentSourceType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a contentSourceType"))
+		Doc("Read a contentSourceType").
+		Operation("ReadContentSourceType").
+		Writes(static_proto.ReadContentSourceTypeResponse{}))
 
 	ws.Route(ws.DELETE("/content/source/type/{contentSourceType_id}").To(p.DeleteContentSourceType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
-		Doc("Delete a contentSourceType"))
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Delete a contentSourceType").
+		Operation("DeleteContentSourceType").
+		Writes(static_proto.DeleteContentSourceTypeResponse{}))
 
 	ws.Route(ws.GET("/module/triggers/all").To(p.AllModuleTriggers).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all moduleTriggers"))
+		Doc("List all moduleTriggers").
+		Do(Paginated, Sorted).
+		Operation("AllModuleTriggers").
+		Writes(static_proto.AllModuleTriggersResponse{}))
 
 	ws.Route(ws.POST("/module/trigger").To(p.CreateModuleTrigger).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a moduleTrigger"))
+		Doc("Create or update a moduleTrigger").
+		Operation("CreateModuleTrigger").
+		Reads(static_proto.CreateModuleTriggerRequest{}).
+		Writes(static_proto.CreateModuleTriggerResponse{}))
 
 	ws.Route(ws.GET("/module/trigger/{moduleTrigger_id}").To(p.ReadModuleTrigger).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a moduleTrigger"))
+		Doc("Read a moduleTrigger").
+		Operation("ReadModuleTrigger").
+		Writes(static_proto.ReadModuleTriggerResponse{}))
 
 	ws.Route(ws.DELETE("/module/trigger/{moduleTrigger_id}").To(p.DeleteModuleTrigger).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a moduleTrigger"))
+		Doc("Delete a moduleTrigger").
+		Operation("DeleteModuleTrigger").
+		Writes(static_proto.DeleteModuleTriggerResponse{}))
 
 	ws.Route(ws.POST("/module/triggers/filter").To(p.FilterModuleTrigger).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter marker"))
+		Doc("Filter marker").
+		Do(Paginated, Sorted).
+		Operation("FilterModuleTrigger").
+		Reads(static_proto.FilterModuleTriggerRequest{}).
+		Writes(static_proto.FilterModuleTriggerResponse{}))
 
 	ws.Route(ws.GET("/trigger/content/types/all").To(p.AllTriggerContentTypes).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all triggerContentTypes"))
+		Doc("List all triggerContentTypes").
+		Do(Paginated, Sorted).
+		Operation("AllTriggerContentTypes").
+		Writes(static_proto.AllTriggerContentTypesResponse{}))
 
 	ws.Route(ws.POST("/trigger/content/type").To(p.CreateTriggerContentType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a triggerContentType"))
+		Doc("Create or update a triggerContentType").
+		Operation("CreateTriggerContentType").
+		Reads(static_proto.CreateTriggerContentTypeRequest{}).
+		Writes(static_proto.CreateTriggerContentTypeResponse{}))
 
 	ws.Route(ws.GET("/trigger/content/type/{triggerContentType_id}").To(p.ReadTriggerContentType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a triggerContentType"))
+		Doc("Read a triggerContentType").
+		Operation("ReadTriggerContentType").
+		Writes(static_proto.ReadTriggerContentTypeResponse{}))
 
 	ws.Route(ws.DELETE("/trigger/content/type/{triggerContentType_id}").To(p.DeleteTriggerContentType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a triggerContentType"))
+		Doc("Delete a triggerContentType").
+		Operation("DeleteTriggerContentType").
+		Writes(static_proto.DeleteTriggerContentTypeResponse{}))
 
 	ws.Route(ws.POST("/trigger/content/types/filter").To(p.FilterTriggerContentType).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter marker"))
+		Doc("Filter marker").
+		Do(Paginated, Sorted).
+		Operation("FilterTriggerContentType").
+		Reads(static_proto.FilterTriggerContentTypeRequest{}).
+		Writes(static_proto.FilterTriggerContentTypeResponse{}))
 
 	ws.Route(ws.GET("/setbacks/all").To(p.AllSetbacks).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all setbacks"))
+		Doc("List all setbacks").
+		Do(Paginated, Sorted).
+		Operation("AllSetbacks").
+		Writes(static_proto.AllSetbacksResponse{}))
 
 	ws.Route(ws.POST("/setback").To(p.CreateSetback).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create or update a setback"))
+		Doc("Create or update a setback").
+		Operation("CreateSetback").
+		Reads(static_proto.CreateSetbackRequest{}).
+		Writes(static_proto.CreateSetbackResponse{}))
 
 	ws.Route(ws.GET("/setback/{setback_id}").To(p.ReadSetback).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read a setback"))
+		Doc("Read a setback").
+		Operation("ReadSetback").
+		Writes(static_proto.ReadSetbackResponse{}))
 
 	ws.Route(ws.DELETE("/setback/{setback_id}").To(p.DeleteSetback).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete a setback"))
+		Doc("Delete a setback").
+		Operation("DeleteSetback").
+		Writes(static_proto.DeleteSetbackResponse{}))
 
 	ws.Route(ws.POST("/setback/search/autocomplete").To(p.AutocompleteSetbackSearch).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Doc("Search autocomplete setback").
+		Operation("AutocompleteSetbackSearch").
+		Reads(static_proto.AutocompleteSetbackSearchRequest{}).
+		Writes(static_proto.AllSetbacksResponse{}))
 
-	ws.Route(ws.POST("/setback/search/autocomplete").To(p.AutocompleteSetbackSearch).
+	ws.Route(ws.POST("/behaviourCategoryAim/upload").To(p.UploadBehaviourCategoryAim).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Doc("Upload behaviour category aim").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadBehaviourCategoryAim").
+		Writes(static_proto.UploadResponse{}))
 
-	ws.Route(ws.POST("/setback/search/autocomplete").To(p.AutocompleteSetbackSearch).
+	ws.Route(ws.POST("/content/category/upload").To(p.UploadContentCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Doc("Search autocomplete setback").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadContentCategory").
+		Writes(static_proto.UploadResponse{}))
 
-	ws.Route(ws.POST("/setback/search/autocomplete").To(p.AutocompleteSetbackSearch).
+	ws.Route(ws.POST("/marker/upload").To(p.UploadMarker).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Doc("Search autocomplete setback").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadMarker").
+		Writes(static_proto.UploadResponse{}))
 
-	ws.Route(ws.POST("/setback/search/autocomplete").To(p.AutocompleteSetbackSearch).
+	ws.Route(ws.POST("/behaviour/category/upload").To(p.UploadBehaviourCategory).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Doc("Search autocomplete setback").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadBehaviourCategory").
+		Writes(static_proto.UploadResponse{}))
 
-	ws.Route(ws.POST("/behaviourCategoryAim/upload").To(p.UploadBehaviourCategoryAim).
+	ws.Route(ws.POST("/content/category/item/upload").To(p.UploadContentCategoryItem).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Upload behaviour category aim"))
+		Doc("Search autocomplete setback").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadContentCategoryItem").
+		Writes(static_proto.UploadResponse{}))
 
-	ws.Route(ws.POST("/content/category/upload").To(p.UploadContentCategory).
+	ws.Route(ws.POST("/trackerMethod/upload").To(p.UploadTrackerMethod).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Doc("Search autocomplete setback").
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("UploadTrackerMethod").
+		Writes(static_proto.UploadResponse{}))
 
-	ws.Route(ws.POST("/marker/upload").To(p.UploadMarker).
+	ws.Route(ws.GET("/trash").To(p.Trash).
 		Filter(p.Auth.BasicAuthenticate).
-		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
-		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
-
-	ws.Route(ws.POST("/behaviour/category/upload").To(p.UploadBehaviourCategory).
-		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
-		Filter(p.Auth.OrganisationAuthenticate).
-		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("List deleted static records").
+		Do(Paginated).
+		Operation("StaticTrash").
+		Writes(static_proto.TrashResponse{}))
 
-	ws.Route(ws.POST("/content/category/item/upload").To(p.UploadContentCategoryItem).
+	ws.Route(ws.POST("/trash/{collection}/{id}/restore").To(p.Restore).
 		Filter(p.Auth.BasicAuthenticate).
-		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
-		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
-
-	ws.Route(ws.POST("/trackerMethod/upload").To(p.UploadTrackerMethod).
-		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
-		Filter(p.Auth.OrganisationAuthenticate).
-		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete setback"))
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Restore a deleted record").
+		Operation("StaticRestore").
+		Writes(static_proto.RestoreResponse{}))
 
 	restful.Add(ws)
 }
@@ -991,6 +1381,7 @@ func (p *StaticService) DeleteApp(req *restful.Request, rsp *restful.Response) {
 	req_app := new(static_proto.DeleteAppRequest)
 	req_app.Id = req.PathParameter("app_id")
 	req_app.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_app.UserId = req.Attribute(UserIdAttrName).(string)
 	req_app.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1277,6 +1668,7 @@ func (p *StaticService) DeletePlatform(req *restful.Request, rsp *restful.Respon
 	req_platform := new(static_proto.DeletePlatformRequest)
 	req_platform.Id = req.PathParameter("platform_id")
 	req_platform.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_platform.UserId = req.Attribute(UserIdAttrName).(string)
 	req_platform.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1583,6 +1975,7 @@ func (p *StaticService) DeleteWearable(req *restful.Request, rsp *restful.Respon
 	req_wearable := new(static_proto.DeleteWearableRequest)
 	req_wearable.Id = req.PathParameter("wearable_id")
 	req_wearable.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_wearable.UserId = req.Attribute(UserIdAttrName).(string)
 	req_wearable.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -1889,6 +2282,7 @@ func (p *StaticService) DeleteDevice(req *restful.Request, rsp *restful.Response
 	req_device := new(static_proto.DeleteDeviceRequest)
 	req_device.Id = req.PathParameter("device_id")
 	req_device.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_device.UserId = req.Attribute(UserIdAttrName).(string)
 	req_device.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -2303,6 +2697,7 @@ func (p *StaticService) DeleteMarker(req *restful.Request, rsp *restful.Response
 	req_marker := new(static_proto.DeleteMarkerRequest)
 	req_marker.Id = req.PathParameter("marker_id")
 	req_marker.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_marker.UserId = req.Attribute(UserIdAttrName).(string)
 	req_marker.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -2605,6 +3000,7 @@ func (p *StaticService) DeleteModule(req *restful.Request, rsp *restful.Response
 	req_module := new(static_proto.DeleteModuleRequest)
 	req_module.Id = req.PathParameter("module_id")
 	req_module.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_module.UserId = req.Attribute(UserIdAttrName).(string)
 	req_module.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -2922,6 +3318,7 @@ func (p *StaticService) DeleteBehaviourCategory(req *restful.Request, rsp *restf
 	req_behaviourcategory := new(static_proto.DeleteBehaviourCategoryRequest)
 	req_behaviourcategory.Id = req.PathParameter("behaviourcategory_id")
 	req_behaviourcategory.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_behaviourcategory.UserId = req.Attribute(UserIdAttrName).(string)
 	req_behaviourcategory.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -3307,6 +3704,7 @@ func (p *StaticService) DeleteSocialType(req *restful.Request, rsp *restful.Resp
 	req_socialType := new(static_proto.DeleteSocialTypeRequest)
 	req_socialType.Id = req.PathParameter("socialType_id")
 	req_socialType.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_socialType.UserId = req.Attribute(UserIdAttrName).(string)
 	req_socialType.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -3627,6 +4025,7 @@ func (p *StaticService) DeleteNotification(req *restful.Request, rsp *restful.Re
 	req_notification := new(static_proto.DeleteNotificationRequest)
 	req_notification.Id = req.PathParameter("notification_id")
 	req_notification.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_notification.UserId = req.Attribute(UserIdAttrName).(string)
 	req_notification.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -3917,6 +4316,7 @@ func (p *StaticService) DeleteTrackerMethod(req *restful.Request, rsp *restful.R
 	req_trackerMethod := new(static_proto.DeleteTrackerMethodRequest)
 	req_trackerMethod.Id = req.PathParameter("trackerMethod_id")
 	req_trackerMethod.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_trackerMethod.UserId = req.Attribute(UserIdAttrName).(string)
 	req_trackerMethod.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -4298,6 +4698,7 @@ func (p *StaticService) DeleteBehaviourCategoryAim(req *restful.Request, rsp *re
 	req_behaviourCategoryAim := new(static_proto.DeleteBehaviourCategoryAimRequest)
 	req_behaviourCategoryAim.Id = req.PathParameter("behaviourCategoryAim_id")
 	req_behaviourCategoryAim.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_behaviourCategoryAim.UserId = req.Attribute(UserIdAttrName).(string)
 	req_behaviourCategoryAim.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -4600,6 +5001,7 @@ func (p *StaticService) DeleteContentParentCategory(req *restful.Request, rsp *r
 	req_contentParentCategory := new(static_proto.DeleteContentParentCategoryRequest)
 	req_contentParentCategory.Id = req.PathParameter("contentParentCategory_id")
 	req_contentParentCategory.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_contentParentCategory.UserId = req.Attribute(UserIdAttrName).(string)
 	req_contentParentCategory.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -4909,6 +5311,7 @@ func (p *StaticService) DeleteContentCategory(req *restful.Request, rsp *restful
 	req_contentCategory := new(static_proto.DeleteContentCategoryRequest)
 	req_contentCategory.Id = req.PathParameter("contentCategory_id")
 	req_contentCategory.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_contentCategory.UserId = req.Attribute(UserIdAttrName).(string)
 	req_contentCategory.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -5203,6 +5606,7 @@ func (p *StaticService) DeleteContentType(req *restful.Request, rsp *restful.Res
 	req_contentType := new(static_proto.DeleteContentTypeRequest)
 	req_contentType.Id = req.PathParameter("contentType_id")
 	req_contentType.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_contentType.UserId = req.Attribute(UserIdAttrName).(string)
 	req_contentType.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -5488,6 +5892,7 @@ func (p *StaticService) DeleteContentSourceType(req *restful.Request, rsp *restf
 	req_contentSourceType := new(static_proto.DeleteContentSourceTypeRequest)
 	req_contentSourceType.Id = req.PathParameter("contentSourceType_id")
 	req_contentSourceType.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_contentSourceType.UserId = req.Attribute(UserIdAttrName).(string)
 	req_contentSourceType.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -5816,6 +6221,7 @@ func (p *StaticService) DeleteModuleTrigger(req *restful.Request, rsp *restful.R
 	req_moduleTrigger := new(static_proto.DeleteModuleTriggerRequest)
 	req_moduleTrigger.Id = req.PathParameter("moduleTrigger_id")
 	req_moduleTrigger.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_moduleTrigger.UserId = req.Attribute(UserIdAttrName).(string)
 	req_moduleTrigger.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -6212,6 +6618,7 @@ func (p *StaticService) DeleteTriggerContentType(req *restful.Request, rsp *rest
 	req_triggerContentType := new(static_proto.DeleteTriggerContentTypeRequest)
 	req_triggerContentType.Id = req.PathParameter("triggerContentType_id")
 	req_triggerContentType.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_triggerContentType.UserId = req.Attribute(UserIdAttrName).(string)
 	req_triggerContentType.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -6594,6 +7001,7 @@ func (p *StaticService) DeleteSetback(req *restful.Request, rsp *restful.Respons
 	req_setback := new(static_proto.DeleteSetbackRequest)
 	req_setback.Id = req.PathParameter("setback_id")
 	req_setback.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_setback.UserId = req.Attribute(UserIdAttrName).(string)
 	req_setback.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -7090,3 +7498,139 @@ func (p *StaticService) UploadTrackerMethod(req *restful.Request, rsp *restful.R
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
 }
+
+/**
+* @api {get} /server/static/trash?session={session_id}&collection={collection}&offset={offset}&limit={limit} List deleted records
+* @apiVersion 0.1.0
+* @apiName Trash
+* @apiGroup Static
+*
+* @apiDescription List the deleted static records of the organisation, the latest deleted first. They are restored until
+* they are purged after the trash retention of the organisation setting. The collection parameter can be repeated,
+* the records of every collection are listed if it's not set.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/static/trash?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="&collection=app
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "items": [
+*       {
+*         "id": "111",
+*         "collection": "app",
+*         "name": "name",
+*         "org_id": "orgid",
+*         "created": 1517891917,
+*         "deleted_at": 1517991917,
+*         "deleted_by": "userid"
+*       },
+*       ... ...
+*     ]
+*   },
+*   "code": 200,
+*   "message": "Read trash successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError BadRequest   	The collection is invalid.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 400 Bad Request
+*     {
+*       "code": 400,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.static.Trash",
+*           "reason": "{\"id\":\"go.micro.srv.static\",\"code\":400,\"detail\":\"collection is invalid\",\"status\":\"Bad Request\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *StaticService) Trash(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Static.Trash API request")
+	req_trash := new(static_proto.TrashRequest)
+	req_trash.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_trash.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_trash.Collections = req.Request.URL.Query()["collection"]
+	req_trash.Limit = req.Attribute(PaginateLimitParameter).(int64)
+	req_trash.Offset = req.Attribute(PaginateOffsetParameter).(int64)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.StaticClient.Trash(ctx, req_trash)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.static.Trash", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read trash successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {post} /server/static/trash/{collection}/{id}/restore?session={session_id} Restore a deleted record
+* @apiVersion 0.1.0
+* @apiName Restore
+* @apiGroup Static
+*
+* @apiDescription Restore a deleted record with the links it had when it was deleted
+*
+* @apiExample Example usage:
+* curl -i -X POST http://BASE_SERVER_URL/server/static/trash/app/111/restore?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "item": {
+*       "id": "111",
+*       "collection": "app",
+*       "name": "name",
+*       "org_id": "orgid",
+*       "created": 1517891917
+*     }
+*   },
+*   "code": 200,
+*   "message": "Restored successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The record isn't in the trash.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "RestoreError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.static.Restore",
+*           "reason": "{\"id\":\"go.micro.srv.db.DB.Restore\",\"code\":404,\"detail\":\"not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *StaticService) Restore(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Static.Restore API request")
+	req_restore := new(static_proto.RestoreRequest)
+	req_restore.Id = req.PathParameter("id")
+	req_restore.Collection = req.PathParameter("collection")
+	req_restore.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_restore.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.StaticClient.Restore(ctx, req_restore)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.static.Restore", "RestoreError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Restored successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
@@ -28,6 +28,7 @@ func (p SurveyService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/surveys")
+	ws.Doc("Surveys")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.SurveySrv,
@@ -38,132 +39,267 @@ func (p SurveyService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all surveys"))
+		Doc("List all surveys").
+		Do(Paginated, Sorted).
+		Operation("AllSurveys").
+		Writes(survey_proto.AllResponse{}))
 
 	ws.Route(ws.GET("/new").To(p.NewSurvey).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create unique id for new survey"))
+		Doc("Create unique id for new survey").
+		Operation("NewSurvey").
+		Writes(survey_proto.NewResponse{}))
 
 	ws.Route(ws.POST("/survey/create").To(p.CreateSurvey).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("create data"))
+		Doc("create data").
+		Operation("CreateSurvey").
+		Reads(survey_proto.CreateRequest{}).
+		Writes(survey_proto.CreateResponse{}))
 
 	ws.Route(ws.GET("/survey/{survey_id}").To(p.ReadSurvey).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Survey detail"))
+		Doc("View Survey detail").
+		Operation("ReadSurvey").
+		Writes(survey_proto.ReadResponse{}))
 
 	ws.Route(ws.DELETE("/survey/{survey_id}").To(p.DeleteSurvey).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Survey detail"))
+		Doc("View Survey detail").
+		Operation("DeleteSurvey").
+		Writes(survey_proto.DeleteResponse{}))
 
 	ws.Route(ws.POST("/survey/copy").To(p.CopySurvey).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Survey detail"))
+		Doc("View Survey detail").
+		Operation("CopySurvey").
+		Reads(survey_proto.CopyRequest{}).
+		Writes(survey_proto.CopyResponse{}))
 
 	ws.Route(ws.GET("/survey/{survey_id}/questions").To(p.Questions).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get survey questions"))
+		Doc("Get survey questions").
+		Do(Paginated, Sorted).
+		Operation("Questions").
+		Writes(survey_proto.QuestionsResponse{}))
 
 	ws.Route(ws.GET("/survey/{survey_id}/questions/{question_id}").To(p.QuestionRef).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get survey question by question_id "))
+		Doc("Get survey question by question_id ").
+		Operation("QuestionRef").
+		Writes(survey_proto.QuestionRefResponse{}))
 
 	ws.Route(ws.POST("/survey/{survey_id}/questions").To(p.CreateQuestion).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Add or Update a question in the survey - Accepts a new list of questions for a survey"))
+		Doc("Add or Update a question in the survey - Accepts a new list of questions for a survey").
+		Operation("CreateQuestion").
+		Reads(survey_proto.CreateQuestionRequest{}).
+		Writes(survey_proto.CreateQuestionResponse{}))
 
 	ws.Route(ws.GET("/creator/{user_id}").To(p.ByCreator).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all surveys created by a particular team member"))
+		Doc("Get all surveys created by a particular team member").
+		Do(Paginated, Sorted).
+		Operation("SurveyByCreator").
+		Writes(survey_proto.ByCreatorResponse{}))
 
 	ws.Route(ws.GET("/survey/{survey_id}/link").To(p.Link).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all surveys created by a particular team member"))
+		Doc("Get all surveys created by a particular team member").
+		Operation("Link").
+		Writes(survey_proto.LinkResponse{}))
 
 	ws.Route(ws.GET("/templates").To(p.Templates).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all surveys created by a particular team member"))
+		Doc("Get all surveys created by a particular team member").
+		Do(Paginated, Sorted).
+		Operation("SurveyTemplates").
+		Writes(survey_proto.TemplatesResponse{}))
 
 	ws.Route(ws.POST("/filter").To(p.Filter).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all surveys created by a particular team member"))
+		Doc("Get all surveys created by a particular team member").
+		Do(Paginated, Sorted).
+		Operation("SurveyFilter").
+		Reads(survey_proto.FilterRequest{}).
+		Writes(survey_proto.FilterResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.SearchSurveys).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all surveys"))
+		Doc("List all surveys").
+		Do(Paginated, Sorted).
+		Operation("SearchSurveys").
+		Reads(survey_proto.SearchRequest{}).
+		Writes(survey_proto.SearchResponse{}))
 
 	ws.Route(ws.POST("/survey/search/autocomplete").To(p.AutocompleteSurveySearch).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search autocomplete survey text"))
+		Doc("Search autocomplete survey text").
+		Operation("AutocompleteSurveySearch").
+		Reads(survey_proto.AutocompleteSearchRequest{}).
+		Writes(survey_proto.AutocompleteSearchResponse{}))
 
 	ws.Route(ws.GET("/tags/top/{n}").To(p.GetTopSurveyTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return top N tags for Survey"))
+		Doc("Return top N tags for Survey").
+		Operation("GetTopSurveyTags").
+		Writes(survey_proto.GetTopTagsResponse{}))
 
 	ws.Route(ws.POST("/tags/autocomplete").To(p.AutocompleteSurveyTags).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete for tags for Survey"))
+		Doc("Autocomplete for tags for Survey").
+		Operation("AutocompleteSurveyTags").
+		Reads(survey_proto.AutocompleteTagsRequest{}).
+		Writes(survey_proto.AutocompleteTagsResponse{}))
+
+	ws.Route(ws.GET("/trash").To(p.Trash).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("List deleted surveys").
+		Do(Paginated).
+		Operation("SurveyTrash").
+		Writes(survey_proto.TrashResponse{}))
+
+	ws.Route(ws.POST("/trash/{collection}/{id}/restore").To(p.Restore).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Restore a deleted record").
+		Operation("SurveyRestore").
+		Writes(survey_proto.RestoreResponse{}))
+
+	ws.Route(ws.GET("/survey/{survey_id}/versions").To(p.ListVersions).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("List the versions of a survey").
+		Do(Paginated).
+		Operation("SurveyListVersions").
+		Writes(survey_proto.ListVersionsResponse{}))
+
+	ws.Route(ws.GET("/survey/{survey_id}/versions/{version}").To(p.ReadVersion).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Read a survey as it was at a version").
+		Operation("SurveyReadVersion").
+		Writes(survey_proto.ReadVersionResponse{}))
+
+	ws.Route(ws.POST("/survey/{survey_id}/versions/{version}/revert").To(p.RevertToVersion).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.EmployeeAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Doc("Revert a survey to a version").
+		Operation("SurveyRevertToVersion").
+		Writes(survey_proto.RevertToVersionResponse{}))
 
 	restful.Add(ws)
 }
@@ -688,6 +824,7 @@ func (p *SurveyService) DeleteSurvey(req *restful.Request, rsp *restful.Response
 	req_survey := new(survey_proto.DeleteRequest)
 	req_survey.Id = req.PathParameter("survey_id")
 	req_survey.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_survey.UserId = req.Attribute(UserIdAttrName).(string)
 	req_survey.TeamId = req.Attribute(TeamIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
@@ -787,6 +924,7 @@ func (p *SurveyService) CopySurvey(req *restful.Request, rsp *restful.Response)
 	}
 	req_survey.OrgId = req.Attribute(OrgIdAttrName).(string)
 	req_survey.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_survey.UserId = req.Attribute(UserIdAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
 	copy_resp, err := p.SurveyClient.Copy(ctx, req_survey)
@@ -1693,3 +1831,366 @@ func (p *SurveyService) AutocompleteSurveyTags(req *restful.Request, rsp *restfu
 	rsp.AddHeader("Survey-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
 }
+
+/**
+* @api {get} /server/surveys/trash?session={session_id}&collection={collection}&offset={offset}&limit={limit} List deleted records
+* @apiVersion 0.1.0
+* @apiName Trash
+* @apiGroup Survey
+*
+* @apiDescription List the deleted surveys of the organisation, the latest deleted first. They are restored until
+* they are purged after the trash retention of the organisation setting. The collection parameter can be repeated,
+* the records of every collection are listed if it's not set.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/surveys/trash?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="&collection=survey
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "items": [
+*       {
+*         "id": "111",
+*         "collection": "survey",
+*         "name": "name",
+*         "org_id": "orgid",
+*         "created": 1517891917,
+*         "deleted_at": 1517991917,
+*         "deleted_by": "userid"
+*       },
+*       ... ...
+*     ]
+*   },
+*   "code": 200,
+*   "message": "Read trash successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError BadRequest   	The collection is invalid.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 400 Bad Request
+*     {
+*       "code": 400,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.survey.Trash",
+*           "reason": "{\"id\":\"go.micro.srv.survey\",\"code\":400,\"detail\":\"collection is invalid\",\"status\":\"Bad Request\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *SurveyService) Trash(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Survey.Trash API request")
+	req_trash := new(survey_proto.TrashRequest)
+	req_trash.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_trash.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_trash.Collections = req.Request.URL.Query()["collection"]
+	req_trash.Limit = req.Attribute(PaginateLimitParameter).(int64)
+	req_trash.Offset = req.Attribute(PaginateOffsetParameter).(int64)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.SurveyClient.Trash(ctx, req_trash)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.survey.Trash", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read trash successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {post} /server/surveys/trash/{collection}/{id}/restore?session={session_id} Restore a deleted record
+* @apiVersion 0.1.0
+* @apiName Restore
+* @apiGroup Survey
+*
+* @apiDescription Restore a deleted record with the links it had when it was deleted
+*
+* @apiExample Example usage:
+* curl -i -X POST http://BASE_SERVER_URL/server/surveys/trash/survey/111/restore?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "item": {
+*       "id": "111",
+*       "collection": "survey",
+*       "name": "name",
+*       "org_id": "orgid",
+*       "created": 1517891917
+*     }
+*   },
+*   "code": 200,
+*   "message": "Restored successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The record isn't in the trash.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "RestoreError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.survey.Restore",
+*           "reason": "{\"id\":\"go.micro.srv.db.DB.Restore\",\"code\":404,\"detail\":\"not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *SurveyService) Restore(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Survey.Restore API request")
+	req_restore := new(survey_proto.RestoreRequest)
+	req_restore.Id = req.PathParameter("id")
+	req_restore.Collection = req.PathParameter("collection")
+	req_restore.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_restore.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.SurveyClient.Restore(ctx, req_restore)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.survey.Restore", "RestoreError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Restored successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {get} /server/surveys/survey/{survey_id}/versions?session={session_id}&offset={offset}&limit={limit} List the versions of a survey
+* @apiVersion 0.1.0
+* @apiName ListVersions
+* @apiGroup Survey
+*
+* @apiDescription List the versions of a survey, the latest first. A version is saved by every write changing the survey
+* with the changes since the previous version, the value of a change is json and empty if the attribute didn't exist.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/surveys/survey/111/versions?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "versions": [
+*       {
+*         "id": "survey:111:2",
+*         "collection": "survey",
+*         "document_id": "111",
+*         "version": 2,
+*         "org_id": "orgid",
+*         "author_id": "userid",
+*         "created": 1517991917,
+*         "changes": [
+*           {
+*             "path": "data.title",
+*             "old_value": "\"title\"",
+*             "new_value": "\"new title\""
+*           }
+*         ]
+*       },
+*       ... ...
+*     ]
+*   },
+*   "code": 200,
+*   "message": "Read versions successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 500 Internal Server Error
+*     {
+*       "code": 500,
+*       "message": "QueryError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.survey.ListVersions",
+*           "reason": "{\"id\":\"go.micro.srv.survey\",\"code\":500,\"detail\":\"versions error\",\"status\":\"Internal Server Error\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *SurveyService) ListVersions(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Survey.ListVersions API request")
+	req_version := new(survey_proto.ListVersionsRequest)
+	req_version.Id = req.PathParameter("survey_id")
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+	req_version.Limit = req.Attribute(PaginateLimitParameter).(int64)
+	req_version.Offset = req.Attribute(PaginateOffsetParameter).(int64)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.SurveyClient.ListVersions(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.survey.ListVersions", "QueryError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read versions successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {get} /server/surveys/survey/{survey_id}/versions/{version}?session={session_id} Read a survey at a version
+* @apiVersion 0.1.0
+* @apiName ReadVersion
+* @apiGroup Survey
+*
+* @apiDescription Read a survey as it was at a version with the changes of the version
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/surveys/survey/111/versions/1?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "version": {
+*       "id": "survey:111:1",
+*       "collection": "survey",
+*       "document_id": "111",
+*       "version": 1,
+*       "org_id": "orgid",
+*       "author_id": "userid",
+*       "created": 1517891917
+*     },
+*     "survey": {
+*       "id": "111",
+*       "title": "title",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Read version successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The version doesn't exist.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "ReadError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.survey.ReadVersion",
+*           "reason": "{\"id\":\"go.micro.srv.survey\",\"code\":404,\"detail\":\"version not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *SurveyService) ReadVersion(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Survey.ReadVersion API request")
+	req_version := new(survey_proto.ReadVersionRequest)
+	req_version.Id = req.PathParameter("survey_id")
+	req_version.Version, _ = strconv.ParseInt(req.PathParameter("version"), 10, 64)
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.SurveyClient.ReadVersion(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.survey.ReadVersion", "ReadError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Read version successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+/**
+* @api {post} /server/surveys/survey/{survey_id}/versions/{version}/revert?session={session_id} Revert a survey to a version
+* @apiVersion 0.1.0
+* @apiName RevertToVersion
+* @apiGroup Survey
+*
+* @apiDescription Revert a survey to a version, the reverted survey is saved as a new version which is returned
+*
+* @apiExample Example usage:
+* curl -i -X POST http://BASE_SERVER_URL/server/surveys/survey/111/versions/1/revert?session="qLN5aNAiway8h6pTPEEaz2YIdayRrdTMsoarQdbkulQ="
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "version": {
+*       "id": "survey:111:3",
+*       "collection": "survey",
+*       "document_id": "111",
+*       "version": 3,
+*       "org_id": "orgid",
+*       "author_id": "userid",
+*       "created": 1518091917,
+*       "changes": [
+*         {
+*           "path": "data.title",
+*           "old_value": "\"new title\"",
+*           "new_value": "\"title\""
+*         }
+*       ]
+*     },
+*     "survey": {
+*       "id": "111",
+*       "title": "title",
+*       ... ...
+*     }
+*   },
+*   "code": 200,
+*   "message": "Reverted successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError NotFound   	The version doesn't exist.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 404 Not Found
+*     {
+*       "code": 404,
+*       "message": "RevertError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.survey.RevertToVersion",
+*           "reason": "{\"id\":\"go.micro.srv.survey\",\"code\":404,\"detail\":\"version not found\",\"status\":\"Not Found\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *SurveyService) RevertToVersion(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Survey.RevertToVersion API request")
+	req_version := new(survey_proto.RevertToVersionRequest)
+	req_version.Id = req.PathParameter("survey_id")
+	req_version.Version, _ = strconv.ParseInt(req.PathParameter("version"), 10, 64)
+	req_version.UserId = req.Attribute(UserIdAttrName).(string)
+	req_version.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_version.TeamId = req.Attribute(TeamIdAttrName).(string)
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.SurveyClient.RevertToVersion(ctx, req_version)
+	if err != nil {
+		utils.WriteErrorResponseWithCode(rsp, err, "go.micro.srv.survey.RevertToVersion", "RevertError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Reverted successfully"
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, resp)
+}
@@ -28,6 +28,7 @@ func (p TaskService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/tasks")
+	ws.Doc("Tasks")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.TaskSrv,
@@ -38,74 +39,117 @@ func (p TaskService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all tasks"))
+		Doc("List all tasks").
+		Do(Paginated, Sorted).
+		Operation("AllTasks").
+		Writes(task_proto.AllResponse{}))
 
 	ws.Route(ws.POST("/task").To(p.CreateTask).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("create data"))
+		Doc("create data").
+		Operation("CreateTask").
+		Reads(task_proto.CreateRequest{}).
+		Writes(task_proto.CreateResponse{}))
 
 	ws.Route(ws.GET("/task/{task_id}").To(p.ReadTask).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Task detail"))
+		Doc("View Task detail").
+		Operation("ReadTask").
+		Writes(task_proto.ReadResponse{}))
 
 	ws.Route(ws.DELETE("/task/{task_id}").To(p.DeleteTask).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Task detail"))
+		Doc("View Task detail").
+		Operation("DeleteTask").
+		Writes(task_proto.DeleteResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.SearchTasks).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all tasks"))
+		Doc("List all tasks").
+		Do(Paginated, Sorted).
+		Operation("SearchTasks").
+		Reads(task_proto.SearchRequest{}).
+		Writes(task_proto.SearchResponse{}))
 
 	ws.Route(ws.GET("/creator/{user_id}").To(p.ByCreator).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all tasks created by a particular team member"))
+		Doc("Get all tasks created by a particular team member").
+		Do(Paginated, Sorted).
+		Operation("TaskByCreator").
+		Writes(task_proto.ByCreatorResponse{}))
 
 	ws.Route(ws.GET("/assign/{user_id}").To(p.ByAssign).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all tasks assigned to a particular team member "))
+		Doc("Get all tasks assigned to a particular team member ").
+		Do(Paginated, Sorted).
+		Operation("ByAssign").
+		Writes(task_proto.ByAssignResponse{}))
 
 	ws.Route(ws.POST("/filter").To(p.Filter).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter tasks by one or more status, one or more priority, one or more category status"))
+		Doc("Filter tasks by one or more status, one or more priority, one or more category status").
+		Do(Paginated, Sorted).
+		Operation("TaskFilter").
+		Writes(task_proto.FilterResponse{}))
 
 	ws.Route(ws.GET("/count/{user_id}").To(p.CountByUser).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Return tasks count of expired tasks, assigned to the user tasks"))
+		Doc("Return tasks count of expired tasks, assigned to the user tasks").
+		Operation("CountByUser").
+		Writes(task_proto.CountByUserResponse{}))
 
 	restful.Add(ws)
 }
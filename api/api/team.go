@@ -27,6 +27,7 @@ func (p TeamService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/teams")
+	ws.Doc("Teams")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.TeamSrv,
@@ -37,95 +38,154 @@ func (p TeamService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all teams"))
+		Doc("List all teams").
+		Do(Paginated, Sorted).
+		Operation("TeamAll").
+		Writes(team_proto.AllResponse{}))
 
 	ws.Route(ws.POST("/team").To(p.Create).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("create data"))
+		Doc("create data").
+		Operation("TeamCreate").
+		Reads(team_proto.CreateRequest{}).
+		Writes(team_proto.CreateResponse{}))
 
 	ws.Route(ws.GET("/team/{team_id}").To(p.Read).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Team detail"))
+		Doc("View Team detail").
+		Operation("Read").
+		Writes(team_proto.ReadResponse{}))
 
 	ws.Route(ws.DELETE("/team/{team_id}").To(p.Delete).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delte Team detail"))
+		Doc("Delte Team detail").
+		Operation("Delete").
+		Writes(team_proto.DeleteResponse{}))
 
 	ws.Route(ws.POST("/filter").To(p.Filter).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter teams"))
+		Doc("Filter teams").
+		Do(Paginated, Sorted).
+		Operation("TeamFilter").
+		Reads(team_proto.FilterRequest{}).
+		Writes(team_proto.FilterResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.Search).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search teams"))
+		Doc("Search teams").
+		Do(Paginated, Sorted).
+		Operation("TeamSearch").
+		Reads(team_proto.SearchRequest{}).
+		Writes(team_proto.SearchResponse{}))
 
 	ws.Route(ws.GET("/members/all").To(p.AllTeamMember).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all team members"))
+		Doc("List all team members").
+		Do(Paginated, Sorted).
+		Operation("AllTeamMember").
+		Writes(team_proto.AllTeamMemberResponse{}))
 
 	ws.Route(ws.POST("/members/member").To(p.CreateTeamMember).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create team member"))
+		Doc("Create team member").
+		Operation("CreateTeamMember").
+		Reads(team_proto.CreateTeamMemberRequest{}).
+		Writes(team_proto.CreateTeamMemberResponse{}))
 
 	ws.Route(ws.GET("/members/member/{user_id}").To(p.ReadTeamMember).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read team member"))
+		Doc("Read team member").
+		Operation("ReadTeamMember").
+		Writes(team_proto.ReadTeamMemberResponse{}))
 
 	ws.Route(ws.POST("/members/member/{user_id}/modules").To(p.CreateEmployeeModuleAccess).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Read team member"))
+		Doc("Read team member").
+		Operation("CreateEmployeeModuleAccess").
+		Reads(team_proto.CreateEmployeeModuleAccessRequest{}).
+		Writes(team_proto.CreateEmployeeModuleAccessResponse{}))
 
 	ws.Route(ws.POST("/members/filter").To(p.FilterTeamMember).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Filter team members"))
+		Doc("Filter team members").
+		Do(Paginated, Sorted).
+		Operation("FilterTeamMember").
+		Reads(team_proto.FilterTeamMemberRequest{}).
+		Writes(team_proto.FilterTeamMemberResponse{}))
 
 	ws.Route(ws.POST("/employee/{employee_id}/delete").To(p.DeleteEmployee).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete employee"))
+		Doc("Delete employee").
+		Operation("DeleteEmployee").
+		Writes(team_proto.DeleteEmployeeResponse{}))
 
 	restful.Add(ws)
 }
@@ -14,8 +14,11 @@ func (r TimestampService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/timestamp")
+	ws.Doc("Timestamp")
 	ws.Route(ws.GET("/").To(r.Timestamp).
-		Doc("Returns current server unix timestamp (since token for events)"))
+		Doc("Returns current server unix timestamp (since token for events)").
+		Operation("Timestamp").
+		Writes(map[string]int64{}))
 
 	restful.Add(ws)
 }
@@ -25,6 +25,7 @@ func (p TodoService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/todos")
+	ws.Doc("Todos")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.TodoSrv,
@@ -35,48 +36,77 @@ func (p TodoService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("List all todos"))
+		Doc("List all todos").
+		Do(Paginated, Sorted).
+		Operation("AllTodos").
+		Writes(todo_proto.AllResponse{}))
 
 	ws.Route(ws.POST("/todo").To(p.CreateTodo).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("create data"))
+		Doc("create data").
+		Operation("CreateTodo").
+		Reads(todo_proto.CreateRequest{}).
+		Writes(todo_proto.CreateResponse{}))
 
 	ws.Route(ws.GET("/todo/{todo_id}").To(p.ReadTodo).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("View Todo detail"))
+		Doc("View Todo detail").
+		Operation("ReadTodo").
+		Writes(todo_proto.ReadResponse{}))
 
 	ws.Route(ws.DELETE("/todo/{todo_id}").To(p.DeleteTodo).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Delete Todo detail"))
+		Doc("Delete Todo detail").
+		Operation("DeleteTodo").
+		Writes(todo_proto.DeleteResponse{}))
 
 	ws.Route(ws.POST("/search").To(p.SearchTodos).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Search todos"))
+		Doc("Search todos").
+		Do(Paginated, Sorted).
+		Operation("SearchTodos").
+		Reads(todo_proto.SearchRequest{}).
+		Writes(todo_proto.SearchResponse{}))
 
 	ws.Route(ws.GET("/creator/{user_id}").To(p.ByCreator).
 		Filter(p.Auth.BasicAuthenticate).
 		Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get all todos created by a particular team member"))
+		Doc("Get all todos created by a particular team member").
+		Do(Sorted).
+		Operation("TodoByCreator").
+		Writes(todo_proto.ByCreatorResponse{}))
 
 	restful.Add(ws)
 }
@@ -2,12 +2,14 @@ package api
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"server/api/utils"
 	audit_proto "server/audit-srv/proto/audit"
 	"server/common"
 	organisation_proto "server/organisation-srv/proto/organisation"
 	track_proto "server/track-srv/proto/track"
+	"strings"
 
 	"github.com/emicklei/go-restful"
 	"github.com/micro/go-os/metrics"
@@ -27,6 +29,7 @@ func (p TrackService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/track")
+	ws.Doc("Tracking")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.TrackSrv,
@@ -37,131 +40,224 @@ func (p TrackService) Register() {
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Created track goal"))
+		Doc("Created track goal").
+		Operation("CreateTrackGoal").
+		Reads(track_proto.CreateTrackGoalRequest{}).
+		Writes(track_proto.CreateTrackGoalResponse{}))
 
 	ws.Route(ws.GET("/goal/{goal_id}/count").To(p.GetGoalCount).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get goal count"))
+		Doc("Get goal count").
+		Do(Paginated).
+		Operation("GetGoalCount").
+		Writes(track_proto.GetGoalCountResponse{}))
 
 	ws.Route(ws.GET("/goal/{goal_id}/history").To(p.GetGoalHistory).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get goal history"))
+		Doc("Get goal history").
+		Do(Paginated, Sorted).
+		Operation("GetGoalHistory").
+		Writes(track_proto.GetGoalHistoryResponse{}))
 
 	ws.Route(ws.POST("/challenge/{challenge_id}").To(p.CreateTrackChallenge).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Created track challenge"))
+		Doc("Created track challenge").
+		Operation("CreateTrackChallenge").
+		Reads(track_proto.CreateTrackChallengeRequest{}).
+		Writes(track_proto.CreateTrackChallengeResponse{}))
 
 	ws.Route(ws.GET("/challenge/{challenge_id}/count").To(p.GetChallengeCount).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get challenge count"))
+		Doc("Get challenge count").
+		Do(Paginated).
+		Operation("GetChallengeCount").
+		Writes(track_proto.GetChallengeCountResponse{}))
 
 	ws.Route(ws.GET("/challenge/{challenge_id}/history").To(p.GetChallengeHistory).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get challenge history"))
+		Doc("Get challenge history").
+		Do(Paginated, Sorted).
+		Operation("GetChallengeHistory").
+		Writes(track_proto.GetChallengeHistoryResponse{}))
 
 	ws.Route(ws.POST("/habit/{habit_id}").To(p.CreateTrackHabit).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Created track habit"))
+		Doc("Created track habit").
+		Operation("CreateTrackHabit").
+		Reads(track_proto.CreateTrackHabitRequest{}).
+		Writes(track_proto.CreateTrackHabitResponse{}))
 
 	ws.Route(ws.GET("/habit/{habit_id}/count").To(p.GetHabitCount).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get habit count"))
+		Doc("Get habit count").
+		Do(Paginated).
+		Operation("GetHabitCount").
+		Writes(track_proto.GetHabitCountResponse{}))
 
 	ws.Route(ws.GET("/habit/{habit_id}/history").To(p.GetHabitHistory).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get habit history"))
+		Doc("Get habit history").
+		Do(Paginated, Sorted).
+		Operation("GetHabitHistory").
+		Writes(track_proto.GetHabitHistoryResponse{}))
 
 	ws.Route(ws.POST("/content/{content_id}").To(p.CreateTrackContent).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Created track content"))
+		Doc("Created track content").
+		Operation("CreateTrackContent").
+		Reads(track_proto.CreateTrackContentRequest{}).
+		Writes(track_proto.CreateTrackContentResponse{}))
 
 	ws.Route(ws.GET("/content/{content_id}/count").To(p.GetContentCount).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get content count"))
+		Doc("Get content count").
+		Do(Paginated).
+		Operation("GetContentCount").
+		Writes(track_proto.GetContentCountResponse{}))
 
 	ws.Route(ws.GET("/content/{content_id}/history").To(p.GetContentHistory).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Get content history"))
+		Doc("Get content history").
+		Do(Paginated, Sorted).
+		Operation("GetContentHistory").
+		Writes(track_proto.GetContentHistoryResponse{}))
 
 	ws.Route(ws.POST("/marker/{marker_id}").To(p.CreateTrackMarker).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create track marker"))
+		Doc("Create track marker").
+		Do(Paginated).
+		Operation("CreateTrackMarker").
+		Reads(track_proto.CreateTrackMarkerRequest{}).
+		Writes(track_proto.CreateTrackMarkerResponse{}))
 
 	ws.Route(ws.GET("/marker/{marker_id}").To(p.GetLastMarker).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create track marker"))
+		Doc("Create track marker").
+		Do(Paginated).
+		Operation("GetLastMarker").
+		Writes(track_proto.GetLastMarkerResponse{}))
 
 	ws.Route(ws.GET("/marker/{marker_id}/history").To(p.GetMarkerHistory).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create track marker"))
+		Doc("Create track marker").
+		Do(Paginated, Sorted).
+		Operation("GetMarkerHistory").
+		Writes(track_proto.GetMarkerHistoryResponse{}))
+
+	ws.Route(ws.GET("/marker/{marker_id}/series").To(p.GetMarkerSeries).
+		Filter(p.Auth.BasicAuthenticate).
+		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
+		Filter(p.Auth.Paginate).
+		Doc("Get marker series").
+		Do(Paginated).
+		Param(ws.QueryParameter("interval", "hour, day or week, hour by default")).
+		Operation("GetMarkerSeries").
+		Writes(track_proto.GetMarkerSeriesResponse{}))
 
 	ws.Route(ws.GET("/marker/history/all").To(p.GetAllMarkerHistory).
 		Filter(p.Auth.BasicAuthenticate).
 		// Filter(p.Auth.EmployeeAuthenticate).
 		Filter(p.Auth.OrganisationAuthenticate).
+		Filter(p.Auth.Authorize).
+		Filter(p.Auth.RateLimit).
 		Filter(p.Auth.Paginate).
 		Filter(p.Auth.SortFilter).
 		// Filter(p.Audit.Clone(audit).AuditFilter).
-		Doc("Create track marker"))
+		Doc("Create track marker").
+		Do(Paginated, Sorted).
+		Operation("GetAllMarkerHistory").
+		Writes(track_proto.GetAllMarkerHistoryResponse{}))
 	restful.Add(ws)
 }
 
@@ -1331,3 +1427,83 @@ func (p *TrackService) GetAllMarkerHistory(req *restful.Request, rsp *restful.Re
 	rsp.AddHeader("Content-Type", "application/json")
 	rsp.WriteHeaderAndEntity(http.StatusOK, data)
 }
+
+/**
+* @api {get} /server/track/marker/{marker_id}/series?session={session_id}&interval={interval}&from={from}&to={to} Get marker series
+* @apiVersion 0.1.0
+* @apiName GetMarkerSeries
+* @apiGroup Track
+*
+* @apiDescription Get the min, max, mean and count of the values tracked by the user for a marker per hour, day or week,
+* the oldest first. interval is hour (default), day or week. The intervals without values are skipped.
+*
+* @apiExample Example usage:
+* curl -i http://BASE_SERVER_URL/server/track/marker/437c32b2-9dd7-410a-8a97-162e580d8a90/series?session="zqoFHcqIPwYbP2QvdfR_W0381FAI7k2HjOh7nGzNskE="&interval=day&from=1517788800&to=1518393600
+*
+* @apiSuccessExample Success-Response:
+* HTTP/1.1 200 OK
+* {
+*   "data": {
+*     "points": [
+*       {
+*         "time": 1517788800,
+*         "min": 62,
+*         "max": 75.5,
+*         "mean": 68.25,
+*         "count": 4
+*     	},
+*     	... ...
+*     ],
+*   },
+*   "code": 200,
+*   "message": "Get marker series successfully"
+* }
+*
+* @apiError NoAuthorized 	Only authenticated users can access the data.
+* @apiError BadRequest   	The interval is invalid or the series can't be read.
+*
+* @apiErrorExample Error-Response:
+*     HTTP/1.1 400 Bad Request
+*     {
+*       "code": 400,
+*       "message": "ReadError",
+*       "errors": [
+*         {
+*           "domain": "go.micro.srv.track.GetMarkerSeries",
+*           "reason": "{\"id\":\"go.micro.client\",\"code\":500,\"detail\":\"none available\",\"status\":\"Internal Server Error\"}"
+*         }
+*       ]
+*     }
+ */
+func (p *TrackService) GetMarkerSeries(req *restful.Request, rsp *restful.Response) {
+	log.Info("Received Track.GetMarkerSeries API request")
+	req_track := new(track_proto.GetMarkerSeriesRequest)
+
+	req_track.MarkerId = req.PathParameter("marker_id")
+	req_track.UserId = req.Attribute(UserIdAttrName).(string)
+	req_track.OrgId = req.Attribute(OrgIdAttrName).(string)
+	req_track.From = req.Attribute(PaginateFromParameter).(int64)
+	req_track.To = req.Attribute(PaginateToParameter).(int64)
+	if interval := req.QueryParameter("interval"); len(interval) > 0 {
+		value, ok := track_proto.SeriesInterval_value[strings.ToUpper(interval)]
+		if !ok {
+			utils.WriteErrorResponse(rsp, errors.New("invalid interval"), "go.micro.srv.track.GetMarkerSeries", "ReadError")
+			return
+		}
+		req_track.Interval = track_proto.SeriesInterval(value)
+	}
+
+	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
+	resp, err := p.TrackClient.GetMarkerSeries(ctx, req_track)
+	if err != nil {
+		utils.WriteErrorResponse(rsp, err, "go.micro.srv.track.GetMarkerSeries", "ReadError")
+		return
+	}
+
+	resp.Code = http.StatusOK
+	resp.Message = "Get marker series successfully"
+	data := utils.MarshalAny(rsp, resp)
+
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusOK, data)
+}
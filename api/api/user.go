@@ -29,6 +29,7 @@ func (u UserService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/users")
+	ws.Doc("Users")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.UserSrv,
@@ -39,32 +40,52 @@ func (u UserService) Register() {
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all users"))
+		Doc("List all users").
+		Do(Paginated, Sorted).
+		Operation("AllUsers").
+		Writes(user_proto.AllResponse{}))
 
 	ws.Route(ws.POST("/user").To(u.CreateUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Create a user"))
+		Doc("Create a user").
+		Operation("CreateUser").
+		Reads(user_proto.CreateRequest{}).
+		Writes(user_proto.CreateResponse{}))
 
 	ws.Route(ws.POST("/user/share/multiple").To(u.ShareMultipleResources).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Share a resource/s this user"))
+		Doc("Share a resource/s this user").
+		Do(Paginated).
+		Operation("ShareMultipleResources").
+		Reads(user_proto.ShareMultipleResourcesRequest{}).
+		Writes(user_proto.ShareResourcesResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}").To(u.ReadUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Read a user"))
+		Doc("Read a user").
+		Operation("UserReadUser").
+		Writes(user_proto.ReadResponse{}))
 
 	// ws.Route(ws.POST("/filter").To(u.FilterUser).
 	// 	Filter(u.Auth.BasicAuthenticate).
@@ -77,155 +98,260 @@ func (u UserService) Register() {
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Share contents"))
+		Doc("Share contents").
+		Operation("UserShareContent").
+		Reads(content_proto.ShareContentRequest{}).
+		Writes(content_proto.ShareContentResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}/preferences").To(u.ReadUserPreference).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get user preferences"))
+		Doc("Get user preferences").
+		Operation("ReadUserPreference").
+		Writes(user_proto.ReadUserPreferenceResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}/feedback").To(u.ListUserFeedback).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List user feedback"))
+		Doc("List user feedback").
+		Operation("ListUserFeedback").
+		Writes(user_proto.ListUserFeedbackResponse{}))
 
 	ws.Route(ws.POST("/user/filter").To(u.FilterUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Filtering user by optional fields"))
+		Doc("Filtering user by optional fields").
+		Do(Paginated, Sorted).
+		Operation("FilterUser").
+		Reads(user_proto.FilterUserRequest{}).
+		Writes(user_proto.FilterUserResponse{}))
 
 	ws.Route(ws.POST("/user/search").To(u.SearchUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Search user"))
+		Doc("Search user").
+		Do(Paginated).
+		Operation("SearchUser").
+		Reads(user_proto.SearchUserRequest{}).
+		Writes(user_proto.SearchUserResponse{}))
 
 	ws.Route(ws.POST("/user/search/autocomplete").To(u.AutocompleteUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete users"))
+		Doc("Autocomplete users").
+		Do(Sorted).
+		Operation("AutocompleteUser").
+		Reads(user_proto.AutocompleteUserRequest{}).
+		Writes(user_proto.AutocompleteUserResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/account/status").To(u.SetAccountStatus).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Set account status"))
+		Doc("Set account status").
+		Operation("SetAccountStatus").
+		Reads(account_proto.SetAccountStatusRequest{}).
+		Writes(account_proto.SetAccountStatusResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}/account/status").To(u.GetAccountStatus).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Set account status"))
+		Doc("Set account status").
+		Operation("GetAccountStatus").
+		Writes(account_proto.GetAccountStatusResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/account/pass/reset").To(u.ResetUserPassword).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Reset / Update account password or passcode"))
+		Doc("Reset / Update account password or passcode").
+		Operation("ResetUserPassword").
+		Reads(account_proto.ResetUserPasswordRequest{}).
+		Writes(account_proto.ResetUserPasswordResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/measurements/measurement").To(u.AddMultipleMeasurements).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Add multiple measurements for a user"))
+		Doc("Add multiple measurements for a user").
+		Do(Paginated).
+		Operation("AddMultipleMeasurements").
+		Reads(user_proto.AddMultipleMeasurementsRequest{}).
+		Writes(user_proto.AddMultipleMeasurementsResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}/measurements/{marker_id}").To(u.GetMeasurementsHistory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get measurements history for this user for specific marker"))
+		Doc("Get measurements history for this user for specific marker").
+		Do(Paginated).
+		Operation("GetMeasurementsHistory").
+		Writes(user_proto.GetMeasurementsHistoryResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}/measurements/all").To(u.GetAllMeasurementsHistory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get all measurements history for this user"))
+		Doc("Get all measurements history for this user").
+		Do(Paginated).
+		Operation("GetAllMeasurementsHistory").
+		Writes(user_proto.GetAllMeasurementsHistoryResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}/markers/all").To(u.GetAllTrackedMarkers).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get all markers that are being tracked for this user"))
+		Doc("Get all markers that are being tracked for this user").
+		Do(Paginated).
+		Operation("GetAllTrackedMarkers").
+		Writes(user_proto.GetAllTrackedMarkersResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/shared").To(u.GetSharedResources).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get resources shared with this user"))
+		Doc("Get resources shared with this user").
+		Do(Paginated).
+		Operation("GetSharedResources").
+		Reads(user_proto.GetSharedResourcesRequest{}).
+		Writes(user_proto.GetSharedResourcesResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/shared/search").To(u.SearchSharedResources).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get resources shared with this user"))
+		Doc("Get resources shared with this user").
+		Do(Paginated).
+		Operation("SearchSharedResources").
+		Reads(user_proto.GetSharedResourcesRequest{}).
+		Writes(user_proto.GetSharedResourcesResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/share").To(u.ShareResources).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Share a resource/s this user"))
+		Doc("Share a resource/s this user").
+		Do(Paginated).
+		Operation("ShareResources").
+		Reads(user_proto.ShareResourcesRequest{}).
+		Writes(user_proto.ShareResourcesResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/share/all").To(u.GetAllShareableResources).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get all shareable resources for this user"))
+		Doc("Get all shareable resources for this user").
+		Do(Paginated).
+		Operation("GetAllShareableResources").
+		Reads(user_proto.GetShareableResourcesRequest{}).
+		Writes(user_proto.GetShareableResourcesResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/share/search").To(u.SearchShareableResources).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Search all shareable resources for this user"))
+		Doc("Search all shareable resources for this user").
+		Do(Paginated).
+		Operation("SearchShareableResources").
+		Reads(user_proto.GetShareableResourcesRequest{}).
+		Writes(user_proto.GetShareableResourcesResponse{}))
 
 	ws.Route(ws.GET("/user/{user_id}/goals/current/progress").To(u.GetGoalProgress).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get all markers that are being tracked for this user"))
+		Doc("Get all markers that are being tracked for this user").
+		Do(Paginated).
+		Operation("UserGetGoalProgress").
+		Writes(userapp_proto.GetGoalProgressResponse{}))
 
 	ws.Route(ws.POST("/user/{user_id}/delete").To(u.DeleteUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
 		Filter(u.Auth.EmployeeAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Deiete user by userid"))
+		Doc("Deiete user by userid").
+		Operation("DeleteUser").
+		Writes(user_proto.DeleteResponse{}))
 
 	restful.Add(ws)
 }
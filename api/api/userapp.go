@@ -30,6 +30,7 @@ func (u UserAppService) Register() {
 	ws := new(restful.WebService)
 
 	ws.Path("/server/user/app")
+	ws.Doc("User app")
 
 	audit := &audit_proto.Audit{
 		ActionService:  common.UserappSrv,
@@ -39,387 +40,664 @@ func (u UserAppService) Register() {
 	ws.Route(ws.GET("/{user_id}").To(u.ReadUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Read a user"))
+		Doc("Read a user").
+		Operation("UserAppReadUser").
+		Writes(user_proto.ReadResponse{}))
 
 	ws.Route(ws.POST("/bookmark").To(u.CreateBookmark).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Create bookmark"))
+		Doc("Create bookmark").
+		Operation("CreateBookmark").
+		Reads(userapp_proto.CreateBookmarkRequest{}).
+		Writes(userapp_proto.CreateBookmarkResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/bookmarks/all").To(u.ReadBookmarkContents).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Create bookmark"))
+		Doc("Create bookmark").
+		Operation("ReadBookmarkContents").
+		Writes(userapp_proto.ReadBookmarkContentResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/bookmarks/categorys").To(u.ReadBookmarkContentCategorys).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Create bookmark"))
+		Doc("Create bookmark").
+		Operation("ReadBookmarkContentCategorys").
+		Writes(userapp_proto.ReadBookmarkContentCategorysResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/{category_id}/bookmarks").To(u.ReadBookmarkByCategory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Create bookmark"))
+		Doc("Create bookmark").
+		Operation("ReadBookmarkByCategory").
+		Writes(userapp_proto.ReadBookmarkByCategoryResponse{}))
 
 	ws.Route(ws.DELETE("/bookmark/{bookmark_id}").To(u.DeleteBookmark).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Delete bookmark"))
+		Doc("Delete bookmark").
+		Operation("DeleteBookmark").
+		Writes(userapp_proto.DeleteBookmarkResponse{}))
 
 	ws.Route(ws.POST("/bookmarks/search").To(u.SearchBookmarks).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Create bookmark"))
+		Doc("Create bookmark").
+		Operation("SearchBookmarks").
+		Reads(userapp_proto.SearchBookmarkRequest{}).
+		Writes(user_proto.GetShareableContentResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/content/shared").To(u.GetSharedContent).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get shared content"))
+		Doc("Get shared content").
+		Operation("GetSharedContent").
+		Writes(userapp_proto.GetSharedContentResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/plan/shared").To(u.GetSharedPlansForUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get shared plan"))
+		Doc("Get shared plan").
+		Operation("GetSharedPlansForUser").
+		Writes(userapp_proto.GetSharedPlanResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/survey/shared").To(u.GetSharedSurveysForUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get shared survey"))
+		Doc("Get shared survey").
+		Operation("GetSharedSurveysForUser").
+		Writes(userapp_proto.GetSharedSurveyResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/goal/shared").To(u.GetSharedGoalsForUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get shared goal"))
+		Doc("Get shared goal").
+		Operation("GetSharedGoalsForUser").
+		Writes(userapp_proto.GetSharedGoalResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/challenge/shared").To(u.GetSharedChallengesForUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
-		Doc("Get shared challenge"))
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
+		Doc("Get shared challenge").
+		Operation("GetSharedChallengesForUser").
+		Writes(userapp_proto.GetSharedChallengeResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/habit/shared").To(u.GetSharedHabitsForUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get shared habits"))
+		Doc("Get shared habits").
+		Operation("GetSharedHabitsForUser").
+		Writes(userapp_proto.GetSharedHabitResponse{}))
 
 	ws.Route(ws.POST("/goal/join").To(u.SignupToGoal).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Signup to a goal"))
+		Doc("Signup to a goal").
+		Operation("SignupToGoal").
+		Reads(userapp_proto.SignupToGoalRequest{}).
+		Writes(userapp_proto.SignupToGoalResponse{}))
 
 	ws.Route(ws.GET("/goal/{goal_id}").To(u.GetGoalDetail).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get goal detail"))
+		Doc("Get goal detail").
+		Operation("GetGoalDetail").
+		Writes(userapp_proto.ReadGoalResponse{}))
 
 	ws.Route(ws.GET("/goals/joined").To(u.GetAllJoinedGoals).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all user's goal"))
+		Doc("List all user's goal").
+		Operation("GetAllJoinedGoals").
+		Writes(userapp_proto.ListGoalResponse{}))
 
 	ws.Route(ws.GET("/goals/current").To(u.GetCurrentJoinedGoals).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List current goal"))
+		Doc("List current goal").
+		Operation("GetCurrentJoinedGoals").
+		Writes(userapp_proto.ListGoalResponse{}))
 
 	ws.Route(ws.GET("/goal/current/progress").To(u.GetGoalProgress).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get goal progress"))
+		Doc("Get goal progress").
+		Operation("UserAppGetGoalProgress").
+		Writes(userapp_proto.GetGoalProgressResponse{}))
 
 	ws.Route(ws.POST("/challenge/join").To(u.SignupToChallenge).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Signup to a challenge"))
+		Doc("Signup to a challenge").
+		Operation("SignupToChallenge").
+		Reads(userapp_proto.SignupToChallengeRequest{}).
+		Writes(userapp_proto.SignupToChallengeResponse{}))
 
 	ws.Route(ws.GET("/challenge/{challenge_id}").To(u.GetChallengeDetail).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get challenge detail"))
+		Doc("Get challenge detail").
+		Operation("GetChallengeDetail").
+		Writes(userapp_proto.ReadChallengeResponse{}))
 
 	ws.Route(ws.GET("/challenges/joined").To(u.GetAllJoinedChallenges).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all user's challenge"))
+		Doc("List all user's challenge").
+		Operation("GetAllJoinedChallenges").
+		Writes(userapp_proto.ListChallengeResponse{}))
 
 	ws.Route(ws.GET("/challenges/current").To(u.GetCurrentJoinedChallenges).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List current challenge"))
+		Doc("List current challenge").
+		Operation("GetCurrentJoinedChallenges").
+		Writes(userapp_proto.ListChallengeResponse{}))
 
 	ws.Route(ws.GET("/challenges/current/count").To(u.GetCurrentChallengesWithCount).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List current challenges with count"))
+		Doc("List current challenges with count").
+		Operation("GetCurrentChallengesWithCount").
+		Writes(userapp_proto.GetCurrentChallengesWithCountResponse{}))
 
 	ws.Route(ws.GET("/current/markers").To(u.ListMarkers).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List markers for the current active goals"))
+		Doc("List markers for the current active goals").
+		Operation("ListMarkers").
+		Writes(userapp_proto.ListMarkersResponse{}))
 
 	ws.Route(ws.GET("/pending").To(u.GetPendingSharedActions).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get pending shared actions"))
+		Doc("Get pending shared actions").
+		Do(Paginated, Sorted).
+		Operation("GetPendingSharedActions").
+		Writes(userapp_proto.GetPendingSharedActionsResponse{}))
 
 	ws.Route(ws.GET("/marker/default/history").To(u.GetDefaultMarkerHistory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get default marker history"))
+		Doc("Get default marker history").
+		Do(Paginated, Sorted).
+		Operation("GetDefaultMarkerHistory").
+		Writes(track_proto.GetDefaultMarkerHistoryResponse{}))
 
 	ws.Route(ws.GET("/markers/{name_slug}/marker").To(u.MarkerByNameslug).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get marker by name_slug"))
+		Doc("Get marker by name_slug").
+		Operation("MarkerByNameslug").
+		Writes(static_proto.ReadMarkerResponse{}))
 
 	ws.Route(ws.POST("/habit/join").To(u.SignupToHabit).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Signup to a habit"))
+		Doc("Signup to a habit").
+		Operation("SignupToHabit").
+		Reads(userapp_proto.SignupToHabitRequest{}).
+		Writes(userapp_proto.SignupToHabitResponse{}))
 
 	ws.Route(ws.GET("/habit/{habit_id}").To(u.GetHabitDetail).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get habit detail"))
+		Doc("Get habit detail").
+		Operation("GetHabitDetail").
+		Writes(userapp_proto.ReadHabitResponse{}))
 
 	ws.Route(ws.GET("/habits/joined").To(u.GetAllJoinedHabits).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all user's habits joined"))
+		Doc("List all user's habits joined").
+		Operation("GetAllJoinedHabits").
+		Writes(userapp_proto.ListHabitResponse{}))
 
 	ws.Route(ws.GET("/habits/current").To(u.GetCurrentJoinedHabits).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List current habits"))
+		Doc("List current habits").
+		Operation("GetCurrentJoinedHabits").
+		Writes(userapp_proto.ListHabitResponse{}))
 
 	ws.Route(ws.GET("/habits/current/count").To(u.GetCurrentHabitsWithCount).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List current habits"))
+		Doc("List current habits").
+		Operation("GetCurrentHabitsWithCount").
+		Writes(userapp_proto.GetCurrentHabitsWithCountResponse{}))
 
 	ws.Route(ws.GET("/content/categorys/all").To(u.GetContentCategorys).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get content categories"))
+		Doc("Get content categories").
+		Operation("GetContentCategorys").
+		Writes(content_proto.GetContentCategorysResponse{}))
 
 	ws.Route(ws.GET("/content/{content_id}").To(u.GetContentDetail).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get content detail"))
+		Doc("Get content detail").
+		Operation("GetContentDetail").
+		Writes(userapp_proto.ReadContentResponse{}))
 
 	ws.Route(ws.GET("/content/category/{category_id}").To(u.GetContentByCategory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get content from a category"))
+		Doc("Get content from a category").
+		Operation("GetContentByCategory").
+		Writes(content_proto.GetContentByCategoryResponse{}))
 
 	ws.Route(ws.GET("/content/category/{category_id}/filters").To(u.GetFiltersForCategory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get filters for a category"))
+		Doc("Get filters for a category").
+		Operation("GetFiltersForCategory").
+		Writes(content_proto.GetFiltersForCategoryResponse{}))
 
 	ws.Route(ws.POST("/content/category/filters/autocomplete").To(u.FiltersAutocomplete).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Filters autocomplete"))
+		Doc("Filters autocomplete").
+		Operation("FiltersAutocomplete").
+		Reads(content_proto.FiltersAutocompleteRequest{}).
+		Writes(content_proto.FiltersAutocompleteResponse{}))
 
 	ws.Route(ws.POST("/content/category/{category_id}/filter").To(u.FilterContentInParticularCategory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Filter content in a particular category"))
+		Doc("Filter content in a particular category").
+		Operation("FilterContentInParticularCategory").
+		Reads(content_proto.FilterContentInParticularCategoryRequest{}).
+		Writes(content_proto.FilterContentInParticularCategoryResponse{}))
 
 	ws.Route(ws.POST("/preferences").To(u.SaveUserPreference).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Save a users preferences"))
+		Doc("Save a users preferences").
+		Operation("SaveUserPreference").
+		Reads(user_proto.SaveUserPreferenceRequest{}).
+		Writes(user_proto.SaveUserPreferenceResponse{}))
 
 	ws.Route(ws.GET("/{user_id}/preferences").To(u.GetUserPreference).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get a users preferences"))
+		Doc("Get a users preferences").
+		Operation("GetUserPreference").
+		Writes(user_proto.ReadUserPreferenceResponse{}))
 
 	ws.Route(ws.POST("/details").To(u.SaveUserDetails).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Save a users details"))
+		Doc("Save a users details").
+		Operation("SaveUserDetails").
+		Reads(userapp_proto.SaveUserDetailsRequest{}).
+		Writes(userapp_proto.SaveUserDetailsResponse{}))
 
 	ws.Route(ws.GET("/content/recommendations/all").To(u.GetContentRecommendationByUser).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get content recommendations for a particular user"))
+		Doc("Get content recommendations for a particular user").
+		Operation("GetContentRecommendationByUser").
+		Writes(content_proto.GetContentRecommendationByUserResponse{}))
 
 	ws.Route(ws.GET("/content/recommendations/category/{category_id}").To(u.GetContentRecommendationByCategory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get content recommendations by category"))
+		Doc("Get content recommendations by category").
+		Operation("GetContentRecommendationByCategory").
+		Writes(content_proto.GetContentRecommendationByCategoryResponse{}))
 
 	ws.Route(ws.POST("/content/{content_id}/rating").To(u.SaveRateForContent).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Save user rating for a particular content object"))
+		Doc("Save user rating for a particular content object").
+		Operation("SaveRateForContent").
+		Reads(userapp_proto.SaveRateForContentRequest{}).
+		Writes(userapp_proto.SaveRateForContentResponse{}))
 
 	ws.Route(ws.POST("/content/{content_id}/dislike").To(u.DislikeForContent).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("User not interested in a particular content"))
+		Doc("User not interested in a particular content").
+		Operation("DislikeForContent").
+		Reads(userapp_proto.DislikeForContentRequest{}).
+		Writes(userapp_proto.DislikeForContentResponse{}))
 
 	ws.Route(ws.POST("/content/{content_id}/dislike/similar").To(u.DislikeForSimilarContent).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("User not interested in similar content"))
+		Doc("User not interested in similar content").
+		Operation("DislikeForSimilarContent").
+		Reads(userapp_proto.DislikeForSimilarContentRequest{}).
+		Writes(userapp_proto.DislikeForSimilarContentResponse{}))
 
 	ws.Route(ws.POST("/feedback").To(u.SaveUserFeedback).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Save general user feedback"))
+		Doc("Save general user feedback").
+		Operation("SaveUserFeedback").
+		Reads(userapp_proto.SaveUserFeedbackRequest{}).
+		Writes(userapp_proto.SaveUserFeedbackResponse{}))
 
 	ws.Route(ws.POST("/plan/join").To(u.JoinUserPlan).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Join/start a plan"))
+		Doc("Join/start a plan").
+		Operation("JoinUserPlan").
+		Reads(userapp_proto.JoinUserPlanRequest{}).
+		Writes(userapp_proto.JoinUserPlanResponse{}))
 
 	ws.Route(ws.POST("/plan/create").To(u.CreateUserPlan).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Create a user's plan"))
+		Doc("Create a user's plan").
+		Operation("CreateUserPlan").
+		Reads(userapp_proto.CreateUserPlanRequest{}).
+		Writes(userapp_proto.CreateUserPlanResponse{}))
 
 	ws.Route(ws.GET("/plan/{user_id}").To(u.GetUserPlan).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get a user's plan"))
+		Doc("Get a user's plan").
+		Operation("GetUserPlan").
+		Writes(userapp_proto.GetUserPlanResponse{}))
 
 	ws.Route(ws.POST("/plan/update").To(u.UpdateUserPlan).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Save general user feedback"))
+		Doc("Save general user feedback").
+		Operation("UpdateUserPlan").
+		Reads(userapp_proto.UpdateUserPlanRequest{}).
+		Writes(userapp_proto.UpdateUserPlanResponse{}))
 
 	ws.Route(ws.GET("/plan/{plan_id}/summary/count").To(u.GetPlanItemsCountByCategory).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get plan items count by category"))
+		Doc("Get plan items count by category").
+		Operation("GetPlanItemsCountByCategory").
+		Writes(userapp_proto.GetPlanItemsCountByCategoryResponse{}))
 
 	ws.Route(ws.GET("/plan/{plan_id}/summary/{day_number}/count").To(u.GetPlanItemsCountByDay).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get plan items count by day"))
+		Doc("Get plan items count by day").
+		Operation("GetPlanItemsCountByDay").
+		Writes(userapp_proto.GetPlanItemsCountByDayResponse{}))
 
 	ws.Route(ws.GET("/plan/{plan_id}/summary").To(u.GetPlanItemsCountByCategoryAndDay).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Get plan items count by category and day"))
+		Doc("Get plan items count by category and day").
+		Operation("GetPlanItemsCountByCategoryAndDay").
+		Writes(userapp_proto.GetPlanItemsCountByCategoryAndDayResponse{}))
 
 	ws.Route(ws.POST("/login").To(u.Login).
-		Doc("User login"))
+		Filter(u.Auth.RateLimit).
+		Doc("User login").
+		Operation("UserAppLogin").
+		Reads(account_proto.LoginRequest{}).
+		Writes(account_proto.LoginResponse{}))
 
 	ws.Route(ws.GET("/logout").To(u.Logout).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Logout the user"))
+		Doc("Logout the user").
+		Operation("UserAppLogout").
+		Writes(account_proto.LogoutResponse{}))
 
 	ws.Route(ws.GET("/goals/all").To(u.AllGoals).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all goals"))
+		Doc("List all goals").
+		Do(Paginated, Sorted).
+		Operation("UserAppAllGoals").
+		Writes(user_proto.AllGoalResponseResponse{}))
 
 	ws.Route(ws.GET("/challenges/all").To(u.AllChallenges).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all challenges"))
+		Doc("List all challenges").
+		Do(Paginated, Sorted).
+		Operation("UserAppAllChallenges").
+		Writes(user_proto.AllChallengeResponseResponse{}))
 
 	ws.Route(ws.GET("/habits/all").To(u.AllHabits).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		Filter(u.Auth.SortFilter).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all habits"))
+		Doc("List all habits").
+		Do(Paginated, Sorted).
+		Operation("UserAppAllHabits").
+		Writes(user_proto.AllHabitResponseResponse{}))
 
 	ws.Route(ws.POST("/contents/all").To(u.GetShareableContent).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		Filter(u.Auth.Paginate).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("List all habits"))
+		Doc("List all habits").
+		Do(Paginated).
+		Operation("GetShareableContent").
+		Writes(user_proto.GetShareableContentResponse{}))
 
 	ws.Route(ws.POST("/{user_id}/shared").To(u.RecievedItems).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Update shared items status to RECIEVED"))
+		Doc("Update shared items status to RECIEVED").
+		Operation("RecievedItems").
+		Reads(userapp_proto.ReceivedItemsRequest{}).
+		Writes(userapp_proto.ReceivedItemsResponse{}))
 
 	ws.Route(ws.POST("/content/category/{nameslug}/items/autocomplete").To(u.AutocompleteContentCategoryItem).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("Autocomplete content category item by nameslug"))
+		Doc("Autocomplete content category item by nameslug").
+		Operation("AutocompleteContentCategoryItem").
+		Reads(content_proto.AutocompleteContentCategoryItemRequest{}).
+		Writes(content_proto.AutocompleteContentCategoryItemResponse{}))
 
 	ws.Route(ws.GET("/content/category/{nameslug}/items/all").To(u.AllContentCategoryItemByNameslug).
 		Filter(u.Auth.BasicAuthenticate).
 		Filter(u.Auth.OrganisationAuthenticate).
+		Filter(u.Auth.Authorize).
+		Filter(u.Auth.RateLimit).
 		// Filter(u.Audit.Clone(audit).AuditFilter).
-		Doc("All content category item by nameslug"))
+		Doc("All content category item by nameslug").
+		Operation("AllContentCategoryItemByNameslug").
+		Reads(content_proto.AllContentCategoryItemByNameslugRequest{}).
+		Writes(content_proto.AllContentCategoryItemByNameslugResponse{}))
 
 	restful.Add(ws)
 }
@@ -4434,6 +4712,7 @@ func (p *UserAppService) Logout(req *restful.Request, rsp *restful.Response) {
 
 	req_logout := new(account_proto.LogoutRequest)
 	req_logout.SessionId = req.QueryParameter("session")
+	req_logout.TokenSession, _ = req.Attribute(TokenSessionAttrName).(string)
 
 	ctx := common.NewContextByHeader(context.TODO(), req.Request.Header)
 	resp, err := p.UserAppClient.Logout(ctx, req_logout)
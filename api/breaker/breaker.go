@@ -0,0 +1,514 @@
+// Package breaker wraps the RPC client of the api so a slow or failing service doesn't hang the endpoints which
+// depend on it. Every service called gets:
+//
+//   - a hystrix circuit breaker, which fails the calls fast while the service errors
+//   - a timeout for each attempt of a call
+//   - a bulkhead, the maximum of concurrent calls to the service
+//   - bounded retries with jittered exponential backoff, for the idempotent methods only
+//
+// The settings are read from the hystrix section of the config and reloaded when it changes.
+package breaker
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"server/common"
+
+	"github.com/afex/hystrix-go/hystrix"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-os/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Service is the settings of the calls of a service, the zero values are the defaults of the Settings and a negative
+// Retries disables the retries. The durations are in milliseconds.
+type Service struct {
+	Timeout               int
+	MaxConcurrent         int
+	VolumeThreshold       int
+	SleepWindow           int
+	ErrorPercentThreshold int
+	Retries               int
+	RetryBackoff          int
+}
+
+// Settings are the hystrix section of the config of the api
+type Settings struct {
+	DefaultTimeout               int
+	DefaultMaxConcurrent         int
+	DefaultVolumeThreshold       int
+	DefaultSleepWindow           int
+	DefaultErrorPercentThreshold int
+	DefaultRetries               int
+	DefaultRetryBackoff          int
+	// Services are the settings of the services, by name
+	Services map[string]Service
+}
+
+// DefaultSettings are the settings used without config
+var DefaultSettings = Settings{
+	DefaultTimeout:               hystrix.DefaultTimeout,
+	DefaultMaxConcurrent:         hystrix.DefaultMaxConcurrent,
+	DefaultVolumeThreshold:       hystrix.DefaultVolumeThreshold,
+	DefaultSleepWindow:           hystrix.DefaultSleepWindow,
+	DefaultErrorPercentThreshold: hystrix.DefaultErrorPercentThreshold,
+	DefaultRetries:               2,
+	DefaultRetryBackoff:          100,
+}
+
+// IdempotentMethods are the methods, Service.Method, which only read, their calls are retried. A method is added once
+// its handler is checked not to write, e.g. StaticService.ReadContentCategoryByNameslugOrCreate creates the category
+// it doesn't find so its calls aren't retried. Refreshing a cache or the expiration of a session is idempotent.
+var IdempotentMethods = map[string]bool{
+	// account-srv
+	"AccountService.ReadSession": true,
+
+	// audit-srv
+	"AuditService.FilterAudits": true,
+
+	// behaviour-srv
+	"BehaviourService.AllChallenges":               true,
+	"BehaviourService.AllGoals":                    true,
+	"BehaviourService.AllHabits":                   true,
+	"BehaviourService.AutocompleteChallengeSearch": true,
+	"BehaviourService.AutocompleteGoalSearch":      true,
+	"BehaviourService.AutocompleteHabitSearch":     true,
+	"BehaviourService.AutocompleteTags":            true,
+	"BehaviourService.Filter":                      true,
+	"BehaviourService.GetTopTags":                  true,
+	"BehaviourService.ListVersions":                true,
+	"BehaviourService.ReadChallenge":               true,
+	"BehaviourService.ReadGoal":                    true,
+	"BehaviourService.ReadHabit":                   true,
+	"BehaviourService.ReadVersion":                 true,
+	"BehaviourService.Search":                      true,
+
+	// content-srv
+	"ContentService.AllContentCategoryItems":       true,
+	"ContentService.AllContentRules":               true,
+	"ContentService.AllContents":                   true,
+	"ContentService.AllSources":                    true,
+	"ContentService.AllTaxonomys":                  true,
+	"ContentService.AutocompleteTags":              true,
+	"ContentService.FilterContent":                 true,
+	"ContentService.FilterContentRecommendations":  true,
+	"ContentService.GetAllSharedContents":          true,
+	"ContentService.GetContentFiltersByPreference": true,
+	"ContentService.GetContentRecommendations":     true,
+	"ContentService.GetTopTags":                    true,
+	"ContentService.ReadContent":                   true,
+	"ContentService.ReadContentCategoryItem":       true,
+	"ContentService.ReadContentRule":               true,
+	"ContentService.ReadSource":                    true,
+	"ContentService.ReadTaxonomy":                  true,
+	"ContentService.ReadTaxonomyByNameslug":        true,
+	"ContentService.SearchContent":                 true,
+
+	// kv-srv
+	"KvService.IsTokenRevoked": true,
+	"KvService.ReadSession":    true,
+
+	// note-srv
+	"NoteService.All":       true,
+	"NoteService.ByCreator": true,
+	"NoteService.ByUser":    true,
+	"NoteService.Filter":    true,
+	"NoteService.Read":      true,
+	"NoteService.Search":    true,
+
+	// organisation-srv
+	"OrganisationService.All":             true,
+	"OrganisationService.GetModulesByOrg": true,
+	"OrganisationService.Read":            true,
+	"OrganisationService.ReadOrgInfo":     true,
+
+	// plan-srv
+	"PlanService.All":                true,
+	"PlanService.AutocompleteSearch": true,
+	"PlanService.AutocompleteTags":   true,
+	"PlanService.ByCreator":          true,
+	"PlanService.Filters":            true,
+	"PlanService.GetTopTags":         true,
+	"PlanService.ListVersions":       true,
+	"PlanService.Read":               true,
+	"PlanService.ReadVersion":        true,
+	"PlanService.Search":             true,
+
+	// product-srv
+	"ProductService.AllBatches":          true,
+	"ProductService.AllProducts":         true,
+	"ProductService.AllServices":         true,
+	"ProductService.AutocompleteProduct": true,
+	"ProductService.AutocompleteService": true,
+	"ProductService.ReadBatch":           true,
+	"ProductService.ReadProduct":         true,
+	"ProductService.ReadService":         true,
+
+	// response-srv
+	"ResponseService.All":            true,
+	"ResponseService.AllAggQuestion": true,
+	"ResponseService.AllState":       true,
+	"ResponseService.ByAnyUser":      true,
+	"ResponseService.ByUser":         true,
+	"ResponseService.Check":          true,
+	"ResponseService.ReadStats":      true,
+
+	// static-srv
+	"StaticService.AllApps":                            true,
+	"StaticService.AllBehaviourCategories":             true,
+	"StaticService.AllBehaviourCategoryAims":           true,
+	"StaticService.AllContentCategories":               true,
+	"StaticService.AllContentParentCategories":         true,
+	"StaticService.AllContentSourceTypes":              true,
+	"StaticService.AllContentTypes":                    true,
+	"StaticService.AllDevices":                         true,
+	"StaticService.AllMarkers":                         true,
+	"StaticService.AllModuleTriggers":                  true,
+	"StaticService.AllModules":                         true,
+	"StaticService.AllNotifications":                   true,
+	"StaticService.AllPlatforms":                       true,
+	"StaticService.AllSetbacks":                        true,
+	"StaticService.AllSocialTypes":                     true,
+	"StaticService.AllTrackerMethods":                  true,
+	"StaticService.AllTriggerContentTypes":             true,
+	"StaticService.AllWearables":                       true,
+	"StaticService.AutocompleteSetbackSearch":          true,
+	"StaticService.FilterBehaviourCategory":            true,
+	"StaticService.FilterMarker":                       true,
+	"StaticService.FilterModuleTrigger":                true,
+	"StaticService.FilterTrackerMethod":                true,
+	"StaticService.FilterTriggerContentType":           true,
+	"StaticService.ReadApp":                            true,
+	"StaticService.ReadAppByNameslug":                  true,
+	"StaticService.ReadBehaviourCategory":              true,
+	"StaticService.ReadBehaviourCategoryAim":           true,
+	"StaticService.ReadBehaviourCategoryAimByNameslug": true,
+	"StaticService.ReadContentCategory":                true,
+	"StaticService.ReadContentCategoryByNameslug":      true,
+	"StaticService.ReadContentParentCategory":          true,
+	"StaticService.ReadContentSourceType":              true,
+	"StaticService.ReadContentType":                    true,
+	"StaticService.ReadDevice":                         true,
+	"StaticService.ReadDeviceByNameslug":               true,
+	"StaticService.ReadMarker":                         true,
+	"StaticService.ReadMarkerByNameslug":               true,
+	"StaticService.ReadModule":                         true,
+	"StaticService.ReadModuleTrigger":                  true,
+	"StaticService.ReadNotification":                   true,
+	"StaticService.ReadPlatform":                       true,
+	"StaticService.ReadSetback":                        true,
+	"StaticService.ReadSocialType":                     true,
+	"StaticService.ReadTrackerMethod":                  true,
+	"StaticService.ReadTrackerMethodByNameslug":        true,
+	"StaticService.ReadTriggerContentType":             true,
+	"StaticService.ReadWearable":                       true,
+	"StaticService.ReadWearableByNameslug":             true,
+
+	// survey-srv
+	"SurveyService.All":                true,
+	"SurveyService.AutocompleteSearch": true,
+	"SurveyService.AutocompleteTags":   true,
+	"SurveyService.ByCreator":          true,
+	"SurveyService.Filter":             true,
+	"SurveyService.GetTopTags":         true,
+	"SurveyService.ListVersions":       true,
+	"SurveyService.Read":               true,
+	"SurveyService.ReadVersion":        true,
+	"SurveyService.Search":             true,
+
+	// task-srv
+	"TaskService.All":       true,
+	"TaskService.ByAssign":  true,
+	"TaskService.ByCreator": true,
+	"TaskService.Filter":    true,
+	"TaskService.Read":      true,
+	"TaskService.Search":    true,
+
+	// team-srv
+	"TeamService.All":              true,
+	"TeamService.AllTeamMember":    true,
+	"TeamService.Filter":           true,
+	"TeamService.FilterTeamMember": true,
+	"TeamService.Read":             true,
+	"TeamService.ReadEmployeeInfo": true,
+	"TeamService.ReadTeamMember":   true,
+	"TeamService.Search":           true,
+
+	// todo-srv
+	"TodoService.All":       true,
+	"TodoService.ByCreator": true,
+	"TodoService.Read":      true,
+	"TodoService.Search":    true,
+
+	// track-srv
+	"TrackService.GetAllMarkerHistory": true,
+	"TrackService.GetChallengeCount":   true,
+	"TrackService.GetChallengeHistory": true,
+	"TrackService.GetContentCount":     true,
+	"TrackService.GetContentHistory":   true,
+	"TrackService.GetGoalCount":        true,
+	"TrackService.GetGoalHistory":      true,
+	"TrackService.GetHabitCount":       true,
+	"TrackService.GetHabitHistory":     true,
+	"TrackService.GetLastMarker":       true,
+	"TrackService.GetMarkerHistory":    true,
+	"TrackService.GetMarkerSeries":     true,
+
+	// user-app-srv
+	"UserAppService.AllChallengeResponse":               true,
+	"UserAppService.AllContentCategoryItemByNameslug":   true,
+	"UserAppService.AllGoalResponse":                    true,
+	"UserAppService.AllHabitResponse":                   true,
+	"UserAppService.AutocompleteContentCategoryItem":    true,
+	"UserAppService.FilterContentInParticularCategory":  true,
+	"UserAppService.FiltersAutocomplete":                true,
+	"UserAppService.GetAllJoinedChallenges":             true,
+	"UserAppService.GetAllJoinedGoals":                  true,
+	"UserAppService.GetAllJoinedHabits":                 true,
+	"UserAppService.GetChallengeDetail":                 true,
+	"UserAppService.GetContentByCategory":               true,
+	"UserAppService.GetContentCategorys":                true,
+	"UserAppService.GetContentDetail":                   true,
+	"UserAppService.GetContentRecommendationByCategory": true,
+	"UserAppService.GetContentRecommendationByUser":     true,
+	"UserAppService.GetCurrentChallengesWithCount":      true,
+	"UserAppService.GetCurrentHabitsWithCount":          true,
+	"UserAppService.GetCurrentJoinedChallenges":         true,
+	"UserAppService.GetCurrentJoinedGoals":              true,
+	"UserAppService.GetCurrentJoinedHabits":             true,
+	"UserAppService.GetDefaultMarkerHistory":            true,
+	"UserAppService.GetFiltersForCategory":              true,
+	"UserAppService.GetGoalDetail":                      true,
+	"UserAppService.GetGoalProgress":                    true,
+	"UserAppService.GetHabitDetail":                     true,
+	"UserAppService.GetPendingSharedActions":            true,
+	"UserAppService.GetPlanItemsCountByCategory":        true,
+	"UserAppService.GetPlanItemsCountByCategoryAndDay":  true,
+	"UserAppService.GetPlanItemsCountByDay":             true,
+	"UserAppService.GetShareableContent":                true,
+	"UserAppService.GetSharedChallengesForUser":         true,
+	"UserAppService.GetSharedContent":                   true,
+	"UserAppService.GetSharedGoalsForUser":              true,
+	"UserAppService.GetSharedHabitsForUser":             true,
+	"UserAppService.GetSharedPlansForUser":              true,
+	"UserAppService.GetSharedSurveysForUser":            true,
+	"UserAppService.GetUserPlan":                        true,
+	"UserAppService.GetUserPreference":                  true,
+	"UserAppService.ListMarkers":                        true,
+	"UserAppService.ReadBookmarkByCategory":             true,
+	"UserAppService.ReadBookmarkContentCategorys":       true,
+	"UserAppService.ReadBookmarkContents":               true,
+	"UserAppService.ReadMarkerByNameslug":               true,
+	"UserAppService.ReadUser":                           true,
+	"UserAppService.SearchBookmarks":                    true,
+
+	// user-srv
+	"UserService.All":                       true,
+	"UserService.AutocompleteUser":          true,
+	"UserService.FilterUser":                true,
+	"UserService.GetAccountStatus":          true,
+	"UserService.GetAllMeasurementsHistory": true,
+	"UserService.GetAllShareableResources":  true,
+	"UserService.GetAllTrackedMarkers":      true,
+	"UserService.GetMeasurementsHistory":    true,
+	"UserService.GetSharedResources":        true,
+	"UserService.ListUserFeedback":          true,
+	"UserService.Read":                      true,
+	"UserService.ReadUserPreference":        true,
+	"UserService.SearchUser":                true,
+}
+
+// Service returns the settings of the service name
+func (s Settings) Service(name string) Service {
+	srv := s.Services[name]
+	if srv.Timeout <= 0 {
+		srv.Timeout = s.DefaultTimeout
+	}
+	if srv.MaxConcurrent <= 0 {
+		srv.MaxConcurrent = s.DefaultMaxConcurrent
+	}
+	if srv.VolumeThreshold <= 0 {
+		srv.VolumeThreshold = s.DefaultVolumeThreshold
+	}
+	if srv.SleepWindow <= 0 {
+		srv.SleepWindow = s.DefaultSleepWindow
+	}
+	if srv.ErrorPercentThreshold <= 0 {
+		srv.ErrorPercentThreshold = s.DefaultErrorPercentThreshold
+	}
+	if srv.Retries == 0 {
+		srv.Retries = s.DefaultRetries
+	}
+	if srv.Retries < 0 {
+		srv.Retries = 0
+	}
+	if srv.RetryBackoff <= 0 {
+		srv.RetryBackoff = s.DefaultRetryBackoff
+	}
+	return srv
+}
+
+// the settings and the services called, the circuits of hystrix are global too
+var (
+	mtx      sync.RWMutex
+	settings = DefaultSettings
+	services = map[string]bool{}
+)
+
+// Configure applies s to the services. The circuits are reset so their bulkheads are rebuilt with the new settings.
+func Configure(s Settings) {
+	mtx.Lock()
+	settings = s
+	for name := range services {
+		hystrix.ConfigureCommand(name, command(s.Service(name)))
+	}
+	mtx.Unlock()
+	hystrix.Flush()
+}
+
+// Watch configures the services from the hystrix section of c, and again when it changes
+func Watch(c config.Config) {
+	Configure(read(c.Get("hystrix")))
+	common.ConfigValueWatcher(c, func(v config.Value) {
+		log.Info("Reloading the circuit breaker settings")
+		Configure(read(v))
+	}, "hystrix")
+}
+
+// read returns the settings of v, the missing values are the DefaultSettings
+func read(v config.Value) Settings {
+	s := DefaultSettings
+	s.Services = nil
+	if err := v.Scan(&s); err != nil {
+		log.Warn("Circuit breaker settings are invalid: ", err)
+		return DefaultSettings
+	}
+	return s
+}
+
+// States returns the state of the circuit of the services called, open or closed
+func States() map[string]string {
+	mtx.RLock()
+	names := []string{}
+	for name := range services {
+		names = append(names, name)
+	}
+	mtx.RUnlock()
+	sort.Strings(names)
+
+	states := map[string]string{}
+	for _, name := range names {
+		state := "closed"
+		if circuit, _, err := hystrix.GetCircuit(name); err == nil && circuit.IsOpen() {
+			state = "open"
+		}
+		states[name] = state
+	}
+	return states
+}
+
+// service returns the settings of the service name and registers its circuit on its first call
+func service(name string) Service {
+	mtx.RLock()
+	srv, ok := settings.Service(name), services[name]
+	mtx.RUnlock()
+	if ok {
+		return srv
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	if !services[name] {
+		services[name] = true
+		hystrix.ConfigureCommand(name, command(settings.Service(name)))
+	}
+	return settings.Service(name)
+}
+
+// timeoutGrace delays the timeout of hystrix after the one of the context of an attempt, so a timed out attempt has
+// returned before it's retried and doesn't write the response of the retry
+const timeoutGrace = 1000
+
+func command(srv Service) hystrix.CommandConfig {
+	return hystrix.CommandConfig{
+		Timeout:                srv.Timeout + timeoutGrace,
+		MaxConcurrentRequests:  srv.MaxConcurrent,
+		RequestVolumeThreshold: srv.VolumeThreshold,
+		SleepWindow:            srv.SleepWindow,
+		ErrorPercentThreshold:  srv.ErrorPercentThreshold,
+	}
+}
+
+// Idempotent checks if the method, Service.Method, only reads
+func Idempotent(method string) bool {
+	return IdempotentMethods[method]
+}
+
+// Retryable checks if a call failed with err can succeed again. The calls rejected by an open circuit or a full
+// bulkhead and the errors of the requests aren't retried.
+func Retryable(err error) bool {
+	if err == hystrix.ErrCircuitOpen || err == hystrix.ErrMaxConcurrency {
+		return false
+	}
+	if err == hystrix.ErrTimeout {
+		return true
+	}
+	code := errors.Parse(err.Error()).Code
+	return code == 0 || code == 408 || code >= 500
+}
+
+// Backoff returns the delay before the retry attempt, from 1, a random duration up to base doubled by attempt
+func Backoff(base time.Duration, attempt int) time.Duration {
+	max := base << uint(attempt-1)
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+type clientWrapper struct {
+	client.Client
+}
+
+func (c *clientWrapper) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	srv := service(req.Service())
+	timeout := time.Duration(srv.Timeout) * time.Millisecond
+	retries := 0
+	if Idempotent(req.Method()) {
+		retries = srv.Retries
+	}
+	// the retries of the client are replaced by the ones of the wrapper
+	opts = append(opts, client.WithRetries(1), client.WithRequestTimeout(timeout))
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(Backoff(time.Duration(srv.RetryBackoff)*time.Millisecond, attempt)):
+			}
+		}
+		err = hystrix.Do(req.Service(), func() error {
+			attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return c.Client.Call(attemptCtx, req, rsp, opts...)
+		}, nil)
+		if err == nil || !Retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// NewClientWrapper returns the client wrapper of the services
+func NewClientWrapper() client.Wrapper {
+	return func(c client.Client) client.Client {
+		return &clientWrapper{c}
+	}
+}
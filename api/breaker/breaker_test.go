@@ -0,0 +1,138 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	micro_errors "github.com/micro/go-micro/errors"
+	"github.com/micro/go-os/config"
+	"github.com/micro/go-os/config/source/memory"
+)
+
+// failing fails the first calls with err
+type failing struct {
+	client.Client
+	err   error
+	fails int
+	calls int
+}
+
+func (c *failing) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	c.calls++
+	if c.calls <= c.fails {
+		return c.err
+	}
+	return nil
+}
+
+func TestService(t *testing.T) {
+	s := DefaultSettings
+	s.Services = map[string]Service{
+		"go.micro.srv.kv":   {Timeout: 500, Retries: 5},
+		"go.micro.srv.note": {Retries: -1},
+	}
+	if kv := s.Service("go.micro.srv.kv"); kv.Timeout != 500 || kv.Retries != 5 || kv.MaxConcurrent != s.DefaultMaxConcurrent {
+		t.Errorf("Service settings are invalid: %+v", kv)
+	}
+	if note := s.Service("go.micro.srv.note"); note.Retries != 0 || note.Timeout != s.DefaultTimeout {
+		t.Errorf("Retries must be disabled: %+v", note)
+	}
+	if user := s.Service("go.micro.srv.user"); user.Retries != s.DefaultRetries {
+		t.Errorf("Service without settings must have the defaults: %+v", user)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	defer Configure(DefaultSettings)
+	s := DefaultSettings
+	s.DefaultRetryBackoff = 1
+	Configure(s)
+	unavailable := micro_errors.InternalServerError("go.micro.srv.note", "unavailable")
+
+	cl := &failing{err: unavailable, fails: 2}
+	wrapped := NewClientWrapper()(cl)
+	req := client.NewRequest("go.micro.srv.note", "NoteService.Read", nil)
+	if err := wrapped.Call(context.TODO(), req, nil); err != nil || cl.calls != 3 {
+		t.Errorf("Idempotent call must be retried: %v, %v calls", err, cl.calls)
+	}
+
+	cl = &failing{err: unavailable, fails: 5}
+	wrapped = NewClientWrapper()(cl)
+	if err := wrapped.Call(context.TODO(), req, nil); err == nil || cl.calls != 1+s.DefaultRetries {
+		t.Errorf("Retries must be bounded: %v, %v calls", err, cl.calls)
+	}
+
+	cl = &failing{err: unavailable, fails: 1}
+	wrapped = NewClientWrapper()(cl)
+	create := client.NewRequest("go.micro.srv.note", "NoteService.Create", nil)
+	if err := wrapped.Call(context.TODO(), create, nil); err == nil || cl.calls != 1 {
+		t.Errorf("Call which isn't idempotent must not be retried: %v, %v calls", err, cl.calls)
+	}
+
+	cl = &failing{err: micro_errors.BadRequest("go.micro.srv.note", "invalid"), fails: 1}
+	wrapped = NewClientWrapper()(cl)
+	if err := wrapped.Call(context.TODO(), req, nil); err == nil || cl.calls != 1 {
+		t.Errorf("Bad request must not be retried: %v, %v calls", err, cl.calls)
+	}
+
+	if states := States(); states["go.micro.srv.note"] != "closed" {
+		t.Errorf("States are invalid: %v", states)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	cases := []struct {
+		err       error
+		retryable bool
+	}{
+		{errors.New("connection refused"), true},
+		{micro_errors.New("go.micro.client", "call timeout", 408), true},
+		{micro_errors.InternalServerError("go.micro.srv.note", "db error"), true},
+		{micro_errors.NotFound("go.micro.srv.note", "not found"), false},
+	}
+	for _, c := range cases {
+		if Retryable(c.err) != c.retryable {
+			t.Errorf("Retryable of %v must be %v", c.err, c.retryable)
+		}
+	}
+	if !Idempotent("NoteService.ByCreator") || Idempotent("KvService.RateLimit") || Idempotent("NoteService.Create") {
+		t.Error("Idempotent is invalid")
+	}
+	if Idempotent("StaticService.ReadContentCategoryByNameslugOrCreate") || Idempotent("NoteService.ReadByOrg") {
+		t.Error("Method which isn't listed must not be idempotent")
+	}
+	for attempt := 1; attempt <= 3; attempt++ {
+		if d := Backoff(100*time.Millisecond, attempt); d < 0 || d >= 100*time.Millisecond<<uint(attempt-1) {
+			t.Errorf("Backoff of attempt %v is invalid: %v", attempt, d)
+		}
+	}
+}
+
+func TestWatch(t *testing.T) {
+	defer Configure(DefaultSettings)
+	source := memory.NewSource(memory.Data([]byte(`{"hystrix": {"DefaultTimeout": 2000}}`)))
+	c := config.NewConfig(config.WithSource(source), config.PollInterval(10*time.Millisecond))
+	defer c.Close()
+
+	Watch(c)
+	if s := current(); s.DefaultTimeout != 2000 || s.DefaultRetries != DefaultSettings.DefaultRetries {
+		t.Fatalf("Settings are invalid: %+v", s)
+	}
+
+	source.Update([]byte(`{"hystrix": {"DefaultTimeout": 3000, "Services": {"go.micro.srv.kv": {"Timeout": 100}}}}`))
+	for i := 0; i < 100 && current().DefaultTimeout != 3000; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if s := current(); s.DefaultTimeout != 3000 || s.Service("go.micro.srv.kv").Timeout != 100 {
+		t.Errorf("Settings must be reloaded: %+v", s)
+	}
+}
+
+func current() Settings {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return settings
+}
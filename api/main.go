@@ -3,10 +3,14 @@ package main
 import (
 	account_proto "server/account-srv/proto/account"
 	"server/api/api"
-	"server/api/utils"
+	"server/api/breaker"
+	"server/api/openapi"
+	"server/api/ratelimit"
+	"server/api/rbac"
 	behaviour_proto "server/behaviour-srv/proto/behaviour"
 	"server/common"
 	content_proto "server/content-srv/proto/content"
+	kv_proto "server/kv-srv/proto/kv"
 	note_proto "server/note-srv/proto/note"
 	organisation_proto "server/organisation-srv/proto/organisation"
 	plan_proto "server/plan-srv/proto/plan"
@@ -24,6 +28,7 @@ import (
 
 	"github.com/emicklei/go-restful"
 	"github.com/micro/go-micro"
+	"github.com/micro/go-micro/client"
 	"github.com/micro/go-os/config"
 	"github.com/micro/go-os/config/source/file"
 	"github.com/micro/go-os/metrics"
@@ -70,10 +75,21 @@ func main() {
 	)
 
 	cmd_service.Init()
-	rateLimitedClient := utils.NewRateLimitedClient(cmd_service.Client())
-	//securedClient := utils.NewCircuitBreakerClient(rateLimitedClient, config)
-	// TODO return it back
-	securedClient := rateLimitedClient
+	// the calls of the services have circuit breakers, timeouts, bulkheads and retries set by the hystrix config
+	breaker.Watch(config)
+	securedClient := breaker.NewClientWrapper()(cmd_service.Client())
+
+	// the requests are limited per user and organisation by the RateLimit filter
+	ratelimit.DefaultMode = ratelimit.Mode(config.Get("ratelimit", "mode").String(string(ratelimit.DefaultMode)))
+	for class, quota := range ratelimit.Defaults {
+		quota.Org = int64(config.Get("ratelimit", string(class), "org").Int(int(quota.Org)))
+		quota.User = int64(config.Get("ratelimit", string(class), "user").Int(int(quota.User)))
+	}
+	proxies, err := ratelimit.ParseProxies(config.Get("ratelimit", "trusted_proxies").StringSlice(nil))
+	if err != nil {
+		log.Fatal("Invalid trusted proxies: ", err)
+	}
+	ratelimit.TrustedProxies = proxies
 
 	// Auth
 	auth_filter := api.Filters{
@@ -81,6 +97,7 @@ func main() {
 		UserClient:         user_proto.NewUserServiceClient("go.micro.srv.user", securedClient),
 		OrganisationClient: organisation_proto.NewOrganisationServiceClient("go.micro.srv.organisation", securedClient),
 		TeamClient:         team_proto.NewTeamServiceClient("go.micro.srv.team", securedClient),
+		KvClient:           kv_proto.NewKvServiceClient("go.micro.srv.kv", securedClient),
 	}
 
 	// Audit
@@ -90,11 +107,80 @@ func main() {
 		Broker: brker,
 	}
 
+	register(securedClient, auth_filter, audit_filter, m)
+
+	// every route needs a permission
+	rbac.DefaultMode = rbac.Mode(config.Get("rbac", "mode").String(string(rbac.DefaultMode)))
+	routes := []string{}
+	for _, ws := range restful.RegisteredWebServices() {
+		for _, route := range ws.Routes() {
+			routes = append(routes, route.Method+" "+route.Path)
+		}
+	}
+	if missing := rbac.Missing(routes); len(missing) > 0 {
+		if rbac.DefaultMode == rbac.ModeEnforce {
+			log.Fatal("Routes without permission: ", missing)
+		}
+		log.Warn("Routes without permission: ", missing)
+	}
+
+	// every route is documented
+	if errs := openapi.Check(restful.RegisteredWebServices()); len(errs) > 0 {
+		log.Fatal("Routes without documentation: ", errs)
+	}
+
+	// OpenAPI document
+	openapi_service := openapi.Service{
+		Info: openapi.Info{Title: name, Description: descr, Version: version},
+		Public: func(route string) bool {
+			rule, ok := rbac.Routes[route]
+			return ok && rule.Access == rbac.AccessPublic
+		},
+	}
+	openapi_service.Register()
+
+	// tenant headers are only set by the api
+	restful.Filter(auth_filter.TenantFilter)
+
+	// accept and respond in JSON unless told otherwise
+	restful.DefaultRequestContentType(restful.MIME_JSON)
+	restful.DefaultResponseContentType(restful.MIME_JSON)
+	// gzip if accepted
+	restful.DefaultContainer.EnableContentEncoding(true)
+	// faster router
+	restful.DefaultContainer.Router(restful.CurlyRouter{})
+	// no need to access body more than once
+	restful.SetCacheReadEntity(false)
+
+	// Create service
+	service := web.NewService(
+		web.Name(name),
+		web.Version(version),
+		web.Metadata(map[string]string{"Description": descr}),
+		web.Registry(cmd_service.Options().Registry),
+		web.RegisterTTL(time.Minute),
+		web.RegisterInterval(time.Second*10),
+	)
+
+	service.Init()
+	log.SetLevel(log.DebugLevel)
+
+	// Register Handler
+	service.Handle("/", restful.DefaultContainer)
+
+	// Run server
+	if err := service.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// register registers the web services of the api
+func register(c client.Client, auth_filter api.Filters, audit_filter api.AuditFilter, m metrics.Metrics) {
 	// User API
 	user_service := api.UserService{
-		UserClient:    user_proto.NewUserServiceClient("go.micro.srv.user", securedClient),
-		UserAppClient: userapp_proto.NewUserAppServiceClient("go.micro.srv.userapp", securedClient),
-		ContentClient: content_proto.NewContentServiceClient("go.micro.srv.content", securedClient),
+		UserClient:    user_proto.NewUserServiceClient("go.micro.srv.user", c),
+		UserAppClient: userapp_proto.NewUserAppServiceClient("go.micro.srv.userapp", c),
+		ContentClient: content_proto.NewContentServiceClient("go.micro.srv.content", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -114,8 +200,8 @@ func main() {
 
 	// Organization API
 	// organization_service := api.OrganizationService{
-	// 	OrganisationClient: organisation_proto.NewOrganisationClient("healum.srv.organization", securedClient),
-	// 	UserClient:         user.NewAccountClient("go.micro.srv.user", securedClient),
+	// 	OrganisationClient: organisation_proto.NewOrganisationClient("healum.srv.organization", c),
+	// 	UserClient:         user.NewAccountClient("go.micro.srv.user", c),
 	// 	FilterMiddle:       auth_filter,
 	// 	ServerMetrics:      m,
 	// }
@@ -123,7 +209,7 @@ func main() {
 
 	// Plan API
 	plan_service := api.PlanService{
-		PlanClient:    plan_proto.NewPlanServiceClient("go.micro.srv.plan", securedClient),
+		PlanClient:    plan_proto.NewPlanServiceClient("go.micro.srv.plan", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -132,7 +218,7 @@ func main() {
 
 	// Task API
 	task_service := api.TaskService{
-		TaskClient:    task_proto.NewTaskServiceClient("go.micro.srv.task", securedClient),
+		TaskClient:    task_proto.NewTaskServiceClient("go.micro.srv.task", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -141,7 +227,7 @@ func main() {
 
 	// Todo API
 	todo_service := api.TodoService{
-		TodoClient:    todo_proto.NewTodoServiceClient("go.micro.srv.todo", securedClient),
+		TodoClient:    todo_proto.NewTodoServiceClient("go.micro.srv.todo", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -150,7 +236,7 @@ func main() {
 
 	// Note API
 	note_service := api.NoteService{
-		NoteClient:    note_proto.NewNoteServiceClient("go.micro.srv.note", securedClient),
+		NoteClient:    note_proto.NewNoteServiceClient("go.micro.srv.note", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -159,7 +245,7 @@ func main() {
 
 	// Survey API
 	survey_service := api.SurveyService{
-		SurveyClient:  survey_proto.NewSurveyServiceClient("go.micro.srv.survey", securedClient),
+		SurveyClient:  survey_proto.NewSurveyServiceClient("go.micro.srv.survey", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -168,8 +254,8 @@ func main() {
 
 	// Response API
 	response_service := api.ResponseService{
-		ResponseClient: resp_proto.NewResponseServiceClient("go.micro.srv.response", securedClient),
-		SurveyClient:   survey_proto.NewSurveyServiceClient("go.micro.srv.survey", securedClient),
+		ResponseClient: resp_proto.NewResponseServiceClient("go.micro.srv.response", c),
+		SurveyClient:   survey_proto.NewSurveyServiceClient("go.micro.srv.survey", c),
 		Auth:           auth_filter,
 		Audit:          audit_filter,
 		ServerMetrics:  m,
@@ -178,28 +264,28 @@ func main() {
 
 	// Behaviour API
 	behaviour_service := api.BehaviourService{
-		BehaviourClient:    behaviour_proto.NewBehaviourServiceClient("go.micro.srv.behaviour", securedClient),
+		BehaviourClient:    behaviour_proto.NewBehaviourServiceClient("go.micro.srv.behaviour", c),
 		Auth:               auth_filter,
 		Audit:              audit_filter,
 		ServerMetrics:      m,
-		OrganisationClient: organisation_proto.NewOrganisationServiceClient("go.micro.srv.organisation", securedClient),
-		StaticClient:       static_proto.NewStaticServiceClient("go.micro.srv.static", securedClient),
+		OrganisationClient: organisation_proto.NewOrganisationServiceClient("go.micro.srv.organisation", c),
+		StaticClient:       static_proto.NewStaticServiceClient("go.micro.srv.static", c),
 	}
 	behaviour_service.Register()
 
 	// Static API
 	static_service := api.StaticService{
-		StaticClient:  static_proto.NewStaticServiceClient("go.micro.srv.static", securedClient),
+		StaticClient:  static_proto.NewStaticServiceClient("go.micro.srv.static", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
-		ContentClient: content_proto.NewContentServiceClient("go.micro.srv.content", securedClient),
+		ContentClient: content_proto.NewContentServiceClient("go.micro.srv.content", c),
 	}
 	static_service.Register()
 
 	// Content API
 	content_service := api.ContentService{
-		ContentClient: content_proto.NewContentServiceClient("go.micro.srv.content", securedClient),
+		ContentClient: content_proto.NewContentServiceClient("go.micro.srv.content", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -208,7 +294,7 @@ func main() {
 
 	// Account API
 	account_service := api.AccountService{
-		AccountClient: account_proto.NewAccountServiceClient("go.micro.srv.account", securedClient),
+		AccountClient: account_proto.NewAccountServiceClient("go.micro.srv.account", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -217,7 +303,7 @@ func main() {
 
 	// Team API
 	team_service := api.TeamService{
-		TeamClient:    team_proto.NewTeamServiceClient("go.micro.srv.team", securedClient),
+		TeamClient:    team_proto.NewTeamServiceClient("go.micro.srv.team", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -226,7 +312,7 @@ func main() {
 
 	// Track API
 	track_service := api.TrackService{
-		TrackClient:   track_proto.NewTrackServiceClient("go.micro.srv.track", securedClient),
+		TrackClient:   track_proto.NewTrackServiceClient("go.micro.srv.track", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -235,7 +321,7 @@ func main() {
 
 	// Organisation API
 	org_service := api.OrganisationService{
-		OrganisationClient: organisation_proto.NewOrganisationServiceClient("go.micro.srv.organisation", securedClient),
+		OrganisationClient: organisation_proto.NewOrganisationServiceClient("go.micro.srv.organisation", c),
 		Auth:               auth_filter,
 		Audit:              audit_filter,
 		ServerMetrics:      m,
@@ -244,7 +330,7 @@ func main() {
 
 	// UserApp API
 	userapp_service := api.UserAppService{
-		UserAppClient: userapp_proto.NewUserAppServiceClient("go.micro.srv.userapp", securedClient),
+		UserAppClient: userapp_proto.NewUserAppServiceClient("go.micro.srv.userapp", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
@@ -253,35 +339,10 @@ func main() {
 
 	// Product API
 	product_service := api.ProductService{
-		ProductClient: product_proto.NewProductServiceClient("go.micro.srv.product", securedClient),
+		ProductClient: product_proto.NewProductServiceClient("go.micro.srv.product", c),
 		Auth:          auth_filter,
 		Audit:         audit_filter,
 		ServerMetrics: m,
 	}
 	product_service.Register()
-
-	// Swagger service
-	swagger_service := utils.SwaggerService{Config: config}
-	swagger_service.Register()
-
-	// Create service
-	service := web.NewService(
-		web.Name(name),
-		web.Version(version),
-		web.Metadata(map[string]string{"Description": descr}),
-		web.Registry(cmd_service.Options().Registry),
-		web.RegisterTTL(time.Minute),
-		web.RegisterInterval(time.Second*10),
-	)
-
-	service.Init()
-	log.SetLevel(log.DebugLevel)
-
-	// Register Handler
-	service.Handle("/", restful.DefaultContainer)
-
-	// Run server
-	if err := service.Run(); err != nil {
-		log.Fatal(err)
-	}
 }
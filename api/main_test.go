@@ -0,0 +1,32 @@
+package main
+
+import (
+	"testing"
+
+	"server/api/api"
+	"server/api/openapi"
+	"server/api/rbac"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/client"
+)
+
+func TestOpenAPI(t *testing.T) {
+	register(client.NewClient(), api.Filters{}, api.AuditFilter{}, nil)
+	if len(restful.RegisteredWebServices()) == 0 {
+		t.Fatal("Web services must be registered")
+	}
+	for _, err := range openapi.Check(restful.RegisteredWebServices()) {
+		t.Error(err)
+	}
+
+	routes := []string{}
+	for _, ws := range restful.RegisteredWebServices() {
+		for _, route := range ws.Routes() {
+			routes = append(routes, route.Method+" "+route.Path)
+		}
+	}
+	if missing := rbac.Missing(routes); len(missing) > 0 {
+		t.Errorf("Routes without permission: %v", missing)
+	}
+}
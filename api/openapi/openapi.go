@@ -0,0 +1,317 @@
+// Package openapi generates the OpenAPI 3 document of the restful web services of the api from their routes:
+//
+//   - the tag of an operation is the Doc of its web service
+//   - the operation id, the summary and the parameters are the Operation, the Doc and the Params of the route
+//   - the request and response schemas are derived from the Reads and Writes samples of the route, the protobuf
+//     messages of the services
+//
+// The schemas follow the json tags of the messages, as they are written by go-restful. The responses written with
+// utils.MarshalAny use the protobuf JSON mapping instead, where the 64-bit integers are strings and the enums are
+// names. Check lists the routes which can't be documented.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"server/api/utils"
+
+	"github.com/emicklei/go-restful"
+)
+
+// Version is the version of the OpenAPI specification of the documents
+const Version = "3.0.3"
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Tags       []*Tag                           `json:"tags,omitempty"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+	Security   []map[string][]string            `json:"security,omitempty"`
+}
+
+// Info is the description of the api
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Tag groups the operations of a web service
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Operation is a route of a web service
+type Operation struct {
+	Tags        []string             `json:"tags,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	OperationId string               `json:"operationId"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+	// Security overrides the security of the document, it is empty for the public routes
+	Security *[]map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter is a path, query or header parameter of an operation
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is the payload of the request of an operation
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is a response of an operation
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType is the schema of a payload
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components are the schemas and the security schemes referenced by the document
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is an authentication of the requests
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Description  string `json:"description,omitempty"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// the security schemes of the api, see the Authentication of the README
+var securitySchemes = map[string]*SecurityScheme{
+	"bearer": {
+		Type:         "http",
+		Description:  "Access token of account-srv",
+		Scheme:       "bearer",
+		BearerFormat: "JWT",
+	},
+	"session": {
+		Type:        "apiKey",
+		Description: "Session of account-srv",
+		In:          "query",
+		Name:        "session",
+	},
+}
+
+// pathParameter matches the parameters of a route path, {name} or {name:regexp}
+var pathParameter = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// operationId matches the valid operation ids, go-restful derives the operation of a route without one from the
+// name of its function, funcN for the anonymous functions
+var (
+	operationId   = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	anonymousFunc = regexp.MustCompile(`^func[0-9]+$`)
+)
+
+// Build returns the document of the routes of services, public tells if a route, "METHOD /path", doesn't
+// authenticate its requests
+func Build(info Info, services []*restful.WebService, public func(route string) bool) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]map[string]*Operation{},
+		Components: Components{
+			Schemas:         map[string]*Schema{},
+			SecuritySchemes: securitySchemes,
+		},
+		Security: []map[string][]string{{"bearer": {}}, {"session": {}}},
+	}
+	s := &schemas{doc.Components.Schemas}
+	errResponse := s.schema(reflect.TypeOf(utils.ErrResponse{}))
+
+	for _, ws := range services {
+		tags := []string{}
+		if tag := ws.Documentation(); len(tag) > 0 {
+			tags = append(tags, tag)
+			doc.Tags = append(doc.Tags, &Tag{Name: tag, Description: ws.RootPath()})
+		}
+
+		for _, route := range ws.Routes() {
+			path := pathParameter.ReplaceAllString(route.Path, "{$1}")
+			op := &Operation{
+				Tags:        tags,
+				Summary:     route.Doc,
+				Description: route.Notes,
+				OperationId: route.Operation,
+				Parameters:  parameters(route),
+				Responses:   map[string]*Response{},
+			}
+			if public != nil && public(route.Method+" "+route.Path) {
+				op.Security = &[]map[string][]string{}
+			}
+
+			// request
+			if route.ReadSample != nil {
+				op.RequestBody = &RequestBody{
+					Required: true,
+					Content:  content(restful.MIME_JSON, s.schema(reflect.TypeOf(route.ReadSample))),
+				}
+			} else if form := formSchema(route); form != nil {
+				mime := "multipart/form-data"
+				if len(route.Consumes) > 0 {
+					mime = route.Consumes[0]
+				}
+				op.RequestBody = &RequestBody{Required: len(form.Required) > 0, Content: content(mime, form)}
+			}
+
+			// responses
+			if route.WriteSample != nil {
+				op.Responses[strconv.Itoa(http.StatusOK)] = &Response{
+					Description: http.StatusText(http.StatusOK),
+					Content:     content(restful.MIME_JSON, s.schema(reflect.TypeOf(route.WriteSample))),
+				}
+			}
+			for code, e := range route.ResponseErrors {
+				r := &Response{Description: e.Message}
+				if e.Model != nil {
+					r.Content = content(restful.MIME_JSON, s.schema(reflect.TypeOf(e.Model)))
+				}
+				op.Responses[strconv.Itoa(code)] = r
+			}
+			op.Responses["default"] = &Response{
+				Description: "Error",
+				Content:     content(restful.MIME_JSON, errResponse),
+			}
+
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = map[string]*Operation{}
+			}
+			doc.Paths[path][strings.ToLower(route.Method)] = op
+		}
+	}
+	return doc
+}
+
+// Check returns the routes of services without operation id, tags or response type, and the routes and operation
+// ids registered twice
+func Check(services []*restful.WebService) []error {
+	errs := []error{}
+	routes := map[string]bool{}
+	operations := map[string]string{}
+	for _, ws := range services {
+		for _, route := range ws.Routes() {
+			key := route.Method + " " + route.Path
+			if routes[key] {
+				errs = append(errs, fmt.Errorf("%v is registered twice", key))
+				continue
+			}
+			routes[key] = true
+
+			if len(ws.Documentation()) == 0 {
+				errs = append(errs, fmt.Errorf("%v has no tags, the Doc of its web service", key))
+			}
+			if !operationId.MatchString(route.Operation) || anonymousFunc.MatchString(route.Operation) {
+				errs = append(errs, fmt.Errorf("%v has no operation id", key))
+			} else if other, ok := operations[route.Operation]; ok {
+				errs = append(errs, fmt.Errorf("%v has the operation id %v of %v", key, route.Operation, other))
+			} else {
+				operations[route.Operation] = key
+			}
+			if route.WriteSample == nil {
+				errs = append(errs, fmt.Errorf("%v has no response type", key))
+			}
+		}
+	}
+	return errs
+}
+
+// parameters returns the path, query and header parameters of a route, every path parameter is documented
+func parameters(route restful.Route) []*Parameter {
+	params := []*Parameter{}
+	documented := map[string]bool{}
+	for _, p := range route.ParameterDocs {
+		data := p.Data()
+		in := ""
+		switch data.Kind {
+		case restful.PathParameterKind:
+			in = "path"
+		case restful.QueryParameterKind:
+			in = "query"
+		case restful.HeaderParameterKind:
+			in = "header"
+		default:
+			continue
+		}
+		if in == "path" {
+			documented[data.Name] = true
+		}
+		params = append(params, &Parameter{
+			Name:        data.Name,
+			In:          in,
+			Description: data.Description,
+			Required:    data.Required || in == "path",
+			Schema:      dataTypeSchema(data.DataType),
+		})
+	}
+	for _, m := range pathParameter.FindAllStringSubmatch(route.Path, -1) {
+		if !documented[m[1]] {
+			params = append(params, &Parameter{Name: m[1], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+	}
+	return params
+}
+
+// formSchema returns the schema of the form parameters of a route, or nil
+func formSchema(route restful.Route) *Schema {
+	var form *Schema
+	for _, p := range route.ParameterDocs {
+		data := p.Data()
+		if data.Kind != restful.FormParameterKind {
+			continue
+		}
+		if form == nil {
+			form = &Schema{Type: "object", Properties: map[string]*Schema{}}
+		}
+		schema := dataTypeSchema(data.DataType)
+		schema.Description = data.Description
+		form.Properties[data.Name] = schema
+		if data.Required {
+			form.Required = append(form.Required, data.Name)
+		}
+	}
+	return form
+}
+
+// dataTypeSchema returns the schema of the DataType of a parameter
+func dataTypeSchema(dataType string) *Schema {
+	switch dataType {
+	case "integer":
+		return &Schema{Type: "integer", Format: "int64"}
+	case "boolean", "number":
+		return &Schema{Type: dataType}
+	case "file":
+		return &Schema{Type: "string", Format: "binary"}
+	}
+	return &Schema{Type: "string"}
+}
+
+func content(mime string, schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{mime: {Schema: schema}}
+}
@@ -0,0 +1,110 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	note_proto "server/note-srv/proto/note"
+	static_proto "server/static-srv/proto/static"
+
+	"github.com/emicklei/go-restful"
+)
+
+func handler(req *restful.Request, rsp *restful.Response) {}
+
+func services() []*restful.WebService {
+	ws := new(restful.WebService)
+	ws.Path("/server/notes")
+	ws.Doc("Notes")
+	ws.Route(ws.POST("/note").To(handler).
+		Doc("Create a note").
+		Operation("CreateNote").
+		Reads(note_proto.CreateRequest{}).
+		Writes(note_proto.CreateResponse{}))
+	ws.Route(ws.GET("/note/{note_id}").To(handler).
+		Doc("Read a note").
+		Param(ws.QueryParameter("limit", "Maximum count").DataType("integer")).
+		Operation("ReadNote").
+		Writes(note_proto.ReadResponse{}))
+	ws.Route(ws.POST("/upload").To(handler).
+		Consumes("multipart/form-data").
+		Param(ws.FormParameter("upload_file", "CSV file").DataType("file").Required(true)).
+		Operation("Upload").
+		Writes(static_proto.CreateRoleResponse{}))
+	return []*restful.WebService{ws}
+}
+
+func TestBuild(t *testing.T) {
+	doc := Build(Info{Title: "api", Version: "1"}, services(), func(route string) bool {
+		return route == "POST /server/notes/upload"
+	})
+	if _, err := json.Marshal(doc); err != nil {
+		t.Fatal(err)
+	}
+
+	create := doc.Paths["/server/notes/note"]["post"]
+	if create == nil || create.OperationId != "CreateNote" || create.Tags[0] != "Notes" || create.Security != nil {
+		t.Fatalf("Operation is invalid: %+v", create)
+	}
+	if ref := create.RequestBody.Content[restful.MIME_JSON].Schema.Ref; ref != "#/components/schemas/go.micro.srv.note.CreateRequest" {
+		t.Errorf("Request schema is invalid: %v", ref)
+	}
+	if ref := create.Responses["200"].Content[restful.MIME_JSON].Schema.Ref; ref != "#/components/schemas/go.micro.srv.note.CreateResponse" {
+		t.Errorf("Response schema is invalid: %v", ref)
+	}
+	if create.Responses["default"] == nil {
+		t.Error("Error response is missing")
+	}
+	note := doc.Components.Schemas["go.micro.srv.note.Note"]
+	if note == nil || note.Properties["org_id"] == nil || note.Properties["created"].Type != "integer" {
+		t.Errorf("Message schema is invalid: %+v", note)
+	}
+
+	read := doc.Paths["/server/notes/note/{note_id}"]["get"]
+	if len(read.Parameters) != 2 || read.Parameters[0].In != "query" || read.Parameters[1].Name != "note_id" || !read.Parameters[1].Required {
+		t.Errorf("Parameters are invalid: %+v", read.Parameters)
+	}
+
+	upload := doc.Paths["/server/notes/upload"]["post"]
+	if upload.Security == nil || len(*upload.Security) != 0 {
+		t.Errorf("Public operation must have no security: %+v", upload.Security)
+	}
+	if form := upload.RequestBody.Content["multipart/form-data"]; form == nil || form.Schema.Properties["upload_file"].Format != "binary" {
+		t.Errorf("Form is invalid: %+v", upload.RequestBody)
+	}
+	role := doc.Components.Schemas["go.micro.srv.static.Role"]
+	if perms := role.Properties["permissions"]; perms.Type != "array" || len(perms.Items.Enum) != 5 || !strings.Contains(perms.Items.Description, "4 OWNER") {
+		t.Errorf("Enum schema is invalid: %+v", perms.Items)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if errs := Check(services()); len(errs) != 0 {
+		t.Errorf("Documented routes must pass: %v", errs)
+	}
+
+	ws := new(restful.WebService)
+	ws.Path("/server/todos")
+	anonymous := func(req *restful.Request, rsp *restful.Response) {}
+	ws.Route(ws.GET("/all").To(anonymous).Doc("List todos"))
+	ws.Route(ws.GET("/all").To(anonymous).Doc("List todos"))
+	ws.Route(ws.GET("/todo").To(handler).Operation("CreateNote").Writes(note_proto.ReadResponse{}))
+	errs := Check(append(services(), ws))
+	expected := []string{
+		"GET /server/todos/all has no tags, the Doc of its web service",
+		"GET /server/todos/all has no operation id",
+		"GET /server/todos/all has no response type",
+		"GET /server/todos/all is registered twice",
+		"GET /server/todos/todo has no tags, the Doc of its web service",
+		"GET /server/todos/todo has the operation id CreateNote of POST /server/notes/note",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("Check is invalid: %v", errs)
+	}
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Errorf("Error is invalid: %v, expected %v", err, expected[i])
+		}
+	}
+}
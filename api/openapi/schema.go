@@ -0,0 +1,157 @@
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// Schema is the schema of a payload or of a parameter
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+}
+
+// the schemas of the well-known types of protobuf
+var wellKnown = map[string]*Schema{
+	"google.protobuf.Any": {
+		Type:                 "object",
+		Properties:           map[string]*Schema{"@type": {Type: "string"}},
+		AdditionalProperties: true,
+	},
+	"google.protobuf.Struct":      {Type: "object", AdditionalProperties: true},
+	"google.protobuf.Value":       {},
+	"google.protobuf.ListValue":   {Type: "array", Items: &Schema{}},
+	"google.protobuf.BoolValue":   {Type: "boolean"},
+	"google.protobuf.StringValue": {Type: "string"},
+	"google.protobuf.BytesValue":  {Type: "string", Format: "byte"},
+	"google.protobuf.Int32Value":  {Type: "integer", Format: "int32"},
+	"google.protobuf.UInt32Value": {Type: "integer", Format: "int32"},
+	"google.protobuf.Int64Value":  {Type: "integer", Format: "int64"},
+	"google.protobuf.UInt64Value": {Type: "integer", Format: "int64"},
+	"google.protobuf.FloatValue":  {Type: "number", Format: "float"},
+	"google.protobuf.DoubleValue": {Type: "number", Format: "double"},
+}
+
+var messageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+// schemas collects the schemas of the structs of a document, by the full name of their message
+type schemas struct {
+	components map[string]*Schema
+}
+
+// schema returns the schema of t, the structs are referenced from the components
+func (s *schemas) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int32, reflect.Uint32, reflect.Int8, reflect.Uint8, reflect.Int16, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Uint, reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+	case reflect.Struct:
+		return s.ref(t)
+	}
+	// interfaces
+	return &Schema{}
+}
+
+// ref returns the reference of the schema of the struct t
+func (s *schemas) ref(t reflect.Type) *Schema {
+	name := t.String()
+	if reflect.PtrTo(t).Implements(messageType) {
+		name = proto.MessageName(reflect.New(t).Interface().(proto.Message))
+		if schema, ok := wellKnown[name]; ok {
+			return schema
+		}
+	}
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+	if _, ok := s.components[name]; ok {
+		return ref
+	}
+
+	// registered first for the recursive messages
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	s.components[name] = schema
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if len(f.PkgPath) > 0 || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		field := strings.Split(f.Tag.Get("json"), ",")[0]
+		if field == "-" {
+			continue
+		}
+		if len(field) == 0 {
+			field = f.Name
+		}
+		if enum := enumSchema(f); enum != nil {
+			schema.Properties[field] = enum
+			continue
+		}
+		schema.Properties[field] = s.schema(f.Type)
+	}
+	return ref
+}
+
+// enumSchema returns the schema of a field of a protobuf enum, or nil
+func enumSchema(f reflect.StructField) *Schema {
+	name := ""
+	for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(part, "enum=") {
+			name = strings.TrimPrefix(part, "enum=")
+		}
+	}
+	if len(name) == 0 {
+		return nil
+	}
+	values := proto.EnumValueMap(name)
+	if len(values) == 0 {
+		return nil
+	}
+
+	numbers := []int{}
+	names := map[int]string{}
+	for n, v := range values {
+		numbers = append(numbers, int(v))
+		names[int(v)] = n
+	}
+	sort.Ints(numbers)
+	enum := &Schema{Type: "integer", Format: "int32"}
+	desc := []string{}
+	for _, v := range numbers {
+		enum.Enum = append(enum.Enum, v)
+		desc = append(desc, fmt.Sprintf("%v %v", v, names[v]))
+	}
+	enum.Description = strings.Join(desc, ", ")
+	if f.Type.Kind() == reflect.Slice {
+		return &Schema{Type: "array", Items: enum}
+	}
+	return enum
+}
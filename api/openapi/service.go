@@ -0,0 +1,42 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	log "github.com/sirupsen/logrus"
+)
+
+// Path is the root path of the documents, versioned by the major version of the specification
+const Path = "/server/openapi/v3"
+
+// Service serves the document of the web services registered before it
+type Service struct {
+	Info Info
+	// Public tells if a route, "METHOD /path", doesn't authenticate its requests
+	Public func(route string) bool
+}
+
+// Register builds the document and registers its route
+func (s Service) Register() {
+	body, err := json.Marshal(Build(s.Info, restful.RegisteredWebServices(), s.Public))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ws := new(restful.WebService)
+
+	ws.Path(Path)
+	ws.Doc("OpenAPI")
+	ws.Route(ws.GET("/openapi.json").To(func(req *restful.Request, rsp *restful.Response) {
+		rsp.AddHeader("Content-Type", "application/json")
+		rsp.WriteHeader(http.StatusOK)
+		rsp.Write(body)
+	}).
+		Doc("Returns the OpenAPI document of the api").
+		Operation("OpenAPI").
+		Writes(Document{}))
+
+	restful.Add(ws)
+}
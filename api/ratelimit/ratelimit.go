@@ -0,0 +1,251 @@
+// Package ratelimit limits the requests of the api per user and per organisation. The requests are counted by
+// kv-srv in windows shared by the replicas of the api, a window starts with its first request. The requests are
+// classed by their route:
+//
+//   - read, the GET requests
+//   - write, the other requests
+//   - public, the requests of the routes without authentication, counted per client address
+//
+// The quotas of an organisation are set by the rate_limit of its setting, the zero values are the Defaults.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"server/common"
+	kv_proto "server/kv-srv/proto/kv"
+	organisation_proto "server/organisation-srv/proto/organisation"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Mode is the enforcement of the quotas
+type Mode string
+
+const (
+	// ModeOff doesn't count the requests
+	ModeOff Mode = "off"
+	// ModeLog logs the requests over quota
+	ModeLog Mode = "log"
+	// ModeEnforce rejects the requests over quota
+	ModeEnforce Mode = "enforce"
+)
+
+// DefaultMode is set from the ratelimit.mode config of the api
+var DefaultMode = ModeEnforce
+
+// Class is the class of the route of a request
+type Class string
+
+const (
+	ClassRead   Class = "read"
+	ClassWrite  Class = "write"
+	ClassPublic Class = "public"
+)
+
+// Window is the duration of the windows of the quotas
+var Window = time.Minute
+
+// Quota is the requests allowed per Window, a quota of zero or less is unlimited
+type Quota struct {
+	Org  int64
+	User int64
+}
+
+// Defaults are the quotas of the organisations without rate_limit setting, they are set from the ratelimit config
+// of the api. The User quota of ClassPublic is per client address.
+var Defaults = map[Class]*Quota{
+	ClassRead:   {Org: 6000, User: 600},
+	ClassWrite:  {Org: 1200, User: 120},
+	ClassPublic: {User: 30},
+}
+
+// RouteClass returns the class of the request of method to an authenticated route
+func RouteClass(method string) Class {
+	if method == http.MethodGet || method == http.MethodHead {
+		return ClassRead
+	}
+	return ClassWrite
+}
+
+// Quotas returns the quota of class for an organisation with the rate limits of setting, which can be nil
+func Quotas(class Class, setting *organisation_proto.RateLimit) Quota {
+	q := Quota{}
+	if d, ok := Defaults[class]; ok {
+		q = *d
+	}
+	if setting == nil {
+		return q
+	}
+	org, user := setting.OrgRead, setting.UserRead
+	if class == ClassWrite {
+		org, user = setting.OrgWrite, setting.UserWrite
+	}
+	if org > 0 {
+		q.Org = org
+	}
+	if user > 0 {
+		q.User = user
+	}
+	return q
+}
+
+// Limit is the most restrictive quota of a request
+type Limit struct {
+	Limit     int64
+	Remaining int64
+	// Reset is the duration until the window of the quota ends
+	Reset time.Duration
+	// Exceeded is set if the request is over the quota
+	Exceeded bool
+}
+
+// SetHeaders sets the RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers of the limit, and
+// Retry-After if it is exceeded
+func (l *Limit) SetHeaders(h http.Header) {
+	reset := strconv.FormatInt(int64(math.Ceil(l.Reset.Seconds())), 10)
+	h.Set("RateLimit-Limit", strconv.FormatInt(l.Limit, 10))
+	h.Set("RateLimit-Remaining", strconv.FormatInt(l.Remaining, 10))
+	h.Set("RateLimit-Reset", reset)
+	if l.Exceeded {
+		h.Set("Retry-After", reset)
+	}
+}
+
+// Error returns the error of an exceeded limit
+func (l *Limit) Error() error {
+	return fmt.Errorf("rate limit of %v requests per %v is exceeded, retry in %v", l.Limit, Window, l.Reset)
+}
+
+// Check counts a request of class by user, and by org if it isn't empty, and returns the most restrictive of their
+// limits, or nil if they are unlimited
+func Check(ctx context.Context, kv kv_proto.KvServiceClient, class Class, user, org string, quota Quota) (*Limit, error) {
+	keys := []string{}
+	quotas := []int64{}
+	if quota.User > 0 {
+		keys = append(keys, fmt.Sprintf("ratelimit:%v:user:%v", class, user))
+		quotas = append(quotas, quota.User)
+	}
+	if quota.Org > 0 && len(org) > 0 {
+		keys = append(keys, fmt.Sprintf("ratelimit:%v:org:%v", class, org))
+		quotas = append(quotas, quota.Org)
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	rsp, err := kv.RateLimit(ctx, &kv_proto.RateLimitRequest{
+		Index:  common.RATE_LIMIT_INDEX,
+		Keys:   keys,
+		Window: int64(Window),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp.Counts) != len(keys) || len(rsp.Resets) != len(keys) {
+		return nil, fmt.Errorf("rate limit response of %v keys is invalid", len(keys))
+	}
+
+	var limit *Limit
+	for i, q := range quotas {
+		reset := time.Duration(rsp.Resets[i])
+		if reset <= 0 || reset > Window {
+			reset = Window
+		}
+		l := &Limit{Limit: q, Remaining: q - rsp.Counts[i], Reset: reset, Exceeded: rsp.Counts[i] > q}
+		if l.Remaining < 0 {
+			l.Remaining = 0
+		}
+		// the exceeded quota which resets last, or the one with the fewest remaining requests
+		switch {
+		case limit == nil:
+			limit = l
+		case l.Exceeded != limit.Exceeded:
+			if l.Exceeded {
+				limit = l
+			}
+		case l.Exceeded && l.Reset > limit.Reset, !l.Exceeded && l.Remaining < limit.Remaining:
+			limit = l
+		}
+	}
+	return limit, nil
+}
+
+// Reject checks if the request of route is rejected by limit, the exceeded limits are only logged in ModeLog
+func Reject(route string, limit *Limit) bool {
+	if limit == nil || !limit.Exceeded || DefaultMode == ModeOff {
+		return false
+	}
+	log.WithField("route", route).Warn("Rate limit exceeded: ", limit.Error())
+	return DefaultMode == ModeEnforce
+}
+
+// TrustedProxies are the addresses of the proxies in front of the api, set from the ratelimit.trusted_proxies config.
+// The X-Forwarded-For header of the other clients isn't trusted.
+var TrustedProxies []*net.IPNet
+
+// ParseProxies parses the addresses or CIDR ranges of the trusted proxies
+func ParseProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := []*net.IPNet{}
+	for _, p := range proxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid proxy address %v", p)
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+func trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range TrustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientAddress returns the address of the client of a public request. The request comes from RemoteAddr, unless
+// it is a trusted proxy: the addresses of X-Forwarded-For are then read from the last one, the first address which
+// isn't a trusted proxy is the client, the previous ones are set by the client.
+func ClientAddress(r *http.Request) string {
+	addr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		addr = r.RemoteAddr
+	}
+	if !trusted(addr) {
+		return addr
+	}
+	forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		a := strings.TrimSpace(forwarded[i])
+		if len(a) == 0 {
+			continue
+		}
+		addr = a
+		if !trusted(a) {
+			break
+		}
+	}
+	return addr
+}
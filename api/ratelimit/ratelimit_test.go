@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	kv_proto "server/kv-srv/proto/kv"
+	organisation_proto "server/organisation-srv/proto/organisation"
+
+	"github.com/micro/go-micro/client"
+)
+
+// counter counts the requests of the keys in memory, the windows never end
+type counter struct {
+	kv_proto.KvServiceClient
+	counts map[string]int64
+}
+
+func (c *counter) RateLimit(ctx context.Context, in *kv_proto.RateLimitRequest, opts ...client.CallOption) (*kv_proto.RateLimitResponse, error) {
+	rsp := &kv_proto.RateLimitResponse{}
+	for _, key := range in.Keys {
+		c.counts[key]++
+		rsp.Counts = append(rsp.Counts, c.counts[key])
+		rsp.Resets = append(rsp.Resets, int64(30*time.Second))
+	}
+	return rsp, nil
+}
+
+func TestQuotas(t *testing.T) {
+	if q := Quotas(ClassRead, nil); q != *Defaults[ClassRead] {
+		t.Errorf("Quota without setting must be the default: %+v", q)
+	}
+	setting := &organisation_proto.RateLimit{OrgWrite: 10, UserRead: 5}
+	if q := Quotas(ClassWrite, setting); q.Org != 10 || q.User != Defaults[ClassWrite].User {
+		t.Errorf("Write quota is invalid: %+v", q)
+	}
+	if q := Quotas(ClassRead, setting); q.Org != Defaults[ClassRead].Org || q.User != 5 {
+		t.Errorf("Read quota is invalid: %+v", q)
+	}
+	if RouteClass(http.MethodGet) != ClassRead || RouteClass(http.MethodDelete) != ClassWrite {
+		t.Error("Route class is invalid")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	kv := &counter{counts: map[string]int64{}}
+	ctx := context.TODO()
+	quota := Quota{Org: 3, User: 2}
+
+	limit, err := Check(ctx, kv, ClassRead, "u1", "org1", quota)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limit.Limit != 2 || limit.Remaining != 1 || limit.Reset != 30*time.Second || limit.Exceeded {
+		t.Errorf("User limit must be the most restrictive: %+v", limit)
+	}
+
+	// the organisation quota is shared by its users
+	Check(ctx, kv, ClassRead, "u2", "org1", quota)
+	Check(ctx, kv, ClassRead, "u3", "org1", quota)
+	limit, _ = Check(ctx, kv, ClassRead, "u4", "org1", quota)
+	if !limit.Exceeded || limit.Limit != 3 || limit.Remaining != 0 {
+		t.Errorf("Organisation limit must be exceeded: %+v", limit)
+	}
+
+	// the classes are counted apart
+	if limit, _ = Check(ctx, kv, ClassWrite, "u1", "org1", quota); limit.Exceeded {
+		t.Errorf("Write limit must not be exceeded: %+v", limit)
+	}
+	if limit, _ = Check(ctx, kv, ClassRead, "u1", "", Quota{}); limit != nil {
+		t.Errorf("Unlimited quota must have no limit: %+v", limit)
+	}
+}
+
+func TestReject(t *testing.T) {
+	defer func(mode Mode) { DefaultMode = mode }(DefaultMode)
+	limit := &Limit{Limit: 2, Reset: 1500 * time.Millisecond, Exceeded: true}
+
+	DefaultMode = ModeLog
+	if Reject("GET /server/notes/all", limit) {
+		t.Error("Log mode must not reject")
+	}
+	DefaultMode = ModeEnforce
+	if !Reject("GET /server/notes/all", limit) {
+		t.Error("Exceeded limit must be rejected")
+	}
+	if Reject("GET /server/notes/all", &Limit{Limit: 2, Remaining: 1}) {
+		t.Error("Limit which isn't exceeded must not be rejected")
+	}
+
+	h := http.Header{}
+	limit.SetHeaders(h)
+	if h.Get("RateLimit-Limit") != "2" || h.Get("RateLimit-Remaining") != "0" || h.Get("RateLimit-Reset") != "2" || h.Get("Retry-After") != "2" {
+		t.Errorf("Headers are invalid: %v", h)
+	}
+}
+
+func TestClientAddress(t *testing.T) {
+	r := &http.Request{RemoteAddr: "10.0.0.1:5000", Header: http.Header{}}
+	if addr := ClientAddress(r); addr != "10.0.0.1" {
+		t.Errorf("Address is invalid: %v", addr)
+	}
+	r.Header.Set("X-Forwarded-For", "1.1.1.1, 2.2.2.2")
+	if addr := ClientAddress(r); addr != "10.0.0.1" {
+		t.Errorf("Forwarded address of an untrusted client must be ignored: %v", addr)
+	}
+
+	proxies, err := ParseProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	TrustedProxies = proxies
+	defer func() { TrustedProxies = nil }()
+	if addr := ClientAddress(r); addr != "2.2.2.2" {
+		t.Errorf("Forwarded address is invalid: %v", addr)
+	}
+	r.Header.Set("X-Forwarded-For", "1.1.1.1, 2.2.2.2, 192.168.1.1")
+	if addr := ClientAddress(r); addr != "2.2.2.2" {
+		t.Errorf("Trusted proxies must be skipped: %v", addr)
+	}
+	r.Header.Del("X-Forwarded-For")
+	if addr := ClientAddress(r); addr != "10.0.0.1" {
+		t.Errorf("Address of a proxy without X-Forwarded-For is invalid: %v", addr)
+	}
+	if _, err := ParseProxies([]string{"proxy"}); err == nil {
+		t.Error("Invalid proxy must be rejected")
+	}
+}
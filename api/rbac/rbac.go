@@ -0,0 +1,208 @@
+// Package rbac checks the permissions of the employees on the routes of the api. The effective permissions of an
+// employee are resolved from:
+//
+//   - the modules of the organisation the employee has access to, see team-srv CreateEmployeeModuleAccess
+//   - the permissions of its overall role, see team-srv PutEmployeeInfo
+//   - the permissions of the roles of its team memberships, which only apply to the routes of their team
+//   - the owner of the organisation, who has every permission on every module of the organisation
+//
+// The permissions are ordered, VIEW < CREATE < DELETE < OWNER, and a permission grants the lower ones. The roles
+// don't name resource types, so the permission of an employee on a module applies to every resource type of it.
+// The permission required by every route is declared in Routes.
+package rbac
+
+import (
+	"fmt"
+
+	organisation_proto "server/organisation-srv/proto/organisation"
+	static_proto "server/static-srv/proto/static"
+	team_proto "server/team-srv/proto/team"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Mode is the enforcement of the permissions
+type Mode string
+
+const (
+	// ModeOff doesn't check the permissions
+	ModeOff Mode = "off"
+	// ModeLog logs the requests without the permission of their route
+	ModeLog Mode = "log"
+	// ModeEnforce rejects the requests without the permission of their route
+	ModeEnforce Mode = "enforce"
+)
+
+// DefaultMode is set from the rbac.mode config of the api
+var DefaultMode = ModeEnforce
+
+// Access is the kind of user allowed on a route
+type Access int
+
+const (
+	// AccessEmployee routes require a permission of the employee
+	AccessEmployee Access = iota
+	// AccessUser routes are allowed to every authenticated user
+	AccessUser
+	// AccessPublic routes don't authenticate their requests
+	AccessPublic
+)
+
+// Rule is the permission required by a route
+type Rule struct {
+	Access Access
+	// Module is the name_slug of the module of the route, the routes of the platform features have none
+	Module string
+	// Resource is the type of the resources of the route
+	Resource   string
+	Permission static_proto.Permission
+	// Team is the path parameter of the team of the route, the roles of the employee in the team apply to it
+	Team string
+}
+
+// PermissionError is the permission missing to a request
+type PermissionError struct {
+	Rule *Rule
+}
+
+func (e *PermissionError) Error() string {
+	if len(e.Rule.Module) == 0 {
+		return fmt.Sprintf("%v permission on %v is required", e.Rule.Permission, e.Rule.Resource)
+	}
+	return fmt.Sprintf("%v permission on %v of module %v is required", e.Rule.Permission, e.Rule.Resource, e.Rule.Module)
+}
+
+// Policy is the effective permissions of an employee
+type Policy struct {
+	// Modules are the permissions per module name_slug
+	Modules map[string]static_proto.Permission
+	// Platform is the permission on the platform features
+	Platform static_proto.Permission
+	// Teams are the permissions of the team roles per team id, then per module name_slug, the platform features
+	// under the "" module
+	Teams map[string]map[string]static_proto.Permission
+}
+
+// highest returns the highest permission of a role
+func highest(role *static_proto.Role) static_proto.Permission {
+	permission := static_proto.Permission_Permission_NONE
+	if role == nil {
+		return permission
+	}
+	for _, p := range role.Permissions {
+		if p > permission {
+			permission = p
+		}
+	}
+	return permission
+}
+
+// Resolve returns the effective permissions of the employee of userId in the organisation of org
+func Resolve(userId string, employee *team_proto.EmployeeInfo, org *organisation_proto.OrgInfo) *Policy {
+	policy := &Policy{Modules: map[string]static_proto.Permission{}, Teams: map[string]map[string]static_proto.Permission{}}
+	if org == nil {
+		return policy
+	}
+
+	// the owner manages the whole organisation
+	if org.Owner != nil && len(org.Owner.Id) > 0 && org.Owner.Id == userId {
+		policy.Platform = static_proto.Permission_OWNER
+		for _, m := range org.Modules {
+			policy.Modules[m.NameSlug] = static_proto.Permission_OWNER
+		}
+		return policy
+	}
+	if employee == nil || employee.Employee == nil || employee.OrgId != org.OrgId {
+		return policy
+	}
+
+	// the modules of the employee which are still modules of the organisation
+	orgModules := map[string]bool{}
+	for _, m := range org.Modules {
+		orgModules[m.Id] = true
+	}
+	modules := []string{}
+	for _, m := range employee.Employee.Modules {
+		if orgModules[m.Id] && len(m.NameSlug) > 0 {
+			modules = append(modules, m.NameSlug)
+		}
+	}
+
+	// the overall role applies to the whole organisation
+	if policy.Platform = highest(employee.Role); policy.Platform != static_proto.Permission_Permission_NONE {
+		for _, m := range modules {
+			policy.Modules[m] = policy.Platform
+		}
+	}
+	// a team role only applies to its team
+	for _, tr := range employee.TeamRoles {
+		permission := highest(tr.Role)
+		if len(tr.TeamId) == 0 || permission == static_proto.Permission_Permission_NONE {
+			continue
+		}
+		team, ok := policy.Teams[tr.TeamId]
+		if !ok {
+			team = map[string]static_proto.Permission{}
+			policy.Teams[tr.TeamId] = team
+		}
+		for _, m := range append(modules, platform) {
+			if permission > team[m] {
+				team[m] = permission
+			}
+		}
+	}
+	return policy
+}
+
+// Allows checks if the policy has the permission of a rule, teamId is the team of the request if the rule has one
+func (p *Policy) Allows(rule *Rule, teamId string) bool {
+	if rule.Access != AccessEmployee {
+		return true
+	}
+	permission := p.Platform
+	if len(rule.Module) > 0 {
+		permission = p.Modules[rule.Module]
+	}
+	if len(rule.Team) > 0 && len(teamId) > 0 && p.Teams[teamId][rule.Module] > permission {
+		permission = p.Teams[teamId][rule.Module]
+	}
+	return permission >= rule.Permission
+}
+
+// Authorize checks the permission of a rule, it returns a PermissionError if it is missing, or only logs it in
+// ModeLog
+func Authorize(route string, policy *Policy, rule *Rule, teamId string) error {
+	if DefaultMode == ModeOff || policy.Allows(rule, teamId) {
+		return nil
+	}
+	err := &PermissionError{rule}
+	log.WithField("route", route).Warn("Permission denied: ", err)
+	if DefaultMode == ModeEnforce {
+		return err
+	}
+	return nil
+}
+
+// Unknown returns the error of a route missing in Routes, it is only logged in ModeLog
+func Unknown(route string) error {
+	if DefaultMode == ModeOff {
+		return nil
+	}
+	err := fmt.Errorf("route %v has no permission", route)
+	log.Warn(err)
+	if DefaultMode == ModeEnforce {
+		return err
+	}
+	return nil
+}
+
+// Missing returns the routes which aren't in Routes
+func Missing(routes []string) []string {
+	missing := []string{}
+	for _, r := range routes {
+		if _, ok := Routes[r]; !ok {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
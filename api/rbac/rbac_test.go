@@ -0,0 +1,117 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	organisation_proto "server/organisation-srv/proto/organisation"
+	static_proto "server/static-srv/proto/static"
+	team_proto "server/team-srv/proto/team"
+	user_proto "server/user-srv/proto/user"
+)
+
+var (
+	notes = &static_proto.Module{Id: "m1", NameSlug: ModuleNotes}
+	plans = &static_proto.Module{Id: "m2", NameSlug: ModulePlans}
+	org   = &organisation_proto.OrgInfo{
+		OrgId:   "org1",
+		Owner:   &user_proto.User{Id: "owner"},
+		Modules: []*static_proto.Module{notes, plans},
+	}
+)
+
+// employee has the overall permission and the permissions of its team roles in the teams t1, t2...
+func employee(modules []*static_proto.Module, overall static_proto.Permission, teams ...static_proto.Permission) *team_proto.EmployeeInfo {
+	info := &team_proto.EmployeeInfo{
+		UserId:   "u1",
+		OrgId:    "org1",
+		Employee: &team_proto.Employee{Modules: modules},
+		Role:     &static_proto.Role{Id: "r0", Permissions: []static_proto.Permission{overall}},
+	}
+	for i, p := range teams {
+		info.TeamRoles = append(info.TeamRoles, &team_proto.TeamRole{
+			TeamId: fmt.Sprintf("t%d", i+1),
+			Role:   &static_proto.Role{Id: fmt.Sprintf("r%d", i+1), Permissions: []static_proto.Permission{p}},
+		})
+	}
+	return info
+}
+
+func TestResolve(t *testing.T) {
+	cases := []struct {
+		name   string
+		userId string
+		info   *team_proto.EmployeeInfo
+		rule   *Rule
+		teamId string
+		allows bool
+	}{
+		{"view of the role", "u1", employee([]*static_proto.Module{notes}, static_proto.Permission_VIEW), view(ModuleNotes, "note"), "", true},
+		{"permission missing", "u1", employee([]*static_proto.Module{notes}, static_proto.Permission_VIEW), create(ModuleNotes, "note"), "", false},
+		{"team role outside of its team", "u1", employee([]*static_proto.Module{notes}, static_proto.Permission_VIEW, static_proto.Permission_DELETE), create(ModuleNotes, "note"), "", false},
+		{"team role on the platform", "u1", employee(nil, static_proto.Permission_VIEW, static_proto.Permission_OWNER), own(platform, "team"), "", false},
+		{"team role in its team", "u1", employee(nil, static_proto.Permission_VIEW, static_proto.Permission_OWNER), inTeam(own(platform, "team"), "team_id"), "t1", true},
+		{"team role in another team", "u1", employee(nil, static_proto.Permission_VIEW, static_proto.Permission_OWNER), inTeam(own(platform, "team"), "team_id"), "t2", false},
+		{"team role in its team on a module", "u1", employee([]*static_proto.Module{notes}, static_proto.Permission_VIEW, static_proto.Permission_VIEW, static_proto.Permission_CREATE), inTeam(create(ModuleNotes, "note"), "team_id"), "t2", true},
+		{"team role on a module of the team only", "u1", employee(nil, static_proto.Permission_VIEW, static_proto.Permission_OWNER), inTeam(view(ModuleNotes, "note"), "team_id"), "t1", false},
+		{"overall role in a team", "u1", employee(nil, static_proto.Permission_OWNER, static_proto.Permission_VIEW), inTeam(own(platform, "team"), "team_id"), "t1", true},
+		{"module of the employee only", "u1", employee([]*static_proto.Module{notes}, static_proto.Permission_OWNER), view(ModulePlans, "plan"), "", false},
+		{"module removed from the organisation", "u1", employee([]*static_proto.Module{{Id: "m3", NameSlug: ModuleTasks}}, static_proto.Permission_OWNER), view(ModuleTasks, "task"), "", false},
+		{"platform feature", "u1", employee(nil, static_proto.Permission_CREATE), create(platform, "team"), "", true},
+		{"without role", "u1", employee([]*static_proto.Module{notes}, static_proto.Permission_Permission_NONE), view(ModuleNotes, "note"), "", false},
+		{"owner", "owner", nil, own(ModulePlans, "plan"), "", true},
+		{"not an employee", "u1", nil, view(ModuleNotes, "note"), "", false},
+		{"user route", "u1", nil, user, "", true},
+	}
+	for _, c := range cases {
+		policy := Resolve(c.userId, c.info, org)
+		if policy.Allows(c.rule, c.teamId) != c.allows {
+			t.Errorf("%s: %v must be %v: %+v", c.name, c.rule, c.allows, policy)
+		}
+	}
+
+	other := employee([]*static_proto.Module{notes}, static_proto.Permission_OWNER)
+	other.OrgId = "org2"
+	if Resolve("u1", other, org).Allows(view(ModuleNotes, "note"), "") {
+		t.Error("Employee of another organisation must not be allowed")
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	policy := Resolve("u1", employee([]*static_proto.Module{notes}, static_proto.Permission_VIEW), org)
+	rule := remove(ModuleNotes, "note")
+
+	err := Authorize("DELETE /notes", policy, rule, "")
+	if perm, ok := err.(*PermissionError); !ok || perm.Rule != rule {
+		t.Errorf("Missing permission must be rejected: %v", err)
+	} else if perm.Error() != "DELETE permission on note of module notes is required" {
+		t.Errorf("Error must name the missing permission: %v", perm)
+	}
+	if err := Unknown("GET /unknown"); err == nil {
+		t.Error("Unknown route must be rejected")
+	}
+
+	DefaultMode = ModeLog
+	defer func() { DefaultMode = ModeEnforce }()
+	if err := Authorize("DELETE /notes", policy, rule, ""); err != nil {
+		t.Errorf("Missing permission must only be logged: %v", err)
+	}
+	if err := Unknown("GET /unknown"); err != nil {
+		t.Errorf("Unknown route must only be logged: %v", err)
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	for route, rule := range Routes {
+		if rule.Access == AccessEmployee && (len(rule.Resource) == 0 || rule.Permission == static_proto.Permission_Permission_NONE) {
+			t.Errorf("%s: rule is incomplete: %+v", route, rule)
+		}
+		if len(rule.Team) > 0 && !strings.Contains(route, "{"+rule.Team+"}") {
+			t.Errorf("%s: team parameter is missing: %+v", route, rule)
+		}
+	}
+	if missing := Missing([]string{"GET /server/notes/all", "GET /server/unknown"}); len(missing) != 1 || missing[0] != "GET /server/unknown" {
+		t.Errorf("Missing routes are invalid: %v", missing)
+	}
+}
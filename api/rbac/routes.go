@@ -0,0 +1,482 @@
+package rbac
+
+import (
+	"server/common"
+	static_proto "server/static-srv/proto/static"
+)
+
+// name_slug of the modules of the organisations
+const (
+	ModuleBehaviours = "behaviours"
+	ModuleContent    = "content"
+	ModuleNotes      = "notes"
+	ModulePlans      = "plans"
+	ModuleProducts   = "products"
+	ModuleSurveys    = "surveys"
+	ModuleTasks      = "tasks"
+	ModuleTodos      = "todos"
+	ModuleTracking   = "tracking"
+	ModuleUsers      = "users"
+
+	// the platform features aren't in a module
+	platform = ""
+)
+
+// resource types without a constant in common
+const (
+	behaviourType = "go.micro.srv.behaviour"
+	staticType    = "go.micro.srv.static"
+	serviceType   = "go.micro.srv.product.Service"
+	batchType     = "go.micro.srv.product.Batch"
+	employeeType  = "go.micro.srv.team.Employee"
+	auditType     = "go.micro.srv.audit.Audit"
+)
+
+var (
+	public = &Rule{Access: AccessPublic}
+	user   = &Rule{Access: AccessUser}
+)
+
+func view(module, resource string) *Rule {
+	return &Rule{Module: module, Resource: resource, Permission: static_proto.Permission_VIEW}
+}
+
+func create(module, resource string) *Rule {
+	return &Rule{Module: module, Resource: resource, Permission: static_proto.Permission_CREATE}
+}
+
+func remove(module, resource string) *Rule {
+	return &Rule{Module: module, Resource: resource, Permission: static_proto.Permission_DELETE}
+}
+
+func own(module, resource string) *Rule {
+	return &Rule{Module: module, Resource: resource, Permission: static_proto.Permission_OWNER}
+}
+
+// inTeam applies the roles of the employee in the team of the path parameter to a rule
+func inTeam(rule *Rule, parameter string) *Rule {
+	rule.Team = parameter
+	return rule
+}
+
+// Routes are the permissions of the routes of the api by "METHOD /path", the path as registered in go-restful. A
+// new route must be added here, the api checks that every route is declared at start and rejects the requests of
+// the routes missing here.
+var Routes = map[string]*Rule{
+	// account
+	"POST /server/account/confirm":        public,
+	"POST /server/account/confirm/resend": public,
+	"POST /server/account/login":          public,
+	"POST /server/account/refresh":        public,
+	"GET /server/account/logout":          user,
+	"POST /server/account/pass/recover":   public,
+	"POST /server/account/pass/update":    public,
+	"POST /server/account/confirm/verify": public,
+	"POST /server/account/pass/verify":    public,
+
+	// audits
+	"POST /server/audits/read": own(platform, auditType),
+
+	// behaviours
+	"GET /server/behaviours/goals/all":                                             view(ModuleBehaviours, common.GOAL_TYPE),
+	"GET /server/behaviours/challenges/all":                                        view(ModuleBehaviours, common.CHALLENGE_TYPE),
+	"GET /server/behaviours/habits/all":                                            view(ModuleBehaviours, common.HABIT_TYPE),
+	"POST /server/behaviours/goal":                                                 create(ModuleBehaviours, common.GOAL_TYPE),
+	"POST /server/behaviours/challenge":                                            create(ModuleBehaviours, common.CHALLENGE_TYPE),
+	"POST /server/behaviours/habit":                                                create(ModuleBehaviours, common.HABIT_TYPE),
+	"GET /server/behaviours/goal/{goal_id}":                                        view(ModuleBehaviours, common.GOAL_TYPE),
+	"PUT /server/behaviours/goal/{goal_id}":                                        create(ModuleBehaviours, common.GOAL_TYPE),
+	"GET /server/behaviours/challenge/{challenge_id}":                              view(ModuleBehaviours, common.CHALLENGE_TYPE),
+	"PUT /server/behaviours/challenge/{challenge_id}":                              create(ModuleBehaviours, common.CHALLENGE_TYPE),
+	"GET /server/behaviours/habit/{habit_id}":                                      view(ModuleBehaviours, common.HABIT_TYPE),
+	"PUT /server/behaviours/habit/{habit_id}":                                      create(ModuleBehaviours, common.HABIT_TYPE),
+	"DELETE /server/behaviours/goal/{goal_id}":                                     remove(ModuleBehaviours, common.GOAL_TYPE),
+	"DELETE /server/behaviours/challenge/{challenge_id}":                           remove(ModuleBehaviours, common.CHALLENGE_TYPE),
+	"DELETE /server/behaviours/habit/{habit_id}":                                   remove(ModuleBehaviours, common.HABIT_TYPE),
+	"POST /server/behaviours/filter":                                               view(ModuleBehaviours, behaviourType),
+	"POST /server/behaviours/search":                                               view(ModuleBehaviours, behaviourType),
+	"POST /server/behaviours/goal/search/autocomplete":                             view(ModuleBehaviours, common.GOAL_TYPE),
+	"POST /server/behaviours/challenge/search/autocomplete":                        view(ModuleBehaviours, common.CHALLENGE_TYPE),
+	"POST /server/behaviours/habit/search/autocomplete":                            view(ModuleBehaviours, common.HABIT_TYPE),
+	"GET /server/behaviours/goals/tags/top/{n}":                                    user,
+	"GET /server/behaviours/challenges/tags/top/{n}":                               user,
+	"GET /server/behaviours/habits/tags/top/{n}":                                   user,
+	"POST /server/behaviours/goals/tags/autocomplete":                              user,
+	"POST /server/behaviours/challenges/tags/autocomplete":                         user,
+	"POST /server/behaviours/habits/tags/autocomplete":                             user,
+	"POST /server/behaviours/goals/upload":                                         create(ModuleBehaviours, common.GOAL_TYPE),
+	"POST /server/behaviours/challenges/upload":                                    create(ModuleBehaviours, common.CHALLENGE_TYPE),
+	"POST /server/behaviours/habits/upload":                                        create(ModuleBehaviours, common.HABIT_TYPE),
+	"GET /server/behaviours/trash":                                                 view(ModuleBehaviours, behaviourType),
+	"POST /server/behaviours/trash/{collection}/{id}/restore":                      remove(ModuleBehaviours, behaviourType),
+	"GET /server/behaviours/versions/{collection}/{id}/versions":                   view(ModuleBehaviours, behaviourType),
+	"GET /server/behaviours/versions/{collection}/{id}/versions/{version}":         view(ModuleBehaviours, behaviourType),
+	"POST /server/behaviours/versions/{collection}/{id}/versions/{version}/revert": create(ModuleBehaviours, behaviourType),
+
+	// content
+	"GET /server/content/sources/all":                               view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/source":                                   create(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/source/{source_id}":                        view(ModuleContent, common.CONTENT_TYPE),
+	"DELETE /server/content/source/{source_id}":                     remove(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/taxonomys/all":                             view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/taxonomy":                                 create(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/taxonomy/{taxonomy_id}":                    view(ModuleContent, common.CONTENT_TYPE),
+	"DELETE /server/content/taxonomy/{taxonomy_id}":                 remove(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/category/items/all":                        view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/category/item":                            create(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/category/item/{contentCategoryItem_id}":    view(ModuleContent, common.CONTENT_TYPE),
+	"DELETE /server/content/category/item/{contentCategoryItem_id}": remove(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/contents/all":                              view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/content":                                  create(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/content/{content_id}":                      view(ModuleContent, common.CONTENT_TYPE),
+	"DELETE /server/content/content/{content_id}":                   remove(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/rules/all":                                 view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/rule":                                     create(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/rule/{contentRule_id}":                     view(ModuleContent, common.CONTENT_TYPE),
+	"DELETE /server/content/rule/{contentRule_id}":                  remove(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/filter":                                   view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/search":                                   view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/share":                                    create(ModuleContent, common.CONTENT_TYPE),
+	"GET /server/content/user/shared/{user_id}":                     user,
+	"GET /server/content/recommendations/{user_id}":                 user,
+	"GET /server/content/recommendations/{user_id}/filters":         user,
+	"POST /server/content/recommendations/{user_id}/filter":         user,
+	"GET /server/content/tags/top/{n}":                              user,
+	"POST /server/content/tags/autocomplete":                        user,
+	"GET /server/content/trash":                                     view(ModuleContent, common.CONTENT_TYPE),
+	"POST /server/content/trash/{collection}/{id}/restore":          remove(ModuleContent, common.CONTENT_TYPE),
+
+	// metrics
+	"GET /server/metrics/": user,
+
+	// notes
+	"GET /server/notes/all":               view(ModuleNotes, common.NOTE_TYPE),
+	"POST /server/notes/note":             create(ModuleNotes, common.NOTE_TYPE),
+	"GET /server/notes/note/{note_id}":    view(ModuleNotes, common.NOTE_TYPE),
+	"DELETE /server/notes/note/{note_id}": remove(ModuleNotes, common.NOTE_TYPE),
+	"POST /server/notes/search":           view(ModuleNotes, common.NOTE_TYPE),
+	"GET /server/notes/creator/{user_id}": view(ModuleNotes, common.NOTE_TYPE),
+	"GET /server/notes/user/{user_id}":    view(ModuleNotes, common.NOTE_TYPE),
+	"POST /server/notes/filter":           view(ModuleNotes, common.NOTE_TYPE),
+
+	// organisations
+	"GET /server/organisations/all":                   view(platform, common.ORGANISATION_TYPE),
+	"POST /server/organisations/organisation":         public,
+	"PUT /server/organisations/organisation":          own(platform, common.ORGANISATION_TYPE),
+	"GET /server/organisations/organisation/{org_id}": view(platform, common.ORGANISATION_TYPE),
+	"POST /server/organisations/profile":              own(platform, common.ORGANISATION_TYPE),
+	"POST /server/organisations/setting":              own(platform, common.ORGANISATION_TYPE),
+	"POST /server/organisations/modules":              own(platform, common.ORGANISATION_TYPE),
+	"GET /server/organisations/modules":               view(platform, common.ORGANISATION_TYPE),
+
+	// openapi
+	"GET /server/openapi/v3/openapi.json": public,
+
+	// plans
+	"GET /server/plans/all":                                       view(ModulePlans, common.PLAN_TYPE),
+	"POST /server/plans/plan":                                     create(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/plan/{plan_id}":                            view(ModulePlans, common.PLAN_TYPE),
+	"PUT /server/plans/plan/{plan_id}":                            create(ModulePlans, common.PLAN_TYPE),
+	"DELETE /server/plans/plan/{plan_id}":                         remove(ModulePlans, common.PLAN_TYPE),
+	"POST /server/plans/search":                                   view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/templates":                                 view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/drafts":                                    view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/creator/{user_id}":                         view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/filters/all":                               view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/filter/time":                               view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/filter/goal":                               view(ModulePlans, common.PLAN_TYPE),
+	"POST /server/plans/plan/search/autocomplete":                 view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/tags/top/{n}":                              user,
+	"POST /server/plans/tags/autocomplete":                        user,
+	"GET /server/plans/plan/{plan_id}/versions":                   view(ModulePlans, common.PLAN_TYPE),
+	"GET /server/plans/plan/{plan_id}/versions/{version}":         view(ModulePlans, common.PLAN_TYPE),
+	"POST /server/plans/plan/{plan_id}/versions/{version}/revert": create(ModulePlans, common.PLAN_TYPE),
+
+	// products
+	"GET /server/products/products/all":            view(ModuleProducts, common.PRODUCT_TYPE),
+	"POST /server/products/product":                create(ModuleProducts, common.PRODUCT_TYPE),
+	"GET /server/products/product/{product_id}":    view(ModuleProducts, common.PRODUCT_TYPE),
+	"DELETE /server/products/product/{product_id}": remove(ModuleProducts, common.PRODUCT_TYPE),
+	"POST /server/products/autocomplete/product":   view(ModuleProducts, common.PRODUCT_TYPE),
+	"GET /server/products/services/all":            view(ModuleProducts, serviceType),
+	"POST /server/products/service":                create(ModuleProducts, serviceType),
+	"GET /server/products/service/{service_id}":    view(ModuleProducts, serviceType),
+	"DELETE /server/products/service/{service_id}": remove(ModuleProducts, serviceType),
+	"POST /server/products/autocomplete/service":   view(ModuleProducts, serviceType),
+	"GET /server/products/batches/all":             view(ModuleProducts, batchType),
+	"POST /server/products/batch":                  create(ModuleProducts, batchType),
+	"GET /server/products/batch/{batch_id}":        view(ModuleProducts, batchType),
+	"DELETE /server/products/batch/{batch_id}":     remove(ModuleProducts, batchType),
+
+	// responses
+	"GET /server/responses/{hash}/check":                                    public,
+	"GET /server/responses/survey/{survey_id}/questions/all":                user,
+	"GET /server/responses/survey/{survey_id}/questions/{question_id}":      user,
+	"GET /server/responses/open/survey/{survey_id}/questions/all":           public,
+	"GET /server/responses/open/survey/{survey_id}/questions/{question_id}": public,
+	"GET /server/responses/{survey_id}/all":                                 view(ModuleSurveys, common.RESPONSE_TYPE),
+	"POST /server/responses/{survey_id}/response":                           user,
+	"POST /server/responses/{survey_id}/response/state":                     user,
+	"GET /server/responses/{survey_id}/all/state/{response_state}":          view(ModuleSurveys, common.RESPONSE_TYPE),
+	"GET /server/responses/{survey_id}/response/stats":                      view(ModuleSurveys, common.RESPONSE_TYPE),
+	"GET /server/responses/{survey_id}/response/by/{user_id}":               view(ModuleSurveys, common.RESPONSE_TYPE),
+	"GET /server/responses/{survey_id}/response/anon":                       view(ModuleSurveys, common.RESPONSE_TYPE),
+
+	// static
+	"GET /server/static/apps/all":                                              view(platform, staticType+".App"),
+	"POST /server/static/app":                                                  create(platform, staticType+".App"),
+	"GET /server/static/app/{app_id}":                                          view(platform, staticType+".App"),
+	"DELETE /server/static/app/{app_id}":                                       remove(platform, staticType+".App"),
+	"GET /server/static/platforms/all":                                         view(platform, staticType+".Platform"),
+	"POST /server/static/platform":                                             create(platform, staticType+".Platform"),
+	"GET /server/static/platform/{platform_id}":                                view(platform, staticType+".Platform"),
+	"DELETE /server/static/platform/{platform_id}":                             remove(platform, staticType+".Platform"),
+	"GET /server/static/wearables/all":                                         view(platform, staticType+".Wearable"),
+	"POST /server/static/wearable":                                             create(platform, staticType+".Wearable"),
+	"GET /server/static/wearable/{wearable_id}":                                view(platform, staticType+".Wearable"),
+	"DELETE /server/static/wearable/{wearable_id}":                             remove(platform, staticType+".Wearable"),
+	"GET /server/static/devices/all":                                           view(platform, staticType+".Device"),
+	"POST /server/static/device":                                               create(platform, staticType+".Device"),
+	"GET /server/static/device/{device_id}":                                    view(platform, staticType+".Device"),
+	"DELETE /server/static/device/{device_id}":                                 remove(platform, staticType+".Device"),
+	"GET /server/static/markers/all":                                           view(platform, staticType+".Marker"),
+	"POST /server/static/marker":                                               create(platform, staticType+".Marker"),
+	"GET /server/static/marker/{marker_id}":                                    view(platform, staticType+".Marker"),
+	"DELETE /server/static/marker/{marker_id}":                                 remove(platform, staticType+".Marker"),
+	"POST /server/static/markers/filter":                                       view(platform, staticType+".Marker"),
+	"GET /server/static/modules/all":                                           view(platform, staticType+".Module"),
+	"POST /server/static/module":                                               create(platform, staticType+".Module"),
+	"GET /server/static/module/{module_id}":                                    view(platform, staticType+".Module"),
+	"DELETE /server/static/module/{module_id}":                                 remove(platform, staticType+".Module"),
+	"GET /server/static/behaviour/categorys/all":                               view(platform, staticType+".BehaviourCategory"),
+	"POST /server/static/behaviour/category":                                   create(platform, staticType+".BehaviourCategory"),
+	"GET /server/static/behaviour/category/{category_id}":                      view(platform, staticType+".BehaviourCategory"),
+	"DELETE /server/static/behaviour/category/{category_id}":                   remove(platform, staticType+".BehaviourCategory"),
+	"POST /server/static/behaviour/categorys/filter":                           view(platform, staticType+".BehaviourCategory"),
+	"GET /server/static/socialTypes/all":                                       view(platform, staticType+".SocialType"),
+	"POST /server/static/socialType":                                           create(platform, staticType+".SocialType"),
+	"GET /server/static/socialType/{socialType_id}":                            view(platform, staticType+".SocialType"),
+	"DELETE /server/static/socialType/{socialType_id}":                         remove(platform, staticType+".SocialType"),
+	"GET /server/static/notifications/all":                                     view(platform, staticType+".Notification"),
+	"POST /server/static/notification":                                         create(platform, staticType+".Notification"),
+	"GET /server/static/notification/{notification_id}":                        view(platform, staticType+".Notification"),
+	"DELETE /server/static/notification/{notification_id}":                     remove(platform, staticType+".Notification"),
+	"GET /server/static/trackerMethods/all":                                    view(platform, staticType+".TrackerMethod"),
+	"POST /server/static/trackerMethod":                                        create(platform, staticType+".TrackerMethod"),
+	"GET /server/static/trackerMethod/{trackerMethod_id}":                      view(platform, staticType+".TrackerMethod"),
+	"DELETE /server/static/trackerMethod/{trackerMethod_id}":                   remove(platform, staticType+".TrackerMethod"),
+	"POST /server/static/trackerMethod/filter":                                 view(platform, staticType+".TrackerMethod"),
+	"GET /server/static/behaviourCategoryAims/all":                             view(platform, staticType+".BehaviourCategoryAim"),
+	"POST /server/static/behaviourCategoryAim":                                 create(platform, staticType+".BehaviourCategoryAim"),
+	"GET /server/static/behaviourCategoryAim/{behaviourCategoryAim_id}":        view(platform, staticType+".BehaviourCategoryAim"),
+	"DELETE /server/static/behaviourCategoryAim/{behaviourCategoryAim_id}":     remove(platform, staticType+".BehaviourCategoryAim"),
+	"GET /server/static/content/category/parents/all":                          view(platform, staticType+".ContentParentCategory"),
+	"POST /server/static/content/category/parent":                              create(platform, staticType+".ContentParentCategory"),
+	"GET /server/static/content/category/parent/{contentParentCategory_id}":    view(platform, staticType+".ContentParentCategory"),
+	"DELETE /server/static/content/category/parent/{contentParentCategory_id}": remove(platform, staticType+".ContentParentCategory"),
+	"GET /server/static/content/categorys/all":                                 view(platform, staticType+".ContentCategory"),
+	"POST /server/static/content/category":                                     create(platform, staticType+".ContentCategory"),
+	"GET /server/static/content/category/{contentCategory_id}":                 view(platform, staticType+".ContentCategory"),
+	"DELETE /server/static/content/category/{contentCategory_id}":              remove(platform, staticType+".ContentCategory"),
+	"GET /server/static/content/types/all":                                     view(platform, staticType+".ContentType"),
+	"POST /server/static/content/type":                                         create(platform, staticType+".ContentType"),
+	"GET /server/static/content/type/{contentType_id}":                         view(platform, staticType+".ContentType"),
+	"DELETE /server/static/content/type/{contentType_id}":                      remove(platform, staticType+".ContentType"),
+	"GET /server/static/content/source/types/all":                              view(platform, staticType+".ContentSourceType"),
+	"POST /server/static/content/source/type":                                  create(platform, staticType+".ContentSourceType"),
+	"GET /server/static/content/source/type/{contentSourceType_id}":            view(platform, staticType+".ContentSourceType"),
+	"DELETE /server/static/content/source/type/{contentSourceType_id}":         remove(platform, staticType+".ContentSourceType"),
+	"GET /server/static/module/triggers/all":                                   view(platform, staticType+".ModuleTrigger"),
+	"POST /server/static/module/trigger":                                       create(platform, staticType+".ModuleTrigger"),
+	"GET /server/static/module/trigger/{moduleTrigger_id}":                     view(platform, staticType+".ModuleTrigger"),
+	"DELETE /server/static/module/trigger/{moduleTrigger_id}":                  remove(platform, staticType+".ModuleTrigger"),
+	"POST /server/static/module/triggers/filter":                               view(platform, staticType+".ModuleTrigger"),
+	"GET /server/static/trigger/content/types/all":                             view(platform, staticType+".TriggerContentType"),
+	"POST /server/static/trigger/content/type":                                 create(platform, staticType+".TriggerContentType"),
+	"GET /server/static/trigger/content/type/{triggerContentType_id}":          view(platform, staticType+".TriggerContentType"),
+	"DELETE /server/static/trigger/content/type/{triggerContentType_id}":       remove(platform, staticType+".TriggerContentType"),
+	"POST /server/static/trigger/content/types/filter":                         view(platform, staticType+".TriggerContentType"),
+	"GET /server/static/setbacks/all":                                          view(platform, staticType+".Setback"),
+	"POST /server/static/setback":                                              create(platform, staticType+".Setback"),
+	"GET /server/static/setback/{setback_id}":                                  view(platform, staticType+".Setback"),
+	"DELETE /server/static/setback/{setback_id}":                               remove(platform, staticType+".Setback"),
+	"POST /server/static/setback/search/autocomplete":                          view(platform, staticType+".Setback"),
+	"POST /server/static/behaviourCategoryAim/upload":                          create(platform, staticType+".BehaviourCategoryAim"),
+	"POST /server/static/content/category/upload":                              create(platform, staticType+".ContentCategory"),
+	"POST /server/static/marker/upload":                                        create(platform, staticType+".Marker"),
+	"POST /server/static/behaviour/category/upload":                            create(platform, staticType+".BehaviourCategory"),
+	"POST /server/static/content/category/item/upload":                         create(platform, staticType+".ContentCategoryItem"),
+	"POST /server/static/trackerMethod/upload":                                 create(platform, staticType+".TrackerMethod"),
+	"GET /server/static/trash":                                                 view(platform, staticType),
+	"POST /server/static/trash/{collection}/{id}/restore":                      remove(platform, staticType),
+
+	// surveys
+	"GET /server/surveys/all":                                           view(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/new":                                           view(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/survey/create":                                create(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/survey/{survey_id}":                            view(ModuleSurveys, common.SURVEY_TYPE),
+	"DELETE /server/surveys/survey/{survey_id}":                         remove(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/survey/copy":                                  create(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/survey/{survey_id}/questions":                  view(ModuleSurveys, common.QUESTION_TYPE),
+	"GET /server/surveys/survey/{survey_id}/questions/{question_id}":    view(ModuleSurveys, common.QUESTION_TYPE),
+	"POST /server/surveys/survey/{survey_id}/questions":                 create(ModuleSurveys, common.QUESTION_TYPE),
+	"GET /server/surveys/creator/{user_id}":                             view(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/survey/{survey_id}/link":                       view(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/templates":                                     view(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/filter":                                       view(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/search":                                       view(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/survey/search/autocomplete":                   view(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/tags/top/{n}":                                  view(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/tags/autocomplete":                            view(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/trash":                                         view(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/trash/{collection}/{id}/restore":              remove(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/survey/{survey_id}/versions":                   view(ModuleSurveys, common.SURVEY_TYPE),
+	"GET /server/surveys/survey/{survey_id}/versions/{version}":         view(ModuleSurveys, common.SURVEY_TYPE),
+	"POST /server/surveys/survey/{survey_id}/versions/{version}/revert": create(ModuleSurveys, common.SURVEY_TYPE),
+
+	// tasks
+	"GET /server/tasks/all":               view(ModuleTasks, common.TASK_TYPE),
+	"POST /server/tasks/task":             create(ModuleTasks, common.TASK_TYPE),
+	"GET /server/tasks/task/{task_id}":    view(ModuleTasks, common.TASK_TYPE),
+	"DELETE /server/tasks/task/{task_id}": remove(ModuleTasks, common.TASK_TYPE),
+	"POST /server/tasks/search":           view(ModuleTasks, common.TASK_TYPE),
+	"GET /server/tasks/creator/{user_id}": view(ModuleTasks, common.TASK_TYPE),
+	"GET /server/tasks/assign/{user_id}":  view(ModuleTasks, common.TASK_TYPE),
+	"POST /server/tasks/filter":           view(ModuleTasks, common.TASK_TYPE),
+	"GET /server/tasks/count/{user_id}":   view(ModuleTasks, common.TASK_TYPE),
+
+	// teams
+	"GET /server/teams/all":                               view(platform, common.TEAM_TYPE),
+	"POST /server/teams/team":                             own(platform, common.TEAM_TYPE),
+	"GET /server/teams/team/{team_id}":                    inTeam(view(platform, common.TEAM_TYPE), "team_id"),
+	"DELETE /server/teams/team/{team_id}":                 inTeam(own(platform, common.TEAM_TYPE), "team_id"),
+	"POST /server/teams/filter":                           view(platform, common.TEAM_TYPE),
+	"POST /server/teams/search":                           view(platform, common.TEAM_TYPE),
+	"GET /server/teams/members/all":                       view(platform, employeeType),
+	"POST /server/teams/members/member":                   own(platform, employeeType),
+	"GET /server/teams/members/member/{user_id}":          view(platform, employeeType),
+	"POST /server/teams/members/member/{user_id}/modules": own(platform, employeeType),
+	"POST /server/teams/members/filter":                   view(platform, employeeType),
+	"POST /server/teams/employee/{employee_id}/delete":    own(platform, employeeType),
+
+	// timestamp
+	"GET /server/timestamp/": public,
+
+	// todos
+	"GET /server/todos/all":               view(ModuleTodos, common.TODO_TYPE),
+	"POST /server/todos/todo":             create(ModuleTodos, common.TODO_TYPE),
+	"GET /server/todos/todo/{todo_id}":    view(ModuleTodos, common.TODO_TYPE),
+	"DELETE /server/todos/todo/{todo_id}": remove(ModuleTodos, common.TODO_TYPE),
+	"POST /server/todos/search":           view(ModuleTodos, common.TODO_TYPE),
+	"GET /server/todos/creator/{user_id}": view(ModuleTodos, common.TODO_TYPE),
+
+	// track
+	"POST /server/track/goal/{goal_id}":                  create(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/goal/{goal_id}/count":             view(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/goal/{goal_id}/history":           view(ModuleTracking, common.TRACK_TYPE),
+	"POST /server/track/challenge/{challenge_id}":        create(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/challenge/{challenge_id}/count":   view(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/challenge/{challenge_id}/history": view(ModuleTracking, common.TRACK_TYPE),
+	"POST /server/track/habit/{habit_id}":                create(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/habit/{habit_id}/count":           view(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/habit/{habit_id}/history":         view(ModuleTracking, common.TRACK_TYPE),
+	"POST /server/track/content/{content_id}":            create(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/content/{content_id}/count":       view(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/content/{content_id}/history":     view(ModuleTracking, common.TRACK_TYPE),
+	"POST /server/track/marker/{marker_id}":              create(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/marker/{marker_id}":               view(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/marker/{marker_id}/history":       view(ModuleTracking, common.TRACK_TYPE),
+	"GET /server/track/marker/{marker_id}/series":        user,
+	"GET /server/track/marker/history/all":               view(ModuleTracking, common.TRACK_TYPE),
+
+	// users
+	"GET /server/users/all":                                      view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user":                                    create(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/share/multiple":                     create(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}":                           view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/content/share":                      create(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}/preferences":               view(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}/feedback":                  view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/filter":                             view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/search":                             view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/search/autocomplete":                view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/account/status":           own(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}/account/status":            view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/account/pass/reset":       own(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/measurements/measurement": create(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}/measurements/{marker_id}":  view(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}/measurements/all":          view(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}/markers/all":               view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/shared":                   view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/shared/search":            view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/share":                    create(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/share/all":                view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/share/search":             view(ModuleUsers, common.USER_TYPE),
+	"GET /server/users/user/{user_id}/goals/current/progress":    view(ModuleUsers, common.USER_TYPE),
+	"POST /server/users/user/{user_id}/delete":                   own(ModuleUsers, common.USER_TYPE),
+
+	// user app
+	"GET /server/user/app/{user_id}":                                       user,
+	"POST /server/user/app/bookmark":                                       user,
+	"GET /server/user/app/{user_id}/bookmarks/all":                         user,
+	"GET /server/user/app/{user_id}/bookmarks/categorys":                   user,
+	"GET /server/user/app/{user_id}/{category_id}/bookmarks":               user,
+	"DELETE /server/user/app/bookmark/{bookmark_id}":                       user,
+	"POST /server/user/app/bookmarks/search":                               user,
+	"GET /server/user/app/{user_id}/content/shared":                        user,
+	"GET /server/user/app/{user_id}/plan/shared":                           user,
+	"GET /server/user/app/{user_id}/survey/shared":                         user,
+	"GET /server/user/app/{user_id}/goal/shared":                           user,
+	"GET /server/user/app/{user_id}/challenge/shared":                      user,
+	"GET /server/user/app/{user_id}/habit/shared":                          user,
+	"POST /server/user/app/goal/join":                                      user,
+	"GET /server/user/app/goal/{goal_id}":                                  user,
+	"GET /server/user/app/goals/joined":                                    user,
+	"GET /server/user/app/goals/current":                                   user,
+	"GET /server/user/app/goal/current/progress":                           user,
+	"POST /server/user/app/challenge/join":                                 user,
+	"GET /server/user/app/challenge/{challenge_id}":                        user,
+	"GET /server/user/app/challenges/joined":                               user,
+	"GET /server/user/app/challenges/current":                              user,
+	"GET /server/user/app/challenges/current/count":                        user,
+	"GET /server/user/app/current/markers":                                 user,
+	"GET /server/user/app/pending":                                         user,
+	"GET /server/user/app/marker/default/history":                          user,
+	"GET /server/user/app/markers/{name_slug}/marker":                      user,
+	"POST /server/user/app/habit/join":                                     user,
+	"GET /server/user/app/habit/{habit_id}":                                user,
+	"GET /server/user/app/habits/joined":                                   user,
+	"GET /server/user/app/habits/current":                                  user,
+	"GET /server/user/app/habits/current/count":                            user,
+	"GET /server/user/app/content/categorys/all":                           user,
+	"GET /server/user/app/content/{content_id}":                            user,
+	"GET /server/user/app/content/category/{category_id}":                  user,
+	"GET /server/user/app/content/category/{category_id}/filters":          user,
+	"POST /server/user/app/content/category/filters/autocomplete":          user,
+	"POST /server/user/app/content/category/{category_id}/filter":          user,
+	"POST /server/user/app/preferences":                                    user,
+	"GET /server/user/app/{user_id}/preferences":                           user,
+	"POST /server/user/app/details":                                        user,
+	"GET /server/user/app/content/recommendations/all":                     user,
+	"GET /server/user/app/content/recommendations/category/{category_id}":  user,
+	"POST /server/user/app/content/{content_id}/rating":                    user,
+	"POST /server/user/app/content/{content_id}/dislike":                   user,
+	"POST /server/user/app/content/{content_id}/dislike/similar":           user,
+	"POST /server/user/app/feedback":                                       user,
+	"POST /server/user/app/plan/join":                                      user,
+	"POST /server/user/app/plan/create":                                    user,
+	"GET /server/user/app/plan/{user_id}":                                  user,
+	"POST /server/user/app/plan/update":                                    user,
+	"GET /server/user/app/plan/{plan_id}/summary/count":                    user,
+	"GET /server/user/app/plan/{plan_id}/summary/{day_number}/count":       user,
+	"GET /server/user/app/plan/{plan_id}/summary":                          user,
+	"POST /server/user/app/login":                                          public,
+	"GET /server/user/app/logout":                                          user,
+	"GET /server/user/app/goals/all":                                       user,
+	"GET /server/user/app/challenges/all":                                  user,
+	"GET /server/user/app/habits/all":                                      user,
+	"POST /server/user/app/contents/all":                                   user,
+	"POST /server/user/app/{user_id}/shared":                               user,
+	"POST /server/user/app/content/category/{nameslug}/items/autocomplete": user,
+	"GET /server/user/app/content/category/{nameslug}/items/all":           user,
+}
@@ -70,6 +70,36 @@ func NoAuthorizedResponse(rsp *restful.Response, err error, domain, reason strin
 	})
 }
 
+// ForbiddenResponse responses the permission missing to an authenticated request
+func ForbiddenResponse(rsp *restful.Response, err error, domain, reason string) {
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusForbidden, &ErrResponse{
+		Code:    http.StatusForbidden,
+		Message: reason,
+		Errors: []*Error{
+			{
+				Domain: domain,
+				Reason: err.Error(),
+			},
+		},
+	})
+}
+
+// TooManyRequestsResponse responses a request over its rate limit, see ratelimit
+func TooManyRequestsResponse(rsp *restful.Response, err error, domain, reason string) {
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusTooManyRequests, &ErrResponse{
+		Code:    http.StatusTooManyRequests,
+		Message: reason,
+		Errors: []*Error{
+			{
+				Domain: domain,
+				Reason: err.Error(),
+			},
+		},
+	})
+}
+
 func UnmarshalAny(req *restful.Request, rsp *restful.Response, obj proto.Message) error {
 	// getting json object
 	req_obj := new(map[string]interface{})
@@ -116,3 +146,38 @@ func MarshalAny(rsp *restful.Response, res proto.Message) interface{} {
 	}
 	return data
 }
+
+// SetETag sets the ETag header to the revision of the document in the response
+func SetETag(rsp *restful.Response, revision string) {
+	if len(revision) > 0 {
+		rsp.AddHeader("ETag", strconv.Quote(revision))
+	}
+}
+
+// IfMatch returns the revision of the If-Match header, it's empty if the header isn't set or matches any revision
+func IfMatch(req *restful.Request) string {
+	revision := strings.TrimSpace(req.HeaderParameter("If-Match"))
+	if revision == "*" {
+		return ""
+	}
+	revision = strings.TrimPrefix(revision, "W/")
+	return strings.Trim(revision, `"`)
+}
+
+// WriteUpdateErrorResponse responses with 412 if the document was written since the revision of If-Match,
+// with the status code of the error otherwise
+func WriteUpdateErrorResponse(rsp *restful.Response, err error, domain, reason string) {
+	if errors.Parse(err.Error()).Code != http.StatusConflict {
+		WriteErrorResponseWithCode(rsp, err, domain, reason)
+		return
+	}
+	rsp.AddHeader("Content-Type", "application/json")
+	rsp.WriteHeaderAndEntity(http.StatusPreconditionFailed, &ErrResponse{
+		Code:    http.StatusPreconditionFailed,
+		Message: reason,
+		Errors: []*Error{{
+			Domain: domain,
+			Reason: err.Error(),
+		}},
+	})
+}
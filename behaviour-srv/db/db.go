@@ -21,7 +21,8 @@ import (
 )
 
 type clientWrapper struct {
-	Db_client db_proto.DBClient
+	Db_client   db_proto.DBClient
+	StaticCache *common.StaticCache
 }
 
 var (
@@ -31,10 +32,11 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 
 	return &clientWrapper{
-		Db_client: cl,
+		Db_client:   cl,
+		StaticCache: common.NewStaticCache(static_proto.NewStaticServiceClient(common.StaticSrv, serviceClient), nil),
 	}
 }
 
@@ -70,11 +72,31 @@ func runQuery(ctx context.Context, q string, table string) (*db_proto.RunQueryRe
 	})
 }
 
+// runQueryBind runs a query which references its parameters as bind variables
+func runQueryBind(ctx context.Context, q string, bindVars common.BindVars, table string) (*db_proto.RunQueryResponse, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return ClientWrapper.Db_client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{
+			Name:     common.DbHealumName,
+			Table:    table,
+			Driver:   common.DbHealumDriver,
+			Metadata: common.SearchableMetaMap,
+		},
+		Query:    q,
+		BindVars: vars,
+	})
+}
+
 func goalToRecord(goal *behaviour_proto.Goal) (string, error) {
 	data, err := common.MarhalToObject(goal)
 	if err != nil {
 		return "", err
 	}
+	// the revision is the _rev of the document
+	delete(data, "revision")
 
 	// category
 	common.FilterObject(data, "category", goal.Category)
@@ -202,6 +224,7 @@ func recordToGoal(r *db_proto.Record) (*behaviour_proto.Goal, error) {
 	if err := jsonpb.Unmarshal(strings.NewReader(r.Parameter3), &p); err != nil {
 		return nil, err
 	}
+	p.Revision = r.Revision
 	return &p, nil
 }
 
@@ -210,6 +233,8 @@ func challengeToRecord(challenge *behaviour_proto.Challenge) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// the revision is the _rev of the document
+	delete(data, "revision")
 
 	// category
 	common.FilterObject(data, "category", challenge.Category)
@@ -327,6 +352,7 @@ func recordToChallenge(r *db_proto.Record) (*behaviour_proto.Challenge, error) {
 	if err := jsonpb.Unmarshal(strings.NewReader(r.Parameter3), &p); err != nil {
 		return nil, err
 	}
+	p.Revision = r.Revision
 	return &p, nil
 }
 
@@ -335,6 +361,8 @@ func habitToRecord(habit *behaviour_proto.Habit) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// the revision is the _rev of the document
+	delete(data, "revision")
 
 	// category
 	common.FilterObject(data, "category", habit.Category)
@@ -443,6 +471,7 @@ func recordToHabit(r *db_proto.Record) (*behaviour_proto.Habit, error) {
 	if err := jsonpb.Unmarshal(strings.NewReader(r.Parameter3), &p); err != nil {
 		return nil, err
 	}
+	p.Revision = r.Revision
 	return &p, nil
 }
 
@@ -914,6 +943,124 @@ func CreateHabit(ctx context.Context, habit *behaviour_proto.Habit) error {
 	return err
 }
 
+// UpdateGoal replaces a goal of the organisation and returns it with its new revision, it returns ErrNotFound if
+// the goal doesn't exist. The update fails with a conflict if goal.Revision is set and the goal was written since.
+func UpdateGoal(ctx context.Context, goal *behaviour_proto.Goal, orgId, teamId string) (*behaviour_proto.Goal, error) {
+	goal.Updated = time.Now().Unix()
+	record, err := goalToRecord(goal)
+	if err != nil {
+		return nil, err
+	}
+	if len(record) == 0 {
+		return nil, errors.New("server serialization")
+	}
+
+	bindVars := common.BindVars{}
+	q := common.QueryReplaceBind(common.DbGoalTable, goal.Id, record, goal.Revision, orgId, "", bindVars)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbGoalTable)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Records) == 0 {
+		return nil, ErrNotFound
+	}
+	return recordToGoal(resp.Records[0])
+}
+
+// UpdateChallenge replaces a challenge of the organisation and returns it with its new revision, it returns ErrNotFound if
+// the challenge doesn't exist. The update fails with a conflict if challenge.Revision is set and the challenge was written since.
+func UpdateChallenge(ctx context.Context, challenge *behaviour_proto.Challenge, orgId, teamId string) (*behaviour_proto.Challenge, error) {
+	challenge.Updated = time.Now().Unix()
+	record, err := challengeToRecord(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if len(record) == 0 {
+		return nil, errors.New("server serialization")
+	}
+
+	bindVars := common.BindVars{}
+	q := common.QueryReplaceBind(common.DbChallengeTable, challenge.Id, record, challenge.Revision, orgId, "", bindVars)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbChallengeTable)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Records) == 0 {
+		return nil, ErrNotFound
+	}
+	return recordToChallenge(resp.Records[0])
+}
+
+// UpdateHabit replaces a habit of the organisation and returns it with its new revision, it returns ErrNotFound if
+// the habit doesn't exist. The update fails with a conflict if habit.Revision is set and the habit was written since.
+func UpdateHabit(ctx context.Context, habit *behaviour_proto.Habit, orgId, teamId string) (*behaviour_proto.Habit, error) {
+	habit.Updated = time.Now().Unix()
+	record, err := habitToRecord(habit)
+	if err != nil {
+		return nil, err
+	}
+	if len(record) == 0 {
+		return nil, errors.New("server serialization")
+	}
+
+	bindVars := common.BindVars{}
+	q := common.QueryReplaceBind(common.DbHabitTable, habit.Id, record, habit.Revision, orgId, "", bindVars)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbHabitTable)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Records) == 0 {
+		return nil, ErrNotFound
+	}
+	return recordToHabit(resp.Records[0])
+}
+
+// resolveStatic replaces the category, target marker and trackers markers and methods of a goal, challenge or
+// habit by the cached records, the references which don't exist anymore are removed
+func resolveStatic(ctx context.Context, category **static_proto.BehaviourCategory, target *static_proto.Target, trackers []*behaviour_proto.Tracker) error {
+	markerIds := []string{}
+	methodIds := []string{}
+	if target != nil && target.Marker != nil {
+		markerIds = append(markerIds, target.Marker.Id)
+	}
+	for _, t := range trackers {
+		if t.Marker != nil {
+			markerIds = append(markerIds, t.Marker.Id)
+		}
+		if t.Method != nil {
+			methodIds = append(methodIds, t.Method.Id)
+		}
+	}
+
+	if *category != nil {
+		categories, err := ClientWrapper.StaticCache.BehaviourCategories(ctx, []string{(*category).Id})
+		if err != nil {
+			return err
+		}
+		*category = categories[(*category).Id]
+	}
+	markers, err := ClientWrapper.StaticCache.Markers(ctx, markerIds)
+	if err != nil {
+		return err
+	}
+	methods, err := ClientWrapper.StaticCache.TrackerMethods(ctx, methodIds)
+	if err != nil {
+		return err
+	}
+	if target != nil && target.Marker != nil {
+		target.Marker = markers[target.Marker.Id]
+	}
+	for _, t := range trackers {
+		if t.Marker != nil {
+			t.Marker = markers[t.Marker.Id]
+		}
+		if t.Method != nil {
+			t.Method = methods[t.Method.Id]
+		}
+	}
+	return nil
+}
+
 // ReadGoal reads a goal by ID
 func ReadGoal(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.Goal, error) {
 	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
@@ -922,20 +1069,8 @@ func ReadGoal(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.G
 	q := fmt.Sprintf(`
 		FOR doc IN %v
 		%s
-		LET category = (FOR p IN %v FILTER doc.data.category.id == p._key RETURN p.data)
 		LET target_aim = (FOR p IN %v FILTER doc.data.target.aim.id == p._key RETURN p.data)
-		LET target_marker = (FOR p IN %v FILTER doc.data.target.marker.id == p._key RETURN p.data)
 		LET createdBy = (FOR p IN %v FILTER doc.data.createdBy.id == p._key RETURN p.data)
-		LET trackers = (
-			FILTER NOT_NULL(doc.data.trackers)
-			FOR tracker IN doc.data.trackers
-				LET m = (FOR p IN %v FILTER tracker.marker.id == p._key RETURN p.data)
-				LET t = (FOR p IN %v FILTER tracker.method.id == p._key RETURN p.data)
-			RETURN MERGE_RECURSIVE(tracker, {
-				marker: m[0],
-				method: t[0]
-			})
-		)
 		LET challenges = (
 			FILTER NOT_NULL(doc.data.challenges)
 			FOR c IN doc.data.challenges
@@ -970,10 +1105,8 @@ func ReadGoal(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.G
 		)
 		LET todo = (FOR p IN %v FILTER doc.data.todos.id == p._key RETURN p.data)
 		RETURN MERGE_RECURSIVE(doc,{data:{
-		category:category[0],
-		target:{aim:target_aim[0], marker:target_marker[0]},
+		target:{aim:target_aim[0]},
 		createdBy:createdBy[0],
-		trackers: trackers,
 		challenges: challenges,
 		habits: habits,
 		triggers:triggers,
@@ -981,8 +1114,7 @@ func ReadGoal(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.G
 		users:users,
 		todos:todo[0]
 		}})`, common.DbGoalTable, query,
-		common.DbBehaviourCategoryTable, common.DbBehaviourCategoryAimTable, common.DbMarkerTable,
-		common.DbUserTable, common.DbMarkerTable, common.DbTrackerMethodTable, common.DbChallengeTable,
+		common.DbBehaviourCategoryAimTable, common.DbUserTable, common.DbChallengeTable,
 		common.DbHabitTable, common.DbModuleTriggerTable, common.DbSetbackTable, common.DbUserTable,
 		common.DbTodoTable,
 	)
@@ -993,7 +1125,14 @@ func ReadGoal(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.G
 	}
 
 	data, err := recordToGoal(resp.Records[0])
-	return data, err
+	if err != nil {
+		return nil, err
+	}
+	// the static references are resolved by the cache
+	if err := resolveStatic(ctx, &data.Category, data.Target, data.Trackers); err != nil {
+		return nil, err
+	}
+	return data, nil
 }
 
 // ReadChallenge reads a challenge by ID
@@ -1004,20 +1143,8 @@ func ReadChallenge(ctx context.Context, id, orgId, teamId string) (*behaviour_pr
 	q := fmt.Sprintf(`
 		FOR doc IN %v
 		%s
-		LET category = (FOR p IN %v FILTER doc.data.category.id == p._key RETURN p.data)
 		LET target_aim = (FOR p IN %v FILTER doc.data.target.aim.id == p._key RETURN p.data)
-		LET target_marker = (FOR p IN %v FILTER doc.data.target.marker.id == p._key RETURN p.data)
 		LET createdBy = (FOR p IN %v FILTER doc.data.createdBy.id == p._key RETURN p.data)
-		LET trackers = (
-			FILTER NOT_NULL(doc.data.trackers)
-			FOR tracker IN doc.data.trackers
-				LET m = (FOR p IN %v FILTER tracker.marker.id == p._key RETURN p.data)
-				LET t = (FOR p IN %v FILTER tracker.method.id == p._key RETURN p.data)
-			RETURN MERGE_RECURSIVE(tracker, {
-				marker: m[0],
-				method: t[0]
-			})
-		)
 		LET habits = (
 			FILTER NOT_NULL(doc.data.habits)
 			FOR h IN doc.data.habits
@@ -1046,18 +1173,15 @@ func ReadChallenge(ctx context.Context, id, orgId, teamId string) (*behaviour_pr
 		)
 		LET todo = (FOR p IN %v FILTER doc.data.todos.id == p._key RETURN p.data)
 		RETURN MERGE_RECURSIVE(doc,{data:{
-		category:category[0],
-		target:{aim:target_aim[0], marker:target_marker[0]},
+		target:{aim:target_aim[0]},
 		createdBy:createdBy[0],
-		trackers: trackers,
 		habits: habits,
 		triggers:triggers,
 		setbacks:setbacks,
 		users:users,
 		todos:todo[0]
 		}})`, common.DbChallengeTable, query,
-		common.DbBehaviourCategoryTable, common.DbBehaviourCategoryAimTable, common.DbMarkerTable,
-		common.DbUserTable, common.DbMarkerTable, common.DbTrackerMethodTable,
+		common.DbBehaviourCategoryAimTable, common.DbUserTable,
 		common.DbHabitTable, common.DbModuleTriggerTable, common.DbSetbackTable, common.DbUserTable,
 		common.DbTodoTable,
 	)
@@ -1068,7 +1192,14 @@ func ReadChallenge(ctx context.Context, id, orgId, teamId string) (*behaviour_pr
 	}
 
 	data, err := recordToChallenge(resp.Records[0])
-	return data, err
+	if err != nil {
+		return nil, err
+	}
+	// the static references are resolved by the cache
+	if err := resolveStatic(ctx, &data.Category, data.Target, data.Trackers); err != nil {
+		return nil, err
+	}
+	return data, nil
 }
 
 // ReadHabit reads a habit by ID
@@ -1079,20 +1210,8 @@ func ReadHabit(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.
 	q := fmt.Sprintf(`
 		FOR doc IN %v
 		%s
-		LET category = (FOR p IN %v FILTER doc.data.category.id == p._key RETURN p.data)
 		LET target_aim = (FOR p IN %v FILTER doc.data.target.aim.id == p._key RETURN p.data)
-		LET target_marker = (FOR p IN %v FILTER doc.data.target.marker.id == p._key RETURN p.data)
 		LET createdBy = (FOR p IN %v FILTER doc.data.createdBy.id == p._key RETURN p.data)
-		LET trackers = (
-			FILTER NOT_NULL(doc.data.trackers)
-			FOR tracker IN doc.data.trackers
-				LET m = (FOR p IN %v FILTER tracker.marker.id == p._key RETURN p.data)
-				LET t = (FOR p IN %v FILTER tracker.method.id == p._key RETURN p.data)
-			RETURN MERGE_RECURSIVE(tracker, {
-				marker: m[0],
-				method: t[0]
-			})
-		)
 		LET triggers = (
 			FILTER NOT_NULL(doc.data.triggers) 
 			FOR t IN doc.data.triggers
@@ -1115,17 +1234,14 @@ func ReadHabit(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.
 		)
 		LET todo = (FOR p IN %v FILTER doc.data.todos.id == p._key RETURN p.data)
 		RETURN MERGE_RECURSIVE(doc,{data:{
-		category:category[0],
-		target:{aim:target_aim[0], marker:target_marker[0]},
+		target:{aim:target_aim[0]},
 		createdBy:createdBy[0],
-		trackers: trackers,
 		triggers:triggers,
 		setbacks:setbacks,
 		users:users,
 		todos:todo[0]
 		}})`, common.DbHabitTable, query,
-		common.DbBehaviourCategoryTable, common.DbBehaviourCategoryAimTable, common.DbMarkerTable,
-		common.DbUserTable, common.DbMarkerTable, common.DbTrackerMethodTable, common.DbModuleTriggerTable,
+		common.DbBehaviourCategoryAimTable, common.DbUserTable, common.DbModuleTriggerTable,
 		common.DbSetbackTable, common.DbUserTable, common.DbTodoTable,
 	)
 
@@ -1135,48 +1251,29 @@ func ReadHabit(ctx context.Context, id, orgId, teamId string) (*behaviour_proto.
 	}
 
 	data, err := recordToHabit(resp.Records[0])
-	return data, err
+	if err != nil {
+		return nil, err
+	}
+	// the static references are resolved by the cache
+	if err := resolveStatic(ctx, &data.Category, data.Target, data.Trackers); err != nil {
+		return nil, err
+	}
+	return data, nil
 }
 
-// DeleteGoal deletes a goal by ID
-func DeleteGoal(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, "")
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v		
-		%s
-		REMOVE doc IN %v`, common.DbGoalTable, query, common.DbGoalTable)
-	_, err := runQuery(ctx, q, common.DbGoalTable)
-	return err
+// DeleteGoal moves a goal and its edges to the trash
+func DeleteGoal(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbGoalTable, id, orgId, userId)
 }
 
-// DeleteChallenge deletes a challenge by ID
-func DeleteChallenge(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, "")
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbChallengeTable, query, common.DbChallengeTable)
-	_, err := runQuery(ctx, q, common.DbChallengeTable)
-	return err
+// DeleteChallenge moves a challenge and its edges to the trash
+func DeleteChallenge(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbChallengeTable, id, orgId, userId)
 }
 
-// DeleteHabit deletes a habit by ID
-func DeleteHabit(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, "")
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbHabitTable, query, common.DbHabitTable)
-	_, err := runQuery(ctx, q, common.DbHabitTable)
-	return err
+// DeleteHabit moves a habit and its edges to the trash
+func DeleteHabit(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbHabitTable, id, orgId, userId)
 }
 
 func Filter(ctx context.Context, req *behaviour_proto.FilterRequest) (*behaviour_proto.FilterResponse_Data, error) {
@@ -1276,19 +1373,20 @@ func Search(ctx context.Context, req *behaviour_proto.SearchRequest) (*behaviour
 	var challenges []*behaviour_proto.Challenge
 	var habits []*behaviour_proto.Habit
 
+	bindVars := common.BindVars{}
 	query := `FILTER`
 	if len(req.Name) > 0 {
-		query += fmt.Sprintf(` && LIKE(doc.name, "%s",true)`, `%`+req.Name+`%`)
+		query += fmt.Sprintf(` && LIKE(doc.name, %s,true)`, bindVars.Add("name", `%`+req.Name+`%`))
 	}
 	if len(req.Description) > 0 {
-		query += fmt.Sprintf(` && LIKE(doc.data.description, "%v",true)`, `%`+req.Description+`%`)
+		query += fmt.Sprintf(` && LIKE(doc.data.description, %s,true)`, bindVars.Add("description", `%`+req.Description+`%`))
 	}
 	if len(req.Summary) > 0 {
-		query += fmt.Sprintf(` && LIKE(doc.data.summary, "%v",true)`, `%`+req.Summary+`%`)
+		query += fmt.Sprintf(` && LIKE(doc.data.summary, %s,true)`, bindVars.Add("summary", `%`+req.Summary+`%`))
 	}
-	query = common.QueryAuth(query, req.OrgId, "")
-	limit_query := common.QueryPaginate(req.Offset, req.Limit)
-	sort_query := common.QuerySort(req.SortParameter, req.SortDirection)
+	query = common.QueryAuthBind(query, req.OrgId, "", bindVars)
+	limit_query := common.QueryPaginateBind(req.Offset, req.Limit, bindVars)
+	sort_query := common.QuerySortBind(req.SortParameter, req.SortDirection, bindVars)
 
 	q := fmt.Sprintf(`
 		FOR doc IN %v
@@ -1296,7 +1394,7 @@ func Search(ctx context.Context, req *behaviour_proto.SearchRequest) (*behaviour
 		%s
 		%s
 		RETURN doc`, common.DbGoalTable, query, sort_query, limit_query)
-	resp, err := runQuery(ctx, q, common.DbGoalTable)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbGoalTable)
 	if err != nil {
 		return nil, err
 	}
@@ -1313,7 +1411,7 @@ func Search(ctx context.Context, req *behaviour_proto.SearchRequest) (*behaviour
 		%s
 		%s
 		RETURN doc`, common.DbChallengeTable, query, sort_query, limit_query)
-	resp1, err := runQuery(ctx, q1, common.DbChallengeTable)
+	resp1, err := runQueryBind(ctx, q1, bindVars, common.DbChallengeTable)
 	if err != nil {
 		return nil, err
 	}
@@ -1330,7 +1428,7 @@ func Search(ctx context.Context, req *behaviour_proto.SearchRequest) (*behaviour
 		%s
 		%s
 		RETURN doc`, common.DbHabitTable, query, sort_query, limit_query)
-	resp2, err := runQuery(ctx, q2, common.DbHabitTable)
+	resp2, err := runQueryBind(ctx, q2, bindVars, common.DbHabitTable)
 	if err != nil {
 		return nil, err
 	}
@@ -1348,6 +1446,8 @@ func Search(ctx context.Context, req *behaviour_proto.SearchRequest) (*behaviour
 
 func ShareGoal(ctx context.Context, goals []*behaviour_proto.Goal, users []*behaviour_proto.TargetedUser, sharedBy *user_proto.User, orgId string) ([]string, error) {
 	userids := []string{}
+	// edges and pendings are written together, a failure doesn't leave one without the other
+	tx := common.NewTransaction(common.DbShareGoalUserEdgeTable, common.DbPendingTable)
 	for _, goal := range goals {
 		for _, user := range users {
 			shared := &behaviour_proto.ShareGoalUser{
@@ -1374,26 +1474,20 @@ func ShareGoal(ctx context.Context, goals []*behaviour_proto.Goal, users []*beha
 			}
 
 			field := fmt.Sprintf(`{_from:"%v",_to:"%v"} `, _from, _to)
+			bindVars := common.BindVars{}
 			q := fmt.Sprintf(`
 				UPSERT %v
 				INSERT %v
 				UPDATE %v
 				INTO %v
-				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field, insert, update, common.DbShareGoalUserEdgeTable)
-
-			resp, err := runQuery(ctx, q, common.DbShareGoalUserEdgeTable)
-			if err != nil {
-				return nil, err
-			}
+				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field,
+				common.QueryPinVersion(insert, common.DbGoalTable, goal.Id, bindVars),
+				common.QueryPinVersion(update, common.DbGoalTable, goal.Id, bindVars),
+				common.DbShareGoalUserEdgeTable)
 
-			// parsing to check whether this was an update (returns nothing) or insert (returns inserted user_id)
-			b, err := common.RecordToInsertedUserId(resp.Records[0])
-			if err != nil {
+			if err := tx.Add(q, bindVars); err != nil {
 				return nil, err
 			}
-			if len(b) > 0 {
-				userids = append(userids, b)
-			}
 
 			any, err := common.FilteredAnyFromObject(common.GOAL_TYPE, goal.Id)
 			if err != nil {
@@ -1416,16 +1510,37 @@ func ShareGoal(ctx context.Context, goals []*behaviour_proto.Goal, users []*beha
 				return nil, err1
 			}
 
-			if _, err := runQuery(ctx, q1, common.DbPendingTable); err != nil {
+			if err := tx.Add(q1, nil); err != nil {
 				return nil, err
 			}
 		}
 	}
+
+	results, err := tx.Run(ctx, ClientWrapper.Db_client)
+	if err != nil {
+		return nil, err
+	}
+	// edge upserts are the even operations, pendings the odd ones
+	for i := 0; i < len(results); i += 2 {
+		if len(results[i].Records) == 0 {
+			continue
+		}
+		// parsing to check whether this was an update (returns nothing) or insert (returns inserted user_id)
+		b, err := common.RecordToInsertedUserId(results[i].Records[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 0 {
+			userids = append(userids, b)
+		}
+	}
 	return userids, nil
 }
 
 func ShareChallenge(ctx context.Context, challenges []*behaviour_proto.Challenge, users []*behaviour_proto.TargetedUser, sharedBy *user_proto.User, orgId string) ([]string, error) {
 	userids := []string{}
+	// edges and pendings are written together, a failure doesn't leave one without the other
+	tx := common.NewTransaction(common.DbShareChallengeUserEdgeTable, common.DbPendingTable)
 	for _, challenge := range challenges {
 		for _, user := range users {
 			shared := &behaviour_proto.ShareChallengeUser{
@@ -1452,26 +1567,20 @@ func ShareChallenge(ctx context.Context, challenges []*behaviour_proto.Challenge
 			}
 
 			field := fmt.Sprintf(`{_from:"%v",_to:"%v"} `, _from, _to)
+			bindVars := common.BindVars{}
 			q := fmt.Sprintf(`
 				UPSERT %v
 				INSERT %v
 				UPDATE %v
 				INTO %v
-				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field, insert, update, common.DbShareChallengeUserEdgeTable)
-
-			resp, err := runQuery(ctx, q, common.DbShareChallengeUserEdgeTable)
-			if err != nil {
-				return nil, err
-			}
+				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field,
+				common.QueryPinVersion(insert, common.DbChallengeTable, challenge.Id, bindVars),
+				common.QueryPinVersion(update, common.DbChallengeTable, challenge.Id, bindVars),
+				common.DbShareChallengeUserEdgeTable)
 
-			// parsing to check whether this was an update (returns nothing) or insert (returns inserted user_id)
-			b, err := common.RecordToInsertedUserId(resp.Records[0])
-			if err != nil {
+			if err := tx.Add(q, bindVars); err != nil {
 				return nil, err
 			}
-			if len(b) > 0 {
-				userids = append(userids, b)
-			}
 
 			// save pending
 			any, err := common.FilteredAnyFromObject(common.CHALLENGE_TYPE, challenge.Id)
@@ -1495,16 +1604,37 @@ func ShareChallenge(ctx context.Context, challenges []*behaviour_proto.Challenge
 				return nil, err1
 			}
 
-			if _, err := runQuery(ctx, q1, common.DbPendingTable); err != nil {
+			if err := tx.Add(q1, nil); err != nil {
 				return nil, err
 			}
 		}
 	}
+
+	results, err := tx.Run(ctx, ClientWrapper.Db_client)
+	if err != nil {
+		return nil, err
+	}
+	// edge upserts are the even operations, pendings the odd ones
+	for i := 0; i < len(results); i += 2 {
+		if len(results[i].Records) == 0 {
+			continue
+		}
+		// parsing to check whether this was an update (returns nothing) or insert (returns inserted user_id)
+		b, err := common.RecordToInsertedUserId(results[i].Records[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 0 {
+			userids = append(userids, b)
+		}
+	}
 	return userids, nil
 }
 
 func ShareHabit(ctx context.Context, habits []*behaviour_proto.Habit, users []*behaviour_proto.TargetedUser, sharedBy *user_proto.User, orgId string) ([]string, error) {
 	userids := []string{}
+	// edges and pendings are written together, a failure doesn't leave one without the other
+	tx := common.NewTransaction(common.DbShareHabitUserEdgeTable, common.DbPendingTable)
 	for _, habit := range habits {
 		for _, user := range users {
 			shared := &behaviour_proto.ShareHabitUser{
@@ -1531,26 +1661,20 @@ func ShareHabit(ctx context.Context, habits []*behaviour_proto.Habit, users []*b
 			}
 
 			field := fmt.Sprintf(`{_from:"%v",_to:"%v"} `, _from, _to)
+			bindVars := common.BindVars{}
 			q := fmt.Sprintf(`
 				UPSERT %v
 				INSERT %v
 				UPDATE %v
 				INTO %v
-				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field, insert, update, common.DbShareHabitUserEdgeTable)
-
-			resp, err := runQuery(ctx, q, common.DbShareHabitUserEdgeTable)
-			if err != nil {
-				return nil, err
-			}
+				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field,
+				common.QueryPinVersion(insert, common.DbHabitTable, habit.Id, bindVars),
+				common.QueryPinVersion(update, common.DbHabitTable, habit.Id, bindVars),
+				common.DbShareHabitUserEdgeTable)
 
-			// parsing to check whether this was an update (returns nothing) or insert (returns inserted user_id)
-			b, err := common.RecordToInsertedUserId(resp.Records[0])
-			if err != nil {
+			if err := tx.Add(q, bindVars); err != nil {
 				return nil, err
 			}
-			if len(b) > 0 {
-				userids = append(userids, b)
-			}
 
 			// save pending
 			any, err := common.FilteredAnyFromObject(common.HABIT_TYPE, habit.Id)
@@ -1573,22 +1697,42 @@ func ShareHabit(ctx context.Context, habits []*behaviour_proto.Habit, users []*b
 				return nil, err1
 			}
 
-			if _, err := runQuery(ctx, q1, common.DbPendingTable); err != nil {
+			if err := tx.Add(q1, nil); err != nil {
 				return nil, err
 			}
 		}
 	}
+
+	results, err := tx.Run(ctx, ClientWrapper.Db_client)
+	if err != nil {
+		return nil, err
+	}
+	// edge upserts are the even operations, pendings the odd ones
+	for i := 0; i < len(results); i += 2 {
+		if len(results[i].Records) == 0 {
+			continue
+		}
+		// parsing to check whether this was an update (returns nothing) or insert (returns inserted user_id)
+		b, err := common.RecordToInsertedUserId(results[i].Records[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 0 {
+			userids = append(userids, b)
+		}
+	}
 	return userids, nil
 }
 
 func AutocompleteGoalSearch(ctx context.Context, title string) ([]*static_proto.AutocompleteResponse, error) {
 	response := []*static_proto.AutocompleteResponse{}
+	bindVars := common.BindVars{}
 	q := fmt.Sprintf(`
 		FOR doc IN %v
-		FILTER LIKE(doc.name, "%v",true)
-		RETURN doc`, common.DbGoalTable, `%`+title+`%`)
+		FILTER LIKE(doc.name, %s,true)
+		RETURN doc`, common.DbGoalTable, bindVars.Add("title", `%`+title+`%`))
 
-	resp, err := runQuery(ctx, q, common.DbGoalTable)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbGoalTable)
 
 	if err != nil {
 		return nil, err
@@ -1602,12 +1746,13 @@ func AutocompleteGoalSearch(ctx context.Context, title string) ([]*static_proto.
 
 func AutocompleteChallengeSearch(ctx context.Context, title string) ([]*static_proto.AutocompleteResponse, error) {
 	response := []*static_proto.AutocompleteResponse{}
+	bindVars := common.BindVars{}
 	q := fmt.Sprintf(`
 		FOR doc IN %v
-		FILTER LIKE(doc.name, "%v",true)
-		RETURN doc`, common.DbChallengeTable, `%`+title+`%`)
+		FILTER LIKE(doc.name, %s,true)
+		RETURN doc`, common.DbChallengeTable, bindVars.Add("title", `%`+title+`%`))
 
-	resp, err := runQuery(ctx, q, common.DbChallengeTable)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbChallengeTable)
 
 	if err != nil {
 		return nil, err
@@ -1621,12 +1766,13 @@ func AutocompleteChallengeSearch(ctx context.Context, title string) ([]*static_p
 
 func AutocompleteHabitSearch(ctx context.Context, title string) ([]*static_proto.AutocompleteResponse, error) {
 	response := []*static_proto.AutocompleteResponse{}
+	bindVars := common.BindVars{}
 	q := fmt.Sprintf(`
 		FOR doc IN %v
-		FILTER LIKE(doc.name, "%v",true)
-		RETURN doc`, common.DbHabitTable, `%`+title+`%`)
+		FILTER LIKE(doc.name, %s,true)
+		RETURN doc`, common.DbHabitTable, bindVars.Add("title", `%`+title+`%`))
 
-	resp, err := runQuery(ctx, q, common.DbHabitTable)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbHabitTable)
 
 	if err != nil {
 		return nil, err
@@ -1714,9 +1860,10 @@ func GetSharedHabit(ctx context.Context, userId, habitId string) (*behaviour_pro
 func AutocompleteTags(ctx context.Context, orgId, object, name string) ([]string, error) {
 	var tags []string
 
+	bindVars := common.BindVars{}
 	var query string
 	if len(orgId) > 0 {
-		query = fmt.Sprintf(`FILTER doc.parameter1 == "%v"`, orgId)
+		query = fmt.Sprintf(`FILTER doc.parameter1 == %s`, bindVars.Add("org_id", orgId))
 	}
 
 	var collection string
@@ -1734,11 +1881,11 @@ func AutocompleteTags(ctx context.Context, orgId, object, name string) ([]string
 		%v
 		RETURN doc.data.tags)[**]
 		FOR t IN tags
-		FILTER LIKE(t,"%v",true)
+		FILTER LIKE(t,%s,true)
 		LET ret = {parameter1:t}
 		RETURN DISTINCT ret
-		`, collection, query, `%`+name+`%`)
-	resp, err := runQuery(ctx, q, collection)
+		`, collection, query, bindVars.Add("name", `%`+name+`%`))
+	resp, err := runQueryBind(ctx, q, bindVars, collection)
 
 	if err != nil {
 		return nil, err
@@ -1981,3 +2128,92 @@ func AllHabitResponse(ctx context.Context, createdBy []string, search_term, user
 	}
 	return response, nil
 }
+
+// trashCollections are the collections whose documents are deleted to the trash
+var trashCollections = []string{
+	common.DbGoalTable,
+	common.DbChallengeTable,
+	common.DbHabitTable,
+}
+
+// Trash returns the deleted goals, challenges and habits, the latest deleted first
+func Trash(ctx context.Context, collections []string, orgId, teamId string, offset, limit int64) ([]*static_proto.TrashItem, error) {
+	collections, err := common.TrashCollections(collections, trashCollections)
+	if err != nil {
+		return nil, err
+	}
+	return common.ListTrash(ctx, ClientWrapper.Db_client, collections, orgId, offset, limit)
+}
+
+// Restore moves a deleted document and its edges back to its collection
+func Restore(ctx context.Context, id, collection, orgId, teamId string) (*static_proto.TrashItem, error) {
+	if _, err := common.TrashCollections([]string{collection}, trashCollections); err != nil {
+		return nil, err
+	}
+	return common.RestoreDocument(ctx, ClientWrapper.Db_client, collection, id, orgId)
+}
+
+// versionCollections are the collections whose documents are versioned
+var versionCollections = trashCollections
+
+func checkVersionCollection(collection string) error {
+	if ok, _ := common.InArray(collection, versionCollections); !ok {
+		return common.ErrVersionCollection
+	}
+	return nil
+}
+
+// recordToVersionData converts the record of a goal, challenge or habit as it was at a version
+func recordToVersionData(collection string, version *static_proto.Version, r *db_proto.Record) (*behaviour_proto.VersionData, error) {
+	data := &behaviour_proto.VersionData{Version: version}
+	var err error
+	switch collection {
+	case common.DbGoalTable:
+		data.Goal, err = recordToGoal(r)
+	case common.DbChallengeTable:
+		data.Challenge, err = recordToChallenge(r)
+	case common.DbHabitTable:
+		data.Habit, err = recordToHabit(r)
+	}
+	return data, err
+}
+
+// SaveVersion snapshots a goal, challenge or habit in its history after a write, it returns its latest version
+func SaveVersion(ctx context.Context, collection, id, userId string) (int64, error) {
+	if err := checkVersionCollection(collection); err != nil {
+		return 0, err
+	}
+	return common.SaveVersion(ctx, ClientWrapper.Db_client, collection, id, userId)
+}
+
+// ListVersions returns the versions of a goal, challenge or habit, the latest first
+func ListVersions(ctx context.Context, collection, id, orgId string, offset, limit int64) ([]*static_proto.Version, error) {
+	if err := checkVersionCollection(collection); err != nil {
+		return nil, err
+	}
+	return common.ListVersions(ctx, ClientWrapper.Db_client, collection, id, orgId, offset, limit)
+}
+
+// ReadVersion returns a version of a goal, challenge or habit and the document as it was
+func ReadVersion(ctx context.Context, collection, id, orgId string, version int64) (*behaviour_proto.VersionData, error) {
+	if err := checkVersionCollection(collection); err != nil {
+		return nil, err
+	}
+	v, r, err := common.ReadVersion(ctx, ClientWrapper.Db_client, collection, id, orgId, version)
+	if err != nil {
+		return nil, err
+	}
+	return recordToVersionData(collection, v, r)
+}
+
+// RevertToVersion writes a goal, challenge or habit as it was at a version, it returns the new version and the document
+func RevertToVersion(ctx context.Context, collection, id, orgId, userId string, version int64) (*behaviour_proto.VersionData, error) {
+	if err := checkVersionCollection(collection); err != nil {
+		return nil, err
+	}
+	v, r, err := common.RevertToVersion(ctx, ClientWrapper.Db_client, collection, id, orgId, userId, version)
+	if err != nil {
+		return nil, err
+	}
+	return recordToVersionData(collection, v, r)
+}
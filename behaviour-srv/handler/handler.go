@@ -63,6 +63,10 @@ func (p *BehaviourService) CreateGoal(ctx context.Context, req *behaviour_proto.
 	if err != nil {
 		return common.InternalServerError(common.BehaviourSrv, p.CreateGoal, err, "server error")
 	}
+	// the version is saved before the goal is shared, shares pin it
+	if _, err := db.SaveVersion(ctx, common.DbGoalTable, req.Goal.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.CreateGoal, err, "version error")
+	}
 	// share goal with user
 	if req.Goal.Users != nil && len(req.Goal.Users) > 0 {
 		req_share := &behaviour_proto.ShareGoalRequest{
@@ -109,7 +113,7 @@ func (p *BehaviourService) ReadGoal(ctx context.Context, req *behaviour_proto.Re
 
 func (p *BehaviourService) DeleteGoal(ctx context.Context, req *behaviour_proto.DeleteGoalRequest, rsp *behaviour_proto.DeleteGoalResponse) error {
 	log.Info("Received Behaviour.DeleteGoal request")
-	if err := db.DeleteGoal(ctx, req.GoalId, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteGoal(ctx, req.GoalId, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.BehaviourSrv, p.DeleteGoal, err, "server error")
 	}
 	return nil
@@ -137,6 +141,10 @@ func (p *BehaviourService) CreateHabit(ctx context.Context, req *behaviour_proto
 	if err := db.CreateHabit(ctx, req.Habit); err != nil {
 		return common.InternalServerError(common.BehaviourSrv, p.CreateHabit, err, "create error")
 	}
+	// the version is saved before the habit is shared, shares pin it
+	if _, err := db.SaveVersion(ctx, common.DbHabitTable, req.Habit.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.CreateHabit, err, "version error")
+	}
 	// share habit with user
 	if req.Habit.Users != nil && len(req.Habit.Users) > 0 {
 		req_share := &behaviour_proto.ShareHabitRequest{
@@ -184,7 +192,7 @@ func (p *BehaviourService) ReadHabit(ctx context.Context, req *behaviour_proto.R
 
 func (p *BehaviourService) DeleteHabit(ctx context.Context, req *behaviour_proto.DeleteHabitRequest, rsp *behaviour_proto.DeleteHabitResponse) error {
 	log.Info("Received Behaviour.DeleteHabit request")
-	if err := db.DeleteHabit(ctx, req.HabitId, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteHabit(ctx, req.HabitId, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.BehaviourSrv, p.DeleteHabit, err, "server error")
 	}
 	return nil
@@ -213,6 +221,10 @@ func (p *BehaviourService) CreateChallenge(ctx context.Context, req *behaviour_p
 	if err != nil {
 		return common.InternalServerError(common.BehaviourSrv, p.CreateChallenge, err, "server error")
 	}
+	// the version is saved before the challenge is shared, shares pin it
+	if _, err := db.SaveVersion(ctx, common.DbChallengeTable, req.Challenge.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.CreateChallenge, err, "version error")
+	}
 	// share habit with user
 	if req.Challenge.Users != nil && len(req.Challenge.Users) > 0 {
 		req_share := &behaviour_proto.ShareChallengeRequest{
@@ -261,7 +273,7 @@ func (p *BehaviourService) ReadChallenge(ctx context.Context, req *behaviour_pro
 
 func (p *BehaviourService) DeleteChallenge(ctx context.Context, req *behaviour_proto.DeleteChallengeRequest, rsp *behaviour_proto.DeleteChallengeResponse) error {
 	log.Info("Received Behaviour.DeleteChallenge request")
-	if err := db.DeleteChallenge(ctx, req.ChallengeId, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteChallenge(ctx, req.ChallengeId, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.BehaviourSrv, p.DeleteChallenge, err, "server error")
 	}
 	return nil
@@ -592,19 +604,73 @@ func (p *BehaviourService) AllHabitResponse(ctx context.Context, req *user_proto
 
 func (p *BehaviourService) UpdateGoal(ctx context.Context, req *behaviour_proto.UpdateGoalRequest, rsp *behaviour_proto.UpdateGoalResponse) error {
 	log.Info("Received Behaviour.UpdateGoal request")
-
+	if req.Goal == nil || len(req.Goal.Id) == 0 {
+		return common.BadRequest(common.BehaviourSrv, p.UpdateGoal, nil, "goal id empty")
+	}
+	if len(req.Goal.OrgId) == 0 {
+		req.Goal.OrgId = req.OrgId
+	}
+	goal, err := db.UpdateGoal(ctx, req.Goal, req.OrgId, req.TeamId)
+	switch {
+	case err == db.ErrNotFound:
+		return common.NotFound(common.BehaviourSrv, p.UpdateGoal, err, "goal not found")
+	case common.IsConflict(err):
+		return common.Conflict(common.BehaviourSrv, p.UpdateGoal, err, "goal was updated since its revision")
+	case err != nil:
+		return common.InternalServerError(common.BehaviourSrv, p.UpdateGoal, err, "server error")
+	}
+	if _, err := db.SaveVersion(ctx, common.DbGoalTable, goal.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.UpdateGoal, err, "version error")
+	}
+	rsp.Data = &behaviour_proto.GoalData{goal}
 	return nil
 }
 
 func (p *BehaviourService) UpdateChallenge(ctx context.Context, req *behaviour_proto.UpdateChallengeRequest, rsp *behaviour_proto.UpdateChallengeResponse) error {
-	log.Info("Received Behaviour.UpdateGoal request")
-
+	log.Info("Received Behaviour.UpdateChallenge request")
+	if req.Challenge == nil || len(req.Challenge.Id) == 0 {
+		return common.BadRequest(common.BehaviourSrv, p.UpdateChallenge, nil, "challenge id empty")
+	}
+	if len(req.Challenge.OrgId) == 0 {
+		req.Challenge.OrgId = req.OrgId
+	}
+	challenge, err := db.UpdateChallenge(ctx, req.Challenge, req.OrgId, req.TeamId)
+	switch {
+	case err == db.ErrNotFound:
+		return common.NotFound(common.BehaviourSrv, p.UpdateChallenge, err, "challenge not found")
+	case common.IsConflict(err):
+		return common.Conflict(common.BehaviourSrv, p.UpdateChallenge, err, "challenge was updated since its revision")
+	case err != nil:
+		return common.InternalServerError(common.BehaviourSrv, p.UpdateChallenge, err, "server error")
+	}
+	if _, err := db.SaveVersion(ctx, common.DbChallengeTable, challenge.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.UpdateChallenge, err, "version error")
+	}
+	rsp.Data = &behaviour_proto.ChallengeData{challenge}
 	return nil
 }
 
 func (p *BehaviourService) UpdateHabit(ctx context.Context, req *behaviour_proto.UpdateHabitRequest, rsp *behaviour_proto.UpdateHabitResponse) error {
-	log.Info("Received Behaviour.UpdateGoal request")
-
+	log.Info("Received Behaviour.UpdateHabit request")
+	if req.Habit == nil || len(req.Habit.Id) == 0 {
+		return common.BadRequest(common.BehaviourSrv, p.UpdateHabit, nil, "habit id empty")
+	}
+	if len(req.Habit.OrgId) == 0 {
+		req.Habit.OrgId = req.OrgId
+	}
+	habit, err := db.UpdateHabit(ctx, req.Habit, req.OrgId, req.TeamId)
+	switch {
+	case err == db.ErrNotFound:
+		return common.NotFound(common.BehaviourSrv, p.UpdateHabit, err, "habit not found")
+	case common.IsConflict(err):
+		return common.Conflict(common.BehaviourSrv, p.UpdateHabit, err, "habit was updated since its revision")
+	case err != nil:
+		return common.InternalServerError(common.BehaviourSrv, p.UpdateHabit, err, "server error")
+	}
+	if _, err := db.SaveVersion(ctx, common.DbHabitTable, habit.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.UpdateHabit, err, "version error")
+	}
+	rsp.Data = &behaviour_proto.HabitData{habit}
 	return nil
 }
 
@@ -645,3 +711,82 @@ func (p *BehaviourService) sendShareNotification(userids []string, message strin
 	}
 	return nil
 }
+
+func (p *BehaviourService) Trash(ctx context.Context, req *behaviour_proto.TrashRequest, rsp *behaviour_proto.TrashResponse) error {
+	log.Info("Received Behaviour.Trash request")
+	items, err := db.Trash(ctx, req.Collections, req.OrgId, req.TeamId, req.Offset, req.Limit)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.BehaviourSrv, p.Trash, err, "collection is invalid")
+	}
+	if err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.Trash, err, "trash error")
+	}
+	rsp.Data = &static_proto.TrashArrData{items}
+	return nil
+}
+
+func (p *BehaviourService) Restore(ctx context.Context, req *behaviour_proto.RestoreRequest, rsp *behaviour_proto.RestoreResponse) error {
+	log.Info("Received Behaviour.Restore request")
+	item, err := db.Restore(ctx, req.Id, req.Collection, req.OrgId, req.TeamId)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.BehaviourSrv, p.Restore, err, "collection is invalid")
+	}
+	if common.IsNotFound(err) {
+		return common.NotFound(common.BehaviourSrv, p.Restore, err, "trashed document not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.Restore, err, "restore error")
+	}
+	rsp.Data = &static_proto.TrashData{item}
+	return nil
+}
+
+func (p *BehaviourService) ListVersions(ctx context.Context, req *behaviour_proto.ListVersionsRequest, rsp *behaviour_proto.ListVersionsResponse) error {
+	log.Info("Received Behaviour.ListVersions request")
+	versions, err := db.ListVersions(ctx, req.Collection, req.Id, req.OrgId, req.Offset, req.Limit)
+	if err == common.ErrVersionCollection {
+		return common.BadRequest(common.BehaviourSrv, p.ListVersions, err, "collection is invalid")
+	}
+	if err == common.ErrVersionOrg {
+		return common.BadRequest(common.BehaviourSrv, p.ListVersions, err, "organisation empty")
+	}
+	if err != nil {
+		return common.InternalServerError(common.BehaviourSrv, p.ListVersions, err, "versions error")
+	}
+	rsp.Data = &static_proto.VersionArrData{versions}
+	return nil
+}
+
+func (p *BehaviourService) ReadVersion(ctx context.Context, req *behaviour_proto.ReadVersionRequest, rsp *behaviour_proto.ReadVersionResponse) error {
+	log.Info("Received Behaviour.ReadVersion request")
+	data, err := db.ReadVersion(ctx, req.Collection, req.Id, req.OrgId, req.Version)
+	switch {
+	case err == common.ErrVersionCollection:
+		return common.BadRequest(common.BehaviourSrv, p.ReadVersion, err, "collection is invalid")
+	case err == common.ErrVersionOrg:
+		return common.BadRequest(common.BehaviourSrv, p.ReadVersion, err, "organisation empty")
+	case err == common.ErrVersionNotFound:
+		return common.NotFound(common.BehaviourSrv, p.ReadVersion, err, "version not found")
+	case err != nil:
+		return common.InternalServerError(common.BehaviourSrv, p.ReadVersion, err, "version error")
+	}
+	rsp.Data = data
+	return nil
+}
+
+func (p *BehaviourService) RevertToVersion(ctx context.Context, req *behaviour_proto.RevertToVersionRequest, rsp *behaviour_proto.RevertToVersionResponse) error {
+	log.Info("Received Behaviour.RevertToVersion request")
+	data, err := db.RevertToVersion(ctx, req.Collection, req.Id, req.OrgId, req.UserId, req.Version)
+	switch {
+	case err == common.ErrVersionCollection:
+		return common.BadRequest(common.BehaviourSrv, p.RevertToVersion, err, "collection is invalid")
+	case err == common.ErrVersionOrg:
+		return common.BadRequest(common.BehaviourSrv, p.RevertToVersion, err, "organisation empty")
+	case err == common.ErrVersionNotFound:
+		return common.NotFound(common.BehaviourSrv, p.RevertToVersion, err, "version not found")
+	case err != nil:
+		return common.InternalServerError(common.BehaviourSrv, p.RevertToVersion, err, "revert error")
+	}
+	rsp.Data = data
+	return nil
+}
@@ -70,8 +70,10 @@ func main() {
 		log.SetLevel(log.DebugLevel)
 	}
 
+	brker := service.Client().Options().Broker
+	brker.Connect()
 	behaviourService := &handler.BehaviourService{
-		Broker:        service.Client().Options().Broker,
+		Broker:        brker,
 		AccountClient: account_proto.NewAccountServiceClient("go.micro.srv.account", service.Client()),
 		StaticClient:  static_proto.NewStaticServiceClient("go.micro.srv.static", service.Client()),
 		KvClient:      kv_proto.NewKvServiceClient("go.micro.srv.kv", service.Client()),
@@ -80,6 +82,12 @@ func main() {
 	behaviour_proto.RegisterBehaviourServiceHandler(service.Server(), behaviourService)
 	db.Init(service.Client())
 
+	// static references are resolved in-process, static-srv publishes their changes
+	db.ClientWrapper.StaticCache = common.NewStaticCache(behaviourService.StaticClient, m)
+	if _, err := db.ClientWrapper.StaticCache.Subscribe(brker); err != nil {
+		log.Fatal(err)
+	}
+
 	// warmup cache
 	go func() {
 		behaviourService.WarmupCacheBehaviour(context.TODO(), &behaviour_proto.WarmupCacheBehaviourRequest{common.GOAL}, &behaviour_proto.WarmupCacheBehaviourResponse{})
@@ -80,6 +80,17 @@ It has these top-level messages:
 	AutocompleteTagsResponse
 	WarmupCacheBehaviourRequest
 	WarmupCacheBehaviourResponse
+	TrashRequest
+	TrashResponse
+	RestoreRequest
+	RestoreResponse
+	ListVersionsRequest
+	ListVersionsResponse
+	VersionData
+	ReadVersionRequest
+	ReadVersionResponse
+	RevertToVersionRequest
+	RevertToVersionResponse
 */
 package go_micro_srv_behaviour
 
@@ -422,6 +433,7 @@ type UpdateGoalRequest struct {
 	Goal   *Goal  `protobuf:"bytes,1,opt,name=goal" json:"goal,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *UpdateGoalRequest) Reset()                    { *m = UpdateGoalRequest{} }
@@ -450,6 +462,13 @@ func (m *UpdateGoalRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *UpdateGoalRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type UpdateGoalResponse struct {
 	Data    *GoalData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
 	Code    int64     `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
@@ -550,6 +569,7 @@ type DeleteGoalRequest struct {
 	GoalId string `protobuf:"bytes,1,opt,name=goal_id,json=goalId" json:"goal_id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteGoalRequest) Reset()                    { *m = DeleteGoalRequest{} }
@@ -578,6 +598,13 @@ func (m *DeleteGoalRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteGoalRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteGoalResponse struct {
 	Code    int64  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
@@ -766,6 +793,7 @@ type UpdateHabitRequest struct {
 	Habit  *Habit `protobuf:"bytes,1,opt,name=habit" json:"habit,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *UpdateHabitRequest) Reset()                    { *m = UpdateHabitRequest{} }
@@ -794,6 +822,13 @@ func (m *UpdateHabitRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *UpdateHabitRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type UpdateHabitResponse struct {
 	Data    *HabitData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
 	Code    int64      `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
@@ -894,6 +929,7 @@ type DeleteHabitRequest struct {
 	HabitId string `protobuf:"bytes,1,opt,name=habit_id,json=habitId" json:"habit_id,omitempty"`
 	OrgId   string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId  string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId  string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteHabitRequest) Reset()                    { *m = DeleteHabitRequest{} }
@@ -922,6 +958,13 @@ func (m *DeleteHabitRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteHabitRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteHabitResponse struct {
 	Code    int64  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
@@ -1158,6 +1201,7 @@ type UpdateChallengeRequest struct {
 	Challenge *Challenge `protobuf:"bytes,1,opt,name=challenge" json:"challenge,omitempty"`
 	OrgId     string     `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId    string     `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId    string     `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *UpdateChallengeRequest) Reset()                    { *m = UpdateChallengeRequest{} }
@@ -1186,6 +1230,13 @@ func (m *UpdateChallengeRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *UpdateChallengeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type UpdateChallengeResponse struct {
 	Data    *ChallengeData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
 	Code    int64          `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
@@ -1286,6 +1337,7 @@ type DeleteChallengeRequest struct {
 	ChallengeId string `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId" json:"challenge_id,omitempty"`
 	OrgId       string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId      string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId      string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteChallengeRequest) Reset()                    { *m = DeleteChallengeRequest{} }
@@ -1314,6 +1366,13 @@ func (m *DeleteChallengeRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteChallengeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteChallengeResponse struct {
 	Code    int64  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
@@ -1943,6 +2002,7 @@ type Goal struct {
 	Status                     Status                                 `protobuf:"varint,27,opt,name=status,enum=go.micro.srv.behaviour.Status" json:"status,omitempty"`
 	Setting                    *go_micro_srv_static.Setting           `protobuf:"bytes,28,opt,name=setting" json:"setting,omitempty"`
 	Todos                      *go_micro_srv_todo.Todo                `protobuf:"bytes,29,opt,name=todos" json:"todos,omitempty"`
+	Revision                   string                                 `protobuf:"bytes,30,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *Goal) Reset()                    { *m = Goal{} }
@@ -2132,6 +2192,13 @@ func (m *Goal) GetTodos() *go_micro_srv_todo.Todo {
 	return nil
 }
 
+func (m *Goal) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type Tracker struct {
 	Marker    *go_micro_srv_static.Marker        `protobuf:"bytes,1,opt,name=marker" json:"marker,omitempty"`
 	Frequency Frequency                          `protobuf:"varint,2,opt,name=frequency,enum=go.micro.srv.behaviour.Frequency" json:"frequency,omitempty"`
@@ -2237,6 +2304,7 @@ type Challenge struct {
 	Setbacks                   []*go_micro_srv_static.Setback         `protobuf:"bytes,25,rep,name=setbacks" json:"setbacks,omitempty"`
 	Setting                    *go_micro_srv_static.Setting           `protobuf:"bytes,26,opt,name=setting" json:"setting,omitempty"`
 	Todos                      *go_micro_srv_todo.Todo                `protobuf:"bytes,27,opt,name=todos" json:"todos,omitempty"`
+	Revision                   string                                 `protobuf:"bytes,28,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *Challenge) Reset()                    { *m = Challenge{} }
@@ -2412,6 +2480,13 @@ func (m *Challenge) GetTodos() *go_micro_srv_todo.Todo {
 	return nil
 }
 
+func (m *Challenge) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type Habit struct {
 	Id                         string                                 `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Title                      string                                 `protobuf:"bytes,2,opt,name=title" json:"title,omitempty"`
@@ -2436,6 +2511,7 @@ type Habit struct {
 	Setbacks                   []*go_micro_srv_static.Setback         `protobuf:"bytes,24,rep,name=setbacks" json:"setbacks,omitempty"`
 	Setting                    *go_micro_srv_static.Setting           `protobuf:"bytes,25,opt,name=setting" json:"setting,omitempty"`
 	Todos                      *go_micro_srv_todo.Todo                `protobuf:"bytes,26,opt,name=todos" json:"todos,omitempty"`
+	Revision                   string                                 `protobuf:"bytes,27,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *Habit) Reset()                    { *m = Habit{} }
@@ -2604,6 +2680,13 @@ func (m *Habit) GetTodos() *go_micro_srv_todo.Todo {
 	return nil
 }
 
+func (m *Habit) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type Category struct {
 	Id          string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Name        string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
@@ -2734,6 +2817,7 @@ type ShareGoalUser struct {
 	CurrentValue     int64                                    `protobuf:"varint,7,opt,name=currentValue" json:"currentValue,omitempty"`
 	ExpectedProgress go_micro_srv_static.ExpectedProgressType `protobuf:"varint,8,opt,name=expectedProgress,enum=go.micro.srv.static.ExpectedProgressType" json:"expectedProgress,omitempty"`
 	Created          int64                                    `protobuf:"varint,9,opt,name=created" json:"created,omitempty"`
+	Version          int64                                    `protobuf:"varint,10,opt,name=version" json:"version,omitempty"`
 }
 
 func (m *ShareGoalUser) Reset()                    { *m = ShareGoalUser{} }
@@ -2804,6 +2888,13 @@ func (m *ShareGoalUser) GetCreated() int64 {
 	return 0
 }
 
+func (m *ShareGoalUser) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type ShareChallengeUser struct {
 	Id               string                                   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Challenge        *Challenge                               `protobuf:"bytes,2,opt,name=challenge" json:"challenge,omitempty"`
@@ -2814,6 +2905,7 @@ type ShareChallengeUser struct {
 	CurrentValue     int64                                    `protobuf:"varint,7,opt,name=currentValue" json:"currentValue,omitempty"`
 	ExpectedProgress go_micro_srv_static.ExpectedProgressType `protobuf:"varint,8,opt,name=expectedProgress,enum=go.micro.srv.static.ExpectedProgressType" json:"expectedProgress,omitempty"`
 	Created          int64                                    `protobuf:"varint,9,opt,name=created" json:"created,omitempty"`
+	Version          int64                                    `protobuf:"varint,10,opt,name=version" json:"version,omitempty"`
 }
 
 func (m *ShareChallengeUser) Reset()                    { *m = ShareChallengeUser{} }
@@ -2884,6 +2976,13 @@ func (m *ShareChallengeUser) GetCreated() int64 {
 	return 0
 }
 
+func (m *ShareChallengeUser) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type ShareHabitUser struct {
 	Id               string                                   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Habit            *Habit                                   `protobuf:"bytes,2,opt,name=habit" json:"habit,omitempty"`
@@ -2894,6 +2993,7 @@ type ShareHabitUser struct {
 	CurrentValue     int64                                    `protobuf:"varint,7,opt,name=currentValue" json:"currentValue,omitempty"`
 	ExpectedProgress go_micro_srv_static.ExpectedProgressType `protobuf:"varint,8,opt,name=expectedProgress,enum=go.micro.srv.static.ExpectedProgressType" json:"expectedProgress,omitempty"`
 	Created          int64                                    `protobuf:"varint,9,opt,name=created" json:"created,omitempty"`
+	Version          int64                                    `protobuf:"varint,10,opt,name=version" json:"version,omitempty"`
 }
 
 func (m *ShareHabitUser) Reset()                    { *m = ShareHabitUser{} }
@@ -2964,6 +3064,13 @@ func (m *ShareHabitUser) GetCreated() int64 {
 	return 0
 }
 
+func (m *ShareHabitUser) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type GetSharedGoalRequest struct {
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 	GoalId string `protobuf:"bytes,2,opt,name=goal_id,json=goalId" json:"goal_id,omitempty"`
@@ -3336,6 +3443,464 @@ func (m *WarmupCacheBehaviourResponse) String() string            { return proto
 func (*WarmupCacheBehaviourResponse) ProtoMessage()               {}
 func (*WarmupCacheBehaviourResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
+// lists the deleted records of the service, the latest deleted first
+type TrashRequest struct {
+	OrgId  string `protobuf:"bytes,1,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId string `protobuf:"bytes,2,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	// collections of the records, all the collections of the service if empty
+	Collections []string `protobuf:"bytes,3,rep,name=collections" json:"collections,omitempty"`
+	Offset      int64    `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit       int64    `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *TrashRequest) Reset()                    { *m = TrashRequest{} }
+func (m *TrashRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrashRequest) ProtoMessage()               {}
+func (*TrashRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *TrashRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetCollections() []string {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func (m *TrashRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TrashRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TrashResponse struct {
+	Data    *go_micro_srv_static.TrashArrData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                             `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                            `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *TrashResponse) Reset()                    { *m = TrashResponse{} }
+func (m *TrashResponse) String() string            { return proto.CompactTextString(m) }
+func (*TrashResponse) ProtoMessage()               {}
+func (*TrashResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *TrashResponse) GetData() *go_micro_srv_static.TrashArrData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TrashResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TrashResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// restores a deleted record and its edges
+type RestoreRequest struct {
+	Id         string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	OrgId      string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId     string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()               {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *RestoreRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type RestoreResponse struct {
+	Data    *go_micro_srv_static.TrashData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                          `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                         `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RestoreResponse) Reset()                    { *m = RestoreResponse{} }
+func (m *RestoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreResponse) ProtoMessage()               {}
+func (*RestoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *RestoreResponse) GetData() *go_micro_srv_static.TrashData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RestoreResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// lists the versions of a record, the latest first
+type ListVersionsRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit  int64  `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+	// collection of the record e.g. goal
+	Collection string `protobuf:"bytes,6,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *ListVersionsRequest) Reset()                    { *m = ListVersionsRequest{} }
+func (m *ListVersionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListVersionsRequest) ProtoMessage()               {}
+func (*ListVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *ListVersionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListVersionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListVersionsRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type ListVersionsResponse struct {
+	Data    *go_micro_srv_static.VersionArrData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                               `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                              `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ListVersionsResponse) Reset()                    { *m = ListVersionsResponse{} }
+func (m *ListVersionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListVersionsResponse) ProtoMessage()               {}
+func (*ListVersionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *ListVersionsResponse) GetData() *go_micro_srv_static.VersionArrData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListVersionsResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListVersionsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// a record as it was at a version
+type VersionData struct {
+	Version *go_micro_srv_static.Version `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
+	// the record of the collection
+	Goal      *Goal      `protobuf:"bytes,2,opt,name=goal" json:"goal,omitempty"`
+	Challenge *Challenge `protobuf:"bytes,3,opt,name=challenge" json:"challenge,omitempty"`
+	Habit     *Habit     `protobuf:"bytes,4,opt,name=habit" json:"habit,omitempty"`
+}
+
+func (m *VersionData) Reset()                    { *m = VersionData{} }
+func (m *VersionData) String() string            { return proto.CompactTextString(m) }
+func (*VersionData) ProtoMessage()               {}
+func (*VersionData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *VersionData) GetVersion() *go_micro_srv_static.Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *VersionData) GetGoal() *Goal {
+	if m != nil {
+		return m.Goal
+	}
+	return nil
+}
+
+func (m *VersionData) GetChallenge() *Challenge {
+	if m != nil {
+		return m.Challenge
+	}
+	return nil
+}
+
+func (m *VersionData) GetHabit() *Habit {
+	if m != nil {
+		return m.Habit
+	}
+	return nil
+}
+
+type ReadVersionRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	OrgId   string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId  string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	// collection of the record e.g. goal
+	Collection string `protobuf:"bytes,5,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *ReadVersionRequest) Reset()                    { *m = ReadVersionRequest{} }
+func (m *ReadVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadVersionRequest) ProtoMessage()               {}
+func (*ReadVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *ReadVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReadVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReadVersionRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ReadVersionRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ReadVersionRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type ReadVersionResponse struct {
+	Data    *VersionData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64        `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string       `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ReadVersionResponse) Reset()                    { *m = ReadVersionResponse{} }
+func (m *ReadVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadVersionResponse) ProtoMessage()               {}
+func (*ReadVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *ReadVersionResponse) GetData() *VersionData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadVersionResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReadVersionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// writes a record as it was at a version, it's saved as a new version
+type RevertToVersionRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	OrgId   string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId  string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId  string `protobuf:"bytes,5,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	// collection of the record e.g. goal
+	Collection string `protobuf:"bytes,6,opt,name=collection" json:"collection,omitempty"`
+}
+
+func (m *RevertToVersionRequest) Reset()                    { *m = RevertToVersionRequest{} }
+func (m *RevertToVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevertToVersionRequest) ProtoMessage()               {}
+func (*RevertToVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *RevertToVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RevertToVersionRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+type RevertToVersionResponse struct {
+	Data    *VersionData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64        `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string       `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RevertToVersionResponse) Reset()                    { *m = RevertToVersionResponse{} }
+func (m *RevertToVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevertToVersionResponse) ProtoMessage()               {}
+func (*RevertToVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *RevertToVersionResponse) GetData() *VersionData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RevertToVersionResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RevertToVersionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GoalData)(nil), "go.micro.srv.behaviour.GoalData")
 	proto.RegisterType((*GoalArrData)(nil), "go.micro.srv.behaviour.GoalArrData")
@@ -3417,6 +3982,17 @@ func init() {
 	proto.RegisterType((*AutocompleteTagsResponse_Data)(nil), "go.micro.srv.behaviour.AutocompleteTagsResponse.Data")
 	proto.RegisterType((*WarmupCacheBehaviourRequest)(nil), "go.micro.srv.behaviour.WarmupCacheBehaviourRequest")
 	proto.RegisterType((*WarmupCacheBehaviourResponse)(nil), "go.micro.srv.behaviour.WarmupCacheBehaviourResponse")
+	proto.RegisterType((*TrashRequest)(nil), "go.micro.srv.behaviour.TrashRequest")
+	proto.RegisterType((*TrashResponse)(nil), "go.micro.srv.behaviour.TrashResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "go.micro.srv.behaviour.RestoreRequest")
+	proto.RegisterType((*RestoreResponse)(nil), "go.micro.srv.behaviour.RestoreResponse")
+	proto.RegisterType((*ListVersionsRequest)(nil), "go.micro.srv.behaviour.ListVersionsRequest")
+	proto.RegisterType((*ListVersionsResponse)(nil), "go.micro.srv.behaviour.ListVersionsResponse")
+	proto.RegisterType((*VersionData)(nil), "go.micro.srv.behaviour.VersionData")
+	proto.RegisterType((*ReadVersionRequest)(nil), "go.micro.srv.behaviour.ReadVersionRequest")
+	proto.RegisterType((*ReadVersionResponse)(nil), "go.micro.srv.behaviour.ReadVersionResponse")
+	proto.RegisterType((*RevertToVersionRequest)(nil), "go.micro.srv.behaviour.RevertToVersionRequest")
+	proto.RegisterType((*RevertToVersionResponse)(nil), "go.micro.srv.behaviour.RevertToVersionResponse")
 	proto.RegisterEnum("go.micro.srv.behaviour.Frequency", Frequency_name, Frequency_value)
 	proto.RegisterEnum("go.micro.srv.behaviour.Status", Status_name, Status_value)
 }
@@ -3462,6 +4038,11 @@ type BehaviourServiceClient interface {
 	AllGoalResponse(ctx context.Context, in *go_micro_srv_user.AllGoalResponseRequest, opts ...client.CallOption) (*go_micro_srv_user.AllGoalResponseResponse, error)
 	AllChallengeResponse(ctx context.Context, in *go_micro_srv_user.AllChallengeResponseRequest, opts ...client.CallOption) (*go_micro_srv_user.AllChallengeResponseResponse, error)
 	AllHabitResponse(ctx context.Context, in *go_micro_srv_user.AllHabitResponseRequest, opts ...client.CallOption) (*go_micro_srv_user.AllHabitResponseResponse, error)
+	Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error)
+	ReadVersion(ctx context.Context, in *ReadVersionRequest, opts ...client.CallOption) (*ReadVersionResponse, error)
+	RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...client.CallOption) (*RevertToVersionResponse, error)
 }
 
 type behaviourServiceClient struct {
@@ -3812,6 +4393,56 @@ func (c *behaviourServiceClient) AllHabitResponse(ctx context.Context, in *go_mi
 	return out, nil
 }
 
+func (c *behaviourServiceClient) Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "BehaviourService.Trash", in)
+	out := new(TrashResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *behaviourServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "BehaviourService.Restore", in)
+	out := new(RestoreResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *behaviourServiceClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "BehaviourService.ListVersions", in)
+	out := new(ListVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *behaviourServiceClient) ReadVersion(ctx context.Context, in *ReadVersionRequest, opts ...client.CallOption) (*ReadVersionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "BehaviourService.ReadVersion", in)
+	out := new(ReadVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *behaviourServiceClient) RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...client.CallOption) (*RevertToVersionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "BehaviourService.RevertToVersion", in)
+	out := new(RevertToVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for BehaviourService service
 
 type BehaviourServiceHandler interface {
@@ -3848,6 +4479,11 @@ type BehaviourServiceHandler interface {
 	AllGoalResponse(context.Context, *go_micro_srv_user.AllGoalResponseRequest, *go_micro_srv_user.AllGoalResponseResponse) error
 	AllChallengeResponse(context.Context, *go_micro_srv_user.AllChallengeResponseRequest, *go_micro_srv_user.AllChallengeResponseResponse) error
 	AllHabitResponse(context.Context, *go_micro_srv_user.AllHabitResponseRequest, *go_micro_srv_user.AllHabitResponseResponse) error
+	Trash(context.Context, *TrashRequest, *TrashResponse) error
+	Restore(context.Context, *RestoreRequest, *RestoreResponse) error
+	ListVersions(context.Context, *ListVersionsRequest, *ListVersionsResponse) error
+	ReadVersion(context.Context, *ReadVersionRequest, *ReadVersionResponse) error
+	RevertToVersion(context.Context, *RevertToVersionRequest, *RevertToVersionResponse) error
 }
 
 func RegisterBehaviourServiceHandler(s server.Server, hdlr BehaviourServiceHandler, opts ...server.HandlerOption) {
@@ -3990,202 +4626,244 @@ func (h *BehaviourService) AllHabitResponse(ctx context.Context, in *go_micro_sr
 	return h.BehaviourServiceHandler.AllHabitResponse(ctx, in, out)
 }
 
+func (h *BehaviourService) Trash(ctx context.Context, in *TrashRequest, out *TrashResponse) error {
+	return h.BehaviourServiceHandler.Trash(ctx, in, out)
+}
+
+func (h *BehaviourService) Restore(ctx context.Context, in *RestoreRequest, out *RestoreResponse) error {
+	return h.BehaviourServiceHandler.Restore(ctx, in, out)
+}
+
+func (h *BehaviourService) ListVersions(ctx context.Context, in *ListVersionsRequest, out *ListVersionsResponse) error {
+	return h.BehaviourServiceHandler.ListVersions(ctx, in, out)
+}
+
+func (h *BehaviourService) ReadVersion(ctx context.Context, in *ReadVersionRequest, out *ReadVersionResponse) error {
+	return h.BehaviourServiceHandler.ReadVersion(ctx, in, out)
+}
+
+func (h *BehaviourService) RevertToVersion(ctx context.Context, in *RevertToVersionRequest, out *RevertToVersionResponse) error {
+	return h.BehaviourServiceHandler.RevertToVersion(ctx, in, out)
+}
+
 func init() {
 	proto.RegisterFile("server/behaviour-srv/proto/behaviour/behaviour.proto", fileDescriptor0)
 }
 
 var fileDescriptor0 = []byte{
-	// 3069 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1c, 0x4b, 0x6f, 0xdc, 0xc6,
-	0x79, 0xb9, 0x2f, 0xed, 0x7e, 0xb2, 0xe4, 0xd5, 0x58, 0x8f, 0xf5, 0xda, 0x49, 0x15, 0xb6, 0x4e,
-	0x65, 0xbb, 0x96, 0x13, 0xd9, 0x4e, 0xe2, 0x24, 0x48, 0x2a, 0xc9, 0xf2, 0x03, 0xb1, 0x63, 0x83,
-	0x92, 0x1a, 0xb8, 0x68, 0x21, 0x50, 0xdc, 0xf1, 0x8a, 0xf1, 0xee, 0x72, 0x43, 0x72, 0x15, 0x2b,
-	0x45, 0x81, 0x36, 0x01, 0x0a, 0xb4, 0x05, 0x7a, 0xe8, 0xa5, 0xa7, 0x1e, 0x92, 0x1e, 0x0a, 0xf4,
-	0x52, 0x14, 0x28, 0x7a, 0x29, 0xd2, 0x43, 0x8f, 0xed, 0x21, 0xe8, 0x2f, 0xc8, 0x2d, 0xbf, 0xa3,
-	0x98, 0x07, 0xc9, 0x19, 0x92, 0xb3, 0x0f, 0x4a, 0x11, 0x12, 0xd8, 0x17, 0x9b, 0x33, 0xfb, 0xcd,
-	0x7c, 0xef, 0x6f, 0xbe, 0x99, 0xf9, 0x46, 0x70, 0xd5, 0xc3, 0xee, 0x3e, 0x76, 0x2f, 0xef, 0xe2,
-	0x3d, 0x73, 0xdf, 0x76, 0xfa, 0xee, 0x25, 0xcf, 0xdd, 0xbf, 0xdc, 0x73, 0x1d, 0xdf, 0x89, 0xfa,
-	0xa2, 0xaf, 0x65, 0xfa, 0x0b, 0x9a, 0x6f, 0x39, 0xcb, 0x1d, 0xdb, 0x72, 0x9d, 0x65, 0xcf, 0xdd,
-	0x5f, 0x0e, 0x7f, 0x6d, 0x5c, 0xe4, 0xb3, 0x79, 0xbe, 0xe9, 0xdb, 0x96, 0x30, 0x15, 0xeb, 0xe0,
-	0xff, 0xb1, 0x49, 0x1a, 0xe7, 0x38, 0xb0, 0xef, 0x34, 0x1d, 0x01, 0x94, 0x34, 0xe9, 0x3f, 0x31,
-	0xb0, 0xbe, 0x87, 0x45, 0xe2, 0x48, 0x93, 0xfe, 0xc3, 0xc1, 0x96, 0x39, 0x98, 0xe5, 0x74, 0x7d,
-	0xdc, 0xf5, 0x05, 0x48, 0xde, 0x13, 0xfc, 0xcf, 0xe0, 0xf5, 0x37, 0xa1, 0x72, 0xcb, 0x31, 0xdb,
-	0x37, 0x4c, 0xdf, 0x44, 0x2f, 0x41, 0xb1, 0xe5, 0x98, 0xed, 0xba, 0xb6, 0xa8, 0x2d, 0x4d, 0xae,
-	0x9c, 0x5d, 0x4e, 0xe7, 0x6e, 0x99, 0xc0, 0x1b, 0x14, 0x52, 0x5f, 0x85, 0x49, 0xd2, 0x5a, 0x75,
-	0x5d, 0x3a, 0xc1, 0x0a, 0x94, 0x48, 0xb7, 0x57, 0xd7, 0x16, 0x0b, 0x43, 0x67, 0x60, 0xa0, 0xfa,
-	0x0f, 0xa1, 0x7a, 0xdb, 0xdc, 0xb5, 0x7d, 0x3a, 0xc1, 0x15, 0x28, 0xed, 0x91, 0x06, 0x27, 0xe1,
-	0x39, 0xd5, 0x04, 0x74, 0x84, 0xc1, 0x60, 0xf5, 0x0d, 0x38, 0x41, 0xdb, 0x01, 0x15, 0xd7, 0xa0,
-	0x4c, 0x7f, 0x08, 0xc8, 0x18, 0x32, 0x0b, 0x07, 0xd6, 0x1f, 0xc0, 0xd4, 0xfa, 0x9e, 0xd9, 0x6e,
-	0xe3, 0x6e, 0x0b, 0xd3, 0x79, 0xde, 0x86, 0xaa, 0x15, 0x74, 0x70, 0x82, 0x5e, 0x50, 0x4d, 0x15,
-	0x8e, 0x34, 0xa2, 0x31, 0xfa, 0x36, 0xd4, 0xc2, 0xfe, 0x80, 0xb8, 0x55, 0x80, 0x10, 0x20, 0x20,
-	0x70, 0x84, 0x59, 0x85, 0x41, 0xfa, 0xe7, 0x1a, 0x9c, 0x5c, 0x6d, 0xb7, 0x89, 0x10, 0x3d, 0x03,
-	0x7f, 0xd0, 0xc7, 0x9e, 0x8f, 0xe6, 0xa0, 0xec, 0xb8, 0xad, 0x1d, 0xbb, 0x49, 0x09, 0xad, 0x1a,
-	0x25, 0xc7, 0x6d, 0xdd, 0x69, 0xa2, 0x05, 0x98, 0xf0, 0xb1, 0xd9, 0x21, 0xfd, 0x79, 0xda, 0x5f,
-	0x26, 0xcd, 0x3b, 0x4d, 0x34, 0x0b, 0xa5, 0xb6, 0xdd, 0xb1, 0xfd, 0x7a, 0x61, 0x51, 0x5b, 0x2a,
-	0x18, 0xac, 0x81, 0xe6, 0xa1, 0xec, 0x3c, 0x7a, 0xe4, 0x61, 0xbf, 0x5e, 0xa4, 0xdd, 0xbc, 0x85,
-	0xce, 0xc1, 0xb4, 0xe7, 0xb8, 0xfe, 0x4e, 0xcf, 0x74, 0xcd, 0x0e, 0xf6, 0xb1, 0x5b, 0x2f, 0xd1,
-	0xd9, 0xa6, 0x48, 0xef, 0x83, 0xa0, 0x33, 0x04, 0x6b, 0xda, 0x2e, 0xb6, 0x7c, 0xdb, 0xe9, 0xd6,
-	0xcb, 0x11, 0xd8, 0x8d, 0xa0, 0x53, 0x3f, 0x80, 0x5a, 0x44, 0xbe, 0xd7, 0x73, 0xba, 0x1e, 0x46,
-	0xaf, 0x42, 0xb1, 0x69, 0xfa, 0x26, 0x17, 0xf3, 0x77, 0x07, 0x19, 0x0e, 0x97, 0xa4, 0x41, 0x07,
-	0x20, 0x04, 0x45, 0xcb, 0x69, 0x62, 0xca, 0x5e, 0xc1, 0xa0, 0xdf, 0xa8, 0x0e, 0x13, 0x1d, 0xec,
-	0x79, 0x66, 0x0b, 0x53, 0xf6, 0xaa, 0x46, 0xd0, 0xd4, 0x7f, 0xa7, 0xc1, 0xcc, 0xba, 0x8b, 0x4d,
-	0x1f, 0x53, 0x13, 0xe4, 0xc2, 0x1b, 0xdb, 0xee, 0x89, 0x5c, 0x89, 0xcf, 0x09, 0x72, 0x25, 0xcd,
-	0x3b, 0x4d, 0x41, 0x0f, 0x05, 0x85, 0x1e, 0x8a, 0xa2, 0x1e, 0xf4, 0x27, 0x80, 0x44, 0x7a, 0xb8,
-	0x34, 0xae, 0x4a, 0xd2, 0x58, 0x1c, 0x44, 0x50, 0x66, 0x51, 0xf4, 0x61, 0x66, 0xbb, 0xd7, 0x3c,
-	0xb4, 0x24, 0x22, 0x86, 0xf3, 0x0a, 0x86, 0x0b, 0x71, 0x86, 0x45, 0xb4, 0xc7, 0xc8, 0xf0, 0x8f,
-	0xe1, 0xa4, 0x81, 0xcd, 0xa6, 0xc8, 0xee, 0x02, 0x4c, 0x10, 0x26, 0x22, 0xb7, 0x29, 0x93, 0xa6,
-	0xa4, 0xc6, 0xd1, 0xb8, 0xda, 0x87, 0x5a, 0x34, 0xf7, 0x31, 0xf2, 0xf4, 0x13, 0x98, 0xb9, 0x81,
-	0xdb, 0x58, 0x56, 0xe2, 0x91, 0x71, 0xb5, 0x06, 0x48, 0x9c, 0x9d, 0xf3, 0x35, 0x1e, 0x85, 0xff,
-	0xd2, 0xa8, 0xb7, 0xd3, 0x50, 0xfb, 0xad, 0x8c, 0x56, 0x3f, 0x83, 0x19, 0x81, 0x7e, 0x2e, 0x83,
-	0xd7, 0x24, 0xdd, 0x7e, 0x6f, 0xe0, 0x02, 0x73, 0x98, 0x78, 0xf5, 0x7b, 0x2d, 0x88, 0x0f, 0x6c,
-	0xad, 0xe2, 0xf2, 0xcb, 0xb2, 0x4c, 0x1e, 0x5d, 0xcc, 0xfa, 0x08, 0x4e, 0x49, 0x34, 0x71, 0x99,
-	0x5c, 0x93, 0x64, 0xf2, 0xc2, 0x40, 0x9a, 0x32, 0x0b, 0xe4, 0x20, 0x08, 0x1f, 0x87, 0x97, 0xc7,
-	0xb8, 0xde, 0xf0, 0x11, 0x9c, 0x92, 0x50, 0x1f, 0x27, 0xdb, 0x3f, 0x65, 0xf1, 0x45, 0x62, 0xfa,
-	0x34, 0x54, 0x28, 0x23, 0x91, 0x1b, 0x4d, 0xd0, 0x76, 0x06, 0x47, 0x7f, 0x02, 0x33, 0xc2, 0xf4,
-	0xc7, 0xc9, 0xd8, 0x4e, 0x10, 0x62, 0xbe, 0x2e, 0xd6, 0xd6, 0xe1, 0x94, 0x84, 0x20, 0x53, 0x10,
-	0x7b, 0x83, 0x58, 0x5d, 0xdb, 0x61, 0x01, 0x3e, 0x8c, 0x62, 0xd3, 0x90, 0x0f, 0xe9, 0xcb, 0xdb,
-	0x2a, 0xd2, 0x08, 0x05, 0xd2, 0xe0, 0x18, 0x05, 0x5a, 0x3a, 0x05, 0x79, 0x99, 0x82, 0x7f, 0x6b,
-	0x30, 0xbb, 0xda, 0x6e, 0x87, 0x09, 0xe1, 0xb7, 0x32, 0x94, 0x7e, 0xa2, 0xc1, 0x5c, 0x8c, 0x09,
-	0x2e, 0x8c, 0x37, 0x25, 0x5b, 0x5b, 0x1a, 0x9a, 0x0f, 0x1f, 0x26, 0xa6, 0x7e, 0xa6, 0xc1, 0x3c,
-	0x8b, 0x5f, 0x51, 0x7a, 0xcd, 0x85, 0x79, 0xd8, 0x8c, 0xff, 0xe8, 0x62, 0xec, 0xc7, 0x1a, 0x2c,
-	0x24, 0x88, 0xe4, 0xc2, 0xba, 0x2e, 0x09, 0xeb, 0xdc, 0x50, 0x02, 0x33, 0x4b, 0xea, 0xd7, 0x1a,
-	0xcc, 0xb3, 0x90, 0x77, 0xf4, 0x92, 0x1a, 0xd7, 0x8f, 0x89, 0x40, 0x12, 0xb4, 0x1c, 0xb7, 0x40,
-	0x6c, 0x98, 0x25, 0x71, 0x32, 0x21, 0x8d, 0x17, 0xe0, 0x44, 0xc8, 0x59, 0xe4, 0x8a, 0x93, 0x61,
-	0x5f, 0x86, 0xb8, 0xf5, 0x0b, 0x0d, 0xe6, 0x62, 0xb8, 0x8e, 0x9b, 0xdb, 0xc7, 0x30, 0xcf, 0x42,
-	0xe7, 0x71, 0xf0, 0x7b, 0x0b, 0x16, 0x12, 0xc8, 0x32, 0xc5, 0xea, 0xcf, 0xf3, 0x30, 0x75, 0xd3,
-	0x6e, 0xfb, 0xd8, 0x3d, 0x9e, 0x10, 0x89, 0xa0, 0xe8, 0x1f, 0xf4, 0x70, 0xbd, 0xb4, 0x58, 0x58,
-	0xaa, 0x1a, 0xf4, 0x1b, 0xbd, 0x02, 0x65, 0xcf, 0x37, 0xfd, 0xbe, 0x57, 0x2f, 0x2f, 0x16, 0x96,
-	0xa6, 0x57, 0x9e, 0x57, 0x29, 0x69, 0x93, 0x42, 0x19, 0x1c, 0x1a, 0x35, 0xa0, 0x62, 0x99, 0x3e,
-	0x6e, 0x39, 0xee, 0x41, 0x7d, 0x82, 0xce, 0x17, 0xb6, 0x09, 0xc7, 0x16, 0x09, 0x08, 0x8e, 0x5b,
-	0xaf, 0xd0, 0x9f, 0x82, 0x66, 0x4a, 0x90, 0xae, 0x8e, 0x16, 0xa4, 0x21, 0x2d, 0x48, 0xff, 0x23,
-	0x0f, 0xd3, 0x81, 0xfc, 0xb8, 0x02, 0xde, 0x96, 0x2c, 0xee, 0xa2, 0x8a, 0x19, 0x79, 0xd4, 0x72,
-	0x56, 0xbb, 0x6b, 0xfc, 0x5d, 0x83, 0x62, 0xd6, 0xe3, 0x24, 0xe1, 0xf0, 0x27, 0x3f, 0xc6, 0xe1,
-	0x4f, 0xec, 0x58, 0xa6, 0x90, 0xe5, 0x58, 0xe6, 0xb7, 0x79, 0x98, 0xda, 0xc4, 0xa6, 0x6b, 0xed,
-	0x1d, 0x9b, 0xe1, 0x75, 0xcd, 0x0e, 0xe6, 0x2b, 0x32, 0xfd, 0x26, 0x42, 0xf5, 0xfa, 0x9d, 0x8e,
-	0xe9, 0x1e, 0xf0, 0x15, 0x38, 0x68, 0xa2, 0x45, 0x98, 0x6c, 0x62, 0xcf, 0x72, 0xed, 0x1e, 0x55,
-	0xfd, 0x04, 0xf3, 0x58, 0xa1, 0x2b, 0xc5, 0x8c, 0x2a, 0xa3, 0x99, 0x51, 0x55, 0x65, 0x46, 0x81,
-	0x34, 0xc6, 0x33, 0x23, 0x79, 0xd4, 0x53, 0x67, 0x46, 0xff, 0xd5, 0xa0, 0xb6, 0xb9, 0x67, 0xba,
-	0xd2, 0x96, 0x3e, 0x0b, 0x0b, 0xaf, 0x43, 0x89, 0x24, 0x1f, 0x01, 0x07, 0xca, 0x4d, 0xea, 0x96,
-	0xe9, 0xb6, 0xb0, 0x8f, 0x9b, 0xdb, 0x1e, 0x76, 0x0d, 0x36, 0x44, 0xcc, 0x63, 0x0a, 0x8a, 0x3c,
-	0xa6, 0xa8, 0x30, 0xe9, 0x92, 0x14, 0xd6, 0x57, 0x61, 0x46, 0x60, 0x26, 0x53, 0xea, 0xfb, 0xa5,
-	0x06, 0x73, 0x74, 0x8e, 0xc4, 0x32, 0x74, 0xf8, 0xb3, 0xd4, 0x6f, 0x86, 0x90, 0x6e, 0xc2, 0x7c,
-	0x9c, 0xc1, 0x4c, 0x92, 0xfa, 0x42, 0xe3, 0xd2, 0x96, 0x36, 0x53, 0xd9, 0x8e, 0xc3, 0xbf, 0x19,
-	0x92, 0x59, 0x03, 0x24, 0x32, 0x94, 0x49, 0x2a, 0x2f, 0xc3, 0xe9, 0xd5, 0xbe, 0xef, 0x58, 0x4e,
-	0xa7, 0x47, 0xf2, 0x0b, 0x39, 0x44, 0xcf, 0x42, 0xc9, 0xb7, 0xfd, 0x36, 0x0e, 0x22, 0x34, 0x6d,
-	0xe8, 0x5f, 0x69, 0xd0, 0x48, 0x1b, 0xc3, 0xf1, 0xbf, 0x23, 0x05, 0xb2, 0x57, 0x55, 0x92, 0x51,
-	0xcf, 0x90, 0x3d, 0xa8, 0xdd, 0xe3, 0x31, 0x6d, 0x03, 0x2a, 0x2e, 0x9f, 0x8c, 0xab, 0xf5, 0xbc,
-	0x4c, 0x06, 0xbf, 0x62, 0x12, 0x69, 0x08, 0xb0, 0x1b, 0xe1, 0x50, 0xfd, 0x0f, 0x55, 0x28, 0x12,
-	0xd7, 0x4c, 0xec, 0x65, 0x43, 0xb9, 0xe4, 0x05, 0xb9, 0x88, 0xcb, 0x4b, 0x61, 0xe0, 0xf2, 0x52,
-	0x4c, 0x2e, 0x2f, 0xb3, 0x50, 0xb2, 0x3b, 0x84, 0x23, 0xa6, 0x61, 0xd6, 0xa0, 0xd9, 0x93, 0xd9,
-	0x62, 0x79, 0x12, 0xc9, 0x9e, 0xcc, 0x96, 0x17, 0x66, 0x3a, 0xb8, 0x49, 0x97, 0xa9, 0x82, 0x11,
-	0x34, 0xc9, 0x2f, 0x7d, 0xba, 0x07, 0x68, 0xd2, 0xb5, 0xa9, 0x60, 0x04, 0x4d, 0xb4, 0x26, 0x64,
-	0x4e, 0x55, 0xaa, 0x96, 0x17, 0x53, 0xe5, 0xb1, 0x16, 0x68, 0x67, 0x9d, 0x43, 0x0b, 0x19, 0xd6,
-	0x15, 0x28, 0xfb, 0xd4, 0x98, 0x69, 0x62, 0x34, 0xb9, 0x72, 0x26, 0x75, 0x06, 0x66, 0xef, 0x06,
-	0x07, 0x25, 0x29, 0x5b, 0xb3, 0xef, 0x9a, 0x94, 0xeb, 0x49, 0xca, 0x59, 0xd8, 0x16, 0xac, 0xfd,
-	0x84, 0x68, 0xed, 0xd7, 0xa0, 0xca, 0x19, 0x5a, 0x3b, 0xa8, 0x4f, 0x51, 0x54, 0x0b, 0x32, 0x2a,
-	0x7a, 0x9f, 0x47, 0x1d, 0x2a, 0x82, 0x24, 0x79, 0x80, 0xe7, 0xf4, 0x5d, 0x0b, 0xd7, 0x67, 0x98,
-	0x8f, 0xb0, 0x16, 0x7a, 0x03, 0x2a, 0xbe, 0x6b, 0x5a, 0x8f, 0x89, 0xaf, 0x22, 0x6a, 0x0a, 0xdf,
-	0x51, 0xfa, 0x2a, 0x83, 0x33, 0xc2, 0x01, 0xe8, 0x1e, 0xcc, 0x78, 0x7d, 0xcb, 0xc2, 0x9e, 0xb7,
-	0x63, 0xb9, 0xb6, 0x8f, 0x5d, 0xdb, 0xf4, 0xea, 0xa7, 0xe8, 0x2c, 0xca, 0x13, 0xeb, 0x75, 0x0e,
-	0x68, 0xd4, 0xf8, 0xd0, 0xa0, 0x23, 0xbe, 0xfe, 0xcd, 0x66, 0x89, 0xc8, 0x51, 0xb8, 0x9a, 0x1b,
-	0x27, 0x5c, 0x5d, 0x87, 0x8a, 0xe9, 0xfa, 0xb6, 0xd5, 0xc6, 0x5e, 0x7d, 0x3e, 0x6d, 0x60, 0x70,
-	0xed, 0xb9, 0xca, 0xa0, 0x8c, 0x10, 0x1c, 0xbd, 0x45, 0x04, 0x68, 0xb7, 0x5a, 0x44, 0x80, 0x0b,
-	0x74, 0xa8, 0x9e, 0xaa, 0xf9, 0x7b, 0x4e, 0xb3, 0xdf, 0xc6, 0x5b, 0x0c, 0xd4, 0x08, 0xc7, 0xa0,
-	0xd7, 0xa0, 0xe2, 0x61, 0x7f, 0xd7, 0xb4, 0x1e, 0x7b, 0xf5, 0x7a, 0xda, 0xfa, 0xcc, 0xc7, 0x6f,
-	0x32, 0x20, 0x23, 0x84, 0x8e, 0x62, 0xec, 0xe9, 0xf1, 0x63, 0xec, 0x5b, 0xd0, 0xe0, 0x8e, 0x6d,
-	0x3b, 0xdd, 0xd5, 0x5e, 0xcf, 0x75, 0xf6, 0x59, 0xbe, 0x60, 0xbb, 0xb8, 0x59, 0x6f, 0x2c, 0x6a,
-	0x4b, 0x15, 0x63, 0x00, 0x84, 0xb0, 0x47, 0x39, 0xb3, 0xa8, 0x8d, 0xb1, 0x47, 0x79, 0x05, 0x26,
-	0x3c, 0xec, 0xfb, 0x76, 0xb7, 0x55, 0x3f, 0x9b, 0x76, 0x4b, 0x14, 0x31, 0x4b, 0x60, 0x8c, 0x00,
-	0x18, 0x5d, 0x82, 0x92, 0xef, 0x34, 0x1d, 0xaf, 0xfe, 0x5c, 0x9a, 0xc5, 0xd3, 0x8b, 0xee, 0x2d,
-	0xa7, 0xe9, 0x18, 0x0c, 0x4a, 0xff, 0x9f, 0x06, 0x13, 0xdc, 0x5c, 0x89, 0x63, 0x76, 0x4c, 0xf7,
-	0x31, 0x76, 0x79, 0xc4, 0x4d, 0x77, 0xcc, 0x7b, 0x14, 0xc4, 0xe0, 0xa0, 0xe8, 0x6d, 0xa8, 0x3e,
-	0x72, 0x49, 0x94, 0xef, 0x5a, 0x07, 0x34, 0x8a, 0x4d, 0xab, 0x2d, 0xf1, 0x66, 0x00, 0x68, 0x44,
-	0x63, 0xd0, 0xeb, 0x50, 0xee, 0x60, 0x7f, 0xcf, 0x61, 0x6b, 0x98, 0xca, 0x28, 0x38, 0x8d, 0xf7,
-	0x28, 0xa4, 0xc1, 0x47, 0x90, 0x60, 0xd7, 0xef, 0xfa, 0x76, 0x3b, 0x58, 0xe6, 0x68, 0x43, 0xff,
-	0x54, 0x83, 0x4a, 0xe0, 0x2b, 0x04, 0x64, 0xdf, 0x6c, 0xf7, 0x83, 0x55, 0x8c, 0x35, 0x48, 0x76,
-	0x4d, 0xe8, 0xdf, 0xf1, 0xfa, 0xc4, 0xb1, 0x1e, 0xf5, 0xdb, 0x94, 0xf4, 0x8a, 0x31, 0x45, 0x7a,
-	0x37, 0x83, 0x4e, 0xf4, 0x1c, 0x00, 0x7e, 0xe2, 0xbb, 0xe6, 0x8e, 0x6f, 0x77, 0x82, 0x35, 0xa2,
-	0x4a, 0x7b, 0xb6, 0xec, 0x0e, 0x46, 0xd7, 0xa1, 0xda, 0xc5, 0x1f, 0x32, 0xab, 0xa1, 0x24, 0x0c,
-	0x09, 0x66, 0x11, 0xb4, 0xfe, 0x9b, 0x0a, 0x54, 0x43, 0xc7, 0x7c, 0xb6, 0x2c, 0x3c, 0xa5, 0xcb,
-	0x42, 0x14, 0x84, 0x4f, 0x65, 0x0d, 0xc2, 0xb3, 0xd9, 0x83, 0xf0, 0x5c, 0x86, 0x20, 0x1c, 0x86,
-	0xd2, 0xf9, 0xa3, 0x0e, 0xa5, 0x0b, 0x63, 0x84, 0xd2, 0xfa, 0x58, 0xa1, 0x54, 0x5c, 0x38, 0x4e,
-	0x8f, 0xb5, 0x70, 0x08, 0x41, 0xb8, 0x91, 0x29, 0x08, 0x9f, 0x19, 0x29, 0x08, 0x7f, 0x31, 0x01,
-	0x25, 0xaa, 0xe1, 0x67, 0x81, 0xe0, 0x29, 0x0d, 0x04, 0xa2, 0x47, 0x9f, 0xca, 0xee, 0xd1, 0xb3,
-	0x87, 0xf1, 0xe8, 0xb9, 0xa3, 0xf6, 0xe8, 0xf9, 0x31, 0x3c, 0x7a, 0x21, 0xb3, 0x47, 0xd7, 0xb3,
-	0x7a, 0xf4, 0xe9, 0x4c, 0x1e, 0xdd, 0x18, 0xc9, 0xa3, 0xbf, 0x22, 0x29, 0x48, 0x60, 0xd0, 0x71,
-	0xa7, 0x0e, 0x4e, 0x14, 0xf3, 0xe9, 0x27, 0x8a, 0x63, 0xbb, 0xf4, 0x19, 0xa8, 0xda, 0x96, 0xd3,
-	0xdd, 0xf1, 0xda, 0xfd, 0x16, 0x77, 0xeb, 0x0a, 0xe9, 0xd8, 0x6c, 0xf7, 0x5b, 0x82, 0xf1, 0x97,
-	0x45, 0xe3, 0xcf, 0xe2, 0xdc, 0x41, 0x90, 0xa8, 0x46, 0x41, 0x42, 0xff, 0x8f, 0x06, 0x27, 0x44,
-	0xcb, 0x40, 0x17, 0xa1, 0x48, 0x6c, 0x83, 0xa7, 0x90, 0x4a, 0x87, 0xa2, 0x40, 0x48, 0x87, 0x13,
-	0x56, 0xdf, 0x75, 0x71, 0xd7, 0xff, 0x11, 0xcd, 0xd1, 0xd8, 0xe6, 0x5c, 0xea, 0x43, 0xdb, 0x50,
-	0xc3, 0x4f, 0x7a, 0xd8, 0xf2, 0x71, 0xf3, 0x81, 0xeb, 0xb4, 0x5c, 0xec, 0x79, 0x54, 0x44, 0xd3,
-	0x8a, 0xad, 0xf8, 0x46, 0x0c, 0x78, 0xeb, 0xa0, 0x87, 0x8d, 0xc4, 0x14, 0x84, 0x99, 0x7e, 0xd7,
-	0xf6, 0xb9, 0x3c, 0xe9, 0xb7, 0xfe, 0xc7, 0x02, 0x4c, 0x85, 0xc7, 0x68, 0x94, 0x9b, 0xb8, 0xea,
-	0x82, 0xc2, 0xad, 0xfc, 0xc8, 0x85, 0x5b, 0x81, 0x3c, 0x0a, 0xa3, 0xc8, 0xe3, 0xb5, 0xd0, 0x1f,
-	0x8a, 0x94, 0xc3, 0xc5, 0x74, 0xe3, 0x24, 0x24, 0xc6, 0x3c, 0x42, 0xd0, 0x5a, 0x49, 0xd6, 0xda,
-	0x55, 0xa8, 0x7a, 0x64, 0x40, 0x73, 0x67, 0x97, 0x9d, 0x56, 0x0f, 0xa0, 0xa2, 0xc2, 0x20, 0xd7,
-	0x0e, 0x12, 0x9a, 0x99, 0x18, 0x51, 0x33, 0x95, 0xc3, 0x6b, 0x46, 0x30, 0xcd, 0xaa, 0x64, 0x9a,
-	0xfa, 0xdf, 0x0a, 0xfc, 0x9c, 0x2a, 0xcc, 0x9c, 0x53, 0x95, 0x24, 0x5d, 0x9a, 0xe6, 0x33, 0x5c,
-	0x9a, 0x3e, 0xd3, 0xd9, 0xd1, 0xe9, 0xec, 0xd3, 0x02, 0x4c, 0x47, 0x67, 0x8b, 0xa9, 0xfa, 0x0a,
-	0xcb, 0x8a, 0xf2, 0x63, 0x94, 0x15, 0x3d, 0xd3, 0xd1, 0xd1, 0xe9, 0xe8, 0x36, 0xcc, 0xde, 0xc2,
-	0x3e, 0x65, 0x3f, 0x5e, 0xb7, 0x19, 0x9c, 0x2f, 0x6b, 0xd2, 0xf9, 0xb2, 0x50, 0xfa, 0x98, 0x17,
-	0x4b, 0x1f, 0xf5, 0xcf, 0x34, 0x98, 0x8b, 0x4d, 0xc5, 0x0f, 0x73, 0x6f, 0x4a, 0x87, 0xb9, 0x2b,
-	0xca, 0xc8, 0x99, 0x36, 0x58, 0x38, 0xc7, 0x6d, 0xac, 0xf2, 0x93, 0xd9, 0xeb, 0x52, 0x09, 0xad,
-	0xf2, 0x7a, 0x5e, 0x0a, 0xe7, 0xbc, 0x9a, 0xfe, 0x3d, 0x38, 0x1d, 0xa2, 0x49, 0x5c, 0x76, 0x28,
-	0x79, 0x8e, 0x5f, 0xc6, 0xe7, 0x13, 0x97, 0xf1, 0xfa, 0x3f, 0x35, 0x68, 0xa4, 0xcd, 0x3c, 0xde,
-	0x79, 0xb6, 0x7a, 0x06, 0x51, 0x0e, 0x0f, 0xb8, 0x1c, 0x6e, 0x27, 0x2b, 0x44, 0x2e, 0x0c, 0x14,
-	0x86, 0x14, 0x3b, 0xc5, 0x32, 0xfa, 0x77, 0x04, 0xd5, 0x49, 0x37, 0x1b, 0x4a, 0x91, 0x88, 0xf5,
-	0x63, 0x79, 0xa9, 0x7e, 0x4c, 0xff, 0xb3, 0x06, 0xf3, 0xf1, 0xd9, 0xb8, 0x18, 0x6e, 0x49, 0x62,
-	0xb8, 0x32, 0x54, 0x0c, 0xd2, 0x68, 0x51, 0x04, 0x37, 0xb8, 0x08, 0xde, 0x94, 0xcb, 0x12, 0x5f,
-	0x1c, 0xc8, 0x7e, 0x18, 0x86, 0x82, 0x67, 0x0d, 0x0f, 0x60, 0xe6, 0x16, 0xf6, 0xb7, 0x9c, 0xde,
-	0x96, 0xd9, 0x0a, 0xcb, 0xbd, 0xe6, 0xa1, 0xec, 0xec, 0xbe, 0x8f, 0x2d, 0x3f, 0xe0, 0x98, 0xb5,
-	0x54, 0xe5, 0x16, 0x27, 0x40, 0xeb, 0xf2, 0xdb, 0x64, 0xad, 0xab, 0xff, 0x49, 0x03, 0x24, 0x4e,
-	0xc9, 0xf9, 0x5e, 0x97, 0xf8, 0xbe, 0x3c, 0x80, 0xef, 0xd8, 0xc8, 0xec, 0xd7, 0x18, 0x0d, 0x2e,
-	0xa1, 0x20, 0x73, 0xd3, 0x84, 0xcc, 0xed, 0x2f, 0x1a, 0x2c, 0x88, 0xd7, 0x16, 0x22, 0xfb, 0x8a,
-	0x1b, 0xf5, 0x48, 0x2a, 0x79, 0x49, 0x2a, 0x41, 0x42, 0x5b, 0x10, 0x12, 0xda, 0xe4, 0x35, 0x77,
-	0x71, 0xb4, 0x6b, 0xee, 0x52, 0xda, 0x35, 0xf7, 0x5f, 0x35, 0xa8, 0x27, 0x89, 0xe5, 0x82, 0xbd,
-	0x23, 0x09, 0xf6, 0xda, 0x28, 0xf7, 0x44, 0x5f, 0xbf, 0x78, 0xaf, 0xc1, 0x99, 0xf7, 0x4c, 0xb7,
-	0xd3, 0xef, 0xad, 0x9b, 0xd6, 0x1e, 0x0e, 0xf7, 0xbb, 0x43, 0x0c, 0x4c, 0x7f, 0x1e, 0xce, 0xa6,
-	0x0f, 0x63, 0xb4, 0x5e, 0xb8, 0x0f, 0xd5, 0xf0, 0x14, 0x15, 0x21, 0x98, 0x0e, 0x1b, 0x3b, 0xef,
-	0xde, 0x7f, 0x77, 0xa3, 0x96, 0x43, 0x55, 0x28, 0xdd, 0x58, 0xbd, 0x73, 0xf7, 0x61, 0x4d, 0x43,
-	0x00, 0xe5, 0xdb, 0xf7, 0xb7, 0x8d, 0xbb, 0x0f, 0x6b, 0x79, 0xf2, 0xfd, 0xde, 0xc6, 0xc6, 0x3b,
-	0x77, 0x1f, 0xd6, 0x0a, 0x68, 0x12, 0x26, 0xee, 0xdd, 0x7f, 0x77, 0xeb, 0xf6, 0xdd, 0x87, 0xb5,
-	0xe2, 0x85, 0x2b, 0x50, 0x66, 0x4b, 0x1e, 0x3a, 0x09, 0x93, 0xec, 0x4b, 0x9c, 0xca, 0x58, 0xbd,
-	0xb9, 0x55, 0xd3, 0xd0, 0x14, 0x54, 0x1f, 0x6c, 0xaf, 0xdd, 0xbd, 0xb3, 0x79, 0x7b, 0xe3, 0x46,
-	0x2d, 0xbf, 0xf2, 0xe5, 0x59, 0xa8, 0x85, 0xb4, 0x6d, 0x62, 0x77, 0xdf, 0xb6, 0x30, 0xda, 0x81,
-	0x4a, 0xf0, 0xde, 0x04, 0x7d, 0x5f, 0xa9, 0x04, 0xf9, 0x41, 0x4d, 0x63, 0x69, 0x38, 0x20, 0xbf,
-	0x43, 0xcb, 0x11, 0x04, 0x41, 0xf5, 0xbf, 0x1a, 0x41, 0xec, 0xed, 0x81, 0x1a, 0x41, 0xfc, 0x21,
-	0x81, 0x9e, 0x43, 0x18, 0x20, 0x7a, 0x25, 0x82, 0xce, 0xab, 0x2f, 0x66, 0x62, 0x2f, 0x5b, 0x1a,
-	0x17, 0x46, 0x01, 0x15, 0xd1, 0x44, 0x6f, 0x33, 0xd4, 0x68, 0x12, 0xcf, 0x46, 0xd4, 0x68, 0x92,
-	0x4f, 0x3d, 0x18, 0x9a, 0xe8, 0x59, 0x81, 0x1a, 0x4d, 0xe2, 0x61, 0x83, 0x1a, 0x4d, 0xf2, 0x95,
-	0x82, 0x9e, 0x43, 0x5d, 0x98, 0x92, 0x8a, 0x4d, 0xd1, 0x0f, 0x06, 0xa8, 0x34, 0x51, 0x58, 0xdb,
-	0xb8, 0x34, 0x22, 0xb4, 0x88, 0x4f, 0x2a, 0xd8, 0x53, 0xe3, 0x4b, 0xab, 0x21, 0x54, 0xe3, 0x4b,
-	0xad, 0x02, 0xd4, 0x73, 0xc8, 0x87, 0x93, 0xb1, 0x0a, 0x51, 0xb4, 0x3c, 0x58, 0xdd, 0x09, 0x9c,
-	0x97, 0x47, 0x86, 0x17, 0xb1, 0xc6, 0xca, 0x30, 0xd5, 0x58, 0xd3, 0x6b, 0x47, 0xd5, 0x58, 0x15,
-	0xf5, 0x9d, 0x0c, 0x6b, 0xac, 0x3a, 0x50, 0x8d, 0x35, 0xbd, 0x66, 0x51, 0x8d, 0x55, 0x51, 0x76,
-	0xa8, 0xe7, 0xd0, 0x2e, 0x54, 0xc3, 0xa7, 0x1f, 0x68, 0x50, 0x40, 0x90, 0x5e, 0xb7, 0x34, 0xce,
-	0x8f, 0x00, 0x29, 0xe2, 0x08, 0x4b, 0xef, 0xd1, 0xc0, 0x98, 0x20, 0xa6, 0x3e, 0x6a, 0x1c, 0x89,
-	0x3a, 0x7e, 0x3d, 0x87, 0xf6, 0x60, 0x52, 0x78, 0xb0, 0x81, 0x86, 0x04, 0x05, 0x09, 0xcf, 0xc5,
-	0x91, 0x60, 0x45, 0x4c, 0xc2, 0x1b, 0x09, 0x34, 0x24, 0x2e, 0x8c, 0x86, 0x29, 0xe5, 0xd1, 0x05,
-	0xc3, 0x24, 0xd4, 0xf5, 0xa3, 0x21, 0xa1, 0x61, 0x34, 0x4c, 0x29, 0x0f, 0x05, 0xf4, 0x1c, 0x7a,
-	0x08, 0x65, 0x56, 0xd9, 0x88, 0xce, 0x0d, 0xab, 0x7c, 0x64, 0xf3, 0xbf, 0x38, 0x5a, 0x81, 0x24,
-	0x9b, 0x9a, 0x15, 0x86, 0xa8, 0xa7, 0x96, 0xca, 0x55, 0xd4, 0x53, 0xcb, 0xf5, 0x25, 0x81, 0x26,
-	0xc2, 0x57, 0x07, 0x83, 0x34, 0x11, 0x7f, 0xd7, 0x30, 0x48, 0x13, 0x89, 0x67, 0x0c, 0xcc, 0x82,
-	0xc3, 0xcd, 0x8c, 0xda, 0x82, 0xe3, 0x25, 0x6d, 0x6a, 0x0b, 0x4e, 0xd4, 0x8b, 0xe9, 0x39, 0xf4,
-	0x01, 0xdf, 0xab, 0x47, 0xee, 0x7f, 0x69, 0xb4, 0xbd, 0x44, 0x80, 0x6d, 0x79, 0x54, 0x70, 0x71,
-	0x95, 0x8a, 0xf2, 0x72, 0x74, 0x7e, 0x78, 0xee, 0x3e, 0x74, 0x95, 0x4a, 0x56, 0x32, 0xe9, 0x39,
-	0xf4, 0x4b, 0x0d, 0xe6, 0xc5, 0x04, 0x90, 0x30, 0xce, 0x6d, 0xe2, 0xe5, 0x71, 0x0a, 0x8b, 0x18,
-	0xee, 0x95, 0xf1, 0x6b, 0x91, 0xf4, 0x1c, 0xfa, 0x95, 0x06, 0x67, 0x44, 0x80, 0x50, 0x1c, 0xc7,
-	0x4d, 0xc8, 0xc7, 0xb1, 0xd4, 0x9f, 0x0a, 0xeb, 0xb8, 0x89, 0xe8, 0xc2, 0x94, 0xb4, 0xd9, 0x57,
-	0xaf, 0xe3, 0x69, 0x67, 0x13, 0xea, 0x75, 0x3c, 0xf5, 0x04, 0x41, 0xcf, 0xa1, 0x9f, 0xd3, 0x4d,
-	0x59, 0x6c, 0x67, 0xad, 0x66, 0x57, 0x79, 0x42, 0xd0, 0x58, 0x19, 0x7f, 0xe3, 0xce, 0x5c, 0x4b,
-	0xde, 0xd1, 0xa2, 0x4b, 0xa3, 0xee, 0x7c, 0x87, 0xb8, 0x56, 0xfa, 0x46, 0x99, 0xb9, 0x56, 0xb4,
-	0x99, 0x54, 0xbb, 0x56, 0x62, 0xf7, 0xab, 0x76, 0xad, 0xe4, 0xde, 0x54, 0xcf, 0xa1, 0x0f, 0xa1,
-	0x16, 0xdf, 0x5a, 0xa1, 0xcb, 0xa3, 0x6f, 0xc2, 0x18, 0xca, 0x97, 0xc6, 0xdd, 0xb5, 0xe9, 0x39,
-	0xf4, 0x89, 0x06, 0xb3, 0x69, 0x9b, 0x25, 0xa4, 0x3c, 0x53, 0x18, 0xb0, 0x23, 0x6b, 0x5c, 0x1d,
-	0x6f, 0x50, 0x48, 0xc5, 0xfb, 0xe1, 0x5f, 0x09, 0x08, 0x37, 0xa4, 0xe7, 0x53, 0xce, 0x01, 0x63,
-	0x30, 0x0a, 0x51, 0xa7, 0x83, 0x86, 0xb8, 0x0e, 0xe4, 0xd7, 0x69, 0x21, 0xc2, 0xe5, 0xf4, 0x59,
-	0x12, 0x80, 0x8a, 0x24, 0x6d, 0x00, 0x7c, 0x88, 0xba, 0x13, 0xbd, 0x2f, 0x0e, 0xd1, 0x2a, 0x88,
-	0x97, 0x80, 0x14, 0xab, 0x9d, 0x02, 0x36, 0x40, 0xb7, 0x5b, 0xa6, 0x7f, 0x36, 0xe3, 0xca, 0xff,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x1c, 0x2e, 0x7d, 0x31, 0x44, 0x00, 0x00,
+	// 3414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xcb, 0x6f, 0x1c, 0xc7,
+	0xd1, 0xd7, 0xec, 0x8b, 0xbb, 0x45, 0x91, 0x5a, 0x36, 0x5f, 0xab, 0x91, 0xac, 0x8f, 0x9e, 0xcf,
+	0x92, 0x29, 0xc9, 0x22, 0x6d, 0x4a, 0xf2, 0x1b, 0xf6, 0x47, 0x49, 0xd4, 0x03, 0x96, 0x2c, 0x61,
+	0x48, 0xd9, 0xd0, 0x87, 0x04, 0xc4, 0x70, 0xb7, 0xb5, 0x1c, 0x6b, 0x77, 0x67, 0x3d, 0x33, 0x4b,
+	0x8b, 0x4e, 0x0c, 0x38, 0x76, 0x90, 0x4b, 0x00, 0x1f, 0xf2, 0x1f, 0x24, 0x06, 0x12, 0x20, 0x39,
+	0xe4, 0x90, 0x20, 0x39, 0x18, 0xce, 0x21, 0xc7, 0xf8, 0x10, 0xe4, 0x2f, 0x08, 0x90, 0x83, 0x2f,
+	0x41, 0xfe, 0x87, 0xa0, 0x7b, 0x7a, 0x7a, 0xba, 0x67, 0xa7, 0x77, 0x67, 0x87, 0xf4, 0xc2, 0x86,
+	0x75, 0xb1, 0xd9, 0xbd, 0xd5, 0x5d, 0x55, 0xbf, 0xae, 0xaa, 0xee, 0xa9, 0xae, 0x16, 0x5c, 0xf2,
+	0xb0, 0xbb, 0x87, 0xdd, 0xd5, 0x1d, 0xbc, 0x6b, 0xed, 0xd9, 0x4e, 0xcf, 0xbd, 0xe0, 0xb9, 0x7b,
+	0xab, 0x5d, 0xd7, 0xf1, 0x9d, 0xa8, 0x2f, 0xfa, 0x6b, 0x85, 0xfe, 0x82, 0x16, 0x9a, 0xce, 0x4a,
+	0xdb, 0xae, 0xbb, 0xce, 0x8a, 0xe7, 0xee, 0xad, 0xf0, 0x5f, 0xf5, 0xf3, 0x6c, 0x36, 0xcf, 0xb7,
+	0x7c, 0xbb, 0x2e, 0x4c, 0x15, 0x74, 0xb0, 0xff, 0x05, 0x93, 0xe8, 0xa7, 0x19, 0xb1, 0xef, 0x34,
+	0x1c, 0x81, 0x94, 0x34, 0xe9, 0x7f, 0x62, 0x64, 0x3d, 0x0f, 0x8b, 0xc2, 0x91, 0x26, 0xfd, 0x0f,
+	0x23, 0x5b, 0x61, 0x64, 0x75, 0xa7, 0xe3, 0xe3, 0x8e, 0x2f, 0x50, 0xb2, 0x9e, 0xf0, 0xff, 0x01,
+	0xbd, 0xf1, 0x3a, 0x94, 0x6f, 0x38, 0x56, 0xeb, 0x9a, 0xe5, 0x5b, 0xe8, 0x79, 0x28, 0x34, 0x1d,
+	0xab, 0x55, 0xd3, 0x96, 0xb4, 0xe5, 0xc9, 0xb5, 0x93, 0x2b, 0xc9, 0xda, 0xad, 0x10, 0x7a, 0x93,
+	0x52, 0x1a, 0xeb, 0x30, 0x49, 0x5a, 0xeb, 0xae, 0x4b, 0x27, 0x58, 0x83, 0x22, 0xe9, 0xf6, 0x6a,
+	0xda, 0x52, 0x7e, 0xe8, 0x0c, 0x01, 0xa9, 0xf1, 0x7f, 0x50, 0xb9, 0x69, 0xed, 0xd8, 0x3e, 0x9d,
+	0xe0, 0x22, 0x14, 0x77, 0x49, 0x83, 0x89, 0xf0, 0x94, 0x6a, 0x02, 0x3a, 0xc2, 0x0c, 0x68, 0x8d,
+	0x0d, 0x38, 0x4a, 0xdb, 0xa1, 0x14, 0x97, 0xa1, 0x44, 0x7f, 0x08, 0xc5, 0x18, 0x32, 0x0b, 0x23,
+	0x36, 0xee, 0xc1, 0xd4, 0xd5, 0x5d, 0xab, 0xd5, 0xc2, 0x9d, 0x26, 0xa6, 0xf3, 0xbc, 0x09, 0x95,
+	0x7a, 0xd8, 0xc1, 0x04, 0x7a, 0x5a, 0x35, 0x15, 0x1f, 0x69, 0x46, 0x63, 0x8c, 0xfb, 0x50, 0xe5,
+	0xfd, 0xa1, 0x70, 0xeb, 0x00, 0x9c, 0x20, 0x14, 0x30, 0xc5, 0xac, 0xc2, 0x20, 0xe3, 0x4b, 0x0d,
+	0x8e, 0xad, 0xb7, 0x5a, 0x04, 0x44, 0xcf, 0xc4, 0xef, 0xf7, 0xb0, 0xe7, 0xa3, 0x79, 0x28, 0x39,
+	0x6e, 0x73, 0xdb, 0x6e, 0x50, 0x41, 0x2b, 0x66, 0xd1, 0x71, 0x9b, 0xb7, 0x1a, 0x68, 0x11, 0x26,
+	0x7c, 0x6c, 0xb5, 0x49, 0x7f, 0x8e, 0xf6, 0x97, 0x48, 0xf3, 0x56, 0x03, 0xcd, 0x41, 0xb1, 0x65,
+	0xb7, 0x6d, 0xbf, 0x96, 0x5f, 0xd2, 0x96, 0xf3, 0x66, 0xd0, 0x40, 0x0b, 0x50, 0x72, 0x1e, 0x3e,
+	0xf4, 0xb0, 0x5f, 0x2b, 0xd0, 0x6e, 0xd6, 0x42, 0xa7, 0x61, 0xda, 0x73, 0x5c, 0x7f, 0xbb, 0x6b,
+	0xb9, 0x56, 0x1b, 0xfb, 0xd8, 0xad, 0x15, 0xe9, 0x6c, 0x53, 0xa4, 0xf7, 0x5e, 0xd8, 0xc9, 0xc9,
+	0x1a, 0xb6, 0x8b, 0xeb, 0xbe, 0xed, 0x74, 0x6a, 0xa5, 0x88, 0xec, 0x5a, 0xd8, 0x69, 0xec, 0x43,
+	0x35, 0x12, 0xdf, 0xeb, 0x3a, 0x1d, 0x0f, 0xa3, 0x97, 0xa0, 0xd0, 0xb0, 0x7c, 0x8b, 0xc1, 0xfc,
+	0xbf, 0x83, 0x0c, 0x87, 0x21, 0x69, 0xd2, 0x01, 0x08, 0x41, 0xa1, 0xee, 0x34, 0x30, 0x55, 0x2f,
+	0x6f, 0xd2, 0xbf, 0x51, 0x0d, 0x26, 0xda, 0xd8, 0xf3, 0xac, 0x26, 0xa6, 0xea, 0x55, 0xcc, 0xb0,
+	0x69, 0x7c, 0xa6, 0xc1, 0xcc, 0x55, 0x17, 0x5b, 0x3e, 0xa6, 0x26, 0xc8, 0xc0, 0x1b, 0xd9, 0xee,
+	0x09, 0xae, 0xc4, 0xe7, 0x04, 0x5c, 0x49, 0xf3, 0x56, 0x43, 0x58, 0x87, 0xbc, 0x62, 0x1d, 0x0a,
+	0xe2, 0x3a, 0x18, 0x8f, 0x01, 0x89, 0xf2, 0x30, 0x34, 0x2e, 0x49, 0x68, 0x2c, 0x0d, 0x12, 0xe8,
+	0x40, 0x50, 0xdc, 0xef, 0x36, 0x0e, 0x0c, 0x45, 0xa4, 0x71, 0x4e, 0xa1, 0x71, 0x5e, 0xb2, 0x3c,
+	0x01, 0xba, 0x82, 0x08, 0x1d, 0x81, 0x42, 0x94, 0x67, 0x8c, 0x50, 0xfc, 0x3f, 0x1c, 0x33, 0xb1,
+	0xd5, 0x10, 0x71, 0x58, 0x84, 0x09, 0xa2, 0x5d, 0xe4, 0x50, 0x25, 0xd2, 0x94, 0x16, 0x38, 0x95,
+	0xba, 0xc6, 0x1e, 0x54, 0xa3, 0xb9, 0xc7, 0xa8, 0x53, 0x0f, 0x66, 0xae, 0xe1, 0x16, 0xf6, 0xf1,
+	0x37, 0xa1, 0x95, 0x7a, 0x11, 0xaf, 0x00, 0x12, 0xd9, 0x32, 0x85, 0x47, 0x13, 0xfd, 0x2f, 0x1a,
+	0x0d, 0x10, 0x34, 0x3a, 0x7f, 0x27, 0x03, 0xdc, 0x8f, 0x60, 0x46, 0x90, 0x9f, 0x61, 0xf0, 0xb2,
+	0xb4, 0xe8, 0xcf, 0x0c, 0xdc, 0x93, 0x0e, 0x12, 0xe2, 0x7e, 0xa1, 0x85, 0x21, 0x25, 0xd8, 0xde,
+	0x18, 0x7e, 0x59, 0x76, 0xd6, 0xc3, 0x0b, 0x73, 0x1f, 0xc2, 0xac, 0x24, 0x13, 0xc3, 0xe4, 0xb2,
+	0x84, 0xc9, 0xd3, 0x03, 0x65, 0x3a, 0x10, 0x20, 0x41, 0x60, 0x39, 0x38, 0x20, 0x87, 0xe6, 0x27,
+	0x1f, 0xc2, 0xac, 0x24, 0xd3, 0x38, 0x01, 0xf9, 0x61, 0x10, 0x92, 0x24, 0x34, 0x8e, 0x43, 0x99,
+	0x6a, 0x18, 0x39, 0xd8, 0x04, 0x6d, 0x67, 0x88, 0x78, 0x8f, 0x61, 0x46, 0x98, 0x7e, 0x9c, 0x8a,
+	0x3d, 0x0e, 0x83, 0xcf, 0x37, 0xa4, 0x9a, 0x7a, 0x39, 0xaf, 0xc2, 0xac, 0xc4, 0x39, 0x53, 0xdc,
+	0x7b, 0x8d, 0xd8, 0x69, 0xcb, 0x09, 0x36, 0x0b, 0x1e, 0xf8, 0xa6, 0x21, 0xc7, 0x05, 0xcf, 0xd9,
+	0x2a, 0x99, 0x89, 0x04, 0xd2, 0xe0, 0x98, 0x04, 0x5a, 0xb2, 0x04, 0x39, 0x59, 0x82, 0xbf, 0x6a,
+	0x30, 0xb7, 0xde, 0x6a, 0xf1, 0x63, 0xe7, 0x77, 0x32, 0xfa, 0x7e, 0xaa, 0xc1, 0x7c, 0x4c, 0x09,
+	0x06, 0xc6, 0xeb, 0x92, 0x11, 0x2e, 0x0f, 0x3d, 0x75, 0x1f, 0x24, 0x0c, 0xff, 0x4a, 0x83, 0x85,
+	0x20, 0xe4, 0xf1, 0xe9, 0x42, 0x30, 0x0f, 0xfa, 0x5d, 0x71, 0x78, 0x61, 0xf9, 0x13, 0x0d, 0x16,
+	0xfb, 0x84, 0x64, 0x60, 0xbd, 0x22, 0x81, 0x75, 0x7a, 0xa8, 0x80, 0x07, 0x42, 0x2a, 0x88, 0x85,
+	0x87, 0x8f, 0xd4, 0xa1, 0x39, 0x38, 0x41, 0xaa, 0x4f, 0xc8, 0x71, 0x23, 0x65, 0xc3, 0x1c, 0x89,
+	0xac, 0x7d, 0x30, 0x3d, 0x0d, 0x47, 0xb9, 0xca, 0x91, 0x8f, 0x4e, 0xf2, 0xbe, 0x0c, 0x41, 0xfc,
+	0x63, 0x0d, 0xe6, 0x63, 0xbc, 0xc6, 0xad, 0xed, 0x4f, 0x35, 0x58, 0x08, 0x82, 0xea, 0x18, 0x14,
+	0x56, 0xaf, 0xfc, 0x0d, 0x58, 0xec, 0x93, 0x22, 0x53, 0x78, 0xff, 0x32, 0x07, 0x53, 0xd7, 0xed,
+	0x96, 0x8f, 0xdd, 0xf1, 0x44, 0x55, 0x04, 0x05, 0x7f, 0xbf, 0x8b, 0x6b, 0xc5, 0xa5, 0xfc, 0x72,
+	0xc5, 0xa4, 0x7f, 0xa3, 0x17, 0xa1, 0xe4, 0xf9, 0x96, 0xdf, 0xf3, 0x6a, 0xa5, 0xa5, 0xfc, 0xf2,
+	0xf4, 0xda, 0x29, 0xd5, 0xf2, 0x6d, 0x52, 0x2a, 0x93, 0x51, 0x23, 0x1d, 0xca, 0x75, 0xcb, 0xc7,
+	0x4d, 0xc7, 0xdd, 0xaf, 0x4d, 0xd0, 0xf9, 0x78, 0x9b, 0x68, 0x5c, 0x27, 0x31, 0xc4, 0x71, 0x6b,
+	0x65, 0xfa, 0x53, 0xd8, 0x4c, 0x88, 0xeb, 0x95, 0x74, 0x71, 0x1d, 0x92, 0xe2, 0xfa, 0x9f, 0x72,
+	0x30, 0x1d, 0xe2, 0xc7, 0x16, 0xe0, 0x4d, 0xc9, 0x16, 0xcf, 0xab, 0x94, 0x91, 0x47, 0xad, 0x64,
+	0xb5, 0x48, 0xfd, 0x8f, 0x1a, 0x14, 0xb2, 0xe6, 0xb9, 0x84, 0xac, 0x54, 0x6e, 0x84, 0xac, 0x54,
+	0x2c, 0x5f, 0x94, 0xcf, 0x92, 0x2f, 0xfa, 0x79, 0x0e, 0xa6, 0x36, 0xb1, 0xe5, 0xd6, 0x77, 0xc7,
+	0x66, 0x78, 0x1d, 0xab, 0x8d, 0xd9, 0x26, 0x4e, 0xff, 0x26, 0xa0, 0x7a, 0xbd, 0x76, 0xdb, 0x72,
+	0xf7, 0xd9, 0xa6, 0x1d, 0x36, 0xd1, 0x12, 0x4c, 0x36, 0xb0, 0x57, 0x77, 0xed, 0x2e, 0x5d, 0xfa,
+	0x89, 0xc0, 0x95, 0x85, 0xae, 0x04, 0x33, 0x2a, 0xa7, 0x33, 0xa3, 0x8a, 0xca, 0x8c, 0x42, 0x34,
+	0x46, 0x33, 0x23, 0x79, 0xd4, 0xf7, 0xce, 0x8c, 0xbe, 0xd2, 0xa0, 0xba, 0xb9, 0x6b, 0xb9, 0x52,
+	0x46, 0x21, 0x8b, 0x0a, 0xaf, 0x42, 0x91, 0xc4, 0xd6, 0x50, 0x03, 0xe5, 0xa7, 0xf0, 0x96, 0xe5,
+	0x36, 0xb1, 0x8f, 0x1b, 0xf7, 0x3d, 0xec, 0x9a, 0xc1, 0x10, 0x31, 0x4c, 0xe7, 0x15, 0x47, 0x9f,
+	0x82, 0xc2, 0xa4, 0x8b, 0xd2, 0x06, 0xb7, 0x0e, 0x33, 0x82, 0x32, 0x99, 0x4e, 0xcb, 0xff, 0xd4,
+	0x60, 0x9e, 0xce, 0xd1, 0xb7, 0x3f, 0x1d, 0x3c, 0xc9, 0xfb, 0xed, 0x00, 0xe9, 0x3a, 0x2c, 0xc4,
+	0x15, 0xcc, 0x84, 0xd4, 0xdf, 0x35, 0x86, 0xb6, 0xf4, 0x61, 0x96, 0x2d, 0x4f, 0xff, 0xed, 0x40,
+	0xe6, 0x0a, 0x20, 0x51, 0xa1, 0x4c, 0xa8, 0xbc, 0x00, 0xc7, 0xd7, 0x7b, 0xbe, 0x53, 0x77, 0xda,
+	0x5d, 0x72, 0xbe, 0x90, 0x43, 0xf4, 0x1c, 0x14, 0x7d, 0xdb, 0x6f, 0xe1, 0x30, 0x42, 0xd3, 0x86,
+	0xf1, 0xb5, 0x06, 0x7a, 0xd2, 0x18, 0xc6, 0xff, 0x2d, 0x29, 0x90, 0xbd, 0xa4, 0x42, 0x46, 0x3d,
+	0x43, 0xf6, 0xa0, 0x76, 0x87, 0xc5, 0xb4, 0x0d, 0x28, 0xbb, 0x6c, 0x32, 0xb6, 0xac, 0x67, 0x65,
+	0x31, 0xd8, 0xdd, 0x97, 0x28, 0x43, 0xc8, 0xdd, 0xe4, 0x43, 0x8d, 0x3f, 0x54, 0xa0, 0x40, 0x5c,
+	0xb3, 0xef, 0xf3, 0x97, 0xe3, 0x92, 0x13, 0x70, 0x11, 0xb7, 0x97, 0xfc, 0xc0, 0xed, 0xa5, 0xd0,
+	0xbf, 0xbd, 0xcc, 0x41, 0xd1, 0x6e, 0x13, 0x8d, 0x82, 0x15, 0x0e, 0x1a, 0xf4, 0xf4, 0x64, 0x35,
+	0x83, 0x73, 0x12, 0x39, 0x3d, 0x59, 0x4d, 0x8f, 0x9f, 0x74, 0x70, 0x83, 0x6e, 0x53, 0x79, 0x33,
+	0x6c, 0x92, 0x5f, 0x7a, 0xf4, 0xeb, 0xa0, 0x41, 0xf7, 0xa6, 0xbc, 0x19, 0x36, 0xd1, 0x15, 0xe1,
+	0xe4, 0x54, 0xa1, 0xcb, 0x72, 0x26, 0x11, 0x8f, 0x2b, 0xe1, 0xea, 0x5c, 0x65, 0xd4, 0xc2, 0x09,
+	0xeb, 0x22, 0x94, 0x7c, 0x6a, 0xcc, 0xf4, 0x60, 0x34, 0xb9, 0x76, 0x22, 0x71, 0x86, 0xc0, 0xde,
+	0x4d, 0x46, 0x4a, 0x8e, 0x6c, 0x8d, 0x9e, 0x6b, 0x51, 0xad, 0x27, 0xa9, 0x66, 0xbc, 0x2d, 0x58,
+	0xfb, 0x51, 0xd1, 0xda, 0x2f, 0x43, 0x85, 0x29, 0x74, 0x65, 0xbf, 0x36, 0x45, 0x59, 0x2d, 0xca,
+	0xac, 0xe8, 0x45, 0x23, 0x75, 0xa8, 0x88, 0x92, 0x9c, 0x03, 0x3c, 0xa7, 0xe7, 0xd6, 0x71, 0x6d,
+	0x26, 0xf0, 0x91, 0xa0, 0x85, 0x5e, 0x83, 0xb2, 0xef, 0x5a, 0xf5, 0x47, 0xc4, 0x57, 0x11, 0x35,
+	0x85, 0xff, 0x51, 0xfa, 0x6a, 0x40, 0x67, 0xf2, 0x01, 0xe8, 0x0e, 0xcc, 0x78, 0xbd, 0x7a, 0x1d,
+	0x7b, 0xde, 0x76, 0xdd, 0xb5, 0x7d, 0xec, 0xda, 0x96, 0x57, 0x9b, 0x5d, 0xca, 0x0f, 0x4a, 0x98,
+	0x5f, 0x65, 0x84, 0x66, 0x95, 0x0d, 0x0d, 0x3b, 0xe2, 0xfb, 0xdf, 0x5c, 0x96, 0x88, 0x1c, 0x85,
+	0xab, 0xf9, 0x51, 0xc2, 0xd5, 0x2b, 0x50, 0xb6, 0x5c, 0xdf, 0xae, 0xb7, 0xb0, 0x57, 0x5b, 0x48,
+	0x1a, 0x18, 0xde, 0xc7, 0xae, 0x07, 0x54, 0x26, 0x27, 0x47, 0x6f, 0x10, 0x00, 0xed, 0x66, 0x93,
+	0x00, 0xb8, 0x48, 0x87, 0x1a, 0x89, 0x2b, 0x7f, 0xc7, 0x69, 0xf4, 0x5a, 0x78, 0x2b, 0x20, 0x35,
+	0xf9, 0x18, 0xf4, 0x32, 0x94, 0x3d, 0xec, 0xef, 0x58, 0xf5, 0x47, 0x5e, 0xad, 0x96, 0xb4, 0x3f,
+	0xb3, 0xf1, 0x9b, 0x01, 0x91, 0xc9, 0xa9, 0xa3, 0x18, 0x7b, 0x7c, 0xf4, 0x18, 0xfb, 0x06, 0xe8,
+	0xcc, 0xb1, 0x6d, 0xa7, 0xb3, 0xde, 0xed, 0xba, 0xce, 0x5e, 0x70, 0x5e, 0xb0, 0x5d, 0xdc, 0xa8,
+	0xe9, 0x4b, 0xda, 0x72, 0xd9, 0x1c, 0x40, 0x21, 0x7c, 0xa3, 0x9c, 0x58, 0xd2, 0x46, 0xf8, 0x46,
+	0x79, 0x11, 0x26, 0x3c, 0xec, 0xfb, 0x76, 0xa7, 0x59, 0x3b, 0x99, 0x74, 0x7b, 0x15, 0x29, 0x4b,
+	0x68, 0xcc, 0x90, 0x18, 0x5d, 0x80, 0xa2, 0xef, 0x34, 0x1c, 0xaf, 0xf6, 0x54, 0x92, 0xc5, 0x93,
+	0x9f, 0x56, 0xb6, 0x9c, 0x86, 0x63, 0x06, 0x54, 0xc4, 0xaf, 0x5c, 0xbc, 0x67, 0x7b, 0xc4, 0xaf,
+	0x4e, 0x05, 0x7e, 0x15, 0xb6, 0x8d, 0x7f, 0x68, 0x30, 0xc1, 0x4c, 0x99, 0x38, 0x6d, 0xdb, 0x72,
+	0x1f, 0x61, 0xb7, 0xa6, 0x0d, 0x70, 0xda, 0x3b, 0x94, 0xc4, 0x64, 0xa4, 0x24, 0xe1, 0xf1, 0xd0,
+	0x25, 0x3b, 0x40, 0xa7, 0xbe, 0x4f, 0x23, 0xdc, 0xb4, 0xda, 0x4a, 0xaf, 0x87, 0x84, 0x66, 0x34,
+	0x06, 0xbd, 0x0a, 0xa5, 0x36, 0xf6, 0x77, 0x9d, 0x60, 0x7f, 0x53, 0x19, 0x0c, 0x93, 0xf1, 0x0e,
+	0xa5, 0x34, 0xd9, 0x08, 0x12, 0x08, 0x7b, 0x1d, 0xdf, 0x6e, 0x85, 0x5b, 0x20, 0x6d, 0x18, 0xbf,
+	0xd4, 0xa0, 0x1c, 0xfa, 0x11, 0x21, 0xd9, 0xb3, 0x5a, 0xbd, 0x70, 0x87, 0x0b, 0x1a, 0xe4, 0xe4,
+	0x4d, 0xe4, 0xdf, 0xf6, 0x7a, 0xc4, 0xe9, 0x1e, 0xf6, 0x5a, 0x54, 0xf4, 0xb2, 0x39, 0x45, 0x7a,
+	0x37, 0xc3, 0x4e, 0xf4, 0x14, 0x00, 0x7e, 0xec, 0xbb, 0xd6, 0xb6, 0x6f, 0xb7, 0xc3, 0xfd, 0xa3,
+	0x42, 0x7b, 0xb6, 0xec, 0x36, 0x49, 0x2c, 0x54, 0x3a, 0xf8, 0x83, 0xc0, 0xa2, 0x6a, 0x85, 0x01,
+	0x98, 0x05, 0x24, 0x66, 0x44, 0x6d, 0xfc, 0xba, 0x0c, 0x15, 0xee, 0xb4, 0x4f, 0xb6, 0x8c, 0xef,
+	0xe9, 0x96, 0x11, 0x05, 0xe8, 0xd9, 0xac, 0x01, 0x7a, 0x2e, 0x7b, 0x80, 0x9e, 0xcf, 0x10, 0xa0,
+	0x79, 0x98, 0x5d, 0x38, 0xec, 0x30, 0xbb, 0x38, 0x42, 0x98, 0xad, 0x8d, 0x14, 0x66, 0xc5, 0x4d,
+	0xe5, 0xf8, 0x48, 0x9b, 0x8a, 0x10, 0xa0, 0xf5, 0x4c, 0x01, 0xfa, 0xc4, 0xc8, 0x01, 0xfa, 0x64,
+	0x2c, 0x40, 0xff, 0x7b, 0x02, 0x8a, 0x74, 0xf5, 0x9f, 0x04, 0x89, 0xef, 0x69, 0x90, 0x10, 0xbd,
+	0x7d, 0x36, 0xbb, 0xb7, 0xcf, 0x1d, 0xc4, 0xdb, 0xe7, 0x0f, 0xdb, 0xdb, 0x17, 0x46, 0xf0, 0xf6,
+	0xc5, 0xcc, 0xde, 0x5e, 0xcb, 0xea, 0xed, 0xc7, 0x33, 0x79, 0xbb, 0x3e, 0xb2, 0xb7, 0x9f, 0x88,
+	0x79, 0xfb, 0xd7, 0xe4, 0xe8, 0x12, 0x1a, 0x7b, 0xdc, 0xe1, 0xc3, 0x2c, 0x65, 0x2e, 0x39, 0x4b,
+	0x39, 0xb2, 0xbb, 0x9f, 0x80, 0x8a, 0x5d, 0x77, 0x3a, 0xdb, 0x5e, 0xab, 0xd7, 0x64, 0x2e, 0x5f,
+	0x26, 0x1d, 0x9b, 0xad, 0x5e, 0x53, 0x70, 0x8c, 0x92, 0xe8, 0x18, 0x59, 0x1c, 0x3f, 0x0c, 0x20,
+	0x95, 0x28, 0x80, 0x18, 0x7f, 0xd3, 0xe0, 0xa8, 0x68, 0x35, 0xe8, 0x3c, 0x14, 0x88, 0xdd, 0xd4,
+	0xb4, 0x24, 0x0c, 0x23, 0x67, 0xa3, 0x44, 0xc8, 0x80, 0xa3, 0xf5, 0x9e, 0xeb, 0xe2, 0x8e, 0xff,
+	0x0e, 0x3d, 0xdb, 0x05, 0x1f, 0xfc, 0x52, 0x1f, 0xba, 0x0f, 0x55, 0xfc, 0xb8, 0x8b, 0xeb, 0x3e,
+	0x6e, 0xdc, 0x73, 0x9d, 0xa6, 0x8b, 0x3d, 0x8f, 0x42, 0x34, 0xad, 0xf8, 0xbc, 0xdf, 0x88, 0x11,
+	0x6f, 0xed, 0x77, 0xb1, 0xd9, 0x37, 0x05, 0x51, 0xa6, 0xd7, 0xb1, 0x7d, 0x86, 0x27, 0xfd, 0xdb,
+	0xf8, 0x73, 0x1e, 0xa6, 0x78, 0x6a, 0x8e, 0x6a, 0x13, 0x5f, 0xba, 0xb0, 0x48, 0x2d, 0x97, 0xba,
+	0x48, 0x2d, 0xc4, 0x23, 0x9f, 0x06, 0x8f, 0x97, 0xb9, 0xaf, 0x14, 0xa8, 0x86, 0x4b, 0xc9, 0x86,
+	0x4b, 0x44, 0x8c, 0x79, 0x8b, 0xb0, 0x6a, 0x45, 0x79, 0xd5, 0x2e, 0x41, 0xc5, 0x23, 0x03, 0x1a,
+	0xdb, 0x3b, 0x41, 0x06, 0x7c, 0x80, 0x14, 0xe5, 0x80, 0xf2, 0xca, 0x7e, 0xdf, 0xca, 0x4c, 0xa4,
+	0x5c, 0x99, 0xf2, 0xc1, 0x57, 0x46, 0x30, 0xcd, 0x4a, 0x9f, 0x69, 0xee, 0x61, 0xd7, 0x0b, 0xef,
+	0x69, 0xf2, 0x66, 0xd8, 0x34, 0xbe, 0xca, 0xb3, 0xac, 0x18, 0x3f, 0x8b, 0x27, 0x2e, 0x9f, 0x74,
+	0xab, 0x9b, 0xcb, 0x70, 0xab, 0xfb, 0x64, 0x35, 0xc7, 0xb1, 0x9a, 0x5f, 0xe4, 0x61, 0x3a, 0xca,
+	0x71, 0x26, 0xae, 0x24, 0xaf, 0xa1, 0xca, 0x8d, 0x50, 0x43, 0xf5, 0x64, 0xf5, 0xc6, 0xb1, 0x7a,
+	0x37, 0x61, 0xee, 0x06, 0xf6, 0x29, 0x30, 0xf1, 0xc2, 0xd6, 0x30, 0x03, 0xae, 0x49, 0x19, 0x70,
+	0xa1, 0x36, 0x34, 0x27, 0xd6, 0x86, 0x92, 0xfa, 0x8c, 0xf9, 0xd8, 0x54, 0x2c, 0xdd, 0x7c, 0x5d,
+	0x4a, 0x37, 0xaf, 0x29, 0xe3, 0x70, 0xd2, 0x60, 0x21, 0xd3, 0xac, 0xaf, 0xb3, 0xdc, 0xf1, 0x2b,
+	0x52, 0xf1, 0xb1, 0xb2, 0xb4, 0x40, 0xda, 0x1c, 0xd8, 0x43, 0x84, 0x77, 0xe1, 0x38, 0x67, 0xd3,
+	0x77, 0x1d, 0xa3, 0xd4, 0x39, 0x5e, 0x47, 0x90, 0xeb, 0xab, 0x23, 0x30, 0xbe, 0xd0, 0x40, 0x4f,
+	0x9a, 0x79, 0xb4, 0x8c, 0xbb, 0x7a, 0x06, 0x11, 0x87, 0x7b, 0x0c, 0x87, 0x9b, 0xfd, 0x65, 0x2f,
+	0xe7, 0x06, 0x82, 0x21, 0xc5, 0x5b, 0xf1, 0x05, 0xc2, 0x5b, 0xc2, 0xd2, 0x49, 0x77, 0x2f, 0x4a,
+	0x48, 0xc4, 0x6a, 0xb9, 0x9c, 0x54, 0x2d, 0x67, 0xfc, 0x46, 0x83, 0x85, 0xf8, 0x6c, 0x0c, 0x86,
+	0x1b, 0x12, 0x0c, 0x17, 0x87, 0xc2, 0x20, 0x8d, 0x16, 0x21, 0xb8, 0xc6, 0x20, 0x78, 0x5d, 0xae,
+	0xce, 0x3c, 0x33, 0x50, 0x7d, 0x1e, 0xa0, 0xc2, 0x17, 0x21, 0xf7, 0x60, 0xe6, 0x06, 0xf6, 0xb7,
+	0x9c, 0xee, 0x96, 0xd5, 0xe4, 0x35, 0x6c, 0xe4, 0xba, 0x7a, 0xe7, 0x3d, 0x5c, 0xf7, 0x43, 0x8d,
+	0x83, 0x96, 0xaa, 0x52, 0xe4, 0x28, 0x68, 0x1d, 0x76, 0xdf, 0xad, 0x75, 0x8c, 0xcf, 0x35, 0x40,
+	0xe2, 0x94, 0x4c, 0xef, 0xab, 0x92, 0xde, 0xab, 0x03, 0xf4, 0x8e, 0x8d, 0xcc, 0x7e, 0xd1, 0xa2,
+	0x33, 0x84, 0xc2, 0x73, 0xa0, 0x26, 0x9c, 0x03, 0x7f, 0xab, 0xc1, 0xa2, 0x78, 0xb1, 0x22, 0xaa,
+	0xaf, 0xb8, 0xf3, 0x8f, 0x50, 0xc9, 0x49, 0xa8, 0x84, 0xc7, 0xe3, 0xbc, 0x70, 0x3c, 0xee, 0xbf,
+	0x88, 0x2f, 0xa4, 0xbb, 0x88, 0x2f, 0x26, 0x5d, 0xc4, 0xff, 0x5e, 0x83, 0x5a, 0xbf, 0xb0, 0x0c,
+	0xd8, 0x5b, 0x12, 0xb0, 0x97, 0xd3, 0xdc, 0x64, 0x7d, 0xf3, 0xf0, 0x5e, 0x86, 0x13, 0xef, 0x5a,
+	0x6e, 0xbb, 0xd7, 0xbd, 0x6a, 0xd5, 0x77, 0x31, 0xff, 0xb2, 0x1e, 0x62, 0x60, 0xc6, 0x29, 0x38,
+	0x99, 0x3c, 0x8c, 0xdd, 0x75, 0x7d, 0x46, 0x4e, 0xef, 0xae, 0xe5, 0x65, 0x2e, 0xcf, 0x58, 0x82,
+	0xc9, 0xba, 0xd3, 0x6a, 0x05, 0xb8, 0x06, 0xb7, 0xfb, 0x15, 0x53, 0xec, 0x52, 0x96, 0x6a, 0xf0,
+	0xc2, 0x8e, 0xa2, 0x50, 0xd8, 0x61, 0xf8, 0x30, 0xc5, 0xe4, 0x49, 0x53, 0xbd, 0x1b, 0xe5, 0x94,
+	0xbd, 0xdd, 0x83, 0x54, 0x4c, 0x76, 0x61, 0xda, 0xc4, 0x9e, 0xef, 0xb8, 0x58, 0x55, 0xfa, 0x7a,
+	0x0a, 0x20, 0x52, 0x8a, 0x61, 0x20, 0xf4, 0x8c, 0x5c, 0xfe, 0xe8, 0xc1, 0x31, 0xce, 0x91, 0x69,
+	0xba, 0x26, 0x69, 0x7a, 0x4a, 0xad, 0x69, 0x66, 0x35, 0x3f, 0xd7, 0x60, 0xf6, 0xb6, 0xed, 0xf9,
+	0xef, 0x04, 0x1b, 0xf5, 0x88, 0x75, 0xbe, 0xea, 0x02, 0xb6, 0x91, 0x96, 0x38, 0x06, 0x65, 0x29,
+	0x0e, 0xa5, 0xf1, 0x11, 0xcc, 0xc9, 0x42, 0xa6, 0x79, 0xa7, 0xc5, 0xf0, 0x61, 0x83, 0x0e, 0x62,
+	0x0b, 0xff, 0xd1, 0x60, 0x92, 0x4d, 0x43, 0xbd, 0xf1, 0xc5, 0xe8, 0x9c, 0xa3, 0x0d, 0x48, 0x26,
+	0xb0, 0x21, 0xfc, 0x14, 0x94, 0xe1, 0x4b, 0x51, 0xfa, 0x38, 0xc9, 0x67, 0xf8, 0x38, 0xe1, 0x67,
+	0xe2, 0xc2, 0x08, 0x4f, 0x18, 0x3f, 0xd3, 0x00, 0x99, 0xd8, 0x6a, 0x84, 0x0a, 0x28, 0x6c, 0x42,
+	0x38, 0xee, 0xe5, 0xa4, 0xe3, 0xde, 0xa8, 0xa6, 0x1f, 0x5b, 0xff, 0x62, 0xdf, 0xfa, 0xff, 0x18,
+	0x66, 0x25, 0x79, 0x46, 0x7b, 0xa6, 0x27, 0x2c, 0x5d, 0xa6, 0xe5, 0xff, 0x9d, 0x06, 0x0b, 0x26,
+	0xde, 0xc3, 0xae, 0xbf, 0xe5, 0x8c, 0x0d, 0x12, 0xe1, 0x48, 0x54, 0x94, 0x8e, 0x44, 0xc3, 0x7c,
+	0xe5, 0x63, 0x0d, 0x16, 0xfb, 0xa4, 0x1d, 0x2b, 0x60, 0xe7, 0xee, 0x42, 0x85, 0x5f, 0x07, 0x22,
+	0x04, 0xd3, 0xbc, 0xb1, 0xfd, 0xf6, 0xdd, 0xb7, 0x37, 0xaa, 0x47, 0x50, 0x05, 0x8a, 0xd7, 0xd6,
+	0x6f, 0xdd, 0x7e, 0x50, 0xd5, 0x10, 0x40, 0xe9, 0xe6, 0xdd, 0xfb, 0xe6, 0xed, 0x07, 0xd5, 0x1c,
+	0xf9, 0xfb, 0xdd, 0x8d, 0x8d, 0xb7, 0x6e, 0x3f, 0xa8, 0xe6, 0xd1, 0x24, 0x4c, 0xdc, 0xb9, 0xfb,
+	0xf6, 0xd6, 0xcd, 0xdb, 0x0f, 0xaa, 0x85, 0x73, 0x17, 0xa1, 0x14, 0x7c, 0x4f, 0xa1, 0x63, 0x30,
+	0x19, 0xfc, 0x25, 0x4e, 0x65, 0xae, 0x5f, 0xdf, 0xaa, 0x6a, 0x68, 0x0a, 0x2a, 0xf7, 0xee, 0x5f,
+	0xb9, 0x7d, 0x6b, 0xf3, 0xe6, 0xc6, 0xb5, 0x6a, 0x6e, 0xed, 0x5f, 0x4b, 0x50, 0xe5, 0xdb, 0xdb,
+	0x26, 0x76, 0xf7, 0xec, 0x3a, 0x46, 0xdb, 0x50, 0x0e, 0x5f, 0x7b, 0xa2, 0x67, 0x95, 0xfb, 0xb8,
+	0xfc, 0x9c, 0x55, 0x5f, 0x1e, 0x4e, 0xc8, 0x36, 0xcf, 0x23, 0x84, 0x41, 0xf8, 0xc2, 0x4e, 0xcd,
+	0x20, 0xf6, 0xbe, 0x4f, 0x5f, 0x1e, 0x4e, 0xc8, 0x19, 0x60, 0x80, 0xe8, 0x8d, 0x26, 0x3a, 0xab,
+	0xae, 0x3e, 0x88, 0xbd, 0x2b, 0xd5, 0xcf, 0xa5, 0x21, 0x15, 0xd9, 0x44, 0xef, 0x1f, 0xd5, 0x6c,
+	0xfa, 0xde, 0x6c, 0xea, 0xe7, 0xd2, 0x90, 0x8a, 0x6c, 0xa2, 0x17, 0x7a, 0x6a, 0x36, 0x7d, 0x8f,
+	0x07, 0xf5, 0x73, 0x69, 0x48, 0x39, 0x9b, 0x0e, 0x4c, 0x49, 0x8f, 0x30, 0xd0, 0x73, 0x03, 0x96,
+	0xb4, 0xef, 0xc1, 0x89, 0x7e, 0x21, 0x25, 0xb5, 0xc8, 0x4f, 0xaa, 0x57, 0x57, 0xf3, 0x4b, 0x2a,
+	0xa1, 0xd7, 0x2f, 0xa4, 0xa4, 0xe6, 0xfc, 0x7c, 0x38, 0x16, 0x7b, 0x39, 0x81, 0x56, 0x06, 0x2f,
+	0x77, 0x1f, 0xcf, 0xd5, 0xd4, 0xf4, 0x22, 0xd7, 0xd8, 0x2b, 0x04, 0x35, 0xd7, 0xe4, 0x37, 0x15,
+	0xfa, 0x6a, 0x6a, 0x7a, 0x91, 0x6b, 0xac, 0x04, 0x5e, 0xcd, 0x35, 0xb9, 0x62, 0x5f, 0x5f, 0x4d,
+	0x4d, 0xcf, 0xb9, 0xee, 0x40, 0x85, 0xbf, 0xa2, 0x44, 0x83, 0x02, 0x82, 0xf4, 0x50, 0x54, 0x3f,
+	0x9b, 0x82, 0x52, 0xe4, 0xc1, 0xdf, 0xaa, 0xa1, 0x81, 0x31, 0x41, 0xfc, 0x7a, 0xd6, 0xcf, 0xa6,
+	0xa0, 0xe4, 0x3c, 0x76, 0x61, 0x52, 0x78, 0xfb, 0x88, 0x86, 0x04, 0x05, 0x89, 0xcf, 0xf9, 0x54,
+	0xb4, 0x22, 0x27, 0xe1, 0x51, 0x21, 0x1a, 0x12, 0x17, 0xd2, 0x71, 0x4a, 0x78, 0xa5, 0x18, 0x70,
+	0x12, 0xde, 0xbb, 0xa1, 0x21, 0xa1, 0x21, 0x1d, 0xa7, 0x84, 0x07, 0x74, 0xc6, 0x11, 0xf4, 0x00,
+	0x4a, 0x41, 0xf9, 0x3e, 0x3a, 0x3d, 0xac, 0xbc, 0x3f, 0x98, 0xff, 0x4c, 0xba, 0x57, 0x00, 0xc1,
+	0xd4, 0x41, 0xf5, 0xa3, 0x7a, 0x6a, 0xa9, 0x26, 0x53, 0x3f, 0x33, 0x8c, 0x4c, 0x5e, 0x09, 0xfe,
+	0x1a, 0x6f, 0xd0, 0x4a, 0xc4, 0xdf, 0xfb, 0xe9, 0xe7, 0x53, 0xd1, 0x8a, 0x16, 0xcc, 0xf3, 0x61,
+	0x6a, 0x0b, 0x8e, 0xd7, 0x6d, 0xeb, 0x67, 0x53, 0x50, 0x72, 0x1e, 0xef, 0xb3, 0x44, 0x70, 0xe4,
+	0xfe, 0x17, 0xd2, 0xa5, 0xa3, 0x42, 0x6e, 0x2b, 0x69, 0xc9, 0xc5, 0x5d, 0x2a, 0x4a, 0xed, 0xa0,
+	0xb3, 0xc3, 0xd3, 0x3f, 0x43, 0x77, 0xa9, 0xfe, 0x72, 0x5d, 0xe3, 0x08, 0xfa, 0x89, 0x06, 0x0b,
+	0x62, 0x0e, 0x81, 0x28, 0xce, 0x6c, 0xe2, 0x85, 0x51, 0xaa, 0x67, 0x03, 0xde, 0x6b, 0xa3, 0x17,
+	0xdc, 0x1a, 0x47, 0xd0, 0xcf, 0x34, 0x38, 0x21, 0x12, 0x70, 0x38, 0xc6, 0x2d, 0xc8, 0x27, 0xb1,
+	0xec, 0x11, 0x05, 0x6b, 0xdc, 0x42, 0x74, 0x60, 0x4a, 0xca, 0x17, 0xab, 0xf7, 0xf1, 0xa4, 0xf4,
+	0xb6, 0x7e, 0x21, 0x25, 0x35, 0xe7, 0xf7, 0x11, 0x20, 0xfe, 0x53, 0x64, 0xdf, 0x2f, 0x8c, 0x92,
+	0xc8, 0x1d, 0xa2, 0xae, 0x3a, 0xf7, 0x1b, 0xb8, 0x96, 0x9c, 0x14, 0x45, 0x17, 0xd2, 0x26, 0x4f,
+	0x87, 0xb8, 0x56, 0x72, 0xae, 0x35, 0x70, 0xad, 0x28, 0x1f, 0xa9, 0x76, 0xad, 0xbe, 0x04, 0xaa,
+	0x7e, 0x2e, 0x0d, 0x29, 0x67, 0xf3, 0x01, 0x54, 0xe3, 0xd9, 0x39, 0xb4, 0x9a, 0x3e, 0x8f, 0x17,
+	0xb0, 0x7c, 0x7e, 0xd4, 0xc4, 0x9f, 0x71, 0x04, 0x7d, 0xaa, 0xc1, 0x5c, 0x52, 0xbe, 0x0d, 0x29,
+	0xd3, 0xd2, 0x03, 0x92, 0x7a, 0xfa, 0xa5, 0xd1, 0x06, 0x71, 0x29, 0xde, 0xe3, 0xff, 0x46, 0x4f,
+	0xd8, 0x19, 0x87, 0x9a, 0x5e, 0x32, 0xc5, 0x68, 0x14, 0x50, 0x27, 0x93, 0x72, 0x5e, 0xfb, 0xf2,
+	0xab, 0x6d, 0xce, 0x70, 0x25, 0x79, 0x96, 0x3e, 0x42, 0xc5, 0x21, 0x6d, 0x00, 0x3d, 0x67, 0xdd,
+	0x8e, 0xfe, 0xa9, 0x0e, 0xce, 0x56, 0x21, 0xbc, 0x44, 0xa4, 0xd8, 0xed, 0x14, 0xb4, 0x9c, 0xdd,
+	0x3b, 0x50, 0xa4, 0xd9, 0x37, 0xf4, 0xcc, 0x80, 0x8a, 0x1f, 0x9e, 0x48, 0xd5, 0x4f, 0x0f, 0xa1,
+	0xe2, 0xf3, 0xfe, 0x00, 0x26, 0x58, 0x26, 0x10, 0x9d, 0x51, 0x9f, 0xed, 0xc4, 0xe4, 0xa4, 0xfe,
+	0xec, 0x50, 0x3a, 0x3e, 0xfb, 0x23, 0x38, 0x2a, 0x26, 0xd3, 0x90, 0x72, 0x8b, 0x4f, 0xc8, 0x0b,
+	0xea, 0xcf, 0xa5, 0x23, 0x16, 0x8f, 0x1e, 0x42, 0xe6, 0x46, 0x7d, 0xf4, 0xe8, 0x4f, 0x37, 0xe9,
+	0xe7, 0x53, 0xd1, 0x8a, 0x9f, 0x05, 0xb1, 0xb4, 0x87, 0xfa, 0xb3, 0x20, 0x39, 0x9b, 0xa3, 0xaf,
+	0xa6, 0xa6, 0x0f, 0xb9, 0xee, 0x94, 0xe8, 0xbf, 0x5b, 0x76, 0xf1, 0xbf, 0x03, 0x00, 0xde, 0x10,
+	0xc6, 0x24, 0xb2, 0x4d, 0x00, 0x00,
 }
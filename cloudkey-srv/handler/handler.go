@@ -1,20 +1,60 @@
 package handler
 
 import (
+	"encoding/json"
+	"time"
+
 	"golang.org/x/net/context"
 
+	audit_proto "server/audit-srv/proto/audit"
 	cloudkey_proto "server/cloudkey-srv/proto/record"
+	"server/cloudkey-srv/rewrap"
 	"server/cloudkey-srv/storage"
+	"server/common"
+
+	"github.com/micro/go-micro/broker"
+	log "github.com/sirupsen/logrus"
 )
 
-type CloudKeyService struct{}
+type CloudKeyService struct {
+	// Broker publishes the audits of the key operations, they aren't published if it isn't set
+	Broker broker.Broker
+}
+
+// audit publishes the audit of a key operation to audit-srv
+func (s *CloudKeyService) audit(method string, actionType audit_proto.ActionType, orgid string, parameters map[string]interface{}) {
+	if s.Broker == nil {
+		return
+	}
+	params, _ := json.Marshal(parameters)
+	audit := &audit_proto.Audit{
+		OrgId:            orgid,
+		ActionName:       "CloudKeyService." + method,
+		ActionType:       actionType,
+		ActionTimestamp:  time.Now().Unix(),
+		ActionResource:   "crypto_key",
+		ActionParameters: string(params),
+		ActionService:    common.CloudKeySrv,
+		ActionMethod:     method,
+	}
+	body, err := json.Marshal(audit)
+	if err != nil {
+		return
+	}
+	if err := s.Broker.Publish(common.AUDIT_ACTION, &broker.Message{Body: body}); err != nil {
+		log.Error("Audit publish failed: ", err)
+	}
+}
 
 func (s *CloudKeyService) CreateKey(ctx context.Context, req *cloudkey_proto.CreateKeyRequest, rsp *cloudkey_proto.CreateKeyResponse) error {
-	return storage.DefaultStorage.CreateKey(nil, req.Orgid, req.CryptoKey)
+	err := storage.DefaultStorage.CreateKey(nil, req.Orgid, req.CryptoKey)
+	s.audit("CreateKey", audit_proto.ActionType_CREATED, req.Orgid, map[string]interface{}{"crypto_key": req.CryptoKey, "success": err == nil})
+	return err
 }
 
 func (s *CloudKeyService) EncryptKey(ctx context.Context, req *cloudkey_proto.EncryptKeyRequest, rsp *cloudkey_proto.EncryptKeyResponse) error {
 	res, err := storage.DefaultStorage.EncryptKey(nil, req.Orgid, req.CryptoKey, req.Dek)
+	s.audit("EncryptKey", audit_proto.ActionType_UPDATE, req.Orgid, map[string]interface{}{"crypto_key": req.CryptoKey, "success": err == nil})
 	if err != nil {
 		return err
 	}
@@ -25,6 +65,7 @@ func (s *CloudKeyService) EncryptKey(ctx context.Context, req *cloudkey_proto.En
 
 func (s *CloudKeyService) DecryptKey(ctx context.Context, req *cloudkey_proto.DecryptKeyRequest, rsp *cloudkey_proto.DecryptKeyResponse) error {
 	res, err := storage.DefaultStorage.DecryptKey(nil, req.Orgid, req.CryptoKey, req.Dek)
+	s.audit("DecryptKey", audit_proto.ActionType_VIEW, req.Orgid, map[string]interface{}{"crypto_key": req.CryptoKey, "success": err == nil})
 	if err != nil {
 		return err
 	}
@@ -32,3 +73,94 @@ func (s *CloudKeyService) DecryptKey(ctx context.Context, req *cloudkey_proto.De
 
 	return nil
 }
+
+// keyError maps the errors of the storage to the errors of the service
+func keyError(err error, method interface{}) error {
+	switch err {
+	case storage.ErrKeyNotFound, storage.ErrKeyVersion:
+		return common.NotFound(common.CloudKeySrv, method, err, err.Error())
+	case storage.ErrPrimaryVersion:
+		return common.BadRequest(common.CloudKeySrv, method, err, err.Error())
+	}
+	return common.InternalServerError(common.CloudKeySrv, method, err, "server error")
+}
+
+func (s *CloudKeyService) RotateKey(ctx context.Context, req *cloudkey_proto.RotateKeyRequest, rsp *cloudkey_proto.RotateKeyResponse) error {
+	log.Info("Received CloudKey.RotateKey request")
+	if len(req.Orgid) == 0 {
+		return common.BadRequest(common.CloudKeySrv, s.RotateKey, nil, "orgid is empty")
+	}
+	version, err := storage.DefaultStorage.RotateKey(nil, req.Orgid, req.CryptoKey)
+	if err != nil {
+		s.audit("RotateKey", audit_proto.ActionType_CREATED, req.Orgid, map[string]interface{}{"crypto_key": req.CryptoKey, "success": false})
+		return keyError(err, s.RotateKey)
+	}
+	s.audit("RotateKey", audit_proto.ActionType_CREATED, req.Orgid, map[string]interface{}{"crypto_key": req.CryptoKey, "version": version.Version, "success": true})
+	rsp.Version = version
+
+	if req.Rewrap {
+		go func() {
+			err := s.rewrap(context.Background(), req.Orgid, false, 0, func(p *cloudkey_proto.RewrapKeysResponse) error {
+				log.WithField("org_id", req.Orgid).Info("Rewrap progress: ", p)
+				return nil
+			})
+			if err != nil {
+				log.WithField("org_id", req.Orgid).Error("Rewrap failed: ", err)
+			}
+		}()
+	}
+	return nil
+}
+
+func (s *CloudKeyService) ListKeyVersions(ctx context.Context, req *cloudkey_proto.ListKeyVersionsRequest, rsp *cloudkey_proto.ListKeyVersionsResponse) error {
+	log.Info("Received CloudKey.ListKeyVersions request")
+	versions, err := storage.DefaultStorage.ListKeyVersions(nil, req.Orgid, req.CryptoKey)
+	s.audit("ListKeyVersions", audit_proto.ActionType_VIEW, req.Orgid, map[string]interface{}{"crypto_key": req.CryptoKey, "success": err == nil})
+	if err != nil {
+		return keyError(err, s.ListKeyVersions)
+	}
+	rsp.Versions = versions
+	return nil
+}
+
+func (s *CloudKeyService) DisableKeyVersion(ctx context.Context, req *cloudkey_proto.DisableKeyVersionRequest, rsp *cloudkey_proto.DisableKeyVersionResponse) error {
+	log.Info("Received CloudKey.DisableKeyVersion request")
+	if req.Version <= 0 {
+		return common.BadRequest(common.CloudKeySrv, s.DisableKeyVersion, nil, "version is invalid")
+	}
+	err := storage.DefaultStorage.DisableKeyVersion(nil, req.Orgid, req.CryptoKey, req.Version)
+	s.audit("DisableKeyVersion", audit_proto.ActionType_UPDATE, req.Orgid, map[string]interface{}{"crypto_key": req.CryptoKey, "version": req.Version, "success": err == nil})
+	if err != nil {
+		return keyError(err, s.DisableKeyVersion)
+	}
+	return nil
+}
+
+// rewrap runs the re-wrap job and audits its result
+func (s *CloudKeyService) rewrap(ctx context.Context, orgid string, reencrypt bool, batchSize int, progress func(*cloudkey_proto.RewrapKeysResponse) error) error {
+	last := &cloudkey_proto.RewrapKeysResponse{}
+	err := rewrap.Rewrap(ctx, orgid, reencrypt, batchSize, func(p *cloudkey_proto.RewrapKeysResponse) error {
+		last = p
+		return progress(p)
+	})
+	s.audit("RewrapKeys", audit_proto.ActionType_UPDATE, orgid, map[string]interface{}{
+		"reencrypt":   reencrypt,
+		"rewrapped":   last.Rewrapped,
+		"failed":      last.Failed,
+		"reencrypted": last.Reencrypted,
+		"success":     err == nil,
+	})
+	return err
+}
+
+func (s *CloudKeyService) RewrapKeys(ctx context.Context, req *cloudkey_proto.RewrapKeysRequest, stream cloudkey_proto.CloudKeyService_RewrapKeysStream) error {
+	log.Info("Received CloudKey.RewrapKeys request")
+	defer stream.Close()
+	err := s.rewrap(ctx, req.Orgid, req.Reencrypt, int(req.BatchSize), func(p *cloudkey_proto.RewrapKeysResponse) error {
+		return stream.Send(p)
+	})
+	if err != nil {
+		return common.InternalServerError(common.CloudKeySrv, s.RewrapKeys, err, "rewrap failed")
+	}
+	return nil
+}
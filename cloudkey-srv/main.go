@@ -5,6 +5,7 @@ import (
 	"server/cloudkey-srv/db"
 	"server/cloudkey-srv/storage"
 	"server/cloudkey-srv/handler"
+	"server/cloudkey-srv/rewrap"
 	cloudkey_proto "server/cloudkey-srv/proto/record"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"log"
 	"server/common"
 	"server/cloudkey-srv/storage/gce"
+	"server/cloudkey-srv/storage/local"
 	"github.com/micro/go-os/metrics"
 	"github.com/micro/go-plugins/metrics/telegraf"
 )
@@ -48,6 +50,8 @@ func main() {
 
 	gce.ProjectID = conf.Get("gce", "ProjectID").String("atlassian-1020")
 	gce.BucketName = conf.Get("gce", "BucketName").String("go-project-test")
+	local.MasterKeyFile = conf.Get("local", "master_key_file").String("")
+	storage.DefaultDriver = conf.Get("storage", "driver").String("gce")
 
 	service := micro.NewService(
 		micro.Name(name),
@@ -61,8 +65,11 @@ func main() {
 		micro.BeforeStart(func() error {
 			return storage.Init()
 		}))
-	cloudkey_proto.RegisterCloudKeyServiceHandler(service.Server(), new(handler.CloudKeyService))
+	brker := service.Client().Options().Broker
+	brker.Connect()
+	cloudkey_proto.RegisterCloudKeyServiceHandler(service.Server(), &handler.CloudKeyService{Broker: brker})
 	db.Init(service.Client())
+	rewrap.PII = common.NewPII(service.Client())
 
 	configDynamic := config.NewConfig(
 		// poll every hour
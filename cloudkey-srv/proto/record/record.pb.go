@@ -16,6 +16,15 @@ It has these top-level messages:
 	EncryptKeyResponse
 	DecryptKeyRequest
 	DecryptKeyResponse
+	KeyVersion
+	RotateKeyRequest
+	RotateKeyResponse
+	ListKeyVersionsRequest
+	ListKeyVersionsResponse
+	DisableKeyVersionRequest
+	DisableKeyVersionResponse
+	RewrapKeysRequest
+	RewrapKeysResponse
 */
 package healum_srv_cloudkey
 
@@ -109,6 +118,126 @@ func (m *DecryptKeyResponse) String() string            { return proto.CompactTe
 func (*DecryptKeyResponse) ProtoMessage()               {}
 func (*DecryptKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
+// version of the crypto key of an organisation
+type KeyVersion struct {
+	Version int64 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	// ENABLED or DISABLED
+	State   string `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
+	Created int64  `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
+	// the version wrapping the new keys
+	Primary bool `protobuf:"varint,4,opt,name=primary" json:"primary,omitempty"`
+}
+
+func (m *KeyVersion) Reset()                    { *m = KeyVersion{} }
+func (m *KeyVersion) String() string            { return proto.CompactTextString(m) }
+func (*KeyVersion) ProtoMessage()               {}
+func (*KeyVersion) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+// adds a version to the crypto key and makes it the primary version
+type RotateKeyRequest struct {
+	Orgid     string `protobuf:"bytes,1,opt,name=orgid" json:"orgid,omitempty"`
+	CryptoKey string `protobuf:"bytes,2,opt,name=crypto_key,json=cryptoKey" json:"crypto_key,omitempty"`
+	// re-wrap the data keys of the organisation in the background
+	Rewrap bool `protobuf:"varint,3,opt,name=rewrap" json:"rewrap,omitempty"`
+}
+
+func (m *RotateKeyRequest) Reset()                    { *m = RotateKeyRequest{} }
+func (m *RotateKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*RotateKeyRequest) ProtoMessage()               {}
+func (*RotateKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+type RotateKeyResponse struct {
+	Version *KeyVersion `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *RotateKeyResponse) Reset()                    { *m = RotateKeyResponse{} }
+func (m *RotateKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*RotateKeyResponse) ProtoMessage()               {}
+func (*RotateKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *RotateKeyResponse) GetVersion() *KeyVersion {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+type ListKeyVersionsRequest struct {
+	Orgid     string `protobuf:"bytes,1,opt,name=orgid" json:"orgid,omitempty"`
+	CryptoKey string `protobuf:"bytes,2,opt,name=crypto_key,json=cryptoKey" json:"crypto_key,omitempty"`
+}
+
+func (m *ListKeyVersionsRequest) Reset()                    { *m = ListKeyVersionsRequest{} }
+func (m *ListKeyVersionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListKeyVersionsRequest) ProtoMessage()               {}
+func (*ListKeyVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+// the latest version first
+type ListKeyVersionsResponse struct {
+	Versions []*KeyVersion `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+}
+
+func (m *ListKeyVersionsResponse) Reset()                    { *m = ListKeyVersionsResponse{} }
+func (m *ListKeyVersionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListKeyVersionsResponse) ProtoMessage()               {}
+func (*ListKeyVersionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ListKeyVersionsResponse) GetVersions() []*KeyVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// the keys wrapped by a disabled version can't be unwrapped, re-wrap them first
+type DisableKeyVersionRequest struct {
+	Orgid     string `protobuf:"bytes,1,opt,name=orgid" json:"orgid,omitempty"`
+	CryptoKey string `protobuf:"bytes,2,opt,name=crypto_key,json=cryptoKey" json:"crypto_key,omitempty"`
+	Version   int64  `protobuf:"varint,3,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *DisableKeyVersionRequest) Reset()                    { *m = DisableKeyVersionRequest{} }
+func (m *DisableKeyVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DisableKeyVersionRequest) ProtoMessage()               {}
+func (*DisableKeyVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+type DisableKeyVersionResponse struct {
+}
+
+func (m *DisableKeyVersionResponse) Reset()                    { *m = DisableKeyVersionResponse{} }
+func (m *DisableKeyVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*DisableKeyVersionResponse) ProtoMessage()               {}
+func (*DisableKeyVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+// re-wraps the data keys of the healum database with the primary version of their crypto key
+type RewrapKeysRequest struct {
+	// all organisations if empty
+	Orgid string `protobuf:"bytes,1,opt,name=orgid" json:"orgid,omitempty"`
+	// also adds a data key version to the organisations and re-encrypts their documents with it
+	Reencrypt bool  `protobuf:"varint,2,opt,name=reencrypt" json:"reencrypt,omitempty"`
+	BatchSize int64 `protobuf:"varint,3,opt,name=batch_size,json=batchSize" json:"batch_size,omitempty"`
+}
+
+func (m *RewrapKeysRequest) Reset()                    { *m = RewrapKeysRequest{} }
+func (m *RewrapKeysRequest) String() string            { return proto.CompactTextString(m) }
+func (*RewrapKeysRequest) ProtoMessage()               {}
+func (*RewrapKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+// progress of a re-wrap, sent after every organisation and once done
+type RewrapKeysResponse struct {
+	Total     int64 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	Rewrapped int64 `protobuf:"varint,2,opt,name=rewrapped" json:"rewrapped,omitempty"`
+	Failed    int64 `protobuf:"varint,3,opt,name=failed" json:"failed,omitempty"`
+	// documents re-encrypted with the new data keys
+	Reencrypted int64 `protobuf:"varint,4,opt,name=reencrypted" json:"reencrypted,omitempty"`
+	Done        bool  `protobuf:"varint,5,opt,name=done" json:"done,omitempty"`
+}
+
+func (m *RewrapKeysResponse) Reset()                    { *m = RewrapKeysResponse{} }
+func (m *RewrapKeysResponse) String() string            { return proto.CompactTextString(m) }
+func (*RewrapKeysResponse) ProtoMessage()               {}
+func (*RewrapKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
 func init() {
 	proto.RegisterType((*Storage)(nil), "healum.srv.cloudkey.Storage")
 	proto.RegisterType((*CreateKeyRequest)(nil), "healum.srv.cloudkey.CreateKeyRequest")
@@ -117,6 +246,15 @@ func init() {
 	proto.RegisterType((*EncryptKeyResponse)(nil), "healum.srv.cloudkey.EncryptKeyResponse")
 	proto.RegisterType((*DecryptKeyRequest)(nil), "healum.srv.cloudkey.DecryptKeyRequest")
 	proto.RegisterType((*DecryptKeyResponse)(nil), "healum.srv.cloudkey.DecryptKeyResponse")
+	proto.RegisterType((*KeyVersion)(nil), "healum.srv.cloudkey.KeyVersion")
+	proto.RegisterType((*RotateKeyRequest)(nil), "healum.srv.cloudkey.RotateKeyRequest")
+	proto.RegisterType((*RotateKeyResponse)(nil), "healum.srv.cloudkey.RotateKeyResponse")
+	proto.RegisterType((*ListKeyVersionsRequest)(nil), "healum.srv.cloudkey.ListKeyVersionsRequest")
+	proto.RegisterType((*ListKeyVersionsResponse)(nil), "healum.srv.cloudkey.ListKeyVersionsResponse")
+	proto.RegisterType((*DisableKeyVersionRequest)(nil), "healum.srv.cloudkey.DisableKeyVersionRequest")
+	proto.RegisterType((*DisableKeyVersionResponse)(nil), "healum.srv.cloudkey.DisableKeyVersionResponse")
+	proto.RegisterType((*RewrapKeysRequest)(nil), "healum.srv.cloudkey.RewrapKeysRequest")
+	proto.RegisterType((*RewrapKeysResponse)(nil), "healum.srv.cloudkey.RewrapKeysResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -130,6 +268,10 @@ type CloudKeyServiceClient interface {
 	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...client.CallOption) (*CreateKeyResponse, error)
 	EncryptKey(ctx context.Context, in *EncryptKeyRequest, opts ...client.CallOption) (*EncryptKeyResponse, error)
 	DecryptKey(ctx context.Context, in *DecryptKeyRequest, opts ...client.CallOption) (*DecryptKeyResponse, error)
+	RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...client.CallOption) (*RotateKeyResponse, error)
+	ListKeyVersions(ctx context.Context, in *ListKeyVersionsRequest, opts ...client.CallOption) (*ListKeyVersionsResponse, error)
+	DisableKeyVersion(ctx context.Context, in *DisableKeyVersionRequest, opts ...client.CallOption) (*DisableKeyVersionResponse, error)
+	RewrapKeys(ctx context.Context, in *RewrapKeysRequest, opts ...client.CallOption) (CloudKeyService_RewrapKeysClient, error)
 }
 
 type cloudKeyServiceClient struct {
@@ -180,12 +322,90 @@ func (c *cloudKeyServiceClient) DecryptKey(ctx context.Context, in *DecryptKeyRe
 	return out, nil
 }
 
+func (c *cloudKeyServiceClient) RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...client.CallOption) (*RotateKeyResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "CloudKeyService.RotateKey", in)
+	out := new(RotateKeyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudKeyServiceClient) ListKeyVersions(ctx context.Context, in *ListKeyVersionsRequest, opts ...client.CallOption) (*ListKeyVersionsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "CloudKeyService.ListKeyVersions", in)
+	out := new(ListKeyVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudKeyServiceClient) DisableKeyVersion(ctx context.Context, in *DisableKeyVersionRequest, opts ...client.CallOption) (*DisableKeyVersionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "CloudKeyService.DisableKeyVersion", in)
+	out := new(DisableKeyVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudKeyServiceClient) RewrapKeys(ctx context.Context, in *RewrapKeysRequest, opts ...client.CallOption) (CloudKeyService_RewrapKeysClient, error) {
+	req := c.c.NewRequest(c.serviceName, "CloudKeyService.RewrapKeys", &RewrapKeysRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &cloudKeyServiceRewrapKeysClient{stream}, nil
+}
+
+type CloudKeyService_RewrapKeysClient interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*RewrapKeysResponse, error)
+}
+
+type cloudKeyServiceRewrapKeysClient struct {
+	stream client.Streamer
+}
+
+func (x *cloudKeyServiceRewrapKeysClient) Close() error {
+	return x.stream.Close()
+}
+
+func (x *cloudKeyServiceRewrapKeysClient) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *cloudKeyServiceRewrapKeysClient) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *cloudKeyServiceRewrapKeysClient) Recv() (*RewrapKeysResponse, error) {
+	m := new(RewrapKeysResponse)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for CloudKeyService service
 
 type CloudKeyServiceHandler interface {
 	CreateKey(context.Context, *CreateKeyRequest, *CreateKeyResponse) error
 	EncryptKey(context.Context, *EncryptKeyRequest, *EncryptKeyResponse) error
 	DecryptKey(context.Context, *DecryptKeyRequest, *DecryptKeyResponse) error
+	RotateKey(context.Context, *RotateKeyRequest, *RotateKeyResponse) error
+	ListKeyVersions(context.Context, *ListKeyVersionsRequest, *ListKeyVersionsResponse) error
+	DisableKeyVersion(context.Context, *DisableKeyVersionRequest, *DisableKeyVersionResponse) error
+	RewrapKeys(context.Context, *RewrapKeysRequest, CloudKeyService_RewrapKeysStream) error
 }
 
 func RegisterCloudKeyServiceHandler(s server.Server, hdlr CloudKeyServiceHandler, opts ...server.HandlerOption) {
@@ -208,6 +428,53 @@ func (h *CloudKeyService) DecryptKey(ctx context.Context, in *DecryptKeyRequest,
 	return h.CloudKeyServiceHandler.DecryptKey(ctx, in, out)
 }
 
+func (h *CloudKeyService) RotateKey(ctx context.Context, in *RotateKeyRequest, out *RotateKeyResponse) error {
+	return h.CloudKeyServiceHandler.RotateKey(ctx, in, out)
+}
+
+func (h *CloudKeyService) ListKeyVersions(ctx context.Context, in *ListKeyVersionsRequest, out *ListKeyVersionsResponse) error {
+	return h.CloudKeyServiceHandler.ListKeyVersions(ctx, in, out)
+}
+
+func (h *CloudKeyService) DisableKeyVersion(ctx context.Context, in *DisableKeyVersionRequest, out *DisableKeyVersionResponse) error {
+	return h.CloudKeyServiceHandler.DisableKeyVersion(ctx, in, out)
+}
+
+func (h *CloudKeyService) RewrapKeys(ctx context.Context, stream server.Streamer) error {
+	m := new(RewrapKeysRequest)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.CloudKeyServiceHandler.RewrapKeys(ctx, m, &cloudKeyServiceRewrapKeysStream{stream})
+}
+
+type CloudKeyService_RewrapKeysStream interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*RewrapKeysResponse) error
+}
+
+type cloudKeyServiceRewrapKeysStream struct {
+	stream server.Streamer
+}
+
+func (x *cloudKeyServiceRewrapKeysStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *cloudKeyServiceRewrapKeysStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *cloudKeyServiceRewrapKeysStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *cloudKeyServiceRewrapKeysStream) Send(m *RewrapKeysResponse) error {
+	return x.stream.Send(m)
+}
+
 //## The API
 //CloudKeyService server implements the following RPC Methods
 //
@@ -215,6 +482,10 @@ func (h *CloudKeyService) DecryptKey(ctx context.Context, in *DecryptKeyRequest,
 //- CreateKey
 //- EncryptKey
 //- DecryptKey
+//- RotateKey
+//- ListKeyVersions
+//- DisableKeyVersion
+//- RewrapKeys
 //
 //### CloudKeyService.CreateKey
 //
@@ -234,30 +505,74 @@ func (h *CloudKeyService) DecryptKey(ctx context.Context, in *DecryptKeyRequest,
 //micro query healum.srv.cloudkey CloudKeyService.DecryptKey
 //```
 //
+//### CloudKeyService.RotateKey
+//
+//```
+//micro query healum.srv.cloudkey CloudKeyService.RotateKey
+//```
+//
+//### CloudKeyService.ListKeyVersions
+//
+//```
+//micro query healum.srv.cloudkey CloudKeyService.ListKeyVersions
+//```
+//
+//### CloudKeyService.DisableKeyVersion
+//
+//```
+//micro query healum.srv.cloudkey CloudKeyService.DisableKeyVersion
+//```
+//
+//### CloudKeyService.RewrapKeys
+//
+//```
+//micro query healum.srv.cloudkey CloudKeyService.RewrapKeys
+//```
+//
 
 func init() { proto.RegisterFile("server/cloudkey-srv/proto/record/record.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x93, 0xcb, 0x6b, 0xc2, 0x40,
-	0x10, 0xc6, 0x7d, 0xb4, 0x8a, 0x43, 0x4b, 0x75, 0x2c, 0x45, 0x84, 0x42, 0x49, 0xe9, 0xe3, 0x62,
-	0x84, 0xf6, 0xd4, 0xb3, 0x96, 0x1e, 0x3c, 0x14, 0xf4, 0x56, 0x0a, 0x12, 0xb3, 0x83, 0x95, 0xa8,
-	0x6b, 0x67, 0x93, 0xc0, 0xfe, 0x59, 0xfd, 0x0f, 0x4b, 0x36, 0xab, 0x06, 0x1f, 0x84, 0x1e, 0x7a,
-	0xca, 0xce, 0x97, 0xd9, 0xdf, 0x37, 0xcc, 0x97, 0x40, 0x47, 0x11, 0xc7, 0xc4, 0x5d, 0x7f, 0x2e,
-	0x23, 0x11, 0x90, 0xee, 0x28, 0x8e, 0xbb, 0x2b, 0x96, 0xa1, 0xec, 0x32, 0xf9, 0x92, 0x85, 0x7d,
-	0xb8, 0x46, 0xc3, 0xe6, 0x17, 0x79, 0xf3, 0x68, 0xe1, 0x2a, 0x8e, 0xdd, 0xf5, 0x15, 0xe7, 0x1d,
-	0xaa, 0xa3, 0x50, 0xb2, 0x37, 0x25, 0x44, 0x38, 0x59, 0x7a, 0x0b, 0x6a, 0x15, 0x6f, 0x8a, 0x8f,
-	0xb5, 0xa1, 0x39, 0x63, 0x0b, 0xaa, 0x2a, 0x9a, 0x18, 0xb9, 0x64, 0xe4, 0x75, 0x89, 0x57, 0x50,
-	0x11, 0x3c, 0x8b, 0x89, 0x5b, 0x65, 0xf3, 0xc2, 0x56, 0xce, 0x1b, 0xd4, 0x7b, 0x4c, 0x5e, 0x48,
-	0x03, 0xd2, 0x43, 0xfa, 0x8e, 0x48, 0x85, 0x78, 0x09, 0xa7, 0x92, 0xa7, 0x33, 0x61, 0xd1, 0x69,
-	0x81, 0xd7, 0x00, 0x3e, 0xeb, 0x55, 0x28, 0xc7, 0x01, 0x69, 0x8b, 0xaf, 0xa5, 0xca, 0x80, 0xb4,
-	0xd3, 0x84, 0x46, 0x06, 0xa4, 0x56, 0x72, 0xa9, 0xc8, 0xf9, 0x80, 0xc6, 0xeb, 0xd2, 0xf4, 0xe4,
-	0xe2, 0xeb, 0x50, 0x16, 0x14, 0x58, 0x6e, 0x72, 0xdc, 0x31, 0x2c, 0xef, 0x1a, 0xbe, 0x00, 0x66,
-	0xd9, 0xa9, 0x23, 0xde, 0xc2, 0x39, 0xa5, 0x2a, 0x89, 0x71, 0x02, 0x4c, 0x4d, 0xce, 0x36, 0x62,
-	0x9f, 0x82, 0x64, 0xac, 0x3e, 0xfd, 0xdf, 0x58, 0x59, 0xf6, 0x1f, 0xc6, 0x7a, 0xfa, 0x29, 0xc1,
-	0x45, 0x2f, 0x49, 0x7a, 0x40, 0x7a, 0x44, 0x1c, 0xcf, 0x7c, 0xc2, 0x4f, 0xa8, 0x6d, 0xd6, 0x8a,
-	0x77, 0xee, 0x81, 0x6f, 0xc2, 0xdd, 0xcd, 0xaf, 0x7d, 0x9f, 0xd7, 0x66, 0xd3, 0x29, 0xe0, 0x18,
-	0x60, 0xbb, 0x43, 0x3c, 0x7c, 0x6f, 0x2f, 0xc0, 0xf6, 0x43, 0x6e, 0x5f, 0xd6, 0x60, 0xbb, 0x8d,
-	0x23, 0x06, 0x7b, 0x51, 0x1c, 0x31, 0xd8, 0x5f, 0xab, 0x53, 0x98, 0x54, 0xcc, 0xcf, 0xf2, 0xfc,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x01, 0xf0, 0xef, 0x9d, 0x5d, 0x03, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x4e, 0xdb, 0x4c,
+	0x10, 0x25, 0x9f, 0x09, 0x24, 0xc3, 0x57, 0x41, 0x16, 0x44, 0xdd, 0xb4, 0x55, 0x23, 0x57, 0x05,
+	0xa4, 0x96, 0x50, 0xd1, 0x2b, 0xd4, 0x4b, 0x52, 0xf5, 0x22, 0xfd, 0x91, 0x8c, 0xc4, 0x45, 0x55,
+	0x29, 0xda, 0xd8, 0x03, 0x58, 0x09, 0x5e, 0x77, 0x77, 0xe3, 0xca, 0x3c, 0x48, 0x9f, 0xad, 0x8f,
+	0x53, 0xed, 0x7a, 0xfd, 0x43, 0x62, 0xe4, 0xa8, 0x51, 0xaf, 0xb2, 0x33, 0x3b, 0x3b, 0xe7, 0x78,
+	0xe6, 0xcc, 0x28, 0x70, 0x2c, 0x90, 0xc7, 0xc8, 0x4f, 0xbc, 0x29, 0x9b, 0xf9, 0x13, 0x4c, 0x8e,
+	0x05, 0x8f, 0x4f, 0x22, 0xce, 0x24, 0x3b, 0xe1, 0xe8, 0x31, 0xee, 0x9b, 0x9f, 0xbe, 0xf6, 0x91,
+	0xdd, 0x1b, 0xa4, 0xd3, 0xd9, 0x6d, 0x5f, 0xf0, 0xb8, 0x9f, 0x3d, 0x71, 0xbe, 0xc2, 0xe6, 0x85,
+	0x64, 0x9c, 0x5e, 0x23, 0x21, 0xb0, 0x1e, 0xd2, 0x5b, 0xb4, 0x1b, 0xbd, 0xc6, 0x51, 0xdb, 0xd5,
+	0x67, 0x62, 0xc3, 0xa6, 0x98, 0x8d, 0xb5, 0xfb, 0x3f, 0xed, 0xce, 0x4c, 0xb2, 0x0f, 0x1b, 0x3e,
+	0x0f, 0x62, 0xe4, 0xb6, 0xa5, 0x2f, 0x8c, 0xe5, 0x7c, 0x84, 0x9d, 0x73, 0x8e, 0x54, 0xe2, 0x10,
+	0x13, 0x17, 0x7f, 0xcc, 0x50, 0x48, 0xb2, 0x07, 0x4d, 0xc6, 0xaf, 0x03, 0xdf, 0xa4, 0x4e, 0x0d,
+	0xf2, 0x1c, 0xc0, 0xe3, 0x49, 0x24, 0xd9, 0x68, 0x82, 0x89, 0x49, 0xdf, 0x4e, 0x3d, 0x43, 0x4c,
+	0x9c, 0x5d, 0xe8, 0x94, 0x12, 0x89, 0x88, 0x85, 0x02, 0x9d, 0x6f, 0xd0, 0xf9, 0x10, 0xea, 0x98,
+	0xda, 0xf4, 0x3b, 0x60, 0xf9, 0x38, 0x31, 0x79, 0xd5, 0x71, 0x0e, 0xd0, 0x9a, 0x07, 0x3c, 0x03,
+	0x52, 0xce, 0x9d, 0x22, 0x92, 0x97, 0xf0, 0x08, 0x53, 0x2f, 0xfa, 0x23, 0x95, 0x30, 0x05, 0xf9,
+	0x3f, 0x77, 0x0e, 0x70, 0xa2, 0x68, 0x0d, 0xf0, 0xdf, 0xd1, 0x1a, 0xe0, 0xdf, 0xd1, 0x8a, 0x00,
+	0x86, 0x98, 0x5c, 0x22, 0x17, 0x01, 0x0b, 0x55, 0x2f, 0xe3, 0xf4, 0xa8, 0x83, 0x2d, 0x37, 0x33,
+	0x15, 0x53, 0x21, 0xa9, 0xcc, 0x7a, 0x9c, 0x1a, 0x2a, 0xde, 0xd3, 0x0d, 0xf0, 0x35, 0x29, 0xcb,
+	0xcd, 0x4c, 0x75, 0x13, 0xf1, 0xe0, 0x96, 0xf2, 0xc4, 0x5e, 0xef, 0x35, 0x8e, 0x5a, 0x6e, 0x66,
+	0x3a, 0x23, 0xd8, 0x71, 0x99, 0x5c, 0xbd, 0xfb, 0x4a, 0x5e, 0x1c, 0x7f, 0x72, 0x1a, 0x69, 0xec,
+	0x96, 0x6b, 0x2c, 0xe7, 0x0b, 0x74, 0x4a, 0x00, 0xa6, 0x18, 0x67, 0xf7, 0xbf, 0x6c, 0xeb, 0xf4,
+	0x45, 0xbf, 0x42, 0xeb, 0xfd, 0xa2, 0x16, 0xf9, 0xa7, 0x3b, 0x9f, 0x61, 0xff, 0x53, 0x20, 0x64,
+	0x71, 0x25, 0x56, 0x12, 0xed, 0x25, 0x3c, 0x5e, 0x48, 0x67, 0x48, 0xbe, 0x87, 0x96, 0x01, 0x15,
+	0x76, 0xa3, 0x67, 0x2d, 0xc3, 0x32, 0x7f, 0xe0, 0x04, 0x60, 0x0f, 0x02, 0x41, 0xc7, 0x53, 0x2c,
+	0x5d, 0xaf, 0x52, 0xdf, 0x92, 0x18, 0xac, 0x7b, 0x62, 0x70, 0x9e, 0xc2, 0x93, 0x0a, 0x28, 0x33,
+	0x7f, 0x57, 0xd0, 0x71, 0x75, 0x23, 0x86, 0x98, 0xd4, 0x54, 0xea, 0x19, 0xb4, 0x39, 0x1a, 0x39,
+	0x6a, 0xfc, 0x96, 0x5b, 0x38, 0x14, 0xbd, 0x31, 0x95, 0xde, 0xcd, 0x48, 0x04, 0x77, 0x68, 0x28,
+	0xb4, 0xb5, 0xe7, 0x22, 0xb8, 0x43, 0xe7, 0x57, 0x03, 0x48, 0x19, 0xc8, 0xd4, 0x70, 0x0f, 0x9a,
+	0x92, 0x49, 0x3a, 0x35, 0x02, 0x4e, 0x8d, 0x14, 0x49, 0xc5, 0x46, 0xe8, 0x6b, 0x24, 0xcb, 0x2d,
+	0x1c, 0x4a, 0x49, 0x57, 0x34, 0x98, 0xe6, 0x2a, 0x36, 0x16, 0xe9, 0xc1, 0x56, 0x4e, 0x07, 0x7d,
+	0x2d, 0x64, 0xcb, 0x2d, 0xbb, 0xd4, 0x42, 0xf4, 0x59, 0x88, 0x76, 0x53, 0x93, 0xd7, 0xe7, 0xd3,
+	0xdf, 0x4d, 0xd8, 0x3e, 0x57, 0xad, 0x1a, 0x62, 0x72, 0x81, 0x3c, 0x0e, 0x3c, 0x24, 0xdf, 0xa1,
+	0x9d, 0x6f, 0x2a, 0xf2, 0xaa, 0xb2, 0xa9, 0xf3, 0x2b, 0xb1, 0x7b, 0x50, 0x17, 0x66, 0x0a, 0xbe,
+	0x46, 0x46, 0x00, 0xc5, 0x5a, 0x22, 0xd5, 0xef, 0x16, 0x76, 0x62, 0xf7, 0xb0, 0x36, 0xae, 0x0c,
+	0x30, 0xc0, 0x1a, 0x80, 0x01, 0x2e, 0x07, 0xb0, 0xb8, 0xa9, 0x9c, 0x35, 0x55, 0x9f, 0x7c, 0x66,
+	0x1f, 0xa8, 0xcf, 0xfc, 0xd2, 0xe8, 0x1e, 0xd4, 0x85, 0xe5, 0xd9, 0x43, 0xd8, 0x9e, 0x1b, 0x39,
+	0xf2, 0xba, 0xf2, 0x71, 0xf5, 0x9c, 0x77, 0xdf, 0x2c, 0x17, 0x9c, 0xe3, 0x49, 0xe8, 0x2c, 0xcc,
+	0x07, 0x39, 0xae, 0xae, 0xc6, 0x03, 0x23, 0xdb, 0xed, 0x2f, 0x1b, 0x9e, 0xa3, 0x52, 0x80, 0x62,
+	0x1e, 0x1e, 0x68, 0xd2, 0xc2, 0x64, 0x76, 0x0f, 0x6b, 0xe3, 0x32, 0x80, 0xb7, 0x8d, 0xf1, 0x86,
+	0xfe, 0x9b, 0xf0, 0xee, 0xcf, 0x00, 0x66, 0xbe, 0x8a, 0x10, 0x57, 0x08, 0x00, 0x00,
 }
@@ -0,0 +1,211 @@
+// Package rewrap re-wraps the data encryption keys of the organisations with the primary version of their crypto key,
+// and optionally re-encrypts the personal fields of their documents with a new data encryption key. After a
+// rotation, the previous version of a crypto key can be disabled once the job is done.
+package rewrap
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"server/cloudkey-srv/db"
+	mdb "server/cloudkey-srv/proto/record"
+	"server/cloudkey-srv/storage"
+	"server/common"
+	db_proto "server/db-srv/proto/db"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// DefaultBatchSize is the number of documents re-encrypted at once
+const DefaultBatchSize = 100
+
+// PII encrypts the documents again, the re-encryption isn't available if it isn't set
+var PII *common.PII
+
+// dataKey is a document of common.DbDataKeyTable
+type dataKey struct {
+	Id   string                 `json:"_key"`
+	Data map[string]interface{} `json:"data"`
+}
+
+func runQuery(ctx context.Context, table, q string, bindVars common.BindVars) ([]*db_proto.Record, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := db.ClientWrapper.Db_client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{Name: common.DbHealumName, Table: table, Driver: common.DbHealumDriver},
+		Query:    q,
+		BindVars: vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Records, nil
+}
+
+// dataKeys reads the data keys of an organisation, of all of them if orgId is empty, by organisation
+func dataKeys(ctx context.Context, orgId string) ([]string, map[string][]*dataKey, error) {
+	bindVars := common.BindVars{}
+	filter := ""
+	if len(orgId) > 0 {
+		filter = fmt.Sprintf(`FILTER k.parameter1 == %s`, bindVars.Add("org_id", orgId))
+	}
+	q := fmt.Sprintf(`
+		FOR k IN %v
+		%s
+		SORT k.parameter1, k.data.version
+		RETURN {data: k}`, common.DbDataKeyTable, filter)
+	records, err := runQuery(ctx, common.DbDataKeyTable, q, bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	orgs := []string{}
+	keys := map[string][]*dataKey{}
+	for _, r := range records {
+		k := &dataKey{}
+		if err := json.Unmarshal([]byte(r.Parameter3), k); err != nil {
+			return nil, nil, err
+		}
+		org, _ := k.Data["org_id"].(string)
+		if _, ok := keys[org]; !ok {
+			orgs = append(orgs, org)
+		}
+		keys[org] = append(keys[org], k)
+	}
+	return orgs, keys, nil
+}
+
+// rewrapKey unwraps a data key and wraps it with the primary version of the crypto key of its organisation
+func rewrapKey(ctx context.Context, orgId string, k *dataKey) error {
+	encoded, _ := k.Data["wrapped_key"].(string)
+	wrapped, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+	dek, err := storage.DecryptKey(nil, orgId, "", string(wrapped))
+	if err != nil {
+		return err
+	}
+	rewrapped, err := storage.EncryptKey(nil, orgId, "", dek)
+	if err != nil {
+		return err
+	}
+	bindVars := common.BindVars{}
+	q := fmt.Sprintf(`UPDATE {_key: %s} WITH {data: {wrapped_key: %s}} IN %v`,
+		bindVars.Add("key", k.Id),
+		bindVars.Add("wrapped_key", base64.StdEncoding.EncodeToString([]byte(rewrapped))),
+		common.DbDataKeyTable)
+	_, err = runQuery(ctx, common.DbDataKeyTable, q, bindVars)
+	return err
+}
+
+// orgFilter matches the documents of an organisation, the documents without organisation use common.PIIDefaultOrg
+func orgFilter(orgId string, bindVars common.BindVars) string {
+	if orgId == common.PIIDefaultOrg {
+		return `FILTER doc.data.org_id == null || doc.data.org_id == ""`
+	}
+	return fmt.Sprintf(`FILTER doc.data.org_id == %s`, bindVars.Add("org_id", orgId))
+}
+
+// reencrypt adds a data key version to an organisation and encrypts its documents with it, it returns the number of
+// documents changed
+func reencrypt(ctx context.Context, orgId string, batchSize int) (int64, error) {
+	if PII == nil {
+		return 0, storage.ErrNotAvailable
+	}
+	if _, err := PII.RotateDataKey(ctx, orgId); err != nil {
+		return 0, err
+	}
+
+	count := int64(0)
+	for collection := range common.PIIFields {
+		for offset := 0; ; offset += batchSize {
+			bindVars := common.BindVars{}
+			q := fmt.Sprintf(`
+				FOR doc IN %v
+				%s
+				SORT doc._key
+				LIMIT %d, %d
+				RETURN {data: doc}`, collection, orgFilter(orgId, bindVars), offset, batchSize)
+			records, err := runQuery(ctx, collection, q, bindVars)
+			if err != nil {
+				return count, err
+			}
+			for _, r := range records {
+				doc := map[string]interface{}{}
+				if err := json.Unmarshal([]byte(r.Parameter3), &doc); err != nil {
+					return count, err
+				}
+				data, ok := doc["data"].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				changed, err := PII.ReencryptFields(ctx, orgId, collection, data)
+				if err != nil {
+					return count, err
+				}
+				if !changed {
+					continue
+				}
+				bindVars := common.BindVars{}
+				q := fmt.Sprintf(`UPDATE {_key: %s} WITH {data: %s} IN %v`,
+					bindVars.Add("key", doc["_key"]), bindVars.Add("data", data), collection)
+				if _, err := runQuery(ctx, collection, q, bindVars); err != nil {
+					return count, err
+				}
+				count++
+			}
+			if len(records) < batchSize {
+				break
+			}
+		}
+	}
+	return count, nil
+}
+
+// Rewrap re-wraps the data keys of an organisation, of all of them if orgId is empty. progress is called after every
+// organisation and once done. A key which fails is counted and skipped, running the job again retries it.
+func Rewrap(ctx context.Context, orgId string, reencryptData bool, batchSize int, progress func(*mdb.RewrapKeysResponse) error) error {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	// the job reads the documents of every organisation
+	ctx = common.NewAdminContext(ctx)
+	orgs, keys, err := dataKeys(ctx, orgId)
+	if err != nil {
+		return err
+	}
+	p := &mdb.RewrapKeysResponse{}
+	for _, org := range orgs {
+		p.Total += int64(len(keys[org]))
+	}
+
+	for _, org := range orgs {
+		failed := false
+		for _, k := range keys[org] {
+			if err := rewrapKey(ctx, org, k); err != nil {
+				log.WithField("org_id", org).WithField("key", k.Id).Error("Rewrap failed: ", err)
+				failed = true
+				p.Failed++
+				continue
+			}
+			p.Rewrapped++
+		}
+		// the documents are only encrypted with a key wrapped by the primary version
+		if reencryptData && !failed {
+			n, err := reencrypt(ctx, org, batchSize)
+			p.Reencrypted += n
+			if err != nil {
+				return err
+			}
+		}
+		if err := progress(p); err != nil {
+			return err
+		}
+	}
+	p.Done = true
+	return progress(p)
+}
@@ -0,0 +1,116 @@
+package rewrap_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"server/cloudkey-srv/db"
+	mdb "server/cloudkey-srv/proto/record"
+	"server/cloudkey-srv/rewrap"
+	"server/cloudkey-srv/storage"
+	"server/common"
+	"server/common/dbtest"
+	db_proto "server/db-srv/proto/db"
+
+	"golang.org/x/net/context"
+)
+
+func userData() map[string]interface{} {
+	return map[string]interface{}{"id": "u1", "org_id": "org1", "firstname": "Alice", "lastname": "Smith"}
+}
+
+func runQuery(t *testing.T, table, q string, bindVars common.BindVars) []map[string]interface{} {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp, err := db.ClientWrapper.Db_client.RunQuery(common.NewAdminContext(context.TODO()), &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{Name: common.DbHealumName, Table: table, Driver: common.DbHealumDriver},
+		Query:    q,
+		BindVars: vars,
+	})
+	if err != nil {
+		t.Fatalf("%s: %v", q, err)
+	}
+	docs := []map[string]interface{}{}
+	for _, r := range rsp.Records {
+		doc := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(r.Parameter3), &doc); err != nil {
+			t.Fatal(err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func TestRewrap(t *testing.T) {
+	c := dbtest.NewClient()
+	dbtest.Reset()
+	pii := common.NewPII(c)
+	rewrap.PII = pii
+	ctx := context.TODO()
+
+	data := userData()
+	if err := pii.EncryptFields(ctx, "org1", common.DbUserTable, data); err != nil {
+		t.Fatal(err)
+	}
+	bindVars := common.BindVars{}
+	runQuery(t, common.DbUserTable, `INSERT {_key: "u1", id: "u1", data: `+bindVars.Add("data", data)+`} INTO user`, bindVars)
+	bidx, err := pii.BlindIndex(ctx, "org1", "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := storage.RotateKey(nil, "org1", ""); err != nil {
+		t.Fatal(err)
+	}
+	progress := []*mdb.RewrapKeysResponse{}
+	err = rewrap.Rewrap(ctx, "", true, 0, func(p *mdb.RewrapKeysResponse) error {
+		progress = append(progress, &mdb.RewrapKeysResponse{Total: p.Total, Rewrapped: p.Rewrapped, Reencrypted: p.Reencrypted, Done: p.Done})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := progress[len(progress)-1]
+	if len(progress) != 2 || !last.Done || last.Total != 1 || last.Rewrapped != 1 || last.Reencrypted != 1 {
+		t.Fatalf("Progress is invalid: %v", progress)
+	}
+
+	// the data keys are wrapped by the second version, the first one can be disabled
+	keys := runQuery(t, common.DbDataKeyTable, `FOR k IN data_key RETURN {data: k.data}`, nil)
+	if len(keys) != 2 {
+		t.Fatalf("Data key must be added: %v", keys)
+	}
+	for _, k := range keys {
+		wrapped, _ := base64.StdEncoding.DecodeString(k["wrapped_key"].(string))
+		if !strings.HasPrefix(string(wrapped), "2:") {
+			t.Errorf("Data key must be wrapped by the primary version: %v", k)
+		}
+	}
+	if err := storage.DisableKeyVersion(nil, "org1", "", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	read := runQuery(t, common.DbUserTable, `FOR doc IN user RETURN {data: doc.data}`, nil)[0]
+	if s, _ := read["firstname"].(string); !strings.HasPrefix(s, "pii:org1:2:") || read["firstname_bidx"] != data["firstname_bidx"] {
+		t.Errorf("User must be encrypted by the new data key with the same blind index: %v", read)
+	}
+	fresh := common.NewPII(c)
+	body, _ := json.Marshal(read)
+	decrypted, err := fresh.DecryptJSON(common.NewTenantContext(ctx, "org1"), string(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := map[string]interface{}{}
+	json.Unmarshal([]byte(decrypted), &user)
+	if !reflect.DeepEqual(user, userData()) {
+		t.Errorf("User must be decrypted: %v", user)
+	}
+	if other, err := fresh.BlindIndex(ctx, "org1", "Alice"); err != nil || other != bidx {
+		t.Errorf("Blind index must not change: %v %v", other, err)
+	}
+}
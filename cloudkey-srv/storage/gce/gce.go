@@ -10,6 +10,10 @@ import (
 
 	"encoding/base64"
 	"golang.org/x/net/context"
+	"path"
+	"sort"
+	"strconv"
+	"time"
 )
 
 var (
@@ -142,3 +146,110 @@ func (d *gceDB) DecryptKey(orgid, cryptoKey, encryptedDek  string) (string, erro
 	res, _ := base64.StdEncoding.DecodeString(resp.Plaintext)
 	return string(res), nil
 }
+
+func newService() (*cloudkms.Service, error) {
+	cl, err := google.DefaultClient(context.Background(), cloudkms.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	return cloudkms.New(cl)
+}
+
+// resource name of the crypto key of an organisation
+func cryptoKeyName(orgid, cryptoKey string) string {
+	if len(cryptoKey) == 0 {
+		cryptoKey = DefaultCryptoKey
+	}
+	return fmt.Sprintf("projects/%s/locations/global/keyRings/%s/cryptoKeys/%s", ProjectID, orgid, cryptoKey)
+}
+
+// keyVersion converts a version of KMS, its id is the last element of its name
+func keyVersion(v *cloudkms.CryptoKeyVersion, primary string) *mdb.KeyVersion {
+	version, _ := strconv.ParseInt(path.Base(v.Name), 10, 64)
+	created, _ := time.Parse(time.RFC3339Nano, v.CreateTime)
+	return &mdb.KeyVersion{
+		Version: version,
+		State:   v.State,
+		Created: created.Unix(),
+		Primary: v.Name == primary,
+	}
+}
+
+// Add a version to a crypto key and make it the primary version
+func (d *gceDB) RotateKey(orgid, cryptoKey string) (*mdb.KeyVersion, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	cloudkmsService, err := newService()
+	if err != nil {
+		return nil, err
+	}
+	name := cryptoKeyName(orgid, cryptoKey)
+	v, err := cloudkmsService.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.Create(name, &cloudkms.CryptoKeyVersion{}).Do()
+	if err != nil {
+		return nil, err
+	}
+	_, err = cloudkmsService.Projects.Locations.KeyRings.CryptoKeys.UpdatePrimaryVersion(name, &cloudkms.UpdateCryptoKeyPrimaryVersionRequest{
+		CryptoKeyVersionId: path.Base(v.Name),
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return keyVersion(v, v.Name), nil
+}
+
+// List the versions of a crypto key, the latest first
+func (d *gceDB) ListKeyVersions(orgid, cryptoKey string) ([]*mdb.KeyVersion, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	cloudkmsService, err := newService()
+	if err != nil {
+		return nil, err
+	}
+	name := cryptoKeyName(orgid, cryptoKey)
+	key, err := cloudkmsService.Projects.Locations.KeyRings.CryptoKeys.Get(name).Do()
+	if err != nil {
+		return nil, err
+	}
+	primary := ""
+	if key.Primary != nil {
+		primary = key.Primary.Name
+	}
+	versions := []*mdb.KeyVersion{}
+	err = cloudkmsService.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.List(name).Pages(context.Background(), func(rsp *cloudkms.ListCryptoKeyVersionsResponse) error {
+		for _, v := range rsp.CryptoKeyVersions {
+			versions = append(versions, keyVersion(v, primary))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version > versions[j].Version })
+	return versions, nil
+}
+
+// Disable a version of a crypto key, the primary version can't be disabled
+func (d *gceDB) DisableKeyVersion(orgid, cryptoKey string, version int64) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	cloudkmsService, err := newService()
+	if err != nil {
+		return err
+	}
+	name := cryptoKeyName(orgid, cryptoKey)
+	key, err := cloudkmsService.Projects.Locations.KeyRings.CryptoKeys.Get(name).Do()
+	if err != nil {
+		return err
+	}
+	versionName := fmt.Sprintf("%s/cryptoKeyVersions/%d", name, version)
+	if key.Primary != nil && key.Primary.Name == versionName {
+		return micro_storage.ErrPrimaryVersion
+	}
+	_, err = cloudkmsService.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.Patch(versionName, &cloudkms.CryptoKeyVersion{
+		State: "DISABLED",
+	}).UpdateMask("state").Do()
+	return err
+}
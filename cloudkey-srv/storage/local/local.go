@@ -0,0 +1,407 @@
+// Package local is the storage of the environments without a cloud key management service. The crypto keys of the
+// organisations are random AES-256 keys wrapped by a master key with AES-GCM, their versions are records of the
+// cloudkey database of db-srv.
+package local
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"server/cloudkey-srv/db"
+	mdb "server/cloudkey-srv/proto/record"
+	micro_storage "server/cloudkey-srv/storage"
+	db_proto "server/db-srv/proto/db"
+
+	"golang.org/x/net/context"
+)
+
+var (
+	// MasterKeyFile is the file of the base64 encoded 32 bytes master key, MasterKeyEnv is read if it isn't set
+	MasterKeyFile = ""
+	MasterKeyEnv  = "CLOUDKEY_MASTER_KEY"
+
+	// DefaultCryptoKey is the crypto key of an organisation if none is requested
+	DefaultCryptoKey = "org"
+
+	// Database of the key versions
+	Database = &db_proto.Database{Name: db.DbCloudKeyName, Table: db.DbCloudKeyTable}
+
+	ErrMasterKey   = errors.New("master key is missing or invalid")
+	ErrWrappedKey  = errors.New("wrapped key is invalid")
+	ErrKeyDisabled = errors.New("crypto key version is disabled")
+)
+
+const (
+	StateEnabled  = "ENABLED"
+	StateDisabled = "DISABLED"
+)
+
+// maximum number of versions of a crypto key
+const maxVersions = 1000
+
+// keys caches the unwrapped key versions, their material never changes
+var keys = struct {
+	sync.RWMutex
+	m map[string][]byte
+}{m: map[string][]byte{}}
+
+type localDriver struct{}
+
+type localDB struct {
+	master cipher.AEAD
+}
+
+// KeyVersion is the parameter3 of the record of a key version
+type KeyVersion struct {
+	Version int64 `json:"version"`
+	// base64 of the key sealed by the master key
+	Key     string `json:"key"`
+	State   string `json:"state"`
+	Created int64  `json:"created"`
+}
+
+func init() {
+	micro_storage.Drivers["local"] = new(localDriver)
+}
+
+// masterKey reads the master key from MasterKeyFile or MasterKeyEnv
+func masterKey() ([]byte, error) {
+	encoded := os.Getenv(MasterKeyEnv)
+	if len(MasterKeyFile) > 0 {
+		b, err := ioutil.ReadFile(MasterKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		encoded = string(b)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(key) != 32 {
+		return nil, ErrMasterKey
+	}
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal returns the nonce followed by the ciphertext, additional binds it to a key
+func seal(aead cipher.AEAD, plaintext []byte, additional string) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, []byte(additional)), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte, additional string) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrWrappedKey
+	}
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(additional))
+}
+
+func (d *localDriver) NewStorage() (micro_storage.ST, error) {
+	key, err := masterKey()
+	if err != nil {
+		return nil, err
+	}
+	master, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &localDB{master: master}, nil
+}
+
+func (d *localDB) Init(mdb *mdb.Storage) error {
+	return nil
+}
+
+func (d *localDB) Close() error {
+	return nil
+}
+
+func cryptoKeyName(orgid, cryptoKey string) string {
+	if len(cryptoKey) == 0 {
+		cryptoKey = DefaultCryptoKey
+	}
+	return orgid + "/" + cryptoKey
+}
+
+// Versions returns the versions of a crypto key, the latest first
+func Versions(orgid, cryptoKey string) ([]*KeyVersion, error) {
+	versions, _, err := versionRecords(orgid, cryptoKey)
+	return versions, err
+}
+
+// versionRecords returns the versions of a crypto key and their records, the latest first
+func versionRecords(orgid, cryptoKey string) ([]*KeyVersion, []*db_proto.Record, error) {
+	rsp, err := db.ClientWrapper.Db_client.Search(context.TODO(), &db_proto.SearchRequest{
+		Database: Database,
+		Metadata: map[string]string{"name": cryptoKeyName(orgid, cryptoKey), "parameter1": orgid},
+		Limit:    maxVersions,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	versions := []*KeyVersion{}
+	records := rsp.Records
+	for _, r := range records {
+		v := &KeyVersion{}
+		if err := json.Unmarshal([]byte(r.Parameter3), v); err != nil {
+			return nil, nil, err
+		}
+		versions = append(versions, v)
+	}
+	for i := 1; i < len(versions); i++ {
+		for j := i; j > 0 && versions[j].Version > versions[j-1].Version; j-- {
+			versions[j], versions[j-1] = versions[j-1], versions[j]
+			records[j], records[j-1] = records[j-1], records[j]
+		}
+	}
+	return versions, records, nil
+}
+
+// latest returns the latest enabled version of a crypto key
+func latest(orgid, cryptoKey string) (*KeyVersion, error) {
+	versions, err := Versions(orgid, cryptoKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.State == StateEnabled {
+			return v, nil
+		}
+	}
+	return nil, micro_storage.ErrKeyNotFound
+}
+
+// versionId is the id of the record of a version, document keys can't contain a slash
+func versionId(orgid, cryptoKey string, version int64) string {
+	return fmt.Sprintf("%s:%d", strings.Replace(cryptoKeyName(orgid, cryptoKey), "/", ":", -1), version)
+}
+
+// createVersion writes a new random version of a crypto key
+func (d *localDB) createVersion(orgid, cryptoKey string, version int64) (*KeyVersion, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	id := versionId(orgid, cryptoKey, version)
+	sealed, err := seal(d.master, key, id)
+	if err != nil {
+		return nil, err
+	}
+	v := &KeyVersion{
+		Version: version,
+		Key:     base64.StdEncoding.EncodeToString(sealed),
+		State:   StateEnabled,
+		Created: time.Now().Unix(),
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	_, err = db.ClientWrapper.Db_client.Create(context.TODO(), &db_proto.CreateRequest{
+		Database: Database,
+		Record: &db_proto.Record{
+			Id:         id,
+			Created:    v.Created,
+			Updated:    v.Created,
+			Name:       cryptoKeyName(orgid, cryptoKey),
+			Parameter1: orgid,
+			Parameter2: strconv.FormatInt(version, 10),
+			Parameter3: string(body),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unwrap returns the key of a version
+func (d *localDB) unwrap(orgid, cryptoKey string, v *KeyVersion) ([]byte, error) {
+	id := versionId(orgid, cryptoKey, v.Version)
+	keys.RLock()
+	key, ok := keys.m[id]
+	keys.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(v.Key)
+	if err != nil {
+		return nil, err
+	}
+	key, err = open(d.master, sealed, id)
+	if err != nil {
+		return nil, err
+	}
+	keys.Lock()
+	keys.m[id] = key
+	keys.Unlock()
+	return key, nil
+}
+
+// CreateKey creates the first version of a crypto key, it does nothing if the key exists
+func (d *localDB) CreateKey(orgid, cryptoKey string) error {
+	versions, err := Versions(orgid, cryptoKey)
+	if err != nil || len(versions) > 0 {
+		return err
+	}
+	_, err = d.createVersion(orgid, cryptoKey, 1)
+	return err
+}
+
+// EncryptKey wraps a data encryption key with the latest enabled version of a crypto key, the version prefixes the
+// wrapped key
+func (d *localDB) EncryptKey(orgid, cryptoKey, dek string) (string, error) {
+	v, err := latest(orgid, cryptoKey)
+	if err != nil {
+		return "", err
+	}
+	key, err := d.unwrap(orgid, cryptoKey, v)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(aead, []byte(dek), cryptoKeyName(orgid, cryptoKey))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d:%s", v.Version, base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+// DecryptKey unwraps a data encryption key with the version of the crypto key which wrapped it
+func (d *localDB) DecryptKey(orgid, cryptoKey, encryptedDek string) (string, error) {
+	i := strings.Index(encryptedDek, ":")
+	if i < 0 {
+		return "", ErrWrappedKey
+	}
+	version, err := strconv.ParseInt(encryptedDek[:i], 10, 64)
+	if err != nil {
+		return "", ErrWrappedKey
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encryptedDek[i+1:])
+	if err != nil {
+		return "", ErrWrappedKey
+	}
+
+	versions, err := Versions(orgid, cryptoKey)
+	if err != nil {
+		return "", err
+	}
+	var v *KeyVersion
+	for _, kv := range versions {
+		if kv.Version == version {
+			v = kv
+		}
+	}
+	switch {
+	case v == nil:
+		return "", micro_storage.ErrKeyVersion
+	case v.State != StateEnabled:
+		return "", ErrKeyDisabled
+	}
+	key, err := d.unwrap(orgid, cryptoKey, v)
+	if err != nil {
+		return "", err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	dek, err := open(aead, sealed, cryptoKeyName(orgid, cryptoKey))
+	if err != nil {
+		return "", err
+	}
+	return string(dek), nil
+}
+
+// RotateKey adds a version to a crypto key, the new keys are wrapped with it
+func (d *localDB) RotateKey(orgid, cryptoKey string) (*mdb.KeyVersion, error) {
+	versions, err := Versions(orgid, cryptoKey)
+	if err != nil {
+		return nil, err
+	}
+	version := int64(1)
+	if len(versions) > 0 {
+		version = versions[0].Version + 1
+	}
+	v, err := d.createVersion(orgid, cryptoKey, version)
+	if err != nil {
+		return nil, err
+	}
+	return &mdb.KeyVersion{Version: v.Version, State: v.State, Created: v.Created, Primary: true}, nil
+}
+
+// ListKeyVersions returns the versions of a crypto key, the latest first, the latest enabled one is primary
+func (d *localDB) ListKeyVersions(orgid, cryptoKey string) ([]*mdb.KeyVersion, error) {
+	versions, err := Versions(orgid, cryptoKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, micro_storage.ErrKeyNotFound
+	}
+	list := []*mdb.KeyVersion{}
+	primary := false
+	for _, v := range versions {
+		kv := &mdb.KeyVersion{Version: v.Version, State: v.State, Created: v.Created}
+		if !primary && v.State == StateEnabled {
+			kv.Primary = true
+			primary = true
+		}
+		list = append(list, kv)
+	}
+	return list, nil
+}
+
+// DisableKeyVersion disables a version which isn't the primary one, the keys it wraps can't be unwrapped anymore
+func (d *localDB) DisableKeyVersion(orgid, cryptoKey string, version int64) error {
+	versions, records, err := versionRecords(orgid, cryptoKey)
+	if err != nil {
+		return err
+	}
+	primary := true
+	for i, v := range versions {
+		if v.Version != version {
+			primary = primary && v.State != StateEnabled
+			continue
+		}
+		if primary && v.State == StateEnabled {
+			return micro_storage.ErrPrimaryVersion
+		}
+		v.State = StateDisabled
+		body, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		r := records[i]
+		r.Parameter3 = string(body)
+		r.Updated = time.Now().Unix()
+		_, err = db.ClientWrapper.Db_client.Update(context.TODO(), &db_proto.UpdateRequest{Database: Database, Record: r})
+		return err
+	}
+	return micro_storage.ErrKeyVersion
+}
@@ -0,0 +1,112 @@
+package local_test
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"server/cloudkey-srv/storage"
+	"server/cloudkey-srv/storage/local"
+	"server/common/dbtest"
+)
+
+func newStorage(t *testing.T) storage.ST {
+	// dbtest serves the cloudkey service on this storage
+	dbtest.NewClient()
+	dbtest.Reset()
+	st, err := storage.Drivers["local"].NewStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func TestWrapKey(t *testing.T) {
+	st := newStorage(t)
+	defer st.Close()
+
+	if _, err := st.EncryptKey("org1", "", "dek"); err != storage.ErrKeyNotFound {
+		t.Errorf("Key must be created before wrapping: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := st.CreateKey("org1", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if versions, err := local.Versions("org1", ""); err != nil || len(versions) != 1 || versions[0].Version != 1 {
+		t.Fatalf("Key must be created once: %v %v", versions, err)
+	}
+
+	wrapped, err := st.EncryptKey("org1", "", "dek")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dek, err := st.DecryptKey("org1", "", wrapped); err != nil || dek != "dek" {
+		t.Errorf("Key must be unwrapped: %v %v", dek, err)
+	}
+	// the wrapped key is bound to its organisation
+	if err := st.CreateKey("org2", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.DecryptKey("org2", "", wrapped); err == nil {
+		t.Error("Key of another organisation must not be unwrapped")
+	}
+	if _, err := st.DecryptKey("org1", "", "2:"+wrapped[2:]); err != storage.ErrKeyVersion {
+		t.Errorf("Unknown version must not be unwrapped: %v", err)
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	st := newStorage(t)
+	defer st.Close()
+
+	if err := st.CreateKey("org1", ""); err != nil {
+		t.Fatal(err)
+	}
+	old, err := st.EncryptKey("org1", "", "dek")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := st.RotateKey("org1", "")
+	if err != nil || v.Version != 2 || !v.Primary {
+		t.Fatalf("Rotation must add the primary version: %v %v", v, err)
+	}
+	versions, err := st.ListKeyVersions("org1", "")
+	if err != nil || len(versions) != 2 || !versions[0].Primary || versions[1].Primary || versions[1].State != local.StateEnabled {
+		t.Errorf("Versions are invalid: %v %v", versions, err)
+	}
+	if wrapped, err := st.EncryptKey("org1", "", "dek"); err != nil || !strings.HasPrefix(wrapped, "2:") {
+		t.Errorf("Key must be wrapped by the primary version: %v %v", wrapped, err)
+	}
+
+	if err := st.DisableKeyVersion("org1", "", 2); err != storage.ErrPrimaryVersion {
+		t.Errorf("Primary version must not be disabled: %v", err)
+	}
+	if dek, err := st.DecryptKey("org1", "", old); err != nil || dek != "dek" {
+		t.Errorf("Key wrapped by the previous version must be unwrapped: %v %v", dek, err)
+	}
+	if err := st.DisableKeyVersion("org1", "", 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := st.DecryptKey("org1", "", old); err != local.ErrKeyDisabled {
+		t.Errorf("Key wrapped by a disabled version must not be unwrapped: %v", err)
+	}
+}
+
+func TestMasterKeyFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(base64.StdEncoding.EncodeToString([]byte("short")) + "\n")
+	f.Close()
+
+	local.MasterKeyFile = f.Name()
+	defer func() { local.MasterKeyFile = "" }()
+	if _, err := storage.Drivers["local"].NewStorage(); err != local.ErrMasterKey {
+		t.Errorf("Master key must be 32 bytes: %v", err)
+	}
+}
@@ -23,6 +23,10 @@ type ST interface {
 	CreateKey(orgid, cryptoKey string) (error)
 	EncryptKey(orgid, cryptoKey, dek string) (string, error)
 	DecryptKey(orgid, cryptoKey, encryptedDek string) (string, error)
+	// Versions of the crypto keys
+	RotateKey(orgid, cryptoKey string) (*mdb.KeyVersion, error)
+	ListKeyVersions(orgid, cryptoKey string) ([]*mdb.KeyVersion, error)
+	DisableKeyVersion(orgid, cryptoKey string, version int64) error
 }
 
 type storage struct {
@@ -40,11 +44,17 @@ var (
 	// Used to lookup the metadata for the driver
 	DBDriverKey = "driver"
 
-	// supported drivers: gce, aws
+	// supported drivers: gce, aws, local
 	Drivers = map[string]Driver{}
 
+	// Driver of the storages which don't name one
+	DefaultDriver = ""
+
 	// Errors
-	ErrNotAvailable = errors.New("not available")
+	ErrNotAvailable   = errors.New("not available")
+	ErrKeyNotFound    = errors.New("crypto key not found")
+	ErrKeyVersion     = errors.New("crypto key version not found")
+	ErrPrimaryVersion = errors.New("primary version of a crypto key can't be disabled")
 )
 
 func NewStorage() *storage {
@@ -60,6 +70,9 @@ func (d *storage) name(db *mdb.Storage) string {
 	if db != nil && len(db.Driver) != 0 {
 		return db.Driver
 	}
+	if _, ok := d.drivers[DefaultDriver]; ok {
+		return DefaultDriver
+	}
 	for k, _ := range(d.drivers){
 		return k
 	}
@@ -114,6 +127,33 @@ func (d *storage) DecryptKey(db *mdb.Storage, orgid, cryptoKey, encryptedDek str
 	return dr.DecryptKey(orgid, cryptoKey, encryptedDek)
 }
 
+func (d *storage) RotateKey(db *mdb.Storage, orgid, cryptoKey string) (*mdb.KeyVersion, error) {
+	dr, err := d.lookup(db)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+	return dr.RotateKey(orgid, cryptoKey)
+}
+
+func (d *storage) ListKeyVersions(db *mdb.Storage, orgid, cryptoKey string) ([]*mdb.KeyVersion, error) {
+	dr, err := d.lookup(db)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+	return dr.ListKeyVersions(orgid, cryptoKey)
+}
+
+func (d *storage) DisableKeyVersion(db *mdb.Storage, orgid, cryptoKey string, version int64) error {
+	dr, err := d.lookup(db)
+	if err != nil {
+		return err
+	}
+	defer dr.Close()
+	return dr.DisableKeyVersion(orgid, cryptoKey, version)
+}
+
 func Init() error {
 	DefaultStorage = NewStorage()
 	return nil
@@ -130,3 +170,15 @@ func EncryptKey(db *mdb.Storage, orgid, cryptoKey, dek string) (string, error) {
 func DecryptKey(db *mdb.Storage, orgid, cryptoKey, encryptedDek string) (string, error) {
 	return DefaultStorage.DecryptKey(db, orgid, cryptoKey, encryptedDek)
 }
+
+func RotateKey(db *mdb.Storage, orgid, cryptoKey string) (*mdb.KeyVersion, error) {
+	return DefaultStorage.RotateKey(db, orgid, cryptoKey)
+}
+
+func ListKeyVersions(db *mdb.Storage, orgid, cryptoKey string) ([]*mdb.KeyVersion, error) {
+	return DefaultStorage.ListKeyVersions(db, orgid, cryptoKey)
+}
+
+func DisableKeyVersion(db *mdb.Storage, orgid, cryptoKey string, version int64) error {
+	return DefaultStorage.DisableKeyVersion(db, orgid, cryptoKey, version)
+}
@@ -0,0 +1,174 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	db_proto "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/broker"
+)
+
+// ChangeHandler processes a change feed event, the checkpoint moves past the event when it returns nil
+type ChangeHandler func(*db_proto.ChangeEvent) error
+
+// number of events read per ReadChanges call when catching up
+var changesPage int64 = 500
+
+// ChangeConsumer delivers the change events of a collection in order, resuming after its checkpoint
+type ChangeConsumer struct {
+	sync.Mutex
+	name       string
+	collection string
+	client     db_proto.DBClient
+	fn         ChangeHandler
+	sequence   int64
+	subscriber broker.Subscriber
+}
+
+// SubscribeChanges delivers the events of a collection to fn, name identifies the consumer's checkpoint
+// the events missed since the checkpoint are read from db-srv before the live ones
+//
+//	c, err := common.SubscribeChanges(ctx, brk, dbClient, "search-indexer", common.DbGoalTable, index)
+//	defer c.Unsubscribe()
+func SubscribeChanges(ctx context.Context, b broker.Broker, client db_proto.DBClient, name, collection string, fn ChangeHandler) (*ChangeConsumer, error) {
+	c := &ChangeConsumer{
+		name:       name,
+		collection: collection,
+		client:     client,
+		fn:         fn,
+	}
+	seq, err := c.checkpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.sequence = seq
+
+	// live events wait until the catch up is done, the ones read twice are skipped by sequence
+	c.Lock()
+	defer c.Unlock()
+	c.subscriber, err = b.Subscribe(ChangeTopic(collection), func(p broker.Publication) error {
+		e := &db_proto.ChangeEvent{}
+		if err := json.Unmarshal(p.Message().Body, e); err != nil {
+			return err
+		}
+		c.Lock()
+		defer c.Unlock()
+		return c.handle(context.Background(), e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.catchUp(ctx); err != nil {
+		c.subscriber.Unsubscribe()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Sequence returns the sequence of the last processed event
+func (c *ChangeConsumer) Sequence() int64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.sequence
+}
+
+// Unsubscribe stops the delivery of the events
+func (c *ChangeConsumer) Unsubscribe() error {
+	return c.subscriber.Unsubscribe()
+}
+
+func (c *ChangeConsumer) catchUp(ctx context.Context) error {
+	for {
+		rsp, err := c.client.ReadChanges(ctx, &db_proto.ReadChangesRequest{
+			Collection:   c.collection,
+			FromSequence: c.sequence,
+			Limit:        changesPage,
+		})
+		if err != nil {
+			return err
+		}
+		for _, e := range rsp.Events {
+			if err := c.handle(ctx, e); err != nil {
+				return err
+			}
+		}
+		if int64(len(rsp.Events)) < changesPage {
+			return nil
+		}
+	}
+}
+
+// handle runs the handler once per event and saves the checkpoint, c must be locked
+func (c *ChangeConsumer) handle(ctx context.Context, e *db_proto.ChangeEvent) error {
+	if e.Sequence <= c.sequence {
+		return nil
+	}
+	if err := c.fn(e); err != nil {
+		return err
+	}
+	c.sequence = e.Sequence
+	return c.saveCheckpoint(ctx)
+}
+
+func (c *ChangeConsumer) key() string {
+	return c.name + "." + c.collection
+}
+
+func checkpointDatabase() *db_proto.Database {
+	return &db_proto.Database{
+		Name:   DbHealumName,
+		Table:  DbChangeCheckpointTable,
+		Driver: DbHealumDriver,
+	}
+}
+
+// sequences are stored as strings, they don't fit in a json number
+func (c *ChangeConsumer) checkpoint(ctx context.Context) (int64, error) {
+	bindVars := BindVars{}
+	q := fmt.Sprintf(`
+		FOR doc IN %s
+		FILTER doc._key == %s
+		RETURN doc`, DbChangeCheckpointTable, bindVars.Add("key", c.key()))
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return 0, err
+	}
+	rsp, err := c.client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: checkpointDatabase(),
+		Query:    q,
+		BindVars: vars,
+	})
+	if err != nil || len(rsp.Records) == 0 {
+		return 0, err
+	}
+	return strconv.ParseInt(rsp.Records[0].Parameter1, 10, 64)
+}
+
+func (c *ChangeConsumer) saveCheckpoint(ctx context.Context) error {
+	bindVars := BindVars{}
+	q := fmt.Sprintf(`
+		UPSERT { _key: %s }
+		INSERT { _key: %s, name: %s, parameter1: %s }
+		UPDATE { parameter1: %s }
+		IN %s`,
+		bindVars.Add("key", c.key()),
+		bindVars.Add("key", c.key()),
+		bindVars.Add("key", c.key()),
+		bindVars.Add("sequence", strconv.FormatInt(c.sequence, 10)),
+		bindVars.Add("sequence", strconv.FormatInt(c.sequence, 10)),
+		DbChangeCheckpointTable)
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return err
+	}
+	_, err = c.client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: checkpointDatabase(),
+		Query:    q,
+		BindVars: vars,
+	})
+	return err
+}
@@ -217,6 +217,89 @@ func QuerySort(sortParameter, sortDirection string, document ...string) string {
 	return fmt.Sprintf(`SORT %v.data.%v %v`, doc, sortParameter, sortDirection)
 }
 
+// BindVars collects the bind variables of a parameterized query
+// values are json encoded by Encode before being sent to db-srv
+type BindVars map[string]interface{}
+
+// Add binds value to name and returns the parameter to be used in the query
+func (b BindVars) Add(name string, value interface{}) string {
+	b[name] = value
+	return "@" + name
+}
+
+// Encode returns the bind variables for RunQueryRequest.BindVars
+func (b BindVars) Encode() (map[string]string, error) {
+	vars := map[string]string{}
+	for k, v := range b {
+		body, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		vars[k] = string(body)
+	}
+	return vars, nil
+}
+
+// QueryAuthBind is QueryAuth with orgId and teamId passed as bind variables
+func QueryAuthBind(query, orgId, teamId string, bindVars BindVars) string {
+	if len(orgId) != 0 {
+		query += fmt.Sprintf(` && doc.parameter1 == %s`, bindVars.Add("auth_org_id", orgId))
+	}
+	if len(teamId) != 0 {
+		query += fmt.Sprintf(` && doc.parameter2 == %s`, bindVars.Add("auth_team_id", teamId))
+	}
+
+	return QueryClean(query)
+}
+
+// QueryReplaceBind returns a query replacing the document of id by a record and returning it, the created time of
+// the document is kept and nothing is returned if it doesn't exist in the organisation. The replace fails with a
+// conflict if revision is set and the document was written since.
+func QueryReplaceBind(table, id, record, revision, orgId, teamId string, bindVars BindVars) string {
+	query := fmt.Sprintf(`FILTER doc._key == %s`, bindVars.Add("id", id))
+	query = QueryAuthBind(query, orgId, teamId, bindVars)
+	replace := `{_key: doc._key, created: doc.created}`
+	if len(revision) > 0 {
+		replace = fmt.Sprintf(`{_key: doc._key, created: doc.created, _rev: %s}`, bindVars.Add("revision", revision))
+	}
+	return fmt.Sprintf(`
+		FOR doc IN %v
+		%s
+		REPLACE MERGE(%s, %s) IN %v OPTIONS { ignoreRevs: %v }
+		RETURN NEW`, table, query, bindVars.Add("record", json.RawMessage(record)), replace, table, len(revision) == 0)
+}
+
+// QueryPaginateBind is QueryPaginate with offset and limit passed as bind variables
+func QueryPaginateBind(offset, limit int64, bindVars BindVars) string {
+	if limit == 0 {
+		limit = 10
+	}
+	return fmt.Sprintf("LIMIT %s, %s", bindVars.Add("page_offset", offset), bindVars.Add("page_limit", limit))
+}
+
+// QuerySortBind is QuerySort with every attribute of sortParameter passed as a bind variable
+func QuerySortBind(sortParameter, sortDirection string, bindVars BindVars, document ...string) string {
+	doc := "doc"
+	if len(document) > 0 {
+		doc = document[0]
+	}
+
+	if sortDirection != "ASC" && sortDirection != "DESC" {
+		sortDirection = "DESC"
+	}
+	// default sort query
+	if len(sortParameter) == 0 {
+		return fmt.Sprintf("SORT %v.data.updated DESC", doc)
+	}
+
+	// doc.data.@sort_0.@sort_1 for "a.b"
+	attrs := []string{}
+	for i, attr := range strings.Split(sortParameter, ".") {
+		attrs = append(attrs, bindVars.Add(fmt.Sprintf("sort_%d", i), attr))
+	}
+	return fmt.Sprintf(`SORT %v.data.%v %v`, doc, strings.Join(attrs, "."), sortDirection)
+}
+
 func QueryStringFromArray(arr []string) string {
 	strs := []string{}
 	for _, s := range arr {
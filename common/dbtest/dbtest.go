@@ -0,0 +1,144 @@
+// Package dbtest runs the db service in process on the in-memory driver, so the service tests can run without
+// consul, nats or arangodb. A service test initialises its db package with the client of NewClient in place of
+// the nats client:
+//
+//	db.Init(dbtest.NewClient())
+//
+// The db service is started once and shared by the clients of the test binary. Registry, transport and broker are
+// the go-micro mocks, published events are delivered in process. Only the db service and the cloudkey service on its local
+// storage, with TestMasterKey as master key, are served, tests calling other services still need them running,
+// and searches of the elastic driver aren't available.
+package dbtest
+
+import (
+	"context"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	cloudkey_db "server/cloudkey-srv/db"
+	cloudkey_handler "server/cloudkey-srv/handler"
+	cloudkey_proto "server/cloudkey-srv/proto/record"
+	"server/cloudkey-srv/storage"
+	"server/cloudkey-srv/storage/local"
+	"server/common"
+	"server/db-srv/db"
+	"server/db-srv/db/memory"
+	"server/db-srv/handler"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/broker"
+	mock_broker "github.com/micro/go-micro/broker/mock"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/registry"
+	mock_registry "github.com/micro/go-micro/registry/mock"
+	"github.com/micro/go-micro/selector"
+	"github.com/micro/go-micro/server"
+	"github.com/micro/go-micro/transport"
+	mock_transport "github.com/micro/go-micro/transport/mock"
+)
+
+// addresses of the db and cloudkey services on the mock transport
+const (
+	address    = "dbtest:1"
+	keyAddress = "dbtest:2"
+)
+
+// TestMasterKey is the master key of the local storage of the cloudkey service
+const TestMasterKey = "ZGJ0ZXN0LW1hc3Rlci1rZXktMDEyMzQ1Njc4OWFiY2Q="
+
+var (
+	once sync.Once
+
+	reg    registry.Registry
+	trans  transport.Transport
+	brk    broker.Broker
+	driver = memory.NewDriver()
+)
+
+// start registers the memory driver as the driver of the healum database and serves the db service
+func start() {
+	reg = mock_registry.NewRegistry()
+	trans = mock_transport.NewTransport()
+	brk = mock_broker.NewBroker()
+	if err := brk.Connect(); err != nil {
+		log.Fatal(err)
+	}
+
+	// the services request the arangodb driver, the node of the driver is served from memory
+	db.Drivers[common.DbHealumDriver] = driver
+	if err := reg.Register(&registry.Service{
+		Name: db.DBServiceNamespace + "." + common.DbHealumDriver,
+		Nodes: []*registry.Node{{
+			Id:       "dbtest-" + common.DbHealumDriver,
+			Address:  "memory",
+			Metadata: map[string]string{db.DBDriverKey: common.DbHealumDriver},
+		}},
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := db.Init(selector.NewSelector(selector.Registry(reg))); err != nil {
+		log.Fatal(err)
+	}
+
+	srv := server.NewServer(
+		server.Name(common.DbSrv),
+		server.Address(address),
+		server.Registry(reg),
+		server.Transport(trans),
+		server.Broker(brk),
+	)
+	dbService := &handler.DB{}
+	if err := dbService.InitDb(context.TODO(), &mdb.InitDbRequest{}, &mdb.InitDbResponse{}); err != nil {
+		log.Fatal(err)
+	}
+	mdb.RegisterDBHandler(srv, handler.NewTenantWrapper(handler.NewNamespaceWrapper(handler.NewWrapper(dbService))))
+	if err := srv.Start(); err != nil {
+		log.Fatal(err)
+	}
+	if err := srv.Register(); err != nil {
+		log.Fatal(err)
+	}
+
+	keySrv := server.NewServer(
+		server.Name(common.CloudKeySrv),
+		server.Address(keyAddress),
+		server.Registry(reg),
+		server.Transport(trans),
+		server.Broker(brk),
+	)
+	// the key versions are stored in the memory driver too
+	os.Setenv(local.MasterKeyEnv, TestMasterKey)
+	local.Database.Driver = common.DbHealumDriver
+	storage.DefaultDriver = "local"
+	storage.Init()
+	cloudkey_db.ClientWrapper = cloudkey_db.NewClientWrapper(newClient())
+	cloudkey_proto.RegisterCloudKeyServiceHandler(keySrv, &cloudkey_handler.CloudKeyService{Broker: brk})
+	if err := keySrv.Start(); err != nil {
+		log.Fatal(err)
+	}
+	if err := keySrv.Register(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// NewClient starts the db service if it isn't running and returns a client of it
+func NewClient() client.Client {
+	once.Do(start)
+	return newClient()
+}
+
+func newClient() client.Client {
+	return client.NewClient(
+		client.Registry(reg),
+		client.Transport(trans),
+		client.Broker(brk),
+		client.RequestTimeout(4*time.Second),
+	)
+}
+
+// Reset removes the documents of every database, tests call it to start from empty collections
+func Reset() {
+	driver.Reset()
+}
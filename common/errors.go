@@ -1,6 +1,9 @@
 package common
 
 import (
+	"fmt"
+	"net/http"
+
 	"github.com/micro/go-micro/errors"
 )
 
@@ -28,6 +31,22 @@ func NotFound(id string, fun interface{}, err error, format string, a ...interfa
 	return errors.NotFound(id, format, a)
 }
 
+// Conflict generates a 409 error.
+func Conflict(id string, fun interface{}, err error, format string, a ...interface{}) error {
+	ErrorLog(id, fun, err, format)
+	return errors.New(id, fmt.Sprintf(format, a...), http.StatusConflict)
+}
+
+// IsConflict reports whether err is a 409 error, db-srv returns it for the writes of an outdated revision
+func IsConflict(err error) bool {
+	return err != nil && errors.Parse(err.Error()).Code == http.StatusConflict
+}
+
+// IsNotFound reports whether err is a 404 error
+func IsNotFound(err error) bool {
+	return err != nil && errors.Parse(err.Error()).Code == http.StatusNotFound
+}
+
 // InternalServerError generates a 500 error.
 func InternalServerError(id string, fun interface{}, err error, format string, a ...interface{}) error {
 	ErrorLog(id, fun, err, format)
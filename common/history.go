@@ -0,0 +1,372 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	db_proto "server/db-srv/proto/db"
+	static_proto "server/static-srv/proto/static"
+
+	"github.com/golang/protobuf/jsonpb"
+)
+
+// ErrVersionNotFound is returned for the versions which aren't in the history of the organisation
+var ErrVersionNotFound = errors.New("version not found")
+
+// ErrVersionCollection is returned for the collections a service doesn't version
+var ErrVersionCollection = errors.New("collection is not versioned by the service")
+
+// ErrVersionOrg is returned when the versions are requested without the organisation of the document
+var ErrVersionOrg = errors.New("organisation of the versions is required")
+
+// saveVersionAttempts bounds the saves of a version raced by the saves of the same document
+const saveVersionAttempts = 3
+
+// the attributes changed by every write, a write changing only them doesn't save a version
+var unversionedPaths = map[string]bool{
+	"updated":      true,
+	"data.updated": true,
+}
+
+// history entries are generic records: _key is "<collection>:<id>:<version>", parameter1 the organisation of the
+// document, parameter2 its collection and data keeps the document with the changes since the previous version
+const versionRecord = `{
+	id: h._key,
+	created: h.created,
+	name: h.name,
+	parameter1: h.parameter1,
+	parameter2: h.parameter2,
+	data: UNSET(h.data, "document")
+}`
+
+// versionEntry is the data of a history entry
+type versionEntry struct {
+	DocumentId string                        `json:"document_id"`
+	Version    int64                         `json:"version"`
+	AuthorId   string                        `json:"author_id"`
+	Changes    []*static_proto.VersionChange `json:"changes"`
+	Document   map[string]interface{}        `json:"document,omitempty"`
+}
+
+// VersionKey returns the key of a version in the history collection
+func VersionKey(table, id string, version int64) string {
+	return fmt.Sprintf("%s:%s:%d", table, id, version)
+}
+
+// QueryLatestVersion returns an expression of the latest version of a document, null if it has none. The
+// collection and the id are added to bindVars.
+func QueryLatestVersion(table, id string, bindVars BindVars) string {
+	return fmt.Sprintf(`FIRST(
+		FOR h IN %v
+		FILTER h.parameter2 == %s && h.data.document_id == %s
+		SORT h.data.version DESC
+		LIMIT 1
+		RETURN h.data.version
+	)`, DbHistoryTable, bindVars.Add("version_collection", table), bindVars.Add("version_document_id", id))
+}
+
+// QueryPinVersion returns an expression of the record of a share edge with the latest version of the shared
+// document, so the share keeps the version the user was given
+func QueryPinVersion(record, table, id string, bindVars BindVars) string {
+	return fmt.Sprintf(`MERGE_RECURSIVE(%s, {data: {version: NOT_NULL(%s, 0)}})`, record, QueryLatestVersion(table, id, bindVars))
+}
+
+func runHistoryQuery(ctx context.Context, client db_proto.DBClient, table, q string, bindVars BindVars) ([]*db_proto.Record, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: healumDatabase(table),
+		Query:    q,
+		BindVars: vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Records, nil
+}
+
+// RecordToVersion converts a record of the history
+func RecordToVersion(r *db_proto.Record) (*static_proto.Version, error) {
+	v := &static_proto.Version{}
+	if len(r.Parameter3) > 0 {
+		u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+		if err := u.Unmarshal(strings.NewReader(r.Parameter3), v); err != nil {
+			return nil, err
+		}
+	}
+	v.Id = r.Id
+	v.Collection = r.Parameter2
+	v.OrgId = r.Parameter1
+	v.Created = r.Created
+	return v, nil
+}
+
+// Diff returns the changes between two documents, the attributes of nested objects are compared one by one
+// and the other values as a whole. Changes are sorted by path.
+func Diff(old, new map[string]interface{}) []*static_proto.VersionChange {
+	changes := []*static_proto.VersionChange{}
+	diff("", old, new, &changes)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func diff(prefix string, old, new map[string]interface{}, changes *[]*static_proto.VersionChange) {
+	keys := map[string]bool{}
+	for k := range old {
+		keys[k] = true
+	}
+	for k := range new {
+		keys[k] = true
+	}
+	for k := range keys {
+		path := k
+		if len(prefix) > 0 {
+			path = prefix + "." + k
+		}
+		o, n := old[k], new[k]
+		om, ok1 := o.(map[string]interface{})
+		nm, ok2 := n.(map[string]interface{})
+		if ok1 && ok2 {
+			diff(path, om, nm, changes)
+			continue
+		}
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+		*changes = append(*changes, &static_proto.VersionChange{
+			Path:     path,
+			OldValue: encodeValue(o),
+			NewValue: encodeValue(n),
+		})
+	}
+}
+
+// encodeValue returns the json of a changed value, an empty string if the attribute doesn't exist
+func encodeValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(body)
+}
+
+// SaveVersion snapshots a document in its history with the changes since its previous version, the version is
+// saved only if the document changed. It returns the latest version of the document, 0 if it doesn't exist.
+// Concurrent saves of a document don't fail, the save which lost the race is compared again with the new version.
+func SaveVersion(ctx context.Context, client db_proto.DBClient, table, id, authorId string) (int64, error) {
+	for attempt := 1; ; attempt++ {
+		version, err := saveVersion(ctx, client, table, id, authorId)
+		if err != errVersionRaced || attempt == saveVersionAttempts {
+			return version, err
+		}
+	}
+}
+
+// errVersionRaced is returned when another version of the document was saved since the previous one was read
+var errVersionRaced = errors.New("version was saved concurrently")
+
+func saveVersion(ctx context.Context, client db_proto.DBClient, table, id, authorId string) (int64, error) {
+	bindVars := BindVars{}
+	q := fmt.Sprintf(`
+		FOR doc IN %v
+		FILTER doc._key == %s
+		LET last = FIRST(
+			FOR h IN %v
+			FILTER h.parameter2 == %s && h.data.document_id == doc._key
+			SORT h.data.version DESC
+			LIMIT 1
+			RETURN h.data
+		)
+		RETURN {
+			id: doc._key,
+			name: doc.name,
+			parameter1: doc.parameter1,
+			data: {document: UNSET(doc, "_id", "_rev"), last: last}
+		}`, table, bindVars.Add("id", id), DbHistoryTable, bindVars.Add("collection", table))
+	records, err := runHistoryQuery(ctx, client, table, q, bindVars)
+	if err != nil || len(records) == 0 {
+		return 0, err
+	}
+	current := struct {
+		Document map[string]interface{} `json:"document"`
+		Last     *versionEntry          `json:"last"`
+	}{}
+	if err := json.Unmarshal([]byte(records[0].Parameter3), &current); err != nil {
+		return 0, err
+	}
+
+	entry := &versionEntry{
+		DocumentId: id,
+		Version:    1,
+		AuthorId:   authorId,
+		Changes:    []*static_proto.VersionChange{},
+		Document:   current.Document,
+	}
+	if current.Last != nil {
+		changed := false
+		for _, c := range Diff(current.Last.Document, current.Document) {
+			if unversionedPaths[c.Path] {
+				continue
+			}
+			changed = true
+			entry.Changes = append(entry.Changes, c)
+		}
+		if !changed {
+			return current.Last.Version, nil
+		}
+		entry.Version = current.Last.Version + 1
+	}
+
+	// the entry is inserted only if the latest version is still the one it was compared with, in the
+	// transaction which checks it
+	key := VersionKey(table, id, entry.Version)
+	now := time.Now().Unix()
+	bindVars = BindVars{}
+	q = fmt.Sprintf(`
+		LET latest = NOT_NULL(%s, 0)
+		FILTER latest == %s
+		INSERT %s INTO %v
+		RETURN {id: NEW._key}`,
+		QueryLatestVersion(table, id, bindVars), bindVars.Add("previous", entry.Version-1),
+		bindVars.Add("entry", map[string]interface{}{
+			"_key":       key,
+			"id":         key,
+			"created":    now,
+			"updated":    now,
+			"name":       records[0].Name,
+			"parameter1": records[0].Parameter1,
+			"parameter2": table,
+			"data":       entry,
+		}), DbHistoryTable)
+	tx := NewTransaction(DbHistoryTable)
+	tx.Read = []string{table}
+	if err := tx.Add(q, bindVars); err != nil {
+		return 0, err
+	}
+	results, err := tx.Run(ctx, client)
+	if IsConflict(err) {
+		// the key of the version was inserted by another save
+		return 0, errVersionRaced
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 || len(results[0].Records) == 0 {
+		return 0, errVersionRaced
+	}
+	return entry.Version, nil
+}
+
+// ListVersions returns the versions of a document in the organisation, the latest first
+func ListVersions(ctx context.Context, client db_proto.DBClient, table, id, orgId string, offset, limit int64) ([]*static_proto.Version, error) {
+	if len(orgId) == 0 {
+		return nil, ErrVersionOrg
+	}
+	bindVars := BindVars{}
+	q := fmt.Sprintf(`
+		FOR h IN %v
+		FILTER h.parameter2 == %s && h.data.document_id == %s && h.parameter1 == %s
+		SORT h.data.version DESC
+		%s
+		RETURN %s`, DbHistoryTable, bindVars.Add("collection", table), bindVars.Add("id", id),
+		bindVars.Add("org_id", orgId), QueryPaginateBind(offset, limit, bindVars), versionRecord)
+	records, err := runHistoryQuery(ctx, client, DbHistoryTable, q, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	versions := []*static_proto.Version{}
+	for _, r := range records {
+		v, err := RecordToVersion(r)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, nil
+}
+
+// ReadVersion returns a version of a document in the organisation and the record of the document as it was,
+// it returns ErrVersionNotFound if the version doesn't exist
+func ReadVersion(ctx context.Context, client db_proto.DBClient, table, id, orgId string, version int64) (*static_proto.Version, *db_proto.Record, error) {
+	if len(orgId) == 0 {
+		return nil, nil, ErrVersionOrg
+	}
+	bindVars := BindVars{}
+	q := fmt.Sprintf(`
+		FOR h IN %v
+		FILTER h._key == %s && h.parameter1 == %s
+		RETURN MERGE(%s, {data: h.data})`,
+		DbHistoryTable, bindVars.Add("key", VersionKey(table, id, version)), bindVars.Add("org_id", orgId), versionRecord)
+	records, err := runHistoryQuery(ctx, client, DbHistoryTable, q, bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, ErrVersionNotFound
+	}
+	return recordToVersionDocument(records[0])
+}
+
+// recordToVersionDocument splits a history record returned with its document, the document record has the
+// data of the document in parameter3 like the records of the collection
+func recordToVersionDocument(r *db_proto.Record) (*static_proto.Version, *db_proto.Record, error) {
+	v, err := RecordToVersion(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the document is an unknown attribute of the version
+	doc := struct {
+		Document map[string]interface{} `json:"document"`
+	}{}
+	if err := json.Unmarshal([]byte(r.Parameter3), &doc); err != nil {
+		return nil, nil, err
+	}
+	body, err := json.Marshal(doc.Document["data"])
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, &db_proto.Record{Id: v.DocumentId, Parameter3: string(body)}, nil
+}
+
+// RevertToVersion replaces a document of the organisation by one of its versions and saves it as a new version,
+// it returns ErrVersionNotFound if the version or the document doesn't exist. The returned version is the new one.
+func RevertToVersion(ctx context.Context, client db_proto.DBClient, table, id, orgId, authorId string, version int64) (*static_proto.Version, *db_proto.Record, error) {
+	if len(orgId) == 0 {
+		return nil, nil, ErrVersionOrg
+	}
+	bindVars := BindVars{}
+	q := fmt.Sprintf(`
+		FOR h IN %v
+		FILTER h._key == %s && h.parameter1 == %s
+		FOR doc IN %v
+		FILTER doc._key == h.data.document_id
+		REPLACE MERGE(h.data.document, {_key: doc._key, updated: %s}) IN %v
+		RETURN {id: NEW._key}`,
+		DbHistoryTable, bindVars.Add("key", VersionKey(table, id, version)), bindVars.Add("org_id", orgId),
+		table, bindVars.Add("now", time.Now().Unix()), table)
+	records, err := runHistoryQuery(ctx, client, table, q, bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, ErrVersionNotFound
+	}
+	latest, err := SaveVersion(ctx, client, table, id, authorId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ReadVersion(ctx, client, table, id, orgId, latest)
+}
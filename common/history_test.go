@@ -0,0 +1,139 @@
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"server/common"
+	"server/common/dbtest"
+	db_proto "server/db-srv/proto/db"
+)
+
+func newDBClient() db_proto.DBClient {
+	dbtest.Reset()
+	return db_proto.NewDBClient("", dbtest.NewClient())
+}
+
+func writeGoal(t *testing.T, client db_proto.DBClient, title string) {
+	bindVars := common.BindVars{}
+	q := `UPSERT {_key: "g1"}
+		INSERT {_key: "g1", id: "g1", updated: @updated, name: @title, parameter1: "org1", data: {title: @title}}
+		UPDATE {updated: @updated, name: @title, data: {title: @title}}
+		IN ` + common.DbGoalTable
+	bindVars.Add("title", title)
+	bindVars.Add("updated", len(title))
+	vars, err := bindVars.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.RunQuery(common.NewTenantContext(context.TODO(), "org1"), &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{Name: common.DbHealumName, Table: common.DbGoalTable, Driver: common.DbHealumDriver},
+		Query:    q,
+		BindVars: vars,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	changes := common.Diff(
+		map[string]interface{}{"name": "a", "data": map[string]interface{}{"tags": []interface{}{"x"}, "title": "a"}},
+		map[string]interface{}{"name": "a", "data": map[string]interface{}{"tags": []interface{}{"x", "y"}}},
+	)
+	if len(changes) != 2 {
+		t.Fatalf("Changes are invalid: %v", changes)
+	}
+	if changes[0].Path != "data.tags" || changes[0].OldValue != `["x"]` || changes[0].NewValue != `["x","y"]` {
+		t.Errorf("Array change is invalid: %v", changes[0])
+	}
+	if changes[1].Path != "data.title" || changes[1].OldValue != `"a"` || changes[1].NewValue != "" {
+		t.Errorf("Removed attribute change is invalid: %v", changes[1])
+	}
+}
+
+func TestVersions(t *testing.T) {
+	client := newDBClient()
+	ctx := common.NewTenantContext(context.TODO(), "org1")
+
+	writeGoal(t, client, "first")
+	if v, err := common.SaveVersion(ctx, client, common.DbGoalTable, "g1", "u1"); err != nil || v != 1 {
+		t.Fatalf("First version is invalid: %v %v", v, err)
+	}
+	// only updated changes
+	if v, err := common.SaveVersion(ctx, client, common.DbGoalTable, "g1", "u1"); err != nil || v != 1 {
+		t.Fatalf("Unchanged document must not be versioned: %v %v", v, err)
+	}
+	writeGoal(t, client, "second")
+	if v, err := common.SaveVersion(ctx, client, common.DbGoalTable, "g1", "u2"); err != nil || v != 2 {
+		t.Fatalf("Second version is invalid: %v %v", v, err)
+	}
+
+	versions, err := common.ListVersions(ctx, client, common.DbGoalTable, "g1", "org1", 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 2 || versions[0].Version != 2 || versions[0].AuthorId != "u2" || versions[0].Collection != common.DbGoalTable {
+		t.Fatalf("Versions are invalid: %v", versions)
+	}
+	if c := versions[0].Changes; len(c) != 2 || c[0].Path != "data.title" || c[1].Path != "name" {
+		t.Errorf("Changes are invalid: %v", c)
+	}
+	if versions, _ := common.ListVersions(ctx, client, common.DbGoalTable, "g1", "org2", 0, 10); len(versions) != 0 {
+		t.Errorf("Versions of another organisation must not be listed: %v", versions)
+	}
+
+	v, r, err := common.ReadVersion(ctx, client, common.DbGoalTable, "g1", "org1", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != 1 || r.Id != "g1" || r.Parameter3 != `{"title":"first"}` {
+		t.Errorf("Read version is invalid: %v %v", v, r)
+	}
+	if _, _, err := common.ReadVersion(ctx, client, common.DbGoalTable, "g1", "org1", 3); err != common.ErrVersionNotFound {
+		t.Errorf("Missing version must not be found: %v", err)
+	}
+
+	v, r, err = common.RevertToVersion(ctx, client, common.DbGoalTable, "g1", "org1", "u3", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Version != 3 || v.AuthorId != "u3" || r.Parameter3 != `{"title":"first"}` {
+		t.Errorf("Reverted version is invalid: %v %v", v, r)
+	}
+	if _, _, err := common.RevertToVersion(ctx, client, common.DbGoalTable, "g1", "org2", "u3", 1); err != common.ErrVersionNotFound {
+		t.Errorf("Version of another organisation must not be reverted: %v", err)
+	}
+}
+
+func TestConcurrentVersions(t *testing.T) {
+	client := newDBClient()
+	ctx := common.NewTenantContext(context.TODO(), "org1")
+
+	writeGoal(t, client, "first")
+	if _, err := common.SaveVersion(ctx, client, common.DbGoalTable, "g1", "u1"); err != nil {
+		t.Fatal(err)
+	}
+	writeGoal(t, client, "second")
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			v, err := common.SaveVersion(ctx, client, common.DbGoalTable, "g1", "u2")
+			if err == nil && v != 2 {
+				err = fmt.Errorf("version %v is invalid", v)
+			}
+			errs <- err
+		}()
+	}
+	for i := 0; i < cap(errs); i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("Concurrent save failed: %v", err)
+		}
+	}
+	if versions, _ := common.ListVersions(ctx, client, common.DbGoalTable, "g1", "org1", 0, 10); len(versions) != 2 {
+		t.Errorf("Concurrent saves must save one version: %v", versions)
+	}
+	if _, err := common.ListVersions(ctx, client, common.DbGoalTable, "g1", "", 0, 10); err != common.ErrVersionOrg {
+		t.Errorf("Versions without organisation must not be listed: %v", err)
+	}
+}
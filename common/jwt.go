@@ -0,0 +1,150 @@
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+)
+
+// types of the tokens issued by account-srv
+const (
+	// AccessToken authenticates the requests of the api, it is verified with the signing key only
+	AccessToken = "access"
+	// RefreshToken is exchanged once for a new pair of tokens by account-srv
+	RefreshToken = "refresh"
+)
+
+var (
+	// TokenKeyEnv is the environment variable of the base64 encoded HMAC-SHA256 key of the tokens, account-srv and
+	// the api must share it
+	TokenKeyEnv = "TOKEN_SIGNING_KEY"
+
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+
+	ErrTokenKey     = errors.New("token signing key is missing or invalid")
+	ErrTokenInvalid = errors.New("token is invalid")
+	ErrTokenExpired = errors.New("token is expired")
+)
+
+// minimum length of the signing key
+const minTokenKeyLength = 32
+
+// header of the tokens, only HS256 is issued and accepted
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// TokenClaims are the claims of the JWT tokens of account-srv
+type TokenClaims struct {
+	Id   string `json:"jti"`
+	Type string `json:"typ"`
+	// Session is shared by the tokens of a login and its refreshes, revoking it revokes all of them
+	Session   string `json:"sid"`
+	AccountId string `json:"sub"`
+	UserId    string `json:"user_id"`
+	OrgId     string `json:"org_id"`
+	// Employee is the employee id of the user in the organisation, empty if the user isn't an employee
+	Employee  string `json:"employee,omitempty"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// TokensEnabled checks if the signing key of the tokens is set, the api only accepts sessions without it
+func TokensEnabled() bool {
+	_, err := tokenKey()
+	return err == nil
+}
+
+// tokenKey reads the signing key from TokenKeyEnv
+func tokenKey() ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(os.Getenv(TokenKeyEnv)))
+	if err != nil || len(key) < minTokenKeyLength {
+		return nil, ErrTokenKey
+	}
+	return key, nil
+}
+
+// NewTokenClaims returns the claims of a new token of typ issued at now, the other claims are copied from c
+func NewTokenClaims(c *TokenClaims, typ string, now time.Time) *TokenClaims {
+	ttl := AccessTokenTTL
+	if typ == RefreshToken {
+		ttl = RefreshTokenTTL
+	}
+	return &TokenClaims{
+		Id:        uuid.NewUUID().String(),
+		Type:      typ,
+		Session:   c.Session,
+		AccountId: c.AccountId,
+		UserId:    c.UserId,
+		OrgId:     c.OrgId,
+		Employee:  c.Employee,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	}
+}
+
+func signToken(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// SignToken returns the signed JWT of claims
+func SignToken(claims *TokenClaims) (string, error) {
+	key, err := tokenKey()
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	payload := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(body)
+	return payload + "." + signToken(key, payload), nil
+}
+
+// VerifyToken checks the signature, the type and the expiry of a JWT and returns its claims
+func VerifyToken(token, typ string, now time.Time) (*TokenClaims, error) {
+	key, err := tokenKey()
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return nil, ErrTokenInvalid
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signToken(key, payload))) {
+		return nil, ErrTokenInvalid
+	}
+	body, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrTokenInvalid
+	}
+	claims := &TokenClaims{}
+	if err := json.Unmarshal(body, claims); err != nil {
+		return nil, ErrTokenInvalid
+	}
+	if claims.Type != typ || len(claims.Id) == 0 || len(claims.Session) == 0 {
+		return nil, ErrTokenInvalid
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	return claims, nil
+}
+
+// BearerToken returns the token of an Authorization: Bearer header, or an empty string
+func BearerToken(authorization string) string {
+	parts := strings.SplitN(strings.TrimSpace(authorization), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(parts[1])
+}
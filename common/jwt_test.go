@@ -0,0 +1,60 @@
+package common
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToken(t *testing.T) {
+	os.Setenv(TokenKeyEnv, base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	defer os.Unsetenv(TokenKeyEnv)
+
+	now := time.Now()
+	claims := NewTokenClaims(&TokenClaims{Session: "s1", AccountId: "a1", UserId: "u1", OrgId: "org1", Employee: "e1"}, AccessToken, now)
+	token, err := SignToken(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verified, err := VerifyToken(token, AccessToken, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *verified != *claims {
+		t.Errorf("Claims don't match: %v %v", verified, claims)
+	}
+	if _, err := VerifyToken(token, RefreshToken, now); err != ErrTokenInvalid {
+		t.Errorf("Access token must not be accepted as refresh token: %v", err)
+	}
+	if _, err := VerifyToken(token, AccessToken, now.Add(AccessTokenTTL)); err != ErrTokenExpired {
+		t.Errorf("Expired token must be rejected: %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	forged := NewTokenClaims(claims, AccessToken, now)
+	forged.OrgId = "org2"
+	other, _ := SignToken(forged)
+	if _, err := VerifyToken(parts[0]+"."+strings.Split(other, ".")[1]+"."+parts[2], AccessToken, now); err != ErrTokenInvalid {
+		t.Errorf("Token with changed claims must be rejected: %v", err)
+	}
+
+	os.Setenv(TokenKeyEnv, base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 32))))
+	if _, err := VerifyToken(token, AccessToken, now); err != ErrTokenInvalid {
+		t.Errorf("Token signed with another key must be rejected: %v", err)
+	}
+	os.Unsetenv(TokenKeyEnv)
+	if _, err := VerifyToken(token, AccessToken, now); err != ErrTokenKey || TokensEnabled() {
+		t.Errorf("Tokens must be disabled without key: %v", err)
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	for h, token := range map[string]string{"Bearer abc": "abc", "bearer  abc ": "abc", "Basic abc": "", "": ""} {
+		if BearerToken(h) != token {
+			t.Errorf("%q: token is invalid: %q", h, BearerToken(h))
+		}
+	}
+}
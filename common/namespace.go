@@ -0,0 +1,7 @@
+// +build !production
+
+package common
+
+// TestNamespacesEnabled lets the api and db-srv serve the test namespaces of the requests, the builds with the
+// production tag ignore them
+const TestNamespacesEnabled = true
@@ -0,0 +1,6 @@
+// +build production
+
+package common
+
+// TestNamespacesEnabled is false, a production service never serves a test namespace
+const TestNamespacesEnabled = false
@@ -0,0 +1,698 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cloudkey_proto "server/cloudkey-srv/proto/record"
+	db_proto "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/client"
+)
+
+// PIIField is an encrypted field of the data of the documents of a collection. The path is dotted, the arrays on
+// the way are walked. A blind indexed field gets a <field>_bidx sibling for the exact match lookups, a prefix indexed
+// field a <field>_prefix_bidx sibling with the blind indexes of its prefixes for the autocompletes.
+type PIIField struct {
+	Path        string
+	BlindIndex  bool
+	PrefixIndex bool
+}
+
+// PIIFields are the encrypted fields per collection. The measurements of the users are track markers, their numeric
+// values are kept in the marker series of influxdb which aggregates them, so they aren't encrypted.
+var PIIFields = map[string][]PIIField{
+	DbUserTable: {
+		{Path: "firstname", BlindIndex: true, PrefixIndex: true},
+		{Path: "lastname", BlindIndex: true, PrefixIndex: true},
+		{Path: "dob"},
+		{Path: "addresses.addressLocality"},
+		{Path: "addresses.addressRegion"},
+		{Path: "addresses.postalCode", BlindIndex: true},
+		{Path: "addresses.streetAddress"},
+		{Path: "addresses.address1"},
+		{Path: "addresses.address2"},
+		{Path: "addresses.city"},
+		{Path: "addresses.county"},
+		{Path: "addresses.postcode", BlindIndex: true},
+		{Path: "contact_details.value", BlindIndex: true},
+	},
+	DbTrackMarkerTable: {{Path: "value"}},
+	DbNoteTable: {
+		{Path: "title", BlindIndex: true},
+		{Path: "description"},
+	},
+	DbResponseTable: {{Path: "answers.data"}},
+}
+
+// PIIDefaultOrg owns the data encryption key of the documents without organisation
+var PIIDefaultOrg = "default"
+
+// PIIPrefixLength is the length of the longest indexed prefix, the longer prefixes are matched on their start
+var PIIPrefixLength = 10
+
+// DataKeyTTL bounds how long an unwrapped data encryption key is kept in memory
+var DataKeyTTL = time.Hour
+
+// ErrPIICiphertext is returned for the encrypted values which can't be parsed
+var ErrPIICiphertext = errors.New("pii ciphertext is invalid")
+
+// encrypted values are "pii:<org_id>:<key version>:<ciphertext>" strings
+const piiPrefix = "pii:"
+
+// suffix of the blind index fields, they are removed from the decrypted documents
+const blindIndexSuffix = "_bidx"
+
+// suffix of the prefix index fields
+const prefixIndexSuffix = "_prefix" + blindIndexSuffix
+
+// piiValue is the plaintext of an encrypted value, the field it is encrypted for is sealed with it so the value is
+// only decrypted in that field
+type piiValue struct {
+	Collection string      `json:"collection"`
+	Path       string      `json:"path"`
+	Value      interface{} `json:"value"`
+}
+
+// at checks that the value is decrypted in the field it was encrypted for, path are the names of the objects from
+// the root of the document. The documents embedded by the queries hold the field under a longer path.
+func (v *piiValue) at(path []string) bool {
+	known := false
+	for _, f := range PIIFields[v.Collection] {
+		known = known || f.Path == v.Path
+	}
+	field := strings.Split(v.Path, ".")
+	if !known || len(path) < len(field) {
+		return false
+	}
+	for i, name := range field {
+		if path[len(path)-len(field)+i] != name {
+			return false
+		}
+	}
+	return true
+}
+
+type dataKey struct {
+	version int64
+	key     string
+	expires time.Time
+}
+
+// dataKeyEntry is the data of the documents of DbDataKeyTable
+type dataKeyEntry struct {
+	OrgId   string `json:"org_id"`
+	Version int64  `json:"version"`
+	// base64 of the data encryption key wrapped by cloudkey-srv
+	WrappedKey string `json:"wrapped_key"`
+	Created    int64  `json:"created"`
+}
+
+// PII encrypts the PIIFields of the documents with a data encryption key per organisation. The keys are generated
+// on the first write of an organisation, wrapped by cloudkey-srv and stored in DbDataKeyTable.
+//
+//	pii := common.NewPII(serviceClient)
+//	dbClient := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, pii))
+//	err := pii.EncryptFields(ctx, orgId, common.DbUserTable, data)
+type PII struct {
+	sync.Mutex
+	keys cloudkey_proto.CloudKeyServiceClient
+	db   db_proto.DBClient
+	// by org_id and by org_id/version
+	current  map[string]*dataKey
+	versions map[string]*dataKey
+}
+
+// NewPII returns a PII using cloudkey-srv and db-srv with the client
+func NewPII(c client.Client) *PII {
+	return newPII(cloudkey_proto.NewCloudKeyServiceClient(CloudKeySrv, c), db_proto.NewDBClient("", c))
+}
+
+func newPII(keys cloudkey_proto.CloudKeyServiceClient, db db_proto.DBClient) *PII {
+	return &PII{
+		keys:     keys,
+		db:       db,
+		current:  map[string]*dataKey{},
+		versions: map[string]*dataKey{},
+	}
+}
+
+func piiOrg(orgId string) string {
+	if len(orgId) == 0 {
+		return PIIDefaultOrg
+	}
+	return orgId
+}
+
+func (p *PII) runQuery(ctx context.Context, q string, bindVars BindVars) ([]*db_proto.Record, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	// the keys aren't searchable
+	rsp, err := p.db.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{Name: DbHealumName, Table: DbDataKeyTable, Driver: DbHealumDriver},
+		Query:    q,
+		BindVars: vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Records, nil
+}
+
+// readKey reads and unwraps the data encryption key of an organisation, the latest if version is 0
+func (p *PII) readKey(ctx context.Context, orgId string, version int64) (*dataKey, error) {
+	bindVars := BindVars{}
+	query := fmt.Sprintf(`FILTER k.parameter1 == %s`, bindVars.Add("org_id", orgId))
+	if version > 0 {
+		query += fmt.Sprintf(` && k.data.version == %s`, bindVars.Add("version", version))
+	}
+	q := fmt.Sprintf(`
+		FOR k IN %v
+		%s
+		SORT k.data.version DESC
+		LIMIT 1
+		RETURN k`, DbDataKeyTable, query)
+	records, err := p.runQuery(ctx, q, bindVars)
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	entry := &dataKeyEntry{}
+	if err := json.Unmarshal([]byte(records[0].Parameter3), entry); err != nil {
+		return nil, err
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(entry.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := p.keys.DecryptKey(ctx, &cloudkey_proto.DecryptKeyRequest{Orgid: orgId, Dek: string(wrapped)})
+	if err != nil {
+		return nil, err
+	}
+	return &dataKey{version: entry.Version, key: rsp.EncryptedDek}, nil
+}
+
+// createKey generates a data encryption key version of an organisation, the key of a concurrent write wins
+func (p *PII) createKey(ctx context.Context, orgId string, version int64) (*dataKey, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(b)
+	rsp, err := p.keys.EncryptKey(ctx, &cloudkey_proto.EncryptKeyRequest{Orgid: orgId, Dek: key})
+	if err != nil {
+		// the key ring of the organisation may not exist yet
+		if _, err := p.keys.CreateKey(ctx, &cloudkey_proto.CreateKeyRequest{Orgid: orgId}); err != nil {
+			return nil, err
+		}
+		if rsp, err = p.keys.EncryptKey(ctx, &cloudkey_proto.EncryptKeyRequest{Orgid: orgId, Dek: key}); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().Unix()
+	id := fmt.Sprintf("%s-%d", orgId, version)
+	bindVars := BindVars{}
+	q := fmt.Sprintf(`INSERT %s INTO %v OPTIONS { ignoreErrors: true }`, bindVars.Add("key", map[string]interface{}{
+		"_key":       id,
+		"id":         id,
+		"created":    now,
+		"updated":    now,
+		"parameter1": orgId,
+		"data": &dataKeyEntry{
+			OrgId:      orgId,
+			Version:    version,
+			WrappedKey: base64.StdEncoding.EncodeToString([]byte(rsp.EncryptedDek)),
+			Created:    now,
+		},
+	}), DbDataKeyTable)
+	if _, err := p.runQuery(ctx, q, bindVars); err != nil {
+		return nil, err
+	}
+	k, err := p.readKey(ctx, orgId, 0)
+	if err == nil && k == nil {
+		err = ErrNotFound
+	}
+	return k, err
+}
+
+// key returns the data encryption key of an organisation, the latest if version is 0. The latest key is created if
+// the organisation has none.
+func (p *PII) key(ctx context.Context, orgId string, version int64) (*dataKey, error) {
+	orgId = piiOrg(orgId)
+	id := fmt.Sprintf("%s/%d", orgId, version)
+	now := time.Now()
+	p.Lock()
+	k, ok := p.current[orgId]
+	if version > 0 {
+		k, ok = p.versions[id]
+	}
+	p.Unlock()
+	if ok && now.Before(k.expires) {
+		return k, nil
+	}
+
+	k, err := p.readKey(ctx, orgId, version)
+	if err != nil {
+		return nil, err
+	}
+	if k == nil {
+		if version > 0 {
+			return nil, ErrNotFound
+		}
+		if k, err = p.createKey(ctx, orgId, 1); err != nil {
+			return nil, err
+		}
+	}
+	k.expires = now.Add(DataKeyTTL)
+
+	p.Lock()
+	defer p.Unlock()
+	if version == 0 {
+		p.current[orgId] = k
+	}
+	p.versions[fmt.Sprintf("%s/%d", orgId, k.version)] = k
+	return k, nil
+}
+
+// Invalidate removes the data encryption keys of an organisation from memory, all of them if orgId is empty
+func (p *PII) Invalidate(orgId string) {
+	p.Lock()
+	defer p.Unlock()
+	if len(orgId) == 0 {
+		p.current = map[string]*dataKey{}
+		p.versions = map[string]*dataKey{}
+		return
+	}
+	orgId = piiOrg(orgId)
+	delete(p.current, orgId)
+	for id := range p.versions {
+		if strings.HasPrefix(id, orgId+"/") {
+			delete(p.versions, id)
+		}
+	}
+}
+
+// RotateDataKey adds a data encryption key version to an organisation, the fields are encrypted with it from now on.
+// The services keep encrypting with the previous version until their key expires.
+func (p *PII) RotateDataKey(ctx context.Context, orgId string) (int64, error) {
+	orgId = piiOrg(orgId)
+	k, err := p.readKey(ctx, orgId, 0)
+	if err != nil {
+		return 0, err
+	}
+	version := int64(1)
+	if k != nil {
+		version = k.version + 1
+	}
+	p.Invalidate(orgId)
+	if k, err = p.createKey(ctx, orgId, version); err != nil {
+		return 0, err
+	}
+	return k.version, nil
+}
+
+// indexKey returns the key of the blind indexes of an organisation, its first data encryption key so the indexes
+// don't change when the key is rotated
+func (p *PII) indexKey(ctx context.Context, orgId string) (*dataKey, error) {
+	k, err := p.key(ctx, orgId, 0)
+	if err != nil || k.version == 1 {
+		return k, err
+	}
+	return p.key(ctx, orgId, 1)
+}
+
+// normalise returns the indexed string of a value, strings are compared ignoring case and spaces around
+func normalise(value interface{}) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		body, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		s = string(body)
+	}
+	return strings.ToLower(strings.TrimSpace(s)), nil
+}
+
+// indexHash returns the keyed hash of a normalised string
+func indexHash(k *dataKey, s string) string {
+	derive := hmac.New(sha256.New, []byte(k.key))
+	derive.Write([]byte("blind index"))
+	mac := hmac.New(sha256.New, derive.Sum(nil))
+	mac.Write([]byte(s))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// blindIndex returns the keyed hash of a normalised value
+func blindIndex(k *dataKey, value interface{}) (string, error) {
+	s, err := normalise(value)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(k, s), nil
+}
+
+// prefixIndexes returns the keyed hashes of the prefixes of a normalised value, up to PIIPrefixLength characters
+func prefixIndexes(k *dataKey, value interface{}) ([]string, error) {
+	s, err := normalise(value)
+	if err != nil {
+		return nil, err
+	}
+	r := []rune(s)
+	indexes := []string{}
+	for n := 1; n <= len(r) && n <= PIIPrefixLength; n++ {
+		indexes = append(indexes, indexHash(k, string(r[:n])))
+	}
+	return indexes, nil
+}
+
+// BlindIndex returns the value of the <field>_bidx of a blind indexed field matching value in the organisation
+func (p *PII) BlindIndex(ctx context.Context, orgId string, value interface{}) (string, error) {
+	k, err := p.indexKey(ctx, orgId)
+	if err != nil {
+		return "", err
+	}
+	return blindIndex(k, value)
+}
+
+// PrefixIndex returns the item of the <field>_prefix_bidx of a prefix indexed field starting with prefix in the
+// organisation, the prefixes longer than PIIPrefixLength match on their start
+func (p *PII) PrefixIndex(ctx context.Context, orgId string, prefix interface{}) (string, error) {
+	k, err := p.indexKey(ctx, orgId)
+	if err != nil {
+		return "", err
+	}
+	s, err := normalise(prefix)
+	if err != nil {
+		return "", err
+	}
+	if r := []rune(s); len(r) > PIIPrefixLength {
+		s = string(r[:PIIPrefixLength])
+	}
+	return indexHash(k, s), nil
+}
+
+// seal returns the encrypted string of a value with the key of an organisation
+func seal(k *dataKey, orgId string, v *piiValue) (string, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := EncryptData(k.key, string(body))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s:%d:%s", piiPrefix, orgId, k.version, sealed), nil
+}
+
+// EncryptFields encrypts the PIIFields of the collection in the data of a document of an organisation, the empty
+// values are kept. The values looking encrypted are encrypted too, a user can't write a ciphertext in a field.
+func (p *PII) EncryptFields(ctx context.Context, orgId, collection string, data map[string]interface{}) error {
+	fields, ok := PIIFields[collection]
+	if !ok {
+		return nil
+	}
+	k, err := p.key(ctx, orgId, 0)
+	if err != nil {
+		return err
+	}
+	index, err := p.indexKey(ctx, orgId)
+	if err != nil {
+		return err
+	}
+	orgId = piiOrg(orgId)
+	for _, f := range fields {
+		err := encryptPath(data, strings.Split(f.Path, "."), func(parent map[string]interface{}, name string, value interface{}) error {
+			if s, ok := value.(string); value == nil || (ok && len(s) == 0) {
+				return nil
+			}
+			if f.BlindIndex {
+				bidx, err := blindIndex(index, value)
+				if err != nil {
+					return err
+				}
+				parent[name+blindIndexSuffix] = bidx
+			}
+			if f.PrefixIndex {
+				indexes, err := prefixIndexes(index, value)
+				if err != nil {
+					return err
+				}
+				parent[name+prefixIndexSuffix] = indexes
+			}
+			sealed, err := seal(k, orgId, &piiValue{Collection: collection, Path: f.Path, Value: value})
+			if err != nil {
+				return err
+			}
+			parent[name] = sealed
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReencryptFields encrypts the PIIFields of the collection which aren't encrypted with the latest key of the
+// organisation again with it, it returns whether the data changed. The blind indexes don't change.
+func (p *PII) ReencryptFields(ctx context.Context, orgId, collection string, data map[string]interface{}) (bool, error) {
+	fields, ok := PIIFields[collection]
+	if !ok {
+		return false, nil
+	}
+	k, err := p.key(ctx, orgId, 0)
+	if err != nil {
+		return false, err
+	}
+	orgId = piiOrg(orgId)
+	latest := fmt.Sprintf("%s%s:%d:", piiPrefix, orgId, k.version)
+	changed := false
+	for _, f := range fields {
+		err := encryptPath(data, strings.Split(f.Path, "."), func(parent map[string]interface{}, name string, value interface{}) error {
+			s, ok := value.(string)
+			if !ok || !strings.HasPrefix(s, piiPrefix) || strings.HasPrefix(s, latest) {
+				return nil
+			}
+			valueOrg, plain, err := p.decrypt(ctx, s)
+			if err != nil {
+				return err
+			}
+			if valueOrg != orgId {
+				return ErrPIICiphertext
+			}
+			sealed, err := seal(k, orgId, plain)
+			if err != nil {
+				return err
+			}
+			parent[name] = sealed
+			changed = true
+			return nil
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+	return changed, nil
+}
+
+// encryptPath calls fn with the values of a path and the objects holding them
+func encryptPath(v interface{}, path []string, fn func(parent map[string]interface{}, name string, value interface{}) error) error {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		value, ok := t[path[0]]
+		if !ok {
+			return nil
+		}
+		if len(path) == 1 {
+			return fn(t, path[0], value)
+		}
+		return encryptPath(value, path[1:], fn)
+	case []interface{}:
+		for _, value := range t {
+			if err := encryptPath(value, path, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseCiphertext returns the organisation, the key version and the ciphertext of an encrypted string
+func parseCiphertext(s string) (string, int64, string, error) {
+	// the organisation may have colons, the version and ciphertext don't
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return "", 0, "", ErrPIICiphertext
+	}
+	j := strings.LastIndex(s[:i], ":")
+	if j < len(piiPrefix) {
+		return "", 0, "", ErrPIICiphertext
+	}
+	version, err := strconv.ParseInt(s[j+1:i], 10, 64)
+	if err != nil || version < 1 {
+		return "", 0, "", ErrPIICiphertext
+	}
+	return s[len(piiPrefix):j], version, s[i+1:], nil
+}
+
+// decrypt returns the organisation and the value of an encrypted string
+func (p *PII) decrypt(ctx context.Context, s string) (string, *piiValue, error) {
+	orgId, version, ciphertext, err := parseCiphertext(s)
+	if err != nil {
+		return "", nil, err
+	}
+	k, err := p.key(ctx, orgId, version)
+	if err != nil {
+		return "", nil, err
+	}
+	body, err := DecryptData(k.key, ciphertext)
+	if err != nil {
+		return "", nil, ErrPIICiphertext
+	}
+	v := &piiValue{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return "", nil, ErrPIICiphertext
+	}
+	return orgId, v, nil
+}
+
+// readable checks that the request of ctx can decrypt the values of an organisation, the requests of an
+// organisation only decrypt its values and the platform level requests every value
+func readable(ctx context.Context, orgId string) bool {
+	tenant, admin := TenantFromContext(ctx)
+	return admin || orgId == piiOrg(tenant)
+}
+
+// open returns the value of an encrypted string at path, the string is kept if it isn't a value of an organisation
+// readable by the request encrypted for the field at path
+func (p *PII) open(ctx context.Context, s string, path []string) (interface{}, error) {
+	orgId, _, _, err := parseCiphertext(s)
+	if err != nil || !readable(ctx, orgId) {
+		return s, nil
+	}
+	_, v, err := p.decrypt(ctx, s)
+	if err == ErrPIICiphertext || err == ErrNotFound {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !v.at(path) {
+		return s, nil
+	}
+	return v.Value, nil
+}
+
+// decryptValue decrypts the encrypted strings of a value whose objects are at path, and removes the blind indexes
+func (p *PII) decryptValue(ctx context.Context, v interface{}, path []string) (interface{}, error) {
+	switch t := v.(type) {
+	case string:
+		if strings.HasPrefix(t, piiPrefix) {
+			return p.open(ctx, t, path)
+		}
+	case map[string]interface{}:
+		for k, value := range t {
+			if strings.HasSuffix(k, blindIndexSuffix) {
+				delete(t, k)
+				continue
+			}
+			d, err := p.decryptValue(ctx, value, append(path[:len(path):len(path)], k))
+			if err != nil {
+				return nil, err
+			}
+			t[k] = d
+		}
+	case []interface{}:
+		for i, value := range t {
+			d, err := p.decryptValue(ctx, value, path)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = d
+		}
+	}
+	return v, nil
+}
+
+// DecryptJSON decrypts the encrypted values of a json document in the PIIFields they were encrypted for, so the
+// documents embedding encrypted documents are decrypted too. Only the values of the organisation of the request are
+// decrypted, the other values are kept encrypted.
+func (p *PII) DecryptJSON(ctx context.Context, body string) (string, error) {
+	if !strings.Contains(body, `"`+piiPrefix) && !strings.Contains(body, blindIndexSuffix+`"`) {
+		return body, nil
+	}
+	var v interface{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return "", err
+	}
+	v, err := p.decryptValue(ctx, v, nil)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// DecryptRecords decrypts the data of records
+func (p *PII) DecryptRecords(ctx context.Context, records []*db_proto.Record) error {
+	for _, r := range records {
+		if r == nil || len(r.Parameter3) == 0 {
+			continue
+		}
+		body, err := p.DecryptJSON(ctx, r.Parameter3)
+		if err != nil {
+			return err
+		}
+		r.Parameter3 = body
+	}
+	return nil
+}
+
+type piiClient struct {
+	client.Client
+	pii *PII
+}
+
+// NewPIIClient returns a client decrypting the records returned by db-srv, the service db layers read the
+// decrypted documents with it
+func NewPIIClient(c client.Client, pii *PII) client.Client {
+	return &piiClient{Client: c, pii: pii}
+}
+
+func (c *piiClient) Call(ctx context.Context, req client.Request, rsp interface{}, opts ...client.CallOption) error {
+	if err := c.Client.Call(ctx, req, rsp, opts...); err != nil {
+		return err
+	}
+	switch r := rsp.(type) {
+	case *db_proto.RunQueryResponse:
+		return c.pii.DecryptRecords(ctx, r.Records)
+	case *db_proto.SearchResponse:
+		return c.pii.DecryptRecords(ctx, r.Records)
+	case *db_proto.ReadResponse:
+		return c.pii.DecryptRecords(ctx, []*db_proto.Record{r.Record})
+	}
+	return nil
+}
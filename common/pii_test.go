@@ -0,0 +1,153 @@
+package common_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"server/common"
+	"server/common/dbtest"
+	db_proto "server/db-srv/proto/db"
+)
+
+func userData() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        "u1",
+		"firstname": "Alice",
+		"lastname":  "",
+		"dob":       "631152000",
+		"gender":    "FEMALE",
+		"contact_details": []interface{}{
+			map[string]interface{}{"id": "c1", "type": "EMAIL", "value": "alice@example.com"},
+		},
+	}
+}
+
+func runUserQuery(t *testing.T, client db_proto.DBClient, q string, bindVars common.BindVars) []*db_proto.Record {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp, err := client.RunQuery(common.NewAdminContext(context.TODO()), &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{Name: common.DbHealumName, Table: common.DbUserTable, Driver: common.DbHealumDriver},
+		Query:    q,
+		BindVars: vars,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rsp.Records
+}
+
+func TestPII(t *testing.T) {
+	dbtest.Reset()
+	c := dbtest.NewClient()
+	pii := common.NewPII(c)
+	ctx := context.TODO()
+
+	data := userData()
+	if err := pii.EncryptFields(ctx, "org1", common.DbUserTable, data); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := data["firstname"].(string); !strings.HasPrefix(s, "pii:org1:1:") || data["firstname_bidx"] == nil {
+		t.Errorf("Firstname must be encrypted and blind indexed: %v", data)
+	}
+	if data["lastname"] != "" || data["gender"] != "FEMALE" {
+		t.Errorf("Empty and not sensitive fields must be kept: %v", data)
+	}
+	contact := data["contact_details"].([]interface{})[0].(map[string]interface{})
+	if s, _ := contact["value"].(string); !strings.HasPrefix(s, "pii:") || contact["type"] != "EMAIL" {
+		t.Errorf("Contact value must be encrypted: %v", contact)
+	}
+
+	raw := db_proto.NewDBClient("", c)
+	bindVars := common.BindVars{}
+	runUserQuery(t, raw, `INSERT {_key: "u1", id: "u1", parameter1: "org1", data: `+bindVars.Add("data", data)+`} INTO `+common.DbUserTable, bindVars)
+
+	// lookup by blind index, read with a key read from the database
+	bidx, err := pii.BlindIndex(ctx, "org1", " alice@EXAMPLE.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypting := db_proto.NewDBClient("", common.NewPIIClient(c, common.NewPII(c)))
+	bindVars = common.BindVars{}
+	records := runUserQuery(t, decrypting, `
+		FOR doc IN `+common.DbUserTable+`
+		FILTER `+bindVars.Add("bidx", bidx)+` IN doc.data.contact_details[*].value_bidx
+		RETURN doc`, bindVars)
+	if len(records) != 1 {
+		t.Fatalf("User must be found by its contact: %v", records)
+	}
+	read := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(records[0].Parameter3), &read); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read, userData()) {
+		t.Errorf("User must be decrypted without blind indexes: %v", read)
+	}
+
+	// the keys are per organisation
+	other, err := pii.BlindIndex(ctx, "org2", "alice@example.com")
+	if err != nil || other == bidx {
+		t.Errorf("Blind index must depend on the organisation: %v %v", other, err)
+	}
+	if records := runUserQuery(t, raw, `FOR k IN `+common.DbDataKeyTable+` RETURN k`, nil); len(records) != 2 {
+		t.Errorf("A key must be created per organisation: %v", records)
+	}
+}
+
+func TestPIIDecryptScope(t *testing.T) {
+	dbtest.Reset()
+	pii := common.NewPII(dbtest.NewClient())
+	ctx := common.NewTenantContext(context.TODO(), "org1")
+
+	data := userData()
+	data["lastname"] = "pii:org2:1:abc"
+	if err := pii.EncryptFields(ctx, "org1", common.DbUserTable, data); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := data["lastname"].(string); !strings.HasPrefix(s, "pii:org1:1:") {
+		t.Errorf("Value looking encrypted must be encrypted: %v", data)
+	}
+	pidx, err := pii.PrefixIndex(ctx, "org1", "AL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if indexes, _ := data["firstname_prefix_bidx"].([]string); len(indexes) != 5 || indexes[1] != pidx {
+		t.Errorf("Firstname must be prefix indexed: %v", data)
+	}
+
+	// the values are decrypted in their field, also in the embedded documents
+	firstname := data["firstname"]
+	body, _ := json.Marshal(map[string]interface{}{
+		"user":   data,
+		"gender": firstname,
+	})
+	decrypt := func(ctx context.Context) map[string]interface{} {
+		decrypted, err := pii.DecryptJSON(ctx, string(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		read := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(decrypted), &read); err != nil {
+			t.Fatal(err)
+		}
+		return read
+	}
+	read := decrypt(ctx)
+	user := read["user"].(map[string]interface{})
+	if user["firstname"] != "Alice" || user["lastname"] != "pii:org2:1:abc" || user["firstname_prefix_bidx"] != nil {
+		t.Errorf("Embedded user must be decrypted: %v", user)
+	}
+	if read["gender"] != firstname {
+		t.Errorf("Value copied to another field must not be decrypted: %v", read)
+	}
+
+	// the values of another organisation are kept encrypted
+	read = decrypt(common.NewTenantContext(context.TODO(), "org2"))
+	if user := read["user"].(map[string]interface{}); user["firstname"] != firstname {
+		t.Errorf("Value of another organisation must not be decrypted: %v", user)
+	}
+}
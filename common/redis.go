@@ -11,6 +11,8 @@ const (
 	CLOUD_TAGS_INDEX         = 7  // will store tags for goal, challenge, hate, plan, content and survey tag info
 	TRACK_INDEX              = 10 // will store track info for track-srv
 	USERAPP_INDEX            = 11 // will store track info fror userapp-srv
+	TOKEN_REVOKED_INDEX      = 12 // will store revoked token ids and token sessions until they expire
+	RATE_LIMIT_INDEX         = 13 // will store request counts of the api rate limits until their window ends
 
 	PLAN      = "plan"
 	GOAL      = "goal"
@@ -32,6 +32,7 @@ var (
 	DbSrv           = "go.micro.srv.db"
 	EmailSrv        = "go.micro.srv.email"
 	AuditSrv        = "go.micro.srv.audit"
+	CloudKeySrv     = "healum.srv.cloudkey"
 )
 
 // ErrorLog logs error
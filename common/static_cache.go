@@ -0,0 +1,248 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	static_proto "server/static-srv/proto/static"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/broker"
+	"github.com/micro/go-os/metrics"
+)
+
+// ErrStaticCollection is returned for the collections which aren't cached reference collections
+var ErrStaticCollection = errors.New("collection is not a static reference collection")
+
+// StaticCacheTTL bounds how long a record is cached, in case its change event was missed
+var StaticCacheTTL = 10 * time.Minute
+
+// StaticCollections are the reference collections of static-srv cached by the services
+var StaticCollections = []string{
+	DbMarkerTable,
+	DbTrackerMethodTable,
+	DbBehaviourCategoryTable,
+	DbContentCategoryTable,
+}
+
+// the cached collections embedding the records of a collection, a change invalidates them too
+var staticDependents = map[string][]string{
+	DbTrackerMethodTable:         {DbMarkerTable, DbBehaviourCategoryTable, DbContentCategoryTable},
+	DbMarkerTable:                {DbBehaviourCategoryTable},
+	DbBehaviourCategoryAimTable:  {DbBehaviourCategoryTable},
+	DbContentParentCategoryTable: {DbContentCategoryTable},
+}
+
+// StaticChanges checks if the changes of collection invalidate cached records, its own or the ones embedding it
+func StaticChanges(collection string) bool {
+	if _, ok := staticDependents[collection]; ok {
+		return true
+	}
+	ok, _ := InArray(collection, StaticCollections)
+	return ok
+}
+
+type staticEntry struct {
+	value   proto.Message
+	expires time.Time
+}
+
+// StaticCache resolves the markers, tracker methods, behaviour categories and content categories referenced by
+// the records of a service in-process. Misses are read from static-srv with one ReadMany call and the records
+// are invalidated by the changes it publishes.
+//
+//	cache := common.NewStaticCache(static_proto.NewStaticServiceClient(common.StaticSrv, c), m)
+//	cache.Subscribe(broker)
+//	markers, err := cache.Markers(ctx, ids)
+type StaticCache struct {
+	sync.RWMutex
+	client  static_proto.StaticServiceClient
+	metrics metrics.Metrics
+	entries map[string]map[string]*staticEntry
+	// incremented by the invalidations of a collection, a read started before isn't cached
+	generations map[string]int64
+}
+
+// NewStaticCache returns an empty cache, m counts the hits and misses if it's set
+func NewStaticCache(client static_proto.StaticServiceClient, m metrics.Metrics) *StaticCache {
+	return &StaticCache{
+		client:      client,
+		metrics:     m,
+		entries:     map[string]map[string]*staticEntry{},
+		generations: map[string]int64{},
+	}
+}
+
+// PublishStaticChanged publishes the change of a reference record, an empty id changes the whole collection
+func PublishStaticChanged(b broker.Broker, collection, id string) error {
+	body, err := json.Marshal(&static_proto.StaticChanged{Collection: collection, Id: id})
+	if err != nil {
+		return err
+	}
+	return b.Publish(STATIC_CHANGED, &broker.Message{Body: body})
+}
+
+// Subscribe invalidates the records changed in static-srv
+func (c *StaticCache) Subscribe(b broker.Broker) (broker.Subscriber, error) {
+	return b.Subscribe(STATIC_CHANGED, func(p broker.Publication) error {
+		e := &static_proto.StaticChanged{}
+		if err := json.Unmarshal(p.Message().Body, e); err != nil {
+			return err
+		}
+		c.Invalidate(e.Collection, e.Id)
+		return nil
+	})
+}
+
+// Invalidate removes a record and the records embedding it, an empty id removes the whole collection
+func (c *StaticCache) Invalidate(collection, id string) {
+	c.Lock()
+	defer c.Unlock()
+	if len(id) == 0 {
+		delete(c.entries, collection)
+	} else {
+		delete(c.entries[collection], id)
+	}
+	c.generations[collection]++
+	for _, d := range staticDependents[collection] {
+		delete(c.entries, d)
+		c.generations[d]++
+	}
+}
+
+func (c *StaticCache) count(name, collection string, n int) {
+	if c.metrics == nil || n == 0 {
+		return
+	}
+	c.metrics.Counter("static_cache." + name).WithFields(metrics.Fields{"collection": collection}).Incr(uint64(n))
+}
+
+// get returns copies of the records of the collection, the ids which don't exist are skipped
+func (c *StaticCache) get(ctx context.Context, collection string, ids []string) (map[string]proto.Message, error) {
+	found := map[string]proto.Message{}
+	missing := []string{}
+	seen := map[string]bool{}
+	now := time.Now()
+
+	c.RLock()
+	generation := c.generations[collection]
+	for _, id := range ids {
+		if len(id) == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if e, ok := c.entries[collection][id]; ok && now.Before(e.expires) {
+			found[id] = e.value
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	c.RUnlock()
+	c.count("hit", collection, len(found))
+	c.count("miss", collection, len(missing))
+
+	if len(missing) > 0 {
+		rsp, err := c.client.ReadMany(ctx, &static_proto.ReadManyRequest{Collection: collection, Ids: missing})
+		if err != nil {
+			return nil, err
+		}
+		values := staticValues(rsp.Data)
+
+		c.Lock()
+		// the records read before an invalidation may be stale
+		if c.generations[collection] == generation {
+			if c.entries[collection] == nil {
+				c.entries[collection] = map[string]*staticEntry{}
+			}
+			for id, v := range values {
+				c.entries[collection][id] = &staticEntry{value: v, expires: now.Add(StaticCacheTTL)}
+			}
+		}
+		c.Unlock()
+		for id, v := range values {
+			found[id] = v
+		}
+	}
+
+	// the callers may change the records they resolve
+	for id, v := range found {
+		found[id] = proto.Clone(v)
+	}
+	return found, nil
+}
+
+// staticValues returns the records of a ReadMany response by id
+func staticValues(data *static_proto.ReadManyData) map[string]proto.Message {
+	values := map[string]proto.Message{}
+	if data == nil {
+		return values
+	}
+	for _, v := range data.Markers {
+		values[v.Id] = v
+	}
+	for _, v := range data.TrackerMethods {
+		values[v.Id] = v
+	}
+	for _, v := range data.BehaviourCategories {
+		values[v.Id] = v
+	}
+	for _, v := range data.ContentCategories {
+		values[v.Id] = v
+	}
+	return values
+}
+
+// Markers returns the markers by id with their tracker methods
+func (c *StaticCache) Markers(ctx context.Context, ids []string) (map[string]*static_proto.Marker, error) {
+	values, err := c.get(ctx, DbMarkerTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	markers := map[string]*static_proto.Marker{}
+	for id, v := range values {
+		markers[id] = v.(*static_proto.Marker)
+	}
+	return markers, nil
+}
+
+// TrackerMethods returns the tracker methods by id
+func (c *StaticCache) TrackerMethods(ctx context.Context, ids []string) (map[string]*static_proto.TrackerMethod, error) {
+	values, err := c.get(ctx, DbTrackerMethodTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	methods := map[string]*static_proto.TrackerMethod{}
+	for id, v := range values {
+		methods[id] = v.(*static_proto.TrackerMethod)
+	}
+	return methods, nil
+}
+
+// BehaviourCategories returns the behaviour categories by id with their aims and markers
+func (c *StaticCache) BehaviourCategories(ctx context.Context, ids []string) (map[string]*static_proto.BehaviourCategory, error) {
+	values, err := c.get(ctx, DbBehaviourCategoryTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	categories := map[string]*static_proto.BehaviourCategory{}
+	for id, v := range values {
+		categories[id] = v.(*static_proto.BehaviourCategory)
+	}
+	return categories, nil
+}
+
+// ContentCategories returns the content categories by id with their parents, actions and tracker methods
+func (c *StaticCache) ContentCategories(ctx context.Context, ids []string) (map[string]*static_proto.ContentCategory, error) {
+	values, err := c.get(ctx, DbContentCategoryTable, ids)
+	if err != nil {
+		return nil, err
+	}
+	categories := map[string]*static_proto.ContentCategory{}
+	for id, v := range values {
+		categories[id] = v.(*static_proto.ContentCategory)
+	}
+	return categories, nil
+}
@@ -0,0 +1,102 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"server/common"
+	static_proto "server/static-srv/proto/static"
+
+	mock_broker "github.com/micro/go-micro/broker/mock"
+	"github.com/micro/go-micro/client"
+)
+
+// staticClient serves ReadMany from a map of markers and counts the calls
+type staticClient struct {
+	static_proto.StaticServiceClient
+	markers map[string]*static_proto.Marker
+	calls   [][]string
+}
+
+func (c *staticClient) ReadMany(ctx context.Context, req *static_proto.ReadManyRequest, opts ...client.CallOption) (*static_proto.ReadManyResponse, error) {
+	c.calls = append(c.calls, req.Ids)
+	data := &static_proto.ReadManyData{}
+	for _, id := range req.Ids {
+		if m, ok := c.markers[id]; ok {
+			data.Markers = append(data.Markers, m)
+		}
+	}
+	return &static_proto.ReadManyResponse{Data: data}, nil
+}
+
+func TestStaticCache(t *testing.T) {
+	static := &staticClient{markers: map[string]*static_proto.Marker{
+		"m1": {Id: "m1", Name: "steps"},
+		"m2": {Id: "m2", Name: "weight"},
+	}}
+	cache := common.NewStaticCache(static, nil)
+	ctx := context.TODO()
+
+	markers, err := cache.Markers(ctx, []string{"m1", "m2", "m3", "m1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(markers) != 2 || markers["m1"].Name != "steps" {
+		t.Fatalf("Markers are invalid: %v", markers)
+	}
+	if len(static.calls) != 1 || len(static.calls[0]) != 3 {
+		t.Fatalf("Misses must be read with one call: %v", static.calls)
+	}
+
+	// the cached records can't be changed by the callers
+	markers["m1"].Name = "changed"
+	markers, _ = cache.Markers(ctx, []string{"m1"})
+	if markers["m1"].Name != "steps" {
+		t.Errorf("Cached marker must not be changed: %v", markers["m1"])
+	}
+	if len(static.calls) != 1 {
+		t.Errorf("Cached marker must not be read: %v", static.calls)
+	}
+	// the ids which don't exist aren't cached
+	cache.Markers(ctx, []string{"m3"})
+	if len(static.calls) != 2 {
+		t.Errorf("Missing marker must be read again: %v", static.calls)
+	}
+}
+
+func TestStaticCacheInvalidation(t *testing.T) {
+	static := &staticClient{markers: map[string]*static_proto.Marker{
+		"m1": {Id: "m1", Name: "steps"},
+	}}
+	cache := common.NewStaticCache(static, nil)
+	ctx := context.TODO()
+
+	b := mock_broker.NewBroker()
+	if err := b.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.Subscribe(b); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Markers(ctx, []string{"m1"})
+	static.markers["m1"] = &static_proto.Marker{Id: "m1", Name: "daily steps"}
+	if err := common.PublishStaticChanged(b, common.DbMarkerTable, "m1"); err != nil {
+		t.Fatal(err)
+	}
+	markers, _ := cache.Markers(ctx, []string{"m1"})
+	if markers["m1"].Name != "daily steps" || len(static.calls) != 2 {
+		t.Errorf("Changed marker must be read again: %v %v", markers["m1"], static.calls)
+	}
+
+	// the markers embed their tracker methods
+	cache.Invalidate(common.DbTrackerMethodTable, "t1")
+	cache.Markers(ctx, []string{"m1"})
+	if len(static.calls) != 3 {
+		t.Errorf("Markers must be invalidated by the changes of tracker methods: %v", static.calls)
+	}
+	if !common.StaticChanges(common.DbBehaviourCategoryAimTable) || !common.StaticChanges(common.DbContentParentCategoryTable) ||
+		!common.StaticChanges(common.DbMarkerTable) || common.StaticChanges(common.DbGoalTable) {
+		t.Error("Collections changing the cached records are invalid")
+	}
+}
@@ -0,0 +1,79 @@
+package common
+
+import (
+	"context"
+	"io"
+	db_proto "server/db-srv/proto/db"
+)
+
+// RecordStream iterates over the records of a streamed query, only one batch is held in memory
+//
+//	stream, err := common.StreamQuery(ctx, client, database, q, nil, 500)
+//	defer stream.Close()
+//	for {
+//		r, err := stream.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//	}
+type RecordStream struct {
+	stream db_proto.DB_RunQueryStreamClient
+	batch  []*db_proto.Record
+}
+
+// StreamQuery runs a query on db-srv streaming its records in batches of batchSize, 0 uses the server default
+func StreamQuery(ctx context.Context, client db_proto.DBClient, database *db_proto.Database, query string, bindVars BindVars, batchSize int64) (*RecordStream, error) {
+	req := &db_proto.RunQueryStreamRequest{
+		Database:  database,
+		Query:     query,
+		BatchSize: batchSize,
+	}
+	if bindVars != nil {
+		vars, err := bindVars.Encode()
+		if err != nil {
+			return nil, err
+		}
+		req.BindVars = vars
+	}
+	stream, err := client.RunQueryStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordStream{stream: stream}, nil
+}
+
+// Next returns the next record, io.EOF after the last one
+func (s *RecordStream) Next() (*db_proto.Record, error) {
+	for len(s.batch) == 0 {
+		rsp, err := s.stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		s.batch = rsp.Records
+	}
+	r := s.batch[0]
+	s.batch = s.batch[1:]
+	return r, nil
+}
+
+// Each calls fn for every record until the stream ends or fn returns an error
+func (s *RecordStream) Each(fn func(*db_proto.Record) error) error {
+	for {
+		r, err := s.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+}
+
+// Close stops the stream, the server releases its cursor
+func (s *RecordStream) Close() error {
+	return s.stream.Close()
+}
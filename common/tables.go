@@ -11,6 +11,8 @@ import (
 var (
 	DbHealumName   = Name("healum")
 	DbHealumDriver = "arangodb"
+	DbSeriesName   = Name("healum_series")
+	DbSeriesDriver = "influxdb"
 	ErrNotFound    = errors.New("not found")
 
 	DbAccountTable                   = Name("account")
@@ -105,6 +107,7 @@ var (
 	DbTrackContentEdgeTable          = Name("track_content_edge")  //edge
 	DbTrackContentGraph              = Name("track_content_graph") //graph
 	DbTrackMarkerTable               = Name("track_marker")
+	DbMarkerSeriesTable              = Name("marker_series")        //measurement of DbSeriesName
 	DbJoinGoalEdgeTable              = Name("join_goal_edge")       //edge
 	DbJoinGoalGraph                  = Name("join_goal_graph")      //graph
 	DbJoinChallengeEdgeTable         = Name("join_challenge_edge")  //edge
@@ -146,6 +149,14 @@ var (
 	DbEmailConfigTable               = Name("email_config")
 	DbSmsSubAccountTable             = Name("sms_subaccount")
 	DbAuditTable                     = Name("audit_log")
+	DbMigrationTable                 = Name("migration")
+	DbChangeLogTable                 = Name("change_log")
+	DbChangeCheckpointTable          = Name("change_checkpoint")
+	DbIndexQueueTable                = Name("index_queue")
+	DbIndexDeadLetterTable           = Name("index_dead_letter")
+	DbTrashTable                     = Name("trash")
+	DbHistoryTable                   = Name("history")
+	DbDataKeyTable                   = Name("data_key")
 
 	DbHealum = [][]string{
 		// table
@@ -216,6 +227,14 @@ var (
 		{DbEmailConfigTable},
 		{DbSmsSubAccountTable},
 		{DbAuditTable},
+		{DbMigrationTable},
+		{DbChangeLogTable},
+		{DbChangeCheckpointTable},
+		{DbIndexQueueTable},
+		{DbIndexDeadLetterTable},
+		{DbTrashTable},
+		{DbHistoryTable},
+		{DbDataKeyTable},
 		{},
 		// egde & graph
 		{DbShareGoalUserEdgeTable, DbShareGoalUserGraph, DbGoalTable, DbUserTable},
@@ -253,6 +272,40 @@ var (
 		{DbUserBatchEdgeTable, DbUserBatchGraph, DbUserTable, DbBatchTable},
 		{},
 	}
+
+	// DbTenantTables hold the documents of the organisations with the organisation in parameter1, db-srv scopes
+	// their queries to the organisation of the request, see NewTenantContext
+	DbTenantTables = []string{
+		DbGoalTable,
+		DbChallengeTable,
+		DbHabitTable,
+		DbUserTable,
+		DbPendingTable,
+		DbSourceTable,
+		DbTaxonomyTable,
+		DbContentCategoryItemTable,
+		DbContentTable,
+		DbContentRuleTable,
+		DbNoteTable,
+		DbPlanTable,
+		DbSurveyTable,
+		DbResponseTable,
+		DbTaskTable,
+		DbTeamTable,
+		DbEmployeeProfileTable,
+		DbTodoTable,
+		DbTrackGoalTable,
+		DbTrackChallengeTable,
+		DbTrackHabitTable,
+		DbTrackContentTable,
+		DbTrackMarkerTable,
+		DbPreferenceTable,
+		DbUserFeedbackTable,
+		DbUserPlanTable,
+		DbProductTable,
+		DbServiceTable,
+		DbBatchTable,
+	}
 )
 
 func Name(table string) string {
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/micro/go-micro/metadata"
+)
+
+// metadata of the tenant of a request, db-srv scopes the queries of DbTenantTables with it
+const (
+	// TenantOrgKey is the organisation of the request, the api sets it from the session
+	TenantOrgKey = "Tenant-Org-Id"
+	// TenantAdminKey marks the platform level requests reading the documents of every organisation
+	TenantAdminKey = "Tenant-Admin"
+)
+
+// withMetadata returns a context with the metadata of ctx and md
+func withMetadata(ctx context.Context, md metadata.Metadata) context.Context {
+	merged := metadata.Metadata{}
+	if current, ok := metadata.FromContext(ctx); ok {
+		for k, v := range current {
+			merged[k] = v
+		}
+	}
+	for k, v := range md {
+		merged[k] = v
+	}
+	return metadata.NewContext(ctx, merged)
+}
+
+// NewTenantContext returns a context whose db-srv requests are scoped to the organisation
+func NewTenantContext(ctx context.Context, orgId string) context.Context {
+	return withMetadata(ctx, metadata.Metadata{TenantOrgKey: orgId})
+}
+
+// NewAdminContext returns a context whose db-srv requests aren't scoped to an organisation. It is the escape hatch
+// of the platform level jobs, a request of a user must never use it.
+func NewAdminContext(ctx context.Context) context.Context {
+	return withMetadata(ctx, metadata.Metadata{TenantAdminKey: "true"})
+}
+
+// TenantFromContext returns the organisation of a context and whether it is a platform level context
+func TenantFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	return md[TenantOrgKey], md[TenantAdminKey] == "true"
+}
+
+// SetTenantHeader sets the organisation of a request, the header is only trusted once the api authenticated it
+func SetTenantHeader(header http.Header, orgId string) {
+	header.Del(TenantAdminKey)
+	header.Set(TenantOrgKey, orgId)
+}
+
+// IsTenantTable checks if the documents of a table belong to organisations
+func IsTenantTable(database, table string) bool {
+	if database != DbHealumName {
+		return false
+	}
+	for _, t := range DbTenantTables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
@@ -2,45 +2,65 @@ package common
 
 import (
 	"context"
-	"github.com/micro/go-micro/metadata"
-	"strings"
 	"net/http"
+	"strings"
+
+	"github.com/micro/go-micro/metadata"
 )
 
-var testMeta = metadata.Metadata{
-	"test_meta": "test_meta",
+// TestNamespaceKey is the metadata and the header of the test namespace of a request, db-srv serves the requests of
+// a namespace from their own databases. The namespaces are only available in the builds without the production tag,
+// see TestNamespacesEnabled.
+const TestNamespaceKey = "Test-Namespace"
+
+// testNamespace is the namespace of the test binary, every test suite gets its own databases
+var testNamespace = "t" + strings.ToLower(Random(12))
+
+// TestNamespace returns the namespace of the test contexts of the process
+func TestNamespace() string {
+	return testNamespace
 }
 
-func IsTestContext(ctx context.Context) bool {
+// TestNamespaceFromContext returns the test namespace of a context, it is empty in the production builds
+func TestNamespaceFromContext(ctx context.Context) string {
+	if !TestNamespacesEnabled {
+		return ""
+	}
 	md, ok := metadata.FromContext(ctx)
 	if !ok {
-		return false
-	}
-	val, ok := md["test_meta"]
-	if !ok || val != "test_meta"{
-		return false
+		return ""
 	}
-	return true
+	return md[TestNamespaceKey]
+}
 
+func IsTestContext(ctx context.Context) bool {
+	return len(TestNamespaceFromContext(ctx)) > 0
 }
 
-func NewContextByHeader(ctx context.Context, header http.Header) context.Context{
-	if header.Get("WWW-Testing") == "test_meta"{
-		return NewTestContext(ctx)
+// NewContextByHeader returns the context of an api request, the tenant header is set by the api once the session
+// is authenticated, see SetTenantHeader
+func NewContextByHeader(ctx context.Context, header http.Header) context.Context {
+	if namespace := header.Get(TestNamespaceKey); TestNamespacesEnabled && len(namespace) > 0 {
+		ctx = withMetadata(ctx, metadata.Metadata{TestNamespaceKey: namespace})
+	}
+	if orgId := header.Get(TenantOrgKey); len(orgId) > 0 {
+		ctx = NewTenantContext(ctx, orgId)
 	}
 	return ctx
 }
 
+// NewTestContext returns a context whose db-srv requests use the databases of the test namespace of the process
 func NewTestContext(ctx context.Context) context.Context {
-	return metadata.NewContext(ctx, testMeta)
+	return withMetadata(ctx, metadata.Metadata{TestNamespaceKey: testNamespace})
 }
 
+// SetTestHeader sets the test namespace of the process on a request to the api
 func SetTestHeader(header http.Header) {
-	header.Set("WWW-Testing", "test_meta")
+	header.Set(TestNamespaceKey, testNamespace)
 }
 
 func TestingName(name string) string {
-	if !strings.HasSuffix(name, "_test"){
+	if !strings.HasSuffix(name, "_test") {
 		return name + "_test"
 	}
 	return name
@@ -31,4 +31,15 @@ const (
 	CONTENT_UNBOOKMARKED    = "content_unbookmarked_topic"
 
 	AUDIT_ACTION = "audit_action_topic"
+
+	// a reference record of static-srv was written or deleted, see StaticCache
+	STATIC_CHANGED = "static_changed_topic"
+
+	// prefix of the change feed topics, see ChangeTopic
+	DB_CHANGE = "db_change_topic"
 )
+
+// ChangeTopic returns the topic of the change feed events of a collection
+func ChangeTopic(collection string) string {
+	return DB_CHANGE + "." + collection
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"context"
+	"errors"
+	db_proto "server/db-srv/proto/db"
+)
+
+// Transaction collects queries which db-srv applies atomically on the healum database
+type Transaction struct {
+	Read       []string
+	Write      []string
+	operations []*db_proto.Operation
+}
+
+// NewTransaction returns a transaction writing to the collections
+func NewTransaction(write ...string) *Transaction {
+	return &Transaction{Write: write}
+}
+
+// Add appends a query, bindVars can be nil
+func (t *Transaction) Add(query string, bindVars BindVars) error {
+	op := &db_proto.Operation{Query: query}
+	if bindVars != nil {
+		vars, err := bindVars.Encode()
+		if err != nil {
+			return err
+		}
+		op.BindVars = vars
+	}
+	t.operations = append(t.operations, op)
+	return nil
+}
+
+// Len returns the number of queries added
+func (t *Transaction) Len() int {
+	return len(t.operations)
+}
+
+// Run applies the queries, their results are in the order the queries were added
+// if any query fails none of them is applied
+func (t *Transaction) Run(ctx context.Context, client db_proto.DBClient) ([]*db_proto.OperationResult, error) {
+	if len(t.operations) == 0 {
+		return nil, nil
+	}
+	if len(t.Write) == 0 {
+		return nil, errors.New("transaction must declare the collections it writes")
+	}
+	rsp, err := client.Transaction(ctx, &db_proto.TransactionRequest{
+		Database: &db_proto.Database{
+			Name:   DbHealumName,
+			Table:  t.Write[0],
+			Driver: DbHealumDriver,
+		},
+		Read:       t.Read,
+		Write:      t.Write,
+		Operations: t.operations,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Results, nil
+}
@@ -0,0 +1,94 @@
+package common
+
+import (
+	"context"
+	"errors"
+	db_proto "server/db-srv/proto/db"
+	static_proto "server/static-srv/proto/static"
+)
+
+// ErrTrashCollection is returned for the collections a service doesn't trash
+var ErrTrashCollection = errors.New("collection is not trashed by the service")
+
+// healumDatabase returns the healum database of a collection, its writes are indexed
+func healumDatabase(table string) *db_proto.Database {
+	return &db_proto.Database{
+		Name:     DbHealumName,
+		Table:    table,
+		Driver:   DbHealumDriver,
+		Metadata: SearchableMetaMap,
+	}
+}
+
+// RecordToTrashItem converts a record of the trash, parameter1 is the organisation and parameter2 the collection
+func RecordToTrashItem(r *db_proto.Record) *static_proto.TrashItem {
+	return &static_proto.TrashItem{
+		Id:         r.Id,
+		Collection: r.Parameter2,
+		Name:       r.Name,
+		OrgId:      r.Parameter1,
+		Created:    r.Created,
+		DeletedAt:  r.DeletedAt,
+		DeletedBy:  r.DeletedBy,
+	}
+}
+
+// TrashDocument moves a document and its edges to the trash, a document which doesn't exist isn't an error
+// like the removes it replaces. An empty orgId matches the documents of every organisation.
+func TrashDocument(ctx context.Context, client db_proto.DBClient, table, id, orgId, userId string) error {
+	_, err := client.Trash(ctx, &db_proto.TrashRequest{
+		Database:  healumDatabase(table),
+		Id:        id,
+		OrgId:     orgId,
+		DeletedBy: userId,
+	})
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// TrashCollections checks the requested collections are trashed by the service, all of them if none is requested
+func TrashCollections(requested, collections []string) ([]string, error) {
+	if len(requested) == 0 {
+		return collections, nil
+	}
+	for _, c := range requested {
+		if ok, _ := InArray(c, collections); !ok {
+			return nil, ErrTrashCollection
+		}
+	}
+	return requested, nil
+}
+
+// ListTrash returns the trashed documents of the collections, the latest deleted first
+func ListTrash(ctx context.Context, client db_proto.DBClient, collections []string, orgId string, offset, limit int64) ([]*static_proto.TrashItem, error) {
+	rsp, err := client.ListTrash(ctx, &db_proto.ListTrashRequest{
+		Database: healumDatabase(DbTrashTable),
+		Tables:   collections,
+		OrgId:    orgId,
+		Offset:   offset,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := []*static_proto.TrashItem{}
+	for _, r := range rsp.Records {
+		items = append(items, RecordToTrashItem(r))
+	}
+	return items, nil
+}
+
+// RestoreDocument moves a trashed document and its edges back to its collection
+func RestoreDocument(ctx context.Context, client db_proto.DBClient, table, id, orgId string) (*static_proto.TrashItem, error) {
+	rsp, err := client.Restore(ctx, &db_proto.RestoreRequest{
+		Database: healumDatabase(table),
+		Id:       id,
+		OrgId:    orgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return RecordToTrashItem(rsp.Record), nil
+}
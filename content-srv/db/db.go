@@ -23,6 +23,7 @@ import (
 type clientWrapper struct {
 	Db_client            db_proto.DBClient
 	ContentServiceClient content_proto.ContentServiceClient
+	StaticCache          *common.StaticCache
 }
 
 var (
@@ -32,12 +33,13 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 	cl1 := content_proto.NewContentServiceClient("", serviceClient)
 
 	return &clientWrapper{
 		Db_client:            cl,
 		ContentServiceClient: cl1,
+		StaticCache:          common.NewStaticCache(static_proto.NewStaticServiceClient(common.StaticSrv, serviceClient), nil),
 	}
 }
 
@@ -443,23 +445,42 @@ func queryMerge() string {
 		LET u = (FOR u IN %v FILTER doc.data.createdBy.id == u._key RETURN u)
 		LET t = (FOR t IN %v FILTER doc.data.type.id == t._key RETURN t)
 		LET s = (FOR s IN %v FILTER doc.data.source.id == s._key RETURN s)
-		LET c = (FOR c IN %v FILTER doc.data.category.id == c._key RETURN c)
 		LET shares = (FILTER NOT_NULL(doc.data.shares) FOR cu IN doc.data.shares FOR p IN %v FILTER cu.id == p._key RETURN p.data)
 		RETURN MERGE_RECURSIVE(doc,{data:{
 			createdBy:u[0].data,
 			tags:tags,
 			type:t[0].data,
 			source:s[0].data,
-			category:c[0].data,
 			shares:shares
 		}})`,
 		common.DbContentTagEdgeTable, common.DbUserTable,
-		common.DbContentTypeTable, common.DbSourceTable, common.DbContentCategoryTable,
+		common.DbContentTypeTable, common.DbSourceTable,
 		common.DbUserTable,
 	)
 	return q
 }
 
+// resolveCategories replaces the categories of the contents by the cached records, the categories which don't
+// exist anymore are removed
+func resolveCategories(ctx context.Context, contents []*content_proto.Content) error {
+	ids := []string{}
+	for _, c := range contents {
+		if c.Category != nil {
+			ids = append(ids, c.Category.Id)
+		}
+	}
+	categories, err := ClientWrapper.StaticCache.ContentCategories(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, c := range contents {
+		if c.Category != nil {
+			c.Category = categories[c.Category.Id]
+		}
+	}
+	return nil
+}
+
 func queryContentRuleMerge() string {
 	q := fmt.Sprintf(`
 		LET source = (FOR p IN %v FILTER doc.data.source.id == p._key RETURN p)
@@ -558,17 +579,9 @@ func ReadSource(ctx context.Context, id, orgId, teamId string) (*content_proto.S
 	return data, err
 }
 
-// DeleteSource deletes a source by ID
-func DeleteSource(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, teamId)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbSourceTable, query, common.DbSourceTable)
-	_, err := runQuery(ctx, q, common.DbSourceTable)
-	return err
+// DeleteSource moves a source and its edges to the trash
+func DeleteSource(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbSourceTable, id, orgId, userId)
 }
 
 // AllTaxonomys get all taxonomys
@@ -641,18 +654,9 @@ func ReadTaxonomy(ctx context.Context, id, orgId, teamId string) (*static_proto.
 	return data, err
 }
 
-// DeleteTaxonomy deletes a taxonomy by ID
-func DeleteTaxonomy(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, teamId)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbTaxonomyTable, query, common.DbTaxonomyTable)
-	_, err := runQuery(ctx, q, common.DbTaxonomyTable)
-	return err
+// DeleteTaxonomy moves a taxonomy and its edges to the trash
+func DeleteTaxonomy(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbTaxonomyTable, id, orgId, userId)
 }
 
 // AllContentCategoryItems get all contentCategoryItems
@@ -737,18 +741,9 @@ func ReadContentCategoryItem(ctx context.Context, id, orgId, teamId string) (*st
 	return data, err
 }
 
-// DeleteContentCategoryItem deletes a contentCategoryItem by ID
-func DeleteContentCategoryItem(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, teamId)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentCategoryItemTable, query, common.DbContentCategoryItemTable)
-	_, err := runQuery(ctx, q, common.DbContentCategoryItemTable)
-	return err
+// DeleteContentCategoryItem moves a contentCategoryItem and its edges to the trash
+func DeleteContentCategoryItem(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbContentCategoryItemTable, id, orgId, userId)
 }
 
 // AllContents get all contents
@@ -780,6 +775,9 @@ func AllContents(ctx context.Context, orgId, teamId string, offset, limit int64,
 			log.Error(err)
 		}
 	}
+	if err := resolveCategories(ctx, contents); err != nil {
+		return nil, err
+	}
 	return contents, nil
 }
 
@@ -851,30 +849,18 @@ func ReadContent(ctx context.Context, id, orgId, teamId string) (*content_proto.
 	}
 
 	data, err := recordToContent(resp.Records[0])
-	return data, err
-}
-
-// DeleteContent deletes a content by ID
-func DeleteContent(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, teamId)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentTable, query, common.DbContentTable)
-	_, err := runQuery(ctx, q, common.DbContentTable)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	if err := resolveCategories(ctx, []*content_proto.Content{data}); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
 
-	query = fmt.Sprintf(`FILTER doc._from == "%v/%v"`, common.DbContentTable, id)
-	q = fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentTagEdgeTable, query, common.DbContentTagEdgeTable)
-	_, err = runQuery(ctx, q, common.DbContentTagEdgeTable)
-	return err
+// DeleteContent moves a content and its edges to the trash
+func DeleteContent(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbContentTable, id, orgId, userId)
 }
 
 // AllContentRules get all contentRules
@@ -952,17 +938,9 @@ func ReadContentRule(ctx context.Context, id, orgId, teamId string) (*content_pr
 	return data, err
 }
 
-// DeleteContentRule deletes a contentRule by ID
-func DeleteContentRule(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, "")
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentRuleTable, query, common.DbContentRuleTable)
-	_, err := runQuery(ctx, q, common.DbContentRuleTable)
-	return err
+// DeleteContentRule moves a contentRule and its edges to the trash
+func DeleteContentRule(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbContentRuleTable, id, orgId, userId)
 }
 
 func FilterContent(ctx context.Context, req *content_proto.FilterContentRequest) ([]*content_proto.Content, error) {
@@ -1826,3 +1804,29 @@ func ReadTaxonomyByNameslug(ctx context.Context, name_slug string) (*static_prot
 	data, err := recordToTaxonomy(resp.Records[0])
 	return data, err
 }
+
+// trashCollections are the collections whose documents are deleted to the trash
+var trashCollections = []string{
+	common.DbSourceTable,
+	common.DbTaxonomyTable,
+	common.DbContentCategoryItemTable,
+	common.DbContentTable,
+	common.DbContentRuleTable,
+}
+
+// Trash returns the deleted sources, taxonomies, content category items, contents and content rules, the latest deleted first
+func Trash(ctx context.Context, collections []string, orgId, teamId string, offset, limit int64) ([]*static_proto.TrashItem, error) {
+	collections, err := common.TrashCollections(collections, trashCollections)
+	if err != nil {
+		return nil, err
+	}
+	return common.ListTrash(ctx, ClientWrapper.Db_client, collections, orgId, offset, limit)
+}
+
+// Restore moves a deleted document and its edges back to its collection
+func Restore(ctx context.Context, id, collection, orgId, teamId string) (*static_proto.TrashItem, error) {
+	if _, err := common.TrashCollections([]string{collection}, trashCollections); err != nil {
+		return nil, err
+	}
+	return common.RestoreDocument(ctx, ClientWrapper.Db_client, collection, id, orgId)
+}
@@ -82,7 +82,7 @@ func (p *ContentService) ReadSource(ctx context.Context, req *content_proto.Read
 
 func (p *ContentService) DeleteSource(ctx context.Context, req *content_proto.DeleteSourceRequest, rsp *content_proto.DeleteSourceResponse) error {
 	log.Info("Received Content.DeleteSource request")
-	if err := db.DeleteSource(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteSource(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.ContentSrv, p.DeleteSource, err, "server error")
 	}
 	return nil
@@ -126,7 +126,7 @@ func (p *ContentService) ReadTaxonomy(ctx context.Context, req *content_proto.Re
 
 func (p *ContentService) DeleteTaxonomy(ctx context.Context, req *content_proto.DeleteTaxonomyRequest, rsp *content_proto.DeleteTaxonomyResponse) error {
 	log.Info("Received Content.DeleteTaxonomy request")
-	if err := db.DeleteTaxonomy(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteTaxonomy(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.ContentSrv, p.DeleteTaxonomy, err, "server error")
 	}
 	return nil
@@ -566,7 +566,7 @@ func (p *ContentService) ReadContent(ctx context.Context, req *content_proto.Rea
 
 func (p *ContentService) DeleteContent(ctx context.Context, req *content_proto.DeleteContentRequest, rsp *content_proto.DeleteContentResponse) error {
 	log.Info("Received Content.DeleteContent request")
-	if err := db.DeleteContent(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteContent(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.ContentSrv, p.DeleteContent, err, "delete error")
 	}
 	return nil
@@ -611,7 +611,7 @@ func (p *ContentService) ReadContentCategoryItem(ctx context.Context, req *conte
 
 func (p *ContentService) DeleteContentCategoryItem(ctx context.Context, req *content_proto.DeleteContentCategoryItemRequest, rsp *content_proto.DeleteContentCategoryItemResponse) error {
 	log.Info("Received Content.DeleteContentCategoryItem request")
-	if err := db.DeleteContentCategoryItem(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteContentCategoryItem(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.ContentSrv, p.DeleteContentCategoryItem, err, "delete error")
 	}
 	return nil
@@ -653,7 +653,7 @@ func (p *ContentService) ReadContentRule(ctx context.Context, req *content_proto
 
 func (p *ContentService) DeleteContentRule(ctx context.Context, req *content_proto.DeleteContentRuleRequest, rsp *content_proto.DeleteContentRuleResponse) error {
 	log.Info("Received Content.DeleteContentRule request")
-	if err := db.DeleteContentRule(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteContentRule(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.ContentSrv, p.DeleteContentRule, err, "server error")
 	}
 	return nil
@@ -1109,3 +1109,32 @@ func (p *ContentService) ReadTaxonomyByNameslug(ctx context.Context, req *static
 	rsp.Data = &content_proto.TaxonomyData{taxonomy}
 	return nil
 }
+
+func (p *ContentService) Trash(ctx context.Context, req *content_proto.TrashRequest, rsp *content_proto.TrashResponse) error {
+	log.Info("Received Content.Trash request")
+	items, err := db.Trash(ctx, req.Collections, req.OrgId, req.TeamId, req.Offset, req.Limit)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.ContentSrv, p.Trash, err, "collection is invalid")
+	}
+	if err != nil {
+		return common.InternalServerError(common.ContentSrv, p.Trash, err, "trash error")
+	}
+	rsp.Data = &static_proto.TrashArrData{items}
+	return nil
+}
+
+func (p *ContentService) Restore(ctx context.Context, req *content_proto.RestoreRequest, rsp *content_proto.RestoreResponse) error {
+	log.Info("Received Content.Restore request")
+	item, err := db.Restore(ctx, req.Id, req.Collection, req.OrgId, req.TeamId)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.ContentSrv, p.Restore, err, "collection is invalid")
+	}
+	if common.IsNotFound(err) {
+		return common.NotFound(common.ContentSrv, p.Restore, err, "trashed document not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.ContentSrv, p.Restore, err, "restore error")
+	}
+	rsp.Data = &static_proto.TrashData{item}
+	return nil
+}
@@ -124,6 +124,12 @@ func main() {
 
 	db.Init(service.Client())
 
+	// static references are resolved in-process, static-srv publishes their changes
+	db.ClientWrapper.StaticCache = common.NewStaticCache(contentService.StaticClient, m)
+	if _, err := db.ClientWrapper.StaticCache.Subscribe(brker); err != nil {
+		log.Fatal(err)
+	}
+
 	if err := service.Run(); err != nil {
 		log.Fatal(err)
 	}
@@ -166,6 +166,10 @@ It has these top-level messages:
 	AllContentCategoryItemByNameslugRequest
 	AllContentCategoryItemByNameslugResponse
 	ContentCategoryItemResponse
+	TrashRequest
+	TrashResponse
+	RestoreRequest
+	RestoreResponse
 */
 package go_micro_srv_content
 
@@ -693,6 +697,7 @@ type DeleteSourceRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteSourceRequest) Reset()                    { *m = DeleteSourceRequest{} }
@@ -721,6 +726,13 @@ func (m *DeleteSourceRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteSourceRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteSourceResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -1029,6 +1041,7 @@ type DeleteTaxonomyRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteTaxonomyRequest) Reset()                    { *m = DeleteTaxonomyRequest{} }
@@ -1057,6 +1070,13 @@ func (m *DeleteTaxonomyRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteTaxonomyRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteTaxonomyResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -1377,6 +1397,7 @@ type DeleteContentCategoryItemRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteContentCategoryItemRequest) Reset()         { *m = DeleteContentCategoryItemRequest{} }
@@ -1407,6 +1428,13 @@ func (m *DeleteContentCategoryItemRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteContentCategoryItemRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteContentCategoryItemResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -1741,6 +1769,7 @@ type DeleteContentRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteContentRequest) Reset()                    { *m = DeleteContentRequest{} }
@@ -1769,6 +1798,13 @@ func (m *DeleteContentRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteContentRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteContentResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -2077,6 +2113,7 @@ type DeleteContentRuleRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteContentRuleRequest) Reset()                    { *m = DeleteContentRuleRequest{} }
@@ -2105,6 +2142,13 @@ func (m *DeleteContentRuleRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteContentRuleRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteContentRuleResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -7626,6 +7670,161 @@ func (m *ContentCategoryItemResponse) GetCategoryitemName() string {
 	return ""
 }
 
+// lists the deleted records of the service, the latest deleted first
+type TrashRequest struct {
+	OrgId  string `protobuf:"bytes,1,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId string `protobuf:"bytes,2,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	// collections of the records, all the collections of the service if empty
+	Collections []string `protobuf:"bytes,3,rep,name=collections" json:"collections,omitempty"`
+	Offset      int64    `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit       int64    `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *TrashRequest) Reset()                    { *m = TrashRequest{} }
+func (m *TrashRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrashRequest) ProtoMessage()               {}
+func (*TrashRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+func (m *TrashRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetCollections() []string {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func (m *TrashRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TrashRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TrashResponse struct {
+	Data    *go_micro_srv_static.TrashArrData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                             `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                            `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *TrashResponse) Reset()                    { *m = TrashResponse{} }
+func (m *TrashResponse) String() string            { return proto.CompactTextString(m) }
+func (*TrashResponse) ProtoMessage()               {}
+func (*TrashResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+func (m *TrashResponse) GetData() *go_micro_srv_static.TrashArrData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TrashResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TrashResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// restores a deleted record and its edges
+type RestoreRequest struct {
+	Id         string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	OrgId      string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId     string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()               {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{160} }
+
+func (m *RestoreRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type RestoreResponse struct {
+	Data    *go_micro_srv_static.TrashData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                          `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                         `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RestoreResponse) Reset()                    { *m = RestoreResponse{} }
+func (m *RestoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreResponse) ProtoMessage()               {}
+func (*RestoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{161} }
+
+func (m *RestoreResponse) GetData() *go_micro_srv_static.TrashData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RestoreResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SourceData)(nil), "go.micro.srv.content.SourceData")
 	proto.RegisterType((*SourceArrData)(nil), "go.micro.srv.content.SourceArrData")
@@ -7802,6 +8001,10 @@ func init() {
 	proto.RegisterType((*AllContentCategoryItemByNameslugResponse)(nil), "go.micro.srv.content.AllContentCategoryItemByNameslugResponse")
 	proto.RegisterType((*AllContentCategoryItemByNameslugResponse_Data)(nil), "go.micro.srv.content.AllContentCategoryItemByNameslugResponse.Data")
 	proto.RegisterType((*ContentCategoryItemResponse)(nil), "go.micro.srv.content.ContentCategoryItemResponse")
+	proto.RegisterType((*TrashRequest)(nil), "go.micro.srv.content.TrashRequest")
+	proto.RegisterType((*TrashResponse)(nil), "go.micro.srv.content.TrashResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "go.micro.srv.content.RestoreRequest")
+	proto.RegisterType((*RestoreResponse)(nil), "go.micro.srv.content.RestoreResponse")
 	proto.RegisterEnum("go.micro.srv.content.RuleType", RuleType_name, RuleType_value)
 	proto.RegisterEnum("go.micro.srv.content.Operator", Operator_name, Operator_value)
 }
@@ -7876,6 +8079,8 @@ type ContentServiceClient interface {
 	AutocompleteContentCategoryItem(ctx context.Context, in *AutocompleteContentCategoryItemRequest, opts ...client.CallOption) (*AutocompleteContentCategoryItemResponse, error)
 	AllContentCategoryItemByNameslug(ctx context.Context, in *AllContentCategoryItemByNameslugRequest, opts ...client.CallOption) (*AllContentCategoryItemByNameslugResponse, error)
 	GetShareableContents(ctx context.Context, in *go_micro_srv_user.GetShareableContentRequest, opts ...client.CallOption) (*go_micro_srv_user.GetShareableContentResponse, error)
+	Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error)
 }
 
 type contentServiceClient struct {
@@ -8516,6 +8721,26 @@ func (c *contentServiceClient) GetShareableContents(ctx context.Context, in *go_
 	return out, nil
 }
 
+func (c *contentServiceClient) Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ContentService.Trash", in)
+	out := new(TrashResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ContentService.Restore", in)
+	out := new(RestoreResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ContentService service
 
 type ContentServiceHandler interface {
@@ -8581,6 +8806,8 @@ type ContentServiceHandler interface {
 	AutocompleteContentCategoryItem(context.Context, *AutocompleteContentCategoryItemRequest, *AutocompleteContentCategoryItemResponse) error
 	AllContentCategoryItemByNameslug(context.Context, *AllContentCategoryItemByNameslugRequest, *AllContentCategoryItemByNameslugResponse) error
 	GetShareableContents(context.Context, *go_micro_srv_user.GetShareableContentRequest, *go_micro_srv_user.GetShareableContentResponse) error
+	Trash(context.Context, *TrashRequest, *TrashResponse) error
+	Restore(context.Context, *RestoreRequest, *RestoreResponse) error
 }
 
 func RegisterContentServiceHandler(s server.Server, hdlr ContentServiceHandler, opts ...server.HandlerOption) {
@@ -8839,471 +9066,484 @@ func (h *ContentService) GetShareableContents(ctx context.Context, in *go_micro_
 	return h.ContentServiceHandler.GetShareableContents(ctx, in, out)
 }
 
+func (h *ContentService) Trash(ctx context.Context, in *TrashRequest, out *TrashResponse) error {
+	return h.ContentServiceHandler.Trash(ctx, in, out)
+}
+
+func (h *ContentService) Restore(ctx context.Context, in *RestoreRequest, out *RestoreResponse) error {
+	return h.ContentServiceHandler.Restore(ctx, in, out)
+}
+
 func init() { proto.RegisterFile("server/content-srv/proto/content/content.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 7405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0xe9, 0x8f, 0x1c, 0xc7,
-	0x75, 0xf8, 0xf6, 0x5c, 0x3b, 0xf3, 0xf6, 0xe0, 0x6e, 0x71, 0xb9, 0x1c, 0x0e, 0x29, 0x8a, 0x6a,
-	0x5d, 0x14, 0x29, 0xad, 0x24, 0xea, 0xa4, 0x44, 0x4b, 0x5a, 0x2e, 0x97, 0xfc, 0xad, 0x4d, 0x53,
-	0x74, 0xef, 0x92, 0xf2, 0xf5, 0xc3, 0xa6, 0x77, 0xa6, 0x38, 0xdb, 0x66, 0xcf, 0xf4, 0xb8, 0xba,
-	0x67, 0xc9, 0x95, 0xed, 0x28, 0x10, 0x6c, 0xc3, 0x48, 0x9c, 0xdb, 0x46, 0x0c, 0x03, 0x36, 0x62,
-	0x04, 0x08, 0x60, 0x20, 0x36, 0x8c, 0xfc, 0x01, 0x49, 0x0c, 0x04, 0x41, 0x3e, 0x04, 0x41, 0x80,
-	0x7c, 0xcf, 0x97, 0xd8, 0xc8, 0xa7, 0x7c, 0x48, 0x10, 0x20, 0xfe, 0x92, 0x20, 0xa8, 0xab, 0xbb,
-	0xaa, 0xa7, 0xab, 0xe7, 0x58, 0x1e, 0x12, 0x92, 0x2f, 0xbb, 0x53, 0xaf, 0x5f, 0x5d, 0xaf, 0x5e,
-	0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0x2b, 0x58, 0x09, 0x31, 0xd9, 0xc3, 0xe4, 0xf9, 0x66, 0xd0, 0x8d,
-	0x70, 0x37, 0x7a, 0x2e, 0x24, 0x7b, 0xcf, 0xf7, 0x48, 0x10, 0x05, 0x12, 0x22, 0xff, 0xaf, 0x30,
-	0x28, 0x5a, 0x6a, 0x07, 0x2b, 0x1d, 0xaf, 0x49, 0x82, 0x95, 0x90, 0xec, 0xad, 0x88, 0x6f, 0x8d,
-	0x63, 0xed, 0x20, 0x68, 0xfb, 0x98, 0xe7, 0xdc, 0xe9, 0xdf, 0x7a, 0xde, 0xed, 0xee, 0xf3, 0x0c,
-	0x8d, 0xb3, 0xa2, 0x82, 0x30, 0x72, 0x23, 0xaf, 0xa9, 0x94, 0xcf, 0x01, 0xe2, 0x9f, 0x40, 0x7e,
-	0x52, 0x20, 0xf7, 0x43, 0x4c, 0x14, 0x54, 0x9a, 0x64, 0x7f, 0x52, 0x68, 0x51, 0xd0, 0x0a, 0x14,
-	0x34, 0x9a, 0x64, 0x7f, 0x86, 0x55, 0xdd, 0xeb, 0xef, 0x84, 0xfd, 0x1d, 0xf1, 0x8f, 0x23, 0xdb,
-	0x17, 0x01, 0x36, 0x83, 0x3e, 0x69, 0xe2, 0x4b, 0x6e, 0xe4, 0xa2, 0x97, 0xa1, 0x12, 0xb2, 0x54,
-	0xdd, 0x3a, 0x65, 0x9d, 0x9e, 0x39, 0x77, 0x62, 0x25, 0xab, 0xdf, 0x2b, 0x3c, 0x87, 0x23, 0x70,
-	0xed, 0x2b, 0x30, 0xc7, 0x21, 0xab, 0x84, 0xb0, 0x62, 0x5e, 0x85, 0x69, 0xfe, 0x29, 0xac, 0x5b,
-	0xa7, 0x8a, 0x43, 0xcb, 0x91, 0xc8, 0xf6, 0x06, 0xcc, 0x6e, 0xb9, 0x77, 0x83, 0x6e, 0xd0, 0xd9,
-	0x67, 0xe5, 0x9c, 0x87, 0x6a, 0x24, 0xd2, 0xa2, 0x41, 0x8f, 0xe8, 0x05, 0x09, 0x2a, 0xca, 0x4c,
-	0x4e, 0x8c, 0x6e, 0x5f, 0x83, 0x43, 0x12, 0x2a, 0x5b, 0xf5, 0x26, 0xd4, 0xe4, 0x67, 0xd9, 0xae,
-	0x21, 0xc5, 0x25, 0xf8, 0x76, 0x1f, 0x8e, 0xae, 0xf1, 0x56, 0xaf, 0xb9, 0x11, 0x6e, 0x07, 0x64,
-	0x7f, 0x23, 0xc2, 0x1d, 0x56, 0xee, 0xe7, 0xe1, 0x70, 0x73, 0xf0, 0x93, 0x68, 0xf0, 0xe9, 0xcc,
-	0x1a, 0x32, 0x8a, 0x72, 0xb2, 0x0a, 0xb1, 0xdf, 0x87, 0x46, 0x06, 0xae, 0xec, 0xd1, 0x17, 0x61,
-	0x29, 0x23, 0x93, 0xec, 0xdc, 0xe8, 0x55, 0x67, 0x96, 0x62, 0x5f, 0x86, 0x19, 0x81, 0xcc, 0x2a,
-	0x7b, 0x0d, 0xa6, 0x05, 0x5a, 0xf6, 0x58, 0xc8, 0x41, 0x15, 0x79, 0x1c, 0x89, 0x6d, 0x7f, 0x0a,
-	0xe6, 0x05, 0x4c, 0xb6, 0xfb, 0x3c, 0x54, 0xc5, 0x47, 0xc3, 0x40, 0xa4, 0xcb, 0x8a, 0xd1, 0xed,
-	0x9b, 0x70, 0x48, 0x02, 0xfb, 0x3e, 0x67, 0xda, 0x35, 0x98, 0x69, 0x26, 0x20, 0xd1, 0xb8, 0xc7,
-	0xf2, 0x0b, 0xec, 0xfb, 0xd8, 0x51, 0x73, 0xd9, 0x5f, 0x00, 0xa4, 0x7c, 0x93, 0x0d, 0x5d, 0x87,
-	0x59, 0x05, 0x49, 0x36, 0x76, 0x84, 0xb2, 0xb5, 0x6c, 0xf6, 0x5f, 0x5a, 0xb0, 0xb8, 0xea, 0xfb,
-	0x9c, 0xdd, 0x43, 0x07, 0x7f, 0xb9, 0x8f, 0xc3, 0x08, 0x1d, 0x81, 0x4a, 0x40, 0xda, 0xdb, 0x5e,
-	0x8b, 0x35, 0xb9, 0xe6, 0x94, 0x03, 0xd2, 0xde, 0x68, 0xa1, 0xa3, 0x30, 0x1d, 0x61, 0xb7, 0x43,
-	0xe1, 0x05, 0x06, 0xaf, 0xd0, 0xe4, 0x46, 0x0b, 0x2d, 0x41, 0xd9, 0xf7, 0x3a, 0x5e, 0x54, 0x2f,
-	0x9e, 0xb2, 0x4e, 0x17, 0x1d, 0x9e, 0x40, 0xcb, 0x50, 0x09, 0x6e, 0xdd, 0x0a, 0x71, 0x54, 0x2f,
-	0x31, 0xb0, 0x48, 0xa1, 0x27, 0x61, 0x3e, 0x0c, 0x48, 0xb4, 0xdd, 0x73, 0x89, 0xdb, 0xc1, 0x11,
-	0x26, 0xf5, 0x32, 0x2b, 0x6d, 0x8e, 0x42, 0xaf, 0x4b, 0x60, 0x8c, 0xd6, 0xf2, 0x08, 0x6e, 0x46,
-	0x5e, 0xd0, 0xad, 0x57, 0x12, 0xb4, 0x4b, 0x12, 0x68, 0x7f, 0x05, 0x90, 0xda, 0x81, 0xb0, 0x17,
-	0x74, 0x43, 0x8c, 0x5e, 0x83, 0x52, 0xcb, 0x8d, 0x5c, 0x41, 0xf2, 0xc7, 0xf3, 0x26, 0xb9, 0xa0,
-	0xa8, 0xc3, 0x32, 0x20, 0x04, 0xa5, 0x66, 0xd0, 0xc2, 0xac, 0x83, 0x45, 0x87, 0xfd, 0x46, 0x75,
-	0x98, 0xee, 0xe0, 0x30, 0x74, 0xdb, 0x98, 0x75, 0xb0, 0xe6, 0xc8, 0xa4, 0xfd, 0x15, 0x38, 0xbc,
-	0x46, 0xb0, 0x1b, 0x61, 0x21, 0x2f, 0x04, 0xfd, 0x26, 0x12, 0x56, 0x0a, 0xd5, 0x0b, 0x06, 0xaa,
-	0x17, 0x55, 0xaa, 0xdb, 0xef, 0xc3, 0x92, 0x5e, 0xb9, 0xe8, 0xfb, 0xcb, 0x5a, 0xdf, 0x4f, 0xe5,
-	0xd5, 0x7d, 0x90, 0x8e, 0xdf, 0xe8, 0xb5, 0x1e, 0x5e, 0xc7, 0xf5, 0xca, 0x1f, 0x60, 0xc7, 0x37,
-	0x61, 0xd1, 0xc1, 0x6e, 0x4b, 0xef, 0xf6, 0x3c, 0x14, 0xe2, 0xb9, 0x52, 0xf0, 0x5a, 0x63, 0x77,
-	0xe8, 0x2e, 0x20, 0xb5, 0xd0, 0x07, 0xd8, 0x9d, 0x1b, 0x70, 0xf8, 0x12, 0xf6, 0x71, 0x7a, 0x1c,
-	0x0f, 0xda, 0xa1, 0x4b, 0xb0, 0xa4, 0x17, 0x2b, 0xba, 0x24, 0x1b, 0x67, 0x65, 0x37, 0xae, 0xa0,
-	0x37, 0xee, 0xe7, 0x16, 0x1c, 0x5e, 0xf5, 0x7d, 0xb9, 0xe8, 0x7d, 0x2c, 0xc5, 0xd3, 0x07, 0xb0,
-	0xa4, 0x77, 0x41, 0x50, 0xe2, 0xbc, 0x36, 0xb8, 0x4f, 0x66, 0x0f, 0x6e, 0x4a, 0x4f, 0x98, 0x68,
-	0x84, 0x3f, 0xb4, 0xe0, 0x08, 0x17, 0x13, 0xb1, 0xf2, 0x20, 0xc8, 0x38, 0xb9, 0x0e, 0x33, 0x36,
-	0x3f, 0xfc, 0x3a, 0x2c, 0xa7, 0xdb, 0x20, 0xe8, 0xf0, 0xaa, 0x46, 0x07, 0x3b, 0x9f, 0x0e, 0x07,
-	0x22, 0x02, 0x17, 0x19, 0x0f, 0x97, 0x08, 0xe9, 0x36, 0x3c, 0x50, 0x22, 0xdc, 0x80, 0xc3, 0x54,
-	0xca, 0xa4, 0x29, 0x70, 0xd0, 0xb9, 0xfe, 0x55, 0x58, 0xd2, 0x8b, 0x7d, 0xa0, 0x9d, 0x7a, 0x0f,
-	0x8e, 0x70, 0x49, 0x73, 0xaf, 0xbb, 0x75, 0x19, 0x96, 0xd3, 0x05, 0x4f, 0x24, 0xc4, 0xfe, 0xce,
-	0x82, 0x93, 0xab, 0xbe, 0x9f, 0xa1, 0xdc, 0x7e, 0x2c, 0xe5, 0xd9, 0x77, 0x2d, 0x78, 0xd4, 0xd8,
-	0x1d, 0x41, 0xa0, 0x4b, 0xda, 0xc8, 0xbf, 0x90, 0xab, 0x93, 0x66, 0x6c, 0x1e, 0x26, 0xe2, 0x83,
-	0x9f, 0x5a, 0x70, 0x8a, 0x8b, 0x98, 0xac, 0x6d, 0x84, 0x20, 0xf4, 0x7d, 0xdc, 0x0f, 0x8d, 0xcd,
-	0x5f, 0xdf, 0xb1, 0xe0, 0xb1, 0x9c, 0x06, 0x0b, 0x52, 0xae, 0x6a, 0xa4, 0x7c, 0x6e, 0x64, 0x52,
-	0x1e, 0x88, 0x8e, 0x5c, 0x4a, 0x7d, 0x8c, 0xe8, 0x98, 0xd3, 0xe0, 0x87, 0x45, 0xc7, 0x5f, 0x83,
-	0x93, 0x54, 0x2a, 0xe6, 0x10, 0xf1, 0xa0, 0x02, 0xea, 0x0f, 0x2c, 0x78, 0xd4, 0x58, 0xc5, 0xc3,
-	0xea, 0xf6, 0x0e, 0x9c, 0xe2, 0x52, 0xf3, 0x3e, 0x76, 0xfc, 0x33, 0xf0, 0x58, 0x4e, 0x1d, 0x13,
-	0x09, 0xe9, 0xbf, 0xb2, 0xd8, 0x2e, 0x52, 0x14, 0xf8, 0xb1, 0x14, 0xcc, 0x5f, 0x63, 0xba, 0x72,
-	0xd2, 0x03, 0x41, 0x87, 0xd7, 0x35, 0x0e, 0x78, 0x22, 0x97, 0x03, 0x0e, 0x22, 0x7f, 0xbf, 0x67,
-	0xc9, 0xdd, 0xa8, 0x34, 0x36, 0x08, 0x1a, 0x4e, 0x6a, 0x9c, 0xa1, 0x54, 0xee, 0x87, 0x98, 0x28,
-	0x54, 0xa6, 0xc9, 0x0d, 0x95, 0x5f, 0x8a, 0x86, 0x51, 0x29, 0xa5, 0x14, 0x94, 0x23, 0xa9, 0x96,
-	0x09, 0xda, 0xbc, 0xa2, 0xd1, 0x26, 0xdf, 0x76, 0x72, 0x20, 0xc2, 0x68, 0xf2, 0xe9, 0x23, 0x45,
-	0x98, 0x54, 0xcb, 0x1e, 0x24, 0x61, 0x3a, 0x7c, 0xd3, 0x9b, 0xa2, 0xca, 0x01, 0x85, 0x83, 0x4a,
-	0x9c, 0x92, 0x4a, 0x1c, 0xfb, 0x7d, 0xae, 0xfd, 0x3e, 0x94, 0xae, 0xde, 0x94, 0xdb, 0xe1, 0x7b,
-	0xdb, 0x59, 0x7b, 0x5d, 0x2a, 0xbf, 0xe9, 0x5e, 0x8d, 0x27, 0xfd, 0xfe, 0xda, 0x82, 0xe5, 0x44,
-	0x76, 0x30, 0xc3, 0xe0, 0xc7, 0x51, 0x02, 0x7e, 0xc3, 0x82, 0xa3, 0x03, 0xdd, 0x10, 0x04, 0xb9,
-	0xa0, 0x0d, 0xf3, 0xe9, 0xa1, 0x66, 0xd2, 0x83, 0x88, 0xc2, 0xdf, 0xb5, 0xa0, 0xae, 0xcf, 0xab,
-	0xbe, 0x1f, 0x5b, 0x56, 0xee, 0x85, 0x49, 0x78, 0x6c, 0x3e, 0xf9, 0xba, 0x05, 0xc7, 0x32, 0x5a,
-	0x34, 0x8e, 0x29, 0x22, 0x65, 0xda, 0x9e, 0x98, 0x30, 0xba, 0x24, 0xfe, 0x28, 0x10, 0x26, 0xa3,
-	0x45, 0x0f, 0x9a, 0x30, 0x9f, 0x85, 0x65, 0x55, 0x36, 0x29, 0x54, 0x39, 0xa8, 0x84, 0xf8, 0xd0,
-	0x82, 0xa3, 0x03, 0x45, 0x3f, 0xe8, 0xee, 0x7d, 0x1e, 0xea, 0xba, 0x98, 0xba, 0x87, 0x1d, 0xdc,
-	0x80, 0x63, 0x19, 0x65, 0x4f, 0x24, 0x06, 0xff, 0xad, 0x08, 0x4b, 0x97, 0x3d, 0x3f, 0xc2, 0x24,
-	0x25, 0xa6, 0x93, 0x36, 0xcd, 0x18, 0xda, 0x34, 0x9b, 0x2d, 0x04, 0xe7, 0xb2, 0x85, 0xe0, 0xfc,
-	0x10, 0x21, 0x78, 0x68, 0x34, 0x21, 0xb8, 0x90, 0x21, 0x04, 0x69, 0xf7, 0xd4, 0x03, 0xce, 0x5a,
-	0x7c, 0x84, 0x89, 0x4e, 0xc1, 0x0c, 0xff, 0xb9, 0xb5, 0xdf, 0xc3, 0x61, 0xbd, 0xc0, 0xbe, 0xaa,
-	0x20, 0x64, 0xc7, 0x67, 0x4a, 0x1c, 0xa5, 0xc8, 0x50, 0x34, 0x18, 0x7a, 0x1d, 0x8e, 0x8a, 0xf4,
-	0x75, 0x97, 0x24, 0xca, 0xb7, 0x87, 0xc3, 0x7a, 0x89, 0xa1, 0x9b, 0x3e, 0xa3, 0x67, 0x61, 0x51,
-	0xdf, 0xef, 0xd1, 0x3c, 0x65, 0x96, 0x67, 0xf0, 0x03, 0x3a, 0x67, 0x38, 0x40, 0xac, 0xb0, 0x0c,
-	0x99, 0xdf, 0xe8, 0x70, 0x47, 0xfb, 0x3d, 0x5c, 0x9f, 0x66, 0x38, 0xec, 0x37, 0x7a, 0x04, 0xa0,
-	0xc9, 0x26, 0x78, 0x6b, 0x7b, 0x67, 0xbf, 0x5e, 0x65, 0x5f, 0x6a, 0x02, 0x72, 0x71, 0xdf, 0xfe,
-	0x00, 0x8e, 0xa4, 0x86, 0xfc, 0x01, 0xeb, 0xcd, 0x7f, 0x63, 0xc1, 0xd2, 0x26, 0x76, 0x49, 0x73,
-	0xd7, 0xc8, 0x74, 0xf7, 0x63, 0xe5, 0x5d, 0x82, 0x72, 0xe4, 0x45, 0x3e, 0x16, 0x0b, 0x2e, 0x4f,
-	0x30, 0xe6, 0xe9, 0x77, 0x3a, 0x2e, 0xd9, 0x17, 0x2b, 0xac, 0x4c, 0x52, 0xe6, 0x69, 0xe1, 0xb0,
-	0x49, 0xbc, 0x1e, 0x63, 0xbd, 0x69, 0xf6, 0x55, 0x05, 0xd9, 0x7f, 0x6f, 0xc1, 0x91, 0x54, 0x47,
-	0xc6, 0x31, 0x07, 0x65, 0x66, 0x5d, 0x99, 0x94, 0xac, 0x8d, 0x55, 0x28, 0x1d, 0xf4, 0x3c, 0xf7,
-	0xdb, 0x16, 0x9c, 0xe0, 0x6b, 0xc3, 0x6a, 0x33, 0xf2, 0xf6, 0xbc, 0x68, 0x3f, 0x35, 0x42, 0x17,
-	0xa0, 0xe6, 0x8a, 0x2f, 0xb2, 0xf0, 0x93, 0xd9, 0x85, 0xcb, 0x02, 0x9c, 0x24, 0xc3, 0x24, 0x4b,
-	0x55, 0x83, 0x37, 0xc7, 0xc1, 0x4d, 0xaf, 0x97, 0x56, 0x25, 0x5f, 0x85, 0x69, 0xc2, 0xe0, 0x43,
-	0x1c, 0x1b, 0x78, 0x66, 0x47, 0x22, 0x8f, 0xdd, 0x8c, 0x84, 0x2a, 0xeb, 0x77, 0x31, 0x69, 0x7a,
-	0x21, 0x1e, 0xa4, 0x0a, 0x16, 0x5f, 0x86, 0x50, 0x45, 0x16, 0xe0, 0x24, 0x19, 0xc6, 0x6e, 0xce,
-	0xb7, 0x2c, 0x38, 0x2e, 0x06, 0x89, 0x44, 0x5e, 0xd3, 0x4f, 0xb7, 0xe6, 0x3c, 0x54, 0x5d, 0xfe,
-	0x61, 0xc8, 0xf8, 0x8b, 0xec, 0x4e, 0x8c, 0x3e, 0x76, 0x53, 0x7e, 0x23, 0xd6, 0x25, 0xae, 0xfb,
-	0x6e, 0x33, 0xdd, 0x90, 0x97, 0xa0, 0xd2, 0xa3, 0x60, 0xd9, 0x8c, 0xe3, 0xd9, 0xcd, 0x60, 0x59,
-	0x1d, 0x81, 0x3a, 0x76, 0x13, 0x7e, 0xcf, 0x82, 0x47, 0x78, 0x13, 0xde, 0xc3, 0xbe, 0xbf, 0x83,
-	0xbd, 0x6e, 0x3b, 0xd5, 0x8c, 0xb7, 0x01, 0xee, 0xc8, 0x4f, 0xb2, 0x29, 0x8f, 0x66, 0x37, 0x25,
-	0x2e, 0xc2, 0x51, 0xb2, 0x1c, 0x80, 0x2a, 0x37, 0xbd, 0x16, 0x0e, 0x06, 0xa9, 0xb2, 0x47, 0xc1,
-	0x43, 0xa8, 0xc2, 0xb2, 0x3a, 0x02, 0xf5, 0x00, 0x3c, 0x72, 0x9d, 0x04, 0xad, 0x7e, 0x33, 0x1a,
-	0xe4, 0x91, 0x1e, 0xff, 0x30, 0x84, 0x47, 0x44, 0x76, 0x27, 0x46, 0x3f, 0x40, 0x53, 0x36, 0x31,
-	0xd9, 0xf3, 0x9a, 0x19, 0xec, 0x1a, 0xf2, 0x0f, 0x43, 0x9a, 0x22, 0xb2, 0x3b, 0x31, 0xfa, 0x01,
-	0x06, 0x66, 0x7d, 0x8f, 0xae, 0xa4, 0x03, 0x03, 0x83, 0xf7, 0x14, 0xa9, 0x69, 0x18, 0x18, 0x96,
-	0xd5, 0x11, 0xa8, 0x07, 0x90, 0x25, 0x0e, 0x0e, 0xb3, 0xd6, 0xc0, 0x0b, 0x50, 0x23, 0xe2, 0xcb,
-	0x10, 0x59, 0x22, 0x0b, 0x70, 0x92, 0x0c, 0x63, 0x37, 0xe7, 0x0e, 0x1c, 0x15, 0xa2, 0xa4, 0xd7,
-	0x4b, 0x35, 0xe4, 0x59, 0x28, 0xb9, 0xbd, 0x9e, 0x6c, 0x43, 0x3d, 0xd3, 0xc2, 0xbd, 0xda, 0xeb,
-	0x39, 0x0c, 0x6b, 0xec, 0x8a, 0x7f, 0x6c, 0x81, 0x9d, 0xb2, 0x50, 0x35, 0x83, 0x4e, 0x07, 0x77,
-	0x5b, 0x2e, 0x5d, 0x5a, 0x65, 0x23, 0x36, 0x61, 0x9e, 0x68, 0x1f, 0xc4, 0x8a, 0x7a, 0x36, 0x7f,
-	0x45, 0xd3, 0xcb, 0x4a, 0x15, 0x31, 0x42, 0x5b, 0x75, 0x9b, 0xd1, 0xd7, 0x0b, 0xf0, 0x78, 0x6e,
-	0x5b, 0xc5, 0xa2, 0xef, 0x68, 0x8b, 0xfe, 0x5b, 0x86, 0x26, 0x0e, 0x2f, 0x68, 0x72, 0x15, 0xe0,
-	0x0b, 0x42, 0x05, 0xb8, 0x1f, 0x64, 0xb3, 0x4f, 0x40, 0xe3, 0x0a, 0x8e, 0x52, 0x06, 0x68, 0x69,
-	0x35, 0xb1, 0x7f, 0x69, 0xc1, 0xf1, 0xcc, 0xcf, 0x82, 0x38, 0x9f, 0xd4, 0x88, 0xf3, 0x6a, 0x76,
-	0x43, 0x72, 0x0a, 0x98, 0x9c, 0x28, 0x57, 0x05, 0x51, 0x2e, 0x41, 0xad, 0x29, 0x4b, 0x15, 0x5c,
-	0xfd, 0x94, 0x81, 0x1e, 0x02, 0x4d, 0xd6, 0xed, 0x24, 0x19, 0xed, 0xd7, 0xe1, 0x68, 0xd2, 0xca,
-	0x4b, 0x38, 0x72, 0x3d, 0x5f, 0x32, 0x2b, 0xd5, 0xbb, 0x39, 0x3c, 0x51, 0x61, 0x6b, 0x02, 0xb2,
-	0xd1, 0xb2, 0x7f, 0xb3, 0x00, 0xf5, 0xc1, 0xac, 0x82, 0x3c, 0x57, 0x34, 0xf2, 0xbc, 0x34, 0x8c,
-	0x3c, 0x7a, 0xee, 0xc9, 0x69, 0x73, 0x47, 0xd0, 0x46, 0x31, 0xcc, 0x16, 0xc6, 0x32, 0xcc, 0x2e,
-	0x43, 0x85, 0xb8, 0x91, 0xd7, 0x6d, 0x0b, 0x1d, 0x5c, 0xa4, 0xd0, 0x49, 0x80, 0x9d, 0x20, 0xb8,
-	0xdd, 0x71, 0xc9, 0x6d, 0xcc, 0xe7, 0x53, 0xd5, 0x51, 0x20, 0xb6, 0xaf, 0x72, 0xcb, 0xc5, 0xfd,
-	0x84, 0xe2, 0x9c, 0x94, 0x8f, 0xc2, 0x8c, 0x24, 0x79, 0x42, 0x4b, 0x90, 0xa0, 0x8d, 0x96, 0xa2,
-	0xe4, 0x17, 0xd2, 0x4a, 0xfe, 0xe0, 0x96, 0xc0, 0xfe, 0x85, 0x05, 0x27, 0xb2, 0xab, 0x13, 0xe4,
-	0xff, 0x94, 0x46, 0xfe, 0xd7, 0x86, 0x91, 0x7f, 0xb0, 0x84, 0xc9, 0x87, 0x60, 0x43, 0x0c, 0xc1,
-	0xea, 0x80, 0xda, 0x3e, 0xc4, 0x3c, 0x21, 0x99, 0x33, 0x51, 0xdf, 0xdf, 0x66, 0xbd, 0xe4, 0x9b,
-	0xbb, 0xf0, 0x72, 0x40, 0xc6, 0xa5, 0xaa, 0xfd, 0x87, 0x16, 0x3c, 0x62, 0x28, 0xe1, 0x21, 0x9e,
-	0x73, 0x7f, 0x06, 0x1a, 0xa2, 0x45, 0xab, 0xfd, 0x28, 0x68, 0x06, 0x9d, 0x9e, 0x8f, 0x23, 0x3c,
-	0x32, 0xab, 0x20, 0x28, 0x75, 0xdd, 0x8e, 0x34, 0x7d, 0xb0, 0xdf, 0xf6, 0x7f, 0x59, 0x70, 0x3c,
-	0xb3, 0xcc, 0x71, 0xa4, 0x55, 0x4e, 0x01, 0x93, 0xb3, 0x43, 0x4b, 0xb0, 0x83, 0xc9, 0x9b, 0xb8,
-	0x70, 0x4f, 0xbc, 0x89, 0x3f, 0x80, 0xd3, 0x9a, 0x0d, 0x60, 0xa3, 0x7b, 0x9d, 0x6d, 0x02, 0xfa,
-	0xbe, 0x3b, 0x36, 0xd7, 0x18, 0xed, 0x16, 0x05, 0xb3, 0xdd, 0xc2, 0xfe, 0x6f, 0x0b, 0x9e, 0x19,
-	0xa1, 0x05, 0x62, 0x38, 0x3e, 0xab, 0x0d, 0xc7, 0xa5, 0xbc, 0xe1, 0x18, 0xa1, 0xb8, 0x8f, 0xc4,
-	0x5c, 0xfd, 0x47, 0x0b, 0x9e, 0x4c, 0x04, 0x8a, 0xbe, 0xf4, 0x5e, 0xdc, 0xbf, 0x11, 0x62, 0x22,
-	0xe9, 0xaf, 0x1c, 0xef, 0x58, 0x86, 0xb3, 0x2f, 0x4d, 0x8f, 0x49, 0x44, 0x63, 0x31, 0x5b, 0x34,
-	0x96, 0x54, 0x6b, 0xc9, 0x3d, 0x3e, 0x8f, 0x28, 0xc0, 0x53, 0xc3, 0x7a, 0x25, 0xc6, 0xf4, 0x86,
-	0x36, 0xa6, 0xab, 0xc3, 0x44, 0x6e, 0x5e, 0x59, 0x93, 0x0f, 0xe8, 0x4d, 0x31, 0xa0, 0xd7, 0xe0,
-	0x90, 0xae, 0xed, 0xc8, 0x71, 0x7d, 0xc2, 0x68, 0x52, 0x50, 0x55, 0xa5, 0x74, 0x66, 0xfb, 0x57,
-	0x16, 0x3c, 0x63, 0x6e, 0x7b, 0x7a, 0x86, 0xdd, 0xdf, 0x11, 0x4e, 0xcd, 0xdf, 0xf2, 0xc0, 0xfc,
-	0x1d, 0x64, 0x81, 0xca, 0x68, 0x2c, 0x30, 0x9d, 0xc5, 0x02, 0xbf, 0x5d, 0x80, 0x33, 0xa3, 0x74,
-	0x5d, 0xb0, 0xc1, 0xe7, 0x34, 0x36, 0x58, 0x1f, 0x97, 0x0d, 0xee, 0xdd, 0xdc, 0xbe, 0x5f, 0xac,
-	0xf0, 0x1c, 0x1c, 0xb9, 0x82, 0x23, 0xc7, 0xed, 0xb6, 0x82, 0x8e, 0xe6, 0x02, 0xb7, 0x04, 0xe5,
-	0x66, 0xd0, 0x17, 0x47, 0xe1, 0x45, 0x87, 0x27, 0xe8, 0x1e, 0x75, 0x39, 0x8d, 0xff, 0x80, 0xed,
-	0xb3, 0x5f, 0x61, 0xba, 0xd9, 0xaa, 0xef, 0x6f, 0xee, 0xba, 0x04, 0xb7, 0xd2, 0x1e, 0x22, 0xf7,
-	0x95, 0x5b, 0xed, 0xff, 0xe4, 0xaa, 0x5a, 0x46, 0xed, 0x63, 0xaa, 0x6a, 0xc6, 0x12, 0x26, 0x67,
-	0x91, 0x2f, 0x0a, 0x16, 0xd9, 0x82, 0xc5, 0x90, 0x16, 0x2d, 0x4a, 0xa6, 0xa2, 0x68, 0xc8, 0x8e,
-	0x62, 0x33, 0x85, 0xee, 0x0c, 0x16, 0x60, 0x3b, 0x70, 0xca, 0xc4, 0xe7, 0x93, 0xd2, 0xde, 0xfe,
-	0xb0, 0x00, 0x8f, 0xe5, 0x14, 0x2a, 0x48, 0x7a, 0x5d, 0x23, 0xe9, 0x85, 0xf1, 0xe6, 0xe0, 0x3d,
-	0xa0, 0xeb, 0xff, 0x17, 0x74, 0xbd, 0x61, 0x9a, 0x7a, 0x63, 0xed, 0x5b, 0x07, 0x66, 0xe0, 0x4d,
-	0x78, 0x22, 0x69, 0xbc, 0x50, 0xda, 0x2e, 0xee, 0x5f, 0x27, 0xf8, 0x16, 0x26, 0xb8, 0x9b, 0xdc,
-	0x00, 0x18, 0x97, 0xb8, 0xbf, 0x5f, 0x50, 0x97, 0xf0, 0xcc, 0x82, 0x05, 0x81, 0xb7, 0x34, 0x02,
-	0xbf, 0x33, 0x8c, 0xc0, 0x39, 0x45, 0xdd, 0x3f, 0xc5, 0xf2, 0xde, 0x5c, 0x53, 0xfb, 0xae, 0x05,
-	0x8f, 0xa7, 0x4e, 0x97, 0x32, 0x19, 0xf9, 0x2d, 0x28, 0x7b, 0x13, 0x55, 0xcb, 0xb3, 0x8d, 0xeb,
-	0x10, 0x64, 0xff, 0x87, 0x05, 0x4f, 0xe4, 0xb7, 0x4b, 0x0c, 0xd5, 0xa6, 0x36, 0x54, 0x6f, 0x8f,
-	0xa0, 0x6a, 0xde, 0xf3, 0xe9, 0xa0, 0x68, 0x99, 0x44, 0x14, 0x36, 0xa6, 0x96, 0x29, 0xb3, 0xd9,
-	0xbf, 0x2c, 0x40, 0x85, 0xdf, 0x47, 0x19, 0x38, 0x75, 0xce, 0xd8, 0x16, 0xa5, 0x8f, 0xbc, 0x8a,
-	0x03, 0x47, 0x5e, 0xe8, 0x38, 0xd4, 0xbc, 0x66, 0xd0, 0xdd, 0x0e, 0xfd, 0x7e, 0x5b, 0x98, 0xc9,
-	0xaa, 0x14, 0xb0, 0xe9, 0xf7, 0xdb, 0x68, 0x01, 0x8a, 0x7d, 0xe2, 0x0b, 0x0d, 0x83, 0xfe, 0x44,
-	0xc7, 0x80, 0x7d, 0xdd, 0xa6, 0x60, 0x71, 0xbc, 0x46, 0xd3, 0x37, 0x88, 0x8f, 0x5e, 0x80, 0xc3,
-	0x6e, 0x14, 0x11, 0x6f, 0xa7, 0x2f, 0x0d, 0x7e, 0x1e, 0xc1, 0x2d, 0xa6, 0x53, 0x54, 0x9d, 0xac,
-	0x4f, 0xca, 0xd0, 0x56, 0xd5, 0xf5, 0xa5, 0x0e, 0xd3, 0xe2, 0x70, 0xb3, 0x5e, 0x63, 0xf4, 0x95,
-	0x49, 0xfa, 0xa5, 0xcf, 0x5c, 0x40, 0x5a, 0x75, 0xe0, 0x5f, 0x44, 0x92, 0x1d, 0x9b, 0xba, 0xed,
-	0xb0, 0x3e, 0x23, 0x8e, 0x4d, 0xdd, 0x76, 0x88, 0xde, 0x10, 0x47, 0xa9, 0xb3, 0x6c, 0xe4, 0x9f,
-	0xca, 0xe3, 0xc8, 0xcd, 0xf8, 0x04, 0x99, 0x1f, 0xb9, 0xda, 0xff, 0x54, 0x86, 0x69, 0xf1, 0x6d,
-	0x80, 0xd0, 0xf1, 0xb9, 0x63, 0xc1, 0x70, 0xee, 0x58, 0xcc, 0x3d, 0x77, 0x2c, 0x0d, 0x0e, 0xc2,
-	0x12, 0x94, 0xbd, 0x0e, 0x65, 0x1c, 0x71, 0x92, 0xc9, 0x12, 0x2a, 0x1d, 0x2a, 0x46, 0x3a, 0x4c,
-	0xeb, 0x74, 0x30, 0x90, 0xf4, 0x15, 0x48, 0xce, 0x8b, 0x19, 0x51, 0x67, 0xce, 0x1d, 0xd5, 0xe9,
-	0xc1, 0x2e, 0x42, 0xb3, 0x95, 0x2c, 0xc1, 0x94, 0xe3, 0x0f, 0xc9, 0xf8, 0x2f, 0x43, 0xc5, 0xed,
-	0x47, 0xbb, 0x01, 0x11, 0x6e, 0x04, 0x22, 0x85, 0x4e, 0x40, 0x2d, 0xf2, 0x3a, 0x38, 0x8c, 0xdc,
-	0x4e, 0x8f, 0x11, 0xbc, 0xe8, 0x24, 0x00, 0x74, 0x41, 0x8c, 0xce, 0xdc, 0x98, 0xb2, 0x81, 0x8f,
-	0xe3, 0xcb, 0x62, 0x1c, 0xe7, 0xb3, 0xee, 0x90, 0xe9, 0xb9, 0x93, 0x11, 0x54, 0xae, 0xf1, 0x1d,
-	0x1a, 0xe3, 0x1a, 0xdf, 0x3b, 0x50, 0x95, 0x8a, 0x34, 0xf3, 0x4d, 0x18, 0x50, 0xcb, 0xb2, 0x5b,
-	0xeb, 0xc4, 0xb9, 0xd0, 0x69, 0x28, 0x51, 0x89, 0x56, 0x5f, 0x64, 0xb9, 0x97, 0x56, 0xf8, 0x25,
-	0xf6, 0x15, 0x79, 0x89, 0x7d, 0x65, 0xb5, 0xbb, 0xef, 0x30, 0x0c, 0xca, 0xb3, 0xbb, 0x6e, 0xb8,
-	0x5b, 0x47, 0x7c, 0xc2, 0xd2, 0xdf, 0xec, 0x6e, 0x37, 0x8e, 0x98, 0xfd, 0xed, 0x70, 0x56, 0xb3,
-	0x45, 0xf5, 0x9b, 0x1c, 0xc7, 0x91, 0xc8, 0xe8, 0x79, 0xa8, 0x30, 0x05, 0x24, 0xac, 0x2f, 0x31,
-	0x1a, 0x1b, 0x47, 0x57, 0xa0, 0xd9, 0xdf, 0x2c, 0xc7, 0xf7, 0x8f, 0x99, 0xd7, 0xd1, 0x88, 0x3e,
-	0x2c, 0x0a, 0x4f, 0x16, 0x8d, 0x3c, 0x59, 0xd2, 0x79, 0xf2, 0x9c, 0x18, 0x3f, 0xca, 0xdc, 0xf3,
-	0xc6, 0xc3, 0x8f, 0xbe, 0x8f, 0x33, 0x47, 0x6f, 0x7a, 0x8c, 0xd1, 0xbb, 0x0c, 0x90, 0xf8, 0x82,
-	0x08, 0x3e, 0x1f, 0x75, 0xde, 0x2b, 0x39, 0xd1, 0xc5, 0xd8, 0x8f, 0x8b, 0x15, 0x34, 0x33, 0x22,
-	0xe3, 0xa9, 0x99, 0x90, 0x03, 0xf3, 0x3d, 0xd5, 0x7d, 0x64, 0x9f, 0x79, 0xd2, 0xcc, 0x9c, 0x3b,
-	0x93, 0x57, 0x8c, 0xe6, 0x70, 0xb2, 0xef, 0xa4, 0x4a, 0xd0, 0xb8, 0xf3, 0xd0, 0x44, 0xdc, 0x79,
-	0x0d, 0xe6, 0x9a, 0x9a, 0x96, 0xb0, 0x38, 0xe6, 0x94, 0xd4, 0xb3, 0xa3, 0x77, 0x00, 0xf0, 0xdd,
-	0x1e, 0xc1, 0x61, 0x48, 0x45, 0x1b, 0xca, 0xbb, 0xe5, 0xb9, 0x1e, 0xe3, 0x39, 0x4a, 0x1e, 0xdb,
-	0x07, 0x48, 0xbe, 0xa0, 0x37, 0xa0, 0x1a, 0xf4, 0x30, 0x71, 0xa3, 0x80, 0x30, 0x66, 0x34, 0xf2,
-	0xcb, 0xbb, 0x02, 0xcb, 0x89, 0xf1, 0xa9, 0xb4, 0xea, 0x78, 0x5d, 0xb1, 0x26, 0xd3, 0x9f, 0x0c,
-	0xe2, 0xde, 0x15, 0x9c, 0x4a, 0x7f, 0xda, 0xff, 0x5a, 0x82, 0x0a, 0x77, 0x1f, 0xa0, 0xa2, 0x8c,
-	0x85, 0x72, 0x08, 0x59, 0x45, 0x05, 0x47, 0xa4, 0xa8, 0x26, 0xd3, 0xf2, 0x70, 0x34, 0xbe, 0x65,
-	0x8e, 0x67, 0x43, 0x97, 0xa1, 0xe6, 0xfa, 0x3e, 0x26, 0x6d, 0x4f, 0xb8, 0x1f, 0x8d, 0x53, 0x46,
-	0x92, 0x95, 0x32, 0xa1, 0xd7, 0x6d, 0x13, 0xdc, 0xf2, 0x98, 0x59, 0xaa, 0xc4, 0x4a, 0x32, 0xd0,
-	0x76, 0x23, 0x46, 0x74, 0xd4, 0x4c, 0xe8, 0x09, 0x98, 0x8b, 0x82, 0xc8, 0xf5, 0xd7, 0x5c, 0x5f,
-	0xfa, 0x2a, 0xd1, 0xae, 0xea, 0x40, 0xba, 0x40, 0x31, 0xc0, 0x7b, 0xd8, 0x6b, 0xef, 0x46, 0x6c,
-	0xb1, 0x29, 0x38, 0x2a, 0x08, 0x7d, 0x02, 0x6a, 0xdd, 0x7e, 0x44, 0x3c, 0x61, 0x25, 0xc8, 0x39,
-	0x71, 0xbf, 0x26, 0xd1, 0x9c, 0x24, 0x07, 0xad, 0xa0, 0x19, 0x04, 0xb7, 0xbd, 0x6e, 0x7b, 0xcb,
-	0xeb, 0x60, 0xb1, 0xaa, 0xab, 0x20, 0xd4, 0x80, 0x6a, 0x8f, 0xe0, 0x1e, 0xfb, 0xcc, 0x97, 0xf6,
-	0x38, 0x4d, 0x3b, 0x21, 0x50, 0x3f, 0x8d, 0xa3, 0xdd, 0x40, 0x7a, 0xb0, 0xe9, 0x40, 0x64, 0xc3,
-	0xac, 0xd7, 0x0d, 0x23, 0xd2, 0x6f, 0xf2, 0x8d, 0xc6, 0x2c, 0x77, 0xfc, 0x52, 0x61, 0x72, 0x3d,
-	0x9b, 0x4b, 0xd6, 0xb3, 0x78, 0xe5, 0x9d, 0x57, 0x57, 0xde, 0x65, 0x6d, 0xed, 0xa8, 0xc5, 0xf2,
-	0x25, 0x5e, 0xf9, 0x17, 0xd4, 0x95, 0xbf, 0x41, 0x67, 0x65, 0x9f, 0xd7, 0xba, 0xc8, 0x6a, 0x8d,
-	0xd3, 0xf6, 0xbf, 0x58, 0x50, 0x8b, 0x49, 0xc2, 0x76, 0xc8, 0xee, 0x0e, 0xf6, 0xa5, 0x3b, 0x14,
-	0x4b, 0xd0, 0x96, 0xbb, 0x3b, 0x3b, 0x04, 0xef, 0x79, 0xfc, 0x68, 0x8f, 0x0b, 0x5c, 0x0d, 0x86,
-	0x4e, 0x02, 0xb0, 0xf1, 0xb8, 0xe9, 0xfa, 0x7d, 0xae, 0x5f, 0x16, 0x1c, 0x05, 0x82, 0x9e, 0x86,
-	0x43, 0x2d, 0xd7, 0xf3, 0xf7, 0xb7, 0x5d, 0xdf, 0x0f, 0xee, 0xb8, 0xdd, 0x26, 0x66, 0x52, 0xb8,
-	0xe0, 0xcc, 0x33, 0xf0, 0xaa, 0x84, 0xd2, 0x45, 0xa7, 0xdf, 0xf5, 0x22, 0xa1, 0x69, 0xb0, 0xdf,
-	0x94, 0x2c, 0xa4, 0xe5, 0xb1, 0x71, 0xaf, 0x3a, 0xf4, 0x27, 0x7a, 0x11, 0x8a, 0x61, 0x7f, 0x67,
-	0xd4, 0x91, 0xa6, 0xb8, 0xf6, 0x87, 0x16, 0x40, 0xc2, 0x86, 0x4c, 0x21, 0xc3, 0x77, 0x23, 0xd1,
-	0x53, 0xf6, 0x9b, 0x12, 0xea, 0xcb, 0x7d, 0xb7, 0x1b, 0x79, 0xd1, 0x3e, 0xeb, 0x64, 0xc1, 0x89,
-	0xd3, 0x5c, 0x7b, 0x76, 0xc3, 0x3e, 0x51, 0xb4, 0x67, 0x96, 0xa4, 0x83, 0x71, 0x87, 0x33, 0x26,
-	0xef, 0x91, 0x48, 0xd1, 0x1a, 0x6e, 0x05, 0x81, 0xb4, 0x7f, 0xb1, 0xdf, 0xf6, 0x06, 0x4c, 0x0b,
-	0x27, 0x98, 0x64, 0x64, 0xb9, 0x0b, 0xa1, 0x18, 0xd9, 0x25, 0x28, 0x33, 0x0f, 0x0c, 0x61, 0xcb,
-	0xe6, 0x89, 0xb8, 0xb1, 0xc5, 0xa4, 0xb1, 0xf6, 0x0f, 0x4b, 0x50, 0x95, 0xde, 0x3d, 0x94, 0x81,
-	0x3b, 0x8c, 0xcd, 0x02, 0x3f, 0x68, 0xef, 0x8b, 0x4e, 0xa9, 0x20, 0xf4, 0x22, 0x94, 0x7d, 0xbc,
-	0x87, 0x7d, 0x71, 0xdc, 0x66, 0xf0, 0x35, 0xb8, 0x4a, 0x51, 0x1c, 0x8e, 0x49, 0x6b, 0x25, 0xb8,
-	0x17, 0x0a, 0xf1, 0xc4, 0x7e, 0x53, 0x58, 0x88, 0xd9, 0x6c, 0x67, 0x30, 0xfa, 0x1b, 0x5d, 0x04,
-	0x88, 0x88, 0xdb, 0xbc, 0x8d, 0x09, 0x55, 0x0b, 0xca, 0x59, 0x57, 0x51, 0xe5, 0x05, 0x5f, 0x8e,
-	0xc6, 0x67, 0x84, 0xa3, 0xe4, 0x4a, 0xa8, 0x51, 0x51, 0xa9, 0xb1, 0x1c, 0xbb, 0xae, 0x70, 0x77,
-	0x43, 0xe9, 0x9d, 0x72, 0x02, 0x6a, 0x54, 0x47, 0xef, 0x75, 0x70, 0x37, 0x92, 0xfe, 0x86, 0x31,
-	0x00, 0x9d, 0x85, 0x52, 0x14, 0xb4, 0x82, 0x7a, 0x2d, 0x4b, 0xd3, 0x60, 0x91, 0x72, 0xb6, 0x82,
-	0x56, 0xe0, 0x30, 0x24, 0xde, 0xc9, 0x30, 0x12, 0x3a, 0x24, 0xfb, 0x4d, 0xf9, 0xa0, 0xd5, 0x27,
-	0x9c, 0xd9, 0xf9, 0x5c, 0x8e, 0xd3, 0x83, 0x12, 0x8b, 0x2b, 0x93, 0x29, 0x89, 0xf5, 0x0e, 0x00,
-	0xc1, 0xcd, 0x3e, 0x61, 0x5b, 0x69, 0xa1, 0x56, 0x9e, 0x32, 0x9a, 0xf3, 0x04, 0x9e, 0xa3, 0xe4,
-	0xd1, 0x96, 0xd2, 0xf9, 0x49, 0x96, 0x52, 0x1b, 0x43, 0x99, 0x0d, 0x27, 0x9d, 0x9b, 0x04, 0x87,
-	0x5e, 0x18, 0xb1, 0x69, 0x27, 0x8e, 0x53, 0x12, 0x08, 0xa5, 0xa6, 0x47, 0x0b, 0x09, 0x25, 0xdf,
-	0xd7, 0x9c, 0x04, 0x40, 0x73, 0xe3, 0xbb, 0x3d, 0x4c, 0x3c, 0xd6, 0x15, 0xce, 0x81, 0x0a, 0xc4,
-	0xfe, 0x7e, 0x01, 0x6a, 0xb1, 0x1b, 0x13, 0x7a, 0x09, 0x2a, 0x91, 0x4b, 0xda, 0x58, 0xde, 0xb7,
-	0x39, 0x6e, 0xb8, 0xe8, 0x4d, 0x51, 0x1c, 0x81, 0xaa, 0xd1, 0xbb, 0x90, 0xa2, 0xb7, 0xb6, 0x33,
-	0x28, 0x8e, 0xbc, 0x33, 0x48, 0x24, 0x64, 0x49, 0x93, 0x90, 0xfa, 0xc0, 0x94, 0x27, 0x18, 0x18,
-	0xc9, 0x5d, 0x95, 0xac, 0xb6, 0xa4, 0xb8, 0xcb, 0xb6, 0x01, 0x92, 0x62, 0x28, 0x93, 0x3b, 0xb1,
-	0xe3, 0x7d, 0xcd, 0xe1, 0x09, 0xfb, 0xdf, 0x4b, 0x50, 0x66, 0x2e, 0x69, 0xe8, 0x0a, 0x2c, 0xd0,
-	0xca, 0x5d, 0xaf, 0x8b, 0x5b, 0x1b, 0x5d, 0x06, 0xcb, 0x26, 0xa3, 0xee, 0xc9, 0x36, 0x90, 0x29,
-	0x76, 0xd2, 0x15, 0x5b, 0x6d, 0xa6, 0xb1, 0xca, 0xed, 0x77, 0x51, 0xd9, 0x7e, 0x9f, 0x85, 0x62,
-	0x1b, 0x07, 0x8c, 0x44, 0x33, 0xe7, 0x8e, 0x99, 0xac, 0x44, 0x81, 0x43, 0xb1, 0xa8, 0xea, 0xef,
-	0xb6, 0x5a, 0x54, 0x11, 0x12, 0x73, 0x3c, 0x5b, 0xf5, 0x5f, 0xe5, 0x38, 0x8e, 0x44, 0x66, 0x5b,
-	0x2f, 0xec, 0xe3, 0xde, 0x6e, 0xd0, 0xc5, 0x62, 0x4f, 0x9e, 0x00, 0x28, 0x7b, 0x79, 0x2d, 0xdc,
-	0x8d, 0xbc, 0x5b, 0x1e, 0x26, 0xc2, 0xc0, 0xaf, 0x40, 0xe4, 0x92, 0x58, 0x4d, 0x96, 0x44, 0xbe,
-	0x13, 0xa8, 0xa9, 0xdb, 0x5d, 0x2e, 0x3a, 0x40, 0x5d, 0x22, 0xaf, 0xc0, 0x5c, 0xd0, 0xc3, 0x5d,
-	0xaf, 0xdb, 0xde, 0xde, 0x0d, 0xfa, 0x24, 0x14, 0x4a, 0xb2, 0x6d, 0xd4, 0xd6, 0x28, 0xea, 0xff,
-	0xa3, 0x98, 0xce, 0x6c, 0xa0, 0xa4, 0x98, 0x53, 0xe1, 0x6e, 0x10, 0x05, 0x7c, 0xc5, 0x36, 0x0f,
-	0x05, 0xc5, 0x71, 0x04, 0x2a, 0x3a, 0x06, 0x55, 0xe6, 0x5e, 0x48, 0xf7, 0x27, 0x7c, 0x35, 0x9f,
-	0x66, 0xe9, 0x8d, 0x16, 0x7a, 0x19, 0xa6, 0x5d, 0x3f, 0xda, 0xf6, 0x5a, 0x61, 0x7d, 0x3e, 0xaf,
-	0xc0, 0x55, 0x3f, 0xda, 0x68, 0x39, 0x15, 0x97, 0xfe, 0x0b, 0xd9, 0xca, 0xce, 0xfc, 0xc5, 0x0f,
-	0x71, 0xf9, 0xc8, 0x12, 0x94, 0xb4, 0xb1, 0xc1, 0x4e, 0xac, 0xf9, 0x09, 0x80, 0x4e, 0xab, 0x3d,
-	0xaf, 0xe9, 0x75, 0xe9, 0xb4, 0x5e, 0xe4, 0xd3, 0x4a, 0xa6, 0xed, 0xb7, 0xa1, 0x78, 0x05, 0x07,
-	0x14, 0xc5, 0x77, 0x23, 0x2f, 0xea, 0x0b, 0x07, 0x7e, 0xcb, 0x89, 0xd3, 0xb4, 0x70, 0x3f, 0xe8,
-	0xb6, 0xf9, 0xc7, 0x02, 0xfb, 0x98, 0x00, 0xec, 0x67, 0x60, 0x56, 0x25, 0x1a, 0xed, 0x31, 0x25,
-	0xdb, 0x76, 0x37, 0xb8, 0xc3, 0x4a, 0xaa, 0x3a, 0xd3, 0x34, 0x7d, 0x2d, 0xb8, 0x63, 0xff, 0x96,
-	0x05, 0x65, 0x46, 0x1e, 0x3a, 0x2b, 0x77, 0xf9, 0x52, 0x49, 0x51, 0x4a, 0x8e, 0x48, 0xa1, 0xb3,
-	0xb0, 0xb8, 0x1b, 0x75, 0xfc, 0x6d, 0xc5, 0x08, 0x23, 0x4f, 0x73, 0x17, 0xe8, 0x87, 0x55, 0x05,
-	0x4e, 0x55, 0x09, 0x46, 0xe5, 0xed, 0xa4, 0xeb, 0x9c, 0xa7, 0xe7, 0x19, 0xd8, 0x89, 0xfb, 0xbf,
-	0x04, 0xe5, 0x3b, 0x5e, 0x2b, 0xda, 0x65, 0xfc, 0x5d, 0x72, 0x78, 0xc2, 0x7e, 0x1d, 0xca, 0x8c,
-	0xb4, 0xda, 0x18, 0x59, 0xfa, 0x18, 0x2d, 0x41, 0x39, 0x6c, 0x06, 0xf1, 0x04, 0xe2, 0x09, 0xfb,
-	0x9f, 0x2b, 0x50, 0x95, 0x5e, 0xc6, 0x82, 0x0b, 0x4f, 0xc7, 0x5c, 0x98, 0x08, 0x9c, 0x67, 0x34,
-	0x81, 0xf3, 0x22, 0x94, 0x9b, 0xbe, 0x1b, 0x86, 0xf9, 0x13, 0x79, 0x8d, 0xa2, 0x38, 0x1c, 0x13,
-	0xbd, 0x06, 0x55, 0x3f, 0x68, 0x26, 0xe2, 0x70, 0xc8, 0xf4, 0x8f, 0x91, 0xa9, 0x16, 0x1c, 0x90,
-	0xb6, 0xdb, 0xf5, 0xde, 0xc7, 0x44, 0xc8, 0x4a, 0x83, 0x6e, 0xf4, 0xae, 0x44, 0x73, 0x92, 0x1c,
-	0x68, 0x05, 0x4a, 0x3d, 0x97, 0xdc, 0x16, 0xe2, 0xa0, 0x61, 0xa8, 0xd3, 0x25, 0xb7, 0x1d, 0x86,
-	0x47, 0xf7, 0x42, 0x61, 0x7f, 0x87, 0xb9, 0x27, 0x0a, 0x49, 0x6a, 0xd8, 0x0b, 0x6d, 0x0a, 0x2c,
-	0x27, 0xc6, 0x47, 0x97, 0x61, 0x96, 0x2e, 0xb5, 0xc4, 0x13, 0xda, 0x70, 0x25, 0x6f, 0x76, 0x3a,
-	0x0a, 0xa6, 0xa3, 0xe5, 0xa3, 0x4c, 0x1a, 0x46, 0x2e, 0x89, 0x2e, 0xb9, 0x91, 0x14, 0x00, 0x09,
-	0x80, 0x2a, 0x6d, 0xb8, 0xdb, 0x62, 0xdf, 0xf8, 0x3a, 0x2e, 0x93, 0xda, 0x92, 0x33, 0x9b, 0x5a,
-	0x72, 0x74, 0x91, 0x34, 0x37, 0x20, 0x92, 0x9e, 0x85, 0x45, 0xc5, 0x38, 0x76, 0x39, 0x20, 0x1d,
-	0x37, 0xaa, 0x1f, 0x61, 0x68, 0x83, 0x1f, 0xa8, 0xf6, 0xdc, 0xc1, 0x38, 0xf2, 0xba, 0xed, 0xeb,
-	0x81, 0xd7, 0x8d, 0x84, 0x35, 0x45, 0x83, 0x31, 0x7d, 0x86, 0x92, 0xe5, 0x6a, 0xd0, 0x0e, 0xea,
-	0xc7, 0x79, 0x2f, 0x62, 0x40, 0xa2, 0x13, 0x9e, 0xe0, 0xdc, 0xc8, 0x75, 0xc2, 0x93, 0x00, 0x21,
-	0xdf, 0x94, 0xde, 0x20, 0x7e, 0xfd, 0x11, 0xde, 0xca, 0x04, 0x22, 0xef, 0x7a, 0xb1, 0x79, 0x13,
-	0x90, 0xfa, 0xa3, 0xc3, 0xee, 0x7a, 0x09, 0x44, 0x47, 0xcd, 0x85, 0xde, 0x82, 0xaa, 0xf4, 0xa6,
-	0xaf, 0x3f, 0xc1, 0x86, 0xd8, 0xce, 0xf7, 0xbe, 0xa7, 0x33, 0xd5, 0x89, 0xf3, 0x50, 0xee, 0x0f,
-	0x6e, 0xdd, 0xc2, 0xa4, 0xfe, 0x64, 0x9e, 0xa8, 0x7b, 0x97, 0xa2, 0x38, 0x1c, 0xd3, 0xfe, 0xa9,
-	0x05, 0xb3, 0xea, 0x80, 0x53, 0x72, 0xb7, 0x71, 0xb7, 0xc5, 0x4e, 0xdf, 0x25, 0x54, 0xac, 0x23,
-	0x83, 0x1f, 0xd8, 0x16, 0x86, 0xe9, 0xb9, 0xd3, 0x62, 0x0b, 0xc3, 0x54, 0xa0, 0x06, 0x54, 0xdd,
-	0x36, 0x76, 0xdc, 0x6e, 0x1b, 0x8b, 0xa5, 0x24, 0x4e, 0xb3, 0x01, 0x72, 0xef, 0xae, 0x46, 0x11,
-	0x2d, 0x0b, 0x87, 0x62, 0xa7, 0xa5, 0xc1, 0x68, 0x7e, 0x7c, 0x17, 0x13, 0x56, 0xf5, 0x61, 0x9e,
-	0x5f, 0xa6, 0xed, 0x1f, 0x58, 0x50, 0x8b, 0xe7, 0x53, 0x96, 0xd5, 0x7b, 0xa4, 0xa5, 0x58, 0xac,
-	0x73, 0x25, 0x6d, 0xeb, 0x87, 0x3b, 0xae, 0x27, 0xcd, 0xdb, 0x3c, 0x31, 0x64, 0x35, 0x45, 0x50,
-	0xf2, 0x29, 0x0f, 0xf1, 0xce, 0xb3, 0xdf, 0xf6, 0x5f, 0x58, 0x50, 0x66, 0xf2, 0x05, 0x9d, 0x87,
-	0x0a, 0x5d, 0xa4, 0xfb, 0xe1, 0x90, 0x9b, 0x7f, 0x14, 0x79, 0x93, 0x21, 0x3a, 0x22, 0x03, 0x2d,
-	0xb8, 0x45, 0xa7, 0x91, 0x38, 0x50, 0xa0, 0xbf, 0xd1, 0x4b, 0xa2, 0x6b, 0xb9, 0x92, 0x86, 0x15,
-	0xa6, 0x18, 0xce, 0xa8, 0x3c, 0x0c, 0xdc, 0xe6, 0xae, 0x90, 0x32, 0x26, 0x79, 0x48, 0x51, 0x1c,
-	0x8e, 0x69, 0xcf, 0xc1, 0x8c, 0xd2, 0x24, 0x7b, 0x06, 0x6a, 0x71, 0xa1, 0xf6, 0xf7, 0x68, 0xe7,
-	0x28, 0x16, 0x25, 0xcc, 0x2d, 0x8f, 0x84, 0xd1, 0x35, 0x4a, 0x59, 0xe1, 0x0b, 0x19, 0x03, 0xf8,
-	0x42, 0x27, 0x3e, 0x0a, 0x15, 0x53, 0xa6, 0x13, 0x05, 0xa2, 0xa8, 0x2a, 0x10, 0x94, 0xd0, 0xbb,
-	0xfd, 0xce, 0x4e, 0x97, 0x0e, 0x41, 0x49, 0x10, 0x5a, 0x02, 0xd0, 0x63, 0x30, 0x1b, 0x7e, 0xb9,
-	0xef, 0x12, 0xbc, 0xad, 0x1a, 0xc6, 0x67, 0x38, 0x6c, 0x83, 0x82, 0xec, 0xbb, 0x30, 0xab, 0xce,
-	0x8a, 0x98, 0x13, 0x2c, 0x85, 0x13, 0x1a, 0x50, 0xed, 0x06, 0x51, 0x22, 0xea, 0xcb, 0x4e, 0x9c,
-	0xa6, 0x0d, 0xe8, 0x11, 0x7c, 0xeb, 0x2a, 0xdb, 0x90, 0xf3, 0xa6, 0x25, 0x00, 0x9a, 0xd3, 0xeb,
-	0x6e, 0x36, 0x77, 0x71, 0x07, 0xc7, 0xc7, 0x22, 0x22, 0x6d, 0x7f, 0x0d, 0xca, 0x6c, 0x4a, 0x49,
-	0xa6, 0xb2, 0x12, 0xa6, 0x1a, 0x95, 0x1d, 0x9f, 0x80, 0xb9, 0x1e, 0xf1, 0x9a, 0x78, 0x8d, 0xeb,
-	0xae, 0xfb, 0xa2, 0x0e, 0x1d, 0x48, 0x29, 0xc7, 0x00, 0x92, 0x45, 0x59, 0xc2, 0x7e, 0x85, 0x5b,
-	0x6e, 0xa5, 0x0c, 0xc9, 0xea, 0x77, 0x96, 0x8b, 0xdc, 0x3f, 0x58, 0x50, 0xa2, 0xab, 0x4b, 0x62,
-	0xc5, 0xb0, 0x54, 0x2b, 0x06, 0x9f, 0x58, 0x85, 0x78, 0x62, 0x0d, 0x3f, 0x3a, 0xca, 0x94, 0xd3,
-	0x25, 0x93, 0x9c, 0x6e, 0x40, 0x95, 0xae, 0x6a, 0x4c, 0x04, 0xf3, 0xee, 0xc4, 0x69, 0x55, 0xf5,
-	0xad, 0x8c, 0xa1, 0xfa, 0xda, 0xdf, 0x28, 0xc2, 0xf4, 0xc5, 0x80, 0x04, 0xfd, 0xf6, 0xee, 0x88,
-	0xbd, 0xe2, 0x7b, 0x31, 0x12, 0x6c, 0x25, 0xdb, 0xfd, 0x04, 0x80, 0x4e, 0xc3, 0xa1, 0x38, 0xa1,
-	0xf5, 0x27, 0x0d, 0xa6, 0xd4, 0xd9, 0xe1, 0x15, 0x2b, 0x1d, 0x52, 0x41, 0xe8, 0x0c, 0x2c, 0x88,
-	0x24, 0xbb, 0xf9, 0xb1, 0xe9, 0xf7, 0xdb, 0x42, 0x9e, 0x0c, 0xc0, 0x29, 0x25, 0x55, 0xd8, 0x16,
-	0xeb, 0x07, 0x97, 0x31, 0x83, 0x1f, 0xa8, 0x40, 0x55, 0x81, 0x42, 0xe0, 0x6a, 0x30, 0xf4, 0x32,
-	0x1c, 0x11, 0xe9, 0xad, 0x3b, 0x5e, 0x14, 0x61, 0xb2, 0xda, 0xe4, 0xbe, 0x29, 0x5c, 0xaf, 0xcf,
-	0xfe, 0xa8, 0x8e, 0x03, 0x8c, 0x33, 0x0e, 0x04, 0xaa, 0x52, 0x07, 0xc9, 0x64, 0x47, 0x4d, 0x8b,
-	0x28, 0xe4, 0x68, 0x11, 0x45, 0xb3, 0x16, 0x51, 0xd2, 0xb5, 0x08, 0xfb, 0x6f, 0x2d, 0x38, 0xac,
-	0x7a, 0x61, 0x28, 0xd7, 0x4f, 0x26, 0xbc, 0x2d, 0x87, 0x9e, 0x83, 0x72, 0x9f, 0xb9, 0x7e, 0x14,
-	0xf2, 0xcf, 0x50, 0x38, 0x96, 0x7a, 0x64, 0x5d, 0x34, 0x1c, 0x59, 0x97, 0x0c, 0xf7, 0x11, 0xca,
-	0xe9, 0x48, 0x83, 0x7a, 0x4f, 0x26, 0xba, 0xfa, 0xfb, 0x67, 0x05, 0x58, 0x48, 0xbb, 0xa5, 0x0c,
-	0x2c, 0x97, 0x13, 0xfb, 0x85, 0x9f, 0x85, 0x12, 0xed, 0xdd, 0x30, 0x13, 0x01, 0x43, 0x42, 0xaf,
-	0xc7, 0x0b, 0x61, 0x89, 0xd9, 0xf0, 0xb3, 0x8f, 0x4e, 0x58, 0x63, 0x53, 0xeb, 0xa0, 0x72, 0x8a,
-	0x54, 0xd6, 0x4f, 0x91, 0x5e, 0x86, 0x1a, 0x3b, 0xba, 0x62, 0x77, 0x60, 0x2b, 0xf9, 0xad, 0xa8,
-	0x72, 0xcc, 0x8b, 0xfb, 0xea, 0x79, 0xd5, 0xb4, 0x76, 0x5e, 0x65, 0xb7, 0x60, 0x61, 0xc0, 0x77,
-	0x6d, 0x12, 0xd7, 0x63, 0xfd, 0x04, 0xbd, 0xa8, 0x9f, 0xa0, 0xdb, 0x3f, 0x2c, 0x24, 0x11, 0x75,
-	0x65, 0x2d, 0xf9, 0xd7, 0x0a, 0x92, 0xe5, 0xb2, 0xa0, 0x2e, 0x97, 0xb1, 0x78, 0x2b, 0xaa, 0xe2,
-	0x2d, 0x39, 0x8e, 0x2d, 0x69, 0xc7, 0xb1, 0xc9, 0xf1, 0x59, 0x79, 0x8c, 0xe3, 0xb3, 0x54, 0xf7,
-	0x2b, 0x03, 0xdd, 0x7f, 0x16, 0x50, 0x82, 0x10, 0xf7, 0x99, 0x0b, 0xaa, 0x85, 0x18, 0x4f, 0x7a,
-	0x0f, 0x3c, 0x9e, 0x9c, 0x35, 0x6d, 0x33, 0xaa, 0x09, 0x41, 0x25, 0x81, 0x54, 0x39, 0xb0, 0x3f,
-	0x2c, 0xc0, 0x91, 0x4c, 0xbf, 0x8b, 0x31, 0x6e, 0xd5, 0x67, 0xcf, 0x42, 0x85, 0xd5, 0x4b, 0x63,
-	0xb1, 0xba, 0xc2, 0x33, 0x65, 0xe3, 0x19, 0x67, 0x45, 0xe7, 0x4e, 0x79, 0xc2, 0x5d, 0x9d, 0xe4,
-	0x84, 0xdb, 0xfe, 0x59, 0x11, 0xe6, 0x53, 0xbd, 0x1f, 0xc2, 0x24, 0x94, 0xb6, 0xf2, 0xb3, 0xc2,
-	0x2c, 0xf2, 0x9e, 0x3b, 0xd3, 0x90, 0x54, 0x24, 0x95, 0x77, 0xe2, 0xcb, 0xf0, 0x8c, 0x85, 0x9e,
-	0x84, 0x79, 0x89, 0xa4, 0xb1, 0x92, 0xcc, 0xba, 0xca, 0x39, 0x6a, 0x2d, 0x41, 0x1b, 0x83, 0xb3,
-	0x64, 0x21, 0x9b, 0x0f, 0x8d, 0xc1, 0x54, 0xfe, 0xa8, 0x69, 0xfc, 0x21, 0x87, 0x0c, 0x26, 0x1a,
-	0xb2, 0x6f, 0x57, 0x60, 0x5a, 0xdc, 0xa5, 0xbc, 0x9f, 0x67, 0xe7, 0x52, 0xe0, 0x94, 0xcd, 0x4e,
-	0x3d, 0x95, 0x41, 0xcd, 0xec, 0x14, 0xa8, 0x9a, 0xb2, 0xbc, 0xe9, 0xae, 0x80, 0xd0, 0x53, 0x30,
-	0x1f, 0x2b, 0xdb, 0x1c, 0x89, 0xd3, 0x2e, 0x05, 0xa5, 0xbb, 0x60, 0xdf, 0x25, 0x6d, 0x51, 0x10,
-	0x27, 0xa0, 0x02, 0xc9, 0xf6, 0x10, 0x11, 0x17, 0x88, 0x66, 0xb4, 0x0b, 0x44, 0x74, 0x8b, 0xd8,
-	0x6f, 0x71, 0x2b, 0xb7, 0xb0, 0x08, 0xc8, 0x34, 0x15, 0x6e, 0xee, 0x1d, 0x97, 0x48, 0x5b, 0x1e,
-	0x4f, 0x50, 0xe8, 0x0e, 0x71, 0xbb, 0x2d, 0x79, 0x36, 0xc7, 0x12, 0xfc, 0xb4, 0x4d, 0x39, 0x03,
-	0xaf, 0x29, 0xa7, 0xdb, 0x08, 0x4a, 0xed, 0xc8, 0x93, 0x51, 0x25, 0xd8, 0x6f, 0xbe, 0xfd, 0xec,
-	0xf6, 0x6f, 0xb9, 0xcd, 0xa8, 0x4f, 0x30, 0x11, 0x96, 0x3a, 0x0d, 0x46, 0xcb, 0xec, 0xb8, 0x11,
-	0x26, 0x9e, 0xeb, 0x0b, 0xfb, 0x41, 0x9c, 0xa6, 0xad, 0xe8, 0x04, 0x2d, 0xec, 0x8b, 0x7d, 0x29,
-	0x4f, 0x08, 0x1f, 0x58, 0xaa, 0x2b, 0x2c, 0xc5, 0x3e, 0xb0, 0x54, 0x27, 0x78, 0x0a, 0xe6, 0xc5,
-	0xc5, 0x5a, 0x2f, 0xe8, 0x32, 0x95, 0xe6, 0x08, 0xfb, 0x9e, 0x82, 0xd2, 0x56, 0xf5, 0xfa, 0xa4,
-	0xb9, 0xeb, 0x86, 0x98, 0x61, 0x2d, 0x33, 0x2c, 0x0d, 0x46, 0x47, 0x91, 0x60, 0x1f, 0x4b, 0x94,
-	0xa3, 0xfc, 0xd4, 0x54, 0x01, 0x31, 0x5a, 0xe3, 0x3d, 0x0f, 0xdf, 0xa9, 0xd7, 0x39, 0x6b, 0xf3,
-	0x94, 0x72, 0x64, 0x76, 0x8c, 0xb7, 0x4e, 0x1c, 0x99, 0x2d, 0x41, 0xb9, 0x85, 0x7b, 0xd1, 0x6e,
-	0xbd, 0xc1, 0x3d, 0x74, 0x59, 0x42, 0xb1, 0x1a, 0x1e, 0xe7, 0xd8, 0xbb, 0x31, 0x36, 0xb7, 0xef,
-	0x9d, 0xe0, 0xd8, 0x2c, 0xc1, 0xbd, 0x9c, 0xfd, 0x80, 0x08, 0x93, 0x08, 0x4f, 0xd8, 0x7f, 0x5e,
-	0x81, 0x69, 0x71, 0x9f, 0xf7, 0xff, 0xa6, 0xc3, 0x47, 0x65, 0x3a, 0xa4, 0x59, 0x7f, 0x61, 0x08,
-	0xeb, 0x2f, 0x9a, 0x58, 0x1f, 0x65, 0xb3, 0xfe, 0x61, 0x8d, 0xf5, 0x13, 0x66, 0x5c, 0xd2, 0x98,
-	0xf1, 0x24, 0x80, 0x4b, 0xb0, 0x4b, 0xb9, 0x03, 0xb7, 0x84, 0x1d, 0x4f, 0x81, 0xd0, 0x8d, 0x92,
-	0xbb, 0xe7, 0x7a, 0xbe, 0xbb, 0xe3, 0xe3, 0xb5, 0x5d, 0xb7, 0xdb, 0xc5, 0x3e, 0xe3, 0xf5, 0x9a,
-	0x33, 0x00, 0xa7, 0xb8, 0xd1, 0xae, 0x47, 0x5a, 0xd7, 0x5d, 0x12, 0xed, 0x5f, 0x24, 0xc1, 0x6d,
-	0x4c, 0x04, 0xeb, 0x0f, 0xc0, 0xe9, 0x66, 0x6e, 0xd7, 0x0d, 0xd9, 0x46, 0x7d, 0xcd, 0x8d, 0x5c,
-	0x3f, 0x68, 0xb3, 0xd9, 0x50, 0x73, 0xd2, 0x60, 0x3a, 0xfa, 0xec, 0x0c, 0x63, 0x55, 0x56, 0xc7,
-	0xe6, 0x47, 0xcd, 0x49, 0x41, 0x69, 0x89, 0xe2, 0xaa, 0xfa, 0x75, 0x12, 0xec, 0x79, 0x2d, 0x4c,
-	0x84, 0x31, 0x31, 0x0d, 0xa6, 0xdb, 0x7b, 0x01, 0x7a, 0xb7, 0x1f, 0xf5, 0xfa, 0x91, 0x30, 0x2d,
-	0xea, 0x40, 0xfb, 0x57, 0x65, 0x28, 0xf3, 0x4d, 0xd3, 0xff, 0xe2, 0x29, 0x33, 0x60, 0x61, 0x2e,
-	0xe6, 0x58, 0x98, 0x8b, 0xa3, 0x59, 0x98, 0x1b, 0x8a, 0x85, 0x7f, 0x4e, 0x58, 0xa3, 0xa4, 0x11,
-	0xff, 0x84, 0x6a, 0xc4, 0xe7, 0x53, 0x49, 0xb1, 0xd1, 0x9f, 0x04, 0x08, 0xfb, 0x3d, 0x4c, 0xb8,
-	0xd5, 0xfd, 0x90, 0xb0, 0xfa, 0xc6, 0x10, 0x5a, 0x72, 0x6c, 0x93, 0xe7, 0xd3, 0x29, 0xb1, 0xb9,
-	0x37, 0x14, 0x63, 0xae, 0x98, 0x4a, 0xb1, 0xa1, 0x76, 0x19, 0x2a, 0xb7, 0xb8, 0x41, 0x81, 0xcf,
-	0x25, 0x91, 0x62, 0xe5, 0x35, 0x77, 0x71, 0xab, 0xef, 0x63, 0x69, 0xf8, 0x94, 0x69, 0x29, 0x4c,
-	0x96, 0x34, 0x61, 0xd2, 0xc3, 0x24, 0x0c, 0xba, 0x62, 0x1a, 0x89, 0x94, 0x22, 0x64, 0x96, 0x35,
-	0x21, 0xb3, 0x1c, 0xef, 0xc3, 0xf8, 0xe2, 0x21, 0x77, 0x59, 0xcc, 0x5a, 0xd7, 0x6d, 0xf7, 0xe9,
-	0x88, 0xd4, 0xa5, 0xb5, 0x8e, 0xa7, 0xb9, 0x40, 0xe9, 0xb9, 0x4d, 0xda, 0x0b, 0xbe, 0x7a, 0xc4,
-	0x69, 0x2a, 0x10, 0xb8, 0xb9, 0x59, 0xac, 0x1f, 0x2c, 0xa1, 0x4c, 0xfc, 0xe3, 0xda, 0xc4, 0x57,
-	0x8d, 0xc2, 0x7c, 0x09, 0x89, 0xd3, 0xf6, 0xcf, 0x4a, 0x50, 0xe6, 0x96, 0x8a, 0xfb, 0xc8, 0xfa,
-	0x75, 0x98, 0xde, 0xf1, 0x22, 0x42, 0x99, 0x47, 0x28, 0xf2, 0x22, 0xc9, 0x1d, 0x92, 0xb8, 0x66,
-	0xea, 0xbd, 0x8f, 0x85, 0x32, 0xaf, 0x82, 0x28, 0x23, 0x88, 0xe4, 0x0d, 0x22, 0x8d, 0xdd, 0x0a,
-	0x44, 0x63, 0xbf, 0xea, 0x20, 0xfb, 0xe1, 0xce, 0x0e, 0x6e, 0xd1, 0x9c, 0x35, 0x61, 0xcd, 0x16,
-	0x69, 0x3a, 0x6d, 0x70, 0xb7, 0x19, 0xb4, 0xbc, 0x6e, 0x5b, 0x58, 0x98, 0xf8, 0x62, 0x91, 0x82,
-	0x32, 0xc6, 0xbf, 0xdb, 0xf3, 0x08, 0x0e, 0x63, 0xc6, 0xe7, 0x49, 0x65, 0xb9, 0x9e, 0xd5, 0x96,
-	0xeb, 0x93, 0x00, 0x3d, 0xdf, 0xdd, 0xc7, 0x84, 0xf9, 0x2c, 0xf2, 0xb0, 0x5d, 0x0a, 0x04, 0x3d,
-	0x0b, 0x8b, 0x89, 0x12, 0xb2, 0x16, 0x74, 0x7a, 0x6e, 0x77, 0x5f, 0x84, 0xf1, 0x1a, 0xfc, 0x40,
-	0xdb, 0x49, 0x70, 0xdb, 0x0b, 0xba, 0x21, 0xf3, 0x1d, 0xc2, 0x2d, 0x36, 0x19, 0x8a, 0x4e, 0x0a,
-	0x8a, 0xce, 0xc1, 0x12, 0xe1, 0xfe, 0xdc, 0xe1, 0x66, 0x7f, 0x27, 0x91, 0x29, 0x7c, 0x72, 0x64,
-	0x7e, 0xa3, 0x2d, 0xed, 0xf7, 0xfc, 0xc0, 0xe5, 0xf3, 0x7a, 0x91, 0xb7, 0x34, 0x81, 0x24, 0x8a,
-	0x07, 0x52, 0x14, 0x0f, 0xfb, 0x27, 0x25, 0xa8, 0xca, 0x10, 0x19, 0xf7, 0x93, 0x6b, 0x4e, 0xc1,
-	0x8c, 0x88, 0x9f, 0x73, 0x31, 0x68, 0xed, 0x4b, 0xd3, 0x9e, 0x02, 0xa2, 0xb4, 0x11, 0xc9, 0x4d,
-	0xed, 0xee, 0x64, 0x0a, 0x4a, 0xeb, 0xe8, 0xb9, 0x6d, 0xbc, 0xde, 0x8d, 0x8d, 0x0f, 0x22, 0xc9,
-	0x6c, 0xcf, 0x6e, 0x1b, 0x6f, 0x52, 0x39, 0xc7, 0xd8, 0xa7, 0xe8, 0x24, 0x00, 0xfa, 0xf5, 0x4e,
-	0x40, 0x5a, 0x6b, 0xb1, 0xc1, 0xae, 0xe8, 0x24, 0x00, 0xca, 0x5d, 0xb7, 0xf1, 0x3e, 0x4d, 0x87,
-	0x82, 0x77, 0xe2, 0xb4, 0xa6, 0x0d, 0xcc, 0xa4, 0xb4, 0x81, 0xe4, 0x04, 0x75, 0x56, 0x3b, 0x41,
-	0x45, 0xb1, 0x73, 0xb6, 0x15, 0xbb, 0xce, 0x23, 0x28, 0x85, 0xee, 0x2d, 0xe9, 0x15, 0xc7, 0x7e,
-	0x33, 0x0b, 0x44, 0xd0, 0xf3, 0x9a, 0x42, 0x2a, 0xf2, 0x84, 0xea, 0xf6, 0xbe, 0xa0, 0xbb, 0xbd,
-	0x53, 0x41, 0xbb, 0xf3, 0x25, 0x4a, 0x8a, 0x3d, 0x2c, 0xe4, 0x61, 0x02, 0xa0, 0x5f, 0x9b, 0x01,
-	0xdf, 0x5b, 0x86, 0x42, 0x26, 0x26, 0x00, 0x31, 0xfb, 0x9a, 0x7e, 0x3f, 0x4c, 0x4e, 0x84, 0x14,
-	0x08, 0xd5, 0x6c, 0x44, 0x35, 0x9b, 0x1d, 0xd7, 0x97, 0x32, 0x52, 0x83, 0xd9, 0x3f, 0x2a, 0xc0,
-	0x9c, 0x76, 0x61, 0x6c, 0x80, 0x69, 0xf4, 0x3d, 0x76, 0xc1, 0x68, 0x88, 0x29, 0x66, 0x1a, 0x62,
-	0x4a, 0x06, 0xef, 0xff, 0xb2, 0x4e, 0x06, 0x75, 0x48, 0x2a, 0xa9, 0x21, 0x49, 0xed, 0x87, 0xa7,
-	0x07, 0xf6, 0xc3, 0x9a, 0xd1, 0x6b, 0x66, 0x54, 0xa3, 0x97, 0x74, 0x41, 0x9f, 0x1d, 0xe6, 0x82,
-	0x6e, 0xbf, 0x0e, 0x8b, 0x57, 0x70, 0xb4, 0x15, 0xf4, 0xb6, 0xdc, 0xf6, 0xb0, 0x78, 0xa9, 0xb3,
-	0x60, 0x49, 0xe7, 0x5a, 0xab, 0x6b, 0xff, 0xc8, 0x02, 0xa4, 0x66, 0x1d, 0x27, 0x58, 0xf7, 0x60,
-	0xbe, 0xc9, 0xef, 0xd6, 0x34, 0xc4, 0xdd, 0x1a, 0xc9, 0xc5, 0x56, 0x72, 0x01, 0xc4, 0xbe, 0x04,
-	0x47, 0xd5, 0xbb, 0xfc, 0x23, 0xf4, 0x31, 0xeb, 0xdc, 0xe4, 0x27, 0x16, 0xd4, 0x07, 0x8b, 0x19,
-	0x27, 0xcc, 0x87, 0x29, 0xf7, 0xfd, 0xe9, 0xf5, 0x71, 0x38, 0xf6, 0x9e, 0x4b, 0x3a, 0xfd, 0xde,
-	0x9a, 0xdb, 0xdc, 0x4d, 0x59, 0xc7, 0xed, 0x13, 0xd0, 0xc8, 0xfa, 0x28, 0x6e, 0x17, 0x7d, 0x0e,
-	0x9e, 0x52, 0xdb, 0x9a, 0x13, 0xff, 0x5c, 0x12, 0xca, 0xd2, 0x0d, 0xa1, 0xf4, 0x3f, 0xb7, 0xd9,
-	0x88, 0x43, 0x40, 0x0a, 0x60, 0x86, 0xd0, 0x6f, 0x16, 0xe0, 0xe9, 0xa1, 0x65, 0x0b, 0xa2, 0xde,
-	0xd4, 0x88, 0x7a, 0x71, 0x38, 0x51, 0x73, 0x0a, 0x9b, 0x9c, 0xc6, 0x37, 0x04, 0x8d, 0x3f, 0x3d,
-	0x70, 0x6b, 0xeb, 0xc5, 0x91, 0xa3, 0x64, 0x64, 0xdc, 0xe0, 0xba, 0x0c, 0x4f, 0x67, 0xbf, 0x3d,
-	0x71, 0x91, 0xd9, 0xab, 0x28, 0xf1, 0x24, 0x91, 0x73, 0x09, 0xfa, 0xad, 0x02, 0x9c, 0x1e, 0x5e,
-	0x90, 0xa0, 0xe8, 0x7b, 0x1a, 0x45, 0xd7, 0x4c, 0x1e, 0x51, 0xa3, 0x95, 0xf6, 0x91, 0x23, 0xe9,
-	0xcf, 0x2d, 0x38, 0x9e, 0xc7, 0x4f, 0x43, 0xcd, 0xfa, 0x67, 0x61, 0x51, 0x33, 0x24, 0x2a, 0x04,
-	0x5f, 0x50, 0x8d, 0x89, 0x14, 0x8e, 0x9e, 0x86, 0x43, 0x12, 0x46, 0x65, 0x68, 0x62, 0x78, 0x9e,
-	0x57, 0xc1, 0x7a, 0xa9, 0x0c, 0x91, 0x4d, 0x98, 0x92, 0x5e, 0x2a, 0xfd, 0x40, 0x4b, 0x3e, 0xf3,
-	0x1a, 0x54, 0xe5, 0x15, 0x18, 0xb4, 0x08, 0x73, 0xf2, 0xf7, 0xf6, 0xb5, 0x77, 0xaf, 0xad, 0x2f,
-	0x4c, 0xa1, 0x19, 0x98, 0xde, 0xb8, 0xb6, 0x76, 0xf5, 0xc6, 0xa5, 0xf5, 0x05, 0x8b, 0x26, 0xd6,
-	0x3f, 0xcb, 0x13, 0x85, 0x33, 0x9f, 0x84, 0xaa, 0xbc, 0x0b, 0x41, 0x33, 0xca, 0xdf, 0x32, 0xe3,
-	0x3c, 0xc0, 0xf5, 0x75, 0x67, 0x6d, 0xfd, 0xda, 0xd6, 0xea, 0x15, 0x9a, 0xb7, 0x06, 0xe5, 0xf5,
-	0xcf, 0xdc, 0x58, 0xbd, 0xba, 0x50, 0x40, 0xd3, 0x50, 0xbc, 0xb2, 0xb5, 0xbe, 0x50, 0xa4, 0x3f,
-	0xae, 0x6e, 0xad, 0x2f, 0x94, 0xce, 0xfd, 0xe2, 0xcd, 0xf8, 0x31, 0x41, 0x69, 0xcc, 0xf9, 0x22,
-	0xd4, 0x84, 0xee, 0xb6, 0x83, 0xd1, 0x93, 0xd9, 0xe7, 0x38, 0xf2, 0xbb, 0xe0, 0xdb, 0xc6, 0x53,
-	0xc3, 0xd0, 0xc4, 0xb8, 0x4d, 0x21, 0x17, 0x20, 0x79, 0xf8, 0x0e, 0x3d, 0x6d, 0xe4, 0x4b, 0xfd,
-	0x6d, 0xbf, 0xc6, 0xe9, 0xe1, 0x88, 0x71, 0x15, 0x6d, 0x98, 0x55, 0x5f, 0x98, 0x43, 0xcf, 0xe4,
-	0x85, 0xf1, 0xd2, 0x5e, 0x10, 0x6b, 0x9c, 0x19, 0x05, 0x55, 0xad, 0x48, 0x7d, 0xd1, 0xcd, 0x54,
-	0x51, 0xc6, 0x93, 0x73, 0xa6, 0x8a, 0xb2, 0x1e, 0x88, 0xe3, 0x44, 0x4b, 0x5e, 0x5a, 0x33, 0x11,
-	0x6d, 0xe0, 0x81, 0x37, 0x13, 0xd1, 0x06, 0x1f, 0x6d, 0xe3, 0x7d, 0x51, 0xdf, 0x3e, 0x33, 0xf5,
-	0x25, 0xe3, 0xd9, 0x35, 0x53, 0x5f, 0xb2, 0x9e, 0x52, 0xe3, 0x15, 0xa9, 0x4f, 0x8b, 0x99, 0x2a,
-	0xca, 0x78, 0x41, 0xcd, 0x54, 0x51, 0xd6, 0x4b, 0x65, 0xf6, 0x14, 0xea, 0xc0, 0xbc, 0xfe, 0x7a,
-	0x17, 0x3a, 0x9b, 0x37, 0xba, 0xa9, 0x97, 0x98, 0x1a, 0xcf, 0x8e, 0x86, 0xac, 0x56, 0xa7, 0xbf,
-	0x93, 0x65, 0xaa, 0x2e, 0xf3, 0x45, 0x2f, 0x53, 0x75, 0xd9, 0x4f, 0x6f, 0x71, 0x32, 0xaa, 0xef,
-	0x57, 0x99, 0xc8, 0x98, 0xf1, 0x74, 0x96, 0x89, 0x8c, 0x59, 0xcf, 0x61, 0xf1, 0x7e, 0xe9, 0x2f,
-	0x4a, 0x99, 0xfa, 0x95, 0xf9, 0xa0, 0x95, 0xa9, 0x5f, 0xd9, 0x8f, 0x54, 0xd9, 0x53, 0x88, 0xf0,
-	0xa0, 0xe2, 0xf2, 0x4b, 0xb2, 0x16, 0xa1, 0xec, 0x6b, 0x74, 0x14, 0x79, 0x60, 0x1d, 0x1d, 0xb3,
-	0x8b, 0xdf, 0xd2, 0x42, 0xf0, 0xeb, 0xd1, 0x99, 0x5f, 0x1e, 0x67, 0xe5, 0x8c, 0x39, 0xf5, 0x95,
-	0x31, 0x73, 0xc5, 0x4d, 0xf9, 0x9d, 0x74, 0x68, 0x77, 0xed, 0x95, 0xa1, 0x57, 0x47, 0x08, 0x48,
-	0x98, 0xa1, 0xbb, 0x35, 0x5e, 0x1b, 0x3b, 0x9f, 0xd6, 0x20, 0xe3, 0x43, 0x45, 0xa6, 0x06, 0x0d,
-	0x7b, 0x8a, 0xc9, 0xd4, 0xa0, 0xa1, 0x2f, 0x22, 0x89, 0xc1, 0x32, 0x3c, 0x20, 0x64, 0x1a, 0xac,
-	0xfc, 0x27, 0x8d, 0x4c, 0x83, 0x35, 0xe4, 0x95, 0x22, 0x41, 0x1b, 0xe3, 0x9b, 0x3e, 0x26, 0xda,
-	0x0c, 0x7b, 0x68, 0xc8, 0x44, 0x9b, 0xa1, 0x8f, 0x07, 0xd9, 0x53, 0xa8, 0x05, 0x33, 0xca, 0x6b,
-	0x3a, 0xe8, 0xf4, 0x30, 0x2e, 0x8c, 0xf9, 0xf5, 0x99, 0x11, 0x30, 0xe3, 0x5a, 0xbe, 0x04, 0x73,
-	0x1a, 0xe7, 0xa0, 0x33, 0x23, 0xc5, 0xc9, 0xe4, 0x35, 0x9d, 0x1d, 0x09, 0x57, 0xad, 0x4b, 0x63,
-	0x0a, 0x74, 0x66, 0x04, 0xce, 0x19, 0x52, 0x57, 0xe6, 0xeb, 0x31, 0x9c, 0x7a, 0xca, 0x98, 0xa3,
-	0xd3, 0x43, 0xd9, 0x62, 0x08, 0xf5, 0x32, 0x1e, 0x6e, 0xe1, 0x3d, 0xd2, 0x86, 0x12, 0x9d, 0x19,
-	0x61, 0xbc, 0x87, 0xf4, 0x28, 0xf3, 0x39, 0x15, 0x7b, 0x0a, 0xf5, 0xe0, 0x50, 0xea, 0x69, 0x11,
-	0xf4, 0xec, 0xb0, 0x91, 0x56, 0x1f, 0x52, 0x69, 0x3c, 0x37, 0x22, 0x76, 0x5c, 0xe3, 0x1e, 0x2c,
-	0x0e, 0xbc, 0x4c, 0x81, 0x56, 0x46, 0x19, 0xf3, 0xe4, 0x75, 0x85, 0xc6, 0xf3, 0x23, 0xe3, 0xab,
-	0xf5, 0x0e, 0x3c, 0x15, 0x62, 0xaa, 0xd7, 0xf4, 0xca, 0x89, 0xa9, 0x5e, 0xe3, 0x1b, 0x24, 0x9c,
-	0xc2, 0xa9, 0x87, 0x2a, 0x4c, 0x14, 0xce, 0x7e, 0x2a, 0xc3, 0x44, 0x61, 0xc3, 0xeb, 0x17, 0xbc,
-	0xa7, 0x03, 0x4f, 0x47, 0x98, 0x7a, 0x6a, 0x7a, 0xbf, 0xc2, 0xd4, 0x53, 0xe3, 0x9b, 0x14, 0x9c,
-	0x6f, 0xb5, 0x98, 0x29, 0x26, 0xbe, 0xcd, 0x7a, 0x8b, 0xc2, 0xc4, 0xb7, 0x99, 0x8f, 0x18, 0xf0,
-	0xba, 0xb4, 0xc8, 0xfa, 0xa6, 0xba, 0xb2, 0x9e, 0x20, 0x30, 0xd5, 0x95, 0x19, 0xaa, 0x9f, 0x2b,
-	0x52, 0xaa, 0x0f, 0x9d, 0x49, 0x91, 0xca, 0x70, 0x3c, 0x34, 0x69, 0x19, 0x59, 0x9e, 0x7d, 0xf6,
-	0x14, 0x7a, 0x5f, 0x3e, 0xe8, 0x95, 0x0a, 0xcc, 0x8f, 0xce, 0xe5, 0xb1, 0x7b, 0x76, 0x14, 0xff,
-	0x71, 0xc5, 0xe8, 0x9e, 0x7c, 0xf1, 0x5b, 0x8b, 0xc2, 0x8f, 0x5e, 0xc8, 0x2b, 0x25, 0x2b, 0x60,
-	0xff, 0xb8, 0xf5, 0xc6, 0x7d, 0x4e, 0x85, 0xdd, 0xcf, 0xef, 0x73, 0x76, 0x8c, 0xfe, 0x71, 0xeb,
-	0xbe, 0x2b, 0x9f, 0x76, 0xd3, 0x63, 0xec, 0xa3, 0x17, 0x73, 0xc9, 0x9d, 0x15, 0x8f, 0x7f, 0xdc,
-	0x9a, 0x23, 0x40, 0x83, 0x21, 0xf5, 0x51, 0xae, 0x54, 0xcb, 0x08, 0xbe, 0x3f, 0x6e, 0xad, 0x5f,
-	0x95, 0xaf, 0x15, 0xa7, 0xa3, 0xe8, 0xa3, 0x97, 0xf2, 0x0a, 0x32, 0xc4, 0xdc, 0x9f, 0xb8, 0xcf,
-	0x6a, 0xc0, 0xfc, 0xfc, 0x3e, 0x67, 0x84, 0xd6, 0x9f, 0x78, 0x8c, 0xf5, 0x18, 0xf9, 0xf9, 0x63,
-	0x9c, 0x19, 0x4f, 0x7f, 0xe2, 0x9a, 0xf5, 0x90, 0xf8, 0xf9, 0x35, 0x67, 0x86, 0xcf, 0x9f, 0x98,
-	0xd2, 0x6a, 0x04, 0xfc, 0x7c, 0x4a, 0x67, 0xc4, 0xca, 0x9f, 0x78, 0x26, 0xa7, 0x82, 0xde, 0xe7,
-	0xcf, 0xe4, 0xec, 0x08, 0xf9, 0xe3, 0xd6, 0xdd, 0x83, 0x85, 0x74, 0x88, 0x7b, 0xf4, 0x5c, 0xee,
-	0x2c, 0x4e, 0x87, 0xc2, 0x1f, 0xb7, 0xc6, 0xef, 0xc4, 0x2f, 0x1e, 0x64, 0x7b, 0xaa, 0xbe, 0x3e,
-	0x41, 0x64, 0x78, 0xde, 0x90, 0xf3, 0x13, 0xc7, 0x94, 0x67, 0x53, 0xfc, 0x70, 0x46, 0x7c, 0x75,
-	0x93, 0x18, 0x37, 0x87, 0x7a, 0x6f, 0xbc, 0x38, 0x76, 0xf0, 0x76, 0x7b, 0x0a, 0x85, 0xb0, 0x90,
-	0x0e, 0x5f, 0x8e, 0x9e, 0x1b, 0x35, 0xcc, 0x39, 0xaf, 0x77, 0x65, 0xbc, 0xa8, 0xe8, 0xf6, 0x14,
-	0xfa, 0x00, 0x96, 0xb2, 0x82, 0x76, 0xa3, 0x17, 0xc7, 0x09, 0xf0, 0xcd, 0x2b, 0x3f, 0x37, 0x7e,
-	0x4c, 0x70, 0x7b, 0x0a, 0x7d, 0x68, 0xb1, 0xe8, 0x9f, 0x83, 0x01, 0xb5, 0x91, 0xb9, 0x3c, 0x63,
-	0xfc, 0xee, 0xc6, 0x4b, 0x63, 0xe5, 0x51, 0x07, 0x3e, 0x23, 0x54, 0xb5, 0x69, 0xe0, 0xcd, 0xa1,
-	0xb6, 0x4d, 0x03, 0x9f, 0x13, 0x07, 0xdb, 0x9e, 0x42, 0x7f, 0x6a, 0xc1, 0x63, 0x43, 0x43, 0x33,
-	0xa3, 0xb7, 0x26, 0x8e, 0xe9, 0xcc, 0x9b, 0xf6, 0xf6, 0x01, 0x63, 0x42, 0xdb, 0x53, 0xe8, 0x07,
-	0x16, 0x9c, 0xcc, 0x8f, 0x37, 0x8c, 0xde, 0x9c, 0x2c, 0x4a, 0x31, 0x6f, 0xe2, 0x85, 0x83, 0x84,
-	0x38, 0xb6, 0xa7, 0xd0, 0x8f, 0x2d, 0xb0, 0x87, 0x07, 0xc2, 0x45, 0x6f, 0x4f, 0x1e, 0x42, 0x97,
-	0xb7, 0xf3, 0x9d, 0x83, 0xc6, 0xe0, 0xe5, 0x76, 0x3f, 0x3d, 0x88, 0xad, 0xc9, 0xee, 0x97, 0x19,
-	0x1a, 0xd7, 0x64, 0xf7, 0xcb, 0x8e, 0x8b, 0x1b, 0xcf, 0xf3, 0x81, 0x88, 0xaf, 0x39, 0xf3, 0xdc,
-	0x14, 0xdd, 0x36, 0x67, 0x9e, 0x1b, 0x03, 0xca, 0x0a, 0x63, 0x8e, 0x31, 0x40, 0x2a, 0x7a, 0x75,
-	0xec, 0x88, 0xaa, 0xb9, 0xc6, 0x9c, 0xa1, 0x91, 0x58, 0xed, 0x29, 0xf4, 0x7d, 0x1e, 0xc9, 0xdf,
-	0x1c, 0x50, 0x14, 0xbd, 0x31, 0x51, 0x14, 0x52, 0xde, 0xb0, 0x37, 0x0f, 0x10, 0xc1, 0xd4, 0x9e,
-	0x42, 0x7f, 0x64, 0xc1, 0x89, 0xbc, 0x10, 0x9a, 0xe8, 0xfc, 0x24, 0x61, 0x37, 0x79, 0xd3, 0xde,
-	0x98, 0x3c, 0x62, 0x27, 0x3f, 0x2b, 0x49, 0xbc, 0x0d, 0x4c, 0x67, 0x25, 0x03, 0x2e, 0x10, 0xa6,
-	0xb3, 0x92, 0x41, 0xc7, 0x05, 0xbe, 0x10, 0xa6, 0x0f, 0xf8, 0x4d, 0x0b, 0xa1, 0xc1, 0x1b, 0xc1,
-	0xb4, 0x10, 0x9a, 0xfc, 0x06, 0xec, 0x29, 0xb4, 0x0f, 0x68, 0xf0, 0x1c, 0xdf, 0xa4, 0xf6, 0x19,
-	0xdd, 0x01, 0x1a, 0x2f, 0x8c, 0x9e, 0x21, 0xae, 0xfa, 0x8f, 0x2d, 0x78, 0x74, 0xc8, 0xe1, 0x3b,
-	0xba, 0x30, 0xe1, 0x99, 0x3d, 0x6f, 0xd5, 0x27, 0x0e, 0x74, 0xe2, 0x6f, 0x4f, 0xa1, 0x3f, 0xb1,
-	0xe0, 0xd4, 0xb0, 0xd3, 0x6c, 0xf4, 0x89, 0x49, 0x4f, 0xc1, 0x79, 0x23, 0xdf, 0x3a, 0xd8, 0x21,
-	0xba, 0x3d, 0x85, 0xee, 0x30, 0x21, 0xc7, 0x44, 0x10, 0xf3, 0x71, 0x8e, 0xaf, 0x21, 0x66, 0x78,
-	0xf8, 0x64, 0x20, 0x1a, 0x98, 0xc7, 0x8c, 0x2e, 0x2b, 0xde, 0xa9, 0x30, 0x4f, 0xa0, 0x97, 0xfe,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xea, 0x71, 0x5c, 0xa7, 0x91, 0x00, 0x00,
+	// 7473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5d, 0x8f, 0x1c, 0xc7,
+	0x71, 0x9c, 0xfd, 0xba, 0xdb, 0xba, 0x0f, 0xde, 0x35, 0x8f, 0xe4, 0x6a, 0x49, 0x51, 0xa7, 0x11,
+	0x29, 0x51, 0xfc, 0x38, 0x89, 0xd4, 0x07, 0x25, 0x59, 0x5f, 0xc7, 0xe3, 0x91, 0x39, 0x5b, 0xa6,
+	0xe8, 0xb9, 0xa3, 0x24, 0xc7, 0x0e, 0x98, 0xb9, 0xdd, 0xe6, 0xde, 0x98, 0xb3, 0x3b, 0xeb, 0x99,
+	0xd9, 0x23, 0x4f, 0xb2, 0xa3, 0xc0, 0xb0, 0x0d, 0x23, 0x71, 0x9c, 0xc4, 0xb1, 0x91, 0xc0, 0x80,
+	0x8d, 0x18, 0x01, 0x02, 0x04, 0x88, 0x03, 0x23, 0x3f, 0x20, 0x89, 0x81, 0x20, 0xc8, 0x43, 0x10,
+	0x04, 0xc8, 0x7b, 0x5e, 0x12, 0x23, 0x4f, 0x79, 0x48, 0x10, 0x20, 0x7e, 0x49, 0x10, 0x54, 0x7f,
+	0xcc, 0x74, 0xcf, 0xce, 0xcc, 0xee, 0xec, 0x91, 0x94, 0x84, 0xe4, 0xe5, 0x6e, 0xbb, 0xba, 0xba,
+	0xbb, 0xba, 0xba, 0xba, 0xba, 0xa6, 0xba, 0xba, 0x1b, 0x56, 0x02, 0xea, 0xef, 0x52, 0xff, 0x99,
+	0x96, 0xd7, 0x0b, 0x69, 0x2f, 0x3c, 0x1f, 0xf8, 0xbb, 0xcf, 0xf4, 0x7d, 0x2f, 0xf4, 0x24, 0x44,
+	0xfe, 0x5f, 0x61, 0x50, 0xb2, 0xd4, 0xf1, 0x56, 0xba, 0x4e, 0xcb, 0xf7, 0x56, 0x02, 0x7f, 0x77,
+	0x45, 0xe4, 0x35, 0x1f, 0xe9, 0x78, 0x5e, 0xc7, 0xa5, 0xbc, 0xe4, 0xf6, 0xe0, 0xf6, 0x33, 0x76,
+	0x6f, 0x8f, 0x17, 0x68, 0x9e, 0x15, 0x0d, 0x04, 0xa1, 0x1d, 0x3a, 0x2d, 0xa5, 0x7e, 0x0e, 0x10,
+	0xff, 0x04, 0xf2, 0x29, 0x81, 0x3c, 0x08, 0xa8, 0xaf, 0xa0, 0x62, 0x92, 0xfd, 0x49, 0xa0, 0x85,
+	0x5e, 0xdb, 0x53, 0xd0, 0x30, 0xc9, 0xfe, 0x8c, 0x6a, 0xba, 0x3f, 0xd8, 0x0e, 0x06, 0xdb, 0xe2,
+	0x1f, 0x47, 0x36, 0x2f, 0x03, 0x6c, 0x7a, 0x03, 0xbf, 0x45, 0xaf, 0xd8, 0xa1, 0x4d, 0x9e, 0x87,
+	0x5a, 0xc0, 0x52, 0x0d, 0x63, 0xd9, 0x38, 0x3d, 0x73, 0xf1, 0xf8, 0x4a, 0x5a, 0xbf, 0x57, 0x78,
+	0x09, 0x4b, 0xe0, 0x9a, 0xd7, 0x60, 0x8e, 0x43, 0x56, 0x7d, 0x9f, 0x55, 0xf3, 0x22, 0x4c, 0xf1,
+	0xac, 0xa0, 0x61, 0x2c, 0x97, 0x47, 0xd6, 0x23, 0x91, 0xcd, 0x0d, 0x98, 0xdd, 0xb2, 0xef, 0x79,
+	0x3d, 0xaf, 0xbb, 0xc7, 0xea, 0x79, 0x19, 0xa6, 0x43, 0x91, 0x16, 0x04, 0x3d, 0xaa, 0x57, 0x24,
+	0xb8, 0x28, 0x0b, 0x59, 0x11, 0xba, 0x79, 0x1d, 0x0e, 0x4a, 0xa8, 0xa4, 0xea, 0x53, 0x50, 0x97,
+	0xd9, 0x92, 0xae, 0x11, 0xd5, 0xc5, 0xf8, 0xe6, 0x00, 0x8e, 0xae, 0x71, 0xaa, 0xd7, 0xec, 0x90,
+	0x76, 0x3c, 0x7f, 0x6f, 0x23, 0xa4, 0x5d, 0x56, 0xef, 0x2f, 0xc3, 0xa1, 0xd6, 0x70, 0x96, 0x20,
+	0xf8, 0x74, 0x6a, 0x0b, 0x29, 0x55, 0x59, 0x69, 0x95, 0x98, 0xef, 0x43, 0x33, 0x05, 0x57, 0xf6,
+	0xe8, 0x8b, 0xb0, 0x94, 0x52, 0x48, 0x76, 0x6e, 0xfc, 0xa6, 0x53, 0x6b, 0x31, 0xaf, 0xc2, 0x8c,
+	0x40, 0x66, 0x8d, 0x5d, 0x82, 0x29, 0x81, 0x96, 0x3e, 0x16, 0x72, 0x50, 0x45, 0x19, 0x4b, 0x62,
+	0x9b, 0x9f, 0x81, 0x79, 0x01, 0x93, 0x74, 0xbf, 0x0c, 0xd3, 0x22, 0x33, 0x63, 0x20, 0x92, 0x75,
+	0x45, 0xe8, 0xe6, 0x3b, 0x70, 0x50, 0x02, 0x07, 0x2e, 0x17, 0xda, 0x35, 0x98, 0x69, 0xc5, 0x20,
+	0x41, 0xdc, 0xe3, 0xf9, 0x15, 0x0e, 0x5c, 0x6a, 0xa9, 0xa5, 0xcc, 0x2f, 0x00, 0x51, 0xf2, 0x24,
+	0xa1, 0xeb, 0x30, 0xab, 0x20, 0x49, 0x62, 0xc7, 0xa8, 0x5b, 0x2b, 0x66, 0xfe, 0xa5, 0x01, 0x8b,
+	0xab, 0xae, 0xcb, 0xc5, 0x3d, 0xb0, 0xe8, 0x97, 0x07, 0x34, 0x08, 0xc9, 0x61, 0xa8, 0x79, 0x7e,
+	0xe7, 0x96, 0xd3, 0x66, 0x24, 0xd7, 0xad, 0xaa, 0xe7, 0x77, 0x36, 0xda, 0xe4, 0x28, 0x4c, 0x85,
+	0xd4, 0xee, 0x22, 0xbc, 0xc4, 0xe0, 0x35, 0x4c, 0x6e, 0xb4, 0xc9, 0x12, 0x54, 0x5d, 0xa7, 0xeb,
+	0x84, 0x8d, 0xf2, 0xb2, 0x71, 0xba, 0x6c, 0xf1, 0x04, 0x39, 0x02, 0x35, 0xef, 0xf6, 0xed, 0x80,
+	0x86, 0x8d, 0x0a, 0x03, 0x8b, 0x14, 0x39, 0x05, 0xf3, 0x81, 0xe7, 0x87, 0xb7, 0xfa, 0xb6, 0x6f,
+	0x77, 0x69, 0x48, 0xfd, 0x46, 0x95, 0xd5, 0x36, 0x87, 0xd0, 0x1b, 0x12, 0x18, 0xa1, 0xb5, 0x1d,
+	0x9f, 0xb6, 0x42, 0xc7, 0xeb, 0x35, 0x6a, 0x31, 0xda, 0x15, 0x09, 0x34, 0x3f, 0x00, 0xa2, 0x76,
+	0x20, 0xe8, 0x7b, 0xbd, 0x80, 0x92, 0x4b, 0x50, 0x69, 0xdb, 0xa1, 0x2d, 0x58, 0xfe, 0x44, 0xde,
+	0x24, 0x17, 0x1c, 0xb5, 0x58, 0x01, 0x42, 0xa0, 0xd2, 0xf2, 0xda, 0x94, 0x75, 0xb0, 0x6c, 0xb1,
+	0xdf, 0xa4, 0x01, 0x53, 0x5d, 0x1a, 0x04, 0x76, 0x87, 0xb2, 0x0e, 0xd6, 0x2d, 0x99, 0x34, 0x3f,
+	0x80, 0x43, 0x6b, 0x3e, 0xb5, 0x43, 0x2a, 0xf4, 0x85, 0xe0, 0xdf, 0x44, 0xca, 0x4a, 0xe1, 0x7a,
+	0x29, 0x83, 0xeb, 0x65, 0x95, 0xeb, 0xe6, 0xfb, 0xb0, 0xa4, 0x37, 0x2e, 0xfa, 0xfe, 0xbc, 0xd6,
+	0xf7, 0xe5, 0xbc, 0xb6, 0xf7, 0xd3, 0xf1, 0x9b, 0xfd, 0xf6, 0x47, 0xd7, 0x71, 0xbd, 0xf1, 0x87,
+	0xd8, 0xf1, 0x4d, 0x58, 0xb4, 0xa8, 0xdd, 0xd6, 0xbb, 0x3d, 0x0f, 0xa5, 0x68, 0xae, 0x94, 0x9c,
+	0x76, 0xe1, 0x0e, 0xdd, 0x03, 0xa2, 0x56, 0xfa, 0x10, 0xbb, 0xd3, 0x83, 0x43, 0x57, 0xa8, 0x4b,
+	0x43, 0x7a, 0x5f, 0x3b, 0x84, 0x19, 0x68, 0x1d, 0x60, 0x46, 0x85, 0x67, 0x60, 0x72, 0xa3, 0x6d,
+	0x5e, 0x81, 0x25, 0xbd, 0x3d, 0xd1, 0x57, 0x49, 0xb5, 0x91, 0x4e, 0x75, 0x49, 0xa7, 0xfa, 0x67,
+	0x06, 0x1c, 0x5a, 0x75, 0x5d, 0xb9, 0x1a, 0x7e, 0x22, 0xf5, 0xd6, 0x87, 0xb0, 0xa4, 0x77, 0x41,
+	0x70, 0xe2, 0x65, 0x6d, 0xd4, 0x4f, 0xa5, 0x8f, 0x7a, 0xc2, 0x80, 0x98, 0x68, 0xe8, 0xbf, 0x66,
+	0xc0, 0x61, 0xae, 0x3f, 0x64, 0x6d, 0x92, 0x8d, 0x93, 0x1b, 0x37, 0x85, 0x25, 0xff, 0xd7, 0xe0,
+	0x48, 0x92, 0x06, 0xc1, 0x87, 0x17, 0x35, 0x3e, 0x98, 0xf9, 0x7c, 0xd8, 0x17, 0x13, 0xb8, 0x2e,
+	0xf9, 0x68, 0x99, 0x90, 0xa4, 0xe1, 0xa1, 0x32, 0xe1, 0x26, 0x1c, 0x42, 0xf5, 0x93, 0xe4, 0xc0,
+	0x7e, 0xb5, 0xda, 0x57, 0x60, 0x49, 0xaf, 0xf6, 0xa1, 0x76, 0xaa, 0x0f, 0x87, 0xb9, 0xa6, 0xb9,
+	0xcf, 0xdd, 0xca, 0xd6, 0x6d, 0x57, 0xe1, 0x48, 0xb2, 0xc5, 0x89, 0xb4, 0xdb, 0xdf, 0x19, 0x70,
+	0x62, 0xd5, 0x75, 0x53, 0xcc, 0xe1, 0x4f, 0xa4, 0xa2, 0xfb, 0xbe, 0x01, 0x8f, 0x65, 0x76, 0x47,
+	0x30, 0xe8, 0x8a, 0x26, 0x12, 0xcf, 0xe6, 0x5a, 0xb1, 0x29, 0x9f, 0x1b, 0x13, 0x09, 0xc8, 0x9f,
+	0x19, 0xb0, 0xcc, 0x75, 0x4f, 0x4a, 0xc5, 0x92, 0xd1, 0x0f, 0xf0, 0x0b, 0xaa, 0xf0, 0x7c, 0xfa,
+	0x9e, 0x01, 0x8f, 0xe7, 0x10, 0x2c, 0x58, 0xb9, 0xaa, 0xb1, 0xf2, 0xfc, 0xd8, 0xac, 0xdc, 0x17,
+	0x1f, 0xb9, 0xfa, 0xfa, 0x04, 0xf1, 0x31, 0x87, 0xe0, 0x8f, 0x8a, 0x8f, 0xbf, 0x0a, 0x27, 0x50,
+	0x5d, 0xe6, 0x30, 0x71, 0xbf, 0x0a, 0xf9, 0xbb, 0x06, 0x3c, 0x96, 0xd9, 0xc4, 0x47, 0xd5, 0xed,
+	0x0f, 0x60, 0x99, 0x6b, 0xcd, 0x07, 0xd7, 0xf1, 0x6c, 0x95, 0xfd, 0x39, 0x78, 0x3c, 0xa7, 0xf1,
+	0x89, 0xb4, 0xf7, 0x5f, 0x19, 0xec, 0x83, 0x54, 0x54, 0xf8, 0x89, 0xd4, 0xd8, 0x5f, 0x85, 0x43,
+	0x5a, 0x0f, 0x04, 0x1f, 0x5e, 0xd2, 0x44, 0xe3, 0x64, 0xae, 0x68, 0xec, 0x47, 0x31, 0xff, 0x81,
+	0x21, 0x3f, 0x6c, 0x45, 0x65, 0x92, 0x87, 0x93, 0xfa, 0x79, 0xd4, 0xf1, 0x2f, 0xa9, 0xe3, 0xaf,
+	0x8c, 0x4a, 0x39, 0x63, 0x54, 0x2a, 0x09, 0x93, 0xe6, 0x70, 0x82, 0x32, 0xc1, 0x9b, 0x17, 0x34,
+	0xde, 0xe4, 0xbb, 0x61, 0xf6, 0xc5, 0x18, 0x4d, 0x71, 0x7d, 0xac, 0x18, 0x93, 0xa0, 0xec, 0x61,
+	0x32, 0xa6, 0xcb, 0xbf, 0x9f, 0x13, 0x5c, 0x79, 0x60, 0x5a, 0xe3, 0x7d, 0x38, 0xa4, 0x35, 0xf7,
+	0x30, 0xbb, 0xea, 0xc9, 0x0f, 0xe8, 0x87, 0xd5, 0xd9, 0x75, 0x38, 0x9c, 0x68, 0x70, 0x22, 0xb5,
+	0xf8, 0xd7, 0x06, 0x1c, 0x89, 0x95, 0x0a, 0x73, 0x3e, 0x7e, 0x12, 0x55, 0xe3, 0x37, 0x0c, 0x38,
+	0x3a, 0xd4, 0x0d, 0xc1, 0x90, 0x57, 0xb5, 0xf1, 0x3f, 0x3d, 0xd2, 0x15, 0xbb, 0x1f, 0x1d, 0xf9,
+	0xdb, 0x06, 0x34, 0xf4, 0x09, 0x87, 0xbe, 0x5d, 0xc1, 0xd0, 0xfb, 0xe1, 0x76, 0x2e, 0x6c, 0x5c,
+	0x7c, 0xdd, 0x80, 0x47, 0x52, 0x28, 0x2a, 0xe2, 0xd5, 0x48, 0xb8, 0xcf, 0x27, 0x66, 0x8c, 0xae,
+	0xa2, 0x3f, 0x0e, 0x8c, 0x49, 0xa1, 0xe8, 0x61, 0x33, 0xe6, 0x3d, 0x38, 0xa2, 0x2a, 0x2d, 0x85,
+	0x2b, 0xfb, 0x35, 0x2b, 0xbf, 0x66, 0xc0, 0xd1, 0xa1, 0xaa, 0x1f, 0x76, 0xf7, 0x02, 0x68, 0xe8,
+	0x6a, 0xea, 0xfe, 0x75, 0x30, 0x5b, 0x37, 0x6e, 0xc0, 0x23, 0x29, 0x8d, 0x4e, 0xa4, 0x1f, 0xff,
+	0xbd, 0x0c, 0x4b, 0x57, 0x1d, 0x37, 0xa4, 0x7e, 0x42, 0xb1, 0xc7, 0xc4, 0xce, 0x64, 0x10, 0x3b,
+	0x9b, 0xae, 0x1d, 0xe7, 0xd2, 0xb5, 0xe3, 0xfc, 0x08, 0xed, 0x78, 0x70, 0x3c, 0xed, 0xb8, 0x90,
+	0xa2, 0x1d, 0xb1, 0x7b, 0xea, 0xee, 0x6a, 0x3d, 0xda, 0x3f, 0x25, 0xcb, 0x30, 0xc3, 0x7f, 0x6e,
+	0xed, 0xf5, 0x69, 0xd0, 0x28, 0xb1, 0x5c, 0x15, 0x44, 0xcc, 0x68, 0x43, 0x8b, 0xa3, 0x94, 0x19,
+	0x8a, 0x06, 0x23, 0x2f, 0xc1, 0x51, 0x91, 0xbe, 0x61, 0xfb, 0xb1, 0xb9, 0xee, 0xd0, 0xa0, 0x51,
+	0x61, 0xe8, 0x59, 0xd9, 0xe4, 0x1c, 0x2c, 0xea, 0x9f, 0x8e, 0x58, 0xa6, 0xca, 0xca, 0x0c, 0x67,
+	0x90, 0x8b, 0x19, 0xbb, 0x97, 0x35, 0x56, 0x20, 0x35, 0x0f, 0x87, 0x3b, 0xdc, 0xeb, 0xd3, 0xc6,
+	0x14, 0xc3, 0x61, 0xbf, 0xc9, 0xa3, 0x00, 0x2d, 0x36, 0xf3, 0xdb, 0xb7, 0xb6, 0xf7, 0x1a, 0xd3,
+	0x2c, 0xa7, 0x2e, 0x20, 0x97, 0xf7, 0xcc, 0x0f, 0xe1, 0x70, 0x62, 0xc8, 0x1f, 0xb2, 0xa5, 0xfd,
+	0x37, 0x06, 0x2c, 0x6d, 0x52, 0xdb, 0x6f, 0xed, 0x64, 0x0a, 0xdd, 0x83, 0x58, 0x92, 0x97, 0xa0,
+	0x1a, 0x3a, 0xa1, 0x4b, 0xc5, 0x4a, 0xcc, 0x13, 0x4c, 0x78, 0x06, 0xdd, 0xae, 0xed, 0xef, 0x89,
+	0xa5, 0x57, 0x26, 0x51, 0x78, 0xda, 0x34, 0x68, 0xf9, 0x4e, 0x9f, 0x89, 0xde, 0x14, 0xcb, 0x55,
+	0x41, 0xe6, 0xdf, 0x1b, 0x70, 0x38, 0xd1, 0x91, 0x22, 0x9e, 0xa5, 0xd4, 0xa2, 0x2b, 0x93, 0xb2,
+	0xb5, 0xb9, 0x0a, 0x95, 0xfd, 0x6e, 0x26, 0x7f, 0xdb, 0x80, 0xe3, 0x7c, 0xd1, 0x58, 0x6d, 0x85,
+	0xce, 0xae, 0x13, 0xee, 0x25, 0x46, 0xe8, 0x55, 0xa8, 0xdb, 0x22, 0x47, 0x56, 0x7e, 0x22, 0xbd,
+	0x72, 0x59, 0x81, 0x15, 0x17, 0x98, 0x64, 0x0d, 0x6b, 0x72, 0x72, 0x2c, 0xda, 0x72, 0xfa, 0x49,
+	0xe3, 0xf3, 0x45, 0x98, 0xf2, 0x19, 0x7c, 0x44, 0x54, 0x05, 0x2f, 0x6c, 0x49, 0xe4, 0xc2, 0x64,
+	0xc4, 0x5c, 0x59, 0xbf, 0x47, 0xfd, 0x96, 0x13, 0xd0, 0x61, 0xae, 0x50, 0x91, 0x33, 0x82, 0x2b,
+	0xb2, 0x02, 0x2b, 0x2e, 0x50, 0x98, 0x9c, 0x6f, 0x19, 0x70, 0x4c, 0x0c, 0x92, 0x1f, 0x3a, 0x2d,
+	0x37, 0x49, 0xcd, 0xcb, 0x30, 0x6d, 0xf3, 0x8c, 0x11, 0xe3, 0x2f, 0x8a, 0x5b, 0x11, 0x7a, 0x61,
+	0x52, 0x7e, 0x3d, 0x32, 0x32, 0x6e, 0xb8, 0x76, 0x2b, 0x49, 0xc8, 0x73, 0x50, 0xeb, 0x23, 0x58,
+	0x92, 0x71, 0x2c, 0x9d, 0x0c, 0x56, 0xd4, 0x12, 0xa8, 0x85, 0x49, 0xf8, 0x1d, 0x03, 0x1e, 0xe5,
+	0x24, 0xbc, 0x4b, 0x5d, 0x77, 0x9b, 0x3a, 0xbd, 0x4e, 0x82, 0x8c, 0x37, 0x00, 0xee, 0xca, 0x2c,
+	0x49, 0xca, 0x63, 0xe9, 0xa4, 0x44, 0x55, 0x58, 0x4a, 0x91, 0x7d, 0x70, 0xe5, 0x1d, 0xa7, 0x4d,
+	0xbd, 0x61, 0xae, 0xec, 0x22, 0x78, 0x04, 0x57, 0x58, 0x51, 0x4b, 0xa0, 0xee, 0x43, 0x46, 0x6e,
+	0xf8, 0x5e, 0x7b, 0xd0, 0x0a, 0x87, 0x65, 0xa4, 0xcf, 0x33, 0x46, 0xc8, 0x88, 0x28, 0x6e, 0x45,
+	0xe8, 0xfb, 0x20, 0x65, 0x93, 0xfa, 0xbb, 0x4e, 0x2b, 0x45, 0x5c, 0x03, 0x9e, 0x31, 0x82, 0x14,
+	0x51, 0xdc, 0x8a, 0xd0, 0xf7, 0x31, 0x30, 0xeb, 0xbb, 0xb8, 0x92, 0x0e, 0x0d, 0x0c, 0xdd, 0x55,
+	0xb4, 0x66, 0xc6, 0xc0, 0xb0, 0xa2, 0x96, 0x40, 0xdd, 0x87, 0x2e, 0xb1, 0x68, 0x90, 0xb6, 0x06,
+	0xbe, 0x0a, 0x75, 0x5f, 0xe4, 0x8c, 0xd0, 0x25, 0xb2, 0x02, 0x2b, 0x2e, 0x50, 0x98, 0x9c, 0xbb,
+	0x70, 0x54, 0xa8, 0x92, 0x7e, 0x3f, 0x41, 0xc8, 0x39, 0xa8, 0xd8, 0xfd, 0xbe, 0xa4, 0xa1, 0x91,
+	0xea, 0x2c, 0x5f, 0xed, 0xf7, 0x2d, 0x86, 0x55, 0xb8, 0xe1, 0x3f, 0x31, 0xc0, 0x4c, 0xf8, 0xb4,
+	0x5a, 0x5e, 0xb7, 0x4b, 0x7b, 0x6d, 0x1b, 0x97, 0x56, 0x49, 0xc4, 0x26, 0xcc, 0xfb, 0x5a, 0x86,
+	0x58, 0x51, 0xcf, 0xe6, 0xaf, 0x68, 0x7a, 0x5d, 0x89, 0x2a, 0xc6, 0xa0, 0x55, 0xf7, 0x32, 0x7d,
+	0xbd, 0x04, 0x4f, 0xe4, 0xd2, 0x2a, 0x16, 0x7d, 0x4b, 0x5b, 0xf4, 0x5f, 0xcf, 0x20, 0x71, 0x74,
+	0x45, 0x93, 0x9b, 0x00, 0x5f, 0x10, 0x26, 0xc0, 0x83, 0x60, 0x9b, 0x79, 0x1c, 0x9a, 0xd7, 0x68,
+	0x98, 0x70, 0x59, 0x4b, 0x77, 0x8a, 0xf9, 0x73, 0x03, 0x8e, 0xa5, 0x66, 0x0b, 0xe6, 0x7c, 0x5a,
+	0x63, 0xce, 0x8b, 0xe9, 0x84, 0xe4, 0x54, 0x30, 0x39, 0x53, 0xde, 0x12, 0x4c, 0xb9, 0x02, 0xf5,
+	0x96, 0xac, 0x55, 0x48, 0xf5, 0x93, 0x19, 0xfc, 0x10, 0x68, 0xb2, 0x6d, 0x2b, 0x2e, 0x68, 0xbe,
+	0x04, 0x47, 0x63, 0x2a, 0xaf, 0xd0, 0xd0, 0x76, 0x5c, 0x29, 0xac, 0x68, 0x77, 0x73, 0x78, 0x6c,
+	0xc2, 0xd6, 0x05, 0x64, 0xa3, 0x6d, 0xfe, 0x46, 0x09, 0x1a, 0xc3, 0x45, 0x05, 0x7b, 0xae, 0x69,
+	0xec, 0x79, 0x6e, 0x14, 0x7b, 0xf4, 0xd2, 0x93, 0xf3, 0xe6, 0xae, 0xe0, 0x8d, 0xe2, 0xca, 0x2d,
+	0x15, 0x72, 0xe5, 0x1e, 0x81, 0x9a, 0x6f, 0x87, 0x4e, 0xaf, 0x23, 0x6c, 0x70, 0x91, 0x22, 0x27,
+	0x00, 0xb6, 0x3d, 0xef, 0x4e, 0xd7, 0xf6, 0xef, 0x50, 0x3e, 0x9f, 0xa6, 0x2d, 0x05, 0x62, 0xba,
+	0xaa, 0xb4, 0x5c, 0xde, 0x8b, 0x39, 0xce, 0x59, 0xf9, 0x18, 0xcc, 0x48, 0x96, 0xc7, 0xbc, 0x04,
+	0x09, 0xda, 0x68, 0x2b, 0x46, 0x7e, 0x29, 0x69, 0xe4, 0x0f, 0x7f, 0x12, 0x98, 0xff, 0x62, 0xc0,
+	0xf1, 0xf4, 0xe6, 0x04, 0xfb, 0x3f, 0xa3, 0xb1, 0xff, 0xd2, 0x28, 0xf6, 0x0f, 0xd7, 0x30, 0xf9,
+	0x10, 0x6c, 0x88, 0x21, 0x58, 0x1d, 0x32, 0xdb, 0x47, 0xf8, 0x2d, 0xa4, 0x70, 0xc6, 0xe6, 0xfb,
+	0x1b, 0xac, 0x97, 0xfc, 0xe3, 0x2e, 0xb8, 0xea, 0xf9, 0x45, 0xb9, 0x6a, 0xfe, 0x9e, 0x01, 0x8f,
+	0x66, 0xd4, 0xf0, 0x11, 0x6e, 0x99, 0x7f, 0x0e, 0x9a, 0x82, 0xa2, 0xd5, 0x41, 0xe8, 0xb5, 0xbc,
+	0x6e, 0xdf, 0xa5, 0x21, 0x1d, 0x5b, 0x54, 0x08, 0x54, 0x7a, 0x76, 0x57, 0xba, 0x3e, 0xd8, 0x6f,
+	0xf3, 0xbf, 0x0d, 0x38, 0x96, 0x5a, 0x67, 0x11, 0x6d, 0x95, 0x53, 0xc1, 0xe4, 0xe2, 0xd0, 0x86,
+	0x4a, 0x6e, 0x28, 0x73, 0xe9, 0xbe, 0x84, 0x32, 0x7f, 0x08, 0xa7, 0x35, 0x1f, 0xc0, 0x46, 0xef,
+	0x06, 0xfb, 0x08, 0x18, 0xb8, 0x76, 0x61, 0xa9, 0x21, 0x17, 0x73, 0x48, 0xcd, 0xf0, 0x5b, 0x98,
+	0xff, 0x63, 0xc0, 0xd3, 0x63, 0x50, 0x20, 0x86, 0xe3, 0x3d, 0x6d, 0x38, 0xae, 0xe4, 0x0d, 0xc7,
+	0x18, 0xd5, 0x7d, 0x2c, 0xe6, 0xea, 0x3f, 0x1a, 0x70, 0x2a, 0x56, 0x28, 0xfa, 0xd2, 0x7b, 0x79,
+	0xef, 0x66, 0x40, 0x7d, 0xc9, 0x7f, 0xc5, 0x0f, 0x68, 0x64, 0xec, 0x96, 0x69, 0x76, 0x4c, 0xac,
+	0x1a, 0xcb, 0xe9, 0xaa, 0xb1, 0xa2, 0x7a, 0x4b, 0xee, 0xf3, 0x46, 0x45, 0x09, 0x9e, 0x1c, 0xd5,
+	0x2b, 0x31, 0xa6, 0x37, 0xb5, 0x31, 0x5d, 0x1d, 0xa5, 0x72, 0xf3, 0xea, 0x9a, 0x7c, 0x40, 0xdf,
+	0x11, 0x03, 0x7a, 0x1d, 0x0e, 0xea, 0xd6, 0x8e, 0x1c, 0xd7, 0x93, 0x99, 0x2e, 0x05, 0x05, 0xd9,
+	0x4a, 0x16, 0x36, 0x7f, 0x61, 0xc0, 0xd3, 0xd9, 0xb4, 0x27, 0x67, 0xd8, 0x83, 0x1d, 0xe1, 0xc4,
+	0xfc, 0xad, 0x0e, 0xcd, 0xdf, 0x61, 0x11, 0xa8, 0x8d, 0x27, 0x02, 0x53, 0x69, 0x22, 0xf0, 0x5b,
+	0x25, 0x38, 0x33, 0x4e, 0xd7, 0x85, 0x18, 0x7c, 0x5e, 0x13, 0x83, 0xf5, 0xa2, 0x62, 0x70, 0xff,
+	0xe6, 0xf6, 0x83, 0x12, 0x85, 0xf3, 0x70, 0xf8, 0x1a, 0x0d, 0x2d, 0xbb, 0xd7, 0xf6, 0xba, 0x5a,
+	0x34, 0xdd, 0x12, 0x54, 0x5b, 0xde, 0x40, 0x6c, 0x9e, 0x97, 0x2d, 0x9e, 0xc0, 0x6f, 0xd4, 0x23,
+	0x49, 0xfc, 0x87, 0xec, 0x9f, 0xfd, 0x80, 0xd9, 0x66, 0x78, 0xbc, 0x61, 0xc7, 0xf6, 0x69, 0x3b,
+	0x19, 0x53, 0xf2, 0x40, 0xa5, 0xd5, 0xfc, 0x2f, 0x6e, 0xaa, 0xa5, 0xb4, 0x5e, 0xd0, 0x54, 0xcb,
+	0xac, 0x61, 0x72, 0x11, 0xf9, 0xa2, 0x10, 0x91, 0x2d, 0x58, 0x0c, 0xb0, 0x6a, 0x51, 0x33, 0xaa,
+	0xa2, 0x11, 0x5f, 0x14, 0x9b, 0x09, 0x74, 0x6b, 0xb8, 0x02, 0xd3, 0x82, 0xe5, 0x2c, 0x39, 0x9f,
+	0x94, 0xf7, 0xe6, 0xd7, 0x4a, 0xf0, 0x78, 0x4e, 0xa5, 0x82, 0xa5, 0x37, 0x34, 0x96, 0xbe, 0x5a,
+	0x6c, 0x0e, 0xde, 0x07, 0xbe, 0xfe, 0x8a, 0xe0, 0xeb, 0xcd, 0xac, 0xa9, 0x57, 0xe8, 0xbb, 0x75,
+	0x68, 0x06, 0xbe, 0x03, 0x27, 0x63, 0xe2, 0x85, 0xd1, 0x76, 0x79, 0xef, 0x86, 0x4f, 0x6f, 0x53,
+	0x9f, 0xf6, 0x5a, 0x74, 0x52, 0xe6, 0xfe, 0x6e, 0x09, 0x4e, 0x8d, 0xa8, 0x58, 0x30, 0x78, 0x4b,
+	0x63, 0xf0, 0x9b, 0xa3, 0x18, 0x9c, 0x53, 0xd5, 0x83, 0x33, 0x2c, 0xef, 0xcf, 0x19, 0xb9, 0xef,
+	0x1b, 0xf0, 0x44, 0x62, 0x77, 0x29, 0x55, 0x90, 0x5f, 0x87, 0xaa, 0x33, 0x51, 0xb3, 0xbc, 0x58,
+	0xd1, 0x10, 0x22, 0xf3, 0x3f, 0x0d, 0x38, 0x99, 0x4f, 0x97, 0x18, 0xaa, 0x4d, 0x6d, 0xa8, 0xde,
+	0x18, 0xc3, 0xd4, 0xbc, 0xef, 0xd3, 0x41, 0xb1, 0x32, 0x7d, 0x51, 0x59, 0x41, 0x2b, 0x53, 0x16,
+	0x33, 0x7f, 0x5e, 0x82, 0x1a, 0x3f, 0xf3, 0x32, 0xb4, 0x1d, 0x9d, 0xf2, 0x59, 0x94, 0xdc, 0xf2,
+	0x2a, 0x0f, 0x6d, 0x79, 0x91, 0x63, 0x50, 0x77, 0x5a, 0x5e, 0xef, 0x56, 0xe0, 0x0e, 0x3a, 0xc2,
+	0x4d, 0x36, 0x8d, 0x80, 0x4d, 0x77, 0xd0, 0x21, 0x0b, 0x50, 0x1e, 0xf8, 0xae, 0xb0, 0x30, 0xf0,
+	0x27, 0x79, 0x04, 0x58, 0xee, 0x2d, 0x04, 0x8b, 0xed, 0x35, 0x4c, 0xdf, 0xf4, 0x5d, 0xf2, 0x2c,
+	0x1c, 0xb2, 0xc3, 0xd0, 0x77, 0xb6, 0x07, 0xd2, 0xe1, 0xe7, 0xf8, 0xb4, 0xcd, 0x6c, 0x8a, 0x69,
+	0x2b, 0x2d, 0x4b, 0x19, 0xda, 0x69, 0x75, 0x7d, 0x69, 0xc0, 0x94, 0xd8, 0xdc, 0x6c, 0xd4, 0x19,
+	0x7f, 0x65, 0x12, 0x73, 0x06, 0x2c, 0x36, 0xa4, 0xdd, 0x00, 0x9e, 0x23, 0x92, 0xd8, 0xf9, 0xd0,
+	0xee, 0x04, 0x8d, 0x19, 0xb1, 0x6d, 0x6a, 0x77, 0x02, 0xf2, 0x8a, 0xd8, 0x4a, 0x9d, 0x5d, 0x36,
+	0x86, 0x15, 0xb9, 0x2e, 0x91, 0x9b, 0xd1, 0x0e, 0x32, 0xdf, 0x72, 0x35, 0xff, 0xa9, 0x0a, 0x53,
+	0x22, 0x6f, 0x88, 0xd1, 0xd1, 0xbe, 0x63, 0x29, 0x63, 0xdf, 0xb1, 0x9c, 0xbb, 0xef, 0x58, 0x19,
+	0x1e, 0x84, 0x25, 0xa8, 0x3a, 0x5d, 0x14, 0x1c, 0xb1, 0x93, 0xc9, 0x12, 0x2a, 0x1f, 0x6a, 0x99,
+	0x7c, 0x98, 0xd2, 0xf9, 0x90, 0xc1, 0xd2, 0x17, 0x20, 0xde, 0x2f, 0x66, 0x4c, 0x9d, 0xb9, 0x78,
+	0x54, 0xe7, 0x07, 0x3b, 0x85, 0xcd, 0x56, 0xb2, 0x18, 0x53, 0x8e, 0x3f, 0xc4, 0xe3, 0x7f, 0x04,
+	0x6a, 0xf6, 0x20, 0xdc, 0xf1, 0x7c, 0x11, 0x46, 0x20, 0x52, 0xe4, 0x38, 0xd4, 0x43, 0xa7, 0x4b,
+	0x83, 0xd0, 0xee, 0xf6, 0x19, 0xc3, 0xcb, 0x56, 0x0c, 0xc0, 0x90, 0x26, 0x36, 0x3a, 0x73, 0x05,
+	0x75, 0x03, 0x1f, 0xc7, 0xe7, 0xc5, 0x38, 0xce, 0xa7, 0x1d, 0x60, 0xd3, 0x4b, 0xc7, 0x23, 0xa8,
+	0x9c, 0x21, 0x3c, 0x58, 0xe0, 0x0c, 0xe1, 0x9b, 0x30, 0x2d, 0x0d, 0xe9, 0xc6, 0x42, 0x9a, 0x59,
+	0x96, 0x4e, 0xad, 0x15, 0x95, 0x22, 0xa7, 0xa1, 0x82, 0x1a, 0xad, 0xb1, 0xc8, 0x4a, 0x2f, 0xad,
+	0xf0, 0x13, 0xf4, 0x2b, 0xf2, 0x04, 0xfd, 0xca, 0x6a, 0x6f, 0xcf, 0x62, 0x18, 0x28, 0xb3, 0x3b,
+	0x76, 0xb0, 0xd3, 0x20, 0x7c, 0xc2, 0xe2, 0x6f, 0x76, 0xb0, 0x9c, 0x86, 0xcc, 0xff, 0x76, 0x28,
+	0x8d, 0x6c, 0xd1, 0xfc, 0x26, 0xc7, 0xb1, 0x24, 0x32, 0x79, 0x06, 0x6a, 0xcc, 0x00, 0x09, 0x1a,
+	0x4b, 0xcb, 0xe5, 0xbc, 0xd1, 0x15, 0x68, 0xe6, 0x37, 0xab, 0x30, 0xa3, 0x84, 0x9b, 0x8c, 0x1b,
+	0xdc, 0xa2, 0xc8, 0x64, 0x39, 0x53, 0x26, 0x2b, 0xba, 0x4c, 0x5e, 0x14, 0xe3, 0x87, 0xc2, 0x3d,
+	0x9f, 0xb9, 0xf9, 0x31, 0x70, 0x69, 0xea, 0xe8, 0x4d, 0x15, 0x18, 0xbd, 0xab, 0x00, 0x71, 0x2c,
+	0x48, 0xa3, 0x5e, 0x68, 0xde, 0x2b, 0x25, 0xc9, 0xe5, 0x28, 0xc0, 0x8b, 0x55, 0x34, 0x33, 0xa6,
+	0xe0, 0xa9, 0x85, 0x88, 0x05, 0xf3, 0x7d, 0x35, 0x7c, 0x64, 0x8f, 0x45, 0xd2, 0xcc, 0x5c, 0x3c,
+	0x93, 0x57, 0x8d, 0x16, 0x70, 0xb2, 0x67, 0x25, 0x6a, 0xd0, 0xa4, 0xf3, 0xe0, 0x44, 0xd2, 0x79,
+	0x1d, 0xe6, 0x5a, 0x9a, 0x95, 0xb0, 0x58, 0x70, 0x4a, 0xea, 0xc5, 0xc9, 0x9b, 0x00, 0xf4, 0x5e,
+	0xdf, 0xa7, 0x41, 0x80, 0xaa, 0x8d, 0xe4, 0x1d, 0x31, 0x5d, 0x8f, 0xf0, 0x2c, 0xa5, 0x8c, 0xe9,
+	0x02, 0xc4, 0x39, 0xe4, 0x15, 0x98, 0xf6, 0xfa, 0xd4, 0xb7, 0x43, 0xcf, 0x6f, 0x18, 0x79, 0xf2,
+	0xf2, 0xb6, 0xc0, 0xb2, 0x22, 0x7c, 0xd4, 0x56, 0x5d, 0xa7, 0x27, 0xd6, 0x64, 0xfc, 0xc9, 0x20,
+	0xf6, 0x3d, 0x21, 0xa9, 0xf8, 0xd3, 0xfc, 0xb7, 0x0a, 0xd4, 0x78, 0xf8, 0x00, 0xaa, 0x32, 0x76,
+	0x8f, 0x44, 0xc0, 0x1a, 0x2a, 0x59, 0x22, 0x85, 0x96, 0x4c, 0xdb, 0xa1, 0x61, 0x71, 0xcf, 0x1c,
+	0x2f, 0x46, 0xae, 0x42, 0xdd, 0x76, 0x5d, 0xea, 0x77, 0x1c, 0x11, 0x7e, 0x54, 0xa4, 0x8e, 0xb8,
+	0x28, 0x0a, 0xa1, 0xd3, 0xeb, 0xf8, 0xb4, 0xed, 0x30, 0xb7, 0x54, 0x65, 0xb9, 0x9c, 0xcd, 0xdb,
+	0x8d, 0x08, 0xd1, 0x52, 0x0b, 0x91, 0x93, 0x30, 0x17, 0x7a, 0xa1, 0xed, 0xae, 0xd9, 0xae, 0x8c,
+	0x55, 0xc2, 0xae, 0xea, 0x40, 0x5c, 0xa0, 0x18, 0xe0, 0x5d, 0xea, 0x74, 0x76, 0x42, 0xb6, 0xd8,
+	0x94, 0x2c, 0x15, 0x44, 0x5e, 0x83, 0x7a, 0x6f, 0x10, 0xfa, 0x8e, 0xf0, 0x12, 0xe4, 0xec, 0xb8,
+	0x5f, 0x97, 0x68, 0x56, 0x5c, 0x02, 0x1b, 0x68, 0x79, 0xde, 0x1d, 0xa7, 0xd7, 0xd9, 0x72, 0xba,
+	0x54, 0xac, 0xea, 0x2a, 0x88, 0x34, 0x71, 0xff, 0x9a, 0xf6, 0x59, 0x36, 0x5f, 0xda, 0xa3, 0x34,
+	0x76, 0x42, 0xa0, 0x7e, 0x96, 0x86, 0x3b, 0x9e, 0x8c, 0x60, 0xd3, 0x81, 0x18, 0xf8, 0xe5, 0xf4,
+	0x82, 0xd0, 0x1f, 0xb4, 0xf8, 0x87, 0xc6, 0x2c, 0x0f, 0xfc, 0x52, 0x61, 0x72, 0x3d, 0x9b, 0x8b,
+	0xd7, 0xb3, 0x68, 0xe5, 0x9d, 0x57, 0x57, 0xde, 0x23, 0xda, 0xda, 0x51, 0x8f, 0xf4, 0x4b, 0xb4,
+	0xf2, 0x2f, 0xa8, 0x2b, 0x7f, 0x13, 0x67, 0xe5, 0x80, 0xb7, 0xba, 0xc8, 0x5a, 0x8d, 0xd2, 0xe6,
+	0xbf, 0x1a, 0x50, 0x8f, 0x58, 0xc2, 0xbe, 0x90, 0xed, 0x6d, 0xea, 0xca, 0x70, 0x28, 0x96, 0x40,
+	0xca, 0xed, 0xed, 0x6d, 0x9f, 0xee, 0x3a, 0x7c, 0x6b, 0x8f, 0x2b, 0x5c, 0x0d, 0x86, 0xdb, 0x2f,
+	0x6c, 0x3c, 0xde, 0xb1, 0xdd, 0x01, 0xb7, 0x2f, 0x4b, 0x96, 0x02, 0x21, 0x4f, 0xc1, 0xc1, 0xb6,
+	0xed, 0xb8, 0x7b, 0xb7, 0x6c, 0xd7, 0xf5, 0xee, 0xda, 0xbd, 0x16, 0x65, 0x5a, 0xb8, 0x64, 0xcd,
+	0x33, 0xf0, 0xaa, 0x84, 0xe2, 0xa2, 0x33, 0xe8, 0x39, 0xa1, 0xb0, 0x34, 0xd8, 0x6f, 0x64, 0x8b,
+	0xdf, 0x76, 0xd8, 0xb8, 0x4f, 0x5b, 0xf8, 0x93, 0x5c, 0x80, 0x72, 0x30, 0xd8, 0x1e, 0x77, 0xa4,
+	0x11, 0x17, 0xc3, 0x37, 0x21, 0x16, 0x43, 0x6c, 0x27, 0xa4, 0xf7, 0x42, 0xd1, 0x53, 0xf6, 0x1b,
+	0x19, 0xf5, 0xe5, 0x81, 0xdd, 0x0b, 0x9d, 0x70, 0x8f, 0x75, 0xb2, 0x64, 0x45, 0x69, 0x6e, 0x3d,
+	0xdb, 0xc1, 0xc0, 0x57, 0xac, 0x67, 0x96, 0xc4, 0xc1, 0xb8, 0xcb, 0x05, 0x93, 0xf7, 0x48, 0xa4,
+	0xb0, 0x85, 0xdb, 0x9e, 0x27, 0xfd, 0x5f, 0xec, 0xb7, 0xb9, 0x01, 0x53, 0x22, 0x08, 0x26, 0x1e,
+	0x59, 0x1e, 0x42, 0xc8, 0x13, 0x08, 0x65, 0x11, 0x18, 0xc2, 0x97, 0xcd, 0x13, 0x11, 0xb1, 0xe5,
+	0x98, 0x58, 0xf3, 0x47, 0x15, 0x98, 0x96, 0xd1, 0x3d, 0x28, 0xc0, 0x5d, 0x26, 0x66, 0x9e, 0xeb,
+	0x75, 0xf6, 0x44, 0xa7, 0x54, 0x10, 0xb9, 0x00, 0x55, 0x97, 0xee, 0x52, 0x57, 0x6c, 0xb7, 0x65,
+	0xc4, 0x1a, 0xbc, 0x85, 0x28, 0x16, 0xc7, 0xc4, 0x56, 0x7d, 0xda, 0x0f, 0x84, 0x7a, 0x62, 0xbf,
+	0x11, 0x16, 0x50, 0x36, 0xdb, 0x19, 0x0c, 0x7f, 0x93, 0xcb, 0x00, 0xa1, 0x6f, 0xb7, 0xee, 0x50,
+	0x1f, 0xcd, 0x82, 0x6a, 0xda, 0x71, 0x57, 0x79, 0x88, 0x98, 0xa3, 0xf1, 0x19, 0x61, 0x29, 0xa5,
+	0x62, 0x6e, 0xd4, 0x54, 0x6e, 0x1c, 0x89, 0x42, 0x57, 0x78, 0xb8, 0xa1, 0x48, 0xa1, 0x35, 0x87,
+	0x36, 0x7a, 0xbf, 0x4b, 0x7b, 0xa1, 0x8c, 0x37, 0x8c, 0x00, 0xe4, 0x2c, 0x54, 0x42, 0xaf, 0xed,
+	0x35, 0xea, 0x69, 0x96, 0x06, 0xe6, 0xac, 0x6c, 0x79, 0x6d, 0xcf, 0x62, 0x48, 0xbc, 0x93, 0x41,
+	0x28, 0x6c, 0x48, 0xf6, 0x1b, 0xe5, 0xa0, 0x3d, 0xf0, 0xb9, 0xb0, 0xf3, 0xb9, 0x1c, 0xa5, 0x87,
+	0x35, 0x16, 0x37, 0x26, 0x75, 0x20, 0x2e, 0x3b, 0x3e, 0x6d, 0x0d, 0x7c, 0xf6, 0x29, 0xdd, 0x98,
+	0xcb, 0x53, 0x8d, 0x56, 0x84, 0x67, 0x29, 0x65, 0xb4, 0xa5, 0x74, 0x7e, 0x92, 0xa5, 0xd4, 0xa4,
+	0x50, 0x65, 0xc3, 0x89, 0x73, 0xd3, 0xa7, 0x81, 0x13, 0x84, 0x6c, 0xda, 0x89, 0xed, 0x94, 0x18,
+	0x82, 0xdc, 0x74, 0xb0, 0x92, 0x40, 0xca, 0x7d, 0xdd, 0x8a, 0x01, 0x58, 0x9a, 0xde, 0xeb, 0x53,
+	0xdf, 0x61, 0x5d, 0xe1, 0x12, 0xa8, 0x40, 0xcc, 0x1f, 0x94, 0xa0, 0x1e, 0x85, 0x31, 0x61, 0x4c,
+	0x4b, 0x68, 0xfb, 0x1d, 0x2a, 0x4f, 0xe8, 0x1c, 0xcb, 0x38, 0x4c, 0x8e, 0x28, 0x96, 0x40, 0xd5,
+	0xf8, 0x5d, 0x4a, 0xf0, 0x5b, 0xfb, 0x32, 0x28, 0x8f, 0xfd, 0x65, 0x10, 0x6b, 0xc8, 0x8a, 0xa6,
+	0x21, 0xf5, 0x81, 0xa9, 0x4e, 0x30, 0x30, 0x52, 0xba, 0x6a, 0x69, 0xb4, 0x24, 0xa4, 0xcb, 0x34,
+	0x01, 0xe2, 0x6a, 0x50, 0xc8, 0xad, 0x28, 0x22, 0xbf, 0x6e, 0xf1, 0x84, 0xf9, 0x1f, 0x15, 0xa8,
+	0xb2, 0x90, 0x34, 0x72, 0x0d, 0x16, 0xb0, 0x71, 0xdb, 0xe9, 0xd1, 0xf6, 0x46, 0x8f, 0xc1, 0xd2,
+	0xd9, 0xa8, 0x47, 0xb2, 0x0d, 0x15, 0x8a, 0x82, 0x74, 0xc5, 0xa7, 0x36, 0xfe, 0x8e, 0x3e, 0xbf,
+	0xcb, 0xca, 0xe7, 0xf7, 0x59, 0x28, 0x77, 0xa8, 0xc7, 0x58, 0x34, 0x73, 0xf1, 0x91, 0x2c, 0x2f,
+	0x91, 0x67, 0x21, 0x16, 0x9a, 0xfe, 0x76, 0xbb, 0x8d, 0x86, 0x50, 0xa3, 0x9a, 0x66, 0xf3, 0xca,
+	0x10, 0x1d, 0x8e, 0x63, 0x49, 0x64, 0xf6, 0xe9, 0x45, 0x5d, 0xda, 0xdf, 0xf1, 0x7a, 0x54, 0x7c,
+	0x93, 0xc7, 0x00, 0x14, 0x2f, 0xa7, 0x4d, 0x7b, 0xa1, 0x73, 0xdb, 0xa1, 0xbe, 0x70, 0xf0, 0x2b,
+	0x10, 0xb9, 0x24, 0x4e, 0xc7, 0x4b, 0x22, 0xff, 0x12, 0xa8, 0xab, 0x9f, 0xbb, 0x5c, 0x75, 0x80,
+	0xba, 0x44, 0x5e, 0x83, 0x39, 0xaf, 0x4f, 0x7b, 0x4e, 0xaf, 0x73, 0x6b, 0xc7, 0x1b, 0xf8, 0x41,
+	0x63, 0x26, 0x4d, 0x2f, 0x29, 0xd6, 0x1a, 0xa2, 0xfe, 0x12, 0x62, 0x5a, 0xb3, 0x9e, 0x92, 0x62,
+	0x41, 0x85, 0x3b, 0x5e, 0xe8, 0xf1, 0x15, 0x3b, 0x7b, 0x28, 0x10, 0xc7, 0x12, 0xa8, 0xe8, 0x86,
+	0x60, 0xe1, 0x85, 0xf8, 0x7d, 0xc2, 0x57, 0xf3, 0x29, 0x96, 0xde, 0x68, 0x93, 0xe7, 0x61, 0xca,
+	0x76, 0x31, 0x60, 0x23, 0x68, 0xcc, 0xe7, 0x55, 0xb8, 0xea, 0x86, 0x1b, 0x6d, 0xab, 0x66, 0xe3,
+	0xbf, 0x80, 0xad, 0xec, 0x2c, 0x5e, 0xfc, 0x20, 0xd7, 0x8f, 0x2c, 0x81, 0xac, 0x8d, 0x1c, 0x76,
+	0x62, 0xcd, 0x8f, 0x01, 0x38, 0xad, 0x76, 0x9d, 0x96, 0xd3, 0xc3, 0x69, 0xbd, 0xc8, 0xa7, 0x95,
+	0x4c, 0x9b, 0x6f, 0x40, 0xf9, 0x1a, 0xf5, 0x10, 0xc5, 0xb5, 0x43, 0x27, 0x1c, 0x88, 0x00, 0x7e,
+	0xc3, 0x8a, 0xd2, 0x58, 0xb9, 0xeb, 0xf5, 0x3a, 0x3c, 0xb3, 0xc4, 0x32, 0x63, 0x80, 0xf9, 0x34,
+	0xcc, 0xaa, 0x4c, 0xc3, 0x1e, 0x23, 0xdb, 0x6e, 0xf5, 0xbc, 0xbb, 0xac, 0xa6, 0x69, 0x6b, 0x0a,
+	0xd3, 0xd7, 0xbd, 0xbb, 0xe6, 0x6f, 0x1a, 0x50, 0x65, 0xec, 0xc1, 0x59, 0xb9, 0xc3, 0x97, 0x4a,
+	0x44, 0xa9, 0x58, 0x22, 0x45, 0xce, 0xc2, 0xe2, 0x4e, 0xd8, 0x75, 0x6f, 0x29, 0x4e, 0x18, 0xb9,
+	0x9b, 0xbb, 0x80, 0x19, 0xab, 0x0a, 0x1c, 0x4d, 0x09, 0xc6, 0xe5, 0x5b, 0x71, 0xd7, 0xb9, 0x4c,
+	0xcf, 0x33, 0xb0, 0x15, 0xf5, 0x7f, 0x09, 0xaa, 0x77, 0x9d, 0x76, 0xb8, 0xc3, 0xe4, 0xbb, 0x62,
+	0xf1, 0x84, 0xf9, 0x12, 0x54, 0x19, 0x6b, 0xb5, 0x31, 0x32, 0xf4, 0x31, 0x5a, 0x82, 0x6a, 0xd0,
+	0xf2, 0xa2, 0x09, 0xc4, 0x13, 0xe6, 0x3f, 0xd7, 0x60, 0x5a, 0x46, 0x19, 0x0b, 0x29, 0x3c, 0x1d,
+	0x49, 0x61, 0xac, 0x70, 0x9e, 0xd6, 0x14, 0xce, 0x05, 0xa8, 0xb6, 0x5c, 0x3b, 0x08, 0xf2, 0x27,
+	0xf2, 0x1a, 0xa2, 0x58, 0x1c, 0x93, 0x5c, 0x82, 0x69, 0xd7, 0x6b, 0xc5, 0xea, 0x70, 0xc4, 0xf4,
+	0x8f, 0x90, 0xd1, 0x0a, 0xf6, 0xfc, 0x8e, 0xdd, 0x73, 0xde, 0xa7, 0xbe, 0xd0, 0x95, 0x19, 0xb6,
+	0xd1, 0xdb, 0x12, 0xcd, 0x8a, 0x4b, 0x90, 0x15, 0xa8, 0xf4, 0x6d, 0xff, 0x8e, 0x50, 0x07, 0xcd,
+	0x8c, 0x36, 0x6d, 0xff, 0x8e, 0xc5, 0xf0, 0xf0, 0x5b, 0x28, 0x18, 0x6c, 0xb3, 0xf0, 0x44, 0xa1,
+	0x49, 0x33, 0xbe, 0x85, 0x36, 0x05, 0x96, 0x15, 0xe1, 0x93, 0xab, 0x30, 0x8b, 0x4b, 0xad, 0xef,
+	0x08, 0x6b, 0xb8, 0x96, 0x37, 0x3b, 0x2d, 0x05, 0xd3, 0xd2, 0xca, 0xa1, 0x90, 0x06, 0xa1, 0xed,
+	0xe3, 0x41, 0x43, 0xa9, 0x00, 0x62, 0x00, 0x1a, 0x6d, 0xb4, 0xd7, 0x66, 0x79, 0x7c, 0x1d, 0x97,
+	0x49, 0x6d, 0xc9, 0x99, 0x4d, 0x2c, 0x39, 0xba, 0x4a, 0x9a, 0x1b, 0x52, 0x49, 0xe7, 0x60, 0x51,
+	0x71, 0x8e, 0x5d, 0xf5, 0xfc, 0xae, 0x1d, 0x36, 0x0e, 0x33, 0xb4, 0xe1, 0x0c, 0xb4, 0x9e, 0xbb,
+	0x94, 0xa2, 0x13, 0xe4, 0x86, 0xe7, 0xf4, 0x42, 0xe1, 0x4d, 0xd1, 0x60, 0xd8, 0x0b, 0x16, 0xde,
+	0xf9, 0x96, 0xd7, 0xf1, 0x1a, 0xc7, 0x78, 0x2f, 0x22, 0x40, 0x6c, 0x13, 0x1e, 0xe7, 0xd2, 0xc8,
+	0x12, 0x48, 0x65, 0xc0, 0x3f, 0x4a, 0x6f, 0xfa, 0x6e, 0xe3, 0x51, 0x4e, 0x65, 0x0c, 0x91, 0x87,
+	0xc0, 0xd8, 0xbc, 0xf1, 0xfc, 0xc6, 0x63, 0xa3, 0x0e, 0x81, 0x09, 0x44, 0x4b, 0x2d, 0x45, 0x5e,
+	0x87, 0x69, 0x19, 0x4d, 0xdf, 0x38, 0xb9, 0x5c, 0xce, 0x1e, 0x22, 0x39, 0x2f, 0x70, 0xa6, 0x5a,
+	0x51, 0x19, 0x94, 0x7e, 0xef, 0xf6, 0x6d, 0xea, 0x37, 0x4e, 0xe5, 0xa9, 0xba, 0xb7, 0x11, 0xc5,
+	0xe2, 0x98, 0x78, 0xcf, 0xc2, 0xac, 0x3a, 0xe0, 0xc8, 0xee, 0x0e, 0xed, 0xb5, 0xa9, 0xaf, 0x40,
+	0xc5, 0x3a, 0x32, 0x9c, 0xc1, 0x3e, 0x61, 0x98, 0x9d, 0x3b, 0x25, 0x3e, 0x61, 0x30, 0x81, 0xc3,
+	0x6d, 0x77, 0xa8, 0x65, 0xf7, 0x3a, 0x54, 0x2c, 0x25, 0x51, 0x9a, 0x0d, 0x90, 0x7d, 0x6f, 0x35,
+	0x0c, 0xb1, 0x2e, 0x1a, 0x88, 0x2f, 0x2d, 0x0d, 0x86, 0xe5, 0x31, 0x7e, 0x9e, 0x35, 0x7d, 0x88,
+	0x97, 0x97, 0x69, 0xf3, 0x87, 0x06, 0xd4, 0xa3, 0xf9, 0x94, 0xe6, 0xf5, 0x1e, 0x6b, 0x29, 0x16,
+	0xeb, 0x5c, 0x45, 0xfb, 0xf4, 0xa3, 0x5d, 0xdb, 0x91, 0xee, 0x6d, 0x9e, 0x18, 0xb1, 0x9a, 0x12,
+	0xa8, 0xb8, 0x28, 0x43, 0xbc, 0xf3, 0xec, 0xb7, 0xf9, 0x17, 0x06, 0x54, 0x99, 0x7e, 0x21, 0x2f,
+	0x43, 0x0d, 0x17, 0xe9, 0x41, 0x30, 0xe2, 0x48, 0x20, 0x22, 0x6f, 0x32, 0x44, 0x4b, 0x14, 0xc0,
+	0x8a, 0xdb, 0x76, 0xc8, 0xbb, 0x51, 0x66, 0x9b, 0x0c, 0x94, 0x3c, 0x27, 0xba, 0x96, 0xab, 0x69,
+	0x58, 0x65, 0x8a, 0xe3, 0x0c, 0xf5, 0xa1, 0x67, 0xb7, 0x76, 0x84, 0x96, 0xc9, 0xd2, 0x87, 0x88,
+	0x62, 0x71, 0x4c, 0x73, 0x0e, 0x66, 0x14, 0x92, 0xcc, 0x19, 0xa8, 0x47, 0x95, 0xe2, 0x59, 0xf1,
+	0x2a, 0x43, 0x46, 0xc6, 0xdc, 0x76, 0xfc, 0x20, 0xbc, 0x8e, 0x9c, 0x15, 0xb1, 0x90, 0x11, 0x80,
+	0x2f, 0x74, 0x22, 0x53, 0x98, 0x98, 0x32, 0x1d, 0x1b, 0x10, 0x65, 0xd5, 0x80, 0x40, 0x46, 0xef,
+	0x0c, 0xba, 0xdb, 0x3d, 0x1c, 0x82, 0x8a, 0x60, 0xb4, 0x04, 0x90, 0xc7, 0x61, 0x36, 0xf8, 0xf2,
+	0xc0, 0xf6, 0xe9, 0x2d, 0xd5, 0x31, 0x3e, 0xc3, 0x61, 0x1b, 0x08, 0x32, 0xef, 0xc1, 0xac, 0x3a,
+	0x2b, 0x22, 0x49, 0x30, 0x14, 0x49, 0x68, 0xc2, 0x74, 0xcf, 0x0b, 0x63, 0x55, 0x5f, 0xb5, 0xa2,
+	0x34, 0x12, 0xd0, 0xf7, 0xe9, 0xed, 0xb7, 0xd8, 0x07, 0x39, 0x27, 0x2d, 0x06, 0x60, 0x49, 0xa7,
+	0xb7, 0xd9, 0xda, 0xa1, 0x5d, 0x1a, 0x6d, 0x8b, 0x88, 0xb4, 0xf9, 0x55, 0xa8, 0xb2, 0x29, 0x25,
+	0x85, 0xca, 0x88, 0x85, 0x6a, 0x5c, 0x71, 0x3c, 0x09, 0x73, 0x7d, 0x1f, 0x63, 0xe7, 0xb9, 0xed,
+	0xba, 0x27, 0xda, 0xd0, 0x81, 0xc8, 0x39, 0x06, 0x90, 0x22, 0xca, 0x12, 0xe6, 0x0b, 0xdc, 0x73,
+	0x2b, 0x75, 0x48, 0x5a, 0xbf, 0xd3, 0x42, 0xe4, 0xfe, 0xc1, 0x80, 0x0a, 0xae, 0x2e, 0xb1, 0x17,
+	0xc3, 0x50, 0xbd, 0x18, 0x7c, 0x62, 0x95, 0xa2, 0x89, 0x35, 0x7a, 0xeb, 0x28, 0x55, 0x4f, 0x57,
+	0xb2, 0xf4, 0x34, 0x7a, 0x78, 0x6c, 0xff, 0x0e, 0x53, 0xc1, 0xbc, 0x3b, 0x51, 0x5a, 0x35, 0x7d,
+	0x6b, 0x05, 0x4c, 0x5f, 0xf3, 0x1b, 0x65, 0x98, 0xba, 0xec, 0xf9, 0xde, 0xa0, 0xb3, 0x33, 0x66,
+	0xaf, 0xf8, 0xb7, 0x98, 0xef, 0x6d, 0xc5, 0x9f, 0xfb, 0x31, 0x80, 0x9c, 0x86, 0x83, 0x51, 0x42,
+	0xeb, 0x4f, 0x12, 0x8c, 0xdc, 0xd9, 0xe6, 0x0d, 0x2b, 0x1d, 0x52, 0x41, 0xe4, 0x0c, 0x2c, 0x88,
+	0x24, 0x3b, 0xf9, 0x81, 0xfb, 0x69, 0x42, 0x9f, 0x0c, 0xc1, 0x91, 0x93, 0x2a, 0x6c, 0x8b, 0xf5,
+	0x83, 0xeb, 0x98, 0xe1, 0x0c, 0x54, 0xa8, 0x2a, 0x50, 0x28, 0x5c, 0x0d, 0x46, 0x9e, 0x87, 0xc3,
+	0x22, 0xbd, 0x75, 0xd7, 0x09, 0x43, 0xea, 0xaf, 0xb6, 0x78, 0x6c, 0x0a, 0xb7, 0xeb, 0xd3, 0x33,
+	0xd5, 0x71, 0x80, 0x22, 0xe3, 0xe0, 0xc3, 0xb4, 0xb4, 0x41, 0x52, 0xc5, 0x51, 0xb3, 0x22, 0x4a,
+	0x39, 0x56, 0x44, 0x39, 0xdb, 0x8a, 0xa8, 0xe8, 0x56, 0x84, 0xf9, 0xb7, 0x06, 0x1c, 0x52, 0xa3,
+	0x30, 0x94, 0xe3, 0x27, 0x13, 0x9e, 0x96, 0x23, 0xe7, 0xa1, 0x3a, 0x60, 0xa1, 0x1f, 0xa5, 0xfc,
+	0x3d, 0x14, 0x8e, 0xa5, 0x6e, 0x59, 0x97, 0x33, 0xb6, 0xac, 0x2b, 0x19, 0xe7, 0x11, 0xaa, 0xda,
+	0x79, 0x84, 0x2b, 0xb0, 0xa4, 0xf7, 0x64, 0xa2, 0xa3, 0xbf, 0x7f, 0x5a, 0x82, 0x85, 0x64, 0x58,
+	0xca, 0xd0, 0x72, 0x39, 0x71, 0x5c, 0xf8, 0x59, 0xa8, 0x60, 0xef, 0x46, 0xb9, 0x08, 0x18, 0x12,
+	0x79, 0x29, 0x5a, 0x08, 0x2b, 0xcc, 0x87, 0x9f, 0xbe, 0x75, 0xc2, 0x88, 0x4d, 0xac, 0x83, 0xca,
+	0x2e, 0x52, 0x55, 0xdf, 0x45, 0x7a, 0x1e, 0xea, 0x6c, 0xeb, 0x8a, 0x9d, 0x81, 0xad, 0xe5, 0x53,
+	0x31, 0xcd, 0x31, 0x2f, 0xef, 0xa9, 0xfb, 0x55, 0x53, 0xda, 0x7e, 0x95, 0xd9, 0x86, 0x85, 0xa1,
+	0xd8, 0xb5, 0x49, 0x42, 0x8f, 0xf5, 0x1d, 0xf4, 0xb2, 0xbe, 0x83, 0x6e, 0xfe, 0xa8, 0x14, 0x5f,
+	0xe7, 0x2b, 0x5b, 0xc9, 0x3f, 0x56, 0x10, 0x2f, 0x97, 0x25, 0x75, 0xb9, 0x8c, 0xd4, 0x5b, 0x59,
+	0x55, 0x6f, 0xf1, 0x76, 0x6c, 0x45, 0xdb, 0x8e, 0x8d, 0xb7, 0xcf, 0xaa, 0x05, 0xb6, 0xcf, 0x12,
+	0xdd, 0xaf, 0x0d, 0x75, 0xff, 0x1c, 0x90, 0x18, 0x21, 0xea, 0x33, 0x57, 0x54, 0x0b, 0x11, 0x9e,
+	0xe8, 0x3b, 0x79, 0x22, 0xde, 0x6b, 0xba, 0xc5, 0xb8, 0x26, 0x14, 0x95, 0x04, 0xa2, 0x71, 0x80,
+	0x01, 0x4d, 0x87, 0x53, 0xe3, 0x2e, 0x0a, 0x1c, 0xb7, 0x4f, 0x9f, 0x85, 0x8a, 0xa8, 0x57, 0x0a,
+	0x89, 0xba, 0x22, 0x33, 0xd5, 0xcc, 0x3d, 0xce, 0x9a, 0x2e, 0x9d, 0x72, 0x87, 0x7b, 0x7a, 0x92,
+	0x1d, 0x6e, 0xf3, 0xa7, 0x65, 0x98, 0x4f, 0xf4, 0x7e, 0x84, 0x90, 0x20, 0x6f, 0x65, 0xb6, 0x22,
+	0x2c, 0xf2, 0x9c, 0x3b, 0xb3, 0x90, 0x54, 0x24, 0x55, 0x76, 0xa2, 0xc3, 0xf0, 0x08, 0xc3, 0x28,
+	0x50, 0x89, 0xa4, 0x89, 0x92, 0x2c, 0xba, 0xca, 0x80, 0x64, 0x2d, 0x46, 0x2b, 0x20, 0x59, 0xb2,
+	0x92, 0xcd, 0x8f, 0x4c, 0xc0, 0x54, 0xf9, 0xa8, 0x6b, 0xf2, 0x21, 0x87, 0x0c, 0x26, 0x1a, 0xb2,
+	0x6f, 0xd7, 0x60, 0x4a, 0x9c, 0xa5, 0x7c, 0x90, 0x7b, 0xe7, 0x52, 0xe1, 0x54, 0xb3, 0x83, 0x7a,
+	0x6a, 0xc3, 0x96, 0xd9, 0x32, 0xa8, 0x96, 0xb2, 0x3c, 0xe9, 0xae, 0x80, 0xc8, 0x93, 0x30, 0x1f,
+	0x19, 0xdb, 0x1c, 0x89, 0xf3, 0x2e, 0x01, 0xc5, 0xaf, 0x60, 0xd7, 0xf6, 0x3b, 0xa2, 0x22, 0xce,
+	0x40, 0x05, 0x92, 0x1e, 0x21, 0x22, 0x0e, 0x10, 0xcd, 0x68, 0x07, 0x88, 0xf0, 0x13, 0x71, 0xd0,
+	0xe6, 0x5e, 0x6e, 0xe1, 0x11, 0x90, 0x69, 0x54, 0x6e, 0xf6, 0x5d, 0xdb, 0x97, 0xbe, 0x3c, 0x9e,
+	0x40, 0xe8, 0xb6, 0x6f, 0xf7, 0xda, 0x72, 0x6f, 0x8e, 0x25, 0xf8, 0x6e, 0x9b, 0xb2, 0x07, 0x5e,
+	0x57, 0x76, 0xb7, 0x09, 0x54, 0x3a, 0xa1, 0x23, 0x6f, 0x95, 0x60, 0xbf, 0xf9, 0xe7, 0x67, 0x6f,
+	0x70, 0xdb, 0x6e, 0x85, 0x03, 0x9f, 0xfa, 0xc2, 0x53, 0xa7, 0xc1, 0xb0, 0xce, 0xae, 0x1d, 0x52,
+	0xdf, 0xb1, 0x5d, 0xe1, 0x3f, 0x88, 0xd2, 0x48, 0x45, 0xd7, 0x6b, 0x53, 0x57, 0x7c, 0x97, 0xf2,
+	0x84, 0x88, 0x81, 0x45, 0x5b, 0x61, 0x29, 0x8a, 0x81, 0x45, 0x9b, 0xe0, 0x49, 0x98, 0x17, 0x07,
+	0x6b, 0x1d, 0xaf, 0xc7, 0x4c, 0x9a, 0xc3, 0x2c, 0x3f, 0x01, 0x45, 0xaa, 0xfa, 0x03, 0xbf, 0xb5,
+	0x63, 0x07, 0x94, 0x61, 0x1d, 0x61, 0x58, 0x1a, 0x0c, 0x47, 0xd1, 0xa7, 0x2e, 0x95, 0x28, 0x47,
+	0x19, 0x8a, 0x0a, 0x62, 0xbc, 0xa6, 0xbb, 0x0e, 0xbd, 0xdb, 0x68, 0x70, 0xd1, 0xe6, 0x29, 0x65,
+	0xcb, 0xec, 0x11, 0x4e, 0x1d, 0x4f, 0x61, 0x5f, 0xda, 0xb4, 0x1f, 0xee, 0x34, 0x9a, 0x0c, 0xcc,
+	0x13, 0x8a, 0xd7, 0xf0, 0x18, 0xc7, 0xde, 0x89, 0xb0, 0xb9, 0x7f, 0xef, 0x38, 0xc7, 0x66, 0x09,
+	0xc2, 0xa2, 0x9c, 0x5d, 0xcf, 0x17, 0x2e, 0x11, 0x9e, 0x30, 0xff, 0xbc, 0x06, 0x53, 0xe2, 0x3c,
+	0xef, 0xff, 0x4f, 0x87, 0x8f, 0xcb, 0x74, 0x48, 0x8a, 0xfe, 0xc2, 0x08, 0xd1, 0x5f, 0xcc, 0x12,
+	0x7d, 0x92, 0x2e, 0xfa, 0x87, 0x34, 0xd1, 0x8f, 0x85, 0x71, 0x49, 0x13, 0xc6, 0x13, 0x00, 0xb6,
+	0x4f, 0x6d, 0x94, 0x0e, 0xda, 0x16, 0x7e, 0x3c, 0x05, 0x82, 0x1f, 0x4a, 0xf6, 0xae, 0xed, 0xb8,
+	0xf6, 0xb6, 0x4b, 0xd7, 0x76, 0xec, 0x5e, 0x8f, 0xba, 0x4c, 0xd6, 0xeb, 0xd6, 0x10, 0x1c, 0x71,
+	0xc3, 0x1d, 0xc7, 0x6f, 0xe3, 0x59, 0xa0, 0xbd, 0xcb, 0xbe, 0x77, 0x87, 0xfa, 0x42, 0xf4, 0x87,
+	0xe0, 0xf8, 0x31, 0xb7, 0x63, 0x07, 0xec, 0x43, 0x7d, 0xcd, 0x0e, 0x6d, 0xd7, 0xeb, 0xb0, 0xd9,
+	0x50, 0xb7, 0x92, 0x60, 0x1c, 0x7d, 0xb6, 0x87, 0xb1, 0x2a, 0x9b, 0x63, 0xf3, 0xa3, 0x6e, 0x25,
+	0xa0, 0x58, 0xa3, 0x38, 0xaa, 0x7e, 0xc3, 0xf7, 0xd0, 0x4b, 0xe8, 0x0b, 0x67, 0x62, 0x12, 0x8c,
+	0x9f, 0xf7, 0x02, 0xf4, 0xf6, 0x20, 0xec, 0x0f, 0x42, 0xe1, 0x5a, 0xd4, 0x81, 0xe6, 0x2f, 0xaa,
+	0x50, 0xe5, 0x1f, 0x4d, 0xff, 0x87, 0xa7, 0xcc, 0x90, 0x87, 0xb9, 0x9c, 0xe3, 0x61, 0x2e, 0x8f,
+	0xe7, 0x61, 0x6e, 0x2a, 0x1e, 0x7e, 0x3e, 0x87, 0xa2, 0x34, 0xb6, 0x17, 0x3b, 0xf1, 0xf9, 0x54,
+	0x8a, 0x01, 0x48, 0x6d, 0x30, 0xe8, 0x53, 0x9f, 0x7b, 0xdd, 0xf9, 0x84, 0x52, 0x20, 0x58, 0x73,
+	0xe4, 0x93, 0xe7, 0xd3, 0x29, 0x4a, 0x63, 0x5e, 0xe4, 0xcc, 0x15, 0x53, 0x49, 0xa6, 0x71, 0x72,
+	0xdc, 0xe6, 0x0e, 0x05, 0x3e, 0x97, 0x44, 0x8a, 0xd5, 0xd7, 0xda, 0xa1, 0x6d, 0xdc, 0xb5, 0x14,
+	0x8e, 0x4f, 0x99, 0x96, 0xca, 0x64, 0x49, 0x53, 0x26, 0x7d, 0xea, 0x07, 0x5e, 0x4f, 0x4c, 0x23,
+	0x91, 0x52, 0x94, 0xcc, 0x11, 0x4d, 0xc9, 0x1c, 0x89, 0xbe, 0xc3, 0xf8, 0xe2, 0x21, 0x52, 0xdc,
+	0x5b, 0xd7, 0xeb, 0x0c, 0x70, 0x44, 0x1a, 0xd2, 0x5b, 0xc7, 0xd3, 0x5c, 0xa1, 0xf4, 0xed, 0x16,
+	0xf6, 0x82, 0xaf, 0x1e, 0x51, 0x1a, 0x15, 0x02, 0x77, 0x37, 0x8b, 0xf5, 0x83, 0x25, 0x94, 0x89,
+	0x7f, 0x4c, 0x9b, 0xf8, 0xaa, 0x53, 0x98, 0x2f, 0x21, 0x51, 0xda, 0xfc, 0x69, 0x05, 0xaa, 0xdc,
+	0x53, 0xf1, 0x00, 0x45, 0xbf, 0x01, 0x53, 0xdb, 0x4e, 0xe8, 0xdb, 0x21, 0x97, 0xfe, 0xb2, 0x25,
+	0x93, 0x3c, 0x20, 0x89, 0x5b, 0xa6, 0xce, 0xfb, 0x54, 0x18, 0xf3, 0x2a, 0x08, 0x05, 0x41, 0x24,
+	0xd1, 0xfd, 0x2f, 0xf6, 0x4d, 0x63, 0x88, 0x26, 0x7e, 0xd3, 0xc3, 0xe2, 0x47, 0xbb, 0xdb, 0xb4,
+	0x8d, 0x25, 0xb9, 0xc0, 0x47, 0x69, 0x9c, 0x36, 0xb4, 0xd7, 0xf2, 0xda, 0x4e, 0xaf, 0x23, 0x3c,
+	0x4c, 0x7c, 0xb1, 0x48, 0x40, 0x99, 0xe0, 0xdf, 0xeb, 0x3b, 0x3e, 0x0d, 0x22, 0xc1, 0xe7, 0x49,
+	0x65, 0xb9, 0x9e, 0xd5, 0x96, 0xeb, 0x13, 0x00, 0x7d, 0xd7, 0xde, 0xa3, 0x3e, 0x8b, 0x59, 0xe4,
+	0xd7, 0x76, 0x29, 0x10, 0x74, 0x32, 0xc5, 0x46, 0xc8, 0x9a, 0xd7, 0xed, 0xdb, 0xbd, 0x3d, 0x71,
+	0x8d, 0xd7, 0x70, 0x06, 0xd2, 0xe9, 0xd3, 0x0e, 0x6e, 0x10, 0xb0, 0xd8, 0x21, 0xda, 0x66, 0x93,
+	0xa1, 0x6c, 0x25, 0xa0, 0x78, 0x56, 0xd4, 0xe7, 0xf1, 0xdc, 0xc1, 0xe6, 0x60, 0x3b, 0xd6, 0x29,
+	0x7c, 0x72, 0xa4, 0xe6, 0x21, 0xa5, 0x83, 0xbe, 0xeb, 0xd9, 0x7c, 0x5e, 0x2f, 0x72, 0x4a, 0x63,
+	0x48, 0x6c, 0x78, 0x10, 0xc5, 0xf0, 0x30, 0x7f, 0x52, 0x81, 0x69, 0x79, 0x45, 0xc6, 0x83, 0x94,
+	0x9a, 0x65, 0x98, 0x11, 0xf7, 0xe7, 0x5c, 0xf6, 0xda, 0x7b, 0xd2, 0xb5, 0xa7, 0x80, 0x90, 0x37,
+	0x22, 0xb9, 0xa9, 0x9d, 0x9d, 0x4c, 0x40, 0xb1, 0x8d, 0xbe, 0xdd, 0xa1, 0xeb, 0xbd, 0xc8, 0xf9,
+	0x20, 0x92, 0xcc, 0xf7, 0x6c, 0x77, 0xd0, 0xf9, 0xe1, 0x87, 0x4c, 0x7c, 0xca, 0x56, 0x0c, 0xc0,
+	0xdc, 0xbb, 0x9e, 0xdf, 0x5e, 0x8b, 0x1c, 0x76, 0x65, 0x2b, 0x06, 0xa0, 0x74, 0xdd, 0xa1, 0x7b,
+	0x98, 0x0e, 0x84, 0xec, 0x44, 0x69, 0xcd, 0x1a, 0x98, 0x49, 0x58, 0x03, 0xf1, 0x0e, 0xea, 0xac,
+	0xb6, 0x83, 0x4a, 0xa2, 0xe0, 0x6c, 0x23, 0x0a, 0x9d, 0xc7, 0x30, 0x24, 0xfb, 0xb6, 0x8c, 0x8a,
+	0x63, 0xbf, 0x71, 0x54, 0x42, 0xaf, 0xef, 0xb4, 0x84, 0x56, 0xe4, 0x09, 0x35, 0xec, 0x7d, 0x41,
+	0x0f, 0x7b, 0x47, 0x45, 0xbb, 0xfd, 0x25, 0x64, 0xc5, 0x2e, 0x15, 0xfa, 0x30, 0x06, 0x60, 0x6e,
+	0xcb, 0xe3, 0xdf, 0x96, 0x81, 0xd0, 0x89, 0x31, 0x40, 0xcc, 0xbe, 0x96, 0x3b, 0x08, 0xe2, 0x1d,
+	0x21, 0x05, 0x82, 0x96, 0x8d, 0x68, 0x66, 0xb3, 0x6b, 0xbb, 0x52, 0x47, 0x6a, 0x30, 0xf3, 0xc7,
+	0x25, 0x98, 0xd3, 0x0e, 0x8c, 0x0d, 0x09, 0x8d, 0xfe, 0x8d, 0x5d, 0xca, 0x74, 0xc4, 0x94, 0x53,
+	0x1d, 0x31, 0x95, 0x8c, 0xe8, 0xff, 0xaa, 0xce, 0x06, 0x75, 0x48, 0x6a, 0x89, 0x21, 0x49, 0x7c,
+	0x0f, 0x4f, 0x0d, 0x7d, 0x0f, 0x6b, 0x4e, 0xaf, 0x99, 0x71, 0x9d, 0x5e, 0x32, 0x04, 0x7d, 0x76,
+	0x54, 0x08, 0xba, 0xf9, 0x12, 0x2c, 0x5e, 0xa3, 0xe1, 0x96, 0xd7, 0xdf, 0xb2, 0x3b, 0xa3, 0x2e,
+	0x52, 0x9d, 0x05, 0x43, 0x06, 0xd7, 0x1a, 0x3d, 0xf3, 0xc7, 0x06, 0x10, 0xb5, 0x68, 0x91, 0x7b,
+	0xbf, 0x87, 0xcb, 0x4d, 0x7e, 0xb6, 0xa6, 0x29, 0xce, 0xd6, 0x48, 0x29, 0x36, 0xe2, 0x03, 0x20,
+	0xe6, 0x15, 0x38, 0xaa, 0x9e, 0xe5, 0x1f, 0xa3, 0x8f, 0x69, 0xfb, 0x26, 0x3f, 0x31, 0xa0, 0x31,
+	0x5c, 0x4d, 0x91, 0x6b, 0x3e, 0xb2, 0x4a, 0x3f, 0x98, 0x5e, 0x1f, 0x83, 0x47, 0xde, 0xb5, 0xfd,
+	0xee, 0xa0, 0xbf, 0x66, 0xb7, 0x76, 0x12, 0xde, 0x71, 0xbc, 0xf3, 0x25, 0x2d, 0x53, 0x9c, 0x2e,
+	0xfa, 0x3c, 0x3c, 0xa9, 0xd2, 0x9a, 0x73, 0x95, 0xba, 0x64, 0x94, 0xa1, 0x3b, 0x42, 0xf1, 0x3f,
+	0xf7, 0xd9, 0x88, 0x4d, 0x40, 0x04, 0x30, 0x47, 0xe8, 0x37, 0x4b, 0xf0, 0xd4, 0xc8, 0xba, 0x05,
+	0x53, 0xdf, 0xd1, 0x98, 0x7a, 0x79, 0x34, 0x53, 0x73, 0x2a, 0x9b, 0x9c, 0xc7, 0x37, 0x05, 0x8f,
+	0x3f, 0x3b, 0x74, 0x6a, 0xeb, 0xc2, 0xd8, 0xb7, 0x64, 0xa4, 0x9c, 0xe0, 0xba, 0x0a, 0x4f, 0xa5,
+	0x3f, 0x63, 0x71, 0x99, 0xf9, 0xab, 0x90, 0x79, 0x92, 0xc9, 0xb9, 0x0c, 0xfd, 0x56, 0x09, 0x4e,
+	0x8f, 0xae, 0x48, 0x70, 0xf4, 0x5d, 0x8d, 0xa3, 0x6b, 0x59, 0x11, 0x51, 0xe3, 0xd5, 0xf6, 0xb1,
+	0x63, 0xe9, 0xcf, 0xf0, 0x46, 0xb2, 0x1c, 0x79, 0x1a, 0xe9, 0xd6, 0x3f, 0x0b, 0x8b, 0x9a, 0x23,
+	0x51, 0x61, 0xf8, 0x82, 0xea, 0x4c, 0x44, 0x38, 0xc6, 0x47, 0x49, 0x18, 0xea, 0xd0, 0xd8, 0xf1,
+	0x3c, 0xaf, 0x82, 0xf5, 0x5a, 0x19, 0x22, 0x9b, 0x30, 0x15, 0xbd, 0x56, 0xcc, 0x60, 0x7e, 0xf0,
+	0xef, 0x18, 0x30, 0xbb, 0xe5, 0xdb, 0xc1, 0xce, 0xa4, 0x77, 0x67, 0x32, 0x93, 0xd6, 0x75, 0xa9,
+	0x08, 0xf8, 0xe1, 0xf7, 0x9e, 0xaa, 0xa0, 0xbc, 0x7b, 0x34, 0xf9, 0xb9, 0xed, 0xaa, 0x7a, 0x6e,
+	0x3b, 0x84, 0x39, 0x41, 0xcf, 0x38, 0xf7, 0x92, 0xc7, 0x01, 0xc8, 0xc1, 0xce, 0x7e, 0x8e, 0xaa,
+	0xf7, 0xd1, 0x11, 0x1e, 0x84, 0x9e, 0x9f, 0x79, 0xeb, 0x2e, 0x33, 0x0d, 0x64, 0xa7, 0x04, 0x0f,
+	0x14, 0x48, 0xe1, 0x2b, 0xe7, 0x03, 0x38, 0x18, 0xb5, 0x28, 0x7a, 0x7a, 0x51, 0xeb, 0xe9, 0x89,
+	0xec, 0x9e, 0x4e, 0xda, 0xcd, 0x33, 0x97, 0x60, 0x5a, 0x1e, 0x78, 0x22, 0x8b, 0x30, 0x27, 0x7f,
+	0xdf, 0xba, 0xfe, 0xf6, 0xf5, 0xf5, 0x85, 0x03, 0x64, 0x06, 0xa6, 0x36, 0xae, 0xaf, 0xbd, 0x75,
+	0xf3, 0xca, 0xfa, 0x82, 0x81, 0x89, 0xf5, 0xf7, 0x78, 0xa2, 0x74, 0xe6, 0xd3, 0x30, 0x2d, 0x4f,
+	0xbe, 0x60, 0x41, 0xf9, 0x5b, 0x16, 0x9c, 0x07, 0xb8, 0xb1, 0x6e, 0xad, 0xad, 0x5f, 0xdf, 0x5a,
+	0xbd, 0x86, 0x65, 0xeb, 0x50, 0x5d, 0xff, 0xdc, 0xcd, 0xd5, 0xb7, 0x16, 0x4a, 0x64, 0x0a, 0xca,
+	0xd7, 0xb6, 0xd6, 0x17, 0xca, 0xf8, 0xe3, 0xad, 0xad, 0xf5, 0x85, 0xca, 0xc5, 0xef, 0xbe, 0x16,
+	0xbd, 0x5b, 0x29, 0x5d, 0x77, 0x5f, 0x84, 0xba, 0xb0, 0xd4, 0xb7, 0x29, 0x39, 0x95, 0xbe, 0x6b,
+	0x27, 0xf3, 0xc5, 0x00, 0x35, 0x9f, 0x1c, 0x85, 0x26, 0x66, 0xe9, 0x01, 0x62, 0x03, 0xc4, 0x6f,
+	0x2c, 0x92, 0xa7, 0x32, 0xb5, 0x90, 0xfe, 0x8c, 0x64, 0xf3, 0xf4, 0x68, 0xc4, 0xa8, 0x89, 0x0e,
+	0xcc, 0xaa, 0x8f, 0x19, 0x92, 0xa7, 0xf3, 0x2e, 0x6d, 0xd3, 0x1e, 0xab, 0x6b, 0x9e, 0x19, 0x07,
+	0x55, 0x6d, 0x48, 0x7d, 0x3c, 0x30, 0xab, 0xa1, 0x94, 0xd7, 0x0d, 0x9b, 0x67, 0xc6, 0x41, 0x55,
+	0x99, 0x16, 0x3f, 0xea, 0x97, 0xc5, 0xb4, 0xa1, 0xb7, 0x04, 0x9b, 0xa7, 0x47, 0x23, 0xaa, 0x7d,
+	0x51, 0x5f, 0xd3, 0xcb, 0xea, 0x4b, 0xca, 0x0b, 0x7f, 0xcd, 0x33, 0xe3, 0xa0, 0xaa, 0x0d, 0xa9,
+	0x8f, 0xd5, 0x65, 0x35, 0x94, 0xf2, 0x26, 0x5f, 0xf3, 0xcc, 0x38, 0xa8, 0x51, 0x43, 0x5d, 0x98,
+	0xd7, 0xdf, 0x83, 0x23, 0x67, 0xf3, 0x46, 0x37, 0xf1, 0xb6, 0x57, 0xf3, 0xdc, 0x78, 0xc8, 0x6a,
+	0x73, 0xfa, 0xcb, 0x6b, 0x59, 0xcd, 0xa5, 0xbe, 0x11, 0xd7, 0x3c, 0x37, 0x1e, 0xb2, 0xca, 0x46,
+	0xf5, 0x45, 0xb4, 0x2c, 0x36, 0xa6, 0x3c, 0xc6, 0xd6, 0x3c, 0x33, 0x0e, 0xaa, 0xda, 0x2f, 0xfd,
+	0x29, 0xb2, 0xac, 0x7e, 0xa5, 0x3e, 0x91, 0xd6, 0x3c, 0x37, 0x1e, 0x72, 0xd4, 0x9c, 0xcf, 0xef,
+	0x96, 0x97, 0x39, 0xb1, 0xe5, 0x41, 0xd2, 0x0f, 0x4d, 0x22, 0xf2, 0x90, 0xd5, 0x54, 0xb0, 0x8b,
+	0xdf, 0xd2, 0x5e, 0x62, 0xd0, 0xef, 0xe2, 0x7e, 0xbe, 0x88, 0x9d, 0x14, 0x49, 0xea, 0x0b, 0x05,
+	0x4b, 0x45, 0xa4, 0x7c, 0x27, 0x79, 0xc3, 0xbf, 0x8a, 0x48, 0x5e, 0x1c, 0xe3, 0xfa, 0xc9, 0x14,
+	0x4b, 0xbd, 0x79, 0xa9, 0x70, 0x39, 0x8d, 0xa0, 0xcc, 0x17, 0xae, 0xb2, 0x08, 0x1a, 0xf5, 0x86,
+	0x57, 0xf3, 0x52, 0xe1, 0x72, 0xda, 0x60, 0x65, 0xbc, 0x3c, 0x95, 0x35, 0x58, 0xf9, 0x6f, 0x61,
+	0x35, 0x5f, 0x28, 0x58, 0x4a, 0xe3, 0x4d, 0xe6, 0x9b, 0x4f, 0x59, 0xbc, 0x19, 0xf5, 0x42, 0x55,
+	0xf3, 0x52, 0xe1, 0x72, 0x11, 0x41, 0x6d, 0x98, 0x89, 0x45, 0x2c, 0x20, 0xa7, 0x47, 0x49, 0x61,
+	0x24, 0xaf, 0x4f, 0x8f, 0x81, 0x19, 0xb5, 0xf2, 0x25, 0x98, 0xd3, 0x24, 0x87, 0x9c, 0x19, 0xeb,
+	0x56, 0x54, 0xde, 0xd2, 0xd9, 0xb1, 0x70, 0xd5, 0xb6, 0x34, 0xa1, 0x20, 0x67, 0xc6, 0x90, 0x9c,
+	0x11, 0x6d, 0xa5, 0xbe, 0x2e, 0xc4, 0xb9, 0xa7, 0x8c, 0x39, 0x39, 0x3d, 0x52, 0x2c, 0x46, 0x70,
+	0x2f, 0xe5, 0x61, 0x1f, 0xde, 0x23, 0x6d, 0x28, 0xc9, 0x99, 0x31, 0xc6, 0x7b, 0x44, 0x8f, 0x52,
+	0x5f, 0xd5, 0x31, 0x0f, 0x90, 0x3e, 0x1c, 0x4c, 0xbc, 0x30, 0x43, 0xce, 0x8d, 0x1a, 0x69, 0xf5,
+	0x3d, 0x9d, 0xe6, 0xf9, 0x31, 0xb1, 0xa3, 0x16, 0x77, 0x61, 0x71, 0xe8, 0x81, 0x12, 0xb2, 0x32,
+	0xce, 0x98, 0xc7, 0x8f, 0x6c, 0x34, 0x9f, 0x19, 0x1b, 0x5f, 0x6d, 0x77, 0xe8, 0xc5, 0x98, 0xac,
+	0x76, 0xb3, 0x1e, 0xbb, 0x69, 0x3e, 0x33, 0x36, 0xbe, 0xca, 0xe1, 0xc4, 0x7b, 0x25, 0x59, 0x1c,
+	0x4e, 0x7f, 0x31, 0xa5, 0x79, 0x7e, 0x4c, 0x6c, 0xb5, 0xa7, 0x43, 0x0f, 0x85, 0x64, 0xf5, 0x34,
+	0xeb, 0x19, 0x93, 0xe6, 0x33, 0x63, 0xe3, 0xab, 0x72, 0xab, 0xdd, 0x90, 0x93, 0x25, 0xb7, 0x69,
+	0x2f, 0x8f, 0x34, 0xcf, 0x8e, 0x85, 0xab, 0xb6, 0xa5, 0xbd, 0xa3, 0x90, 0xd5, 0x56, 0xda, 0x83,
+	0x13, 0xcd, 0xb3, 0x63, 0xe1, 0xaa, 0x86, 0x94, 0x1a, 0x31, 0x99, 0x65, 0x48, 0xa5, 0x84, 0x99,
+	0x36, 0xcf, 0x8c, 0x83, 0x1a, 0x35, 0xf4, 0xbe, 0x7c, 0xf0, 0x2d, 0xf1, 0x0c, 0x03, 0xb9, 0x98,
+	0x27, 0xee, 0xe9, 0x6f, 0x36, 0x14, 0x55, 0xa3, 0xbb, 0xf2, 0x71, 0x79, 0xed, 0xcd, 0x05, 0xf2,
+	0x6c, 0x5e, 0x2d, 0x69, 0xcf, 0x33, 0x14, 0x6d, 0x37, 0xea, 0x73, 0xe2, 0x91, 0x85, 0xfc, 0x3e,
+	0xa7, 0xbf, 0xc8, 0x50, 0xb4, 0xed, 0x7b, 0xf2, 0xe9, 0x3f, 0xfd, 0x45, 0x05, 0x72, 0x21, 0x97,
+	0xdd, 0x69, 0xaf, 0x2f, 0x14, 0x6d, 0x39, 0x04, 0x32, 0xfc, 0x80, 0x02, 0xc9, 0xd5, 0x6a, 0x29,
+	0x4f, 0x2d, 0x14, 0x6d, 0xf5, 0x2b, 0xf2, 0xfd, 0xeb, 0xe4, 0x9b, 0x09, 0xe4, 0xb9, 0xbc, 0x8a,
+	0x32, 0x5e, 0x58, 0x98, 0xb8, 0xcf, 0xea, 0xf3, 0x08, 0xf9, 0x7d, 0x4e, 0x79, 0x48, 0x61, 0xe2,
+	0x31, 0xd6, 0x5f, 0x44, 0xc8, 0x1f, 0xe3, 0xd4, 0xd7, 0x13, 0x26, 0x6e, 0x59, 0x7f, 0x00, 0x21,
+	0xbf, 0xe5, 0xd4, 0xc7, 0x12, 0x26, 0xe6, 0xb4, 0xfa, 0xde, 0x41, 0x3e, 0xa7, 0x53, 0x5e, 0x46,
+	0x98, 0x78, 0x26, 0x27, 0x9e, 0x38, 0xc8, 0x9f, 0xc9, 0xe9, 0xef, 0x21, 0x14, 0x6d, 0xbb, 0x0f,
+	0x0b, 0xc9, 0x07, 0x0d, 0xc8, 0xf9, 0xdc, 0x59, 0xdc, 0xef, 0xef, 0xaf, 0xc5, 0xef, 0x45, 0xef,
+	0x5b, 0xa4, 0xc7, 0x25, 0xbf, 0x34, 0xc1, 0x3b, 0x00, 0x9c, 0x90, 0x97, 0x27, 0x7e, 0x41, 0x80,
+	0x4d, 0xf1, 0x43, 0x29, 0xb7, 0xe9, 0x67, 0xa9, 0xf1, 0xec, 0x8b, 0xfd, 0x9b, 0x17, 0x0a, 0x5f,
+	0xd5, 0x6f, 0x1e, 0x20, 0x01, 0x2c, 0x24, 0x2f, 0xab, 0x27, 0xe7, 0xc7, 0xbd, 0xd4, 0x9e, 0xb7,
+	0xbb, 0x52, 0xec, 0x0e, 0x7c, 0xf3, 0x00, 0xf9, 0x10, 0x96, 0xd2, 0xae, 0x68, 0x27, 0x17, 0x8a,
+	0x5c, 0xe7, 0xce, 0x1b, 0xbf, 0x58, 0xfc, 0x06, 0x78, 0xf3, 0x00, 0xc1, 0x67, 0xfd, 0x53, 0xaf,
+	0x4f, 0x27, 0xd9, 0xf5, 0x65, 0xde, 0xd6, 0xde, 0x7c, 0xae, 0x50, 0x19, 0x75, 0xe0, 0x53, 0x2e,
+	0x26, 0xcf, 0x1a, 0xf8, 0xec, 0x8b, 0xd5, 0x9b, 0x17, 0x0a, 0x94, 0x88, 0x5a, 0xff, 0x63, 0x03,
+	0x1e, 0x1f, 0x79, 0x11, 0x37, 0x79, 0x7d, 0xe2, 0x1b, 0xbc, 0x39, 0x69, 0x6f, 0xec, 0xf3, 0x06,
+	0x70, 0xf3, 0x00, 0xf9, 0xa1, 0x01, 0x27, 0xf2, 0x6f, 0x97, 0x26, 0x9f, 0x9a, 0xec, 0x4e, 0x6a,
+	0x4e, 0xe2, 0xab, 0xfb, 0xb9, 0xd0, 0xda, 0x3c, 0x40, 0xf0, 0x81, 0x94, 0xd1, 0xd7, 0x1e, 0x93,
+	0x37, 0x26, 0xbf, 0x30, 0x99, 0xd3, 0xf9, 0xe6, 0x7e, 0x6f, 0x5c, 0xe6, 0x7e, 0x3f, 0xfd, 0xca,
+	0xe2, 0x2c, 0xbf, 0x5f, 0xea, 0x45, 0xc8, 0xcd, 0x73, 0xe3, 0x21, 0x27, 0xe6, 0xf9, 0xd0, 0xfd,
+	0xbe, 0x39, 0xf3, 0x3c, 0xeb, 0x2e, 0xe3, 0xe6, 0xc5, 0x22, 0x45, 0x34, 0x67, 0x4e, 0x16, 0x83,
+	0x02, 0xf2, 0x62, 0xe1, 0xfb, 0x73, 0x73, 0x9d, 0x39, 0x23, 0xef, 0xdd, 0x35, 0x0f, 0x90, 0x1f,
+	0xf0, 0x77, 0x1b, 0xb2, 0xaf, 0x8f, 0x25, 0xaf, 0x4c, 0x74, 0xe7, 0x2c, 0x27, 0xec, 0x53, 0xfb,
+	0xb8, 0xaf, 0xd6, 0x3c, 0x40, 0x7e, 0xdf, 0x80, 0xe3, 0x79, 0x17, 0xa6, 0x92, 0x97, 0x27, 0xb9,
+	0x64, 0x95, 0x93, 0xf6, 0xca, 0xe4, 0xf7, 0xb3, 0xf2, 0xbd, 0x92, 0x38, 0xb6, 0x24, 0x6b, 0xaf,
+	0x64, 0x28, 0xe0, 0xa5, 0x79, 0x7a, 0x34, 0xa2, 0xba, 0x10, 0x26, 0xc3, 0x39, 0xb2, 0x16, 0xc2,
+	0x8c, 0xd8, 0x93, 0xe6, 0xca, 0xb8, 0xe8, 0x51, 0xa3, 0x7b, 0x40, 0x86, 0xa3, 0x36, 0xb2, 0xcc,
+	0xbe, 0xcc, 0xe0, 0x8f, 0xe6, 0xb3, 0xe3, 0x17, 0x88, 0x9a, 0xfe, 0x43, 0x03, 0x1e, 0x1b, 0x11,
+	0x6a, 0x41, 0x5e, 0x9d, 0x30, 0x42, 0x83, 0x53, 0xf5, 0xda, 0xbe, 0xe2, 0x3b, 0xcc, 0x03, 0xe4,
+	0x8f, 0x0c, 0x58, 0x1e, 0x15, 0xbb, 0x40, 0x5e, 0x9b, 0x34, 0xe6, 0x81, 0x13, 0xf9, 0xfa, 0xfe,
+	0x42, 0x26, 0xcc, 0x03, 0xe4, 0x2e, 0x53, 0x72, 0x4c, 0x05, 0xb1, 0x88, 0xf6, 0xe8, 0xd0, 0x69,
+	0x4a, 0x3c, 0x57, 0x0a, 0x62, 0x86, 0xf0, 0x64, 0xa3, 0x47, 0x0d, 0x5b, 0x50, 0x65, 0x9b, 0xd5,
+	0x24, 0xe3, 0x6a, 0x09, 0x35, 0xea, 0xa0, 0xf9, 0x44, 0x2e, 0x4e, 0x54, 0xe7, 0x7b, 0x30, 0x25,
+	0x36, 0xcd, 0xc9, 0xc9, 0xec, 0x3b, 0x45, 0xe2, 0x5d, 0xfc, 0xe6, 0xa9, 0x11, 0x58, 0xb2, 0xe6,
+	0xed, 0x1a, 0x8b, 0x52, 0x7b, 0xee, 0x7f, 0x07, 0x00, 0x05, 0x1a, 0xaf, 0x6d, 0xc0, 0x94, 0x00,
+	0x00,
 }
@@ -0,0 +1,222 @@
+// Package backup exports the collections of the healum database to archives and restores them
+//
+// An archive is a gzipped tar of manifest.json, the BackupManifest, followed by a <collection>.jsonl entry per
+// collection and edge collection of common.DbHealum with a document per line. The archive of an organisation has
+// the documents of the organisation and the edges linking them. Restoring replaces the documents and edges with the
+// same keys, with remapped ids every document and edge gets a new key and the values equal to a key of the archive
+// are changed, so an organisation can be restored next to its original.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+// FormatVersion is the version of the archives written by Backup, Restore reads the archives up to this version
+const FormatVersion = 1
+
+const manifestName = "manifest.json"
+
+var (
+	// Dir is the directory of the archives of the Backup and RestoreBackup RPCs
+	Dir = "backups"
+
+	ErrVersion    = errors.New("unsupported backup version")
+	ErrManifest   = errors.New("backup manifest is missing")
+	ErrCollection = errors.New("backup collection is unknown")
+)
+
+const documentsQuery = `
+	FOR doc IN @@collection
+	FILTER @org_id == "" || doc.parameter1 == @org_id || doc._key == @org_id
+	RETURN {id: doc._key, data: UNSET(doc, "_id", "_rev")}`
+
+const edgesQuery = `
+	FOR doc IN @@collection
+	RETURN {id: doc._key, data: UNSET(doc, "_id", "_rev")}`
+
+// Collections returns the collections of the healum database followed by its edge collections
+func Collections() []*mdb.BackupCollection {
+	collections := []*mdb.BackupCollection{}
+	edges := []*mdb.BackupCollection{}
+	seen := map[string]bool{}
+	for _, cols := range common.DbHealum {
+		if len(cols) == 0 || seen[cols[0]] {
+			continue
+		}
+		seen[cols[0]] = true
+		if len(cols) == 4 {
+			edges = append(edges, &mdb.BackupCollection{Name: cols[0], Edge: true, From: cols[2], To: cols[3]})
+			continue
+		}
+		collections = append(collections, &mdb.BackupCollection{Name: cols[0]})
+	}
+	return append(collections, edges...)
+}
+
+// ArchiveName returns the name of the archive of a backup
+func ArchiveName(name, orgId string, now time.Time) string {
+	if len(orgId) == 0 {
+		orgId = "all"
+	}
+	return fmt.Sprintf("%s-%s-%s.tar.gz", name, orgId, now.UTC().Format("20060102T150405Z"))
+}
+
+func database(name, collection string) *mdb.Database {
+	return &mdb.Database{Name: name, Table: collection, Driver: common.DbHealumDriver}
+}
+
+// Backup writes the archive of a database at path, only the documents of the organisation and the edges between
+// them if orgId is set
+func Backup(path, name, orgId string, batchSize int, now int64) (*mdb.BackupManifest, error) {
+	manifest := &mdb.BackupManifest{
+		Version:     FormatVersion,
+		Database:    name,
+		OrgId:       orgId,
+		Created:     now,
+		Collections: Collections(),
+	}
+
+	// the entries are written to temporary files first, the tar headers need their size
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	files := map[string]*os.File{}
+	defer func() {
+		for _, f := range files {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	exported := map[string]bool{}
+	for _, c := range manifest.Collections {
+		f, err := ioutil.TempFile(dir, ".backup-")
+		if err != nil {
+			return nil, err
+		}
+		files[c.Name] = f
+		if err := export(f, name, orgId, c, batchSize, exported); err != nil {
+			return nil, err
+		}
+	}
+
+	tmp, err := ioutil.TempFile(dir, ".backup-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	if err := writeArchive(tmp, manifest, files); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// export writes the documents of a collection a line each, exported has the ids of the exported documents and
+// selects the edges of an organisation
+func export(w io.Writer, name, orgId string, c *mdb.BackupCollection, batchSize int, exported map[string]bool) error {
+	query := documentsQuery
+	vars := map[string]interface{}{"@collection": c.Name}
+	if c.Edge {
+		query = edgesQuery
+	} else {
+		vars["org_id"] = orgId
+	}
+	cursor, err := db.RunQueryStream(database(name, c.Name), query, vars, batchSize)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+
+	for {
+		records, err := cursor.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if c.Edge && len(orgId) > 0 {
+				e := struct {
+					From string `json:"_from"`
+					To   string `json:"_to"`
+				}{}
+				if err := json.Unmarshal([]byte(r.Parameter3), &e); err != nil {
+					return err
+				}
+				// an edge to a document of another organisation would leak its id
+				if !exported[e.From] || !exported[e.To] {
+					continue
+				}
+			}
+			if !c.Edge {
+				exported[c.Name+"/"+r.Id] = true
+			}
+			if _, err := io.WriteString(w, r.Parameter3+"\n"); err != nil {
+				return err
+			}
+			c.Count++
+		}
+	}
+}
+
+func writeArchive(w io.Writer, manifest *mdb.BackupManifest, files map[string]*os.File) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	body, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(tw, manifestName, int64(len(body)), strings.NewReader(string(body))); err != nil {
+		return err
+	}
+	for _, c := range manifest.Collections {
+		f := files[c.Name]
+		size, err := f.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := writeEntry(tw, c.Name+".jsonl", size, f); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+func writeEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: size}); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, r)
+	return err
+}
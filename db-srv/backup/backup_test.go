@@ -0,0 +1,197 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"server/common"
+	"server/db-srv/db"
+	"server/db-srv/db/memory"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/registry"
+	mock_registry "github.com/micro/go-micro/registry/mock"
+	"github.com/micro/go-micro/selector"
+)
+
+var testDB = common.TestingName(common.DbHealumName)
+
+func initDb(t *testing.T) string {
+	reg := mock_registry.NewRegistry()
+	db.Drivers[common.DbHealumDriver] = memory.NewDriver()
+	if err := reg.Register(&registry.Service{
+		Name: db.DBServiceNamespace + "." + common.DbHealumDriver,
+		Nodes: []*registry.Node{{
+			Id:       "backup-" + common.DbHealumDriver,
+			Address:  "memory",
+			Metadata: map[string]string{db.DBDriverKey: common.DbHealumDriver},
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Init(selector.NewSelector(selector.Registry(reg))); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func runQuery(t *testing.T, name, q string, bindVars map[string]interface{}) []*mdb.Record {
+	records, err := db.RunQuery(&mdb.Database{Name: name, Table: common.DbGoalTable, Driver: common.DbHealumDriver}, q, bindVars)
+	if err != nil {
+		t.Fatalf("%s: %v", q, err)
+	}
+	return records
+}
+
+// createGoal creates a goal of the organisation shared with a user of the organisation
+func createGoal(t *testing.T, id, orgId string) {
+	runQuery(t, testDB, `
+		INSERT {_key: @id, id: @id, created: 1, name: "goal", parameter1: @org_id, data: {title: "goal", owner: @id}} INTO goal
+		INSERT {_key: CONCAT("u", @id), id: CONCAT("u", @id), name: "user", parameter1: @org_id} INTO user
+		INSERT {_from: CONCAT("goal/", @id), _to: CONCAT("user/u", @id), data: {}} INTO share_goal_user`,
+		map[string]interface{}{"id": id, "org_id": orgId})
+}
+
+// shareGoal shares a goal with a user
+func shareGoal(t *testing.T, id, userId string) {
+	runQuery(t, testDB, `INSERT {_from: CONCAT("goal/", @id), _to: CONCAT("user/", @user_id), data: {}} INTO share_goal_user`,
+		map[string]interface{}{"id": id, "user_id": userId})
+}
+
+func documents(t *testing.T, name, collection string) []map[string]interface{} {
+	docs := []map[string]interface{}{}
+	for _, r := range runQuery(t, name, `FOR d IN @@collection RETURN {data: d}`, map[string]interface{}{"@collection": collection}) {
+		doc := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(r.Parameter3), &doc); err != nil {
+			t.Fatal(err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func count(manifest *mdb.BackupManifest, collection string) int64 {
+	for _, c := range manifest.Collections {
+		if c.Name == collection {
+			return c.Count
+		}
+	}
+	return -1
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	dir := initDb(t)
+	defer os.RemoveAll(dir)
+	createGoal(t, "g1", "org1")
+	createGoal(t, "g2", "org2")
+	shareGoal(t, "g1", "ug2")
+
+	path := filepath.Join(dir, "org1.tar.gz")
+	manifest, err := Backup(path, testDB, "org1", 1, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Version != FormatVersion || manifest.OrgId != "org1" || manifest.Created != 100 {
+		t.Errorf("Manifest is invalid: %v", manifest)
+	}
+	// the edge to the user of another organisation isn't
+	if count(manifest, common.DbGoalTable) != 1 || count(manifest, common.DbUserTable) != 1 || count(manifest, common.DbShareGoalUserEdgeTable) != 1 {
+		t.Errorf("Manifest counts are invalid: %v", manifest)
+	}
+
+	// restored next to the original
+	reindexed := []string{}
+	rsp, err := Restore(path, testDB, &Options{RemapIds: true, Reindex: func(database *mdb.Database, orgId string) (int64, error) {
+		reindexed = append(reindexed, database.Table)
+		return 0, nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Restored != 3 || len(rsp.Ids["g1"]) == 0 || len(rsp.Ids["ug1"]) == 0 || rsp.Manifest.OrgId != "org1" {
+		t.Fatalf("Restore is invalid: %v", rsp)
+	}
+	if len(reindexed) != 2 || reindexed[0] != common.DbGoalTable || reindexed[1] != common.DbUserTable {
+		t.Errorf("Restored collections must be reindexed: %v", reindexed)
+	}
+	id := rsp.Ids["g1"]
+	restored := false
+	for _, doc := range documents(t, testDB, common.DbGoalTable) {
+		if doc["_key"] == id {
+			restored = doc["id"] == id && doc["data"].(map[string]interface{})["owner"] == id
+		}
+	}
+	if !restored {
+		t.Errorf("Goal must be restored with its remapped ids: %v", documents(t, testDB, common.DbGoalTable))
+	}
+	edges := documents(t, testDB, common.DbShareGoalUserEdgeTable)
+	if len(edges) != 4 {
+		t.Fatalf("Edge must be restored: %v", edges)
+	}
+	linked := false
+	for _, e := range edges {
+		linked = linked || (e["_from"] == "goal/"+id && e["_to"] == "user/"+rsp.Ids["ug1"])
+	}
+	if !linked {
+		t.Errorf("Restored edge must link the restored goal: %v", edges)
+	}
+
+	// restored in another database with the same keys, twice
+	for i := 0; i < 2; i++ {
+		if _, err := Restore(path, testDB+"_copy", &Options{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if docs := documents(t, testDB+"_copy", common.DbGoalTable); len(docs) != 1 || docs[0]["_key"] != "g1" {
+		t.Errorf("Goal must be restored once: %v", docs)
+	}
+}
+
+func TestRestoreVersion(t *testing.T) {
+	dir := initDb(t)
+	defer os.RemoveAll(dir)
+
+	f, err := os.Create(filepath.Join(dir, "next.tar.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeArchive(f, &mdb.BackupManifest{Version: FormatVersion + 1}, nil); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if _, err := Restore(f.Name(), testDB, &Options{}); err != ErrVersion {
+		t.Errorf("Archive of a later version must not be restored: %v", err)
+	}
+}
+
+func TestRestoreCheck(t *testing.T) {
+	dir := initDb(t)
+	defer os.RemoveAll(dir)
+	createGoal(t, "g1", "org1")
+
+	path := filepath.Join(dir, "org1.tar.gz")
+	if _, err := Backup(path, testDB, "org1", 1, 100); err != nil {
+		t.Fatal(err)
+	}
+	rejected := errors.New("rejected")
+	_, err := Restore(path, testDB+"_check", &Options{Check: func(manifest *mdb.BackupManifest) error {
+		if manifest.OrgId != "org1" {
+			t.Errorf("Manifest is invalid: %v", manifest)
+		}
+		return rejected
+	}})
+	if err != rejected {
+		t.Errorf("Rejected archive must not be restored: %v", err)
+	}
+	if docs := documents(t, testDB+"_check", common.DbGoalTable); len(docs) != 0 {
+		t.Errorf("Rejected archive must not be written: %v", docs)
+	}
+}
@@ -0,0 +1,242 @@
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+
+	"server/db-srv/db"
+	"server/db-srv/indexer"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/pborman/uuid"
+)
+
+// the documents of the archive replace the documents with the same key
+const restoreQuery = `
+	FOR d IN @docs
+	UPSERT {_key: d._key} INSERT d REPLACE d IN @@collection`
+
+// maximum size of a document of an archive
+const maxLine = 64 * 1024 * 1024
+
+// Options of a restore
+type Options struct {
+	// RemapIds gives new keys to the documents and edges and changes the values equal to a key of the archive
+	RemapIds  bool
+	BatchSize int
+	// Reindex rebuilds the search index of a restored collection, it returns the number of records which failed
+	Reindex func(database *mdb.Database, orgId string) (int64, error)
+	// Check is called with the manifest before anything is restored, the archive isn't restored if it fails
+	Check func(manifest *mdb.BackupManifest) error
+}
+
+// Reindex rebuilds the search index of a collection with the default indexer
+func Reindex(database *mdb.Database, orgId string) (int64, error) {
+	var failed int64
+	err := indexer.Reindex(database, orgId, 0, func(p *mdb.ReindexResponse) error {
+		failed = p.Failed
+		return nil
+	})
+	return failed, err
+}
+
+// readArchive checks the manifest of an archive, with check too if it's set, and calls fn with every collection entry
+func readArchive(path string, check func(manifest *mdb.BackupManifest) error, fn func(c *mdb.BackupCollection, r io.Reader) error) (*mdb.BackupManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	var manifest *mdb.BackupManifest
+	entries := map[string]*mdb.BackupCollection{}
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if manifest == nil {
+			if h.Name != manifestName {
+				return nil, ErrManifest
+			}
+			manifest = &mdb.BackupManifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, err
+			}
+			if manifest.Version < 1 || manifest.Version > FormatVersion {
+				return nil, ErrVersion
+			}
+			if check != nil {
+				if err := check(manifest); err != nil {
+					return nil, err
+				}
+			}
+			known := map[string]bool{}
+			for _, c := range Collections() {
+				known[c.Name] = true
+			}
+			for _, c := range manifest.Collections {
+				if !known[c.Name] {
+					return nil, ErrCollection
+				}
+				entries[c.Name+".jsonl"] = c
+			}
+			continue
+		}
+
+		c, ok := entries[h.Name]
+		if !ok {
+			return nil, ErrCollection
+		}
+		if err := fn(c, tr); err != nil {
+			return nil, err
+		}
+	}
+	if manifest == nil {
+		return nil, ErrManifest
+	}
+	return manifest, nil
+}
+
+// eachDocument calls fn with the documents of a collection entry
+func eachDocument(r io.Reader, fn func(doc map[string]interface{}) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLine)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		doc := map[string]interface{}{}
+		if err := json.Unmarshal(scanner.Bytes(), &doc); err != nil {
+			return err
+		}
+		if err := fn(doc); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// remap changes the values equal to a key of the archive, handles has the new "<collection>/<key>" ids of the
+// documents as the keys aren't unique across the collections and _from and _to are handles
+func remap(v interface{}, ids, handles map[string]string) interface{} {
+	switch t := v.(type) {
+	case string:
+		if id, ok := handles[t]; ok {
+			return id
+		}
+		if id, ok := ids[t]; ok {
+			return id
+		}
+	case map[string]interface{}:
+		for k, value := range t {
+			if s, ok := value.(string); ok && (k == "_from" || k == "_to") {
+				if id, ok := handles[s]; ok {
+					t[k] = id
+				}
+				continue
+			}
+			t[k] = remap(value, ids, handles)
+		}
+	case []interface{}:
+		for i, value := range t {
+			t[i] = remap(value, ids, handles)
+		}
+	}
+	return v
+}
+
+// Restore writes the documents and edges of the archive at path in a database and rebuilds the search indexes of
+// the restored collections
+func Restore(path, name string, opts *Options) (*mdb.RestoreBackupResponse, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = db.DefaultBatchSize
+	}
+	rsp := &mdb.RestoreBackupResponse{Ids: map[string]string{}}
+	handles := map[string]string{}
+
+	// the documents can reference the documents of the next collections
+	if opts.RemapIds {
+		_, err := readArchive(path, opts.Check, func(c *mdb.BackupCollection, r io.Reader) error {
+			return eachDocument(r, func(doc map[string]interface{}) error {
+				if key, ok := doc["_key"].(string); ok {
+					id := uuid.NewUUID().String()
+					rsp.Ids[key] = id
+					handles[c.Name+"/"+key] = c.Name + "/" + id
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	manifest, err := readArchive(path, opts.Check, func(c *mdb.BackupCollection, r io.Reader) error {
+		docs := []interface{}{}
+		flush := func() error {
+			if len(docs) == 0 {
+				return nil
+			}
+			vars := map[string]interface{}{"@collection": c.Name, "docs": docs}
+			if _, err := db.RunQuery(database(name, c.Name), restoreQuery, vars); err != nil {
+				return err
+			}
+			rsp.Restored += int64(len(docs))
+			docs = []interface{}{}
+			return nil
+		}
+		err := eachDocument(r, func(doc map[string]interface{}) error {
+			if opts.RemapIds {
+				remap(doc, rsp.Ids, handles)
+			}
+			docs = append(docs, doc)
+			if len(docs) < batchSize {
+				return nil
+			}
+			return flush()
+		})
+		if err != nil {
+			return err
+		}
+		return flush()
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp.Manifest = manifest
+
+	if opts.Reindex == nil {
+		return rsp, nil
+	}
+	orgId := manifest.OrgId
+	if id, ok := rsp.Ids[orgId]; ok {
+		orgId = id
+	}
+	for _, c := range manifest.Collections {
+		if c.Edge || c.Count == 0 {
+			continue
+		}
+		failed, err := opts.Reindex(database(name, c.Name), orgId)
+		if err != nil {
+			return nil, err
+		}
+		rsp.IndexFailed += failed
+	}
+	return rsp, nil
+}
@@ -0,0 +1,233 @@
+// Package changefeed tails the writes of the healum database and publishes them as change events
+//
+// Every event is stored in the change log collection before it is published on
+// common.ChangeTopic(collection), consumers which missed events catch up with the ReadChanges rpc.
+package changefeed
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"server/common"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/broker"
+)
+
+var (
+	// PollInterval is the wait between two reads of an idle log
+	PollInterval = time.Second
+	// Retention is how long the events are kept in the change log, 0 keeps them forever
+	Retention = 7 * 24 * time.Hour
+	// PurgeInterval is the wait between two purges of the change log
+	PurgeInterval = time.Hour
+)
+
+// collections which are never published, the feed would follow its own writes
+var skipped = map[string]bool{
+	common.DbChangeLogTable:        true,
+	common.DbChangeCheckpointTable: true,
+	common.DbMigrationTable:        true,
+	common.DbIndexQueueTable:       true,
+	common.DbIndexDeadLetterTable:  true,
+}
+
+// Feed publishes the changes of a database
+type Feed struct {
+	database string
+	source   Source
+	store    Store
+	broker   broker.Broker
+	handlers []Handler
+
+	// sequence of the last stored event
+	sequence int64
+	exit     chan bool
+	wg       sync.WaitGroup
+}
+
+// Handler is called with every event before it is published, the change is read again if it fails
+type Handler func(*mdb.ChangeEvent) error
+
+// NewFeed returns a feed of the database changes read from the source
+func NewFeed(database string, source Source, store Store, b broker.Broker) *Feed {
+	return &Feed{
+		database: database,
+		source:   source,
+		store:    store,
+		broker:   b,
+		exit:     make(chan bool),
+	}
+}
+
+// Handle adds a handler, it must be called before Start
+func (f *Feed) Handle(h Handler) {
+	f.handlers = append(f.handlers, h)
+}
+
+// Start resumes after the last stored event and publishes the changes until Stop is called
+// the first start publishes the writes done after it
+func (f *Feed) Start() error {
+	ctx := context.Background()
+	seq, err := f.store.Last()
+	if err != nil {
+		return err
+	}
+	if seq == 0 {
+		if seq, err = f.source.LastTick(ctx); err != nil {
+			return err
+		}
+	}
+	f.sequence = seq
+
+	f.wg.Add(1)
+	go f.run(ctx)
+	return nil
+}
+
+// Stop waits for the current read to complete
+func (f *Feed) Stop() error {
+	close(f.exit)
+	f.wg.Wait()
+	return nil
+}
+
+func (f *Feed) run(ctx context.Context) {
+	defer f.wg.Done()
+	poll := time.NewTicker(PollInterval)
+	defer poll.Stop()
+	purge := time.NewTicker(PurgeInterval)
+	defer purge.Stop()
+
+	for {
+		select {
+		case <-f.exit:
+			return
+		case <-purge.C:
+			if Retention > 0 {
+				if err := f.store.Purge(time.Now().Add(-Retention).Unix()); err != nil {
+					common.ErrorLog(common.DbSrv, f.run, err, "Change log purge is failed")
+				}
+			}
+		case <-poll.C:
+			// drain the log before waiting again
+			for {
+				n, err := f.poll(ctx)
+				if err != nil {
+					common.ErrorLog(common.DbSrv, f.poll, err, "Change feed read is failed")
+				}
+				if err != nil || n == 0 {
+					break
+				}
+			}
+		}
+	}
+}
+
+// poll stores and publishes the changes after the last event, it returns the number of changes read
+func (f *Feed) poll(ctx context.Context) (int, error) {
+	changes, next, err := f.source.Tail(ctx, f.sequence)
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range changes {
+		if c.Tick <= f.sequence {
+			continue
+		}
+		if err := f.process(c); err != nil {
+			return 0, err
+		}
+		f.sequence = c.Tick
+	}
+	if next > f.sequence {
+		f.sequence = next
+	}
+	return len(changes), nil
+}
+
+func (f *Feed) process(c *Change) error {
+	if skipped[c.Collection] || len(c.Collection) == 0 || c.Collection[0] == '_' {
+		return nil
+	}
+	old, err := f.store.Previous(c.Collection, c.Key)
+	if err != nil {
+		return err
+	}
+	e, err := newEvent(f.database, c, old)
+	if err != nil {
+		return err
+	}
+	if err := f.store.Append(e, c.Key); err != nil {
+		return err
+	}
+	for _, h := range f.handlers {
+		if err := h(e); err != nil {
+			return err
+		}
+	}
+	return Publish(f.broker, e)
+}
+
+// Publish sends an event on the topic of its collection
+func Publish(b broker.Broker, e *mdb.ChangeEvent) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return b.Publish(common.ChangeTopic(e.Collection), &broker.Message{
+		Header: map[string]string{"sequence": strconv.FormatInt(e.Sequence, 10)},
+		Body:   body,
+	})
+}
+
+// newEvent builds the event of a change, old is the previous document of the record if it is known
+func newEvent(database string, c *Change, old map[string]interface{}) (*mdb.ChangeEvent, error) {
+	e := &mdb.ChangeEvent{
+		Sequence:   c.Tick,
+		Database:   database,
+		Collection: c.Collection,
+		Id:         c.Key,
+		Created:    time.Now().Unix(),
+	}
+
+	// records keep the id in the document, organisation in parameter1 (see common.QueryAuth)
+	doc := c.Doc
+	if doc == nil {
+		doc = old
+	}
+	if id, ok := doc["id"].(string); ok && len(id) > 0 {
+		e.Id = id
+	}
+	e.OrgId, _ = doc["parameter1"].(string)
+
+	switch {
+	case c.Removed:
+		e.Type = mdb.ChangeType_DELETE
+	case old != nil:
+		e.Type = mdb.ChangeType_UPDATE
+	// the change log was purged or the feed was off, only the record knows if it's new
+	case doc["created"] != nil && doc["created"] != doc["updated"]:
+		e.Type = mdb.ChangeType_UPDATE
+	default:
+		e.Type = mdb.ChangeType_CREATE
+	}
+
+	if old != nil {
+		body, err := json.Marshal(old)
+		if err != nil {
+			return nil, err
+		}
+		e.Old = string(body)
+	}
+	if c.Doc != nil {
+		body, err := json.Marshal(c.Doc)
+		if err != nil {
+			return nil, err
+		}
+		e.New = string(body)
+	}
+	return e, nil
+}
@@ -0,0 +1,166 @@
+package changefeed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"server/common"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/broker"
+	"github.com/micro/go-micro/broker/mock"
+)
+
+type testSource struct {
+	changes []*Change
+}
+
+func (s *testSource) LastTick(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (s *testSource) Tail(ctx context.Context, from int64) ([]*Change, int64, error) {
+	changes := []*Change{}
+	for _, c := range s.changes {
+		if c.Tick > from {
+			changes = append(changes, c)
+		}
+	}
+	return changes, from, nil
+}
+
+type testStore struct {
+	events []*mdb.ChangeEvent
+	docs   map[string]map[string]interface{}
+}
+
+func (s *testStore) Last() (int64, error) {
+	if len(s.events) == 0 {
+		return 0, nil
+	}
+	return s.events[len(s.events)-1].Sequence, nil
+}
+
+func (s *testStore) Previous(collection, key string) (map[string]interface{}, error) {
+	return s.docs[collection+"/"+key], nil
+}
+
+func (s *testStore) Append(e *mdb.ChangeEvent, key string) error {
+	s.events = append(s.events, e)
+	doc := map[string]interface{}(nil)
+	if len(e.New) > 0 {
+		if err := json.Unmarshal([]byte(e.New), &doc); err != nil {
+			return err
+		}
+	}
+	s.docs[e.Collection+"/"+key] = doc
+	return nil
+}
+
+func (s *testStore) Read(collection string, from, limit int64) ([]*mdb.ChangeEvent, error) {
+	return nil, nil
+}
+
+func (s *testStore) Purge(before int64) error {
+	return nil
+}
+
+func TestTailWAL(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/_db/healum/_api/collection":
+			fmt.Fprint(w, `{"result":[{"name":"goal","globallyUniqueId":"h1"}]}`)
+		case "/_db/healum/_api/wal/tail":
+			if r.URL.Query().Get("from") != "10" {
+				t.Errorf("Tail must start from the tick, got %s", r.URL.RawQuery)
+			}
+			w.Header().Set("X-Arango-Replication-Lastincluded", "14")
+			fmt.Fprintln(w, `{"tick":"11","type":2200,"tid":"5"}`)
+			fmt.Fprintln(w, `{"tick":"12","type":2300,"cuid":"h1","data":{"_key":"g1","id":"g1","parameter1":"o1"}}`)
+			fmt.Fprintln(w, `{"tick":"13","type":2302,"cuid":"h1","data":{"_key":"g1","_rev":"x"}}`)
+			fmt.Fprintln(w, `{"tick":"14","type":2201,"tid":"5"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	s := NewWALSource(ts.URL, "healum", "root", "")
+	changes, next, err := s.Tail(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 14 {
+		t.Errorf("Next tick must be the last included, got %d", next)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("Transaction markers must be skipped, got %d changes", len(changes))
+	}
+	if changes[0].Tick != 12 || changes[0].Collection != "goal" || changes[0].Key != "g1" || changes[0].Removed {
+		t.Errorf("Insert is invalid: %+v", changes[0])
+	}
+	if !changes[1].Removed || changes[1].Doc != nil {
+		t.Errorf("Remove is invalid: %+v", changes[1])
+	}
+}
+
+func TestFeedPublishesEvents(t *testing.T) {
+	b := mock.NewBroker()
+	if err := b.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	published := []*mdb.ChangeEvent{}
+	if _, err := b.Subscribe(common.ChangeTopic("goal"), func(p broker.Publication) error {
+		e := &mdb.ChangeEvent{}
+		if err := json.Unmarshal(p.Message().Body, e); err != nil {
+			return err
+		}
+		published = append(published, e)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	source := &testSource{changes: []*Change{
+		{Tick: 1, Collection: "goal", Key: "g1", Doc: map[string]interface{}{"id": "g1", "parameter1": "o1", "created": 1, "updated": 1}},
+		{Tick: 2, Collection: "goal", Key: "g1", Doc: map[string]interface{}{"id": "g1", "parameter1": "o1", "created": 1, "updated": 2}},
+		{Tick: 3, Collection: common.DbChangeLogTable, Key: "3", Doc: map[string]interface{}{}},
+		{Tick: 4, Collection: "goal", Key: "g1", Removed: true},
+	}}
+	store := &testStore{docs: map[string]map[string]interface{}{}}
+	f := NewFeed("healum", source, store, b)
+	if _, err := f.poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(published) != 3 || len(store.events) != 3 {
+		t.Fatalf("The change log must not be published, got %d events", len(published))
+	}
+	types := []mdb.ChangeType{mdb.ChangeType_CREATE, mdb.ChangeType_UPDATE, mdb.ChangeType_DELETE}
+	for i, e := range published {
+		if e.Type != types[i] {
+			t.Errorf("Event %d must be %v, got %v", i, types[i], e.Type)
+		}
+		if e.Id != "g1" || e.OrgId != "o1" {
+			t.Errorf("Event %d has invalid record: %+v", i, e)
+		}
+	}
+	if len(published[0].Old) != 0 || len(published[1].Old) == 0 || len(published[2].New) != 0 {
+		t.Error("Old and new documents are invalid")
+	}
+	if f.sequence != 4 {
+		t.Errorf("Feed must move to the last change, got %d", f.sequence)
+	}
+
+	// restarting on the same changes doesn't publish them again
+	if _, err := f.poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(published) != 3 {
+		t.Error("Changes must be published once")
+	}
+}
@@ -0,0 +1,190 @@
+package changefeed
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+// Store keeps the published events so that consumers can catch up
+type Store interface {
+	// Last returns the sequence of the last stored event, 0 if there is none
+	Last() (int64, error)
+	// Previous returns the last stored document of a record, nil if there is none
+	Previous(collection, key string) (map[string]interface{}, error)
+	Append(e *mdb.ChangeEvent, key string) error
+	Read(collection string, from, limit int64) ([]*mdb.ChangeEvent, error)
+	// Purge removes the events created before the time
+	Purge(before int64) error
+}
+
+// the change log is stored as generic records:
+// parameter1 is the organisation, parameter2 the padded sequence so that it sorts as a string
+type changeData struct {
+	Type     string      `json:"type"`
+	Database string      `json:"database"`
+	Key      string      `json:"key"`
+	Old      interface{} `json:"old,omitempty"`
+	New      interface{} `json:"new,omitempty"`
+}
+
+// sequences are arangodb ticks, they don't fit in a json number
+func sequenceKey(seq int64) string {
+	return fmt.Sprintf("%020d", seq)
+}
+
+// dbStore stores the events in the change log collection through db-srv connections
+type dbStore struct {
+	database *mdb.Database
+}
+
+// NewStore returns a store using the change log collection of the healum database
+func NewStore() Store {
+	return &dbStore{
+		database: &mdb.Database{
+			Name:   common.DbHealumName,
+			Table:  common.DbChangeLogTable,
+			Driver: common.DbHealumDriver,
+		},
+	}
+}
+
+func (s *dbStore) Last() (int64, error) {
+	q := fmt.Sprintf(`
+		FOR e IN %s
+		SORT e.parameter2 DESC
+		LIMIT 1
+		RETURN e`, common.DbChangeLogTable)
+	records, err := db.RunQuery(s.database, q, nil)
+	if err != nil || len(records) == 0 {
+		return 0, err
+	}
+	return strconv.ParseInt(records[0].Parameter2, 10, 64)
+}
+
+func (s *dbStore) Previous(collection, key string) (map[string]interface{}, error) {
+	q := fmt.Sprintf(`
+		FOR e IN %s
+		FILTER e.name == @collection && e.data.key == @key
+		SORT e.parameter2 DESC
+		LIMIT 1
+		RETURN e`, common.DbChangeLogTable)
+	records, err := db.RunQuery(s.database, q, map[string]interface{}{
+		"collection": collection,
+		"key":        key,
+	})
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	data := struct {
+		New map[string]interface{} `json:"new"`
+	}{}
+	if err := json.Unmarshal([]byte(records[0].Parameter3), &data); err != nil {
+		return nil, err
+	}
+	return data.New, nil
+}
+
+func (s *dbStore) Append(e *mdb.ChangeEvent, key string) error {
+	data := &changeData{
+		Type:     e.Type.String(),
+		Database: e.Database,
+		Key:      key,
+	}
+	if len(e.Old) > 0 {
+		data.Old = json.RawMessage(e.Old)
+	}
+	if len(e.New) > 0 {
+		data.New = json.RawMessage(e.New)
+	}
+	doc := map[string]interface{}{
+		"_key":       sequenceKey(e.Sequence),
+		"id":         e.Id,
+		"created":    e.Created,
+		"updated":    e.Created,
+		"name":       e.Collection,
+		"parameter1": e.OrgId,
+		"parameter2": sequenceKey(e.Sequence),
+		"data":       data,
+	}
+	// events are appended again after a restart, the first write wins
+	q := fmt.Sprintf(`INSERT @doc INTO %s OPTIONS { ignoreErrors: true }`, common.DbChangeLogTable)
+	_, err := db.RunQuery(s.database, q, map[string]interface{}{"doc": doc})
+	return err
+}
+
+func (s *dbStore) Read(collection string, from, limit int64) ([]*mdb.ChangeEvent, error) {
+	bindVars := map[string]interface{}{
+		"from":  sequenceKey(from),
+		"limit": limit,
+	}
+	filter := ""
+	if len(collection) > 0 {
+		filter = "&& e.name == @collection"
+		bindVars["collection"] = collection
+	}
+	q := fmt.Sprintf(`
+		FOR e IN %s
+		FILTER e.parameter2 > @from %s
+		SORT e.parameter2
+		LIMIT @limit
+		RETURN e`, common.DbChangeLogTable, filter)
+	records, err := db.RunQuery(s.database, q, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	events := []*mdb.ChangeEvent{}
+	for _, r := range records {
+		e, err := recordToEvent(r)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+func (s *dbStore) Purge(before int64) error {
+	q := fmt.Sprintf(`
+		FOR e IN %s
+		FILTER e.created < @before
+		REMOVE e IN %s`, common.DbChangeLogTable, common.DbChangeLogTable)
+	_, err := db.RunQuery(s.database, q, map[string]interface{}{"before": before})
+	return err
+}
+
+func recordToEvent(r *mdb.Record) (*mdb.ChangeEvent, error) {
+	seq, err := strconv.ParseInt(r.Parameter2, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	data := struct {
+		Type     string          `json:"type"`
+		Database string          `json:"database"`
+		Old      json.RawMessage `json:"old"`
+		New      json.RawMessage `json:"new"`
+	}{}
+	if err := json.Unmarshal([]byte(r.Parameter3), &data); err != nil {
+		return nil, err
+	}
+	e := &mdb.ChangeEvent{
+		Sequence:   seq,
+		Type:       mdb.ChangeType(mdb.ChangeType_value[data.Type]),
+		Database:   data.Database,
+		Collection: r.Name,
+		Id:         r.Id,
+		OrgId:      r.Parameter1,
+		Created:    r.Created,
+	}
+	if len(data.Old) > 0 {
+		e.Old = string(data.Old)
+	}
+	if len(data.New) > 0 {
+		e.New = string(data.New)
+	}
+	return e, nil
+}
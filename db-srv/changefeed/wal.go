@@ -0,0 +1,205 @@
+package changefeed
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// arangodb write ahead log markers, see https://docs.arangodb.com/3.4/HTTP/Replications/WALAccess.html
+const (
+	markerDocument = 2300
+	markerRemove   = 2302
+)
+
+// Change is a document write read from the write ahead log
+type Change struct {
+	Tick       int64
+	Collection string
+	Key        string
+	Removed    bool
+	// the document after the write, nil for removes
+	Doc map[string]interface{}
+}
+
+// Source returns the writes of a database in order
+type Source interface {
+	// LastTick returns the tick of the last write
+	LastTick(ctx context.Context) (int64, error)
+	// Tail returns the changes after the tick and the tick to continue from
+	Tail(ctx context.Context, from int64) ([]*Change, int64, error)
+}
+
+type walEntry struct {
+	Tick string                 `json:"tick"`
+	Type int                    `json:"type"`
+	Cuid string                 `json:"cuid"`
+	Data map[string]interface{} `json:"data"`
+}
+
+// walSource tails the write ahead log of an arangodb database over http
+type walSource struct {
+	sync.Mutex
+	url      string
+	database string
+	user     string
+	pass     string
+	client   *http.Client
+	// collection globally unique id to name
+	collections map[string]string
+}
+
+// NewWALSource returns a source reading the write ahead log of an arangodb database
+func NewWALSource(url, database, user, pass string) Source {
+	return &walSource{
+		url:         url,
+		database:    database,
+		user:        user,
+		pass:        pass,
+		client:      &http.Client{},
+		collections: map[string]string{},
+	}
+}
+
+func (s *walSource) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/_db/%s%s", s.url, s.database, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(s.user, s.pass)
+	rsp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode >= 300 {
+		rsp.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", path, rsp.Status)
+	}
+	return rsp, nil
+}
+
+func (s *walSource) LastTick(ctx context.Context) (int64, error) {
+	rsp, err := s.get(ctx, "/_api/wal/lastTick")
+	if err != nil {
+		return 0, err
+	}
+	defer rsp.Body.Close()
+
+	body := struct {
+		Tick string `json:"tick"`
+	}{}
+	if err := json.NewDecoder(rsp.Body).Decode(&body); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(body.Tick, 10, 64)
+}
+
+func (s *walSource) Tail(ctx context.Context, from int64) ([]*Change, int64, error) {
+	rsp, err := s.get(ctx, fmt.Sprintf("/_api/wal/tail?from=%d", from))
+	if err != nil {
+		return nil, from, err
+	}
+	defer rsp.Body.Close()
+
+	next := from
+	if last, err := strconv.ParseInt(rsp.Header.Get("X-Arango-Replication-Lastincluded"), 10, 64); err == nil && last > 0 {
+		next = last
+	}
+	if rsp.StatusCode == http.StatusNoContent {
+		return nil, next, nil
+	}
+
+	entries, err := parseWAL(rsp.Body)
+	if err != nil {
+		return nil, from, err
+	}
+	changes := []*Change{}
+	for _, e := range entries {
+		c, err := s.change(ctx, e)
+		if err != nil {
+			return nil, from, err
+		}
+		if c != nil {
+			changes = append(changes, c)
+		}
+	}
+	return changes, next, nil
+}
+
+// change converts a log entry, markers other than document writes are skipped
+func (s *walSource) change(ctx context.Context, e *walEntry) (*Change, error) {
+	if e.Type != markerDocument && e.Type != markerRemove {
+		return nil, nil
+	}
+	tick, err := strconv.ParseInt(e.Tick, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := s.collection(ctx, e.Cuid)
+	if err != nil {
+		return nil, err
+	}
+	c := &Change{
+		Tick:       tick,
+		Collection: collection,
+		Removed:    e.Type == markerRemove,
+	}
+	c.Key, _ = e.Data["_key"].(string)
+	if !c.Removed {
+		c.Doc = e.Data
+	}
+	return c, nil
+}
+
+// collection returns the name of a collection, the names are reloaded when a collection is unknown
+func (s *walSource) collection(ctx context.Context, cuid string) (string, error) {
+	s.Lock()
+	defer s.Unlock()
+	if name, ok := s.collections[cuid]; ok {
+		return name, nil
+	}
+
+	rsp, err := s.get(ctx, "/_api/collection")
+	if err != nil {
+		return "", err
+	}
+	defer rsp.Body.Close()
+
+	body := struct {
+		Result []struct {
+			Name             string `json:"name"`
+			GloballyUniqueId string `json:"globallyUniqueId"`
+		} `json:"result"`
+	}{}
+	if err := json.NewDecoder(rsp.Body).Decode(&body); err != nil {
+		return "", err
+	}
+	for _, c := range body.Result {
+		s.collections[c.GloballyUniqueId] = c.Name
+	}
+	return s.collections[cuid], nil
+}
+
+// parseWAL reads the newline separated entries of a wal tail response
+func parseWAL(r io.Reader) ([]*walEntry, error) {
+	entries := []*walEntry{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		e := &walEntry{}
+		if err := json.Unmarshal(line, e); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, scanner.Err()
+}
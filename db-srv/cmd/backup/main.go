@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"server/common"
+	"server/db-srv/backup"
+	"server/db-srv/db"
+	_ "server/db-srv/db/arangodb"
+	_ "server/db-srv/db/elastic"
+
+	"github.com/micro/cli"
+	"github.com/micro/go-micro/registry"
+	"github.com/micro/go-micro/registry/consul"
+	"github.com/micro/go-micro/selector"
+)
+
+// initDb connects to the databases registered in consul
+func initDb(c *cli.Context) {
+	db.DBServiceNamespace = c.GlobalString("database_service_namespace")
+	reg := consul.NewRegistry(registry.Addrs(c.GlobalString("registry_address")))
+	if err := db.Init(selector.NewSelector(selector.Registry(reg))); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func create(c *cli.Context) {
+	initDb(c)
+	now := time.Now()
+	path := c.String("output")
+	if len(path) == 0 {
+		path = backup.ArchiveName(c.GlobalString("database"), c.String("org_id"), now)
+	}
+	manifest, err := backup.Backup(path, c.GlobalString("database"), c.String("org_id"), c.Int("batch_size"), now.Unix())
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, col := range manifest.Collections {
+		fmt.Printf("%-40s  %d\n", col.Name, col.Count)
+	}
+	fmt.Println("written", filepath.Clean(path))
+}
+
+func restore(c *cli.Context) {
+	if len(c.String("input")) == 0 {
+		log.Fatal("input is blank")
+	}
+	initDb(c)
+	rsp, err := backup.Restore(c.String("input"), c.GlobalString("database"), &backup.Options{
+		RemapIds:  c.Bool("remap_ids"),
+		BatchSize: c.Int("batch_size"),
+		Reindex:   backup.Reindex,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("restored %d documents and edges of %s, %d failed to be indexed\n", rsp.Restored, rsp.Manifest.Database, rsp.IndexFailed)
+	if rsp.IndexFailed > 0 {
+		fmt.Println("the failed records are retried by the indexing queue of db-srv")
+	}
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "backup"
+	app.Usage = "Backup and restore of the healum database per organisation"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   "registry_address",
+			EnvVar: "MICRO_REGISTRY_ADDRESS",
+			Value:  "127.0.0.1:8500",
+			Usage:  "Address of the consul registry of the databases",
+		},
+		cli.StringFlag{
+			Name:   "database_service_namespace",
+			EnvVar: "DATABASE_SERVICE_NAMESPACE",
+			Value:  db.DBServiceNamespace,
+			Usage:  "Namespace of the registered databases",
+		},
+		cli.StringFlag{
+			Name:   "database",
+			EnvVar: "DATABASE",
+			Value:  common.DbHealumName,
+			Usage:  "Name of the database",
+		},
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "create",
+			Usage: "Write the documents and edges of the database to an archive",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "org_id",
+					Usage: "Only the documents of the organisation and their edges",
+				},
+				cli.StringFlag{
+					Name:  "output",
+					Usage: "Path of the archive, <database>-<org_id>-<time>.tar.gz by default",
+				},
+				cli.IntFlag{
+					Name:  "batch_size",
+					Usage: "Documents read per query",
+				},
+			},
+			Action: create,
+		},
+		{
+			Name:  "restore",
+			Usage: "Write the documents and edges of an archive to the database and reindex them",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "input",
+					Usage: "Path of the archive",
+				},
+				cli.BoolFlag{
+					Name:  "remap_ids",
+					Usage: "Give new keys to the documents so the organisation is restored next to its original",
+				},
+				cli.IntFlag{
+					Name:  "batch_size",
+					Usage: "Documents written per query",
+				},
+			},
+			Action: restore,
+		},
+	}
+	app.Run(os.Args)
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"server/common"
+	"server/db-srv/db/arangodb"
+	"server/db-srv/migrations"
+
+	"github.com/micro/cli"
+	lib "github.com/solher/arangolite"
+)
+
+func database(c *cli.Context) *lib.Database {
+	return lib.NewDatabase(
+		lib.OptEndpoint(c.GlobalString("arangodb_address")),
+		lib.OptBasicAuth(arangodb.DBUser, arangodb.DBPass),
+		lib.OptDatabaseName(c.GlobalString("database")),
+	)
+}
+
+func status(c *cli.Context) {
+	m := migrations.NewMigrator(database(c), false, os.Stdout)
+	status, err := m.Status(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, s := range status {
+		applied := "pending"
+		if s.Applied {
+			applied = "applied " + time.Unix(s.AppliedAt, 0).Format(time.RFC3339)
+		}
+		fmt.Printf("%5d  %-30s  %s\n", s.Version, applied, s.Description)
+	}
+}
+
+func up(c *cli.Context) {
+	m := migrations.NewMigrator(database(c), c.Bool("dry_run"), os.Stdout)
+	done, err := m.Up(context.Background(), int64(c.Int("target")))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(done) == 0 {
+		fmt.Println("no pending migrations")
+	}
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "migrate"
+	app.Usage = "Versioned migrations of the healum database"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   "arangodb_address",
+			EnvVar: "ARANGODB_ADDRESS",
+			Value:  "http://127.0.0.1:8529",
+			Usage:  "Address of the arangodb server",
+		},
+		cli.StringFlag{
+			Name:   "database",
+			EnvVar: "DATABASE",
+			Value:  common.DbHealumName,
+			Usage:  "Name of the database to migrate",
+		},
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "status",
+			Usage:  "List the migrations and whether they are applied",
+			Action: status,
+		},
+		{
+			Name:  "up",
+			Usage: "Apply the pending migrations",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "dry_run",
+					Usage: "Print the requests of the pending migrations without running them",
+				},
+				cli.IntFlag{
+					Name:  "target",
+					Usage: "Apply migrations up to and including this version",
+				},
+			},
+			Action: up,
+		},
+	}
+	app.Run(os.Args)
+}
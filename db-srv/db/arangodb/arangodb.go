@@ -3,8 +3,6 @@ package arangodb
 import (
 	"encoding/json"
 	"fmt"
-	"log"
-	"strings"
 
 	lib "github.com/solher/arangolite"
 	lib_req "github.com/solher/arangolite/requests"
@@ -39,6 +37,17 @@ var (
 	DBPass = ""
 )
 
+// errorNumConflict is the arangodb error of a write with an outdated _rev
+const errorNumConflict = 1200
+
+// checkConflict converts the revision conflicts of arangodb to db.ErrConflict
+func checkConflict(err error) error {
+	if lib.HasErrorNum(err, errorNumConflict) {
+		return db.ErrConflict
+	}
+	return err
+}
+
 type Node struct {
 	lib.Document
 }
@@ -53,6 +62,9 @@ type Record struct {
 	Parameter3 string            `json:"parameter3,omitempty"`
 	Metadata   map[string]string `json:"metadata,omitempty"`
 	Data       interface{}       `json:"data,omitempty"`
+	DeletedAt  int64             `json:"deleted_at,omitempty"`
+	DeletedBy  string            `json:"deleted_by,omitempty"`
+	Rev        string            `json:"_rev,omitempty"`
 }
 
 func init() {
@@ -83,6 +95,14 @@ func (d *arangodbDriver) NewDB(nodes ...*registry.Node) (db.DB, error) {
 
 func (d *arangodbDB) Init(db *mdb.Database) error {
 	d.arangoName = db.Name
+	d.dbCon.Options(
+		lib.OptDatabaseName(db.Name),
+	)
+	return nil
+}
+
+// Bootstrap creates the database, its collections and graphs if they don't exist
+func (d *arangodbDB) Bootstrap(db *mdb.Database) error {
 	d.CreateDatabase(db.Name)
 	d.dbCon.Options(
 		lib.OptDatabaseName(db.Name),
@@ -121,20 +141,22 @@ func (d *arangodbDB) Init(db *mdb.Database) error {
 					}
 					d.dbCon.Run(ctx, nil, &lib_req.CreateGraph{Name: cols[1], EdgeDefinitions: edgeDefinitions})
 				default:
-					common.ErrorLog(common.DbSrv, d.Init, err, "Init is failed")
-					log.Fatal(err)
+					common.ErrorLog(common.DbSrv, d.Bootstrap, err, "Bootstrap is failed")
+					return err
 				}
 			}
 		}
 	}
-
-	if d.graph {
-
-	} else {
-	}
 	return nil
 }
 
+// Ping checks the connection by running a trivial query
+func (d *arangodbDB) Ping() error {
+	d.RLock()
+	defer d.RUnlock()
+	return d.dbCon.Run(context.Background(), nil, lib_req.NewAQL(`RETURN 1`))
+}
+
 // func (d *arangodbDB) Init(db *mdb.Database) error {
 // 	d.arangoName = db.Name
 // 	d.arangoCollection = db.Table
@@ -219,7 +241,7 @@ func (d *arangodbDB) Read(id, orgid string) (*mdb.Record, error) {
 		    FOR n
 		    IN %s
 		    FILTER n.id == "%s" && n.parameter3 == "%s"
-		    RETURN n
+		    RETURN MERGE(n, {revision: n._rev})
 		  `, "edges", id, orgid)
 		err = d.dbCon.Run(ctx, &nodes, q)
 		if err != nil {
@@ -231,7 +253,7 @@ func (d *arangodbDB) Read(id, orgid string) (*mdb.Record, error) {
 		    FOR n
 		    IN %s
 		    FILTER n.id == "%s" && n.parameter3 == "%s"
-		    RETURN n
+		    RETURN MERGE(n, {revision: n._rev})
 		  `, d.arangoCollection, id, orgid)
 
 		err = d.dbCon.Run(ctx, &nodes, q)
@@ -258,6 +280,8 @@ func (d *arangodbDB) Create(r *mdb.Record) error {
 	ctx := context.Background()
 
 	r.Updated = time.Now().Unix()
+	// the revision is kept by arangodb in _rev
+	r.Revision = ""
 
 	data, err := json.Marshal(r)
 	if err != nil {
@@ -291,6 +315,7 @@ func (d *arangodbDB) Update(r *mdb.Record) error {
 
 	ctx := context.Background()
 	r.Updated = time.Now().Unix()
+	r.Revision = ""
 	data, err := json.Marshal(r)
 	if err != nil {
 		return err
@@ -332,6 +357,81 @@ func (d *arangodbDB) Update(r *mdb.Record) error {
 	return nil
 }
 
+// UpdateIfMatch updates a record if its _rev is the expected revision, the record gets its new revision
+func (d *arangodbDB) UpdateIfMatch(r *mdb.Record, revision string) error {
+	d.RLock()
+	defer d.RUnlock()
+	if r.Created == 0 {
+		r.Created = time.Now().Unix()
+	}
+
+	ctx := context.Background()
+	r.Updated = time.Now().Unix()
+	r.Revision = ""
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	collection := d.arangoCollection
+	if d.graph {
+		collection = "edges"
+	}
+	q := newAQL(`
+		FOR n
+		IN @@collection
+		FILTER n.id == @id
+		UPDATE MERGE(@data, {_key: n._key, _rev: @revision}) IN @@collection OPTIONS { ignoreRevs: false }
+		RETURN {id: NEW.id, _rev: NEW._rev}
+	`, map[string]interface{}{
+		"@collection": collection,
+		"id":          r.Id,
+		"data":        json.RawMessage(data),
+		"revision":    revision,
+	})
+	records := []*Record{}
+	if err := d.dbCon.Run(ctx, &records, q); err != nil {
+		return checkConflict(err)
+	}
+	if len(records) == 0 {
+		return db.ErrNotFound
+	}
+	r.Revision = records[0].Rev
+	return nil
+}
+
+// DeleteIfMatch deletes a record if its _rev is the expected revision
+func (d *arangodbDB) DeleteIfMatch(id, orgid, revision string) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	ctx := context.Background()
+	collection := d.arangoCollection
+	if d.graph {
+		collection = "edges"
+	}
+	q := newAQL(`
+		FOR n
+		IN @@collection
+		FILTER n.id == @id && n.parameter3 == @orgid
+		REMOVE {_key: n._key, _rev: @revision} IN @@collection OPTIONS { ignoreRevs: false }
+		RETURN {id: OLD.id}
+	`, map[string]interface{}{
+		"@collection": collection,
+		"id":          id,
+		"orgid":       orgid,
+		"revision":    revision,
+	})
+	records := []*Record{}
+	if err := d.dbCon.Run(ctx, &records, q); err != nil {
+		return checkConflict(err)
+	}
+	if len(records) == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
+
 // D of CRUD for generic records
 func (d *arangodbDB) Delete(id, orgid string) error {
 	d.RLock()
@@ -474,7 +574,9 @@ func (d *arangodbDB) Search(md map[string]string, from, to, limit, offset int64,
 				Parameter1: r.Parameter1,
 				Parameter2: r.Parameter2,
 				// Parameter3: r.Parameter3,
-				Metadata: r.Metadata,
+				Metadata:  r.Metadata,
+				DeletedAt: r.DeletedAt,
+				DeletedBy: r.DeletedBy,
 			}
 			if r.Data != nil {
 				body, err := json.Marshal(r.Data)
@@ -490,26 +592,39 @@ func (d *arangodbDB) Search(md map[string]string, from, to, limit, offset int64,
 	return nodes, nil
 }
 
-func (d *arangodbDB) RunQuery(query string) ([]*mdb.Record, error) {
+// newAQL escapes the query, as it's formatted by NewAQL, and binds the variables
+func newAQL(query string, bindVars map[string]interface{}) *lib_req.AQL {
+	// NewAQL formats its query with its params, the query is passed as a param so it is sent as it is
+	q := lib_req.NewAQL("%s", query)
+	// bind variables are passed through to arangodb as they are
+	for k, v := range bindVars {
+		q.Bind(k, v)
+	}
+	return q
+}
+
+func (d *arangodbDB) RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
 	d.RLock()
 	defer d.RUnlock()
 	ctx := context.Background()
-	query = strings.Replace(query, `'`, `\'`, -1)
-	query = strings.Replace(query, `%`, `%%`, -1)
-	fmt.Println(query)
 
 	records := []*Record{}
-	nodes := []*mdb.Record{}
 	var err error
 
-	q := lib_req.NewAQL(query)
+	q := newAQL(query, bindVars)
 	err = d.dbCon.Run(ctx, &records, q)
 	if err != nil {
 		common.ErrorLog(common.DbSrv, d.RunQuery, err, "RunQuery is failed")
-		return nil, err
+		return nil, checkConflict(err)
 	}
 	// fmt.Println("records:", records)
 
+	return d.toRecords(records), nil
+}
+
+// toRecords converts query results, data is marshaled into parameter3
+func (d *arangodbDB) toRecords(records []*Record) []*mdb.Record {
+	nodes := []*mdb.Record{}
 	for _, r := range records {
 		// fmt.Println("record:", r)
 		node := &mdb.Record{
@@ -520,19 +635,22 @@ func (d *arangodbDB) RunQuery(query string) ([]*mdb.Record, error) {
 			Parameter1: r.Parameter1,
 			Parameter2: r.Parameter2,
 			// Parameter3: r.Parameter3,
-			Metadata: r.Metadata,
+			Metadata:  r.Metadata,
+			DeletedAt: r.DeletedAt,
+			DeletedBy: r.DeletedBy,
+			Revision:  r.Rev,
 		}
 		if r.Data != nil {
 			body, err := json.Marshal(r.Data)
 			if err != nil {
-				common.ErrorLog(common.DbSrv, d.RunQuery, err, "Object marshaling is failed")
+				common.ErrorLog(common.DbSrv, d.toRecords, err, "Object marshaling is failed")
 				continue
 			}
 			node.Parameter3 = string(body)
 		}
 		nodes = append(nodes, node)
 	}
-	return nodes, nil
+	return nodes
 }
 
 // A database must be created for every datatype (User, Auth, Room, etc.)
@@ -0,0 +1,94 @@
+package arangodb
+
+import (
+	"context"
+	"io"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+
+	lib "github.com/solher/arangolite"
+	lib_req "github.com/solher/arangolite/requests"
+)
+
+// deleteCursor frees a server side cursor which wasn't drained
+type deleteCursor struct {
+	id string
+}
+
+func (c *deleteCursor) Description() string {
+	return "DELETE CURSOR"
+}
+
+func (c *deleteCursor) Path() string {
+	return "/_api/cursor/" + c.id
+}
+
+func (c *deleteCursor) Method() string {
+	return "DELETE"
+}
+
+func (c *deleteCursor) Generate() []byte {
+	return nil
+}
+
+// cursor fetches the batches of an arangodb cursor one by one
+type cursor struct {
+	d    *arangodbDB
+	resp lib.Response
+	id   string
+	done bool
+}
+
+func (d *arangodbDB) RunQueryStream(query string, bindVars map[string]interface{}, batchSize int) (db.Cursor, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	q := newAQL(query, bindVars).BatchSize(batchSize)
+	resp, err := d.dbCon.Send(context.Background(), q)
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.RunQueryStream, err, "RunQueryStream is failed")
+		return nil, err
+	}
+	return &cursor{d: d, resp: resp, id: resp.Cursor()}, nil
+}
+
+func (c *cursor) Next() ([]*mdb.Record, error) {
+	if c.done {
+		return nil, io.EOF
+	}
+
+	// the first batch comes with the query response
+	if c.resp == nil {
+		c.d.RLock()
+		resp, err := c.d.dbCon.Send(context.Background(), &lib_req.FollowCursor{Cursor: c.id})
+		c.d.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+		c.resp = resp
+	}
+
+	records := []*Record{}
+	if err := c.resp.UnmarshalResult(&records); err != nil {
+		return nil, err
+	}
+	c.done = !c.resp.HasMore()
+	c.resp = nil
+
+	if len(records) == 0 && c.done {
+		return nil, io.EOF
+	}
+	return c.d.toRecords(records), nil
+}
+
+func (c *cursor) Close() error {
+	if c.done || len(c.id) == 0 {
+		return nil
+	}
+	c.done = true
+	c.d.RLock()
+	defer c.d.RUnlock()
+	return c.d.dbCon.Run(context.Background(), nil, &deleteCursor{c.id})
+}
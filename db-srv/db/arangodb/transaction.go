@@ -0,0 +1,99 @@
+package arangodb
+
+import (
+	"context"
+	"encoding/json"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+// runs the operations of a transaction, returning the documents of every query
+const operationsAction = `function (params) {
+	var db = require("@arangodb").db;
+	return params.operations.map(function (op) {
+		return db._query(op.query, op.bindVars).toArray();
+	});
+}`
+
+type transactionCollections struct {
+	Read  []string `json:"read,omitempty"`
+	Write []string `json:"write,omitempty"`
+}
+
+type transactionOperation struct {
+	Query    string                 `json:"query"`
+	BindVars map[string]interface{} `json:"bindVars"`
+}
+
+// transaction is a javascript transaction, arangodb runs it atomically on the server
+type transaction struct {
+	Collections transactionCollections `json:"collections"`
+	Action      string                 `json:"action"`
+	Params      interface{}            `json:"params,omitempty"`
+}
+
+func (t *transaction) Description() string {
+	return "TRANSACTION"
+}
+
+func (t *transaction) Path() string {
+	return "/_api/transaction"
+}
+
+func (t *transaction) Method() string {
+	return "POST"
+}
+
+func (t *transaction) Generate() []byte {
+	m, _ := json.Marshal(t)
+	return m
+}
+
+func (d *arangodbDB) Transaction(tx *db.Tx) ([][]*mdb.Record, error) {
+	d.RLock()
+	defer d.RUnlock()
+	ctx := context.Background()
+
+	t := &transaction{
+		Collections: transactionCollections{
+			Read:  tx.Read,
+			Write: tx.Write,
+		},
+	}
+
+	// a custom action returns a single array of documents
+	if len(tx.Action) > 0 {
+		t.Action = tx.Action
+		t.Params = tx.Params
+		records := []*Record{}
+		if err := d.dbCon.Run(ctx, &records, t); err != nil {
+			common.ErrorLog(common.DbSrv, d.Transaction, err, "Transaction is failed")
+			return nil, checkConflict(err)
+		}
+		return [][]*mdb.Record{d.toRecords(records)}, nil
+	}
+
+	operations := []*transactionOperation{}
+	for _, op := range tx.Operations {
+		bindVars := op.BindVars
+		if bindVars == nil {
+			bindVars = map[string]interface{}{}
+		}
+		operations = append(operations, &transactionOperation{Query: op.Query, BindVars: bindVars})
+	}
+	t.Action = operationsAction
+	t.Params = map[string]interface{}{"operations": operations}
+
+	results := [][]*Record{}
+	if err := d.dbCon.Run(ctx, &results, t); err != nil {
+		common.ErrorLog(common.DbSrv, d.Transaction, err, "Transaction is failed")
+		return nil, checkConflict(err)
+	}
+	nodes := [][]*mdb.Record{}
+	for _, r := range results {
+		nodes = append(nodes, d.toRecords(r))
+	}
+	return nodes, nil
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"io"
+
+	mdb "server/db-srv/proto/db"
+)
+
+// Default number of records per batch of a cursor
+var DefaultBatchSize = 100
+
+// Cursor iterates over the records of a query batch by batch
+type Cursor interface {
+	// Next returns the next batch of records, io.EOF after the last one
+	Next() ([]*mdb.Record, error)
+	// Close releases the cursor, it must be called even if the cursor isn't drained
+	Close() error
+}
+
+// Streamer is implemented by drivers which can run a query without loading all the results in memory
+type Streamer interface {
+	RunQueryStream(query string, bindVars map[string]interface{}, batchSize int) (Cursor, error)
+}
+
+// sliceCursor batches records already in memory, used for drivers which don't implement Streamer
+type sliceCursor struct {
+	records   []*mdb.Record
+	batchSize int
+}
+
+func (c *sliceCursor) Next() ([]*mdb.Record, error) {
+	if len(c.records) == 0 {
+		return nil, io.EOF
+	}
+	n := c.batchSize
+	if n > len(c.records) {
+		n = len(c.records)
+	}
+	batch := c.records[:n]
+	c.records = c.records[n:]
+	return batch, nil
+}
+
+func (c *sliceCursor) Close() error {
+	c.records = nil
+	return nil
+}
+
+// releaseCursor releases the pooled connection of a cursor when it's closed
+type releaseCursor struct {
+	Cursor
+	release func()
+}
+
+func (c *releaseCursor) Close() error {
+	defer c.release()
+	return c.Cursor.Close()
+}
+
+func (d *db) RunQueryStream(db *mdb.Database, query string, bindVars map[string]interface{}, batchSize int) (Cursor, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	dr, release, err := d.lookup(db)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := dr.(Streamer); ok {
+		c, err := s.RunQueryStream(query, bindVars, batchSize)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		// the connection is used until the cursor is closed
+		return &releaseCursor{c, release}, nil
+	}
+	defer release()
+	records, err := dr.RunQuery(query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sliceCursor{records: records, batchSize: batchSize}, nil
+}
+
+func RunQueryStream(db *mdb.Database, query string, bindVars map[string]interface{}, batchSize int) (Cursor, error) {
+	return DefaultDB.RunQueryStream(db, query, bindVars, batchSize)
+}
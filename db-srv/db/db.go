@@ -1,8 +1,10 @@
 package db
 
 import (
+	"encoding/json"
 	"errors"
 	"log"
+	"regexp"
 	"strings"
 
 	mdb "server/db-srv/proto/db"
@@ -31,17 +33,49 @@ type DB interface {
 	Update(mdb *mdb.Record) error
 	Delete(id, extraId string) error
 	Search(md map[string]string, from, to, limit, offset int64, reverse bool) ([]*mdb.Record, error)
-	RunQuery(query string) ([]*mdb.Record, error)
+	RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error)
 	CreateDatabase(name string) error
 	DeleteDatabase(name string) error
 }
 
+// Operation is a query of a transaction
+type Operation struct {
+	Query    string
+	BindVars map[string]interface{}
+}
+
+// Tx is a set of operations applied atomically
+type Tx struct {
+	// collections read and written by the transaction
+	Read  []string
+	Write []string
+	// queries run in order
+	Operations []*Operation
+	// driver specific transaction body run instead of the operations
+	Action string
+	Params map[string]interface{}
+}
+
+// Transactioner is implemented by drivers which can apply several operations atomically
+// it returns the records of every operation
+type Transactioner interface {
+	Transaction(tx *Tx) ([][]*mdb.Record, error)
+}
+
+// Revisioner is implemented by drivers which keep a revision of the records, the conditional writes fail with
+// ErrConflict if the record was written since the expected revision
+type Revisioner interface {
+	UpdateIfMatch(r *mdb.Record, revision string) error
+	DeleteIfMatch(id, extraId, revision string) error
+}
+
 type db struct {
 	selector    selector.Selector
 	namespace   string
 	defaultName string
 	drivers     map[string]Driver
 	driverKey   string
+	pool        *pool
 }
 
 var (
@@ -65,16 +99,32 @@ var (
 	// Errors
 	ErrNotFound     = errors.New("not found")
 	ErrNotAvailable = errors.New("not available")
+
+	ErrTransactionNotSupported       = errors.New("transactions are not supported by the driver")
+	ErrTransactionActionNotSupported = errors.New("transaction actions are not supported by the driver")
+
+	ErrConflict             = errors.New("revision conflict")
+	ErrRevisionNotSupported = errors.New("revisions are not supported by the driver")
+
+	// matches @name and @@name bind parameters in a query
+	bindVarRegexp = regexp.MustCompile(`@@?[A-Za-z_][A-Za-z0-9_]*`)
 )
 
 func NewDB(s selector.Selector) *db {
-	return &db{
+	d := &db{
 		selector:    s,
 		namespace:   DBServiceNamespace,
 		defaultName: DBServiceName,
 		drivers:     Drivers,
 		driverKey:   DBDriverKey,
+		pool:        newPool(),
+	}
+	var reg registry.Registry
+	if s != nil {
+		reg = s.Options().Registry
 	}
+	d.pool.run(reg)
+	return d
 }
 
 func (d *db) name(db *mdb.Database) string {
@@ -89,12 +139,12 @@ func (d *db) name(db *mdb.Database) string {
 	return strings.Join([]string{d.namespace, name}, ".")
 }
 
-// looks up a registered database and its driver, creates a connection by the driver and prepares queries
-func (d *db) lookup(db *mdb.Database) (DB, error) {
+// looks up a registered database and its driver and calls fn with the first matching node
+func (d *db) node(db *mdb.Database, fn func(dv string, dr Driver, node *registry.Node) error) error {
 	dbname := d.name(db)
 	next, err := d.selector.Select(dbname)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var id string
@@ -102,12 +152,12 @@ func (d *db) lookup(db *mdb.Database) (DB, error) {
 	for {
 		node, err := next()
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		// seen all?
 		if node.Id == id {
-			return nil, ErrNotAvailable
+			return ErrNotAvailable
 		}
 
 		id = node.Id
@@ -133,78 +183,174 @@ func (d *db) lookup(db *mdb.Database) (DB, error) {
 		if !ok {
 			continue
 		}
-		conn, err := dr.NewDB(node)
-		if err != nil {
-			return nil, err
+		return fn(dv, dr, node)
+	}
+
+	return ErrNotAvailable
+}
+
+// connect creates a connection by the driver, bootstraps the database if it wasn't yet and prepares queries
+func (d *db) connect(dv string, dr Driver, node *registry.Node, db *mdb.Database) (DB, error) {
+	conn, err := dr.NewDB(node)
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := conn.(Bootstrapper); ok {
+		key := bootstrapKey(dv, node.Id, db.Name)
+		if !d.pool.isBootstrapped(key) {
+			if err := b.Bootstrap(db); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			d.pool.setBootstrapped(key, true)
 		}
-		if err := conn.Init(db); err != nil {
-			return nil, err
+	}
+	if err := conn.Init(db); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// looks up a registered database and returns its pooled connection, connecting if there is none
+// pooled connections must not be closed by the caller, which calls release when it's done with the connection
+func (d *db) lookup(db *mdb.Database) (DB, func(), error) {
+	var conn DB
+	var release func()
+	err := d.node(db, func(dv string, dr Driver, node *registry.Node) error {
+		key := poolKey{driver: dv, node: node.Id, name: db.Name, table: db.Table}
+		if conn, release = d.pool.get(key); conn != nil {
+			return nil
+		}
+		c, err := d.connect(dv, dr, node, db)
+		if err != nil {
+			return err
 		}
-		return conn, nil
+		conn, release = d.pool.put(key, d.name(db), c)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
+	return conn, release, nil
+}
 
-	return nil, ErrNotAvailable
+// looks up a registered database and returns a new connection which isn't pooled
+// used for creating and dropping databases, the caller must close it
+func (d *db) lookupUnpooled(db *mdb.Database) (DB, error) {
+	var conn DB
+	err := d.node(db, func(dv string, dr Driver, node *registry.Node) error {
+		c, err := d.connect(dv, dr, node, db)
+		if err != nil {
+			return err
+		}
+		conn = c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
 }
 
 func (d *db) Init() {
 }
 
 func (d *db) Read(db *mdb.Database, id, extraId string) (*mdb.Record, error) {
-	dr, err := d.lookup(db)
+	dr, release, err := d.lookup(db)
 	if err != nil {
 		return nil, err
 	}
-	defer dr.Close()
+	defer release()
 	return dr.Read(id, extraId)
 }
 
 func (d *db) Create(db *mdb.Database, r *mdb.Record) error {
-	dr, err := d.lookup(db)
+	dr, release, err := d.lookup(db)
 	if err != nil {
 		return err
 	}
-	defer dr.Close()
+	defer release()
 	return dr.Create(r)
 }
 
 func (d *db) Update(db *mdb.Database, r *mdb.Record) error {
-	dr, err := d.lookup(db)
+	dr, release, err := d.lookup(db)
 	if err != nil {
 		return err
 	}
-	defer dr.Close()
+	defer release()
 	return dr.Update(r)
 }
 
 func (d *db) Delete(db *mdb.Database, id, extraId string) error {
-	dr, err := d.lookup(db)
+	dr, release, err := d.lookup(db)
 	if err != nil {
 		return err
 	}
-	defer dr.Close()
+	defer release()
 	return dr.Delete(id, extraId)
 }
 
+func (d *db) UpdateIfMatch(db *mdb.Database, r *mdb.Record, revision string) error {
+	dr, release, err := d.lookup(db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	rv, ok := dr.(Revisioner)
+	if !ok {
+		return ErrRevisionNotSupported
+	}
+	return rv.UpdateIfMatch(r, revision)
+}
+
+func (d *db) DeleteIfMatch(db *mdb.Database, id, extraId, revision string) error {
+	dr, release, err := d.lookup(db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	rv, ok := dr.(Revisioner)
+	if !ok {
+		return ErrRevisionNotSupported
+	}
+	return rv.DeleteIfMatch(id, extraId, revision)
+}
+
 func (d *db) Search(db *mdb.Database, md map[string]string, from, to, limit, offset int64, reverse bool) ([]*mdb.Record, error) {
-	dr, err := d.lookup(db)
+	dr, release, err := d.lookup(db)
 	if err != nil {
 		return nil, err
 	}
-	defer dr.Close()
+	defer release()
 	return dr.Search(md, from, to, limit, offset, reverse)
 }
 
-func (d *db) RunQuery(db *mdb.Database, query string) ([]*mdb.Record, error) {
-	dr, err := d.lookup(db)
+func (d *db) RunQuery(db *mdb.Database, query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
+	dr, release, err := d.lookup(db)
 	if err != nil {
 		return nil, err
 	}
-	defer dr.Close()
-	return dr.RunQuery(query)
+	defer release()
+	return dr.RunQuery(query, bindVars)
+}
+
+func (d *db) Transaction(db *mdb.Database, tx *Tx) ([][]*mdb.Record, error) {
+	dr, release, err := d.lookup(db)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	t, ok := dr.(Transactioner)
+	if !ok {
+		return nil, ErrTransactionNotSupported
+	}
+	return t.Transaction(tx)
 }
 
 func (d *db) CreateDatabase(db *mdb.Database) error {
-	dr, err := d.lookup(db)
+	dr, err := d.lookupUnpooled(db)
 	if err != nil {
 		return err
 	}
@@ -213,15 +359,56 @@ func (d *db) CreateDatabase(db *mdb.Database) error {
 }
 
 func (d *db) DeleteDatabase(db *mdb.Database) error {
-	dr, err := d.lookup(db)
+	dr, err := d.lookupUnpooled(db)
 	if err != nil {
 		return err
 	}
 	defer dr.Close()
+	// pooled connections and the schema are gone with the database
+	defer d.pool.evictDatabase(db.Name)
 	return dr.DeleteDatabase(db.Name)
 }
 
+// Bootstrap creates the database schema (collections, graphs, etc.) of drivers which need one
+// it runs even if the database was bootstrapped before
+func (d *db) Bootstrap(db *mdb.Database) error {
+	return d.node(db, func(dv string, dr Driver, node *registry.Node) error {
+		conn, err := dr.NewDB(node)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		b, ok := conn.(Bootstrapper)
+		if !ok {
+			return nil
+		}
+		if err := b.Bootstrap(db); err != nil {
+			return err
+		}
+		d.pool.setBootstrapped(bootstrapKey(dv, node.Id, db.Name), true)
+		return nil
+	})
+}
+
+// Node returns the registry node serving the database
+func (d *db) Node(db *mdb.Database) (*registry.Node, error) {
+	var n *registry.Node
+	err := d.node(db, func(dv string, dr Driver, node *registry.Node) error {
+		n = node
+		return nil
+	})
+	return n, err
+}
+
+// Close closes all the pooled connections
+func (d *db) Close() {
+	d.pool.close()
+}
+
 func Init(s selector.Selector) error {
+	if DefaultDB != nil {
+		DefaultDB.Close()
+	}
 	DefaultDB = NewDB(s)
 	return nil
 }
@@ -242,12 +429,26 @@ func Delete(db *mdb.Database, id, extraId string) error {
 	return DefaultDB.Delete(db, id, extraId)
 }
 
+// UpdateIfMatch updates a record if its revision is the expected one
+func UpdateIfMatch(db *mdb.Database, r *mdb.Record, revision string) error {
+	return DefaultDB.UpdateIfMatch(db, r, revision)
+}
+
+// DeleteIfMatch deletes a record if its revision is the expected one
+func DeleteIfMatch(db *mdb.Database, id, extraId, revision string) error {
+	return DefaultDB.DeleteIfMatch(db, id, extraId, revision)
+}
+
 func Search(db *mdb.Database, md map[string]string, from, to, limit, offset int64, reverse bool) ([]*mdb.Record, error) {
 	return DefaultDB.Search(db, md, from, to, limit, offset, reverse)
 }
 
-func RunQuery(db *mdb.Database, query string) ([]*mdb.Record, error) {
-	return DefaultDB.RunQuery(db, query)
+func RunQuery(db *mdb.Database, query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
+	return DefaultDB.RunQuery(db, query, bindVars)
+}
+
+func Transaction(db *mdb.Database, tx *Tx) ([][]*mdb.Record, error) {
+	return DefaultDB.Transaction(db, tx)
 }
 
 func CreateDatabase(db *mdb.Database) error {
@@ -259,3 +460,37 @@ func CreateDatabase(db *mdb.Database) error {
 func DeleteDatabase(db *mdb.Database) error {
 	return DefaultDB.DeleteDatabase(db)
 }
+
+func Bootstrap(db *mdb.Database) error {
+	return DefaultDB.Bootstrap(db)
+}
+
+// DecodeBindVars decodes the json encoded values of RunQueryRequest.BindVars
+func DecodeBindVars(bindVars map[string]string) (map[string]interface{}, error) {
+	vars := map[string]interface{}{}
+	for k, v := range bindVars {
+		var value interface{}
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			return nil, err
+		}
+		vars[k] = value
+	}
+	return vars, nil
+}
+
+// ReplaceBindVars rewrites every @name of the query which has a bind variable
+// with the placeholder returned by fn, in order of appearance.
+// Used by drivers which don't understand arangodb style bind parameters
+func ReplaceBindVars(query string, bindVars map[string]interface{}, fn func(name string, value interface{}) string) string {
+	if len(bindVars) == 0 {
+		return query
+	}
+	return bindVarRegexp.ReplaceAllStringFunc(query, func(m string) string {
+		name := m[1:]
+		value, ok := bindVars[name]
+		if !ok {
+			return m
+		}
+		return fn(name, value)
+	})
+}
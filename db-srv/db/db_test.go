@@ -0,0 +1,197 @@
+package db
+
+import (
+	"io"
+	mdb "server/db-srv/proto/db"
+	"testing"
+
+	"github.com/micro/go-micro/registry"
+	"github.com/micro/go-micro/registry/mock"
+	"github.com/micro/go-micro/selector"
+)
+
+type testDriver struct {
+	connections int
+	bootstraps  int
+}
+
+type testDB struct {
+	driver *testDriver
+	closed bool
+}
+
+func (d *testDriver) NewDB(nodes ...*registry.Node) (DB, error) {
+	d.connections++
+	return &testDB{driver: d}, nil
+}
+
+func (d *testDB) Init(mdb *mdb.Database) error { return nil }
+func (d *testDB) Close() error {
+	d.closed = true
+	return nil
+}
+func (d *testDB) Read(id, extraId string) (*mdb.Record, error) { return &mdb.Record{Id: id}, nil }
+func (d *testDB) Create(mdb *mdb.Record) error                 { return nil }
+func (d *testDB) Update(mdb *mdb.Record) error                 { return nil }
+func (d *testDB) Delete(id, extraId string) error              { return nil }
+func (d *testDB) Search(md map[string]string, from, to, limit, offset int64, reverse bool) ([]*mdb.Record, error) {
+	return nil, nil
+}
+func (d *testDB) RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
+	return nil, nil
+}
+func (d *testDB) CreateDatabase(name string) error { return nil }
+func (d *testDB) DeleteDatabase(name string) error { return nil }
+func (d *testDB) Bootstrap(mdb *mdb.Database) error {
+	d.driver.bootstraps++
+	return nil
+}
+
+var testService = &registry.Service{
+	Name:    "go.micro.db.testdriver",
+	Version: "1.0.0",
+	Nodes: []*registry.Node{
+		{
+			Id:      "go.micro.db.testdriver-1",
+			Address: "127.0.0.1",
+			Port:    1234,
+			Metadata: map[string]string{
+				"driver": "testdriver",
+			},
+		},
+	},
+}
+
+func newTestDB(t *testing.T) (*db, *testDriver) {
+	reg := mock.NewRegistry()
+	if err := reg.Register(testService); err != nil {
+		t.Fatal(err)
+	}
+	s := selector.NewSelector(selector.Registry(reg))
+	dr := &testDriver{}
+	d := NewDB(s)
+	d.drivers = map[string]Driver{"testdriver": dr}
+	return d, dr
+}
+
+func TestConnectionIsPooled(t *testing.T) {
+	d, dr := newTestDB(t)
+	defer d.Close()
+	database := &mdb.Database{Name: "test", Table: "table", Driver: "testdriver"}
+
+	for i := 0; i < 3; i++ {
+		if _, err := d.Read(database, "1", ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if dr.connections != 1 {
+		t.Error("Connection must be reused, connections:", dr.connections)
+	}
+	if dr.bootstraps != 1 {
+		t.Error("Database must be bootstrapped once, bootstraps:", dr.bootstraps)
+	}
+
+	// another table gets its own connection, but the database is already bootstrapped
+	if _, err := d.Read(&mdb.Database{Name: "test", Table: "other", Driver: "testdriver"}, "1", ""); err != nil {
+		t.Fatal(err)
+	}
+	if dr.connections != 2 || dr.bootstraps != 1 {
+		t.Error("Unexpected connections or bootstraps:", dr.connections, dr.bootstraps)
+	}
+}
+
+func TestConnectionEvictedOnNodeRemoval(t *testing.T) {
+	d, dr := newTestDB(t)
+	defer d.Close()
+	database := &mdb.Database{Name: "test", Table: "table", Driver: "testdriver"}
+
+	conn, release, err := d.lookup(database)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+	d.pool.nodesChanged(&registry.Result{
+		Action:  "delete",
+		Service: testService,
+	})
+	if !conn.(*testDB).closed {
+		t.Error("Connection to a removed node must be closed")
+	}
+	if _, _, err := d.lookup(database); err != nil {
+		t.Fatal(err)
+	}
+	if dr.connections != 2 {
+		t.Error("Connection must be recreated, connections:", dr.connections)
+	}
+}
+
+func TestConnectionInUseIsClosedOnRelease(t *testing.T) {
+	d, _ := newTestDB(t)
+	defer d.Close()
+	database := &mdb.Database{Name: "test", Table: "table", Driver: "testdriver"}
+
+	conn, release, err := d.lookup(database)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.pool.nodesChanged(&registry.Result{
+		Action:  "delete",
+		Service: testService,
+	})
+	if conn.(*testDB).closed {
+		t.Error("Connection in use must not be closed")
+	}
+	release()
+	if !conn.(*testDB).closed {
+		t.Error("Evicted connection must be closed by its last release")
+	}
+}
+
+func TestDeleteDatabaseResetsBootstrap(t *testing.T) {
+	d, dr := newTestDB(t)
+	defer d.Close()
+	database := &mdb.Database{Name: "test", Table: "table", Driver: "testdriver"}
+
+	if _, _, err := d.lookup(database); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DeleteDatabase(database); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := d.lookup(database); err != nil {
+		t.Fatal(err)
+	}
+	if dr.bootstraps != 2 {
+		t.Error("Dropped database must be bootstrapped again, bootstraps:", dr.bootstraps)
+	}
+}
+
+func TestRunQueryStreamBatches(t *testing.T) {
+	d, _ := newTestDB(t)
+	defer d.Close()
+	database := &mdb.Database{Name: "test", Table: "table", Driver: "testdriver"}
+
+	// testDB doesn't stream, its results are batched
+	cursor, err := d.RunQueryStream(database, "FOR doc IN table RETURN doc", nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cursor.Close()
+	c := cursor.(*sliceCursor)
+	c.records = []*mdb.Record{{Id: "1"}, {Id: "2"}, {Id: "3"}}
+
+	sizes := []int{}
+	for {
+		batch, err := cursor.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		sizes = append(sizes, len(batch))
+	}
+	if len(sizes) != 2 || sizes[0] != 2 || sizes[1] != 1 {
+		t.Error("Unexpected batches:", sizes)
+	}
+}
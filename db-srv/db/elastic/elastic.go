@@ -65,6 +65,14 @@ func (d *elasticsearchDB) Close() error {
 	return nil
 }
 
+// Ping checks the cluster health
+func (d *elasticsearchDB) Ping() error {
+	d.RLock()
+	defer d.RUnlock()
+	_, err := d.connection.Health()
+	return err
+}
+
 // Reads a generic record from a database. Records must be split across corresponding databases
 func (d *elasticsearchDB) Read(id, extraId string) (*mdb.Record, error) {
 	d.RLock()
@@ -143,7 +151,10 @@ func (d *elasticsearchDB) Update(r *mdb.Record) error {
 	if r.Created == 0 {
 		r.Created = time.Now().Unix()
 	}
-	r.Updated = time.Now().Unix()
+	// the indexer keeps the updated time of the database, it tells stale records apart
+	if r.Updated == 0 {
+		r.Updated = time.Now().Unix()
+	}
 	_, err := d.connection.Index(d.elasticIndex, d.elasticType, r.Id, nil, r)
 	return err
 }
@@ -153,6 +164,9 @@ func (d *elasticsearchDB) Delete(id, extraId string) error {
 	d.RLock()
 	defer d.RUnlock()
 	_, err := d.connection.Delete(d.elasticIndex, d.elasticType, id, nil)
+	if err == elib.RecordNotFound {
+		return db.ErrNotFound
+	}
 	return err
 }
 
@@ -318,11 +332,20 @@ func (d *elasticsearchDB) Search(md map[string]string, from, to, limit, offset i
 	return records, nil
 }
 
-func (d *elasticsearchDB) RunQuery(query string) ([]*mdb.Record, error) {
+func (d *elasticsearchDB) RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
 	d.RLock()
 	defer d.RUnlock()
 
-	elasticQuery, err := d.connection.Search(d.elasticIndex, d.elasticIndex, nil, query);
+	// @name => json encoded value, so it can't escape from the query body
+	query = db.ReplaceBindVars(query, bindVars, func(name string, value interface{}) string {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return "null"
+		}
+		return string(b)
+	})
+
+	elasticQuery, err := d.connection.Search(d.elasticIndex, d.elasticType, nil, query);
 	var records []*mdb.Record
 	if err != nil {
 		if strings.Contains(err.Error(), "No mapping found"){
@@ -12,6 +12,10 @@ import (
 	"log"
 	"encoding/json"
 	"math"
+	"regexp"
+	"strings"
+
+	"github.com/influxdata/influxdb/models"
 )
 
 type influxdbDriver struct{}
@@ -72,6 +76,14 @@ func (d *influxdbDB) Close() error {
 	return nil
 }
 
+// Ping checks the connection to the server is still alive
+func (d *influxdbDB) Ping() error {
+	d.RLock()
+	defer d.RUnlock()
+	_, _, err := d.cl.Ping(2 * time.Second)
+	return err
+}
+
 // Reads a generic record from a database. Records must be split across corresponding databases
 func (d *influxdbDB) Read(id, extraId string) (*mdb.Record, error) {
 	d.RLock()
@@ -278,13 +290,32 @@ func (d *influxdbDB) Search(md map[string]string, from, to, limit, offset int64,
 	return records, nil
 }
 
-func (d *influxdbDB) RunQuery(query string) ([]*mdb.Record, error) {
+// RunQuery runs an InfluxQL query, the @name bind variables are sent as query parameters. The rows of the records
+// written by Create are returned as records, the other rows (aggregates, series values, ...) as records with the
+// columns and the tags of the row in parameter3 and the time in seconds.
+//
+// INSERT @points [INTO <retention policy>] writes the points of a bind variable in the measurement of the table:
+//
+//	[{"time": 1500000000, "tags": {"user": "u1"}, "fields": {"value": 72.5}}]
+func (d *influxdbDB) RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
 	d.RLock()
 	defer d.RUnlock()
 
+	if m := insertRegexp.FindStringSubmatch(query); m != nil {
+		return nil, d.insert(bindVars[m[1]], strings.Trim(m[2], `"`))
+	}
+
+	// @name => $name
+	params := map[string]interface{}{}
+	query = db.ReplaceBindVars(query, bindVars, func(name string, value interface{}) string {
+		params[name] = value
+		return "$" + name
+	})
 	q := client.Query{
-		Command:  query,
-		Database: d.influxdbDatabase,
+		Command:    query,
+		Database:   d.influxdbDatabase,
+		Precision:  "s",
+		Parameters: params,
 	}
 	var res []client.Result
 	if response, err := d.cl.Query(q); err == nil {
@@ -303,15 +334,10 @@ func (d *influxdbDB) RunQuery(query string) ([]*mdb.Record, error) {
 	for _, r := range (res) {
 		for _, s := range (r.Series) {
 			for _, v := range (s.Values) {
-				pl := &mdb.Record{}
-				pl.Id = v[1].(string)
-				pl.Name = v[3].(string)
-				pl.Parameter1 = v[4].(string)
-				pl.Parameter2 = v[5].(string)
-				pl.Parameter3 = v[6].(string)
-				pl.Updated, _ = v[7].(json.Number).Int64()
-				metaStr := v[2].(string)
-				json.Unmarshal([]byte(metaStr), &pl.Metadata)
+				pl, err := rowToRecord(s, v)
+				if err != nil {
+					return nil, err
+				}
 				records = append(records, pl)
 			}
 		}
@@ -319,6 +345,102 @@ func (d *influxdbDB) RunQuery(query string) ([]*mdb.Record, error) {
 	return records, nil
 }
 
+var insertRegexp = regexp.MustCompile(`(?is)^\s*INSERT\s+@(\w+)(?:\s+INTO\s+("[^"]+"|\w+))?\s*$`)
+
+// the columns of the records written by Create
+var recordColumns = map[string]bool{
+	"time":       true,
+	"id":         true,
+	"metadata":   true,
+	"name1":      true,
+	"parameter1": true,
+	"parameter2": true,
+	"parameter3": true,
+	"updated":    true,
+}
+
+// point is a point written by INSERT, time is a unix timestamp in seconds
+type point struct {
+	Time   int64                  `json:"time"`
+	Tags   map[string]string      `json:"tags"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// insert writes the points in the measurement of the table, in the default retention policy if rp is empty
+func (d *influxdbDB) insert(value interface{}, rp string) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	points := []*point{}
+	if err := json.Unmarshal(body, &points); err != nil {
+		return err
+	}
+	bp, err := client.NewBatchPoints(client.BatchPointsConfig{
+		Database:        d.influxdbDatabase,
+		RetentionPolicy: rp,
+		Precision:       "s",
+	})
+	if err != nil {
+		return err
+	}
+	for _, p := range points {
+		t := time.Now()
+		if p.Time > 0 {
+			t = time.Unix(p.Time, 0)
+		}
+		pt, err := client.NewPoint(d.influxdbMeasure, p.Tags, p.Fields, t)
+		if err != nil {
+			return err
+		}
+		bp.AddPoint(pt)
+	}
+	return d.cl.Write(bp)
+}
+
+// rowToRecord converts a row of a query result
+func rowToRecord(s models.Row, v []interface{}) (*mdb.Record, error) {
+	row := map[string]interface{}{}
+	for k, t := range s.Tags {
+		row[k] = t
+	}
+	generic := true
+	for i, c := range s.Columns {
+		if i < len(v) {
+			row[c] = v[i]
+		}
+		if !recordColumns[c] {
+			generic = false
+		}
+	}
+
+	pl := &mdb.Record{}
+	if t, ok := row["time"].(json.Number); ok {
+		pl.Created, _ = t.Int64()
+	}
+	if !generic {
+		body, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		pl.Name = s.Name
+		pl.Parameter3 = string(body)
+		return pl, nil
+	}
+	pl.Id, _ = row["id"].(string)
+	pl.Name, _ = row["name1"].(string)
+	pl.Parameter1, _ = row["parameter1"].(string)
+	pl.Parameter2, _ = row["parameter2"].(string)
+	pl.Parameter3, _ = row["parameter3"].(string)
+	if u, ok := row["updated"].(json.Number); ok {
+		pl.Updated, _ = u.Int64()
+	}
+	if metaStr, ok := row["metadata"].(string); ok {
+		json.Unmarshal([]byte(metaStr), &pl.Metadata)
+	}
+	return pl, nil
+}
+
 // A database must be created for every datatype (User, Auth, Room, etc.)
 func (d *influxdbDB) DeleteDatabase(name string) error {
 	d.Lock()
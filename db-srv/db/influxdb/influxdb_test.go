@@ -0,0 +1,49 @@
+package influxdb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/influxdata/influxdb/models"
+)
+
+func TestInsertRegexp(t *testing.T) {
+	m := insertRegexp.FindStringSubmatch(`INSERT @points INTO "raw"`)
+	if m == nil || m[1] != "points" || m[2] != `"raw"` {
+		t.Errorf("INSERT is invalid: %v", m)
+	}
+	m = insertRegexp.FindStringSubmatch(`insert @points`)
+	if m == nil || m[1] != "points" || m[2] != "" {
+		t.Errorf("INSERT without retention policy is invalid: %v", m)
+	}
+	if m = insertRegexp.FindStringSubmatch(`SELECT * FROM marker`); m != nil {
+		t.Errorf("SELECT must not be an INSERT: %v", m)
+	}
+}
+
+func TestRowToRecord(t *testing.T) {
+	// a record written by Create
+	r, err := rowToRecord(models.Row{
+		Name:    "bar",
+		Columns: []string{"time", "id", "metadata", "name1", "parameter1", "parameter2", "parameter3", "updated"},
+	}, []interface{}{json.Number("10"), "1", `{"key":"value"}`, "n", "p1", "p2", "p3", json.Number("20")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Id != "1" || r.Name != "n" || r.Parameter3 != "p3" || r.Created != 10 || r.Updated != 20 || r.Metadata["key"] != "value" {
+		t.Errorf("Record is invalid: %v", r)
+	}
+
+	// an aggregate
+	r, err = rowToRecord(models.Row{
+		Name:    "marker_series",
+		Tags:    map[string]string{"marker": "m1"},
+		Columns: []string{"time", "min", "mean"},
+	}, []interface{}{json.Number("3600"), json.Number("1"), json.Number("1.5")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Created != 3600 || r.Name != "marker_series" || r.Parameter3 != `{"marker":"m1","mean":1.5,"min":1,"time":3600}` {
+		t.Errorf("Aggregate record is invalid: %v", r)
+	}
+}
@@ -0,0 +1,833 @@
+package memory
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"server/db-srv/db"
+)
+
+// scope holds the variables of a row, rows share the variables of their parents
+type scope struct {
+	name   string
+	value  interface{}
+	parent *scope
+}
+
+func (s *scope) with(name string, value interface{}) *scope {
+	return &scope{name: name, value: value, parent: s}
+}
+
+func (s *scope) get(name string) (interface{}, bool) {
+	for c := s; c != nil; c = c.parent {
+		if c.name == name {
+			return c.value, true
+		}
+	}
+	return nil, false
+}
+
+// variables returns the variables declared after the root as an object, used by COLLECT INTO
+func (s *scope) variables(root *scope) map[string]interface{} {
+	vars := map[string]interface{}{}
+	for c := s; c != nil && c != root; c = c.parent {
+		if _, ok := vars[c.name]; !ok && !strings.HasPrefix(c.name, "$") {
+			vars[c.name] = c.value
+		}
+	}
+	return vars
+}
+
+// the element of an expansion
+const currentVar = "$current"
+
+// executor runs the queries of a transaction
+type executor struct {
+	tx       *tx
+	bindVars map[string]interface{}
+}
+
+// run returns the values of the RETURN of a query, the rows start from root
+func (e *executor) run(q *query, root *scope) ([]interface{}, error) {
+	rows := []*scope{root}
+	results := []interface{}{}
+	for _, op := range q.ops {
+		var err error
+		switch op := op.(type) {
+		case *forOp:
+			rows, err = e.runFor(op, rows)
+		case *filterOp:
+			kept := []*scope{}
+			for _, r := range rows {
+				v, err := e.eval(op.cond, r)
+				if err != nil {
+					return nil, err
+				}
+				if truthy(v) {
+					kept = append(kept, r)
+				}
+			}
+			rows = kept
+		case *letOp:
+			for i, r := range rows {
+				v, err := e.eval(op.value, r)
+				if err != nil {
+					return nil, err
+				}
+				rows[i] = r.with(op.name, v)
+			}
+		case *sortOp:
+			err = e.runSort(op, rows)
+		case *limitOp:
+			rows, err = e.runLimit(op, root, rows)
+		case *collectOp:
+			rows, err = e.runCollect(op, root, rows)
+		case *returnOp:
+			for _, r := range rows {
+				v, err := e.eval(op.value, r)
+				if err != nil {
+					return nil, err
+				}
+				if op.distinct && position(results, v) >= 0 {
+					continue
+				}
+				results = append(results, v)
+			}
+			rows = nil
+		case *insertOp:
+			rows, err = e.runInsert(op, rows)
+		case *updateOp:
+			rows, err = e.runUpdate(op, rows)
+		case *removeOp:
+			rows, err = e.runRemove(op, rows)
+		case *upsertOp:
+			rows, err = e.runUpsert(op, rows)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func (e *executor) runFor(op *forOp, rows []*scope) ([]*scope, error) {
+	next := []*scope{}
+	for _, r := range rows {
+		if op.traversal != nil {
+			steps, err := e.traverse(op.traversal, r)
+			if err != nil {
+				return nil, err
+			}
+			for _, s := range steps {
+				row := r
+				for i, v := range op.vars {
+					row = row.with(v, s[i])
+				}
+				next = append(next, row)
+			}
+			continue
+		}
+
+		// a collection bind parameter names the collection to iterate
+		if b, ok := op.in.(*bindVar); ok && strings.HasPrefix(b.name, "@") {
+			c, err := e.target(b, r)
+			if err != nil {
+				return nil, err
+			}
+			for _, doc := range c.all() {
+				next = append(next, r.with(op.vars[0], doc))
+			}
+			continue
+		}
+
+		v, err := e.eval(op.in, r)
+		if err != nil {
+			return nil, err
+		}
+		switch v := v.(type) {
+		case nil:
+		case []interface{}:
+			for _, item := range v {
+				next = append(next, r.with(op.vars[0], item))
+			}
+		default:
+			return nil, fmt.Errorf("collection or array expected as operand to FOR loop, got %s", toString(v))
+		}
+	}
+	return next, nil
+}
+
+func (e *executor) runSort(op *sortOp, rows []*scope) error {
+	keys := make([][]interface{}, len(rows))
+	for i, r := range rows {
+		for _, k := range op.keys {
+			v, err := e.eval(k.value, r)
+			if err != nil {
+				return err
+			}
+			keys[i] = append(keys[i], v)
+		}
+	}
+	index := make([]int, len(rows))
+	for i := range index {
+		index[i] = i
+	}
+	sort.SliceStable(index, func(a, b int) bool {
+		for n, k := range op.keys {
+			c := compare(keys[index[a]][n], keys[index[b]][n])
+			if k.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	sorted := make([]*scope, len(rows))
+	for i, j := range index {
+		sorted[i] = rows[j]
+	}
+	copy(rows, sorted)
+	return nil
+}
+
+func (e *executor) runLimit(op *limitOp, root *scope, rows []*scope) ([]*scope, error) {
+	offset, err := e.eval(op.offset, root)
+	if err != nil {
+		return nil, err
+	}
+	count, err := e.eval(op.count, root)
+	if err != nil {
+		return nil, err
+	}
+	o, c := int(toNumber(offset)), int(toNumber(count))
+	if o < 0 || c < 0 {
+		return nil, fmt.Errorf("LIMIT value is negative")
+	}
+	if o >= len(rows) {
+		return []*scope{}, nil
+	}
+	rows = rows[o:]
+	if c < len(rows) {
+		rows = rows[:c]
+	}
+	return rows, nil
+}
+
+type group struct {
+	values []interface{}
+	rows   []*scope
+}
+
+func (e *executor) runCollect(op *collectOp, root *scope, rows []*scope) ([]*scope, error) {
+	groups := []*group{}
+	if len(op.groups) == 0 {
+		// without groups everything is aggregated in a single row, even if there is no row
+		groups = append(groups, &group{rows: rows})
+	} else {
+		byKey := map[string]*group{}
+		for _, r := range rows {
+			values := []interface{}{}
+			for _, g := range op.groups {
+				v, err := e.eval(g.value, r)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, v)
+			}
+			key := toString(values)
+			if _, ok := byKey[key]; !ok {
+				byKey[key] = &group{values: values}
+				groups = append(groups, byKey[key])
+			}
+			byKey[key].rows = append(byKey[key].rows, r)
+		}
+		// groups are sorted by their values
+		sort.SliceStable(groups, func(a, b int) bool {
+			return compare(groups[a].values, groups[b].values) < 0
+		})
+	}
+
+	next := []*scope{}
+	for _, g := range groups {
+		row := root
+		for i, a := range op.groups {
+			row = row.with(a.name, g.values[i])
+		}
+		for _, a := range op.aggregates {
+			c := a.value.(*call)
+			args := make([]interface{}, len(c.args))
+			for i, arg := range c.args {
+				values := []interface{}{}
+				for _, r := range g.rows {
+					v, err := e.eval(arg, r)
+					if err != nil {
+						return nil, err
+					}
+					values = append(values, v)
+				}
+				args[i] = values
+			}
+			v, err := functions[c.function](args)
+			if err != nil {
+				return nil, err
+			}
+			row = row.with(a.name, v)
+		}
+		if len(op.count) > 0 {
+			row = row.with(op.count, float64(len(g.rows)))
+		}
+		if len(op.into) > 0 {
+			values := []interface{}{}
+			for _, r := range g.rows {
+				if op.intoExpr != nil {
+					v, err := e.eval(op.intoExpr, r)
+					if err != nil {
+						return nil, err
+					}
+					values = append(values, v)
+					continue
+				}
+				values = append(values, r.variables(root))
+			}
+			row = row.with(op.into, values)
+		}
+		next = append(next, row)
+	}
+	return next, nil
+}
+
+// options of a modification
+type options struct {
+	ignoreErrors bool
+	ignoreRevs   bool
+	keepNull     bool
+	mergeObjects bool
+}
+
+func (e *executor) options(o expr, s *scope) (*options, error) {
+	opts := &options{ignoreRevs: true, keepNull: true, mergeObjects: true}
+	if o == nil {
+		return opts, nil
+	}
+	v, err := e.eval(o, s)
+	if err != nil {
+		return nil, err
+	}
+	m, _ := v.(map[string]interface{})
+	if v, ok := m["ignoreErrors"]; ok {
+		opts.ignoreErrors = truthy(v)
+	}
+	if v, ok := m["ignoreRevs"]; ok {
+		opts.ignoreRevs = truthy(v)
+	}
+	if v, ok := m["keepNull"]; ok {
+		opts.keepNull = truthy(v)
+	}
+	if v, ok := m["mergeObjects"]; ok {
+		opts.mergeObjects = truthy(v)
+	}
+	return opts, nil
+}
+
+// target returns the collection of a modification
+func (e *executor) target(c expr, s *scope) (*collection, error) {
+	v, err := e.eval(c, s)
+	if err != nil {
+		return nil, err
+	}
+	name, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid collection: %s", toString(v))
+	}
+	return e.tx.collection(name)
+}
+
+// checkRev returns db.ErrConflict if revisions aren't ignored and the _rev of the document isn't the one of
+// the existing document, a document without _rev matches every revision
+func checkRev(old map[string]interface{}, doc interface{}, opts *options) error {
+	if opts.ignoreRevs {
+		return nil
+	}
+	m, _ := doc.(map[string]interface{})
+	if rev, ok := m["_rev"]; ok && rev != old["_rev"] {
+		return db.ErrConflict
+	}
+	return nil
+}
+
+// key returns the key of a document or key value
+func key(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		if i := strings.Index(v, "/"); i >= 0 {
+			return v[i+1:], nil
+		}
+		return v, nil
+	case map[string]interface{}:
+		if k, ok := v["_key"].(string); ok {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("invalid document key: %s", toString(v))
+}
+
+func (e *executor) runInsert(op *insertOp, rows []*scope) ([]*scope, error) {
+	next := []*scope{}
+	for _, r := range rows {
+		c, err := e.target(op.collection, r)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := e.options(op.options, r)
+		if err != nil {
+			return nil, err
+		}
+		v, err := e.eval(op.doc, r)
+		if err != nil {
+			return nil, err
+		}
+		doc, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid document type: %s", toString(v))
+		}
+		neu, err := e.tx.insert(c, doc)
+		if err != nil {
+			if opts.ignoreErrors {
+				continue
+			}
+			return nil, err
+		}
+		next = append(next, r.with("NEW", neu).with("OLD", nil))
+	}
+	return next, nil
+}
+
+// modify applies an update or replace document to an existing document
+func (e *executor) modify(c *collection, old, doc map[string]interface{}, replace bool, opts *options) map[string]interface{} {
+	if replace {
+		return e.tx.replace(c, old, doc)
+	}
+	neu := merge(old, doc, opts.mergeObjects)
+	if !opts.keepNull {
+		neu = unsetNulls(neu)
+	}
+	return e.tx.replace(c, old, neu)
+}
+
+func (e *executor) runUpdate(op *updateOp, rows []*scope) ([]*scope, error) {
+	next := []*scope{}
+	for _, r := range rows {
+		c, err := e.target(op.collection, r)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := e.options(op.options, r)
+		if err != nil {
+			return nil, err
+		}
+		kv, err := e.eval(op.key, r)
+		if err != nil {
+			return nil, err
+		}
+		doc := kv
+		if op.with != nil {
+			if doc, err = e.eval(op.with, r); err != nil {
+				return nil, err
+			}
+		}
+		m, ok := doc.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid document type: %s", toString(doc))
+		}
+		k, err := key(kv)
+		if err != nil {
+			return nil, err
+		}
+		old, ok := c.docs[k]
+		if !ok {
+			if opts.ignoreErrors {
+				continue
+			}
+			return nil, fmt.Errorf("document not found: %s/%s", c.name, k)
+		}
+		if err := checkRev(old, kv, opts); err != nil {
+			if opts.ignoreErrors {
+				continue
+			}
+			return nil, err
+		}
+		neu := e.modify(c, old, m, op.replace, opts)
+		next = append(next, r.with("NEW", neu).with("OLD", old))
+	}
+	return next, nil
+}
+
+func (e *executor) runRemove(op *removeOp, rows []*scope) ([]*scope, error) {
+	next := []*scope{}
+	for _, r := range rows {
+		c, err := e.target(op.collection, r)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := e.options(op.options, r)
+		if err != nil {
+			return nil, err
+		}
+		kv, err := e.eval(op.key, r)
+		if err != nil {
+			return nil, err
+		}
+		k, err := key(kv)
+		if err != nil {
+			return nil, err
+		}
+		old, ok := c.docs[k]
+		if !ok {
+			if opts.ignoreErrors {
+				continue
+			}
+			return nil, fmt.Errorf("document not found: %s/%s", c.name, k)
+		}
+		if err := checkRev(old, kv, opts); err != nil {
+			if opts.ignoreErrors {
+				continue
+			}
+			return nil, err
+		}
+		e.tx.remove(c, k)
+		next = append(next, r.with("NEW", nil).with("OLD", old))
+	}
+	return next, nil
+}
+
+// matches reports whether the document has the attributes of the example, nested objects are matched the same way
+func matches(doc, example map[string]interface{}) bool {
+	for k, v := range example {
+		if sub, ok := v.(map[string]interface{}); ok {
+			d, ok := doc[k].(map[string]interface{})
+			if !ok || !matches(d, sub) {
+				return false
+			}
+			continue
+		}
+		if !equal(doc[k], v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *executor) runUpsert(op *upsertOp, rows []*scope) ([]*scope, error) {
+	next := []*scope{}
+	for _, r := range rows {
+		c, err := e.target(op.collection, r)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := e.options(op.options, r)
+		if err != nil {
+			return nil, err
+		}
+		sv, err := e.eval(op.search, r)
+		if err != nil {
+			return nil, err
+		}
+		search, ok := sv.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid UPSERT search document: %s", toString(sv))
+		}
+		var old map[string]interface{}
+		for _, doc := range c.all() {
+			if matches(doc, search) {
+				old = doc
+				break
+			}
+		}
+
+		if old == nil {
+			v, err := e.eval(op.insert, r.with("OLD", nil))
+			if err != nil {
+				return nil, err
+			}
+			doc, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid document type: %s", toString(v))
+			}
+			neu, err := e.tx.insert(c, doc)
+			if err != nil {
+				if opts.ignoreErrors {
+					continue
+				}
+				return nil, err
+			}
+			next = append(next, r.with("NEW", neu).with("OLD", nil))
+			continue
+		}
+
+		// the update can use OLD
+		v, err := e.eval(op.update, r.with("OLD", old))
+		if err != nil {
+			return nil, err
+		}
+		doc, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid document type: %s", toString(v))
+		}
+		if err := checkRev(old, doc, opts); err != nil {
+			if opts.ignoreErrors {
+				continue
+			}
+			return nil, err
+		}
+		neu := e.modify(c, old, doc, op.replace, opts)
+		next = append(next, r.with("NEW", neu).with("OLD", old))
+	}
+	return next, nil
+}
+
+// eval returns the value of an expression in a row
+func (e *executor) eval(x expr, s *scope) (interface{}, error) {
+	switch x := x.(type) {
+	case *literal:
+		return x.value, nil
+	case *bindVar:
+		v, ok := e.bindVars[x.name]
+		if !ok {
+			return nil, fmt.Errorf("bind parameter '%s' was not declared in the query", x.name)
+		}
+		return v, nil
+	case *name:
+		if v, ok := s.get(x.name); ok {
+			return v, nil
+		}
+		if c, ok := e.tx.database.collections[x.name]; ok {
+			docs := []interface{}{}
+			for _, d := range c.all() {
+				docs = append(docs, d)
+			}
+			return docs, nil
+		}
+		return nil, fmt.Errorf("variable '%s' is not defined", x.name)
+	case *current:
+		v, _ := s.get(currentVar)
+		return v, nil
+	case *attribute:
+		v, err := e.eval(x.value, s)
+		if err != nil {
+			return nil, err
+		}
+		m, _ := v.(map[string]interface{})
+		return m[x.name], nil
+	case *index:
+		v, err := e.eval(x.value, s)
+		if err != nil {
+			return nil, err
+		}
+		i, err := e.eval(x.index, s)
+		if err != nil {
+			return nil, err
+		}
+		switch v := v.(type) {
+		case []interface{}:
+			n := int(toNumber(i))
+			if n < 0 {
+				n += len(v)
+			}
+			if n < 0 || n >= len(v) {
+				return nil, nil
+			}
+			return v[n], nil
+		case map[string]interface{}:
+			return v[toString(i)], nil
+		}
+		return nil, nil
+	case *expansion:
+		v, err := e.eval(x.value, s)
+		if err != nil {
+			return nil, err
+		}
+		values, _ := v.([]interface{})
+		if x.flatten {
+			values = flatten(values, 1)
+		}
+		if x.then == nil {
+			return append([]interface{}{}, values...), nil
+		}
+		expanded := []interface{}{}
+		for _, item := range values {
+			v, err := e.eval(x.then, s.with(currentVar, item))
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, v)
+		}
+		return expanded, nil
+	case *call:
+		args := make([]interface{}, len(x.args))
+		for i, a := range x.args {
+			v, err := e.eval(a, s)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		return functions[x.function](args)
+	case *unary:
+		v, err := e.eval(x.value, s)
+		if err != nil {
+			return nil, err
+		}
+		switch x.op {
+		case "!":
+			return !truthy(v), nil
+		case "-":
+			return number(-toNumber(v)), nil
+		}
+		return toNumber(v), nil
+	case *binary:
+		return e.evalBinary(x, s)
+	case *ternary:
+		cond, err := e.eval(x.cond, s)
+		if err != nil {
+			return nil, err
+		}
+		if truthy(cond) {
+			if x.then == nil {
+				return cond, nil
+			}
+			return e.eval(x.then, s)
+		}
+		return e.eval(x.otherwise, s)
+	case *object:
+		m := map[string]interface{}{}
+		for _, f := range x.fields {
+			k := f.key
+			if f.computed != nil {
+				v, err := e.eval(f.computed, s)
+				if err != nil {
+					return nil, err
+				}
+				k = toString(v)
+			}
+			v, err := e.eval(f.value, s)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	case *array:
+		values := []interface{}{}
+		for _, a := range x.values {
+			v, err := e.eval(a, s)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return values, nil
+	case *subquery:
+		return e.run(x.query, s)
+	}
+	return nil, fmt.Errorf("%v: unsupported expression %T", ErrInvalidQuery, x)
+}
+
+func (e *executor) evalBinary(x *binary, s *scope) (interface{}, error) {
+	left, err := e.eval(x.left, s)
+	if err != nil {
+		return nil, err
+	}
+	// logical operators return an operand, the right one is only evaluated if needed
+	switch x.op {
+	case "&&":
+		if !truthy(left) {
+			return left, nil
+		}
+		return e.eval(x.right, s)
+	case "||":
+		if truthy(left) {
+			return left, nil
+		}
+		return e.eval(x.right, s)
+	}
+
+	right, err := e.eval(x.right, s)
+	if err != nil {
+		return nil, err
+	}
+	if len(x.quantifier) == 0 {
+		return operate(x.op, left, right)
+	}
+
+	values, _ := left.([]interface{})
+	n := 0
+	for _, v := range values {
+		r, err := operate(x.op, v, right)
+		if err != nil {
+			return nil, err
+		}
+		if truthy(r) {
+			n++
+		}
+	}
+	switch x.quantifier {
+	case "ANY":
+		return n > 0, nil
+	case "ALL":
+		return n == len(values), nil
+	}
+	return n == 0, nil
+}
+
+func operate(op string, left, right interface{}) (interface{}, error) {
+	switch op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "<":
+		return compare(left, right) < 0, nil
+	case "<=":
+		return compare(left, right) <= 0, nil
+	case ">":
+		return compare(left, right) > 0, nil
+	case ">=":
+		return compare(left, right) >= 0, nil
+	case "IN", "NOT IN":
+		values, _ := right.([]interface{})
+		return (position(values, left) >= 0) == (op == "IN"), nil
+	case "LIKE", "NOT LIKE":
+		return like(toString(left), toString(right), false) == (op == "LIKE"), nil
+	case "=~", "!~":
+		re, err := regexp.Compile(toString(right))
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString(toString(left)) == (op == "=~"), nil
+	case "+":
+		return number(toNumber(left) + toNumber(right)), nil
+	case "-":
+		return number(toNumber(left) - toNumber(right)), nil
+	case "*":
+		return number(toNumber(left) * toNumber(right)), nil
+	case "/":
+		return number(toNumber(left) / toNumber(right)), nil
+	case "%":
+		r := toNumber(right)
+		if r == 0 {
+			return nil, nil
+		}
+		return number(float64(int64(toNumber(left)) % int64(r))), nil
+	case "..":
+		values := []interface{}{}
+		for i := int64(toNumber(left)); i <= int64(toNumber(right)); i++ {
+			values = append(values, float64(i))
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("%v: unsupported operator %s", ErrInvalidQuery, op)
+}
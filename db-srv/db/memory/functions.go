@@ -0,0 +1,484 @@
+package memory
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type function func(args []interface{}) (interface{}, error)
+
+// functions are the AQL functions the services use and a few common ones, names are upper case
+var functions = map[string]function{
+	// types
+	"IS_NULL":   func(a []interface{}) (interface{}, error) { return arg(a, 0) == nil, nil },
+	"IS_ARRAY":  func(a []interface{}) (interface{}, error) { return typeOrder(arg(a, 0)) == 4, nil },
+	"IS_OBJECT": func(a []interface{}) (interface{}, error) { return typeOrder(arg(a, 0)) == 5, nil },
+	"IS_STRING": func(a []interface{}) (interface{}, error) { return typeOrder(arg(a, 0)) == 3, nil },
+	"IS_NUMBER": func(a []interface{}) (interface{}, error) { return typeOrder(arg(a, 0)) == 2, nil },
+	"NOT_NULL":  notNull,
+	"FIRST_DOCUMENT": func(a []interface{}) (interface{}, error) {
+		for _, v := range a {
+			if _, ok := v.(map[string]interface{}); ok {
+				return v, nil
+			}
+		}
+		return nil, nil
+	},
+	"TO_STRING": func(a []interface{}) (interface{}, error) { return toString(arg(a, 0)), nil },
+	"TO_NUMBER": func(a []interface{}) (interface{}, error) { return toNumber(arg(a, 0)), nil },
+	"TO_BOOL":   func(a []interface{}) (interface{}, error) { return truthy(arg(a, 0)), nil },
+	"TO_ARRAY":  func(a []interface{}) (interface{}, error) { return toArray(arg(a, 0)), nil },
+
+	// strings
+	"CONCAT": func(a []interface{}) (interface{}, error) {
+		return concat("", a, false), nil
+	},
+	"CONCAT_SEPARATOR": func(a []interface{}) (interface{}, error) {
+		if len(a) == 0 {
+			return "", nil
+		}
+		return concat(toString(a[0]), a[1:], true), nil
+	},
+	"LIKE": func(a []interface{}) (interface{}, error) {
+		return like(toString(arg(a, 0)), toString(arg(a, 1)), truthy(arg(a, 2))), nil
+	},
+	"CONTAINS": func(a []interface{}) (interface{}, error) {
+		i := strings.Index(toString(arg(a, 0)), toString(arg(a, 1)))
+		if truthy(arg(a, 2)) {
+			if i >= 0 {
+				i = len([]rune(toString(arg(a, 0))[:i]))
+			}
+			return float64(i), nil
+		}
+		return i >= 0, nil
+	},
+	"LOWER": func(a []interface{}) (interface{}, error) { return strings.ToLower(toString(arg(a, 0))), nil },
+	"UPPER": func(a []interface{}) (interface{}, error) { return strings.ToUpper(toString(arg(a, 0))), nil },
+	"TRIM":  func(a []interface{}) (interface{}, error) { return strings.TrimSpace(toString(arg(a, 0))), nil },
+	"SPLIT": func(a []interface{}) (interface{}, error) {
+		values := []interface{}{}
+		for _, s := range strings.Split(toString(arg(a, 0)), toString(arg(a, 1))) {
+			values = append(values, s)
+		}
+		return values, nil
+	},
+	"SUBSTRING": func(a []interface{}) (interface{}, error) {
+		r := []rune(toString(arg(a, 0)))
+		start, end := sliceRange(len(r), arg(a, 1), arg(a, 2))
+		return string(r[start:end]), nil
+	},
+	"REGEX_TEST": func(a []interface{}) (interface{}, error) {
+		return regexMatch(toString(arg(a, 0)), toString(arg(a, 1)), truthy(arg(a, 2)))
+	},
+
+	// arrays
+	"LENGTH": length,
+	"COUNT":  length,
+	"FIRST": func(a []interface{}) (interface{}, error) {
+		if arr := toArray(arg(a, 0)); len(arr) > 0 {
+			return arr[0], nil
+		}
+		return nil, nil
+	},
+	"LAST": func(a []interface{}) (interface{}, error) {
+		if arr := toArray(arg(a, 0)); len(arr) > 0 {
+			return arr[len(arr)-1], nil
+		}
+		return nil, nil
+	},
+	"NTH": func(a []interface{}) (interface{}, error) {
+		arr := toArray(arg(a, 0))
+		i := int(toNumber(arg(a, 1)))
+		if i < 0 || i >= len(arr) {
+			return nil, nil
+		}
+		return arr[i], nil
+	},
+	"APPEND": func(a []interface{}) (interface{}, error) {
+		values := append([]interface{}{}, toArray(arg(a, 0))...)
+		add := arg(a, 1)
+		if _, ok := add.([]interface{}); !ok {
+			add = []interface{}{add}
+		}
+		for _, v := range add.([]interface{}) {
+			if truthy(arg(a, 2)) && position(values, v) >= 0 {
+				continue
+			}
+			values = append(values, v)
+		}
+		return values, nil
+	},
+	"PUSH": func(a []interface{}) (interface{}, error) {
+		values := append([]interface{}{}, toArray(arg(a, 0))...)
+		if truthy(arg(a, 2)) && position(values, arg(a, 1)) >= 0 {
+			return values, nil
+		}
+		return append(values, arg(a, 1)), nil
+	},
+	"SLICE": func(a []interface{}) (interface{}, error) {
+		arr := toArray(arg(a, 0))
+		start := int(toNumber(arg(a, 1)))
+		if start < 0 {
+			start += len(arr)
+		}
+		s, e := sliceRange(len(arr), float64(start), arg(a, 2))
+		return append([]interface{}{}, arr[s:e]...), nil
+	},
+	"POSITION": func(a []interface{}) (interface{}, error) {
+		i := position(toArray(arg(a, 0)), arg(a, 1))
+		if truthy(arg(a, 2)) {
+			return float64(i), nil
+		}
+		return i >= 0, nil
+	},
+	"UNIQUE": func(a []interface{}) (interface{}, error) {
+		return unique(toArray(arg(a, 0))), nil
+	},
+	"FLATTEN": func(a []interface{}) (interface{}, error) {
+		depth := 1
+		if len(a) > 1 {
+			depth = int(toNumber(a[1]))
+		}
+		return flatten(toArray(arg(a, 0)), depth), nil
+	},
+	"UNION": func(a []interface{}) (interface{}, error) {
+		values := []interface{}{}
+		for _, v := range a {
+			values = append(values, toArray(v)...)
+		}
+		return values, nil
+	},
+	"UNION_DISTINCT": func(a []interface{}) (interface{}, error) {
+		values := []interface{}{}
+		for _, v := range a {
+			values = append(values, toArray(v)...)
+		}
+		return unique(values), nil
+	},
+	"INTERSECTION": func(a []interface{}) (interface{}, error) {
+		if len(a) == 0 {
+			return []interface{}{}, nil
+		}
+		values := unique(toArray(a[0]))
+		for _, v := range a[1:] {
+			other := toArray(v)
+			kept := []interface{}{}
+			for _, x := range values {
+				if position(other, x) >= 0 {
+					kept = append(kept, x)
+				}
+			}
+			values = kept
+		}
+		return values, nil
+	},
+	"MINUS": func(a []interface{}) (interface{}, error) {
+		if len(a) == 0 {
+			return []interface{}{}, nil
+		}
+		values := []interface{}{}
+		for _, x := range unique(toArray(a[0])) {
+			found := false
+			for _, v := range a[1:] {
+				if position(toArray(v), x) >= 0 {
+					found = true
+				}
+			}
+			if !found {
+				values = append(values, x)
+			}
+		}
+		return values, nil
+	},
+	"REVERSE": func(a []interface{}) (interface{}, error) {
+		if s, ok := arg(a, 0).(string); ok {
+			r := []rune(s)
+			for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+				r[i], r[j] = r[j], r[i]
+			}
+			return string(r), nil
+		}
+		arr := toArray(arg(a, 0))
+		values := make([]interface{}, len(arr))
+		for i, v := range arr {
+			values[len(arr)-1-i] = v
+		}
+		return values, nil
+	},
+
+	// numbers and aggregates
+	"MIN": func(a []interface{}) (interface{}, error) {
+		return extreme(toArray(arg(a, 0)), -1), nil
+	},
+	"MAX": func(a []interface{}) (interface{}, error) {
+		return extreme(toArray(arg(a, 0)), 1), nil
+	},
+	"SUM": func(a []interface{}) (interface{}, error) {
+		sum := 0.0
+		for _, v := range toArray(arg(a, 0)) {
+			sum += toNumber(v)
+		}
+		return sum, nil
+	},
+	"AVERAGE": average,
+	"AVG":     average,
+	"ABS":     func(a []interface{}) (interface{}, error) { return math.Abs(toNumber(arg(a, 0))), nil },
+	"FLOOR":   func(a []interface{}) (interface{}, error) { return math.Floor(toNumber(arg(a, 0))), nil },
+	"CEIL":    func(a []interface{}) (interface{}, error) { return math.Ceil(toNumber(arg(a, 0))), nil },
+	"ROUND": func(a []interface{}) (interface{}, error) {
+		return math.Floor(toNumber(arg(a, 0)) + 0.5), nil
+	},
+	"RAND": func(a []interface{}) (interface{}, error) { return rand.Float64(), nil },
+
+	// objects
+	"MERGE": func(a []interface{}) (interface{}, error) {
+		return mergeArgs(a, false)
+	},
+	"MERGE_RECURSIVE": func(a []interface{}) (interface{}, error) {
+		return mergeArgs(a, true)
+	},
+	"HAS": func(a []interface{}) (interface{}, error) {
+		m, ok := arg(a, 0).(map[string]interface{})
+		if !ok {
+			return false, nil
+		}
+		_, has := m[toString(arg(a, 1))]
+		return has, nil
+	},
+	"ATTRIBUTES": func(a []interface{}) (interface{}, error) {
+		m, _ := arg(a, 0).(map[string]interface{})
+		keys := []interface{}{}
+		for _, k := range sortedKeys(m) {
+			if truthy(arg(a, 1)) && strings.HasPrefix(k, "_") {
+				continue
+			}
+			keys = append(keys, k)
+		}
+		return keys, nil
+	},
+	"VALUES": func(a []interface{}) (interface{}, error) {
+		m, _ := arg(a, 0).(map[string]interface{})
+		values := []interface{}{}
+		for _, k := range sortedKeys(m) {
+			if truthy(arg(a, 1)) && strings.HasPrefix(k, "_") {
+				continue
+			}
+			values = append(values, m[k])
+		}
+		return values, nil
+	},
+	"KEEP": func(a []interface{}) (interface{}, error) {
+		m, _ := arg(a, 0).(map[string]interface{})
+		kept := map[string]interface{}{}
+		for _, k := range attributeArgs(a[1:]) {
+			if v, ok := m[k]; ok {
+				kept[k] = v
+			}
+		}
+		return kept, nil
+	},
+	"UNSET": func(a []interface{}) (interface{}, error) {
+		m, _ := arg(a, 0).(map[string]interface{})
+		kept := copyDocument(m)
+		for _, k := range attributeArgs(a[1:]) {
+			delete(kept, k)
+		}
+		return kept, nil
+	},
+	"ZIP": func(a []interface{}) (interface{}, error) {
+		keys, values := toArray(arg(a, 0)), toArray(arg(a, 1))
+		m := map[string]interface{}{}
+		for i, k := range keys {
+			if i < len(values) {
+				m[toString(k)] = values[i]
+			}
+		}
+		return m, nil
+	},
+
+	// dates are unix milliseconds
+	"DATE_NOW": func(a []interface{}) (interface{}, error) {
+		return float64(time.Now().UnixNano() / int64(time.Millisecond)), nil
+	},
+}
+
+// arg returns the argument i, null if it isn't passed
+func arg(args []interface{}, i int) interface{} {
+	if i < len(args) {
+		return args[i]
+	}
+	return nil
+}
+
+func notNull(a []interface{}) (interface{}, error) {
+	for _, v := range a {
+		if v != nil {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+func length(a []interface{}) (interface{}, error) {
+	switch v := arg(a, 0).(type) {
+	case nil:
+		return float64(0), nil
+	case bool:
+		if v {
+			return float64(1), nil
+		}
+		return float64(0), nil
+	case float64:
+		return float64(len(toString(v))), nil
+	case string:
+		return float64(len([]rune(v))), nil
+	case []interface{}:
+		return float64(len(v)), nil
+	case map[string]interface{}:
+		return float64(len(v)), nil
+	}
+	return float64(0), nil
+}
+
+func average(a []interface{}) (interface{}, error) {
+	sum, n := 0.0, 0
+	for _, v := range toArray(arg(a, 0)) {
+		if v == nil {
+			continue
+		}
+		sum += toNumber(v)
+		n++
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	return sum / float64(n), nil
+}
+
+// extreme returns the minimum (sign -1) or the maximum (sign 1) of the values which aren't null
+func extreme(values []interface{}, sign int) interface{} {
+	var found interface{}
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if found == nil || compare(v, found) == sign {
+			found = v
+		}
+	}
+	return found
+}
+
+func concat(sep string, args []interface{}, skipNull bool) string {
+	parts := []string{}
+	for _, a := range args {
+		values, ok := a.([]interface{})
+		if !ok {
+			values = []interface{}{a}
+		}
+		for _, v := range values {
+			if v == nil && skipNull {
+				continue
+			}
+			parts = append(parts, toString(v))
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+func mergeArgs(a []interface{}, recursive bool) (interface{}, error) {
+	// a single array argument is the list of objects
+	if len(a) == 1 {
+		if arr, ok := a[0].([]interface{}); ok {
+			a = arr
+		}
+	}
+	m := map[string]interface{}{}
+	for _, v := range a {
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid argument type in call to function MERGE: %s", toString(v))
+		}
+		m = merge(m, o, recursive)
+	}
+	return m, nil
+}
+
+// attributeArgs reads the attribute names passed as values or as an array
+func attributeArgs(args []interface{}) []string {
+	names := []string{}
+	for _, a := range args {
+		for _, v := range toArray(a) {
+			names = append(names, toString(v))
+		}
+	}
+	return names
+}
+
+func position(values []interface{}, v interface{}) int {
+	for i, x := range values {
+		if equal(x, v) {
+			return i
+		}
+	}
+	return -1
+}
+
+func unique(values []interface{}) []interface{} {
+	u := []interface{}{}
+	for _, v := range values {
+		if position(u, v) < 0 {
+			u = append(u, v)
+		}
+	}
+	return u
+}
+
+func flatten(values []interface{}, depth int) []interface{} {
+	flat := []interface{}{}
+	for _, v := range values {
+		if arr, ok := v.([]interface{}); ok && depth > 0 {
+			flat = append(flat, flatten(arr, depth-1)...)
+			continue
+		}
+		flat = append(flat, v)
+	}
+	return flat
+}
+
+// sliceRange returns the bounds of start and end (exclusive) for n elements, end is the length when it's null
+func sliceRange(n int, start, end interface{}) (int, int) {
+	s := int(toNumber(start))
+	e := n
+	if end != nil {
+		e = s + int(toNumber(end))
+	}
+	if s < 0 {
+		s = 0
+	}
+	if s > n {
+		s = n
+	}
+	if e > n {
+		e = n
+	}
+	if e < s {
+		e = s
+	}
+	return s, e
+}
+
+func regexMatch(text, pattern string, caseInsensitive bool) (interface{}, error) {
+	if caseInsensitive {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString(text), nil
+}
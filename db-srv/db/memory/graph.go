@@ -0,0 +1,195 @@
+package memory
+
+import (
+	"fmt"
+)
+
+// traverse walks the edge collections from the start vertex, it returns the vertex, edge and path of every step
+// between the min and max depth. Edges are unique on a path, vertices are unique if uniqueVertices is set.
+func (e *executor) traverse(tr *traversal, s *scope) ([][]interface{}, error) {
+	min, err := e.eval(tr.min, s)
+	if err != nil {
+		return nil, err
+	}
+	max, err := e.eval(tr.max, s)
+	if err != nil {
+		return nil, err
+	}
+	start, err := e.eval(tr.start, s)
+	if err != nil {
+		return nil, err
+	}
+	var startId string
+	switch v := start.(type) {
+	case string:
+		startId = v
+	case map[string]interface{}:
+		startId, _ = v["_id"].(string)
+	}
+	// an invalid start vertex isn't an error, there is nothing to traverse
+	if len(startId) == 0 {
+		return [][]interface{}{}, nil
+	}
+
+	edges := []*collection{}
+	for _, ex := range tr.edges {
+		c, err := e.target(ex, s)
+		if err != nil {
+			return nil, err
+		}
+		if !c.edge {
+			return nil, fmt.Errorf("collection type invalid: %s is not an edge collection", c.name)
+		}
+		edges = append(edges, c)
+	}
+
+	opts := map[string]interface{}{}
+	if tr.options != nil {
+		v, err := e.eval(tr.options, s)
+		if err != nil {
+			return nil, err
+		}
+		opts, _ = v.(map[string]interface{})
+	}
+	w := &walker{
+		tx:             e.tx,
+		direction:      tr.direction,
+		edges:          edges,
+		min:            int(toNumber(min)),
+		max:            int(toNumber(max)),
+		uniqueVertices: toString(opts["uniqueVertices"]),
+		visited:        map[string]bool{},
+		steps:          [][]interface{}{},
+	}
+	first := &step{id: startId}
+	if doc := e.tx.lookup(startId); doc != nil {
+		first.vertex = doc
+	}
+	w.visited[startId] = true
+	if truthy(opts["bfs"]) {
+		w.bfs(first)
+	} else {
+		w.dfs(first)
+	}
+	return w.steps, nil
+}
+
+// step is a vertex reached by a traversal, it links to the previous step of its path
+type step struct {
+	id     string
+	vertex interface{}
+	edge   map[string]interface{}
+	prev   *step
+	depth  int
+}
+
+func (s *step) onPath(id string) bool {
+	for p := s; p != nil; p = p.prev {
+		if p.id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *step) hasEdge(edge map[string]interface{}) bool {
+	for p := s; p != nil; p = p.prev {
+		if p.edge != nil && p.edge["_id"] == edge["_id"] {
+			return true
+		}
+	}
+	return false
+}
+
+// path returns the vertices and edges from the start vertex
+func (s *step) path() map[string]interface{} {
+	vertices, edges := []interface{}{}, []interface{}{}
+	for p := s; p != nil; p = p.prev {
+		vertices = append([]interface{}{p.vertex}, vertices...)
+		if p.edge != nil {
+			edges = append([]interface{}{p.edge}, edges...)
+		}
+	}
+	return map[string]interface{}{"vertices": vertices, "edges": edges}
+}
+
+type walker struct {
+	tx             *tx
+	direction      string
+	edges          []*collection
+	min, max       int
+	uniqueVertices string
+	visited        map[string]bool
+	steps          [][]interface{}
+}
+
+func (w *walker) emit(s *step) {
+	if s.depth < w.min {
+		return
+	}
+	var edge interface{}
+	if s.edge != nil {
+		edge = s.edge
+	}
+	w.steps = append(w.steps, []interface{}{s.vertex, edge, s.path()})
+}
+
+// next returns the steps one edge away
+func (w *walker) next(s *step) []*step {
+	steps := []*step{}
+	if s.depth >= w.max {
+		return steps
+	}
+	for _, c := range w.edges {
+		for _, edge := range c.all() {
+			from, _ := edge["_from"].(string)
+			to, _ := edge["_to"].(string)
+			id := ""
+			switch {
+			case w.direction != "INBOUND" && from == s.id:
+				id = to
+			case w.direction != "OUTBOUND" && to == s.id:
+				id = from
+			default:
+				continue
+			}
+			if s.hasEdge(edge) {
+				continue
+			}
+			switch w.uniqueVertices {
+			case "path":
+				if s.onPath(id) {
+					continue
+				}
+			case "global":
+				if w.visited[id] {
+					continue
+				}
+				w.visited[id] = true
+			}
+			n := &step{id: id, edge: edge, prev: s, depth: s.depth + 1}
+			if doc := w.tx.lookup(id); doc != nil {
+				n.vertex = doc
+			}
+			steps = append(steps, n)
+		}
+	}
+	return steps
+}
+
+func (w *walker) dfs(s *step) {
+	w.emit(s)
+	for _, n := range w.next(s) {
+		w.dfs(n)
+	}
+}
+
+func (w *walker) bfs(s *step) {
+	queue := []*step{s}
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		w.emit(s)
+		queue = append(queue, w.next(s)...)
+	}
+}
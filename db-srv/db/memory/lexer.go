@@ -0,0 +1,180 @@
+package memory
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenType int
+
+const (
+	tokEOF tokenType = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokBindVar
+	tokOp
+)
+
+type token struct {
+	typ tokenType
+	val string
+	pos int
+}
+
+// is reports whether the token is the keyword, keywords are case insensitive
+func (t token) is(keyword string) bool {
+	return t.typ == tokIdent && strings.EqualFold(t.val, keyword)
+}
+
+func (t token) op(op string) bool {
+	return t.typ == tokOp && t.val == op
+}
+
+func (t token) String() string {
+	if t.typ == tokEOF {
+		return "end of query"
+	}
+	return fmt.Sprintf("%q at %d", t.val, t.pos)
+}
+
+// operators, the longest ones first
+var operators = []string{
+	"[**]", "[*]",
+	"==", "!=", "<=", ">=", "&&", "||", "=~", "!~", "..",
+	"=", "<", ">", "!", "+", "-", "*", "/", "%", "?", ":",
+	".", ",", "(", ")", "[", "]", "{", "}",
+}
+
+func lex(q string) ([]token, error) {
+	tokens := []token{}
+	r := []rune(q)
+	for i := 0; i < len(r); {
+		c := r[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '/' && i+1 < len(r) && r[i+1] == '/':
+			for i < len(r) && r[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(r) && r[i+1] == '*':
+			j := i + 2
+			for j+1 < len(r) && !(r[j] == '*' && r[j+1] == '/') {
+				j++
+			}
+			if j+1 >= len(r) {
+				return nil, fmt.Errorf("%v: unterminated comment at %d", ErrInvalidQuery, i)
+			}
+			i = j + 2
+		case c == '"' || c == '\'':
+			s, n, err := lexString(r[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v at %d", ErrInvalidQuery, err, i)
+			}
+			tokens = append(tokens, token{tokString, s, i})
+			i += n
+		case c == '`':
+			j := i + 1
+			for j < len(r) && r[j] != '`' {
+				j++
+			}
+			if j == len(r) {
+				return nil, fmt.Errorf("%v: unterminated name at %d", ErrInvalidQuery, i)
+			}
+			tokens = append(tokens, token{tokIdent, string(r[i+1 : j]), i})
+			i = j + 1
+		case c == '@':
+			j := i + 1
+			if j < len(r) && r[j] == '@' {
+				j++
+			}
+			for j < len(r) && isIdentRune(r[j]) {
+				j++
+			}
+			tokens = append(tokens, token{tokBindVar, string(r[i+1 : j]), i})
+			i = j
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(r) && unicode.IsDigit(r[j]) {
+				j++
+			}
+			// a range 1..2 isn't a decimal
+			if j+1 < len(r) && r[j] == '.' && unicode.IsDigit(r[j+1]) {
+				j++
+				for j < len(r) && unicode.IsDigit(r[j]) {
+					j++
+				}
+			}
+			if j < len(r) && (r[j] == 'e' || r[j] == 'E') {
+				k := j + 1
+				if k < len(r) && (r[k] == '+' || r[k] == '-') {
+					k++
+				}
+				if k < len(r) && unicode.IsDigit(r[k]) {
+					j = k
+					for j < len(r) && unicode.IsDigit(r[j]) {
+						j++
+					}
+				}
+			}
+			tokens = append(tokens, token{tokNumber, string(r[i:j]), i})
+			i = j
+		case isIdentRune(c) || c == '$':
+			j := i + 1
+			for j < len(r) && isIdentRune(r[j]) {
+				j++
+			}
+			tokens = append(tokens, token{tokIdent, string(r[i:j]), i})
+			i = j
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(string(r[i:]), o) {
+					op = o
+					break
+				}
+			}
+			if len(op) == 0 {
+				return nil, fmt.Errorf("%v: unexpected %q at %d", ErrInvalidQuery, c, i)
+			}
+			tokens = append(tokens, token{tokOp, op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{typ: tokEOF, pos: len(r)}), nil
+}
+
+func isIdentRune(c rune) bool {
+	return c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+// lexString reads a quoted string, it returns the value and the number of runes read
+func lexString(r []rune) (string, int, error) {
+	quote := r[0]
+	var b bytes.Buffer
+	for i := 1; i < len(r); i++ {
+		c := r[i]
+		switch {
+		case c == quote:
+			return b.String(), i + 1, nil
+		case c == '\\' && i+1 < len(r):
+			i++
+			switch r[i] {
+			case 'n':
+				b.WriteRune('\n')
+			case 'r':
+				b.WriteRune('\r')
+			case 't':
+				b.WriteRune('\t')
+			default:
+				b.WriteRune(r[i])
+			}
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
@@ -0,0 +1,492 @@
+// Package memory is an embedded db driver which keeps the databases in memory, it's used to run tests without
+// any database server.
+//
+// Documents follow the arangodb semantics: they have a _key, _id and _rev, edge collections link the _from and _to
+// vertices and the collections of common.DbHealum are created by Bootstrap. RunQuery interprets the subset of AQL
+// used by the services: FOR over collections, arrays and graph traversals (OUTBOUND, INBOUND and ANY with a depth
+// range), FILTER, LET, SORT, LIMIT, COLLECT (INTO, WITH COUNT INTO and AGGREGATE), RETURN [DISTINCT], subqueries,
+// INSERT, UPDATE, REPLACE, REMOVE and UPSERT with NEW and OLD, bind variables, the [*] and [**] operators,
+// ANY/ALL/NONE array comparisons and the functions of functions.go. Queries run atomically, a failed query leaves
+// the documents unchanged.
+package memory
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/registry"
+)
+
+const DriverName = "memory"
+
+// ErrInvalidQuery is returned by RunQuery for queries which can't be parsed
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Driver creates connections to its databases, the connections of a driver share the documents
+type Driver struct {
+	store *store
+}
+
+type memoryDB struct {
+	store *store
+	name  string
+	table string
+}
+
+// record is a query result, data is returned as json in parameter3 like the arangodb driver does
+type record struct {
+	Id         string            `json:"id,omitempty"`
+	Created    int64             `json:"created,omitempty"`
+	Updated    int64             `json:"updated,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Parameter1 string            `json:"parameter1,omitempty"`
+	Parameter2 string            `json:"parameter2,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Data       interface{}       `json:"data,omitempty"`
+	DeletedAt  int64             `json:"deleted_at,omitempty"`
+	DeletedBy  string            `json:"deleted_by,omitempty"`
+	Rev        string            `json:"_rev,omitempty"`
+}
+
+func init() {
+	db.Drivers[DriverName] = NewDriver()
+}
+
+func NewDriver() *Driver {
+	return &Driver{store: newStore()}
+}
+
+// NewDB returns a connection, the node is ignored as the databases are in memory
+func (d *Driver) NewDB(nodes ...*registry.Node) (db.DB, error) {
+	return &memoryDB{store: d.store}, nil
+}
+
+// Reset removes the documents of every collection, the databases and collections are kept
+func (d *Driver) Reset() {
+	d.store.Lock()
+	defer d.store.Unlock()
+	for _, database := range d.store.databases {
+		for name, c := range database.collections {
+			database.collections[name] = newCollection(name, c.edge)
+		}
+	}
+}
+
+// Init selects the database and creates the table if it doesn't exist
+func (d *memoryDB) Init(database *mdb.Database) error {
+	d.name = database.Name
+	d.table = database.Table
+	if len(d.table) == 0 {
+		return nil
+	}
+	d.store.Lock()
+	defer d.store.Unlock()
+	cols := d.store.database(d.name).collections
+	if _, ok := cols[d.table]; !ok {
+		_, edge := database.Metadata[common.GraphFlag]
+		cols[d.table] = newCollection(d.table, edge)
+	}
+	return nil
+}
+
+// Bootstrap creates the collections and edge collections of the healum database
+func (d *memoryDB) Bootstrap(database *mdb.Database) error {
+	d.store.Lock()
+	defer d.store.Unlock()
+	cols := d.store.database(database.Name).collections
+	create := func(name string, edge bool) {
+		if _, ok := cols[name]; !ok {
+			cols[name] = newCollection(name, edge)
+		}
+	}
+	for _, c := range common.DbHealum {
+		switch len(c) {
+		case 1:
+			create(c[0], false)
+		case 4:
+			create(c[0], true)
+			create(c[2], false)
+			create(c[3], false)
+		}
+	}
+	return nil
+}
+
+func (d *memoryDB) Ping() error {
+	return nil
+}
+
+func (d *memoryDB) Close() error {
+	return nil
+}
+
+// collection returns the table of the connection
+func (d *memoryDB) collection() (*collection, error) {
+	return d.store.begin(d.name).collection(d.table)
+}
+
+func recordToDocument(r *mdb.Record) (map[string]interface{}, error) {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, err
+	}
+	if len(r.Id) > 0 {
+		doc["_key"] = r.Id
+	}
+	// the revision is kept in _rev
+	delete(doc, "revision")
+	return doc, nil
+}
+
+func documentToRecord(doc map[string]interface{}) (*mdb.Record, error) {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	r := &mdb.Record{}
+	if err := json.Unmarshal(body, r); err != nil {
+		return nil, err
+	}
+	r.Revision, _ = doc["_rev"].(string)
+	return r, nil
+}
+
+// Read returns a record by id, the extra id must match parameter3 if it's set
+func (d *memoryDB) Read(id, extraId string) (*mdb.Record, error) {
+	d.store.Lock()
+	defer d.store.Unlock()
+	c, err := d.collection()
+	if err != nil {
+		return nil, err
+	}
+	doc, ok := c.docs[id]
+	if !ok || len(extraId) > 0 && doc["parameter3"] != extraId {
+		return nil, db.ErrNotFound
+	}
+	return documentToRecord(doc)
+}
+
+func (d *memoryDB) Create(r *mdb.Record) error {
+	if r.Created == 0 {
+		r.Created = time.Now().Unix()
+	}
+	r.Updated = time.Now().Unix()
+	doc, err := recordToDocument(r)
+	if err != nil {
+		return err
+	}
+
+	d.store.Lock()
+	defer d.store.Unlock()
+	c, err := d.collection()
+	if err != nil {
+		return err
+	}
+	t := d.store.begin(d.name)
+	doc, err = t.insert(c, doc)
+	if err != nil {
+		return err
+	}
+	if len(r.Id) == 0 {
+		r.Id = doc["_key"].(string)
+		c.docs[r.Id]["id"] = r.Id
+	}
+	return nil
+}
+
+// Update replaces a record, it returns db.ErrNotFound if it doesn't exist
+func (d *memoryDB) Update(r *mdb.Record) error {
+	if r.Created == 0 {
+		r.Created = time.Now().Unix()
+	}
+	r.Updated = time.Now().Unix()
+	doc, err := recordToDocument(r)
+	if err != nil {
+		return err
+	}
+
+	d.store.Lock()
+	defer d.store.Unlock()
+	c, err := d.collection()
+	if err != nil {
+		return err
+	}
+	old, ok := c.docs[r.Id]
+	if !ok {
+		return db.ErrNotFound
+	}
+	d.store.begin(d.name).replace(c, old, doc)
+	return nil
+}
+
+// UpdateIfMatch replaces a record if its _rev is the expected revision, the record gets its new revision
+func (d *memoryDB) UpdateIfMatch(r *mdb.Record, revision string) error {
+	if r.Created == 0 {
+		r.Created = time.Now().Unix()
+	}
+	r.Updated = time.Now().Unix()
+	doc, err := recordToDocument(r)
+	if err != nil {
+		return err
+	}
+
+	d.store.Lock()
+	defer d.store.Unlock()
+	c, err := d.collection()
+	if err != nil {
+		return err
+	}
+	old, ok := c.docs[r.Id]
+	if !ok {
+		return db.ErrNotFound
+	}
+	if old["_rev"] != revision {
+		return db.ErrConflict
+	}
+	doc = d.store.begin(d.name).replace(c, old, doc)
+	r.Revision = doc["_rev"].(string)
+	return nil
+}
+
+// DeleteIfMatch removes a record if its _rev is the expected revision
+func (d *memoryDB) DeleteIfMatch(id, extraId, revision string) error {
+	d.store.Lock()
+	defer d.store.Unlock()
+	c, err := d.collection()
+	if err != nil {
+		return err
+	}
+	doc, ok := c.docs[id]
+	if !ok || len(extraId) > 0 && doc["parameter3"] != extraId {
+		return db.ErrNotFound
+	}
+	if doc["_rev"] != revision {
+		return db.ErrConflict
+	}
+	d.store.begin(d.name).remove(c, id)
+	return nil
+}
+
+func (d *memoryDB) Delete(id, extraId string) error {
+	d.store.Lock()
+	defer d.store.Unlock()
+	c, err := d.collection()
+	if err != nil {
+		return err
+	}
+	doc, ok := c.docs[id]
+	if !ok || len(extraId) > 0 && doc["parameter3"] != extraId {
+		return db.ErrNotFound
+	}
+	d.store.begin(d.name).remove(c, id)
+	return nil
+}
+
+// Search filters the records by name, parameter1, parameter2 and metadata in the created interval,
+// they are ordered by created time
+func (d *memoryDB) Search(md map[string]string, from, to, limit, offset int64, reverse bool) ([]*mdb.Record, error) {
+	// if from and to are not set, they are maximum values to filter properly
+	if to == 0 {
+		to = math.MaxInt32
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	d.store.Lock()
+	defer d.store.Unlock()
+	c, err := d.collection()
+	if err != nil {
+		return nil, err
+	}
+	records := []*mdb.Record{}
+	for _, doc := range c.all() {
+		r, err := documentToRecord(doc)
+		if err != nil {
+			return nil, err
+		}
+		if r.Created < from || r.Created > to || !matchRecord(r, md) {
+			continue
+		}
+		records = append(records, r)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		if reverse {
+			return records[i].Created > records[j].Created
+		}
+		return records[i].Created < records[j].Created
+	})
+
+	if offset >= int64(len(records)) {
+		return []*mdb.Record{}, nil
+	}
+	records = records[offset:]
+	if limit < int64(len(records)) {
+		records = records[:limit]
+	}
+	return records, nil
+}
+
+func matchRecord(r *mdb.Record, md map[string]string) bool {
+	for k, v := range md {
+		switch k {
+		case "name":
+			if r.Name != v {
+				return false
+			}
+		case "parameter1":
+			if r.Parameter1 != v {
+				return false
+			}
+		case "parameter2":
+			if r.Parameter2 != v {
+				return false
+			}
+		default:
+			if r.Metadata[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// query parses and runs a query in the transaction of the executor, bind variables must be normalized
+func (e *executor) query(query string) ([]*mdb.Record, error) {
+	q, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+	results, err := e.run(q, &scope{})
+	if err != nil {
+		return nil, err
+	}
+	return toRecords(results)
+}
+
+func (d *memoryDB) RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
+	vars, err := normalizeBindVars(bindVars)
+	if err != nil {
+		return nil, err
+	}
+
+	d.store.Lock()
+	defer d.store.Unlock()
+	e := &executor{tx: d.store.begin(d.name), bindVars: vars}
+	records, err := e.query(query)
+	if err != nil {
+		e.tx.rollback()
+		common.ErrorLog(common.DbSrv, d.RunQuery, err, "RunQuery is failed")
+		return nil, err
+	}
+	return records, nil
+}
+
+// Transaction runs the operations in order, they are all rolled back if one fails
+func (d *memoryDB) Transaction(tx *db.Tx) ([][]*mdb.Record, error) {
+	if len(tx.Action) > 0 {
+		return nil, db.ErrTransactionActionNotSupported
+	}
+	vars := make([]map[string]interface{}, len(tx.Operations))
+	for i, op := range tx.Operations {
+		v, err := normalizeBindVars(op.BindVars)
+		if err != nil {
+			return nil, err
+		}
+		vars[i] = v
+	}
+
+	d.store.Lock()
+	defer d.store.Unlock()
+	t := d.store.begin(d.name)
+	results := [][]*mdb.Record{}
+	for i, op := range tx.Operations {
+		e := &executor{tx: t, bindVars: vars[i]}
+		records, err := e.query(op.Query)
+		if err != nil {
+			t.rollback()
+			return nil, err
+		}
+		results = append(results, records)
+	}
+	return results, nil
+}
+
+func normalizeBindVars(bindVars map[string]interface{}) (map[string]interface{}, error) {
+	vars := map[string]interface{}{}
+	for k, v := range bindVars {
+		n, err := normalize(v)
+		if err != nil {
+			return nil, err
+		}
+		vars[k] = n
+	}
+	return vars, nil
+}
+
+// toRecords converts the results the way the arangodb driver does, they must be objects
+func toRecords(results []interface{}) ([]*mdb.Record, error) {
+	records := []*mdb.Record{}
+	for _, v := range results {
+		if v == nil {
+			continue
+		}
+		body, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		r := &record{}
+		if err := json.Unmarshal(body, r); err != nil {
+			return nil, err
+		}
+		node := &mdb.Record{
+			Id:         r.Id,
+			Created:    r.Created,
+			Updated:    r.Updated,
+			Name:       r.Name,
+			Parameter1: r.Parameter1,
+			Parameter2: r.Parameter2,
+			Metadata:   r.Metadata,
+			DeletedAt:  r.DeletedAt,
+			DeletedBy:  r.DeletedBy,
+			Revision:   r.Rev,
+		}
+		if r.Data != nil {
+			body, err := json.Marshal(r.Data)
+			if err != nil {
+				return nil, err
+			}
+			node.Parameter3 = string(body)
+		}
+		records = append(records, node)
+	}
+	return records, nil
+}
+
+func (d *memoryDB) CreateDatabase(name string) error {
+	d.store.Lock()
+	defer d.store.Unlock()
+	d.store.database(name)
+	return nil
+}
+
+func (d *memoryDB) DeleteDatabase(name string) error {
+	d.store.Lock()
+	defer d.store.Unlock()
+	delete(d.store.databases, name)
+	return nil
+}
@@ -0,0 +1,368 @@
+package memory
+
+import (
+	"encoding/json"
+	"testing"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+func newTestDB(t *testing.T) *memoryDB {
+	d, err := NewDriver().NewDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := d.(*memoryDB)
+	database := &mdb.Database{Name: common.TestingName(common.DbHealumName), Driver: DriverName}
+	if err := m.Bootstrap(database); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Init(database); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func runQuery(t *testing.T, m *memoryDB, q string, bindVars map[string]interface{}) []*mdb.Record {
+	records, err := m.RunQuery(q, bindVars)
+	if err != nil {
+		t.Fatalf("%s: %v", q, err)
+	}
+	return records
+}
+
+func data(t *testing.T, r *mdb.Record) map[string]interface{} {
+	d := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(r.Parameter3), &d); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestRecords(t *testing.T) {
+	m := newTestDB(t)
+	if err := m.Init(&mdb.Database{Name: m.name, Table: "records"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []*mdb.Record{
+		{Id: "1", Name: "run", Created: 10, Parameter3: "org1"},
+		{Id: "2", Name: "walk", Created: 20, Parameter3: "org1"},
+		{Id: "3", Name: "run", Created: 30, Parameter3: "org2"},
+	} {
+		if err := m.Create(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Create(&mdb.Record{Id: "1"}); err == nil {
+		t.Error("Duplicated id must fail")
+	}
+
+	if r, err := m.Read("2", "org1"); err != nil || r.Name != "walk" {
+		t.Errorf("Read is invalid: %v %v", r, err)
+	}
+	if _, err := m.Read("2", "org2"); err != db.ErrNotFound {
+		t.Errorf("Read of another org must not be found: %v", err)
+	}
+
+	records, err := m.Search(map[string]string{"name": "run"}, 0, 0, 10, 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Id != "3" || records[1].Id != "1" {
+		t.Errorf("Search is invalid: %v", records)
+	}
+	records, err = m.Search(nil, 0, 0, 1, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Id != "2" {
+		t.Errorf("Search with offset is invalid: %v", records)
+	}
+
+	if err := m.Update(&mdb.Record{Id: "2", Name: "swim"}); err != nil {
+		t.Fatal(err)
+	}
+	if r, _ := m.Read("2", ""); r == nil || r.Name != "swim" {
+		t.Errorf("Update is invalid: %v", r)
+	}
+	if err := m.Delete("2", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Delete("2", ""); err != db.ErrNotFound {
+		t.Errorf("Deleted record must not be found: %v", err)
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	m := newTestDB(t)
+	q := `INSERT {_key: @id, created: 1, updated: 1, name: @name, data: {title: @name, tags: ["a"]}}
+		IN @@collection
+		OPTIONS {overwrite: true}
+		RETURN NEW`
+	records := runQuery(t, m, q, map[string]interface{}{"id": "g1", "name": "goal", "@collection": "goal"})
+	if len(records) != 1 || records[0].Name != "goal" || data(t, records[0])["title"] != "goal" {
+		t.Fatalf("Insert is invalid: %v", records)
+	}
+
+	q = `UPSERT {_key: @id}
+		INSERT {_key: @id, name: "new"}
+		UPDATE {name: "updated", data: MERGE(OLD.data, {count: 1})} IN goal
+		RETURN {id: NEW._key, name: NEW.name, data: NEW.data, old: OLD}`
+	records = runQuery(t, m, q, map[string]interface{}{"id": "g1"})
+	if len(records) != 1 || records[0].Name != "updated" {
+		t.Fatalf("Upsert update is invalid: %v", records)
+	}
+	d := data(t, records[0])
+	if d["title"] != "goal" || d["count"] != float64(1) {
+		t.Errorf("Upsert data is invalid: %v", d)
+	}
+	records = runQuery(t, m, q, map[string]interface{}{"id": "g2"})
+	if len(records) != 1 || records[0].Name != "new" {
+		t.Errorf("Upsert insert is invalid: %v", records)
+	}
+
+	q = `FOR doc IN goal
+		FILTER doc._key == @id
+		UPDATE doc WITH {name: null, data: {tags: APPEND(doc.data.tags, "b")}} IN goal OPTIONS {keepNull: false}
+		RETURN NEW`
+	records = runQuery(t, m, q, map[string]interface{}{"id": "g1"})
+	if len(records) != 1 || records[0].Name != "" {
+		t.Fatalf("Update is invalid: %v", records)
+	}
+	if tags := data(t, records[0])["tags"].([]interface{}); len(tags) != 2 {
+		t.Errorf("Update must merge the data: %v", tags)
+	}
+
+	records = runQuery(t, m, `FOR doc IN goal REMOVE doc IN goal RETURN OLD`, nil)
+	if len(records) != 2 || len(runQuery(t, m, `FOR doc IN goal RETURN doc`, nil)) != 0 {
+		t.Errorf("Remove is invalid: %v", records)
+	}
+}
+
+func TestRevisions(t *testing.T) {
+	m := newTestDB(t)
+	if err := m.Init(&mdb.Database{Name: m.name, Table: "records"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Create(&mdb.Record{Id: "1", Name: "run"}); err != nil {
+		t.Fatal(err)
+	}
+	r, err := m.Read("1", "")
+	if err != nil || len(r.Revision) == 0 {
+		t.Fatalf("Read must return the revision: %v %v", r, err)
+	}
+	rev := r.Revision
+
+	r.Name = "walk"
+	if err := m.UpdateIfMatch(r, rev); err != nil {
+		t.Fatal(err)
+	}
+	if r.Revision == rev {
+		t.Error("Update must change the revision")
+	}
+	if err := m.UpdateIfMatch(&mdb.Record{Id: "1", Name: "swim"}, rev); err != db.ErrConflict {
+		t.Errorf("Update of an outdated revision must conflict: %v", err)
+	}
+	if err := m.DeleteIfMatch("1", "", rev); err != db.ErrConflict {
+		t.Errorf("Delete of an outdated revision must conflict: %v", err)
+	}
+	if err := m.DeleteIfMatch("1", "", r.Revision); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.UpdateIfMatch(r, r.Revision); err != db.ErrNotFound {
+		t.Errorf("Update of a deleted record must not be found: %v", err)
+	}
+
+	runQuery(t, m, `INSERT {_key: "g1", name: "goal"} INTO goal`, nil)
+	records := runQuery(t, m, `FOR doc IN goal RETURN doc`, nil)
+	if len(records) != 1 || len(records[0].Revision) == 0 {
+		t.Fatalf("Query must return the revision: %v", records)
+	}
+	q := `FOR doc IN goal
+		REPLACE MERGE(doc, {name: @name, _rev: @rev}) IN goal OPTIONS {ignoreRevs: false}
+		RETURN NEW`
+	if _, err := m.RunQuery(q, map[string]interface{}{"name": "a", "rev": "0"}); err != db.ErrConflict {
+		t.Errorf("Replace of an outdated revision must conflict: %v", err)
+	}
+	records = runQuery(t, m, q, map[string]interface{}{"name": "a", "rev": records[0].Revision})
+	if len(records) != 1 || records[0].Name != "a" {
+		t.Errorf("Replace of the revision is invalid: %v", records)
+	}
+	// revisions are ignored by default
+	runQuery(t, m, `UPDATE {_key: "g1", _rev: "0", name: "b"} IN goal`, nil)
+}
+
+func TestCollect(t *testing.T) {
+	m := newTestDB(t)
+	runQuery(t, m, `FOR i IN 1..6
+		INSERT {_key: TO_STRING(i), created: i, parameter1: i % 2 == 0 ? "even" : "odd"} IN todo`, nil)
+
+	q := `FOR doc IN todo
+		COLLECT kind = doc.parameter1 INTO g
+		SORT kind
+		RETURN {name: kind, data: {count: LENGTH(g), created: g[*].doc.created}}`
+	records := runQuery(t, m, q, nil)
+	if len(records) != 2 || records[0].Name != "even" || records[1].Name != "odd" {
+		t.Fatalf("Collect is invalid: %v", records)
+	}
+	if d := data(t, records[0]); d["count"] != float64(3) || len(d["created"].([]interface{})) != 3 {
+		t.Errorf("Collect groups are invalid: %v", d)
+	}
+
+	q = `FOR doc IN todo
+		FILTER doc.created > @from
+		COLLECT AGGREGATE minDate = MIN(doc.created), maxDate = MAX(doc.created)
+		RETURN {data: { minDate, maxDate }}`
+	records = runQuery(t, m, q, map[string]interface{}{"from": 2})
+	if d := data(t, records[0]); d["minDate"] != float64(3) || d["maxDate"] != float64(6) {
+		t.Errorf("Aggregate is invalid: %v", d)
+	}
+
+	q = `FOR doc IN todo COLLECT WITH COUNT INTO length RETURN {data: {length: length}}`
+	if d := data(t, runQuery(t, m, q, nil)[0]); d["length"] != float64(6) {
+		t.Errorf("Count is invalid: %v", d)
+	}
+
+	q = `FOR doc IN todo SORT doc.created DESC LIMIT 1, 2 RETURN DISTINCT doc`
+	records = runQuery(t, m, q, nil)
+	if len(records) != 2 || records[0].Created != 5 || records[1].Created != 4 {
+		t.Errorf("Sort and limit are invalid: %v", records)
+	}
+}
+
+func TestTraversal(t *testing.T) {
+	m := newTestDB(t)
+	runQuery(t, m, `
+		LET users = (FOR k IN ["u1", "u2", "u3"] INSERT {_key: k, name: k} IN user RETURN NEW)
+		INSERT {_key: "t1", name: "team"} IN team
+		INSERT {_from: "team/t1", _to: "user/u1"} IN team_membership
+		INSERT {_from: "team/t1", _to: "user/u2"} IN team_membership`, nil)
+
+	q := `FOR v, e IN OUTBOUND @team team_membership
+		OPTIONS {bfs: true, uniqueVertices: "global"}
+		SORT v.name
+		RETURN {id: v._key, name: v.name, parameter1: e._from}`
+	records := runQuery(t, m, q, map[string]interface{}{"team": "team/t1"})
+	if len(records) != 2 || records[0].Id != "u1" || records[1].Parameter1 != "team/t1" {
+		t.Errorf("Outbound is invalid: %v", records)
+	}
+
+	q = `FOR u IN user
+		FILTER u._key == "u2"
+		FOR v IN 1..1 INBOUND u team_membership
+		RETURN v`
+	if records := runQuery(t, m, q, nil); len(records) != 1 || records[0].Name != "team" {
+		t.Errorf("Inbound is invalid: %v", records)
+	}
+
+	q = `FOR v IN 0..2 ANY "user/u1" team_membership RETURN v._key`
+	if _, err := m.RunQuery(q, nil); err == nil {
+		t.Error("Strings must not be returned as records")
+	}
+	q = `FOR v IN 0..2 ANY "user/u1" team_membership SORT v._key RETURN {id: v._key}`
+	if records := runQuery(t, m, q, nil); len(records) != 3 {
+		t.Errorf("Any is invalid: %v", records)
+	}
+
+	if _, err := m.RunQuery(`FOR v IN OUTBOUND "team/t1" user RETURN v`, nil); err == nil {
+		t.Error("Traversal of a document collection must fail")
+	}
+}
+
+func TestExpressions(t *testing.T) {
+	m := newTestDB(t)
+	runQuery(t, m, `
+		FOR p IN [
+			{_key: "p1", name: "Morning run", data: {tags: [{name: "run"}, {name: "health"}], items: [[1, 2], [3]]}},
+			{_key: "p2", name: "Evening walk", data: {tags: [{name: "walk"}], items: []}}
+		]
+		INSERT p IN plan`, nil)
+
+	q := `FOR p IN plan
+		FILTER @tags ANY IN p.data.tags[*].name
+		FILTER LIKE(p.name, @name, true) && p.name NOT IN @excluded
+		LET default = FIRST(p.data.tags)
+		RETURN {
+			id: p._key,
+			name: CONCAT_SEPARATOR(" ", p.data.tags[*].name),
+			parameter1: default.name,
+			data: {items: p.data.items[**], total: SUM(p.data.items[**]), "quoted key": CONTAINS(p.name, "run")}
+		}`
+	records := runQuery(t, m, q, map[string]interface{}{
+		"tags":     []string{"run", "swim"},
+		"name":     "%RUN",
+		"excluded": []string{"Evening walk"},
+	})
+	if len(records) != 1 || records[0].Id != "p1" || records[0].Name != "run health" || records[0].Parameter1 != "run" {
+		t.Fatalf("Filters are invalid: %v", records)
+	}
+	d := data(t, records[0])
+	if len(d["items"].([]interface{})) != 3 || d["total"] != float64(6) || d["quoted key"] != true {
+		t.Errorf("Expressions are invalid: %v", d)
+	}
+
+	q = `LET names = (FOR p IN plan FILTER "walk" IN p.data.tags[*].name RETURN p.name)
+		RETURN {name: names[0], data: {ids: INTERSECTION(["p1", "p2"], (FOR p IN plan RETURN p._key))}}`
+	records = runQuery(t, m, q, nil)
+	if len(records) != 1 || records[0].Name != "Evening walk" {
+		t.Errorf("Subqueries are invalid: %v", records)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	m := newTestDB(t)
+	runQuery(t, m, `INSERT {_key: "n1", name: "note"} IN note`, nil)
+
+	if _, err := m.RunQuery(`
+		UPDATE "n1" WITH {name: "changed"} IN note
+		INSERT {_key: "n2"} IN note
+		INSERT {_key: "n1"} IN note`, nil); err == nil {
+		t.Fatal("Unique constraint must fail")
+	}
+	records := runQuery(t, m, `FOR n IN note RETURN n`, nil)
+	if len(records) != 1 || records[0].Name != "note" {
+		t.Errorf("Failed query must be rolled back: %v", records)
+	}
+
+	_, err := m.Transaction(&db.Tx{Operations: []*db.Operation{
+		{Query: `REMOVE "n1" IN note`},
+		{Query: `FOR n IN @@collection RETURN n`, BindVars: map[string]interface{}{"@collection": "missing"}},
+	}})
+	if err == nil {
+		t.Fatal("Missing collection must fail")
+	}
+	if records := runQuery(t, m, `FOR n IN note RETURN n`, nil); len(records) != 1 {
+		t.Errorf("Failed transaction must be rolled back: %v", records)
+	}
+
+	results, err := m.Transaction(&db.Tx{Operations: []*db.Operation{
+		{Query: `INSERT {_key: "n2", name: "second"} IN note RETURN NEW`},
+		{Query: `FOR n IN note SORT n._key RETURN n`},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || len(results[1]) != 2 {
+		t.Errorf("Transaction results are invalid: %v", results)
+	}
+}
+
+func TestInvalidQueries(t *testing.T) {
+	m := newTestDB(t)
+	queries := []string{
+		`FOR doc IN`,
+		`FOR doc IN note RETURN`,
+		`RETURN UNKNOWN_FUNCTION(1)`,
+		`RETURN {a: 1`,
+		`RETURN "unterminated`,
+		`RETURN @missing`,
+		`RETURN undefined`,
+	}
+	for _, q := range queries {
+		if _, err := m.RunQuery(q, nil); err == nil {
+			t.Errorf("%q must be invalid", q)
+		}
+	}
+}
@@ -0,0 +1,1078 @@
+package memory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// query is a list of operations run in order, every operation maps the rows of the previous one
+type query struct {
+	ops []operation
+}
+
+type operation interface{}
+
+type forOp struct {
+	vars []string
+	// FOR v IN expr
+	in expr
+	// FOR v, e, p IN min..max OUTBOUND start edges
+	traversal *traversal
+}
+
+type traversal struct {
+	min, max  expr
+	direction string
+	start     expr
+	edges     []expr
+	options   expr
+}
+
+type filterOp struct {
+	cond expr
+}
+
+type letOp struct {
+	name  string
+	value expr
+}
+
+type sortKey struct {
+	value expr
+	desc  bool
+}
+
+type sortOp struct {
+	keys []sortKey
+}
+
+type limitOp struct {
+	offset, count expr
+}
+
+type assignment struct {
+	name  string
+	value expr
+}
+
+type collectOp struct {
+	groups     []assignment
+	aggregates []assignment
+	// INTO name [= expr]
+	into     string
+	intoExpr expr
+	// WITH COUNT INTO name
+	count string
+}
+
+type returnOp struct {
+	distinct bool
+	value    expr
+}
+
+type insertOp struct {
+	doc        expr
+	collection expr
+	options    expr
+}
+
+// updateOp is UPDATE and REPLACE, key is the document or its key when with is set
+type updateOp struct {
+	replace    bool
+	key        expr
+	with       expr
+	collection expr
+	options    expr
+}
+
+type removeOp struct {
+	key        expr
+	collection expr
+	options    expr
+}
+
+type upsertOp struct {
+	search     expr
+	insert     expr
+	update     expr
+	replace    bool
+	collection expr
+	options    expr
+}
+
+// expressions
+
+type expr interface{}
+
+type literal struct {
+	value interface{}
+}
+
+type bindVar struct {
+	name string
+}
+
+// name is a variable or a collection
+type name struct {
+	name string
+}
+
+type attribute struct {
+	value expr
+	name  string
+}
+
+type index struct {
+	value, index expr
+}
+
+// expansion applies then to every element of value, [*] or [**] when flatten is set
+type expansion struct {
+	value   expr
+	flatten bool
+	then    expr
+}
+
+// current is the element of an expansion
+type current struct{}
+
+type call struct {
+	function string
+	args     []expr
+}
+
+type unary struct {
+	op    string
+	value expr
+}
+
+type binary struct {
+	op          string
+	left, right expr
+	// ANY, ALL or NONE array comparison
+	quantifier string
+}
+
+type ternary struct {
+	cond, then, otherwise expr
+}
+
+type objectField struct {
+	key      string
+	computed expr
+	value    expr
+}
+
+type object struct {
+	fields []objectField
+}
+
+type array struct {
+	values []expr
+}
+
+type subquery struct {
+	query *query
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	// IN ends the document of UPDATE, REPLACE and REMOVE rather than being an operator
+	noIn bool
+}
+
+// keywords which end an expression
+var keywords = map[string]bool{
+	"FOR": true, "RETURN": true, "FILTER": true, "SORT": true, "LIMIT": true, "LET": true, "COLLECT": true,
+	"INSERT": true, "UPDATE": true, "REPLACE": true, "REMOVE": true, "UPSERT": true, "WITH": true,
+	"INTO": true, "IN": true, "ASC": true, "DESC": true,
+	"OUTBOUND": true, "INBOUND": true, "ANY": true, "ALL": true, "NONE": true, "DISTINCT": true,
+	"AND": true, "OR": true, "NOT": true, "LIKE": true,
+}
+
+func parse(q string) (*query, error) {
+	tokens, err := lex(q)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	// WITH lists the collections of a traversal, it's only needed by clusters
+	if p.peek().is("WITH") {
+		p.next()
+		for {
+			p.next()
+			if !p.peek().op(",") {
+				break
+			}
+			p.next()
+		}
+	}
+	qr, err := p.parseQuery()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().typ != tokEOF {
+		return nil, p.unexpected()
+	}
+	return qr, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekAt(n int) token {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) unexpected() error {
+	return fmt.Errorf("%v: unexpected %v", ErrInvalidQuery, p.peek())
+}
+
+func (p *parser) expectOp(op string) error {
+	if !p.peek().op(op) {
+		return fmt.Errorf("%v: expected %q, got %v", ErrInvalidQuery, op, p.peek())
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.peek().is(keyword) {
+		return fmt.Errorf("%v: expected %s, got %v", ErrInvalidQuery, keyword, p.peek())
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.peek()
+	if t.typ != tokIdent {
+		return "", fmt.Errorf("%v: expected a name, got %v", ErrInvalidQuery, t)
+	}
+	p.next()
+	return t.val, nil
+}
+
+// parseQuery reads operations until the end of the query or of a subquery
+func (p *parser) parseQuery() (*query, error) {
+	q := &query{}
+	for {
+		t := p.peek()
+		if t.typ == tokEOF || t.op(")") {
+			break
+		}
+		if t.typ != tokIdent {
+			return nil, p.unexpected()
+		}
+		var op operation
+		var err error
+		switch strings.ToUpper(t.val) {
+		case "FOR":
+			op, err = p.parseFor()
+		case "FILTER":
+			p.next()
+			var cond expr
+			cond, err = p.parseExpr()
+			op = &filterOp{cond: cond}
+		case "LET":
+			op, err = p.parseLet()
+		case "SORT":
+			op, err = p.parseSort()
+		case "LIMIT":
+			op, err = p.parseLimit()
+		case "COLLECT":
+			op, err = p.parseCollect()
+		case "RETURN":
+			p.next()
+			r := &returnOp{}
+			if p.peek().is("DISTINCT") {
+				p.next()
+				r.distinct = true
+			}
+			r.value, err = p.parseExpr()
+			op = r
+		case "INSERT":
+			op, err = p.parseInsert()
+		case "UPDATE", "REPLACE":
+			op, err = p.parseUpdate()
+		case "REMOVE":
+			op, err = p.parseRemove()
+		case "UPSERT":
+			op, err = p.parseUpsert()
+		default:
+			return nil, p.unexpected()
+		}
+		if err != nil {
+			return nil, err
+		}
+		q.ops = append(q.ops, op)
+	}
+	if len(q.ops) == 0 {
+		return nil, fmt.Errorf("%v: empty query", ErrInvalidQuery)
+	}
+	return q, nil
+}
+
+func (p *parser) parseFor() (operation, error) {
+	p.next()
+	f := &forOp{}
+	for {
+		v, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		f.vars = append(f.vars, v)
+		if !p.peek().op(",") {
+			break
+		}
+		p.next()
+	}
+	if err := p.expectKeyword("IN"); err != nil {
+		return nil, err
+	}
+
+	if t := p.isTraversal(); t {
+		tr, err := p.parseTraversal()
+		if err != nil {
+			return nil, err
+		}
+		f.traversal = tr
+		return f, nil
+	}
+	if len(f.vars) > 1 {
+		return nil, fmt.Errorf("%v: FOR over a collection has one variable", ErrInvalidQuery)
+	}
+	in, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	f.in = in
+	// OPTIONS of a collection loop are index hints
+	if p.peek().is("OPTIONS") {
+		p.next()
+		if _, err := p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// isTraversal looks ahead for the direction, after an optional depth range
+func (p *parser) isTraversal() bool {
+	for n := 0; n < 4; n++ {
+		t := p.peekAt(n)
+		if t.is("OUTBOUND") || t.is("INBOUND") || t.is("ANY") {
+			return true
+		}
+		if !(t.typ == tokNumber || t.typ == tokBindVar || t.op("..")) {
+			return false
+		}
+	}
+	return false
+}
+
+func (p *parser) parseTraversal() (*traversal, error) {
+	tr := &traversal{min: &literal{float64(1)}, max: &literal{float64(1)}}
+	if !(p.peek().is("OUTBOUND") || p.peek().is("INBOUND") || p.peek().is("ANY")) {
+		min, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		tr.min, tr.max = min, min
+		if p.peek().op("..") {
+			p.next()
+			if tr.max, err = p.parsePrimary(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	tr.direction = strings.ToUpper(p.next().val)
+	start, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	tr.start = start
+	for {
+		t := p.peek()
+		var edge expr
+		switch t.typ {
+		case tokIdent:
+			p.next()
+			edge = &literal{t.val}
+		case tokBindVar:
+			p.next()
+			edge = &bindVar{t.val}
+		default:
+			return nil, p.unexpected()
+		}
+		tr.edges = append(tr.edges, edge)
+		if !p.peek().op(",") {
+			break
+		}
+		p.next()
+	}
+	if p.peek().is("OPTIONS") {
+		p.next()
+		if tr.options, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	return tr, nil
+}
+
+func (p *parser) parseLet() (operation, error) {
+	p.next()
+	n, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectOp("="); err != nil {
+		return nil, err
+	}
+	v, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &letOp{name: n, value: v}, nil
+}
+
+func (p *parser) parseSort() (operation, error) {
+	p.next()
+	s := &sortOp{}
+	for {
+		v, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		k := sortKey{value: v}
+		if p.peek().is("DESC") {
+			p.next()
+			k.desc = true
+		} else if p.peek().is("ASC") {
+			p.next()
+		}
+		s.keys = append(s.keys, k)
+		if !p.peek().op(",") {
+			break
+		}
+		p.next()
+	}
+	return s, nil
+}
+
+func (p *parser) parseLimit() (operation, error) {
+	p.next()
+	l := &limitOp{offset: &literal{float64(0)}}
+	v, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	l.count = v
+	if p.peek().op(",") {
+		p.next()
+		l.offset = v
+		if l.count, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+func (p *parser) parseAssignments() ([]assignment, error) {
+	list := []assignment{}
+	for {
+		n, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectOp("="); err != nil {
+			return nil, err
+		}
+		v, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, assignment{n, v})
+		if !p.peek().op(",") {
+			return list, nil
+		}
+		p.next()
+	}
+}
+
+func (p *parser) parseCollect() (operation, error) {
+	p.next()
+	c := &collectOp{}
+	var err error
+	if p.peek().typ == tokIdent && p.peekAt(1).op("=") {
+		if c.groups, err = p.parseAssignments(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek().is("AGGREGATE") {
+		p.next()
+		if c.aggregates, err = p.parseAssignments(); err != nil {
+			return nil, err
+		}
+		for _, a := range c.aggregates {
+			if _, ok := a.value.(*call); !ok {
+				return nil, fmt.Errorf("%v: aggregate %s must be a function call", ErrInvalidQuery, a.name)
+			}
+		}
+	}
+	switch {
+	case p.peek().is("WITH"):
+		p.next()
+		if err := p.expectKeyword("COUNT"); err != nil {
+			return nil, err
+		}
+		if err := p.expectKeyword("INTO"); err != nil {
+			return nil, err
+		}
+		if c.count, err = p.ident(); err != nil {
+			return nil, err
+		}
+	case p.peek().is("INTO"):
+		p.next()
+		if c.into, err = p.ident(); err != nil {
+			return nil, err
+		}
+		if p.peek().op("=") {
+			p.next()
+			if c.intoExpr, err = p.parseExpr(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if p.peek().is("OPTIONS") {
+		p.next()
+		if _, err := p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// parseTarget reads IN|INTO collection [OPTIONS {...}]
+func (p *parser) parseTarget() (collection expr, options expr, err error) {
+	if !(p.peek().is("IN") || p.peek().is("INTO")) {
+		return nil, nil, fmt.Errorf("%v: expected IN or INTO, got %v", ErrInvalidQuery, p.peek())
+	}
+	p.next()
+	t := p.next()
+	switch t.typ {
+	case tokIdent:
+		collection = &literal{t.val}
+	case tokBindVar:
+		collection = &bindVar{t.val}
+	default:
+		return nil, nil, fmt.Errorf("%v: expected a collection, got %v", ErrInvalidQuery, t)
+	}
+	if p.peek().is("OPTIONS") {
+		p.next()
+		if options, err = p.parseExpr(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return collection, options, nil
+}
+
+func (p *parser) parseInsert() (operation, error) {
+	p.next()
+	doc, err := p.parseDocument()
+	if err != nil {
+		return nil, err
+	}
+	c, o, err := p.parseTarget()
+	if err != nil {
+		return nil, err
+	}
+	return &insertOp{doc: doc, collection: c, options: o}, nil
+}
+
+func (p *parser) parseUpdate() (operation, error) {
+	u := &updateOp{replace: p.next().is("REPLACE")}
+	var err error
+	if u.key, err = p.parseDocument(); err != nil {
+		return nil, err
+	}
+	if p.peek().is("WITH") {
+		p.next()
+		if u.with, err = p.parseDocument(); err != nil {
+			return nil, err
+		}
+	}
+	if u.collection, u.options, err = p.parseTarget(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (p *parser) parseRemove() (operation, error) {
+	p.next()
+	key, err := p.parseDocument()
+	if err != nil {
+		return nil, err
+	}
+	c, o, err := p.parseTarget()
+	if err != nil {
+		return nil, err
+	}
+	return &removeOp{key: key, collection: c, options: o}, nil
+}
+
+func (p *parser) parseUpsert() (operation, error) {
+	p.next()
+	u := &upsertOp{}
+	var err error
+	if u.search, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("INSERT"); err != nil {
+		return nil, err
+	}
+	if u.insert, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.peek().is("UPDATE"):
+	case p.peek().is("REPLACE"):
+		u.replace = true
+	default:
+		return nil, fmt.Errorf("%v: expected UPDATE or REPLACE, got %v", ErrInvalidQuery, p.peek())
+	}
+	p.next()
+	if u.update, err = p.parseDocument(); err != nil {
+		return nil, err
+	}
+	if u.collection, u.options, err = p.parseTarget(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// parseDocument reads the document of a modification, it's followed by IN
+func (p *parser) parseDocument() (expr, error) {
+	p.noIn = true
+	defer func() { p.noIn = false }()
+	return p.parseExpr()
+}
+
+// nested parses an expression in brackets, where IN is an operator again
+func (p *parser) nested(fn func() (expr, error)) (expr, error) {
+	noIn := p.noIn
+	p.noIn = false
+	defer func() { p.noIn = noIn }()
+	return fn()
+}
+
+// expressions, from the lowest precedence
+
+func (p *parser) parseExpr() (expr, error) {
+	cond, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peek().op("?") {
+		return cond, nil
+	}
+	p.next()
+	t := &ternary{cond: cond}
+	// a ?: b returns a if it's true
+	if !p.peek().op(":") {
+		if t.then, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expectOp(":"); err != nil {
+		return nil, err
+	}
+	if t.otherwise, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().op("||") || p.peek().is("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().op("&&") || p.peek().is("AND") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.peek().op("!") || p.peek().is("NOT") {
+		p.next()
+		v, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unary{op: "!", value: v}, nil
+	}
+	return p.parseComparison()
+}
+
+var comparisons = map[string]bool{
+	"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true, "=~": true, "!~": true,
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	left, err := p.parseRange()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		b := &binary{left: left}
+		t := p.peek()
+		if t.is("ANY") || t.is("ALL") || t.is("NONE") {
+			b.quantifier = strings.ToUpper(t.val)
+			p.next()
+			t = p.peek()
+		}
+		switch {
+		case t.typ == tokOp && comparisons[t.val]:
+			p.next()
+			b.op = t.val
+		case t.is("IN") && !p.noIn:
+			p.next()
+			b.op = "IN"
+		case t.is("LIKE"):
+			p.next()
+			b.op = "LIKE"
+		case t.is("NOT") && (p.peekAt(1).is("IN") && !p.noIn || p.peekAt(1).is("LIKE")):
+			p.next()
+			b.op = "NOT " + strings.ToUpper(p.next().val)
+		default:
+			if len(b.quantifier) > 0 {
+				return nil, p.unexpected()
+			}
+			return left, nil
+		}
+		if b.right, err = p.parseRange(); err != nil {
+			return nil, err
+		}
+		left = b
+	}
+}
+
+func (p *parser) parseRange() (expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().op("..") {
+		p.next()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		return &binary{op: "..", left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parseAdditive() (expr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().op("+") || p.peek().op("-") {
+		op := p.next().val
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseMultiplicative() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().op("*") || p.peek().op("/") || p.peek().op("%") {
+		op := p.next().val
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.peek().op("-") || p.peek().op("+") || p.peek().op("!") {
+		op := p.next().val
+		v, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unary{op: op, value: v}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (expr, error) {
+	v, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	return p.parseAccess(v)
+}
+
+// parseAccess reads the attribute and index accesses of a value
+func (p *parser) parseAccess(v expr) (expr, error) {
+	for {
+		t := p.peek()
+		switch {
+		case t.op("."):
+			p.next()
+			a := p.next()
+			if a.typ != tokIdent && a.typ != tokString {
+				return nil, fmt.Errorf("%v: expected an attribute, got %v", ErrInvalidQuery, a)
+			}
+			v = &attribute{value: v, name: a.val}
+		case t.op("[*]") || t.op("[**]"):
+			p.next()
+			e := &expansion{value: v, flatten: t.val == "[**]"}
+			then, err := p.parseAccess(&current{})
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := then.(*current); !ok {
+				e.then = then
+			}
+			return e, nil
+		case t.op("["):
+			p.next()
+			i, err := p.nested(p.parseExpr)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp("]"); err != nil {
+				return nil, err
+			}
+			v = &index{value: v, index: i}
+		default:
+			return v, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.peek()
+	if t.op("(") || t.op("[") || t.op("{") || t.typ == tokIdent && p.peekAt(1).op("(") {
+		return p.nested(p.primary)
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (expr, error) {
+	t := p.peek()
+	switch t.typ {
+	case tokNumber:
+		p.next()
+		n, err := strconv.ParseFloat(t.val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%v: invalid number %v", ErrInvalidQuery, t)
+		}
+		return &literal{n}, nil
+	case tokString:
+		p.next()
+		return &literal{t.val}, nil
+	case tokBindVar:
+		p.next()
+		return &bindVar{t.val}, nil
+	case tokIdent:
+		p.next()
+		switch strings.ToUpper(t.val) {
+		case "NULL":
+			return &literal{nil}, nil
+		case "TRUE":
+			return &literal{true}, nil
+		case "FALSE":
+			return &literal{false}, nil
+		}
+		if p.peek().op("(") {
+			return p.parseCall(t.val)
+		}
+		if keywords[strings.ToUpper(t.val)] {
+			p.pos--
+			return nil, p.unexpected()
+		}
+		return &name{t.val}, nil
+	}
+
+	switch {
+	case t.op("("):
+		p.next()
+		var v expr
+		var err error
+		if p.isSubquery() {
+			q, err := p.parseQuery()
+			if err != nil {
+				return nil, err
+			}
+			v = &subquery{q}
+		} else if v, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+		if err := p.expectOp(")"); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case t.op("["):
+		p.next()
+		a := &array{}
+		for !p.peek().op("]") {
+			v, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			a.values = append(a.values, v)
+			if !p.peek().op(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expectOp("]"); err != nil {
+			return nil, err
+		}
+		return a, nil
+	case t.op("{"):
+		return p.parseObject()
+	}
+	return nil, p.unexpected()
+}
+
+func (p *parser) isSubquery() bool {
+	t := p.peek()
+	for _, k := range []string{"FOR", "LET", "FILTER", "RETURN", "INSERT", "UPDATE", "REPLACE", "REMOVE", "UPSERT", "COLLECT"} {
+		if t.is(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) parseCall(function string) (expr, error) {
+	p.next()
+	c := &call{function: strings.ToUpper(function)}
+	// a subquery can be the argument without its own brackets, LENGTH(FOR doc IN c RETURN doc)
+	if p.isSubquery() {
+		q, err := p.parseQuery()
+		if err != nil {
+			return nil, err
+		}
+		c.args = append(c.args, &subquery{q})
+	} else {
+		for !p.peek().op(")") {
+			v, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			c.args = append(c.args, v)
+			if !p.peek().op(",") {
+				break
+			}
+			p.next()
+		}
+	}
+	if err := p.expectOp(")"); err != nil {
+		return nil, err
+	}
+	if _, ok := functions[c.function]; !ok {
+		return nil, fmt.Errorf("%v: unknown function %s", ErrInvalidQuery, function)
+	}
+	return c, nil
+}
+
+func (p *parser) parseObject() (expr, error) {
+	p.next()
+	o := &object{}
+	for !p.peek().op("}") {
+		f := objectField{}
+		t := p.next()
+		switch {
+		case t.typ == tokIdent || t.typ == tokString || t.typ == tokNumber:
+			f.key = t.val
+		case t.typ == tokBindVar:
+			f.computed = &bindVar{t.val}
+		case t.op("["):
+			k, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp("]"); err != nil {
+				return nil, err
+			}
+			f.computed = k
+		default:
+			return nil, fmt.Errorf("%v: expected an attribute, got %v", ErrInvalidQuery, t)
+		}
+		if p.peek().op(":") {
+			p.next()
+			v, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			f.value = v
+		} else if t.typ == tokIdent {
+			// { a } is { a: a }
+			f.value = &name{t.val}
+		} else {
+			return nil, p.unexpected()
+		}
+		o.fields = append(o.fields, f)
+		if !p.peek().op(",") {
+			break
+		}
+		p.next()
+	}
+	if err := p.expectOp("}"); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
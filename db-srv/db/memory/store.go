@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type collection struct {
+	name string
+	edge bool
+	// keys in insertion order, the order of a collection scan
+	keys []string
+	docs map[string]map[string]interface{}
+	// last generated key
+	seq int64
+}
+
+func newCollection(name string, edge bool) *collection {
+	return &collection{
+		name: name,
+		edge: edge,
+		docs: map[string]map[string]interface{}{},
+	}
+}
+
+// all returns the documents in insertion order
+func (c *collection) all() []map[string]interface{} {
+	docs := make([]map[string]interface{}, 0, len(c.keys))
+	for _, k := range c.keys {
+		docs = append(docs, c.docs[k])
+	}
+	return docs
+}
+
+func (c *collection) put(doc map[string]interface{}) {
+	key := doc["_key"].(string)
+	if _, ok := c.docs[key]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.docs[key] = doc
+}
+
+func (c *collection) remove(key string) {
+	if _, ok := c.docs[key]; !ok {
+		return
+	}
+	delete(c.docs, key)
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i:i], c.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+type database struct {
+	collections map[string]*collection
+}
+
+// store holds the databases of a driver, the connections of the driver share it
+type store struct {
+	sync.Mutex
+	databases map[string]*database
+	// revision of the documents
+	rev int64
+}
+
+func newStore() *store {
+	return &store{databases: map[string]*database{}}
+}
+
+func (s *store) database(name string) *database {
+	d, ok := s.databases[name]
+	if !ok {
+		d = &database{collections: map[string]*collection{}}
+		s.databases[name] = d
+	}
+	return d
+}
+
+// change is an applied write which can be undone
+type change struct {
+	collection *collection
+	key        string
+	// nil if the document didn't exist
+	old map[string]interface{}
+}
+
+// tx writes to the collections of a database, it records the changes to roll them back on errors
+type tx struct {
+	store    *store
+	database *database
+	changes  []change
+}
+
+func (s *store) begin(name string) *tx {
+	return &tx{store: s, database: s.database(name)}
+}
+
+func (t *tx) collection(name string) (*collection, error) {
+	c, ok := t.database.collections[name]
+	if !ok {
+		return nil, fmt.Errorf("collection or view not found: %s", name)
+	}
+	return c, nil
+}
+
+func (t *tx) rollback() {
+	for i := len(t.changes) - 1; i >= 0; i-- {
+		ch := t.changes[i]
+		if ch.old == nil {
+			ch.collection.remove(ch.key)
+		} else {
+			ch.collection.put(ch.old)
+		}
+	}
+	t.changes = nil
+}
+
+// insert adds a document, generating its key and system attributes
+func (t *tx) insert(c *collection, doc map[string]interface{}) (map[string]interface{}, error) {
+	doc = copyDocument(doc)
+	key, ok := doc["_key"]
+	if !ok || key == nil {
+		c.seq++
+		key = strconv.FormatInt(c.seq, 10)
+	}
+	k, ok := key.(string)
+	if !ok || len(k) == 0 || strings.Contains(k, "/") {
+		return nil, fmt.Errorf("illegal document key: %v", key)
+	}
+	if _, ok := c.docs[k]; ok {
+		return nil, fmt.Errorf("unique constraint violated: %s/%s", c.name, k)
+	}
+	if c.edge {
+		if _, ok := doc["_from"].(string); !ok {
+			return nil, fmt.Errorf("edge attribute missing or invalid: %s", c.name)
+		}
+		if _, ok := doc["_to"].(string); !ok {
+			return nil, fmt.Errorf("edge attribute missing or invalid: %s", c.name)
+		}
+	}
+	doc["_key"] = k
+	t.write(c, k, doc)
+	return doc, nil
+}
+
+// replace writes a new version of an existing document, keeping its system attributes
+func (t *tx) replace(c *collection, old, doc map[string]interface{}) map[string]interface{} {
+	doc = copyDocument(doc)
+	doc["_key"] = old["_key"]
+	for _, a := range []string{"_from", "_to"} {
+		if _, ok := doc[a]; !ok && old[a] != nil {
+			doc[a] = old[a]
+		}
+	}
+	t.write(c, old["_key"].(string), doc)
+	return doc
+}
+
+func (t *tx) remove(c *collection, key string) {
+	old, ok := c.docs[key]
+	if !ok {
+		return
+	}
+	t.changes = append(t.changes, change{collection: c, key: key, old: old})
+	c.remove(key)
+}
+
+func (t *tx) write(c *collection, key string, doc map[string]interface{}) {
+	t.changes = append(t.changes, change{collection: c, key: key, old: c.docs[key]})
+	t.store.rev++
+	doc["_id"] = c.name + "/" + key
+	doc["_rev"] = strconv.FormatInt(t.store.rev, 36)
+	c.put(doc)
+}
+
+// lookup returns the document of an _id, nil if it doesn't exist
+func (t *tx) lookup(id string) map[string]interface{} {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+	c, ok := t.database.collections[parts[0]]
+	if !ok {
+		return nil
+	}
+	return c.docs[parts[1]]
+}
+
+func copyDocument(doc map[string]interface{}) map[string]interface{} {
+	c := map[string]interface{}{}
+	for k, v := range doc {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,278 @@
+package memory
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Values are the types of decoded json: nil, bool, float64, string, []interface{} and map[string]interface{}
+
+// normalize converts a bind variable or a document to the value types
+func normalize(v interface{}) (interface{}, error) {
+	switch v.(type) {
+	case nil, bool, float64, string:
+		return v, nil
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var n interface{}
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// typeOrder is the order of the types in comparisons, null < bool < number < string < array < object
+func typeOrder(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case float64:
+		return 2
+	case string:
+		return 3
+	case []interface{}:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// compare returns -1, 0 or 1 comparing two values
+func compare(a, b interface{}) int {
+	ta, tb := typeOrder(a), typeOrder(b)
+	if ta != tb {
+		if ta < tb {
+			return -1
+		}
+		return 1
+	}
+	switch a := a.(type) {
+	case nil:
+		return 0
+	case bool:
+		bb := b.(bool)
+		switch {
+		case a == bb:
+			return 0
+		case !a:
+			return -1
+		}
+		return 1
+	case float64:
+		bf := b.(float64)
+		switch {
+		case a < bf:
+			return -1
+		case a > bf:
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(a, b.(string))
+	case []interface{}:
+		ba := b.([]interface{})
+		for i := 0; i < len(a) || i < len(ba); i++ {
+			var x, y interface{}
+			if i < len(a) {
+				x = a[i]
+			}
+			if i < len(ba) {
+				y = ba[i]
+			}
+			if c := compare(x, y); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case map[string]interface{}:
+		bm := b.(map[string]interface{})
+		keys := map[string]bool{}
+		for k := range a {
+			keys[k] = true
+		}
+		for k := range bm {
+			keys[k] = true
+		}
+		sorted := []string{}
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			if c := compare(a[k], bm[k]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+	return 0
+}
+
+func equal(a, b interface{}) bool {
+	return compare(a, b) == 0
+}
+
+func truthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return len(v) > 0
+	}
+	return true
+}
+
+func toNumber(v interface{}) float64 {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return 1
+		}
+	case float64:
+		return v
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err == nil && !math.IsInf(n, 0) && !math.IsNaN(n) {
+			return n
+		}
+	case []interface{}:
+		if len(v) == 1 {
+			return toNumber(v[0])
+		}
+	}
+	return 0
+}
+
+func toString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	}
+	body, _ := json.Marshal(v)
+	return string(body)
+}
+
+func toArray(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return []interface{}{}
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		values := []interface{}{}
+		for _, k := range sortedKeys(v) {
+			values = append(values, v[k])
+		}
+		return values
+	}
+	return []interface{}{v}
+}
+
+// number returns the value of a float64, it's nil for the results which aren't numbers
+func number(n float64) interface{} {
+	if math.IsInf(n, 0) || math.IsNaN(n) {
+		return nil
+	}
+	return n
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// merge returns a copy of a with the attributes of b, nested objects are merged too if recursive is set
+func merge(a, b map[string]interface{}, recursive bool) map[string]interface{} {
+	m := map[string]interface{}{}
+	for k, v := range a {
+		m[k] = v
+	}
+	for k, v := range b {
+		if recursive {
+			old, ok1 := m[k].(map[string]interface{})
+			neu, ok2 := v.(map[string]interface{})
+			if ok1 && ok2 {
+				m[k] = merge(old, neu, true)
+				continue
+			}
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// unsetNulls removes the null attributes, used for updates with keepNull false
+func unsetNulls(m map[string]interface{}) map[string]interface{} {
+	c := map[string]interface{}{}
+	for k, v := range m {
+		switch v := v.(type) {
+		case nil:
+			continue
+		case map[string]interface{}:
+			c[k] = unsetNulls(v)
+		default:
+			c[k] = v
+		}
+	}
+	return c
+}
+
+// like matches a LIKE pattern, % is any sequence and _ any character, \ escapes them
+func like(text, pattern string, caseInsensitive bool) bool {
+	if caseInsensitive {
+		text, pattern = strings.ToLower(text), strings.ToLower(pattern)
+	}
+	t, p := []rune(text), []rune(pattern)
+	// positions to retry after a %
+	star, match := -1, 0
+	i, j := 0, 0
+	for i < len(t) {
+		if j < len(p) && p[j] == '\\' && j+1 < len(p) {
+			if p[j+1] == t[i] {
+				i++
+				j += 2
+				continue
+			}
+		} else if j < len(p) && (p[j] == '_' || p[j] == t[i] && p[j] != '%') {
+			i++
+			j++
+			continue
+		} else if j < len(p) && p[j] == '%' {
+			star, match = j, i
+			j++
+			continue
+		}
+		if star < 0 {
+			return false
+		}
+		j = star + 1
+		match++
+		i = match
+	}
+	for j < len(p) && p[j] == '%' {
+		j++
+	}
+	return j == len(p)
+}
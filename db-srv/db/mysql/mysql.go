@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -98,6 +99,13 @@ func (d *mysqlDB) Close() error {
 	return d.conn.Close()
 }
 
+// Ping checks the connection to the database is still alive
+func (d *mysqlDB) Ping() error {
+	d.RLock()
+	defer d.RUnlock()
+	return d.conn.Ping()
+}
+
 // Reads a generic record from a database. Records must be split across corresponding databases
 func (d *mysqlDB) Read(id, extraId string) (*mdb.Record, error) {
 	d.RLock()
@@ -313,14 +321,19 @@ func (d *mysqlDB) Search(md map[string]string, from, to, limit, offset int64, re
 	return records, nil
 }
 
-func (d *mysqlDB) RunQuery(query string) ([]*mdb.Record, error) {
+func (d *mysqlDB) RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
 	d.RLock()
 	defer d.RUnlock()
 
 	var rows *sql.Rows
 	var err error
 
+	// @name => ? with the args in the order they appear
 	var args []interface{}
+	query = db.ReplaceBindVars(query, bindVars, func(name string, value interface{}) string {
+		args = append(args, value)
+		return "?"
+	})
 
 	rows, err = d.conn.Query(query, args...)
 	if err != nil {
@@ -328,8 +341,17 @@ func (d *mysqlDB) RunQuery(query string) ([]*mdb.Record, error) {
 	}
 	defer rows.Close()
 
+	return scanRecords(rows)
+}
+
+func scanRecords(rows *sql.Rows) ([]*mdb.Record, error) {
+	return scanRecordsLimit(rows, 0)
+}
+
+// scanRecordsLimit scans at most limit rows, all of them if limit is 0
+func scanRecordsLimit(rows *sql.Rows, limit int) ([]*mdb.Record, error) {
 	var records []*mdb.Record
-	for rows.Next() {
+	for (limit == 0 || len(records) < limit) && rows.Next() {
 		r := &mdb.Record{}
 		var meta []byte
 		var extra float64
@@ -346,12 +368,90 @@ func (d *mysqlDB) RunQuery(query string) ([]*mdb.Record, error) {
 		records = append(records, r)
 
 	}
-	if rows.Err() != nil {
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// rowsCursor reads the rows of a query batch by batch
+type rowsCursor struct {
+	rows      *sql.Rows
+	batchSize int
+}
+
+func (d *mysqlDB) RunQueryStream(query string, bindVars map[string]interface{}, batchSize int) (db.Cursor, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	var args []interface{}
+	query = db.ReplaceBindVars(query, bindVars, func(name string, value interface{}) string {
+		args = append(args, value)
+		return "?"
+	})
+	rows, err := d.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &rowsCursor{rows: rows, batchSize: batchSize}, nil
+}
+
+func (c *rowsCursor) Next() ([]*mdb.Record, error) {
+	records, err := scanRecordsLimit(c.rows, c.batchSize)
+	if err != nil {
 		return nil, err
 	}
+	if len(records) == 0 {
+		return nil, io.EOF
+	}
 	return records, nil
 }
 
+func (c *rowsCursor) Close() error {
+	return c.rows.Close()
+}
+
+// Transaction runs the operations in a sql transaction, it's rolled back if any of them fails
+func (d *mysqlDB) Transaction(tx *db.Tx) ([][]*mdb.Record, error) {
+	d.RLock()
+	defer d.RUnlock()
+
+	if len(tx.Action) > 0 {
+		return nil, db.ErrTransactionActionNotSupported
+	}
+
+	sqlTx, err := d.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	results := [][]*mdb.Record{}
+	for _, op := range tx.Operations {
+		var args []interface{}
+		query := db.ReplaceBindVars(op.Query, op.BindVars, func(name string, value interface{}) string {
+			args = append(args, value)
+			return "?"
+		})
+		rows, err := sqlTx.Query(query, args...)
+		if err != nil {
+			sqlTx.Rollback()
+			return nil, err
+		}
+		records, err := scanRecords(rows)
+		rows.Close()
+		if err != nil {
+			sqlTx.Rollback()
+			return nil, err
+		}
+		results = append(results, records)
+	}
+
+	if err := sqlTx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // A database must be created for every datatype (User, Auth, Room, etc.)
 func (d *mysqlDB) DeleteDatabase(name string) error {
 	d.Lock()
@@ -0,0 +1,265 @@
+package db
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/registry"
+)
+
+var (
+	// How often pooled connections are pinged
+	HealthCheckInterval = time.Second * 30
+
+	// Pooled connections unused for longer than this are closed
+	IdleTimeout = time.Minute * 10
+)
+
+// Pinger is implemented by drivers which can check their connection is still alive
+type Pinger interface {
+	Ping() error
+}
+
+// Bootstrapper is implemented by drivers which need to create a database schema
+// (collections, graphs, etc.) once, rather than on every connection
+type Bootstrapper interface {
+	Bootstrap(mdb *mdb.Database) error
+}
+
+// a connection is reused for the same driver, registry node, database and table
+type poolKey struct {
+	driver string
+	node   string
+	name   string
+	table  string
+}
+
+type pooledConn struct {
+	conn     DB
+	service  string
+	lastUsed time.Time
+	// refs counts the requests using the connection, an evicted connection is closed by the last one
+	refs    int
+	evicted bool
+}
+
+type pool struct {
+	sync.RWMutex
+	conns map[poolKey]*pooledConn
+	// driver/node/database which have been bootstrapped
+	bootstrapped map[string]bool
+	exit         chan bool
+}
+
+func newPool() *pool {
+	return &pool{
+		conns:        make(map[poolKey]*pooledConn),
+		bootstrapped: make(map[string]bool),
+		exit:         make(chan bool),
+	}
+}
+
+func bootstrapKey(driver, node, name string) string {
+	return strings.Join([]string{driver, node, name}, "/")
+}
+
+// get returns a pooled connection and the func releasing it, or nil
+func (p *pool) get(key poolKey) (DB, func()) {
+	p.Lock()
+	defer p.Unlock()
+	pc, ok := p.conns[key]
+	if !ok {
+		return nil, nil
+	}
+	return pc.conn, p.acquire(pc)
+}
+
+// put stores a connection and returns the func releasing it, if another one was stored meanwhile that one is
+// returned and conn is closed
+func (p *pool) put(key poolKey, service string, conn DB) (DB, func()) {
+	p.Lock()
+	defer p.Unlock()
+	if pc, ok := p.conns[key]; ok {
+		conn.Close()
+		return pc.conn, p.acquire(pc)
+	}
+	pc := &pooledConn{
+		conn:    conn,
+		service: service,
+	}
+	p.conns[key] = pc
+	return conn, p.acquire(pc)
+}
+
+// acquire references pc until the returned func is called, the pool must be locked
+func (p *pool) acquire(pc *pooledConn) func() {
+	pc.refs++
+	pc.lastUsed = time.Now()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.Lock()
+			defer p.Unlock()
+			pc.refs--
+			pc.lastUsed = time.Now()
+			if pc.evicted && pc.refs == 0 {
+				pc.conn.Close()
+			}
+		})
+	}
+}
+
+func (p *pool) isBootstrapped(key string) bool {
+	p.RLock()
+	defer p.RUnlock()
+	return p.bootstrapped[key]
+}
+
+func (p *pool) setBootstrapped(key string, done bool) {
+	p.Lock()
+	defer p.Unlock()
+	if done {
+		p.bootstrapped[key] = true
+	} else {
+		delete(p.bootstrapped, key)
+	}
+}
+
+// evict removes every pooled connection matching fn, a connection is closed once the requests using it release it
+func (p *pool) evict(fn func(key poolKey, pc *pooledConn) bool) {
+	p.Lock()
+	defer p.Unlock()
+	for key, pc := range p.conns {
+		if fn(key, pc) {
+			delete(p.conns, key)
+			pc.evicted = true
+			if pc.refs == 0 {
+				pc.conn.Close()
+			}
+		}
+	}
+}
+
+// evictDatabase removes the connections and bootstrap state of a database, e.g. after it's dropped
+func (p *pool) evictDatabase(name string) {
+	p.evict(func(key poolKey, pc *pooledConn) bool {
+		return key.name == name
+	})
+	p.Lock()
+	defer p.Unlock()
+	for key := range p.bootstrapped {
+		if strings.HasSuffix(key, "/"+name) {
+			delete(p.bootstrapped, key)
+		}
+	}
+}
+
+// healthCheck closes idle connections and connections which fail to ping
+func (p *pool) healthCheck() {
+	p.RLock()
+	conns := map[poolKey]*pooledConn{}
+	for key, pc := range p.conns {
+		conns[key] = pc
+	}
+	p.RUnlock()
+
+	failed := map[poolKey]bool{}
+	for key, pc := range conns {
+		p.RLock()
+		idle := pc.refs == 0 && time.Since(pc.lastUsed) > IdleTimeout
+		p.RUnlock()
+		if idle {
+			failed[key] = true
+			continue
+		}
+		if pinger, ok := pc.conn.(Pinger); ok {
+			if err := pinger.Ping(); err != nil {
+				log.Println("db connection health check failed:", key.driver, key.node, key.name, err)
+				failed[key] = true
+			}
+		}
+	}
+	if len(failed) == 0 {
+		return
+	}
+	p.evict(func(key poolKey, pc *pooledConn) bool {
+		return failed[key]
+	})
+}
+
+// nodesChanged evicts connections to nodes which left the registry
+func (p *pool) nodesChanged(res *registry.Result) {
+	if res == nil || res.Service == nil {
+		return
+	}
+	nodes := map[string]bool{}
+	for _, node := range res.Service.Nodes {
+		nodes[node.Id] = true
+	}
+	p.evict(func(key poolKey, pc *pooledConn) bool {
+		if pc.service != res.Service.Name {
+			return false
+		}
+		switch res.Action {
+		case "delete":
+			// the nodes of a delete result are the ones which are gone
+			return nodes[key.node]
+		default:
+			// create and update results carry the current nodes
+			return !nodes[key.node]
+		}
+	})
+}
+
+// run health checks the pool and watches the registry until close is called
+func (p *pool) run(reg registry.Registry) {
+	if reg != nil {
+		go func() {
+			w, err := reg.Watch()
+			if err != nil {
+				log.Println("db registry watch failed:", err)
+				return
+			}
+			go func() {
+				<-p.exit
+				w.Stop()
+			}()
+			for {
+				res, err := w.Next()
+				if err != nil {
+					return
+				}
+				p.nodesChanged(res)
+			}
+		}()
+	}
+
+	go func() {
+		t := time.NewTicker(HealthCheckInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				p.healthCheck()
+			case <-p.exit:
+				return
+			}
+		}
+	}()
+}
+
+func (p *pool) close() {
+	select {
+	case <-p.exit:
+		return
+	default:
+		close(p.exit)
+	}
+	p.evict(func(key poolKey, pc *pooledConn) bool {
+		return true
+	})
+}
@@ -0,0 +1,230 @@
+package redis
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	redis "gopkg.in/redis.v4"
+
+	mdb "server/db-srv/proto/db"
+)
+
+// Records are stored as json under their id. Every table keeps secondary indexes next to them:
+//
+//	<table>:created                  sorted set of the ids scored by created time
+//	<table>:name:<value>             set of the ids with the name, same for parameter1 and parameter2
+//	<table>:meta:<key>:<value>       set of the ids with the metadata
+//
+// Indexes aren't updated when a record expires, searches drop the ids of the missing records.
+
+// record fields which can be searched, other search keys are metadata
+var indexedFields = []string{"name", "parameter1", "parameter2"}
+
+func (d *redisDB) createdKey() string {
+	return d.redisType + ":created"
+}
+
+func (d *redisDB) fieldKey(field, value string) string {
+	return d.redisType + ":" + field + ":" + value
+}
+
+func (d *redisDB) metaKey(key, value string) string {
+	return d.redisType + ":meta:" + key + ":" + value
+}
+
+// indexKeys returns the sets a record belongs to
+func (d *redisDB) indexKeys(r *mdb.Record) []string {
+	keys := []string{}
+	fields := map[string]string{
+		"name":       r.Name,
+		"parameter1": r.Parameter1,
+		"parameter2": r.Parameter2,
+	}
+	for _, f := range indexedFields {
+		if len(fields[f]) > 0 {
+			keys = append(keys, d.fieldKey(f, fields[f]))
+		}
+	}
+	for k, v := range r.Metadata {
+		keys = append(keys, d.metaKey(k, v))
+	}
+	return keys
+}
+
+// read returns the stored record, nil if it doesn't exist
+func (d *redisDB) read(client *redis.Client, id string) (*mdb.Record, error) {
+	val, err := client.Get(id).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	r := &mdb.Record{}
+	if err := json.Unmarshal([]byte(val), r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// unindex removes a record from the indexes
+func (d *redisDB) unindex(pipe *redis.Pipeline, r *mdb.Record) {
+	pipe.ZRem(d.createdKey(), r.Id)
+	for _, k := range d.indexKeys(r) {
+		pipe.SRem(k, r.Id)
+	}
+}
+
+// save writes a record with its indexes, replacing the ones of the previous version
+func (d *redisDB) save(client *redis.Client, r *mdb.Record) error {
+	expiry := expiryTime(r)
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	old, err := d.read(client, r.Id)
+	if err != nil {
+		return err
+	}
+
+	pipe := client.Pipeline()
+	defer pipe.Close()
+	if old != nil {
+		d.unindex(pipe, old)
+	}
+	pipe.Set(r.Id, string(body), expiry)
+	pipe.ZAdd(d.createdKey(), redis.Z{Score: float64(r.Created), Member: r.Id})
+	for _, k := range d.indexKeys(r) {
+		pipe.SAdd(k, r.Id)
+	}
+	_, err = pipe.Exec()
+	return err
+}
+
+// remove deletes a record and its indexes
+func (d *redisDB) remove(client *redis.Client, id string) error {
+	old, err := d.read(client, id)
+	if err != nil || old == nil {
+		return err
+	}
+	pipe := client.Pipeline()
+	defer pipe.Close()
+	d.unindex(pipe, old)
+	pipe.Del(id)
+	_, err = pipe.Exec()
+	return err
+}
+
+// search returns the records matching all the fields and metadata, ordered by created time like the other drivers
+func (d *redisDB) search(client *redis.Client, md map[string]string, from, to, limit, offset int64, reverse bool) ([]*mdb.Record, error) {
+	// if from and to are not set, they are maximum values to filter properly
+	if to == 0 {
+		to = math.MaxInt32
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	keys := []string{}
+	for k, v := range md {
+		field := false
+		for _, f := range indexedFields {
+			if k == f {
+				field = true
+			}
+		}
+		if field {
+			keys = append(keys, d.fieldKey(k, v))
+		} else {
+			keys = append(keys, d.metaKey(k, v))
+		}
+	}
+
+	rangeBy := redis.ZRangeBy{
+		Min: strconv.FormatInt(from, 10),
+		Max: strconv.FormatInt(to, 10),
+	}
+	// the time index pages directly when there is no other filter
+	if len(keys) == 0 {
+		rangeBy.Offset = offset
+		rangeBy.Count = limit
+	}
+	var ids []string
+	var err error
+	if reverse {
+		ids, err = client.ZRevRangeByScore(d.createdKey(), rangeBy).Result()
+	} else {
+		ids, err = client.ZRangeByScore(d.createdKey(), rangeBy).Result()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) > 0 {
+		members, err := client.SInter(keys...).Result()
+		if err != nil {
+			return nil, err
+		}
+		ids = page(filterIds(ids, members), offset, limit)
+	}
+	return d.load(client, ids)
+}
+
+// filterIds keeps the ids which are members, in order
+func filterIds(ids, members []string) []string {
+	set := map[string]bool{}
+	for _, m := range members {
+		set[m] = true
+	}
+	filtered := []string{}
+	for _, id := range ids {
+		if set[id] {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
+}
+
+func page(ids []string, offset, limit int64) []string {
+	if offset >= int64(len(ids)) {
+		return []string{}
+	}
+	ids = ids[offset:]
+	if limit < int64(len(ids)) {
+		ids = ids[:limit]
+	}
+	return ids
+}
+
+// load reads the records of the ids, the expired ones are removed from the time index
+func (d *redisDB) load(client *redis.Client, ids []string) ([]*mdb.Record, error) {
+	records := []*mdb.Record{}
+	if len(ids) == 0 {
+		return records, nil
+	}
+	vals, err := client.MGet(ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+	expired := []interface{}{}
+	for i, v := range vals {
+		s, ok := v.(string)
+		if !ok {
+			expired = append(expired, ids[i])
+			continue
+		}
+		r := &mdb.Record{}
+		if err := json.Unmarshal([]byte(s), r); err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	if len(expired) > 0 {
+		client.ZRem(d.createdKey(), expired...)
+	}
+	return records, nil
+}
@@ -0,0 +1,241 @@
+package redis
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	redis "gopkg.in/redis.v4"
+
+	mdb "server/db-srv/proto/db"
+)
+
+// ErrInvalidQuery is returned by RunQuery for queries which can't be parsed, see RunQuery
+var ErrInvalidQuery = errors.New("invalid redis query")
+
+type query struct {
+	command string
+	args    []string
+	// SEARCH
+	filters map[string]string
+	from    int64
+	to      int64
+	limit   int64
+	offset  int64
+	reverse bool
+}
+
+// token is a word of a query, quoted is the offset of its first quoted part or -1 if it has none
+type token struct {
+	text   string
+	quoted int
+}
+
+// tokenize splits a query on spaces, keeping the quoted values whole
+func tokenize(q string) ([]token, error) {
+	tokens := []token{}
+	var text []rune
+	quoted, inToken, quotedAt := false, false, -1
+	for _, c := range q {
+		switch {
+		case c == '"':
+			if !quoted && quotedAt < 0 {
+				quotedAt = len(string(text))
+			}
+			quoted = !quoted
+			inToken = true
+		case unicode.IsSpace(c) && !quoted:
+			if inToken {
+				tokens = append(tokens, token{string(text), quotedAt})
+			}
+			text, inToken, quotedAt = nil, false, -1
+		default:
+			text = append(text, c)
+			inToken = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("%v: unterminated quote", ErrInvalidQuery)
+	}
+	if inToken {
+		tokens = append(tokens, token{string(text), quotedAt})
+	}
+	return tokens, nil
+}
+
+// bind replaces a @name value by its variable, quoted values are literal and values are never parsed again
+func bind(value string, quoted bool, bindVars map[string]interface{}) (string, error) {
+	if quoted || !strings.HasPrefix(value, "@") {
+		return value, nil
+	}
+	v, ok := bindVars[value[1:]]
+	if !ok {
+		return "", fmt.Errorf("%v: bind variable %s is missing", ErrInvalidQuery, value)
+	}
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func parseInt(name, value string) (int64, error) {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%v: %s must be an integer", ErrInvalidQuery, name)
+	}
+	return n, nil
+}
+
+func parseQuery(q string, bindVars map[string]interface{}) (*query, error) {
+	tokens, err := tokenize(q)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%v: empty query", ErrInvalidQuery)
+	}
+	p := &query{command: strings.ToUpper(tokens[0].text)}
+	if p.command == "SEARCH" {
+		return parseSearch(p, tokens[1:], bindVars)
+	}
+	for _, t := range tokens[1:] {
+		v, err := bind(t.text, t.quoted >= 0, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		p.args = append(p.args, v)
+	}
+
+	switch p.command {
+	case "GET", "DELETE":
+		if len(p.args) == 0 {
+			return nil, fmt.Errorf("%v: %s needs an id", ErrInvalidQuery, p.command)
+		}
+	case "EXPIRE":
+		if len(p.args) != 2 {
+			return nil, fmt.Errorf("%v: EXPIRE needs an id and seconds", ErrInvalidQuery)
+		}
+		if _, err := parseInt("seconds", p.args[1]); err != nil {
+			return nil, err
+		}
+	case "INCR":
+		if len(p.args) == 0 || len(p.args) > 2 {
+			return nil, fmt.Errorf("%v: INCR needs a counter", ErrInvalidQuery)
+		}
+		if len(p.args) == 2 {
+			if _, err := parseInt("increment", p.args[1]); err != nil {
+				return nil, err
+			}
+		}
+	case "COUNTER":
+		if len(p.args) != 1 {
+			return nil, fmt.Errorf("%v: COUNTER needs a counter", ErrInvalidQuery)
+		}
+	default:
+		return nil, fmt.Errorf("%v: unknown command %s", ErrInvalidQuery, tokens[0].text)
+	}
+	return p, nil
+}
+
+func parseSearch(p *query, tokens []token, bindVars map[string]interface{}) (*query, error) {
+	p.filters = map[string]string{}
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i].text
+		if j := strings.Index(t, "="); j > 0 {
+			v, err := bind(t[j+1:], tokens[i].quoted > j, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			p.filters[t[:j]] = v
+			continue
+		}
+
+		keyword := strings.ToUpper(t)
+		if keyword == "REVERSE" {
+			p.reverse = true
+			continue
+		}
+		if i+1 == len(tokens) {
+			return nil, fmt.Errorf("%v: %s needs a value", ErrInvalidQuery, t)
+		}
+		i++
+		v, err := bind(tokens[i].text, tokens[i].quoted >= 0, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		n, err := parseInt(keyword, v)
+		if err != nil {
+			return nil, err
+		}
+		switch keyword {
+		case "FROM":
+			p.from = n
+		case "TO":
+			p.to = n
+		case "LIMIT":
+			p.limit = n
+		case "OFFSET":
+			p.offset = n
+		default:
+			return nil, fmt.Errorf("%v: unknown keyword %s", ErrInvalidQuery, t)
+		}
+	}
+	return p, nil
+}
+
+func (d *redisDB) counterKey(name string) string {
+	return d.redisType + ":counter:" + name
+}
+
+func counterRecord(name string, value int64) *mdb.Record {
+	return &mdb.Record{
+		Id:         name,
+		Updated:    time.Now().Unix(),
+		Parameter1: strconv.FormatInt(value, 10),
+	}
+}
+
+func (d *redisDB) runQuery(client *redis.Client, q *query) ([]*mdb.Record, error) {
+	switch q.command {
+	case "GET":
+		return d.load(client, q.args)
+	case "DELETE":
+		for _, id := range q.args {
+			if err := d.remove(client, id); err != nil {
+				return nil, err
+			}
+		}
+		return []*mdb.Record{}, nil
+	case "EXPIRE":
+		seconds, _ := strconv.ParseInt(q.args[1], 10, 64)
+		if err := client.Expire(q.args[0], time.Duration(seconds)*time.Second).Err(); err != nil {
+			return nil, err
+		}
+		return []*mdb.Record{}, nil
+	case "INCR":
+		n := int64(1)
+		if len(q.args) == 2 {
+			n, _ = strconv.ParseInt(q.args[1], 10, 64)
+		}
+		value, err := client.IncrBy(d.counterKey(q.args[0]), n).Result()
+		if err != nil {
+			return nil, err
+		}
+		return []*mdb.Record{counterRecord(q.args[0], value)}, nil
+	case "COUNTER":
+		value, err := client.Get(d.counterKey(q.args[0])).Int64()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		return []*mdb.Record{counterRecord(q.args[0], value)}, nil
+	default:
+		return d.search(client, q.filters, q.from, q.to, q.limit, q.offset, q.reverse)
+	}
+}
@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"testing"
+)
+
+func TestParseSearch(t *testing.T) {
+	q, err := parseQuery(`search name=@name parameter1="org 1" FROM 10 limit @limit REVERSE`, map[string]interface{}{
+		"name":  "run",
+		"limit": float64(20),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.command != "SEARCH" || q.filters["name"] != "run" || q.filters["parameter1"] != "org 1" {
+		t.Errorf("Filters are invalid: %+v", q.filters)
+	}
+	if q.from != 10 || q.to != 0 || q.limit != 20 || !q.reverse {
+		t.Errorf("Options are invalid: %+v", q)
+	}
+}
+
+func TestParseCommands(t *testing.T) {
+	q, err := parseQuery(`INCR visits @by`, map[string]interface{}{"by": float64(-2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.command != "INCR" || len(q.args) != 2 || q.args[1] != "-2" {
+		t.Errorf("INCR is invalid: %+v", q)
+	}
+
+	// bound values aren't parsed again
+	q, err = parseQuery(`GET @id`, map[string]interface{}{"id": "a b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(q.args) != 1 || q.args[0] != "a b" {
+		t.Errorf("GET is invalid: %+v", q)
+	}
+
+	// quoted values are literal
+	q, err = parseQuery(`SEARCH name="@name" parameter1=@org`, map[string]interface{}{"org": "org1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.filters["name"] != "@name" || q.filters["parameter1"] != "org1" {
+		t.Errorf("Quoted value must not be bound: %+v", q.filters)
+	}
+	if q, err = parseQuery(`DELETE "@id"`, nil); err != nil || q.args[0] != "@id" {
+		t.Errorf("Quoted id must not be bound: %+v, %v", q, err)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	queries := []string{
+		``,
+		`FOR doc IN goal RETURN doc`,
+		`GET`,
+		`GET @missing`,
+		`EXPIRE id soon`,
+		`SEARCH LIMIT`,
+		`SEARCH ORDER 1`,
+		`SEARCH name="run`,
+	}
+	for _, q := range queries {
+		if _, err := parseQuery(q, nil); err == nil {
+			t.Errorf("%q must be invalid", q)
+		}
+	}
+}
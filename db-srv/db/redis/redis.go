@@ -75,13 +75,24 @@ func (d *redisDB) Close() error {
 	d.Lock()
 	defer d.Unlock()
 	client, ok := d.clients[d.redisType]
-	if !ok {
+	if ok {
 		client.Close()
 	}
 
 	return nil
 }
 
+// Ping checks the connection of the type's client
+func (d *redisDB) Ping() error {
+	d.RLock()
+	defer d.RUnlock()
+	client, ok := d.clients[d.redisType]
+	if !ok {
+		return db.ErrNotAvailable
+	}
+	return client.Ping().Err()
+}
+
 // Reads a generic record from a database. Records must be split across corresponding databases
 func (d *redisDB) Read(id, extraId string) (*mdb.Record, error) {
 	d.RLock()
@@ -113,19 +124,12 @@ func (d *redisDB) Create(r *mdb.Record) error {
 	if r.Created == 0 {
 		r.Created = time.Now().Unix()
 	}
-	expiry := expiryTime(r)
 	r.Updated = time.Now().Unix()
-	res, err := json.Marshal(r)
-
-	if err != nil {
-		return err
-	}
 	client, ok := d.clients[d.redisType]
-	if ok {
-		client.Set(r.Id, string(res), expiry)
+	if !ok {
+		return db.ErrNotAvailable
 	}
-
-	return nil
+	return d.save(client, r)
 }
 
 // U of CRUD for generic records
@@ -135,18 +139,12 @@ func (d *redisDB) Update(r *mdb.Record) error {
 	if r.Created == 0 {
 		r.Created = time.Now().Unix()
 	}
-	expiry := expiryTime(r)
 	r.Updated = time.Now().Unix()
-	res, err := json.Marshal(r)
-	if err != nil {
-		return err
-	}
 	client, ok := d.clients[d.redisType]
-	if ok {
-		client.Set(r.Id, string(res), expiry)
+	if !ok {
+		return db.ErrNotAvailable
 	}
-
-	return nil
+	return d.save(client, r)
 }
 
 // D of CRUD for generic records
@@ -154,11 +152,10 @@ func (d *redisDB) Delete(id, extraId string) error {
 	d.RLock()
 	defer d.RUnlock()
 	client, ok := d.clients[d.redisType]
-	if ok {
-		client.Del(id)
+	if !ok {
+		return db.ErrNotAvailable
 	}
-
-	return nil
+	return d.remove(client, id)
 }
 
 // name and parameter are provided through name and parameter1 values of the metadata parameter. If they exists, the
@@ -169,18 +166,37 @@ func (d *redisDB) Search(md map[string]string, from, to, limit, offset int64, re
 	d.RLock()
 	defer d.RUnlock()
 
-	var records []*mdb.Record
-
-	return records, nil
+	client, ok := d.clients[d.redisType]
+	if !ok {
+		return nil, db.ErrNotAvailable
+	}
+	return d.search(client, md, from, to, limit, offset, reverse)
 }
 
-func (d *redisDB) RunQuery(query string) ([]*mdb.Record, error) {
+// RunQuery accepts one command per query, keywords are case insensitive and values can be "quoted" or @bind variables
+//
+//	GET <id> [<id> ...]                    records by id
+//	SEARCH [<field>=<value> ...] [FROM <unix>] [TO <unix>] [LIMIT <n>] [OFFSET <n>] [REVERSE]
+//	                                       like Search, fields are name, parameter1, parameter2 or metadata keys
+//	DELETE <id> [<id> ...]                 removes records
+//	EXPIRE <id> <seconds>                  sets the time to live of a record
+//	INCR <counter> [<n>]                   adds n (default 1) to a counter, returns {id: counter, parameter1: value}
+//	COUNTER <counter>                      returns the counter like INCR without changing it
+//
+// e.g. SEARCH name=@name parameter1="org 1" LIMIT 20 REVERSE
+func (d *redisDB) RunQuery(query string, bindVars map[string]interface{}) ([]*mdb.Record, error) {
 	d.RLock()
 	defer d.RUnlock()
 
-	var records []*mdb.Record
-
-	return records, nil
+	q, err := parseQuery(query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	client, ok := d.clients[d.redisType]
+	if !ok {
+		return nil, db.ErrNotAvailable
+	}
+	return d.runQuery(client, q)
 }
 
 // A database must be created for every datatype (User, Auth, Room, etc.)
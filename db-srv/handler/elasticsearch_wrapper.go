@@ -6,6 +6,7 @@ import (
 	"github.com/jinzhu/copier"
 
 	"server/common"
+	"server/db-srv/indexer"
 
 	"golang.org/x/net/context"
 )
@@ -35,6 +36,22 @@ func isSearchable(db *mdb.Database) bool {
 	return hasSearchable
 }
 
+// enqueue queues the index update of a record, the record is written already so a failure is only logged,
+// CheckIndex reports the records which aren't indexed
+func enqueue(database *mdb.Database, op, id, extraId string, r *mdb.Record) {
+	j := &indexer.Job{
+		Op:       op,
+		Database: database.Name,
+		Table:    database.Table,
+		RecordId: id,
+		ExtraId:  extraId,
+		Record:   r,
+	}
+	if err := indexer.Enqueue(j); err != nil {
+		common.ErrorLog(common.DbSrv, enqueue, err, "Index job enqueue is failed")
+	}
+}
+
 //Checks if the data object is searchable in elastic (autocomplete request)
 func isAutocomlete(db *mdb.Database) bool {
 	_, hasSearchable := db.Metadata[common.SearchableAutocompleteMeta]
@@ -57,54 +74,33 @@ func (d *ElasticSearchWrapper) Read(ctx context.Context, req *mdb.ReadRequest, r
 }
 
 func (d *ElasticSearchWrapper) Create(ctx context.Context, req *mdb.CreateRequest, rsp *mdb.CreateResponse) error {
-
+	if err := d.wrappedDb.Create(ctx, req, rsp); err != nil {
+		return err
+	}
 	if isSearchable(req.Database) {
-		// async index update
-		go func() {
-			reqCopy := new(mdb.CreateRequest)
-			copier.Copy(reqCopy, req)
-			reqCopy.Database = new(mdb.Database)
-			copier.Copy(reqCopy.Database, req.Database)
-			reqCopy.Database.Driver = elasticDriver
-			rspCopy := new(mdb.CreateResponse)
-			d.wrappedDb.Create(ctx, reqCopy, rspCopy)
-		}()
+		enqueue(req.Database, indexer.OpIndex, req.Record.Id, "", req.Record)
 	}
-	return d.wrappedDb.Create(ctx, req, rsp)
+	return nil
 }
 
 func (d *ElasticSearchWrapper) Update(ctx context.Context, req *mdb.UpdateRequest, rsp *mdb.UpdateResponse) error {
+	if err := d.wrappedDb.Update(ctx, req, rsp); err != nil {
+		return err
+	}
 	if isSearchable(req.Database) {
-		// async index update
-		go func() {
-			reqCopy := new(mdb.UpdateRequest)
-			copier.Copy(reqCopy, req)
-			reqCopy.Database = new(mdb.Database)
-			copier.Copy(reqCopy.Database, req.Database)
-			reqCopy.Database.Driver = elasticDriver
-			rspCopy := new(mdb.UpdateResponse)
-			d.wrappedDb.Update(ctx, reqCopy, rspCopy)
-		}()
+		enqueue(req.Database, indexer.OpIndex, req.Record.Id, "", req.Record)
 	}
-
-	return d.wrappedDb.Update(ctx, req, rsp)
+	return nil
 }
 
 func (d *ElasticSearchWrapper) Delete(ctx context.Context, req *mdb.DeleteRequest, rsp *mdb.DeleteResponse) error {
+	if err := d.wrappedDb.Delete(ctx, req, rsp); err != nil {
+		return err
+	}
 	if isSearchable(req.Database) {
-		// async index update
-		go func() {
-			reqCopy := new(mdb.DeleteRequest)
-			copier.Copy(reqCopy, req)
-			reqCopy.Database = new(mdb.Database)
-			copier.Copy(reqCopy.Database, req.Database)
-			reqCopy.Database.Driver = elasticDriver
-			rspCopy := new(mdb.DeleteResponse)
-			d.wrappedDb.Delete(ctx, reqCopy, rspCopy)
-		}()
+		enqueue(req.Database, indexer.OpDelete, req.Id, req.Parameter3, nil)
 	}
-
-	return d.wrappedDb.Delete(ctx, req, rsp)
+	return nil
 }
 
 func (d *ElasticSearchWrapper) Search(ctx context.Context, req *mdb.SearchRequest, rsp *mdb.SearchResponse) error {
@@ -126,20 +122,71 @@ func (d *ElasticSearchWrapper) Search(ctx context.Context, req *mdb.SearchReques
 	return d.wrappedDb.Search(ctx, req, rsp)
 }
 
-func (d *ElasticSearchWrapper) RunQuery(ctx context.Context, req *mdb.RunQueryRequest, rsp *mdb.RunQueryResponse) error {
+func (d *ElasticSearchWrapper) RunQueryStream(ctx context.Context, req *mdb.RunQueryStreamRequest, stream mdb.DB_RunQueryStreamStream) error {
+	return d.wrappedDb.RunQueryStream(ctx, req, stream)
+}
+
+// Transaction isn't indexed, its queries are opaque like the ones of RunQuery
+func (d *ElasticSearchWrapper) Transaction(ctx context.Context, req *mdb.TransactionRequest, rsp *mdb.TransactionResponse) error {
+	return d.wrappedDb.Transaction(ctx, req, rsp)
+}
+
+func (d *ElasticSearchWrapper) ReadChanges(ctx context.Context, req *mdb.ReadChangesRequest, rsp *mdb.ReadChangesResponse) error {
+	return d.wrappedDb.ReadChanges(ctx, req, rsp)
+}
+
+func (d *ElasticSearchWrapper) Reindex(ctx context.Context, req *mdb.ReindexRequest, stream mdb.DB_ReindexStream) error {
+	return d.wrappedDb.Reindex(ctx, req, stream)
+}
+
+func (d *ElasticSearchWrapper) CheckIndex(ctx context.Context, req *mdb.CheckIndexRequest, rsp *mdb.CheckIndexResponse) error {
+	return d.wrappedDb.CheckIndex(ctx, req, rsp)
+}
+
+// Trash removes the trashed record from the index
+func (d *ElasticSearchWrapper) Trash(ctx context.Context, req *mdb.TrashRequest, rsp *mdb.TrashResponse) error {
+	if err := d.wrappedDb.Trash(ctx, req, rsp); err != nil {
+		return err
+	}
 	if isSearchable(req.Database) {
-		// async index update
-		go func() {
-			reqCopy := new(mdb.RunQueryRequest)
-			copier.Copy(reqCopy, req)
-			reqCopy.Database = new(mdb.Database)
-			copier.Copy(reqCopy.Database, req.Database)
-			reqCopy.Database.Driver = elasticDriver
-			rspCopy := new(mdb.RunQueryResponse)
-			d.wrappedDb.RunQuery(ctx, reqCopy, rspCopy)
-		}()
+		enqueue(req.Database, indexer.OpDelete, req.Id, "", nil)
 	}
+	return nil
+}
 
+func (d *ElasticSearchWrapper) ListTrash(ctx context.Context, req *mdb.ListTrashRequest, rsp *mdb.ListTrashResponse) error {
+	return d.wrappedDb.ListTrash(ctx, req, rsp)
+}
+
+// Restore indexes the restored record again
+func (d *ElasticSearchWrapper) Restore(ctx context.Context, req *mdb.RestoreRequest, rsp *mdb.RestoreResponse) error {
+	if err := d.wrappedDb.Restore(ctx, req, rsp); err != nil {
+		return err
+	}
+	if isSearchable(req.Database) {
+		enqueue(req.Database, indexer.OpIndex, req.Id, "", rsp.Record)
+	}
+	return nil
+}
+
+func (d *ElasticSearchWrapper) PurgeTrash(ctx context.Context, req *mdb.PurgeTrashRequest, rsp *mdb.PurgeTrashResponse) error {
+	return d.wrappedDb.PurgeTrash(ctx, req, rsp)
+}
+
+func (d *ElasticSearchWrapper) Backup(ctx context.Context, req *mdb.BackupRequest, rsp *mdb.BackupResponse) error {
+	return d.wrappedDb.Backup(ctx, req, rsp)
+}
+
+// RestoreBackup reindexes the restored collections already
+func (d *ElasticSearchWrapper) RestoreBackup(ctx context.Context, req *mdb.RestoreBackupRequest, rsp *mdb.RestoreBackupResponse) error {
+	return d.wrappedDb.RestoreBackup(ctx, req, rsp)
+}
+
+// RunQuery queries can't be indexed, the indexer follows the change feed for the searchable collections
+func (d *ElasticSearchWrapper) RunQuery(ctx context.Context, req *mdb.RunQueryRequest, rsp *mdb.RunQueryResponse) error {
+	if isSearchable(req.Database) {
+		indexer.DefaultIndexer.SetSearchable(req.Database)
+	}
 	return d.wrappedDb.RunQuery(ctx, req, rsp)
 }
 
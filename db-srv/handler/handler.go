@@ -1,17 +1,35 @@
 package handler
 
 import (
-	"log"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"server/common"
+	"server/db-srv/backup"
+	"server/db-srv/changefeed"
 	"server/db-srv/db"
+	"server/db-srv/indexer"
 	mdb "server/db-srv/proto/db"
+	"server/db-srv/tenant"
+	"server/db-srv/trash"
+	"time"
 
 	"github.com/micro/go-micro/errors"
 	"golang.org/x/net/context"
 )
 
+// maximum number of events returned by ReadChanges
+const maxChanges = 1000
+
 type DB struct{}
 
+// conflict is returned for the writes of an outdated revision
+func conflict(id string) error {
+	return errors.New(id, db.ErrConflict.Error(), http.StatusConflict)
+}
+
 func validateDB(method string, d *mdb.Database) error {
 	if d == nil {
 		return errors.BadRequest("go.micro.srv.db."+method, "invalid database")
@@ -29,17 +47,26 @@ func validateDB(method string, d *mdb.Database) error {
 	return nil
 }
 
-// InitDb initializes healum databases
-func (d *DB) InitDb(ctx context.Context, req *mdb.InitDbRequest, rsp *mdb.InitDbResponse) error {
-	if err := d.CreateDatabase(context.TODO(), &mdb.CreateDatabaseRequest{
-		&mdb.Database{
-			Name:   common.DbHealumName,
-			Driver: "arangodb",
-		},
-	}, &mdb.CreateDatabaseResponse{}); err != nil {
-		log.Fatal(err)
+// validateSource checks the database the index is rebuilt from, it's read with AQL
+func validateSource(method string, d *mdb.Database) error {
+	if err := validateDB(method, d); err != nil {
 		return err
 	}
+	if d.Driver != common.DbHealumDriver {
+		return errors.BadRequest("go.micro.srv.db."+method, "only arangodb databases are indexed")
+	}
+	return nil
+}
+
+// InitDb initializes healum databases, creating their collections and graphs
+func (d *DB) InitDb(ctx context.Context, req *mdb.InitDbRequest, rsp *mdb.InitDbResponse) error {
+	if err := db.Bootstrap(&mdb.Database{
+		Name:   common.DbHealumName,
+		Driver: "arangodb",
+	}); err != nil {
+		common.ErrorLog(common.DbSrv, d.InitDb, err, "Bootstrap is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.InitDb", err.Error())
+	}
 
 	return nil
 }
@@ -117,12 +144,22 @@ func (d *DB) Update(ctx context.Context, req *mdb.UpdateRequest, rsp *mdb.Update
 		return errors.BadRequest("go.micro.srv.db.DB.Update", "invalid id")
 	}
 
-	if err := db.Update(req.Database, req.Record); err != nil && err == db.ErrNotFound {
+	var err error
+	if len(req.Revision) > 0 {
+		err = db.UpdateIfMatch(req.Database, req.Record, req.Revision)
+	} else {
+		err = db.Update(req.Database, req.Record)
+	}
+	switch {
+	case err == db.ErrNotFound:
 		return errors.NotFound("go.micro.srv.db.DB.Update", "not found")
-	} else if err != nil {
+	case err == db.ErrConflict:
+		return conflict("go.micro.srv.db.DB.Update")
+	case err != nil:
 		return errors.InternalServerError("go.micro.srv.db.DB.Update", err.Error())
 	}
 
+	rsp.Revision = req.Record.Revision
 	return nil
 }
 
@@ -137,8 +174,16 @@ func (d *DB) Delete(ctx context.Context, req *mdb.DeleteRequest, rsp *mdb.Delete
 		return errors.BadRequest("go.micro.srv.db.DB.Delete", "invalid id")
 	}
 
-	if err := db.Delete(req.Database, req.Id, req.Parameter3); err != nil && err == db.ErrNotFound {
+	var err error
+	if len(req.Revision) > 0 {
+		err = db.DeleteIfMatch(req.Database, req.Id, req.Parameter3, req.Revision)
+	} else {
+		err = db.Delete(req.Database, req.Id, req.Parameter3)
+	}
+	if err != nil && err == db.ErrNotFound {
 		return nil
+	} else if err == db.ErrConflict {
+		return conflict("go.micro.srv.db.DB.Delete")
 	} else if err != nil {
 		common.ErrorLog(common.DbSrv, d.Delete, err, "Delete query is failed")
 		return errors.InternalServerError("go.micro.srv.db.DB.Delete", err.Error())
@@ -177,7 +222,16 @@ func (d *DB) RunQuery(ctx context.Context, req *mdb.RunQueryRequest, rsp *mdb.Ru
 		return err
 	}
 
-	r, err := db.RunQuery(req.Database, req.Query)
+	bindVars, err := db.DecodeBindVars(req.BindVars)
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.RunQuery, err, "Bind variables are invalid")
+		return errors.BadRequest("go.micro.srv.db.DB.RunQuery", "%v", err)
+	}
+
+	r, err := db.RunQuery(req.Database, req.Query, bindVars)
+	if err == db.ErrConflict {
+		return conflict("go.micro.srv.db.DB.RunQuery")
+	}
 	if err != nil {
 		common.ErrorLog(common.DbSrv, d.RunQuery, err, "RunQuery is failed")
 		return errors.InternalServerError("go.micro.srv.db.DB.RunQuery", err.Error())
@@ -187,6 +241,289 @@ func (d *DB) RunQuery(ctx context.Context, req *mdb.RunQueryRequest, rsp *mdb.Ru
 	return nil
 }
 
+// RunQueryStream streams the records of a query in batches of req.BatchSize
+func (d *DB) RunQueryStream(ctx context.Context, req *mdb.RunQueryStreamRequest, stream mdb.DB_RunQueryStreamStream) error {
+	defer stream.Close()
+	if err := validateDB("DB.RunQueryStream", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.RunQueryStream, err, "DB is invalid")
+		return err
+	}
+
+	bindVars, err := db.DecodeBindVars(req.BindVars)
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.RunQueryStream, err, "Bind variables are invalid")
+		return errors.BadRequest("go.micro.srv.db.DB.RunQueryStream", "%v", err)
+	}
+
+	cursor, err := db.RunQueryStream(req.Database, req.Query, bindVars, int(req.BatchSize))
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.RunQueryStream, err, "RunQueryStream is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.RunQueryStream", err.Error())
+	}
+	defer cursor.Close()
+
+	for {
+		records, err := cursor.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			common.ErrorLog(common.DbSrv, d.RunQueryStream, err, "Cursor is failed")
+			return errors.InternalServerError("go.micro.srv.db.DB.RunQueryStream", err.Error())
+		}
+		if err := stream.Send(&mdb.RunQueryStreamResponse{Records: records}); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *DB) Transaction(ctx context.Context, req *mdb.TransactionRequest, rsp *mdb.TransactionResponse) error {
+	if err := validateDB("DB.Transaction", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.Transaction, err, "DB is invalid")
+		return err
+	}
+	if len(req.Operations) == 0 && len(req.Action) == 0 {
+		return errors.BadRequest("go.micro.srv.db.DB.Transaction", "operations or action is required")
+	}
+
+	tx := &db.Tx{
+		Read:   req.Read,
+		Write:  req.Write,
+		Action: req.Action,
+	}
+	for _, op := range req.Operations {
+		bindVars, err := db.DecodeBindVars(op.BindVars)
+		if err != nil {
+			common.ErrorLog(common.DbSrv, d.Transaction, err, "Bind variables are invalid")
+			return errors.BadRequest("go.micro.srv.db.DB.Transaction", "%v", err)
+		}
+		tx.Operations = append(tx.Operations, &db.Operation{Query: op.Query, BindVars: bindVars})
+	}
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal([]byte(req.Params), &tx.Params); err != nil {
+			common.ErrorLog(common.DbSrv, d.Transaction, err, "Params are invalid")
+			return errors.BadRequest("go.micro.srv.db.DB.Transaction", "%v", err)
+		}
+	}
+
+	results, err := db.Transaction(req.Database, tx)
+	switch {
+	case err == db.ErrTransactionNotSupported || err == db.ErrTransactionActionNotSupported:
+		return errors.BadRequest("go.micro.srv.db.DB.Transaction", "%v", err)
+	case err == db.ErrConflict:
+		return conflict("go.micro.srv.db.DB.Transaction")
+	case err != nil:
+		common.ErrorLog(common.DbSrv, d.Transaction, err, "Transaction is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.Transaction", err.Error())
+	}
+	for _, records := range results {
+		rsp.Results = append(rsp.Results, &mdb.OperationResult{Records: records})
+	}
+
+	return nil
+}
+
+// ReadChanges returns the change feed events after req.FromSequence, consumers call it to catch up after a restart
+func (d *DB) ReadChanges(ctx context.Context, req *mdb.ReadChangesRequest, rsp *mdb.ReadChangesResponse) error {
+	if req.FromSequence < 0 {
+		return errors.BadRequest("go.micro.srv.db.DB.ReadChanges", "from_sequence is invalid")
+	}
+	if req.Limit <= 0 || req.Limit > maxChanges {
+		req.Limit = maxChanges
+	}
+
+	events, err := changefeed.NewStore().Read(req.Collection, req.FromSequence, req.Limit)
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.ReadChanges, err, "ReadChanges is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.ReadChanges", err.Error())
+	}
+	rsp.Events = events
+
+	return nil
+}
+
+// Reindex rebuilds the elasticsearch index of a collection from the database, streaming the progress
+func (d *DB) Reindex(ctx context.Context, req *mdb.ReindexRequest, stream mdb.DB_ReindexStream) error {
+	defer stream.Close()
+	if err := validateSource("DB.Reindex", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.Reindex, err, "DB is invalid")
+		return err
+	}
+
+	err := indexer.Reindex(req.Database, req.OrgId, int(req.BatchSize), func(p *mdb.ReindexResponse) error {
+		return stream.Send(p)
+	})
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.Reindex, err, "Reindex is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.Reindex", err.Error())
+	}
+	return nil
+}
+
+// CheckIndex reports the records of a collection which are missing from or stale in the elasticsearch index
+func (d *DB) CheckIndex(ctx context.Context, req *mdb.CheckIndexRequest, rsp *mdb.CheckIndexResponse) error {
+	if err := validateSource("DB.CheckIndex", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.CheckIndex, err, "DB is invalid")
+		return err
+	}
+
+	r, err := indexer.Check(req.Database, req.OrgId, 0)
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.CheckIndex, err, "CheckIndex is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.CheckIndex", err.Error())
+	}
+	*rsp = *r
+
+	return nil
+}
+
+// Trash moves a document and its edges to the trash collection
+func (d *DB) Trash(ctx context.Context, req *mdb.TrashRequest, rsp *mdb.TrashResponse) error {
+	if err := validateSource("DB.Trash", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.Trash, err, "DB is invalid")
+		return err
+	}
+	if len(req.Id) == 0 {
+		return errors.BadRequest("go.micro.srv.db.DB.Trash", "id is blank")
+	}
+
+	r, err := trash.Trash(req.Database.Name, req.Database.Table, req.Id, req.OrgId, req.DeletedBy, time.Now().Unix())
+	switch {
+	case err == db.ErrNotFound:
+		return errors.NotFound("go.micro.srv.db.DB.Trash", "not found")
+	case err != nil:
+		common.ErrorLog(common.DbSrv, d.Trash, err, "Trash is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.Trash", err.Error())
+	}
+	rsp.Record = r
+
+	return nil
+}
+
+// ListTrash returns the trashed documents of the collections, the table of the database isn't used
+func (d *DB) ListTrash(ctx context.Context, req *mdb.ListTrashRequest, rsp *mdb.ListTrashResponse) error {
+	if req.Database == nil || len(req.Database.Name) == 0 {
+		return errors.BadRequest("go.micro.srv.db.DB.ListTrash", "database is blank")
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	records, err := trash.List(req.Database.Name, req.Tables, req.OrgId, req.Offset, req.Limit)
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.ListTrash, err, "ListTrash is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.ListTrash", err.Error())
+	}
+	rsp.Records = records
+
+	return nil
+}
+
+// Restore moves a trashed document and its edges back to the collection
+func (d *DB) Restore(ctx context.Context, req *mdb.RestoreRequest, rsp *mdb.RestoreResponse) error {
+	if err := validateSource("DB.Restore", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.Restore, err, "DB is invalid")
+		return err
+	}
+	if len(req.Id) == 0 {
+		return errors.BadRequest("go.micro.srv.db.DB.Restore", "id is blank")
+	}
+
+	r, err := trash.Restore(req.Database.Name, req.Database.Table, req.Id, req.OrgId)
+	switch {
+	case err == db.ErrNotFound:
+		return errors.NotFound("go.micro.srv.db.DB.Restore", "not found")
+	case err != nil:
+		common.ErrorLog(common.DbSrv, d.Restore, err, "Restore is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.Restore", err.Error())
+	}
+	rsp.Record = r
+
+	return nil
+}
+
+// PurgeTrash removes the trashed documents after the trash retention of their organisation
+func (d *DB) PurgeTrash(ctx context.Context, req *mdb.PurgeTrashRequest, rsp *mdb.PurgeTrashResponse) error {
+	if req.Database == nil || len(req.Database.Name) == 0 {
+		return errors.BadRequest("go.micro.srv.db.DB.PurgeTrash", "database is blank")
+	}
+	if req.Now == 0 {
+		req.Now = time.Now().Unix()
+	}
+
+	purged, err := trash.Purge(req.Database.Name, req.Now)
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.PurgeTrash, err, "PurgeTrash is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.PurgeTrash", err.Error())
+	}
+	rsp.Purged = purged
+
+	return nil
+}
+
+// Backup exports the documents of a database, or of an organisation, to an archive of the backup directory
+func (d *DB) Backup(ctx context.Context, req *mdb.BackupRequest, rsp *mdb.BackupResponse) error {
+	if err := validateSource("DB.Backup", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.Backup, err, "DB is invalid")
+		return err
+	}
+
+	if err := tenant.Archive(ctx, req.OrgId); err != nil {
+		return tenantError("DB.Backup", err)
+	}
+
+	now := time.Now()
+	name := backup.ArchiveName(req.Database.Name, req.OrgId, now)
+	manifest, err := backup.Backup(filepath.Join(backup.Dir, name), req.Database.Name, req.OrgId, int(req.BatchSize), now.Unix())
+	if err != nil {
+		common.ErrorLog(common.DbSrv, d.Backup, err, "Backup is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.Backup", err.Error())
+	}
+	rsp.Archive = name
+	rsp.Manifest = manifest
+
+	return nil
+}
+
+// RestoreBackup imports an archive of the backup directory and rebuilds the search indexes of the restored collections
+func (d *DB) RestoreBackup(ctx context.Context, req *mdb.RestoreBackupRequest, rsp *mdb.RestoreBackupResponse) error {
+	if err := validateSource("DB.RestoreBackup", req.Database); err != nil {
+		common.ErrorLog(common.DbSrv, d.RestoreBackup, err, "DB is invalid")
+		return err
+	}
+	if len(req.Archive) == 0 {
+		return errors.BadRequest("go.micro.srv.db.DB.RestoreBackup", "archive is blank")
+	}
+
+	// only the archives of the backup directory can be restored
+	path := filepath.Join(backup.Dir, filepath.Base(req.Archive))
+	r, err := backup.Restore(path, req.Database.Name, &backup.Options{
+		RemapIds:  req.RemapIds,
+		BatchSize: int(req.BatchSize),
+		Reindex:   backup.Reindex,
+		Check: func(manifest *mdb.BackupManifest) error {
+			return tenant.Archive(ctx, manifest.OrgId)
+		},
+	})
+	switch {
+	case err == tenant.ErrArchive:
+		return tenantError("DB.RestoreBackup", err)
+	case os.IsNotExist(err):
+		return errors.NotFound("go.micro.srv.db.DB.RestoreBackup", "archive not found")
+	case err == backup.ErrVersion, err == backup.ErrManifest, err == backup.ErrCollection:
+		return errors.BadRequest("go.micro.srv.db.DB.RestoreBackup", "%v", err)
+	case err != nil:
+		common.ErrorLog(common.DbSrv, d.RestoreBackup, err, "RestoreBackup is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.RestoreBackup", err.Error())
+	}
+	*rsp = *r
+
+	return nil
+}
+
 func (d *DB) CreateDatabase(ctx context.Context, req *mdb.CreateDatabaseRequest, rsp *mdb.CreateDatabaseResponse) error {
 	// if err := validateDB("DB.CreateDatabase", req.Database); err != nil {
 	// 	common.ErrorLog(common.DbSrv, d.CreateDatabase, err, "DB is invalid")
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"fmt"
 	mdb "server/db-srv/proto/db"
 	"testing"
 	"time"
@@ -255,6 +256,7 @@ func TestRecordUpdated(t *testing.T) {
 				"test_key": "test_value",
 			},
 		},
+		"",
 	}
 
 	resp_upd := &mdb.UpdateResponse{}
@@ -323,6 +325,7 @@ func TestRecordDeleted(t *testing.T) {
 		},
 		"111",
 		"test_param3",
+		"",
 	}
 
 	resp_del := &mdb.DeleteResponse{}
@@ -1451,6 +1454,7 @@ func TestRecordUpdatedElastic(t *testing.T) {
 				"test_key": "test_value",
 			},
 		},
+		"",
 	}
 
 	resp_upd := &mdb.UpdateResponse{}
@@ -1522,6 +1526,7 @@ func TestRecordDeletedElastic(t *testing.T) {
 		},
 		"111",
 		"test_param3",
+		"",
 	}
 
 	resp_del := &mdb.DeleteResponse{}
@@ -2581,6 +2586,7 @@ func TestRecordUpdatedRedis(t *testing.T) {
 				"test_key": "test_value",
 			},
 		},
+		"",
 	}
 
 	resp_upd := &mdb.UpdateResponse{}
@@ -2652,6 +2658,7 @@ func TestRecordDeletedRedis(t *testing.T) {
 		},
 		"111",
 		"test_param3",
+		"",
 	}
 
 	resp_del := &mdb.DeleteResponse{}
@@ -2681,6 +2688,61 @@ func TestRecordDeletedRedis(t *testing.T) {
 	}
 }
 
+func TestRecordSearchRedis(t *testing.T) {
+	initDb(t, "redis", map[string]string{})
+	ctx := common.NewTestContext(context.TODO())
+	database := &mdb.Database{
+		Name:   TestDBName,
+		Table:  TestDBTable,
+		Driver: "redis",
+	}
+
+	hdlr := new(DB)
+	for i, name := range []string{"search_a", "search_b", "search_a"} {
+		req := &mdb.CreateRequest{
+			database,
+			&mdb.Record{
+				Id:         fmt.Sprintf("search_%d", i),
+				Created:    int64(1000 + i),
+				Name:       name,
+				Parameter1: "test_param",
+			},
+		}
+		if err := hdlr.Create(ctx, req, &mdb.CreateResponse{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req := &mdb.SearchRequest{
+		Database: database,
+		Metadata: map[string]string{"name": "search_a"},
+		From:     1000,
+		To:       2000,
+		Limit:    10,
+		Reverse:  true,
+	}
+	resp := &mdb.SearchResponse{}
+	if err := hdlr.Search(ctx, req, resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Records) != 2 || resp.Records[0].Id != "search_2" {
+		t.Error("Search must return the matching records, latest first")
+	}
+
+	req_query := &mdb.RunQueryRequest{
+		Database: database,
+		Query:    `SEARCH parameter1=@param LIMIT 1 OFFSET 1`,
+		BindVars: map[string]string{"param": `"test_param"`},
+	}
+	resp_query := &mdb.RunQueryResponse{}
+	if err := hdlr.RunQuery(ctx, req_query, resp_query); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp_query.Records) != 1 || resp_query.Records[0].Id != "search_1" {
+		t.Error("RunQuery must page the records")
+	}
+}
+
 func TestDbDeletedRedis(t *testing.T) {
 	initDb(t, "redis", map[string]string{})
 	ctx := common.NewTestContext(context.TODO())
@@ -2849,6 +2911,7 @@ func TestRecordUpdatedArangodb(t *testing.T) {
 				"test_key": "test_value",
 			},
 		},
+		"",
 	}
 
 	resp_upd := &mdb.UpdateResponse{}
@@ -2920,6 +2983,7 @@ func TestRecordDeletedArangodb(t *testing.T) {
 		},
 		"111",
 		"test_param3",
+		"",
 	}
 
 	resp_del := &mdb.DeleteResponse{}
@@ -3615,6 +3679,166 @@ func TestRecordSearchEmptyParamsArnagodb(t *testing.T) {
 }
 
 // searchable
+func TestRecordRunQueryBindArangodb(t *testing.T) {
+	initDb(t, "arangodb", map[string]string{})
+	ctx := common.NewTestContext(context.TODO())
+	req := &mdb.CreateRequest{
+		Database: &mdb.Database{
+			Name:   TestDBName,
+			Table:  TestDBTable,
+			Driver: "arangodb",
+		},
+		Record: &mdb.Record{
+			Id:         "111",
+			Created:    time.Now().Unix(),
+			Updated:    time.Now().Unix(),
+			Name:       `test_"name'%`,
+			Parameter1: "test_param",
+			Parameter2: "test_param2",
+			Parameter3: "test_param3",
+			Metadata: map[string]string{
+				"test_key": "test_value",
+			},
+		},
+	}
+
+	resp := &mdb.CreateResponse{}
+
+	hdlr := new(DB)
+	res := hdlr.Create(ctx, req, resp)
+	if res != nil {
+		t.Error(res)
+	}
+
+	req_query := &mdb.RunQueryRequest{
+		Database: &mdb.Database{
+			Name:   TestDBName,
+			Table:  TestDBTable,
+			Driver: "arangodb",
+		},
+		Query: `FOR doc IN @@table FILTER doc.name == @name && doc.parameter1 == @param1 LIMIT @limit RETURN doc`,
+		BindVars: map[string]string{
+			"@table": `"` + TestDBTable + `"`,
+			"name":   `"test_\"name'%"`,
+			"param1": `"test_param"`,
+			"limit":  `1`,
+		},
+	}
+
+	resp_query := &mdb.RunQueryResponse{}
+
+	res_query := hdlr.RunQuery(ctx, req_query, resp_query)
+	if res_query != nil {
+		t.Error(res_query)
+		return
+	}
+	if len(resp_query.Records) == 0 {
+		t.Error("Empty result")
+		return
+	}
+	if resp_query.Records[0].Name != `test_"name'%` {
+		t.Error("Name doesn't match")
+		return
+	}
+}
+
+func TestRunQueryInvalidBindVars(t *testing.T) {
+	ctx := common.NewTestContext(context.TODO())
+	req := &mdb.RunQueryRequest{
+		Database: &mdb.Database{
+			Name:   TestDBName,
+			Table:  TestDBTable,
+			Driver: "arangodb",
+		},
+		Query: `FOR doc IN @@table RETURN doc`,
+		BindVars: map[string]string{
+			"@table": TestDBTable,
+		},
+	}
+
+	resp := &mdb.RunQueryResponse{}
+	hdlr := new(DB)
+	if err := hdlr.RunQuery(ctx, req, resp); err == nil {
+		t.Error("Invalid bind variables must fail")
+	}
+}
+
+func TestTransactionArangodb(t *testing.T) {
+	initDb(t, "arangodb", map[string]string{})
+	ctx := common.NewTestContext(context.TODO())
+	database := &mdb.Database{
+		Name:   TestDBName,
+		Table:  TestDBTable,
+		Driver: "arangodb",
+	}
+	hdlr := new(DB)
+
+	req := &mdb.TransactionRequest{
+		Database: database,
+		Write:    []string{TestDBTable},
+		Operations: []*mdb.Operation{
+			{
+				Query:    `INSERT {_key: "tx1", id: "tx1", name: "tx_name"} INTO @@table`,
+				BindVars: map[string]string{"@table": `"` + TestDBTable + `"`},
+			},
+			{
+				Query:    `FOR doc IN @@table FILTER doc._key == @key RETURN doc`,
+				BindVars: map[string]string{"@table": `"` + TestDBTable + `"`, "key": `"tx1"`},
+			},
+		},
+	}
+	resp := &mdb.TransactionResponse{}
+	if err := hdlr.Transaction(ctx, req, resp); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(resp.Results) != 2 || len(resp.Results[1].Records) == 0 {
+		t.Error("Transaction results are missing")
+		return
+	}
+	if resp.Results[1].Records[0].Name != "tx_name" {
+		t.Error("Name doesn't match")
+	}
+
+	// the duplicate key fails the second insert, the first one must be rolled back
+	req_failed := &mdb.TransactionRequest{
+		Database: database,
+		Write:    []string{TestDBTable},
+		Operations: []*mdb.Operation{
+			{Query: `INSERT {_key: "tx2", id: "tx2"} INTO ` + TestDBTable},
+			{Query: `INSERT {_key: "tx1", id: "tx1"} INTO ` + TestDBTable},
+		},
+	}
+	if err := hdlr.Transaction(ctx, req_failed, &mdb.TransactionResponse{}); err == nil {
+		t.Error("Transaction must fail")
+		return
+	}
+
+	req_read := &mdb.ReadRequest{Database: database, Id: "tx2"}
+	resp_read := &mdb.ReadResponse{}
+	hdlr.Read(ctx, req_read, resp_read)
+	if resp_read.Record != nil && resp_read.Record.Id == "tx2" {
+		t.Error("Failed transaction must be rolled back")
+	}
+}
+
+func TestTransactionNotSupportedRedis(t *testing.T) {
+	initDb(t, "redis", map[string]string{})
+	ctx := common.NewTestContext(context.TODO())
+	req := &mdb.TransactionRequest{
+		Database: &mdb.Database{
+			Name:   TestDBName,
+			Table:  TestDBTable,
+			Driver: "redis",
+		},
+		Operations: []*mdb.Operation{{Query: "GET key"}},
+	}
+	hdlr := new(DB)
+	if err := hdlr.Transaction(ctx, req, &mdb.TransactionResponse{}); err == nil {
+		t.Error("Redis must not support transactions")
+	}
+}
+
 func TestDbCreatedSearchable(t *testing.T) {
 	md := map[string]string{common.SearchableMeta: ""}
 	initDb(t, "mysql", md)
@@ -3758,6 +3982,7 @@ func TestRecordUpdatedSearchable(t *testing.T) {
 				"test_key": "test_value",
 			},
 		},
+		"",
 	}
 
 	resp_upd := &mdb.UpdateResponse{}
@@ -3834,6 +4059,7 @@ func TestRecordDeletedSearchable(t *testing.T) {
 		},
 		"111",
 		"test_param3",
+		"",
 	}
 
 	resp_del := &mdb.DeleteResponse{}
@@ -4148,6 +4374,7 @@ func TestRecordUpdatedArangodbGraph(t *testing.T) {
 				"test_key": "test_value",
 			},
 		},
+		"",
 	}
 
 	resp_upd := &mdb.UpdateResponse{}
@@ -4222,6 +4449,7 @@ func TestRecordDeletedArangodbGraph(t *testing.T) {
 		},
 		"111",
 		"test_param3",
+		"",
 	}
 
 	resp_del := &mdb.DeleteResponse{}
@@ -4607,6 +4835,7 @@ func TestRecordDeletedInfluxdb(t *testing.T) {
 		},
 		"111",
 		"test_param3",
+		"",
 	}
 
 	resp_del := &mdb.DeleteResponse{}
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"server/common"
+	"server/db-srv/db"
+	"server/db-srv/namespace"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/errors"
+	"golang.org/x/net/context"
+)
+
+// NamespaceWrapper serves the requests of a test namespace from the databases of the namespace, see package
+// namespace. The requests without namespace are passed through.
+type NamespaceWrapper struct {
+	mdb.DBHandler
+}
+
+func NewNamespaceWrapper(h mdb.DBHandler) *NamespaceWrapper {
+	return &NamespaceWrapper{h}
+}
+
+// database renames the database of a request to its name in the namespace of ctx
+func (d *NamespaceWrapper) database(ctx context.Context, method string, database *mdb.Database) (string, error) {
+	ns, err := namespace.Database(ctx, database)
+	if err != nil {
+		return "", errors.BadRequest("go.micro.srv.db."+method, "%v", err)
+	}
+	return ns, nil
+}
+
+// InitDb bootstraps the healum database of the namespace
+func (d *NamespaceWrapper) InitDb(ctx context.Context, req *mdb.InitDbRequest, rsp *mdb.InitDbResponse) error {
+	database := &mdb.Database{Name: common.DbHealumName, Driver: common.DbHealumDriver}
+	ns, err := d.database(ctx, "DB.InitDb", database)
+	if err != nil {
+		return err
+	}
+	if len(ns) == 0 {
+		return d.DBHandler.InitDb(ctx, req, rsp)
+	}
+	if err := db.Bootstrap(database); err != nil {
+		common.ErrorLog(common.DbSrv, d.InitDb, err, "Bootstrap is failed")
+		return errors.InternalServerError("go.micro.srv.db.DB.InitDb", "%v", err)
+	}
+	return nil
+}
+
+// RemoveDb drops the databases of the namespace
+func (d *NamespaceWrapper) RemoveDb(ctx context.Context, req *mdb.RemoveDbRequest, rsp *mdb.RemoveDbResponse) error {
+	ns, err := d.database(ctx, "DB.RemoveDb", nil)
+	if err != nil {
+		return err
+	}
+	if len(ns) == 0 {
+		return d.DBHandler.RemoveDb(ctx, req, rsp)
+	}
+	if err := namespace.DefaultCleaner.Drop(ns); err != nil {
+		return errors.InternalServerError("go.micro.srv.db.DB.RemoveDb", "%v", err)
+	}
+	return nil
+}
+
+func (d *NamespaceWrapper) Read(ctx context.Context, req *mdb.ReadRequest, rsp *mdb.ReadResponse) error {
+	if _, err := d.database(ctx, "DB.Read", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Read(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Create(ctx context.Context, req *mdb.CreateRequest, rsp *mdb.CreateResponse) error {
+	if _, err := d.database(ctx, "DB.Create", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Create(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Update(ctx context.Context, req *mdb.UpdateRequest, rsp *mdb.UpdateResponse) error {
+	if _, err := d.database(ctx, "DB.Update", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Update(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Delete(ctx context.Context, req *mdb.DeleteRequest, rsp *mdb.DeleteResponse) error {
+	if _, err := d.database(ctx, "DB.Delete", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Delete(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Search(ctx context.Context, req *mdb.SearchRequest, rsp *mdb.SearchResponse) error {
+	if _, err := d.database(ctx, "DB.Search", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Search(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) RunQuery(ctx context.Context, req *mdb.RunQueryRequest, rsp *mdb.RunQueryResponse) error {
+	if _, err := d.database(ctx, "DB.RunQuery", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.RunQuery(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) RunQueryStream(ctx context.Context, req *mdb.RunQueryStreamRequest, stream mdb.DB_RunQueryStreamStream) error {
+	if _, err := d.database(ctx, "DB.RunQueryStream", req.Database); err != nil {
+		stream.Close()
+		return err
+	}
+	return d.DBHandler.RunQueryStream(ctx, req, stream)
+}
+
+func (d *NamespaceWrapper) Transaction(ctx context.Context, req *mdb.TransactionRequest, rsp *mdb.TransactionResponse) error {
+	if _, err := d.database(ctx, "DB.Transaction", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Transaction(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Reindex(ctx context.Context, req *mdb.ReindexRequest, stream mdb.DB_ReindexStream) error {
+	if _, err := d.database(ctx, "DB.Reindex", req.Database); err != nil {
+		stream.Close()
+		return err
+	}
+	return d.DBHandler.Reindex(ctx, req, stream)
+}
+
+func (d *NamespaceWrapper) CheckIndex(ctx context.Context, req *mdb.CheckIndexRequest, rsp *mdb.CheckIndexResponse) error {
+	if _, err := d.database(ctx, "DB.CheckIndex", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.CheckIndex(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Trash(ctx context.Context, req *mdb.TrashRequest, rsp *mdb.TrashResponse) error {
+	if _, err := d.database(ctx, "DB.Trash", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Trash(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) ListTrash(ctx context.Context, req *mdb.ListTrashRequest, rsp *mdb.ListTrashResponse) error {
+	if _, err := d.database(ctx, "DB.ListTrash", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.ListTrash(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Restore(ctx context.Context, req *mdb.RestoreRequest, rsp *mdb.RestoreResponse) error {
+	if _, err := d.database(ctx, "DB.Restore", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Restore(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) PurgeTrash(ctx context.Context, req *mdb.PurgeTrashRequest, rsp *mdb.PurgeTrashResponse) error {
+	if _, err := d.database(ctx, "DB.PurgeTrash", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.PurgeTrash(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) Backup(ctx context.Context, req *mdb.BackupRequest, rsp *mdb.BackupResponse) error {
+	if _, err := d.database(ctx, "DB.Backup", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.Backup(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) RestoreBackup(ctx context.Context, req *mdb.RestoreBackupRequest, rsp *mdb.RestoreBackupResponse) error {
+	if _, err := d.database(ctx, "DB.RestoreBackup", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.RestoreBackup(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) CreateDatabase(ctx context.Context, req *mdb.CreateDatabaseRequest, rsp *mdb.CreateDatabaseResponse) error {
+	if _, err := d.database(ctx, "DB.CreateDatabase", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.CreateDatabase(ctx, req, rsp)
+}
+
+func (d *NamespaceWrapper) DeleteDatabase(ctx context.Context, req *mdb.DeleteDatabaseRequest, rsp *mdb.DeleteDatabaseResponse) error {
+	if _, err := d.database(ctx, "DB.DeleteDatabase", req.Database); err != nil {
+		return err
+	}
+	return d.DBHandler.DeleteDatabase(ctx, req, rsp)
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"encoding/json"
+
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+	"server/db-srv/tenant"
+
+	"github.com/micro/go-micro/errors"
+	"golang.org/x/net/context"
+)
+
+// TenantWrapper scopes the reads and writes of the wrapped handler to the tenant of the request, see package tenant.
+// The requests which don't access the documents are passed through.
+type TenantWrapper struct {
+	mdb.DBHandler
+}
+
+func NewTenantWrapper(h mdb.DBHandler) *TenantWrapper {
+	return &TenantWrapper{h}
+}
+
+// tenantError maps the errors of the tenant package to the errors of the service
+func tenantError(method string, err error) error {
+	switch err {
+	case tenant.ErrNoTenant, tenant.ErrUnscoped, tenant.ErrScript, tenant.ErrArchive, tenant.ErrOrganisation:
+		return errors.Forbidden("go.micro.srv.db."+method, "%v", err)
+	}
+	return errors.BadRequest("go.micro.srv.db."+method, "%v", err)
+}
+
+// scopeQuery scopes a query and its json encoded bind variables to the tenant of ctx
+func scopeQuery(ctx context.Context, method string, database *mdb.Database, query string, bindVars map[string]string) (string, map[string]string, error) {
+	if database == nil {
+		return query, bindVars, nil
+	}
+	vars, err := db.DecodeBindVars(bindVars)
+	if err != nil {
+		return "", nil, tenantError(method, err)
+	}
+	scoped, err := tenant.Query(ctx, database.Name, query, vars)
+	if err != nil {
+		return "", nil, tenantError(method, err)
+	}
+	for _, name := range tenant.BindVars {
+		value, ok := vars[name]
+		if !ok {
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", nil, tenantError(method, err)
+		}
+		if bindVars == nil {
+			bindVars = map[string]string{}
+		}
+		bindVars[name] = string(encoded)
+	}
+	return scoped, bindVars, nil
+}
+
+// owned checks that the document of id doesn't belong to another organisation than the tenant orgId, the document of
+// another organisation doesn't exist for the request
+func (d *TenantWrapper) owned(ctx context.Context, method string, database *mdb.Database, orgId, id string) error {
+	if len(orgId) == 0 {
+		return nil
+	}
+	// the inner wrappers rename the database of their request, the write uses the database of the caller
+	read := *database
+	rsp := &mdb.ReadResponse{}
+	if err := d.DBHandler.Read(ctx, &mdb.ReadRequest{Database: &read, Id: id}, rsp); err != nil {
+		// the wrapped handler fails the write of a document which doesn't exist
+		return nil
+	}
+	if rsp.Record != nil && rsp.Record.Parameter1 != orgId {
+		return errors.NotFound("go.micro.srv.db."+method, "not found")
+	}
+	return nil
+}
+
+func (d *TenantWrapper) Create(ctx context.Context, req *mdb.CreateRequest, rsp *mdb.CreateResponse) error {
+	if req.Database == nil {
+		return d.DBHandler.Create(ctx, req, rsp)
+	}
+	orgId, err := tenant.Collection(ctx, req.Database.Name, req.Database.Table)
+	if err != nil {
+		return tenantError("DB.Create", err)
+	}
+	if len(orgId) > 0 && req.Record != nil && req.Record.Parameter1 != orgId {
+		return tenantError("DB.Create", tenant.ErrOrganisation)
+	}
+	return d.DBHandler.Create(ctx, req, rsp)
+}
+
+func (d *TenantWrapper) Update(ctx context.Context, req *mdb.UpdateRequest, rsp *mdb.UpdateResponse) error {
+	if req.Database == nil || req.Record == nil {
+		return d.DBHandler.Update(ctx, req, rsp)
+	}
+	orgId, err := tenant.Collection(ctx, req.Database.Name, req.Database.Table)
+	if err != nil {
+		return tenantError("DB.Update", err)
+	}
+	if len(orgId) > 0 && req.Record.Parameter1 != orgId {
+		return tenantError("DB.Update", tenant.ErrOrganisation)
+	}
+	if err := d.owned(ctx, "DB.Update", req.Database, orgId, req.Record.Id); err != nil {
+		return err
+	}
+	return d.DBHandler.Update(ctx, req, rsp)
+}
+
+func (d *TenantWrapper) Delete(ctx context.Context, req *mdb.DeleteRequest, rsp *mdb.DeleteResponse) error {
+	if req.Database == nil {
+		return d.DBHandler.Delete(ctx, req, rsp)
+	}
+	orgId, err := tenant.Collection(ctx, req.Database.Name, req.Database.Table)
+	if err != nil {
+		return tenantError("DB.Delete", err)
+	}
+	if err := d.owned(ctx, "DB.Delete", req.Database, orgId, req.Id); err != nil {
+		return err
+	}
+	return d.DBHandler.Delete(ctx, req, rsp)
+}
+
+func (d *TenantWrapper) Read(ctx context.Context, req *mdb.ReadRequest, rsp *mdb.ReadResponse) error {
+	if req.Database == nil {
+		return d.DBHandler.Read(ctx, req, rsp)
+	}
+	orgId, err := tenant.Collection(ctx, req.Database.Name, req.Database.Table)
+	if err != nil {
+		return tenantError("DB.Read", err)
+	}
+	if err := d.DBHandler.Read(ctx, req, rsp); err != nil {
+		return err
+	}
+	// the document of another organisation doesn't exist for the request
+	if len(orgId) > 0 && rsp.Record != nil && rsp.Record.Parameter1 != orgId {
+		rsp.Record = nil
+		return errors.NotFound("go.micro.srv.db.DB.Read", "not found")
+	}
+	return nil
+}
+
+func (d *TenantWrapper) Search(ctx context.Context, req *mdb.SearchRequest, rsp *mdb.SearchResponse) error {
+	if req.Database == nil {
+		return d.DBHandler.Search(ctx, req, rsp)
+	}
+	orgId, err := tenant.Collection(ctx, req.Database.Name, req.Database.Table)
+	if err != nil {
+		return tenantError("DB.Search", err)
+	}
+	if len(orgId) == 0 {
+		return d.DBHandler.Search(ctx, req, rsp)
+	}
+	if req.Metadata == nil {
+		req.Metadata = map[string]string{}
+	}
+	if p, ok := req.Metadata["parameter1"]; ok && p != orgId {
+		return nil
+	}
+	req.Metadata["parameter1"] = orgId
+	if err := d.DBHandler.Search(ctx, req, rsp); err != nil {
+		return err
+	}
+	// the drivers don't match parameter1 with every other metadata
+	records := []*mdb.Record{}
+	for _, r := range rsp.Records {
+		if r.Parameter1 == orgId {
+			records = append(records, r)
+		}
+	}
+	rsp.Records = records
+	return nil
+}
+
+func (d *TenantWrapper) RunQuery(ctx context.Context, req *mdb.RunQueryRequest, rsp *mdb.RunQueryResponse) error {
+	q, vars, err := scopeQuery(ctx, "DB.RunQuery", req.Database, req.Query, req.BindVars)
+	if err != nil {
+		return err
+	}
+	req.Query, req.BindVars = q, vars
+	return d.DBHandler.RunQuery(ctx, req, rsp)
+}
+
+func (d *TenantWrapper) RunQueryStream(ctx context.Context, req *mdb.RunQueryStreamRequest, stream mdb.DB_RunQueryStreamStream) error {
+	q, vars, err := scopeQuery(ctx, "DB.RunQueryStream", req.Database, req.Query, req.BindVars)
+	if err != nil {
+		stream.Close()
+		return err
+	}
+	req.Query, req.BindVars = q, vars
+	return d.DBHandler.RunQueryStream(ctx, req, stream)
+}
+
+func (d *TenantWrapper) Transaction(ctx context.Context, req *mdb.TransactionRequest, rsp *mdb.TransactionResponse) error {
+	if req.Database == nil {
+		return d.DBHandler.Transaction(ctx, req, rsp)
+	}
+	if len(req.Action) > 0 {
+		if err := tenant.Script(ctx, req.Database.Name, append(append([]string{}, req.Read...), req.Write...)); err != nil {
+			return tenantError("DB.Transaction", err)
+		}
+	}
+	for _, op := range req.Operations {
+		q, vars, err := scopeQuery(ctx, "DB.Transaction", req.Database, op.Query, op.BindVars)
+		if err != nil {
+			return err
+		}
+		op.Query, op.BindVars = q, vars
+	}
+	return d.DBHandler.Transaction(ctx, req, rsp)
+}
@@ -0,0 +1,224 @@
+// Package indexer keeps the elasticsearch index of the searchable collections in sync with their database
+//
+// Writes are queued as jobs in the database and applied by a worker, failed jobs are retried with an
+// exponential backoff and moved to a dead letter list after MaxAttempts.
+package indexer
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+const ElasticDriver = "elasticsearch"
+
+var (
+	// PollInterval is the wait between two reads of an empty queue
+	PollInterval = time.Second
+	// BatchSize is the number of jobs read at once
+	BatchSize = 100
+	// MaxAttempts is the number of attempts before a job is dead
+	MaxAttempts = 10
+	MinBackoff  = time.Second
+	MaxBackoff  = time.Hour
+
+	DefaultIndexer = NewIndexer(NewQueue())
+)
+
+// Indexer applies the queued jobs to elasticsearch
+type Indexer struct {
+	sync.RWMutex
+	queue Queue
+	// database/table of the collections seen with the searchable metadata
+	searchable map[string]bool
+	// applies a job, replaced in tests
+	apply func(j *Job) error
+
+	exit chan bool
+	wg   sync.WaitGroup
+}
+
+func NewIndexer(q Queue) *Indexer {
+	return &Indexer{
+		queue:      q,
+		searchable: map[string]bool{},
+		apply:      apply,
+	}
+}
+
+func apply(j *Job) error {
+	if j.Op == OpDelete {
+		if err := db.Delete(j.Target(), j.RecordId, j.ExtraId); err != nil && err != db.ErrNotFound {
+			return err
+		}
+		return nil
+	}
+	return db.Update(j.Target(), j.Record)
+}
+
+// Backoff returns the wait before the next attempt of a job which failed attempts times
+func Backoff(attempts int) time.Duration {
+	d := time.Duration(float64(MinBackoff) * math.Pow(2, float64(attempts-1)))
+	if d > MaxBackoff || d <= 0 {
+		return MaxBackoff
+	}
+	return d
+}
+
+// SetSearchable marks a collection as indexed, writes of the change feed are indexed for these collections
+func (i *Indexer) SetSearchable(database *mdb.Database) {
+	i.Lock()
+	defer i.Unlock()
+	i.searchable[database.Name+"/"+database.Table] = true
+}
+
+func (i *Indexer) isSearchable(database, table string) bool {
+	i.RLock()
+	defer i.RUnlock()
+	return i.searchable[database+"/"+table]
+}
+
+// Enqueue adds a job which is applied by the worker
+func (i *Indexer) Enqueue(j *Job) error {
+	j.Attempts = 0
+	j.NextAttempt = time.Now().Unix()
+	return i.queue.Push(j)
+}
+
+// HandleChange queues the change feed events of the searchable collections,
+// it indexes the writes of RunQuery and Transaction which the wrapper can't see
+func (i *Indexer) HandleChange(e *mdb.ChangeEvent) error {
+	if !i.isSearchable(e.Database, e.Collection) {
+		return nil
+	}
+	j := &Job{
+		Database: e.Database,
+		Table:    e.Collection,
+		RecordId: e.Id,
+	}
+	if e.Type == mdb.ChangeType_DELETE {
+		j.Op = OpDelete
+		return i.Enqueue(j)
+	}
+
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(e.New), &doc); err != nil {
+		return err
+	}
+	r, err := DocToRecord(doc)
+	if err != nil {
+		return err
+	}
+	j.Op = OpIndex
+	j.Record = r
+	return i.Enqueue(j)
+}
+
+// DocToRecord converts a database document the way the arangodb driver does, data is json encoded in parameter3
+func DocToRecord(doc map[string]interface{}) (*mdb.Record, error) {
+	r := &mdb.Record{}
+	data := doc["data"]
+	delete(doc, "data")
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, r); err != nil {
+		return nil, err
+	}
+	r.Parameter3 = ""
+	if data != nil {
+		body, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		r.Parameter3 = string(body)
+	}
+	return r, nil
+}
+
+// Start runs the worker until Stop is called
+func (i *Indexer) Start() {
+	i.exit = make(chan bool)
+	i.wg.Add(1)
+	go i.run()
+}
+
+// Stop waits for the current batch to complete
+func (i *Indexer) Stop() {
+	close(i.exit)
+	i.wg.Wait()
+}
+
+func (i *Indexer) run() {
+	defer i.wg.Done()
+	t := time.NewTicker(PollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-i.exit:
+			return
+		case <-t.C:
+			// drain the due jobs before waiting again
+			for {
+				n, err := i.process(time.Now())
+				if err != nil {
+					common.ErrorLog(common.DbSrv, i.process, err, "Index queue read is failed")
+				}
+				if err != nil || n < BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// process applies the due jobs, it returns the number of jobs read
+func (i *Indexer) process(now time.Time) (int, error) {
+	jobs, err := i.queue.Due(now.Unix(), BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, j := range jobs {
+		err := i.apply(j)
+		switch {
+		case err == nil:
+			err = i.queue.Done(j)
+		case j.Attempts+1 >= MaxAttempts:
+			common.ErrorLog(common.DbSrv, i.process, err, "Index job is dead")
+			j.Attempts++
+			j.Error = err.Error()
+			err = i.queue.Dead(j)
+		default:
+			j.Attempts++
+			j.Error = err.Error()
+			j.NextAttempt = now.Add(Backoff(j.Attempts)).Unix()
+			err = i.queue.Retry(j)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(jobs), nil
+}
+
+// Enqueue adds a job to the default indexer
+func Enqueue(j *Job) error {
+	return DefaultIndexer.Enqueue(j)
+}
+
+// Reindex rebuilds the index of a collection with the default indexer
+func Reindex(source *mdb.Database, orgId string, batchSize int, progress func(*mdb.ReindexResponse) error) error {
+	return DefaultIndexer.Reindex(source, orgId, batchSize, progress)
+}
+
+// Check compares the index of a collection to its records with the default indexer
+func Check(source *mdb.Database, orgId string, batchSize int) (*mdb.CheckIndexResponse, error) {
+	return DefaultIndexer.Check(source, orgId, batchSize)
+}
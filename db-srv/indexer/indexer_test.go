@@ -0,0 +1,160 @@
+package indexer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mdb "server/db-srv/proto/db"
+)
+
+type testQueue struct {
+	jobs map[string]*Job
+	dead []*Job
+}
+
+func newTestQueue() *testQueue {
+	return &testQueue{jobs: map[string]*Job{}}
+}
+
+func (q *testQueue) Push(j *Job) error {
+	q.jobs[j.Key()] = j
+	return nil
+}
+
+func (q *testQueue) Due(now int64, limit int) ([]*Job, error) {
+	jobs := []*Job{}
+	for _, j := range q.jobs {
+		if j.NextAttempt <= now && len(jobs) < limit {
+			jobs = append(jobs, j)
+		}
+	}
+	return jobs, nil
+}
+
+func (q *testQueue) Retry(j *Job) error {
+	return nil
+}
+
+func (q *testQueue) Done(j *Job) error {
+	delete(q.jobs, j.Key())
+	return nil
+}
+
+func (q *testQueue) Dead(j *Job) error {
+	q.dead = append(q.dead, j)
+	return q.Done(j)
+}
+
+func (q *testQueue) DeadLetters(limit int) ([]*Job, error) {
+	return q.dead, nil
+}
+
+func TestBackoff(t *testing.T) {
+	if Backoff(1) != MinBackoff {
+		t.Errorf("First retry must wait %v, got %v", MinBackoff, Backoff(1))
+	}
+	if Backoff(3) != 4*MinBackoff {
+		t.Errorf("Backoff must double, got %v", Backoff(3))
+	}
+	if Backoff(100) != MaxBackoff {
+		t.Errorf("Backoff must be capped, got %v", Backoff(100))
+	}
+}
+
+func TestProcessRetriesAndDeadLetters(t *testing.T) {
+	q := newTestQueue()
+	i := NewIndexer(q)
+	applied := 0
+	i.apply = func(j *Job) error {
+		applied++
+		return errors.New("elasticsearch is down")
+	}
+
+	j := &Job{Op: OpIndex, Database: "healum", Table: "goal", RecordId: "g1", Record: &mdb.Record{Id: "g1"}}
+	if err := i.Enqueue(j); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	for n := 0; n < MaxAttempts; n++ {
+		if _, err := i.process(now); err != nil {
+			t.Fatal(err)
+		}
+		if n < MaxAttempts-1 {
+			if j.Attempts != n+1 || j.NextAttempt != now.Add(Backoff(n+1)).Unix() {
+				t.Fatalf("Failed job must be retried later, got %+v", j)
+			}
+		}
+		// the next attempt is due
+		now = time.Unix(j.NextAttempt, 0)
+	}
+
+	if applied != MaxAttempts {
+		t.Errorf("Job must be attempted %d times, got %d", MaxAttempts, applied)
+	}
+	if len(q.jobs) != 0 || len(q.dead) != 1 {
+		t.Fatal("Job must be moved to the dead letters")
+	}
+	if q.dead[0].Error != "elasticsearch is down" {
+		t.Error("Dead letter must keep the error")
+	}
+}
+
+func TestProcessDone(t *testing.T) {
+	q := newTestQueue()
+	i := NewIndexer(q)
+	i.apply = func(j *Job) error {
+		return nil
+	}
+	i.Enqueue(&Job{Op: OpDelete, Database: "healum", Table: "goal", RecordId: "g1"})
+	if n, err := i.process(time.Now()); err != nil || n != 1 {
+		t.Fatal(n, err)
+	}
+	if len(q.jobs) != 0 {
+		t.Error("Applied job must be removed")
+	}
+}
+
+func TestHandleChangeSearchable(t *testing.T) {
+	q := newTestQueue()
+	i := NewIndexer(q)
+	e := &mdb.ChangeEvent{
+		Type:       mdb.ChangeType_UPDATE,
+		Database:   "healum",
+		Collection: "goal",
+		Id:         "g1",
+		New:        `{"_key":"g1","id":"g1","updated":2,"parameter1":"o1","data":{"title":"run"}}`,
+	}
+	if err := i.HandleChange(e); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.jobs) != 0 {
+		t.Fatal("Collection which isn't searchable must not be indexed")
+	}
+
+	i.SetSearchable(&mdb.Database{Name: "healum", Table: "goal"})
+	if err := i.HandleChange(e); err != nil {
+		t.Fatal(err)
+	}
+	if len(q.jobs) != 1 {
+		t.Fatal("Change must be queued")
+	}
+	for _, j := range q.jobs {
+		r := j.Record
+		if j.Op != OpIndex || r.Id != "g1" || r.Updated != 2 || r.Parameter1 != "o1" || r.Parameter3 != `{"title":"run"}` {
+			t.Errorf("Record is invalid: %+v", r)
+		}
+	}
+
+	// a newer change of the record replaces the pending job
+	e.Type = mdb.ChangeType_DELETE
+	if err := i.HandleChange(e); err != nil {
+		t.Fatal(err)
+	}
+	for _, j := range q.jobs {
+		if j.Op != OpDelete || len(q.jobs) != 1 {
+			t.Error("Delete must replace the pending index")
+		}
+	}
+}
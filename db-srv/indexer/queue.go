@@ -0,0 +1,227 @@
+package indexer
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+const (
+	OpIndex  = "index"
+	OpDelete = "delete"
+)
+
+// Job indexes or deletes a record in elasticsearch
+type Job struct {
+	Op       string
+	Database string
+	Table    string
+	RecordId string
+	// extra id of the delete, see DB.Delete
+	ExtraId string
+	// the record to index
+	Record *mdb.Record
+
+	Attempts    int
+	NextAttempt int64
+	Error       string
+	// set when the job is pushed, a job replaced by a newer one isn't updated
+	version int64
+}
+
+// Key identifies the record of the job, there is one pending job per record
+func (j *Job) Key() string {
+	h := sha1.Sum([]byte(j.Database + "/" + j.Table + "/" + j.RecordId))
+	return hex.EncodeToString(h[:])
+}
+
+// Target returns the elasticsearch index of the job
+func (j *Job) Target() *mdb.Database {
+	return &mdb.Database{
+		Name:   j.Database,
+		Table:  j.Table,
+		Driver: ElasticDriver,
+	}
+}
+
+// Queue stores the pending jobs
+type Queue interface {
+	// Push adds a job, it replaces the pending job of the same record
+	Push(j *Job) error
+	// Due returns the jobs to run at the time, the earliest first
+	Due(now int64, limit int) ([]*Job, error)
+	// Retry saves the attempts of a failed job
+	Retry(j *Job) error
+	Done(j *Job) error
+	// Dead moves a job to the dead letter list
+	Dead(j *Job) error
+	DeadLetters(limit int) ([]*Job, error)
+}
+
+// jobs are stored as generic records: name is the table, parameter1 the database, parameter2 the operation
+// and updated the version
+type jobData struct {
+	RecordId    string      `json:"record_id"`
+	ExtraId     string      `json:"extra_id,omitempty"`
+	Record      *mdb.Record `json:"record,omitempty"`
+	Attempts    int         `json:"attempts"`
+	NextAttempt int64       `json:"next_attempt"`
+	Error       string      `json:"error,omitempty"`
+}
+
+// dbQueue stores the jobs in the healum database through db-srv connections
+type dbQueue struct {
+	database *mdb.Database
+}
+
+// NewQueue returns a queue using the index queue collection of the healum database
+func NewQueue() Queue {
+	return &dbQueue{
+		database: &mdb.Database{
+			Name:   common.DbHealumName,
+			Table:  common.DbIndexQueueTable,
+			Driver: common.DbHealumDriver,
+		},
+	}
+}
+
+func jobDoc(j *Job) map[string]interface{} {
+	return map[string]interface{}{
+		"_key":       j.Key(),
+		"id":         j.Key(),
+		"created":    time.Now().Unix(),
+		"updated":    j.version,
+		"name":       j.Table,
+		"parameter1": j.Database,
+		"parameter2": j.Op,
+		"data": &jobData{
+			RecordId:    j.RecordId,
+			ExtraId:     j.ExtraId,
+			Record:      j.Record,
+			Attempts:    j.Attempts,
+			NextAttempt: j.NextAttempt,
+			Error:       j.Error,
+		},
+	}
+}
+
+func recordToJob(r *mdb.Record) (*Job, error) {
+	data := &jobData{}
+	if err := json.Unmarshal([]byte(r.Parameter3), data); err != nil {
+		return nil, err
+	}
+	return &Job{
+		Op:          r.Parameter2,
+		Database:    r.Parameter1,
+		Table:       r.Name,
+		RecordId:    data.RecordId,
+		ExtraId:     data.ExtraId,
+		Record:      data.Record,
+		Attempts:    data.Attempts,
+		NextAttempt: data.NextAttempt,
+		Error:       data.Error,
+		version:     r.Updated,
+	}, nil
+}
+
+func (q *dbQueue) Push(j *Job) error {
+	// microseconds, nanoseconds don't fit in a json number
+	j.version = time.Now().UnixNano() / int64(time.Microsecond)
+	query := fmt.Sprintf(`
+		UPSERT { _key: @key }
+		INSERT @doc
+		REPLACE @doc
+		IN %s`, common.DbIndexQueueTable)
+	_, err := db.RunQuery(q.database, query, map[string]interface{}{
+		"key": j.Key(),
+		"doc": jobDoc(j),
+	})
+	return err
+}
+
+func (q *dbQueue) Due(now int64, limit int) ([]*Job, error) {
+	query := fmt.Sprintf(`
+		FOR doc IN %s
+		FILTER doc.data.next_attempt <= @now
+		SORT doc.data.next_attempt
+		LIMIT @limit
+		RETURN doc`, common.DbIndexQueueTable)
+	records, err := db.RunQuery(q.database, query, map[string]interface{}{
+		"now":   now,
+		"limit": limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recordsToJobs(records)
+}
+
+func (q *dbQueue) Retry(j *Job) error {
+	query := fmt.Sprintf(`
+		FOR doc IN %s
+		FILTER doc._key == @key && doc.updated == @version
+		UPDATE doc WITH { data: { attempts: @attempts, next_attempt: @next_attempt, error: @error } } IN %s`,
+		common.DbIndexQueueTable, common.DbIndexQueueTable)
+	_, err := db.RunQuery(q.database, query, map[string]interface{}{
+		"key":          j.Key(),
+		"version":      j.version,
+		"attempts":     j.Attempts,
+		"next_attempt": j.NextAttempt,
+		"error":        j.Error,
+	})
+	return err
+}
+
+func (q *dbQueue) Done(j *Job) error {
+	query := fmt.Sprintf(`
+		FOR doc IN %s
+		FILTER doc._key == @key && doc.updated == @version
+		REMOVE doc IN %s`, common.DbIndexQueueTable, common.DbIndexQueueTable)
+	_, err := db.RunQuery(q.database, query, map[string]interface{}{
+		"key":     j.Key(),
+		"version": j.version,
+	})
+	return err
+}
+
+func (q *dbQueue) Dead(j *Job) error {
+	doc := jobDoc(j)
+	// a record fails again after it's fixed, every failure is kept
+	doc["_key"] = fmt.Sprintf("%s-%d", j.Key(), j.version)
+	query := fmt.Sprintf(`INSERT @doc INTO %s OPTIONS { ignoreErrors: true }`, common.DbIndexDeadLetterTable)
+	if _, err := db.RunQuery(q.database, query, map[string]interface{}{"doc": doc}); err != nil {
+		return err
+	}
+	return q.Done(j)
+}
+
+func (q *dbQueue) DeadLetters(limit int) ([]*Job, error) {
+	query := fmt.Sprintf(`
+		FOR doc IN %s
+		SORT doc.created DESC
+		LIMIT @limit
+		RETURN doc`, common.DbIndexDeadLetterTable)
+	records, err := db.RunQuery(q.database, query, map[string]interface{}{"limit": limit})
+	if err != nil {
+		return nil, err
+	}
+	return recordsToJobs(records)
+}
+
+func recordsToJobs(records []*mdb.Record) ([]*Job, error) {
+	jobs := []*Job{}
+	for _, r := range records {
+		j, err := recordToJob(r)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,188 @@
+package indexer
+
+import (
+	"fmt"
+	"io"
+
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+// IndexedPageSize is the number of indexed records read per request by Reindex and Check, the pages follow each
+// other with search_after so a collection can have more records than the max_result_window of elasticsearch
+var IndexedPageSize = 1000
+
+// the database is the source of truth, its collections are read with AQL
+const sourceQuery = `
+	FOR doc IN @@collection
+	FILTER @org_id == "" || doc.parameter1 == @org_id
+	RETURN doc`
+
+// RunQuery only returns records, the count is returned in created
+const countQuery = `
+	RETURN { created: LENGTH(
+		FOR doc IN @@collection
+		FILTER @org_id == "" || doc.parameter1 == @org_id
+		RETURN 1
+	) }`
+
+func sourceVars(source *mdb.Database, orgId string) map[string]interface{} {
+	return map[string]interface{}{
+		"@collection": source.Table,
+		"org_id":      orgId,
+	}
+}
+
+func target(source *mdb.Database) *mdb.Database {
+	return &mdb.Database{
+		Name:   source.Name,
+		Table:  source.Table,
+		Driver: ElasticDriver,
+	}
+}
+
+// indexed returns the updated time of the indexed records by id
+func indexed(source *mdb.Database, orgId string) (map[string]int64, error) {
+	match := `{"match_all": {}}`
+	if len(orgId) > 0 {
+		match = `{"match_phrase": {"parameter1": @org_id}}`
+	}
+	// the index ids are the record ids
+	query := fmt.Sprintf(`{"size": %d, "_source": ["id", "updated", "parameter1"], "query": %s, "sort": [{"_id": "asc"}]`, IndexedPageSize, match)
+	ids := map[string]int64{}
+	after := ""
+	for {
+		page := query + `}`
+		if len(after) > 0 {
+			page = query + `, "search_after": [@after]}`
+		}
+		records, err := db.RunQuery(target(source), page, map[string]interface{}{"org_id": orgId, "after": after})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			after = r.Id
+			// the phrase matches organisations sharing words with the id
+			if len(orgId) > 0 && r.Parameter1 != orgId {
+				continue
+			}
+			ids[r.Id] = r.Updated
+		}
+		if len(records) < IndexedPageSize {
+			return ids, nil
+		}
+	}
+}
+
+// each calls fn with the batches of source records
+func each(source *mdb.Database, orgId string, batchSize int, fn func([]*mdb.Record) error) error {
+	cursor, err := db.RunQueryStream(source, sourceQuery, sourceVars(source, orgId), batchSize)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for {
+		records, err := cursor.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(records); err != nil {
+			return err
+		}
+	}
+}
+
+// Reindex indexes the records of a collection and removes the indexed records which aren't in it,
+// progress is called after every batch. Records which fail are queued.
+func (i *Indexer) Reindex(source *mdb.Database, orgId string, batchSize int, progress func(*mdb.ReindexResponse) error) error {
+	i.SetSearchable(source)
+
+	count, err := db.RunQuery(source, countQuery, sourceVars(source, orgId))
+	if err != nil {
+		return err
+	}
+	p := &mdb.ReindexResponse{}
+	if len(count) > 0 {
+		p.Total = count[0].Created
+	}
+
+	seen := map[string]bool{}
+	err = each(source, orgId, batchSize, func(records []*mdb.Record) error {
+		for _, r := range records {
+			seen[r.Id] = true
+			j := &Job{Op: OpIndex, Database: source.Name, Table: source.Table, RecordId: r.Id, Record: r}
+			if err := i.apply(j); err != nil {
+				if err := i.Enqueue(j); err != nil {
+					return err
+				}
+				p.Failed++
+				continue
+			}
+			p.Indexed++
+		}
+		return progress(p)
+	})
+	if err != nil {
+		return err
+	}
+
+	ids, err := indexed(source, orgId)
+	if err != nil {
+		return err
+	}
+	for id := range ids {
+		if seen[id] {
+			continue
+		}
+		j := &Job{Op: OpDelete, Database: source.Name, Table: source.Table, RecordId: id}
+		if err := i.apply(j); err != nil {
+			if err := i.Enqueue(j); err != nil {
+				return err
+			}
+			p.Failed++
+			continue
+		}
+		p.Removed++
+	}
+
+	p.Done = true
+	return progress(p)
+}
+
+// Check compares the index of a collection to its records
+func (i *Indexer) Check(source *mdb.Database, orgId string, batchSize int) (*mdb.CheckIndexResponse, error) {
+	ids, err := indexed(source, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &mdb.CheckIndexResponse{
+		Missing: []string{},
+		Stale:   []string{},
+		Extra:   []string{},
+	}
+	err = each(source, orgId, batchSize, func(records []*mdb.Record) error {
+		for _, r := range records {
+			rsp.Checked++
+			updated, ok := ids[r.Id]
+			switch {
+			case !ok:
+				rsp.Missing = append(rsp.Missing, r.Id)
+			case updated < r.Updated:
+				rsp.Stale = append(rsp.Stale, r.Id)
+			}
+			delete(ids, r.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for id := range ids {
+		rsp.Extra = append(rsp.Extra, id)
+	}
+	return rsp, nil
+}
@@ -2,16 +2,24 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"server/common"
+	"server/db-srv/backup"
+	"server/db-srv/changefeed"
 	"server/db-srv/db"
-	_ "server/db-srv/db/arangodb"
+	"server/db-srv/db/arangodb"
 	_ "server/db-srv/db/elastic"
 	_ "server/db-srv/db/influxdb"
+	_ "server/db-srv/db/memory"
 	_ "server/db-srv/db/mysql"
 	_ "server/db-srv/db/redis"
 	"server/db-srv/handler"
+	"server/db-srv/indexer"
+	"server/db-srv/namespace"
 	proto "server/db-srv/proto/db"
+	"server/db-srv/tenant"
+	"server/db-srv/trash"
 	"time"
 
 	"github.com/micro/cli"
@@ -73,22 +81,63 @@ func main() {
 		}),
 	)
 
+	var feed *changefeed.Feed
 	service.Init(
-		// init the db
+		// the db uses the client selector, connections are pooled per node
 		micro.BeforeStart(func() error {
 			sel := service.Client().Options().Selector
-			sel.Init(selector.SetStrategy(selector.RoundRobin))
-			return db.Init(sel)
+			return sel.Init(selector.SetStrategy(selector.RoundRobin))
+		}),
+		// the change feed publishes once the broker is connected
+		micro.AfterStart(func() error {
+			indexer.DefaultIndexer.Start()
+			trash.RetentionDays = conf.Get("trash", "retention_days").Int(trash.RetentionDays)
+			trash.PurgeInterval = conf.Get("trash", "purge_interval").Duration(trash.PurgeInterval)
+			trash.DefaultPurger.Start()
+			backup.Dir = conf.Get("backup", "dir").String(backup.Dir)
+			if common.TestNamespacesEnabled {
+				namespace.IdleTimeout = conf.Get("namespace", "idle_timeout").Duration(namespace.IdleTimeout)
+				namespace.DefaultCleaner.Start()
+			}
+			// the writes of RunQuery and Transaction are only indexed from the feed
+			if !conf.Get("changefeed", "enabled").Bool(true) {
+				return nil
+			}
+			database := &proto.Database{Name: common.DbHealumName, Driver: common.DbHealumDriver}
+			node, err := db.DefaultDB.Node(database)
+			if err != nil {
+				return err
+			}
+			source := changefeed.NewWALSource(fmt.Sprintf("http://%s:%d", node.Address, node.Port), database.Name, arangodb.DBUser, arangodb.DBPass)
+			feed = changefeed.NewFeed(database.Name, source, changefeed.NewStore(), service.Client().Options().Broker)
+			// writes of RunQuery are indexed from the feed
+			feed.Handle(indexer.DefaultIndexer.HandleChange)
+			return feed.Start()
+		}),
+		micro.BeforeStop(func() error {
+			defer indexer.DefaultIndexer.Stop()
+			defer trash.DefaultPurger.Stop()
+			if common.TestNamespacesEnabled {
+				defer namespace.DefaultCleaner.Stop()
+			}
+			if feed == nil {
+				return nil
+			}
+			return feed.Stop()
 		}),
 	)
 
-	db.DefaultDB = db.NewDB(service.Client().Options().Selector)
+	if err := db.Init(service.Client().Options().Selector); err != nil {
+		log.Fatal(err)
+	}
+	defer db.DefaultDB.Close()
 	dbService := &handler.DB{}
-	// init db
+	// bootstrap collections and graphs once
 	if err := dbService.InitDb(context.TODO(), &proto.InitDbRequest{}, &proto.InitDbResponse{}); err != nil {
 		log.Fatal(err)
 	}
-	proto.RegisterDBHandler(service.Server(), handler.NewWrapper(dbService))
+	tenant.DefaultMode = tenant.Mode(conf.Get("tenant", "mode").String(string(tenant.DefaultMode)))
+	proto.RegisterDBHandler(service.Server(), handler.NewTenantWrapper(handler.NewNamespaceWrapper(handler.NewWrapper(dbService))))
 
 	if err := service.Run(); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"context"
+
+	"server/common"
+)
+
+// most queries filter by organisation (parameter1, see common.QueryAuth) and sort by update time
+func init() {
+	Register(&Migration{
+		Version:     1,
+		Description: "index organisation and updated time of the shared collections",
+		Up: func(ctx context.Context, e Executor) error {
+			collections := []string{
+				common.DbGoalTable,
+				common.DbChallengeTable,
+				common.DbHabitTable,
+				common.DbContentTable,
+				common.DbPlanTable,
+				common.DbSurveyTable,
+				common.DbUserTable,
+				common.DbNoteTable,
+				common.DbTodoTable,
+			}
+			for _, c := range collections {
+				if err := e.Run(ctx, nil, &CreateIndex{Collection: c, Type: "hash", Fields: []string{"parameter1"}}); err != nil {
+					return err
+				}
+				if err := e.Run(ctx, nil, &CreateIndex{Collection: c, Type: "skiplist", Fields: []string{"data.updated"}}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
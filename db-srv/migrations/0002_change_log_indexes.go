@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+
+	"server/common"
+)
+
+// the change feed reads the log by sequence (parameter2), the previous document of a record
+// and purges by creation time
+func init() {
+	Register(&Migration{
+		Version:     2,
+		Description: "index sequence, record and created time of the change log",
+		Up: func(ctx context.Context, e Executor) error {
+			indexes := []*CreateIndex{
+				{Collection: common.DbChangeLogTable, Type: "skiplist", Fields: []string{"parameter2"}, Unique: true},
+				{Collection: common.DbChangeLogTable, Type: "hash", Fields: []string{"name", "data.key"}},
+				{Collection: common.DbChangeLogTable, Type: "skiplist", Fields: []string{"created"}},
+			}
+			for _, i := range indexes {
+				if err := e.Run(ctx, nil, i); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"context"
+
+	"server/common"
+)
+
+// the indexer reads the due jobs of the queue by their next attempt
+func init() {
+	Register(&Migration{
+		Version:     3,
+		Description: "index next attempt of the index queue",
+		Up: func(ctx context.Context, e Executor) error {
+			return e.Run(ctx, nil, &CreateIndex{Collection: common.DbIndexQueueTable, Type: "skiplist", Fields: []string{"data.next_attempt"}})
+		},
+	})
+}
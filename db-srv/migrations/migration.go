@@ -0,0 +1,65 @@
+// Package migrations runs versioned schema and data migrations against the healum arangodb database.
+// Applied versions are recorded in the migration collection, so every migration runs only once.
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	lib "github.com/solher/arangolite"
+	lib_req "github.com/solher/arangolite/requests"
+)
+
+// Executor runs requests against the database being migrated, *arangolite.Database implements it
+type Executor interface {
+	Run(ctx context.Context, v interface{}, q lib.Runnable) error
+}
+
+// Migration is a versioned change of the database
+type Migration struct {
+	// Versions must be unique, migrations are applied in ascending order
+	Version     int64
+	Description string
+	// AQL statements, run in order before Up
+	Queries []string
+	// Up runs changes which can't be expressed in AQL, e.g. creating indexes or renaming collections
+	Up func(ctx context.Context, e Executor) error
+}
+
+var migrations = map[int64]*Migration{}
+
+// Register adds a migration, it's expected to be called from init
+func Register(m *Migration) {
+	if _, ok := migrations[m.Version]; ok {
+		panic(fmt.Sprintf("migration %d is registered twice", m.Version))
+	}
+	migrations[m.Version] = m
+}
+
+// All returns the registered migrations ordered by version
+func All() []*Migration {
+	all := []*Migration{}
+	for _, m := range migrations {
+		all = append(all, m)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Version < all[j].Version
+	})
+	return all
+}
+
+func (m *Migration) apply(ctx context.Context, e Executor) error {
+	for _, q := range m.Queries {
+		// NewAQL formats the query
+		q = strings.Replace(q, `%`, `%%`, -1)
+		if err := e.Run(ctx, nil, lib_req.NewAQL(q)); err != nil {
+			return err
+		}
+	}
+	if m.Up != nil {
+		return m.Up(ctx, e)
+	}
+	return nil
+}
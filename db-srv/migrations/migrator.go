@@ -0,0 +1,162 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"server/common"
+
+	lib "github.com/solher/arangolite"
+	lib_req "github.com/solher/arangolite/requests"
+)
+
+// Status of a registered migration
+type Status struct {
+	Version     int64
+	Description string
+	Applied     bool
+	AppliedAt   int64
+}
+
+type appliedMigration struct {
+	Version     int64  `json:"version"`
+	Description string `json:"description"`
+	Applied     int64  `json:"applied"`
+}
+
+// Migrator applies the registered migrations to a database
+type Migrator struct {
+	db         Executor
+	collection string
+	dryRun     bool
+	out        io.Writer
+}
+
+// NewMigrator returns a migrator for db, with dryRun requests are written to out instead of being run
+func NewMigrator(db Executor, dryRun bool, out io.Writer) *Migrator {
+	return &Migrator{
+		db:         db,
+		collection: common.DbMigrationTable,
+		dryRun:     dryRun,
+		out:        out,
+	}
+}
+
+// dryRunExecutor prints the requests of a migration
+type dryRunExecutor struct {
+	out io.Writer
+}
+
+func (e *dryRunExecutor) Run(ctx context.Context, v interface{}, q lib.Runnable) error {
+	body := string(q.Generate())
+	fmt.Fprintf(e.out, "  %s %s %s\n", q.Method(), q.Path(), body)
+	return nil
+}
+
+// errCollectionNotFound is the error number of arangodb for a missing collection
+const errCollectionNotFound = 1203
+
+// init creates the migration collection, it isn't called by the dry runs and the status which don't write
+func (m *Migrator) init(ctx context.Context) error {
+	err := m.db.Run(ctx, nil, &lib_req.CreateCollection{Name: m.collection})
+	if err != nil && !lib.HasStatusCode(err, 409) {
+		return err
+	}
+	return nil
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int64]*appliedMigration, error) {
+	records := []*appliedMigration{}
+	q := lib_req.NewAQL(`FOR doc IN @@collection RETURN doc`).Bind("@collection", m.collection)
+	err := m.db.Run(ctx, &records, q)
+	// no migration is applied before the collection is created
+	if err != nil && lib.HasErrorNum(err, errCollectionNotFound) {
+		return map[int64]*appliedMigration{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	applied := map[int64]*appliedMigration{}
+	for _, r := range records {
+		applied[r.Version] = r
+	}
+	return applied, nil
+}
+
+// Status reports every registered migration and whether it's applied
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := []*Status{}
+	for _, mig := range All() {
+		s := &Status{
+			Version:     mig.Version,
+			Description: mig.Description,
+		}
+		if a, ok := applied[mig.Version]; ok {
+			s.Applied = true
+			s.AppliedAt = a.Applied
+		}
+		status = append(status, s)
+	}
+	return status, nil
+}
+
+// Up applies the pending migrations up to and including target, all of them if target is 0
+// it stops at the first failing migration, the ones before it stay applied
+func (m *Migrator) Up(ctx context.Context, target int64) ([]*Migration, error) {
+	if !m.dryRun {
+		if err := m.init(ctx); err != nil {
+			return nil, err
+		}
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	done := []*Migration{}
+	for _, mig := range All() {
+		if target > 0 && mig.Version > target {
+			break
+		}
+		if _, ok := applied[mig.Version]; ok {
+			continue
+		}
+
+		if m.dryRun {
+			fmt.Fprintf(m.out, "%d %s (dry run)\n", mig.Version, mig.Description)
+			if err := mig.apply(ctx, &dryRunExecutor{m.out}); err != nil {
+				return done, err
+			}
+			done = append(done, mig)
+			continue
+		}
+
+		fmt.Fprintf(m.out, "%d %s\n", mig.Version, mig.Description)
+		if err := mig.apply(ctx, m.db); err != nil {
+			return done, fmt.Errorf("migration %d failed: %v", mig.Version, err)
+		}
+		if err := m.record(ctx, mig); err != nil {
+			return done, err
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+func (m *Migrator) record(ctx context.Context, mig *Migration) error {
+	q := lib_req.NewAQL(`INSERT {_key: @key, version: @version, description: @description, applied: @applied} INTO @@collection`).
+		Bind("@collection", m.collection).
+		Bind("key", strconv.FormatInt(mig.Version, 10)).
+		Bind("version", mig.Version).
+		Bind("description", mig.Description).
+		Bind("applied", time.Now().Unix())
+	return m.db.Run(ctx, nil, q)
+}
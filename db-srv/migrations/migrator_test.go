@@ -0,0 +1,115 @@
+package migrations
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	lib "github.com/solher/arangolite"
+	lib_req "github.com/solher/arangolite/requests"
+)
+
+// testExecutor fakes the migration collection with the applied versions
+type testExecutor struct {
+	applied []*appliedMigration
+	run     []lib.Runnable
+	created bool
+}
+
+func (e *testExecutor) Run(ctx context.Context, v interface{}, q lib.Runnable) error {
+	if records, ok := v.(*[]*appliedMigration); ok {
+		*records = e.applied
+		return nil
+	}
+	if _, ok := q.(*lib_req.CreateCollection); ok {
+		e.created = true
+		return nil
+	}
+	e.run = append(e.run, q)
+	return nil
+}
+
+func (e *testExecutor) indexes() int {
+	n := 0
+	for _, q := range e.run {
+		if _, ok := q.(*CreateIndex); ok {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMigrationsOrdered(t *testing.T) {
+	all := All()
+	if len(all) == 0 {
+		t.Fatal("No migrations registered")
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Version >= all[i].Version {
+			t.Error("Migrations must be ordered by version")
+		}
+	}
+}
+
+func TestUpSkipsApplied(t *testing.T) {
+	e := &testExecutor{applied: []*appliedMigration{{Version: 1, Applied: 1}}}
+	m := NewMigrator(e, false, &bytes.Buffer{})
+	done, err := m.Up(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 0 || len(e.run) != 0 {
+		t.Error("Applied migration must not run again")
+	}
+
+	e.created = false
+	status, err := m.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status[0].Applied || status[0].AppliedAt != 1 {
+		t.Error("Status must report the applied migration")
+	}
+	if e.created {
+		t.Error("Status must not create the migration collection")
+	}
+}
+
+func TestUpRecordsVersion(t *testing.T) {
+	e := &testExecutor{}
+	m := NewMigrator(e, false, &bytes.Buffer{})
+	done, err := m.Up(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 1 || done[0].Version != 1 {
+		t.Fatal("Migration 1 must be applied")
+	}
+	if e.indexes() == 0 {
+		t.Error("Indexes must be created")
+	}
+	// the last request records the version
+	if _, ok := e.run[len(e.run)-1].(*lib_req.AQL); !ok {
+		t.Error("Applied version must be recorded")
+	}
+}
+
+func TestUpDryRun(t *testing.T) {
+	e := &testExecutor{}
+	out := &bytes.Buffer{}
+	m := NewMigrator(e, true, out)
+	done, err := m.Up(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 1 {
+		t.Fatal("Dry run must report the pending migration")
+	}
+	if len(e.run) != 0 || e.created {
+		t.Error("Dry run must not run anything")
+	}
+	if !strings.Contains(out.String(), "/_api/index") {
+		t.Error("Dry run must print the requests")
+	}
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CreateIndex creates an index on a collection, it's a no-op if the same index exists
+type CreateIndex struct {
+	Collection string   `json:"-"`
+	Type       string   `json:"type"`
+	Fields     []string `json:"fields"`
+	Unique     bool     `json:"unique,omitempty"`
+	Sparse     bool     `json:"sparse,omitempty"`
+}
+
+func (r *CreateIndex) Description() string {
+	return fmt.Sprintf("CREATE %s INDEX ON %s %v", r.Type, r.Collection, r.Fields)
+}
+
+func (r *CreateIndex) Path() string {
+	return "/_api/index?collection=" + r.Collection
+}
+
+func (r *CreateIndex) Method() string {
+	return "POST"
+}
+
+func (r *CreateIndex) Generate() []byte {
+	m, _ := json.Marshal(r)
+	return m
+}
+
+// RenameCollection renames a collection
+type RenameCollection struct {
+	Name    string `json:"-"`
+	NewName string `json:"name"`
+}
+
+func (r *RenameCollection) Description() string {
+	return fmt.Sprintf("RENAME COLLECTION %s TO %s", r.Name, r.NewName)
+}
+
+func (r *RenameCollection) Path() string {
+	return "/_api/collection/" + r.Name + "/rename"
+}
+
+func (r *RenameCollection) Method() string {
+	return "PUT"
+}
+
+func (r *RenameCollection) Generate() []byte {
+	m, _ := json.Marshal(r)
+	return m
+}
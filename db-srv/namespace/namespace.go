@@ -0,0 +1,167 @@
+// Package namespace serves the requests of a test namespace from their own databases. A test suite sends its
+// namespace in the go-micro metadata, see common.NewTestContext, and the databases of its requests are renamed
+// to <database>_<namespace>: they are created by the first request using them, and dropped by RemoveDb or once the
+// namespace is idle for IdleTimeout. The namespaces are ignored by the production builds.
+package namespace
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+var (
+	// IdleTimeout is the time after the last request of a namespace its databases are dropped
+	IdleTimeout = time.Hour
+	// CleanInterval is the wait between two checks of the idle namespaces
+	CleanInterval = 10 * time.Minute
+
+	DefaultCleaner = NewCleaner()
+
+	ErrInvalidNamespace = errors.New("test namespace is invalid")
+)
+
+// Name returns the name of a database in a namespace
+func Name(database, namespace string) string {
+	return database + "_" + namespace
+}
+
+// valid checks that a namespace only has lower case letters and digits, it is a part of the database names
+func valid(namespace string) bool {
+	if len(namespace) == 0 || len(namespace) > 32 {
+		return false
+	}
+	for _, c := range namespace {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Database renames a database of a request to its name in the test namespace of ctx, and returns the namespace.
+// The database isn't changed if ctx has no namespace.
+func Database(ctx context.Context, database *mdb.Database) (string, error) {
+	namespace := common.TestNamespaceFromContext(ctx)
+	if len(namespace) == 0 {
+		return "", nil
+	}
+	if !valid(namespace) {
+		return "", ErrInvalidNamespace
+	}
+	if database == nil || len(database.Name) == 0 {
+		return namespace, nil
+	}
+	database.Name = Name(database.Name, namespace)
+	DefaultCleaner.touch(namespace, database)
+	return namespace, nil
+}
+
+// namespaceDatabases are the databases used by a namespace
+type namespaceDatabases struct {
+	used      time.Time
+	databases map[string]*mdb.Database
+}
+
+// Cleaner drops the databases of the idle namespaces periodically
+type Cleaner struct {
+	sync.Mutex
+	namespaces map[string]*namespaceDatabases
+
+	exit chan bool
+	wg   sync.WaitGroup
+}
+
+func NewCleaner() *Cleaner {
+	return &Cleaner{namespaces: map[string]*namespaceDatabases{}}
+}
+
+func (c *Cleaner) touch(namespace string, database *mdb.Database) {
+	c.Lock()
+	defer c.Unlock()
+	n, ok := c.namespaces[namespace]
+	if !ok {
+		n = &namespaceDatabases{databases: map[string]*mdb.Database{}}
+		c.namespaces[namespace] = n
+	}
+	n.used = time.Now()
+	if _, ok := n.databases[database.Name]; !ok {
+		n.databases[database.Name] = &mdb.Database{Name: database.Name, Driver: database.Driver}
+	}
+}
+
+// Drop drops the databases of a namespace, the healum database of the namespace is dropped even if it wasn't used
+func (c *Cleaner) Drop(namespace string) error {
+	c.Lock()
+	databases := map[string]*mdb.Database{}
+	if n, ok := c.namespaces[namespace]; ok {
+		databases = n.databases
+	}
+	delete(c.namespaces, namespace)
+	c.Unlock()
+
+	healum := Name(common.DbHealumName, namespace)
+	if _, ok := databases[healum]; !ok {
+		databases[healum] = &mdb.Database{Name: healum, Driver: common.DbHealumDriver}
+	}
+	var last error
+	for _, database := range databases {
+		if err := db.DeleteDatabase(database); err != nil {
+			log.WithField("database", database.Name).Error("Namespace database drop is failed: ", err)
+			last = err
+		}
+	}
+	return last
+}
+
+// Clean drops the databases of the namespaces idle since before now - IdleTimeout
+func (c *Cleaner) Clean(now time.Time) {
+	c.Lock()
+	idle := []string{}
+	for namespace, n := range c.namespaces {
+		if now.Sub(n.used) > IdleTimeout {
+			idle = append(idle, namespace)
+		}
+	}
+	c.Unlock()
+
+	for _, namespace := range idle {
+		log.WithField("namespace", namespace).Info("Dropping the databases of an idle test namespace")
+		c.Drop(namespace)
+	}
+}
+
+// Start runs the cleanups until Stop is called
+func (c *Cleaner) Start() {
+	c.exit = make(chan bool)
+	c.wg.Add(1)
+	go c.run()
+}
+
+// Stop waits for the current cleanup to complete
+func (c *Cleaner) Stop() {
+	close(c.exit)
+	c.wg.Wait()
+}
+
+func (c *Cleaner) run() {
+	defer c.wg.Done()
+	t := time.NewTicker(CleanInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-c.exit:
+			return
+		case now := <-t.C:
+			c.Clean(now)
+		}
+	}
+}
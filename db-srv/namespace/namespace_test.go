@@ -0,0 +1,97 @@
+package namespace_test
+
+import (
+	"testing"
+	"time"
+
+	"server/common"
+	"server/common/dbtest"
+	"server/db-srv/namespace"
+	db_proto "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/metadata"
+	"golang.org/x/net/context"
+)
+
+var database = &db_proto.Database{Name: common.DbHealumName, Table: common.DbGoalTable, Driver: common.DbHealumDriver}
+
+func insertGoal(t *testing.T, ctx context.Context, client db_proto.DBClient) {
+	q := `INSERT {_key: "g1", parameter1: "org1", data: {title: "walk"}} INTO ` + common.DbGoalTable
+	if _, err := client.RunQuery(common.NewTenantContext(ctx, "org1"), &db_proto.RunQueryRequest{Database: database, Query: q}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func countGoals(t *testing.T, ctx context.Context, client db_proto.DBClient) int {
+	q := `FOR doc IN ` + common.DbGoalTable + ` RETURN {data: doc}`
+	rsp, err := client.RunQuery(common.NewTenantContext(ctx, "org1"), &db_proto.RunQueryRequest{Database: database, Query: q})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(rsp.Records)
+}
+
+func TestNamespace(t *testing.T) {
+	dbtest.Reset()
+	client := db_proto.NewDBClient(common.DbSrv, dbtest.NewClient())
+	ctx := common.NewTestContext(context.TODO())
+
+	insertGoal(t, ctx, client)
+	if n := countGoals(t, ctx, client); n != 1 {
+		t.Errorf("Goal must be read from the namespace: %v", n)
+	}
+	if n := countGoals(t, context.TODO(), client); n != 0 {
+		t.Errorf("Goal of the namespace must not be read without namespace: %v", n)
+	}
+
+	if _, err := client.RemoveDb(ctx, &db_proto.RemoveDbRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := countGoals(t, ctx, client); n != 0 {
+		t.Errorf("Namespace database must be dropped: %v", n)
+	}
+
+	invalid := metadata.NewContext(context.TODO(), metadata.Metadata{common.TestNamespaceKey: "../healum"})
+	if _, err := client.RunQuery(invalid, &db_proto.RunQueryRequest{Database: database, Query: `RETURN 1`}); err == nil {
+		t.Error("Invalid namespace must be rejected")
+	}
+}
+
+func TestClean(t *testing.T) {
+	dbtest.Reset()
+	client := db_proto.NewDBClient(common.DbSrv, dbtest.NewClient())
+	ctx := common.NewTestContext(context.TODO())
+
+	insertGoal(t, ctx, client)
+	namespace.DefaultCleaner.Clean(time.Now())
+	if n := countGoals(t, ctx, client); n != 1 {
+		t.Errorf("Namespace in use must be kept: %v", n)
+	}
+	namespace.DefaultCleaner.Clean(time.Now().Add(2 * namespace.IdleTimeout))
+	if n := countGoals(t, ctx, client); n != 0 {
+		t.Errorf("Idle namespace must be dropped: %v", n)
+	}
+}
+
+func TestNamespaceWrite(t *testing.T) {
+	dbtest.Reset()
+	client := db_proto.NewDBClient(common.DbSrv, dbtest.NewClient())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "org1")
+
+	insertGoal(t, ctx, client)
+	// the tenant reads the record of the update before the namespace renames the database of the request
+	record := &db_proto.Record{Id: "g1", Parameter1: "org1", Parameter3: `{"title": "run"}`}
+	if _, err := client.Update(ctx, &db_proto.UpdateRequest{Database: database, Record: record}); err != nil {
+		t.Fatal(err)
+	}
+	rsp, err := client.Read(ctx, &db_proto.ReadRequest{Database: database, Id: "g1"})
+	if err != nil || rsp.Record == nil || rsp.Record.Parameter3 != record.Parameter3 {
+		t.Errorf("Record of the namespace must be updated: %v %v", rsp, err)
+	}
+	if _, err := client.Delete(ctx, &db_proto.DeleteRequest{Database: database, Id: "g1"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := countGoals(t, ctx, client); n != 0 {
+		t.Errorf("Record of the namespace must be deleted: %v", n)
+	}
+}
@@ -26,10 +26,37 @@ It has these top-level messages:
 	SearchResponse
 	RunQueryRequest
 	RunQueryResponse
+	RunQueryStreamRequest
+	RunQueryStreamResponse
+	Operation
+	TransactionRequest
+	OperationResult
+	TransactionResponse
 	CreateDatabaseRequest
 	CreateDatabaseResponse
 	DeleteDatabaseRequest
 	DeleteDatabaseResponse
+	ChangeEvent
+	ReadChangesRequest
+	ReadChangesResponse
+	ReindexRequest
+	ReindexResponse
+	CheckIndexRequest
+	CheckIndexResponse
+	TrashRequest
+	TrashResponse
+	ListTrashRequest
+	ListTrashResponse
+	RestoreRequest
+	RestoreResponse
+	PurgeTrashRequest
+	PurgeTrashResponse
+	BackupCollection
+	BackupManifest
+	BackupRequest
+	BackupResponse
+	RestoreBackupRequest
+	RestoreBackupResponse
 */
 package go_micro_srv_db
 
@@ -54,6 +81,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ChangeType int32
+
+const (
+	ChangeType_CREATE ChangeType = 0
+	ChangeType_UPDATE ChangeType = 1
+	ChangeType_DELETE ChangeType = 2
+)
+
+var ChangeType_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "DELETE",
+}
+var ChangeType_value = map[string]int32{
+	"CREATE": 0,
+	"UPDATE": 1,
+	"DELETE": 2,
+}
+
+func (x ChangeType) String() string {
+	return proto.EnumName(ChangeType_name, int32(x))
+}
+func (ChangeType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type Database struct {
 	Name     string            `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Table    string            `protobuf:"bytes,2,opt,name=table" json:"table,omitempty"`
@@ -105,6 +156,11 @@ type Record struct {
 	Lat        float64           `protobuf:"fixed64,8,opt,name=lat" json:"lat,omitempty"`
 	Lng        float64           `protobuf:"fixed64,9,opt,name=lng" json:"lng,omitempty"`
 	Metadata   map[string]string `protobuf:"bytes,10,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// set on the records of the trash
+	DeletedAt int64  `protobuf:"varint,11,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
+	DeletedBy string `protobuf:"bytes,12,opt,name=deleted_by,json=deletedBy" json:"deleted_by,omitempty"`
+	// revision of the document, every write changes it
+	Revision string `protobuf:"bytes,13,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *Record) Reset()                    { *m = Record{} }
@@ -182,6 +238,27 @@ func (m *Record) GetMetadata() map[string]string {
 	return nil
 }
 
+func (m *Record) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
+func (m *Record) GetDeletedBy() string {
+	if m != nil {
+		return m.DeletedBy
+	}
+	return ""
+}
+
+func (m *Record) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type InitDbRequest struct {
 }
 
@@ -297,6 +374,8 @@ func (*CreateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []
 type UpdateRequest struct {
 	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
 	Record   *Record   `protobuf:"bytes,2,opt,name=record" json:"record,omitempty"`
+	// the update fails with a conflict if the record was written since this revision, it isn't checked if empty
+	Revision string `protobuf:"bytes,3,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
@@ -318,7 +397,16 @@ func (m *UpdateRequest) GetRecord() *Record {
 	return nil
 }
 
+func (m *UpdateRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type UpdateResponse struct {
+	// revision of the updated record
+	Revision string `protobuf:"bytes,1,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *UpdateResponse) Reset()                    { *m = UpdateResponse{} }
@@ -326,10 +414,19 @@ func (m *UpdateResponse) String() string            { return proto.CompactTextSt
 func (*UpdateResponse) ProtoMessage()               {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
+func (m *UpdateResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type DeleteRequest struct {
 	Database   *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
 	Id         string    `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
 	Parameter3 string    `protobuf:"bytes,3,opt,name=parameter3" json:"parameter3,omitempty"`
+	// the delete fails with a conflict if the record was written since this revision, it isn't checked if empty
+	Revision string `protobuf:"bytes,4,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
@@ -358,6 +455,13 @@ func (m *DeleteRequest) GetParameter3() string {
 	return ""
 }
 
+func (m *DeleteRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type DeleteResponse struct {
 }
 
@@ -452,6 +556,8 @@ func (m *SearchResponse) GetRecords() []*Record {
 type RunQueryRequest struct {
 	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
 	Query    string    `protobuf:"bytes,2,opt,name=query" json:"query,omitempty"`
+	// bind variables referenced as @name in the query, values are json encoded
+	BindVars map[string]string `protobuf:"bytes,3,rep,name=bind_vars,json=bindVars" json:"bind_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *RunQueryRequest) Reset()                    { *m = RunQueryRequest{} }
@@ -473,6 +579,13 @@ func (m *RunQueryRequest) GetQuery() string {
 	return ""
 }
 
+func (m *RunQueryRequest) GetBindVars() map[string]string {
+	if m != nil {
+		return m.BindVars
+	}
+	return nil
+}
+
 type RunQueryResponse struct {
 	Records []*Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
 }
@@ -489,6 +602,182 @@ func (m *RunQueryResponse) GetRecords() []*Record {
 	return nil
 }
 
+type RunQueryStreamRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	Query    string    `protobuf:"bytes,2,opt,name=query" json:"query,omitempty"`
+	// bind variables referenced as @name in the query, values are json encoded
+	BindVars map[string]string `protobuf:"bytes,3,rep,name=bind_vars,json=bindVars" json:"bind_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// records per streamed response, defaults to 100
+	BatchSize int64 `protobuf:"varint,4,opt,name=batch_size,json=batchSize" json:"batch_size,omitempty"`
+}
+
+func (m *RunQueryStreamRequest) Reset()                    { *m = RunQueryStreamRequest{} }
+func (m *RunQueryStreamRequest) String() string            { return proto.CompactTextString(m) }
+func (*RunQueryStreamRequest) ProtoMessage()               {}
+func (*RunQueryStreamRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *RunQueryStreamRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *RunQueryStreamRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *RunQueryStreamRequest) GetBindVars() map[string]string {
+	if m != nil {
+		return m.BindVars
+	}
+	return nil
+}
+
+func (m *RunQueryStreamRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+type RunQueryStreamResponse struct {
+	Records []*Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *RunQueryStreamResponse) Reset()                    { *m = RunQueryStreamResponse{} }
+func (m *RunQueryStreamResponse) String() string            { return proto.CompactTextString(m) }
+func (*RunQueryStreamResponse) ProtoMessage()               {}
+func (*RunQueryStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *RunQueryStreamResponse) GetRecords() []*Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type Operation struct {
+	Query string `protobuf:"bytes,1,opt,name=query" json:"query,omitempty"`
+	// bind variables referenced as @name in the query, values are json encoded
+	BindVars map[string]string `protobuf:"bytes,2,rep,name=bind_vars,json=bindVars" json:"bind_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *Operation) Reset()                    { *m = Operation{} }
+func (m *Operation) String() string            { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()               {}
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *Operation) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *Operation) GetBindVars() map[string]string {
+	if m != nil {
+		return m.BindVars
+	}
+	return nil
+}
+
+type TransactionRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	// collections read and written by the transaction
+	Read  []string `protobuf:"bytes,2,rep,name=read" json:"read,omitempty"`
+	Write []string `protobuf:"bytes,3,rep,name=write" json:"write,omitempty"`
+	// queries run in order, either all of them are applied or none
+	Operations []*Operation `protobuf:"bytes,4,rep,name=operations" json:"operations,omitempty"`
+	// javascript function run instead of the operations (arangodb only), it must return an array of documents
+	Action string `protobuf:"bytes,5,opt,name=action" json:"action,omitempty"`
+	// json encoded parameters of the action
+	Params string `protobuf:"bytes,6,opt,name=params" json:"params,omitempty"`
+}
+
+func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
+func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactionRequest) ProtoMessage()               {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *TransactionRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetRead() []string {
+	if m != nil {
+		return m.Read
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetWrite() []string {
+	if m != nil {
+		return m.Write
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetOperations() []*Operation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+type OperationResult struct {
+	Records []*Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *OperationResult) Reset()                    { *m = OperationResult{} }
+func (m *OperationResult) String() string            { return proto.CompactTextString(m) }
+func (*OperationResult) ProtoMessage()               {}
+func (*OperationResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *OperationResult) GetRecords() []*Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type TransactionResponse struct {
+	// results in the order of the operations, the action has one result
+	Results []*OperationResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *TransactionResponse) Reset()                    { *m = TransactionResponse{} }
+func (m *TransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*TransactionResponse) ProtoMessage()               {}
+func (*TransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *TransactionResponse) GetResults() []*OperationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type CreateDatabaseRequest struct {
 	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
 }
@@ -496,7 +785,7 @@ type CreateDatabaseRequest struct {
 func (m *CreateDatabaseRequest) Reset()                    { *m = CreateDatabaseRequest{} }
 func (m *CreateDatabaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateDatabaseRequest) ProtoMessage()               {}
-func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *CreateDatabaseRequest) GetDatabase() *Database {
 	if m != nil {
@@ -511,7 +800,7 @@ type CreateDatabaseResponse struct {
 func (m *CreateDatabaseResponse) Reset()                    { *m = CreateDatabaseResponse{} }
 func (m *CreateDatabaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateDatabaseResponse) ProtoMessage()               {}
-func (*CreateDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*CreateDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 type DeleteDatabaseRequest struct {
 	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
@@ -520,7 +809,7 @@ type DeleteDatabaseRequest struct {
 func (m *DeleteDatabaseRequest) Reset()                    { *m = DeleteDatabaseRequest{} }
 func (m *DeleteDatabaseRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteDatabaseRequest) ProtoMessage()               {}
-func (*DeleteDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*DeleteDatabaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *DeleteDatabaseRequest) GetDatabase() *Database {
 	if m != nil {
@@ -535,122 +824,910 @@ type DeleteDatabaseResponse struct {
 func (m *DeleteDatabaseResponse) Reset()                    { *m = DeleteDatabaseResponse{} }
 func (m *DeleteDatabaseResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteDatabaseResponse) ProtoMessage()               {}
-func (*DeleteDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
-
-func init() {
-	proto.RegisterType((*Database)(nil), "go.micro.srv.db.Database")
-	proto.RegisterType((*Record)(nil), "go.micro.srv.db.Record")
-	proto.RegisterType((*InitDbRequest)(nil), "go.micro.srv.db.InitDbRequest")
-	proto.RegisterType((*InitDbResponse)(nil), "go.micro.srv.db.InitDbResponse")
-	proto.RegisterType((*RemoveDbRequest)(nil), "go.micro.srv.db.RemoveDbRequest")
-	proto.RegisterType((*RemoveDbResponse)(nil), "go.micro.srv.db.RemoveDbResponse")
-	proto.RegisterType((*ReadRequest)(nil), "go.micro.srv.db.ReadRequest")
-	proto.RegisterType((*ReadResponse)(nil), "go.micro.srv.db.ReadResponse")
-	proto.RegisterType((*CreateRequest)(nil), "go.micro.srv.db.CreateRequest")
-	proto.RegisterType((*CreateResponse)(nil), "go.micro.srv.db.CreateResponse")
-	proto.RegisterType((*UpdateRequest)(nil), "go.micro.srv.db.UpdateRequest")
-	proto.RegisterType((*UpdateResponse)(nil), "go.micro.srv.db.UpdateResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "go.micro.srv.db.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "go.micro.srv.db.DeleteResponse")
-	proto.RegisterType((*SearchRequest)(nil), "go.micro.srv.db.SearchRequest")
-	proto.RegisterType((*SearchResponse)(nil), "go.micro.srv.db.SearchResponse")
-	proto.RegisterType((*RunQueryRequest)(nil), "go.micro.srv.db.RunQueryRequest")
-	proto.RegisterType((*RunQueryResponse)(nil), "go.micro.srv.db.RunQueryResponse")
-	proto.RegisterType((*CreateDatabaseRequest)(nil), "go.micro.srv.db.CreateDatabaseRequest")
-	proto.RegisterType((*CreateDatabaseResponse)(nil), "go.micro.srv.db.CreateDatabaseResponse")
-	proto.RegisterType((*DeleteDatabaseRequest)(nil), "go.micro.srv.db.DeleteDatabaseRequest")
-	proto.RegisterType((*DeleteDatabaseResponse)(nil), "go.micro.srv.db.DeleteDatabaseResponse")
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ client.Option
-var _ server.Option
-
-// Client API for DB service
-
-type DBClient interface {
-	InitDb(ctx context.Context, in *InitDbRequest, opts ...client.CallOption) (*InitDbResponse, error)
-	RemoveDb(ctx context.Context, in *RemoveDbRequest, opts ...client.CallOption) (*RemoveDbResponse, error)
-	Read(ctx context.Context, in *ReadRequest, opts ...client.CallOption) (*ReadResponse, error)
-	Create(ctx context.Context, in *CreateRequest, opts ...client.CallOption) (*CreateResponse, error)
-	Update(ctx context.Context, in *UpdateRequest, opts ...client.CallOption) (*UpdateResponse, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error)
-	Search(ctx context.Context, in *SearchRequest, opts ...client.CallOption) (*SearchResponse, error)
-	RunQuery(ctx context.Context, in *RunQueryRequest, opts ...client.CallOption) (*RunQueryResponse, error)
-	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...client.CallOption) (*CreateDatabaseResponse, error)
-	DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...client.CallOption) (*DeleteDatabaseResponse, error)
+func (*DeleteDatabaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+// ChangeEvent is published to common.ChangeTopic(collection) for every write of the change feed
+type ChangeEvent struct {
+	// monotonic, consumers resume after the last sequence they processed
+	Sequence   int64      `protobuf:"varint,1,opt,name=sequence" json:"sequence,omitempty"`
+	Type       ChangeType `protobuf:"varint,2,opt,name=type,enum=go.micro.srv.db.ChangeType" json:"type,omitempty"`
+	Database   string     `protobuf:"bytes,3,opt,name=database" json:"database,omitempty"`
+	Collection string     `protobuf:"bytes,4,opt,name=collection" json:"collection,omitempty"`
+	Id         string     `protobuf:"bytes,5,opt,name=id" json:"id,omitempty"`
+	OrgId      string     `protobuf:"bytes,6,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	// json documents, old is empty for creates and new for deletes
+	Old     string `protobuf:"bytes,7,opt,name=old" json:"old,omitempty"`
+	New     string `protobuf:"bytes,8,opt,name=new" json:"new,omitempty"`
+	Created int64  `protobuf:"varint,9,opt,name=created" json:"created,omitempty"`
+}
+
+func (m *ChangeEvent) Reset()                    { *m = ChangeEvent{} }
+func (m *ChangeEvent) String() string            { return proto.CompactTextString(m) }
+func (*ChangeEvent) ProtoMessage()               {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *ChangeEvent) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-type dBClient struct {
-	c           client.Client
-	serviceName string
+func (m *ChangeEvent) GetType() ChangeType {
+	if m != nil {
+		return m.Type
+	}
+	return ChangeType_CREATE
 }
 
-func NewDBClient(serviceName string, c client.Client) DBClient {
-	if c == nil {
-		c = client.NewClient()
-	}
-	if len(serviceName) == 0 {
-		serviceName = "go.micro.srv.db"
-	}
-	return &dBClient{
-		c:           c,
-		serviceName: serviceName,
+func (m *ChangeEvent) GetDatabase() string {
+	if m != nil {
+		return m.Database
 	}
+	return ""
 }
 
-func (c *dBClient) InitDb(ctx context.Context, in *InitDbRequest, opts ...client.CallOption) (*InitDbResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "DB.InitDb", in)
-	out := new(InitDbResponse)
-	err := c.c.Call(ctx, req, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChangeEvent) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dBClient) RemoveDb(ctx context.Context, in *RemoveDbRequest, opts ...client.CallOption) (*RemoveDbResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "DB.RemoveDb", in)
-	out := new(RemoveDbResponse)
-	err := c.c.Call(ctx, req, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChangeEvent) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dBClient) Read(ctx context.Context, in *ReadRequest, opts ...client.CallOption) (*ReadResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "DB.Read", in)
-	out := new(ReadResponse)
-	err := c.c.Call(ctx, req, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChangeEvent) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dBClient) Create(ctx context.Context, in *CreateRequest, opts ...client.CallOption) (*CreateResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "DB.Create", in)
-	out := new(CreateResponse)
-	err := c.c.Call(ctx, req, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChangeEvent) GetOld() string {
+	if m != nil {
+		return m.Old
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dBClient) Update(ctx context.Context, in *UpdateRequest, opts ...client.CallOption) (*UpdateResponse, error) {
-	req := c.c.NewRequest(c.serviceName, "DB.Update", in)
-	out := new(UpdateResponse)
-	err := c.c.Call(ctx, req, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ChangeEvent) GetNew() string {
+	if m != nil {
+		return m.New
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error) {
+func (m *ChangeEvent) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+type ReadChangesRequest struct {
+	// all collections if empty
+	Collection string `protobuf:"bytes,1,opt,name=collection" json:"collection,omitempty"`
+	// events with a greater sequence are returned
+	FromSequence int64 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence" json:"from_sequence,omitempty"`
+	Limit        int64 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ReadChangesRequest) Reset()                    { *m = ReadChangesRequest{} }
+func (m *ReadChangesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadChangesRequest) ProtoMessage()               {}
+func (*ReadChangesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *ReadChangesRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *ReadChangesRequest) GetFromSequence() int64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
+}
+
+func (m *ReadChangesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ReadChangesResponse struct {
+	Events []*ChangeEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *ReadChangesResponse) Reset()                    { *m = ReadChangesResponse{} }
+func (m *ReadChangesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadChangesResponse) ProtoMessage()               {}
+func (*ReadChangesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *ReadChangesResponse) GetEvents() []*ChangeEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// rebuilds the elasticsearch index of a collection from the database
+type ReindexRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	// all organisations if empty
+	OrgId     string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	BatchSize int64  `protobuf:"varint,3,opt,name=batch_size,json=batchSize" json:"batch_size,omitempty"`
+}
+
+func (m *ReindexRequest) Reset()                    { *m = ReindexRequest{} }
+func (m *ReindexRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReindexRequest) ProtoMessage()               {}
+func (*ReindexRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *ReindexRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *ReindexRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ReindexRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+// progress of a reindex, sent after every batch and once done
+type ReindexResponse struct {
+	Total   int64 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	Indexed int64 `protobuf:"varint,2,opt,name=indexed" json:"indexed,omitempty"`
+	// failed records are retried by the indexing queue
+	Failed int64 `protobuf:"varint,3,opt,name=failed" json:"failed,omitempty"`
+	// records which are in the index but not in the database
+	Removed int64 `protobuf:"varint,4,opt,name=removed" json:"removed,omitempty"`
+	Done    bool  `protobuf:"varint,5,opt,name=done" json:"done,omitempty"`
+}
+
+func (m *ReindexResponse) Reset()                    { *m = ReindexResponse{} }
+func (m *ReindexResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReindexResponse) ProtoMessage()               {}
+func (*ReindexResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *ReindexResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ReindexResponse) GetIndexed() int64 {
+	if m != nil {
+		return m.Indexed
+	}
+	return 0
+}
+
+func (m *ReindexResponse) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *ReindexResponse) GetRemoved() int64 {
+	if m != nil {
+		return m.Removed
+	}
+	return 0
+}
+
+func (m *ReindexResponse) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+// compares the elasticsearch index of a collection to the database
+type CheckIndexRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	// all organisations if empty
+	OrgId string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+}
+
+func (m *CheckIndexRequest) Reset()                    { *m = CheckIndexRequest{} }
+func (m *CheckIndexRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckIndexRequest) ProtoMessage()               {}
+func (*CheckIndexRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *CheckIndexRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *CheckIndexRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+type CheckIndexResponse struct {
+	Checked int64 `protobuf:"varint,1,opt,name=checked" json:"checked,omitempty"`
+	// ids of the records which aren't indexed
+	Missing []string `protobuf:"bytes,2,rep,name=missing" json:"missing,omitempty"`
+	// ids of the records which are indexed with an older version
+	Stale []string `protobuf:"bytes,3,rep,name=stale" json:"stale,omitempty"`
+	// ids of the indexed records which aren't in the database
+	Extra []string `protobuf:"bytes,4,rep,name=extra" json:"extra,omitempty"`
+}
+
+func (m *CheckIndexResponse) Reset()                    { *m = CheckIndexResponse{} }
+func (m *CheckIndexResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckIndexResponse) ProtoMessage()               {}
+func (*CheckIndexResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *CheckIndexResponse) GetChecked() int64 {
+	if m != nil {
+		return m.Checked
+	}
+	return 0
+}
+
+func (m *CheckIndexResponse) GetMissing() []string {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+func (m *CheckIndexResponse) GetStale() []string {
+	if m != nil {
+		return m.Stale
+	}
+	return nil
+}
+
+func (m *CheckIndexResponse) GetExtra() []string {
+	if m != nil {
+		return m.Extra
+	}
+	return nil
+}
+
+// moves a document and its edges to the trash, the queries of the collection don't see it anymore
+type TrashRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	Id       string    `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	// the document is only trashed if it belongs to the organisation, any document if empty
+	OrgId     string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	DeletedBy string `protobuf:"bytes,4,opt,name=deleted_by,json=deletedBy" json:"deleted_by,omitempty"`
+}
+
+func (m *TrashRequest) Reset()                    { *m = TrashRequest{} }
+func (m *TrashRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrashRequest) ProtoMessage()               {}
+func (*TrashRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *TrashRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *TrashRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetDeletedBy() string {
+	if m != nil {
+		return m.DeletedBy
+	}
+	return ""
+}
+
+type TrashResponse struct {
+	Record *Record `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *TrashResponse) Reset()                    { *m = TrashResponse{} }
+func (m *TrashResponse) String() string            { return proto.CompactTextString(m) }
+func (*TrashResponse) ProtoMessage()               {}
+func (*TrashResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *TrashResponse) GetRecord() *Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	// collections of the trashed documents, all of them if empty
+	Tables []string `protobuf:"bytes,2,rep,name=tables" json:"tables,omitempty"`
+	// all organisations if empty
+	OrgId  string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit  int64  `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListTrashRequest) Reset()                    { *m = ListTrashRequest{} }
+func (m *ListTrashRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTrashRequest) ProtoMessage()               {}
+func (*ListTrashRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *ListTrashRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *ListTrashRequest) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *ListTrashRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ListTrashRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListTrashRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	// the latest deleted first, parameter2 is the collection of the record
+	Records []*Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *ListTrashResponse) Reset()                    { *m = ListTrashResponse{} }
+func (m *ListTrashResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListTrashResponse) ProtoMessage()               {}
+func (*ListTrashResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *ListTrashResponse) GetRecords() []*Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// moves a trashed document and its edges back to the collection
+type RestoreRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	Id       string    `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	// the document is only restored if it belongs to the organisation, any document if empty
+	OrgId string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()               {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *RestoreRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *RestoreRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+type RestoreResponse struct {
+	Record *Record `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *RestoreResponse) Reset()                    { *m = RestoreResponse{} }
+func (m *RestoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreResponse) ProtoMessage()               {}
+func (*RestoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *RestoreResponse) GetRecord() *Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+// removes the trashed documents after the trash retention of their organisation setting
+type PurgeTrashRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	// unix time the retention is counted from, now if zero
+	Now int64 `protobuf:"varint,2,opt,name=now" json:"now,omitempty"`
+}
+
+func (m *PurgeTrashRequest) Reset()                    { *m = PurgeTrashRequest{} }
+func (m *PurgeTrashRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeTrashRequest) ProtoMessage()               {}
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *PurgeTrashRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *PurgeTrashRequest) GetNow() int64 {
+	if m != nil {
+		return m.Now
+	}
+	return 0
+}
+
+type PurgeTrashResponse struct {
+	Purged int64 `protobuf:"varint,1,opt,name=purged" json:"purged,omitempty"`
+}
+
+func (m *PurgeTrashResponse) Reset()                    { *m = PurgeTrashResponse{} }
+func (m *PurgeTrashResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeTrashResponse) ProtoMessage()               {}
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *PurgeTrashResponse) GetPurged() int64 {
+	if m != nil {
+		return m.Purged
+	}
+	return 0
+}
+
+// a collection of a backup archive
+type BackupCollection struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// edge collections link the documents of from to the documents of to
+	Edge  bool   `protobuf:"varint,2,opt,name=edge" json:"edge,omitempty"`
+	From  string `protobuf:"bytes,3,opt,name=from" json:"from,omitempty"`
+	To    string `protobuf:"bytes,4,opt,name=to" json:"to,omitempty"`
+	Count int64  `protobuf:"varint,5,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *BackupCollection) Reset()                    { *m = BackupCollection{} }
+func (m *BackupCollection) String() string            { return proto.CompactTextString(m) }
+func (*BackupCollection) ProtoMessage()               {}
+func (*BackupCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *BackupCollection) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BackupCollection) GetEdge() bool {
+	if m != nil {
+		return m.Edge
+	}
+	return false
+}
+
+func (m *BackupCollection) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *BackupCollection) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BackupCollection) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// manifest of a backup archive
+type BackupManifest struct {
+	// format of the archive
+	Version  int64  `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	Database string `protobuf:"bytes,2,opt,name=database" json:"database,omitempty"`
+	// the archive only has the documents of the organisation and their edges, every document if empty
+	OrgId       string              `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	Created     int64               `protobuf:"varint,4,opt,name=created" json:"created,omitempty"`
+	Collections []*BackupCollection `protobuf:"bytes,5,rep,name=collections" json:"collections,omitempty"`
+}
+
+func (m *BackupManifest) Reset()                    { *m = BackupManifest{} }
+func (m *BackupManifest) String() string            { return proto.CompactTextString(m) }
+func (*BackupManifest) ProtoMessage()               {}
+func (*BackupManifest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *BackupManifest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *BackupManifest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *BackupManifest) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetCollections() []*BackupCollection {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+// exports the collections and edge collections of a database to an archive of the backup directory
+type BackupRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	// only the documents of the organisation and their edges, every document if empty
+	OrgId     string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	BatchSize int64  `protobuf:"varint,3,opt,name=batch_size,json=batchSize" json:"batch_size,omitempty"`
+}
+
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *BackupRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *BackupRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+type BackupResponse struct {
+	// name of the archive in the backup directory
+	Archive  string          `protobuf:"bytes,1,opt,name=archive" json:"archive,omitempty"`
+	Manifest *BackupManifest `protobuf:"bytes,2,opt,name=manifest" json:"manifest,omitempty"`
+}
+
+func (m *BackupResponse) Reset()                    { *m = BackupResponse{} }
+func (m *BackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()               {}
+func (*BackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *BackupResponse) GetArchive() string {
+	if m != nil {
+		return m.Archive
+	}
+	return ""
+}
+
+func (m *BackupResponse) GetManifest() *BackupManifest {
+	if m != nil {
+		return m.Manifest
+	}
+	return nil
+}
+
+// imports an archive of the backup directory, the documents and edges with the same keys are replaced
+type RestoreBackupRequest struct {
+	Database *Database `protobuf:"bytes,1,opt,name=database" json:"database,omitempty"`
+	Archive  string    `protobuf:"bytes,2,opt,name=archive" json:"archive,omitempty"`
+	// the documents and edges get new keys and the references to the keys of the archive are changed
+	RemapIds  bool  `protobuf:"varint,3,opt,name=remap_ids,json=remapIds" json:"remap_ids,omitempty"`
+	BatchSize int64 `protobuf:"varint,4,opt,name=batch_size,json=batchSize" json:"batch_size,omitempty"`
+}
+
+func (m *RestoreBackupRequest) Reset()                    { *m = RestoreBackupRequest{} }
+func (m *RestoreBackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreBackupRequest) ProtoMessage()               {}
+func (*RestoreBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *RestoreBackupRequest) GetDatabase() *Database {
+	if m != nil {
+		return m.Database
+	}
+	return nil
+}
+
+func (m *RestoreBackupRequest) GetArchive() string {
+	if m != nil {
+		return m.Archive
+	}
+	return ""
+}
+
+func (m *RestoreBackupRequest) GetRemapIds() bool {
+	if m != nil {
+		return m.RemapIds
+	}
+	return false
+}
+
+func (m *RestoreBackupRequest) GetBatchSize() int64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+type RestoreBackupResponse struct {
+	Manifest *BackupManifest `protobuf:"bytes,1,opt,name=manifest" json:"manifest,omitempty"`
+	// documents and edges written
+	Restored int64 `protobuf:"varint,2,opt,name=restored" json:"restored,omitempty"`
+	// new keys by key of the archive, set if remap_ids is
+	Ids map[string]string `protobuf:"bytes,3,rep,name=ids" json:"ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// records which failed to be indexed, they are retried by the indexing queue
+	IndexFailed int64 `protobuf:"varint,4,opt,name=index_failed,json=indexFailed" json:"index_failed,omitempty"`
+}
+
+func (m *RestoreBackupResponse) Reset()                    { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()               {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *RestoreBackupResponse) GetManifest() *BackupManifest {
+	if m != nil {
+		return m.Manifest
+	}
+	return nil
+}
+
+func (m *RestoreBackupResponse) GetRestored() int64 {
+	if m != nil {
+		return m.Restored
+	}
+	return 0
+}
+
+func (m *RestoreBackupResponse) GetIds() map[string]string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *RestoreBackupResponse) GetIndexFailed() int64 {
+	if m != nil {
+		return m.IndexFailed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Database)(nil), "go.micro.srv.db.Database")
+	proto.RegisterType((*Record)(nil), "go.micro.srv.db.Record")
+	proto.RegisterType((*InitDbRequest)(nil), "go.micro.srv.db.InitDbRequest")
+	proto.RegisterType((*InitDbResponse)(nil), "go.micro.srv.db.InitDbResponse")
+	proto.RegisterType((*RemoveDbRequest)(nil), "go.micro.srv.db.RemoveDbRequest")
+	proto.RegisterType((*RemoveDbResponse)(nil), "go.micro.srv.db.RemoveDbResponse")
+	proto.RegisterType((*ReadRequest)(nil), "go.micro.srv.db.ReadRequest")
+	proto.RegisterType((*ReadResponse)(nil), "go.micro.srv.db.ReadResponse")
+	proto.RegisterType((*CreateRequest)(nil), "go.micro.srv.db.CreateRequest")
+	proto.RegisterType((*CreateResponse)(nil), "go.micro.srv.db.CreateResponse")
+	proto.RegisterType((*UpdateRequest)(nil), "go.micro.srv.db.UpdateRequest")
+	proto.RegisterType((*UpdateResponse)(nil), "go.micro.srv.db.UpdateResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "go.micro.srv.db.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "go.micro.srv.db.DeleteResponse")
+	proto.RegisterType((*SearchRequest)(nil), "go.micro.srv.db.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "go.micro.srv.db.SearchResponse")
+	proto.RegisterType((*RunQueryRequest)(nil), "go.micro.srv.db.RunQueryRequest")
+	proto.RegisterType((*RunQueryResponse)(nil), "go.micro.srv.db.RunQueryResponse")
+	proto.RegisterType((*RunQueryStreamRequest)(nil), "go.micro.srv.db.RunQueryStreamRequest")
+	proto.RegisterType((*RunQueryStreamResponse)(nil), "go.micro.srv.db.RunQueryStreamResponse")
+	proto.RegisterType((*Operation)(nil), "go.micro.srv.db.Operation")
+	proto.RegisterType((*TransactionRequest)(nil), "go.micro.srv.db.TransactionRequest")
+	proto.RegisterType((*OperationResult)(nil), "go.micro.srv.db.OperationResult")
+	proto.RegisterType((*TransactionResponse)(nil), "go.micro.srv.db.TransactionResponse")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "go.micro.srv.db.CreateDatabaseRequest")
+	proto.RegisterType((*CreateDatabaseResponse)(nil), "go.micro.srv.db.CreateDatabaseResponse")
+	proto.RegisterType((*DeleteDatabaseRequest)(nil), "go.micro.srv.db.DeleteDatabaseRequest")
+	proto.RegisterType((*DeleteDatabaseResponse)(nil), "go.micro.srv.db.DeleteDatabaseResponse")
+	proto.RegisterType((*ChangeEvent)(nil), "go.micro.srv.db.ChangeEvent")
+	proto.RegisterType((*ReadChangesRequest)(nil), "go.micro.srv.db.ReadChangesRequest")
+	proto.RegisterType((*ReadChangesResponse)(nil), "go.micro.srv.db.ReadChangesResponse")
+	proto.RegisterType((*ReindexRequest)(nil), "go.micro.srv.db.ReindexRequest")
+	proto.RegisterType((*ReindexResponse)(nil), "go.micro.srv.db.ReindexResponse")
+	proto.RegisterType((*CheckIndexRequest)(nil), "go.micro.srv.db.CheckIndexRequest")
+	proto.RegisterType((*CheckIndexResponse)(nil), "go.micro.srv.db.CheckIndexResponse")
+	proto.RegisterType((*TrashRequest)(nil), "go.micro.srv.db.TrashRequest")
+	proto.RegisterType((*TrashResponse)(nil), "go.micro.srv.db.TrashResponse")
+	proto.RegisterType((*ListTrashRequest)(nil), "go.micro.srv.db.ListTrashRequest")
+	proto.RegisterType((*ListTrashResponse)(nil), "go.micro.srv.db.ListTrashResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "go.micro.srv.db.RestoreRequest")
+	proto.RegisterType((*RestoreResponse)(nil), "go.micro.srv.db.RestoreResponse")
+	proto.RegisterType((*PurgeTrashRequest)(nil), "go.micro.srv.db.PurgeTrashRequest")
+	proto.RegisterType((*PurgeTrashResponse)(nil), "go.micro.srv.db.PurgeTrashResponse")
+	proto.RegisterType((*BackupCollection)(nil), "go.micro.srv.db.BackupCollection")
+	proto.RegisterType((*BackupManifest)(nil), "go.micro.srv.db.BackupManifest")
+	proto.RegisterType((*BackupRequest)(nil), "go.micro.srv.db.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "go.micro.srv.db.BackupResponse")
+	proto.RegisterType((*RestoreBackupRequest)(nil), "go.micro.srv.db.RestoreBackupRequest")
+	proto.RegisterType((*RestoreBackupResponse)(nil), "go.micro.srv.db.RestoreBackupResponse")
+	proto.RegisterEnum("go.micro.srv.db.ChangeType", ChangeType_name, ChangeType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ client.Option
+var _ server.Option
+
+// Client API for DB service
+
+type DBClient interface {
+	InitDb(ctx context.Context, in *InitDbRequest, opts ...client.CallOption) (*InitDbResponse, error)
+	RemoveDb(ctx context.Context, in *RemoveDbRequest, opts ...client.CallOption) (*RemoveDbResponse, error)
+	Read(ctx context.Context, in *ReadRequest, opts ...client.CallOption) (*ReadResponse, error)
+	Create(ctx context.Context, in *CreateRequest, opts ...client.CallOption) (*CreateResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...client.CallOption) (*UpdateResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...client.CallOption) (*SearchResponse, error)
+	RunQuery(ctx context.Context, in *RunQueryRequest, opts ...client.CallOption) (*RunQueryResponse, error)
+	RunQueryStream(ctx context.Context, in *RunQueryStreamRequest, opts ...client.CallOption) (DB_RunQueryStreamClient, error)
+	Transaction(ctx context.Context, in *TransactionRequest, opts ...client.CallOption) (*TransactionResponse, error)
+	ReadChanges(ctx context.Context, in *ReadChangesRequest, opts ...client.CallOption) (*ReadChangesResponse, error)
+	Reindex(ctx context.Context, in *ReindexRequest, opts ...client.CallOption) (DB_ReindexClient, error)
+	CheckIndex(ctx context.Context, in *CheckIndexRequest, opts ...client.CallOption) (*CheckIndexResponse, error)
+	Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...client.CallOption) (*ListTrashResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...client.CallOption) (*PurgeTrashResponse, error)
+	Backup(ctx context.Context, in *BackupRequest, opts ...client.CallOption) (*BackupResponse, error)
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...client.CallOption) (*RestoreBackupResponse, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...client.CallOption) (*CreateDatabaseResponse, error)
+	DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...client.CallOption) (*DeleteDatabaseResponse, error)
+}
+
+type dBClient struct {
+	c           client.Client
+	serviceName string
+}
+
+func NewDBClient(serviceName string, c client.Client) DBClient {
+	if c == nil {
+		c = client.NewClient()
+	}
+	if len(serviceName) == 0 {
+		serviceName = "go.micro.srv.db"
+	}
+	return &dBClient{
+		c:           c,
+		serviceName: serviceName,
+	}
+}
+
+func (c *dBClient) InitDb(ctx context.Context, in *InitDbRequest, opts ...client.CallOption) (*InitDbResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.InitDb", in)
+	out := new(InitDbResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) RemoveDb(ctx context.Context, in *RemoveDbRequest, opts ...client.CallOption) (*RemoveDbResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.RemoveDb", in)
+	out := new(RemoveDbResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Read(ctx context.Context, in *ReadRequest, opts ...client.CallOption) (*ReadResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Read", in)
+	out := new(ReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Create(ctx context.Context, in *CreateRequest, opts ...client.CallOption) (*CreateResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Create", in)
+	out := new(CreateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Update(ctx context.Context, in *UpdateRequest, opts ...client.CallOption) (*UpdateResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Update", in)
+	out := new(UpdateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error) {
 	req := c.c.NewRequest(c.serviceName, "DB.Delete", in)
 	out := new(DeleteResponse)
 	err := c.c.Call(ctx, req, out, opts...)
@@ -680,6 +1757,184 @@ func (c *dBClient) RunQuery(ctx context.Context, in *RunQueryRequest, opts ...cl
 	return out, nil
 }
 
+func (c *dBClient) RunQueryStream(ctx context.Context, in *RunQueryStreamRequest, opts ...client.CallOption) (DB_RunQueryStreamClient, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.RunQueryStream", &RunQueryStreamRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &dBRunQueryStreamClient{stream}, nil
+}
+
+type DB_RunQueryStreamClient interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*RunQueryStreamResponse, error)
+}
+
+type dBRunQueryStreamClient struct {
+	stream client.Streamer
+}
+
+func (x *dBRunQueryStreamClient) Close() error {
+	return x.stream.Close()
+}
+
+func (x *dBRunQueryStreamClient) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *dBRunQueryStreamClient) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *dBRunQueryStreamClient) Recv() (*RunQueryStreamResponse, error) {
+	m := new(RunQueryStreamResponse)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dBClient) Transaction(ctx context.Context, in *TransactionRequest, opts ...client.CallOption) (*TransactionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Transaction", in)
+	out := new(TransactionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) ReadChanges(ctx context.Context, in *ReadChangesRequest, opts ...client.CallOption) (*ReadChangesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.ReadChanges", in)
+	out := new(ReadChangesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Reindex(ctx context.Context, in *ReindexRequest, opts ...client.CallOption) (DB_ReindexClient, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Reindex", &ReindexRequest{})
+	stream, err := c.c.Stream(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(in); err != nil {
+		return nil, err
+	}
+	return &dBReindexClient{stream}, nil
+}
+
+type DB_ReindexClient interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Recv() (*ReindexResponse, error)
+}
+
+type dBReindexClient struct {
+	stream client.Streamer
+}
+
+func (x *dBReindexClient) Close() error {
+	return x.stream.Close()
+}
+
+func (x *dBReindexClient) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *dBReindexClient) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *dBReindexClient) Recv() (*ReindexResponse, error) {
+	m := new(ReindexResponse)
+	err := x.stream.Recv(m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dBClient) CheckIndex(ctx context.Context, in *CheckIndexRequest, opts ...client.CallOption) (*CheckIndexResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.CheckIndex", in)
+	out := new(CheckIndexResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Trash", in)
+	out := new(TrashResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...client.CallOption) (*ListTrashResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.ListTrash", in)
+	out := new(ListTrashResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Restore", in)
+	out := new(RestoreResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...client.CallOption) (*PurgeTrashResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.PurgeTrash", in)
+	out := new(PurgeTrashResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) Backup(ctx context.Context, in *BackupRequest, opts ...client.CallOption) (*BackupResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.Backup", in)
+	out := new(BackupResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...client.CallOption) (*RestoreBackupResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "DB.RestoreBackup", in)
+	out := new(RestoreBackupResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dBClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...client.CallOption) (*CreateDatabaseResponse, error) {
 	req := c.c.NewRequest(c.serviceName, "DB.CreateDatabase", in)
 	out := new(CreateDatabaseResponse)
@@ -711,6 +1966,17 @@ type DBHandler interface {
 	Delete(context.Context, *DeleteRequest, *DeleteResponse) error
 	Search(context.Context, *SearchRequest, *SearchResponse) error
 	RunQuery(context.Context, *RunQueryRequest, *RunQueryResponse) error
+	RunQueryStream(context.Context, *RunQueryStreamRequest, DB_RunQueryStreamStream) error
+	Transaction(context.Context, *TransactionRequest, *TransactionResponse) error
+	ReadChanges(context.Context, *ReadChangesRequest, *ReadChangesResponse) error
+	Reindex(context.Context, *ReindexRequest, DB_ReindexStream) error
+	CheckIndex(context.Context, *CheckIndexRequest, *CheckIndexResponse) error
+	Trash(context.Context, *TrashRequest, *TrashResponse) error
+	ListTrash(context.Context, *ListTrashRequest, *ListTrashResponse) error
+	Restore(context.Context, *RestoreRequest, *RestoreResponse) error
+	PurgeTrash(context.Context, *PurgeTrashRequest, *PurgeTrashResponse) error
+	Backup(context.Context, *BackupRequest, *BackupResponse) error
+	RestoreBackup(context.Context, *RestoreBackupRequest, *RestoreBackupResponse) error
 	CreateDatabase(context.Context, *CreateDatabaseRequest, *CreateDatabaseResponse) error
 	DeleteDatabase(context.Context, *DeleteDatabaseRequest, *DeleteDatabaseResponse) error
 }
@@ -755,6 +2021,112 @@ func (h *DB) RunQuery(ctx context.Context, in *RunQueryRequest, out *RunQueryRes
 	return h.DBHandler.RunQuery(ctx, in, out)
 }
 
+func (h *DB) RunQueryStream(ctx context.Context, stream server.Streamer) error {
+	m := new(RunQueryStreamRequest)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.DBHandler.RunQueryStream(ctx, m, &dBRunQueryStreamStream{stream})
+}
+
+type DB_RunQueryStreamStream interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*RunQueryStreamResponse) error
+}
+
+type dBRunQueryStreamStream struct {
+	stream server.Streamer
+}
+
+func (x *dBRunQueryStreamStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *dBRunQueryStreamStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *dBRunQueryStreamStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *dBRunQueryStreamStream) Send(m *RunQueryStreamResponse) error {
+	return x.stream.Send(m)
+}
+
+func (h *DB) Transaction(ctx context.Context, in *TransactionRequest, out *TransactionResponse) error {
+	return h.DBHandler.Transaction(ctx, in, out)
+}
+
+func (h *DB) ReadChanges(ctx context.Context, in *ReadChangesRequest, out *ReadChangesResponse) error {
+	return h.DBHandler.ReadChanges(ctx, in, out)
+}
+
+func (h *DB) Reindex(ctx context.Context, stream server.Streamer) error {
+	m := new(ReindexRequest)
+	if err := stream.Recv(m); err != nil {
+		return err
+	}
+	return h.DBHandler.Reindex(ctx, m, &dBReindexStream{stream})
+}
+
+type DB_ReindexStream interface {
+	SendMsg(interface{}) error
+	RecvMsg(interface{}) error
+	Close() error
+	Send(*ReindexResponse) error
+}
+
+type dBReindexStream struct {
+	stream server.Streamer
+}
+
+func (x *dBReindexStream) Close() error {
+	return x.stream.Close()
+}
+
+func (x *dBReindexStream) SendMsg(m interface{}) error {
+	return x.stream.Send(m)
+}
+
+func (x *dBReindexStream) RecvMsg(m interface{}) error {
+	return x.stream.Recv(m)
+}
+
+func (x *dBReindexStream) Send(m *ReindexResponse) error {
+	return x.stream.Send(m)
+}
+
+func (h *DB) CheckIndex(ctx context.Context, in *CheckIndexRequest, out *CheckIndexResponse) error {
+	return h.DBHandler.CheckIndex(ctx, in, out)
+}
+
+func (h *DB) Trash(ctx context.Context, in *TrashRequest, out *TrashResponse) error {
+	return h.DBHandler.Trash(ctx, in, out)
+}
+
+func (h *DB) ListTrash(ctx context.Context, in *ListTrashRequest, out *ListTrashResponse) error {
+	return h.DBHandler.ListTrash(ctx, in, out)
+}
+
+func (h *DB) Restore(ctx context.Context, in *RestoreRequest, out *RestoreResponse) error {
+	return h.DBHandler.Restore(ctx, in, out)
+}
+
+func (h *DB) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, out *PurgeTrashResponse) error {
+	return h.DBHandler.PurgeTrash(ctx, in, out)
+}
+
+func (h *DB) Backup(ctx context.Context, in *BackupRequest, out *BackupResponse) error {
+	return h.DBHandler.Backup(ctx, in, out)
+}
+
+func (h *DB) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, out *RestoreBackupResponse) error {
+	return h.DBHandler.RestoreBackup(ctx, in, out)
+}
+
 func (h *DB) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, out *CreateDatabaseResponse) error {
 	return h.DBHandler.CreateDatabase(ctx, in, out)
 }
@@ -766,55 +2138,130 @@ func (h *DB) DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, out
 func init() { proto.RegisterFile("server/db-srv/proto/db/db.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 798 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdb, 0x6e, 0xd3, 0x4c,
-	0x10, 0x6e, 0xec, 0xd4, 0x4d, 0xa7, 0x7f, 0x0e, 0xff, 0xaa, 0x2d, 0x26, 0x82, 0x36, 0x58, 0x82,
-	0xf6, 0x02, 0x12, 0x35, 0x15, 0x12, 0x82, 0x0b, 0x04, 0x4d, 0x25, 0x50, 0x45, 0xa5, 0x2e, 0xe2,
-	0x16, 0x69, 0x1d, 0x6f, 0x4b, 0x44, 0x12, 0xa7, 0xeb, 0x8d, 0xab, 0x3e, 0x1a, 0x12, 0xcf, 0xc0,
-	0x53, 0xf0, 0x20, 0x68, 0x0f, 0x76, 0xb2, 0xee, 0x86, 0x53, 0x40, 0xdc, 0xed, 0xec, 0x8c, 0xe7,
-	0x9b, 0xfd, 0x66, 0xe6, 0x4b, 0x60, 0x37, 0xa1, 0x2c, 0xa5, 0xac, 0x13, 0x85, 0x8f, 0x12, 0x96,
-	0x76, 0x26, 0x2c, 0xe6, 0x71, 0x27, 0x0a, 0x3b, 0x51, 0xd8, 0x96, 0x67, 0x54, 0xbf, 0x88, 0xdb,
-	0xa3, 0x41, 0x9f, 0xc5, 0xed, 0x84, 0xa5, 0xed, 0x28, 0x0c, 0xbe, 0x94, 0xa0, 0xd2, 0x23, 0x9c,
-	0x84, 0x24, 0xa1, 0x08, 0x41, 0x79, 0x4c, 0x46, 0xd4, 0x2f, 0xb5, 0x4a, 0xfb, 0xeb, 0x58, 0x9e,
-	0xd1, 0x26, 0xac, 0x72, 0x12, 0x0e, 0xa9, 0xef, 0xc8, 0x4b, 0x65, 0xa0, 0x6d, 0xf0, 0x22, 0x36,
-	0x48, 0x29, 0xf3, 0x5d, 0x79, 0xad, 0x2d, 0x74, 0x04, 0x95, 0x11, 0xe5, 0x24, 0x22, 0x9c, 0xf8,
-	0xe5, 0x96, 0xbb, 0xbf, 0xd1, 0xdd, 0x6b, 0x17, 0x20, 0xdb, 0x19, 0x5c, 0xfb, 0x8d, 0x8e, 0x3c,
-	0x1e, 0x73, 0x76, 0x8d, 0xf3, 0x0f, 0x9b, 0xcf, 0xa0, 0x6a, 0xb8, 0x50, 0x03, 0xdc, 0x8f, 0xf4,
-	0x5a, 0x97, 0x25, 0x8e, 0xa2, 0xaa, 0x94, 0x0c, 0xa7, 0x79, 0x55, 0xd2, 0x78, 0xea, 0x3c, 0x29,
-	0x05, 0x5f, 0x1d, 0xf0, 0x30, 0xed, 0xc7, 0x2c, 0x42, 0x35, 0x70, 0x06, 0x91, 0xfe, 0xca, 0x19,
-	0x44, 0xc8, 0x87, 0xb5, 0x3e, 0xa3, 0x84, 0xd3, 0x48, 0x7e, 0xe6, 0xe2, 0xcc, 0x14, 0x9e, 0xe9,
-	0x24, 0x92, 0x1e, 0x57, 0x79, 0xb4, 0x99, 0x53, 0x52, 0x9e, 0xa3, 0x64, 0x07, 0x60, 0x42, 0x18,
-	0x19, 0x51, 0x4e, 0xd9, 0x81, 0xbf, 0x2a, 0x3d, 0x73, 0x37, 0x86, 0xbf, 0xeb, 0x7b, 0x05, 0x7f,
-	0xd7, 0xf0, 0x1f, 0xfa, 0x6b, 0x05, 0xff, 0xa1, 0x78, 0xee, 0x90, 0x70, 0xbf, 0xd2, 0x2a, 0xed,
-	0x97, 0xb0, 0x38, 0xca, 0x9b, 0xf1, 0x85, 0xbf, 0xae, 0x6f, 0xc6, 0x17, 0xe8, 0xc5, 0x1c, 0xd1,
-	0x20, 0x89, 0xbe, 0x7f, 0x83, 0x68, 0x45, 0xc3, 0xdf, 0xa1, 0xb9, 0x0e, 0xd5, 0xd7, 0xe3, 0x01,
-	0xef, 0x85, 0x98, 0x5e, 0x4e, 0x69, 0xc2, 0x83, 0x06, 0xd4, 0xb2, 0x8b, 0x64, 0x12, 0x8f, 0x13,
-	0x1a, 0xfc, 0x0f, 0x75, 0x4c, 0x47, 0x71, 0x4a, 0x67, 0x41, 0x08, 0x1a, 0xb3, 0x2b, 0x1d, 0xc6,
-	0x61, 0x03, 0x53, 0x12, 0xe9, 0x10, 0xf4, 0x18, 0x2a, 0x91, 0x1e, 0x10, 0x59, 0xc9, 0x46, 0xf7,
-	0xf6, 0xc2, 0x09, 0xc2, 0x79, 0xa8, 0xee, 0xb5, 0x93, 0xf7, 0xda, 0xe4, 0xd8, 0x2d, 0x72, 0x1c,
-	0x3c, 0x87, 0xff, 0x14, 0xaa, 0xaa, 0x02, 0x75, 0xc0, 0x63, 0x92, 0x2e, 0x0d, 0x7a, 0x6b, 0x01,
-	0x9b, 0x58, 0x87, 0x05, 0x57, 0x50, 0x3d, 0x92, 0xd3, 0xb3, 0x64, 0xe1, 0x33, 0x60, 0xe7, 0xe7,
-	0x80, 0x1b, 0x50, 0xcb, 0x80, 0x35, 0x83, 0x57, 0x50, 0x7d, 0x27, 0xc7, 0xf5, 0x1f, 0x94, 0x92,
-	0x01, 0xeb, 0x52, 0x52, 0xa8, 0xf6, 0xe8, 0x90, 0x2e, 0x5d, 0xca, 0xaf, 0xb6, 0xb3, 0x01, 0xb5,
-	0x0c, 0x57, 0x57, 0xf2, 0xc9, 0x81, 0xea, 0x5b, 0x4a, 0x58, 0xff, 0xc3, 0x92, 0xa5, 0xbc, 0x9a,
-	0xdb, 0x34, 0x47, 0x6e, 0xda, 0xc3, 0x1b, 0x9f, 0x19, 0x40, 0x8b, 0x16, 0x4e, 0x68, 0xc9, 0x39,
-	0x8b, 0x47, 0x5a, 0x62, 0xe4, 0x59, 0x3c, 0x94, 0xc7, 0x52, 0x5d, 0x5c, 0xec, 0xf0, 0x58, 0x6c,
-	0xdc, 0x70, 0x30, 0x1a, 0x70, 0x29, 0x2b, 0x2e, 0x56, 0x86, 0x90, 0xdb, 0xf8, 0xfc, 0x3c, 0xa1,
-	0x5c, 0xaa, 0x89, 0x8b, 0xb5, 0x25, 0x74, 0x8b, 0xd1, 0x94, 0xb2, 0x84, 0x4a, 0x19, 0xa9, 0xe0,
-	0xcc, 0x5c, 0x6e, 0xb9, 0x8f, 0xa0, 0x96, 0xbd, 0x48, 0xaf, 0xc7, 0x81, 0x00, 0x12, 0x3d, 0x4f,
-	0xfc, 0x92, 0xe4, 0x60, 0xe1, 0x6c, 0x64, 0x71, 0xc1, 0x7b, 0xa8, 0xe3, 0xe9, 0xf8, 0x6c, 0x4a,
-	0xd9, 0xf5, 0x92, 0x1d, 0xd8, 0x84, 0xd5, 0x4b, 0x91, 0x26, 0x2b, 0x54, 0x1a, 0xc1, 0x31, 0x34,
-	0x66, 0xf9, 0x7f, 0xbf, 0xcc, 0x53, 0xd8, 0x52, 0xeb, 0x94, 0x03, 0x2f, 0x55, 0x6c, 0xe0, 0xc3,
-	0x76, 0x31, 0x9f, 0x9e, 0xc8, 0x53, 0xd8, 0x52, 0x33, 0xfa, 0xe7, 0x90, 0x8a, 0xf9, 0x14, 0x52,
-	0xf7, 0xb3, 0x07, 0x4e, 0xef, 0x25, 0x3a, 0x01, 0x4f, 0x49, 0x32, 0xda, 0xb9, 0x91, 0xcf, 0x10,
-	0xef, 0xe6, 0xee, 0x42, 0xbf, 0xae, 0x7d, 0x05, 0x9d, 0x41, 0x25, 0x93, 0x6e, 0xd4, 0xb2, 0xb0,
-	0x6a, 0x08, 0x7d, 0xf3, 0xde, 0x77, 0x22, 0xf2, 0x94, 0xc7, 0x50, 0x16, 0x1a, 0x8c, 0xee, 0x58,
-	0x82, 0xf3, 0x1f, 0x84, 0xe6, 0xdd, 0x05, 0xde, 0x3c, 0xcd, 0x09, 0x78, 0x8a, 0x71, 0xcb, 0x33,
-	0x0d, 0x89, 0xb6, 0x3c, 0xb3, 0xa0, 0xa4, 0x32, 0x99, 0x92, 0x34, 0x4b, 0x32, 0x43, 0x64, 0x2d,
-	0xc9, 0x0a, 0x5a, 0x28, 0x93, 0xa9, 0x0e, 0x59, 0x92, 0x19, 0x32, 0x69, 0x49, 0x56, 0x90, 0x33,
-	0x99, 0x4c, 0x2d, 0xa5, 0x25, 0x99, 0xa1, 0x3f, 0x96, 0x64, 0xe6, 0x36, 0xeb, 0x6e, 0xea, 0xe5,
-	0xb1, 0x75, 0xd3, 0xdc, 0x5b, 0x5b, 0x37, 0x0b, 0x9b, 0x17, 0xac, 0xa0, 0x7e, 0xf6, 0xbb, 0x94,
-	0xff, 0x9d, 0x7c, 0xb0, 0x80, 0xee, 0xc2, 0xfc, 0x37, 0xf7, 0x7e, 0x18, 0x37, 0x0f, 0x62, 0xce,
-	0xbc, 0x05, 0xc4, 0xba, 0x64, 0x16, 0x10, 0xfb, 0xf2, 0x04, 0x2b, 0xa1, 0x27, 0xff, 0x2b, 0x1f,
-	0x7e, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x09, 0x91, 0xda, 0x4e, 0x0b, 0x00, 0x00,
+	// 1989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0xdb, 0x6e, 0x1b, 0xc7,
+	0xd5, 0xcb, 0xa5, 0x28, 0xf2, 0x48, 0xa4, 0xe8, 0x89, 0xed, 0x6e, 0x37, 0xb1, 0x25, 0xaf, 0x13,
+	0xdb, 0x28, 0x5c, 0x2a, 0x91, 0xd3, 0xa2, 0x70, 0x1e, 0x5a, 0x4b, 0x62, 0x10, 0x41, 0x89, 0x6b,
+	0xaf, 0x95, 0x06, 0x08, 0x0a, 0xa8, 0x43, 0xee, 0x88, 0x5a, 0x98, 0xdc, 0x65, 0x66, 0x97, 0x74,
+	0x14, 0xb4, 0xcf, 0x7d, 0x28, 0xd0, 0x87, 0xe6, 0x13, 0x8a, 0xf6, 0x1f, 0x0a, 0x14, 0xfd, 0x84,
+	0xfe, 0x43, 0xdf, 0xfa, 0x0f, 0x05, 0x8a, 0x62, 0xae, 0xdc, 0x59, 0xee, 0x52, 0xb6, 0x28, 0xbb,
+	0x6f, 0x73, 0x66, 0xce, 0x9c, 0xdb, 0x9c, 0x3d, 0xb7, 0x85, 0xcd, 0x84, 0xd0, 0x29, 0xa1, 0xdb,
+	0x41, 0xef, 0xc7, 0x09, 0x9d, 0x6e, 0x8f, 0x69, 0x9c, 0xc6, 0xdb, 0x41, 0x6f, 0x3b, 0xe8, 0x75,
+	0xf8, 0x1a, 0x6d, 0x0c, 0xe2, 0xce, 0x28, 0xec, 0xd3, 0xb8, 0x93, 0xd0, 0x69, 0x27, 0xe8, 0x79,
+	0xff, 0xb4, 0xa0, 0xbe, 0x8f, 0x53, 0xdc, 0xc3, 0x09, 0x41, 0x08, 0xaa, 0x11, 0x1e, 0x11, 0xc7,
+	0xda, 0xb2, 0xee, 0x37, 0x7c, 0xbe, 0x46, 0xd7, 0x60, 0x25, 0xc5, 0xbd, 0x21, 0x71, 0x2a, 0x7c,
+	0x53, 0x00, 0xe8, 0x06, 0xd4, 0x02, 0x1a, 0x4e, 0x09, 0x75, 0x6c, 0xbe, 0x2d, 0x21, 0xb4, 0x07,
+	0xf5, 0x11, 0x49, 0x71, 0x80, 0x53, 0xec, 0x54, 0xb7, 0xec, 0xfb, 0x6b, 0x3b, 0xf7, 0x3a, 0x39,
+	0x96, 0x1d, 0xc5, 0xae, 0xf3, 0x85, 0xc4, 0xec, 0x46, 0x29, 0x3d, 0xf3, 0xf5, 0x45, 0xf7, 0x13,
+	0x68, 0x1a, 0x47, 0xa8, 0x0d, 0xf6, 0x0b, 0x72, 0x26, 0xc5, 0x62, 0x4b, 0x26, 0xd5, 0x14, 0x0f,
+	0x27, 0x5a, 0x2a, 0x0e, 0x3c, 0xaa, 0xfc, 0xcc, 0xf2, 0xfe, 0x61, 0x43, 0xcd, 0x27, 0xfd, 0x98,
+	0x06, 0xa8, 0x05, 0x95, 0x30, 0x90, 0xb7, 0x2a, 0x61, 0x80, 0x1c, 0x58, 0xed, 0x53, 0x82, 0x53,
+	0x12, 0xf0, 0x6b, 0xb6, 0xaf, 0x40, 0x76, 0x32, 0x19, 0x07, 0xfc, 0xc4, 0x16, 0x27, 0x12, 0xd4,
+	0x26, 0xa9, 0x66, 0x4c, 0x72, 0x0b, 0x60, 0x8c, 0x29, 0x1e, 0x91, 0x94, 0xd0, 0x8f, 0x9c, 0x15,
+	0x7e, 0x92, 0xd9, 0x31, 0xce, 0x77, 0x9c, 0x5a, 0xee, 0x7c, 0xc7, 0x38, 0x7f, 0xe8, 0xac, 0xe6,
+	0xce, 0x1f, 0x32, 0x75, 0x87, 0x38, 0x75, 0xea, 0x5b, 0xd6, 0x7d, 0xcb, 0x67, 0x4b, 0xbe, 0x13,
+	0x0d, 0x9c, 0x86, 0xdc, 0x89, 0x06, 0xe8, 0x71, 0xc6, 0xd0, 0xc0, 0x0d, 0xfd, 0xc1, 0x9c, 0xa1,
+	0x85, 0x19, 0xca, 0xcc, 0x8c, 0x6e, 0x02, 0x04, 0x64, 0x48, 0x52, 0x12, 0x1c, 0xe3, 0xd4, 0x59,
+	0xe3, 0x7a, 0x37, 0xe4, 0xce, 0xe3, 0x34, 0x7b, 0xdc, 0x3b, 0x73, 0xd6, 0xb9, 0x94, 0xea, 0x78,
+	0xf7, 0x0c, 0xb9, 0x50, 0xa7, 0x64, 0x1a, 0x26, 0x61, 0x1c, 0x39, 0x4d, 0x7e, 0xa8, 0xe1, 0xe5,
+	0x1e, 0x70, 0x03, 0x9a, 0x07, 0x51, 0x98, 0xee, 0xf7, 0x7c, 0xf2, 0xcd, 0x84, 0x24, 0xa9, 0xd7,
+	0x86, 0x96, 0xda, 0x48, 0xc6, 0x71, 0x94, 0x10, 0xef, 0x2a, 0x6c, 0xf8, 0x64, 0x14, 0x4f, 0xc9,
+	0x0c, 0x09, 0x41, 0x7b, 0xb6, 0x25, 0xd1, 0x52, 0x58, 0xf3, 0x09, 0x0e, 0x24, 0x0a, 0xfa, 0x09,
+	0xd4, 0x03, 0xe9, 0x7a, 0x5c, 0x92, 0xb5, 0x9d, 0x1f, 0x96, 0xfa, 0xa6, 0xaf, 0x51, 0xa5, 0x17,
+	0x55, 0xb4, 0x17, 0x99, 0xaf, 0x67, 0xe7, 0x5f, 0xcf, 0xfb, 0x39, 0xac, 0x0b, 0xae, 0x42, 0x0a,
+	0xb4, 0x0d, 0x35, 0xca, 0x1f, 0x42, 0x32, 0xfd, 0x41, 0xc9, 0x3b, 0xf9, 0x12, 0xcd, 0x7b, 0x09,
+	0xcd, 0x3d, 0xee, 0x97, 0x4b, 0x0a, 0x3e, 0x63, 0x5c, 0x79, 0x35, 0xc6, 0x6d, 0x68, 0x29, 0xc6,
+	0xd2, 0x82, 0xdf, 0x5b, 0xd0, 0xfc, 0x72, 0x1c, 0xbc, 0x7d, 0x59, 0x0c, 0xf7, 0xb2, 0x4d, 0xf7,
+	0xf2, 0x1e, 0x40, 0x4b, 0x09, 0x25, 0x6d, 0x9c, 0xc5, 0xb6, 0x72, 0xd8, 0x7f, 0xb2, 0xa0, 0xb9,
+	0xcf, 0xdd, 0xf6, 0xed, 0x3a, 0x82, 0x21, 0x54, 0x35, 0x27, 0x54, 0x1b, 0x5a, 0x4a, 0x26, 0x69,
+	0xea, 0xbf, 0x55, 0xa0, 0xf9, 0x9c, 0x60, 0xda, 0x3f, 0x5d, 0x52, 0xcc, 0xcf, 0x32, 0x91, 0xa1,
+	0xc2, 0x23, 0xc3, 0x83, 0xb9, 0x6b, 0x06, 0xa3, 0xd2, 0x00, 0x81, 0xa0, 0x7a, 0x42, 0xe3, 0x91,
+	0x0c, 0x89, 0x7c, 0xcd, 0x8c, 0x90, 0xc6, 0x5c, 0x1d, 0xdb, 0xaf, 0xa4, 0x31, 0xfb, 0x8e, 0x87,
+	0xe1, 0x28, 0x4c, 0x79, 0x18, 0xb4, 0x7d, 0x01, 0xb0, 0xf4, 0x10, 0x9f, 0x9c, 0x24, 0x24, 0xe5,
+	0xd1, 0xcf, 0xf6, 0x25, 0xc4, 0xe2, 0x2c, 0x25, 0x53, 0x42, 0x13, 0xc2, 0xc3, 0x5e, 0xdd, 0x57,
+	0xe0, 0x72, 0x21, 0x63, 0x0f, 0x5a, 0x4a, 0x23, 0xe9, 0x10, 0x1f, 0xc1, 0xaa, 0x70, 0xa4, 0xc4,
+	0xb1, 0xb6, 0xec, 0x45, 0x0e, 0xa7, 0xf0, 0xbc, 0x7f, 0x5b, 0xb0, 0xe1, 0x4f, 0xa2, 0x67, 0x13,
+	0x42, 0xcf, 0x96, 0x7c, 0x82, 0x6b, 0xb0, 0xf2, 0x0d, 0x23, 0xa3, 0x24, 0xe5, 0x00, 0x3a, 0x84,
+	0x46, 0x2f, 0x8c, 0x82, 0xe3, 0x29, 0xa6, 0x89, 0x63, 0x73, 0xa9, 0x3a, 0xf3, 0x52, 0x99, 0x12,
+	0x74, 0x76, 0xc3, 0x28, 0xf8, 0x15, 0xa6, 0x89, 0x7c, 0x9b, 0x9e, 0x04, 0x99, 0xbd, 0x8c, 0xa3,
+	0xd7, 0xb2, 0x57, 0x17, 0xda, 0x33, 0x3e, 0x17, 0xb7, 0xd8, 0xf7, 0x15, 0xb8, 0xae, 0xe8, 0x3c,
+	0x4f, 0x29, 0xc1, 0xa3, 0x37, 0x62, 0xb7, 0x67, 0xf3, 0x76, 0xfb, 0xb8, 0xd4, 0x6e, 0x86, 0x1c,
+	0x65, 0xd6, 0x63, 0xb9, 0xad, 0x87, 0xd3, 0xfe, 0xe9, 0x71, 0x12, 0x7e, 0x47, 0xa4, 0x37, 0x37,
+	0xf8, 0xce, 0xf3, 0xf0, 0x3b, 0xb2, 0x9c, 0x71, 0x0f, 0xe1, 0x46, 0x5e, 0x98, 0x8b, 0x9b, 0xf8,
+	0x2f, 0x16, 0x34, 0x7e, 0x39, 0x26, 0x14, 0xa7, 0x61, 0x1c, 0xcd, 0xec, 0x63, 0x65, 0xed, 0xd3,
+	0xcd, 0xda, 0x47, 0x7c, 0xf1, 0xf7, 0xe7, 0x08, 0x6b, 0x22, 0x6f, 0xc6, 0xa3, 0xfe, 0x65, 0x01,
+	0x3a, 0xa2, 0x38, 0x4a, 0x70, 0x9f, 0x31, 0x59, 0xd2, 0x0f, 0x10, 0x54, 0x29, 0xc1, 0x01, 0x57,
+	0xa6, 0xe1, 0xf3, 0x35, 0xe3, 0xfd, 0x92, 0x86, 0x29, 0xe1, 0x1e, 0xd0, 0xf0, 0x05, 0x80, 0x1e,
+	0x01, 0xc4, 0x4a, 0xb3, 0x44, 0x56, 0x9c, 0x6e, 0xb9, 0xf2, 0x7e, 0x06, 0x9b, 0x05, 0x29, 0x21,
+	0xad, 0x2c, 0xe1, 0x24, 0xc4, 0xf6, 0x79, 0x14, 0x4f, 0x64, 0xe9, 0x26, 0x21, 0x6f, 0x1f, 0x36,
+	0x66, 0x84, 0x48, 0x32, 0x19, 0xa6, 0x17, 0x79, 0xd1, 0x67, 0xf0, 0x8e, 0x61, 0x28, 0xe9, 0x1b,
+	0x8f, 0x18, 0x25, 0x46, 0x53, 0x51, 0xda, 0x5a, 0xa0, 0x05, 0x47, 0xf4, 0xd5, 0x05, 0xef, 0x09,
+	0x5c, 0x17, 0x79, 0x5b, 0x9b, 0x72, 0x29, 0xf3, 0x7b, 0x0e, 0xdc, 0xc8, 0xd3, 0x93, 0x49, 0xea,
+	0x09, 0x5c, 0x17, 0x69, 0xeb, 0xf2, 0x38, 0xe5, 0xe9, 0x49, 0x4e, 0xff, 0xb1, 0x60, 0x6d, 0xef,
+	0x14, 0x47, 0x03, 0xd2, 0x9d, 0x92, 0x28, 0x65, 0xc9, 0x34, 0x61, 0xbc, 0xa2, 0xbe, 0x60, 0x60,
+	0xfb, 0x1a, 0x46, 0xdb, 0x50, 0x4d, 0xcf, 0xc6, 0xc2, 0x2b, 0x5b, 0x3b, 0xef, 0xce, 0x31, 0x16,
+	0x74, 0x8e, 0xce, 0xc6, 0xc4, 0xe7, 0x88, 0x8c, 0x98, 0x96, 0x56, 0x16, 0x17, 0x0a, 0x66, 0x59,
+	0xbd, 0x1f, 0x0f, 0x87, 0x44, 0x78, 0x86, 0xc8, 0xdb, 0x99, 0x1d, 0x59, 0x05, 0xac, 0xe8, 0x2a,
+	0xe0, 0x3a, 0xd4, 0x62, 0x3a, 0x38, 0x0e, 0x03, 0xe9, 0x2d, 0x2b, 0x31, 0x1d, 0x1c, 0x04, 0xec,
+	0xe3, 0x89, 0x87, 0x81, 0x2c, 0xee, 0xd9, 0x92, 0xed, 0x44, 0xe4, 0x25, 0xaf, 0xea, 0x1b, 0x3e,
+	0x5b, 0x66, 0xfb, 0x91, 0x86, 0xd1, 0x8f, 0x78, 0x31, 0x20, 0x56, 0x43, 0x0a, 0xc1, 0x13, 0x65,
+	0x64, 0x53, 0x34, 0x6b, 0x4e, 0xb4, 0x3b, 0xd0, 0x64, 0x39, 0xfa, 0x58, 0x1b, 0x4a, 0x74, 0x39,
+	0xeb, 0x6c, 0xf3, 0xb9, 0x32, 0x96, 0x4e, 0xd8, 0x76, 0x26, 0x61, 0x7b, 0x87, 0xf0, 0x8e, 0xc1,
+	0x50, 0x7a, 0xe5, 0xc7, 0x50, 0x23, 0xcc, 0xfc, 0xca, 0x29, 0xdf, 0x2b, 0xb1, 0x2d, 0x7f, 0x23,
+	0x5f, 0xe2, 0x7a, 0xbf, 0x83, 0x96, 0x4f, 0xc2, 0x28, 0x20, 0xdf, 0x2e, 0x19, 0x07, 0x66, 0xb6,
+	0xad, 0x64, 0x6d, 0x6b, 0x46, 0x6f, 0x3b, 0x17, 0xbd, 0xbd, 0xdf, 0xb3, 0x44, 0xae, 0xf8, 0x4b,
+	0x45, 0x58, 0x17, 0x1b, 0xa7, 0x78, 0x28, 0x7d, 0x47, 0x00, 0xec, 0x01, 0x38, 0xda, 0xac, 0x21,
+	0x94, 0x20, 0x8b, 0x01, 0x27, 0x38, 0x1c, 0xea, 0x7e, 0x50, 0x42, 0xa2, 0x80, 0x61, 0x6d, 0x46,
+	0x20, 0xb3, 0x86, 0x02, 0x59, 0xcc, 0x0a, 0xe2, 0x88, 0x70, 0xcf, 0xa8, 0xfb, 0x7c, 0xed, 0x61,
+	0xb8, 0xba, 0x77, 0x4a, 0xfa, 0x2f, 0x0e, 0xde, 0x98, 0x2d, 0xbc, 0x14, 0x50, 0x96, 0x85, 0x54,
+	0x97, 0x79, 0x16, 0xdb, 0x25, 0x81, 0x54, 0x58, 0x81, 0xec, 0x64, 0x14, 0x26, 0x49, 0x18, 0x0d,
+	0x64, 0x74, 0x55, 0x20, 0x33, 0x51, 0x92, 0xe2, 0xa1, 0x0e, 0xb0, 0x1c, 0x60, 0xbb, 0xe4, 0xdb,
+	0x94, 0x8a, 0x6e, 0xbe, 0xe1, 0x0b, 0xc0, 0xfb, 0x83, 0x05, 0xeb, 0x47, 0x14, 0x27, 0xa7, 0x97,
+	0x5c, 0x52, 0xcf, 0x94, 0xb4, 0x73, 0x0f, 0x9e, 0x69, 0x45, 0xab, 0xb9, 0x56, 0xd4, 0xfb, 0x05,
+	0x34, 0xa5, 0x30, 0x17, 0x6d, 0xb9, 0xfe, 0x6a, 0x41, 0xfb, 0xf3, 0x30, 0x49, 0x2f, 0x43, 0xa7,
+	0x1b, 0x50, 0xe3, 0x33, 0x92, 0x44, 0x1a, 0x58, 0x42, 0x65, 0xba, 0xcd, 0x4a, 0xe5, 0xaa, 0x51,
+	0x2a, 0x17, 0x16, 0xd6, 0xde, 0xa7, 0x70, 0x35, 0x23, 0xe7, 0xc5, 0xeb, 0x8a, 0x88, 0x7d, 0xa2,
+	0x49, 0x1a, 0x53, 0xf2, 0x56, 0x5e, 0xd0, 0xdb, 0x85, 0x0d, 0xcd, 0xef, 0xa2, 0x8f, 0xf4, 0x6b,
+	0xb8, 0xfa, 0x74, 0x42, 0x07, 0xe4, 0x32, 0x1e, 0x89, 0x05, 0xe3, 0xf8, 0xa5, 0xfc, 0xea, 0xd9,
+	0xd2, 0x7b, 0x00, 0x28, 0x4b, 0x5d, 0x0a, 0xc9, 0x6a, 0x01, 0xb6, 0xab, 0xbe, 0x23, 0x09, 0x79,
+	0x29, 0xb4, 0x77, 0x71, 0xff, 0xc5, 0x64, 0xbc, 0x37, 0x0b, 0xbf, 0x45, 0xd3, 0x33, 0x04, 0x55,
+	0x12, 0x0c, 0x44, 0x24, 0xae, 0xfb, 0x7c, 0x6d, 0xb4, 0x55, 0x8d, 0xb9, 0xb6, 0xaa, 0xa1, 0xda,
+	0xaa, 0x7e, 0x3c, 0x89, 0xf4, 0xeb, 0x73, 0xc0, 0xfb, 0xbb, 0x05, 0x2d, 0xc1, 0xf6, 0x0b, 0x1c,
+	0x85, 0x27, 0x4c, 0x7f, 0x07, 0x56, 0x59, 0x03, 0xa5, 0x12, 0x82, 0xed, 0x2b, 0xd0, 0x48, 0x72,
+	0x95, 0x5c, 0x92, 0x2b, 0xf1, 0xc5, 0x4c, 0x42, 0xaa, 0x9a, 0x03, 0xb2, 0x3d, 0x58, 0x9b, 0x25,
+	0x9a, 0xc4, 0x59, 0xe1, 0x6e, 0x76, 0x7b, 0xce, 0xd2, 0x79, 0x9b, 0xf8, 0xd9, 0x5b, 0xde, 0x6f,
+	0xa1, 0x29, 0x10, 0xfe, 0x2f, 0x69, 0x61, 0xa0, 0x6c, 0x97, 0x8d, 0x92, 0xac, 0x69, 0x0c, 0xa7,
+	0xea, 0xcd, 0x14, 0x88, 0x3e, 0x81, 0xfa, 0x48, 0x5a, 0x58, 0x0e, 0x2c, 0x36, 0x4b, 0x74, 0x55,
+	0x0f, 0xe1, 0xeb, 0x0b, 0xde, 0x9f, 0x2d, 0xb8, 0x26, 0x9d, 0xfd, 0x52, 0xd4, 0xcd, 0x88, 0x59,
+	0x31, 0xc5, 0x7c, 0x17, 0x1a, 0x94, 0x8c, 0xf0, 0xf8, 0x38, 0x0c, 0x12, 0xae, 0x70, 0x9d, 0x8d,
+	0x18, 0x46, 0x78, 0x7c, 0x10, 0x9c, 0xd7, 0xe3, 0x78, 0x7f, 0x64, 0xcd, 0x9b, 0x29, 0xa5, 0x34,
+	0x4b, 0x56, 0x79, 0xeb, 0x35, 0x95, 0x17, 0x43, 0x0f, 0x4e, 0x55, 0xe5, 0x54, 0x0d, 0xa3, 0xc7,
+	0x60, 0x0b, 0x41, 0x99, 0xf3, 0x6c, 0x17, 0x7c, 0xee, 0x05, 0xd2, 0x74, 0x0e, 0x02, 0xd9, 0xa9,
+	0xb0, 0xbb, 0xe8, 0x36, 0xac, 0xf3, 0x14, 0x7d, 0x2c, 0xb3, 0xb3, 0x50, 0x6b, 0x8d, 0xef, 0x7d,
+	0xca, 0xb7, 0xdc, 0x9f, 0x42, 0x5d, 0xdd, 0x79, 0x9d, 0x16, 0xe6, 0x47, 0x1f, 0x02, 0xcc, 0x0a,
+	0x45, 0x04, 0x50, 0xdb, 0xf3, 0xbb, 0x8f, 0x8f, 0xba, 0xed, 0x2b, 0x6c, 0xfd, 0xe5, 0xd3, 0x7d,
+	0xb6, 0xb6, 0xd8, 0x7a, 0xbf, 0xfb, 0x79, 0xf7, 0xa8, 0xdb, 0xae, 0xec, 0xfc, 0xb7, 0x09, 0x95,
+	0xfd, 0x5d, 0x74, 0x08, 0x35, 0x31, 0x9f, 0x44, 0xb7, 0xe6, 0x74, 0x32, 0x26, 0x99, 0xee, 0x66,
+	0xe9, 0xb9, 0xac, 0x7a, 0xaf, 0xa0, 0x67, 0x50, 0x57, 0x73, 0x4c, 0xb4, 0x55, 0x60, 0x22, 0x63,
+	0xea, 0xe9, 0xde, 0x5e, 0x80, 0xa1, 0x49, 0x76, 0xa1, 0xca, 0x6a, 0x3b, 0xf4, 0x5e, 0x01, 0xb2,
+	0x9e, 0x8e, 0xba, 0x37, 0x4b, 0x4e, 0x35, 0x99, 0x43, 0xa8, 0x89, 0xae, 0xa0, 0x40, 0x4d, 0x63,
+	0x5e, 0xe9, 0x6e, 0x96, 0x9e, 0x67, 0x89, 0x89, 0x11, 0x5e, 0x01, 0x31, 0x63, 0xe0, 0xe8, 0x6e,
+	0x96, 0x9e, 0x67, 0x89, 0x89, 0x2e, 0xa2, 0x80, 0x98, 0x31, 0xf9, 0x73, 0x37, 0x4b, 0xcf, 0xb3,
+	0xc4, 0xc4, 0x2c, 0xa9, 0x80, 0x98, 0x31, 0x36, 0x73, 0x37, 0x4b, 0xcf, 0x8d, 0xd7, 0x94, 0xb3,
+	0x80, 0xa2, 0xd7, 0x34, 0x67, 0x3d, 0xee, 0xed, 0x05, 0x18, 0x9a, 0x24, 0x81, 0x96, 0x39, 0x5e,
+	0x40, 0x77, 0x5f, 0x6d, 0x18, 0xe2, 0xde, 0x3b, 0x17, 0x4f, 0x31, 0xf9, 0xd0, 0x42, 0x5f, 0xc3,
+	0x5a, 0xa6, 0x4d, 0x45, 0x77, 0xe6, 0xee, 0xce, 0x77, 0xfb, 0xee, 0xfb, 0x8b, 0x91, 0xb4, 0x0a,
+	0x5f, 0x8b, 0xb9, 0xbc, 0x6c, 0x36, 0x0a, 0x68, 0xcf, 0xf7, 0x3e, 0xee, 0xfb, 0x8b, 0x91, 0x34,
+	0xed, 0xa7, 0xb0, 0x2a, 0x6b, 0x7f, 0xb4, 0x59, 0x70, 0x25, 0xdb, 0x95, 0xb8, 0x5b, 0xe5, 0x08,
+	0x19, 0x4b, 0x7c, 0x05, 0x30, 0xab, 0xb0, 0x91, 0x57, 0xd0, 0x01, 0xe5, 0x2a, 0x7c, 0xf7, 0xce,
+	0x42, 0x1c, 0x2d, 0xea, 0x67, 0xb0, 0xc2, 0x8b, 0x0d, 0x74, 0xb3, 0xc8, 0x6e, 0xba, 0xc4, 0x71,
+	0x6f, 0x95, 0x1d, 0x6b, 0x4a, 0x47, 0xd0, 0xd0, 0x55, 0x21, 0x9a, 0xf7, 0xa2, 0x7c, 0x65, 0xeb,
+	0x7a, 0x8b, 0x50, 0x34, 0xd5, 0x27, 0xb0, 0x2a, 0x43, 0x72, 0xa1, 0x29, 0xb3, 0xd5, 0xa3, 0xbb,
+	0x55, 0x8e, 0xa0, 0xe9, 0x7d, 0x05, 0x30, 0xab, 0xb0, 0x0a, 0x0c, 0x39, 0x57, 0xdc, 0xb9, 0x77,
+	0x16, 0xe2, 0x64, 0x3f, 0x59, 0x91, 0x34, 0x0a, 0x3e, 0x59, 0x23, 0x03, 0xbb, 0x9b, 0xa5, 0xe7,
+	0x9a, 0xd8, 0x6f, 0xa0, 0x69, 0x24, 0x22, 0xf4, 0xc1, 0x79, 0x89, 0x4a, 0x90, 0xbe, 0xfb, 0x6a,
+	0xf9, 0xcc, 0xbb, 0x82, 0xfa, 0xea, 0x37, 0x8b, 0xfe, 0xef, 0x7a, 0xb7, 0x24, 0x60, 0xe6, 0xa6,
+	0x2c, 0xee, 0xbd, 0x73, 0xf1, 0xb2, 0x4c, 0xcc, 0xc9, 0x4a, 0x01, 0x93, 0xc2, 0x51, 0x8e, 0x7b,
+	0xef, 0x5c, 0x3c, 0xc5, 0xa4, 0x57, 0xe3, 0x3f, 0x95, 0x1f, 0xfe, 0x6f, 0x00, 0xd6, 0xb0, 0x88,
+	0xd4, 0x77, 0x1e, 0x00, 0x00,
 }
@@ -0,0 +1,654 @@
+// Package tenant scopes the queries of db-srv to the organisation of the request. The organisation is read from the
+// go-micro metadata of the request, see common.NewTenantContext, and the queries accessing the collections of
+// common.DbTenantTables are rewritten to only access the documents of the organisation:
+//
+//	FOR doc IN note SORT doc.created RETURN doc
+//	FOR doc IN note FILTER doc.parameter1 == @tenant_org_id SORT doc.created RETURN doc
+//
+// The paths of the graph traversals are filtered the same way, every vertex of a path is of the organisation or of a
+// platform collection, the inserted and updated documents get the organisation, and the writes by key are done in a
+// loop over the documents of the organisation:
+//
+//	REMOVE @key IN note
+//	LET tenant_key1 = (@key) FOR tenant_doc1 IN note FILTER ... REMOVE tenant_doc1 IN note
+//
+// A request without organisation accessing a tenant collection, or a query accessing it in a way which can't be
+// scoped like DOCUMENT or a collection used as a value (LENGTH(note), FULLTEXT(note, ...), note[*]), is rejected, or only logged in ModeLog, unless it is a platform level request of
+// common.NewAdminContext.
+package tenant
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"server/common"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// Mode is the enforcement of the tenant scoping
+type Mode string
+
+const (
+	// ModeOff doesn't scope the queries
+	ModeOff Mode = "off"
+	// ModeLog scopes the queries of the requests with an organisation and logs the ones without it
+	ModeLog Mode = "log"
+	// ModeEnforce scopes the queries of the requests with an organisation and rejects the ones without it
+	ModeEnforce Mode = "enforce"
+)
+
+const (
+	// OrgBindVar is the bind variable of the organisation added to the scoped queries
+	OrgBindVar = "tenant_org_id"
+	// CollectionsBindVar is the bind variable of the tenant collections added to the scoped traversals
+	CollectionsBindVar = "tenant_collections"
+)
+
+var (
+	// DefaultMode is set from the tenant.mode config of db-srv, it only logs until every caller sets a tenant
+	DefaultMode = ModeLog
+
+	ErrNoTenant     = errors.New("tenant is required to access an organisation collection")
+	ErrUnscoped     = errors.New("query accesses an organisation collection in a way which can't be scoped")
+	ErrScript       = errors.New("transaction action can't be scoped to a tenant")
+	ErrArchive      = errors.New("archive of another organisation")
+	ErrOrganisation = errors.New("record of another organisation")
+)
+
+// BindVars are the bind variables the scoped queries may have
+var BindVars = []string{OrgBindVar, CollectionsBindVar}
+
+// traversal directions
+var directions = []string{"OUTBOUND", "INBOUND", "ANY"}
+
+// the keywords ending the declaration of a traversal
+var operations = []string{"FOR", "LET", "FILTER", "SORT", "LIMIT", "COLLECT", "RETURN", "INSERT", "UPDATE", "REPLACE",
+	"REMOVE", "UPSERT", "PRUNE", "WINDOW"}
+
+// Scope returns a query whose loops, traversals and writes over the tenant collections of database only access the
+// documents of orgId, the tenant collections accessed, and the tenant collections accessed in a way which can't be
+// scoped. A tenant collection is only scoped as the source of a FOR loop or the collection of a write, its other
+// uses are unscoped. The DOCUMENT calls of an unknown collection are counted as unscoped "DOCUMENT". Only the
+// traversals are scoped if orgId is empty, their paths through the vertices of the tenant collections are filtered
+// out. The collections bound as
+// @@name are looked up in bindVars, and the organisation and the tenant collections are added to them if they are
+// used by the scoped query.
+func Scope(database, query string, bindVars map[string]interface{}, orgId string) (string, []string, []string) {
+	if database != common.DbHealumName {
+		return query, nil, nil
+	}
+	tokens := tokenize(query)
+	collections := []string{}
+	unscoped := []string{}
+	// the edits of the loops and writes, and of the traversals which are also scoped without organisation
+	edits := []insert{}
+	filters := []insert{}
+	writes, traversals := 0, 0
+	// the tokens of the collections used by a loop, a write, a traversal or a DOCUMENT call
+	used := map[int]bool{}
+	// the collections declared by WITH for the traversals
+	if len(tokens) > 0 && tokens[0].keyword("WITH") {
+		for j := 1; j < len(tokens) && (tokens[j].kind == identToken || tokens[j].kind == collectionBindToken); j += 2 {
+			used[j] = true
+			if j+1 >= len(tokens) || !tokens[j+1].punct(",") {
+				break
+			}
+		}
+	}
+	for i := 0; i < len(tokens); i++ {
+		switch {
+		case tokens[i].keyword("FOR"):
+			// FOR doc IN collection
+			if i+3 < len(tokens) && tokens[i+1].kind == identToken && tokens[i+2].keyword("IN") {
+				collection, ok := collectionName(tokens[i+3], bindVars)
+				// an expression or a function call isn't a collection
+				if ok && (i+4 >= len(tokens) || !tokens[i+4].punct(".", "(", "[")) && common.IsTenantTable(database, collection) {
+					used[i+3] = true
+					collections = append(collections, collection)
+					edits = append(edits, insert{
+						pos:  afterOptions(tokens, i+4, tokens[i+3].end),
+						text: fmt.Sprintf(" FILTER %s.parameter1 == @%s ", tokens[i+1].text, OrgBindVar),
+					})
+					continue
+				}
+			}
+			// FOR v, e, p IN min..max OUTBOUND start edges
+			end, ok := traversal(tokens, i)
+			if end < 0 {
+				continue
+			}
+			if !ok {
+				unscoped = append(unscoped, "traversal")
+				continue
+			}
+			// the edge collections
+			for j := i + 1; j < len(tokens) && tokens[j].start < end; j++ {
+				used[j] = true
+			}
+			// the vertices of the whole path are filtered, the edge and path variables are added if needed
+			traversals++
+			path, vars := pathVariable(tokens, i, traversals)
+			if len(vars) > 0 {
+				filters = append(filters, vars...)
+			}
+			filter := fmt.Sprintf(`SPLIT(CURRENT._id, "/")[0] IN @%s`, CollectionsBindVar)
+			if len(orgId) > 0 {
+				filter = fmt.Sprintf(`%s && CURRENT.parameter1 != @%s`, filter, OrgBindVar)
+			}
+			filters = append(filters, insert{pos: end, text: fmt.Sprintf(" FILTER LENGTH(%s.vertices[* FILTER %s]) == 0 ", path, filter)})
+		case tokens[i].keyword("DOCUMENT") && statement(tokens, i) && i+1 < len(tokens) && tokens[i+1].punct("("):
+			collection := documentCollection(tokens, i+2, bindVars)
+			used[i+2] = true
+			if len(collection) == 0 {
+				unscoped = append(unscoped, "DOCUMENT")
+			} else if common.IsTenantTable(database, collection) {
+				unscoped = append(unscoped, collection)
+			}
+		case statement(tokens, i) && (tokens[i].keyword("INSERT") || tokens[i].keyword("UPDATE") ||
+			tokens[i].keyword("REPLACE") || tokens[i].keyword("REMOVE") || tokens[i].keyword("UPSERT")):
+			w, ok := parseWrite(tokens, i)
+			if !ok {
+				continue
+			}
+			// the INSERT and UPDATE of an UPSERT are part of it
+			i = w.collection
+			used[w.collection] = true
+			collection, ok := collectionName(tokens[w.collection], bindVars)
+			if !ok {
+				unscoped = append(unscoped, tokens[w.collection].text)
+				continue
+			}
+			if !common.IsTenantTable(database, collection) {
+				continue
+			}
+			collections = append(collections, collection)
+			writes++
+			edits = append(edits, w.scope(tokens, writes)...)
+		}
+	}
+	unscoped = append(unscoped, values(database, tokens, bindVars, used)...)
+	// the loops and writes can't be scoped without organisation
+	if len(orgId) == 0 {
+		edits = nil
+	}
+	edits = append(edits, filters...)
+	if len(edits) == 0 {
+		return query, collections, unscoped
+	}
+
+	// the edits at the same position are kept in order
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].pos < edits[j].pos })
+	scoped := query
+	for i := len(edits) - 1; i >= 0; i-- {
+		scoped = scoped[:edits[i].pos] + edits[i].text + scoped[edits[i].pos+edits[i].drop:]
+	}
+	if len(orgId) > 0 {
+		bindVars[OrgBindVar] = orgId
+	}
+	if len(filters) > 0 {
+		tables := []interface{}{}
+		for _, t := range common.DbTenantTables {
+			tables = append(tables, t)
+		}
+		bindVars[CollectionsBindVar] = tables
+	}
+	return scoped, collections, unscoped
+}
+
+// pathVariable returns the path variable of the traversal starting at the FOR token i, and the edits declaring the
+// edge and path variables if the traversal doesn't, n numbers the variables of the traversal
+func pathVariable(tokens []token, i, n int) (string, []insert) {
+	declared := []token{tokens[i+1]}
+	for j := i + 2; j+1 < len(tokens) && len(declared) < 3 && tokens[j].punct(","); j += 2 {
+		declared = append(declared, tokens[j+1])
+	}
+	path := fmt.Sprintf("tenant_path%d", n)
+	switch len(declared) {
+	case 1:
+		return path, []insert{{pos: declared[0].end, text: fmt.Sprintf(", tenant_edge%d, %s", n, path)}}
+	case 2:
+		return path, []insert{{pos: declared[1].end, text: ", " + path}}
+	}
+	return declared[2].text, nil
+}
+
+// values returns the tenant collections of the tokens which aren't used by a loop, a write, a traversal or a
+// DOCUMENT call, the collections used as values can't be scoped. The variables, attributes, object keys and
+// function names aren't collections.
+func values(database string, tokens []token, bindVars map[string]interface{}, used map[int]bool) []string {
+	variables := map[string]bool{}
+	for i, t := range tokens {
+		switch {
+		// FOR v, e, p IN
+		case t.keyword("FOR"):
+			for j := i + 1; j < len(tokens) && tokens[j].kind == identToken; j += 2 {
+				variables[tokens[j].text] = true
+				if j+1 >= len(tokens) || !tokens[j+1].punct(",") {
+					break
+				}
+			}
+		// LET x =, COLLECT x =, AGGREGATE x =
+		case t.kind == identToken && i+1 < len(tokens) && tokens[i+1].punct("=") && (i+2 >= len(tokens) || !tokens[i+2].punct("=")):
+			variables[t.text] = true
+		}
+	}
+	collections := []string{}
+	for i, t := range tokens {
+		if used[i] || t.kind == identToken && variables[t.text] {
+			continue
+		}
+		if i > 0 && tokens[i-1].punct(".") || i+1 < len(tokens) && tokens[i+1].punct(":", "(") {
+			continue
+		}
+		if c, ok := collectionName(t, bindVars); ok && common.IsTenantTable(database, c) {
+			collections = append(collections, c)
+		}
+	}
+	return collections
+}
+
+// collectionName returns the collection of a token, a name or a @@name bind variable
+func collectionName(t token, bindVars map[string]interface{}) (string, bool) {
+	switch t.kind {
+	case identToken:
+		return t.text, true
+	case collectionBindToken:
+		c, ok := bindVars[t.text[2:]].(string)
+		return c, ok
+	}
+	return "", false
+}
+
+// statement checks that the keyword at i isn't an attribute name
+func statement(tokens []token, i int) bool {
+	return (i == 0 || !tokens[i-1].punct(".")) && (i+1 >= len(tokens) || !tokens[i+1].punct(":"))
+}
+
+// traversal returns the end of the declaration of the traversal starting at the FOR token i and whether it can be
+// scoped, the end is -1 if it isn't a traversal
+func traversal(tokens []token, i int) (int, bool) {
+	// the vertex, edge and path variables
+	j := i + 1
+	for n := 0; n < 3 && j < len(tokens) && tokens[j].kind == identToken; n++ {
+		if j+1 < len(tokens) && tokens[j+1].punct(",") {
+			j += 2
+			continue
+		}
+		j++
+		break
+	}
+	if j >= len(tokens) || !tokens[j].keyword("IN") || tokens[i+1].kind != identToken {
+		return -1, false
+	}
+	// the depths
+	for j++; j < len(tokens) && (tokens[j].kind == numberToken || tokens[j].kind == bindToken || tokens[j].punct(".")); j++ {
+	}
+	if j >= len(tokens) || !keyword(tokens[j], directions) || j+1 < len(tokens) && tokens[j+1].punct(".", "(", "[") {
+		return -1, false
+	}
+	// the shortest paths aren't scoped
+	if j+1 < len(tokens) && (tokens[j+1].keyword("SHORTEST_PATH") || tokens[j+1].keyword("K_SHORTEST_PATHS")) {
+		return 0, false
+	}
+	depth := 0
+	for j++; j < len(tokens); j++ {
+		t := tokens[j]
+		switch {
+		case t.punct("(", "[", "{"):
+			depth++
+		case t.punct(")", "]", "}"):
+			if depth == 0 {
+				return t.start, true
+			}
+			depth--
+		case depth == 0 && t.keyword("PRUNE"):
+			return 0, false
+		case depth == 0 && keyword(t, operations) && statement(tokens, j):
+			return t.start, true
+		}
+	}
+	return tokens[len(tokens)-1].end, true
+}
+
+// documentCollection returns the collection of the DOCUMENT call whose arguments start at i, empty if it isn't known
+func documentCollection(tokens []token, i int, bindVars map[string]interface{}) string {
+	if i+1 >= len(tokens) {
+		return ""
+	}
+	t := tokens[i]
+	switch {
+	// DOCUMENT(collection, key)
+	case tokens[i+1].punct(","):
+		c, _ := collectionName(t, bindVars)
+		return c
+	// DOCUMENT("collection/key")
+	case t.kind == stringToken && tokens[i+1].punct(")"):
+		id := t.text[1 : len(t.text)-1]
+		if j := strings.Index(id, "/"); j > 0 {
+			return id[:j]
+		}
+	}
+	return ""
+}
+
+// write is an INSERT, UPDATE, REPLACE, REMOVE or UPSERT, the expressions are token ranges [start, end]
+type write struct {
+	operation  token
+	expr       [][2]int
+	collection int
+}
+
+// parseWrite parses the write starting at i
+func parseWrite(tokens []token, i int) (*write, bool) {
+	w := &write{operation: tokens[i]}
+	// the keywords separating the expressions
+	separators := map[string][]string{
+		"INSERT":  {"INTO", "IN"},
+		"UPDATE":  {"WITH", "IN", "INTO"},
+		"REPLACE": {"WITH", "IN", "INTO"},
+		"REMOVE":  {"IN", "INTO"},
+		"UPSERT":  {"INSERT", "UPDATE", "REPLACE", "IN", "INTO"},
+	}[strings.ToUpper(w.operation.text)]
+	start, depth := i+1, 0
+	for j := i + 1; j < len(tokens); j++ {
+		t := tokens[j]
+		switch {
+		case t.punct("(", "[", "{"):
+			depth++
+		case t.punct(")", "]", "}"):
+			depth--
+			if depth < 0 {
+				return nil, false
+			}
+		case depth == 0 && keyword(t, separators) && statement(tokens, j) && j > start:
+			w.expr = append(w.expr, [2]int{start, j - 1})
+			start = j + 1
+			if t.keyword("IN") || t.keyword("INTO") {
+				if j+1 >= len(tokens) {
+					return nil, false
+				}
+				w.collection = j + 1
+				return w, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// scope returns the edits scoping the write to the organisation, n numbers the variables of the write
+func (w *write) scope(tokens []token, n int) []insert {
+	org := fmt.Sprintf("{parameter1: @%s}", OrgBindVar)
+	merge := func(e [2]int) []insert {
+		return []insert{
+			{pos: tokens[e[0]].start, text: "MERGE("},
+			{pos: tokens[e[1]].end, text: ", " + org + ")"},
+		}
+	}
+	operation := strings.ToUpper(w.operation.text)
+	switch operation {
+	case "INSERT":
+		return merge(w.expr[0])
+	case "UPSERT":
+		edits := []insert{}
+		for _, e := range w.expr {
+			edits = append(edits, merge(e)...)
+		}
+		return edits
+	}
+
+	// the documents written by key are looked up in the documents of the organisation
+	key, doc := fmt.Sprintf("tenant_key%d", n), fmt.Sprintf("tenant_doc%d", n)
+	c := tokens[w.collection]
+	loop := fmt.Sprintf(`) FOR %s IN %s FILTER %s._key == (IS_OBJECT(%s) ? %s._key : %s) && %s.parameter1 == @%s %s %s`,
+		doc, c.text, doc, key, key, key, doc, OrgBindVar, operation, doc)
+	// the operation keyword is replaced by the LET
+	edits := []insert{{pos: w.operation.start, drop: w.operation.end - w.operation.start, text: fmt.Sprintf("LET %s = (", key)}}
+	switch {
+	case operation == "REMOVE":
+		edits = append(edits, insert{pos: tokens[w.expr[0][1]].end, text: loop})
+	case len(w.expr) == 1:
+		// UPDATE doc IN collection
+		edits = append(edits, insert{pos: tokens[w.expr[0][1]].end, text: fmt.Sprintf("%s WITH MERGE(%s, %s)", loop, key, org)})
+	default:
+		edits = append(edits, insert{pos: tokens[w.expr[0][1]].end, text: loop})
+		edits = append(edits, merge(w.expr[1])...)
+	}
+	return edits
+}
+
+// keyword checks that a token is one of the keywords
+func keyword(t token, keywords []string) bool {
+	for _, k := range keywords {
+		if t.keyword(k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Query scopes a query to the tenant of ctx, the queries without organisation aren't changed in ModeLog
+func Query(ctx context.Context, database, query string, bindVars map[string]interface{}) (string, error) {
+	orgId, admin := common.TenantFromContext(ctx)
+	if DefaultMode == ModeOff || admin {
+		return query, nil
+	}
+	scoped, collections, unscoped := Scope(database, query, bindVars, orgId)
+	var err error
+	if len(unscoped) > 0 {
+		log.WithField("collections", unscoped).Warn("Tenant collections accessed in a way which can't be scoped")
+		err = ErrUnscoped
+	}
+	if len(collections) > 0 && len(orgId) == 0 {
+		log.WithField("collections", collections).Warn("Tenant collections accessed without tenant")
+		err = ErrNoTenant
+	}
+	if err != nil && DefaultMode == ModeEnforce || len(orgId) == 0 && DefaultMode == ModeLog {
+		for _, name := range BindVars {
+			delete(bindVars, name)
+		}
+		if DefaultMode == ModeEnforce {
+			return query, err
+		}
+		return query, nil
+	}
+	return scoped, nil
+}
+
+// Collection checks that a request without query, a read, a write or a search by id, can access the collection
+func Collection(ctx context.Context, database, collection string) (string, error) {
+	orgId, admin := common.TenantFromContext(ctx)
+	if DefaultMode == ModeOff || admin || !common.IsTenantTable(database, collection) || len(orgId) > 0 {
+		return orgId, nil
+	}
+	return "", reject([]string{collection})
+}
+
+// Script checks that a transaction action can read the collections, it can't be scoped so only the platform level
+// requests can run it on the tenant collections
+func Script(ctx context.Context, database string, collections []string) error {
+	_, admin := common.TenantFromContext(ctx)
+	if DefaultMode == ModeOff || admin {
+		return nil
+	}
+	tenant := []string{}
+	for _, c := range collections {
+		if common.IsTenantTable(database, c) {
+			tenant = append(tenant, c)
+		}
+	}
+	if len(tenant) == 0 {
+		return nil
+	}
+	log.WithField("collections", tenant).Warn("Transaction action on tenant collections")
+	if DefaultMode == ModeEnforce {
+		return ErrScript
+	}
+	return nil
+}
+
+// Archive checks that a request can backup or restore the archive of orgId, the archive of every organisation if
+// it's empty. Only the platform level requests handle the archives of other organisations.
+func Archive(ctx context.Context, orgId string) error {
+	tenant, admin := common.TenantFromContext(ctx)
+	if DefaultMode == ModeOff || admin || len(orgId) > 0 && orgId == tenant {
+		return nil
+	}
+	log.WithFields(log.Fields{"org_id": orgId, "tenant": tenant}).Warn("Archive of another organisation")
+	if DefaultMode == ModeEnforce {
+		return ErrArchive
+	}
+	return nil
+}
+
+// reject returns the error of a request without organisation, it is only logged in ModeLog
+func reject(collections []string) error {
+	log.WithField("collections", collections).Warn("Tenant collections accessed without tenant")
+	if DefaultMode == ModeEnforce {
+		return ErrNoTenant
+	}
+	return nil
+}
+
+// insert is a text inserted at a position of a query, replacing drop bytes
+type insert struct {
+	pos  int
+	drop int
+	text string
+}
+
+// afterOptions returns the end of the OPTIONS {...} of a loop starting at token i, or end if it has none
+func afterOptions(tokens []token, i, end int) int {
+	if i+1 >= len(tokens) || !tokens[i].keyword("OPTIONS") || !tokens[i+1].punct("{") {
+		return end
+	}
+	depth := 0
+	for ; i+1 < len(tokens); i++ {
+		t := tokens[i+1]
+		if t.punct("{") {
+			depth++
+		} else if t.punct("}") {
+			depth--
+			if depth == 0 {
+				return t.end
+			}
+		}
+	}
+	return end
+}
+
+type tokenKind int
+
+const (
+	identToken tokenKind = iota
+	bindToken
+	collectionBindToken
+	stringToken
+	numberToken
+	punctToken
+)
+
+// token of a query, text is the source text and start and end its offsets in the query
+type token struct {
+	kind  tokenKind
+	text  string
+	start int
+	end   int
+}
+
+func (t token) keyword(k string) bool {
+	return t.kind == identToken && strings.EqualFold(t.text, k)
+}
+
+func (t token) punct(p ...string) bool {
+	if t.kind != punctToken {
+		return false
+	}
+	for _, s := range p {
+		if t.text == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdent(c byte, first bool) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || !first && c >= '0' && c <= '9'
+}
+
+// tokenize splits a query in tokens, the comments and the content of the strings are skipped
+func tokenize(q string) []token {
+	tokens := []token{}
+	for i := 0; i < len(q); {
+		c := q[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case strings.HasPrefix(q[i:], "//"):
+			for i < len(q) && q[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(q[i:], "/*"):
+			if end := strings.Index(q[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(q)
+			}
+			continue
+		case c == '"' || c == '\'' || c == '`':
+			i++
+			for i < len(q) && q[i] != c {
+				if q[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			if i > len(q) {
+				i = len(q)
+			}
+			kind := stringToken
+			// a quoted name is an identifier
+			if c == '`' {
+				kind = identToken
+				tokens = append(tokens, token{kind: kind, text: strings.Trim(q[start:i], "`"), start: start, end: i})
+				continue
+			}
+			tokens = append(tokens, token{kind: kind, text: q[start:i], start: start, end: i})
+			continue
+		case c == '@':
+			kind := bindToken
+			i++
+			if i < len(q) && q[i] == '@' {
+				kind = collectionBindToken
+				i++
+			}
+			for i < len(q) && isIdent(q[i], false) {
+				i++
+			}
+			tokens = append(tokens, token{kind: kind, text: q[start:i], start: start, end: i})
+			continue
+		case isIdent(c, true):
+			for i < len(q) && isIdent(q[i], false) {
+				i++
+			}
+			tokens = append(tokens, token{kind: identToken, text: q[start:i], start: start, end: i})
+			continue
+		case c >= '0' && c <= '9':
+			for i < len(q) && (q[i] >= '0' && q[i] <= '9' || q[i] == '.' && !strings.HasPrefix(q[i:], "..")) {
+				i++
+			}
+			tokens = append(tokens, token{kind: numberToken, text: q[start:i], start: start, end: i})
+			continue
+		}
+		i++
+		tokens = append(tokens, token{kind: punctToken, text: q[start:i], start: start, end: i})
+	}
+	return tokens
+}
@@ -0,0 +1,195 @@
+package tenant
+
+import (
+	"strings"
+	"testing"
+
+	"server/common"
+	"server/db-srv/db/memory"
+	mdb "server/db-srv/proto/db"
+
+	"golang.org/x/net/context"
+)
+
+func TestScope(t *testing.T) {
+	filter := func(v string) string {
+		return "FILTER " + v + ".parameter1 == @" + OrgBindVar
+	}
+	paths := func(p string) string {
+		return `FILTER LENGTH(` + p + `.vertices[* FILTER SPLIT(CURRENT._id, "/")[0] IN @` + CollectionsBindVar +
+			` && CURRENT.parameter1 != @` + OrgBindVar + `]) == 0`
+	}
+	loop := func(n string) string {
+		return `FOR tenant_doc` + n + ` IN ` + common.DbNoteTable + ` FILTER tenant_doc` + n + `._key == (IS_OBJECT(tenant_key` + n +
+			`) ? tenant_key` + n + `._key : tenant_key` + n + `) && tenant_doc` + n + `.parameter1 == @` + OrgBindVar
+	}
+	org := "{parameter1: @" + OrgBindVar + "})"
+	cases := []struct {
+		query       string
+		scoped      []string
+		collections int
+		unscoped    int
+	}{
+		{`FOR doc IN ` + common.DbNoteTable + ` SORT doc.created RETURN doc`, []string{common.DbNoteTable + ` ` + filter("doc") + `  SORT`}, 1, 0},
+		{`FOR c IN ` + common.DbContentTable + ` FOR doc IN ` + common.DbContentCategoryTable + ` RETURN doc`, []string{filter("c")}, 1, 0},
+		{`FOR doc IN @@collection OPTIONS {indexHint: "byOrg"} RETURN doc`, []string{`"byOrg"} ` + filter("doc")}, 1, 0},
+		{`LET u = (FOR p IN ` + common.DbUserTable + ` RETURN p) FOR doc IN u RETURN doc`, []string{filter("p")}, 1, 0},
+		// the vertices of the paths of the traversals are filtered
+		{`FOR v, e IN 1..1 OUTBOUND "user/1" ` + common.DbUserOrgEdgeTable + ` RETURN v`, []string{`FOR v, e, tenant_path1 IN`, common.DbUserOrgEdgeTable + `  ` + paths("tenant_path1") + ` RETURN`}, 0, 0},
+		{`LET a = (FOR a IN OUTBOUND doc edge OPTIONS {bfs: true}) RETURN a`, []string{`FOR a, tenant_edge1, tenant_path1 IN`, `{bfs: true} ` + paths("tenant_path1") + ` )`}, 0, 0},
+		{`FOR v, e, p IN 1..3 ANY "user/1" ` + common.DbUserOrgEdgeTable + ` RETURN p`, []string{`FOR v, e, p IN`, paths("p")}, 0, 0},
+		{`WITH ` + common.DbUserTable + ` FOR v IN OUTBOUND "team/1" ` + common.DbTeamMembershipTable + ` RETURN v`, []string{paths("tenant_path1")}, 0, 0},
+		// the writes get the organisation
+		{`INSERT {_key: "n1"} INTO ` + common.DbNoteTable + ` RETURN NEW`, []string{`INSERT MERGE({_key: "n1"}, ` + org + ` INTO`}, 1, 0},
+		{`UPSERT {_key: @id} INSERT @doc UPDATE @doc IN ` + common.DbNoteTable, []string{`UPSERT MERGE({_key: @id}, ` + org + ` INSERT MERGE(@doc, ` + org + ` UPDATE MERGE(@doc, ` + org + ` IN`}, 1, 0},
+		{`REMOVE @key IN ` + common.DbNoteTable, []string{`LET tenant_key1 = ( @key) ` + loop("1") + ` REMOVE tenant_doc1 IN`}, 1, 0},
+		{`UPDATE {_key: @key} WITH {data: @data} IN ` + common.DbNoteTable + ` UPDATE @doc IN ` + common.DbNoteTable,
+			[]string{`LET tenant_key1 = ( {_key: @key}) ` + loop("1") + ` UPDATE tenant_doc1 WITH MERGE({data: @data}, ` + org,
+				`LET tenant_key2 = ( @doc) ` + loop("2") + ` UPDATE tenant_doc2 WITH MERGE(tenant_key2, ` + org + ` IN`}, 2, 0},
+		{`INSERT {_key: "t1"} INTO ` + common.DbMarkerTable, nil, 0, 0},
+		// DOCUMENT can't be scoped
+		{`RETURN DOCUMENT("` + common.DbNoteTable + `/1")`, nil, 0, 1},
+		{`RETURN DOCUMENT(` + common.DbNoteTable + `, "1")`, nil, 0, 1},
+		{`RETURN DOCUMENT(@id)`, nil, 0, 1},
+		{`RETURN DOCUMENT("` + common.DbMarkerTable + `/1")`, nil, 0, 0},
+		// the collections used as values can't be scoped
+		{`FOR doc IN FULLTEXT(` + common.DbNoteTable + `, "title", "walk") RETURN doc`, nil, 0, 1},
+		{`FOR doc IN NEAR(` + common.DbNoteTable + `, 0, 0) RETURN doc`, nil, 0, 1},
+		{`RETURN LENGTH(` + common.DbNoteTable + `)`, nil, 0, 1},
+		{`FOR doc IN ` + common.DbNoteTable + `[*] RETURN doc`, nil, 0, 1},
+		{`RETURN APPEND(` + common.DbNoteTable + `, [])`, nil, 0, 1},
+		{`LET x = ` + common.DbNoteTable + ` RETURN x`, nil, 0, 1},
+		{`RETURN LENGTH(@@collection)`, nil, 0, 1},
+		{`RETURN LENGTH(` + common.DbMarkerTable + `)`, nil, 0, 0},
+		// the variables named like a collection aren't collections
+		{`LET user = (FOR user IN ` + common.DbUserTable + ` RETURN user) RETURN LENGTH(user)`, []string{filter("user")}, 1, 0},
+		// expressions, attributes, strings and comments aren't accesses of a collection
+		{`FOR doc IN ` + common.DbOrganisationTable + ` FOR m IN doc.data.modules RETURN {update: m.remove}`, nil, 0, 0},
+		{`RETURN "FOR doc IN ` + common.DbNoteTable + `" // FOR doc IN ` + common.DbNoteTable, nil, 0, 0},
+	}
+	for _, c := range cases {
+		vars := map[string]interface{}{"collection": common.DbTodoTable}
+		scoped, collections, unscoped := Scope(common.DbHealumName, c.query, vars, "org1")
+		if len(collections) != c.collections || len(unscoped) != c.unscoped {
+			t.Errorf("%s: collections are invalid: %v %v", c.query, collections, unscoped)
+		}
+		for _, s := range c.scoped {
+			if !strings.Contains(scoped, s) {
+				t.Errorf("%s: query isn't scoped: %s", c.query, scoped)
+			}
+		}
+		if len(c.scoped) == 0 && scoped != c.query {
+			t.Errorf("%s: query must not be changed: %s", c.query, scoped)
+		}
+		if c.collections > 0 && vars[OrgBindVar] != "org1" {
+			t.Errorf("%s: organisation isn't bound: %v", c.query, vars)
+		}
+	}
+
+	// the traversals without organisation skip the paths through the tenant collections
+	q := `FOR v IN OUTBOUND "user/1" ` + common.DbUserOrgEdgeTable + ` RETURN v`
+	vars := map[string]interface{}{}
+	if scoped, _, _ := Scope(common.DbHealumName, q, vars, ""); !strings.Contains(scoped, `FILTER LENGTH(tenant_path1.vertices[* FILTER SPLIT(CURRENT._id, "/")[0] IN @`+CollectionsBindVar+`]) == 0`) || vars[OrgBindVar] != nil {
+		t.Errorf("Traversal without organisation must skip the tenant collections: %s %v", scoped, vars)
+	}
+
+	// the databases of the other services aren't scoped
+	q = `FOR doc IN ` + common.DbNoteTable + ` RETURN doc`
+	if scoped, _, _ := Scope("other", q, map[string]interface{}{}, "org1"); scoped != q {
+		t.Errorf("Query of another database must not be scoped: %s", scoped)
+	}
+}
+
+func TestScopeRun(t *testing.T) {
+	d, err := memory.NewDriver().NewDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Init(&mdb.Database{Name: common.DbHealumName, Table: common.DbNoteTable, Driver: memory.DriverName}); err != nil {
+		t.Fatal(err)
+	}
+	run := func(q string, vars map[string]interface{}) []*mdb.Record {
+		scoped, _, _ := Scope(common.DbHealumName, q, vars, "org1")
+		records, err := d.RunQuery(scoped, vars)
+		if err != nil {
+			t.Fatalf("%s: %v", scoped, err)
+		}
+		return records
+	}
+	for _, org := range []string{"org1", "org2"} {
+		q := `INSERT {_key: @key, parameter1: @org, data: {title: "walk"}} INTO ` + common.DbNoteTable
+		if _, err := d.RunQuery(q, map[string]interface{}{"key": org, "org": org}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	q := `UPDATE {_key: @key} WITH {data: {title: "run"}} IN ` + common.DbNoteTable + ` RETURN NEW`
+	if records := run(q, map[string]interface{}{"key": "org2"}); len(records) != 0 {
+		t.Errorf("Note of another organisation must not be updated: %v", records)
+	}
+	if records := run(q, map[string]interface{}{"key": "org1"}); len(records) != 1 {
+		t.Errorf("Note of the organisation must be updated: %v", records)
+	}
+	q = `INSERT {_key: "n3", parameter1: "org2"} INTO ` + common.DbNoteTable + ` RETURN NEW`
+	if records := run(q, map[string]interface{}{}); len(records) != 1 || records[0].Parameter1 != "org1" {
+		t.Errorf("Note must be inserted in the organisation: %v", records)
+	}
+	q = `REMOVE @key IN ` + common.DbNoteTable + ` RETURN OLD`
+	if records := run(q, map[string]interface{}{"key": "org2"}); len(records) != 0 {
+		t.Errorf("Note of another organisation must not be removed: %v", records)
+	}
+	if records, _ := d.RunQuery(`FOR doc IN `+common.DbNoteTable+` FILTER doc.parameter1 == "org2" RETURN doc`, nil); len(records) != 1 {
+		t.Errorf("Note of another organisation must be kept: %v", records)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	defer func(mode Mode) { DefaultMode = mode }(DefaultMode)
+	DefaultMode = ModeEnforce
+	q := `FOR doc IN ` + common.DbNoteTable + ` RETURN doc`
+	ctx := context.TODO()
+
+	if _, err := Query(ctx, common.DbHealumName, q, map[string]interface{}{}); err != ErrNoTenant {
+		t.Errorf("Query without tenant must be rejected: %v", err)
+	}
+	if scoped, err := Query(common.NewAdminContext(ctx), common.DbHealumName, q, map[string]interface{}{}); err != nil || scoped != q {
+		t.Errorf("Platform level query must not be scoped: %v %v", scoped, err)
+	}
+	if scoped, err := Query(common.NewTenantContext(ctx, "org1"), common.DbHealumName, q, map[string]interface{}{}); err != nil || scoped == q {
+		t.Errorf("Query of a tenant must be scoped: %v %v", scoped, err)
+	}
+	static := `FOR doc IN ` + common.DbMarkerTable + ` RETURN doc`
+	if _, err := Query(ctx, common.DbHealumName, static, map[string]interface{}{}); err != nil {
+		t.Errorf("Query of a platform collection must not be rejected: %v", err)
+	}
+
+	document := `RETURN DOCUMENT("` + common.DbNoteTable + `/1")`
+	if _, err := Query(common.NewTenantContext(ctx, "org1"), common.DbHealumName, document, map[string]interface{}{}); err != ErrUnscoped {
+		t.Errorf("Query which can't be scoped must be rejected: %v", err)
+	}
+
+	DefaultMode = ModeLog
+	vars := map[string]interface{}{}
+	traversal := `FOR v IN OUTBOUND "user/1" ` + common.DbUserOrgEdgeTable + ` FOR doc IN ` + common.DbNoteTable + ` RETURN doc`
+	if scoped, err := Query(ctx, common.DbHealumName, traversal, vars); err != nil || scoped != traversal || len(vars) > 0 {
+		t.Errorf("Query without tenant must only be logged: %v %v %v", scoped, err, vars)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	defer func(mode Mode) { DefaultMode = mode }(DefaultMode)
+	DefaultMode = ModeEnforce
+	ctx := common.NewTenantContext(context.TODO(), "org1")
+	if err := Archive(ctx, "org1"); err != nil {
+		t.Errorf("Archive of the tenant must be allowed: %v", err)
+	}
+	if err := Archive(ctx, "org2"); err != ErrArchive {
+		t.Errorf("Archive of another organisation must be rejected: %v", err)
+	}
+	if err := Archive(ctx, ""); err != ErrArchive {
+		t.Errorf("Archive of every organisation must be rejected: %v", err)
+	}
+	if err := Archive(common.NewAdminContext(context.TODO()), ""); err != nil {
+		t.Errorf("Platform level archive must be allowed: %v", err)
+	}
+}
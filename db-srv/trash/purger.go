@@ -0,0 +1,57 @@
+package trash
+
+import (
+	"sync"
+	"time"
+
+	"server/common"
+)
+
+var (
+	// PurgeInterval is the wait between two purges
+	PurgeInterval = time.Hour
+
+	DefaultPurger = NewPurger(common.DbHealumName)
+)
+
+// Purger purges the trash of a database periodically
+type Purger struct {
+	database string
+
+	exit chan bool
+	wg   sync.WaitGroup
+}
+
+func NewPurger(database string) *Purger {
+	return &Purger{database: database}
+}
+
+// Start runs the purges until Stop is called
+func (p *Purger) Start() {
+	p.exit = make(chan bool)
+	p.wg.Add(1)
+	go p.run()
+}
+
+// Stop waits for the current purge to complete
+func (p *Purger) Stop() {
+	close(p.exit)
+	p.wg.Wait()
+}
+
+func (p *Purger) run() {
+	defer p.wg.Done()
+	t := time.NewTicker(PurgeInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-p.exit:
+			return
+		case <-t.C:
+			if _, err := Purge(p.database, time.Now().Unix()); err != nil {
+				common.ErrorLog(common.DbSrv, Purge, err, "Trash purge is failed")
+			}
+		}
+	}
+}
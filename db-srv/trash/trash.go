@@ -0,0 +1,271 @@
+// Package trash soft deletes the documents of the healum database
+//
+// A trashed document is moved to the trash collection with the edges linking it, so the queries of its collection
+// and the graph traversals don't see it anymore. It can be restored with its edges until the purger removes it
+// after the trash retention of its organisation, the edges created meanwhile to the trashed document are removed too.
+package trash
+
+import (
+	"fmt"
+	"strings"
+
+	"server/common"
+	"server/db-srv/db"
+	mdb "server/db-srv/proto/db"
+)
+
+const day = 24 * 60 * 60
+
+// RetentionDays is the trash retention of the organisations without a trash_retention_days setting
+var RetentionDays = 30
+
+// trash entries are generic records: _key is "<collection>:<id>", parameter1 the organisation of the document,
+// parameter2 its collection and data keeps the document with its edges
+const trashRecord = `{
+	id: t.data.document._key,
+	created: t.created,
+	updated: t.updated,
+	name: t.name,
+	parameter1: t.parameter1,
+	parameter2: t.parameter2,
+	deleted_at: t.deleted_at,
+	deleted_by: t.deleted_by,
+	data: t.data.document.data
+}`
+
+const matchDocument = `
+	FOR doc IN @@collection
+	FILTER doc._key == @id && (@org_id == "" || doc.parameter1 == @org_id)`
+
+const matchEntry = `
+	FOR t IN %s
+	FILTER t._key == @key && (@org_id == "" || t.parameter1 == @org_id)`
+
+// the expired entries, days is the trash retention of the organisation setting
+const matchExpired = `
+	LET settings = (
+		FOR s IN %s
+		FILTER TO_NUMBER(s.data.trash_retention_days) > 0
+		RETURN {org_id: s.data.org_id, days: TO_NUMBER(s.data.trash_retention_days)}
+	)
+	FOR t IN %s
+	LET days = FIRST(FOR s IN settings FILTER s.org_id == t.parameter1 RETURN s.days)
+	FILTER t.deleted_at <= @now - NOT_NULL(days, @days) * %d`
+
+// Key returns the key of a trashed document in the trash collection
+func Key(collection, id string) string {
+	return collection + ":" + id
+}
+
+// edgeCollections returns the edge collections of the healum graph linking the documents of a collection,
+// all of them if the collection is empty
+func edgeCollections(collection string) []string {
+	edges := []string{}
+	seen := map[string]bool{}
+	for _, cols := range common.DbHealum {
+		if len(cols) != 4 || seen[cols[0]] {
+			continue
+		}
+		if len(collection) == 0 || cols[2] == collection || cols[3] == collection {
+			seen[cols[0]] = true
+			edges = append(edges, cols[0])
+		}
+	}
+	return edges
+}
+
+func database(name string) *mdb.Database {
+	return &mdb.Database{Name: name, Table: common.DbTrashTable, Driver: common.DbHealumDriver}
+}
+
+// Trash moves a document and its edges to the trash, it returns db.ErrNotFound if the document doesn't exist
+// in the organisation. An empty orgId matches the documents of every organisation.
+func Trash(name, collection, id, orgId, deletedBy string, now int64) (*mdb.Record, error) {
+	edges := edgeCollections(collection)
+	vars := map[string]interface{}{
+		"@collection": collection,
+		"id":          id,
+		"org_id":      orgId,
+	}
+
+	linked := []string{}
+	for _, edge := range edges {
+		linked = append(linked, fmt.Sprintf(`(
+			FOR e IN %s
+			FILTER e._from == doc._id || e._to == doc._id
+			RETURN {collection: %q, edge: e}
+		)`, edge, edge))
+	}
+	insert := fmt.Sprintf(`%s
+		LET edges = FLATTEN([%s])
+		INSERT {
+			_key: @key,
+			id: @key,
+			created: doc.created,
+			updated: @now,
+			name: doc.name,
+			parameter1: doc.parameter1,
+			parameter2: @table,
+			deleted_at: @now,
+			deleted_by: @deleted_by,
+			data: {document: doc, edges: edges}
+		} INTO %s
+		RETURN {
+			id: doc._key,
+			created: doc.created,
+			updated: @now,
+			name: doc.name,
+			parameter1: doc.parameter1,
+			parameter2: @table,
+			deleted_at: @now,
+			deleted_by: @deleted_by,
+			data: doc.data
+		}`, matchDocument, strings.Join(linked, ", "), common.DbTrashTable)
+	tx := &db.Tx{
+		Read:  []string{collection},
+		Write: append([]string{common.DbTrashTable, collection}, edges...),
+		Operations: []*db.Operation{{
+			Query: insert,
+			BindVars: map[string]interface{}{
+				"@collection": collection,
+				"id":          id,
+				"org_id":      orgId,
+				"key":         Key(collection, id),
+				"table":       collection,
+				"now":         now,
+				"deleted_by":  deletedBy,
+			},
+		}},
+	}
+	for _, edge := range edges {
+		tx.Operations = append(tx.Operations, &db.Operation{
+			Query: fmt.Sprintf(`%s
+				FOR e IN %s
+				FILTER e._from == doc._id || e._to == doc._id
+				REMOVE e IN %s`, matchDocument, edge, edge),
+			BindVars: vars,
+		})
+	}
+	tx.Operations = append(tx.Operations, &db.Operation{
+		Query:    matchDocument + ` REMOVE doc IN @@collection`,
+		BindVars: vars,
+	})
+
+	results, err := db.Transaction(database(name), tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(results[0]) == 0 {
+		return nil, db.ErrNotFound
+	}
+	return results[0][0], nil
+}
+
+// List returns the trashed documents of the collections, the latest deleted first. Every collection is listed
+// if collections is empty and every organisation if orgId is empty.
+func List(name string, collections []string, orgId string, offset, limit int64) ([]*mdb.Record, error) {
+	if collections == nil {
+		collections = []string{}
+	}
+	q := fmt.Sprintf(`
+		FOR t IN %s
+		FILTER (LENGTH(@collections) == 0 || t.parameter2 IN @collections) && (@org_id == "" || t.parameter1 == @org_id)
+		SORT t.deleted_at DESC
+		LIMIT @offset, @limit
+		RETURN %s`, common.DbTrashTable, trashRecord)
+	return db.RunQuery(database(name), q, map[string]interface{}{
+		"collections": collections,
+		"org_id":      orgId,
+		"offset":      offset,
+		"limit":       limit,
+	})
+}
+
+// Restore moves a trashed document and its edges back to its collection, it returns db.ErrNotFound if the
+// document isn't in the trash of the organisation. It fails if a document with the same key was created since.
+func Restore(name, collection, id, orgId string) (*mdb.Record, error) {
+	edges := edgeCollections(collection)
+	match := fmt.Sprintf(matchEntry, common.DbTrashTable)
+	vars := map[string]interface{}{
+		"key":    Key(collection, id),
+		"org_id": orgId,
+	}
+
+	tx := &db.Tx{
+		Read:  []string{common.DbTrashTable},
+		Write: append([]string{common.DbTrashTable, collection}, edges...),
+		Operations: []*db.Operation{{
+			Query: fmt.Sprintf(`%s
+				INSERT UNSET(t.data.document, "_id", "_rev") INTO @@collection
+				RETURN %s`, match, trashRecord),
+			BindVars: map[string]interface{}{
+				"@collection": collection,
+				"key":         Key(collection, id),
+				"org_id":      orgId,
+			},
+		}},
+	}
+	// an edge to a vertex removed meanwhile is restored, it's removed when the vertex is purged
+	for _, edge := range edges {
+		tx.Operations = append(tx.Operations, &db.Operation{
+			Query: fmt.Sprintf(`%s
+				FOR e IN t.data.edges
+				FILTER e.collection == %q
+				INSERT UNSET(e.edge, "_id", "_rev") INTO %s OPTIONS { ignoreErrors: true }`, match, edge, edge),
+			BindVars: vars,
+		})
+	}
+	tx.Operations = append(tx.Operations, &db.Operation{
+		Query:    match + fmt.Sprintf(` REMOVE t IN %s`, common.DbTrashTable),
+		BindVars: vars,
+	})
+
+	results, err := db.Transaction(database(name), tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(results[0]) == 0 {
+		return nil, db.ErrNotFound
+	}
+	r := results[0][0]
+	// the restored document isn't deleted anymore
+	r.DeletedAt = 0
+	r.DeletedBy = ""
+	return r, nil
+}
+
+// Purge removes the trashed documents deleted before their trash retention and the edges linking them,
+// it returns the number of documents removed
+func Purge(name string, now int64) (int64, error) {
+	edges := edgeCollections("")
+	expired := fmt.Sprintf(matchExpired, common.DbOrgSettingTable, common.DbTrashTable, day)
+	vars := map[string]interface{}{
+		"now":  now,
+		"days": RetentionDays,
+	}
+
+	tx := &db.Tx{
+		Read:  []string{common.DbOrgSettingTable, common.DbTrashTable},
+		Write: append([]string{common.DbTrashTable}, edges...),
+	}
+	// edges are linked again by restores, or to a trashed vertex after its trash
+	for _, edge := range edges {
+		tx.Operations = append(tx.Operations, &db.Operation{
+			Query: fmt.Sprintf(`%s
+				FOR e IN %s
+				FILTER e._from == t.data.document._id || e._to == t.data.document._id
+				REMOVE e IN %s OPTIONS { ignoreErrors: true }`, expired, edge, edge),
+			BindVars: vars,
+		})
+	}
+	tx.Operations = append(tx.Operations, &db.Operation{
+		Query:    expired + fmt.Sprintf(` REMOVE t IN %s RETURN {id: OLD._key}`, common.DbTrashTable),
+		BindVars: vars,
+	})
+
+	results, err := db.Transaction(database(name), tx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(results[len(results)-1])), nil
+}
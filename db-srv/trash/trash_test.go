@@ -0,0 +1,141 @@
+package trash
+
+import (
+	"testing"
+
+	"server/common"
+	"server/db-srv/db"
+	"server/db-srv/db/memory"
+	mdb "server/db-srv/proto/db"
+
+	"github.com/micro/go-micro/registry"
+	mock_registry "github.com/micro/go-micro/registry/mock"
+	"github.com/micro/go-micro/selector"
+)
+
+var testDB = common.TestingName(common.DbHealumName)
+
+func initDb(t *testing.T) {
+	reg := mock_registry.NewRegistry()
+	db.Drivers[common.DbHealumDriver] = memory.NewDriver()
+	if err := reg.Register(&registry.Service{
+		Name: db.DBServiceNamespace + "." + common.DbHealumDriver,
+		Nodes: []*registry.Node{{
+			Id:       "trash-" + common.DbHealumDriver,
+			Address:  "memory",
+			Metadata: map[string]string{db.DBDriverKey: common.DbHealumDriver},
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Init(selector.NewSelector(selector.Registry(reg))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func runQuery(t *testing.T, q string, bindVars map[string]interface{}) []*mdb.Record {
+	records, err := db.RunQuery(&mdb.Database{Name: testDB, Table: common.DbGoalTable, Driver: common.DbHealumDriver}, q, bindVars)
+	if err != nil {
+		t.Fatalf("%s: %v", q, err)
+	}
+	return records
+}
+
+// createGoal creates a goal of the organisation shared with a user
+func createGoal(t *testing.T, id, orgId string) {
+	runQuery(t, `
+		INSERT {_key: @id, id: @id, created: 1, name: "goal", parameter1: @org_id, data: {title: "goal"}} INTO goal
+		INSERT {_key: @id, id: @id, name: "user"} INTO user OPTIONS { ignoreErrors: true }
+		INSERT {_from: CONCAT("goal/", @id), _to: CONCAT("user/", @id), data: {}} INTO share_goal_user`,
+		map[string]interface{}{"id": id, "org_id": orgId})
+}
+
+func count(t *testing.T, collection string) int {
+	return len(runQuery(t, `FOR d IN @@collection RETURN {id: d._key}`, map[string]interface{}{"@collection": collection}))
+}
+
+func TestTrashAndRestore(t *testing.T) {
+	initDb(t)
+	createGoal(t, "g1", "org1")
+
+	if _, err := Trash(testDB, common.DbGoalTable, "g1", "org2", "u1", 100); err != db.ErrNotFound {
+		t.Error("Document of another organisation must not be trashed:", err)
+	}
+	r, err := Trash(testDB, common.DbGoalTable, "g1", "org1", "u1", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Id != "g1" || r.DeletedAt != 100 || r.DeletedBy != "u1" || r.Parameter2 != common.DbGoalTable {
+		t.Error("Trashed record is invalid:", r)
+	}
+	if count(t, common.DbGoalTable) != 0 || count(t, common.DbShareGoalUserEdgeTable) != 0 {
+		t.Error("Document and edges must be moved to the trash")
+	}
+
+	records, err := List(testDB, []string{common.DbGoalTable}, "org1", 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Id != "g1" || records[0].Parameter3 != `{"title":"goal"}` {
+		t.Error("Trash list is invalid:", records)
+	}
+	if records, _ := List(testDB, []string{common.DbContentTable}, "", 0, 10); len(records) != 0 {
+		t.Error("Trash list must be filtered by collection:", records)
+	}
+
+	if _, err := Restore(testDB, common.DbGoalTable, "g1", "org2"); err != db.ErrNotFound {
+		t.Error("Document of another organisation must not be restored:", err)
+	}
+	r, err = Restore(testDB, common.DbGoalTable, "g1", "org1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Id != "g1" || r.DeletedAt != 0 {
+		t.Error("Restored record is invalid:", r)
+	}
+	if count(t, common.DbGoalTable) != 1 || count(t, common.DbShareGoalUserEdgeTable) != 1 || count(t, common.DbTrashTable) != 0 {
+		t.Error("Document and edges must be restored")
+	}
+	if _, err := Restore(testDB, common.DbGoalTable, "g1", "org1"); err != db.ErrNotFound {
+		t.Error("Restored document must be removed from the trash:", err)
+	}
+}
+
+func TestPurge(t *testing.T) {
+	initDb(t)
+	createGoal(t, "g1", "org1")
+	createGoal(t, "g2", "org2")
+	// org1 keeps its trash 2 days
+	runQuery(t, `INSERT {_key: "s1", data: {org_id: "org1", trash_retention_days: "2"}} INTO organisation_setting`, nil)
+
+	if _, err := Trash(testDB, common.DbGoalTable, "g1", "", "u1", 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Trash(testDB, common.DbGoalTable, "g2", "", "u1", 0); err != nil {
+		t.Fatal(err)
+	}
+	// an edge created to the trashed goal after the trash is purged with it
+	runQuery(t, `INSERT {_from: "goal/g1", _to: "user/g2", data: {}} INTO share_goal_user`, nil)
+
+	purged, err := Purge(testDB, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 0 {
+		t.Error("Documents must be kept during the retention:", purged)
+	}
+	purged, err = Purge(testDB, 3*day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 || count(t, common.DbShareGoalUserEdgeTable) != 0 {
+		t.Error("Documents of org1 must be purged with their edges:", purged)
+	}
+	purged, err = Purge(testDB, int64(RetentionDays)*day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 || count(t, common.DbTrashTable) != 0 {
+		t.Error("Documents without retention setting must be purged after RetentionDays:", purged)
+	}
+}
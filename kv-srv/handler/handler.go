@@ -5,6 +5,7 @@ import (
 	"fmt"
 	kv_proto "server/kv-srv/proto/kv"
 	"strings"
+	"sync"
 	"time"
 
 	"server/common"
@@ -17,6 +18,27 @@ import (
 
 type KvService struct {
 	Client *redis.Client
+	sync.Mutex
+	// by index, see indexClient
+	clients map[int64]*redis.Client
+}
+
+// indexClient returns a client whose connections use the database of index. The SELECT of a pooled connection doesn't
+// apply to the commands of the other connections.
+func (p *KvService) indexClient(index int64) *redis.Client {
+	p.Lock()
+	defer p.Unlock()
+	if c, ok := p.clients[index]; ok {
+		return c
+	}
+	if p.clients == nil {
+		p.clients = map[int64]*redis.Client{}
+	}
+	options := *p.Client.Options()
+	options.DB = int(index)
+	c := redis.NewClient(&options)
+	p.clients[index] = c
+	return c
 }
 
 func (p *KvService) Get(ctx context.Context, req *kv_proto.GetRequest, rsp *kv_proto.GetResponse) error {
@@ -242,6 +264,62 @@ func (p *KvService) RemoveSession(ctx context.Context, req *kv_proto.RemoveSessi
 	return p.Del(ctx, &kv_proto.DelRequest{req.SessionId}, rsp_del)
 }
 
+func (p *KvService) RevokeToken(ctx context.Context, req *kv_proto.RevokeTokenRequest, rsp *kv_proto.RevokeTokenResponse) error {
+	log.Info("Received Kv.RevokeToken request")
+	if len(req.Id) == 0 || req.Expiration <= 0 {
+		return common.BadRequest(common.KvSrv, p.RevokeToken, nil, "token id and expiration are required")
+	}
+	// the token can't be used after it expired, so neither can its entry
+	set, err := p.indexClient(req.Index).SetNX(req.Id, "true", time.Duration(req.Expiration)).Result()
+	if err != nil {
+		return common.InternalServerError(common.KvSrv, p.RevokeToken, err, "revoke token error")
+	}
+	rsp.Revoked = !set
+	return nil
+}
+
+func (p *KvService) IsTokenRevoked(ctx context.Context, req *kv_proto.IsTokenRevokedRequest, rsp *kv_proto.IsTokenRevokedResponse) error {
+	log.Info("Received Kv.IsTokenRevoked request")
+	if len(req.Ids) == 0 {
+		return nil
+	}
+	n, err := p.indexClient(req.Index).Exists(req.Ids...).Result()
+	if err != nil {
+		return common.InternalServerError(common.KvSrv, p.IsTokenRevoked, err, "is token revoked error")
+	}
+	rsp.Revoked = n > 0
+	return nil
+}
+
+func (p *KvService) RateLimit(ctx context.Context, req *kv_proto.RateLimitRequest, rsp *kv_proto.RateLimitResponse) error {
+	// every request of the api is counted
+	log.Debug("Received Kv.RateLimit request")
+	if len(req.Keys) == 0 || req.Window <= 0 {
+		return common.BadRequest(common.KvSrv, p.RateLimit, nil, "keys and window are required")
+	}
+
+	// the counters of the gateway replicas are incremented in one transaction
+	counts := make([]*redis.IntCmd, len(req.Keys))
+	resets := make([]*redis.DurationCmd, len(req.Keys))
+	_, err := p.indexClient(req.Index).TxPipelined(func(pipe redis.Pipeliner) error {
+		for i, key := range req.Keys {
+			// the window of a key starts with its first request
+			pipe.SetNX(key, 0, time.Duration(req.Window))
+			counts[i] = pipe.Incr(key)
+			resets[i] = pipe.PTTL(key)
+		}
+		return nil
+	})
+	if err != nil {
+		return common.InternalServerError(common.KvSrv, p.RateLimit, err, "rate limit error")
+	}
+	for i := range req.Keys {
+		rsp.Counts = append(rsp.Counts, counts[i].Val())
+		rsp.Resets = append(rsp.Resets, int64(resets[i].Val()))
+	}
+	return nil
+}
+
 func (p *KvService) IncTrackCount(ctx context.Context, req *kv_proto.IncTrackCountRequest, rsp *kv_proto.IncTrackCountResponse) error {
 	log.Info("Received Kv.IncTrackCount request")
 	cmd := redis.NewStringCmd("SELECT", req.Index)
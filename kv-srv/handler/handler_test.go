@@ -3,6 +3,7 @@ package handler
 import (
 	"bytes"
 	"context"
+	"fmt"
 	account_proto "server/account-srv/proto/account"
 	"server/common"
 	kv_proto "server/kv-srv/proto/kv"
@@ -211,3 +212,25 @@ func TestTxPipeline(t *testing.T) {
 		return nil
 	})
 }
+
+func TestRateLimit(t *testing.T) {
+	hdlr := initHandler()
+	ctx := common.NewTestContext(context.TODO())
+
+	// new windows
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	keys := []string{"ratelimit:user:" + suffix, "ratelimit:org:" + suffix}
+	req := &kv_proto.RateLimitRequest{Index: common.RATE_LIMIT_INDEX, Keys: keys, Window: int64(time.Minute)}
+	for i := int64(1); i <= 3; i++ {
+		rsp := &kv_proto.RateLimitResponse{}
+		if err := hdlr.RateLimit(ctx, req, rsp); err != nil {
+			t.Fatal(err)
+		}
+		if len(rsp.Counts) != 2 || rsp.Counts[0] != i || rsp.Counts[1] != i {
+			t.Errorf("Counts are invalid: %v", rsp.Counts)
+		}
+		if rsp.Resets[0] <= 0 || rsp.Resets[0] > int64(time.Minute) {
+			t.Errorf("Reset is invalid: %v", rsp.Resets)
+		}
+	}
+}
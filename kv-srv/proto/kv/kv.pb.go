@@ -37,6 +37,12 @@ It has these top-level messages:
 	RemoveSessionResponse
 	ReadSessionRequest
 	ReadSessionResponse
+	RevokeTokenRequest
+	RevokeTokenResponse
+	IsTokenRevokedRequest
+	IsTokenRevokedResponse
+	RateLimitRequest
+	RateLimitResponse
 	IncTrackCountRequest
 	IncTrackCountResponse
 	GetTrackCountRequest
@@ -602,6 +608,152 @@ func (m *ReadSessionResponse) GetValue() string {
 	return ""
 }
 
+// denylist of the jwt tokens, id is a token id or a token session
+type RevokeTokenRequest struct {
+	Index      int64  `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	Expiration int64  `protobuf:"varint,3,opt,name=expiration" json:"expiration,omitempty"`
+}
+
+func (m *RevokeTokenRequest) Reset()                    { *m = RevokeTokenRequest{} }
+func (m *RevokeTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeTokenRequest) ProtoMessage()               {}
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *RevokeTokenRequest) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RevokeTokenRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevokeTokenRequest) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type RevokeTokenResponse struct {
+	Revoked bool `protobuf:"varint,1,opt,name=revoked" json:"revoked,omitempty"`
+}
+
+func (m *RevokeTokenResponse) Reset()                    { *m = RevokeTokenResponse{} }
+func (m *RevokeTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevokeTokenResponse) ProtoMessage()               {}
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *RevokeTokenResponse) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+type IsTokenRevokedRequest struct {
+	Index int64    `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Ids   []string `protobuf:"bytes,2,rep,name=ids" json:"ids,omitempty"`
+}
+
+func (m *IsTokenRevokedRequest) Reset()                    { *m = IsTokenRevokedRequest{} }
+func (m *IsTokenRevokedRequest) String() string            { return proto.CompactTextString(m) }
+func (*IsTokenRevokedRequest) ProtoMessage()               {}
+func (*IsTokenRevokedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *IsTokenRevokedRequest) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *IsTokenRevokedRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type IsTokenRevokedResponse struct {
+	Revoked bool `protobuf:"varint,1,opt,name=revoked" json:"revoked,omitempty"`
+}
+
+func (m *IsTokenRevokedResponse) Reset()                    { *m = IsTokenRevokedResponse{} }
+func (m *IsTokenRevokedResponse) String() string            { return proto.CompactTextString(m) }
+func (*IsTokenRevokedResponse) ProtoMessage()               {}
+func (*IsTokenRevokedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *IsTokenRevokedResponse) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+// counts a request in the windows of keys, a window starts with its first request
+type RateLimitRequest struct {
+	Index  int64    `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	Keys   []string `protobuf:"bytes,2,rep,name=keys" json:"keys,omitempty"`
+	Window int64    `protobuf:"varint,3,opt,name=window" json:"window,omitempty"`
+}
+
+func (m *RateLimitRequest) Reset()                    { *m = RateLimitRequest{} }
+func (m *RateLimitRequest) String() string            { return proto.CompactTextString(m) }
+func (*RateLimitRequest) ProtoMessage()               {}
+func (*RateLimitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *RateLimitRequest) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RateLimitRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *RateLimitRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type RateLimitResponse struct {
+	Counts []int64 `protobuf:"varint,1,rep,packed,name=counts" json:"counts,omitempty"`
+	Resets []int64 `protobuf:"varint,2,rep,packed,name=resets" json:"resets,omitempty"`
+}
+
+func (m *RateLimitResponse) Reset()                    { *m = RateLimitResponse{} }
+func (m *RateLimitResponse) String() string            { return proto.CompactTextString(m) }
+func (*RateLimitResponse) ProtoMessage()               {}
+func (*RateLimitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *RateLimitResponse) GetCounts() []int64 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *RateLimitResponse) GetResets() []int64 {
+	if m != nil {
+		return m.Resets
+	}
+	return nil
+}
+
 type IncTrackCountRequest struct {
 	Index int64  `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
 	Key   string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
@@ -610,7 +762,7 @@ type IncTrackCountRequest struct {
 func (m *IncTrackCountRequest) Reset()                    { *m = IncTrackCountRequest{} }
 func (m *IncTrackCountRequest) String() string            { return proto.CompactTextString(m) }
 func (*IncTrackCountRequest) ProtoMessage()               {}
-func (*IncTrackCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*IncTrackCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *IncTrackCountRequest) GetIndex() int64 {
 	if m != nil {
@@ -633,7 +785,7 @@ type IncTrackCountResponse struct {
 func (m *IncTrackCountResponse) Reset()                    { *m = IncTrackCountResponse{} }
 func (m *IncTrackCountResponse) String() string            { return proto.CompactTextString(m) }
 func (*IncTrackCountResponse) ProtoMessage()               {}
-func (*IncTrackCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*IncTrackCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *IncTrackCountResponse) GetCount() int64 {
 	if m != nil {
@@ -650,7 +802,7 @@ type GetTrackCountRequest struct {
 func (m *GetTrackCountRequest) Reset()                    { *m = GetTrackCountRequest{} }
 func (m *GetTrackCountRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTrackCountRequest) ProtoMessage()               {}
-func (*GetTrackCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*GetTrackCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *GetTrackCountRequest) GetIndex() int64 {
 	if m != nil {
@@ -673,7 +825,7 @@ type GetTrackCountResponse struct {
 func (m *GetTrackCountResponse) Reset()                    { *m = GetTrackCountResponse{} }
 func (m *GetTrackCountResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTrackCountResponse) ProtoMessage()               {}
-func (*GetTrackCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*GetTrackCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *GetTrackCountResponse) GetCount() int64 {
 	if m != nil {
@@ -691,7 +843,7 @@ type SetTrackCountRequest struct {
 func (m *SetTrackCountRequest) Reset()                    { *m = SetTrackCountRequest{} }
 func (m *SetTrackCountRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetTrackCountRequest) ProtoMessage()               {}
-func (*SetTrackCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*SetTrackCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *SetTrackCountRequest) GetIndex() int64 {
 	if m != nil {
@@ -720,7 +872,7 @@ type SetTrackCountResponse struct {
 func (m *SetTrackCountResponse) Reset()                    { *m = SetTrackCountResponse{} }
 func (m *SetTrackCountResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetTrackCountResponse) ProtoMessage()               {}
-func (*SetTrackCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*SetTrackCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 type IncBadgeCountRequest struct {
 	Index int64  `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
@@ -730,7 +882,7 @@ type IncBadgeCountRequest struct {
 func (m *IncBadgeCountRequest) Reset()                    { *m = IncBadgeCountRequest{} }
 func (m *IncBadgeCountRequest) String() string            { return proto.CompactTextString(m) }
 func (*IncBadgeCountRequest) ProtoMessage()               {}
-func (*IncBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*IncBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *IncBadgeCountRequest) GetIndex() int64 {
 	if m != nil {
@@ -753,7 +905,7 @@ type IncBadgeCountResponse struct {
 func (m *IncBadgeCountResponse) Reset()                    { *m = IncBadgeCountResponse{} }
 func (m *IncBadgeCountResponse) String() string            { return proto.CompactTextString(m) }
 func (*IncBadgeCountResponse) ProtoMessage()               {}
-func (*IncBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*IncBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *IncBadgeCountResponse) GetCount() int64 {
 	if m != nil {
@@ -770,7 +922,7 @@ type DecrBadgeCountRequest struct {
 func (m *DecrBadgeCountRequest) Reset()                    { *m = DecrBadgeCountRequest{} }
 func (m *DecrBadgeCountRequest) String() string            { return proto.CompactTextString(m) }
 func (*DecrBadgeCountRequest) ProtoMessage()               {}
-func (*DecrBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*DecrBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *DecrBadgeCountRequest) GetIndex() int64 {
 	if m != nil {
@@ -793,7 +945,7 @@ type DecrBadgeCountResponse struct {
 func (m *DecrBadgeCountResponse) Reset()                    { *m = DecrBadgeCountResponse{} }
 func (m *DecrBadgeCountResponse) String() string            { return proto.CompactTextString(m) }
 func (*DecrBadgeCountResponse) ProtoMessage()               {}
-func (*DecrBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*DecrBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *DecrBadgeCountResponse) GetCount() int64 {
 	if m != nil {
@@ -810,7 +962,7 @@ type GetBadgeCountRequest struct {
 func (m *GetBadgeCountRequest) Reset()                    { *m = GetBadgeCountRequest{} }
 func (m *GetBadgeCountRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetBadgeCountRequest) ProtoMessage()               {}
-func (*GetBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*GetBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *GetBadgeCountRequest) GetIndex() int64 {
 	if m != nil {
@@ -833,7 +985,7 @@ type GetBadgeCountResponse struct {
 func (m *GetBadgeCountResponse) Reset()                    { *m = GetBadgeCountResponse{} }
 func (m *GetBadgeCountResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetBadgeCountResponse) ProtoMessage()               {}
-func (*GetBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*GetBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *GetBadgeCountResponse) GetCount() int64 {
 	if m != nil {
@@ -851,7 +1003,7 @@ type SetBadgeCountRequest struct {
 func (m *SetBadgeCountRequest) Reset()                    { *m = SetBadgeCountRequest{} }
 func (m *SetBadgeCountRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetBadgeCountRequest) ProtoMessage()               {}
-func (*SetBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*SetBadgeCountRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *SetBadgeCountRequest) GetIndex() int64 {
 	if m != nil {
@@ -880,7 +1032,7 @@ type SetBadgeCountResponse struct {
 func (m *SetBadgeCountResponse) Reset()                    { *m = SetBadgeCountResponse{} }
 func (m *SetBadgeCountResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetBadgeCountResponse) ProtoMessage()               {}
-func (*SetBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*SetBadgeCountResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 type GetTrackValueRequest struct {
 	Index int64  `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
@@ -890,7 +1042,7 @@ type GetTrackValueRequest struct {
 func (m *GetTrackValueRequest) Reset()                    { *m = GetTrackValueRequest{} }
 func (m *GetTrackValueRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTrackValueRequest) ProtoMessage()               {}
-func (*GetTrackValueRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*GetTrackValueRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *GetTrackValueRequest) GetIndex() int64 {
 	if m != nil {
@@ -913,7 +1065,7 @@ type GetTrackValueResponse struct {
 func (m *GetTrackValueResponse) Reset()                    { *m = GetTrackValueResponse{} }
 func (m *GetTrackValueResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTrackValueResponse) ProtoMessage()               {}
-func (*GetTrackValueResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*GetTrackValueResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *GetTrackValueResponse) GetValue() *google_protobuf.Value {
 	if m != nil {
@@ -932,7 +1084,7 @@ type TagsCloudRequest struct {
 func (m *TagsCloudRequest) Reset()                    { *m = TagsCloudRequest{} }
 func (m *TagsCloudRequest) String() string            { return proto.CompactTextString(m) }
 func (*TagsCloudRequest) ProtoMessage()               {}
-func (*TagsCloudRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*TagsCloudRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *TagsCloudRequest) GetIndex() int64 {
 	if m != nil {
@@ -968,7 +1120,7 @@ type TagsCloudResponse struct {
 func (m *TagsCloudResponse) Reset()                    { *m = TagsCloudResponse{} }
 func (m *TagsCloudResponse) String() string            { return proto.CompactTextString(m) }
 func (*TagsCloudResponse) ProtoMessage()               {}
-func (*TagsCloudResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*TagsCloudResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 type GetTopTagsRequest struct {
 	Index  int64  `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
@@ -980,7 +1132,7 @@ type GetTopTagsRequest struct {
 func (m *GetTopTagsRequest) Reset()                    { *m = GetTopTagsRequest{} }
 func (m *GetTopTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTopTagsRequest) ProtoMessage()               {}
-func (*GetTopTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*GetTopTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *GetTopTagsRequest) GetIndex() int64 {
 	if m != nil {
@@ -1017,7 +1169,7 @@ type GetTopTagsResponse struct {
 func (m *GetTopTagsResponse) Reset()                    { *m = GetTopTagsResponse{} }
 func (m *GetTopTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTopTagsResponse) ProtoMessage()               {}
-func (*GetTopTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*GetTopTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *GetTopTagsResponse) GetTags() []string {
 	if m != nil {
@@ -1056,6 +1208,12 @@ func init() {
 	proto.RegisterType((*RemoveSessionResponse)(nil), "go.micro.srv.kv.RemoveSessionResponse")
 	proto.RegisterType((*ReadSessionRequest)(nil), "go.micro.srv.kv.ReadSessionRequest")
 	proto.RegisterType((*ReadSessionResponse)(nil), "go.micro.srv.kv.ReadSessionResponse")
+	proto.RegisterType((*RevokeTokenRequest)(nil), "go.micro.srv.kv.RevokeTokenRequest")
+	proto.RegisterType((*RevokeTokenResponse)(nil), "go.micro.srv.kv.RevokeTokenResponse")
+	proto.RegisterType((*IsTokenRevokedRequest)(nil), "go.micro.srv.kv.IsTokenRevokedRequest")
+	proto.RegisterType((*IsTokenRevokedResponse)(nil), "go.micro.srv.kv.IsTokenRevokedResponse")
+	proto.RegisterType((*RateLimitRequest)(nil), "go.micro.srv.kv.RateLimitRequest")
+	proto.RegisterType((*RateLimitResponse)(nil), "go.micro.srv.kv.RateLimitResponse")
 	proto.RegisterType((*IncTrackCountRequest)(nil), "go.micro.srv.kv.IncTrackCountRequest")
 	proto.RegisterType((*IncTrackCountResponse)(nil), "go.micro.srv.kv.IncTrackCountResponse")
 	proto.RegisterType((*GetTrackCountRequest)(nil), "go.micro.srv.kv.GetTrackCountRequest")
@@ -1102,6 +1260,10 @@ type KvServiceClient interface {
 	AuthFailed(ctx context.Context, in *AuthFailedRequest, opts ...client.CallOption) (*AuthFailedResponse, error)
 	RemoveSession(ctx context.Context, in *RemoveSessionRequest, opts ...client.CallOption) (*RemoveSessionResponse, error)
 	ReadSession(ctx context.Context, in *ReadSessionRequest, opts ...client.CallOption) (*ReadSessionResponse, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...client.CallOption) (*RevokeTokenResponse, error)
+	IsTokenRevoked(ctx context.Context, in *IsTokenRevokedRequest, opts ...client.CallOption) (*IsTokenRevokedResponse, error)
+	// api rate limits
+	RateLimit(ctx context.Context, in *RateLimitRequest, opts ...client.CallOption) (*RateLimitResponse, error)
 	// track-srv
 	IncTrackCount(ctx context.Context, in *IncTrackCountRequest, opts ...client.CallOption) (*IncTrackCountResponse, error)
 	GetTrackCount(ctx context.Context, in *GetTrackCountRequest, opts ...client.CallOption) (*GetTrackCountResponse, error)
@@ -1275,6 +1437,36 @@ func (c *kvServiceClient) ReadSession(ctx context.Context, in *ReadSessionReques
 	return out, nil
 }
 
+func (c *kvServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...client.CallOption) (*RevokeTokenResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "KvService.RevokeToken", in)
+	out := new(RevokeTokenResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kvServiceClient) IsTokenRevoked(ctx context.Context, in *IsTokenRevokedRequest, opts ...client.CallOption) (*IsTokenRevokedResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "KvService.IsTokenRevoked", in)
+	out := new(IsTokenRevokedResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kvServiceClient) RateLimit(ctx context.Context, in *RateLimitRequest, opts ...client.CallOption) (*RateLimitResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "KvService.RateLimit", in)
+	out := new(RateLimitResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kvServiceClient) IncTrackCount(ctx context.Context, in *IncTrackCountRequest, opts ...client.CallOption) (*IncTrackCountResponse, error) {
 	req := c.c.NewRequest(c.serviceName, "KvService.IncTrackCount", in)
 	out := new(IncTrackCountResponse)
@@ -1394,6 +1586,10 @@ type KvServiceHandler interface {
 	AuthFailed(context.Context, *AuthFailedRequest, *AuthFailedResponse) error
 	RemoveSession(context.Context, *RemoveSessionRequest, *RemoveSessionResponse) error
 	ReadSession(context.Context, *ReadSessionRequest, *ReadSessionResponse) error
+	RevokeToken(context.Context, *RevokeTokenRequest, *RevokeTokenResponse) error
+	IsTokenRevoked(context.Context, *IsTokenRevokedRequest, *IsTokenRevokedResponse) error
+	// api rate limits
+	RateLimit(context.Context, *RateLimitRequest, *RateLimitResponse) error
 	// track-srv
 	IncTrackCount(context.Context, *IncTrackCountRequest, *IncTrackCountResponse) error
 	GetTrackCount(context.Context, *GetTrackCountRequest, *GetTrackCountResponse) error
@@ -1473,6 +1669,18 @@ func (h *KvService) ReadSession(ctx context.Context, in *ReadSessionRequest, out
 	return h.KvServiceHandler.ReadSession(ctx, in, out)
 }
 
+func (h *KvService) RevokeToken(ctx context.Context, in *RevokeTokenRequest, out *RevokeTokenResponse) error {
+	return h.KvServiceHandler.RevokeToken(ctx, in, out)
+}
+
+func (h *KvService) IsTokenRevoked(ctx context.Context, in *IsTokenRevokedRequest, out *IsTokenRevokedResponse) error {
+	return h.KvServiceHandler.IsTokenRevoked(ctx, in, out)
+}
+
+func (h *KvService) RateLimit(ctx context.Context, in *RateLimitRequest, out *RateLimitResponse) error {
+	return h.KvServiceHandler.RateLimit(ctx, in, out)
+}
+
 func (h *KvService) IncTrackCount(ctx context.Context, in *IncTrackCountRequest, out *IncTrackCountResponse) error {
 	return h.KvServiceHandler.IncTrackCount(ctx, in, out)
 }
@@ -1516,78 +1724,87 @@ func (h *KvService) GetTopTags(ctx context.Context, in *GetTopTagsRequest, out *
 func init() { proto.RegisterFile("server/kv-srv/proto/kv/kv.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x6d, 0x73, 0x9b, 0x46,
-	0x17, 0x86, 0x25, 0xcb, 0xf6, 0x44, 0x47, 0x56, 0x6c, 0xaf, 0x25, 0xc7, 0xc3, 0xf3, 0xc4, 0x71,
-	0x49, 0xe2, 0xa8, 0xad, 0x83, 0x66, 0xdc, 0xe9, 0xcb, 0xf4, 0x43, 0x3b, 0x8d, 0x95, 0x38, 0x1a,
-	0x77, 0x5a, 0x57, 0xa8, 0xed, 0x4c, 0x67, 0x3a, 0x2e, 0x46, 0x2b, 0x42, 0x85, 0x58, 0x95, 0xb7,
-	0x71, 0xfe, 0x58, 0x7f, 0x5f, 0x87, 0x65, 0x81, 0x05, 0x56, 0x60, 0x45, 0xf9, 0x24, 0x2d, 0x9c,
-	0x73, 0xdf, 0xe7, 0x1c, 0x96, 0xe5, 0x82, 0x27, 0x2e, 0x76, 0x02, 0xec, 0xf4, 0x67, 0xc1, 0x4b,
-	0xd7, 0x09, 0xfa, 0x0b, 0x87, 0x78, 0xa4, 0x3f, 0x0b, 0xfa, 0xb3, 0x40, 0xa1, 0xff, 0xd1, 0xae,
-	0x41, 0x94, 0xb9, 0xa9, 0x3b, 0x44, 0x71, 0x9d, 0x40, 0x99, 0x05, 0xd2, 0x97, 0x86, 0xe9, 0xbd,
-	0xf3, 0x6f, 0x15, 0x9d, 0xcc, 0xfb, 0x06, 0xb1, 0x34, 0xdb, 0x88, 0xb2, 0x6e, 0xfd, 0x69, 0x7f,
-	0xe1, 0xbd, 0x5f, 0x60, 0xb7, 0xef, 0x7a, 0x8e, 0xaf, 0x7b, 0xec, 0x27, 0xd2, 0x91, 0x7f, 0x82,
-	0xcd, 0xa1, 0x87, 0xe7, 0x68, 0x0f, 0x1a, 0x33, 0xfc, 0xfe, 0xa8, 0x7e, 0x52, 0xef, 0x35, 0x47,
-	0xe1, 0x5f, 0xd4, 0x81, 0xad, 0x40, 0xb3, 0x7c, 0x7c, 0xb4, 0x71, 0x52, 0xef, 0xed, 0x8c, 0xa2,
-	0x05, 0x3a, 0x06, 0xc0, 0x77, 0x0b, 0xd3, 0xd1, 0x3c, 0x93, 0xd8, 0x47, 0x8d, 0x93, 0x7a, 0xaf,
-	0x31, 0xe2, 0xae, 0xc8, 0xc7, 0x00, 0x97, 0xd8, 0x1b, 0xe1, 0x7f, 0x7c, 0xec, 0x7a, 0x45, 0x55,
-	0xf9, 0x1b, 0x68, 0xd1, 0xfb, 0xee, 0x82, 0xd8, 0x2e, 0x46, 0x9f, 0xc2, 0xa6, 0xe9, 0xe1, 0x39,
-	0x8d, 0x68, 0x9d, 0x77, 0x95, 0x5c, 0x57, 0x4a, 0x58, 0xdb, 0x88, 0x86, 0xc8, 0x5f, 0x03, 0x5c,
-	0xfb, 0x89, 0xf2, 0x0a, 0x89, 0x6d, 0x68, 0xd1, 0xc4, 0xc8, 0x32, 0xac, 0x70, 0x80, 0xad, 0xe5,
-	0x15, 0xb6, 0xa1, 0x45, 0xef, 0xb3, 0xf0, 0xaf, 0x60, 0xe7, 0x12, 0x7b, 0xaf, 0xef, 0xe2, 0x84,
-	0x0e, 0x6c, 0x99, 0xf6, 0x04, 0xdf, 0xd1, 0x94, 0xc6, 0x28, 0x5a, 0xc4, 0x32, 0x1b, 0xa9, 0xcc,
-	0xb7, 0xd0, 0x66, 0x79, 0xab, 0xb7, 0xfa, 0x33, 0xec, 0x5c, 0xfb, 0x95, 0x9e, 0xb1, 0xe0, 0x46,
-	0xb5, 0xe0, 0x2e, 0xb4, 0x99, 0x60, 0xda, 0xd5, 0x00, 0x5b, 0xab, 0x77, 0xb5, 0x0b, 0x6d, 0x96,
-	0xc7, 0x84, 0x5e, 0xc0, 0xc1, 0x05, 0xb1, 0xa7, 0xa6, 0x33, 0x1f, 0x93, 0x19, 0xb6, 0x97, 0x8f,
-	0xf5, 0x0c, 0x3a, 0xd9, 0x40, 0x36, 0x96, 0x64, 0x9b, 0x45, 0xb1, 0xd1, 0x42, 0x1e, 0x02, 0x1a,
-	0xe1, 0x39, 0x09, 0x70, 0x46, 0xf5, 0x31, 0x80, 0xa6, 0xeb, 0xc4, 0xb7, 0xbd, 0x1b, 0x73, 0xc2,
-	0x12, 0x9a, 0xec, 0xca, 0x70, 0x12, 0x4a, 0x79, 0x61, 0x38, 0x2b, 0x38, 0x5a, 0xc8, 0x5d, 0x38,
-	0xc8, 0x48, 0xb1, 0xc2, 0x07, 0xd0, 0xfe, 0x91, 0xe8, 0x33, 0x3c, 0x29, 0x1f, 0x41, 0xd6, 0x72,
-	0x23, 0x67, 0x29, 0xf7, 0xe0, 0x61, 0xac, 0xc2, 0xfa, 0x39, 0x84, 0x6d, 0x8b, 0x5e, 0xa1, 0x3a,
-	0x0f, 0x46, 0x6c, 0x25, 0xfb, 0xd0, 0xfe, 0xd5, 0x0e, 0x63, 0x63, 0xbf, 0x53, 0xd8, 0xd5, 0x7c,
-	0xef, 0xdd, 0xcd, 0x54, 0x33, 0xad, 0x1b, 0xde, 0xb9, 0x1d, 0x5e, 0x7e, 0xa3, 0x99, 0xd6, 0x30,
-	0xae, 0x20, 0x94, 0x60, 0x21, 0x1b, 0x34, 0xa4, 0x19, 0x5e, 0x19, 0x0a, 0x0a, 0x6c, 0x08, 0x0a,
-	0x8c, 0x6d, 0x2b, 0x0a, 0x7c, 0x03, 0xbb, 0x43, 0xf7, 0x23, 0x8c, 0xe4, 0x33, 0xd8, 0x4b, 0x75,
-	0x2a, 0x3c, 0xdf, 0xc2, 0xfe, 0x0f, 0xac, 0xd9, 0x35, 0x5d, 0xcf, 0x00, 0xf1, 0x4a, 0xa9, 0xef,
-	0x94, 0x5e, 0x61, 0x5a, 0x6c, 0x25, 0x5f, 0x41, 0x27, 0xda, 0x13, 0x2a, 0x76, 0x5d, 0x93, 0xd8,
-	0x95, 0xd6, 0x6e, 0x14, 0xc7, 0x59, 0xb3, 0x2b, 0xc3, 0x89, 0xfc, 0x08, 0xba, 0x39, 0x31, 0xb6,
-	0xc5, 0xe8, 0x26, 0xd6, 0x26, 0x1f, 0xc3, 0xe3, 0xf3, 0x70, 0x13, 0x73, 0x52, 0xa5, 0x2f, 0xcf,
-	0x77, 0xd0, 0x19, 0xda, 0xfa, 0xd8, 0xd1, 0xf4, 0xd9, 0x45, 0x38, 0x9e, 0x55, 0x5f, 0xf2, 0x97,
-	0xd0, 0xcd, 0xe5, 0xa7, 0x76, 0x74, 0xde, 0xb1, 0x00, 0x5d, 0x84, 0x76, 0x97, 0xd8, 0x5b, 0xcb,
-	0x2e, 0x97, 0x5f, 0x6a, 0x37, 0x86, 0x8e, 0xba, 0x86, 0x5d, 0xaa, 0xda, 0xe0, 0x55, 0x1f, 0x41,
-	0x57, 0x15, 0x15, 0xc1, 0x86, 0xf9, 0x4a, 0x9b, 0x18, 0x78, 0x8d, 0x61, 0xf2, 0xf9, 0xa5, 0xdd,
-	0x7d, 0x0f, 0xdd, 0x01, 0xd6, 0x9d, 0x0f, 0xf7, 0x53, 0xe0, 0x30, 0x2f, 0x70, 0x8f, 0xa7, 0xb7,
-	0x56, 0x7f, 0xb9, 0xfc, 0x7b, 0x3c, 0xbd, 0x0f, 0xb6, 0x2b, 0x7d, 0x7a, 0xc5, 0x22, 0xf8, 0xbd,
-	0xf9, 0x5b, 0xf8, 0x6e, 0xac, 0xda, 0xdd, 0xeb, 0x74, 0x6f, 0xb2, 0x7c, 0xd6, 0xdd, 0x19, 0xff,
-	0xe6, 0xb5, 0xce, 0x0f, 0x15, 0x83, 0x10, 0xc3, 0xc2, 0x4a, 0xcc, 0x5c, 0x4a, 0x14, 0xce, 0xde,
-	0xc8, 0x19, 0xec, 0x8d, 0x35, 0xc3, 0xbd, 0xb0, 0x88, 0x5f, 0x71, 0xcc, 0x75, 0x61, 0x9b, 0x38,
-	0x46, 0x7a, 0x06, 0x6c, 0x11, 0xc7, 0x18, 0x4e, 0xc2, 0x83, 0x8c, 0xdc, 0xfe, 0x8d, 0x75, 0x8f,
-	0x9d, 0xf0, 0x6c, 0x85, 0x10, 0x6c, 0x7a, 0x9a, 0xe1, 0x1e, 0x6d, 0x9e, 0x34, 0x7a, 0xcd, 0x11,
-	0xfd, 0x2f, 0x1f, 0xc0, 0x3e, 0x67, 0xc6, 0x06, 0x31, 0x85, 0xfd, 0xb0, 0x11, 0xb2, 0x08, 0x6f,
-	0x95, 0x97, 0xb0, 0x03, 0x75, 0x9b, 0x7d, 0x67, 0xea, 0x36, 0x57, 0x50, 0x43, 0x5c, 0xd0, 0x26,
-	0x5f, 0x90, 0xdc, 0x03, 0xc4, 0xfb, 0xb0, 0x69, 0xc5, 0x65, 0xd6, 0xd3, 0x32, 0xcf, 0xff, 0xdd,
-	0x83, 0xe6, 0x55, 0xa0, 0x62, 0x27, 0x30, 0x75, 0x8c, 0x5e, 0x41, 0xe3, 0x12, 0x7b, 0xe8, 0x7f,
-	0x05, 0x8a, 0x49, 0x69, 0x52, 0xfa, 0xbf, 0xf8, 0x26, 0xeb, 0xb0, 0x16, 0x6a, 0x5c, 0xfb, 0x22,
-	0x8d, 0x94, 0x1b, 0x05, 0x1a, 0x3c, 0x1b, 0x52, 0x8d, 0x01, 0xb6, 0x04, 0x1a, 0x29, 0x33, 0x0a,
-	0x34, 0x78, 0x60, 0xac, 0xa1, 0xb7, 0xb0, 0x45, 0xd1, 0x0f, 0x3d, 0x16, 0x15, 0x9c, 0x40, 0x97,
-	0x74, 0xbc, 0xec, 0x36, 0xaf, 0x44, 0xb9, 0x4d, 0xa0, 0xc4, 0x03, 0xa2, 0x40, 0x29, 0x8b, 0x7b,
-	0x54, 0x89, 0x82, 0x9b, 0x40, 0x89, 0x07, 0x41, 0x81, 0x52, 0x96, 0xf7, 0x6a, 0xe8, 0x4f, 0xd8,
-	0xe1, 0x41, 0x0e, 0x3d, 0x2b, 0x64, 0x08, 0x80, 0x50, 0x7a, 0x5e, 0x11, 0x95, 0xc8, 0xff, 0x01,
-	0x2d, 0x0e, 0xd7, 0xd0, 0xd3, 0x42, 0x5e, 0x91, 0x0b, 0xa5, 0x67, 0xe5, 0x41, 0x89, 0xf6, 0x15,
-	0x6c, 0x47, 0x60, 0x82, 0x8a, 0x6d, 0x66, 0xc8, 0x47, 0x7a, 0xb2, 0xf4, 0x3e, 0x2f, 0x16, 0x91,
-	0x95, 0x40, 0x2c, 0x43, 0x7a, 0x02, 0xb1, 0x2c, 0x92, 0xc9, 0x35, 0xf4, 0x0b, 0x3c, 0x88, 0xa1,
-	0x09, 0x9d, 0x14, 0x49, 0x3e, 0xcb, 0x65, 0xd2, 0x27, 0x25, 0x11, 0x89, 0xe4, 0xef, 0x00, 0x29,
-	0x11, 0x21, 0xb9, 0x90, 0x52, 0x00, 0x2f, 0xe9, 0x69, 0x69, 0x4c, 0x22, 0xfc, 0x17, 0xb4, 0x33,
-	0xbc, 0x83, 0x9e, 0x2f, 0x19, 0x7f, 0x16, 0x7c, 0xa4, 0xd3, 0xaa, 0xb0, 0xec, 0x1e, 0x48, 0x68,
-	0x47, 0xb8, 0x07, 0xf2, 0x58, 0x25, 0xdc, 0x03, 0x05, 0x60, 0x8a, 0xaa, 0xcf, 0xc0, 0x8d, 0xa0,
-	0x7a, 0x11, 0x3c, 0x09, 0xaa, 0x17, 0x32, 0x52, 0xe4, 0x90, 0xe1, 0x19, 0x81, 0x83, 0x88, 0x97,
-	0x04, 0x0e, 0x42, 0x2c, 0x8a, 0x1c, 0xd4, 0x0a, 0x07, 0xf5, 0x7e, 0x0e, 0xea, 0x72, 0x87, 0xcc,
-	0x77, 0xaf, 0xa4, 0x07, 0xfe, 0xbb, 0x5a, 0xd2, 0x43, 0xe6, 0xf3, 0x99, 0x3c, 0x87, 0xf4, 0x93,
-	0x2d, 0x7e, 0x0e, 0x05, 0x50, 0x10, 0x3f, 0x07, 0xc1, 0x97, 0xbf, 0x86, 0x74, 0x78, 0x98, 0x25,
-	0x21, 0x74, 0x2a, 0x38, 0xdc, 0x04, 0xac, 0x25, 0xbd, 0xa8, 0x8c, 0xcb, 0x0d, 0xaa, 0xb4, 0x0d,
-	0x11, 0x5e, 0x89, 0x07, 0xb5, 0xcc, 0x41, 0xad, 0x70, 0x50, 0xef, 0xe7, 0xa0, 0x2e, 0x71, 0x18,
-	0x43, 0x33, 0x01, 0x06, 0x54, 0x3c, 0x5b, 0xf2, 0xe4, 0x22, 0xc9, 0x65, 0x21, 0xfc, 0xf9, 0x93,
-	0x92, 0x80, 0xe0, 0xfc, 0x29, 0xe0, 0x88, 0xe0, 0xfc, 0x29, 0xa2, 0x84, 0x5c, 0xbb, 0xdd, 0xa6,
-	0x8c, 0xf5, 0xc5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xea, 0xe1, 0xea, 0x24, 0x13, 0x00,
+	// 1297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x6d, 0x53, 0xdb, 0x46,
+	0x17, 0x7d, 0x6c, 0x03, 0x4f, 0x7c, 0x8d, 0x03, 0x08, 0x9b, 0x78, 0xb6, 0x09, 0x71, 0x05, 0x31,
+	0xb4, 0x25, 0xf6, 0x0c, 0x9d, 0xbe, 0x4c, 0x3f, 0x34, 0xd3, 0x40, 0x42, 0x3c, 0x64, 0x5a, 0xd7,
+	0x76, 0xdb, 0x99, 0xcc, 0x74, 0xa8, 0x90, 0x16, 0x45, 0x95, 0xad, 0x75, 0xf5, 0x16, 0xf8, 0x91,
+	0xfd, 0x4f, 0x9d, 0x95, 0x56, 0xd2, 0x4a, 0x5a, 0x4b, 0x10, 0xf2, 0x29, 0xde, 0xdd, 0x73, 0xcf,
+	0xb9, 0x77, 0xf7, 0xea, 0xe6, 0x00, 0x4f, 0x1d, 0x6c, 0xfb, 0xd8, 0x1e, 0x98, 0xfe, 0x73, 0xc7,
+	0xf6, 0x07, 0x0b, 0x9b, 0xb8, 0x64, 0x60, 0xfa, 0x03, 0xd3, 0xef, 0x07, 0xbf, 0xa5, 0x0d, 0x9d,
+	0xf4, 0xe7, 0x86, 0x6a, 0x93, 0xbe, 0x63, 0xfb, 0x7d, 0xd3, 0x47, 0xdf, 0xe8, 0x86, 0xfb, 0xde,
+	0xbb, 0xec, 0xab, 0x64, 0x3e, 0xd0, 0xc9, 0x4c, 0xb1, 0xf4, 0x30, 0xea, 0xd2, 0xbb, 0x1a, 0x2c,
+	0xdc, 0x9b, 0x05, 0x76, 0x06, 0x8e, 0x6b, 0x7b, 0xaa, 0xcb, 0xfe, 0x09, 0x79, 0xe4, 0x9f, 0x61,
+	0x65, 0xe8, 0xe2, 0xb9, 0xb4, 0x09, 0x35, 0x13, 0xdf, 0x74, 0x2a, 0xdd, 0xca, 0x61, 0x7d, 0x4c,
+	0x7f, 0x4a, 0x2d, 0x58, 0xf5, 0x95, 0x99, 0x87, 0x3b, 0xd5, 0x6e, 0xe5, 0x70, 0x7d, 0x1c, 0x2e,
+	0xa4, 0x5d, 0x00, 0x7c, 0xbd, 0x30, 0x6c, 0xc5, 0x35, 0x88, 0xd5, 0xa9, 0x75, 0x2b, 0x87, 0xb5,
+	0x31, 0xb7, 0x23, 0xef, 0x02, 0x9c, 0x61, 0x77, 0x8c, 0xff, 0xf1, 0xb0, 0xe3, 0xe6, 0x59, 0xe5,
+	0xef, 0xa1, 0x11, 0x9c, 0x3b, 0x0b, 0x62, 0x39, 0x58, 0xfa, 0x02, 0x56, 0x0c, 0x17, 0xcf, 0x03,
+	0x44, 0xe3, 0xb8, 0xdd, 0xcf, 0x54, 0xd5, 0xa7, 0xb9, 0x8d, 0x03, 0x88, 0xfc, 0x1d, 0xc0, 0xc8,
+	0x8b, 0x99, 0xef, 0x10, 0xd8, 0x84, 0xc6, 0xc8, 0x8b, 0x25, 0x69, 0x86, 0xa7, 0x78, 0xb6, 0x3c,
+	0xc3, 0x26, 0x34, 0x82, 0x73, 0x06, 0xff, 0x16, 0xd6, 0xcf, 0xb0, 0xfb, 0xea, 0x3a, 0x0a, 0x68,
+	0xc1, 0xaa, 0x61, 0x69, 0xf8, 0x3a, 0x08, 0xa9, 0x8d, 0xc3, 0x45, 0x44, 0x53, 0x4d, 0x68, 0x7e,
+	0x80, 0x26, 0x8b, 0xbb, 0x7b, 0xa9, 0xbf, 0xc0, 0xfa, 0xc8, 0x2b, 0xd5, 0x8c, 0x08, 0xab, 0xe5,
+	0x84, 0x1b, 0xd0, 0x1c, 0x79, 0x5c, 0x32, 0xb4, 0xaa, 0x53, 0x3c, 0xbb, 0x7b, 0x55, 0x1b, 0xd0,
+	0x64, 0x71, 0x8c, 0xe8, 0x00, 0xb6, 0x4f, 0x88, 0x75, 0x65, 0xd8, 0xf3, 0x29, 0x31, 0xb1, 0xb5,
+	0xfc, 0x5a, 0x8f, 0xa0, 0x95, 0x06, 0xb2, 0x6b, 0x89, 0xdb, 0x2c, 0xc4, 0x86, 0x0b, 0x79, 0x08,
+	0xd2, 0x18, 0xcf, 0x89, 0x8f, 0x53, 0xac, 0x4f, 0x00, 0x14, 0x55, 0x25, 0x9e, 0xe5, 0x5e, 0x18,
+	0x1a, 0x0b, 0xa8, 0xb3, 0x9d, 0xa1, 0x46, 0xa9, 0x5c, 0x0a, 0x67, 0x09, 0x87, 0x0b, 0xb9, 0x0d,
+	0xdb, 0x29, 0x2a, 0x96, 0xf8, 0x29, 0x34, 0xdf, 0x12, 0xd5, 0xc4, 0x5a, 0xf1, 0x15, 0xa4, 0x25,
+	0xab, 0x19, 0x49, 0xf9, 0x10, 0x1e, 0x46, 0x2c, 0xac, 0x9e, 0x1d, 0x58, 0x9b, 0x05, 0x3b, 0x01,
+	0xcf, 0x83, 0x31, 0x5b, 0xc9, 0x1e, 0x34, 0x7f, 0xb3, 0x28, 0x36, 0xd2, 0xeb, 0xc1, 0x86, 0xe2,
+	0xb9, 0xef, 0x2f, 0xae, 0x14, 0x63, 0x76, 0xc1, 0x2b, 0x37, 0xe9, 0xf6, 0x6b, 0xc5, 0x98, 0x0d,
+	0xa3, 0x0c, 0x28, 0x05, 0x83, 0x54, 0x03, 0x48, 0x9d, 0xee, 0x0c, 0x05, 0x09, 0xd6, 0x04, 0x09,
+	0x46, 0xb2, 0x25, 0x09, 0xbe, 0x86, 0x8d, 0xa1, 0xf3, 0x09, 0xae, 0xe4, 0x4b, 0xd8, 0x4c, 0x78,
+	0x4a, 0x34, 0xdf, 0xc0, 0xd6, 0x4f, 0xac, 0xd8, 0x7b, 0xaa, 0x1e, 0x81, 0xc4, 0x33, 0x25, 0xba,
+	0x57, 0xc1, 0x0e, 0xe3, 0x62, 0x2b, 0xf9, 0x1c, 0x5a, 0x61, 0x4f, 0x4c, 0xb0, 0xe3, 0x18, 0xc4,
+	0x2a, 0x95, 0x76, 0x42, 0x1c, 0x27, 0xcd, 0x76, 0x86, 0x9a, 0xfc, 0x08, 0xda, 0x19, 0x32, 0xd6,
+	0x62, 0x41, 0x13, 0x2b, 0xda, 0xa7, 0xd0, 0xf8, 0x0a, 0xb6, 0x53, 0x54, 0x85, 0x1f, 0xcf, 0x3b,
+	0xaa, 0xeb, 0x13, 0x33, 0xfd, 0xf1, 0x88, 0x75, 0x1f, 0x42, 0x35, 0xd6, 0xab, 0x1a, 0x5a, 0xe9,
+	0x7c, 0x1f, 0xc0, 0x76, 0x8a, 0x9b, 0x25, 0xd2, 0x81, 0xff, 0xdb, 0xc1, 0x76, 0xf4, 0xc2, 0xd1,
+	0x52, 0x7e, 0x01, 0xed, 0xa1, 0xc3, 0xc0, 0xc1, 0x4e, 0xe9, 0xc8, 0x31, 0x34, 0xa7, 0x53, 0xed,
+	0xd6, 0xe8, 0xe0, 0x30, 0x34, 0x47, 0x3e, 0x86, 0x9d, 0x2c, 0x41, 0xa9, 0xe8, 0x14, 0x36, 0xc7,
+	0x8a, 0x8b, 0xdf, 0x1a, 0x73, 0xc3, 0x2d, 0xd6, 0x93, 0x60, 0xc5, 0xc4, 0x37, 0x91, 0x60, 0xf0,
+	0x9b, 0x76, 0xcd, 0x07, 0xc3, 0xd2, 0xc8, 0x07, 0x56, 0x3f, 0x5b, 0xc9, 0x27, 0xb0, 0xc5, 0xb1,
+	0x26, 0x2d, 0x16, 0xf4, 0xa0, 0xd3, 0xa9, 0x74, 0x6b, 0x14, 0x1c, 0xae, 0xe8, 0xbe, 0x8d, 0x1d,
+	0xec, 0x86, 0xd4, 0xb5, 0x31, 0x5b, 0xc9, 0x3f, 0x42, 0x6b, 0x68, 0xa9, 0x53, 0x5b, 0x51, 0xcd,
+	0x13, 0x8a, 0xbc, 0xeb, 0x04, 0x7e, 0x0e, 0xed, 0x4c, 0x7c, 0xd2, 0x0b, 0x81, 0x74, 0x44, 0x10,
+	0x2c, 0xa8, 0xdc, 0x19, 0x76, 0xef, 0x25, 0x97, 0x89, 0x2f, 0x94, 0x9b, 0x42, 0x6b, 0x72, 0x0f,
+	0xb9, 0x84, 0xb5, 0xc6, 0xb3, 0x3e, 0x82, 0xf6, 0x44, 0x94, 0x04, 0xbb, 0xcc, 0x97, 0x8a, 0xa6,
+	0xe3, 0x7b, 0x5c, 0x26, 0x1f, 0x5f, 0x58, 0xdd, 0x0b, 0x68, 0x9f, 0x62, 0xd5, 0xfe, 0x78, 0xbd,
+	0x3e, 0xec, 0x64, 0x09, 0x6e, 0xf1, 0x7a, 0xf7, 0xaa, 0x2f, 0x13, 0x7f, 0x8b, 0xd7, 0xfb, 0x68,
+	0xb9, 0xc2, 0xd7, 0xcb, 0x27, 0xc1, 0xf7, 0xe6, 0xef, 0x74, 0x70, 0xdd, 0xb5, 0xba, 0x57, 0xd0,
+	0xce, 0xc4, 0xb3, 0xea, 0x8e, 0xf8, 0xb1, 0xd8, 0x38, 0xde, 0xe9, 0xeb, 0x84, 0xe8, 0x33, 0xdc,
+	0x8f, 0x0c, 0x71, 0x3f, 0x84, 0xb3, 0x71, 0x69, 0xc2, 0xe6, 0x54, 0xd1, 0x9d, 0x93, 0x19, 0xf1,
+	0x4a, 0x86, 0x53, 0x1b, 0xd6, 0x88, 0xad, 0x27, 0x03, 0x7a, 0x95, 0xd8, 0xfa, 0x50, 0xa3, 0x9f,
+	0x3a, 0xb9, 0xfc, 0x1b, 0xab, 0x2e, 0xfb, 0xef, 0x97, 0xad, 0xe8, 0x6c, 0x71, 0x15, 0xdd, 0xe9,
+	0xac, 0x84, 0xb3, 0x85, 0xfe, 0x96, 0xb7, 0x61, 0x8b, 0x13, 0x63, 0x17, 0x71, 0x05, 0x5b, 0xb4,
+	0x10, 0xb2, 0xa0, 0x47, 0xc5, 0x29, 0xac, 0x43, 0xc5, 0x62, 0x26, 0xa0, 0x62, 0x71, 0x09, 0xd5,
+	0xc4, 0x09, 0xad, 0xf0, 0x09, 0xc9, 0x87, 0x20, 0xf1, 0x3a, 0xec, 0xb6, 0xa2, 0x34, 0x2b, 0x49,
+	0x9a, 0xc7, 0xff, 0x4a, 0x50, 0x3f, 0xf7, 0x27, 0xd8, 0xf6, 0x0d, 0x15, 0x4b, 0x2f, 0xa1, 0x76,
+	0x86, 0x5d, 0xe9, 0xb3, 0x9c, 0xc5, 0x4c, 0xac, 0x3e, 0x7a, 0x2c, 0x3e, 0x64, 0x15, 0xfe, 0x8f,
+	0x72, 0x8c, 0x3c, 0x11, 0xc7, 0xc8, 0x2b, 0xe0, 0x18, 0x79, 0x19, 0x8e, 0x53, 0x3c, 0x13, 0x70,
+	0x24, 0x86, 0x1e, 0x3d, 0x16, 0x1f, 0xc6, 0x1c, 0x6f, 0x60, 0x35, 0xf0, 0xe5, 0xd2, 0x13, 0x51,
+	0xc2, 0xb1, 0x23, 0x46, 0xbb, 0xcb, 0x8e, 0x79, 0xa6, 0x91, 0x27, 0x66, 0x1a, 0x79, 0x85, 0x4c,
+	0x23, 0x2f, 0xc7, 0x14, 0xb8, 0x6a, 0x01, 0x13, 0xef, 0xd2, 0xd1, 0xee, 0xb2, 0xe3, 0x98, 0xe9,
+	0x4f, 0x58, 0xe7, 0x5d, 0xb6, 0xb4, 0x9f, 0x8b, 0x10, 0xb8, 0x75, 0xf4, 0xac, 0x04, 0x15, 0xd3,
+	0xbf, 0x83, 0x06, 0xe7, 0xa5, 0xa5, 0xbd, 0x5c, 0x5c, 0xde, 0xb4, 0xa3, 0xfd, 0x62, 0x50, 0xcc,
+	0x7d, 0x0e, 0x6b, 0xa1, 0x6b, 0x94, 0xf2, 0x65, 0xa6, 0x6c, 0x29, 0x7a, 0xba, 0xf4, 0x9c, 0x27,
+	0x0b, 0x6d, 0xaf, 0x80, 0x2c, 0x65, 0xc3, 0xd1, 0xd3, 0xa5, 0xe7, 0x31, 0xd9, 0xaf, 0xf0, 0x20,
+	0x72, 0xb4, 0x52, 0x37, 0xff, 0x67, 0x56, 0xda, 0x34, 0xa3, 0xcf, 0x0b, 0x10, 0x31, 0xe5, 0x1f,
+	0x00, 0x89, 0x5d, 0x95, 0xe4, 0x5c, 0x48, 0xce, 0x15, 0xa3, 0xbd, 0x42, 0x4c, 0x4c, 0xfc, 0x17,
+	0x34, 0x53, 0x66, 0x54, 0x7a, 0xb6, 0xe4, 0xfa, 0xd3, 0xae, 0x14, 0xf5, 0xca, 0x60, 0xe9, 0x1e,
+	0x88, 0xad, 0xa8, 0xb0, 0x07, 0xb2, 0x9e, 0x17, 0xed, 0x17, 0x83, 0xd2, 0xdc, 0xb1, 0xbb, 0x14,
+	0x72, 0x67, 0x7d, 0x2d, 0xda, 0x2f, 0x06, 0xc5, 0xdc, 0x2a, 0x3c, 0x4c, 0xfb, 0x48, 0xa9, 0x27,
+	0x78, 0x29, 0x81, 0x53, 0x45, 0x07, 0xa5, 0xb8, 0x58, 0x64, 0x0a, 0xf5, 0xd8, 0x22, 0x4a, 0xf9,
+	0x4e, 0xc8, 0x9a, 0x52, 0x24, 0x17, 0x41, 0xf8, 0x47, 0x4d, 0x79, 0x3e, 0xc1, 0xa3, 0x8a, 0x3c,
+	0x25, 0xea, 0x95, 0xc1, 0x78, 0x85, 0x94, 0xcd, 0x13, 0x28, 0x88, 0x6c, 0x24, 0xea, 0x95, 0xc1,
+	0x78, 0x85, 0x49, 0x89, 0xc2, 0xe4, 0x76, 0x0a, 0x93, 0xe5, 0x0a, 0x29, 0x3b, 0x50, 0x50, 0x03,
+	0x6f, 0x37, 0x50, 0xaf, 0x0c, 0x96, 0x79, 0x87, 0xc4, 0xc9, 0x88, 0xdf, 0x21, 0xe7, 0x9f, 0x50,
+	0xaf, 0x0c, 0xc6, 0x37, 0x69, 0xda, 0x20, 0x0a, 0x9a, 0x54, 0x68, 0x41, 0xd1, 0x41, 0x29, 0x2e,
+	0x73, 0x51, 0x85, 0x65, 0x88, 0x5c, 0x27, 0xea, 0x95, 0xc1, 0x32, 0x8f, 0x5d, 0xa8, 0x30, 0xb9,
+	0x9d, 0xc2, 0x64, 0x89, 0xc2, 0x14, 0xea, 0xb1, 0x8f, 0x12, 0x7c, 0x68, 0x59, 0x43, 0x87, 0xe4,
+	0x22, 0x08, 0x3f, 0x96, 0x13, 0x83, 0x24, 0x18, 0xcb, 0x39, 0x97, 0x86, 0xf6, 0x0a, 0x31, 0x11,
+	0xf1, 0xe5, 0x5a, 0x60, 0x3d, 0xbf, 0xfe, 0x6f, 0x00, 0x65, 0xce, 0xd7, 0x02, 0xd8, 0x15, 0x00,
 	0x00,
 }
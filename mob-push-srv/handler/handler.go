@@ -93,7 +93,10 @@ func (p *MobpushService) Push(ctx context.Context, req *mobpush_proto.PushReques
 	}
 	req_push, err := http.NewRequest("POST", p.PushUrl, bytes.NewBuffer(jsonStr))
 	req_push.Header.Set("Content-Type", restful.MIME_JSON)
-	common.SetTestHeader(req_push.Header)
+	// the push of a test request stays in its namespace
+	if namespace := common.TestNamespaceFromContext(ctx); len(namespace) > 0 {
+		req_push.Header.Set(common.TestNamespaceKey, namespace)
+	}
 
 	client := &http.Client{}
 	rsp_push, err := client.Do(req_push)
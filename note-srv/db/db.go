@@ -19,6 +19,7 @@ import (
 
 type clientWrapper struct {
 	Db_client db_proto.DBClient
+	PII       *common.PII
 }
 
 var (
@@ -28,10 +29,12 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	pii := common.NewPII(serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, pii))
 
 	return &clientWrapper{
 		Db_client: cl,
+		PII:       pii,
 	}
 }
 
@@ -67,7 +70,7 @@ func runQuery(ctx context.Context, q string, table string) (*db_proto.RunQueryRe
 	})
 }
 
-func noteToRecord(note *note_proto.Note) (string, error) {
+func noteToRecord(ctx context.Context, note *note_proto.Note) (string, error) {
 	data, err := common.MarhalToObject(note)
 	if err != nil {
 		return "", err
@@ -79,13 +82,18 @@ func noteToRecord(note *note_proto.Note) (string, error) {
 	if note.Creator != nil {
 		creatorId = note.Creator.Id
 	}
+	if err := ClientWrapper.PII.EncryptFields(ctx, note.OrgId, common.DbNoteTable, data); err != nil {
+		return "", err
+	}
+	// the name is the blind index of the encrypted title
+	name, _ := data["title_bidx"].(string)
 
 	d := map[string]interface{}{
 		"_key":       note.Id,
 		"id":         note.Id,
 		"created":    note.Created,
 		"updated":    note.Updated,
-		"name":       note.Title,
+		"name":       name,
 		"parameter1": note.OrgId,
 		"parameter2": creatorId,
 		"data":       data,
@@ -152,7 +160,7 @@ func Create(ctx context.Context, note *note_proto.Note) error {
 	}
 	note.Updated = time.Now().Unix()
 
-	record, err := noteToRecord(note)
+	record, err := noteToRecord(ctx, note)
 	if err != nil {
 		return err
 	}
@@ -205,8 +213,13 @@ func Delete(ctx context.Context, id, orgId, teamId string) error {
 func Search(ctx context.Context, name, orgId, teamId string, limit, offset, from, to int64, sortParameter, sortDirection string) ([]*note_proto.Note, error) {
 	var notes []*note_proto.Note
 	query := `FILTER`
+	// the titles are encrypted, they are matched by their blind indexes
 	if len(name) > 0 {
-		query += fmt.Sprintf(` doc.name == "%v"`, name)
+		bidx, err := ClientWrapper.PII.BlindIndex(ctx, orgId, name)
+		if err != nil {
+			return nil, err
+		}
+		query += fmt.Sprintf(` doc.name == "%v"`, bidx)
 	}
 	query = common.QueryAuth(query, orgId, teamId)
 	limit_query := common.QueryPaginate(offset, limit)
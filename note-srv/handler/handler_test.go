@@ -3,15 +3,12 @@ package handler
 import (
 	"context"
 	"server/common"
+	"server/common/dbtest"
 	"server/note-srv/db"
 	note_proto "server/note-srv/proto/note"
 	user_proto "server/user-srv/proto/user"
 	"testing"
 	"time"
-
-	"github.com/micro/go-micro/client"
-	nats_broker "github.com/micro/go-plugins/broker/nats"
-	nats_transport "github.com/micro/go-plugins/transport/nats"
 )
 
 var note = &note_proto.Note{
@@ -25,15 +22,7 @@ var note = &note_proto.Note{
 }
 
 func initDb() {
-	cl := client.NewClient(
-		client.Transport(nats_transport.NewTransport()),
-		client.Broker(nats_broker.NewBroker()),
-		client.RequestTimeout(4*time.Second),
-		client.Retries(5),
-	)
-	// ctx := common.NewTestContext(context.TODO())
-	// db.RemoveDb(ctx, cl)
-	db.Init(cl)
+	db.Init(dbtest.NewClient())
 }
 
 func createNote(ctx context.Context, hdlr *NoteService, t *testing.T) *note_proto.Note {
@@ -49,7 +38,7 @@ func createNote(ctx context.Context, hdlr *NoteService, t *testing.T) *note_prot
 func TestAll(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		return
@@ -75,7 +64,7 @@ func TestAll(t *testing.T) {
 func TestNoteIsCreated(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		t.Error("Create is failed")
@@ -86,7 +75,7 @@ func TestNoteIsCreated(t *testing.T) {
 func TestNoteRead(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		return
@@ -111,7 +100,7 @@ func TestNoteRead(t *testing.T) {
 func TestNoteDelete(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		return
@@ -128,7 +117,7 @@ func TestNoteDelete(t *testing.T) {
 func TestByCreator(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		return
@@ -153,7 +142,7 @@ func TestByCreator(t *testing.T) {
 func TestByUser(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		return
@@ -179,7 +168,7 @@ func TestByUser(t *testing.T) {
 func TestFilter(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		return
@@ -208,7 +197,7 @@ func TestFilter(t *testing.T) {
 func TestSearch(t *testing.T) {
 	initDb()
 	hdlr := new(NoteService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	note := createNote(ctx, hdlr, t)
 	if note == nil {
 		return
@@ -29,7 +29,7 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 
 	return &clientWrapper{
 		Db_client: cl,
@@ -87,11 +87,13 @@ func queryMerge() string {
 				path: m.data.path
 			}
 		)
-		RETURN MERGE_RECURSIVE(doc,{data:{owner:owner[0],parent:parent[0], child:child,modules:modules}})`,
+		LET setting = (FOR s IN %v FILTER s.data.org_id == doc._key SORT s.updated DESC LIMIT 1 RETURN s.data)
+		RETURN MERGE_RECURSIVE(doc,{data:{owner:owner[0],parent:parent[0], child:child,modules:modules,setting:setting[0]}})`,
 		common.DbUserTable,
 		common.DbOrganisationTable,
 		common.DbOrganisationTable,
 		common.DbOrgModuleEdgeTable,
+		common.DbOrgSettingTable,
 	)
 	return query
 }
@@ -148,12 +148,17 @@ func (p *OrganisationService) Create(ctx context.Context, req *organisation_prot
 
 func (p *OrganisationService) readAndPutOrg(ctx context.Context, orgId string) {
 	log.Info("Received Organisation.readAndPutOrg request for Organisation: ", orgId)
-	rsp_org, _ := db.Read(ctx, orgId)
+	rsp_org, err := db.Read(ctx, orgId)
+	if err != nil || rsp_org == nil {
+		common.ErrorLog(common.OrganisationSrv, common.GetFunctionName(p.readAndPutOrg), err, "Organisation query is failed")
+		return
+	}
 	oi := &organisation_proto.OrgInfo{
 		OrgId:   rsp_org.Id,
 		Type:    rsp_org.Type,
 		Owner:   rsp_org.Owner,
 		Modules: rsp_org.Modules,
+		Setting: rsp_org.Setting,
 	}
 	req_orginfo := &organisation_proto.PutOrgInfoRequest{OrgId: orgId, OrgInfo: oi}
 	rsp_orginfo := &organisation_proto.PutOrgInfoResponse{}
@@ -201,6 +206,8 @@ func (p *OrganisationService) CreateOrganisationSetting(ctx context.Context, req
 	if err != nil {
 		return common.InternalServerError(common.OrganisationSrv, p.CreateOrganisationSetting, err, "create organization setting error")
 	}
+	// the api reads the settings from the organisation info
+	p.readAndPutOrg(ctx, req.Setting.OrgId)
 	rsp.Data = &organisation_proto.CreateOrganisationSettingResponse_Data{req.Setting}
 	return nil
 }
@@ -271,6 +278,7 @@ func (p *OrganisationService) WarmupCacheOrganisation(ctx context.Context, req *
 				Type:    org.Type,
 				Owner:   org.Owner,
 				Modules: org.Modules,
+				Setting: org.Setting,
 			}
 			req_put := &organisation_proto.PutOrgInfoRequest{OrgId: org.Id, OrgInfo: oi}
 			rsp_put := &organisation_proto.PutOrgInfoResponse{}
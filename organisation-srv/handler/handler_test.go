@@ -216,6 +216,50 @@ func TestCreateOrganisationSetting(t *testing.T) {
 	}
 }
 
+func TestReadOrganisationSetting(t *testing.T) {
+	initDb()
+	hdlr := initHandler()
+	ctx := common.NewTestContext(context.TODO())
+
+	// is created organisation
+	org := createOrganisation(ctx, hdlr, t)
+	if org == nil {
+		return
+	}
+
+	req := &organisation_proto.CreateOrganisationSettingRequest{
+		Setting: &organisation_proto.OrganisationSetting{
+			OrgId:     org.Id,
+			RateLimit: &organisation_proto.RateLimit{OrgRead: 1000, UserWrite: 10},
+		},
+	}
+	if err := hdlr.CreateOrganisationSetting(ctx, req, &organisation_proto.CreateOrganisationSettingResponse{}); err != nil {
+		t.Error(err)
+		return
+	}
+
+	rsp_read := &organisation_proto.ReadResponse{}
+	if err := hdlr.Read(ctx, &organisation_proto.ReadRequest{OrgId: org.Id}, rsp_read); err != nil {
+		t.Error(err)
+		return
+	}
+	setting := rsp_read.Data.Organisation.Setting
+	if setting == nil || setting.RateLimit == nil || setting.RateLimit.OrgRead != 1000 || setting.RateLimit.UserWrite != 10 {
+		t.Error("Setting does not match: ", setting)
+		return
+	}
+
+	// the api reads the rate limits from the organisation info
+	rsp_info := &organisation_proto.ReadOrgInfoResponse{}
+	if err := hdlr.ReadOrgInfo(ctx, &organisation_proto.ReadOrgInfoRequest{org.Id}, rsp_info); err != nil {
+		t.Error(err)
+		return
+	}
+	if rsp_info.OrgInfo.Setting == nil || rsp_info.OrgInfo.Setting.RateLimit.OrgRead != 1000 {
+		t.Error("Organisation info setting does not match: ", rsp_info.OrgInfo.Setting)
+	}
+}
+
 func TestUpdateModulesByOrg(t *testing.T) {
 	initDb()
 	hdlr := initHandler()
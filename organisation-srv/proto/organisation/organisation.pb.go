@@ -30,6 +30,7 @@ It has these top-level messages:
 	Organisation
 	OrganisationProfile
 	OrganisationSetting
+	RateLimit
 	OrganisationModule
 	OrgInfo
 	UpdateModulesRequest
@@ -673,6 +674,7 @@ type Organisation struct {
 	Domain       string                        `protobuf:"bytes,11,opt,name=domain" json:"domain,omitempty"`
 	Email        string                        `protobuf:"bytes,12,opt,name=email" json:"email,omitempty"`
 	FriendlyName string                        `protobuf:"bytes,13,opt,name=friendly_name,json=friendlyName" json:"friendly_name,omitempty"`
+	Setting      *OrganisationSetting          `protobuf:"bytes,14,opt,name=setting" json:"setting,omitempty"`
 }
 
 func (m *Organisation) Reset()                    { *m = Organisation{} }
@@ -771,6 +773,13 @@ func (m *Organisation) GetFriendlyName() string {
 	return ""
 }
 
+func (m *Organisation) GetSetting() *OrganisationSetting {
+	if m != nil {
+		return m.Setting
+	}
+	return nil
+}
+
 // to be update later
 type OrganisationProfile struct {
 	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -818,6 +827,9 @@ type OrganisationSetting struct {
 	OrgId   string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	Created int64  `protobuf:"varint,3,opt,name=created" json:"created,omitempty"`
 	Updated int64  `protobuf:"varint,4,opt,name=updated" json:"updated,omitempty"`
+	// days the deleted records stay in the trash before they are purged, the db-srv default if zero
+	TrashRetentionDays int64      `protobuf:"varint,5,opt,name=trash_retention_days,json=trashRetentionDays" json:"trash_retention_days,omitempty"`
+	RateLimit          *RateLimit `protobuf:"bytes,6,opt,name=rate_limit,json=rateLimit" json:"rate_limit,omitempty"`
 }
 
 func (m *OrganisationSetting) Reset()                    { *m = OrganisationSetting{} }
@@ -853,6 +865,63 @@ func (m *OrganisationSetting) GetUpdated() int64 {
 	return 0
 }
 
+func (m *OrganisationSetting) GetTrashRetentionDays() int64 {
+	if m != nil {
+		return m.TrashRetentionDays
+	}
+	return 0
+}
+
+func (m *OrganisationSetting) GetRateLimit() *RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+// requests per minute allowed in the api, the api defaults if zero
+type RateLimit struct {
+	// requests of the whole organisation
+	OrgRead  int64 `protobuf:"varint,1,opt,name=org_read,json=orgRead" json:"org_read,omitempty"`
+	OrgWrite int64 `protobuf:"varint,2,opt,name=org_write,json=orgWrite" json:"org_write,omitempty"`
+	// requests of each user of the organisation
+	UserRead  int64 `protobuf:"varint,3,opt,name=user_read,json=userRead" json:"user_read,omitempty"`
+	UserWrite int64 `protobuf:"varint,4,opt,name=user_write,json=userWrite" json:"user_write,omitempty"`
+}
+
+func (m *RateLimit) Reset()                    { *m = RateLimit{} }
+func (m *RateLimit) String() string            { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()               {}
+func (*RateLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *RateLimit) GetOrgRead() int64 {
+	if m != nil {
+		return m.OrgRead
+	}
+	return 0
+}
+
+func (m *RateLimit) GetOrgWrite() int64 {
+	if m != nil {
+		return m.OrgWrite
+	}
+	return 0
+}
+
+func (m *RateLimit) GetUserRead() int64 {
+	if m != nil {
+		return m.UserRead
+	}
+	return 0
+}
+
+func (m *RateLimit) GetUserWrite() int64 {
+	if m != nil {
+		return m.UserWrite
+	}
+	return 0
+}
+
 type OrganisationModule struct {
 	Id          string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId       string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
@@ -862,7 +931,7 @@ type OrganisationModule struct {
 func (m *OrganisationModule) Reset()                    { *m = OrganisationModule{} }
 func (m *OrganisationModule) String() string            { return proto.CompactTextString(m) }
 func (*OrganisationModule) ProtoMessage()               {}
-func (*OrganisationModule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*OrganisationModule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *OrganisationModule) GetId() string {
 	if m != nil {
@@ -890,12 +959,13 @@ type OrgInfo struct {
 	Type    OrganisationType              `protobuf:"varint,2,opt,name=type,enum=go.micro.srv.organisation.OrganisationType" json:"type,omitempty"`
 	Owner   *go_micro_srv_user.User       `protobuf:"bytes,7,opt,name=owner" json:"owner,omitempty"`
 	Modules []*go_micro_srv_static.Module `protobuf:"bytes,5,rep,name=modules" json:"modules,omitempty"`
+	Setting *OrganisationSetting          `protobuf:"bytes,8,opt,name=setting" json:"setting,omitempty"`
 }
 
 func (m *OrgInfo) Reset()                    { *m = OrgInfo{} }
 func (m *OrgInfo) String() string            { return proto.CompactTextString(m) }
 func (*OrgInfo) ProtoMessage()               {}
-func (*OrgInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*OrgInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *OrgInfo) GetOrgId() string {
 	if m != nil {
@@ -925,6 +995,13 @@ func (m *OrgInfo) GetModules() []*go_micro_srv_static.Module {
 	return nil
 }
 
+func (m *OrgInfo) GetSetting() *OrganisationSetting {
+	if m != nil {
+		return m.Setting
+	}
+	return nil
+}
+
 type UpdateModulesRequest struct {
 	Modules []*go_micro_srv_static.Module `protobuf:"bytes,1,rep,name=modules" json:"modules,omitempty"`
 	OrgId   string                        `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
@@ -933,7 +1010,7 @@ type UpdateModulesRequest struct {
 func (m *UpdateModulesRequest) Reset()                    { *m = UpdateModulesRequest{} }
 func (m *UpdateModulesRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateModulesRequest) ProtoMessage()               {}
-func (*UpdateModulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*UpdateModulesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *UpdateModulesRequest) GetModules() []*go_micro_srv_static.Module {
 	if m != nil {
@@ -958,7 +1035,7 @@ type UpdateModulesResponse struct {
 func (m *UpdateModulesResponse) Reset()                    { *m = UpdateModulesResponse{} }
 func (m *UpdateModulesResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateModulesResponse) ProtoMessage()               {}
-func (*UpdateModulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*UpdateModulesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *UpdateModulesResponse) GetData() *UpdateModulesResponse_Data {
 	if m != nil {
@@ -988,7 +1065,7 @@ type UpdateModulesResponse_Data struct {
 func (m *UpdateModulesResponse_Data) Reset()                    { *m = UpdateModulesResponse_Data{} }
 func (m *UpdateModulesResponse_Data) String() string            { return proto.CompactTextString(m) }
 func (*UpdateModulesResponse_Data) ProtoMessage()               {}
-func (*UpdateModulesResponse_Data) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25, 0} }
+func (*UpdateModulesResponse_Data) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26, 0} }
 
 func (m *UpdateModulesResponse_Data) GetModules() []*go_micro_srv_static.Module {
 	if m != nil {
@@ -1004,7 +1081,7 @@ type GetModulesByOrgRequest struct {
 func (m *GetModulesByOrgRequest) Reset()                    { *m = GetModulesByOrgRequest{} }
 func (m *GetModulesByOrgRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetModulesByOrgRequest) ProtoMessage()               {}
-func (*GetModulesByOrgRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*GetModulesByOrgRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *GetModulesByOrgRequest) GetOrgId() string {
 	if m != nil {
@@ -1022,7 +1099,7 @@ type GetModulesByOrgResponse struct {
 func (m *GetModulesByOrgResponse) Reset()                    { *m = GetModulesByOrgResponse{} }
 func (m *GetModulesByOrgResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetModulesByOrgResponse) ProtoMessage()               {}
-func (*GetModulesByOrgResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*GetModulesByOrgResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *GetModulesByOrgResponse) GetData() *GetModulesByOrgResponse_Data {
 	if m != nil {
@@ -1053,7 +1130,7 @@ func (m *GetModulesByOrgResponse_Data) Reset()         { *m = GetModulesByOrgRes
 func (m *GetModulesByOrgResponse_Data) String() string { return proto.CompactTextString(m) }
 func (*GetModulesByOrgResponse_Data) ProtoMessage()    {}
 func (*GetModulesByOrgResponse_Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{27, 0}
+	return fileDescriptor0, []int{28, 0}
 }
 
 func (m *GetModulesByOrgResponse_Data) GetModules() []*go_micro_srv_static.Module {
@@ -1091,6 +1168,7 @@ func init() {
 	proto.RegisterType((*Organisation)(nil), "go.micro.srv.organisation.Organisation")
 	proto.RegisterType((*OrganisationProfile)(nil), "go.micro.srv.organisation.OrganisationProfile")
 	proto.RegisterType((*OrganisationSetting)(nil), "go.micro.srv.organisation.OrganisationSetting")
+	proto.RegisterType((*RateLimit)(nil), "go.micro.srv.organisation.RateLimit")
 	proto.RegisterType((*OrganisationModule)(nil), "go.micro.srv.organisation.OrganisationModule")
 	proto.RegisterType((*OrgInfo)(nil), "go.micro.srv.organisation.OrgInfo")
 	proto.RegisterType((*UpdateModulesRequest)(nil), "go.micro.srv.organisation.UpdateModulesRequest")
@@ -1324,89 +1402,97 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 1339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0xe3, 0xc4,
-	0x1f, 0x8f, 0xf3, 0x6c, 0xbf, 0x49, 0xfa, 0xcb, 0x6f, 0xb6, 0xbb, 0xf5, 0x06, 0x01, 0xad, 0xa1,
-	0x6c, 0xa1, 0x34, 0x85, 0xa2, 0x65, 0xb5, 0x2c, 0xd5, 0xaa, 0xdb, 0x06, 0xa8, 0x56, 0x6d, 0xa2,
-	0xe9, 0x03, 0x21, 0x21, 0x2a, 0x63, 0x4f, 0xb2, 0x16, 0x8e, 0x1d, 0xc6, 0x4e, 0x51, 0xf9, 0x0b,
-	0x38, 0x73, 0x42, 0xe2, 0xca, 0x3f, 0xc1, 0x85, 0x2b, 0x47, 0x0e, 0x7b, 0xe0, 0x6f, 0x81, 0x13,
-	0xf2, 0x3c, 0x52, 0x3b, 0x4d, 0x1c, 0x3b, 0xa4, 0xe2, 0x62, 0x7b, 0x66, 0xbe, 0xef, 0xc7, 0xcc,
-	0x67, 0x0c, 0xbb, 0x1e, 0xa1, 0x97, 0x84, 0x6e, 0xbb, 0xb4, 0xab, 0x3b, 0x96, 0xa7, 0xfb, 0x96,
-	0xeb, 0x6c, 0x79, 0xf4, 0x72, 0xbb, 0x4f, 0x5d, 0xdf, 0x8d, 0x4c, 0x47, 0x06, 0x0d, 0xb6, 0x8e,
-	0xee, 0x77, 0xdd, 0x46, 0xcf, 0x32, 0xa8, 0xdb, 0xf0, 0xe8, 0x65, 0x23, 0x4c, 0x50, 0xdf, 0x14,
-	0x92, 0x3d, 0x5f, 0xf7, 0x2d, 0x23, 0x24, 0x93, 0x4f, 0x88, 0x17, 0x97, 0x53, 0x6f, 0x08, 0x62,
-	0xc3, 0x75, 0x7c, 0xe2, 0xf8, 0x21, 0x6a, 0x31, 0x23, 0xdf, 0x23, 0xf4, 0xba, 0x61, 0xb8, 0x83,
-	0x08, 0xbd, 0x98, 0x91, 0x6f, 0x41, 0xbf, 0x2e, 0xe8, 0x07, 0x1e, 0xa1, 0x21, 0xe2, 0x60, 0xc8,
-	0x1e, 0x9c, 0x4c, 0x3b, 0x87, 0x52, 0x8b, 0x76, 0x0f, 0x74, 0x5f, 0x47, 0xcf, 0xa1, 0x12, 0x76,
-	0x47, 0x55, 0x56, 0x95, 0x8d, 0xf2, 0xce, 0x83, 0xc6, 0x44, 0x87, 0x1b, 0xad, 0xd0, 0x00, 0x47,
-	0x98, 0xb5, 0x1f, 0x14, 0x80, 0x3d, 0xdb, 0xc6, 0xe4, 0xdb, 0x01, 0xf1, 0x7c, 0x74, 0x0f, 0x8a,
-	0x6e, 0xa7, 0xe3, 0x11, 0x9f, 0x49, 0xcd, 0x61, 0x31, 0x42, 0xcb, 0x50, 0xb0, 0xad, 0x9e, 0xe5,
-	0xab, 0x59, 0x36, 0xcd, 0x07, 0x68, 0x1d, 0x96, 0x3c, 0x97, 0xfa, 0x17, 0x7d, 0x9d, 0xea, 0x3d,
-	0xe2, 0x13, 0xaa, 0xe6, 0x56, 0x95, 0x8d, 0x45, 0x5c, 0x0d, 0x66, 0xdb, 0x72, 0x72, 0x48, 0x66,
-	0x5a, 0x94, 0x18, 0xcc, 0xe4, 0xfc, 0x35, 0xd9, 0x81, 0x9c, 0xd4, 0x5e, 0x2a, 0x50, 0x66, 0xa6,
-	0x78, 0x7d, 0xd7, 0xf1, 0x08, 0x7a, 0x0a, 0x79, 0x53, 0xf7, 0x75, 0xe1, 0xdf, 0x66, 0x8c, 0x7f,
-	0x21, 0xae, 0x46, 0x10, 0x22, 0xcc, 0x18, 0x11, 0x82, 0xbc, 0xe1, 0x9a, 0x44, 0xd8, 0xcc, 0xbe,
-	0x91, 0x0a, 0xa5, 0x1e, 0xf1, 0x3c, 0xbd, 0x4b, 0x84, 0xad, 0x72, 0x58, 0x3f, 0x83, 0x3c, 0x0b,
-	0xef, 0x11, 0x54, 0xc3, 0xc2, 0x3d, 0x55, 0x59, 0xcd, 0xa5, 0x89, 0x6f, 0x94, 0x5b, 0xfb, 0x4b,
-	0x81, 0xea, 0x3e, 0x25, 0xba, 0x4f, 0x64, 0x8c, 0xe7, 0x99, 0x3f, 0xb4, 0x09, 0xf9, 0xa0, 0x4a,
-	0x98, 0x8f, 0xe5, 0x9d, 0x95, 0xa8, 0x10, 0x56, 0x3f, 0x67, 0x1e, 0xa1, 0x98, 0x11, 0xa1, 0x47,
-	0x50, 0x12, 0xc5, 0xc7, 0x9c, 0x2f, 0xef, 0xbc, 0x1a, 0xa5, 0x97, 0x95, 0xb9, 0xc7, 0xdf, 0x58,
-	0x52, 0xa3, 0x87, 0x50, 0xea, 0xb9, 0xe6, 0xc0, 0x26, 0x9e, 0x9a, 0x67, 0xd1, 0x78, 0x25, 0xca,
-	0x28, 0x3a, 0xe6, 0x88, 0xd1, 0x60, 0x49, 0xab, 0xfd, 0x96, 0x85, 0x25, 0xe9, 0xbb, 0x48, 0xea,
-	0xb3, 0x48, 0x52, 0x1b, 0x31, 0x4e, 0x47, 0x19, 0x67, 0xcf, 0xeb, 0xaf, 0x8a, 0x48, 0xec, 0x5c,
-	0xe3, 0x1e, 0x0a, 0x65, 0x36, 0x55, 0x28, 0x65, 0xc2, 0x72, 0x09, 0x12, 0xa6, 0x7d, 0x09, 0xd5,
-	0xb3, 0xbe, 0x79, 0x4b, 0xb5, 0xa3, 0xfd, 0xa9, 0xc0, 0x92, 0x14, 0x9f, 0x3a, 0x3d, 0x51, 0xc6,
-	0xd9, 0xd3, 0x73, 0x72, 0x0b, 0xd9, 0xd1, 0xde, 0x84, 0x32, 0x26, 0xba, 0x29, 0xa3, 0x76, 0x17,
-	0x8a, 0x2e, 0xed, 0x5e, 0x58, 0x26, 0x93, 0xba, 0x88, 0x0b, 0x2e, 0xed, 0x1e, 0x9a, 0x9a, 0x0f,
-	0x15, 0x4e, 0x25, 0x9c, 0xff, 0x30, 0xe2, 0xbc, 0x16, 0xaf, 0x7a, 0x56, 0x87, 0x35, 0x1b, 0x56,
-	0x79, 0x69, 0x87, 0xed, 0x6f, 0x53, 0xb7, 0x63, 0xd9, 0xc3, 0x34, 0x7f, 0x06, 0xa5, 0x3e, 0x9f,
-	0x49, 0x90, 0x89, 0x71, 0x72, 0x24, 0xbb, 0xf6, 0xb7, 0x02, 0x6b, 0x31, 0xea, 0x84, 0xe7, 0x67,
-	0x11, 0xcf, 0xf7, 0xa6, 0x76, 0x65, 0x8c, 0xac, 0xd9, 0x2b, 0xa1, 0x2d, 0x2a, 0x61, 0x7e, 0xce,
-	0x8f, 0x0d, 0xf5, 0x09, 0xf1, 0x7d, 0xcb, 0xe9, 0x86, 0x42, 0xed, 0xf1, 0x99, 0x94, 0xda, 0xa4,
-	0x1c, 0xc9, 0x3e, 0x21, 0xd4, 0x43, 0x75, 0x73, 0x08, 0xf5, 0x88, 0xac, 0xb9, 0x84, 0x7a, 0x4e,
-	0xce, 0x6f, 0x02, 0x0a, 0x7a, 0xa9, 0x45, 0xbb, 0x87, 0x4e, 0xc7, 0x9d, 0xd2, 0x78, 0xa7, 0x70,
-	0x27, 0x42, 0x2c, 0x42, 0xb3, 0x0b, 0x0b, 0x8c, 0xda, 0xe9, 0xb8, 0xc9, 0x7a, 0x90, 0x71, 0x97,
-	0x5c, 0xfe, 0xa1, 0x59, 0xf0, 0xff, 0xf6, 0xc0, 0x4f, 0x64, 0x41, 0x44, 0x55, 0x36, 0xbd, 0xaa,
-	0x65, 0x40, 0x61, 0x55, 0xdc, 0x7e, 0xcd, 0x81, 0xd7, 0x3e, 0xd7, 0x69, 0x6f, 0xd0, 0xdf, 0xd7,
-	0x8d, 0x17, 0x91, 0xc4, 0x49, 0x6b, 0x6e, 0x02, 0x26, 0x25, 0x19, 0x60, 0xca, 0x8e, 0x03, 0x4c,
-	0x6b, 0xf0, 0xfa, 0x44, 0x7d, 0xc2, 0xa4, 0x9f, 0xf3, 0x50, 0x09, 0x2f, 0xa0, 0x25, 0xc8, 0x0e,
-	0x63, 0x91, 0xb5, 0xcc, 0xa0, 0x6e, 0x1c, 0xbd, 0x47, 0x84, 0x02, 0xf6, 0x1d, 0x00, 0x2f, 0xff,
-	0xaa, 0xcf, 0x8b, 0x66, 0x29, 0x16, 0x78, 0x85, 0x45, 0x9f, 0x5e, 0xf5, 0x09, 0x66, 0x8c, 0xe8,
-	0x29, 0x14, 0xfb, 0x3a, 0x25, 0x8e, 0xcf, 0x80, 0x5e, 0x8a, 0x5d, 0x5c, 0xb0, 0xa1, 0x5d, 0x28,
-	0x18, 0x2f, 0x2c, 0xdb, 0x54, 0x0b, 0xe9, 0xb0, 0x17, 0xe7, 0x0a, 0xc3, 0x95, 0x62, 0x72, 0xb8,
-	0x82, 0x1e, 0xc3, 0xa2, 0xed, 0x1a, 0x02, 0xf5, 0x95, 0xc6, 0x31, 0x4a, 0xa8, 0xdf, 0xb6, 0x75,
-	0x83, 0xe0, 0x6b, 0x6a, 0xb4, 0x05, 0x05, 0xf7, 0x3b, 0x87, 0x50, 0x75, 0x21, 0xfe, 0x58, 0xe7,
-	0x54, 0x41, 0x67, 0x1a, 0xac, 0xbb, 0x4d, 0x75, 0x91, 0x35, 0xac, 0x1c, 0x06, 0x2b, 0x03, 0x76,
-	0xb2, 0x9a, 0x2a, 0xf0, 0x15, 0x31, 0x0c, 0xa0, 0xb9, 0xe9, 0xf6, 0x74, 0xcb, 0x51, 0xcb, 0x2c,
-	0x57, 0x62, 0x14, 0x40, 0x73, 0xd2, 0xd3, 0x2d, 0x5b, 0xad, 0xf0, 0x02, 0x67, 0x03, 0xf4, 0x06,
-	0x54, 0x3b, 0xd4, 0x22, 0x8e, 0x69, 0x5f, 0x5d, 0xb0, 0x04, 0x57, 0xd9, 0x6a, 0x45, 0x4e, 0x1e,
-	0xeb, 0x3d, 0xa2, 0xf5, 0xe1, 0xce, 0x98, 0xfd, 0xf3, 0x46, 0x8d, 0x5c, 0xf7, 0x50, 0x36, 0xdc,
-	0x43, 0x21, 0x27, 0x72, 0x13, 0x9d, 0xc8, 0x47, 0x9c, 0x18, 0xd5, 0x28, 0xb6, 0x91, 0xdb, 0xd4,
-	0xf8, 0x15, 0xa0, 0xb0, 0x46, 0x9e, 0xf3, 0xa4, 0x0a, 0xd7, 0xa0, 0x62, 0x5a, 0x5e, 0xdf, 0xd6,
-	0x45, 0x10, 0x0b, 0x6c, 0xb1, 0x2c, 0xe6, 0x58, 0x0c, 0x7f, 0x57, 0xd8, 0xcd, 0x2c, 0xd8, 0x08,
-	0x26, 0x6d, 0x36, 0xb2, 0x9f, 0xb2, 0xb3, 0xf6, 0xd3, 0xb0, 0xba, 0x4a, 0x89, 0xaa, 0x2b, 0x54,
-	0xfe, 0x85, 0x14, 0x68, 0xdd, 0x84, 0x65, 0x0e, 0xea, 0xf8, 0x82, 0x27, 0x37, 0xad, 0x90, 0x38,
-	0x25, 0x45, 0x37, 0x8d, 0x0f, 0xa9, 0xf6, 0x87, 0x02, 0x77, 0x47, 0xd4, 0x88, 0xed, 0xff, 0x30,
-	0x72, 0x32, 0x3e, 0x9c, 0x8a, 0x3d, 0x47, 0xf8, 0x67, 0x3f, 0x0d, 0x77, 0xc5, 0x69, 0x38, 0x9b,
-	0xa3, 0xda, 0x36, 0xdc, 0xfb, 0x94, 0xf8, 0xc2, 0x9a, 0x67, 0x57, 0x2d, 0xda, 0x9d, 0x72, 0xfc,
-	0xbd, 0x54, 0x60, 0xe5, 0x06, 0x87, 0x08, 0xc2, 0xf3, 0x48, 0x10, 0x1e, 0xc5, 0x04, 0x61, 0x82,
-	0x84, 0xff, 0x2a, 0x0c, 0xef, 0x10, 0xa8, 0x8d, 0x96, 0x2f, 0x5a, 0x80, 0xfc, 0x71, 0xeb, 0xb8,
-	0x59, 0xcb, 0x04, 0x5f, 0xb8, 0xd5, 0x3a, 0xad, 0x29, 0xa8, 0x02, 0x0b, 0xfb, 0x67, 0x27, 0xa7,
-	0xad, 0xa3, 0x26, 0xae, 0x65, 0x51, 0x19, 0x4a, 0xed, 0x3d, 0x7c, 0x7a, 0xdc, 0xc4, 0xb5, 0x5c,
-	0xb0, 0x84, 0x9b, 0x9f, 0x34, 0x31, 0x6e, 0xe2, 0x5a, 0x1e, 0x2d, 0x43, 0xed, 0xa4, 0x89, 0xcf,
-	0x0f, 0xf7, 0x9b, 0x17, 0x6d, 0xdc, 0x3a, 0x3f, 0x3c, 0x68, 0xe2, 0x5a, 0x61, 0xe7, 0x17, 0x18,
-	0xdd, 0x42, 0xe8, 0xa5, 0x65, 0x10, 0x74, 0x0e, 0xb9, 0x3d, 0xdb, 0x46, 0xeb, 0xd3, 0x7e, 0x13,
-	0xb0, 0xcc, 0xd4, 0xdf, 0x4a, 0xf6, 0x37, 0x41, 0xcb, 0xa0, 0x0b, 0x28, 0x72, 0x20, 0x86, 0x36,
-	0x12, 0x5c, 0x56, 0xb9, 0xf4, 0xb7, 0x13, 0x5f, 0x6b, 0xb9, 0x02, 0x5e, 0xcf, 0xb1, 0x0a, 0x22,
-	0xd7, 0xc0, 0x58, 0x05, 0xd1, 0x8b, 0x99, 0x96, 0x41, 0x5f, 0x40, 0x3e, 0x40, 0x5b, 0x28, 0xce,
-	0xe7, 0xd0, 0x6d, 0xa9, 0xfe, 0x60, 0x2a, 0xdd, 0x50, 0xf4, 0x4f, 0x0a, 0xdc, 0x9f, 0x78, 0x23,
-	0x40, 0x4f, 0x66, 0xbb, 0x47, 0x70, 0x2b, 0x3e, 0xfe, 0x37, 0x97, 0x90, 0x89, 0xa6, 0xc9, 0x03,
-	0xe7, 0xc9, 0x6c, 0xb8, 0x7b, 0x16, 0xd3, 0x46, 0x40, 0xbb, 0x96, 0x41, 0x0e, 0xbf, 0x9d, 0xca,
-	0x53, 0x63, 0x6b, 0x4a, 0xbc, 0xa3, 0x88, 0xb6, 0xde, 0x48, 0x4a, 0x3e, 0xd4, 0xf7, 0x0d, 0xc0,
-	0x35, 0x5a, 0x45, 0xef, 0xc6, 0xf0, 0xdf, 0xc0, 0xcf, 0xf5, 0xad, 0x84, 0xd4, 0x43, 0x65, 0x3f,
-	0x2a, 0xb0, 0x32, 0x01, 0x95, 0xa2, 0xc7, 0x31, 0xc2, 0xe2, 0x91, 0x73, 0xfd, 0xa3, 0x59, 0x58,
-	0x87, 0x46, 0xf9, 0xf2, 0x3f, 0x8a, 0xd8, 0x31, 0xd1, 0x76, 0xf2, 0xd3, 0x85, 0xeb, 0x7f, 0x2f,
-	0xed, 0x71, 0xa4, 0x65, 0xd0, 0xf7, 0xf0, 0xbf, 0x91, 0x4d, 0x1a, 0xbd, 0x9f, 0x66, 0x43, 0xe7,
-	0x9a, 0x77, 0xd2, 0x9f, 0x01, 0x5a, 0xe6, 0xeb, 0x22, 0xfb, 0x6d, 0xfc, 0xc1, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xda, 0x21, 0x0d, 0x37, 0x46, 0x17, 0x00, 0x00,
+	// 1464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x18, 0xcd, 0x72, 0xdb, 0x44,
+	0x38, 0xf2, 0x6f, 0xfc, 0xd9, 0x09, 0x66, 0x9b, 0xb6, 0xaa, 0x3b, 0x85, 0x54, 0xb4, 0xb4, 0x25,
+	0xc4, 0x29, 0x61, 0x4a, 0xa7, 0x94, 0x4c, 0x27, 0x4d, 0x0c, 0x64, 0x4a, 0x63, 0x8f, 0xf2, 0xd3,
+	0x61, 0x86, 0xc1, 0xb3, 0x48, 0x1b, 0x57, 0x83, 0x2c, 0x99, 0x95, 0x9c, 0x4e, 0xb8, 0x70, 0xe5,
+	0x0a, 0x27, 0x1e, 0x80, 0x97, 0xe0, 0xc2, 0x2b, 0x70, 0xe8, 0x81, 0x27, 0x60, 0x78, 0x06, 0x38,
+	0x31, 0xfb, 0xe7, 0x48, 0x8e, 0x2d, 0xcb, 0x6e, 0x3a, 0x5c, 0x6c, 0xed, 0xee, 0xf7, 0xff, 0xb7,
+	0xdf, 0xb7, 0xb0, 0x11, 0x10, 0x7a, 0x4c, 0xe8, 0x9a, 0x4f, 0x3b, 0xd8, 0x73, 0x02, 0x1c, 0x3a,
+	0xbe, 0xb7, 0x1a, 0xd0, 0xe3, 0xb5, 0x1e, 0xf5, 0x43, 0x3f, 0xb6, 0x1d, 0x5b, 0xd4, 0xf9, 0x39,
+	0xba, 0xd2, 0xf1, 0xeb, 0x5d, 0xc7, 0xa2, 0x7e, 0x3d, 0xa0, 0xc7, 0xf5, 0x28, 0x40, 0x6d, 0x45,
+	0x52, 0x0e, 0x42, 0x1c, 0x3a, 0x56, 0x84, 0xa6, 0xd8, 0x90, 0x7f, 0x82, 0x4e, 0xad, 0x2e, 0x81,
+	0x2d, 0xdf, 0x0b, 0x89, 0x17, 0x46, 0xa0, 0xe5, 0x8e, 0xfa, 0x1f, 0x82, 0xc7, 0x96, 0xe5, 0xf7,
+	0x63, 0xf0, 0x72, 0x47, 0xfd, 0x4b, 0xf8, 0x9b, 0x12, 0xbe, 0x1f, 0x10, 0x1a, 0x01, 0x66, 0x4b,
+	0xfe, 0x23, 0xc0, 0x8c, 0x43, 0x28, 0x36, 0x69, 0x67, 0x1b, 0x87, 0x18, 0x3d, 0x81, 0x4a, 0x54,
+	0x1d, 0x5d, 0x5b, 0xd6, 0x6e, 0x97, 0xd7, 0x6f, 0xd5, 0xc7, 0x2a, 0x5c, 0x6f, 0x46, 0x16, 0x66,
+	0x0c, 0xd9, 0xf8, 0x51, 0x03, 0xd8, 0x74, 0x5d, 0x93, 0x7c, 0xd7, 0x27, 0x41, 0x88, 0x2e, 0x41,
+	0xc1, 0x3f, 0x3a, 0x0a, 0x48, 0xc8, 0xa9, 0x66, 0x4d, 0xb9, 0x42, 0x4b, 0x90, 0x77, 0x9d, 0xae,
+	0x13, 0xea, 0x19, 0xbe, 0x2d, 0x16, 0xe8, 0x26, 0x2c, 0x06, 0x3e, 0x0d, 0xdb, 0x3d, 0x4c, 0x71,
+	0x97, 0x84, 0x84, 0xea, 0xd9, 0x65, 0xed, 0x76, 0xc9, 0x5c, 0x60, 0xbb, 0x2d, 0xb5, 0x39, 0x00,
+	0xb3, 0x1d, 0x4a, 0x2c, 0x2e, 0x72, 0xee, 0x14, 0x6c, 0x5b, 0x6d, 0x1a, 0x2f, 0x35, 0x28, 0x73,
+	0x51, 0x82, 0x9e, 0xef, 0x05, 0x04, 0x3d, 0x82, 0x9c, 0x8d, 0x43, 0x2c, 0xf5, 0x5b, 0x49, 0xd0,
+	0x2f, 0x82, 0x55, 0x67, 0x26, 0x32, 0x39, 0x22, 0x42, 0x90, 0xb3, 0x7c, 0x9b, 0x48, 0x99, 0xf9,
+	0x37, 0xd2, 0xa1, 0xd8, 0x25, 0x41, 0x80, 0x3b, 0x44, 0xca, 0xaa, 0x96, 0xb5, 0x03, 0xc8, 0x71,
+	0xf3, 0x3e, 0x85, 0x85, 0x28, 0xf1, 0x40, 0xd7, 0x96, 0xb3, 0xd3, 0xd8, 0x37, 0x8e, 0x6d, 0xfc,
+	0xa3, 0xc1, 0xc2, 0x16, 0x25, 0x38, 0x24, 0xca, 0xc6, 0xe7, 0xe9, 0x3f, 0xb4, 0x02, 0x39, 0x16,
+	0x25, 0x5c, 0xc7, 0xf2, 0xfa, 0xe5, 0x38, 0x11, 0x76, 0x52, 0x3f, 0x08, 0x08, 0x35, 0x39, 0x10,
+	0xba, 0x0f, 0x45, 0x19, 0x7c, 0x5c, 0xf9, 0xf2, 0xfa, 0xb5, 0x38, 0xbc, 0x3c, 0xac, 0x6f, 0x8a,
+	0x7f, 0x53, 0x41, 0xa3, 0x7b, 0x50, 0xec, 0xfa, 0x76, 0xdf, 0x25, 0x81, 0x9e, 0xe3, 0xd6, 0xb8,
+	0x1a, 0x47, 0x94, 0x19, 0xf3, 0x94, 0xc3, 0x98, 0x0a, 0xd6, 0xf8, 0x3d, 0x03, 0x8b, 0x4a, 0x77,
+	0xe9, 0xd4, 0xc7, 0x31, 0xa7, 0xd6, 0x13, 0x94, 0x8e, 0x23, 0xce, 0xee, 0xd7, 0xdf, 0x34, 0xe9,
+	0xd8, 0x73, 0xb5, 0x7b, 0xc4, 0x94, 0x99, 0xa9, 0x4c, 0xa9, 0x1c, 0x96, 0x4d, 0xe1, 0x30, 0xe3,
+	0x2b, 0x58, 0x38, 0xe8, 0xd9, 0xaf, 0x29, 0x76, 0x8c, 0x3f, 0x35, 0x58, 0x54, 0xe4, 0xa7, 0x76,
+	0x4f, 0x1c, 0x71, 0x76, 0xf7, 0xec, 0xbd, 0x06, 0xef, 0x18, 0x37, 0xa0, 0x6c, 0x12, 0x6c, 0x2b,
+	0xab, 0x5d, 0x84, 0x82, 0x4f, 0x3b, 0x6d, 0xc7, 0xe6, 0x54, 0x4b, 0x66, 0xde, 0xa7, 0x9d, 0x1d,
+	0xdb, 0x08, 0xa1, 0x22, 0xa0, 0xa4, 0xf2, 0x1f, 0xc5, 0x94, 0x37, 0x92, 0x59, 0xcf, 0xaa, 0xb0,
+	0xe1, 0xc2, 0xb2, 0x08, 0xed, 0xa8, 0xfc, 0x2d, 0xea, 0x1f, 0x39, 0xee, 0xc0, 0xcd, 0x9f, 0x43,
+	0xb1, 0x27, 0x76, 0x52, 0x78, 0x62, 0x14, 0x1d, 0x85, 0x6e, 0xfc, 0xab, 0xc1, 0xf5, 0x04, 0x76,
+	0x52, 0xf3, 0x83, 0x98, 0xe6, 0x9b, 0x13, 0xb3, 0x32, 0x81, 0xd6, 0xec, 0x91, 0xd0, 0x92, 0x91,
+	0x70, 0x7e, 0xca, 0x8f, 0x34, 0xf5, 0x1e, 0x09, 0x43, 0xc7, 0xeb, 0x44, 0x4c, 0x1d, 0x88, 0x9d,
+	0x29, 0xb9, 0x29, 0x3a, 0x0a, 0x7d, 0x8c, 0xa9, 0x07, 0xec, 0xce, 0xc1, 0xd4, 0x43, 0xb4, 0xce,
+	0xc5, 0xd4, 0xe7, 0xa4, 0xfc, 0x0a, 0x20, 0x96, 0x4b, 0x4d, 0xda, 0xd9, 0xf1, 0x8e, 0xfc, 0x09,
+	0x89, 0xb7, 0x0f, 0x17, 0x62, 0xc0, 0xd2, 0x34, 0x1b, 0x30, 0xcf, 0xa1, 0xbd, 0x23, 0x3f, 0x5d,
+	0x0e, 0x72, 0xec, 0xa2, 0x2f, 0x3e, 0x0c, 0x07, 0xde, 0x6c, 0xf5, 0xc3, 0x54, 0x12, 0xc4, 0x58,
+	0x65, 0xa6, 0x67, 0xb5, 0x04, 0x28, 0xca, 0x4a, 0xc8, 0x6f, 0x78, 0xf0, 0xd6, 0x33, 0x4c, 0xbb,
+	0xfd, 0xde, 0x16, 0xb6, 0x9e, 0xc7, 0x1c, 0xa7, 0xa4, 0x39, 0xdb, 0x30, 0x69, 0xe9, 0x1a, 0xa6,
+	0xcc, 0xa8, 0x86, 0xe9, 0x3a, 0xbc, 0x3d, 0x96, 0x9f, 0x14, 0xe9, 0xaf, 0x1c, 0x54, 0xa2, 0x07,
+	0x68, 0x11, 0x32, 0x03, 0x5b, 0x64, 0x1c, 0x9b, 0xc5, 0x8d, 0x87, 0xbb, 0x44, 0x32, 0xe0, 0xdf,
+	0xac, 0xf1, 0x0a, 0x4f, 0x7a, 0x22, 0x68, 0x16, 0x13, 0x1b, 0xaf, 0x28, 0xe9, 0xfd, 0x93, 0x1e,
+	0x31, 0x39, 0x22, 0x7a, 0x04, 0x85, 0x1e, 0xa6, 0xc4, 0x0b, 0xf5, 0xdc, 0x74, 0x55, 0x5c, 0xa2,
+	0xa1, 0x0d, 0xc8, 0x5b, 0xcf, 0x1d, 0xd7, 0xd6, 0xf3, 0xd3, 0xf5, 0x5e, 0x02, 0x2b, 0xda, 0xae,
+	0x14, 0xd2, 0xb7, 0x2b, 0xe8, 0x01, 0x94, 0x5c, 0xdf, 0x92, 0x5d, 0x5f, 0x71, 0x14, 0xa2, 0x6a,
+	0xf5, 0x5b, 0x2e, 0xb6, 0x88, 0x79, 0x0a, 0x8d, 0x56, 0x21, 0xef, 0xbf, 0xf0, 0x08, 0xd5, 0xe7,
+	0x93, 0xaf, 0x75, 0x01, 0xc5, 0x32, 0xd3, 0xe2, 0xd9, 0x6d, 0xeb, 0x25, 0x9e, 0xb0, 0x6a, 0xc9,
+	0x4e, 0xfa, 0xfc, 0x66, 0xb5, 0x75, 0x10, 0x27, 0x72, 0xc9, 0x5a, 0x73, 0xdb, 0xef, 0x62, 0xc7,
+	0xd3, 0xcb, 0xdc, 0x57, 0x72, 0xc5, 0x5a, 0x73, 0xd2, 0xc5, 0x8e, 0xab, 0x57, 0x44, 0x80, 0xf3,
+	0x05, 0x7a, 0x07, 0x16, 0x8e, 0xa8, 0x43, 0x3c, 0xdb, 0x3d, 0x69, 0x73, 0x07, 0x2f, 0xf0, 0xd3,
+	0x8a, 0xda, 0xdc, 0x65, 0x8e, 0x8e, 0xa4, 0xff, 0xe2, 0xab, 0xa5, 0x7f, 0x0f, 0x2e, 0x8c, 0xa8,
+	0xc4, 0x67, 0xa2, 0xed, 0x34, 0x1b, 0x33, 0xd1, 0x6c, 0x8c, 0x98, 0x23, 0x3b, 0xd6, 0x1c, 0xb9,
+	0x98, 0x39, 0x8c, 0xbf, 0x35, 0xb8, 0x30, 0x42, 0xa4, 0xd7, 0xc8, 0x12, 0xdd, 0x85, 0xa5, 0x90,
+	0xe2, 0xe0, 0x79, 0x9b, 0x12, 0x16, 0x05, 0x8e, 0xef, 0xb5, 0x6d, 0x7c, 0x12, 0xe8, 0x79, 0x0e,
+	0x86, 0xf8, 0x99, 0xa9, 0x8e, 0xb6, 0xf1, 0x49, 0x80, 0xb6, 0x00, 0x28, 0x0e, 0x49, 0x5b, 0xcc,
+	0x4e, 0x05, 0x6e, 0xe3, 0x1b, 0x09, 0x36, 0x36, 0x71, 0x48, 0xbe, 0x60, 0xb0, 0x66, 0x89, 0xaa,
+	0x4f, 0xe3, 0x07, 0x28, 0x0d, 0xf6, 0xd1, 0x15, 0x51, 0xb8, 0x28, 0xc1, 0xb6, 0x1c, 0xd1, 0x58,
+	0x51, 0x62, 0xd5, 0x14, 0x5d, 0x85, 0x12, 0x3b, 0x7a, 0x41, 0x9d, 0x50, 0xdd, 0x03, 0x0c, 0xf6,
+	0x19, 0x5b, 0xb3, 0x43, 0x16, 0x85, 0x02, 0x51, 0x68, 0x3c, 0xcf, 0x36, 0x38, 0xe6, 0x35, 0x00,
+	0x7e, 0x28, 0x50, 0x85, 0xd6, 0x1c, 0x9c, 0xe3, 0x1a, 0x5f, 0x03, 0x8a, 0x5a, 0x5a, 0xa4, 0x4d,
+	0x5a, 0x43, 0x5f, 0x87, 0x8a, 0xed, 0x04, 0x3d, 0x17, 0xcb, 0x38, 0xcc, 0xf3, 0xc3, 0xb2, 0xdc,
+	0x63, 0x61, 0x68, 0xfc, 0x94, 0x81, 0xa2, 0xac, 0xa5, 0xe3, 0xea, 0xb5, 0x2a, 0x49, 0x99, 0x59,
+	0x4b, 0xd2, 0x20, 0x41, 0x8b, 0xa9, 0x12, 0x34, 0x52, 0x41, 0xf2, 0x53, 0x54, 0x90, 0x48, 0x42,
+	0xcd, 0xbf, 0x5a, 0x42, 0xd9, 0xb0, 0x24, 0x3a, 0x6c, 0xc1, 0x22, 0x50, 0x37, 0x48, 0x44, 0x30,
+	0x6d, 0x0a, 0xc1, 0x46, 0x3b, 0xc7, 0xf8, 0x43, 0x83, 0x8b, 0x43, 0x6c, 0xe4, 0x5d, 0xbc, 0x13,
+	0x6b, 0x53, 0xee, 0x4d, 0x1c, 0x04, 0x86, 0xf0, 0x67, 0x6f, 0x4d, 0x36, 0x64, 0x6b, 0x32, 0x9b,
+	0xa2, 0xc6, 0x1a, 0x5c, 0xfa, 0x8c, 0x84, 0x52, 0x9a, 0xc7, 0x27, 0x4d, 0xda, 0x99, 0xd0, 0x8b,
+	0xbc, 0xd4, 0xe0, 0xf2, 0x19, 0x0c, 0x69, 0x84, 0x27, 0x31, 0x23, 0xdc, 0x4f, 0x30, 0xc2, 0x18,
+	0x0a, 0xff, 0x97, 0x19, 0xde, 0x23, 0x50, 0x1d, 0x4e, 0x04, 0x34, 0x0f, 0xb9, 0xdd, 0xe6, 0x6e,
+	0xa3, 0x3a, 0xc7, 0xbe, 0xcc, 0x66, 0x73, 0xbf, 0xaa, 0xa1, 0x0a, 0xcc, 0x6f, 0x1d, 0xec, 0xed,
+	0x37, 0x9f, 0x36, 0xcc, 0x6a, 0x06, 0x95, 0xa1, 0xd8, 0xda, 0x34, 0xf7, 0x77, 0x1b, 0x66, 0x35,
+	0xcb, 0x8e, 0xcc, 0xc6, 0xa7, 0x0d, 0xd3, 0x6c, 0x98, 0xd5, 0x1c, 0x5a, 0x82, 0xea, 0x5e, 0xc3,
+	0x3c, 0xdc, 0xd9, 0x6a, 0xb4, 0x5b, 0x66, 0xf3, 0x70, 0x67, 0xbb, 0x61, 0x56, 0xf3, 0xeb, 0xbf,
+	0xc2, 0x70, 0x11, 0xa6, 0xc7, 0x8e, 0x45, 0xd0, 0x21, 0x64, 0x37, 0x5d, 0x17, 0xdd, 0x9c, 0xf4,
+	0x66, 0xc3, 0x3d, 0x53, 0x7b, 0x37, 0xdd, 0xd3, 0x8e, 0x31, 0x87, 0xda, 0x50, 0x10, 0x5d, 0x31,
+	0xba, 0x9d, 0xe2, 0xe5, 0x40, 0x50, 0xbf, 0x93, 0xfa, 0x8d, 0x41, 0x30, 0x10, 0xf1, 0x9c, 0xc8,
+	0x20, 0x36, 0x93, 0xd7, 0xee, 0xa4, 0x80, 0x1c, 0x30, 0xf8, 0x12, 0x72, 0xbc, 0xe4, 0x26, 0xe9,
+	0x1c, 0x19, 0x5d, 0x6b, 0xb7, 0x26, 0xc2, 0x0d, 0x48, 0xff, 0xa2, 0xc1, 0x95, 0xb1, 0xe3, 0x19,
+	0x7a, 0x38, 0xdb, 0x50, 0x27, 0xa4, 0xf8, 0xe4, 0x55, 0x26, 0xc2, 0xb1, 0xa2, 0xa9, 0x2b, 0xfb,
+	0xe1, 0x6c, 0x43, 0xd0, 0x2c, 0xa2, 0x0d, 0x4d, 0x50, 0xc6, 0x1c, 0xf2, 0xc4, 0x53, 0x81, 0xba,
+	0x7f, 0x56, 0x27, 0xd8, 0x3b, 0x3e, 0x5e, 0xd4, 0xea, 0x69, 0xc1, 0x07, 0xfc, 0xbe, 0x05, 0x38,
+	0x1d, 0x1d, 0xd0, 0xfb, 0x09, 0xf8, 0x67, 0x86, 0x99, 0xda, 0x6a, 0x4a, 0xe8, 0x01, 0xb3, 0x9f,
+	0x35, 0xb8, 0x3c, 0x66, 0x44, 0x40, 0x0f, 0x12, 0x88, 0x25, 0x8f, 0x31, 0xb5, 0x8f, 0x67, 0x41,
+	0x1d, 0x08, 0x15, 0xaa, 0x47, 0x2d, 0x59, 0x31, 0xd1, 0x5a, 0xfa, 0xdb, 0x45, 0xf0, 0xbf, 0x3b,
+	0xed, 0x75, 0x64, 0xcc, 0xa1, 0xef, 0xe1, 0x8d, 0xa1, 0x22, 0x8d, 0x3e, 0x98, 0xa6, 0xa0, 0x0b,
+	0xce, 0xeb, 0xd3, 0xdf, 0x01, 0xc6, 0xdc, 0x37, 0x05, 0xfe, 0x86, 0xff, 0xe1, 0x7f, 0x03, 0x00,
+	0xf7, 0xcd, 0x45, 0x25, 0xd3, 0x18, 0x00, 0x00,
 }
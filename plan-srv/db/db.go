@@ -31,7 +31,7 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 
 	return &clientWrapper{
 		Db_client: cl,
@@ -70,11 +70,31 @@ func runQuery(ctx context.Context, q string, table string) (*db_proto.RunQueryRe
 	})
 }
 
+// runQueryBind runs a query which references its parameters as bind variables
+func runQueryBind(ctx context.Context, q string, bindVars common.BindVars, table string) (*db_proto.RunQueryResponse, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return ClientWrapper.Db_client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{
+			Name:     common.DbHealumName,
+			Table:    table,
+			Driver:   common.DbHealumDriver,
+			Metadata: common.SearchableMetaMap,
+		},
+		Query:    q,
+		BindVars: vars,
+	})
+}
+
 func planToRecord(plan *plan_proto.Plan) (string, error) {
 	data, err := common.MarhalToObject(plan)
 	if err != nil {
 		return "", err
 	}
+	// the revision is the _rev of the document
+	delete(data, "revision")
 	// users
 	if len(plan.Users) > 0 {
 		var arr []interface{}
@@ -144,6 +164,7 @@ func recordToPlan(r *db_proto.Record) (*plan_proto.Plan, error) {
 	if err := jsonpb.Unmarshal(strings.NewReader(r.Parameter3), &p); err != nil {
 		return nil, err
 	}
+	p.Revision = r.Revision
 	return &p, nil
 }
 
@@ -283,6 +304,37 @@ func Create(ctx context.Context, plan *plan_proto.Plan) error {
 	return err
 }
 
+// Update replaces a plan of the organisation and returns it with its new revision, it returns ErrNotFound if the
+// plan doesn't exist. The update fails with a conflict if plan.Revision is set and the plan was written since.
+func Update(ctx context.Context, plan *plan_proto.Plan, orgId, teamId string) (*plan_proto.Plan, error) {
+	plan.Updated = time.Now().Unix()
+	// calc items_count
+	items_count := 0
+	for _, v := range plan.Days {
+		items_count += len(v.Items)
+	}
+	plan.ItemsCount = int64(items_count)
+
+	record, err := planToRecord(plan)
+	if err != nil {
+		return nil, err
+	}
+	if len(record) == 0 {
+		return nil, errors.New("server serialization")
+	}
+
+	bindVars := common.BindVars{}
+	q := common.QueryReplaceBind(common.DbPlanTable, plan.Id, record, plan.Revision, orgId, teamId, bindVars)
+	resp, err := runQueryBind(ctx, q, bindVars, common.DbPlanTable)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Records) == 0 {
+		return nil, ErrNotFound
+	}
+	return recordToPlan(resp.Records[0])
+}
+
 // Reads a plan by ID
 func Read(ctx context.Context, id, orgId, teamId string) (*plan_proto.Plan, error) {
 	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
@@ -644,14 +696,18 @@ func SharePlan(ctx context.Context, plans []*plan_proto.Plan, users []*user_prot
 			}
 
 			field := fmt.Sprintf(`{_from:"%v",_to:"%v"} `, _from, _to)
+			bindVars := common.BindVars{}
 			q := fmt.Sprintf(`
 				UPSERT %v
 				INSERT %v
 				UPDATE %v
 				INTO %v
-				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field, record, record, common.DbSharePlanUserEdgeTable)
+				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field,
+				common.QueryPinVersion(record, common.DbPlanTable, plan.Id, bindVars),
+				common.QueryPinVersion(record, common.DbPlanTable, plan.Id, bindVars),
+				common.DbSharePlanUserEdgeTable)
 
-			resp, err := runQuery(ctx, q, common.DbSharePlanUserEdgeTable)
+			resp, err := runQueryBind(ctx, q, bindVars, common.DbSharePlanUserEdgeTable)
 			if err != nil {
 				return nil, err
 			}
@@ -757,3 +813,33 @@ func AutocompleteTags(ctx context.Context, orgId, name string) ([]string, error)
 	}
 	return tags, nil
 }
+
+// SaveVersion snapshots a plan in its history after a write, it returns the latest version of the plan
+func SaveVersion(ctx context.Context, id, userId string) (int64, error) {
+	return common.SaveVersion(ctx, ClientWrapper.Db_client, common.DbPlanTable, id, userId)
+}
+
+// ListVersions returns the versions of a plan, the latest first
+func ListVersions(ctx context.Context, id, orgId string, offset, limit int64) ([]*static_proto.Version, error) {
+	return common.ListVersions(ctx, ClientWrapper.Db_client, common.DbPlanTable, id, orgId, offset, limit)
+}
+
+// ReadVersion returns a version of a plan and the plan as it was
+func ReadVersion(ctx context.Context, id, orgId string, version int64) (*static_proto.Version, *plan_proto.Plan, error) {
+	v, r, err := common.ReadVersion(ctx, ClientWrapper.Db_client, common.DbPlanTable, id, orgId, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan, err := recordToPlan(r)
+	return v, plan, err
+}
+
+// RevertToVersion writes a plan as it was at a version, it returns the new version and the plan
+func RevertToVersion(ctx context.Context, id, orgId, userId string, version int64) (*static_proto.Version, *plan_proto.Plan, error) {
+	v, r, err := common.RevertToVersion(ctx, ClientWrapper.Db_client, common.DbPlanTable, id, orgId, userId, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan, err := recordToPlan(r)
+	return v, plan, err
+}
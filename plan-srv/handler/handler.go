@@ -10,6 +10,7 @@ import (
 	"server/plan-srv/db"
 	plan_proto "server/plan-srv/proto/plan"
 	pubsub_proto "server/static-srv/proto/pubsub"
+	static_proto "server/static-srv/proto/static"
 	team_proto "server/team-srv/proto/team"
 	"strconv"
 
@@ -51,6 +52,10 @@ func (p *PlanService) Create(ctx context.Context, req *plan_proto.CreateRequest,
 	if err != nil {
 		return common.InternalServerError(common.PlanSrv, p.Create, err, "create error")
 	}
+	// the version is saved before the plan is shared, shares pin it
+	if _, err := db.SaveVersion(ctx, req.Plan.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.PlanSrv, p.Create, err, "version error")
+	}
 	// share plan with user
 	req_share := &plan_proto.SharePlanRequest{
 		Plans:  []*plan_proto.Plan{req.Plan},
@@ -327,6 +332,69 @@ func (p *PlanService) sendShareNotification(userids []string, message string, al
 
 func (p *PlanService) Update(ctx context.Context, req *plan_proto.UpdateRequest, rsp *plan_proto.UpdateResponse) error {
 	log.Print("Received Plan.Update request")
+	if req.Plan == nil || len(req.Plan.Id) == 0 {
+		return common.BadRequest(common.PlanSrv, p.Update, nil, "plan id error")
+	}
+	if len(req.Plan.OrgId) == 0 {
+		req.Plan.OrgId = req.OrgId
+	}
+	plan, err := db.Update(ctx, req.Plan, req.OrgId, req.TeamId)
+	switch {
+	case err == db.ErrNotFound:
+		return common.NotFound(common.PlanSrv, p.Update, err, "plan not found")
+	case common.IsConflict(err):
+		return common.Conflict(common.PlanSrv, p.Update, err, "plan was updated since its revision")
+	case err != nil:
+		return common.InternalServerError(common.PlanSrv, p.Update, err, "update error")
+	}
+	if _, err := db.SaveVersion(ctx, plan.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.PlanSrv, p.Update, err, "version error")
+	}
+	rsp.Data = &plan_proto.Data{plan}
+	return nil
+}
+
+func (p *PlanService) ListVersions(ctx context.Context, req *plan_proto.ListVersionsRequest, rsp *plan_proto.ListVersionsResponse) error {
+	log.Print("Received Plan.ListVersions request")
+	versions, err := db.ListVersions(ctx, req.Id, req.OrgId, req.Offset, req.Limit)
+	if err == common.ErrVersionOrg {
+		return common.BadRequest(common.PlanSrv, p.ListVersions, err, "organisation empty")
+	}
+	if err != nil {
+		return common.InternalServerError(common.PlanSrv, p.ListVersions, err, "versions error")
+	}
+	rsp.Data = &static_proto.VersionArrData{versions}
+	return nil
+}
 
+func (p *PlanService) ReadVersion(ctx context.Context, req *plan_proto.ReadVersionRequest, rsp *plan_proto.ReadVersionResponse) error {
+	log.Print("Received Plan.ReadVersion request")
+	version, plan, err := db.ReadVersion(ctx, req.Id, req.OrgId, req.Version)
+	if err == common.ErrVersionOrg {
+		return common.BadRequest(common.PlanSrv, p.ReadVersion, err, "organisation empty")
+	}
+	if err == common.ErrVersionNotFound {
+		return common.NotFound(common.PlanSrv, p.ReadVersion, err, "version not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.PlanSrv, p.ReadVersion, err, "version error")
+	}
+	rsp.Data = &plan_proto.VersionData{version, plan}
+	return nil
+}
+
+func (p *PlanService) RevertToVersion(ctx context.Context, req *plan_proto.RevertToVersionRequest, rsp *plan_proto.RevertToVersionResponse) error {
+	log.Print("Received Plan.RevertToVersion request")
+	version, plan, err := db.RevertToVersion(ctx, req.Id, req.OrgId, req.UserId, req.Version)
+	if err == common.ErrVersionOrg {
+		return common.BadRequest(common.PlanSrv, p.RevertToVersion, err, "organisation empty")
+	}
+	if err == common.ErrVersionNotFound {
+		return common.NotFound(common.PlanSrv, p.RevertToVersion, err, "version not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.PlanSrv, p.RevertToVersion, err, "revert error")
+	}
+	rsp.Data = &plan_proto.VersionData{version, plan}
 	return nil
 }
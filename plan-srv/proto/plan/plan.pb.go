@@ -59,6 +59,13 @@ It has these top-level messages:
 	AutocompleteTagsResponse
 	WarmupCacheRequest
 	WarmupCacheResponse
+	ListVersionsRequest
+	ListVersionsResponse
+	VersionData
+	ReadVersionRequest
+	ReadVersionResponse
+	RevertToVersionRequest
+	RevertToVersionResponse
 */
 package go_micro_srv_plan
 
@@ -1589,6 +1596,7 @@ type Plan struct {
 	Setting       *go_micro_srv_static.Setting              `protobuf:"bytes,22,opt,name=setting" json:"setting,omitempty"`
 	Tags          []string                                  `protobuf:"bytes,23,rep,name=tags" json:"tags,omitempty"`
 	Summary       string                                    `protobuf:"bytes,24,opt,name=summary" json:"summary,omitempty"`
+	Revision      string                                    `protobuf:"bytes,25,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *Plan) Reset()                    { *m = Plan{} }
@@ -1771,6 +1779,13 @@ func (m *Plan) GetSummary() string {
 	return ""
 }
 
+func (m *Plan) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type PlanItem struct {
 	Item *PlanItem_Item          `protobuf:"bytes,1,opt,name=item" json:"item,omitempty"`
 	Todo *go_micro_srv_todo.Todo `protobuf:"bytes,2,opt,name=todo" json:"todo,omitempty"`
@@ -1899,6 +1914,7 @@ type SharePlanUser struct {
 	Updated  int64                           `protobuf:"varint,5,opt,name=updated" json:"updated,omitempty"`
 	SharedBy *go_micro_srv_user.User         `protobuf:"bytes,6,opt,name=shared_by,json=sharedBy" json:"shared_by,omitempty"`
 	Created  int64                           `protobuf:"varint,7,opt,name=created" json:"created,omitempty"`
+	Version  int64                           `protobuf:"varint,8,opt,name=version" json:"version,omitempty"`
 }
 
 func (m *SharePlanUser) Reset()                    { *m = SharePlanUser{} }
@@ -1955,6 +1971,13 @@ func (m *SharePlanUser) GetCreated() int64 {
 	return 0
 }
 
+func (m *SharePlanUser) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type GetTopTagsRequest struct {
 	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	N     int64  `protobuf:"varint,2,opt,name=n" json:"n,omitempty"`
@@ -2117,6 +2140,265 @@ func (m *WarmupCacheResponse) String() string            { return proto.CompactT
 func (*WarmupCacheResponse) ProtoMessage()               {}
 func (*WarmupCacheResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
+// lists the versions of a record, the latest first
+type ListVersionsRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit  int64  `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListVersionsRequest) Reset()                    { *m = ListVersionsRequest{} }
+func (m *ListVersionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListVersionsRequest) ProtoMessage()               {}
+func (*ListVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *ListVersionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListVersionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListVersionsResponse struct {
+	Data    *go_micro_srv_static.VersionArrData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                               `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                              `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ListVersionsResponse) Reset()                    { *m = ListVersionsResponse{} }
+func (m *ListVersionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListVersionsResponse) ProtoMessage()               {}
+func (*ListVersionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *ListVersionsResponse) GetData() *go_micro_srv_static.VersionArrData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListVersionsResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListVersionsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// a record as it was at a version
+type VersionData struct {
+	Version *go_micro_srv_static.Version `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
+	Plan    *Plan                        `protobuf:"bytes,2,opt,name=plan" json:"plan,omitempty"`
+}
+
+func (m *VersionData) Reset()                    { *m = VersionData{} }
+func (m *VersionData) String() string            { return proto.CompactTextString(m) }
+func (*VersionData) ProtoMessage()               {}
+func (*VersionData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *VersionData) GetVersion() *go_micro_srv_static.Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *VersionData) GetPlan() *Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+type ReadVersionRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	OrgId   string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId  string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+}
+
+func (m *ReadVersionRequest) Reset()                    { *m = ReadVersionRequest{} }
+func (m *ReadVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadVersionRequest) ProtoMessage()               {}
+func (*ReadVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *ReadVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReadVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReadVersionRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ReadVersionRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type ReadVersionResponse struct {
+	Data    *VersionData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64        `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string       `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ReadVersionResponse) Reset()                    { *m = ReadVersionResponse{} }
+func (m *ReadVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadVersionResponse) ProtoMessage()               {}
+func (*ReadVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *ReadVersionResponse) GetData() *VersionData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadVersionResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReadVersionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// writes a record as it was at a version, it's saved as a new version
+type RevertToVersionRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	OrgId   string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId  string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId  string `protobuf:"bytes,5,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+}
+
+func (m *RevertToVersionRequest) Reset()                    { *m = RevertToVersionRequest{} }
+func (m *RevertToVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevertToVersionRequest) ProtoMessage()               {}
+func (*RevertToVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *RevertToVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RevertToVersionRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type RevertToVersionResponse struct {
+	Data    *VersionData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64        `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string       `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RevertToVersionResponse) Reset()                    { *m = RevertToVersionResponse{} }
+func (m *RevertToVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevertToVersionResponse) ProtoMessage()               {}
+func (*RevertToVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *RevertToVersionResponse) GetData() *VersionData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RevertToVersionResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RevertToVersionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Data)(nil), "go.micro.srv.plan.Data")
 	proto.RegisterType((*ArrData)(nil), "go.micro.srv.plan.ArrData")
@@ -2174,6 +2456,13 @@ func init() {
 	proto.RegisterType((*AutocompleteTagsResponse_Data)(nil), "go.micro.srv.plan.AutocompleteTagsResponse.Data")
 	proto.RegisterType((*WarmupCacheRequest)(nil), "go.micro.srv.plan.WarmupCacheRequest")
 	proto.RegisterType((*WarmupCacheResponse)(nil), "go.micro.srv.plan.WarmupCacheResponse")
+	proto.RegisterType((*ListVersionsRequest)(nil), "go.micro.srv.plan.ListVersionsRequest")
+	proto.RegisterType((*ListVersionsResponse)(nil), "go.micro.srv.plan.ListVersionsResponse")
+	proto.RegisterType((*VersionData)(nil), "go.micro.srv.plan.VersionData")
+	proto.RegisterType((*ReadVersionRequest)(nil), "go.micro.srv.plan.ReadVersionRequest")
+	proto.RegisterType((*ReadVersionResponse)(nil), "go.micro.srv.plan.ReadVersionResponse")
+	proto.RegisterType((*RevertToVersionRequest)(nil), "go.micro.srv.plan.RevertToVersionRequest")
+	proto.RegisterType((*RevertToVersionResponse)(nil), "go.micro.srv.plan.RevertToVersionResponse")
 	proto.RegisterEnum("go.micro.srv.plan.StatusEnum", StatusEnum_name, StatusEnum_value)
 }
 
@@ -2207,6 +2496,9 @@ type PlanServiceClient interface {
 	GetTopTags(ctx context.Context, in *GetTopTagsRequest, opts ...client.CallOption) (*GetTopTagsResponse, error)
 	AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...client.CallOption) (*AutocompleteTagsResponse, error)
 	WarmupCache(ctx context.Context, in *WarmupCacheRequest, opts ...client.CallOption) (*WarmupCacheResponse, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error)
+	ReadVersion(ctx context.Context, in *ReadVersionRequest, opts ...client.CallOption) (*ReadVersionResponse, error)
+	RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...client.CallOption) (*RevertToVersionResponse, error)
 }
 
 type planServiceClient struct {
@@ -2447,6 +2739,36 @@ func (c *planServiceClient) WarmupCache(ctx context.Context, in *WarmupCacheRequ
 	return out, nil
 }
 
+func (c *planServiceClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "PlanService.ListVersions", in)
+	out := new(ListVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *planServiceClient) ReadVersion(ctx context.Context, in *ReadVersionRequest, opts ...client.CallOption) (*ReadVersionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "PlanService.ReadVersion", in)
+	out := new(ReadVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *planServiceClient) RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...client.CallOption) (*RevertToVersionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "PlanService.RevertToVersion", in)
+	out := new(RevertToVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PlanService service
 
 type PlanServiceHandler interface {
@@ -2472,6 +2794,9 @@ type PlanServiceHandler interface {
 	GetTopTags(context.Context, *GetTopTagsRequest, *GetTopTagsResponse) error
 	AutocompleteTags(context.Context, *AutocompleteTagsRequest, *AutocompleteTagsResponse) error
 	WarmupCache(context.Context, *WarmupCacheRequest, *WarmupCacheResponse) error
+	ListVersions(context.Context, *ListVersionsRequest, *ListVersionsResponse) error
+	ReadVersion(context.Context, *ReadVersionRequest, *ReadVersionResponse) error
+	RevertToVersion(context.Context, *RevertToVersionRequest, *RevertToVersionResponse) error
 }
 
 func RegisterPlanServiceHandler(s server.Server, hdlr PlanServiceHandler, opts ...server.HandlerOption) {
@@ -2570,142 +2895,165 @@ func (h *PlanService) WarmupCache(ctx context.Context, in *WarmupCacheRequest, o
 	return h.PlanServiceHandler.WarmupCache(ctx, in, out)
 }
 
+func (h *PlanService) ListVersions(ctx context.Context, in *ListVersionsRequest, out *ListVersionsResponse) error {
+	return h.PlanServiceHandler.ListVersions(ctx, in, out)
+}
+
+func (h *PlanService) ReadVersion(ctx context.Context, in *ReadVersionRequest, out *ReadVersionResponse) error {
+	return h.PlanServiceHandler.ReadVersion(ctx, in, out)
+}
+
+func (h *PlanService) RevertToVersion(ctx context.Context, in *RevertToVersionRequest, out *RevertToVersionResponse) error {
+	return h.PlanServiceHandler.RevertToVersion(ctx, in, out)
+}
+
 func init() { proto.RegisterFile("server/plan-srv/proto/plan/plan.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2130 bytes of a gzipped FileDescriptorProto
+	// 2311 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4f, 0x6f, 0x1c, 0x49,
-	0x15, 0x77, 0xcf, 0xf4, 0x78, 0xec, 0xe7, 0x78, 0x32, 0x2e, 0x27, 0x71, 0x67, 0xb4, 0x9b, 0x38,
-	0x0d, 0x81, 0xec, 0x9a, 0x4c, 0x20, 0xc9, 0x42, 0x00, 0xb1, 0x2b, 0x27, 0x93, 0x2c, 0x96, 0xd8,
-	0x24, 0x6a, 0xdb, 0x80, 0x84, 0xb4, 0x56, 0xa5, 0xbb, 0x3c, 0x69, 0xa5, 0xff, 0xcc, 0x56, 0xf5,
-	0x58, 0xcc, 0x69, 0x4f, 0xdc, 0xf8, 0x0a, 0xf0, 0x01, 0x38, 0x70, 0xe2, 0x00, 0x42, 0xf0, 0x31,
-	0xe0, 0xcc, 0x47, 0x40, 0x9c, 0xe0, 0x84, 0xea, 0x4f, 0xf7, 0x54, 0x4f, 0xd7, 0xfc, 0x71, 0x56,
-	0x1e, 0x6d, 0x2e, 0xe3, 0xae, 0xea, 0x57, 0xd5, 0xbf, 0xf7, 0xab, 0xf7, 0x5e, 0xbd, 0x7a, 0x65,
-	0xb8, 0xcd, 0x08, 0x3d, 0x23, 0xf4, 0xde, 0x20, 0xc2, 0xc9, 0x5d, 0x46, 0xcf, 0xee, 0x0d, 0x68,
-	0x9a, 0xa5, 0xa2, 0x29, 0x7e, 0xba, 0xa2, 0x8d, 0xb6, 0xfa, 0x69, 0x37, 0x0e, 0x7d, 0x9a, 0x76,
-	0x19, 0x3d, 0xeb, 0xf2, 0x17, 0x9d, 0x7c, 0xe4, 0x90, 0x11, 0xaa, 0x8d, 0xe4, 0x4d, 0xf1, 0x23,
-	0x47, 0x16, 0x62, 0x59, 0x1a, 0xa4, 0x9a, 0x18, 0x6f, 0x8a, 0x1f, 0x25, 0xf6, 0x50, 0x89, 0xbd,
-	0x22, 0xaf, 0xf1, 0x59, 0x98, 0x0e, 0xf5, 0x29, 0x8b, 0xbe, 0xf1, 0x93, 0x1a, 0xb5, 0xa7, 0x46,
-	0xb1, 0x0c, 0x67, 0xa1, 0xaf, 0x0d, 0x91, 0x1d, 0xea, 0xcf, 0x3c, 0x61, 0x3f, 0x8d, 0xe3, 0x34,
-	0x51, 0x7f, 0xa4, 0xb0, 0xfb, 0x00, 0xec, 0x1e, 0xce, 0x30, 0xda, 0x03, 0x9b, 0x6b, 0xeb, 0x58,
-	0xbb, 0xd6, 0x9d, 0x8d, 0xfb, 0x3b, 0xdd, 0x0a, 0x0f, 0xdd, 0x97, 0x11, 0x4e, 0x3c, 0x21, 0xe4,
-	0x3e, 0x82, 0xe6, 0x3e, 0xa5, 0x62, 0xdc, 0x5d, 0x68, 0xf0, 0x2e, 0xe6, 0x58, 0xbb, 0xf5, 0x59,
-	0x03, 0xa5, 0x94, 0xfb, 0x67, 0x0b, 0x60, 0x3f, 0x8a, 0x3c, 0xf2, 0xc5, 0x90, 0xb0, 0x0c, 0x5d,
-	0x85, 0xd5, 0x94, 0xf6, 0x4f, 0xc2, 0x40, 0x7c, 0x77, 0xdd, 0x6b, 0xa4, 0xb4, 0x7f, 0x10, 0xa0,
-	0x1d, 0x68, 0x66, 0x04, 0xc7, 0xbc, 0xbf, 0x26, 0xfa, 0x57, 0x79, 0xf3, 0x20, 0x40, 0x57, 0xa0,
-	0x11, 0x85, 0x71, 0x98, 0x39, 0xf5, 0x5d, 0xeb, 0x4e, 0xdd, 0x93, 0x0d, 0x74, 0x0d, 0x56, 0xd3,
-	0xd3, 0x53, 0x46, 0x32, 0xc7, 0x16, 0xdd, 0xaa, 0x85, 0x6e, 0x43, 0x8b, 0xa5, 0x34, 0x3b, 0x19,
-	0x60, 0x8a, 0x63, 0x92, 0x11, 0xea, 0x34, 0xc4, 0x6c, 0x9b, 0xbc, 0xf7, 0x65, 0xde, 0x59, 0x88,
-	0x05, 0x21, 0x25, 0x7e, 0x16, 0xa6, 0x89, 0xb3, 0x3a, 0x16, 0xeb, 0xe5, 0x9d, 0xee, 0x1b, 0xd8,
-	0x10, 0xc8, 0xd9, 0x20, 0x4d, 0x18, 0x41, 0x5d, 0xb0, 0x03, 0x9c, 0x61, 0x45, 0x58, 0xc7, 0xa0,
-	0xb7, 0xa2, 0xc8, 0x13, 0x72, 0x08, 0x81, 0xed, 0xa7, 0x01, 0x11, 0x0a, 0xd5, 0x3d, 0xf1, 0x8c,
-	0x1c, 0x68, 0xc6, 0x84, 0x31, 0xdc, 0x27, 0x42, 0xa1, 0x75, 0x2f, 0x6f, 0xba, 0xbf, 0xb1, 0x60,
-	0xf3, 0x09, 0x25, 0x38, 0x23, 0x39, 0x55, 0xe7, 0x59, 0x20, 0x4e, 0x20, 0x37, 0x4d, 0x8d, 0x40,
-	0xde, 0x3c, 0x08, 0x34, 0xc2, 0xeb, 0x53, 0x08, 0xb7, 0x75, 0xc2, 0xdd, 0x37, 0xd0, 0xca, 0x61,
-	0x28, 0xbd, 0xf7, 0x4a, 0x7a, 0x9b, 0x70, 0x7c, 0x25, 0xa5, 0x8f, 0x07, 0xc1, 0xd7, 0x41, 0xe9,
-	0x1c, 0xc6, 0xc5, 0x2b, 0xfd, 0x19, 0x6c, 0x78, 0x04, 0x07, 0xb9, 0xc6, 0x2d, 0xa8, 0x15, 0xde,
-	0x50, 0x0b, 0x75, 0xec, 0xb5, 0x29, 0xd8, 0xeb, 0x25, 0xec, 0x21, 0x5c, 0x92, 0xd3, 0x5d, 0x3c,
-	0xf2, 0x17, 0xb0, 0xd9, 0x23, 0x11, 0x19, 0xaf, 0xd6, 0x57, 0xc5, 0xfe, 0x31, 0xb4, 0xf2, 0x09,
-	0x15, 0xfa, 0xf3, 0x01, 0xfa, 0x8f, 0x05, 0x9b, 0x87, 0x04, 0x53, 0xff, 0x75, 0x8e, 0x08, 0x81,
-	0x9d, 0xe0, 0x98, 0x28, 0x4c, 0xe2, 0xf9, 0xbc, 0xa8, 0xc6, 0x31, 0xc7, 0x36, 0xc7, 0x9c, 0x46,
-	0x29, 0xe6, 0x20, 0xb0, 0x4f, 0x69, 0x1a, 0x8b, 0x10, 0x52, 0xf7, 0xc4, 0x33, 0xe7, 0x25, 0x4b,
-	0x9d, 0xa6, 0xe8, 0xa9, 0x65, 0xa9, 0x21, 0x2e, 0xad, 0x2d, 0x16, 0x97, 0xd6, 0x4d, 0x71, 0x29,
-	0x81, 0x56, 0xae, 0xf4, 0x52, 0x42, 0xd3, 0xdf, 0x2d, 0x68, 0x1f, 0x91, 0x78, 0x10, 0xe1, 0x8c,
-	0xb0, 0x77, 0x31, 0x90, 0x7f, 0x01, 0x5b, 0x1a, 0xfe, 0xa5, 0x70, 0xf6, 0x57, 0x0b, 0x36, 0x7b,
-	0x14, 0x9f, 0x66, 0xef, 0x24, 0x61, 0x09, 0xb4, 0x72, 0xf0, 0x4b, 0x61, 0xeb, 0x9f, 0x16, 0xb4,
-	0x1f, 0x8f, 0xc4, 0xbe, 0x93, 0xd2, 0x9c, 0x30, 0x2d, 0xba, 0x5b, 0x53, 0xa2, 0xfb, 0xc6, 0x14,
-	0x26, 0x2f, 0x99, 0x99, 0xdc, 0x34, 0x33, 0xd9, 0xba, 0x58, 0xd3, 0xd3, 0x14, 0x5b, 0x0a, 0x99,
-	0xbf, 0xb5, 0xa0, 0xf5, 0x2c, 0x8c, 0x32, 0x42, 0x0b, 0xdb, 0x2b, 0x18, 0xb0, 0xcc, 0x0c, 0xd4,
-	0xe6, 0x30, 0x50, 0x5f, 0x8c, 0x01, 0xdb, 0xc4, 0xc0, 0xdf, 0x2c, 0xb8, 0x5c, 0xc0, 0x51, 0x04,
-	0xfc, 0xb8, 0x44, 0xc0, 0xb7, 0x0d, 0x04, 0x4c, 0x8c, 0x78, 0xeb, 0x3d, 0xab, 0xf3, 0x89, 0x4a,
-	0x77, 0x7f, 0x00, 0xcd, 0x53, 0x39, 0xa7, 0x4a, 0x5c, 0xdf, 0x9f, 0x92, 0x5b, 0xc8, 0x2f, 0x7b,
-	0xb9, 0xb4, 0xbb, 0x0d, 0x5b, 0x47, 0xe9, 0xa0, 0x4c, 0xa8, 0x4b, 0x01, 0xe9, 0x9d, 0x4b, 0x59,
-	0xd7, 0xff, 0x59, 0x80, 0x8e, 0xc2, 0x98, 0x4c, 0xac, 0xed, 0xfb, 0x00, 0x2c, 0xc3, 0x7c, 0x1d,
-	0x70, 0x46, 0xd4, 0x02, 0xaf, 0x8b, 0x9e, 0x1e, 0xce, 0x08, 0xba, 0x0e, 0x6b, 0x24, 0x09, 0xe4,
-	0x4b, 0xf9, 0x9d, 0x26, 0x49, 0x02, 0xf1, 0xea, 0x9c, 0x59, 0xd2, 0xd8, 0x8a, 0x1a, 0x66, 0x2b,
-	0x5a, 0x9d, 0x63, 0x45, 0xcd, 0xc5, 0xac, 0x68, 0xcd, 0x64, 0x45, 0x0c, 0xb6, 0x4b, 0xba, 0x2f,
-	0x85, 0xf1, 0x6d, 0xd8, 0x3a, 0x66, 0xa4, 0xba, 0xf4, 0x7a, 0xe7, 0x52, 0x80, 0xec, 0xc0, 0xd5,
-	0xc3, 0xa1, 0xef, 0x13, 0xc6, 0x26, 0xc0, 0x9c, 0xc1, 0xb5, 0xc9, 0x17, 0x4b, 0x01, 0x74, 0x1d,
-	0x76, 0x9e, 0xa4, 0x49, 0x10, 0xf2, 0xb5, 0x99, 0x80, 0xf4, 0x6b, 0x70, 0xaa, 0xaf, 0x96, 0x02,
-	0xea, 0x1f, 0x16, 0xa0, 0x4f, 0x53, 0x1c, 0x55, 0x83, 0x5f, 0x3f, 0xc5, 0x11, 0xcb, 0xf7, 0x5d,
-	0xd1, 0x78, 0xa7, 0x36, 0x11, 0x06, 0xdb, 0x25, 0xbd, 0x96, 0xc2, 0xe6, 0x1f, 0x2d, 0xd8, 0x91,
-	0x27, 0x41, 0x2d, 0x2a, 0x2a, 0x4a, 0x3f, 0x82, 0x55, 0x19, 0x1e, 0xd5, 0xb7, 0xe7, 0xc4, 0x52,
-	0x25, 0x7c, 0xb1, 0x9c, 0xbb, 0x5f, 0x82, 0x53, 0xc5, 0xab, 0xa8, 0x7a, 0x4b, 0xc0, 0xe7, 0x63,
-	0xec, 0x2f, 0x16, 0xb4, 0x0f, 0x5f, 0x63, 0x2a, 0x00, 0xe4, 0x54, 0x9d, 0xaf, 0x5c, 0xc2, 0xc5,
-	0x79, 0x96, 0xc3, 0x9c, 0x9a, 0x49, 0x5c, 0x54, 0x9f, 0x8e, 0x19, 0xa1, 0x9e, 0x94, 0xd2, 0x73,
-	0xa4, 0xfa, 0x94, 0x1c, 0xc9, 0x9e, 0x42, 0x75, 0xa3, 0x74, 0x12, 0xdb, 0x87, 0x2d, 0x0d, 0xfa,
-	0xc4, 0x61, 0xcc, 0x32, 0xab, 0x5f, 0x2b, 0xab, 0xff, 0x25, 0x5c, 0xdf, 0x1f, 0x66, 0xa9, 0x9f,
-	0xc6, 0x03, 0x7e, 0xa4, 0x2b, 0x9f, 0xcb, 0xae, 0x40, 0x23, 0x0b, 0xb3, 0x28, 0x3f, 0x98, 0xc9,
-	0x86, 0xc1, 0x4d, 0x6a, 0x8b, 0xb9, 0x49, 0xdd, 0xe4, 0x26, 0xff, 0xb2, 0xa0, 0x63, 0x42, 0xa0,
-	0xb4, 0x79, 0x56, 0x72, 0x97, 0xfb, 0x26, 0x77, 0x99, 0x3a, 0xf8, 0xed, 0xf3, 0x8f, 0xcf, 0x54,
-	0xfe, 0xf1, 0x14, 0xd6, 0xa8, 0x9a, 0x4c, 0x99, 0xc2, 0x07, 0x65, 0x04, 0xaa, 0xa2, 0xa7, 0x63,
-	0xc8, 0xbf, 0xee, 0x15, 0x43, 0xdd, 0x7f, 0x37, 0xc1, 0xe6, 0x6b, 0x54, 0x39, 0x7a, 0xe7, 0x07,
-	0xdf, 0x9a, 0xf1, 0xe0, 0x7b, 0x43, 0x37, 0x82, 0x36, 0xd4, 0x07, 0xa1, 0xaf, 0x80, 0xf2, 0x47,
-	0xb4, 0x0b, 0x1b, 0x01, 0x61, 0x3e, 0x0d, 0x07, 0x5a, 0x1e, 0xa7, 0x77, 0x71, 0x05, 0x7d, 0xe1,
-	0x5c, 0x81, 0xda, 0xfd, 0xf3, 0x26, 0x7f, 0x33, 0x14, 0xb5, 0x93, 0x40, 0x25, 0x00, 0x79, 0x73,
-	0x6c, 0xcb, 0xcd, 0x85, 0x6c, 0xf9, 0x7e, 0x1e, 0xa7, 0xd7, 0x84, 0xf8, 0x7b, 0x65, 0xf1, 0x71,
-	0x81, 0x94, 0x87, 0xc2, 0x3c, 0x8a, 0x77, 0x60, 0x2d, 0x18, 0x52, 0xac, 0x9d, 0x95, 0x8b, 0x36,
-	0x37, 0x39, 0x91, 0x06, 0x39, 0x20, 0xa3, 0x87, 0x68, 0x70, 0xe5, 0x49, 0x22, 0x03, 0x50, 0xdd,
-	0xe3, 0x8f, 0xa8, 0x0b, 0x88, 0x24, 0x01, 0xcf, 0x2e, 0x8e, 0x13, 0x36, 0x20, 0x7e, 0x78, 0x1a,
-	0x12, 0x19, 0x89, 0xd6, 0x3c, 0xc3, 0x1b, 0xf4, 0x09, 0x00, 0x25, 0xfe, 0x90, 0x52, 0x92, 0xf8,
-	0xc4, 0xd9, 0x14, 0x60, 0x6f, 0x1a, 0xd7, 0xd2, 0x2b, 0xc4, 0x3c, 0x6d, 0x08, 0xfa, 0x9e, 0xe2,
-	0x32, 0xa5, 0x22, 0x82, 0xcd, 0x60, 0x26, 0x97, 0x43, 0x3f, 0x81, 0x4d, 0x3f, 0x8d, 0x22, 0xfc,
-	0x2a, 0xa5, 0xbc, 0xcd, 0x9c, 0xcb, 0xb3, 0x29, 0x2d, 0x4b, 0xa3, 0x7b, 0xb0, 0xca, 0xb8, 0x77,
-	0x33, 0xa7, 0x3d, 0x7b, 0x9c, 0x12, 0x43, 0x1f, 0x71, 0x5f, 0x19, 0x31, 0x67, 0x4b, 0x88, 0xdf,
-	0x9a, 0x12, 0xb4, 0xba, 0x3d, 0x3c, 0x62, 0x4f, 0x93, 0x8c, 0x8e, 0x3c, 0x21, 0x8e, 0x6e, 0xc2,
-	0x46, 0x98, 0x91, 0x98, 0x9d, 0xf8, 0xe9, 0x30, 0xc9, 0x1c, 0x24, 0x48, 0x06, 0xd1, 0xf5, 0x84,
-	0xf7, 0xa0, 0x1b, 0x00, 0x21, 0xcb, 0xcf, 0xe2, 0xce, 0xb6, 0xe0, 0x58, 0xeb, 0xe1, 0xef, 0x33,
-	0xf5, 0x7c, 0x10, 0x38, 0x57, 0xc4, 0x8a, 0x6a, 0x3d, 0x3c, 0x8e, 0x73, 0x6e, 0x87, 0xcc, 0xb9,
-	0xba, 0x6b, 0xdd, 0x69, 0x19, 0xe3, 0xf8, 0xa1, 0x10, 0x78, 0x9a, 0x0c, 0x63, 0x4f, 0x09, 0xa3,
-	0xef, 0x43, 0x93, 0x91, 0x2c, 0x0b, 0x93, 0xbe, 0x73, 0x4d, 0x30, 0xfe, 0x9e, 0x71, 0xbd, 0x0e,
-	0xa5, 0x8c, 0x97, 0x0b, 0x73, 0xa7, 0xca, 0x70, 0x9f, 0x39, 0x3b, 0xbb, 0x75, 0xee, 0x54, 0xfc,
-	0x99, 0xdb, 0x3b, 0x1b, 0xc6, 0x31, 0xa6, 0x23, 0xc7, 0x91, 0xae, 0xae, 0x9a, 0x9d, 0x9f, 0xc3,
-	0x7a, 0x41, 0x08, 0xb7, 0xb3, 0x37, 0x64, 0xa4, 0x1c, 0x94, 0x3f, 0xa2, 0x07, 0xd0, 0x38, 0xc3,
-	0xd1, 0x50, 0xba, 0x68, 0x65, 0x0b, 0x52, 0x10, 0x7a, 0x78, 0x74, 0xc0, 0xe9, 0xf2, 0xa4, 0xec,
-	0x8f, 0x6a, 0x8f, 0x2c, 0xf7, 0x4f, 0x16, 0xac, 0x71, 0xba, 0xf9, 0x0b, 0xf4, 0x10, 0x6c, 0xce,
-	0xa7, 0x8a, 0x62, 0xbb, 0x53, 0x56, 0x86, 0x8b, 0x76, 0xf9, 0x8f, 0x27, 0xa4, 0xd1, 0x1e, 0xd8,
-	0x59, 0x1a, 0xa4, 0xea, 0xd3, 0x13, 0xcb, 0x2f, 0x2e, 0x2b, 0x8e, 0xd2, 0x20, 0xf5, 0x84, 0x50,
-	0xe7, 0x29, 0xd8, 0xe2, 0x53, 0x93, 0x21, 0xa6, 0x88, 0xe1, 0x35, 0x3d, 0x86, 0xef, 0x40, 0x73,
-	0x10, 0xfa, 0x27, 0x43, 0x1a, 0xe5, 0x5b, 0xd0, 0x20, 0xf4, 0x8f, 0x69, 0xe4, 0x7e, 0x0e, 0x36,
-	0xb7, 0xa9, 0x85, 0x22, 0x95, 0x16, 0x5e, 0xea, 0x53, 0xc3, 0x8b, 0x5d, 0x0a, 0x2f, 0xee, 0x73,
-	0x80, 0xf1, 0x96, 0x2d, 0x42, 0x58, 0xc8, 0x06, 0x11, 0x1e, 0x3d, 0x1f, 0xd7, 0xff, 0xf4, 0x2e,
-	0x6e, 0x5b, 0x72, 0x5b, 0x3f, 0x8c, 0x86, 0x7d, 0xf5, 0x75, 0xad, 0xc7, 0xfd, 0x5d, 0x0d, 0x36,
-	0x8b, 0x3d, 0xd0, 0x88, 0x3c, 0x2f, 0x4e, 0xd7, 0x16, 0x29, 0x4e, 0xef, 0x81, 0xcd, 0xfd, 0x4a,
-	0xe8, 0x33, 0xc3, 0xe3, 0x84, 0x10, 0x7a, 0x54, 0xd8, 0xb5, 0x2d, 0xec, 0x7a, 0xd7, 0x6c, 0x9f,
-	0x1c, 0x9d, 0x34, 0xef, 0xc2, 0xb4, 0x35, 0x7e, 0x1a, 0xe5, 0xf0, 0xfb, 0x10, 0xd6, 0x85, 0x37,
-	0x07, 0x27, 0xaf, 0x46, 0x22, 0x34, 0xcf, 0x40, 0xb1, 0x26, 0x25, 0x1f, 0x8f, 0xf4, 0x95, 0x68,
-	0x96, 0x56, 0xc2, 0x7d, 0x04, 0x5b, 0x9f, 0x92, 0xec, 0x28, 0x1d, 0x1c, 0xe1, 0xfe, 0xbc, 0xaa,
-	0xd6, 0x25, 0xb0, 0x12, 0xb5, 0x41, 0x5a, 0x89, 0xfb, 0x7b, 0x9e, 0x98, 0x6b, 0x43, 0xd5, 0x86,
-	0xfc, 0x71, 0x69, 0x43, 0xfe, 0xd0, 0x40, 0x67, 0x75, 0xd0, 0xdb, 0x6f, 0xc4, 0x1d, 0xb5, 0x11,
-	0xe7, 0x3e, 0x6d, 0x8d, 0x7d, 0xda, 0xed, 0xc1, 0x8e, 0xbe, 0xef, 0x2e, 0xa0, 0xa0, 0xc1, 0x88,
-	0xdd, 0x3f, 0x58, 0xe0, 0x54, 0xa7, 0x51, 0xca, 0xf6, 0x4a, 0xca, 0x7e, 0x77, 0x4e, 0xf6, 0x71,
-	0xf1, 0x2a, 0x5f, 0x01, 0xf4, 0x0b, 0x4c, 0xe3, 0xe1, 0xe0, 0x09, 0xf6, 0x5f, 0xe7, 0x05, 0x7d,
-	0xf7, 0x2a, 0x6c, 0x97, 0x7a, 0x25, 0x82, 0x0f, 0x7f, 0x08, 0x30, 0x8e, 0xaa, 0x68, 0x1b, 0x2e,
-	0x8f, 0x5b, 0x27, 0xcf, 0x5f, 0x3c, 0x7f, 0xda, 0x5e, 0x41, 0x1b, 0xd0, 0x7c, 0x79, 0xfc, 0xf8,
-	0x67, 0x07, 0x87, 0x3f, 0x6d, 0x5b, 0x68, 0x1d, 0x1a, 0x3d, 0x6f, 0xff, 0xd9, 0x51, 0xbb, 0x76,
-	0xff, 0xbf, 0x97, 0x61, 0x83, 0x7b, 0xc5, 0x21, 0xa1, 0x67, 0xa1, 0xcf, 0xb3, 0xb0, 0xfa, 0x7e,
-	0x14, 0x21, 0x53, 0xe0, 0x1e, 0x5f, 0x13, 0x76, 0x6e, 0x4c, 0x7b, 0xad, 0xd2, 0xa0, 0x15, 0xf4,
-	0x02, 0x56, 0x65, 0xb6, 0x8f, 0x4c, 0x31, 0xb0, 0x74, 0x93, 0xd6, 0xb9, 0x35, 0x43, 0x42, 0x9f,
-	0x50, 0xde, 0x01, 0x19, 0x27, 0x2c, 0xdd, 0x52, 0x19, 0x27, 0x2c, 0x5f, 0x20, 0xb9, 0x2b, 0xe8,
-	0x00, 0x6c, 0x8f, 0xe0, 0x00, 0x99, 0x74, 0xd1, 0x2e, 0x80, 0x3a, 0x37, 0xa7, 0xbe, 0xd7, 0xb1,
-	0xc9, 0x7b, 0x12, 0x23, 0xb6, 0xd2, 0x9d, 0x8c, 0x11, 0x5b, 0xf9, 0x92, 0x45, 0x4e, 0x28, 0x13,
-	0x5c, 0xe3, 0x84, 0xa5, 0xd4, 0xdd, 0x38, 0x61, 0x39, 0x3b, 0x76, 0x57, 0xd0, 0x2f, 0x61, 0xbd,
-	0x28, 0xb1, 0xa3, 0x6f, 0x18, 0x46, 0x4c, 0x5e, 0x20, 0x74, 0xbe, 0x39, 0x5b, 0xa8, 0xa4, 0xbb,
-	0xa8, 0x45, 0x9b, 0x75, 0xd7, 0x6b, 0xec, 0x66, 0xdd, 0x4b, 0x85, 0x6c, 0x09, 0xb5, 0x28, 0xc9,
-	0x1a, 0xa1, 0x4e, 0x56, 0xa2, 0x8d, 0x50, 0x2b, 0x55, 0x5d, 0x77, 0x05, 0x79, 0xd0, 0x54, 0x67,
-	0x74, 0x74, 0x6b, 0x56, 0x4d, 0x53, 0xce, 0xea, 0xce, 0x2f, 0x7b, 0xba, 0x2b, 0xe8, 0x57, 0x00,
-	0xe3, 0x4a, 0x23, 0x32, 0x92, 0x36, 0x59, 0x9d, 0xec, 0xdc, 0x9e, 0x23, 0x55, 0x4c, 0xfe, 0x39,
-	0x6c, 0x68, 0x55, 0x35, 0x64, 0x1c, 0x57, 0xa9, 0x38, 0x76, 0xbe, 0x35, 0x4f, 0x4c, 0x07, 0x3f,
-	0xae, 0x95, 0x19, 0xc1, 0x57, 0xea, 0x6b, 0x46, 0xf0, 0xd5, 0x82, 0x9b, 0xbb, 0x82, 0xfa, 0xd0,
-	0x2a, 0xd7, 0xbe, 0xd0, 0x1d, 0x93, 0xa5, 0x9a, 0xea, 0x66, 0x9d, 0x0f, 0x16, 0x90, 0x2c, 0x3e,
-	0x14, 0x43, 0x7b, 0xb2, 0xa2, 0x85, 0x4c, 0xbb, 0xd5, 0x94, 0x8a, 0x58, 0x67, 0x6f, 0x21, 0x59,
-	0x7d, 0x51, 0xb4, 0x6a, 0x8f, 0x71, 0x51, 0xaa, 0x55, 0x2e, 0xe3, 0xa2, 0x18, 0x8a, 0x46, 0x4a,
-	0x9d, 0x89, 0x3a, 0x89, 0x59, 0x1d, 0x73, 0xf1, 0xc7, 0xac, 0xce, 0x94, 0xc2, 0x8b, 0x74, 0xb7,
-	0x22, 0xab, 0x32, 0xba, 0xdb, 0x64, 0xc9, 0xc4, 0xe8, 0x6e, 0x95, 0xe2, 0x84, 0xbb, 0x82, 0x18,
-	0xa0, 0xea, 0x89, 0x1d, 0x7d, 0x67, 0xc1, 0x83, 0xbd, 0xfc, 0xd6, 0xdd, 0x73, 0x95, 0x01, 0xa4,
-	0x49, 0x8f, 0xb3, 0x12, 0xa3, 0x49, 0x57, 0x92, 0x24, 0xa3, 0x49, 0x57, 0x53, 0x1b, 0xb9, 0x34,
-	0x93, 0x59, 0x80, 0x71, 0x69, 0xa6, 0x24, 0x2b, 0xc6, 0xa5, 0x99, 0x96, 0x56, 0x48, 0x4b, 0xd3,
-	0x76, 0x7b, 0xa3, 0xa5, 0x55, 0x73, 0x04, 0xa3, 0xa5, 0x19, 0x92, 0x06, 0x77, 0xe5, 0xd5, 0xaa,
-	0xf8, 0x8f, 0xa3, 0x07, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x66, 0xa5, 0xa8, 0x1d, 0x8b, 0x25,
-	0x00, 0x00,
+	0x15, 0xdf, 0x9e, 0xff, 0x7e, 0x13, 0x4f, 0xec, 0xb2, 0x13, 0x77, 0x5a, 0x6c, 0x62, 0xf7, 0xc6,
+	0xd9, 0x24, 0x26, 0x13, 0x70, 0xb2, 0xbb, 0x01, 0xc4, 0xae, 0x9c, 0x8c, 0xb3, 0x58, 0xda, 0x4d,
+	0xa2, 0xb6, 0xbd, 0x20, 0x21, 0xad, 0xd5, 0xe9, 0x2e, 0x4f, 0x9a, 0xf4, 0x74, 0xcf, 0x56, 0xd5,
+	0x0c, 0x8c, 0x10, 0xda, 0x03, 0xe2, 0x04, 0x7c, 0x05, 0xce, 0x88, 0x03, 0x27, 0x24, 0x40, 0x08,
+	0x3e, 0x06, 0x9c, 0xf9, 0x0c, 0xdc, 0x38, 0xa1, 0xaa, 0xae, 0xee, 0xa9, 0x9e, 0xa9, 0xf9, 0x63,
+	0xef, 0x7a, 0x44, 0x2e, 0xe3, 0xae, 0xea, 0x5f, 0x55, 0xbf, 0xf7, 0xab, 0xf7, 0x5e, 0xbd, 0x7a,
+	0x65, 0xd8, 0xa6, 0x98, 0xf4, 0x31, 0xb9, 0xdf, 0x0d, 0xdd, 0xe8, 0x1e, 0x25, 0xfd, 0xfb, 0x5d,
+	0x12, 0xb3, 0x58, 0x34, 0xc5, 0x4f, 0x53, 0xb4, 0xd1, 0x6a, 0x3b, 0x6e, 0x76, 0x02, 0x8f, 0xc4,
+	0x4d, 0x4a, 0xfa, 0x4d, 0xfe, 0xc2, 0x4a, 0x47, 0xf6, 0x28, 0x26, 0xca, 0x48, 0xde, 0x14, 0x3f,
+	0xc9, 0xc8, 0x0c, 0xc6, 0x62, 0x3f, 0x56, 0x60, 0xbc, 0x29, 0x7e, 0x24, 0xec, 0xa1, 0x84, 0xbd,
+	0xc4, 0xaf, 0xdc, 0x7e, 0x10, 0xf7, 0xd4, 0x29, 0xb3, 0xbe, 0xe1, 0x93, 0x1c, 0xb5, 0x23, 0x47,
+	0x51, 0xe6, 0xb2, 0xc0, 0x53, 0x86, 0x24, 0x1d, 0xf2, 0xcf, 0x2c, 0xb0, 0x17, 0x77, 0x3a, 0x71,
+	0x24, 0xff, 0x24, 0x60, 0xfb, 0x01, 0x94, 0x5a, 0x2e, 0x73, 0xd1, 0x0e, 0x94, 0xb8, 0xb6, 0xa6,
+	0xb1, 0x69, 0xdc, 0xae, 0xef, 0x6e, 0x34, 0xc7, 0x78, 0x68, 0xbe, 0x08, 0xdd, 0xc8, 0x11, 0x20,
+	0xfb, 0x11, 0x54, 0xf7, 0x08, 0x11, 0xe3, 0xee, 0x41, 0x99, 0x77, 0x51, 0xd3, 0xd8, 0x2c, 0x4e,
+	0x1b, 0x98, 0xa0, 0xec, 0xbf, 0x18, 0x00, 0x7b, 0x61, 0xe8, 0xe0, 0x2f, 0x7a, 0x98, 0x32, 0x74,
+	0x05, 0x2a, 0x31, 0x69, 0x9f, 0x04, 0xbe, 0xf8, 0xee, 0x92, 0x53, 0x8e, 0x49, 0xfb, 0xc0, 0x47,
+	0x1b, 0x50, 0x65, 0xd8, 0xed, 0xf0, 0xfe, 0x82, 0xe8, 0xaf, 0xf0, 0xe6, 0x81, 0x8f, 0xd6, 0xa1,
+	0x1c, 0x06, 0x9d, 0x80, 0x99, 0xc5, 0x4d, 0xe3, 0x76, 0xd1, 0x49, 0x1a, 0xe8, 0x2a, 0x54, 0xe2,
+	0xd3, 0x53, 0x8a, 0x99, 0x59, 0x12, 0xdd, 0xb2, 0x85, 0xb6, 0xa1, 0x41, 0x63, 0xc2, 0x4e, 0xba,
+	0x2e, 0x71, 0x3b, 0x98, 0x61, 0x62, 0x96, 0xc5, 0x6c, 0xcb, 0xbc, 0xf7, 0x45, 0xda, 0x99, 0xc1,
+	0xfc, 0x80, 0x60, 0x8f, 0x05, 0x71, 0x64, 0x56, 0x86, 0xb0, 0x56, 0xda, 0x69, 0xbf, 0x86, 0xba,
+	0x90, 0x9c, 0x76, 0xe3, 0x88, 0x62, 0xd4, 0x84, 0x92, 0xef, 0x32, 0x57, 0x12, 0x66, 0x69, 0xf4,
+	0x96, 0x14, 0x39, 0x02, 0x87, 0x10, 0x94, 0xbc, 0xd8, 0xc7, 0x42, 0xa1, 0xa2, 0x23, 0x9e, 0x91,
+	0x09, 0xd5, 0x0e, 0xa6, 0xd4, 0x6d, 0x63, 0xa1, 0xd0, 0x92, 0x93, 0x36, 0xed, 0x5f, 0x19, 0xb0,
+	0xfc, 0x84, 0x60, 0x97, 0xe1, 0x94, 0xaa, 0xb3, 0x2c, 0x10, 0x27, 0x90, 0x9b, 0xa6, 0x42, 0x20,
+	0x6f, 0x1e, 0xf8, 0x0a, 0xe1, 0xc5, 0x09, 0x84, 0x97, 0x54, 0xc2, 0xed, 0xd7, 0xd0, 0x48, 0xc5,
+	0x90, 0x7a, 0xef, 0xe4, 0xf4, 0xd6, 0xc9, 0xf1, 0x95, 0x94, 0x3e, 0xee, 0xfa, 0xff, 0x0f, 0x4a,
+	0xa7, 0x62, 0x5c, 0xbc, 0xd2, 0x9f, 0x42, 0xdd, 0xc1, 0xae, 0x9f, 0x6a, 0xdc, 0x80, 0x42, 0xe6,
+	0x0d, 0x85, 0x40, 0x95, 0xbd, 0x30, 0x41, 0xf6, 0x62, 0x4e, 0xf6, 0x00, 0x2e, 0x25, 0xd3, 0x5d,
+	0xbc, 0xe4, 0xcf, 0x61, 0xb9, 0x85, 0x43, 0xcc, 0xf0, 0xd7, 0x25, 0xfb, 0x87, 0xd0, 0x48, 0x27,
+	0x94, 0xd2, 0x9f, 0x4d, 0xa0, 0xff, 0x18, 0xb0, 0x7c, 0x88, 0x5d, 0xe2, 0xbd, 0x4a, 0x25, 0x42,
+	0x50, 0x8a, 0xdc, 0x0e, 0x96, 0x32, 0x89, 0xe7, 0xb3, 0x4a, 0x35, 0x8c, 0x39, 0x25, 0x7d, 0xcc,
+	0x29, 0xe7, 0x62, 0x0e, 0x82, 0xd2, 0x29, 0x89, 0x3b, 0x22, 0x84, 0x14, 0x1d, 0xf1, 0xcc, 0x79,
+	0x61, 0xb1, 0x59, 0x15, 0x3d, 0x05, 0x16, 0x6b, 0xe2, 0x52, 0x6d, 0xbe, 0xb8, 0xb4, 0xa4, 0x8b,
+	0x4b, 0x11, 0x34, 0x52, 0xa5, 0x17, 0x12, 0x9a, 0xfe, 0x61, 0xc0, 0xca, 0x11, 0xee, 0x74, 0x43,
+	0x97, 0x61, 0xfa, 0x26, 0x06, 0xf2, 0x2f, 0x60, 0x55, 0x91, 0x7f, 0x21, 0x9c, 0xfd, 0xcd, 0x80,
+	0xe5, 0x16, 0x71, 0x4f, 0xd9, 0x1b, 0x49, 0x58, 0x04, 0x8d, 0x54, 0xf8, 0x85, 0xb0, 0xf5, 0x2f,
+	0x03, 0x56, 0x1e, 0x0f, 0xc4, 0xbe, 0x13, 0x93, 0x94, 0x30, 0x25, 0xba, 0x1b, 0x13, 0xa2, 0x7b,
+	0x7d, 0x02, 0x93, 0x97, 0xf4, 0x4c, 0x2e, 0xeb, 0x99, 0x6c, 0x5c, 0xac, 0xe9, 0x29, 0x8a, 0x2d,
+	0x84, 0xcc, 0xdf, 0x18, 0xd0, 0x78, 0x1a, 0x84, 0x0c, 0x93, 0xcc, 0xf6, 0x32, 0x06, 0x0c, 0x3d,
+	0x03, 0x85, 0x19, 0x0c, 0x14, 0xe7, 0x63, 0xa0, 0xa4, 0x63, 0xe0, 0xef, 0x06, 0x5c, 0xce, 0xc4,
+	0x91, 0x04, 0x7c, 0x2f, 0x47, 0xc0, 0xbb, 0x1a, 0x02, 0x46, 0x46, 0x9c, 0x7b, 0xcf, 0xb2, 0x3e,
+	0x92, 0xe9, 0xee, 0x07, 0x50, 0x3d, 0x4d, 0xe6, 0x94, 0x89, 0xeb, 0xdb, 0x13, 0x72, 0x8b, 0xe4,
+	0xcb, 0x4e, 0x8a, 0xb6, 0xd7, 0x60, 0xf5, 0x28, 0xee, 0xe6, 0x09, 0xb5, 0x09, 0x20, 0xb5, 0x73,
+	0x21, 0xeb, 0xfa, 0x5f, 0x03, 0xd0, 0x51, 0xd0, 0xc1, 0x23, 0x6b, 0xfb, 0x36, 0x00, 0x65, 0x2e,
+	0x5f, 0x07, 0x97, 0x61, 0xb9, 0xc0, 0x4b, 0xa2, 0xa7, 0xe5, 0x32, 0x8c, 0xae, 0x41, 0x0d, 0x47,
+	0x7e, 0xf2, 0x32, 0xf9, 0x4e, 0x15, 0x47, 0xbe, 0x78, 0x75, 0xc6, 0x2c, 0x69, 0x68, 0x45, 0x65,
+	0xbd, 0x15, 0x55, 0x66, 0x58, 0x51, 0x75, 0x3e, 0x2b, 0xaa, 0xe9, 0xac, 0x88, 0xc2, 0x5a, 0x4e,
+	0xf7, 0x85, 0x30, 0xbe, 0x06, 0xab, 0xc7, 0x14, 0x8f, 0x2f, 0xfd, 0x31, 0x5d, 0xb0, 0x20, 0x1b,
+	0x70, 0xe5, 0xb0, 0xe7, 0x79, 0x98, 0xd2, 0x11, 0x61, 0xfa, 0x70, 0x75, 0xf4, 0xc5, 0x42, 0x04,
+	0xba, 0x06, 0x1b, 0x4f, 0xe2, 0xc8, 0x0f, 0xf8, 0xda, 0x8c, 0x88, 0xf4, 0x33, 0x30, 0xc7, 0x5f,
+	0x2d, 0x44, 0xa8, 0x7f, 0x1a, 0x80, 0x3e, 0x8e, 0xdd, 0x70, 0x3c, 0xf8, 0xb5, 0x63, 0x37, 0xa4,
+	0xe9, 0xbe, 0x2b, 0x1a, 0x6f, 0xd4, 0x26, 0x42, 0x61, 0x2d, 0xa7, 0xd7, 0x42, 0xd8, 0xfc, 0xa3,
+	0x01, 0x1b, 0xc9, 0x49, 0x50, 0x89, 0x8a, 0x92, 0xd2, 0xf7, 0xa0, 0x92, 0x84, 0x47, 0xf9, 0xed,
+	0x19, 0xb1, 0x54, 0x82, 0x2f, 0x96, 0x73, 0xfb, 0x4b, 0x30, 0xc7, 0xe5, 0x95, 0x54, 0x9d, 0x53,
+	0xe0, 0xb3, 0x31, 0xf6, 0x57, 0x03, 0x56, 0x0e, 0x5f, 0xb9, 0x44, 0x08, 0x90, 0x52, 0x75, 0xb6,
+	0x72, 0x09, 0x87, 0xf3, 0x2c, 0x87, 0x9a, 0x05, 0x1d, 0x9c, 0xbf, 0x6a, 0x1e, 0x53, 0x4c, 0x9c,
+	0x04, 0xa5, 0xe6, 0x48, 0xc5, 0x09, 0x39, 0x52, 0x69, 0x02, 0xd5, 0xe5, 0xdc, 0x49, 0x6c, 0x0f,
+	0x56, 0x15, 0xd1, 0x47, 0x0e, 0x63, 0x86, 0x5e, 0xfd, 0x42, 0x5e, 0xfd, 0x2f, 0xe1, 0xda, 0x5e,
+	0x8f, 0xc5, 0x5e, 0xdc, 0xe9, 0x86, 0x98, 0xe1, 0xfc, 0xb9, 0x6c, 0x1d, 0xca, 0x2c, 0x60, 0x61,
+	0x7a, 0x30, 0x4b, 0x1a, 0x1a, 0x37, 0x29, 0xcc, 0xe7, 0x26, 0x45, 0x9d, 0x9b, 0xfc, 0xdb, 0x00,
+	0x4b, 0x27, 0x81, 0xd4, 0xe6, 0x69, 0xce, 0x5d, 0x76, 0x75, 0xee, 0x32, 0x71, 0xf0, 0xf9, 0xf3,
+	0x8f, 0x4f, 0x65, 0xfe, 0xb1, 0x0f, 0x35, 0x22, 0x27, 0x93, 0xa6, 0x70, 0x27, 0x2f, 0x81, 0xac,
+	0xe8, 0xa9, 0x32, 0xa4, 0x5f, 0x77, 0xb2, 0xa1, 0xf6, 0x6f, 0x6b, 0x50, 0xe2, 0x6b, 0x34, 0x76,
+	0xf4, 0x4e, 0x0f, 0xbe, 0x05, 0xed, 0xc1, 0xf7, 0xba, 0x6a, 0x04, 0x2b, 0x50, 0xec, 0x06, 0x9e,
+	0x14, 0x94, 0x3f, 0xa2, 0x4d, 0xa8, 0xfb, 0x98, 0x7a, 0x24, 0xe8, 0x2a, 0x79, 0x9c, 0xda, 0xc5,
+	0x15, 0xf4, 0x84, 0x73, 0xf9, 0x72, 0xf7, 0x4f, 0x9b, 0xfc, 0x4d, 0x4f, 0xd4, 0x4e, 0x7c, 0x99,
+	0x00, 0xa4, 0xcd, 0xa1, 0x2d, 0x57, 0xe7, 0xb2, 0xe5, 0xdd, 0x34, 0x4e, 0xd7, 0x04, 0xfc, 0x1b,
+	0x79, 0xf8, 0xb0, 0x40, 0xca, 0x43, 0x61, 0x1a, 0xc5, 0x2d, 0xa8, 0xf9, 0x3d, 0xe2, 0x2a, 0x67,
+	0xe5, 0xac, 0xcd, 0x4d, 0x4e, 0xa4, 0x41, 0x26, 0x24, 0xd1, 0x43, 0x34, 0xb8, 0xf2, 0x38, 0x4a,
+	0x02, 0x50, 0xd1, 0xe1, 0x8f, 0xa8, 0x09, 0x08, 0x47, 0x3e, 0xcf, 0x2e, 0x8e, 0x23, 0xda, 0xc5,
+	0x5e, 0x70, 0x1a, 0xe0, 0x24, 0x12, 0xd5, 0x1c, 0xcd, 0x1b, 0xf4, 0x11, 0x00, 0xc1, 0x5e, 0x8f,
+	0x10, 0x1c, 0x79, 0xd8, 0x5c, 0x16, 0xc2, 0xde, 0xd0, 0xae, 0xa5, 0x93, 0xc1, 0x1c, 0x65, 0x08,
+	0xfa, 0xb6, 0xe4, 0x32, 0x26, 0x22, 0x82, 0x4d, 0x61, 0x26, 0xc5, 0xa1, 0xef, 0xc3, 0xb2, 0x17,
+	0x87, 0xa1, 0xfb, 0x32, 0x26, 0xbc, 0x4d, 0xcd, 0xcb, 0xd3, 0x29, 0xcd, 0xa3, 0xd1, 0x7d, 0xa8,
+	0x50, 0xee, 0xdd, 0xd4, 0x5c, 0x99, 0x3e, 0x4e, 0xc2, 0xd0, 0x7b, 0xdc, 0x57, 0x06, 0xd4, 0x5c,
+	0x15, 0xf0, 0xad, 0x09, 0x41, 0xab, 0xd9, 0x72, 0x07, 0x74, 0x3f, 0x62, 0x64, 0xe0, 0x08, 0x38,
+	0xba, 0x01, 0xf5, 0x80, 0xe1, 0x0e, 0x3d, 0xf1, 0xe2, 0x5e, 0xc4, 0x4c, 0x24, 0x48, 0x06, 0xd1,
+	0xf5, 0x84, 0xf7, 0xa0, 0xeb, 0x00, 0x01, 0x4d, 0xcf, 0xe2, 0xe6, 0x9a, 0xe0, 0x58, 0xe9, 0xe1,
+	0xef, 0x99, 0x7c, 0x3e, 0xf0, 0xcd, 0x75, 0xb1, 0xa2, 0x4a, 0x0f, 0x8f, 0xe3, 0x9c, 0xdb, 0x1e,
+	0x35, 0xaf, 0x6c, 0x1a, 0xb7, 0x1b, 0xda, 0x38, 0x7e, 0x28, 0x00, 0xfb, 0x51, 0xaf, 0xe3, 0x48,
+	0x30, 0x7a, 0x1f, 0xaa, 0x14, 0x33, 0x16, 0x44, 0x6d, 0xf3, 0xea, 0xa6, 0x31, 0x6e, 0x5c, 0x72,
+	0xbd, 0x0e, 0x13, 0x8c, 0x93, 0x82, 0xb9, 0x53, 0x31, 0xb7, 0x4d, 0xcd, 0x8d, 0xcd, 0x22, 0x77,
+	0x2a, 0xfe, 0xcc, 0xed, 0x9d, 0xf6, 0x3a, 0x1d, 0x97, 0x0c, 0x4c, 0x33, 0x71, 0x75, 0xd9, 0xe4,
+	0xc6, 0x48, 0x70, 0x3f, 0xa0, 0xdc, 0x18, 0xaf, 0x25, 0xc6, 0x98, 0xb6, 0xad, 0xcf, 0x60, 0x29,
+	0x23, 0x8b, 0xdb, 0xe0, 0x6b, 0x3c, 0x90, 0xce, 0xcb, 0x1f, 0xd1, 0x03, 0x28, 0xf7, 0xdd, 0xb0,
+	0x97, 0xb8, 0xef, 0xd8, 0xf6, 0x24, 0xc5, 0x6b, 0xb9, 0x83, 0x03, 0x4e, 0xa5, 0x93, 0x60, 0xbf,
+	0x5b, 0x78, 0x64, 0xd8, 0x7f, 0x32, 0xa0, 0xc6, 0x97, 0x82, 0xbf, 0x40, 0x0f, 0xa1, 0xc4, 0xb9,
+	0x96, 0x11, 0x6e, 0x73, 0xc2, 0xaa, 0x71, 0x68, 0x93, 0xff, 0x38, 0x02, 0xcd, 0x0b, 0x86, 0x2c,
+	0xf6, 0x63, 0xf9, 0xe9, 0x11, 0xd3, 0xe0, 0x6f, 0x9a, 0x47, 0xb1, 0x1f, 0x3b, 0x02, 0x64, 0xed,
+	0x43, 0x49, 0x7c, 0x6a, 0x34, 0xfc, 0x64, 0xf1, 0xbd, 0xa0, 0xc6, 0xf7, 0x0d, 0xa8, 0x76, 0x03,
+	0xef, 0xa4, 0x47, 0xc2, 0x74, 0x7b, 0xea, 0x06, 0xde, 0x31, 0x09, 0xed, 0xcf, 0xa1, 0xc4, 0xed,
+	0x6d, 0xae, 0x28, 0xa6, 0x84, 0x9e, 0xe2, 0xc4, 0xd0, 0x53, 0xca, 0x85, 0x1e, 0xfb, 0x19, 0xc0,
+	0x70, 0x3b, 0x17, 0xe1, 0x2d, 0xa0, 0xdd, 0xd0, 0x1d, 0x3c, 0x1b, 0xd6, 0x06, 0xd5, 0x2e, 0x6e,
+	0x77, 0xc9, 0x96, 0x7f, 0x18, 0xf6, 0xda, 0xf2, 0xeb, 0x4a, 0x8f, 0xfd, 0xe7, 0x02, 0x2c, 0x67,
+	0xfb, 0xa3, 0x56, 0xf2, 0xb4, 0x70, 0x5d, 0x98, 0xa7, 0x70, 0xbd, 0x03, 0x25, 0xee, 0x73, 0x66,
+	0x51, 0x07, 0x1e, 0x7a, 0xa3, 0x00, 0xa1, 0x47, 0x99, 0xcd, 0x97, 0x84, 0xcd, 0x6f, 0xea, 0x6d,
+	0x97, 0x4b, 0x97, 0x98, 0x7e, 0x66, 0xf6, 0x0a, 0x3f, 0xe5, 0x7c, 0x68, 0x7e, 0x08, 0x4b, 0xc2,
+	0xd3, 0xfd, 0x93, 0x97, 0x03, 0xb3, 0x32, 0x5d, 0x8a, 0x5a, 0x82, 0x7c, 0x3c, 0x50, 0x57, 0xa2,
+	0x3a, 0xb6, 0x12, 0x7d, 0x4c, 0x68, 0x7a, 0x7c, 0x2b, 0x3a, 0x69, 0xd3, 0x7e, 0x04, 0xab, 0x1f,
+	0x63, 0x76, 0x14, 0x77, 0x8f, 0xdc, 0xf6, 0xac, 0x5a, 0xd8, 0x25, 0x30, 0x22, 0xb9, 0xad, 0x1a,
+	0x91, 0xfd, 0x3b, 0x9e, 0xce, 0x2b, 0x43, 0xe5, 0x36, 0xfe, 0x61, 0x6e, 0x1b, 0xbf, 0xab, 0x21,
+	0x7a, 0x7c, 0xd0, 0xf9, 0xb7, 0x6f, 0x4b, 0x6e, 0xdf, 0x69, 0x24, 0x30, 0x86, 0x91, 0xc0, 0x6e,
+	0xc1, 0x86, 0xba, 0x5b, 0xcf, 0xa1, 0xa0, 0xc6, 0xbc, 0xed, 0x3f, 0x18, 0x60, 0x8e, 0x4f, 0x23,
+	0x95, 0x6d, 0xe5, 0x94, 0xfd, 0xd6, 0x8c, 0x9c, 0xe5, 0xe2, 0x55, 0x5e, 0x07, 0xf4, 0x43, 0x97,
+	0x74, 0x7a, 0xdd, 0x27, 0xae, 0xf7, 0x2a, 0xbd, 0x06, 0xb0, 0xaf, 0xc0, 0x5a, 0xae, 0x57, 0xe6,
+	0x2a, 0xbf, 0x34, 0x60, 0xed, 0x93, 0x80, 0xb2, 0xcf, 0x12, 0x53, 0xa0, 0x5f, 0xd3, 0xad, 0xc1,
+	0xc4, 0x1a, 0xa8, 0xb6, 0x3e, 0x61, 0xff, 0x02, 0xd6, 0xf3, 0x42, 0x48, 0x6a, 0x3f, 0xc8, 0x51,
+	0xfb, 0x8e, 0xd6, 0xa9, 0xe4, 0xa0, 0xaf, 0x72, 0x8c, 0x22, 0x50, 0x97, 0xb3, 0x08, 0x52, 0xdf,
+	0x1f, 0x3a, 0x8a, 0x31, 0x65, 0x27, 0x92, 0x43, 0x32, 0x37, 0x3a, 0x53, 0x78, 0xb1, 0x23, 0x40,
+	0x0e, 0x76, 0xfd, 0x74, 0x92, 0x09, 0xb4, 0x2b, 0x3e, 0x5b, 0xc8, 0xf9, 0xec, 0x99, 0xaf, 0xcf,
+	0x7e, 0x0a, 0x6b, 0xb9, 0xef, 0x49, 0x86, 0x77, 0x73, 0x0c, 0x5f, 0xd7, 0xc8, 0xac, 0x30, 0x73,
+	0x2e, 0x72, 0x7f, 0x6d, 0xc0, 0x55, 0x07, 0xf7, 0x31, 0x61, 0x47, 0xf1, 0xa2, 0xb4, 0x55, 0xcf,
+	0x5c, 0x65, 0xf5, 0xcc, 0x65, 0xff, 0x1c, 0x36, 0xc6, 0x84, 0x59, 0x14, 0x15, 0x77, 0xbf, 0x03,
+	0x30, 0x4c, 0x7c, 0xd0, 0x1a, 0x5c, 0x1e, 0xb6, 0x4e, 0x9e, 0x3d, 0x7f, 0xb6, 0xbf, 0xf2, 0x16,
+	0xaa, 0x43, 0xf5, 0xc5, 0xf1, 0xe3, 0x4f, 0x0e, 0x0e, 0x7f, 0xb0, 0x62, 0xa0, 0x25, 0x28, 0xb7,
+	0x9c, 0xbd, 0xa7, 0x47, 0x2b, 0x85, 0xdd, 0xdf, 0x23, 0xa8, 0x73, 0xeb, 0x39, 0xc4, 0xa4, 0x1f,
+	0x78, 0xfc, 0xa0, 0x54, 0xdc, 0x0b, 0x43, 0xa4, 0xcb, 0xad, 0x86, 0x37, 0xf9, 0xd6, 0xf5, 0x49,
+	0xaf, 0xa5, 0xf7, 0xbf, 0x85, 0x9e, 0x43, 0x25, 0x39, 0x90, 0x23, 0x5d, 0x2a, 0x92, 0xbb, 0xec,
+	0xb6, 0xb6, 0xa6, 0x20, 0xd4, 0x09, 0x93, 0x6b, 0x5a, 0xed, 0x84, 0xb9, 0x8b, 0x64, 0x6b, 0x6b,
+	0x0a, 0x22, 0x9b, 0xf0, 0x00, 0x4a, 0xdc, 0x70, 0x91, 0x4e, 0x17, 0xe5, 0x8e, 0xd6, 0xba, 0x31,
+	0xf1, 0xbd, 0x2a, 0x5b, 0x72, 0x95, 0xa9, 0x95, 0x2d, 0x77, 0x6d, 0x6a, 0x6d, 0x4d, 0x41, 0xa8,
+	0x13, 0x26, 0x67, 0x50, 0xed, 0x84, 0xb9, 0xd3, 0xb5, 0xb5, 0x35, 0x05, 0x91, 0x4d, 0xf8, 0x23,
+	0x58, 0xca, 0x6e, 0xc1, 0xd0, 0x3b, 0x9a, 0x11, 0xa3, 0x77, 0x7c, 0xd6, 0xcd, 0xe9, 0xa0, 0x9c,
+	0xee, 0xe2, 0xba, 0x48, 0xaf, 0xbb, 0x7a, 0x0d, 0x66, 0x6d, 0x4d, 0x41, 0xa8, 0xa2, 0x66, 0xb7,
+	0x26, 0x5a, 0x51, 0x47, 0x2f, 0x8b, 0xac, 0x9b, 0xd3, 0x41, 0xd9, 0xcc, 0x0e, 0x54, 0x65, 0x19,
+	0x0d, 0x6d, 0x4d, 0xbb, 0x76, 0x48, 0x66, 0xb5, 0x67, 0xdf, 0x4c, 0xd8, 0x6f, 0xa1, 0x1f, 0x03,
+	0x0c, 0x2f, 0x03, 0x90, 0x96, 0xb4, 0xd1, 0x0b, 0x04, 0x6b, 0x7b, 0x06, 0x2a, 0x9b, 0xfc, 0x73,
+	0xa8, 0x2b, 0x85, 0x6f, 0xa4, 0x1d, 0x37, 0x76, 0x29, 0x60, 0xdd, 0x9a, 0x05, 0x53, 0x85, 0x1f,
+	0x96, 0xb3, 0xb5, 0xc2, 0x8f, 0x95, 0xc0, 0xad, 0xed, 0x19, 0xa8, 0x6c, 0xf2, 0x36, 0x34, 0xf2,
+	0xe5, 0x69, 0x74, 0x5b, 0x67, 0xa9, 0xba, 0xd2, 0xb6, 0x75, 0x67, 0x0e, 0x64, 0xf6, 0xa1, 0x0e,
+	0xac, 0x8c, 0x16, 0x9d, 0x91, 0x2e, 0x35, 0x9c, 0x50, 0xb4, 0xb6, 0x76, 0xe6, 0xc2, 0xaa, 0x8b,
+	0xa2, 0x14, 0x64, 0xb5, 0x8b, 0x32, 0x5e, 0x88, 0xb6, 0x6e, 0xcd, 0x82, 0xe5, 0xd4, 0x19, 0x29,
+	0x65, 0xea, 0xd5, 0xd1, 0xd7, 0x67, 0xad, 0x9d, 0xb9, 0xb0, 0xaa, 0xbb, 0x65, 0x87, 0x1b, 0xad,
+	0xbb, 0x8d, 0x56, 0x35, 0xad, 0x9b, 0xd3, 0x41, 0xd9, 0xcc, 0x14, 0xd0, 0x78, 0x51, 0x0d, 0x7d,
+	0x73, 0xce, 0xda, 0x5b, 0xf2, 0xad, 0x7b, 0x67, 0xaa, 0xd4, 0x25, 0x26, 0x3d, 0x3c, 0x02, 0x68,
+	0x4d, 0x7a, 0xec, 0x44, 0x62, 0x6d, 0xcf, 0x40, 0xa9, 0x4b, 0x33, 0x9a, 0x72, 0x6b, 0x97, 0x66,
+	0xc2, 0xc9, 0xc0, 0xda, 0x99, 0x0b, 0xab, 0x5a, 0x9a, 0x92, 0x5a, 0x6b, 0x2d, 0x6d, 0x3c, 0x21,
+	0xb7, 0x6e, 0xcd, 0x82, 0x65, 0xf3, 0xbb, 0x70, 0x49, 0xcd, 0x8e, 0x91, 0x6e, 0xa4, 0x26, 0x87,
+	0xb7, 0xde, 0x9d, 0x89, 0x53, 0x55, 0x50, 0xb2, 0x43, 0xad, 0x0a, 0xe3, 0xd9, 0xaa, 0x75, 0x6b,
+	0x16, 0x2c, 0x9b, 0xff, 0x27, 0x70, 0x79, 0x24, 0xed, 0x42, 0x77, 0xb4, 0x83, 0x75, 0x79, 0xa2,
+	0x75, 0x77, 0x1e, 0x68, 0xfa, 0xad, 0x97, 0x15, 0xf1, 0x3f, 0x94, 0x0f, 0xfe, 0x37, 0x00, 0x56,
+	0x42, 0xc1, 0x54, 0x5d, 0x2a, 0x00, 0x00,
 }
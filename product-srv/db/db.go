@@ -28,7 +28,7 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 
 	return &clientWrapper{
 		Db_client: cl,
@@ -23,6 +23,7 @@ import (
 
 type clientWrapper struct {
 	Db_client             db_proto.DBClient
+	PII                   *common.PII
 	ResponseServiceClient resp_proto.ResponseServiceClient
 }
 
@@ -33,11 +34,13 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	pii := common.NewPII(serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, pii))
 	cl1 := resp_proto.NewResponseServiceClient("", serviceClient)
 
 	return &clientWrapper{
 		Db_client:             cl,
+		PII:                   pii,
 		ResponseServiceClient: cl1,
 	}
 }
@@ -74,13 +77,16 @@ func runQuery(ctx context.Context, q string, table string) (*db_proto.RunQueryRe
 	})
 }
 
-func responseToRecord(resp *resp_proto.SubmitSurveyResponse) (string, error) {
+func responseToRecord(ctx context.Context, resp *resp_proto.SubmitSurveyResponse) (string, error) {
 	data, err := common.MarhalToObject(resp)
 	if err != nil {
 		return "", err
 	}
 
 	common.FilterObject(data, "responder", resp.Responder)
+	if err := ClientWrapper.PII.EncryptFields(ctx, resp.OrgId, common.DbResponseTable, data); err != nil {
+		return "", err
+	}
 
 	d := map[string]interface{}{
 		"_key":       resp.Id,
@@ -203,7 +209,7 @@ func Create(ctx context.Context, surveyId string, response *resp_proto.SubmitSur
 		response.Created = time.Now().Unix()
 	}
 	response.Updated = time.Now().Unix()
-	record, err := responseToRecord(response)
+	record, err := responseToRecord(ctx, response)
 	if err != nil {
 		common.ErrorLog(common.ResponseSrv, Create, err, "ResponseToRecord is failed")
 		return err
@@ -68,6 +68,24 @@ func runQuery(ctx context.Context, q string, table string) (*db_proto.RunQueryRe
 	})
 }
 
+// runQueryBind runs a query which references its parameters as bind variables
+func runQueryBind(ctx context.Context, q string, bindVars common.BindVars, table string) (*db_proto.RunQueryResponse, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return ClientWrapper.Db_client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{
+			Name:     common.DbHealumName,
+			Table:    table,
+			Driver:   common.DbHealumDriver,
+			Metadata: common.SearchableMetaMap,
+		},
+		Query:    q,
+		BindVars: vars,
+	})
+}
+
 func appToRecord(app *static_proto.App) (string, error) {
 	data, err := common.MarhalToObject(app)
 	if err != nil {
@@ -869,17 +887,9 @@ func ReadApp(ctx context.Context, id, orgId, teamId string) (*static_proto.App,
 	return data, err
 }
 
-// DeleteApp deletes a app by ID
-func DeleteApp(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbAppTable, query, common.DbAppTable)
-	_, err := runQuery(ctx, q, common.DbAppTable)
-	return err
+// DeleteApp moves a app and its edges to the trash
+func DeleteApp(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbAppTable, id, "", userId)
 }
 
 // AllPlatforms get all platforms
@@ -956,17 +966,9 @@ func ReadPlatform(ctx context.Context, id, orgId, teamId string) (*static_proto.
 	return data, err
 }
 
-// DeletePlatform deletes a platform by ID
-func DeletePlatform(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbPlatformTable, query, common.DbPlatformTable)
-	_, err := runQuery(ctx, q, common.DbPlatformTable)
-	return err
+// DeletePlatform moves a platform and its edges to the trash
+func DeletePlatform(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbPlatformTable, id, "", userId)
 }
 
 // AllWearables get all wearables
@@ -1043,17 +1045,9 @@ func ReadWearable(ctx context.Context, id, orgId, teamId string) (*static_proto.
 	return data, err
 }
 
-// DeleteWearable deletes a wearable by ID
-func DeleteWearable(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbWearableTable, query, common.DbWearableTable)
-	_, err := runQuery(ctx, q, common.DbWearableTable)
-	return err
+// DeleteWearable moves a wearable and its edges to the trash
+func DeleteWearable(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbWearableTable, id, "", userId)
 }
 
 // AllDevices get all devices
@@ -1130,17 +1124,9 @@ func ReadDevice(ctx context.Context, id, orgId, teamId string) (*static_proto.De
 	return data, err
 }
 
-// DeleteDevice deletes a device by ID
-func DeleteDevice(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbDeviceTable, query, common.DbDeviceTable)
-	_, err := runQuery(ctx, q, common.DbDeviceTable)
-	return err
+// DeleteDevice moves a device and its edges to the trash
+func DeleteDevice(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbDeviceTable, id, "", userId)
 }
 
 // AllMarkers get all markers
@@ -1249,12 +1235,10 @@ func CreateMarker(ctx context.Context, marker *static_proto.Marker) error {
 	return err
 }
 
-// ReadMarker reads a marker by ID
-func ReadMarker(ctx context.Context, id, orgId, teamId string) (*static_proto.Marker, error) {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, "")
-
-	q := fmt.Sprintf(`
+// queryMarkers returns the query of the markers matching the filter with their apps, wearables, devices
+// and tracker methods
+func queryMarkers(query string) string {
+	return fmt.Sprintf(`
 		FOR doc IN %v
 		%s
 		LET apps = (
@@ -1290,6 +1274,14 @@ func ReadMarker(ctx context.Context, id, orgId, teamId string) (*static_proto.Ma
 			trackerMethods:trackerMethods
 		}})`, common.DbMarkerTable, query,
 		common.DbAppTable, common.DbWearableTable, common.DbDeviceTable, common.DbMarkerTrackerEdgeTable)
+}
+
+// ReadMarker reads a marker by ID
+func ReadMarker(ctx context.Context, id, orgId, teamId string) (*static_proto.Marker, error) {
+	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
+	query = common.QueryAuth(query, orgId, "")
+
+	q := queryMarkers(query)
 
 	resp, err := runQuery(ctx, q, common.DbMarkerTable)
 	if err != nil || len(resp.Records) == 0 {
@@ -1300,27 +1292,9 @@ func ReadMarker(ctx context.Context, id, orgId, teamId string) (*static_proto.Ma
 	return data, err
 }
 
-// DeleteMarker deletes a marker by ID
-func DeleteMarker(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query += queryOrgAuth(orgId)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbMarkerTable, query, common.DbMarkerTable)
-	_, err := runQuery(ctx, q, common.DbMarkerTable)
-	if err != nil {
-		return err
-	}
-
-	query = fmt.Sprintf(`FILTER doc._from == "%v/%v"`, common.DbMarkerTable, id)
-	q = fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbMarkerTrackerEdgeTable, query, common.DbMarkerTrackerEdgeTable)
-	_, err = runQuery(ctx, q, common.DbMarkerTrackerEdgeTable)
-	return err
+// DeleteMarker moves a marker and its edges to the trash
+func DeleteMarker(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbMarkerTable, id, "", userId)
 }
 
 func FilterMarker(ctx context.Context, trackerMethods []string, orgId, teamId string, offset, limit int64, sortParameter, sortDirection string) ([]*static_proto.Marker, error) {
@@ -1461,18 +1435,9 @@ func ReadModule(ctx context.Context, id, orgId, teamId string) (*static_proto.Mo
 	return data, err
 }
 
-// DeleteModule deletes a module by ID
-func DeleteModule(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-	query += queryOrgAuth(orgId)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbModuleTable, query, common.DbModuleTable)
-	_, err := runQuery(ctx, q, common.DbModuleTable)
-	return err
+// DeleteModule moves a module and its edges to the trash
+func DeleteModule(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbModuleTable, id, "", userId)
 }
 
 // AllBehaviourCategories get all categories
@@ -1547,12 +1512,9 @@ func CreateBehaviourCategory(ctx context.Context, category *static_proto.Behavio
 	return err
 }
 
-// ReadBehaviourCategory reads a category by ID
-func ReadBehaviourCategory(ctx context.Context, id, orgId, teamId string) (*static_proto.BehaviourCategory, error) {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, "")
-
-	q := fmt.Sprintf(`
+// queryBehaviourCategories returns the query of the categories matching the filter with their aims and markers
+func queryBehaviourCategories(query string) string {
+	return fmt.Sprintf(`
 		FOR doc IN %v
 		%s
 		LET aims = (
@@ -1575,6 +1537,14 @@ func ReadBehaviourCategory(ctx context.Context, id, orgId, teamId string) (*stat
 		}})`, common.DbBehaviourCategoryTable, query,
 		common.DbBehaviourCategoryAimTable, common.DbMarkerTable, common.DbMarkerTable,
 	)
+}
+
+// ReadBehaviourCategory reads a category by ID
+func ReadBehaviourCategory(ctx context.Context, id, orgId, teamId string) (*static_proto.BehaviourCategory, error) {
+	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
+	query = common.QueryAuth(query, orgId, "")
+
+	q := queryBehaviourCategories(query)
 
 	resp, err := runQuery(ctx, q, common.DbBehaviourCategoryTable)
 	if err != nil || len(resp.Records) == 0 {
@@ -1585,17 +1555,9 @@ func ReadBehaviourCategory(ctx context.Context, id, orgId, teamId string) (*stat
 	return data, err
 }
 
-// DeleteBehaviourCategory deletes a category by ID
-func DeleteBehaviourCategory(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query += queryOrgAuth(orgId)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbBehaviourCategoryTable, query, common.DbBehaviourCategoryTable)
-	_, err := runQuery(ctx, q, common.DbBehaviourCategoryTable)
-	return err
+// DeleteBehaviourCategory moves a category and its edges to the trash
+func DeleteBehaviourCategory(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbBehaviourCategoryTable, id, "", userId)
 }
 
 func FilterBehaviourCategory(ctx context.Context, markers []string, orgId, teamId string, offset, limit int64, sortParameter, sortDirection string) ([]*static_proto.BehaviourCategory, error) {
@@ -1724,17 +1686,9 @@ func ReadSocialType(ctx context.Context, id, orgId, teamId string) (*static_prot
 	return data, err
 }
 
-// DeleteSocialType deletes a socialType by ID
-func DeleteSocialType(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbSocialTypeTable, query, common.DbSocialTypeTable)
-	_, err := runQuery(ctx, q, common.DbSocialTypeTable)
-	return err
+// DeleteSocialType moves a socialType and its edges to the trash
+func DeleteSocialType(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbSocialTypeTable, id, "", userId)
 }
 
 // AllNotifications get all notifications
@@ -1810,17 +1764,9 @@ func ReadNotification(ctx context.Context, id, orgId, teamId string) (*static_pr
 	return data, err
 }
 
-// DeleteNotification deletes a notification by ID
-func DeleteNotification(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbNotificationTable, query, common.DbNotificationTable)
-	_, err := runQuery(ctx, q, common.DbNotificationTable)
-	return err
+// DeleteNotification moves a notification and its edges to the trash
+func DeleteNotification(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbNotificationTable, id, "", userId)
 }
 
 // AllTrackerMethods get all trackerMethods
@@ -1907,16 +1853,9 @@ func ReadTrackerMethod(ctx context.Context, id, name_slug, orgId, teamId string)
 	return data, err
 }
 
-// DeleteTrackerMethod deletes a trackerMethod by ID
-func DeleteTrackerMethod(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbTrackerMethodTable, query, common.DbTrackerMethodTable)
-	_, err := runQuery(ctx, q, common.DbTrackerMethodTable)
-	return err
+// DeleteTrackerMethod moves a trackerMethod and its edges to the trash
+func DeleteTrackerMethod(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbTrackerMethodTable, id, "", userId)
 }
 
 // FilterTrackerMethod filter trackerMethod by marker
@@ -2031,16 +1970,9 @@ func ReadBehaviourCategoryAim(ctx context.Context, id, orgId, teamId string) (*s
 	return data, err
 }
 
-// DeleteBehaviourCategoryAim deletes a behaviourCategoryAim by ID
-func DeleteBehaviourCategoryAim(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbBehaviourCategoryAimTable, query, common.DbBehaviourCategoryAimTable)
-	_, err := runQuery(ctx, q, common.DbBehaviourCategoryAimTable)
-	return err
+// DeleteBehaviourCategoryAim moves a behaviourCategoryAim and its edges to the trash
+func DeleteBehaviourCategoryAim(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbBehaviourCategoryAimTable, id, "", userId)
 }
 
 // AllContentParentCategories get all contentParentCategories
@@ -2112,16 +2044,9 @@ func ReadContentParentCategory(ctx context.Context, id, orgId, teamId string) (*
 	return data, err
 }
 
-// DeleteContentParentCategory deletes a contentParentCategory by ID
-func DeleteContentParentCategory(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentParentCategoryTable, query, common.DbContentParentCategoryTable)
-	_, err := runQuery(ctx, q, common.DbContentParentCategoryTable)
-	return err
+// DeleteContentParentCategory moves a contentParentCategory and its edges to the trash
+func DeleteContentParentCategory(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbContentParentCategoryTable, id, "", userId)
 }
 
 // AllContentCategories get all contentCategories
@@ -2198,11 +2123,10 @@ func CreateContentCategory(ctx context.Context, contentCategory *static_proto.Co
 	return err
 }
 
-// ReadContentCategory reads a contentCategory by ID
-func ReadContentCategory(ctx context.Context, id, orgId, teamId string) (*static_proto.ContentCategory, error) {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-
-	q := fmt.Sprintf(`
+// queryContentCategories returns the query of the categories matching the filter with their parents, actions
+// and tracker methods
+func queryContentCategories(query string) string {
+	return fmt.Sprintf(`
 		FOR doc IN %v
 		%s
 		LET parent = (
@@ -2230,6 +2154,13 @@ func ReadContentCategory(ctx context.Context, id, orgId, teamId string) (*static
 		}})`, common.DbContentCategoryTable, query,
 		common.DbContentParentCategoryTable, common.DbActionTable, common.DbTrackerMethodTable,
 	)
+}
+
+// ReadContentCategory reads a contentCategory by ID
+func ReadContentCategory(ctx context.Context, id, orgId, teamId string) (*static_proto.ContentCategory, error) {
+	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
+
+	q := queryContentCategories(query)
 
 	resp, err := runQuery(ctx, q, common.DbContentCategoryTable)
 	if err != nil || len(resp.Records) == 0 {
@@ -2240,16 +2171,9 @@ func ReadContentCategory(ctx context.Context, id, orgId, teamId string) (*static
 	return data, err
 }
 
-// DeleteContentCategory deletes a contentCategory by ID
-func DeleteContentCategory(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentCategoryTable, query, common.DbContentCategoryTable)
-	_, err := runQuery(ctx, q, common.DbContentCategoryTable)
-	return err
+// DeleteContentCategory moves a contentCategory and its edges to the trash
+func DeleteContentCategory(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbContentCategoryTable, id, "", userId)
 }
 
 // AllContentTypes get all contentTypes
@@ -2326,17 +2250,9 @@ func ReadContentType(ctx context.Context, id, orgId, teamId string) (*static_pro
 	return data, err
 }
 
-// DeleteContentType deletes a contentType by ID
-func DeleteContentType(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentTypeTable, query, common.DbContentTypeTable)
-	_, err := runQuery(ctx, q, common.DbContentTypeTable)
-	return err
+// DeleteContentType moves a contentType and its edges to the trash
+func DeleteContentType(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbContentTypeTable, id, "", userId)
 }
 
 // AllContentSourceTypes get all contentSourceTypes
@@ -2413,17 +2329,9 @@ func ReadContentSourceType(ctx context.Context, id, orgId, teamId string) (*stat
 	return data, err
 }
 
-// DeleteContentSourceType deletes a contentSourceType by ID
-func DeleteContentSourceType(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbContentSourceTypeTable, query, common.DbContentSourceTypeTable)
-	_, err := runQuery(ctx, q, common.DbContentSourceTypeTable)
-	return err
+// DeleteContentSourceType moves a contentSourceType and its edges to the trash
+func DeleteContentSourceType(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbContentSourceTypeTable, id, "", userId)
 }
 
 // AllModuleTriggers get all moduleTriggers
@@ -2533,16 +2441,9 @@ func ReadModuleTrigger(ctx context.Context, id, orgId, teamId string) (*static_p
 	return data, err
 }
 
-// DeleteModuleTrigger deletes a moduleTrigger by ID
-func DeleteModuleTrigger(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbModuleTriggerTable, query, common.DbModuleTriggerTable)
-	_, err := runQuery(ctx, q, common.DbModuleTriggerTable)
-	return err
+// DeleteModuleTrigger moves a moduleTrigger and its edges to the trash
+func DeleteModuleTrigger(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbModuleTriggerTable, id, "", userId)
 }
 
 // FilterModuleTrigger get all moduleTriggers
@@ -2683,17 +2584,9 @@ func ReadTriggerContentType(ctx context.Context, id, orgId, teamId string) (*sta
 	return data, err
 }
 
-// DeleteTriggerContentType deletes a triggerContentType by ID
-func DeleteTriggerContentType(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbTriggerContentTypeTable, query, common.DbTriggerContentTypeTable)
-	_, err := runQuery(ctx, q, common.DbTriggerContentTypeTable)
-	return err
+// DeleteTriggerContentType moves a triggerContentType and its edges to the trash
+func DeleteTriggerContentType(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbTriggerContentTypeTable, id, "", userId)
 }
 
 // FilterTriggerContentType get all triggerContentTypes
@@ -2901,17 +2794,9 @@ func ReadSetback(ctx context.Context, id, orgId, teamId string) (*static_proto.S
 	return data, err
 }
 
-// DeleteSetback deletes a setback by ID
-func DeleteSetback(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc.id == "%v"`, id)
-
-	q := fmt.Sprintf(`
-		FOR doc
-		IN %v
-		%s
-		REMOVE doc IN %v`, common.DbSetbackTable, query, common.DbSetbackTable)
-	_, err := runQuery(ctx, q, common.DbSetbackTable)
-	return err
+// DeleteSetback moves a setback and its edges to the trash
+func DeleteSetback(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbSetbackTable, id, "", userId)
 }
 
 func AutocompleteSetbackSearch(ctx context.Context, title string) ([]*static_proto.Setback, error) {
@@ -3046,3 +2931,101 @@ func ReadDeviceByNameslug(ctx context.Context, name_slug string) (*static_proto.
 	data, err := recordToDevice(resp.Records[0])
 	return data, err
 }
+
+// trashCollections are the collections whose documents are deleted to the trash
+var trashCollections = []string{
+	common.DbAppTable,
+	common.DbPlatformTable,
+	common.DbWearableTable,
+	common.DbDeviceTable,
+	common.DbMarkerTable,
+	common.DbModuleTable,
+	common.DbBehaviourCategoryTable,
+	common.DbSocialTypeTable,
+	common.DbNotificationTable,
+	common.DbTrackerMethodTable,
+	common.DbBehaviourCategoryAimTable,
+	common.DbContentParentCategoryTable,
+	common.DbContentCategoryTable,
+	common.DbContentTypeTable,
+	common.DbContentSourceTypeTable,
+	common.DbModuleTriggerTable,
+	common.DbTriggerContentTypeTable,
+	common.DbSetbackTable,
+}
+
+// Trash returns the deleted static records, the latest deleted first
+func Trash(ctx context.Context, collections []string, orgId, teamId string, offset, limit int64) ([]*static_proto.TrashItem, error) {
+	collections, err := common.TrashCollections(collections, trashCollections)
+	if err != nil {
+		return nil, err
+	}
+	return common.ListTrash(ctx, ClientWrapper.Db_client, collections, "", offset, limit)
+}
+
+// Restore moves a deleted document and its edges back to its collection
+func Restore(ctx context.Context, id, collection, orgId, teamId string) (*static_proto.TrashItem, error) {
+	if _, err := common.TrashCollections([]string{collection}, trashCollections); err != nil {
+		return nil, err
+	}
+	return common.RestoreDocument(ctx, ClientWrapper.Db_client, collection, id, "")
+}
+
+// ReadMany reads the records of a reference collection by id with the references they embed, like their
+// Read functions. The ids which don't exist are skipped.
+func ReadMany(ctx context.Context, collection string, ids []string) (*static_proto.ReadManyData, error) {
+	bindVars := common.BindVars{}
+	query := fmt.Sprintf(`FILTER doc._key IN %s`, bindVars.Add("ids", ids))
+
+	var q string
+	switch collection {
+	case common.DbMarkerTable:
+		q = queryMarkers(query)
+	case common.DbTrackerMethodTable:
+		q = fmt.Sprintf(`
+			FOR doc IN %v
+			%s
+			RETURN doc`, common.DbTrackerMethodTable, query)
+	case common.DbBehaviourCategoryTable:
+		q = queryBehaviourCategories(query)
+	case common.DbContentCategoryTable:
+		q = queryContentCategories(query)
+	default:
+		return nil, common.ErrStaticCollection
+	}
+
+	resp, err := runQueryBind(ctx, q, bindVars, collection)
+	if err != nil {
+		return nil, err
+	}
+	data := &static_proto.ReadManyData{}
+	for _, r := range resp.Records {
+		switch collection {
+		case common.DbMarkerTable:
+			marker, err := recordToMarker(r)
+			if err != nil {
+				return nil, err
+			}
+			data.Markers = append(data.Markers, marker)
+		case common.DbTrackerMethodTable:
+			method, err := recordToTrackerMethod(r)
+			if err != nil {
+				return nil, err
+			}
+			data.TrackerMethods = append(data.TrackerMethods, method)
+		case common.DbBehaviourCategoryTable:
+			category, err := recordToCategory(r)
+			if err != nil {
+				return nil, err
+			}
+			data.BehaviourCategories = append(data.BehaviourCategories, category)
+		case common.DbContentCategoryTable:
+			category, err := recordToContentCategory(r)
+			if err != nil {
+				return nil, err
+			}
+			data.ContentCategories = append(data.ContentCategories, category)
+		}
+	}
+	return data, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"server/common"
+	"server/common/dbtest"
+	static_proto "server/static-srv/proto/static"
 	"testing"
 	"time"
 
@@ -33,3 +35,45 @@ func TestRunQuery(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestReadMany(t *testing.T) {
+	dbtest.Reset()
+	Init(dbtest.NewClient())
+	ctx := common.NewTestContext(context.TODO())
+
+	method := &static_proto.TrackerMethod{Id: "t1", Name: "count", NameSlug: "count"}
+	if err := CreateTrackerMethod(ctx, method); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"m1", "m2"} {
+		marker := &static_proto.Marker{Id: id, Name: id, TrackerMethods: []*static_proto.TrackerMethod{method}}
+		if err := CreateMarker(ctx, marker); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := ReadMany(ctx, common.DbMarkerTable, []string{"m1", "m2", "m3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Markers) != 2 || len(data.TrackerMethods) != 0 {
+		t.Fatalf("Markers are invalid: %v", data)
+	}
+	for _, m := range data.Markers {
+		if len(m.TrackerMethods) != 1 || m.TrackerMethods[0].Name != "count" {
+			t.Errorf("Tracker methods of %v must be read: %v", m.Id, m.TrackerMethods)
+		}
+	}
+
+	data, err = ReadMany(ctx, common.DbTrackerMethodTable, []string{"t1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.TrackerMethods) != 1 || data.TrackerMethods[0].NameSlug != "count" {
+		t.Errorf("Tracker methods are invalid: %v", data)
+	}
+
+	if _, err := ReadMany(ctx, common.DbAppTable, []string{"a1"}); err != common.ErrStaticCollection {
+		t.Errorf("Collection which isn't cached must not be read: %v", err)
+	}
+}
@@ -6,11 +6,24 @@ import (
 	"server/static-srv/db"
 	static_proto "server/static-srv/proto/static"
 
+	"github.com/micro/go-micro/broker"
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
 )
 
-type StaticService struct{}
+type StaticService struct {
+	Broker broker.Broker
+}
+
+// publishChanged invalidates the caches of a reference record, a failure is only logged as the cached records expire
+func (p *StaticService) publishChanged(fn interface{}, collection, id string) {
+	if p.Broker == nil {
+		return
+	}
+	if err := common.PublishStaticChanged(p.Broker, collection, id); err != nil {
+		common.ErrorLog(common.StaticSrv, fn, err, "publish change failed")
+	}
+}
 
 func (p *StaticService) AllApps(ctx context.Context, req *static_proto.AllAppsRequest, rsp *static_proto.AllAppsResponse) error {
 	log.Info("Received Static.AllApps request")
@@ -51,7 +64,7 @@ func (p *StaticService) ReadApp(ctx context.Context, req *static_proto.ReadAppRe
 
 func (p *StaticService) DeleteApp(ctx context.Context, req *static_proto.DeleteAppRequest, rsp *static_proto.DeleteAppResponse) error {
 	log.Info("Received Static.DeleteApp request")
-	if err := db.DeleteApp(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteApp(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteApp, nil, "delete error")
 	}
 	return nil
@@ -96,7 +109,7 @@ func (p *StaticService) ReadPlatform(ctx context.Context, req *static_proto.Read
 
 func (p *StaticService) DeletePlatform(ctx context.Context, req *static_proto.DeletePlatformRequest, rsp *static_proto.DeletePlatformResponse) error {
 	log.Info("Received Static.DeletePlatform request")
-	if err := db.DeletePlatform(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeletePlatform(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeletePlatform, nil, "platform delete error")
 	}
 	return nil
@@ -141,7 +154,7 @@ func (p *StaticService) ReadDevice(ctx context.Context, req *static_proto.ReadDe
 
 func (p *StaticService) DeleteDevice(ctx context.Context, req *static_proto.DeleteDeviceRequest, rsp *static_proto.DeleteDeviceResponse) error {
 	log.Info("Received Static.DeleteDevice request")
-	if err := db.DeleteDevice(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteDevice(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteDevice, nil, "delete error")
 	}
 	return nil
@@ -186,7 +199,7 @@ func (p *StaticService) ReadWearable(ctx context.Context, req *static_proto.Read
 
 func (p *StaticService) DeleteWearable(ctx context.Context, req *static_proto.DeleteWearableRequest, rsp *static_proto.DeleteWearableResponse) error {
 	log.Info("Received Static.DeleteWearable request")
-	if err := db.DeleteWearable(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteWearable(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteWearable, nil, "delete error")
 	}
 	return nil
@@ -215,6 +228,7 @@ func (p *StaticService) CreateMarker(ctx context.Context, req *static_proto.Crea
 	if err != nil {
 		return common.InternalServerError(common.StaticSrv, p.CreateMarker, err, "marker create error")
 	}
+	p.publishChanged(p.CreateMarker, common.DbMarkerTable, req.Marker.Id)
 	rsp.Data = &static_proto.MarkerData{req.Marker}
 	return nil
 }
@@ -231,9 +245,10 @@ func (p *StaticService) ReadMarker(ctx context.Context, req *static_proto.ReadMa
 
 func (p *StaticService) DeleteMarker(ctx context.Context, req *static_proto.DeleteMarkerRequest, rsp *static_proto.DeleteMarkerResponse) error {
 	log.Info("Received Static.DeleteMarker request")
-	if err := db.DeleteMarker(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteMarker(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteMarker, err, "delete error")
 	}
+	p.publishChanged(p.DeleteMarker, common.DbMarkerTable, req.Id)
 	return nil
 }
 
@@ -286,7 +301,7 @@ func (p *StaticService) ReadModule(ctx context.Context, req *static_proto.ReadMo
 
 func (p *StaticService) DeleteModule(ctx context.Context, req *static_proto.DeleteModuleRequest, rsp *static_proto.DeleteModuleResponse) error {
 	log.Info("Received Static.DeleteModule request")
-	if err := db.DeleteModule(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteModule(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteModule, err, "delete error")
 	}
 	return nil
@@ -315,6 +330,7 @@ func (p *StaticService) CreateBehaviourCategory(ctx context.Context, req *static
 	if err != nil {
 		return common.InternalServerError(common.StaticSrv, p.CreateBehaviourCategory, err, "create error")
 	}
+	p.publishChanged(p.CreateBehaviourCategory, common.DbBehaviourCategoryTable, req.Category.Id)
 	rsp.Data = &static_proto.BehaviourCategoryData{req.Category}
 	return nil
 }
@@ -332,9 +348,10 @@ func (p *StaticService) ReadBehaviourCategory(ctx context.Context, req *static_p
 
 func (p *StaticService) DeleteBehaviourCategory(ctx context.Context, req *static_proto.DeleteBehaviourCategoryRequest, rsp *static_proto.DeleteBehaviourCategoryResponse) error {
 	log.Info("Received Static.DeleteBehaviourCategory request")
-	if err := db.DeleteBehaviourCategory(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteBehaviourCategory(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteBehaviourCategory, nil, "delete error")
 	}
+	p.publishChanged(p.DeleteBehaviourCategory, common.DbBehaviourCategoryTable, req.Id)
 	return nil
 }
 
@@ -387,7 +404,7 @@ func (p *StaticService) ReadSocialType(ctx context.Context, req *static_proto.Re
 
 func (p *StaticService) DeleteSocialType(ctx context.Context, req *static_proto.DeleteSocialTypeRequest, rsp *static_proto.DeleteSocialTypeResponse) error {
 	log.Info("Received Static.DeleteSocialType request")
-	if err := db.DeleteSocialType(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteSocialType(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteSocialType, nil, "delete error")
 	}
 	return nil
@@ -432,7 +449,7 @@ func (p *StaticService) ReadNotification(ctx context.Context, req *static_proto.
 
 func (p *StaticService) DeleteNotification(ctx context.Context, req *static_proto.DeleteNotificationRequest, rsp *static_proto.DeleteNotificationResponse) error {
 	log.Info("Received Static.DeleteNotification request")
-	if err := db.DeleteNotification(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteNotification(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteNotification, nil, "delete error")
 	}
 	return nil
@@ -461,6 +478,7 @@ func (p *StaticService) CreateTrackerMethod(ctx context.Context, req *static_pro
 	if err != nil {
 		return common.InternalServerError(common.StaticSrv, p.CreateTrackerMethod, err, "create error")
 	}
+	p.publishChanged(p.CreateTrackerMethod, common.DbTrackerMethodTable, req.TrackerMethod.Id)
 	rsp.Data = &static_proto.TrackerMethodData{req.TrackerMethod}
 	return nil
 }
@@ -477,9 +495,10 @@ func (p *StaticService) ReadTrackerMethod(ctx context.Context, req *static_proto
 
 func (p *StaticService) DeleteTrackerMethod(ctx context.Context, req *static_proto.DeleteTrackerMethodRequest, rsp *static_proto.DeleteTrackerMethodResponse) error {
 	log.Info("Received Static.DeleteTrackerMethod request")
-	if err := db.DeleteTrackerMethod(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteTrackerMethod(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteTrackerMethod, nil, "delete error")
 	}
+	p.publishChanged(p.DeleteTrackerMethod, common.DbTrackerMethodTable, req.Id)
 	return nil
 }
 
@@ -516,6 +535,7 @@ func (p *StaticService) CreateBehaviourCategoryAim(ctx context.Context, req *sta
 	if err != nil {
 		return common.InternalServerError(common.StaticSrv, p.CreateBehaviourCategoryAim, err, "create error")
 	}
+	p.publishChanged(p.CreateBehaviourCategoryAim, common.DbBehaviourCategoryAimTable, req.BehaviourCategoryAim.Id)
 	rsp.Data = &static_proto.BehaviourCategoryAimData{req.BehaviourCategoryAim}
 	return nil
 }
@@ -532,9 +552,10 @@ func (p *StaticService) ReadBehaviourCategoryAim(ctx context.Context, req *stati
 
 func (p *StaticService) DeleteBehaviourCategoryAim(ctx context.Context, req *static_proto.DeleteBehaviourCategoryAimRequest, rsp *static_proto.DeleteBehaviourCategoryAimResponse) error {
 	log.Info("Received Static.DeleteBehaviourCategoryAim request")
-	if err := db.DeleteBehaviourCategoryAim(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteBehaviourCategoryAim(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteBehaviourCategoryAim, nil, "delete error")
 	}
+	p.publishChanged(p.DeleteBehaviourCategoryAim, common.DbBehaviourCategoryAimTable, req.Id)
 	return nil
 }
 
@@ -561,6 +582,7 @@ func (p *StaticService) CreateContentParentCategory(ctx context.Context, req *st
 	if err != nil {
 		return common.InternalServerError(common.StaticSrv, p.CreateContentParentCategory, err, "create error")
 	}
+	p.publishChanged(p.CreateContentParentCategory, common.DbContentParentCategoryTable, req.ContentParentCategory.Id)
 	rsp.Data = &static_proto.ContentParentCategoryData{req.ContentParentCategory}
 	return nil
 }
@@ -577,9 +599,10 @@ func (p *StaticService) ReadContentParentCategory(ctx context.Context, req *stat
 
 func (p *StaticService) DeleteContentParentCategory(ctx context.Context, req *static_proto.DeleteContentParentCategoryRequest, rsp *static_proto.DeleteContentParentCategoryResponse) error {
 	log.Info("Received Static.DeleteContentParentCategory request")
-	if err := db.DeleteContentParentCategory(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteContentParentCategory(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteContentParentCategory, nil, "delete error")
 	}
+	p.publishChanged(p.DeleteContentParentCategory, common.DbContentParentCategoryTable, req.Id)
 	return nil
 }
 
@@ -605,6 +628,7 @@ func (p *StaticService) CreateContentCategory(ctx context.Context, req *static_p
 	if err != nil {
 		return common.InternalServerError(common.StaticSrv, p.CreateContentCategory, err, "create error")
 	}
+	p.publishChanged(p.CreateContentCategory, common.DbContentCategoryTable, req.ContentCategory.Id)
 	rsp.Data = &static_proto.ContentCategoryData{req.ContentCategory}
 	return nil
 }
@@ -621,9 +645,10 @@ func (p *StaticService) ReadContentCategory(ctx context.Context, req *static_pro
 
 func (p *StaticService) DeleteContentCategory(ctx context.Context, req *static_proto.DeleteContentCategoryRequest, rsp *static_proto.DeleteContentCategoryResponse) error {
 	log.Info("Received Static.DeleteContentCategory request")
-	if err := db.DeleteContentCategory(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteContentCategory(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteContentCategory, nil, "delete error")
 	}
+	p.publishChanged(p.DeleteContentCategory, common.DbContentCategoryTable, req.Id)
 	return nil
 }
 
@@ -666,7 +691,7 @@ func (p *StaticService) ReadContentType(ctx context.Context, req *static_proto.R
 
 func (p *StaticService) DeleteContentType(ctx context.Context, req *static_proto.DeleteContentTypeRequest, rsp *static_proto.DeleteContentTypeResponse) error {
 	log.Info("Received Static.DeleteContentType request")
-	if err := db.DeleteContentType(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteContentType(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteContentType, err, "delete error")
 	}
 	return nil
@@ -711,7 +736,7 @@ func (p *StaticService) ReadContentSourceType(ctx context.Context, req *static_p
 
 func (p *StaticService) DeleteContentSourceType(ctx context.Context, req *static_proto.DeleteContentSourceTypeRequest, rsp *static_proto.DeleteContentSourceTypeResponse) error {
 	log.Info("Received Static.DeleteContentSourceType request")
-	if err := db.DeleteContentSourceType(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteContentSourceType(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteContentSourceType, nil, "delete error")
 	}
 	return nil
@@ -756,7 +781,7 @@ func (p *StaticService) ReadModuleTrigger(ctx context.Context, req *static_proto
 
 func (p *StaticService) DeleteModuleTrigger(ctx context.Context, req *static_proto.DeleteModuleTriggerRequest, rsp *static_proto.DeleteModuleTriggerResponse) error {
 	log.Info("Received Static.DeleteModuleTrigger request")
-	if err := db.DeleteModuleTrigger(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteModuleTrigger(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteModuleTrigger, nil, "delete error")
 	}
 	return nil
@@ -811,7 +836,7 @@ func (p *StaticService) ReadTriggerContentType(ctx context.Context, req *static_
 
 func (p *StaticService) DeleteTriggerContentType(ctx context.Context, req *static_proto.DeleteTriggerContentTypeRequest, rsp *static_proto.DeleteTriggerContentTypeResponse) error {
 	log.Info("Received Static.DeleteTriggerContentType request")
-	if err := db.DeleteTriggerContentType(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteTriggerContentType(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteTriggerContentType, nil, "delete error")
 	}
 	return nil
@@ -924,7 +949,7 @@ func (p *StaticService) ReadSetback(ctx context.Context, req *static_proto.ReadS
 
 func (p *StaticService) DeleteSetback(ctx context.Context, req *static_proto.DeleteSetbackRequest, rsp *static_proto.DeleteSetbackResponse) error {
 	log.Info("Received Static.DeleteSetback request")
-	if err := db.DeleteSetback(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.DeleteSetback(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.StaticSrv, p.DeleteSetback, err, "delete error")
 	}
 	return nil
@@ -1098,24 +1123,28 @@ func (p *StaticService) UpdateSetback(ctx context.Context, req *static_proto.Upd
 func (p *StaticService) UploadBehaviourCategoryAim(ctx context.Context, req *static_proto.UploadRequest, rsp *static_proto.UploadResponse) error {
 	log.Info("Received Static.UploadBehaviourCategoryAim request")
 
+	p.publishChanged(p.UploadBehaviourCategoryAim, common.DbBehaviourCategoryAimTable, "")
 	return nil
 }
 
 func (p *StaticService) UploadContentCategory(ctx context.Context, req *static_proto.UploadRequest, rsp *static_proto.UploadResponse) error {
 	log.Info("Received Static.UploadContentCategory request")
 
+	p.publishChanged(p.UploadContentCategory, common.DbContentCategoryTable, "")
 	return nil
 }
 
 func (p *StaticService) UploadMarker(ctx context.Context, req *static_proto.UploadRequest, rsp *static_proto.UploadResponse) error {
 	log.Info("Received Static.UploadMarker request")
 
+	p.publishChanged(p.UploadMarker, common.DbMarkerTable, "")
 	return nil
 }
 
 func (p *StaticService) UploadBehaviourCategory(ctx context.Context, req *static_proto.UploadRequest, rsp *static_proto.UploadResponse) error {
 	log.Info("Received Static.UploadBehaviourCategory request")
 
+	p.publishChanged(p.UploadBehaviourCategory, common.DbBehaviourCategoryTable, "")
 	return nil
 }
 
@@ -1128,5 +1157,51 @@ func (p *StaticService) UploadContentCategoryItem(ctx context.Context, req *stat
 func (p *StaticService) UploadTrackerMethod(ctx context.Context, req *static_proto.UploadRequest, rsp *static_proto.UploadResponse) error {
 	log.Info("Received Static.UploadBehaviourCategory request")
 
+	p.publishChanged(p.UploadTrackerMethod, common.DbTrackerMethodTable, "")
+	return nil
+}
+
+func (p *StaticService) Trash(ctx context.Context, req *static_proto.TrashRequest, rsp *static_proto.TrashResponse) error {
+	log.Info("Received Static.Trash request")
+	items, err := db.Trash(ctx, req.Collections, req.OrgId, req.TeamId, req.Offset, req.Limit)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.StaticSrv, p.Trash, err, "collection is invalid")
+	}
+	if err != nil {
+		return common.InternalServerError(common.StaticSrv, p.Trash, err, "trash error")
+	}
+	rsp.Data = &static_proto.TrashArrData{items}
+	return nil
+}
+
+func (p *StaticService) Restore(ctx context.Context, req *static_proto.RestoreRequest, rsp *static_proto.RestoreResponse) error {
+	log.Info("Received Static.Restore request")
+	item, err := db.Restore(ctx, req.Id, req.Collection, req.OrgId, req.TeamId)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.StaticSrv, p.Restore, err, "collection is invalid")
+	}
+	if common.IsNotFound(err) {
+		return common.NotFound(common.StaticSrv, p.Restore, err, "trashed document not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.StaticSrv, p.Restore, err, "restore error")
+	}
+	if common.StaticChanges(req.Collection) {
+		p.publishChanged(p.Restore, req.Collection, req.Id)
+	}
+	rsp.Data = &static_proto.TrashData{item}
+	return nil
+}
+
+func (p *StaticService) ReadMany(ctx context.Context, req *static_proto.ReadManyRequest, rsp *static_proto.ReadManyResponse) error {
+	log.Info("Received Static.ReadMany request")
+	data, err := db.ReadMany(ctx, req.Collection, req.Ids)
+	if err == common.ErrStaticCollection {
+		return common.BadRequest(common.StaticSrv, p.ReadMany, err, "collection is invalid")
+	}
+	if err != nil {
+		return common.InternalServerError(common.StaticSrv, p.ReadMany, err, "read error")
+	}
+	rsp.Data = data
 	return nil
 }
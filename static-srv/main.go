@@ -65,7 +65,9 @@ func main() {
 		log.SetLevel(log.DebugLevel)
 	}
 
-	static_proto.RegisterStaticServiceHandler(service.Server(), new(handler.StaticService))
+	static_proto.RegisterStaticServiceHandler(service.Server(), &handler.StaticService{
+		Broker: service.Client().Options().Broker,
+	})
 	db.Init(service.Client())
 
 	if err := service.Run(); err != nil {
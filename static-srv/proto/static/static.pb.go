@@ -278,6 +278,20 @@ It has these top-level messages:
 	Setting
 	Batch
 	SharedUserId
+	VersionChange
+	Version
+	VersionArrData
+	TrashItem
+	TrashData
+	TrashArrData
+	TrashRequest
+	TrashResponse
+	RestoreRequest
+	RestoreResponse
+	ReadManyRequest
+	ReadManyData
+	ReadManyResponse
+	StaticChanged
 */
 package go_micro_srv_static
 
@@ -1391,6 +1405,7 @@ type DeleteAppRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteAppRequest) Reset()                    { *m = DeleteAppRequest{} }
@@ -1419,6 +1434,13 @@ func (m *DeleteAppRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteAppRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteAppResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -1727,6 +1749,7 @@ type DeletePlatformRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeletePlatformRequest) Reset()                    { *m = DeletePlatformRequest{} }
@@ -1755,6 +1778,13 @@ func (m *DeletePlatformRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeletePlatformRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeletePlatformResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -2063,6 +2093,7 @@ type DeleteDeviceRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteDeviceRequest) Reset()                    { *m = DeleteDeviceRequest{} }
@@ -2091,6 +2122,13 @@ func (m *DeleteDeviceRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteDeviceRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteDeviceResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -2399,6 +2437,7 @@ type DeleteWearableRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteWearableRequest) Reset()                    { *m = DeleteWearableRequest{} }
@@ -2427,6 +2466,13 @@ func (m *DeleteWearableRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteWearableRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteWearableResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -2735,6 +2781,7 @@ type DeleteMarkerRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteMarkerRequest) Reset()                    { *m = DeleteMarkerRequest{} }
@@ -2763,6 +2810,13 @@ func (m *DeleteMarkerRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteMarkerRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteMarkerResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -3167,6 +3221,7 @@ type DeleteModuleRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteModuleRequest) Reset()                    { *m = DeleteModuleRequest{} }
@@ -3195,6 +3250,13 @@ func (m *DeleteModuleRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteModuleRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteModuleResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -3511,6 +3573,7 @@ type DeleteBehaviourCategoryRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteBehaviourCategoryRequest) Reset()         { *m = DeleteBehaviourCategoryRequest{} }
@@ -3541,6 +3604,13 @@ func (m *DeleteBehaviourCategoryRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteBehaviourCategoryRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteBehaviourCategoryResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -3951,6 +4021,7 @@ type DeleteSocialTypeRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteSocialTypeRequest) Reset()                    { *m = DeleteSocialTypeRequest{} }
@@ -3979,6 +4050,13 @@ func (m *DeleteSocialTypeRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteSocialTypeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteSocialTypeResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -4287,6 +4365,7 @@ type DeleteNotificationRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteNotificationRequest) Reset()                    { *m = DeleteNotificationRequest{} }
@@ -4315,6 +4394,13 @@ func (m *DeleteNotificationRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteNotificationRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteNotificationResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -4631,6 +4717,7 @@ type DeleteTrackerMethodRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteTrackerMethodRequest) Reset()                    { *m = DeleteTrackerMethodRequest{} }
@@ -4659,6 +4746,13 @@ func (m *DeleteTrackerMethodRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteTrackerMethodRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteTrackerMethodResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -5079,6 +5173,7 @@ type DeleteBehaviourCategoryAimRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteBehaviourCategoryAimRequest) Reset()         { *m = DeleteBehaviourCategoryAimRequest{} }
@@ -5109,6 +5204,13 @@ func (m *DeleteBehaviourCategoryAimRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteBehaviourCategoryAimRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteBehaviourCategoryAimResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -5435,6 +5537,7 @@ type DeleteContentParentCategoryRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteContentParentCategoryRequest) Reset()         { *m = DeleteContentParentCategoryRequest{} }
@@ -5465,6 +5568,13 @@ func (m *DeleteContentParentCategoryRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteContentParentCategoryRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteContentParentCategoryResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -5775,6 +5885,7 @@ type DeleteContentCategoryRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteContentCategoryRequest) Reset()                    { *m = DeleteContentCategoryRequest{} }
@@ -5803,6 +5914,13 @@ func (m *DeleteContentCategoryRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteContentCategoryRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteContentCategoryResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -6111,6 +6229,7 @@ type DeleteContentTypeRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteContentTypeRequest) Reset()                    { *m = DeleteContentTypeRequest{} }
@@ -6139,6 +6258,13 @@ func (m *DeleteContentTypeRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteContentTypeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteContentTypeResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -6455,6 +6581,7 @@ type DeleteContentSourceTypeRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteContentSourceTypeRequest) Reset()         { *m = DeleteContentSourceTypeRequest{} }
@@ -6485,6 +6612,13 @@ func (m *DeleteContentSourceTypeRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteContentSourceTypeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteContentSourceTypeResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -6795,6 +6929,7 @@ type DeleteModuleTriggerRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteModuleTriggerRequest) Reset()                    { *m = DeleteModuleTriggerRequest{} }
@@ -6823,6 +6958,13 @@ func (m *DeleteModuleTriggerRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteModuleTriggerRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteModuleTriggerResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -7247,6 +7389,7 @@ type DeleteTriggerContentTypeRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteTriggerContentTypeRequest) Reset()         { *m = DeleteTriggerContentTypeRequest{} }
@@ -7277,6 +7420,13 @@ func (m *DeleteTriggerContentTypeRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteTriggerContentTypeRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteTriggerContentTypeResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -7919,6 +8069,7 @@ type DeleteSetbackRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteSetbackRequest) Reset()                    { *m = DeleteSetbackRequest{} }
@@ -7947,6 +8098,13 @@ func (m *DeleteSetbackRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteSetbackRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteSetbackResponse struct {
 	Code    int64  `protobuf:"varint,1,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -10339,6 +10497,511 @@ func (m *SharedUserId) GetUserId() string {
 	return ""
 }
 
+// a change of a record between two versions, the values are json encoded
+type VersionChange struct {
+	// dotted path of the attribute e.g. data.target.value
+	Path     string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue" json:"new_value,omitempty"`
+}
+
+func (m *VersionChange) Reset()                    { *m = VersionChange{} }
+func (m *VersionChange) String() string            { return proto.CompactTextString(m) }
+func (*VersionChange) ProtoMessage()               {}
+func (*VersionChange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{270} }
+
+func (m *VersionChange) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *VersionChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *VersionChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+// a snapshot of a record in its history, one is saved on every write changing it
+type Version struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// collection of the record e.g. goal
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	DocumentId string `protobuf:"bytes,3,opt,name=document_id,json=documentId" json:"document_id,omitempty"`
+	// number of the version, the first one is 1
+	Version int64  `protobuf:"varint,4,opt,name=version" json:"version,omitempty"`
+	OrgId   string `protobuf:"bytes,5,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	// id of the user who wrote the version
+	AuthorId string `protobuf:"bytes,6,opt,name=author_id,json=authorId" json:"author_id,omitempty"`
+	Created  int64  `protobuf:"varint,7,opt,name=created" json:"created,omitempty"`
+	// changes since the previous version, empty for the first one
+	Changes []*VersionChange `protobuf:"bytes,8,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *Version) Reset()                    { *m = Version{} }
+func (m *Version) String() string            { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()               {}
+func (*Version) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{271} }
+
+func (m *Version) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Version) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *Version) GetDocumentId() string {
+	if m != nil {
+		return m.DocumentId
+	}
+	return ""
+}
+
+func (m *Version) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Version) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *Version) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *Version) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Version) GetChanges() []*VersionChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type VersionArrData struct {
+	Versions []*Version `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+}
+
+func (m *VersionArrData) Reset()                    { *m = VersionArrData{} }
+func (m *VersionArrData) String() string            { return proto.CompactTextString(m) }
+func (*VersionArrData) ProtoMessage()               {}
+func (*VersionArrData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{272} }
+
+func (m *VersionArrData) GetVersions() []*Version {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// a deleted record, it's kept in the trash until it's restored or purged
+type TrashItem struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// collection of the record e.g. goal
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	OrgId      string `protobuf:"bytes,4,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	Created    int64  `protobuf:"varint,5,opt,name=created" json:"created,omitempty"`
+	DeletedAt  int64  `protobuf:"varint,6,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
+	// id of the user who deleted the record
+	DeletedBy string `protobuf:"bytes,7,opt,name=deleted_by,json=deletedBy" json:"deleted_by,omitempty"`
+}
+
+func (m *TrashItem) Reset()                    { *m = TrashItem{} }
+func (m *TrashItem) String() string            { return proto.CompactTextString(m) }
+func (*TrashItem) ProtoMessage()               {}
+func (*TrashItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{273} }
+
+func (m *TrashItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TrashItem) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *TrashItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TrashItem) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *TrashItem) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *TrashItem) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
+func (m *TrashItem) GetDeletedBy() string {
+	if m != nil {
+		return m.DeletedBy
+	}
+	return ""
+}
+
+type TrashData struct {
+	Item *TrashItem `protobuf:"bytes,1,opt,name=item" json:"item,omitempty"`
+}
+
+func (m *TrashData) Reset()                    { *m = TrashData{} }
+func (m *TrashData) String() string            { return proto.CompactTextString(m) }
+func (*TrashData) ProtoMessage()               {}
+func (*TrashData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{274} }
+
+func (m *TrashData) GetItem() *TrashItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type TrashArrData struct {
+	Items []*TrashItem `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+}
+
+func (m *TrashArrData) Reset()                    { *m = TrashArrData{} }
+func (m *TrashArrData) String() string            { return proto.CompactTextString(m) }
+func (*TrashArrData) ProtoMessage()               {}
+func (*TrashArrData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{275} }
+
+func (m *TrashArrData) GetItems() []*TrashItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// lists the deleted records of the service, the latest deleted first
+type TrashRequest struct {
+	OrgId  string `protobuf:"bytes,1,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId string `protobuf:"bytes,2,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	// collections of the records, all the collections of the service if empty
+	Collections []string `protobuf:"bytes,3,rep,name=collections" json:"collections,omitempty"`
+	Offset      int64    `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit       int64    `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *TrashRequest) Reset()                    { *m = TrashRequest{} }
+func (m *TrashRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrashRequest) ProtoMessage()               {}
+func (*TrashRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{276} }
+
+func (m *TrashRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetCollections() []string {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func (m *TrashRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TrashRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TrashResponse struct {
+	Data    *TrashArrData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64         `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string        `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *TrashResponse) Reset()                    { *m = TrashResponse{} }
+func (m *TrashResponse) String() string            { return proto.CompactTextString(m) }
+func (*TrashResponse) ProtoMessage()               {}
+func (*TrashResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{277} }
+
+func (m *TrashResponse) GetData() *TrashArrData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TrashResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TrashResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// restores a deleted record and its edges
+type RestoreRequest struct {
+	Id         string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	OrgId      string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId     string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()               {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{278} }
+
+func (m *RestoreRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type RestoreResponse struct {
+	Data    *TrashData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64      `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string     `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RestoreResponse) Reset()                    { *m = RestoreResponse{} }
+func (m *RestoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreResponse) ProtoMessage()               {}
+func (*RestoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{279} }
+
+func (m *RestoreResponse) GetData() *TrashData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RestoreResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// reads the records of a reference collection by id, the ids which don't exist are skipped
+type ReadManyRequest struct {
+	// marker, tracker_method, behaviour_category or content_category
+	Collection string   `protobuf:"bytes,1,opt,name=collection" json:"collection,omitempty"`
+	Ids        []string `protobuf:"bytes,2,rep,name=ids" json:"ids,omitempty"`
+}
+
+func (m *ReadManyRequest) Reset()                    { *m = ReadManyRequest{} }
+func (m *ReadManyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadManyRequest) ProtoMessage()               {}
+func (*ReadManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{280} }
+
+func (m *ReadManyRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *ReadManyRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// only the records of the requested collection are set
+type ReadManyData struct {
+	Markers             []*Marker            `protobuf:"bytes,1,rep,name=markers" json:"markers,omitempty"`
+	TrackerMethods      []*TrackerMethod     `protobuf:"bytes,2,rep,name=tracker_methods,json=trackerMethods" json:"tracker_methods,omitempty"`
+	BehaviourCategories []*BehaviourCategory `protobuf:"bytes,3,rep,name=behaviour_categories,json=behaviourCategories" json:"behaviour_categories,omitempty"`
+	ContentCategories   []*ContentCategory   `protobuf:"bytes,4,rep,name=content_categories,json=contentCategories" json:"content_categories,omitempty"`
+}
+
+func (m *ReadManyData) Reset()                    { *m = ReadManyData{} }
+func (m *ReadManyData) String() string            { return proto.CompactTextString(m) }
+func (*ReadManyData) ProtoMessage()               {}
+func (*ReadManyData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{281} }
+
+func (m *ReadManyData) GetMarkers() []*Marker {
+	if m != nil {
+		return m.Markers
+	}
+	return nil
+}
+
+func (m *ReadManyData) GetTrackerMethods() []*TrackerMethod {
+	if m != nil {
+		return m.TrackerMethods
+	}
+	return nil
+}
+
+func (m *ReadManyData) GetBehaviourCategories() []*BehaviourCategory {
+	if m != nil {
+		return m.BehaviourCategories
+	}
+	return nil
+}
+
+func (m *ReadManyData) GetContentCategories() []*ContentCategory {
+	if m != nil {
+		return m.ContentCategories
+	}
+	return nil
+}
+
+type ReadManyResponse struct {
+	Data    *ReadManyData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64         `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string        `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ReadManyResponse) Reset()                    { *m = ReadManyResponse{} }
+func (m *ReadManyResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadManyResponse) ProtoMessage()               {}
+func (*ReadManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{282} }
+
+func (m *ReadManyResponse) GetData() *ReadManyData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadManyResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReadManyResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// published on the static_changed_topic when a reference record is written or deleted
+type StaticChanged struct {
+	Collection string `protobuf:"bytes,1,opt,name=collection" json:"collection,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *StaticChanged) Reset()                    { *m = StaticChanged{} }
+func (m *StaticChanged) String() string            { return proto.CompactTextString(m) }
+func (*StaticChanged) ProtoMessage()               {}
+func (*StaticChanged) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{283} }
+
+func (m *StaticChanged) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *StaticChanged) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AppData)(nil), "go.micro.srv.static.AppData")
 	proto.RegisterType((*AppArrData)(nil), "go.micro.srv.static.AppArrData")
@@ -10610,6 +11273,20 @@ func init() {
 	proto.RegisterType((*Setting)(nil), "go.micro.srv.static.Setting")
 	proto.RegisterType((*Batch)(nil), "go.micro.srv.static.Batch")
 	proto.RegisterType((*SharedUserId)(nil), "go.micro.srv.static.SharedUserId")
+	proto.RegisterType((*VersionChange)(nil), "go.micro.srv.static.VersionChange")
+	proto.RegisterType((*Version)(nil), "go.micro.srv.static.Version")
+	proto.RegisterType((*VersionArrData)(nil), "go.micro.srv.static.VersionArrData")
+	proto.RegisterType((*TrashItem)(nil), "go.micro.srv.static.TrashItem")
+	proto.RegisterType((*TrashData)(nil), "go.micro.srv.static.TrashData")
+	proto.RegisterType((*TrashArrData)(nil), "go.micro.srv.static.TrashArrData")
+	proto.RegisterType((*TrashRequest)(nil), "go.micro.srv.static.TrashRequest")
+	proto.RegisterType((*TrashResponse)(nil), "go.micro.srv.static.TrashResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "go.micro.srv.static.RestoreRequest")
+	proto.RegisterType((*RestoreResponse)(nil), "go.micro.srv.static.RestoreResponse")
+	proto.RegisterType((*ReadManyRequest)(nil), "go.micro.srv.static.ReadManyRequest")
+	proto.RegisterType((*ReadManyData)(nil), "go.micro.srv.static.ReadManyData")
+	proto.RegisterType((*ReadManyResponse)(nil), "go.micro.srv.static.ReadManyResponse")
+	proto.RegisterType((*StaticChanged)(nil), "go.micro.srv.static.StaticChanged")
 	proto.RegisterEnum("go.micro.srv.static.NotificationTarget", NotificationTarget_name, NotificationTarget_value)
 	proto.RegisterEnum("go.micro.srv.static.TriggerType", TriggerType_name, TriggerType_value)
 	proto.RegisterEnum("go.micro.srv.static.Permission", Permission_name, Permission_value)
@@ -10741,6 +11418,9 @@ type StaticServiceClient interface {
 	UploadBehaviourCategory(ctx context.Context, in *UploadRequest, opts ...client.CallOption) (*UploadResponse, error)
 	UploadContentCategoryItem(ctx context.Context, in *UploadRequest, opts ...client.CallOption) (*UploadResponse, error)
 	UploadTrackerMethod(ctx context.Context, in *UploadRequest, opts ...client.CallOption) (*UploadResponse, error)
+	Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error)
+	ReadMany(ctx context.Context, in *ReadManyRequest, opts ...client.CallOption) (*ReadManyResponse, error)
 }
 
 type staticServiceClient struct {
@@ -11891,6 +12571,36 @@ func (c *staticServiceClient) UploadTrackerMethod(ctx context.Context, in *Uploa
 	return out, nil
 }
 
+func (c *staticServiceClient) Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "StaticService.Trash", in)
+	out := new(TrashResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "StaticService.Restore", in)
+	out := new(RestoreResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staticServiceClient) ReadMany(ctx context.Context, in *ReadManyRequest, opts ...client.CallOption) (*ReadManyResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "StaticService.ReadMany", in)
+	out := new(ReadManyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for StaticService service
 
 type StaticServiceHandler interface {
@@ -12007,6 +12717,9 @@ type StaticServiceHandler interface {
 	UploadBehaviourCategory(context.Context, *UploadRequest, *UploadResponse) error
 	UploadContentCategoryItem(context.Context, *UploadRequest, *UploadResponse) error
 	UploadTrackerMethod(context.Context, *UploadRequest, *UploadResponse) error
+	Trash(context.Context, *TrashRequest, *TrashResponse) error
+	Restore(context.Context, *RestoreRequest, *RestoreResponse) error
+	ReadMany(context.Context, *ReadManyRequest, *ReadManyResponse) error
 }
 
 func RegisterStaticServiceHandler(s server.Server, hdlr StaticServiceHandler, opts ...server.HandlerOption) {
@@ -12469,434 +13182,472 @@ func (h *StaticService) UploadTrackerMethod(ctx context.Context, in *UploadReque
 	return h.StaticServiceHandler.UploadTrackerMethod(ctx, in, out)
 }
 
+func (h *StaticService) Trash(ctx context.Context, in *TrashRequest, out *TrashResponse) error {
+	return h.StaticServiceHandler.Trash(ctx, in, out)
+}
+
+func (h *StaticService) Restore(ctx context.Context, in *RestoreRequest, out *RestoreResponse) error {
+	return h.StaticServiceHandler.Restore(ctx, in, out)
+}
+
+func (h *StaticService) ReadMany(ctx context.Context, in *ReadManyRequest, out *ReadManyResponse) error {
+	return h.StaticServiceHandler.ReadMany(ctx, in, out)
+}
+
 func init() { proto.RegisterFile("server/static-srv/proto/static/static.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0xcf, 0x73, 0x1c, 0xc7,
-	0x75, 0x3f, 0x67, 0x77, 0xb1, 0x58, 0x3c, 0xfc, 0xe0, 0x72, 0x00, 0x90, 0x20, 0xf8, 0x0b, 0x1c,
-	0xc9, 0x24, 0x05, 0x89, 0xa0, 0xf8, 0x43, 0x32, 0x29, 0x7f, 0x6d, 0x7d, 0x97, 0xc0, 0x52, 0x84,
-	0x0c, 0x82, 0xc8, 0x00, 0x24, 0x2d, 0x59, 0x16, 0x34, 0xdc, 0x1d, 0x02, 0x5b, 0xdc, 0xc5, 0xae,
-	0x66, 0x67, 0x49, 0x43, 0xb6, 0xe4, 0x58, 0x72, 0xec, 0xc8, 0x89, 0xa3, 0x48, 0x96, 0x9d, 0x4a,
-	0x0e, 0x49, 0xa5, 0x2a, 0x95, 0x4a, 0x7c, 0xc8, 0x21, 0x95, 0xca, 0x21, 0x95, 0x2a, 0x27, 0xe5,
-	0x24, 0x55, 0x49, 0xca, 0xe5, 0xca, 0x39, 0xb7, 0x1c, 0x52, 0xc9, 0x29, 0x49, 0x25, 0x7f, 0x40,
-	0x6a, 0xa6, 0xbb, 0x67, 0xba, 0x67, 0xba, 0x7b, 0x66, 0x76, 0x16, 0xbb, 0x84, 0x2a, 0xa7, 0xdd,
-	0xe9, 0x79, 0xdd, 0xef, 0xbd, 0x4f, 0xf7, 0xbc, 0xee, 0xe9, 0x7e, 0xf3, 0x1e, 0x3c, 0xdb, 0x36,
-	0xad, 0x47, 0xa6, 0x75, 0xa1, 0x6d, 0x1b, 0x76, 0xad, 0x72, 0xbe, 0x6d, 0x3d, 0xba, 0xd0, 0xb2,
-	0x9a, 0x76, 0x13, 0x17, 0xe0, 0x9f, 0x05, 0xb7, 0x4c, 0x9d, 0xdc, 0x6a, 0x2e, 0x34, 0x6a, 0x15,
-	0xab, 0xb9, 0xd0, 0xb6, 0x1e, 0x2d, 0xa0, 0x5b, 0xda, 0x0b, 0x30, 0x5c, 0x6a, 0xb5, 0x96, 0x0c,
-	0xdb, 0x50, 0xe7, 0x21, 0x6b, 0xb4, 0x5a, 0x33, 0xca, 0x9c, 0x72, 0x6e, 0xf4, 0xd2, 0xcc, 0x02,
-	0x87, 0x7a, 0xa1, 0xd4, 0x6a, 0xe9, 0x0e, 0x91, 0xf6, 0x12, 0x40, 0xa9, 0xd5, 0x2a, 0x59, 0x96,
-	0x5b, 0xf3, 0x39, 0xc8, 0x19, 0xad, 0x56, 0x7b, 0x46, 0x99, 0xcb, 0x4a, 0xab, 0xba, 0x54, 0xda,
-	0x32, 0x8c, 0xad, 0xd5, 0x0d, 0xfb, 0x41, 0xd3, 0x6a, 0xb8, 0xb5, 0xaf, 0x41, 0xa1, 0x85, 0xaf,
-	0x31, 0xf3, 0x13, 0xdc, 0x16, 0x48, 0x25, 0xdd, 0x23, 0xd7, 0x56, 0xe1, 0x20, 0x29, 0x25, 0xb2,
-	0x7c, 0x01, 0x46, 0xc8, 0x6d, 0x22, 0x50, 0x44, 0x73, 0x3e, 0xbd, 0x56, 0x02, 0x58, 0x32, 0x1f,
-	0xd5, 0x2a, 0xa6, 0xdb, 0xd4, 0x65, 0xc8, 0x57, 0xdd, 0x2b, 0x2c, 0xd6, 0x31, 0x6e, 0x3b, 0xa8,
-	0x82, 0x8e, 0x49, 0xb5, 0x1b, 0x30, 0x8e, 0x4a, 0x88, 0x40, 0x2f, 0xc0, 0x30, 0xba, 0x45, 0xc4,
-	0x91, 0x36, 0x43, 0x68, 0x1d, 0x94, 0xee, 0x99, 0x86, 0x65, 0xdc, 0xaf, 0x9b, 0x04, 0xa5, 0xc7,
-	0xf8, 0x5a, 0x8a, 0x12, 0xa9, 0xa4, 0x7b, 0xe4, 0x0e, 0x4a, 0xa4, 0x94, 0x42, 0x89, 0xdc, 0x96,
-	0xa3, 0xe4, 0x35, 0xe7, 0xd3, 0x3b, 0x28, 0xdd, 0x32, 0xac, 0x87, 0xa6, 0x45, 0x50, 0x6a, 0xb8,
-	0x57, 0x52, 0x94, 0x50, 0x05, 0x1d, 0x93, 0x3a, 0x28, 0xa1, 0x12, 0x0a, 0x25, 0x74, 0x4b, 0x8e,
-	0x12, 0x6e, 0x86, 0xd0, 0xba, 0xa2, 0x34, 0xab, 0x9d, 0xba, 0xd7, 0x61, 0x0d, 0xf7, 0x4a, 0x2e,
-	0x8a, 0x4b, 0xa2, 0x63, 0x52, 0x57, 0x14, 0xf7, 0x1f, 0x2d, 0x8a, 0x5b, 0x10, 0x21, 0x0a, 0x6a,
-	0x86, 0xd0, 0x6a, 0x5f, 0x85, 0xe9, 0xeb, 0xe6, 0xb6, 0xf1, 0xa8, 0xd6, 0xec, 0x58, 0x8b, 0x86,
-	0x6d, 0x6e, 0x35, 0xad, 0x5d, 0xb7, 0xbd, 0xeb, 0x50, 0xa8, 0xe0, 0x6b, 0x2c, 0xd7, 0x19, 0x6e,
-	0x83, 0xa1, 0xda, 0xba, 0x57, 0x4f, 0xbb, 0x0f, 0x33, 0xa1, 0xdb, 0x44, 0xde, 0x1b, 0x00, 0x98,
-	0xae, 0xe6, 0x89, 0x1c, 0x97, 0x03, 0x55, 0x53, 0xfb, 0x25, 0x98, 0x58, 0x6f, 0x56, 0x6a, 0x46,
-	0x7d, 0x63, 0xb7, 0x85, 0xf0, 0x7c, 0x19, 0xa0, 0xed, 0x95, 0x60, 0xd9, 0x4f, 0x71, 0x5b, 0xf6,
-	0x2b, 0xea, 0x54, 0x15, 0xed, 0x2e, 0x1c, 0xf2, 0xef, 0x10, 0x79, 0x4b, 0x30, 0xea, 0x93, 0x10,
-	0x81, 0x23, 0x9b, 0xa5, 0xeb, 0x68, 0xaf, 0x41, 0x71, 0xb5, 0x69, 0xd7, 0x1e, 0xd4, 0x2a, 0x86,
-	0x5d, 0x6b, 0xee, 0xb8, 0xcd, 0x96, 0x61, 0x6c, 0x87, 0x2a, 0xc3, 0xe2, 0x9e, 0xe6, 0xb6, 0x4b,
-	0x57, 0xd6, 0x99, 0x6a, 0xda, 0x9b, 0x30, 0x49, 0xdf, 0x25, 0x42, 0xbf, 0x02, 0xe3, 0x34, 0x19,
-	0x11, 0x3b, 0x46, 0xf3, 0x6c, 0x3d, 0xed, 0x6b, 0x70, 0x68, 0xc3, 0x32, 0x2a, 0x0f, 0x4d, 0xeb,
-	0x96, 0x69, 0x6f, 0x37, 0xab, 0x6e, 0xeb, 0x37, 0x61, 0xdc, 0xa6, 0x0b, 0xb1, 0xf0, 0x1a, 0xb7,
-	0x75, 0xa6, 0xba, 0xce, 0x56, 0xd4, 0xee, 0xc3, 0x14, 0x73, 0x9f, 0xc8, 0xff, 0x2a, 0x4c, 0x30,
-	0x84, 0x44, 0x81, 0x38, 0x2c, 0x02, 0x35, 0xb5, 0x5d, 0xde, 0x60, 0xac, 0x21, 0x63, 0xfe, 0x35,
-	0x98, 0xba, 0xcf, 0xb9, 0x87, 0x15, 0x7a, 0x26, 0xde, 0xb0, 0x2c, 0xd5, 0x1a, 0x3a, 0xb7, 0x19,
-	0xed, 0x3d, 0x38, 0xc6, 0xa3, 0x26, 0x5a, 0x6e, 0xc2, 0x34, 0xaf, 0x1a, 0x51, 0x36, 0x01, 0x7b,
-	0x7e, 0x3b, 0xda, 0xbb, 0x70, 0x74, 0xb1, 0xb9, 0x63, 0x9b, 0x3b, 0xf6, 0x9a, 0x61, 0x99, 0x3b,
-	0x36, 0xf3, 0xa0, 0xbf, 0x05, 0xd3, 0x15, 0xde, 0x4d, 0xac, 0xfc, 0x3c, 0x97, 0x3b, 0xb7, 0x39,
-	0x9d, 0xdf, 0x90, 0xf6, 0x1d, 0x05, 0x8e, 0x73, 0x2b, 0x10, 0x00, 0xaa, 0x70, 0x84, 0x57, 0xd3,
-	0x37, 0x0c, 0x49, 0x84, 0x10, 0x35, 0xa5, 0x99, 0x30, 0x89, 0x6b, 0x30, 0xfa, 0xaf, 0xc2, 0xc1,
-	0x0a, 0x5b, 0x8c, 0x35, 0x7f, 0x5a, 0xc6, 0xd4, 0x63, 0x17, 0xac, 0xac, 0xd5, 0xe1, 0x70, 0x80,
-	0x86, 0xa8, 0xa9, 0xc3, 0x21, 0x96, 0xd8, 0x57, 0x30, 0x1e, 0xaf, 0x70, 0x75, 0xed, 0x0e, 0x1c,
-	0xc4, 0x54, 0x9e, 0xfd, 0xbb, 0x0e, 0xa3, 0x15, 0xbf, 0x08, 0x2b, 0x33, 0x27, 0x63, 0x80, 0x4c,
-	0x15, 0x55, 0x49, 0x7b, 0x1d, 0x54, 0xea, 0x1e, 0x51, 0x60, 0x09, 0xc6, 0x28, 0x22, 0x22, 0x7b,
-	0x74, 0xd3, 0x4c, 0x2d, 0xad, 0x01, 0xd3, 0xf8, 0xe6, 0x7a, 0xb3, 0x63, 0x55, 0x4c, 0x4f, 0xf0,
-	0x0d, 0x0f, 0x1f, 0xff, 0x86, 0x74, 0xee, 0x09, 0x35, 0xa3, 0x87, 0x1b, 0xd0, 0x2c, 0x98, 0x09,
-	0xd1, 0x11, 0x85, 0xee, 0x82, 0x1a, 0xaa, 0x20, 0x9f, 0x8c, 0xc2, 0x2c, 0x39, 0x2d, 0x38, 0xe6,
-	0x12, 0x4d, 0xb4, 0x1b, 0x56, 0x6d, 0x6b, 0x0b, 0x2f, 0x39, 0x6e, 0xc2, 0x78, 0x83, 0x2e, 0x94,
-	0x9a, 0x4b, 0xa6, 0xba, 0xce, 0x56, 0x74, 0xcc, 0x25, 0x73, 0x9f, 0x32, 0x97, 0x0c, 0xa1, 0xdc,
-	0x5c, 0xb2, 0x2c, 0x02, 0x35, 0xb5, 0xb7, 0xe1, 0x30, 0xfe, 0x1f, 0x1c, 0x5f, 0xf7, 0x40, 0xb5,
-	0x43, 0x77, 0xb0, 0x32, 0x67, 0x05, 0x86, 0x39, 0x48, 0xae, 0x73, 0x9a, 0xd0, 0x1e, 0xc1, 0xd1,
-	0x30, 0x25, 0xd1, 0xed, 0x35, 0x98, 0x0c, 0x57, 0x21, 0x0a, 0xc6, 0x66, 0xcb, 0x6b, 0x43, 0xbb,
-	0x06, 0x05, 0xbd, 0x89, 0x17, 0x63, 0xe7, 0x21, 0x67, 0x35, 0xbd, 0xa5, 0xd8, 0x51, 0x6e, 0xbb,
-	0x0e, 0xb1, 0xee, 0x92, 0x69, 0x5f, 0x82, 0x51, 0xe7, 0x8a, 0x08, 0x79, 0x01, 0x86, 0x9c, 0x62,
-	0x22, 0x96, 0xa4, 0x3a, 0xa2, 0xd3, 0xfe, 0x52, 0x81, 0x89, 0x52, 0xbd, 0x5e, 0x6a, 0xb5, 0xda,
-	0xba, 0xf9, 0x76, 0xc7, 0x6c, 0xdb, 0xea, 0x34, 0xe4, 0x9b, 0xd6, 0xd6, 0x66, 0x0d, 0x4d, 0xa7,
-	0x23, 0xfa, 0x50, 0xd3, 0xda, 0x5a, 0xae, 0xaa, 0x47, 0x60, 0xd8, 0x36, 0x8d, 0x86, 0x53, 0x9e,
-	0x71, 0xcb, 0xf3, 0xce, 0xe5, 0x72, 0x55, 0x9d, 0x82, 0xa1, 0x7a, 0xad, 0x51, 0xb3, 0x67, 0xb2,
-	0x73, 0xca, 0xb9, 0xac, 0x8e, 0x2e, 0xd4, 0xc3, 0x90, 0x6f, 0x3e, 0x78, 0xd0, 0x36, 0xed, 0x99,
-	0x9c, 0x5b, 0x8c, 0xaf, 0xd4, 0xcf, 0xc1, 0x44, 0xbb, 0x69, 0xd9, 0x9b, 0x2d, 0xc3, 0x32, 0x1a,
-	0xa6, 0x6d, 0x5a, 0x33, 0x43, 0x6e, 0x6b, 0xe3, 0x4e, 0xe9, 0x1a, 0x29, 0xf4, 0xc8, 0xaa, 0x35,
-	0xcb, 0xac, 0xb8, 0x0b, 0x93, 0xbc, 0x4f, 0xb6, 0x44, 0x0a, 0x35, 0x1b, 0x0e, 0x7a, 0xd2, 0xb7,
-	0x5b, 0xcd, 0x9d, 0xb6, 0xa9, 0x5e, 0x86, 0x5c, 0xd5, 0xb0, 0x0d, 0xe9, 0xba, 0xcb, 0x7f, 0x09,
-	0xd3, 0x5d, 0x62, 0x55, 0x85, 0x5c, 0xa5, 0x59, 0x35, 0x5d, 0xcd, 0xb2, 0xba, 0xfb, 0x5f, 0x9d,
-	0x81, 0xe1, 0x86, 0xd9, 0x6e, 0x1b, 0x5b, 0xa6, 0xab, 0xd9, 0x88, 0x4e, 0x2e, 0xb5, 0x1d, 0x28,
-	0x2e, 0x5a, 0xa6, 0x61, 0x9b, 0xce, 0xdb, 0x19, 0x46, 0x2d, 0xc1, 0x6b, 0x20, 0x85, 0x70, 0x46,
-	0x80, 0x70, 0x96, 0x46, 0x58, 0x6b, 0xc3, 0x21, 0x8a, 0x1f, 0xd6, 0xf3, 0x79, 0x46, 0xcf, 0xe3,
-	0x22, 0x8e, 0x69, 0x94, 0xbc, 0xd3, 0xaa, 0xf6, 0x55, 0x49, 0x8a, 0x5f, 0x9f, 0x94, 0x5c, 0x83,
-	0x09, 0xdd, 0x34, 0xaa, 0x94, 0x8a, 0x13, 0x90, 0xf1, 0x46, 0x7e, 0xa6, 0x56, 0x4d, 0xac, 0xc6,
-	0xdb, 0x70, 0xd0, 0x6b, 0xb1, 0x4f, 0x4a, 0xe8, 0x50, 0x5c, 0x32, 0xeb, 0x26, 0xd3, 0x53, 0x69,
-	0xd5, 0x28, 0xc1, 0x21, 0xaa, 0x4d, 0xac, 0x08, 0x11, 0x4b, 0xe1, 0x8b, 0x95, 0x61, 0xc5, 0xfa,
-	0x6b, 0x05, 0x26, 0x4b, 0xf5, 0x3a, 0xd9, 0x30, 0xd8, 0x97, 0xf6, 0xe5, 0x3d, 0x98, 0x62, 0x55,
-	0xc0, 0x48, 0x5c, 0x65, 0xba, 0xf4, 0x69, 0xe9, 0x4e, 0x49, 0x1a, 0x4b, 0xf3, 0xbe, 0x02, 0xd3,
-	0xe8, 0xd1, 0xf7, 0xf6, 0x5d, 0x30, 0x8a, 0xdd, 0x6f, 0xff, 0x24, 0x1e, 0x0b, 0xef, 0xc2, 0xe1,
-	0xa0, 0x0c, 0x18, 0x86, 0x17, 0x18, 0x18, 0x4e, 0x4b, 0x05, 0x48, 0x85, 0x01, 0xb2, 0x0c, 0x83,
-	0xc5, 0x20, 0x28, 0x43, 0x3f, 0x31, 0xb8, 0x03, 0x93, 0x8e, 0x55, 0x09, 0x02, 0x90, 0xf6, 0x29,
-	0xff, 0x06, 0x4c, 0xb1, 0xcd, 0xf6, 0x53, 0xa7, 0x7b, 0x30, 0x8d, 0x4c, 0x4c, 0xaf, 0xb5, 0xba,
-	0x01, 0x87, 0x83, 0x0d, 0x77, 0x65, 0xc0, 0x7e, 0xaa, 0xc0, 0xa1, 0x52, 0xbd, 0x8e, 0xb6, 0x18,
-	0xf7, 0xa5, 0xf9, 0x7a, 0x07, 0x54, 0x5a, 0x01, 0x8c, 0xc2, 0x8b, 0x4c, 0xef, 0x6a, 0x92, 0x7d,
-	0xd5, 0x34, 0xa6, 0xeb, 0x1d, 0x98, 0x44, 0x56, 0x03, 0x6f, 0xd1, 0x62, 0xf8, 0xba, 0xd9, 0x1d,
-	0x4e, 0x3c, 0x02, 0x76, 0x61, 0x8a, 0xe5, 0x9d, 0x60, 0x6d, 0xe8, 0xef, 0x64, 0x77, 0xab, 0x36,
-	0x32, 0x14, 0x83, 0x51, 0x9b, 0xe5, 0xdd, 0x3f, 0xb5, 0xd7, 0xe1, 0x90, 0x63, 0x49, 0x58, 0xa5,
-	0xd3, 0x3e, 0xc8, 0x8f, 0x41, 0xa5, 0x1b, 0xed, 0x9f, 0x36, 0x77, 0x60, 0x12, 0x59, 0x90, 0xde,
-	0xea, 0xb3, 0x04, 0x53, 0x6c, 0xb3, 0x69, 0xd6, 0x55, 0xe4, 0x88, 0x61, 0x1f, 0xaf, 0xab, 0x28,
-	0x15, 0x12, 0xac, 0xab, 0x02, 0x87, 0x32, 0x29, 0xd7, 0x55, 0xde, 0x49, 0x8d, 0xbf, 0xa6, 0xe8,
-	0xf2, 0xc0, 0xa8, 0xfb, 0x75, 0x95, 0x2f, 0x43, 0x82, 0xf9, 0x97, 0x3e, 0xe6, 0x4a, 0xb9, 0xae,
-	0x1a, 0x2c, 0x06, 0x41, 0x19, 0xfa, 0x89, 0x01, 0x5e, 0x57, 0x05, 0x01, 0xe8, 0xd1, 0xba, 0x6a,
-	0x30, 0x3a, 0x79, 0xeb, 0xaa, 0x5e, 0x6b, 0xe5, 0xad, 0xab, 0x42, 0x7a, 0x75, 0xb5, 0xae, 0x42,
-	0x87, 0x92, 0xfb, 0x78, 0x5d, 0xe5, 0x29, 0x90, 0x60, 0x5d, 0xc5, 0x1c, 0xdf, 0xa6, 0x5b, 0x57,
-	0xe1, 0x43, 0x5d, 0x7f, 0x81, 0x91, 0xf8, 0x3c, 0xb9, 0xfb, 0x75, 0x15, 0xe1, 0x9d, 0x60, 0x4a,
-	0xf6, 0xcf, 0xbe, 0xd3, 0xad, 0xab, 0x06, 0xa3, 0x36, 0xcb, 0xbb, 0x7f, 0x6a, 0xe3, 0x75, 0x15,
-	0xab, 0x74, 0x8f, 0xd6, 0x55, 0xfd, 0xd7, 0xc6, 0x5b, 0x57, 0xf5, 0x56, 0x1f, 0x6f, 0x5d, 0x15,
-	0xd0, 0x28, 0x99, 0x59, 0xfa, 0x77, 0x05, 0x26, 0x6f, 0xd4, 0xea, 0xb6, 0x69, 0xb1, 0xd2, 0x9d,
-	0xe1, 0x9e, 0x01, 0x8f, 0x04, 0xcf, 0x77, 0x93, 0x4a, 0xed, 0x1b, 0xb0, 0x1c, 0xdf, 0x80, 0x0d,
-	0x45, 0x18, 0xb0, 0x7c, 0x3c, 0x03, 0x36, 0xcc, 0x33, 0x60, 0xdf, 0x84, 0x29, 0x56, 0xd5, 0xbe,
-	0x9a, 0x30, 0x32, 0x01, 0x20, 0x17, 0x90, 0x7d, 0x3c, 0x01, 0x10, 0x05, 0x92, 0xa0, 0x47, 0x3b,
-	0xcd, 0xa4, 0x9c, 0x00, 0x90, 0x2b, 0x0d, 0x65, 0x09, 0x93, 0x7a, 0xf1, 0xa4, 0x98, 0x00, 0x30,
-	0xef, 0x24, 0xb6, 0xc3, 0xf3, 0x38, 0x4a, 0x39, 0x01, 0x0c, 0x44, 0x6d, 0x96, 0x77, 0xff, 0xd4,
-	0x26, 0x13, 0x00, 0xa3, 0x74, 0xaf, 0x26, 0x80, 0xbe, 0x6b, 0xe3, 0x4f, 0x00, 0x3d, 0xd5, 0xc7,
-	0x9f, 0x00, 0x58, 0x8d, 0x92, 0x4d, 0x00, 0xff, 0xa8, 0xc0, 0x89, 0x52, 0xbd, 0x1e, 0x74, 0x6c,
-	0xa9, 0xed, 0x4f, 0x13, 0xf5, 0xb1, 0x02, 0x27, 0x45, 0xda, 0x60, 0x78, 0x4a, 0x4c, 0x87, 0x9f,
-	0x8f, 0xe9, 0xe6, 0x93, 0xc2, 0x74, 0x7d, 0xaa, 0xc0, 0x49, 0x64, 0x3f, 0xc2, 0x3e, 0x75, 0x18,
-	0xe2, 0x1e, 0xb8, 0xfd, 0x25, 0x1e, 0x3e, 0x1f, 0x29, 0x70, 0x4a, 0x28, 0x16, 0xc6, 0xea, 0x4b,
-	0x0c, 0x56, 0xf3, 0xf1, 0x64, 0x4a, 0x05, 0x14, 0xb2, 0x38, 0x4f, 0x1c, 0x50, 0x42, 0xb1, 0x06,
-	0x02, 0xd4, 0x9b, 0x70, 0xdc, 0xb1, 0x64, 0x42, 0x94, 0xd2, 0x5a, 0x96, 0x1f, 0x28, 0x70, 0x42,
-	0xc0, 0x60, 0x20, 0xfa, 0xbe, 0x05, 0x27, 0x91, 0xa5, 0xdb, 0x33, 0x8d, 0x6f, 0xc3, 0x29, 0x21,
-	0x87, 0xae, 0xcc, 0xea, 0xbf, 0x2a, 0x70, 0x12, 0x2d, 0x36, 0x85, 0x32, 0xcf, 0xb0, 0x6e, 0xcc,
-	0x23, 0x9e, 0xa7, 0xf2, 0xbe, 0x5a, 0x54, 0x7f, 0xa2, 0xc0, 0x29, 0xa1, 0xa2, 0x83, 0x32, 0xb9,
-	0x3f, 0x53, 0x60, 0xba, 0x54, 0xaf, 0xfb, 0x2e, 0xc1, 0xfb, 0x72, 0x32, 0x7b, 0x5f, 0x81, 0xc3,
-	0x41, 0x2d, 0x30, 0xa2, 0x2f, 0x31, 0x88, 0x9e, 0x89, 0x70, 0x88, 0x4e, 0x03, 0xe5, 0xf7, 0x15,
-	0x38, 0x82, 0xa6, 0x09, 0xca, 0xc1, 0x1a, 0x83, 0x99, 0xd6, 0xe7, 0x3b, 0xf1, 0x63, 0xfa, 0x6d,
-	0x05, 0x66, 0xc2, 0xc2, 0x60, 0x4c, 0x3e, 0xcf, 0x60, 0xf2, 0x54, 0x84, 0x1c, 0xa9, 0x00, 0x41,
-	0xd3, 0xc1, 0x13, 0x02, 0x48, 0x58, 0x98, 0xfe, 0x02, 0x72, 0x0f, 0xa6, 0x9d, 0xc9, 0x22, 0x8c,
-	0x46, 0x5a, 0xa3, 0xfc, 0x2d, 0x38, 0x1c, 0x6c, 0xb8, 0xbf, 0x9a, 0xbd, 0x06, 0x47, 0xd0, 0xac,
-	0xd0, 0x7b, 0xdd, 0x6e, 0xc2, 0x4c, 0xb8, 0xe9, 0xae, 0x66, 0x9a, 0xbf, 0x55, 0xe0, 0x48, 0xa9,
-	0x5e, 0xa7, 0xbf, 0x23, 0xd8, 0x97, 0xd6, 0xee, 0xbb, 0x0a, 0xcc, 0x84, 0xf5, 0xc0, 0x90, 0xfc,
-	0x3f, 0xa6, 0xc3, 0xcf, 0x45, 0x7e, 0x49, 0x91, 0xc6, 0xe2, 0x7d, 0xa2, 0xc0, 0x51, 0x64, 0x64,
-	0x98, 0x6f, 0x33, 0x30, 0xa4, 0xbd, 0xf9, 0x74, 0x24, 0xf1, 0x78, 0xf9, 0x15, 0x05, 0x66, 0x79,
-	0x42, 0x61, 0x7c, 0xae, 0x31, 0xf8, 0x7c, 0x2e, 0x52, 0x9a, 0x54, 0xe0, 0x20, 0x83, 0xf3, 0x84,
-	0x81, 0xc3, 0x13, 0xaa, 0xdf, 0xe0, 0xbc, 0x06, 0x47, 0x1c, 0x83, 0xc5, 0x43, 0x26, 0xad, 0xbd,
-	0xf8, 0x40, 0x81, 0x99, 0x70, 0xdb, 0xfd, 0x56, 0xf0, 0xab, 0x70, 0x14, 0x59, 0xad, 0xbd, 0x50,
-	0xf1, 0x55, 0x98, 0xe5, 0x35, 0xde, 0x95, 0x51, 0xfc, 0x3b, 0x64, 0x4c, 0x98, 0x6f, 0x93, 0xf6,
-	0xa5, 0x55, 0xfc, 0x55, 0x05, 0x8e, 0x72, 0x14, 0xc1, 0xa0, 0x7c, 0x91, 0xe9, 0xf8, 0x67, 0xa2,
-	0xbf, 0xcf, 0x4a, 0x63, 0x17, 0x7f, 0xec, 0x99, 0x20, 0xf6, 0x93, 0x2f, 0x8c, 0x6a, 0xcf, 0xbe,
-	0x4b, 0x4b, 0x3c, 0x70, 0xbe, 0xa7, 0xc0, 0x31, 0xae, 0x60, 0x09, 0x16, 0xcb, 0xa1, 0xef, 0xec,
-	0xba, 0x86, 0x08, 0x19, 0xa2, 0x27, 0x10, 0x22, 0xae, 0x60, 0x7d, 0x87, 0xe8, 0x31, 0xb2, 0x63,
-	0x5c, 0x7c, 0x52, 0x5a, 0x10, 0xf5, 0x18, 0x8c, 0xec, 0x18, 0x0d, 0x73, 0xb3, 0x5d, 0xef, 0x6c,
-	0xb9, 0x4f, 0xe5, 0x88, 0x5e, 0x70, 0x0a, 0xd6, 0xeb, 0x9d, 0x2d, 0x67, 0x92, 0x38, 0xca, 0xe1,
-	0xdc, 0x77, 0x00, 0xde, 0x20, 0x66, 0x6e, 0x2f, 0x20, 0xd0, 0xbe, 0x0c, 0xc7, 0xb8, 0xad, 0x77,
-	0x65, 0x45, 0xff, 0x45, 0x81, 0x59, 0xf4, 0x6e, 0xcf, 0x95, 0xf5, 0xb3, 0xb1, 0x81, 0xf1, 0x7d,
-	0x05, 0x8e, 0x71, 0x95, 0x1c, 0x84, 0x8d, 0xfd, 0xb9, 0x02, 0xa7, 0x38, 0xfb, 0xd7, 0xee, 0x07,
-	0xa5, 0xfb, 0x71, 0xfa, 0xfa, 0xb1, 0x02, 0x73, 0x62, 0x7d, 0x30, 0xc2, 0x4b, 0x0c, 0xc2, 0xcf,
-	0xc7, 0xfe, 0xf0, 0x36, 0x0d, 0xd0, 0x7f, 0xaa, 0xc0, 0x69, 0xc1, 0xee, 0x77, 0xa9, 0xe6, 0x39,
-	0x65, 0xef, 0xed, 0x17, 0xca, 0x89, 0x1f, 0xee, 0x1f, 0x29, 0xa0, 0xc9, 0x84, 0x4e, 0xb5, 0xdd,
-	0x56, 0x6b, 0xa4, 0x02, 0x53, 0xb0, 0x43, 0xfe, 0x64, 0x83, 0x29, 0x13, 0x7a, 0x50, 0x60, 0x1a,
-	0x70, 0x8a, 0xbb, 0xf7, 0x4e, 0x21, 0x99, 0x76, 0x92, 0xf8, 0xa1, 0x02, 0x73, 0x62, 0x1e, 0x83,
-	0x52, 0xbc, 0x02, 0xa7, 0x05, 0x7b, 0xf0, 0x3d, 0x54, 0x5d, 0x07, 0x4d, 0xc6, 0xa4, 0xab, 0x69,
-	0xf2, 0x17, 0x0a, 0x9c, 0x2e, 0xd5, 0xeb, 0x8b, 0xfc, 0x2f, 0xe0, 0xf7, 0xa3, 0xd9, 0xfe, 0x6d,
-	0x05, 0x34, 0x99, 0x46, 0x18, 0xa6, 0x32, 0x33, 0x44, 0x2e, 0xc6, 0x0f, 0x17, 0x90, 0xc6, 0x72,
-	0xff, 0xb9, 0x67, 0x04, 0xf9, 0x91, 0x08, 0x30, 0xdc, 0x7b, 0x1e, 0x60, 0x21, 0xf1, 0xd0, 0xfb,
-	0x2d, 0x05, 0x9e, 0x92, 0x0a, 0x8e, 0x51, 0xbd, 0xce, 0xa0, 0xba, 0x10, 0x5f, 0xd0, 0x54, 0x90,
-	0x22, 0x53, 0xb8, 0x0f, 0x21, 0x95, 0x0a, 0x3e, 0x30, 0x48, 0xef, 0x23, 0x0b, 0x2b, 0xc5, 0x33,
-	0xad, 0x2d, 0xfb, 0x91, 0x02, 0xa7, 0x25, 0x4c, 0x06, 0xa6, 0x7b, 0x95, 0xd8, 0xd8, 0x3d, 0xd5,
-	0x7e, 0x1d, 0x9e, 0x92, 0x72, 0xe9, 0xca, 0x94, 0xff, 0xbd, 0x02, 0xc7, 0x7c, 0xc3, 0xb7, 0xbf,
-	0x8d, 0xf8, 0x0f, 0x14, 0x38, 0xce, 0xd7, 0x05, 0x43, 0xf3, 0x32, 0x33, 0x32, 0x9e, 0x8d, 0x13,
-	0x0c, 0x25, 0x8d, 0xe1, 0xfe, 0x5d, 0x05, 0x8e, 0x33, 0xf6, 0x2f, 0x38, 0x22, 0x7a, 0x1c, 0x13,
-	0x26, 0xf1, 0x88, 0xfa, 0x35, 0x05, 0x4e, 0x08, 0x04, 0x4c, 0x70, 0x0c, 0xc1, 0x89, 0x76, 0xd3,
-	0x35, 0x5c, 0x8c, 0x6d, 0x7b, 0x12, 0xe1, 0x12, 0x08, 0x38, 0x00, 0xb8, 0xde, 0x80, 0x59, 0xca,
-	0x16, 0xf6, 0xda, 0xd8, 0x7c, 0xa8, 0xc0, 0x31, 0x6e, 0xf3, 0x03, 0xd0, 0xf4, 0x4d, 0x38, 0xce,
-	0x18, 0xbe, 0x5e, 0xeb, 0x7a, 0x0b, 0x4e, 0x08, 0xda, 0xef, 0xca, 0xa4, 0xfe, 0x0d, 0xf2, 0x62,
-	0xa0, 0x63, 0xbf, 0xec, 0x47, 0x6b, 0xfa, 0x1d, 0x74, 0xcc, 0xca, 0xaa, 0x81, 0x01, 0xf9, 0x02,
-	0xd3, 0xfb, 0x67, 0xa3, 0x22, 0x33, 0xa5, 0x31, 0xa2, 0xbf, 0xe1, 0x79, 0x40, 0xd0, 0x01, 0x76,
-	0x3c, 0xef, 0xb8, 0xd4, 0x31, 0xa8, 0xba, 0x39, 0x98, 0x3a, 0xca, 0x11, 0x28, 0xc1, 0xa7, 0x8d,
-	0x81, 0x48, 0x47, 0x5d, 0xc3, 0xc2, 0xd8, 0xa2, 0x27, 0x01, 0x16, 0x8e, 0x40, 0x7d, 0x86, 0xe5,
-	0x2b, 0xc8, 0x83, 0x82, 0x83, 0x49, 0x5a, 0x23, 0xf1, 0x6d, 0x05, 0x9d, 0x75, 0x0e, 0x52, 0xbb,
-	0xd7, 0x89, 0x0f, 0xc5, 0x1e, 0xe8, 0xb7, 0x4c, 0x4e, 0x3a, 0x79, 0x0a, 0x26, 0x33, 0x80, 0xff,
-	0xc0, 0xac, 0xc3, 0xa8, 0x80, 0x65, 0xfb, 0xd1, 0x0c, 0xfe, 0x26, 0x72, 0x17, 0xe7, 0x29, 0x93,
-	0x60, 0xdf, 0x48, 0x14, 0x1a, 0xae, 0xab, 0x61, 0xf0, 0x87, 0x9e, 0x7f, 0x75, 0x38, 0x4c, 0x1c,
-	0x46, 0x78, 0x4f, 0x62, 0xdc, 0xa5, 0xf0, 0xb8, 0xe6, 0x08, 0x9a, 0xc0, 0xb1, 0x96, 0x1b, 0xc7,
-	0xaf, 0x6b, 0xe8, 0x18, 0x2b, 0xf5, 0x24, 0x43, 0x27, 0x14, 0x74, 0x20, 0xd0, 0x61, 0x1f, 0x6c,
-	0x21, 0x6e, 0xbd, 0xf2, 0xc1, 0x7e, 0x52, 0xf4, 0xf5, 0x7c, 0xb0, 0xf7, 0x4c, 0x63, 0xcf, 0x07,
-	0x5b, 0xac, 0x72, 0x57, 0x4e, 0x20, 0x4c, 0xc4, 0xc5, 0xfd, 0xec, 0x04, 0x12, 0x54, 0x24, 0xc1,
-	0x01, 0x25, 0x2f, 0x70, 0x65, 0x4a, 0x27, 0x10, 0x36, 0x90, 0xa5, 0xef, 0xe1, 0xd0, 0x9b, 0x68,
-	0x9b, 0x29, 0x9c, 0x40, 0x02, 0x82, 0x25, 0x38, 0xe0, 0x0f, 0x45, 0x0f, 0x4d, 0xe9, 0x04, 0xf2,
-	0x04, 0x42, 0xc4, 0x15, 0xac, 0xef, 0x10, 0xbd, 0x8e, 0x9c, 0x40, 0xb8, 0xf8, 0xa4, 0x35, 0x23,
-	0xc4, 0xcf, 0x63, 0xd0, 0x3a, 0x7a, 0x7e, 0x1e, 0x7b, 0xa2, 0xa5, 0xe7, 0xe7, 0xc1, 0x57, 0x33,
-	0x99, 0xa1, 0x7c, 0x3f, 0x43, 0xfc, 0x3c, 0xb8, 0xb2, 0xee, 0xad, 0xa9, 0x3c, 0xec, 0x7d, 0xcb,
-	0x3a, 0xe4, 0x3a, 0x91, 0x90, 0xcf, 0x55, 0xe7, 0x60, 0x14, 0x87, 0x8d, 0x75, 0x97, 0x1c, 0xf9,
-	0xb9, 0xec, 0xb9, 0xac, 0x4e, 0x17, 0x71, 0x8c, 0xec, 0x70, 0x3c, 0x23, 0x5b, 0x90, 0xfb, 0x81,
-	0xf0, 0x21, 0xed, 0xab, 0x99, 0xc5, 0x5f, 0x65, 0x86, 0x63, 0xe9, 0xee, 0xcb, 0xf9, 0xeb, 0x13,
-	0xf4, 0x55, 0x26, 0x57, 0x9b, 0x04, 0xa7, 0x14, 0xc2, 0x30, 0xc5, 0x5d, 0x41, 0xfc, 0x13, 0x6f,
-	0x35, 0xce, 0x89, 0x58, 0x8c, 0x41, 0xde, 0xab, 0xa0, 0xcb, 0x89, 0x1f, 0xf7, 0x8f, 0x15, 0x98,
-	0x13, 0x0b, 0x9b, 0x60, 0x3f, 0x9f, 0x1f, 0x5d, 0xba, 0x6b, 0x00, 0x89, 0x4f, 0xe1, 0xbe, 0x00,
-	0x50, 0x2c, 0xec, 0x60, 0x00, 0xdc, 0x44, 0x2b, 0x7c, 0x31, 0x7a, 0x69, 0x27, 0x89, 0x8f, 0x14,
-	0x38, 0x29, 0xe2, 0x30, 0x18, 0x95, 0x0d, 0xb2, 0xc6, 0xdf, 0x3b, 0xa5, 0xd7, 0x60, 0x4e, 0xcc,
-	0xa2, 0xab, 0xe9, 0xf1, 0x7f, 0xbc, 0x4f, 0x1c, 0xc5, 0x52, 0xef, 0xb9, 0x39, 0x46, 0xb3, 0xe2,
-	0x26, 0x7e, 0x14, 0xf0, 0x5c, 0x19, 0x58, 0x10, 0xf6, 0xd6, 0x31, 0xf2, 0x53, 0x05, 0xe6, 0xc4,
-	0x6a, 0x0f, 0xcc, 0x6e, 0x5f, 0x27, 0x71, 0xc1, 0xdd, 0x88, 0xee, 0x18, 0xfe, 0x84, 0x01, 0xe4,
-	0x3b, 0xa0, 0xd2, 0x6d, 0x60, 0x5d, 0x2e, 0x32, 0xba, 0x9c, 0x10, 0x36, 0x92, 0x46, 0x74, 0x64,
-	0x83, 0xd2, 0x89, 0x4e, 0xb7, 0xd1, 0x2f, 0xd1, 0xaf, 0xa2, 0x45, 0xb5, 0xd3, 0xc6, 0xf5, 0xdd,
-	0x55, 0xa3, 0x61, 0xb6, 0xeb, 0x9d, 0x2d, 0xa2, 0x02, 0xe3, 0x77, 0xad, 0x04, 0xfc, 0xae, 0xdf,
-	0x45, 0x07, 0x73, 0xc1, 0x9a, 0xfd, 0x12, 0xfc, 0x2d, 0x18, 0xbf, 0xd3, 0xaa, 0x37, 0x0d, 0xcf,
-	0x6b, 0xf9, 0x14, 0x8c, 0x76, 0xdc, 0x82, 0xcd, 0x07, 0x35, 0x0c, 0xfb, 0x88, 0x0e, 0xa8, 0xe8,
-	0x46, 0xad, 0x8b, 0x38, 0x29, 0x5f, 0x82, 0x09, 0xc2, 0xa1, 0x2b, 0xf3, 0x52, 0x86, 0xd1, 0x75,
-	0xd3, 0xbe, 0x6f, 0x54, 0x1e, 0xba, 0xd9, 0x0c, 0x5e, 0x84, 0xe1, 0x36, 0xba, 0x94, 0xc6, 0x4e,
-	0xc7, 0x55, 0x74, 0x42, 0xac, 0xbd, 0x0a, 0x13, 0xb8, 0x8c, 0xe4, 0x45, 0xb8, 0x0a, 0x05, 0x7c,
-	0x93, 0xa4, 0x46, 0x90, 0x37, 0xe5, 0x51, 0x6b, 0x7f, 0xa5, 0xb8, 0xa1, 0x7e, 0xf0, 0x8d, 0x7d,
-	0xb9, 0xe6, 0xfc, 0xa6, 0x1b, 0x2f, 0xd4, 0xd7, 0x20, 0xc9, 0x97, 0xa3, 0x0c, 0x8c, 0x5d, 0x8d,
-	0xba, 0xf7, 0x48, 0xc8, 0x20, 0x82, 0x2d, 0x46, 0xb0, 0xcb, 0xce, 0xed, 0x22, 0x16, 0xe3, 0x74,
-	0x80, 0x3f, 0xd6, 0xff, 0x0a, 0xa3, 0xff, 0x9c, 0x8c, 0x7b, 0x1a, 0xe5, 0xf1, 0x37, 0xc9, 0x03,
-	0x53, 0x3e, 0xc0, 0xbf, 0x8f, 0xca, 0x6f, 0xa0, 0x28, 0x43, 0x01, 0xd5, 0xd3, 0x2e, 0x6a, 0x76,
-	0x51, 0xc8, 0xce, 0x41, 0x28, 0x74, 0x97, 0x84, 0x19, 0xea, 0xb1, 0x4a, 0x65, 0x12, 0xb1, 0x33,
-	0xa8, 0x54, 0x32, 0xeb, 0x79, 0x15, 0xe6, 0x4a, 0x1d, 0xbb, 0x59, 0x69, 0x36, 0x5a, 0x54, 0x63,
-	0xeb, 0xa6, 0x61, 0x55, 0xb6, 0x89, 0xa8, 0x53, 0x30, 0x64, 0xd7, 0x6c, 0xcf, 0xd8, 0xa3, 0x0b,
-	0xed, 0x0a, 0xfa, 0x6e, 0x3d, 0xe1, 0x74, 0xf6, 0x17, 0x19, 0xc8, 0x96, 0x5a, 0xad, 0x90, 0xfa,
-	0x2a, 0xe4, 0x1c, 0x1a, 0x2c, 0x9e, 0xfb, 0xdf, 0x91, 0xba, 0xdd, 0x69, 0x34, 0x0c, 0x6b, 0x97,
-	0x80, 0x8a, 0x2f, 0xd5, 0x39, 0x18, 0xad, 0x9a, 0xed, 0x8a, 0x55, 0x6b, 0xb9, 0x16, 0x0c, 0x7d,
-	0xab, 0x44, 0x17, 0x39, 0x42, 0xd4, 0x2a, 0xcd, 0x1d, 0x24, 0x04, 0x32, 0x84, 0x05, 0xa7, 0xc0,
-	0x11, 0xc2, 0x69, 0xb8, 0xe2, 0x3e, 0xde, 0x55, 0xd7, 0xf8, 0x65, 0x75, 0x72, 0xe9, 0xdc, 0xe9,
-	0xb8, 0x63, 0xbf, 0xea, 0x2e, 0xea, 0xb2, 0x3a, 0xb9, 0x74, 0x40, 0xa8, 0x35, 0x1c, 0x00, 0xd1,
-	0xee, 0x07, 0xba, 0x70, 0xc4, 0xb6, 0x8d, 0xad, 0xf6, 0xcc, 0x88, 0xbb, 0x9e, 0x74, 0xff, 0xb3,
-	0x69, 0x31, 0x21, 0x59, 0x5a, 0x4c, 0x16, 0xbc, 0xd1, 0x00, 0x78, 0x7f, 0xa2, 0x40, 0x81, 0x54,
-	0x8a, 0x85, 0x20, 0xd3, 0x5a, 0x96, 0x6d, 0x8d, 0x85, 0x28, 0x17, 0x80, 0xa8, 0x08, 0xd9, 0x8e,
-	0x55, 0xc7, 0xc8, 0x39, 0x7f, 0xbb, 0x01, 0x4d, 0xfb, 0x34, 0x03, 0x05, 0x12, 0xf8, 0xf5, 0xb3,
-	0xd2, 0xe5, 0x18, 0x99, 0x11, 0x1f, 0x19, 0x32, 0x08, 0x80, 0x1a, 0x04, 0xd2, 0x7e, 0xfc, 0x24,
-	0x03, 0x79, 0x14, 0xce, 0xfb, 0xff, 0x40, 0xf1, 0x40, 0xf9, 0x8f, 0x2c, 0xe4, 0x51, 0x98, 0xc8,
-	0xc1, 0x82, 0xe2, 0x1b, 0xe2, 0x3c, 0x6d, 0x88, 0x29, 0xac, 0x86, 0x85, 0x58, 0x15, 0x58, 0xac,
-	0x54, 0xc8, 0x75, 0x76, 0x6a, 0x36, 0xb1, 0x0e, 0xce, 0x7f, 0x2f, 0x81, 0x2f, 0xc4, 0x49, 0xe0,
-	0xcb, 0x26, 0x8f, 0x1d, 0x4d, 0x96, 0x3c, 0x96, 0x4e, 0x87, 0x3b, 0x16, 0x3f, 0x1d, 0x2e, 0x27,
-	0x7f, 0xe5, 0x78, 0xb7, 0xf9, 0x2b, 0xd9, 0x1e, 0x9f, 0x08, 0xf4, 0xf8, 0x1f, 0x67, 0x20, 0x8f,
-	0xb6, 0x7c, 0x07, 0xdb, 0xe3, 0x8c, 0x90, 0xf9, 0x80, 0x95, 0xec, 0xa6, 0xdf, 0x67, 0xdd, 0x37,
-	0x07, 0xbb, 0xb6, 0xe3, 0xce, 0x0c, 0x6e, 0x7b, 0xe4, 0x5a, 0x3d, 0x0d, 0x63, 0xd5, 0x5a, 0xbb,
-	0x55, 0x37, 0x76, 0x37, 0x5d, 0x0d, 0x01, 0x0b, 0x8b, 0xca, 0x9c, 0xb9, 0xd4, 0x51, 0xbe, 0x65,
-	0xd8, 0xdb, 0xf8, 0x09, 0x71, 0xff, 0x6b, 0x15, 0xc8, 0xa3, 0x50, 0x27, 0xea, 0x65, 0xc8, 0xd9,
-	0x09, 0xc2, 0xe3, 0xb8, 0xc4, 0xea, 0xd3, 0x30, 0x5e, 0xe9, 0xb4, 0xed, 0x66, 0xe3, 0x16, 0xb3,
-	0x0c, 0x60, 0x0b, 0xb5, 0x1f, 0x2a, 0x00, 0x7e, 0xd5, 0x58, 0x9d, 0x82, 0x1f, 0xfc, 0xac, 0xff,
-	0xe0, 0xcb, 0xbe, 0x22, 0xa6, 0xd1, 0x1c, 0x12, 0xa2, 0x99, 0x67, 0x27, 0x91, 0x7f, 0xcb, 0xc0,
-	0x18, 0x1d, 0xd3, 0x20, 0x24, 0xd7, 0x31, 0x18, 0xc1, 0xdb, 0x3b, 0xde, 0xea, 0xa9, 0x80, 0x0a,
-	0x96, 0x7d, 0xa1, 0xb3, 0x94, 0xd0, 0xd1, 0xe3, 0xe5, 0x65, 0xc8, 0xdb, 0x86, 0xb5, 0x85, 0xbf,
-	0x9d, 0x9d, 0x10, 0x6c, 0xb6, 0xd2, 0x52, 0x6d, 0xb8, 0xe4, 0x3a, 0xae, 0x26, 0x1f, 0x53, 0xcc,
-	0x68, 0x1c, 0x16, 0x1b, 0xe5, 0x82, 0x10, 0xa2, 0x11, 0x76, 0xc0, 0x5d, 0x82, 0xa9, 0x1d, 0x26,
-	0xea, 0x43, 0xa3, 0xb6, 0x53, 0x35, 0x2d, 0x77, 0x70, 0x65, 0x75, 0xee, 0x3d, 0xcf, 0x38, 0xe1,
-	0x51, 0xe6, 0xfc, 0xd7, 0x7e, 0x5f, 0x81, 0x71, 0xe6, 0x81, 0xde, 0xe3, 0x55, 0x46, 0x37, 0xc3,
-	0xe1, 0x8f, 0x14, 0x98, 0xe2, 0x7d, 0x7d, 0xf6, 0x04, 0x8a, 0xfa, 0x67, 0x59, 0x38, 0x14, 0x12,
-	0xf5, 0xb3, 0x34, 0xbb, 0x85, 0xd6, 0xbe, 0x5f, 0x84, 0x9c, 0x51, 0xf3, 0x96, 0xbd, 0x09, 0x3e,
-	0x4a, 0x75, 0xab, 0xa9, 0x25, 0x18, 0x47, 0xdf, 0xc0, 0x2f, 0x99, 0x0f, 0x8c, 0x4e, 0x1d, 0x0d,
-	0xce, 0x88, 0x00, 0xed, 0x6c, 0x0d, 0xbf, 0x89, 0xdb, 0x2d, 0x94, 0x3d, 0x7a, 0x2c, 0x3a, 0xc7,
-	0x39, 0x5b, 0x43, 0xfb, 0x6f, 0xc5, 0x4b, 0xf6, 0x1a, 0xf8, 0xc0, 0xea, 0x33, 0xdc, 0x75, 0xda,
-	0x3f, 0x65, 0xbd, 0xac, 0xbc, 0x89, 0xf4, 0x95, 0x3e, 0x52, 0x14, 0x18, 0x39, 0x29, 0x18, 0x43,
-	0x11, 0x60, 0xe4, 0x85, 0x60, 0x0c, 0xd3, 0x60, 0x5c, 0x87, 0x7c, 0xcb, 0xed, 0xb2, 0x99, 0x42,
-	0xe2, 0x1c, 0xcc, 0xb8, 0xa6, 0xb3, 0x6c, 0x32, 0x2a, 0x68, 0xec, 0x8c, 0x48, 0xc6, 0x4e, 0xc9,
-	0xa5, 0xd1, 0x09, 0x2d, 0xdd, 0x0f, 0x20, 0xec, 0x87, 0x51, 0x7e, 0x3f, 0x8c, 0x51, 0x8f, 0x50,
-	0x0f, 0x97, 0x5f, 0xda, 0xcf, 0x32, 0x90, 0x47, 0x72, 0xc6, 0xea, 0xca, 0x40, 0x9f, 0x64, 0x23,
-	0xfa, 0x24, 0x68, 0x22, 0x4f, 0xc1, 0x28, 0x02, 0x83, 0x1e, 0xbf, 0x80, 0x8a, 0xfa, 0x61, 0x7c,
-	0xae, 0x41, 0xbe, 0x81, 0x02, 0xc3, 0x80, 0x3b, 0x69, 0x9f, 0x96, 0xf4, 0x1b, 0x06, 0x0c, 0x57,
-	0x90, 0xbf, 0x99, 0xfc, 0xb3, 0x02, 0x87, 0x42, 0x4e, 0x75, 0x3d, 0x02, 0x94, 0xd2, 0x3d, 0x27,
-	0xd4, 0x7d, 0x88, 0xaf, 0x7b, 0x5e, 0xf4, 0x6a, 0x35, 0x2c, 0x9b, 0xd8, 0x0a, 0x6c, 0xaf, 0x69,
-	0x1f, 0x64, 0x60, 0x94, 0x3e, 0x1a, 0xee, 0x8d, 0x5e, 0xcf, 0x79, 0x7e, 0xaf, 0x4e, 0xa3, 0xeb,
-	0xb6, 0x55, 0xdb, 0x21, 0x03, 0x26, 0x7c, 0xa3, 0x9b, 0xc9, 0xd5, 0x43, 0x61, 0x58, 0x84, 0x42,
-	0x41, 0x86, 0xc2, 0x48, 0x00, 0x85, 0x9f, 0xe4, 0x60, 0x9c, 0x71, 0x3f, 0xd9, 0x7f, 0x6f, 0xe6,
-	0x57, 0xf0, 0x8b, 0x41, 0xc1, 0x1d, 0xfc, 0x73, 0xb2, 0xb3, 0x44, 0xea, 0xcd, 0xc0, 0x8f, 0x85,
-	0x3f, 0x12, 0x3f, 0x16, 0xfe, 0x35, 0xc8, 0x9b, 0x8f, 0xcc, 0x1d, 0x9b, 0x4c, 0xf4, 0xa7, 0x65,
-	0xcc, 0xca, 0x0e, 0xa5, 0x8e, 0x2b, 0x38, 0xef, 0x46, 0xd5, 0x8e, 0x85, 0xe2, 0xdb, 0x8d, 0xba,
-	0x5d, 0xe7, 0x5d, 0xab, 0x53, 0x30, 0x54, 0x35, 0xeb, 0xc6, 0xee, 0xcc, 0x18, 0x32, 0x0f, 0xee,
-	0x85, 0xfa, 0xe5, 0x40, 0x02, 0xf7, 0xf1, 0x64, 0xd9, 0xb3, 0x99, 0xca, 0xea, 0x22, 0xb6, 0x51,
-	0x38, 0x82, 0xd4, 0x44, 0x5c, 0x43, 0x41, 0xd7, 0xd2, 0xe6, 0xa0, 0xb0, 0x5e, 0xd9, 0x36, 0x5d,
-	0x28, 0xa6, 0x60, 0x48, 0xd7, 0x3b, 0xfe, 0xe6, 0xa8, 0x7b, 0xa1, 0x7d, 0xa8, 0x80, 0x1a, 0x96,
-	0xa5, 0x27, 0xf3, 0x69, 0x52, 0x73, 0xa1, 0xfd, 0x8e, 0x02, 0x63, 0x74, 0x57, 0xa4, 0x97, 0xc2,
-	0xb7, 0xe3, 0x39, 0x81, 0x1d, 0x8f, 0xbd, 0x44, 0xfe, 0x79, 0x06, 0x86, 0x4b, 0xd5, 0xaa, 0x65,
-	0xb6, 0xdb, 0xee, 0x13, 0x4d, 0xde, 0x6c, 0x47, 0xf0, 0xf0, 0x3c, 0x07, 0x07, 0x0d, 0x74, 0x7b,
-	0xa5, 0x59, 0x31, 0xea, 0x35, 0x7b, 0x17, 0x8b, 0x19, 0x2c, 0x76, 0x5e, 0x71, 0x71, 0x91, 0x6e,
-	0x6e, 0xf9, 0x56, 0x89, 0x2d, 0x54, 0x4f, 0x02, 0xb4, 0x9a, 0x6d, 0xdb, 0xa8, 0x2f, 0x36, 0xab,
-	0x26, 0x16, 0x9f, 0x2a, 0x71, 0x5a, 0x69, 0xdb, 0x96, 0x69, 0xda, 0x58, 0x28, 0xef, 0x10, 0x8d,
-	0x2e, 0x74, 0x06, 0x31, 0x6e, 0xf6, 0x22, 0x59, 0x99, 0x90, 0x6b, 0xea, 0xde, 0x25, 0x62, 0x88,
-	0xc9, 0xb5, 0xbb, 0x37, 0xef, 0xa8, 0x80, 0x4c, 0x93, 0xfb, 0x5f, 0x3d, 0x0c, 0xf9, 0x4a, 0xb3,
-	0xb3, 0x63, 0xef, 0x62, 0x9b, 0x84, 0xaf, 0x5c, 0x34, 0x9d, 0x7f, 0xd6, 0x2e, 0xde, 0x23, 0x20,
-	0x97, 0x0e, 0x07, 0x47, 0x62, 0x77, 0x97, 0x1f, 0xcf, 0x55, 0xe4, 0x5a, 0xfb, 0x38, 0x03, 0x39,
-	0xbd, 0xc9, 0xd9, 0x51, 0x11, 0x9c, 0x2f, 0x50, 0x7d, 0x96, 0x15, 0xf6, 0x59, 0x8e, 0x35, 0x34,
-	0x94, 0xd5, 0x1b, 0x62, 0xad, 0x1e, 0x19, 0x49, 0x79, 0xd1, 0x48, 0x0a, 0xce, 0x4c, 0x01, 0x33,
-	0x59, 0x08, 0x9b, 0xc9, 0x12, 0x8c, 0xb6, 0x4c, 0xab, 0x51, 0x6b, 0xb7, 0xbd, 0x15, 0xd9, 0x84,
-	0x60, 0xd7, 0x63, 0xcd, 0xa3, 0xd3, 0xe9, 0x3a, 0xda, 0x3a, 0x4c, 0xd1, 0x67, 0x1c, 0xde, 0x49,
-	0x49, 0x4c, 0x88, 0xbc, 0xe3, 0x8f, 0x2c, 0x7d, 0xfc, 0xf1, 0x5f, 0x0a, 0xe4, 0xd1, 0x3b, 0xbf,
-	0xfa, 0x05, 0xc8, 0x1a, 0xdd, 0x04, 0xe2, 0x71, 0x6a, 0x51, 0xc9, 0xa8, 0x32, 0xf1, 0x93, 0x51,
-	0xcd, 0xc1, 0x28, 0xda, 0x67, 0xb8, 0x6b, 0xd4, 0x3b, 0x26, 0xee, 0x1f, 0xba, 0xc8, 0x7b, 0xbb,
-	0x1f, 0xf2, 0xdf, 0xee, 0xd5, 0x97, 0x01, 0x2c, 0xb3, 0xd2, 0xb1, 0x2c, 0x73, 0xa7, 0x82, 0x3c,
-	0x42, 0x45, 0xfb, 0x47, 0xba, 0x47, 0xa6, 0x53, 0x55, 0x34, 0x0d, 0xc0, 0xbf, 0x23, 0xb0, 0x7c,
-	0xbf, 0x97, 0x81, 0xc2, 0x86, 0xf1, 0xf5, 0xe6, 0x4e, 0xb3, 0x11, 0xef, 0xfd, 0xe1, 0x04, 0x40,
-	0x7b, 0xbb, 0x69, 0xd9, 0x9b, 0xd4, 0x36, 0xcd, 0x88, 0x5b, 0xb2, 0x1a, 0x6f, 0xaf, 0xc6, 0xef,
-	0xb6, 0x21, 0xc1, 0xc8, 0x8e, 0x3d, 0x85, 0x92, 0x35, 0x45, 0x81, 0x5a, 0x53, 0x1c, 0x86, 0xfc,
-	0x63, 0xb3, 0xb6, 0xb5, 0x6d, 0xe3, 0x4d, 0x17, 0x7c, 0xe5, 0x3e, 0x85, 0x56, 0xad, 0x69, 0x39,
-	0xcf, 0x33, 0x5a, 0xf0, 0x7b, 0xd7, 0xf2, 0xe5, 0xe4, 0x4f, 0xb3, 0x30, 0x19, 0x78, 0xd1, 0x5a,
-	0xb6, 0xcd, 0x3e, 0xec, 0x5f, 0x08, 0x1e, 0xda, 0x00, 0xc2, 0x79, 0x19, 0xc2, 0xc3, 0x02, 0x84,
-	0x63, 0xef, 0x54, 0xf1, 0x8e, 0x05, 0xae, 0x41, 0xc1, 0xc6, 0x23, 0x06, 0xbf, 0xf0, 0xf3, 0xf7,
-	0xb8, 0xc9, 0xb0, 0xd2, 0x3d, 0x72, 0xaa, 0x73, 0xc6, 0x84, 0x9d, 0x33, 0x1e, 0xe8, 0x9c, 0xff,
-	0x4f, 0x25, 0xc1, 0x98, 0x48, 0xf0, 0xc5, 0xbf, 0x57, 0x4b, 0xfb, 0x85, 0x02, 0xc3, 0xf8, 0xa4,
-	0x34, 0xae, 0x11, 0xe1, 0x6d, 0x43, 0x76, 0xb3, 0xf6, 0x8f, 0xee, 0xac, 0xcb, 0x90, 0x37, 0x7c,
-	0xb7, 0xb4, 0x88, 0xb7, 0x57, 0x4c, 0xaa, 0xfd, 0x41, 0xd6, 0xd5, 0xc8, 0x76, 0x16, 0xe3, 0x2f,
-	0x03, 0x3c, 0xaa, 0xb5, 0x6b, 0xf7, 0x6b, 0xee, 0x6c, 0xab, 0xb8, 0x2b, 0x24, 0xbe, 0x99, 0xb8,
-	0xeb, 0x91, 0xe9, 0x54, 0x15, 0xf5, 0x15, 0x18, 0xa7, 0x77, 0x1c, 0xdb, 0x33, 0x19, 0xc9, 0x22,
-	0x91, 0x89, 0x56, 0xcb, 0xd6, 0x73, 0x54, 0x41, 0xc1, 0xdd, 0x67, 0xb2, 0x92, 0x17, 0x71, 0xb4,
-	0x63, 0xad, 0x63, 0x52, 0x75, 0x01, 0xd4, 0x7a, 0x6d, 0xe7, 0xe1, 0xfa, 0xb6, 0xe1, 0xbc, 0x5a,
-	0x94, 0x77, 0x8c, 0xfb, 0x75, 0x0c, 0x70, 0x41, 0xe7, 0xdc, 0x51, 0xe7, 0xa1, 0x68, 0x36, 0xee,
-	0x9b, 0xd5, 0x2a, 0x45, 0x3d, 0xe4, 0x52, 0x87, 0xca, 0xdd, 0xd5, 0xc1, 0xb6, 0x61, 0x99, 0xce,
-	0xd5, 0x4a, 0x6d, 0xe7, 0xa1, 0xe7, 0x3b, 0x43, 0x17, 0xaa, 0x2f, 0xc2, 0x61, 0xa3, 0x63, 0x6f,
-	0x9b, 0x3b, 0x36, 0x15, 0xe2, 0xb7, 0x66, 0x61, 0x73, 0x53, 0xd0, 0x05, 0x77, 0x9d, 0xbe, 0x6d,
-	0x6f, 0x37, 0x1f, 0x2f, 0x1a, 0x2d, 0xbb, 0xb2, 0x6d, 0xb8, 0xcf, 0x54, 0x41, 0xa7, 0x8b, 0xb4,
-	0x47, 0x30, 0x74, 0xdd, 0xb0, 0x2b, 0xdb, 0xb1, 0x6c, 0x85, 0x3f, 0x12, 0xb3, 0x82, 0xa7, 0x36,
-	0xf6, 0x12, 0xf2, 0x2c, 0x8c, 0x39, 0xa8, 0x99, 0xd5, 0x3b, 0x6d, 0xd3, 0x42, 0x5e, 0x09, 0x9d,
-	0xb6, 0x69, 0xf9, 0xae, 0x4c, 0xf9, 0x8e, 0x7b, 0x63, 0xde, 0x00, 0x35, 0xbc, 0x29, 0xae, 0x1e,
-	0x83, 0x23, 0xe1, 0xd2, 0xcd, 0xd5, 0xdb, 0xab, 0xe5, 0xe2, 0x01, 0x75, 0x0c, 0x0a, 0xa5, 0xb5,
-	0xb5, 0xcd, 0x3b, 0xeb, 0x65, 0xbd, 0xa8, 0xa8, 0x07, 0x61, 0x74, 0xf5, 0xf6, 0xa6, 0x57, 0x90,
-	0x71, 0x0a, 0x6e, 0x94, 0x16, 0x97, 0x57, 0x96, 0x37, 0x4a, 0x1b, 0xb7, 0xf5, 0x62, 0x76, 0xfe,
-	0x2a, 0x8c, 0x52, 0x6f, 0x31, 0xea, 0x14, 0x14, 0xa9, 0x4b, 0xd2, 0xe8, 0x08, 0x0c, 0x95, 0xef,
-	0x96, 0x57, 0x37, 0x8a, 0x8a, 0x5a, 0x80, 0xdc, 0xc6, 0xf2, 0xad, 0x72, 0x31, 0x33, 0xbf, 0x0a,
-	0xe0, 0x2f, 0x11, 0xd4, 0x49, 0x38, 0xe8, 0x5f, 0x91, 0x7a, 0x05, 0xc8, 0xdd, 0x5d, 0x2e, 0xdf,
-	0x2b, 0x2a, 0x2a, 0x40, 0x7e, 0x51, 0x2f, 0x97, 0x36, 0xca, 0xc5, 0x8c, 0xf3, 0x7f, 0xa9, 0xbc,
-	0x52, 0xde, 0x28, 0x17, 0xb3, 0x4e, 0xcb, 0xb7, 0xef, 0xad, 0x96, 0xf5, 0x62, 0x6e, 0xfe, 0x1e,
-	0x8c, 0xba, 0xa8, 0xac, 0xdb, 0x86, 0xdd, 0x69, 0x3b, 0x92, 0x50, 0x97, 0xa4, 0x45, 0x80, 0xfc,
-	0xfa, 0xcd, 0x92, 0x5e, 0x5e, 0x2a, 0x2a, 0x8e, 0xaa, 0x7a, 0x79, 0xb1, 0xbc, 0x7c, 0xb7, 0xbc,
-	0x84, 0x5a, 0x75, 0x78, 0x95, 0x97, 0x8a, 0x59, 0x17, 0x84, 0xc5, 0x8d, 0xe5, 0xdb, 0xab, 0xe5,
-	0xa5, 0x62, 0x6e, 0xfe, 0x25, 0x00, 0xff, 0xd1, 0x72, 0x04, 0xf5, 0xaf, 0xa8, 0x66, 0xd7, 0xee,
-	0x5c, 0x5f, 0x59, 0x5e, 0x2c, 0x2a, 0xea, 0x28, 0x0c, 0xaf, 0xe9, 0xcb, 0x77, 0x5d, 0x59, 0xe7,
-	0x6b, 0x30, 0x46, 0xbf, 0xb8, 0xa8, 0xd3, 0x70, 0x88, 0xbe, 0x26, 0xf5, 0x8b, 0x30, 0xb6, 0x7a,
-	0x7b, 0x63, 0xf9, 0xc6, 0xf2, 0x62, 0xc9, 0x61, 0x8b, 0x70, 0x5a, 0xbc, 0x59, 0xda, 0x28, 0x66,
-	0xd4, 0x61, 0xc8, 0xae, 0xdf, 0x5a, 0x47, 0xba, 0x96, 0x6f, 0x95, 0x96, 0x57, 0x8a, 0x39, 0xe7,
-	0xef, 0xda, 0x4d, 0xa7, 0xea, 0x90, 0x73, 0xbb, 0xb4, 0xb2, 0x52, 0xcc, 0xcf, 0xbf, 0x04, 0xf9,
-	0x57, 0x4c, 0xf7, 0x2c, 0xe1, 0x20, 0x8c, 0xa2, 0x7f, 0x14, 0x8e, 0xb7, 0x4a, 0x2b, 0x65, 0x84,
-	0xe3, 0x8d, 0xb2, 0xfb, 0x3f, 0xe3, 0x62, 0xb7, 0x71, 0xb3, 0xec, 0xf4, 0xe2, 0x03, 0x98, 0x2a,
-	0x7f, 0xbd, 0x65, 0x56, 0x6c, 0xb3, 0xba, 0x66, 0x35, 0xb7, 0x9c, 0xe5, 0xb1, 0xdb, 0x9d, 0x27,
-	0xe0, 0x28, 0xaf, 0x9c, 0x52, 0x7b, 0x65, 0x79, 0xb5, 0x5c, 0xc2, 0x43, 0xa5, 0xfc, 0x95, 0xb5,
-	0xdb, 0xab, 0xe5, 0xd5, 0x8d, 0xe5, 0xd2, 0x4a, 0x31, 0xe3, 0x30, 0x5d, 0x5f, 0x5e, 0x75, 0x3a,
-	0xa9, 0x00, 0xb9, 0xeb, 0xe5, 0x95, 0x95, 0x62, 0xee, 0xd2, 0x7f, 0x6e, 0xc1, 0xf8, 0xba, 0x6b,
-	0x27, 0xd6, 0x4d, 0xcb, 0x3d, 0x71, 0xbf, 0x0b, 0xc3, 0xa5, 0x7a, 0xbd, 0xd4, 0x6a, 0xb5, 0x55,
-	0xbe, 0xff, 0x1a, 0xbe, 0x8b, 0xdd, 0x59, 0x66, 0x9f, 0x96, 0x13, 0xa1, 0xb5, 0xa4, 0x76, 0x40,
-	0x7d, 0x03, 0x46, 0x90, 0xcf, 0x58, 0xa9, 0xd5, 0x52, 0xf9, 0x41, 0xc4, 0xbd, 0xfb, 0xa4, 0xed,
-	0x33, 0x51, 0x64, 0x74, 0xeb, 0xc8, 0x29, 0x4b, 0xdc, 0xba, 0x77, 0x5f, 0xde, 0x3a, 0x45, 0xe6,
-	0xb5, 0x7e, 0x17, 0x86, 0x75, 0xd3, 0xa8, 0x3a, 0x6d, 0x3f, 0x25, 0x58, 0x10, 0xba, 0x77, 0xe5,
-	0x98, 0x78, 0x44, 0xb4, 0xd4, 0xc8, 0x49, 0x49, 0x2c, 0xb5, 0x77, 0x5f, 0x2e, 0x35, 0x45, 0xe6,
-	0xb5, 0x6e, 0xc2, 0x58, 0xa9, 0x5e, 0x5f, 0xf3, 0x7c, 0x67, 0xce, 0x89, 0x7a, 0xca, 0x23, 0x21,
-	0x3c, 0x9e, 0x89, 0x41, 0xe9, 0xb1, 0x79, 0x08, 0x13, 0xa8, 0x47, 0x3c, 0xd7, 0x9b, 0x79, 0x49,
-	0xb7, 0x79, 0x4e, 0x3d, 0x98, 0xd5, 0xb3, 0xb1, 0x68, 0x69, 0x66, 0xa8, 0x83, 0x22, 0x98, 0xb1,
-	0x44, 0x72, 0x66, 0x41, 0x5a, 0x1a, 0x40, 0xa7, 0xcf, 0x3c, 0x56, 0xe7, 0x84, 0xdd, 0x1a, 0x64,
-	0xf4, 0x4c, 0x0c, 0x4a, 0x5a, 0x27, 0xd4, 0x7d, 0x11, 0x3a, 0xb1, 0x44, 0x72, 0x9d, 0x82, 0xb4,
-	0x1e, 0xb3, 0x4d, 0x80, 0x52, 0xbd, 0xbe, 0x84, 0x7d, 0x19, 0xce, 0x88, 0x3a, 0x1a, 0x13, 0x10,
-	0x26, 0x67, 0x23, 0xe9, 0x68, 0xd0, 0x50, 0xef, 0x61, 0x0f, 0x9e, 0x73, 0x92, 0x0e, 0x26, 0x39,
-	0xfb, 0x65, 0xa0, 0xb1, 0x94, 0x34, 0x1b, 0xd4, 0x6f, 0x52, 0x36, 0x34, 0x89, 0x9c, 0x0d, 0x4b,
-	0x49, 0xc3, 0xe5, 0xf4, 0x1a, 0x66, 0x72, 0x46, 0xd8, 0xad, 0x2c, 0x8b, 0xb3, 0x91, 0x74, 0xb4,
-	0x1e, 0xa8, 0xaf, 0xa4, 0x7a, 0xd0, 0x24, 0x72, 0x3d, 0x58, 0xca, 0x80, 0x2d, 0xb8, 0xe7, 0xf9,
-	0xbe, 0x08, 0x6d, 0x81, 0x47, 0x12, 0x69, 0x0b, 0x28, 0xca, 0xb0, 0x2d, 0xf0, 0xbc, 0xda, 0x64,
-	0xb6, 0x20, 0x90, 0x4c, 0x5d, 0x6a, 0x0b, 0x82, 0xf9, 0xd1, 0x69, 0x5b, 0x10, 0xc1, 0x8c, 0x9b,
-	0x90, 0x5f, 0x6a, 0x0b, 0x38, 0xcc, 0xb0, 0x2d, 0xf0, 0x58, 0x89, 0x6d, 0x41, 0x90, 0xd1, 0x33,
-	0x31, 0x28, 0xc3, 0xb6, 0x20, 0x42, 0x27, 0x6e, 0x36, 0x7a, 0xa9, 0x2d, 0xe0, 0x30, 0x43, 0xb6,
-	0x00, 0xa7, 0x5c, 0x17, 0xdb, 0x02, 0x36, 0xa9, 0xbc, 0xd8, 0x16, 0x04, 0x72, 0xb7, 0xd3, 0xb6,
-	0x00, 0x3b, 0xae, 0xc9, 0x6c, 0x01, 0x93, 0x20, 0x5a, 0x6a, 0x0b, 0xd8, 0xfc, 0xca, 0xb4, 0x2d,
-	0x90, 0xb2, 0xe1, 0xa4, 0x3a, 0x97, 0xda, 0x82, 0x10, 0x1b, 0x6c, 0x0b, 0x30, 0x13, 0xb1, 0x2d,
-	0x60, 0x59, 0x9c, 0x8d, 0xa4, 0x0b, 0xdb, 0x02, 0xa9, 0x1e, 0x9c, 0x6c, 0xdf, 0x52, 0x5b, 0xc0,
-	0x63, 0x43, 0x27, 0xaa, 0x16, 0xb0, 0xe1, 0xa4, 0xed, 0x16, 0xb0, 0xe1, 0x65, 0xbd, 0xf6, 0x47,
-	0x17, 0xca, 0xe7, 0x2c, 0x19, 0x5d, 0x4c, 0xc6, 0x6a, 0xc9, 0xe8, 0x62, 0x13, 0x43, 0x33, 0xa3,
-	0x0b, 0x9d, 0xba, 0x48, 0x47, 0x17, 0x9d, 0x1b, 0x57, 0x3e, 0xba, 0x98, 0x74, 0xb7, 0xcc, 0xe8,
-	0x92, 0xb1, 0xe1, 0xe4, 0x51, 0x96, 0x8f, 0xae, 0x20, 0x1b, 0x32, 0xba, 0x10, 0x13, 0xc9, 0xe8,
-	0x62, 0x58, 0x9c, 0x8d, 0xa4, 0xe3, 0x8c, 0x2e, 0x99, 0x1e, 0x9c, 0x54, 0xc2, 0xf2, 0xd1, 0x15,
-	0x64, 0xf3, 0x01, 0x0a, 0xc8, 0xc7, 0xc9, 0x91, 0xab, 0x5e, 0x12, 0xf5, 0xad, 0x38, 0x3d, 0xf0,
-	0xec, 0xe5, 0x44, 0x75, 0x3c, 0x29, 0x7e, 0x59, 0xc1, 0xee, 0xf7, 0x21, 0xf7, 0xa2, 0x8b, 0x42,
-	0xc4, 0x44, 0xa9, 0x34, 0x67, 0x2f, 0x25, 0xa9, 0xe2, 0x89, 0xf0, 0x5d, 0x2f, 0xb5, 0x61, 0x58,
-	0x88, 0xcb, 0x92, 0x01, 0x28, 0x14, 0xe3, 0x4a, 0xb2, 0x4a, 0x8c, 0x20, 0x82, 0x50, 0xf4, 0x02,
-	0x41, 0xe4, 0x69, 0x72, 0x05, 0x82, 0x44, 0x24, 0xb1, 0xc5, 0x82, 0x08, 0xc2, 0xa3, 0x0b, 0x04,
-	0x91, 0xe7, 0x65, 0x15, 0x08, 0x12, 0x91, 0x6a, 0x15, 0x0b, 0x22, 0xc8, 0x2a, 0x2a, 0x10, 0x44,
-	0x9e, 0x6c, 0x55, 0x20, 0x48, 0x44, 0xe2, 0x52, 0x34, 0xdd, 0xb3, 0x29, 0x38, 0x05, 0xd3, 0x3d,
-	0x37, 0xdb, 0xa8, 0x60, 0xba, 0xe7, 0xe7, 0xf4, 0xd4, 0x0e, 0xa8, 0x6f, 0x43, 0x31, 0x98, 0xdd,
-	0x52, 0x7d, 0x4e, 0x32, 0xa6, 0x42, 0x69, 0x09, 0x67, 0xcf, 0xc7, 0xa4, 0xa6, 0x59, 0x06, 0xf3,
-	0x47, 0x0a, 0x58, 0x0a, 0x72, 0x5e, 0x0a, 0x58, 0x8a, 0x92, 0x52, 0x22, 0x48, 0xd9, 0xb4, 0x8e,
-	0x02, 0x48, 0xb9, 0x49, 0x25, 0x05, 0x90, 0xf2, 0xf3, 0x44, 0x22, 0xfd, 0x82, 0x79, 0x16, 0x05,
-	0xfa, 0x09, 0x32, 0x3d, 0x0a, 0xf4, 0x13, 0x25, 0x6f, 0x44, 0x2c, 0x83, 0x79, 0x0c, 0x05, 0x2c,
-	0x05, 0x69, 0x1b, 0x05, 0x2c, 0x45, 0xc9, 0x11, 0xb5, 0x03, 0xea, 0x63, 0xf2, 0x3d, 0x33, 0xe3,
-	0x66, 0xbc, 0x20, 0x19, 0x0c, 0x9c, 0x04, 0x6e, 0xb3, 0x17, 0x62, 0xd3, 0xd3, 0x8c, 0xc3, 0x89,
-	0xf7, 0x04, 0x8c, 0x85, 0x69, 0x03, 0x05, 0x8c, 0xc5, 0x19, 0xfd, 0x10, 0xc8, 0xc1, 0x74, 0x78,
-	0x02, 0x90, 0x05, 0x19, 0xf9, 0x04, 0x20, 0x8b, 0x72, 0xec, 0x21, 0x5d, 0xc3, 0xf9, 0xe9, 0x04,
-	0xba, 0x0a, 0xb3, 0xe4, 0x09, 0x74, 0x15, 0x27, 0xbe, 0xd3, 0x0e, 0xa8, 0x36, 0x1c, 0x0a, 0xa5,
-	0x80, 0x53, 0x85, 0x63, 0x84, 0x9b, 0xf3, 0x6e, 0x76, 0x21, 0x2e, 0xb9, 0xc7, 0xf5, 0x1d, 0x98,
-	0xe4, 0x24, 0x55, 0x53, 0x65, 0x83, 0x84, 0x97, 0x25, 0x6a, 0xf6, 0xf9, 0xf8, 0x15, 0x68, 0xde,
-	0x9c, 0x6c, 0x65, 0xaa, 0x6c, 0x9c, 0x24, 0xe0, 0x2d, 0x49, 0x84, 0x86, 0xd0, 0x0e, 0xa5, 0x09,
-	0x53, 0xc5, 0x83, 0x85, 0xcb, 0x77, 0x21, 0x2e, 0x39, 0xad, 0x31, 0x27, 0x6f, 0x97, 0x2a, 0x1b,
-	0x2d, 0x09, 0x34, 0x96, 0xa4, 0x04, 0x43, 0xbc, 0x39, 0x09, 0xb0, 0x04, 0xbc, 0xc5, 0xf9, 0xc0,
-	0x04, 0xbc, 0x25, 0xb9, 0xb5, 0xb4, 0x03, 0xea, 0x87, 0x28, 0x46, 0x1f, 0x37, 0x41, 0x94, 0x7a,
-	0x25, 0xee, 0xd2, 0x92, 0xce, 0x8f, 0x35, 0xfb, 0x42, 0xc2, 0x5a, 0x9e, 0x2c, 0x1f, 0x79, 0xb1,
-	0xed, 0xb8, 0xee, 0xf9, 0x2f, 0x26, 0x59, 0xdd, 0xf9, 0x29, 0x6b, 0x66, 0x3f, 0x9f, 0xb8, 0x1e,
-	0x23, 0x91, 0x38, 0x47, 0x91, 0x40, 0xa2, 0xc8, 0x4c, 0x4c, 0x02, 0x89, 0xa2, 0x93, 0x21, 0xe1,
-	0xfe, 0x12, 0xa5, 0x0e, 0x12, 0xf4, 0x57, 0x44, 0x36, 0x23, 0x41, 0x7f, 0x45, 0xe5, 0x27, 0xc2,
-	0xe8, 0x88, 0x93, 0xf9, 0x08, 0xd0, 0x89, 0x4c, 0x31, 0x24, 0x40, 0x27, 0x3a, 0x6b, 0x10, 0x96,
-	0x48, 0x9c, 0x37, 0x47, 0x20, 0x51, 0x64, 0xea, 0x20, 0x81, 0x44, 0xd1, 0x09, 0x7a, 0xb4, 0x03,
-	0xea, 0x27, 0x5e, 0x58, 0x44, 0xfe, 0x07, 0x01, 0xb2, 0xc1, 0x29, 0x4b, 0xdf, 0x31, 0x7b, 0x35,
-	0x79, 0x45, 0x46, 0x28, 0x49, 0xda, 0x16, 0x55, 0x36, 0x3e, 0xbb, 0x10, 0x2a, 0x46, 0x86, 0x18,
-	0xed, 0x80, 0xfa, 0xeb, 0x38, 0x6e, 0x20, 0x5f, 0x24, 0xf1, 0x20, 0x95, 0x0a, 0xf4, 0x62, 0xd2,
-	0x6a, 0x0c, 0x46, 0x92, 0xfc, 0x26, 0xaa, 0x6c, 0x94, 0x76, 0x81, 0x51, 0x8c, 0x54, 0x2a, 0xda,
-	0x01, 0xf5, 0x5d, 0x98, 0xe2, 0x65, 0x14, 0x51, 0x9f, 0x8f, 0x18, 0xa0, 0xe1, 0x21, 0x7d, 0x31,
-	0x41, 0x0d, 0x66, 0xcf, 0x80, 0x9b, 0xa0, 0x43, 0xb0, 0x67, 0x20, 0xcb, 0x36, 0x22, 0xd8, 0x33,
-	0x90, 0xe6, 0xff, 0xc0, 0x22, 0x70, 0x93, 0x5e, 0x08, 0x44, 0x90, 0x65, 0xf0, 0x10, 0x88, 0x20,
-	0xcd, 0xa9, 0x81, 0xa6, 0x6b, 0x4e, 0x2a, 0x0a, 0xc1, 0x74, 0x2d, 0xce, 0x89, 0x21, 0x98, 0xae,
-	0x25, 0x59, 0x2e, 0xb0, 0xfa, 0xdc, 0xe4, 0x10, 0x02, 0xf5, 0x65, 0x89, 0x2a, 0x04, 0xea, 0x4b,
-	0x73, 0x4f, 0x68, 0x07, 0xd4, 0x6f, 0x31, 0x71, 0x91, 0x09, 0x81, 0x1f, 0xc5, 0x41, 0xf2, 0x36,
-	0x19, 0x0a, 0xf5, 0xd0, 0x15, 0x06, 0xdf, 0x53, 0xe0, 0x73, 0x52, 0x09, 0x6e, 0x5b, 0x68, 0x14,
-	0xed, 0xb9, 0x24, 0x3b, 0x70, 0x30, 0x90, 0x92, 0x42, 0x7d, 0x36, 0xe2, 0xb9, 0x62, 0xb6, 0x27,
-	0x9e, 0x8b, 0x47, 0x4c, 0x2f, 0x8d, 0x43, 0xa9, 0x1e, 0xd4, 0xf3, 0xd1, 0xcf, 0x11, 0xfd, 0x3a,
-	0xbd, 0x10, 0x97, 0x9c, 0xe6, 0x1a, 0xca, 0xa4, 0xa0, 0x9e, 0x8f, 0x7e, 0x74, 0xa2, 0xb9, 0x0a,
-	0x13, 0x34, 0x20, 0x6c, 0x03, 0xf9, 0x0d, 0xd4, 0x67, 0xa3, 0xba, 0x88, 0xe6, 0xf8, 0x5c, 0x3c,
-	0x62, 0x5a, 0xcb, 0x50, 0xc2, 0x01, 0xf5, 0x7c, 0xf4, 0x13, 0x12, 0xad, 0xa5, 0x30, 0x8f, 0x01,
-	0x7e, 0x9e, 0xb9, 0xe1, 0xfc, 0xd5, 0x28, 0x03, 0x1d, 0xce, 0x63, 0x30, 0x7b, 0x29, 0x49, 0x15,
-	0xce, 0x2e, 0x6c, 0xf8, 0x13, 0xa5, 0xcb, 0xd1, 0x83, 0x25, 0x14, 0x87, 0x5c, 0xba, 0x0b, 0x2b,
-	0x0c, 0x2d, 0xce, 0xec, 0xc2, 0xc6, 0x15, 0x44, 0x1e, 0x3a, 0x5f, 0xba, 0x0b, 0x2b, 0x13, 0x84,
-	0x6c, 0x8d, 0x87, 0xc5, 0xb8, 0x18, 0x35, 0xa8, 0xc2, 0x42, 0x5c, 0x4a, 0x52, 0x85, 0xb3, 0x11,
-	0x1c, 0x17, 0x0b, 0x79, 0x70, 0x78, 0xe9, 0x46, 0xb0, 0x4c, 0x10, 0xb4, 0xf7, 0xc1, 0x46, 0x3e,
-	0x17, 0xef, 0x7d, 0x70, 0x43, 0xbd, 0x8b, 0xf7, 0x3e, 0xf8, 0x01, 0xd5, 0xe9, 0xbd, 0x0f, 0xf6,
-	0x8b, 0xaa, 0x0b, 0x91, 0xa7, 0x52, 0x6c, 0xe4, 0x64, 0xe9, 0xde, 0x07, 0x37, 0xca, 0x30, 0xbd,
-	0xf7, 0x11, 0x87, 0xb7, 0x38, 0xce, 0xb8, 0x74, 0xef, 0x43, 0xc4, 0x1b, 0xef, 0x7d, 0xb0, 0x9c,
-	0xcf, 0x47, 0x9c, 0x60, 0x05, 0xf8, 0x2e, 0xc4, 0x25, 0x0f, 0xef, 0x7d, 0xc4, 0xd1, 0x58, 0x1c,
-	0x53, 0x5b, 0xba, 0xf7, 0x21, 0xe1, 0xcd, 0x09, 0xfa, 0x2c, 0xdd, 0xfb, 0x48, 0xc0, 0x5b, 0x12,
-	0x4f, 0xda, 0x3f, 0x88, 0xe3, 0x84, 0x45, 0x16, 0x1f, 0xc4, 0x89, 0x23, 0x42, 0x8b, 0x0f, 0xe2,
-	0x24, 0x71, 0x97, 0xf1, 0x1b, 0xbd, 0x28, 0xb4, 0xb0, 0x7a, 0x45, 0xba, 0x79, 0x27, 0x08, 0x86,
-	0x2a, 0x78, 0xa3, 0x8f, 0x8a, 0x5f, 0x8c, 0x65, 0x11, 0x45, 0xe9, 0x55, 0xaf, 0x48, 0x37, 0xf3,
-	0x92, 0xc9, 0x12, 0x15, 0x0a, 0x18, 0xf7, 0x0e, 0x3f, 0x78, 0xae, 0x7a, 0x49, 0xb2, 0xbd, 0x27,
-	0x92, 0xe3, 0x72, 0xa2, 0x3a, 0x0c, 0x22, 0xa2, 0x70, 0xb6, 0xea, 0x15, 0xe9, 0x66, 0x5f, 0x32,
-	0x44, 0xa2, 0x62, 0xe6, 0x62, 0x59, 0x44, 0x01, 0x61, 0xd5, 0x2b, 0xd2, 0xcd, 0xbf, 0x64, 0xb2,
-	0x44, 0x45, 0x9d, 0x45, 0x87, 0xf1, 0x7e, 0x04, 0x57, 0x55, 0xe6, 0x86, 0x4a, 0xc5, 0x5a, 0x15,
-	0x1c, 0xc6, 0x87, 0x43, 0xc1, 0x22, 0x06, 0x7e, 0x9c, 0x55, 0x55, 0xe6, 0x89, 0x1a, 0xcd, 0x20,
-	0x1c, 0xb0, 0x15, 0x1d, 0x27, 0x84, 0xe3, 0xa2, 0xaa, 0x62, 0xeb, 0xc9, 0x0d, 0xbd, 0x3a, 0x7b,
-	0x21, 0x36, 0xbd, 0xc7, 0xf8, 0x3e, 0x8c, 0x52, 0x91, 0x31, 0x55, 0xa1, 0x3f, 0x47, 0x20, 0xfa,
-	0xe7, 0xec, 0xb9, 0x68, 0x42, 0x8f, 0xc7, 0x36, 0x8c, 0x33, 0xf1, 0x27, 0x55, 0x99, 0x43, 0x07,
-	0x1b, 0x58, 0x70, 0x76, 0x3e, 0x0e, 0x29, 0xcd, 0x89, 0x09, 0xf6, 0xa8, 0xca, 0x7c, 0x3a, 0x62,
-	0x71, 0xe2, 0xc6, 0x8e, 0x44, 0xb8, 0x51, 0x31, 0x18, 0x55, 0xb1, 0x63, 0x47, 0x80, 0xcb, 0xb9,
-	0x68, 0x42, 0x5a, 0x1b, 0x26, 0x28, 0xa2, 0x2a, 0xf3, 0xec, 0x88, 0xa5, 0x0d, 0x37, 0xc6, 0xa2,
-	0x76, 0x40, 0x7d, 0x0f, 0x8e, 0x0a, 0xe3, 0x26, 0x0a, 0x76, 0xbb, 0xa2, 0xe2, 0x2c, 0x26, 0x1a,
-	0x21, 0x0d, 0x98, 0xf2, 0x5d, 0xac, 0xba, 0x7c, 0x7b, 0x4f, 0xe0, 0xb9, 0xf5, 0xa1, 0x02, 0x9a,
-	0x68, 0x4b, 0xb9, 0x4b, 0xee, 0x5d, 0xef, 0x5b, 0x7f, 0x03, 0xe5, 0x12, 0x65, 0x8e, 0x44, 0xba,
-	0x94, 0x21, 0xf9, 0x41, 0xd3, 0x16, 0x5a, 0xe2, 0x95, 0x5a, 0xad, 0x2e, 0x59, 0xc6, 0x75, 0x9d,
-	0x7f, 0x1b, 0x4d, 0x9f, 0xc4, 0xab, 0xb1, 0x4b, 0x6e, 0x89, 0x7c, 0x33, 0xf1, 0x98, 0x42, 0xbe,
-	0xb5, 0x3d, 0x1f, 0x53, 0x21, 0x97, 0xdd, 0x2d, 0x98, 0x45, 0x81, 0x9f, 0xb9, 0xc7, 0x0f, 0x9a,
-	0xc0, 0xb8, 0x50, 0xb1, 0xa8, 0x67, 0x9f, 0x92, 0xd2, 0x50, 0x96, 0x67, 0x1a, 0x95, 0x05, 0x37,
-	0xdd, 0x7a, 0xc8, 0xe3, 0x35, 0x18, 0x43, 0x65, 0xd8, 0xe7, 0xb0, 0x87, 0x4d, 0x57, 0x9d, 0xf7,
-	0x6a, 0x2e, 0x4e, 0xbd, 0xe4, 0xf2, 0x00, 0x8e, 0x72, 0x41, 0x72, 0x3f, 0x4d, 0xed, 0x21, 0x9f,
-	0xb7, 0x9c, 0xf7, 0x33, 0xa7, 0x8c, 0x3d, 0x2d, 0xed, 0x1d, 0x87, 0xfb, 0xf9, 0x96, 0xd5, 0xb4,
-	0x9b, 0x97, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xa0, 0x65, 0xbc, 0xb2, 0xc9, 0x00, 0x00,
+	// 7226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x1c, 0xc9,
+	0x75, 0xf6, 0xf6, 0xcc, 0x70, 0x38, 0x3c, 0xbc, 0x68, 0xd4, 0x24, 0x25, 0x6a, 0xb4, 0x92, 0xa8,
+	0xde, 0x8b, 0xb4, 0xd2, 0x8a, 0xd2, 0x52, 0xd4, 0xde, 0x7c, 0xd9, 0x7f, 0x44, 0x8e, 0x2c, 0xda,
+	0x12, 0xc5, 0xbf, 0x49, 0x49, 0x5e, 0xff, 0xb6, 0xe9, 0xd6, 0x4c, 0x8b, 0x1c, 0x68, 0x6e, 0xdb,
+	0xd3, 0x94, 0xcc, 0xb5, 0x77, 0x7d, 0xfd, 0xed, 0xd8, 0x89, 0xe3, 0x78, 0x63, 0x3b, 0x48, 0x1e,
+	0x12, 0x04, 0x08, 0x82, 0xc4, 0x0f, 0x79, 0x08, 0x82, 0x3c, 0x04, 0x41, 0x9c, 0xc4, 0x49, 0x80,
+	0x24, 0x30, 0x82, 0x3c, 0xe7, 0x2d, 0x0f, 0x41, 0xf2, 0x14, 0x04, 0x49, 0xde, 0x83, 0xea, 0xaa,
+	0xea, 0xa9, 0xea, 0xae, 0xaa, 0xbe, 0xcc, 0x70, 0x28, 0x2e, 0xf2, 0x34, 0xd3, 0xd5, 0xa7, 0xea,
+	0x9c, 0xf3, 0x55, 0xf5, 0xa9, 0xea, 0xaa, 0xd3, 0xe7, 0xc0, 0xc5, 0xae, 0xed, 0x3c, 0xb6, 0x9d,
+	0xcb, 0x5d, 0xd7, 0x72, 0xeb, 0xd5, 0x4b, 0x5d, 0xe7, 0xf1, 0xe5, 0x8e, 0xd3, 0x76, 0xdb, 0xa4,
+	0x80, 0xfc, 0x2c, 0x78, 0x65, 0xfa, 0xf4, 0x76, 0x7b, 0xa1, 0x59, 0xaf, 0x3a, 0xed, 0x85, 0xae,
+	0xf3, 0x78, 0x01, 0xdf, 0x32, 0xae, 0xc1, 0x68, 0xb9, 0xd3, 0x59, 0xb1, 0x5c, 0x4b, 0xbf, 0x00,
+	0x59, 0xab, 0xd3, 0x99, 0xd3, 0xe6, 0xb5, 0xf3, 0xe3, 0x8b, 0x73, 0x0b, 0x02, 0xea, 0x85, 0x72,
+	0xa7, 0x63, 0x22, 0x22, 0xe3, 0x4d, 0x80, 0x72, 0xa7, 0x53, 0x76, 0x1c, 0xaf, 0xe6, 0xcb, 0x90,
+	0xb3, 0x3a, 0x9d, 0xee, 0x9c, 0x36, 0x9f, 0x55, 0x56, 0xf5, 0xa8, 0x8c, 0x55, 0x98, 0x58, 0x6f,
+	0x58, 0xee, 0xc3, 0xb6, 0xd3, 0xf4, 0x6a, 0xbf, 0x01, 0x85, 0x0e, 0xb9, 0x26, 0xcc, 0x4f, 0x09,
+	0x5b, 0xa0, 0x95, 0x4c, 0x9f, 0xdc, 0x58, 0x83, 0x23, 0xb4, 0x94, 0xca, 0xf2, 0x11, 0x18, 0xa3,
+	0xb7, 0xa9, 0x40, 0x11, 0xcd, 0xf5, 0xe8, 0x8d, 0x32, 0xc0, 0x8a, 0xfd, 0xb8, 0x5e, 0xb5, 0xbd,
+	0xa6, 0xae, 0x42, 0xbe, 0xe6, 0x5d, 0x11, 0xb1, 0x4e, 0x0a, 0xdb, 0xc1, 0x15, 0x4c, 0x42, 0x6a,
+	0xdc, 0x80, 0x49, 0x5c, 0x42, 0x05, 0xba, 0x06, 0xa3, 0xf8, 0x16, 0x15, 0x47, 0xd9, 0x0c, 0xa5,
+	0x45, 0x28, 0xdd, 0xb7, 0x2d, 0xc7, 0x7a, 0xd0, 0xb0, 0x29, 0x4a, 0x4f, 0xc8, 0xb5, 0x12, 0x25,
+	0x5a, 0xc9, 0xf4, 0xc9, 0x11, 0x4a, 0xb4, 0x94, 0x41, 0x89, 0xde, 0x56, 0xa3, 0xe4, 0x37, 0xd7,
+	0xa3, 0x47, 0x28, 0xdd, 0xb6, 0x9c, 0x47, 0xb6, 0x43, 0x51, 0x6a, 0x7a, 0x57, 0x4a, 0x94, 0x70,
+	0x05, 0x93, 0x90, 0x22, 0x94, 0x70, 0x09, 0x83, 0x12, 0xbe, 0xa5, 0x46, 0x89, 0x34, 0x43, 0x69,
+	0x3d, 0x51, 0xda, 0xb5, 0xdd, 0x86, 0xdf, 0x61, 0x4d, 0xef, 0x4a, 0x2d, 0x8a, 0x47, 0x62, 0x12,
+	0x52, 0x4f, 0x14, 0xef, 0x1f, 0x2b, 0x8a, 0x57, 0x10, 0x21, 0x0a, 0x6e, 0x86, 0xd2, 0x1a, 0xff,
+	0x0f, 0x66, 0xaf, 0xdb, 0x3b, 0xd6, 0xe3, 0x7a, 0x7b, 0xd7, 0x59, 0xb6, 0x5c, 0x7b, 0xbb, 0xed,
+	0xec, 0x79, 0xed, 0x5d, 0x87, 0x42, 0x95, 0x5c, 0x13, 0xb9, 0x5e, 0x14, 0x36, 0x18, 0xaa, 0x6d,
+	0xfa, 0xf5, 0x8c, 0x07, 0x30, 0x17, 0xba, 0x4d, 0xe5, 0xbd, 0x01, 0x40, 0xe8, 0xea, 0xbe, 0xc8,
+	0x71, 0x39, 0x30, 0x35, 0x8d, 0xff, 0x0b, 0x53, 0x1b, 0xed, 0x6a, 0xdd, 0x6a, 0x6c, 0xee, 0x75,
+	0x30, 0x9e, 0x6f, 0x01, 0x74, 0xfd, 0x12, 0x22, 0xfb, 0x19, 0x61, 0xcb, 0xbd, 0x8a, 0x26, 0x53,
+	0xc5, 0xb8, 0x07, 0x47, 0x7b, 0x77, 0xa8, 0xbc, 0x65, 0x18, 0xef, 0x91, 0x50, 0x81, 0x23, 0x9b,
+	0x65, 0xeb, 0x18, 0x6f, 0x43, 0x71, 0xad, 0xed, 0xd6, 0x1f, 0xd6, 0xab, 0x96, 0x5b, 0x6f, 0xb7,
+	0xbc, 0x66, 0x2b, 0x30, 0xd1, 0x62, 0xca, 0x88, 0xb8, 0x67, 0x85, 0xed, 0xb2, 0x95, 0x4d, 0xae,
+	0x9a, 0xf1, 0x79, 0x98, 0x66, 0xef, 0x52, 0xa1, 0x3f, 0x01, 0x93, 0x2c, 0x19, 0x15, 0x3b, 0x46,
+	0xf3, 0x7c, 0x3d, 0xe3, 0x73, 0x70, 0x74, 0xd3, 0xb1, 0xaa, 0x8f, 0x6c, 0xe7, 0xb6, 0xed, 0xee,
+	0xb4, 0x6b, 0x5e, 0xeb, 0x37, 0x61, 0xd2, 0x65, 0x0b, 0x89, 0xf0, 0x86, 0xb0, 0x75, 0xae, 0xba,
+	0xc9, 0x57, 0x34, 0x1e, 0xc0, 0x0c, 0x77, 0x9f, 0xca, 0xff, 0x49, 0x98, 0xe2, 0x08, 0xa9, 0x02,
+	0x71, 0x58, 0x04, 0x6a, 0x1a, 0x7b, 0xa2, 0xc1, 0x58, 0xc7, 0xc6, 0xfc, 0x73, 0x30, 0xf3, 0x40,
+	0x70, 0x8f, 0x28, 0xf4, 0x52, 0xbc, 0x61, 0x59, 0xae, 0x37, 0x4d, 0x61, 0x33, 0xc6, 0xfb, 0x70,
+	0x52, 0x44, 0x4d, 0xb5, 0xdc, 0x82, 0x59, 0x51, 0x35, 0xaa, 0x6c, 0x02, 0xf6, 0xe2, 0x76, 0x8c,
+	0xf7, 0xe0, 0xc4, 0x72, 0xbb, 0xe5, 0xda, 0x2d, 0x77, 0xdd, 0x72, 0xec, 0x96, 0xcb, 0x3d, 0xe8,
+	0x5f, 0x80, 0xd9, 0xaa, 0xe8, 0x26, 0x51, 0xfe, 0x82, 0x90, 0xbb, 0xb0, 0x39, 0x53, 0xdc, 0x90,
+	0xf1, 0x4d, 0x0d, 0x9e, 0x15, 0x56, 0xa0, 0x00, 0xd4, 0xe0, 0xb8, 0xa8, 0x66, 0xcf, 0x30, 0x24,
+	0x11, 0x42, 0xd6, 0x94, 0x61, 0xc3, 0x34, 0xa9, 0xc1, 0xe9, 0xbf, 0x06, 0x47, 0xaa, 0x7c, 0x31,
+	0xd1, 0xfc, 0x79, 0x15, 0x53, 0x9f, 0x5d, 0xb0, 0xb2, 0xd1, 0x80, 0x63, 0x01, 0x1a, 0xaa, 0xa6,
+	0x09, 0x47, 0x79, 0xe2, 0x9e, 0x82, 0xf1, 0x78, 0x85, 0xab, 0x1b, 0x77, 0xe1, 0x08, 0xa1, 0xf2,
+	0xed, 0xdf, 0x75, 0x18, 0xaf, 0xf6, 0x8a, 0x88, 0x32, 0xf3, 0x2a, 0x06, 0xd8, 0x54, 0x31, 0x95,
+	0x8c, 0xcf, 0x80, 0xce, 0xdc, 0xa3, 0x0a, 0xac, 0xc0, 0x04, 0x43, 0x44, 0x65, 0x8f, 0x6e, 0x9a,
+	0xab, 0x65, 0x34, 0x61, 0x96, 0xdc, 0xdc, 0x68, 0xef, 0x3a, 0x55, 0xdb, 0x17, 0x7c, 0xd3, 0xc7,
+	0xa7, 0x77, 0x43, 0x39, 0xf7, 0x84, 0x9a, 0x31, 0xc3, 0x0d, 0x18, 0x0e, 0xcc, 0x85, 0xe8, 0xa8,
+	0x42, 0xf7, 0x40, 0x0f, 0x55, 0x50, 0x4f, 0x46, 0x61, 0x96, 0x82, 0x16, 0x90, 0xb9, 0xc4, 0x13,
+	0xed, 0xa6, 0x53, 0xdf, 0xde, 0x26, 0x4b, 0x8e, 0x9b, 0x30, 0xd9, 0x64, 0x0b, 0x95, 0xe6, 0x92,
+	0xab, 0x6e, 0xf2, 0x15, 0x91, 0xb9, 0xe4, 0xee, 0x33, 0xe6, 0x92, 0x23, 0x54, 0x9b, 0x4b, 0x9e,
+	0x45, 0xa0, 0xa6, 0xf1, 0x0e, 0x1c, 0x23, 0xff, 0x83, 0xe3, 0xeb, 0x3e, 0xe8, 0x6e, 0xe8, 0x0e,
+	0x51, 0xe6, 0x9c, 0xc4, 0x30, 0x07, 0xc9, 0x4d, 0x41, 0x13, 0xc6, 0x63, 0x38, 0x11, 0xa6, 0xa4,
+	0xba, 0xbd, 0x0d, 0xd3, 0xe1, 0x2a, 0x54, 0xc1, 0xd8, 0x6c, 0x45, 0x6d, 0x18, 0x6f, 0x40, 0xc1,
+	0x6c, 0x93, 0xc5, 0xd8, 0x25, 0xc8, 0x39, 0x6d, 0x7f, 0x29, 0x76, 0x42, 0xd8, 0x2e, 0x22, 0x36,
+	0x3d, 0x32, 0xe3, 0xe3, 0x30, 0x8e, 0xae, 0xa8, 0x90, 0x97, 0x61, 0x04, 0x15, 0x53, 0xb1, 0x14,
+	0xd5, 0x31, 0x9d, 0xf1, 0xa7, 0x1a, 0x4c, 0x95, 0x1b, 0x8d, 0x72, 0xa7, 0xd3, 0x35, 0xed, 0x77,
+	0x76, 0xed, 0xae, 0xab, 0xcf, 0x42, 0xbe, 0xed, 0x6c, 0x6f, 0xd5, 0xf1, 0x74, 0x3a, 0x66, 0x8e,
+	0xb4, 0x9d, 0xed, 0xd5, 0x9a, 0x7e, 0x1c, 0x46, 0x5d, 0xdb, 0x6a, 0xa2, 0xf2, 0x8c, 0x57, 0x9e,
+	0x47, 0x97, 0xab, 0x35, 0x7d, 0x06, 0x46, 0x1a, 0xf5, 0x66, 0xdd, 0x9d, 0xcb, 0xce, 0x6b, 0xe7,
+	0xb3, 0x26, 0xbe, 0xd0, 0x8f, 0x41, 0xbe, 0xfd, 0xf0, 0x61, 0xd7, 0x76, 0xe7, 0x72, 0x5e, 0x31,
+	0xb9, 0xd2, 0x5f, 0x80, 0xa9, 0x6e, 0xdb, 0x71, 0xb7, 0x3a, 0x96, 0x63, 0x35, 0x6d, 0xd7, 0x76,
+	0xe6, 0x46, 0xbc, 0xd6, 0x26, 0x51, 0xe9, 0x3a, 0x2d, 0xf4, 0xc9, 0x6a, 0x75, 0xc7, 0xae, 0x7a,
+	0x0b, 0x93, 0x7c, 0x8f, 0x6c, 0x85, 0x16, 0x1a, 0x2e, 0x1c, 0xf1, 0xa5, 0xef, 0x76, 0xda, 0xad,
+	0xae, 0xad, 0x5f, 0x85, 0x5c, 0xcd, 0x72, 0x2d, 0xe5, 0xba, 0xab, 0xf7, 0x12, 0x66, 0x7a, 0xc4,
+	0xba, 0x0e, 0xb9, 0x6a, 0xbb, 0x66, 0x7b, 0x9a, 0x65, 0x4d, 0xef, 0xbf, 0x3e, 0x07, 0xa3, 0x4d,
+	0xbb, 0xdb, 0xb5, 0xb6, 0x6d, 0x4f, 0xb3, 0x31, 0x93, 0x5e, 0x1a, 0x2d, 0x28, 0x2e, 0x3b, 0xb6,
+	0xe5, 0xda, 0xe8, 0xed, 0x8c, 0xa0, 0x96, 0xe0, 0x35, 0x90, 0x41, 0x38, 0x23, 0x41, 0x38, 0xcb,
+	0x22, 0x6c, 0x74, 0xe1, 0x28, 0xc3, 0x8f, 0xe8, 0x79, 0x85, 0xd3, 0xf3, 0x59, 0x19, 0xc7, 0x7e,
+	0x94, 0xbc, 0xdb, 0xa9, 0x0d, 0x55, 0x49, 0x86, 0xdf, 0x90, 0x94, 0x5c, 0x87, 0x29, 0xd3, 0xb6,
+	0x6a, 0x8c, 0x8a, 0x53, 0x90, 0xf1, 0x47, 0x7e, 0xa6, 0x5e, 0x4b, 0xac, 0xc6, 0x3b, 0x70, 0xc4,
+	0x6f, 0x71, 0x48, 0x4a, 0x3c, 0x82, 0xe2, 0x8a, 0xdd, 0xb0, 0x5d, 0x7b, 0x70, 0x6a, 0xa0, 0x1b,
+	0xbb, 0x5d, 0xdb, 0x41, 0x37, 0x72, 0xf8, 0x06, 0xba, 0x5c, 0xad, 0x19, 0x65, 0x38, 0xca, 0x30,
+	0x23, 0x1a, 0x52, 0x79, 0x35, 0xb1, 0xbc, 0x19, 0x5e, 0xde, 0xbf, 0xd0, 0x60, 0xba, 0xdc, 0x68,
+	0xd0, 0x9d, 0x84, 0x43, 0x69, 0x78, 0xde, 0x87, 0x19, 0x5e, 0x05, 0x82, 0xc4, 0xeb, 0x5c, 0x5f,
+	0x3f, 0xaf, 0xdc, 0x42, 0xe9, 0xc7, 0x04, 0x7d, 0x5d, 0x83, 0x59, 0x6c, 0x13, 0x68, 0x6b, 0x14,
+	0xc5, 0xf4, 0xfb, 0x42, 0x89, 0xc7, 0xfa, 0x7b, 0x70, 0x2c, 0x28, 0x03, 0x81, 0xe1, 0x1a, 0x07,
+	0xc3, 0x59, 0xa5, 0x00, 0x7d, 0x61, 0x80, 0x4d, 0xc6, 0xc1, 0x62, 0x10, 0x94, 0x61, 0x98, 0x18,
+	0xdc, 0x85, 0x69, 0x64, 0x6e, 0x82, 0x00, 0xf4, 0x6b, 0xc5, 0xbe, 0x04, 0x33, 0x7c, 0xb3, 0xc3,
+	0xd4, 0xa9, 0x03, 0xb3, 0xd8, 0xc4, 0x0c, 0x58, 0x2b, 0xb9, 0x51, 0xbb, 0x01, 0xc7, 0x82, 0x1c,
+	0x53, 0x59, 0xb6, 0x9f, 0x6a, 0x70, 0xb4, 0xdc, 0x68, 0xe0, 0x4d, 0xc9, 0x43, 0x69, 0xd7, 0xde,
+	0x05, 0x9d, 0x55, 0x80, 0xa0, 0xf0, 0x2a, 0xd7, 0xed, 0x86, 0x62, 0x27, 0xb6, 0x1f, 0x9b, 0xf6,
+	0x2e, 0x4c, 0x63, 0x73, 0x82, 0x9b, 0xa2, 0xf0, 0xa5, 0xd9, 0x4f, 0x4e, 0x3c, 0xe0, 0xf7, 0x60,
+	0x86, 0xe7, 0x9d, 0x60, 0x35, 0xd9, 0xdb, 0xfb, 0x4e, 0xab, 0x36, 0xb6, 0x20, 0x07, 0xa3, 0x36,
+	0xcf, 0x7b, 0x78, 0x6a, 0x6f, 0xc0, 0x51, 0x64, 0x62, 0x78, 0xa5, 0xfb, 0xb5, 0x5b, 0x4f, 0x40,
+	0x67, 0x1b, 0x1d, 0x9e, 0x36, 0x2d, 0x98, 0xc6, 0x16, 0x64, 0xa0, 0xfa, 0xc8, 0x2d, 0xd6, 0x0a,
+	0xcc, 0xf0, 0xfc, 0xfa, 0x59, 0x89, 0xd1, 0xd3, 0x8a, 0x43, 0xbc, 0x12, 0x63, 0x54, 0x48, 0xb0,
+	0x12, 0x0b, 0x9c, 0xef, 0xf4, 0xb9, 0x12, 0xa3, 0xad, 0x31, 0xab, 0x90, 0x94, 0x67, 0x4f, 0xe9,
+	0x57, 0x62, 0x3d, 0x19, 0x12, 0xcc, 0xd8, 0xec, 0x89, 0x59, 0x9f, 0x2b, 0xb1, 0x83, 0xc5, 0x20,
+	0x28, 0xc3, 0x30, 0x31, 0x20, 0x2b, 0xb1, 0x20, 0x00, 0x03, 0x5a, 0x89, 0x1d, 0x8c, 0x4e, 0xfe,
+	0x4a, 0x6c, 0xc0, 0x5a, 0xc5, 0x58, 0x89, 0x85, 0x14, 0x4e, 0xb5, 0x12, 0xc3, 0x07, 0x9f, 0x87,
+	0x78, 0x25, 0xe6, 0x2b, 0x90, 0x60, 0x25, 0xc6, 0x1d, 0x11, 0xf7, 0xb7, 0x12, 0xc3, 0x4d, 0x31,
+	0x4b, 0x92, 0xc4, 0x67, 0xd6, 0xe9, 0x57, 0x62, 0x94, 0x77, 0x82, 0x49, 0xbc, 0x77, 0xbe, 0xde,
+	0xdf, 0x4a, 0xec, 0x60, 0xd4, 0xe6, 0x79, 0x0f, 0x4f, 0x6d, 0xb2, 0x12, 0xe3, 0x95, 0x1e, 0xd0,
+	0x4a, 0x6c, 0xf8, 0xda, 0xf8, 0x2b, 0xb1, 0x81, 0xea, 0x13, 0x63, 0x25, 0x16, 0x50, 0x35, 0x99,
+	0xbd, 0xfa, 0x37, 0x0d, 0xa6, 0x6f, 0xd4, 0x1b, 0xae, 0xed, 0xf0, 0x62, 0xbf, 0x28, 0x3c, 0x80,
+	0x1e, 0x0b, 0x1e, 0x2e, 0x27, 0x56, 0xc7, 0xb7, 0x6c, 0x39, 0xb1, 0x65, 0x1b, 0x89, 0xb0, 0x6c,
+	0xf9, 0x78, 0x96, 0x6d, 0x54, 0x64, 0xd9, 0xbe, 0x0c, 0x33, 0xbc, 0xaa, 0x43, 0xb5, 0x6d, 0x74,
+	0x66, 0xc0, 0xfe, 0x27, 0x87, 0x78, 0x66, 0xa0, 0x0a, 0x24, 0x41, 0x8f, 0xf5, 0xd8, 0xe9, 0x73,
+	0x66, 0xf0, 0x9a, 0x62, 0x4d, 0x64, 0x52, 0x17, 0xa2, 0x3e, 0x66, 0x06, 0xc2, 0x3b, 0x89, 0x51,
+	0xf1, 0xdd, 0x9d, 0xfa, 0x9c, 0x19, 0x0e, 0x44, 0x6d, 0x9e, 0xf7, 0xf0, 0xd4, 0xa6, 0x33, 0x03,
+	0xa7, 0xf4, 0xa0, 0x66, 0x86, 0xa1, 0x6b, 0xd3, 0x9b, 0x19, 0x06, 0xa9, 0x4f, 0x9c, 0x99, 0x81,
+	0x57, 0x35, 0xd9, 0xcc, 0xf0, 0xf7, 0x1a, 0x9c, 0x2a, 0x37, 0x1a, 0x41, 0x77, 0x9b, 0xfa, 0xe1,
+	0xb4, 0x5d, 0x3f, 0xd0, 0xe0, 0xb4, 0x4c, 0x1b, 0x02, 0x4f, 0x99, 0x1b, 0x09, 0x97, 0x62, 0x3a,
+	0x1f, 0xf5, 0x61, 0xd3, 0x7e, 0xa8, 0xc1, 0x69, 0x6c, 0x58, 0x42, 0xcd, 0x52, 0x88, 0x07, 0xe0,
+	0x8c, 0x98, 0xf8, 0x39, 0xf9, 0xbe, 0x06, 0x67, 0xa4, 0x62, 0x11, 0xac, 0x3e, 0xce, 0x61, 0x75,
+	0x21, 0x9e, 0x4c, 0x7d, 0x01, 0x85, 0x4d, 0xd1, 0x53, 0x07, 0x94, 0x54, 0xac, 0x03, 0x01, 0xea,
+	0xf3, 0xf0, 0x2c, 0x32, 0x71, 0x52, 0x94, 0xfa, 0x35, 0xa1, 0xdf, 0xd3, 0xe0, 0x94, 0x84, 0xc1,
+	0x81, 0xe8, 0xbb, 0x07, 0xa7, 0xb1, 0xa5, 0xdb, 0x2f, 0x8d, 0xe5, 0x46, 0xf6, 0x0e, 0x9c, 0x91,
+	0xb2, 0x4e, 0x65, 0x6f, 0xff, 0x45, 0x83, 0xd3, 0x78, 0x79, 0x2a, 0x55, 0x66, 0x8e, 0xf7, 0xba,
+	0x1e, 0xf3, 0x1d, 0xab, 0x0f, 0xd5, 0x32, 0xfc, 0x03, 0x0d, 0xce, 0x48, 0x15, 0x3d, 0x28, 0x5b,
+	0xfc, 0x33, 0x0d, 0x66, 0xcb, 0x8d, 0x46, 0xcf, 0x83, 0xf9, 0x50, 0xce, 0x72, 0x5f, 0xd7, 0xe0,
+	0x58, 0x50, 0x0b, 0x82, 0xe8, 0x9b, 0x1c, 0xa2, 0x2f, 0x46, 0xf8, 0x6f, 0xf7, 0x03, 0xe5, 0x77,
+	0x35, 0x38, 0x8e, 0xe7, 0x8f, 0x5e, 0x7b, 0x14, 0xcc, 0x7e, 0x5d, 0xd4, 0x13, 0x5b, 0xac, 0xaf,
+	0x69, 0x30, 0x17, 0x16, 0x86, 0x60, 0xf2, 0x1a, 0x87, 0xc9, 0x73, 0x11, 0x72, 0xf4, 0x05, 0x08,
+	0x9e, 0x27, 0x9e, 0x12, 0x40, 0xc2, 0xc2, 0x0c, 0x17, 0x90, 0xfb, 0x30, 0x8b, 0x66, 0x91, 0x30,
+	0x1a, 0xfd, 0xce, 0x4f, 0x5f, 0x81, 0x63, 0xc1, 0x86, 0x87, 0xab, 0x99, 0x03, 0xc7, 0xf1, 0xac,
+	0x30, 0x70, 0xdd, 0xe4, 0x33, 0xd1, 0x4d, 0x98, 0x0b, 0xf3, 0x4c, 0x35, 0x05, 0xfd, 0xb5, 0x06,
+	0xc7, 0xcb, 0x8d, 0x06, 0xfb, 0x3d, 0xc4, 0xa1, 0x34, 0x83, 0xdf, 0xd2, 0x60, 0x2e, 0xac, 0x07,
+	0x81, 0xe4, 0xa3, 0xdc, 0x48, 0x38, 0x1f, 0xf9, 0x45, 0x48, 0x3f, 0xa6, 0xf0, 0x03, 0x0d, 0x4e,
+	0x60, 0xeb, 0xc3, 0xb6, 0x48, 0x21, 0x1d, 0xcc, 0x27, 0x30, 0x89, 0x1f, 0x92, 0xff, 0xaf, 0x41,
+	0x49, 0x24, 0x14, 0xc1, 0xe7, 0x0d, 0x0e, 0x9f, 0x17, 0x22, 0xa5, 0xe9, 0x0b, 0x1c, 0x6c, 0x89,
+	0x9e, 0x32, 0x70, 0x44, 0x42, 0x0d, 0x1b, 0x9c, 0xb7, 0xe1, 0x38, 0xb2, 0x64, 0x22, 0x64, 0xfa,
+	0x35, 0x92, 0xdf, 0xd0, 0x60, 0x2e, 0xdc, 0xf6, 0xb0, 0x15, 0x74, 0xe1, 0x04, 0xb6, 0x5a, 0xfb,
+	0xa0, 0xa2, 0xdc, 0x56, 0x7e, 0x12, 0x4a, 0x22, 0xae, 0xa9, 0xac, 0xe5, 0xdf, 0x60, 0x2b, 0xc3,
+	0x7d, 0x7c, 0x75, 0x28, 0xcd, 0xe5, 0x2f, 0x68, 0x70, 0x42, 0xa0, 0x08, 0x01, 0xe5, 0x63, 0xdc,
+	0x88, 0x78, 0x29, 0xfa, 0x03, 0xb4, 0x7e, 0x0c, 0xe6, 0x8f, 0x7d, 0xdb, 0xc4, 0x35, 0x49, 0x51,
+	0x1d, 0xd8, 0x87, 0x77, 0x89, 0x1f, 0x9a, 0x6f, 0x6b, 0x70, 0x52, 0x28, 0x58, 0x82, 0xe5, 0x75,
+	0xe8, 0x43, 0xc2, 0xd4, 0x10, 0x61, 0x0b, 0xf5, 0x14, 0x42, 0x24, 0x14, 0x6c, 0xe8, 0x10, 0x3d,
+	0xc1, 0x06, 0x4e, 0x88, 0x4f, 0xbf, 0xa6, 0xe5, 0x24, 0x8c, 0xb5, 0xac, 0xa6, 0xbd, 0xd5, 0x6d,
+	0xec, 0x6e, 0x13, 0xe3, 0x52, 0x40, 0x05, 0x1b, 0x8d, 0xdd, 0x6d, 0x34, 0x7b, 0x9c, 0x10, 0x70,
+	0x1e, 0x3a, 0x00, 0xbb, 0xd4, 0xcc, 0xed, 0x0b, 0x04, 0x52, 0xeb, 0xfa, 0x29, 0x38, 0x29, 0x64,
+	0x9b, 0xca, 0xbc, 0xfe, 0xb3, 0x06, 0x25, 0xbc, 0x4d, 0x20, 0x54, 0xe2, 0xc3, 0xb1, 0x17, 0xf2,
+	0x5d, 0x0d, 0x4e, 0x0a, 0x95, 0x3c, 0x08, 0xe3, 0xfb, 0x73, 0x0d, 0xce, 0x08, 0xf6, 0xc8, 0xbd,
+	0x4f, 0x69, 0x0f, 0xe3, 0xbc, 0xf6, 0x63, 0x0d, 0xe6, 0xe5, 0xfa, 0x10, 0x84, 0x57, 0x38, 0x84,
+	0xaf, 0xc4, 0xfe, 0xe4, 0xb8, 0x1f, 0xa0, 0xff, 0x50, 0x83, 0xb3, 0x92, 0x1d, 0x76, 0xf4, 0x31,
+	0x33, 0x81, 0x7a, 0x7f, 0xbf, 0xcd, 0x4e, 0x6c, 0xde, 0x7f, 0xa4, 0x81, 0xa1, 0x12, 0xba, 0xaf,
+	0x9d, 0xbb, 0x7a, 0xb3, 0x2f, 0x30, 0x25, 0xbb, 0xf0, 0x4f, 0x37, 0x98, 0x2a, 0xa1, 0x0f, 0x0a,
+	0x4c, 0x0b, 0xce, 0x08, 0xf7, 0xf7, 0x19, 0x24, 0xfb, 0x7d, 0xfd, 0xf8, 0x55, 0x0d, 0xe6, 0xe5,
+	0x3c, 0x0e, 0x4a, 0xf1, 0x2f, 0xc3, 0x59, 0xc9, 0x76, 0xfe, 0xe0, 0x54, 0x97, 0x4f, 0x9c, 0x26,
+	0x18, 0x2a, 0xee, 0xa9, 0xe6, 0xcf, 0x7f, 0xd0, 0xe0, 0x6c, 0xb9, 0xd1, 0x58, 0x16, 0x07, 0x05,
+	0x38, 0x8c, 0xf6, 0xfc, 0xd7, 0x35, 0x30, 0x54, 0x1a, 0x11, 0x98, 0x2a, 0xdc, 0xd8, 0x79, 0x25,
+	0x7e, 0x04, 0x85, 0x7e, 0x4c, 0xfa, 0x1f, 0xfb, 0xd6, 0x51, 0xd8, 0x34, 0x85, 0x7b, 0xdf, 0x63,
+	0x4e, 0x24, 0x7e, 0x1c, 0x7f, 0x4d, 0x83, 0xe7, 0x94, 0x82, 0x13, 0x54, 0xaf, 0x73, 0xa8, 0x2e,
+	0xc4, 0x17, 0xb4, 0x2f, 0x48, 0xb1, 0x8d, 0x3c, 0x84, 0x90, 0x2a, 0x05, 0x3f, 0x30, 0x48, 0x1f,
+	0x60, 0xd3, 0xab, 0xc4, 0xb3, 0x5f, 0xfb, 0xfe, 0x23, 0x0d, 0xce, 0x2a, 0x98, 0x1c, 0x98, 0xee,
+	0xef, 0x51, 0x1b, 0xbb, 0x9f, 0xda, 0xcb, 0x4d, 0xfc, 0x06, 0x3c, 0xa7, 0x64, 0x9f, 0xca, 0xc6,
+	0xff, 0xad, 0x06, 0x27, 0x7b, 0x16, 0xf1, 0x70, 0x5b, 0xf7, 0xef, 0x69, 0xf0, 0xac, 0x58, 0x17,
+	0x02, 0xcd, 0x5b, 0xdc, 0x90, 0xb9, 0x18, 0x27, 0x70, 0x4c, 0x3f, 0x16, 0xfd, 0x37, 0x51, 0xf0,
+	0x1e, 0xd6, 0x30, 0x06, 0x87, 0xca, 0x80, 0xe3, 0xe7, 0x24, 0x7e, 0xd0, 0x7e, 0x51, 0x83, 0x53,
+	0x12, 0x01, 0x13, 0x1c, 0x75, 0x08, 0x22, 0x03, 0xa5, 0x86, 0x8b, 0x33, 0x7a, 0x4f, 0x23, 0x5c,
+	0x12, 0x01, 0x0f, 0x00, 0xae, 0xcf, 0x42, 0x89, 0x31, 0x92, 0x83, 0xb6, 0xc1, 0xdf, 0xd1, 0xe0,
+	0xa4, 0xb0, 0xf9, 0x03, 0xd0, 0xf4, 0x09, 0x3c, 0xcb, 0x19, 0xbe, 0xa1, 0x59, 0xdc, 0xdb, 0x70,
+	0x4a, 0xc2, 0x38, 0x95, 0xad, 0xfd, 0x2b, 0xec, 0x5b, 0xc1, 0x06, 0xd0, 0x39, 0x8c, 0x66, 0xf6,
+	0x9b, 0xf8, 0x8c, 0x97, 0x57, 0x83, 0x00, 0xf2, 0x11, 0x6e, 0x58, 0x9c, 0x8b, 0x0a, 0x6f, 0xd5,
+	0x8f, 0x75, 0xfd, 0x65, 0xdf, 0x2f, 0x83, 0x69, 0xb0, 0xe7, 0xcc, 0xd7, 0x77, 0x20, 0xaf, 0x34,
+	0xa7, 0x62, 0x27, 0x04, 0x02, 0x25, 0xf8, 0xa8, 0x33, 0x10, 0x2e, 0x2a, 0x35, 0x2c, 0x9c, 0x91,
+	0x7a, 0x1a, 0x60, 0x11, 0x08, 0x34, 0x64, 0x58, 0x3e, 0x8d, 0xfd, 0x3a, 0x04, 0x98, 0xf4, 0x6b,
+	0x29, 0xbf, 0xa6, 0xc1, 0xf1, 0x50, 0xd3, 0x43, 0xd6, 0xae, 0x4b, 0x1d, 0x38, 0x06, 0xaf, 0x9f,
+	0xdc, 0x3a, 0xae, 0xc2, 0x09, 0x01, 0xd3, 0x54, 0x96, 0xf1, 0xef, 0xb8, 0x95, 0x1b, 0x13, 0x0e,
+	0xee, 0x30, 0xda, 0xc7, 0x5f, 0xc1, 0x6e, 0xef, 0x22, 0x65, 0x12, 0xec, 0x4d, 0xc9, 0x02, 0xef,
+	0xa5, 0x1a, 0x1f, 0xbf, 0xeb, 0xfb, 0x89, 0x87, 0x9a, 0xa5, 0x08, 0xef, 0x4b, 0x04, 0xc1, 0x3e,
+	0x3c, 0xc7, 0x05, 0x82, 0x26, 0x70, 0x10, 0x16, 0x46, 0x49, 0x4c, 0x0d, 0x1d, 0x67, 0xbe, 0x9e,
+	0x66, 0xe8, 0xa4, 0x82, 0x1e, 0x08, 0x74, 0xc4, 0x97, 0x5c, 0x8a, 0xdb, 0xa0, 0x7c, 0xc9, 0x9f,
+	0x16, 0x7d, 0x7d, 0x5f, 0xf2, 0xfd, 0xd2, 0x38, 0x86, 0x2f, 0xb9, 0x1c, 0x8b, 0x54, 0xae, 0x29,
+	0x5c, 0xa0, 0xcb, 0xc3, 0xec, 0x9a, 0x12, 0x54, 0x24, 0xc1, 0xe9, 0xa8, 0x28, 0x5e, 0x68, 0x9f,
+	0xae, 0x29, 0x5c, 0x93, 0x8c, 0xdf, 0xc5, 0x60, 0x82, 0x9c, 0xf6, 0xe1, 0x9a, 0x12, 0x10, 0x2c,
+	0x81, 0xdb, 0x41, 0x28, 0x68, 0x6b, 0x9f, 0xae, 0x29, 0x4f, 0x21, 0x44, 0x42, 0xc1, 0x86, 0x0e,
+	0xd1, 0x67, 0xb0, 0x6b, 0x8a, 0x10, 0x9f, 0x7e, 0x2d, 0x2a, 0xf5, 0x3e, 0x39, 0x68, 0x1d, 0x7d,
+	0xef, 0x93, 0xfd, 0xd0, 0x32, 0x86, 0xf7, 0x89, 0x58, 0xff, 0x64, 0x16, 0xf4, 0xeb, 0x19, 0xea,
+	0x7d, 0x22, 0x54, 0x62, 0x7f, 0x6d, 0xe8, 0x31, 0xff, 0xf3, 0xde, 0x11, 0xcf, 0xb5, 0x85, 0x5c,
+	0xe9, 0xf3, 0x30, 0x4e, 0xc2, 0xf8, 0x7a, 0x8b, 0x94, 0xfc, 0x7c, 0xf6, 0x7c, 0xd6, 0x64, 0x8b,
+	0x04, 0xd6, 0x77, 0x34, 0x9e, 0xf5, 0x2d, 0xa8, 0xbd, 0x53, 0xc4, 0x90, 0x0e, 0xd5, 0xfe, 0x92,
+	0xef, 0x51, 0xc3, 0xb1, 0x8d, 0x0f, 0xe5, 0xc4, 0xf6, 0x01, 0xfe, 0x1e, 0x55, 0xa8, 0x4d, 0x82,
+	0x23, 0x12, 0x69, 0xd8, 0xe8, 0x54, 0x10, 0xff, 0xc4, 0x5f, 0xbf, 0x87, 0xdb, 0xa5, 0x20, 0xef,
+	0x57, 0x10, 0xec, 0xc4, 0xd6, 0xee, 0x07, 0x1a, 0xcc, 0xcb, 0x85, 0x4d, 0x70, 0x66, 0x20, 0x8e,
+	0xf6, 0x9d, 0x1a, 0x40, 0xea, 0x02, 0x79, 0x28, 0x00, 0x94, 0x0b, 0x7b, 0x30, 0x00, 0x6e, 0xe1,
+	0x77, 0x02, 0x39, 0x7a, 0xfd, 0xce, 0x91, 0xdf, 0xd7, 0xe0, 0xb4, 0x8c, 0xc3, 0xc1, 0xa8, 0xfc,
+	0x2e, 0x5d, 0xfc, 0xef, 0x9b, 0xd2, 0xf2, 0x29, 0x73, 0x1d, 0xe6, 0xe5, 0xbc, 0x53, 0xcd, 0x9b,
+	0xff, 0xe5, 0x7f, 0xdc, 0x29, 0x57, 0x67, 0xdf, 0xed, 0x34, 0x9e, 0x2e, 0xb7, 0xc8, 0x33, 0x42,
+	0x26, 0xd1, 0xc0, 0x12, 0x72, 0xb0, 0x7e, 0x9c, 0x3f, 0xd4, 0x60, 0x5e, 0xae, 0xf6, 0x81, 0x19,
+	0xf4, 0xeb, 0x34, 0x80, 0xbb, 0x17, 0x7a, 0x9f, 0xc0, 0x9f, 0x30, 0xd2, 0xff, 0x2e, 0xe8, 0x6c,
+	0x1b, 0x44, 0x97, 0x57, 0x38, 0x5d, 0x4e, 0x49, 0x1b, 0xe9, 0x47, 0x74, 0x6c, 0x9c, 0xfa, 0x13,
+	0x9d, 0x6d, 0x63, 0x58, 0xa2, 0xbf, 0x8e, 0x97, 0xe1, 0xa8, 0x8d, 0xeb, 0x7b, 0x6b, 0x56, 0xd3,
+	0x46, 0xbe, 0xe2, 0x54, 0x05, 0xce, 0x7f, 0x5c, 0x0b, 0xf8, 0x8f, 0xbf, 0x07, 0x25, 0x51, 0xcd,
+	0x61, 0x09, 0xfe, 0x05, 0x98, 0xbc, 0xdb, 0x69, 0xb4, 0x2d, 0xdf, 0xc9, 0xfa, 0x0c, 0x8c, 0xef,
+	0x7a, 0x05, 0x5b, 0x0f, 0xeb, 0x04, 0xf6, 0x31, 0x13, 0x70, 0xd1, 0x8d, 0x7a, 0x8a, 0x98, 0x32,
+	0x1f, 0x87, 0x29, 0xca, 0x21, 0x95, 0x79, 0xa9, 0xc0, 0xf8, 0x86, 0xed, 0x3e, 0xb0, 0xaa, 0x8f,
+	0x90, 0x92, 0xfa, 0xab, 0x30, 0xda, 0xc5, 0x97, 0xca, 0x20, 0xf7, 0xa4, 0x8a, 0x49, 0x89, 0x8d,
+	0x4f, 0xc2, 0x14, 0x29, 0x23, 0x4f, 0x97, 0xfe, 0x3a, 0x14, 0xc8, 0x4d, 0x9a, 0xc3, 0x42, 0xdd,
+	0x94, 0x4f, 0x6d, 0xfc, 0xb9, 0xe6, 0x85, 0x45, 0x22, 0x37, 0x0e, 0xe5, 0x62, 0xf4, 0xcb, 0x30,
+	0xcd, 0x69, 0x90, 0xe4, 0x9b, 0x59, 0x0e, 0xc6, 0x54, 0xa3, 0xee, 0x7d, 0x1a, 0x5e, 0x89, 0x62,
+	0x4b, 0x10, 0x4c, 0xd9, 0xb9, 0x29, 0x22, 0x5d, 0xce, 0x06, 0xf8, 0x13, 0xfd, 0x97, 0x38, 0xfd,
+	0xe7, 0x55, 0xdc, 0xfb, 0x51, 0x9e, 0x7c, 0x8d, 0x7d, 0x60, 0xca, 0x07, 0xf8, 0x0f, 0x51, 0xf9,
+	0x4d, 0x1c, 0x91, 0x29, 0xa0, 0x7a, 0xbf, 0x4b, 0xbc, 0x3d, 0x98, 0xe6, 0x5a, 0x1d, 0xa2, 0x42,
+	0x6d, 0x1a, 0x79, 0x69, 0xb0, 0x2a, 0xc9, 0x17, 0x70, 0x15, 0x98, 0x0d, 0x30, 0x4c, 0x65, 0x56,
+	0x5f, 0x87, 0xf9, 0xf2, 0xae, 0xdb, 0xae, 0xb6, 0x9b, 0x1d, 0xa6, 0xb1, 0x0d, 0xdb, 0x72, 0xaa,
+	0x3b, 0x54, 0x87, 0x19, 0x18, 0x71, 0xeb, 0xae, 0x3f, 0x0b, 0xe0, 0x0b, 0x63, 0x09, 0x7f, 0xca,
+	0x9f, 0x70, 0x9e, 0xfb, 0x93, 0x0c, 0x64, 0xcb, 0x9d, 0x4e, 0x08, 0x17, 0x1d, 0x72, 0x88, 0x86,
+	0x88, 0xe7, 0xfd, 0x47, 0x52, 0x77, 0x77, 0x9b, 0x4d, 0xcb, 0xd9, 0xa3, 0x68, 0x93, 0x4b, 0xb4,
+	0x1d, 0x52, 0xb3, 0xbb, 0x55, 0xa7, 0xde, 0xf1, 0x4c, 0x1b, 0x46, 0x86, 0x2d, 0x42, 0x42, 0xd4,
+	0xab, 0xed, 0x16, 0x16, 0x02, 0x5b, 0xc8, 0x02, 0x2a, 0x40, 0x42, 0xa0, 0x86, 0xab, 0xde, 0x73,
+	0x5f, 0xf3, 0xac, 0x62, 0xd6, 0xa4, 0x97, 0xe8, 0xce, 0xae, 0xf7, 0x50, 0xd4, 0xbc, 0xd5, 0x5e,
+	0xd6, 0xa4, 0x97, 0x08, 0x84, 0x7a, 0x13, 0x01, 0x88, 0xf7, 0x4b, 0xf0, 0x05, 0x12, 0xdb, 0xb5,
+	0xb6, 0xbb, 0x73, 0x63, 0xde, 0x42, 0xd3, 0xfb, 0xcf, 0x27, 0x36, 0x85, 0x64, 0x89, 0x4d, 0x79,
+	0xf0, 0xc6, 0x03, 0xe0, 0xfd, 0x81, 0x06, 0x05, 0x5a, 0x29, 0x16, 0x82, 0x5c, 0x6b, 0x59, 0xbe,
+	0x35, 0x1e, 0xa2, 0x5c, 0x00, 0xa2, 0x22, 0x64, 0x77, 0x9d, 0x06, 0x41, 0x0e, 0xfd, 0x4d, 0x03,
+	0x9a, 0xf1, 0xc3, 0x0c, 0x14, 0x68, 0x58, 0xdd, 0x0f, 0x4b, 0x97, 0x13, 0x64, 0xc6, 0x7a, 0xc8,
+	0xd0, 0x41, 0x00, 0xcc, 0x20, 0x50, 0xf6, 0xe3, 0x07, 0x19, 0xc8, 0xe3, 0x28, 0xea, 0xff, 0x0b,
+	0x8a, 0x0f, 0xca, 0xbf, 0x67, 0x21, 0x8f, 0x63, 0x6d, 0x1e, 0x2c, 0x28, 0x3d, 0x0b, 0x9d, 0x67,
+	0x2d, 0x34, 0x83, 0xd5, 0xa8, 0x14, 0xab, 0x02, 0x8f, 0x95, 0x0e, 0xb9, 0xdd, 0x56, 0xdd, 0xa5,
+	0xd6, 0x01, 0xfd, 0xf7, 0x53, 0x30, 0x43, 0x9c, 0x14, 0xcc, 0x7c, 0xfa, 0xdf, 0xf1, 0x64, 0xe9,
+	0x7f, 0xd9, 0x84, 0xc6, 0x13, 0xf1, 0x13, 0x1a, 0x0b, 0x32, 0x90, 0x4e, 0xa6, 0xcd, 0x40, 0xca,
+	0xf7, 0xf8, 0x54, 0xa0, 0xc7, 0x7f, 0x3f, 0x03, 0x79, 0xbc, 0x49, 0x7c, 0xb0, 0x3d, 0xce, 0x09,
+	0x99, 0x0f, 0x58, 0xc9, 0x34, 0xfd, 0x5e, 0xf2, 0x5e, 0x29, 0xdc, 0x7a, 0xcb, 0x9b, 0x19, 0xbc,
+	0xf6, 0xe8, 0xb5, 0x7e, 0x16, 0x26, 0x6a, 0xf5, 0x6e, 0xa7, 0x61, 0xed, 0x6d, 0x79, 0x1a, 0x02,
+	0x11, 0x16, 0x97, 0xa1, 0xb9, 0x14, 0x29, 0xdf, 0xb1, 0xdc, 0x1d, 0xf2, 0x84, 0x78, 0xff, 0x8d,
+	0x2a, 0xe4, 0x71, 0x90, 0x17, 0x14, 0xb6, 0xd2, 0x4d, 0x10, 0x31, 0xc8, 0x23, 0xd6, 0x9f, 0x87,
+	0xc9, 0xea, 0x6e, 0xd7, 0x6d, 0x37, 0x6f, 0x73, 0xcb, 0x00, 0xbe, 0x10, 0x7d, 0xa0, 0x05, 0xbd,
+	0xaa, 0xb1, 0x3a, 0x85, 0x3c, 0xf8, 0xd9, 0xde, 0x83, 0xaf, 0xfa, 0x4c, 0x9a, 0x45, 0x73, 0x44,
+	0x8a, 0x66, 0x9e, 0x9f, 0x44, 0xfe, 0x35, 0x03, 0x13, 0x6c, 0xd0, 0x86, 0x90, 0x5c, 0x27, 0x61,
+	0x8c, 0xec, 0xfb, 0xf8, 0xcb, 0xaa, 0x02, 0x2e, 0x58, 0xed, 0x09, 0x9d, 0x65, 0x84, 0x8e, 0x1e,
+	0x2f, 0x6f, 0x41, 0xde, 0xb5, 0x9c, 0x6d, 0xf2, 0x0d, 0xf0, 0x94, 0x64, 0x7b, 0x96, 0x95, 0x6a,
+	0xd3, 0x23, 0x37, 0x49, 0x35, 0xf5, 0x98, 0xe2, 0x46, 0xe3, 0xa8, 0xdc, 0x28, 0x17, 0xa4, 0x10,
+	0x8d, 0xf1, 0x03, 0x6e, 0x11, 0x66, 0x5a, 0x5c, 0x58, 0x8b, 0x66, 0xbd, 0x55, 0xb3, 0x1d, 0x6f,
+	0x70, 0x65, 0x4d, 0xe1, 0x3d, 0xdf, 0x38, 0x91, 0x51, 0x86, 0xfe, 0x1b, 0xbf, 0xad, 0xc1, 0x24,
+	0xf7, 0x40, 0xef, 0xf3, 0x2a, 0x23, 0xcd, 0x70, 0xf8, 0x3d, 0x0d, 0x66, 0x44, 0x1f, 0xcb, 0x3d,
+	0x85, 0xa2, 0xfe, 0x51, 0x16, 0x8e, 0x86, 0x44, 0xfd, 0x30, 0xcd, 0x6e, 0xa1, 0xb5, 0xef, 0xc7,
+	0x20, 0x67, 0xd5, 0xfd, 0x65, 0x6f, 0x82, 0x8f, 0x6b, 0xbd, 0x6a, 0x7a, 0x19, 0x26, 0xf1, 0xb7,
+	0xfc, 0x2b, 0xf6, 0x43, 0x6b, 0xb7, 0x81, 0x07, 0x67, 0x44, 0xf8, 0x7b, 0xbe, 0x46, 0xaf, 0x89,
+	0x3b, 0x1d, 0x9c, 0xff, 0x7b, 0x22, 0x3a, 0x4b, 0x3d, 0x5f, 0xc3, 0xf8, 0x4f, 0x0d, 0x66, 0x85,
+	0xdf, 0xea, 0x7c, 0x98, 0xbb, 0xce, 0xf8, 0xc7, 0xac, 0x9f, 0x57, 0x39, 0x91, 0xbe, 0xca, 0x47,
+	0x8a, 0x01, 0x23, 0xa7, 0x04, 0x63, 0x24, 0x02, 0x8c, 0xbc, 0x14, 0x8c, 0x51, 0x16, 0x8c, 0xeb,
+	0x90, 0xef, 0x78, 0x5d, 0x36, 0x57, 0x48, 0x9c, 0x45, 0x9b, 0xd4, 0x44, 0xcb, 0x26, 0xab, 0x8a,
+	0xc7, 0xce, 0x98, 0x62, 0xec, 0x94, 0x3d, 0x1a, 0x93, 0xd2, 0xb2, 0xfd, 0x00, 0xd2, 0x7e, 0x18,
+	0x17, 0xf7, 0xc3, 0x04, 0xf3, 0x08, 0x0d, 0x70, 0xf9, 0x65, 0xfc, 0x2c, 0x03, 0x79, 0x2c, 0x67,
+	0xac, 0xae, 0x0c, 0xf4, 0x49, 0x36, 0xa2, 0x4f, 0x82, 0x26, 0xf2, 0x0c, 0x8c, 0x63, 0x30, 0xd8,
+	0xf1, 0x0b, 0xb8, 0x68, 0x18, 0xc6, 0xe7, 0x0d, 0xc8, 0x37, 0x3d, 0xc5, 0xbd, 0x0e, 0x98, 0x92,
+	0x84, 0x0c, 0xc3, 0x78, 0x10, 0xc0, 0x48, 0x05, 0xf5, 0x9b, 0xc9, 0x3f, 0x69, 0x70, 0x34, 0xe4,
+	0x9f, 0x37, 0x20, 0x40, 0x19, 0xdd, 0x73, 0x52, 0xdd, 0x47, 0xc4, 0xba, 0xe7, 0x65, 0xaf, 0x56,
+	0xa3, 0xaa, 0x89, 0xad, 0xc0, 0xf7, 0x9a, 0xf1, 0x8d, 0x0c, 0x8c, 0xb3, 0x87, 0xc9, 0x83, 0xd1,
+	0xeb, 0x65, 0xdf, 0xb7, 0x16, 0x35, 0xba, 0xe1, 0x3a, 0xf5, 0x16, 0x1d, 0x30, 0xe1, 0x1b, 0x69,
+	0x26, 0x57, 0x1f, 0x85, 0x51, 0x19, 0x0a, 0x05, 0x15, 0x0a, 0x63, 0x01, 0x14, 0x7e, 0x92, 0x83,
+	0x49, 0xce, 0x61, 0xe5, 0xf0, 0xbd, 0x99, 0x2f, 0x91, 0x17, 0x83, 0x82, 0x37, 0xf8, 0xe7, 0x55,
+	0x87, 0x8c, 0xcc, 0x9b, 0x41, 0x2f, 0xa1, 0xc0, 0x58, 0xfc, 0x84, 0x02, 0x6f, 0x40, 0xde, 0x7e,
+	0x6c, 0xb7, 0x5c, 0x3a, 0xd1, 0x9f, 0x55, 0x31, 0xab, 0x20, 0x4a, 0x93, 0x54, 0x40, 0xef, 0x46,
+	0xb5, 0x5d, 0x07, 0x47, 0xf6, 0x1b, 0xf7, 0xba, 0xce, 0xbf, 0x46, 0x7b, 0x0b, 0x35, 0xbb, 0x61,
+	0xed, 0xcd, 0x4d, 0x60, 0xf3, 0xe0, 0x5d, 0xe8, 0x9f, 0x0a, 0xa4, 0xe0, 0x9f, 0x4c, 0x96, 0xff,
+	0x9c, 0xab, 0xac, 0x2f, 0x13, 0x1b, 0x45, 0x42, 0x64, 0x4d, 0xc5, 0x35, 0x14, 0x6c, 0x2d, 0x63,
+	0x1e, 0x0a, 0x1b, 0xd5, 0x1d, 0xdb, 0x83, 0x62, 0x06, 0x46, 0x4c, 0x73, 0xb7, 0xb7, 0x39, 0xea,
+	0x5d, 0xa0, 0xcf, 0xf0, 0xf4, 0xb0, 0x2c, 0x03, 0x99, 0x4f, 0x93, 0x9a, 0x0b, 0xe3, 0x37, 0x34,
+	0x98, 0x60, 0xbb, 0xa2, 0x7f, 0x29, 0x7a, 0x76, 0x3c, 0x27, 0xb1, 0xe3, 0xb1, 0x97, 0xc8, 0x3f,
+	0xcf, 0xc0, 0x68, 0xb9, 0x56, 0x73, 0xec, 0x6e, 0x57, 0xd7, 0xc9, 0x00, 0xc6, 0x92, 0x79, 0xff,
+	0xf5, 0xf3, 0x70, 0xc4, 0xc2, 0xb7, 0x6f, 0xb5, 0xab, 0x56, 0xa3, 0xee, 0xee, 0x11, 0x31, 0x83,
+	0xc5, 0xe8, 0x15, 0x97, 0x14, 0x99, 0xf6, 0x76, 0xcf, 0x2a, 0xf1, 0x85, 0xfa, 0x69, 0x80, 0x4e,
+	0xbb, 0xeb, 0x5a, 0x8d, 0xe5, 0x76, 0xcd, 0x26, 0xe2, 0x33, 0x25, 0xa8, 0x95, 0xae, 0xeb, 0xd8,
+	0xb6, 0x4b, 0x84, 0xf2, 0x4f, 0xd7, 0xd8, 0x42, 0x34, 0x88, 0x49, 0xb3, 0xaf, 0xd0, 0x95, 0x09,
+	0xbd, 0x66, 0xee, 0x2d, 0x52, 0x43, 0x4c, 0xaf, 0x91, 0x86, 0x55, 0xa4, 0x02, 0x36, 0x4d, 0xde,
+	0x7f, 0x74, 0xd0, 0x57, 0x6d, 0xef, 0xb6, 0xdc, 0x3d, 0x62, 0x93, 0xc8, 0x95, 0x87, 0x26, 0xfa,
+	0xe7, 0xec, 0x91, 0x3d, 0x02, 0x7a, 0x89, 0x38, 0x20, 0x89, 0xbd, 0x5d, 0x7e, 0x32, 0x57, 0xd1,
+	0x6b, 0xe3, 0x07, 0x19, 0xc8, 0xa1, 0x93, 0xe0, 0xb8, 0x07, 0x0f, 0x4c, 0x9f, 0x65, 0xa5, 0x7d,
+	0x96, 0xe3, 0x0d, 0x0d, 0x63, 0xf5, 0x46, 0x78, 0xab, 0x47, 0x47, 0x52, 0x5e, 0x36, 0x92, 0x82,
+	0x33, 0x53, 0xc0, 0x4c, 0x16, 0xc2, 0x66, 0xb2, 0x0c, 0xe3, 0x1d, 0xdb, 0x69, 0xd6, 0xbb, 0x5d,
+	0x7f, 0x45, 0x36, 0x25, 0xd9, 0xf5, 0x58, 0xf7, 0xe9, 0x4c, 0xb6, 0x8e, 0xb1, 0x01, 0x33, 0xec,
+	0x19, 0x87, 0x7f, 0x52, 0x12, 0x13, 0x22, 0xff, 0xf8, 0x23, 0xcb, 0x1e, 0x7f, 0xfc, 0x87, 0x06,
+	0x79, 0xfc, 0xce, 0xaf, 0x7f, 0x04, 0xb2, 0x56, 0x9a, 0x80, 0x42, 0xa8, 0x16, 0x93, 0xea, 0x2b,
+	0x13, 0x3f, 0xd5, 0x17, 0x72, 0x07, 0xf5, 0x78, 0xdf, 0xb3, 0x1a, 0xbb, 0x36, 0xe9, 0x1f, 0xb6,
+	0xc8, 0x7f, 0xbb, 0x1f, 0xe9, 0xbd, 0xdd, 0xa3, 0x88, 0xd3, 0x8e, 0x5d, 0xdd, 0x75, 0x1c, 0xbb,
+	0x55, 0xc5, 0x3e, 0xa4, 0xb2, 0xfd, 0x23, 0xd3, 0x27, 0x33, 0x99, 0x2a, 0x86, 0x01, 0xd0, 0xbb,
+	0x23, 0xb1, 0x7c, 0xbf, 0x95, 0x81, 0xc2, 0xa6, 0xf5, 0xc5, 0x76, 0xab, 0xdd, 0x8c, 0xf7, 0xfe,
+	0x70, 0x0a, 0xa0, 0xbb, 0x83, 0x8e, 0xaa, 0x99, 0x6d, 0x9a, 0x31, 0xaf, 0x64, 0x2d, 0xde, 0x5e,
+	0x4d, 0xaf, 0xdb, 0x46, 0x24, 0x23, 0x3b, 0xf6, 0x14, 0x4a, 0xd7, 0x14, 0x05, 0x66, 0x4d, 0x71,
+	0x0c, 0xf2, 0x4f, 0xec, 0xfa, 0xf6, 0x8e, 0x4b, 0x36, 0x5d, 0xc8, 0x95, 0xf7, 0x14, 0x3a, 0xf5,
+	0xb6, 0x53, 0x77, 0xf1, 0x03, 0x9a, 0x35, 0xfd, 0x6b, 0xf5, 0x72, 0xf2, 0xa7, 0x59, 0x98, 0x0e,
+	0xbc, 0x68, 0xad, 0xba, 0xf6, 0x10, 0xf6, 0x2f, 0x24, 0x0f, 0x6d, 0x00, 0xe1, 0xbc, 0x0a, 0xe1,
+	0x51, 0x09, 0xc2, 0xb1, 0x77, 0xaa, 0x44, 0xc7, 0x02, 0x6f, 0x40, 0xc1, 0x25, 0x23, 0x86, 0xbc,
+	0xf0, 0x8b, 0xf7, 0xb8, 0xe9, 0xb0, 0x32, 0x7d, 0x72, 0xa6, 0x73, 0x26, 0xa4, 0x9d, 0x33, 0x19,
+	0xe8, 0x9c, 0xff, 0xc3, 0x24, 0x0c, 0x99, 0x4a, 0x10, 0x87, 0xc0, 0xaf, 0x85, 0x02, 0x2c, 0x8d,
+	0x92, 0x93, 0xd2, 0xb8, 0x46, 0x44, 0xb4, 0x0d, 0x99, 0x66, 0xed, 0x1f, 0xdd, 0x59, 0x57, 0x21,
+	0x6f, 0xf5, 0xfc, 0xd5, 0x22, 0xde, 0x5e, 0x09, 0xa9, 0xf1, 0x3b, 0x59, 0x4f, 0x23, 0xb4, 0x45,
+	0x8d, 0xcc, 0xc4, 0xe3, 0x7a, 0xb7, 0xfe, 0xa0, 0xee, 0xcd, 0xb6, 0x9a, 0xb7, 0x42, 0x12, 0x9b,
+	0x89, 0x7b, 0x3e, 0x99, 0xc9, 0x54, 0xd1, 0x3f, 0x01, 0x93, 0xec, 0x8e, 0x63, 0x77, 0x2e, 0xa3,
+	0x58, 0x24, 0x72, 0xe1, 0x78, 0xf9, 0x7a, 0x48, 0x15, 0x1c, 0xef, 0x7e, 0x2e, 0xab, 0x78, 0x11,
+	0xc7, 0x3b, 0xd6, 0x26, 0x21, 0xd5, 0x17, 0x40, 0x6f, 0xd4, 0x5b, 0x8f, 0x36, 0x76, 0x2c, 0xf4,
+	0x6a, 0x51, 0x69, 0xa1, 0xb3, 0x10, 0x0c, 0x70, 0xc1, 0x14, 0xdc, 0xd1, 0x2f, 0x40, 0xd1, 0x6e,
+	0x3e, 0xb0, 0x6b, 0x35, 0x86, 0x7a, 0xc4, 0xa3, 0x0e, 0x95, 0x7b, 0xab, 0x83, 0x1d, 0xcb, 0xb1,
+	0xd1, 0xd5, 0xad, 0x7a, 0xeb, 0x91, 0xef, 0x54, 0xc3, 0x16, 0xea, 0xaf, 0xc2, 0x31, 0x6b, 0xd7,
+	0xdd, 0xb1, 0x5b, 0x2e, 0x13, 0xdc, 0xb8, 0xee, 0x10, 0x73, 0x53, 0x30, 0x25, 0x77, 0x51, 0xdf,
+	0x76, 0x77, 0xda, 0x4f, 0x96, 0xad, 0x8e, 0x5b, 0xdd, 0xb1, 0xbc, 0x67, 0xaa, 0x60, 0xb2, 0x45,
+	0xc6, 0x63, 0x18, 0xb9, 0x6e, 0xb9, 0xd5, 0x9d, 0x58, 0xb6, 0xa2, 0x37, 0x12, 0xb3, 0x92, 0xa7,
+	0x36, 0xf6, 0x12, 0xf2, 0x1c, 0x4c, 0x20, 0xd4, 0xec, 0xda, 0x5d, 0xcf, 0xf9, 0x80, 0xf5, 0x4a,
+	0xd0, 0x38, 0xaf, 0x84, 0xcf, 0xc1, 0xe4, 0x3d, 0xdb, 0x41, 0xd3, 0xee, 0xf2, 0x8e, 0xd5, 0xda,
+	0xee, 0x9d, 0x65, 0x68, 0xbd, 0xb3, 0x0c, 0x64, 0xa7, 0xda, 0x8d, 0xda, 0xd6, 0x63, 0x6f, 0xee,
+	0x22, 0xfb, 0xf5, 0xed, 0x46, 0x0d, 0x4f, 0x5c, 0xc8, 0xc2, 0xd9, 0x4f, 0xc8, 0x4d, 0x6a, 0xe1,
+	0xec, 0x27, 0xde, 0x4d, 0xe3, 0xab, 0x19, 0x18, 0x25, 0xed, 0x87, 0x20, 0x38, 0x0d, 0x50, 0x6d,
+	0x37, 0x1a, 0xc4, 0xdb, 0x09, 0x37, 0xcb, 0x94, 0xa0, 0xdd, 0x89, 0x5a, 0xbb, 0xba, 0xdb, 0xb4,
+	0x5b, 0x6e, 0x0f, 0x13, 0xa0, 0x45, 0x18, 0x98, 0xc7, 0xb8, 0x6d, 0x0a, 0x0c, 0xb9, 0x94, 0xcd,
+	0x30, 0x27, 0x61, 0x0c, 0xf5, 0x64, 0xdb, 0xe9, 0xed, 0x68, 0x14, 0x70, 0x81, 0x72, 0x53, 0xe3,
+	0xa3, 0x30, 0x5a, 0xf5, 0xc0, 0xe9, 0xce, 0x15, 0x14, 0x3b, 0x3b, 0x1c, 0x8e, 0x26, 0xad, 0x82,
+	0x1c, 0xd8, 0xc8, 0x1d, 0xc6, 0x81, 0x8d, 0x08, 0xaa, 0x76, 0x60, 0x23, 0xd5, 0x4c, 0x9f, 0xda,
+	0xf8, 0x33, 0x0d, 0xc6, 0x36, 0x1d, 0xab, 0xbb, 0x23, 0x9c, 0x7f, 0xa2, 0x00, 0x15, 0x99, 0xb4,
+	0xc4, 0x6f, 0x06, 0xa7, 0x00, 0x6a, 0x9e, 0x1b, 0x4b, 0x6d, 0xcb, 0x72, 0xc9, 0x44, 0x3d, 0x46,
+	0x4a, 0xca, 0x2e, 0x7b, 0xfb, 0xc1, 0x1e, 0x99, 0x7d, 0xe8, 0xed, 0xeb, 0x7b, 0xc6, 0x5b, 0x44,
+	0x7e, 0x0f, 0x87, 0x45, 0xc8, 0xd5, 0x5d, 0x9b, 0xae, 0xc3, 0x4e, 0xcb, 0xb6, 0xcb, 0xb0, 0xb6,
+	0xa6, 0x47, 0x6b, 0xac, 0xa0, 0x57, 0x23, 0xab, 0xbb, 0x43, 0xb1, 0x5c, 0x82, 0x11, 0x54, 0x4e,
+	0x81, 0x8c, 0x6a, 0x04, 0x13, 0xa3, 0xd8, 0x0d, 0xb8, 0x99, 0xb4, 0x2e, 0x80, 0xf3, 0x30, 0xde,
+	0x03, 0xb6, 0xeb, 0x59, 0xbb, 0x31, 0x93, 0x2d, 0x92, 0xba, 0x03, 0xfa, 0xce, 0x83, 0x23, 0x8c,
+	0xf3, 0xa0, 0xe1, 0xc2, 0x24, 0x91, 0x27, 0x41, 0xee, 0x66, 0x16, 0x88, 0x94, 0xb9, 0x9b, 0xa7,
+	0x4c, 0xbb, 0xeb, 0xb6, 0x1d, 0xa9, 0xfb, 0x7a, 0xd4, 0x90, 0x92, 0x98, 0x2c, 0x06, 0xb7, 0x1c,
+	0xe7, 0xf0, 0xd5, 0x85, 0x23, 0x3e, 0x47, 0xa2, 0xe9, 0x22, 0xa7, 0xa9, 0xa2, 0x03, 0x53, 0xab,
+	0xb9, 0x0c, 0x47, 0x70, 0x9e, 0xd9, 0x96, 0x1f, 0xc9, 0x86, 0xd7, 0x4b, 0x0b, 0xe9, 0x55, 0x84,
+	0x6c, 0xbd, 0x86, 0xe7, 0xc1, 0x31, 0x13, 0xfd, 0x35, 0xfe, 0x32, 0x03, 0x13, 0xb4, 0x15, 0x6f,
+	0xe4, 0x5d, 0xe3, 0xa3, 0x1a, 0x47, 0xbc, 0x08, 0x50, 0x5a, 0xfd, 0x53, 0x70, 0x84, 0xec, 0xf9,
+	0x6e, 0x35, 0xc9, 0x76, 0x71, 0x26, 0xf5, 0x69, 0xfd, 0xdb, 0x4c, 0xa8, 0xd4, 0xad, 0xaa, 0x1f,
+	0x87, 0x8b, 0xcc, 0xbe, 0x71, 0xd3, 0xaa, 0x4d, 0x3f, 0x08, 0xa7, 0xda, 0xd3, 0x37, 0x40, 0x27,
+	0xfb, 0x30, 0x6c, 0xc3, 0xb9, 0xf9, 0x6c, 0xec, 0xe5, 0xd7, 0xd1, 0x6a, 0x30, 0x3e, 0x98, 0xf1,
+	0x04, 0x8a, 0xbd, 0x9e, 0x48, 0x30, 0xd2, 0x59, 0xe0, 0x53, 0x0d, 0x81, 0xb7, 0x60, 0x72, 0xc3,
+	0x6b, 0x0a, 0x5b, 0xe7, 0x5a, 0xe4, 0x00, 0xc0, 0x0f, 0x42, 0x86, 0x3e, 0x08, 0x17, 0x2c, 0xd0,
+	0xc3, 0x87, 0xc7, 0xfa, 0x49, 0x38, 0x1e, 0x2e, 0xdd, 0x5a, 0xbb, 0xb3, 0x56, 0x29, 0x3e, 0xa3,
+	0x4f, 0x40, 0xa1, 0xbc, 0xbe, 0xbe, 0x75, 0x77, 0xa3, 0x62, 0x16, 0x35, 0xfd, 0x08, 0x8c, 0xaf,
+	0xdd, 0xd9, 0xf2, 0x0b, 0x32, 0xa8, 0xe0, 0x46, 0x79, 0x79, 0xf5, 0xd6, 0xea, 0x66, 0x79, 0xf3,
+	0x8e, 0x59, 0xcc, 0x5e, 0x78, 0x1d, 0xc6, 0x99, 0xdd, 0x3e, 0x7d, 0x06, 0x8a, 0xcc, 0x25, 0x6d,
+	0x74, 0x0c, 0x46, 0x2a, 0xf7, 0x2a, 0x6b, 0x9b, 0x45, 0x4d, 0x2f, 0x40, 0x6e, 0x73, 0xf5, 0x76,
+	0xa5, 0x98, 0xb9, 0xb0, 0x06, 0xd0, 0x7b, 0x95, 0xd6, 0xa7, 0xe1, 0x48, 0xef, 0x8a, 0xd6, 0x2b,
+	0x40, 0xee, 0xde, 0x6a, 0xe5, 0x7e, 0x51, 0xd3, 0x01, 0xf2, 0xcb, 0x66, 0xa5, 0xbc, 0x59, 0x29,
+	0x66, 0xd0, 0xff, 0x95, 0xca, 0xad, 0xca, 0x66, 0xa5, 0x98, 0x45, 0x2d, 0xdf, 0xb9, 0xbf, 0x56,
+	0x31, 0x8b, 0xb9, 0x0b, 0xf7, 0x61, 0xdc, 0x5b, 0x3d, 0x20, 0xc8, 0x76, 0xbb, 0x48, 0x12, 0xe6,
+	0x92, 0xb6, 0x08, 0x90, 0xdf, 0xb8, 0x59, 0x36, 0x2b, 0x2b, 0x45, 0x0d, 0xa9, 0x6a, 0x56, 0x96,
+	0x2b, 0xab, 0xf7, 0x2a, 0x2b, 0xb8, 0x55, 0xc4, 0xab, 0xb2, 0x52, 0xcc, 0x7a, 0x20, 0x2c, 0x6f,
+	0xae, 0xde, 0x59, 0xab, 0xac, 0x14, 0x73, 0x17, 0xde, 0x04, 0xe8, 0x2d, 0x41, 0x91, 0xa0, 0xbd,
+	0x2b, 0xa6, 0xd9, 0xf5, 0xbb, 0xd7, 0x6f, 0xad, 0x2e, 0x17, 0x35, 0x7d, 0x1c, 0x46, 0xd7, 0xcd,
+	0xd5, 0x7b, 0x9e, 0xac, 0x17, 0xea, 0x30, 0xc1, 0x6e, 0xf0, 0xe9, 0xb3, 0x70, 0x94, 0xbd, 0xa6,
+	0xf5, 0x8b, 0x30, 0xb1, 0x76, 0x67, 0x73, 0xf5, 0xc6, 0xea, 0x72, 0x19, 0xb1, 0xc5, 0x38, 0x2d,
+	0xdf, 0x2c, 0x6f, 0x16, 0x33, 0xfa, 0x28, 0x64, 0x37, 0x6e, 0x6f, 0x60, 0x5d, 0x2b, 0xb7, 0xcb,
+	0xab, 0xb7, 0x8a, 0x39, 0xf4, 0x77, 0xfd, 0x26, 0xaa, 0x3a, 0x82, 0x6e, 0x97, 0x6f, 0xdd, 0x2a,
+	0xe6, 0x2f, 0xbc, 0x09, 0xf9, 0x4f, 0xd8, 0xde, 0x99, 0xfb, 0x11, 0x18, 0xc7, 0xff, 0x18, 0x1c,
+	0x6f, 0x97, 0x6f, 0x55, 0x30, 0x8e, 0x37, 0x2a, 0xde, 0xff, 0x8c, 0x87, 0xdd, 0xe6, 0xcd, 0x0a,
+	0xea, 0xc5, 0x87, 0x30, 0x53, 0xf9, 0x62, 0xc7, 0xae, 0xba, 0x76, 0x6d, 0xdd, 0x69, 0x6f, 0xa3,
+	0x6d, 0x24, 0xaf, 0x3b, 0x4f, 0xc1, 0x09, 0x51, 0x39, 0xa3, 0xf6, 0xad, 0xd5, 0xb5, 0x4a, 0x99,
+	0x0c, 0x95, 0xca, 0xa7, 0xd7, 0xef, 0xac, 0x55, 0xd6, 0x36, 0x57, 0xcb, 0xb7, 0x8a, 0x19, 0xc4,
+	0x74, 0x63, 0x75, 0x0d, 0x75, 0x52, 0x01, 0x72, 0xd7, 0x2b, 0xb7, 0x6e, 0x15, 0x73, 0x8b, 0xff,
+	0x5d, 0xa7, 0x43, 0x7a, 0xc3, 0x76, 0x3c, 0xcf, 0xb4, 0x7b, 0x30, 0x5a, 0x6e, 0x34, 0xca, 0xc8,
+	0x0b, 0x49, 0xec, 0x00, 0x4e, 0xee, 0x12, 0x1b, 0x58, 0x7a, 0x5e, 0x4d, 0x84, 0x1f, 0x4f, 0xe3,
+	0x19, 0xfd, 0xb3, 0x30, 0x86, 0x9d, 0xae, 0x91, 0x1b, 0xa8, 0x38, 0xcd, 0x88, 0x7f, 0x9f, 0xb6,
+	0xfd, 0x62, 0x14, 0x19, 0xdb, 0x3a, 0xf6, 0x6a, 0x96, 0xb7, 0xee, 0xdf, 0x57, 0xb7, 0xce, 0x90,
+	0xf9, 0xad, 0xdf, 0x83, 0x51, 0x64, 0x3b, 0x50, 0xdb, 0xcf, 0x49, 0x2d, 0x0b, 0xd3, 0xf2, 0xf3,
+	0x6a, 0x22, 0x56, 0x6a, 0xec, 0xcc, 0x2b, 0x97, 0xda, 0xbf, 0xaf, 0x96, 0x9a, 0x21, 0xf3, 0x5b,
+	0xb7, 0x61, 0xa2, 0xdc, 0x68, 0xac, 0xfb, 0x3e, 0xa6, 0xe7, 0x65, 0x3d, 0xe5, 0x93, 0x50, 0x1e,
+	0x2f, 0xc5, 0xa0, 0xf4, 0xd9, 0x3c, 0x82, 0x29, 0xdc, 0x23, 0xf4, 0xa6, 0x7e, 0x41, 0xd1, 0x6d,
+	0x94, 0x88, 0xb2, 0xba, 0x18, 0x8b, 0x96, 0x65, 0x86, 0x3b, 0x28, 0x82, 0x19, 0x4f, 0xa4, 0x66,
+	0x16, 0xa4, 0x65, 0x01, 0x44, 0x7d, 0xe6, 0xb3, 0x3a, 0x2f, 0xed, 0xd6, 0x20, 0xa3, 0x97, 0x62,
+	0x50, 0xb2, 0x3a, 0xe1, 0xee, 0x8b, 0xd0, 0x89, 0x27, 0x52, 0xeb, 0x14, 0xa4, 0xf5, 0x99, 0x6d,
+	0x01, 0x94, 0x1b, 0x8d, 0x15, 0xe2, 0xf3, 0xf7, 0xa2, 0xac, 0xa3, 0x09, 0x01, 0x65, 0x72, 0x2e,
+	0x92, 0x8e, 0x05, 0x0d, 0xf7, 0x1e, 0xbe, 0x25, 0x01, 0x8d, 0x25, 0x51, 0x83, 0xc6, 0x53, 0xb2,
+	0x6c, 0x70, 0xbf, 0x29, 0xd9, 0xb0, 0x24, 0x6a, 0x36, 0x3c, 0x25, 0x0b, 0x17, 0xea, 0x35, 0xc2,
+	0xe4, 0x45, 0x69, 0xb7, 0xf2, 0x2c, 0xce, 0x45, 0xd2, 0xb1, 0x7a, 0xe0, 0xbe, 0x52, 0xea, 0xc1,
+	0x92, 0xa8, 0xf5, 0xe0, 0x29, 0x03, 0xb6, 0xe0, 0xbe, 0xef, 0x23, 0x2a, 0xb5, 0x05, 0x3e, 0x49,
+	0xa4, 0x2d, 0x60, 0x28, 0xc3, 0xb6, 0x80, 0xde, 0x54, 0xda, 0x02, 0x4a, 0x14, 0xc7, 0x16, 0xf4,
+	0x68, 0xc3, 0xb6, 0x20, 0x82, 0x19, 0x4f, 0x14, 0xc7, 0x16, 0x08, 0x98, 0x11, 0x5b, 0xe0, 0xb3,
+	0x92, 0xdb, 0x82, 0x20, 0xa3, 0x97, 0x62, 0x50, 0x86, 0x6d, 0x41, 0x84, 0x4e, 0x3c, 0x51, 0x1c,
+	0x5b, 0x20, 0x60, 0x86, 0x6d, 0xc1, 0x6d, 0xf2, 0x4a, 0x21, 0xb5, 0x05, 0x84, 0x20, 0xd2, 0x16,
+	0xf8, 0x74, 0x61, 0x5b, 0x80, 0x6f, 0x29, 0x6d, 0x01, 0x26, 0x89, 0x63, 0x0b, 0x28, 0x65, 0xd8,
+	0x16, 0x28, 0xd9, 0xb0, 0x24, 0x71, 0x6c, 0x41, 0x88, 0x0d, 0xb1, 0x05, 0x84, 0xc9, 0x8b, 0x8a,
+	0x57, 0x0c, 0x96, 0xc5, 0xb9, 0x48, 0xba, 0xb0, 0x2d, 0x50, 0xea, 0xc1, 0x92, 0xc4, 0xb1, 0x05,
+	0x22, 0x36, 0x24, 0xc6, 0x87, 0x8a, 0x0d, 0x4b, 0xa2, 0x66, 0xc3, 0x53, 0x06, 0x47, 0x97, 0xe7,
+	0x39, 0xa0, 0x1a, 0x5d, 0x98, 0x20, 0x7a, 0x74, 0x51, 0x3a, 0xc1, 0xe8, 0xf2, 0x6e, 0xa9, 0x47,
+	0x17, 0x9b, 0x88, 0xbf, 0xf4, 0x52, 0x0c, 0x4a, 0xc1, 0xe8, 0x52, 0xb1, 0x61, 0x49, 0x62, 0x8d,
+	0xae, 0x20, 0x1b, 0x3a, 0xba, 0x30, 0x13, 0xc5, 0xe8, 0xe2, 0x58, 0x9c, 0x8b, 0xa4, 0x13, 0x8c,
+	0x2e, 0x95, 0x1e, 0x2c, 0x49, 0xac, 0xd1, 0x15, 0x64, 0xf3, 0x0d, 0x1c, 0x35, 0x57, 0x90, 0x77,
+	0x5f, 0x5f, 0x94, 0xf5, 0xad, 0x80, 0x98, 0xf2, 0xbe, 0x9a, 0xa8, 0x8e, 0x2f, 0xc5, 0x57, 0x35,
+	0xf2, 0x99, 0x5a, 0xc8, 0x0d, 0xf7, 0x15, 0x29, 0x62, 0xb2, 0x2c, 0xdc, 0xa5, 0xc5, 0x24, 0x55,
+	0x7c, 0x11, 0xbe, 0xe5, 0x67, 0x45, 0x0e, 0x0b, 0x71, 0x55, 0x31, 0x00, 0xa5, 0x62, 0x2c, 0x25,
+	0xab, 0xc4, 0x09, 0x22, 0x49, 0x3d, 0x23, 0x11, 0x44, 0x9d, 0x7a, 0xbf, 0xb4, 0x94, 0xac, 0x12,
+	0x27, 0x88, 0x24, 0xeb, 0x89, 0x44, 0x10, 0x75, 0xae, 0xf7, 0xd2, 0x52, 0xb2, 0x4a, 0x9c, 0x20,
+	0x92, 0x84, 0xe4, 0x12, 0x41, 0xd4, 0x79, 0xda, 0x4b, 0x4b, 0xc9, 0x2a, 0xb1, 0xd3, 0x3d, 0x9f,
+	0xbd, 0x5b, 0x32, 0xdd, 0x0b, 0x13, 0x95, 0x97, 0x2e, 0xc6, 0xa2, 0xf5, 0x99, 0xbd, 0x03, 0xc5,
+	0x60, 0x62, 0x6c, 0xfd, 0x65, 0xc5, 0x98, 0x0a, 0x65, 0x34, 0x2e, 0x5d, 0x8a, 0x49, 0xcd, 0xb2,
+	0x0c, 0xa6, 0x9e, 0x96, 0xb0, 0x94, 0xa4, 0xcb, 0x2e, 0x5d, 0x8a, 0x49, 0xcd, 0x42, 0xca, 0x67,
+	0x84, 0x96, 0x40, 0x2a, 0xcc, 0x47, 0x5d, 0xba, 0x18, 0x8b, 0x96, 0xd5, 0x2f, 0x98, 0x89, 0x59,
+	0xa2, 0x9f, 0x24, 0x49, 0x74, 0xe9, 0x52, 0x4c, 0x6a, 0x96, 0x65, 0x30, 0xd3, 0xb1, 0x84, 0xa5,
+	0x24, 0xb1, 0x73, 0xe9, 0x52, 0x4c, 0x6a, 0x9f, 0xe5, 0x13, 0x1a, 0x10, 0x84, 0x25, 0xd0, 0x17,
+	0x14, 0x83, 0x41, 0x90, 0xe2, 0xb5, 0x74, 0x39, 0x36, 0x3d, 0xcb, 0x38, 0x9c, 0x9a, 0x57, 0xc2,
+	0x58, 0x9a, 0x58, 0xb8, 0x74, 0x39, 0x36, 0x3d, 0x0b, 0x72, 0x30, 0x61, 0xae, 0x04, 0x64, 0x49,
+	0xce, 0xde, 0xd2, 0xa5, 0x98, 0xd4, 0xac, 0xae, 0xe1, 0x44, 0xb5, 0x12, 0x5d, 0xa5, 0x79, 0x74,
+	0x4b, 0x97, 0x63, 0xd3, 0xfb, 0x8c, 0x5d, 0x38, 0x1a, 0xca, 0x05, 0xab, 0x4b, 0xc7, 0x88, 0x30,
+	0xf9, 0x6d, 0x69, 0x21, 0x2e, 0xb9, 0xcf, 0xf5, 0x5d, 0x98, 0x16, 0x64, 0x57, 0xd5, 0x55, 0x83,
+	0x44, 0x94, 0x15, 0xb2, 0x74, 0x25, 0x7e, 0x05, 0x96, 0xb7, 0x20, 0x6d, 0xa9, 0xae, 0x1a, 0x27,
+	0x09, 0x78, 0x2b, 0x32, 0xa2, 0x62, 0xb4, 0x43, 0xf9, 0x42, 0x75, 0xf9, 0x60, 0x11, 0xf2, 0x5d,
+	0x88, 0x4b, 0xce, 0x6a, 0x2c, 0xc8, 0xd3, 0xa9, 0xab, 0x46, 0x4b, 0x02, 0x8d, 0x15, 0x29, 0x40,
+	0x31, 0x6f, 0x41, 0xc2, 0x4b, 0x09, 0x6f, 0x79, 0xfe, 0xcf, 0xd2, 0x95, 0xf8, 0x15, 0x7c, 0xde,
+	0xdf, 0xc1, 0x61, 0x71, 0x85, 0x09, 0x21, 0xf5, 0xa5, 0xb8, 0x4b, 0x4b, 0x36, 0x1f, 0x66, 0xe9,
+	0x5a, 0xc2, 0x5a, 0xbe, 0x2c, 0xdf, 0xf7, 0xc3, 0xc9, 0x8a, 0x28, 0xf5, 0x57, 0x93, 0xac, 0xee,
+	0x7a, 0x29, 0xea, 0x4a, 0xaf, 0x25, 0xae, 0xc7, 0x49, 0x24, 0xcf, 0x49, 0x28, 0x91, 0x28, 0x32,
+	0xf3, 0x62, 0xe9, 0xb5, 0xc4, 0xf5, 0xb8, 0xfe, 0x92, 0xa5, 0x0a, 0x94, 0xf4, 0x57, 0x44, 0xf6,
+	0xc2, 0xd2, 0xb5, 0x84, 0xb5, 0x38, 0x74, 0xe4, 0x39, 0xfa, 0x24, 0xe8, 0x44, 0xa6, 0x14, 0x2c,
+	0xbd, 0x96, 0xb8, 0x1e, 0x27, 0x91, 0x3c, 0x1d, 0x9e, 0x44, 0xa2, 0xc8, 0x8c, 0x80, 0xa5, 0xd7,
+	0x12, 0xd7, 0xf3, 0x25, 0xfa, 0xc0, 0x8f, 0x44, 0x2c, 0xfe, 0x70, 0x4e, 0x35, 0x38, 0x55, 0x59,
+	0xb9, 0x4a, 0xaf, 0x27, 0xaf, 0xc8, 0x09, 0xa5, 0xc8, 0xc6, 0xa6, 0xab, 0xc6, 0x67, 0x0a, 0xa1,
+	0x62, 0x24, 0x7e, 0x33, 0x9e, 0xd1, 0x7f, 0x89, 0x84, 0xea, 0x15, 0x8b, 0x24, 0x1f, 0xa4, 0x4a,
+	0x81, 0x5e, 0x4d, 0x5a, 0x8d, 0xc3, 0x48, 0x91, 0x9d, 0x4c, 0x57, 0x8d, 0xd2, 0x14, 0x18, 0xc5,
+	0x48, 0x84, 0x66, 0x3c, 0xa3, 0xbf, 0x07, 0x33, 0xa2, 0x7c, 0x60, 0xfa, 0x95, 0x88, 0x01, 0x1a,
+	0x1e, 0xd2, 0xaf, 0x24, 0xa8, 0xc1, 0xed, 0x19, 0x08, 0xd3, 0x6b, 0x49, 0xf6, 0x0c, 0x54, 0xb9,
+	0xc2, 0x4a, 0x8b, 0x49, 0xaa, 0x70, 0x22, 0x08, 0x53, 0x56, 0x49, 0x44, 0x50, 0xe5, 0xdf, 0x2a,
+	0x2d, 0x26, 0xa9, 0xc2, 0x4e, 0xd7, 0x82, 0x44, 0x52, 0x92, 0xe9, 0x5a, 0x9e, 0xd1, 0xaa, 0x74,
+	0x25, 0x7e, 0x05, 0x4e, 0x7d, 0x61, 0x06, 0x27, 0x89, 0xfa, 0xaa, 0x34, 0x53, 0xa5, 0xc5, 0x24,
+	0x55, 0x7c, 0x11, 0xbe, 0xc2, 0xe5, 0x28, 0xa0, 0x04, 0xbd, 0x68, 0x47, 0x8a, 0xb7, 0xc9, 0x50,
+	0x48, 0xa4, 0x54, 0x18, 0x7c, 0x5b, 0x83, 0x17, 0x94, 0x12, 0xdc, 0x71, 0xf0, 0x28, 0xda, 0x77,
+	0x49, 0x5a, 0x70, 0x24, 0x90, 0x37, 0x4a, 0xbf, 0x18, 0xf1, 0x5c, 0x71, 0xdb, 0x13, 0x2f, 0xc7,
+	0x23, 0x66, 0x97, 0xc6, 0xa1, 0x7c, 0x4c, 0xfa, 0xa5, 0xe8, 0xe7, 0x88, 0x7d, 0x9d, 0x5e, 0x88,
+	0x4b, 0xce, 0x72, 0x0d, 0xa5, 0x3b, 0xd2, 0x2f, 0x45, 0x3f, 0x3a, 0xd1, 0x5c, 0xa5, 0x59, 0x94,
+	0x30, 0xb6, 0x81, 0x24, 0x44, 0xfa, 0xc5, 0xa8, 0x2e, 0x62, 0x39, 0xbe, 0x1c, 0x8f, 0x98, 0xd5,
+	0x32, 0x94, 0xfc, 0x47, 0xbf, 0x14, 0xfd, 0x84, 0x44, 0x6b, 0x29, 0xcd, 0x29, 0x44, 0x9e, 0x67,
+	0x61, 0x6a, 0x1d, 0x3d, 0xca, 0x40, 0x87, 0x73, 0x0a, 0x95, 0x16, 0x93, 0x54, 0x11, 0xec, 0xc2,
+	0x86, 0xc8, 0x94, 0xbb, 0xb0, 0xb2, 0x9c, 0x20, 0xa5, 0xa5, 0x64, 0x95, 0x04, 0xbb, 0xb0, 0x71,
+	0x05, 0x51, 0xa7, 0xb1, 0x29, 0x2d, 0x25, 0xab, 0x14, 0xda, 0x1a, 0x0f, 0x8b, 0xf1, 0x4a, 0xd4,
+	0xa0, 0x0a, 0x0b, 0xb1, 0x98, 0xa4, 0x8a, 0x60, 0x23, 0x38, 0x2e, 0x16, 0xea, 0x44, 0x2d, 0xa5,
+	0xa5, 0x64, 0x95, 0x02, 0x7b, 0x1f, 0x7c, 0xb2, 0x11, 0xf9, 0xde, 0x87, 0x30, 0xbb, 0x4a, 0x69,
+	0x21, 0x2e, 0x79, 0x78, 0xef, 0x83, 0xa3, 0x50, 0xee, 0x7d, 0x88, 0x72, 0x12, 0x94, 0xae, 0xc4,
+	0xaf, 0x10, 0xde, 0xfb, 0x88, 0xc3, 0x5b, 0x9e, 0xda, 0xa3, 0x74, 0x25, 0x7e, 0x85, 0xe0, 0xde,
+	0x07, 0xcf, 0xf9, 0x52, 0xc4, 0x09, 0x56, 0x80, 0xef, 0x42, 0x5c, 0xf2, 0xf0, 0xde, 0x47, 0x1c,
+	0x8d, 0xe5, 0x69, 0x2c, 0x4a, 0x57, 0xe2, 0x57, 0x08, 0xef, 0x7d, 0xc4, 0xe1, 0x2d, 0xcf, 0x3e,
+	0x51, 0xba, 0x12, 0xbf, 0x42, 0xf0, 0x20, 0x4e, 0x90, 0x70, 0x40, 0x7e, 0x10, 0x27, 0xcf, 0xb5,
+	0x50, 0xba, 0x9a, 0xa8, 0x0e, 0xf7, 0x46, 0x2f, 0x0b, 0xda, 0xaf, 0x2f, 0x29, 0x37, 0xef, 0x24,
+	0xd1, 0xc4, 0x4b, 0xd7, 0x12, 0xd6, 0xe2, 0x64, 0x91, 0xc5, 0xbf, 0xd7, 0x97, 0x94, 0x9b, 0x79,
+	0xc9, 0x64, 0x89, 0x0a, 0xb2, 0x4f, 0x7a, 0x47, 0x1c, 0x96, 0x5e, 0x5f, 0x54, 0x6c, 0xef, 0xc9,
+	0xe4, 0xb8, 0x9a, 0xa8, 0x0e, 0x87, 0x88, 0x2c, 0x1e, 0xbc, 0xbe, 0xa4, 0xdc, 0xec, 0x4b, 0x86,
+	0x48, 0x54, 0xd0, 0x79, 0x22, 0x8b, 0x2c, 0xa2, 0xba, 0xbe, 0xa4, 0xdc, 0xfc, 0x4b, 0x26, 0x4b,
+	0x54, 0xd8, 0x76, 0x7c, 0x18, 0xdf, 0x0b, 0x81, 0xae, 0xab, 0xdc, 0x50, 0xcd, 0x76, 0xd4, 0x61,
+	0x7c, 0x38, 0x96, 0x3a, 0x66, 0xd0, 0x0b, 0x54, 0xae, 0xab, 0x3c, 0x51, 0xa3, 0x19, 0x84, 0x23,
+	0x9e, 0xe3, 0xe3, 0x84, 0x70, 0x60, 0x71, 0x5d, 0x6e, 0x3d, 0x85, 0xb1, 0xcb, 0x4b, 0x97, 0x63,
+	0xd3, 0xfb, 0x8c, 0x1f, 0xc0, 0x38, 0x13, 0x5a, 0x5a, 0x97, 0xfa, 0x73, 0x04, 0xc2, 0x67, 0x97,
+	0xce, 0x47, 0x13, 0xfa, 0x3c, 0x76, 0x60, 0x92, 0x0b, 0xe0, 0xac, 0xab, 0x1c, 0x3a, 0xf8, 0xc8,
+	0xbc, 0xa5, 0x0b, 0x71, 0x48, 0x59, 0x4e, 0x5c, 0xb4, 0x64, 0x5d, 0xe5, 0xd3, 0x11, 0x8b, 0x93,
+	0x30, 0xf8, 0x32, 0xc6, 0x8d, 0x09, 0x62, 0xac, 0xcb, 0x1d, 0x3b, 0x02, 0x5c, 0xce, 0x47, 0x13,
+	0xb2, 0xda, 0x70, 0xc1, 0x83, 0x75, 0x95, 0x67, 0x47, 0x2c, 0x6d, 0x84, 0xb1, 0x88, 0x8d, 0x67,
+	0xf4, 0xf7, 0xe1, 0x84, 0x34, 0xbe, 0xb0, 0x64, 0xb7, 0x2b, 0x2a, 0x1e, 0x71, 0xa2, 0x11, 0xd2,
+	0x84, 0x99, 0x9e, 0x8b, 0x55, 0xca, 0xb7, 0xf7, 0x04, 0x9e, 0x5b, 0xdf, 0xd1, 0xc0, 0x90, 0x6d,
+	0x29, 0xa7, 0xe4, 0x9e, 0x7a, 0xdf, 0xfa, 0x4b, 0x38, 0x13, 0x38, 0x77, 0x24, 0x92, 0x52, 0x86,
+	0xe4, 0x07, 0x4d, 0xdb, 0x78, 0x89, 0x57, 0xee, 0x74, 0x52, 0xb2, 0x8c, 0xeb, 0x3a, 0xff, 0x0e,
+	0x9e, 0x3e, 0xa9, 0x57, 0x63, 0x4a, 0x6e, 0x89, 0x7c, 0x33, 0xc9, 0x98, 0xc2, 0xbe, 0xb5, 0x03,
+	0x1f, 0x53, 0x21, 0x97, 0xdd, 0x6d, 0x28, 0xe1, 0xcc, 0x09, 0xc2, 0xe3, 0x07, 0x43, 0x62, 0x5c,
+	0x98, 0x64, 0x0e, 0xa5, 0xe7, 0x94, 0x34, 0x8c, 0xe5, 0x99, 0xc5, 0x65, 0xc1, 0x4d, 0xb7, 0x01,
+	0xf2, 0x78, 0x1b, 0x26, 0x70, 0x19, 0xf1, 0x39, 0x1c, 0x60, 0xd3, 0x35, 0x38, 0x8e, 0xcb, 0x42,
+	0x38, 0x0d, 0x92, 0xcb, 0x43, 0x38, 0x81, 0xcb, 0x44, 0x21, 0x1c, 0x06, 0xc8, 0xe7, 0x0b, 0x30,
+	0x8d, 0xcb, 0xf8, 0xd3, 0xd2, 0x01, 0x72, 0x58, 0x87, 0x11, 0xef, 0x43, 0x48, 0x5d, 0xf1, 0x39,
+	0x28, 0x6d, 0xd2, 0x50, 0x91, 0xf0, 0x9f, 0xc7, 0x78, 0x9f, 0x63, 0x4a, 0x3f, 0x8f, 0x61, 0x3f,
+	0x0f, 0x2d, 0x3d, 0xaf, 0x26, 0x62, 0x06, 0x4d, 0x81, 0x7e, 0xb2, 0xa7, 0x3f, 0xaf, 0xfc, 0xa2,
+	0x8f, 0xb6, 0xfc, 0x42, 0x04, 0x15, 0x6d, 0xfa, 0x41, 0xbe, 0xe3, 0xb4, 0xdd, 0xf6, 0xd5, 0xff,
+	0x19, 0x00, 0xb3, 0x10, 0x79, 0xa5, 0xa1, 0xd3, 0x00, 0x00,
 }
@@ -32,7 +32,7 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 
 	return &clientWrapper{
 		Db_client: cl,
@@ -71,6 +71,24 @@ func runQuery(ctx context.Context, q string, table string) (*db_proto.RunQueryRe
 	})
 }
 
+// runQueryBind runs a query which references its parameters as bind variables
+func runQueryBind(ctx context.Context, q string, bindVars common.BindVars, table string) (*db_proto.RunQueryResponse, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return ClientWrapper.Db_client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{
+			Name:     common.DbHealumName,
+			Table:    table,
+			Driver:   common.DbHealumDriver,
+			Metadata: common.SearchableMetaMap,
+		},
+		Query:    q,
+		BindVars: vars,
+	})
+}
+
 func surveyToRecord(survey *survey_proto.Survey) (string, error) {
 	data, err := common.MarhalToObject(survey)
 	if err != nil {
@@ -354,17 +372,9 @@ func Read(ctx context.Context, id, orgId, teamId string) (*survey_proto.Survey,
 	return data, err
 }
 
-// Deletes a survey by ID
-func Delete(ctx context.Context, id, orgId, teamId string) error {
-	query := fmt.Sprintf(`FILTER doc._key == "%v"`, id)
-	query = common.QueryAuth(query, orgId, teamId)
-
-	q := fmt.Sprintf(`
-		FOR doc IN %v
-		%s
-		REMOVE doc IN %v`, common.DbSurveyTable, query, common.DbSurveyTable)
-	_, err := runQuery(ctx, q, common.DbSurveyTable)
-	return err
+// Delete moves a survey and its edges to the trash
+func Delete(ctx context.Context, id, orgId, teamId, userId string) error {
+	return common.TrashDocument(ctx, ClientWrapper.Db_client, common.DbSurveyTable, id, orgId, userId)
 }
 
 // Questions ...
@@ -590,14 +600,18 @@ func ShareSurvey(ctx context.Context, surveys []*survey_proto.Survey, users []*u
 			}
 
 			field := fmt.Sprintf(`{_from:"%v",_to:"%v"} `, _from, _to)
+			bindVars := common.BindVars{}
 			q := fmt.Sprintf(`
 				UPSERT %v
 				INSERT %v
 				UPDATE %v
 				INTO %v
-				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field, record, record, common.DbShareSurveyUserEdgeTable)
+				RETURN {data:{user_id: OLD ? "" : NEW.data.user.id}}`, field,
+				common.QueryPinVersion(record, common.DbSurveyTable, survey.Id, bindVars),
+				common.QueryPinVersion(record, common.DbSurveyTable, survey.Id, bindVars),
+				common.DbShareSurveyUserEdgeTable)
 
-			resp, err := runQuery(ctx, q, common.DbShareSurveyUserEdgeTable)
+			resp, err := runQueryBind(ctx, q, bindVars, common.DbShareSurveyUserEdgeTable)
 			if err != nil {
 				return nil, err
 			}
@@ -748,3 +762,55 @@ func GetShareableSurveys(ctx context.Context, createdBy []string, search_term, u
 	}
 	return response, nil
 }
+
+// trashCollections are the collections whose documents are deleted to the trash
+var trashCollections = []string{
+	common.DbSurveyTable,
+}
+
+// Trash returns the deleted surveys, the latest deleted first
+func Trash(ctx context.Context, collections []string, orgId, teamId string, offset, limit int64) ([]*static_proto.TrashItem, error) {
+	collections, err := common.TrashCollections(collections, trashCollections)
+	if err != nil {
+		return nil, err
+	}
+	return common.ListTrash(ctx, ClientWrapper.Db_client, collections, orgId, offset, limit)
+}
+
+// Restore moves a deleted document and its edges back to its collection
+func Restore(ctx context.Context, id, collection, orgId, teamId string) (*static_proto.TrashItem, error) {
+	if _, err := common.TrashCollections([]string{collection}, trashCollections); err != nil {
+		return nil, err
+	}
+	return common.RestoreDocument(ctx, ClientWrapper.Db_client, collection, id, orgId)
+}
+
+// SaveVersion snapshots a survey in its history after a write, it returns the latest version of the survey
+func SaveVersion(ctx context.Context, id, userId string) (int64, error) {
+	return common.SaveVersion(ctx, ClientWrapper.Db_client, common.DbSurveyTable, id, userId)
+}
+
+// ListVersions returns the versions of a survey, the latest first
+func ListVersions(ctx context.Context, id, orgId string, offset, limit int64) ([]*static_proto.Version, error) {
+	return common.ListVersions(ctx, ClientWrapper.Db_client, common.DbSurveyTable, id, orgId, offset, limit)
+}
+
+// ReadVersion returns a version of a survey and the survey as it was
+func ReadVersion(ctx context.Context, id, orgId string, version int64) (*static_proto.Version, *survey_proto.Survey, error) {
+	v, r, err := common.ReadVersion(ctx, ClientWrapper.Db_client, common.DbSurveyTable, id, orgId, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	survey, err := recordToSurvey(r)
+	return v, survey, err
+}
+
+// RevertToVersion writes a survey as it was at a version, it returns the new version and the survey
+func RevertToVersion(ctx context.Context, id, orgId, userId string, version int64) (*static_proto.Version, *survey_proto.Survey, error) {
+	v, r, err := common.RevertToVersion(ctx, ClientWrapper.Db_client, common.DbSurveyTable, id, orgId, userId, version)
+	if err != nil {
+		return nil, nil, err
+	}
+	survey, err := recordToSurvey(r)
+	return v, survey, err
+}
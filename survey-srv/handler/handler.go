@@ -8,6 +8,7 @@ import (
 	"server/common"
 	kv_proto "server/kv-srv/proto/kv"
 	pubsub_proto "server/static-srv/proto/pubsub"
+	static_proto "server/static-srv/proto/static"
 	"server/survey-srv/db"
 	survey_proto "server/survey-srv/proto/survey"
 	team_proto "server/team-srv/proto/team"
@@ -63,6 +64,10 @@ func (p *SurveyService) Create(ctx context.Context, req *survey_proto.CreateRequ
 	if err != nil {
 		return common.InternalServerError(common.SurveySrv, p.Create, err, "create error")
 	}
+	// the version is saved before the survey is shared, shares pin it
+	if _, err := db.SaveVersion(ctx, req.Survey.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.SurveySrv, p.Create, err, "version error")
+	}
 
 	// share survey with user
 	req_share := &survey_proto.ShareSurveyRequest{
@@ -105,7 +110,7 @@ func (p *SurveyService) Read(ctx context.Context, req *survey_proto.ReadRequest,
 
 func (p *SurveyService) Delete(ctx context.Context, req *survey_proto.DeleteRequest, rsp *survey_proto.DeleteResponse) error {
 	log.Info("Received Survey.Delete request")
-	if err := db.Delete(ctx, req.Id, req.OrgId, req.TeamId); err != nil {
+	if err := db.Delete(ctx, req.Id, req.OrgId, req.TeamId, req.UserId); err != nil {
 		return common.InternalServerError(common.SurveySrv, p.Delete, err, "delete error")
 	}
 	return nil
@@ -131,6 +136,9 @@ func (p *SurveyService) Copy(ctx context.Context, req *survey_proto.CopyRequest,
 	if err != nil {
 		return common.InternalServerError(common.SurveySrv, p.Copy, err, "create error")
 	}
+	if _, err := db.SaveVersion(ctx, survey.Id, req.UserId); err != nil {
+		return common.InternalServerError(common.SurveySrv, p.Copy, err, "version error")
+	}
 	rsp.Data = &survey_proto.Data{survey}
 	return nil
 }
@@ -370,3 +378,77 @@ func (p *SurveyService) Update(ctx context.Context, req *survey_proto.UpdateRequ
 	log.Info("Received Survey.Update request")
 	return nil
 }
+
+func (p *SurveyService) Trash(ctx context.Context, req *survey_proto.TrashRequest, rsp *survey_proto.TrashResponse) error {
+	log.Info("Received Survey.Trash request")
+	items, err := db.Trash(ctx, req.Collections, req.OrgId, req.TeamId, req.Offset, req.Limit)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.SurveySrv, p.Trash, err, "collection is invalid")
+	}
+	if err != nil {
+		return common.InternalServerError(common.SurveySrv, p.Trash, err, "trash error")
+	}
+	rsp.Data = &static_proto.TrashArrData{items}
+	return nil
+}
+
+func (p *SurveyService) Restore(ctx context.Context, req *survey_proto.RestoreRequest, rsp *survey_proto.RestoreResponse) error {
+	log.Info("Received Survey.Restore request")
+	item, err := db.Restore(ctx, req.Id, req.Collection, req.OrgId, req.TeamId)
+	if err == common.ErrTrashCollection {
+		return common.BadRequest(common.SurveySrv, p.Restore, err, "collection is invalid")
+	}
+	if common.IsNotFound(err) {
+		return common.NotFound(common.SurveySrv, p.Restore, err, "trashed document not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.SurveySrv, p.Restore, err, "restore error")
+	}
+	rsp.Data = &static_proto.TrashData{item}
+	return nil
+}
+
+func (p *SurveyService) ListVersions(ctx context.Context, req *survey_proto.ListVersionsRequest, rsp *survey_proto.ListVersionsResponse) error {
+	log.Info("Received Survey.ListVersions request")
+	versions, err := db.ListVersions(ctx, req.Id, req.OrgId, req.Offset, req.Limit)
+	if err == common.ErrVersionOrg {
+		return common.BadRequest(common.SurveySrv, p.ListVersions, err, "organisation empty")
+	}
+	if err != nil {
+		return common.InternalServerError(common.SurveySrv, p.ListVersions, err, "versions error")
+	}
+	rsp.Data = &static_proto.VersionArrData{versions}
+	return nil
+}
+
+func (p *SurveyService) ReadVersion(ctx context.Context, req *survey_proto.ReadVersionRequest, rsp *survey_proto.ReadVersionResponse) error {
+	log.Info("Received Survey.ReadVersion request")
+	version, survey, err := db.ReadVersion(ctx, req.Id, req.OrgId, req.Version)
+	if err == common.ErrVersionOrg {
+		return common.BadRequest(common.SurveySrv, p.ReadVersion, err, "organisation empty")
+	}
+	if err == common.ErrVersionNotFound {
+		return common.NotFound(common.SurveySrv, p.ReadVersion, err, "version not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.SurveySrv, p.ReadVersion, err, "version error")
+	}
+	rsp.Data = &survey_proto.VersionData{version, survey}
+	return nil
+}
+
+func (p *SurveyService) RevertToVersion(ctx context.Context, req *survey_proto.RevertToVersionRequest, rsp *survey_proto.RevertToVersionResponse) error {
+	log.Info("Received Survey.RevertToVersion request")
+	version, survey, err := db.RevertToVersion(ctx, req.Id, req.OrgId, req.UserId, req.Version)
+	if err == common.ErrVersionOrg {
+		return common.BadRequest(common.SurveySrv, p.RevertToVersion, err, "organisation empty")
+	}
+	if err == common.ErrVersionNotFound {
+		return common.NotFound(common.SurveySrv, p.RevertToVersion, err, "version not found")
+	}
+	if err != nil {
+		return common.InternalServerError(common.SurveySrv, p.RevertToVersion, err, "revert error")
+	}
+	rsp.Data = &survey_proto.VersionData{version, survey}
+	return nil
+}
@@ -75,6 +75,17 @@ It has these top-level messages:
 	AutocompleteTagsResponse
 	WarmupCacheSurveyRequest
 	WarmupCacheSurveyResponse
+	TrashRequest
+	TrashResponse
+	RestoreRequest
+	RestoreResponse
+	ListVersionsRequest
+	ListVersionsResponse
+	VersionData
+	ReadVersionRequest
+	ReadVersionResponse
+	RevertToVersionRequest
+	RevertToVersionResponse
 */
 package go_micro_srv_survey
 
@@ -793,6 +804,7 @@ type DeleteRequest struct {
 	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
@@ -821,6 +833,13 @@ func (m *DeleteRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *DeleteRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type DeleteResponse struct {
 	Code    int64  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
 	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
@@ -849,6 +868,7 @@ type CopyRequest struct {
 	SurveyId string `protobuf:"bytes,1,opt,name=survey_id,json=surveyId" json:"survey_id,omitempty"`
 	OrgId    string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	TeamId   string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId   string `protobuf:"bytes,4,opt,name=user_id,json=userId" json:"user_id,omitempty"`
 }
 
 func (m *CopyRequest) Reset()                    { *m = CopyRequest{} }
@@ -877,6 +897,13 @@ func (m *CopyRequest) GetTeamId() string {
 	return ""
 }
 
+func (m *CopyRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
 type CopyResponse struct {
 	Data    *Data  `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
 	Code    int64  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
@@ -3200,6 +3227,7 @@ type ShareSurveyUser struct {
 	SharedBy *go_micro_srv_user.User         `protobuf:"bytes,6,opt,name=shared_by,json=sharedBy" json:"shared_by,omitempty"`
 	Count    int64                           `protobuf:"varint,7,opt,name=count" json:"count,omitempty"`
 	Created  int64                           `protobuf:"varint,8,opt,name=created" json:"created,omitempty"`
+	Version  int64                           `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
 }
 
 func (m *ShareSurveyUser) Reset()                    { *m = ShareSurveyUser{} }
@@ -3263,6 +3291,13 @@ func (m *ShareSurveyUser) GetCreated() int64 {
 	return 0
 }
 
+func (m *ShareSurveyUser) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type GetTopTagsRequest struct {
 	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
 	N     int64  `protobuf:"varint,2,opt,name=n" json:"n,omitempty"`
@@ -3441,6 +3476,420 @@ func (m *WarmupCacheSurveyResponse) String() string            { return proto.Co
 func (*WarmupCacheSurveyResponse) ProtoMessage()               {}
 func (*WarmupCacheSurveyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
+// lists the deleted records of the service, the latest deleted first
+type TrashRequest struct {
+	OrgId  string `protobuf:"bytes,1,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId string `protobuf:"bytes,2,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	// collections of the records, all the collections of the service if empty
+	Collections []string `protobuf:"bytes,3,rep,name=collections" json:"collections,omitempty"`
+	Offset      int64    `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit       int64    `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *TrashRequest) Reset()                    { *m = TrashRequest{} }
+func (m *TrashRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrashRequest) ProtoMessage()               {}
+func (*TrashRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *TrashRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *TrashRequest) GetCollections() []string {
+	if m != nil {
+		return m.Collections
+	}
+	return nil
+}
+
+func (m *TrashRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *TrashRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TrashResponse struct {
+	Data    *go_micro_srv_static.TrashArrData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                             `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                            `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *TrashResponse) Reset()                    { *m = TrashResponse{} }
+func (m *TrashResponse) String() string            { return proto.CompactTextString(m) }
+func (*TrashResponse) ProtoMessage()               {}
+func (*TrashResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *TrashResponse) GetData() *go_micro_srv_static.TrashArrData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TrashResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TrashResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// restores a deleted record and its edges
+type RestoreRequest struct {
+	Id         string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Collection string `protobuf:"bytes,2,opt,name=collection" json:"collection,omitempty"`
+	OrgId      string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId     string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+}
+
+func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
+func (m *RestoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*RestoreRequest) ProtoMessage()               {}
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *RestoreRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *RestoreRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type RestoreResponse struct {
+	Data    *go_micro_srv_static.TrashData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                          `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                         `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RestoreResponse) Reset()                    { *m = RestoreResponse{} }
+func (m *RestoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*RestoreResponse) ProtoMessage()               {}
+func (*RestoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *RestoreResponse) GetData() *go_micro_srv_static.TrashData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RestoreResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// lists the versions of a record, the latest first
+type ListVersionsRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	OrgId  string `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit  int64  `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListVersionsRequest) Reset()                    { *m = ListVersionsRequest{} }
+func (m *ListVersionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListVersionsRequest) ProtoMessage()               {}
+func (*ListVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *ListVersionsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListVersionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListVersionsResponse struct {
+	Data    *go_micro_srv_static.VersionArrData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                               `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                              `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ListVersionsResponse) Reset()                    { *m = ListVersionsResponse{} }
+func (m *ListVersionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListVersionsResponse) ProtoMessage()               {}
+func (*ListVersionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *ListVersionsResponse) GetData() *go_micro_srv_static.VersionArrData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ListVersionsResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListVersionsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// a record as it was at a version
+type VersionData struct {
+	Version *go_micro_srv_static.Version `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
+	Survey  *Survey                      `protobuf:"bytes,2,opt,name=survey" json:"survey,omitempty"`
+}
+
+func (m *VersionData) Reset()                    { *m = VersionData{} }
+func (m *VersionData) String() string            { return proto.CompactTextString(m) }
+func (*VersionData) ProtoMessage()               {}
+func (*VersionData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *VersionData) GetVersion() *go_micro_srv_static.Version {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *VersionData) GetSurvey() *Survey {
+	if m != nil {
+		return m.Survey
+	}
+	return nil
+}
+
+type ReadVersionRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	OrgId   string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId  string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+}
+
+func (m *ReadVersionRequest) Reset()                    { *m = ReadVersionRequest{} }
+func (m *ReadVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadVersionRequest) ProtoMessage()               {}
+func (*ReadVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *ReadVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReadVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReadVersionRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *ReadVersionRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type ReadVersionResponse struct {
+	Data    *VersionData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64        `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string       `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ReadVersionResponse) Reset()                    { *m = ReadVersionResponse{} }
+func (m *ReadVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadVersionResponse) ProtoMessage()               {}
+func (*ReadVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *ReadVersionResponse) GetData() *VersionData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReadVersionResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReadVersionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// writes a record as it was at a version, it's saved as a new version
+type RevertToVersionRequest struct {
+	Id      string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	OrgId   string `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	TeamId  string `protobuf:"bytes,4,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	UserId  string `protobuf:"bytes,5,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+}
+
+func (m *RevertToVersionRequest) Reset()                    { *m = RevertToVersionRequest{} }
+func (m *RevertToVersionRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevertToVersionRequest) ProtoMessage()               {}
+func (*RevertToVersionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *RevertToVersionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RevertToVersionRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *RevertToVersionRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type RevertToVersionResponse struct {
+	Data    *VersionData `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64        `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string       `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RevertToVersionResponse) Reset()                    { *m = RevertToVersionResponse{} }
+func (m *RevertToVersionResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevertToVersionResponse) ProtoMessage()               {}
+func (*RevertToVersionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *RevertToVersionResponse) GetData() *VersionData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RevertToVersionResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RevertToVersionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Data)(nil), "go.micro.srv.survey.Data")
 	proto.RegisterType((*ArrData)(nil), "go.micro.srv.survey.ArrData")
@@ -3527,6 +3976,17 @@ func init() {
 	proto.RegisterType((*AutocompleteTagsResponse_Data)(nil), "go.micro.srv.survey.AutocompleteTagsResponse.Data")
 	proto.RegisterType((*WarmupCacheSurveyRequest)(nil), "go.micro.srv.survey.WarmupCacheSurveyRequest")
 	proto.RegisterType((*WarmupCacheSurveyResponse)(nil), "go.micro.srv.survey.WarmupCacheSurveyResponse")
+	proto.RegisterType((*TrashRequest)(nil), "go.micro.srv.survey.TrashRequest")
+	proto.RegisterType((*TrashResponse)(nil), "go.micro.srv.survey.TrashResponse")
+	proto.RegisterType((*RestoreRequest)(nil), "go.micro.srv.survey.RestoreRequest")
+	proto.RegisterType((*RestoreResponse)(nil), "go.micro.srv.survey.RestoreResponse")
+	proto.RegisterType((*ListVersionsRequest)(nil), "go.micro.srv.survey.ListVersionsRequest")
+	proto.RegisterType((*ListVersionsResponse)(nil), "go.micro.srv.survey.ListVersionsResponse")
+	proto.RegisterType((*VersionData)(nil), "go.micro.srv.survey.VersionData")
+	proto.RegisterType((*ReadVersionRequest)(nil), "go.micro.srv.survey.ReadVersionRequest")
+	proto.RegisterType((*ReadVersionResponse)(nil), "go.micro.srv.survey.ReadVersionResponse")
+	proto.RegisterType((*RevertToVersionRequest)(nil), "go.micro.srv.survey.RevertToVersionRequest")
+	proto.RegisterType((*RevertToVersionResponse)(nil), "go.micro.srv.survey.RevertToVersionResponse")
 	proto.RegisterEnum("go.micro.srv.survey.ProgressBarStyle", ProgressBarStyle_name, ProgressBarStyle_value)
 	proto.RegisterEnum("go.micro.srv.survey.QuestionType", QuestionType_name, QuestionType_value)
 	proto.RegisterEnum("go.micro.srv.survey.AttachmentType", AttachmentType_name, AttachmentType_value)
@@ -3567,6 +4027,11 @@ type SurveyServiceClient interface {
 	AutocompleteTags(ctx context.Context, in *AutocompleteTagsRequest, opts ...client.CallOption) (*AutocompleteTagsResponse, error)
 	WarmupCacheSurvey(ctx context.Context, in *WarmupCacheSurveyRequest, opts ...client.CallOption) (*WarmupCacheSurveyResponse, error)
 	GetShareableSurveys(ctx context.Context, in *go_micro_srv_user.GetShareableSurveyRequest, opts ...client.CallOption) (*go_micro_srv_user.GetShareableSurveyResponse, error)
+	Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error)
+	ReadVersion(ctx context.Context, in *ReadVersionRequest, opts ...client.CallOption) (*ReadVersionResponse, error)
+	RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...client.CallOption) (*RevertToVersionResponse, error)
 }
 
 type surveyServiceClient struct {
@@ -3797,6 +4262,56 @@ func (c *surveyServiceClient) GetShareableSurveys(ctx context.Context, in *go_mi
 	return out, nil
 }
 
+func (c *surveyServiceClient) Trash(ctx context.Context, in *TrashRequest, opts ...client.CallOption) (*TrashResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SurveyService.Trash", in)
+	out := new(TrashResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *surveyServiceClient) Restore(ctx context.Context, in *RestoreRequest, opts ...client.CallOption) (*RestoreResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SurveyService.Restore", in)
+	out := new(RestoreResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *surveyServiceClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...client.CallOption) (*ListVersionsResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SurveyService.ListVersions", in)
+	out := new(ListVersionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *surveyServiceClient) ReadVersion(ctx context.Context, in *ReadVersionRequest, opts ...client.CallOption) (*ReadVersionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SurveyService.ReadVersion", in)
+	out := new(ReadVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *surveyServiceClient) RevertToVersion(ctx context.Context, in *RevertToVersionRequest, opts ...client.CallOption) (*RevertToVersionResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SurveyService.RevertToVersion", in)
+	out := new(RevertToVersionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SurveyService service
 
 type SurveyServiceHandler interface {
@@ -3821,6 +4336,11 @@ type SurveyServiceHandler interface {
 	AutocompleteTags(context.Context, *AutocompleteTagsRequest, *AutocompleteTagsResponse) error
 	WarmupCacheSurvey(context.Context, *WarmupCacheSurveyRequest, *WarmupCacheSurveyResponse) error
 	GetShareableSurveys(context.Context, *go_micro_srv_user.GetShareableSurveyRequest, *go_micro_srv_user.GetShareableSurveyResponse) error
+	Trash(context.Context, *TrashRequest, *TrashResponse) error
+	Restore(context.Context, *RestoreRequest, *RestoreResponse) error
+	ListVersions(context.Context, *ListVersionsRequest, *ListVersionsResponse) error
+	ReadVersion(context.Context, *ReadVersionRequest, *ReadVersionResponse) error
+	RevertToVersion(context.Context, *RevertToVersionRequest, *RevertToVersionResponse) error
 }
 
 func RegisterSurveyServiceHandler(s server.Server, hdlr SurveyServiceHandler, opts ...server.HandlerOption) {
@@ -3915,247 +4435,287 @@ func (h *SurveyService) GetShareableSurveys(ctx context.Context, in *go_micro_sr
 	return h.SurveyServiceHandler.GetShareableSurveys(ctx, in, out)
 }
 
+func (h *SurveyService) Trash(ctx context.Context, in *TrashRequest, out *TrashResponse) error {
+	return h.SurveyServiceHandler.Trash(ctx, in, out)
+}
+
+func (h *SurveyService) Restore(ctx context.Context, in *RestoreRequest, out *RestoreResponse) error {
+	return h.SurveyServiceHandler.Restore(ctx, in, out)
+}
+
+func (h *SurveyService) ListVersions(ctx context.Context, in *ListVersionsRequest, out *ListVersionsResponse) error {
+	return h.SurveyServiceHandler.ListVersions(ctx, in, out)
+}
+
+func (h *SurveyService) ReadVersion(ctx context.Context, in *ReadVersionRequest, out *ReadVersionResponse) error {
+	return h.SurveyServiceHandler.ReadVersion(ctx, in, out)
+}
+
+func (h *SurveyService) RevertToVersion(ctx context.Context, in *RevertToVersionRequest, out *RevertToVersionResponse) error {
+	return h.SurveyServiceHandler.RevertToVersion(ctx, in, out)
+}
+
 func init() { proto.RegisterFile("server/survey-srv/proto/survey/survey.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 3819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x4d, 0x6f, 0x23, 0x47,
-	0x76, 0x6a, 0x7e, 0xf3, 0x51, 0xd2, 0xb4, 0x6a, 0xbe, 0x38, 0x9a, 0xf5, 0x5a, 0xd3, 0x8e, 0x77,
-	0x67, 0x65, 0x8f, 0xc6, 0x1e, 0x7b, 0xb2, 0xf6, 0xda, 0xb0, 0x4d, 0x91, 0x9c, 0x19, 0xee, 0x4a,
-	0xe4, 0xb8, 0x49, 0x79, 0x76, 0x9c, 0x00, 0x44, 0x93, 0x5d, 0xa2, 0x1a, 0xd3, 0x64, 0x73, 0xba,
-	0x9b, 0x92, 0x79, 0xc9, 0x29, 0x39, 0x6e, 0xce, 0x8b, 0x5c, 0x36, 0x40, 0x72, 0x5c, 0x20, 0xd8,
-	0x9c, 0x72, 0x48, 0x72, 0x49, 0x82, 0x24, 0x40, 0x80, 0x6c, 0xb0, 0x87, 0x1c, 0x72, 0x0c, 0x90,
-	0xfc, 0x82, 0x1c, 0x03, 0x04, 0xf5, 0xd5, 0x5d, 0x4d, 0x76, 0x53, 0xa4, 0x06, 0x9a, 0xec, 0xee,
-	0x45, 0x62, 0xbd, 0x7a, 0xf5, 0xea, 0x7d, 0xd5, 0x7b, 0xaf, 0x3e, 0x1a, 0xde, 0xf1, 0xb0, 0x7b,
-	0x8a, 0xdd, 0xfb, 0xde, 0xc4, 0x3d, 0xc5, 0xd3, 0x7b, 0x9e, 0x7b, 0x7a, 0x7f, 0xec, 0x3a, 0xbe,
-	0xc3, 0x01, 0xfc, 0xdf, 0x1e, 0x85, 0xa1, 0xab, 0x03, 0x67, 0x6f, 0x68, 0xf5, 0x5d, 0x67, 0xcf,
-	0x73, 0x4f, 0xf7, 0x58, 0xd7, 0xf6, 0xad, 0x81, 0xe3, 0x0c, 0x6c, 0xcc, 0x86, 0xf5, 0x26, 0xc7,
-	0xf7, 0x8d, 0x11, 0xc7, 0xdf, 0x7e, 0x9b, 0x13, 0x9f, 0x78, 0xd8, 0x95, 0x48, 0x93, 0x26, 0xfd,
-	0xc3, 0xd1, 0x02, 0x1e, 0x7c, 0xc3, 0xb7, 0xfa, 0x32, 0x0f, 0x14, 0xc0, 0xff, 0x31, 0x64, 0xed,
-	0x13, 0xc8, 0xd4, 0x0c, 0xdf, 0x40, 0x1f, 0x40, 0x8e, 0x31, 0x50, 0x56, 0x76, 0x94, 0xbb, 0xa5,
-	0x07, 0xb7, 0xf7, 0x62, 0x98, 0xdb, 0x6b, 0xd3, 0x7f, 0x3a, 0x47, 0xd5, 0xbe, 0x80, 0x7c, 0xc5,
-	0x75, 0xe9, 0xf8, 0x87, 0x90, 0x67, 0x40, 0xaf, 0xac, 0xec, 0xa4, 0xcf, 0x23, 0x20, 0x70, 0xb5,
-	0xbf, 0x52, 0x00, 0x2a, 0xb6, 0xad, 0xe3, 0x97, 0x13, 0xec, 0xf9, 0xe8, 0x3a, 0xe4, 0x1c, 0x77,
-	0xd0, 0xb5, 0x4c, 0xca, 0x45, 0x51, 0xcf, 0x3a, 0xee, 0xa0, 0x61, 0xa2, 0x9b, 0x90, 0xf7, 0xb1,
-	0x31, 0x24, 0xf0, 0x14, 0x85, 0xe7, 0x48, 0xb3, 0x61, 0xa2, 0x6b, 0x90, 0xb5, 0xad, 0xa1, 0xe5,
-	0x97, 0xd3, 0x3b, 0xca, 0xdd, 0xb4, 0xce, 0x1a, 0xe8, 0x06, 0xe4, 0x9c, 0xe3, 0x63, 0x0f, 0xfb,
-	0xe5, 0x0c, 0x05, 0xf3, 0x16, 0x7a, 0x1b, 0x36, 0x3d, 0xc7, 0xf5, 0xbb, 0x63, 0xc3, 0x35, 0x86,
-	0xd8, 0xc7, 0x6e, 0x39, 0x4b, 0xa9, 0x6d, 0x10, 0xe8, 0x53, 0x01, 0x0c, 0xd0, 0x4c, 0xcb, 0xc5,
-	0x7d, 0xdf, 0x72, 0x46, 0xe5, 0x5c, 0x88, 0x56, 0x13, 0x40, 0x6d, 0x08, 0x25, 0xca, 0xb9, 0x37,
-	0x76, 0x46, 0x1e, 0x46, 0xef, 0x41, 0xc6, 0x34, 0x7c, 0x83, 0xab, 0xef, 0x5b, 0xb1, 0xd2, 0x73,
-	0x65, 0xe9, 0x14, 0x13, 0x21, 0xc8, 0xf4, 0x1d, 0x13, 0x53, 0x91, 0xd2, 0x3a, 0xfd, 0x8d, 0xca,
-	0x90, 0x1f, 0x62, 0xcf, 0x33, 0x06, 0x98, 0x8a, 0x54, 0xd4, 0x45, 0x53, 0xfb, 0x14, 0xa0, 0x89,
-	0xcf, 0xe6, 0x15, 0x95, 0x4a, 0x50, 0x54, 0x5a, 0x56, 0x94, 0xf6, 0x17, 0x0a, 0x94, 0xe8, 0x70,
-	0xce, 0xed, 0xc7, 0x11, 0x6e, 0xdf, 0x8e, 0xe5, 0x56, 0xc2, 0xdf, 0xbb, 0x28, 0xdb, 0xdb, 0xdf,
-	0xe7, 0xfe, 0x85, 0x20, 0x73, 0x62, 0x78, 0x27, 0xdc, 0xae, 0xf4, 0x37, 0xba, 0x0d, 0x45, 0x36,
-	0x55, 0x28, 0x47, 0x81, 0x01, 0x1a, 0xa6, 0xf6, 0x13, 0x05, 0x36, 0xaa, 0x2e, 0x36, 0x7c, 0x2c,
-	0x64, 0xbe, 0x88, 0x8b, 0x2e, 0x50, 0x14, 0x59, 0x31, 0x92, 0xa2, 0x48, 0x33, 0xaa, 0xc1, 0x4c,
-	0x44, 0x83, 0x43, 0xd8, 0x14, 0xec, 0x70, 0x1d, 0xde, 0x8b, 0xe8, 0xf0, 0x56, 0x2c, 0x37, 0x17,
-	0x36, 0x37, 0x11, 0xff, 0x68, 0x6c, 0xfe, 0x3a, 0x89, 0x2f, 0xd8, 0x79, 0x1d, 0xe2, 0x1f, 0x42,
-	0x49, 0xc7, 0x86, 0x29, 0x64, 0xdf, 0x84, 0x54, 0x10, 0x13, 0x52, 0x96, 0xb9, 0xb2, 0xfb, 0xbf,
-	0x80, 0x75, 0x46, 0xee, 0x75, 0xf0, 0xde, 0x82, 0x8d, 0x1a, 0xb6, 0x71, 0x68, 0xb9, 0x57, 0xe5,
-	0xfe, 0x33, 0xd8, 0x14, 0x04, 0x39, 0xff, 0xab, 0x31, 0xf4, 0x35, 0x94, 0xaa, 0xce, 0x78, 0x2a,
-	0xd8, 0x89, 0x2c, 0x3b, 0x25, 0xba, 0xec, 0x2e, 0xa2, 0x59, 0x46, 0xfb, 0x75, 0x68, 0xf6, 0x3f,
-	0x14, 0x50, 0xbf, 0x24, 0x32, 0x58, 0xce, 0xc8, 0xbb, 0x0c, 0x71, 0xc2, 0x84, 0x92, 0x89, 0x4f,
-	0x28, 0xd9, 0x73, 0x12, 0x4a, 0x6e, 0xb9, 0x84, 0x92, 0x8f, 0x4b, 0x28, 0xbf, 0x4c, 0xc1, 0x96,
-	0x24, 0x1d, 0x57, 0xe8, 0x7e, 0x44, 0xa1, 0x7b, 0xb1, 0x0a, 0x9d, 0x1b, 0xf5, 0x2a, 0x99, 0x66,
-	0xfb, 0x9f, 0x94, 0x30, 0xad, 0x7f, 0x02, 0xc5, 0x97, 0x82, 0x38, 0x4f, 0xec, 0x6f, 0x2c, 0x64,
-	0x41, 0x0f, 0xf1, 0xd1, 0x67, 0x90, 0x3f, 0xc3, 0x76, 0xdf, 0x19, 0xb2, 0x99, 0x4b, 0x0f, 0x7e,
-	0x27, 0xde, 0x1d, 0xf0, 0xb1, 0x31, 0xb1, 0xfd, 0x80, 0x82, 0x18, 0x84, 0xbe, 0x80, 0x82, 0x7f,
-	0x62, 0x8c, 0x5e, 0x4c, 0x9d, 0x09, 0xe5, 0x71, 0x59, 0x02, 0xc1, 0x28, 0xed, 0x0f, 0x15, 0x40,
-	0x01, 0x18, 0x1f, 0x2f, 0xe5, 0x32, 0x77, 0x60, 0x5d, 0x88, 0xd0, 0x75, 0xf1, 0x31, 0x77, 0x9c,
-	0xd2, 0xcb, 0x90, 0x8c, 0xe4, 0x55, 0xe9, 0x04, 0xaf, 0x8a, 0x06, 0xcf, 0x7f, 0x56, 0xe0, 0x6a,
-	0x84, 0x0d, 0x6e, 0xdb, 0x4a, 0xc4, 0xb6, 0xf7, 0x16, 0x2b, 0x36, 0x1c, 0x77, 0xf1, 0x6c, 0x5c,
-	0xe1, 0xd9, 0xf8, 0x63, 0x28, 0x08, 0x79, 0xf8, 0xe4, 0xe7, 0x58, 0x35, 0x40, 0xd7, 0x7e, 0xa6,
-	0xc0, 0x75, 0x96, 0x08, 0x43, 0xce, 0x96, 0xd0, 0xaa, 0x3c, 0x63, 0x6a, 0xa5, 0x19, 0x57, 0xd6,
-	0xf6, 0xbf, 0x2a, 0x70, 0x63, 0x96, 0x43, 0xae, 0xf0, 0x5a, 0x44, 0xe1, 0xef, 0xc5, 0x72, 0x10,
-	0x3f, 0xf4, 0xff, 0x55, 0xe7, 0xff, 0xae, 0x80, 0xba, 0x3f, 0xa5, 0x8c, 0x39, 0xae, 0x50, 0xb7,
-	0x94, 0xc3, 0x95, 0x48, 0x0e, 0xff, 0x4d, 0x8a, 0x79, 0x1e, 0x6c, 0x49, 0x82, 0xbd, 0xa6, 0x52,
-	0xfa, 0x6b, 0x28, 0x1d, 0x58, 0xa3, 0x17, 0x97, 0x92, 0x0f, 0x9f, 0xc1, 0x3a, 0xa3, 0xcd, 0x65,
-	0xb9, 0x05, 0x85, 0x89, 0x6b, 0x77, 0x6d, 0x6b, 0xf4, 0x82, 0xd3, 0xce, 0x4f, 0x5c, 0x9b, 0xa0,
-	0xac, 0xc8, 0xf4, 0xdf, 0x2a, 0xa0, 0x76, 0xf0, 0x70, 0x6c, 0x1b, 0x3e, 0xf6, 0x7e, 0x13, 0xf7,
-	0x4b, 0x1e, 0x6c, 0x49, 0xfc, 0xbf, 0x26, 0x53, 0xff, 0x22, 0x0d, 0x1b, 0x8f, 0x2c, 0xdb, 0xc7,
-	0xc1, 0xb2, 0xf9, 0x18, 0x72, 0x64, 0x03, 0x3c, 0x61, 0xe9, 0x6c, 0xf3, 0xc1, 0x9d, 0x05, 0x65,
-	0x74, 0x9b, 0x22, 0xea, 0x7c, 0x00, 0x99, 0xda, 0x37, 0x06, 0x5e, 0x39, 0xb5, 0x93, 0x26, 0x7b,
-	0x18, 0xf2, 0x1b, 0xd5, 0x61, 0xdd, 0xc5, 0x23, 0x13, 0xbb, 0x1d, 0xc3, 0x1d, 0x60, 0xa2, 0xd8,
-	0x64, 0xa2, 0xba, 0x84, 0xa8, 0x47, 0x86, 0xa1, 0xcf, 0x01, 0x4e, 0x2d, 0xcf, 0xea, 0x59, 0xb6,
-	0xe5, 0x4f, 0xcb, 0x19, 0x4a, 0xe4, 0xcd, 0x19, 0x22, 0x6c, 0xdb, 0xfe, 0x55, 0x80, 0xa6, 0x4b,
-	0x43, 0xd0, 0x1b, 0x00, 0x7d, 0x1a, 0xb8, 0xcc, 0x6e, 0x6f, 0x5a, 0xce, 0x52, 0x0e, 0x8b, 0x1c,
-	0xb2, 0x2f, 0xef, 0x03, 0x72, 0x09, 0x8e, 0x92, 0x8f, 0x77, 0x94, 0x42, 0xbc, 0xa3, 0x14, 0xcf,
-	0x71, 0x14, 0x58, 0xce, 0x51, 0x4a, 0x71, 0x8e, 0x32, 0x86, 0x4d, 0x61, 0xb2, 0xd7, 0xe4, 0x25,
-	0x7f, 0x9c, 0x82, 0x8d, 0x36, 0x36, 0xdc, 0xfe, 0x89, 0xf0, 0x12, 0x04, 0x99, 0x91, 0x31, 0xc4,
-	0x62, 0xbb, 0x4a, 0x7e, 0x5f, 0x72, 0x5c, 0xdd, 0x81, 0x92, 0x89, 0xbd, 0xbe, 0x6b, 0x8d, 0xa5,
-	0x25, 0x24, 0x83, 0x48, 0x9c, 0xa2, 0x01, 0x9f, 0x32, 0xc6, 0xcc, 0x55, 0x20, 0x80, 0x26, 0x61,
-	0x6e, 0xde, 0x04, 0x85, 0xe5, 0x4c, 0x50, 0x4c, 0x30, 0x81, 0xd0, 0xc7, 0x6b, 0x32, 0xc1, 0xdf,
-	0x29, 0x80, 0xda, 0x27, 0x86, 0x8b, 0xf9, 0x06, 0x96, 0xdb, 0xe1, 0x62, 0xc7, 0x4a, 0xe8, 0x1e,
-	0x64, 0x89, 0x66, 0xd8, 0x52, 0x2d, 0x3d, 0xb8, 0x19, 0x1d, 0x44, 0xcf, 0xca, 0x8e, 0x3c, 0xec,
-	0xea, 0x0c, 0x2b, 0x79, 0x3b, 0x1c, 0x9a, 0x3c, 0x93, 0x60, 0xf2, 0x6c, 0x24, 0xfa, 0x57, 0xe1,
-	0x6a, 0x44, 0x88, 0x99, 0xed, 0x9a, 0x12, 0xaf, 0x8a, 0x54, 0x54, 0x15, 0xef, 0xc3, 0xad, 0xca,
-	0xc4, 0x77, 0xfa, 0xce, 0x70, 0x4c, 0x36, 0x7d, 0x51, 0xc7, 0xbc, 0x06, 0x59, 0xdf, 0xf2, 0x6d,
-	0xe1, 0x99, 0xac, 0xa1, 0xfd, 0xa7, 0x02, 0xdb, 0x71, 0x63, 0xf8, 0xfc, 0x4f, 0x22, 0xc6, 0xfb,
-	0x30, 0xde, 0x78, 0x89, 0xc3, 0x2f, 0x5e, 0xfa, 0x1c, 0xf2, 0xd2, 0xa7, 0x0e, 0x05, 0x97, 0x13,
-	0xe3, 0x66, 0xfc, 0x5e, 0x6c, 0x6c, 0x93, 0x79, 0x10, 0xb3, 0xeb, 0xc1, 0x50, 0xed, 0x5f, 0xf2,
-	0x90, 0x63, 0x9a, 0x5d, 0x76, 0x4b, 0x1d, 0x68, 0x2b, 0x2d, 0x69, 0x6b, 0x76, 0xa9, 0x65, 0xe6,
-	0x97, 0x9a, 0x88, 0xf4, 0x59, 0x29, 0xd2, 0x97, 0x21, 0xcf, 0xe3, 0x29, 0x5d, 0x9c, 0x69, 0x5d,
-	0x34, 0x49, 0xcf, 0x84, 0x9e, 0x8d, 0xb0, 0x28, 0x9a, 0xd6, 0x45, 0x13, 0xbd, 0xcf, 0xc7, 0x38,
-	0x6c, 0x39, 0x2e, 0xf0, 0x44, 0x81, 0x87, 0xee, 0x43, 0xce, 0x23, 0x2e, 0xe4, 0x95, 0x8b, 0x8b,
-	0x7d, 0x97, 0xa3, 0xa1, 0x4f, 0x20, 0xcf, 0x52, 0x89, 0x57, 0x86, 0x65, 0x93, 0x8f, 0x18, 0x81,
-	0x7e, 0x17, 0xf2, 0x1e, 0xf6, 0x7d, 0x6b, 0x34, 0xa0, 0xb1, 0x78, 0x7e, 0x65, 0x33, 0xc3, 0xb4,
-	0x19, 0x8e, 0x2e, 0x90, 0xe5, 0xad, 0xdd, 0xfa, 0xab, 0x6e, 0xed, 0x36, 0x2e, 0xb2, 0xb5, 0x8b,
-	0xee, 0x4c, 0x37, 0x57, 0xdc, 0x99, 0xfe, 0x00, 0x72, 0x26, 0xf6, 0xac, 0xc1, 0xa8, 0x7c, 0x85,
-	0x4e, 0xae, 0x2d, 0x88, 0x2a, 0x7b, 0x35, 0x8a, 0xa9, 0xf3, 0x11, 0x52, 0x01, 0xa1, 0xee, 0x28,
-	0xab, 0x15, 0x10, 0xdf, 0x06, 0xb0, 0x3c, 0x51, 0x04, 0x95, 0xb7, 0x76, 0x94, 0xbb, 0x05, 0x5d,
-	0x82, 0x90, 0x7e, 0x9f, 0xff, 0x6e, 0x98, 0x65, 0x44, 0xfd, 0x52, 0x82, 0x10, 0x47, 0xf3, 0x26,
-	0xc3, 0xa1, 0xe1, 0x4e, 0xcb, 0x57, 0xd9, 0x4a, 0xe3, 0xcd, 0xed, 0x5f, 0x28, 0x90, 0x63, 0x7c,
-	0xa2, 0x36, 0xa0, 0xb1, 0xeb, 0x0c, 0x5c, 0xec, 0x79, 0xdd, 0x9e, 0xe1, 0x76, 0x3d, 0x7f, 0xca,
-	0xc3, 0xc5, 0x66, 0xc2, 0x41, 0xef, 0x53, 0x8e, 0xbe, 0x6f, 0xb8, 0x6d, 0x82, 0xac, 0xab, 0xe3,
-	0x19, 0x08, 0xba, 0x0b, 0xaa, 0xc9, 0x4c, 0xd1, 0xed, 0x0d, 0xba, 0x7d, 0xc7, 0x76, 0x5c, 0xbe,
-	0xd2, 0x36, 0x39, 0x7c, 0x7f, 0x50, 0x25, 0x50, 0x19, 0xd3, 0x76, 0x06, 0x4e, 0x77, 0xe2, 0xda,
-	0x7c, 0xf5, 0x09, 0xcc, 0x03, 0x67, 0xe0, 0x1c, 0xb9, 0xb6, 0xf6, 0xd7, 0x29, 0x28, 0x70, 0xc7,
-	0xa2, 0xaa, 0xf1, 0x4e, 0x9c, 0xb3, 0xfd, 0x89, 0xef, 0xf3, 0xfd, 0x51, 0x41, 0x97, 0x20, 0xa4,
-	0xbf, 0x47, 0x7f, 0x75, 0xf0, 0x37, 0x3e, 0x9f, 0x5a, 0x82, 0xa0, 0x0f, 0xe1, 0x86, 0xe7, 0xf4,
-	0x2d, 0xc3, 0xee, 0x92, 0x65, 0x61, 0x8d, 0x06, 0x5d, 0x3c, 0x32, 0x7a, 0x36, 0x66, 0x11, 0xbd,
-	0xa0, 0x5f, 0x63, 0xbd, 0x6d, 0xd6, 0x59, 0x67, 0x7d, 0xe8, 0x7b, 0xa0, 0xba, 0x98, 0xe5, 0xc2,
-	0x00, 0x3f, 0x43, 0xf1, 0xaf, 0x08, 0xb8, 0x40, 0xbd, 0x43, 0x0a, 0x3d, 0x8e, 0x4a, 0x64, 0x62,
-	0x81, 0xbf, 0x24, 0x60, 0x47, 0xae, 0x8d, 0xbe, 0x80, 0x92, 0x37, 0xe9, 0x0d, 0x2d, 0xbf, 0x3b,
-	0x24, 0x31, 0x32, 0x47, 0x55, 0xfe, 0x66, 0x82, 0x7b, 0x10, 0xbc, 0x43, 0xc7, 0xc4, 0x3a, 0x78,
-	0xc1, 0x6f, 0xb4, 0x0b, 0x2a, 0x91, 0xb9, 0x63, 0x0d, 0x71, 0xc7, 0xa9, 0x8c, 0xbc, 0x33, 0xec,
-	0xd2, 0x90, 0x52, 0xd0, 0xe7, 0xe0, 0xda, 0x67, 0x81, 0xc5, 0x6f, 0x41, 0x21, 0x30, 0x0a, 0xdf,
-	0x63, 0xf4, 0xb8, 0x35, 0x6e, 0x41, 0x21, 0xb0, 0x02, 0x4f, 0x33, 0x36, 0x57, 0xff, 0x3f, 0xa6,
-	0xe0, 0xca, 0xcc, 0xf2, 0x42, 0x0f, 0x21, 0xe3, 0x4f, 0xc7, 0xc2, 0x5b, 0xee, 0x2c, 0x5c, 0x4f,
-	0x9d, 0xe9, 0x18, 0xeb, 0x14, 0x3d, 0x0c, 0xb3, 0xa9, 0x05, 0x61, 0x36, 0x3d, 0x1f, 0x66, 0xaf,
-	0x41, 0xd6, 0x71, 0x4d, 0xec, 0x8a, 0x0a, 0x89, 0x36, 0xd0, 0x07, 0xc1, 0xe2, 0xcc, 0x2e, 0x38,
-	0xe8, 0x9e, 0x5b, 0x95, 0x05, 0x1e, 0x9b, 0x3c, 0xaa, 0xf8, 0xa4, 0x68, 0x20, 0x1c, 0x4e, 0x0f,
-	0xd0, 0x49, 0xed, 0x6d, 0xf8, 0xbe, 0xd1, 0x3f, 0x19, 0xe2, 0x91, 0x5f, 0xce, 0xd3, 0x50, 0x12,
-	0x6f, 0xb5, 0x4a, 0x80, 0xa6, 0x4b, 0x43, 0xb4, 0x3f, 0x52, 0x00, 0xc2, 0x2e, 0xf4, 0xfd, 0x88,
-	0x12, 0xdf, 0x3a, 0x87, 0x92, 0xa4, 0x46, 0x15, 0xd2, 0xa1, 0x9d, 0xc8, 0x4f, 0xa2, 0xa0, 0x33,
-	0xcb, 0xf4, 0x4f, 0xc4, 0x7e, 0x8d, 0x36, 0x48, 0x09, 0x79, 0x82, 0xad, 0xc1, 0x49, 0xb0, 0x5f,
-	0x63, 0x2d, 0xed, 0x7f, 0x53, 0x50, 0x08, 0x4c, 0x39, 0x9b, 0x21, 0x85, 0x69, 0x53, 0x2b, 0x9b,
-	0x96, 0x99, 0x28, 0x2d, 0x9b, 0x28, 0x30, 0x78, 0x66, 0x81, 0xc1, 0xb3, 0xf3, 0x06, 0xff, 0x34,
-	0x30, 0x6d, 0x6e, 0x41, 0xd0, 0x17, 0x6c, 0xcc, 0xda, 0xf8, 0x5d, 0xc8, 0x1d, 0x5b, 0xd8, 0x36,
-	0x3d, 0xba, 0x26, 0x4a, 0x0f, 0xae, 0xed, 0xb1, 0xbb, 0xd2, 0x3d, 0x71, 0x57, 0xba, 0x57, 0x19,
-	0x4d, 0x75, 0x8e, 0x83, 0xde, 0x93, 0x3c, 0xa2, 0xb0, 0x00, 0x3f, 0xc0, 0xda, 0x7e, 0xd5, 0x15,
-	0xb5, 0x0e, 0xf0, 0x95, 0x61, 0x5b, 0xa6, 0x41, 0x6b, 0xe8, 0x3f, 0x49, 0x91, 0x0d, 0xef, 0x37,
-	0xc1, 0xe2, 0x7a, 0x44, 0xd8, 0x42, 0x5f, 0x52, 0x67, 0x73, 0xad, 0xde, 0xc4, 0xc7, 0x1e, 0x2f,
-	0xc8, 0xde, 0x8f, 0xd5, 0xc2, 0xdc, 0x58, 0xe2, 0x34, 0x7c, 0xa0, 0x2e, 0x11, 0x41, 0x15, 0x28,
-	0x9d, 0x06, 0xd3, 0x8a, 0x92, 0x37, 0xde, 0x81, 0x43, 0xf6, 0x74, 0x79, 0xcc, 0xcc, 0x12, 0x60,
-	0x67, 0xad, 0xab, 0x2c, 0x81, 0xed, 0x3d, 0xba, 0x02, 0x04, 0x47, 0x33, 0x8e, 0xa0, 0xcc, 0x39,
-	0x82, 0xf6, 0x4b, 0x05, 0xae, 0xc9, 0x02, 0x06, 0x79, 0xe0, 0x1e, 0xa0, 0xa1, 0xf1, 0x8d, 0x35,
-	0x9c, 0x0c, 0xbb, 0xfd, 0x13, 0xc3, 0x35, 0xfa, 0x3e, 0x29, 0x6c, 0x58, 0xe1, 0xbc, 0xc5, 0x7b,
-	0xaa, 0x41, 0x07, 0x7a, 0x0b, 0x36, 0x86, 0x13, 0xdb, 0xb7, 0xc6, 0x36, 0xee, 0xba, 0xce, 0x99,
-	0x47, 0x4d, 0x52, 0xd0, 0xd7, 0x05, 0x50, 0x77, 0xce, 0x3c, 0xf4, 0x2d, 0x28, 0x0e, 0x8d, 0x91,
-	0x49, 0xca, 0xab, 0x29, 0x4f, 0x07, 0x21, 0x80, 0xf8, 0xb2, 0x35, 0x24, 0xc5, 0x2b, 0x0b, 0xfc,
-	0xac, 0x41, 0xa0, 0xa7, 0x96, 0x89, 0x1d, 0xea, 0xc5, 0x05, 0x9d, 0x35, 0xd0, 0x36, 0x14, 0x4e,
-	0x0d, 0xd7, 0x22, 0x19, 0x81, 0x7a, 0x70, 0x41, 0x0f, 0xda, 0xda, 0x9f, 0xa7, 0xe0, 0xf6, 0x21,
-	0x9f, 0xb6, 0x7a, 0xe2, 0x58, 0x7d, 0x1c, 0xb5, 0xfc, 0x43, 0xc8, 0xf7, 0x29, 0x78, 0xf1, 0x56,
-	0x86, 0x0d, 0xd5, 0x05, 0x2e, 0xfa, 0xbd, 0x88, 0xc3, 0xb0, 0xa3, 0xd3, 0x4f, 0x62, 0x47, 0x2e,
-	0x98, 0x7c, 0x49, 0xd7, 0x49, 0xaf, 0xee, 0x3a, 0x2b, 0x5b, 0xfe, 0xef, 0x15, 0xf8, 0x76, 0x3c,
-	0xa7, 0x81, 0x0f, 0x44, 0xec, 0xa5, 0xcc, 0xda, 0x8b, 0x78, 0x88, 0x30, 0xb9, 0x87, 0x6d, 0xbe,
-	0x8d, 0x65, 0x76, 0xdf, 0x12, 0x3d, 0x6d, 0xd1, 0x81, 0xde, 0x60, 0x85, 0x45, 0xd7, 0xf1, 0x4f,
-	0x78, 0x14, 0x2b, 0xe8, 0x45, 0x02, 0x69, 0x11, 0x00, 0x29, 0xa9, 0xc6, 0x56, 0xdf, 0x9f, 0xb8,
-	0xc2, 0xfe, 0xa2, 0x49, 0xb8, 0x70, 0x8d, 0x91, 0xe9, 0x0c, 0x2d, 0x0f, 0x73, 0x2f, 0x08, 0x01,
-	0xda, 0x87, 0x90, 0x63, 0xdc, 0xd3, 0x6d, 0xbe, 0xd1, 0xc3, 0xb6, 0xd8, 0x91, 0xd1, 0x46, 0xe8,
-	0x55, 0x3c, 0x25, 0xd2, 0x86, 0xf6, 0x6f, 0x29, 0xb8, 0x56, 0x75, 0x46, 0xbe, 0xd1, 0x9f, 0x09,
-	0x0b, 0x9d, 0x98, 0xb0, 0x10, 0xbf, 0x4f, 0x8b, 0x1b, 0x7e, 0x79, 0x91, 0x61, 0xfb, 0x67, 0x4a,
-	0xc4, 0xbe, 0x6f, 0x00, 0x1c, 0x5b, 0xae, 0xe7, 0x77, 0xa5, 0xd3, 0x91, 0x22, 0x85, 0xd0, 0x53,
-	0x88, 0xdb, 0x50, 0xb4, 0x0d, 0xd1, 0xcb, 0x6f, 0xf4, 0x09, 0x40, 0x1c, 0x51, 0xf4, 0x19, 0xf3,
-	0xdd, 0xd1, 0x64, 0xd8, 0xe3, 0xd6, 0x28, 0xea, 0x1b, 0x1c, 0xda, 0xa4, 0x40, 0xa2, 0x39, 0x3c,
-	0x34, 0x2c, 0x5b, 0xe4, 0x16, 0xda, 0x20, 0x76, 0x32, 0x4c, 0x93, 0xd4, 0xa4, 0x3c, 0xaf, 0x88,
-	0xa6, 0xf6, 0x5f, 0x0a, 0xdc, 0x9c, 0x51, 0xca, 0x92, 0x9e, 0x14, 0x15, 0x86, 0x79, 0x50, 0x92,
-	0x30, 0xcc, 0x71, 0x16, 0x09, 0xc3, 0xdc, 0x27, 0x49, 0x18, 0x1e, 0x46, 0xe6, 0x84, 0x61, 0x51,
-	0x44, 0x34, 0x49, 0x80, 0x71, 0xf1, 0xcb, 0x89, 0xe5, 0xd2, 0xbd, 0x24, 0xd9, 0x7c, 0x06, 0x6d,
-	0xed, 0x7f, 0x14, 0x40, 0x07, 0x78, 0x60, 0xd8, 0x51, 0xd7, 0xd1, 0x63, 0x5c, 0xe7, 0x41, 0xac,
-	0x8d, 0xe7, 0x07, 0x5f, 0xa2, 0xe3, 0x3c, 0x59, 0x2d, 0x2e, 0xd0, 0xed, 0xb5, 0x33, 0xf2, 0x49,
-	0xfe, 0xe1, 0x69, 0x95, 0x37, 0xb5, 0x9f, 0x2a, 0x70, 0x3d, 0xc2, 0x7a, 0x5b, 0xaa, 0xdc, 0xf8,
-	0x16, 0x20, 0xac, 0xb7, 0xe2, 0xb9, 0xdc, 0x0f, 0xd0, 0x74, 0x69, 0xc8, 0x2c, 0x5b, 0xcc, 0x05,
-	0x22, 0x6c, 0x2d, 0xcc, 0x1d, 0xda, 0x5f, 0xa6, 0xe0, 0x7a, 0xcd, 0x75, 0xc6, 0xa6, 0x73, 0x36,
-	0x8a, 0x5a, 0xe5, 0x28, 0xc6, 0x2a, 0x0f, 0xe3, 0x0b, 0xd9, 0xb8, 0xf1, 0x97, 0x98, 0xeb, 0xa5,
-	0x3c, 0x94, 0x5e, 0x3e, 0x0f, 0x5d, 0xc4, 0x9e, 0xce, 0x38, 0xe4, 0xb2, 0xa8, 0x8b, 0xa6, 0xf6,
-	0x2b, 0x05, 0xca, 0xb3, 0x42, 0x2f, 0xbf, 0x62, 0xa5, 0x60, 0x9e, 0x9a, 0x0d, 0xe6, 0x91, 0x90,
-	0x9d, 0x9e, 0x09, 0xd9, 0x24, 0x71, 0x18, 0xf6, 0xf8, 0xc4, 0xe8, 0x61, 0xdf, 0xea, 0x1b, 0x76,
-	0x37, 0xdc, 0x7a, 0x14, 0xf4, 0x2d, 0xb9, 0xa7, 0x45, 0x6b, 0xdc, 0xf8, 0x3c, 0x93, 0x4d, 0xc8,
-	0x33, 0xb4, 0xdc, 0x7b, 0x64, 0xd9, 0xf8, 0xa2, 0xe5, 0xde, 0xdc, 0xd8, 0xdf, 0xa6, 0x72, 0xef,
-	0x0f, 0xe0, 0x9a, 0x2c, 0xdf, 0x92, 0xd6, 0x7e, 0x02, 0xaa, 0x61, 0xdb, 0xce, 0x19, 0x36, 0xbb,
-	0xc7, 0x96, 0x8d, 0xf9, 0xf6, 0x25, 0x7d, 0x77, 0x33, 0x61, 0x6f, 0x47, 0xa6, 0xa0, 0x4b, 0xfc,
-	0x0a, 0x1f, 0xf6, 0x88, 0x8f, 0xd2, 0x7e, 0x9a, 0x82, 0xab, 0xfb, 0xd6, 0xc8, 0x70, 0xa7, 0x51,
-	0xf3, 0xb4, 0x63, 0xcc, 0xf3, 0x41, 0x7c, 0x00, 0x99, 0x1f, 0xfd, 0xeb, 0x6c, 0xa0, 0xbb, 0x11,
-	0x03, 0x6d, 0x43, 0x81, 0x59, 0xa3, 0x27, 0x72, 0x76, 0xd0, 0xd6, 0xfe, 0x4c, 0x81, 0x1b, 0x51,
-	0xe1, 0x96, 0xb4, 0x4e, 0xa4, 0xc2, 0x99, 0xaf, 0x9b, 0xd3, 0x72, 0xdd, 0x1c, 0x0d, 0xd4, 0x99,
-	0x95, 0x03, 0xb5, 0xf6, 0x93, 0x34, 0x94, 0x75, 0x63, 0x34, 0xe0, 0x0b, 0x2e, 0x6a, 0xc5, 0x1f,
-	0xc7, 0x58, 0xf1, 0xa3, 0xf8, 0x43, 0xd0, 0x04, 0x12, 0x97, 0x98, 0x07, 0x7f, 0xa5, 0xac, 0x18,
-	0x38, 0x9f, 0x41, 0x8e, 0x96, 0x90, 0xa2, 0xd8, 0xff, 0xfc, 0xa2, 0x92, 0xec, 0x1d, 0x50, 0x32,
-	0x3a, 0x27, 0xb7, 0xfd, 0x43, 0xc8, 0x31, 0x08, 0x42, 0x90, 0xb1, 0xf1, 0xb1, 0x2f, 0x6e, 0xb7,
-	0xc8, 0x6f, 0x74, 0x03, 0x72, 0x7d, 0x3c, 0xf2, 0xb1, 0x38, 0xd7, 0xe3, 0x2d, 0x62, 0x50, 0x97,
-	0x9e, 0x35, 0xf0, 0x23, 0x74, 0xda, 0xd0, 0xfe, 0x5b, 0x81, 0xdb, 0x31, 0x2c, 0x2c, 0xef, 0x3a,
-	0x9e, 0x8f, 0xc7, 0x1e, 0xbf, 0x46, 0x60, 0x0d, 0xf4, 0x90, 0x40, 0xa7, 0xfc, 0xb0, 0x3e, 0xc9,
-	0x3f, 0xd8, 0xa4, 0xf4, 0x94, 0x92, 0x61, 0xa3, 0x4f, 0xe1, 0x36, 0xcb, 0x09, 0x23, 0x7b, 0xda,
-	0x25, 0xa2, 0x74, 0x29, 0x8b, 0x5d, 0xae, 0x44, 0x16, 0xdf, 0x6f, 0xd2, 0x24, 0x31, 0xb2, 0xa7,
-	0x07, 0xf8, 0xd8, 0xd7, 0x49, 0x3f, 0x57, 0xc5, 0x9b, 0x50, 0xa2, 0xa3, 0x87, 0x96, 0x69, 0xda,
-	0xa2, 0xce, 0xa7, 0x49, 0xe6, 0x90, 0x42, 0xb4, 0xbf, 0x49, 0xc1, 0x15, 0xe9, 0x4e, 0xe7, 0xc8,
-	0xc3, 0xee, 0xdc, 0xd9, 0x4a, 0xf8, 0x34, 0x33, 0xb5, 0xfc, 0xd3, 0xcc, 0x77, 0x20, 0x33, 0xf1,
-	0x78, 0x11, 0xbc, 0xe0, 0x98, 0x9f, 0x22, 0xa1, 0x8f, 0x82, 0x43, 0x67, 0xb6, 0x78, 0x76, 0xe2,
-	0x8f, 0xe9, 0x29, 0x9f, 0xd1, 0x33, 0x67, 0xe9, 0x72, 0x22, 0x1b, 0xbd, 0x9c, 0xf8, 0x10, 0x8a,
-	0xf4, 0x0a, 0x81, 0xde, 0x18, 0xe7, 0x16, 0x73, 0x51, 0x60, 0x98, 0xfb, 0xd4, 0x76, 0x7d, 0x67,
-	0x42, 0x0f, 0xca, 0xa8, 0xed, 0x68, 0x43, 0xbe, 0x1c, 0x29, 0x44, 0x2e, 0x47, 0xb4, 0x8f, 0x60,
-	0xeb, 0x31, 0xf6, 0x3b, 0xce, 0xb8, 0x63, 0x0c, 0xce, 0x7b, 0xb7, 0xb0, 0x0e, 0xca, 0x88, 0xfb,
-	0x84, 0x32, 0xd2, 0xfe, 0x54, 0x01, 0x24, 0x0f, 0xe5, 0x97, 0x59, 0x5f, 0x44, 0x2e, 0xb3, 0xde,
-	0x8d, 0x55, 0xf5, 0xfc, 0xb0, 0x8b, 0x5f, 0x62, 0x6d, 0x87, 0x2f, 0x98, 0xe9, 0x9d, 0x90, 0x12,
-	0xde, 0x09, 0x69, 0x35, 0xb8, 0x29, 0xdf, 0x59, 0x2d, 0x21, 0xa2, 0xb8, 0x58, 0x4e, 0x85, 0x17,
-	0xcb, 0xda, 0xcf, 0x15, 0x28, 0xcf, 0x93, 0xe1, 0xe2, 0x3e, 0x8a, 0x88, 0xfb, 0xe0, 0xdc, 0xbb,
-	0xbb, 0xcb, 0x17, 0xfa, 0x04, 0xca, 0xcf, 0x0c, 0x77, 0x38, 0x19, 0x57, 0x8d, 0xfe, 0xc9, 0xcc,
-	0x7d, 0xed, 0xfc, 0x35, 0xb4, 0xb2, 0xdc, 0x35, 0x74, 0x2a, 0xee, 0x1a, 0xfa, 0x36, 0xdc, 0x8a,
-	0x99, 0x89, 0xc9, 0xb6, 0xfb, 0x25, 0xa8, 0xb3, 0x17, 0x17, 0xe8, 0x16, 0x5c, 0x9f, 0x85, 0x75,
-	0x9b, 0xad, 0x66, 0x5d, 0x5d, 0x43, 0x05, 0xc8, 0x3c, 0x6a, 0x1c, 0x1c, 0xa8, 0x0a, 0x5a, 0x87,
-	0x42, 0xf3, 0xe8, 0x70, 0xbf, 0xae, 0xd7, 0x6b, 0x6a, 0x0a, 0x01, 0xe4, 0x6a, 0xad, 0x4e, 0xa7,
-	0x5e, 0x53, 0xd3, 0xbb, 0x3f, 0x57, 0x60, 0x5d, 0x3e, 0x03, 0x45, 0xd7, 0xc3, 0x17, 0x99, 0xa4,
-	0x2d, 0x68, 0x95, 0x20, 0xff, 0xac, 0x7e, 0x50, 0x6d, 0x1d, 0xd6, 0x19, 0xb9, 0xf6, 0x93, 0x96,
-	0xde, 0xa9, 0xff, 0xb8, 0xa3, 0xa6, 0xd0, 0x55, 0xb8, 0x72, 0x78, 0x74, 0xd0, 0x69, 0x3c, 0x3d,
-	0xa8, 0x77, 0xab, 0x4f, 0x5a, 0x8d, 0x6a, 0x5d, 0x4d, 0x13, 0xfc, 0x6a, 0xab, 0xd9, 0xa9, 0x54,
-	0x3b, 0x6a, 0x06, 0x15, 0x21, 0x7b, 0x50, 0x7f, 0x5c, 0x39, 0x50, 0xb3, 0x64, 0x68, 0x4d, 0x6f,
-	0x3d, 0xad, 0xb5, 0x9e, 0x35, 0xd5, 0x1c, 0xe7, 0xb0, 0xae, 0xe6, 0x09, 0x4f, 0xfb, 0x8d, 0x66,
-	0x45, 0x7f, 0xae, 0x16, 0xc8, 0x6f, 0xbd, 0xd2, 0x69, 0x34, 0x1f, 0xab, 0x45, 0xb4, 0x01, 0xc5,
-	0xce, 0x93, 0x4a, 0xf3, 0x47, 0xdd, 0xe7, 0xad, 0x23, 0x15, 0x76, 0x3f, 0x87, 0xcd, 0xe8, 0x39,
-	0x32, 0xba, 0x09, 0x57, 0xa3, 0x10, 0xc1, 0x71, 0x11, 0xb2, 0x8d, 0xc3, 0xca, 0x63, 0xc2, 0x6f,
-	0x11, 0xb2, 0x5f, 0x35, 0x6a, 0xf5, 0x96, 0x9a, 0xda, 0xfd, 0x01, 0xac, 0xcb, 0xd7, 0x82, 0x44,
-	0x5c, 0xb9, 0x2d, 0x06, 0xe7, 0x21, 0xfd, 0xac, 0xbe, 0xaf, 0x2a, 0x84, 0x97, 0xc3, 0xd6, 0x3e,
-	0xe1, 0x31, 0xb5, 0xdb, 0x04, 0x08, 0x2f, 0x31, 0x88, 0xd8, 0x61, 0x4b, 0x52, 0x93, 0x5e, 0xaf,
-	0x1f, 0xb4, 0x2a, 0x35, 0xa6, 0x26, 0xbd, 0x5e, 0x6b, 0xe8, 0xf5, 0x2a, 0x51, 0x93, 0x0a, 0xeb,
-	0xed, 0x27, 0xad, 0x67, 0xdd, 0xc3, 0x7a, 0xbb, 0x4d, 0xd8, 0x4a, 0xef, 0x9a, 0xb0, 0x2e, 0xdf,
-	0x99, 0x11, 0x5e, 0xe4, 0xb6, 0x24, 0x48, 0x4d, 0xaf, 0x3c, 0xea, 0xa8, 0x0a, 0xd5, 0xaa, 0x5e,
-	0xaf, 0x74, 0x84, 0x19, 0x2b, 0xd5, 0x4e, 0xe3, 0x2b, 0xa2, 0xee, 0x75, 0x28, 0x34, 0x9a, 0xbc,
-	0x95, 0x21, 0x68, 0xb5, 0xfa, 0x41, 0x9d, 0xa0, 0x65, 0x77, 0x5d, 0x80, 0xb0, 0xc2, 0x20, 0x5c,
-	0x87, 0x2d, 0x31, 0x03, 0x40, 0xee, 0x79, 0xbd, 0xdd, 0x6d, 0xb6, 0x54, 0x05, 0x21, 0xd8, 0xac,
-	0x54, 0xab, 0xf5, 0xa7, 0x9d, 0x6e, 0xad, 0x5e, 0x3d, 0x68, 0x34, 0xeb, 0x6a, 0x8a, 0xc2, 0x1e,
-	0xeb, 0xf5, 0x7a, 0xb7, 0xd6, 0x68, 0xd3, 0x1f, 0x6a, 0x9a, 0x8c, 0xe9, 0x3c, 0x39, 0x3a, 0xdc,
-	0x6f, 0xab, 0x19, 0x74, 0x05, 0x4a, 0x9d, 0x46, 0xf5, 0x47, 0xed, 0x6e, 0x55, 0x6f, 0xb5, 0xdb,
-	0x6a, 0x76, 0xf7, 0x6b, 0x28, 0x88, 0xca, 0x14, 0x6d, 0xd1, 0xe7, 0x48, 0x78, 0x66, 0x3e, 0x6a,
-	0x9a, 0x36, 0x53, 0x30, 0xb5, 0x4d, 0x5b, 0x4d, 0x11, 0xad, 0xd7, 0x5a, 0x55, 0x35, 0x4d, 0xfc,
-	0x82, 0x3a, 0x57, 0x86, 0x80, 0x9e, 0xd6, 0x1e, 0xa9, 0x59, 0xf2, 0xa3, 0xd2, 0x7c, 0xae, 0xe6,
-	0x76, 0xab, 0x00, 0x61, 0x46, 0x24, 0xf2, 0x84, 0x2d, 0x89, 0x3e, 0x73, 0x77, 0x55, 0x21, 0xa4,
-	0xda, 0x9d, 0x8a, 0xce, 0xf4, 0xd5, 0x3e, 0x6c, 0x1c, 0xd4, 0x9f, 0xab, 0xe9, 0x07, 0xff, 0x70,
-	0x05, 0x36, 0xb8, 0xae, 0xb1, 0x7b, 0x6a, 0xf5, 0x31, 0xfa, 0x21, 0xa4, 0x2b, 0xb6, 0x8d, 0x12,
-	0x4a, 0xce, 0xe0, 0x7b, 0x9d, 0xed, 0x9d, 0x64, 0x04, 0x7e, 0x67, 0xbf, 0x46, 0x68, 0x35, 0xf1,
-	0x59, 0x02, 0xad, 0xf0, 0x93, 0x96, 0x04, 0x5a, 0xd2, 0x47, 0x28, 0xda, 0x1a, 0x6a, 0x43, 0x8e,
-	0x3d, 0xcf, 0x44, 0xda, 0x82, 0xb7, 0x9b, 0x82, 0xe2, 0x5b, 0x0b, 0x71, 0x64, 0xa2, 0xec, 0xd3,
-	0x86, 0x04, 0xa2, 0x91, 0xcf, 0x30, 0x12, 0x88, 0x46, 0xbf, 0x8d, 0xd0, 0xd6, 0xd0, 0x21, 0x64,
-	0x74, 0x6c, 0x98, 0x68, 0x27, 0xe1, 0x32, 0x3e, 0xf8, 0xb6, 0x61, 0xfb, 0xce, 0x02, 0x0c, 0x99,
-	0x47, 0xf6, 0x09, 0x40, 0x02, 0x8f, 0x91, 0x0f, 0x0e, 0x12, 0x78, 0x8c, 0x7e, 0x43, 0xc0, 0x78,
-	0xac, 0x3a, 0xe3, 0x69, 0x02, 0x8f, 0xd2, 0x27, 0x03, 0x09, 0x3c, 0xca, 0x0f, 0xff, 0xb5, 0x35,
-	0xf4, 0xfb, 0x50, 0x0c, 0x1e, 0xa2, 0xa3, 0xb7, 0xcf, 0x7b, 0xa8, 0xce, 0x08, 0x7f, 0x67, 0xb9,
-	0xf7, 0xec, 0xda, 0x1a, 0xea, 0x41, 0x49, 0x7a, 0x0a, 0x8d, 0xbe, 0x7b, 0xfe, 0x63, 0x69, 0x36,
-	0xc3, 0xdd, 0x65, 0x5f, 0x55, 0x6b, 0x6b, 0xe8, 0x85, 0xf8, 0xc6, 0x27, 0xb8, 0x45, 0xdb, 0x5d,
-	0xea, 0x89, 0x30, 0x9b, 0xe9, 0x9d, 0x15, 0x9e, 0x13, 0x33, 0x75, 0x05, 0x4f, 0x5f, 0x13, 0xd4,
-	0x35, 0xfb, 0xe6, 0x37, 0x41, 0x5d, 0x73, 0x2f, 0x68, 0x99, 0x6d, 0xe9, 0x23, 0xd3, 0x78, 0xdb,
-	0x4a, 0xcf, 0x5f, 0x13, 0x6c, 0x2b, 0x3f, 0x62, 0x65, 0xcc, 0x06, 0x8f, 0x37, 0x13, 0x98, 0x9d,
-	0x7d, 0x9c, 0x9a, 0xc0, 0xec, 0xdc, 0x1b, 0x50, 0xe6, 0xdd, 0xec, 0xc5, 0x5f, 0x82, 0x77, 0x47,
-	0x5e, 0x70, 0x26, 0x78, 0x77, 0xf4, 0xc9, 0x20, 0x23, 0xca, 0xde, 0x31, 0x25, 0x10, 0x8d, 0xbc,
-	0xab, 0x4a, 0x20, 0x1a, 0x7d, 0x08, 0xc5, 0xbc, 0x50, 0xda, 0x0c, 0x24, 0x78, 0xe1, 0xfc, 0x3b,
-	0xb6, 0x04, 0x2f, 0x8c, 0x79, 0x2b, 0xa6, 0xad, 0xa1, 0x33, 0x40, 0xf3, 0x8f, 0xb1, 0xd0, 0xde,
-	0xd2, 0xaf, 0xb6, 0xd8, 0x8c, 0xf7, 0x57, 0x7c, 0xe5, 0xa5, 0xad, 0xa1, 0x2e, 0x40, 0x58, 0x38,
-	0xa3, 0xef, 0x9c, 0x5b, 0x59, 0xb3, 0x89, 0xbe, 0xbb, 0x64, 0x05, 0xae, 0xad, 0xa1, 0x97, 0xa0,
-	0xce, 0x96, 0xaa, 0xe8, 0xdd, 0x25, 0x2b, 0x5a, 0x36, 0xd9, 0xbd, 0x95, 0xea, 0x5f, 0x6d, 0x0d,
-	0xf9, 0xb0, 0x35, 0x57, 0x42, 0xa2, 0x78, 0x2a, 0x49, 0x45, 0xed, 0xf6, 0xde, 0xb2, 0xe8, 0xd2,
-	0xac, 0x57, 0x1f, 0x63, 0x9f, 0x9a, 0xd7, 0xe8, 0xd9, 0xbc, 0x7f, 0x4e, 0x56, 0xba, 0xbd, 0x9a,
-	0xc7, 0x4b, 0x90, 0x35, 0x11, 0x5b, 0xcc, 0xda, 0xcb, 0xd1, 0x7b, 0xed, 0x0f, 0xfe, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0x5e, 0xbd, 0xec, 0x3c, 0x85, 0x3c, 0x00, 0x00,
+	// 4131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x4d, 0x6f, 0x1b, 0x49,
+	0x76, 0x6e, 0x7e, 0xf3, 0x51, 0x92, 0x5b, 0x25, 0x7f, 0xd0, 0xf4, 0xce, 0x8c, 0xdc, 0x5a, 0xcf,
+	0x6a, 0x34, 0x36, 0x3d, 0xa3, 0xb1, 0x77, 0x66, 0x76, 0x06, 0x33, 0x43, 0x91, 0xb4, 0xcd, 0x5d,
+	0x49, 0xf4, 0x34, 0x29, 0x7b, 0xbd, 0x09, 0x40, 0xb4, 0xc8, 0x12, 0xd9, 0x70, 0x93, 0x4d, 0x77,
+	0x37, 0xa5, 0x51, 0x80, 0xcd, 0x25, 0xc9, 0x29, 0xd8, 0x3d, 0x2f, 0x72, 0xd9, 0x00, 0xc9, 0x29,
+	0x58, 0x20, 0xd8, 0x9c, 0x72, 0x08, 0x72, 0x49, 0x80, 0x24, 0x40, 0x80, 0x6c, 0xb0, 0x87, 0x3d,
+	0xe4, 0x18, 0x20, 0xf9, 0x05, 0x39, 0x06, 0x08, 0xea, 0xab, 0x59, 0x4d, 0x76, 0x53, 0xa4, 0x3c,
+	0x56, 0x76, 0xf6, 0x22, 0xb1, 0x5e, 0xbf, 0x57, 0xf5, 0xbe, 0xea, 0xbd, 0x7a, 0xf5, 0x01, 0xef,
+	0xba, 0xd8, 0x39, 0xc6, 0xce, 0x3d, 0x77, 0xe4, 0x1c, 0xe3, 0xd3, 0xbb, 0xae, 0x73, 0x7c, 0x6f,
+	0xe8, 0xd8, 0x9e, 0xcd, 0x01, 0xfc, 0x5f, 0x91, 0xc2, 0xd0, 0x5a, 0xd7, 0x2e, 0xf6, 0xcd, 0xb6,
+	0x63, 0x17, 0x5d, 0xe7, 0xb8, 0xc8, 0x3e, 0x15, 0x6e, 0x74, 0x6d, 0xbb, 0x6b, 0x61, 0x46, 0x76,
+	0x38, 0x3a, 0xba, 0x67, 0x0c, 0x38, 0x7e, 0xe1, 0x36, 0xef, 0x7c, 0xe4, 0x62, 0x47, 0xea, 0x9a,
+	0x34, 0xe9, 0x1f, 0x8e, 0xe6, 0xf3, 0xe0, 0x19, 0x9e, 0xd9, 0x96, 0x79, 0xa0, 0x00, 0xfe, 0x8f,
+	0x21, 0x6b, 0x9f, 0x40, 0xa2, 0x62, 0x78, 0x06, 0xfa, 0x00, 0x52, 0x8c, 0x81, 0xbc, 0xb2, 0xae,
+	0x6c, 0xe6, 0xb6, 0x6f, 0x16, 0x43, 0x98, 0x2b, 0x36, 0xe8, 0x3f, 0x9d, 0xa3, 0x6a, 0x5f, 0x40,
+	0xba, 0xe4, 0x38, 0x94, 0xfe, 0x01, 0xa4, 0x19, 0xd0, 0xcd, 0x2b, 0xeb, 0xf1, 0xb3, 0x3a, 0x10,
+	0xb8, 0xda, 0xdf, 0x2a, 0x00, 0x25, 0xcb, 0xd2, 0xf1, 0xcb, 0x11, 0x76, 0x3d, 0x74, 0x15, 0x52,
+	0xb6, 0xd3, 0x6d, 0x99, 0x1d, 0xca, 0x45, 0x56, 0x4f, 0xda, 0x4e, 0xb7, 0xd6, 0x41, 0xd7, 0x21,
+	0xed, 0x61, 0xa3, 0x4f, 0xe0, 0x31, 0x0a, 0x4f, 0x91, 0x66, 0xad, 0x83, 0xae, 0x40, 0xd2, 0x32,
+	0xfb, 0xa6, 0x97, 0x8f, 0xaf, 0x2b, 0x9b, 0x71, 0x9d, 0x35, 0xd0, 0x35, 0x48, 0xd9, 0x47, 0x47,
+	0x2e, 0xf6, 0xf2, 0x09, 0x0a, 0xe6, 0x2d, 0x74, 0x1b, 0x56, 0x5c, 0xdb, 0xf1, 0x5a, 0x43, 0xc3,
+	0x31, 0xfa, 0xd8, 0xc3, 0x4e, 0x3e, 0x49, 0x7b, 0x5b, 0x26, 0xd0, 0x27, 0x02, 0xe8, 0xa3, 0x75,
+	0x4c, 0x07, 0xb7, 0x3d, 0xd3, 0x1e, 0xe4, 0x53, 0x63, 0xb4, 0x8a, 0x00, 0x6a, 0x7d, 0xc8, 0x51,
+	0xce, 0xdd, 0xa1, 0x3d, 0x70, 0x31, 0x7a, 0x0f, 0x12, 0x1d, 0xc3, 0x33, 0xb8, 0xfa, 0xbe, 0x15,
+	0x2a, 0x3d, 0x57, 0x96, 0x4e, 0x31, 0x11, 0x82, 0x44, 0xdb, 0xee, 0x60, 0x2a, 0x52, 0x5c, 0xa7,
+	0xbf, 0x51, 0x1e, 0xd2, 0x7d, 0xec, 0xba, 0x46, 0x17, 0x53, 0x91, 0xb2, 0xba, 0x68, 0x6a, 0x9f,
+	0x02, 0xec, 0xe3, 0x93, 0x69, 0x45, 0xc5, 0x22, 0x14, 0x15, 0x97, 0x15, 0xa5, 0xfd, 0xb5, 0x02,
+	0x39, 0x4a, 0xce, 0xb9, 0xfd, 0x38, 0xc0, 0xed, 0xed, 0x50, 0x6e, 0x25, 0xfc, 0xe2, 0x79, 0xd9,
+	0x2e, 0x7c, 0xc8, 0xfd, 0x0b, 0x41, 0xa2, 0x67, 0xb8, 0x3d, 0x6e, 0x57, 0xfa, 0x1b, 0xdd, 0x84,
+	0x2c, 0x1b, 0x6a, 0x2c, 0x47, 0x86, 0x01, 0x6a, 0x1d, 0xed, 0x27, 0x0a, 0x2c, 0x97, 0x1d, 0x6c,
+	0x78, 0x58, 0xc8, 0x7c, 0x1e, 0x17, 0x9d, 0xa1, 0x28, 0x32, 0x63, 0x24, 0x45, 0x91, 0x66, 0x50,
+	0x83, 0x89, 0x80, 0x06, 0xfb, 0xb0, 0x22, 0xd8, 0xe1, 0x3a, 0xbc, 0x1b, 0xd0, 0xe1, 0x8d, 0x50,
+	0x6e, 0xce, 0x6d, 0x6e, 0x22, 0xfe, 0xc1, 0xb0, 0xf3, 0xdb, 0x24, 0xbe, 0x60, 0xe7, 0x22, 0xc4,
+	0xdf, 0x83, 0x9c, 0x8e, 0x8d, 0x8e, 0x90, 0x7d, 0x05, 0x62, 0x7e, 0x4c, 0x88, 0x99, 0x9d, 0x85,
+	0xdd, 0xff, 0x05, 0x2c, 0xb1, 0xee, 0x2e, 0x82, 0xf7, 0x1e, 0x2c, 0x57, 0xb0, 0x85, 0x3d, 0xfc,
+	0x35, 0x71, 0x2f, 0x5b, 0x2b, 0x21, 0x5b, 0x4b, 0xfb, 0x0c, 0x56, 0xc4, 0x48, 0x5c, 0xb0, 0xc5,
+	0x38, 0x75, 0x21, 0x57, 0xb6, 0x87, 0xa7, 0x82, 0xcf, 0xc0, 0x7c, 0x54, 0x82, 0xf3, 0xf1, 0xeb,
+	0x63, 0xfa, 0x05, 0x2c, 0xb1, 0x41, 0x2f, 0xc2, 0x16, 0xff, 0xa1, 0x80, 0xfa, 0x25, 0x11, 0xce,
+	0xb4, 0x07, 0xee, 0x6b, 0x91, 0xd3, 0x4f, 0x41, 0x89, 0xf0, 0x14, 0x94, 0x3c, 0x23, 0x05, 0xa5,
+	0xe6, 0x4b, 0x41, 0xe9, 0xb0, 0x14, 0xf4, 0xab, 0x18, 0xac, 0x4a, 0xd2, 0x71, 0x85, 0xee, 0x04,
+	0x14, 0x5a, 0x0c, 0x55, 0xe8, 0x14, 0xd5, 0xab, 0xe4, 0xa6, 0xc2, 0x3f, 0x2b, 0xe3, 0x85, 0xc0,
+	0x27, 0x90, 0x7d, 0x29, 0x3a, 0xe7, 0x4b, 0x81, 0x37, 0x66, 0xb2, 0xa0, 0x8f, 0xf1, 0xd1, 0x67,
+	0x90, 0x3e, 0xc1, 0x56, 0xdb, 0xee, 0xb3, 0x91, 0x73, 0xdb, 0xdf, 0x0e, 0x77, 0x07, 0x7c, 0x64,
+	0x8c, 0x2c, 0xcf, 0xef, 0x41, 0x10, 0xa1, 0x2f, 0x20, 0xe3, 0xf5, 0x8c, 0xc1, 0x8b, 0x53, 0x7b,
+	0x94, 0x8f, 0x2f, 0xd0, 0x81, 0x4f, 0xa5, 0xfd, 0xb1, 0x02, 0xc8, 0x07, 0xe3, 0xa3, 0xb9, 0x5c,
+	0xe6, 0x16, 0x2c, 0x09, 0x11, 0x5a, 0x0e, 0x3e, 0xe2, 0x8e, 0x93, 0x7b, 0x39, 0xee, 0x46, 0xf2,
+	0xaa, 0x78, 0x84, 0x57, 0x05, 0xc3, 0xed, 0xbf, 0x28, 0xb0, 0x16, 0x60, 0x83, 0xdb, 0xb6, 0x14,
+	0xb0, 0xed, 0xdd, 0xd9, 0x8a, 0x1d, 0xd3, 0x9d, 0x3f, 0x7f, 0x97, 0x78, 0xfe, 0xfe, 0x18, 0x32,
+	0x42, 0x1e, 0x3e, 0xf8, 0x19, 0x56, 0xf5, 0xd1, 0xb5, 0x9f, 0x2b, 0x70, 0x95, 0xa5, 0xce, 0x31,
+	0x67, 0x73, 0x68, 0x55, 0x1e, 0x31, 0xb6, 0xd0, 0x88, 0x0b, 0x6b, 0xfb, 0xdf, 0x14, 0xb8, 0x36,
+	0xc9, 0x21, 0x57, 0x78, 0x25, 0xa0, 0xf0, 0xf7, 0x42, 0x39, 0x08, 0x27, 0xfd, 0x7f, 0xd5, 0xf9,
+	0x6f, 0x14, 0x50, 0x77, 0x4e, 0x29, 0x63, 0xb6, 0x23, 0xd4, 0x2d, 0x85, 0x64, 0x25, 0x90, 0xf5,
+	0xbf, 0x49, 0x31, 0xcf, 0x85, 0x55, 0x49, 0xb0, 0x0b, 0x5a, 0x7c, 0xff, 0x08, 0x72, 0xbb, 0xe6,
+	0xe0, 0xc5, 0xeb, 0x48, 0x20, 0xda, 0x33, 0x58, 0x62, 0x7d, 0x73, 0x59, 0x6e, 0x40, 0x66, 0xe4,
+	0x58, 0x2d, 0xcb, 0x1c, 0xbc, 0xe0, 0x7d, 0xa7, 0x47, 0x8e, 0x45, 0x50, 0x16, 0x64, 0xfa, 0xef,
+	0x15, 0x50, 0x9b, 0xb8, 0x3f, 0xb4, 0x0c, 0x0f, 0xbb, 0xdf, 0xc4, 0x0a, 0xcb, 0x85, 0x55, 0x89,
+	0xff, 0x0b, 0x32, 0xf5, 0x2f, 0xe3, 0xb0, 0xfc, 0xd0, 0xb4, 0x3c, 0xec, 0x4f, 0x9b, 0x8f, 0x21,
+	0xe5, 0x7a, 0x86, 0x37, 0x62, 0xe9, 0x6c, 0x65, 0xfb, 0xd6, 0x8c, 0x85, 0x77, 0x83, 0x22, 0xea,
+	0x9c, 0x80, 0x0c, 0xed, 0x19, 0x5d, 0x37, 0x1f, 0x5b, 0x8f, 0x93, 0xaa, 0x87, 0xfc, 0x46, 0x55,
+	0x58, 0x72, 0xf0, 0xa0, 0x83, 0x9d, 0xa6, 0xe1, 0x74, 0x31, 0x51, 0x6c, 0x74, 0xa7, 0xba, 0x84,
+	0xa8, 0x07, 0xc8, 0xd0, 0xe7, 0x00, 0xc7, 0xa6, 0x6b, 0x1e, 0x9a, 0x96, 0xe9, 0x9d, 0xe6, 0x13,
+	0xb4, 0x93, 0xb7, 0x26, 0x3a, 0x61, 0x85, 0xfe, 0x53, 0x1f, 0x4d, 0x97, 0x48, 0xd0, 0x1b, 0x00,
+	0x6d, 0x1a, 0xb8, 0x3a, 0xad, 0xc3, 0xd3, 0x7c, 0x92, 0x72, 0x98, 0xe5, 0x90, 0x1d, 0xb9, 0x72,
+	0x48, 0x45, 0x38, 0x4a, 0x3a, 0xdc, 0x51, 0x32, 0xe1, 0x8e, 0x92, 0x3d, 0xc3, 0x51, 0x60, 0x3e,
+	0x47, 0xc9, 0x85, 0x39, 0xca, 0x10, 0x56, 0x84, 0xc9, 0x2e, 0xc8, 0x4b, 0x7e, 0x1a, 0x83, 0xe5,
+	0x06, 0x36, 0x9c, 0x76, 0x4f, 0x78, 0x09, 0x82, 0xc4, 0xc0, 0xe8, 0x63, 0x51, 0xe0, 0x92, 0xdf,
+	0xaf, 0x39, 0xae, 0xae, 0x43, 0xae, 0x83, 0xdd, 0xb6, 0x63, 0x0e, 0xa5, 0x29, 0x24, 0x83, 0x48,
+	0x9c, 0xa2, 0x01, 0x9f, 0x32, 0xc6, 0xcc, 0x95, 0x21, 0x80, 0x7d, 0xc2, 0xdc, 0xb4, 0x09, 0x32,
+	0xf3, 0x99, 0x20, 0x1b, 0x61, 0x02, 0xa1, 0x8f, 0x0b, 0x32, 0xc1, 0x3f, 0x28, 0x80, 0x1a, 0x3d,
+	0xc3, 0xc1, 0xbc, 0xe4, 0xe5, 0x76, 0x38, 0xdf, 0x46, 0x14, 0xba, 0x0b, 0x49, 0xa2, 0x19, 0x36,
+	0x55, 0x73, 0xdb, 0xd7, 0x83, 0x44, 0xe4, 0x53, 0xf1, 0xc0, 0xc5, 0x8e, 0xce, 0xb0, 0xa2, 0x0b,
+	0xe8, 0xb1, 0xc9, 0x13, 0x11, 0x26, 0x4f, 0x06, 0xa2, 0x7f, 0x19, 0xd6, 0x02, 0x42, 0x4c, 0xd4,
+	0x71, 0x4a, 0xb8, 0x2a, 0x62, 0x41, 0x55, 0xbc, 0x0f, 0x37, 0x4a, 0x23, 0xcf, 0x6e, 0xdb, 0xfd,
+	0x21, 0xa9, 0x06, 0x83, 0x8e, 0x79, 0x05, 0x92, 0x9e, 0xe9, 0x59, 0xc2, 0x33, 0x59, 0x43, 0xfb,
+	0x4f, 0x05, 0x0a, 0x61, 0x34, 0x7c, 0xfc, 0xc7, 0x01, 0xe3, 0xdd, 0x0f, 0x37, 0x5e, 0x24, 0xf9,
+	0xf9, 0x97, 0x3e, 0x7b, 0x7c, 0xe9, 0x53, 0x85, 0x8c, 0xc3, 0x3b, 0xe3, 0x66, 0x7c, 0x27, 0x34,
+	0xb6, 0xc9, 0x3c, 0x88, 0xd1, 0x75, 0x9f, 0x54, 0xfb, 0xd7, 0x34, 0xa4, 0x98, 0x66, 0xe7, 0x2d,
+	0xc2, 0x7d, 0x6d, 0xc5, 0x25, 0x6d, 0x4d, 0x4e, 0xb5, 0xc4, 0xf4, 0x54, 0x13, 0x91, 0x3e, 0x29,
+	0x45, 0xfa, 0x3c, 0xa4, 0x79, 0x3c, 0xa5, 0x93, 0x33, 0xae, 0x8b, 0x26, 0xf9, 0x32, 0xa2, 0xbb,
+	0x29, 0x2c, 0x8a, 0xc6, 0x75, 0xd1, 0x44, 0xef, 0x73, 0x1a, 0x9b, 0x4d, 0xc7, 0x19, 0x9e, 0x28,
+	0xf0, 0xd0, 0x3d, 0x48, 0xb9, 0xc4, 0x85, 0xdc, 0x7c, 0x76, 0xb6, 0xef, 0x72, 0x34, 0xf4, 0x09,
+	0xa4, 0x59, 0x2a, 0x71, 0xf3, 0x30, 0x6f, 0xf2, 0x11, 0x14, 0xe8, 0xbb, 0x90, 0x76, 0xb1, 0xe7,
+	0x99, 0x83, 0x6e, 0x3e, 0x17, 0x3a, 0xb3, 0x99, 0x61, 0x1a, 0x0c, 0x47, 0x17, 0xc8, 0x72, 0x69,
+	0xb7, 0xf4, 0xaa, 0xa5, 0xdd, 0xf2, 0x79, 0x4a, 0xbb, 0x60, 0x65, 0xba, 0xb2, 0x60, 0x65, 0xfa,
+	0x3d, 0x48, 0x75, 0xb0, 0x6b, 0x76, 0x07, 0xf9, 0xcb, 0x74, 0x70, 0x6d, 0x46, 0x54, 0x29, 0x56,
+	0x28, 0xa6, 0xce, 0x29, 0xa4, 0x05, 0x84, 0xba, 0xae, 0x2c, 0xb6, 0x80, 0x78, 0x13, 0xc0, 0x74,
+	0xc5, 0x22, 0x28, 0xbf, 0xba, 0xae, 0x6c, 0x66, 0x74, 0x09, 0x42, 0xbe, 0x7b, 0xfc, 0x77, 0xad,
+	0x93, 0x47, 0xd4, 0x2f, 0x25, 0x08, 0x71, 0x34, 0x77, 0xd4, 0xef, 0x1b, 0xce, 0x69, 0x7e, 0x8d,
+	0xcd, 0x34, 0xde, 0x2c, 0xfc, 0x52, 0x81, 0x14, 0xe3, 0x13, 0x35, 0x00, 0x0d, 0x1d, 0xbb, 0xeb,
+	0x60, 0xd7, 0x6d, 0x1d, 0x1a, 0x4e, 0xcb, 0xf5, 0x4e, 0x79, 0xb8, 0x58, 0x89, 0xd8, 0x1a, 0x7e,
+	0xc2, 0xd1, 0x77, 0x0c, 0xa7, 0x41, 0x90, 0x75, 0x75, 0x38, 0x01, 0x41, 0x9b, 0xa0, 0x76, 0x98,
+	0x29, 0x5a, 0x87, 0xdd, 0x56, 0xdb, 0xb6, 0x6c, 0x87, 0xcf, 0xb4, 0x15, 0x0e, 0xdf, 0xe9, 0x96,
+	0x09, 0x54, 0xc6, 0xb4, 0xec, 0xae, 0xdd, 0x1a, 0x39, 0x56, 0x3e, 0x1e, 0xc0, 0xdc, 0xb5, 0xbb,
+	0xf6, 0x81, 0x63, 0x69, 0x7f, 0x17, 0x83, 0x0c, 0x77, 0x2c, 0xaa, 0x1a, 0xb7, 0x67, 0x9f, 0xec,
+	0x8c, 0x3c, 0x8f, 0xd7, 0x47, 0x19, 0x5d, 0x82, 0x90, 0xef, 0x87, 0xf4, 0x57, 0x13, 0x7f, 0xe5,
+	0xf1, 0xa1, 0x25, 0x08, 0xba, 0x0f, 0xd7, 0x5c, 0xbb, 0x6d, 0x1a, 0x56, 0x8b, 0x4c, 0x0b, 0x73,
+	0xd0, 0x6d, 0xe1, 0x81, 0x71, 0x68, 0x61, 0x16, 0xd1, 0x33, 0xfa, 0x15, 0xf6, 0xb5, 0xc1, 0x3e,
+	0x56, 0xd9, 0x37, 0xf4, 0x0e, 0xa8, 0x0e, 0x66, 0xb9, 0xd0, 0xc7, 0x4f, 0x50, 0xfc, 0xcb, 0x02,
+	0x2e, 0x50, 0x6f, 0xc1, 0x92, 0x00, 0x51, 0x99, 0x58, 0xe0, 0xcf, 0x09, 0xd8, 0x81, 0x63, 0xa1,
+	0x2f, 0x20, 0xe7, 0x8e, 0x0e, 0xfb, 0xa6, 0xd7, 0xea, 0x93, 0x18, 0x99, 0x5a, 0x57, 0x42, 0x56,
+	0x71, 0xc2, 0x3d, 0x08, 0xde, 0x9e, 0xdd, 0xc1, 0x3a, 0xb8, 0xfe, 0x6f, 0xb4, 0x05, 0x2a, 0x91,
+	0xb9, 0x69, 0xf6, 0x71, 0xd3, 0x2e, 0x0d, 0xdc, 0x13, 0xec, 0xd0, 0x90, 0x92, 0xd1, 0xa7, 0xe0,
+	0xda, 0x67, 0xbe, 0xc5, 0x6f, 0x40, 0xc6, 0x37, 0x0a, 0xaf, 0x31, 0x0e, 0xb9, 0x35, 0x6e, 0x40,
+	0xc6, 0xb7, 0x02, 0x4f, 0x33, 0x16, 0x57, 0xff, 0x3f, 0xc5, 0xe0, 0xf2, 0xc4, 0xf4, 0x42, 0x0f,
+	0x20, 0xe1, 0x9d, 0x0e, 0x85, 0xb7, 0xdc, 0x9a, 0x39, 0x9f, 0x9a, 0xa7, 0x43, 0xac, 0x53, 0xf4,
+	0x71, 0x98, 0x8d, 0xcd, 0x08, 0xb3, 0xf1, 0xe9, 0x30, 0x7b, 0x05, 0x92, 0xb6, 0xd3, 0xc1, 0x8e,
+	0x58, 0x21, 0xd1, 0x06, 0xd9, 0x1a, 0xe7, 0x93, 0x33, 0x39, 0x63, 0x6b, 0x7c, 0x6a, 0x56, 0x66,
+	0x78, 0x6c, 0x72, 0xa9, 0xe2, 0xa3, 0xa2, 0x81, 0x70, 0x38, 0xdd, 0x47, 0x27, 0x6b, 0x6f, 0xc3,
+	0xf3, 0x8c, 0x76, 0xaf, 0x8f, 0x07, 0x5e, 0x3e, 0x4d, 0x43, 0x49, 0xb8, 0xd5, 0x4a, 0x3e, 0x9a,
+	0x2e, 0x91, 0x68, 0x7f, 0x42, 0x8e, 0xbd, 0xfc, 0x26, 0xfa, 0x30, 0xa0, 0xc4, 0x8d, 0x33, 0x7a,
+	0x92, 0xd4, 0xa8, 0x42, 0x7c, 0x6c, 0x27, 0xf2, 0x93, 0x28, 0xe8, 0xc4, 0xec, 0x78, 0x3d, 0x51,
+	0xaf, 0xd1, 0x06, 0x59, 0x42, 0xf6, 0xb0, 0xd9, 0xed, 0xf9, 0xf5, 0x1a, 0x6b, 0x69, 0xff, 0x1b,
+	0x83, 0x8c, 0x6f, 0xca, 0xc9, 0x0c, 0x29, 0x4c, 0x1b, 0x5b, 0xd8, 0xb4, 0xcc, 0x44, 0x71, 0xd9,
+	0x44, 0xbe, 0xc1, 0x13, 0x33, 0x0c, 0x9e, 0x9c, 0x36, 0xf8, 0xa7, 0xbe, 0x69, 0x53, 0x33, 0x82,
+	0xbe, 0x60, 0x63, 0xd2, 0xc6, 0x77, 0x20, 0x75, 0x64, 0x62, 0xab, 0xe3, 0xd2, 0x39, 0x91, 0xdb,
+	0xbe, 0x52, 0x64, 0xa7, 0xab, 0x45, 0x71, 0xba, 0x5a, 0x2c, 0x0d, 0x4e, 0x75, 0x8e, 0x83, 0xde,
+	0x93, 0x3c, 0x22, 0x33, 0x03, 0xdf, 0xc7, 0x2a, 0xbc, 0xea, 0x8c, 0x5a, 0x02, 0x78, 0x6a, 0x58,
+	0x66, 0xc7, 0xa0, 0x6b, 0xe8, 0x3f, 0x8b, 0x91, 0x82, 0xf7, 0x2b, 0x7f, 0x72, 0x3d, 0x24, 0x6c,
+	0xa1, 0x2f, 0xa9, 0xb3, 0x39, 0xe6, 0xe1, 0xc8, 0xc3, 0x2e, 0x5f, 0x90, 0xbd, 0x1f, 0xaa, 0x85,
+	0x29, 0xda, 0x62, 0xc9, 0x27, 0xd4, 0xa5, 0x4e, 0x50, 0x09, 0x72, 0xc7, 0xfe, 0xb0, 0x62, 0xc9,
+	0x1b, 0xee, 0xc0, 0x63, 0xf6, 0x74, 0x99, 0x66, 0x62, 0x0a, 0xb0, 0xbd, 0xd6, 0x45, 0xa6, 0x40,
+	0xa1, 0x48, 0x67, 0x80, 0xe0, 0x68, 0xc2, 0x11, 0x94, 0x29, 0x47, 0xd0, 0x7e, 0xa5, 0xc0, 0x15,
+	0x59, 0x40, 0x3f, 0x0f, 0xdc, 0x05, 0xd4, 0x37, 0xbe, 0x32, 0xfb, 0xa3, 0x7e, 0xab, 0xdd, 0x33,
+	0x1c, 0xa3, 0xed, 0x91, 0x85, 0x0d, 0x5b, 0x38, 0xaf, 0xf2, 0x2f, 0x65, 0xff, 0x03, 0xda, 0x80,
+	0xe5, 0xfe, 0xc8, 0xf2, 0xcc, 0xa1, 0x85, 0x5b, 0x8e, 0x7d, 0xe2, 0x52, 0x93, 0x64, 0xf4, 0x25,
+	0x01, 0xd4, 0xed, 0x13, 0x17, 0x7d, 0x0b, 0xb2, 0x7d, 0x63, 0xd0, 0x31, 0x3c, 0xdb, 0x39, 0xe5,
+	0xe9, 0x60, 0x0c, 0x20, 0xbe, 0x6c, 0xf6, 0x8d, 0x2e, 0xf3, 0xe5, 0x8c, 0xce, 0x1a, 0x04, 0x7a,
+	0x6c, 0x76, 0xb0, 0x4d, 0xbd, 0x38, 0xa3, 0xb3, 0x06, 0x2a, 0x40, 0xe6, 0xd8, 0x70, 0x4c, 0x92,
+	0x11, 0xa8, 0x07, 0x67, 0x74, 0xbf, 0xad, 0xfd, 0x65, 0x0c, 0x6e, 0xee, 0xf1, 0x61, 0xcb, 0x3d,
+	0xdb, 0x6c, 0xe3, 0xa0, 0xe5, 0x1f, 0x40, 0xba, 0x4d, 0xc1, 0xb3, 0x4b, 0x19, 0x46, 0xaa, 0x0b,
+	0x5c, 0xf4, 0x7b, 0x01, 0x87, 0x61, 0x5b, 0xa7, 0x9f, 0x84, 0x52, 0xce, 0x18, 0x7c, 0x4e, 0xd7,
+	0x89, 0x2f, 0xee, 0x3a, 0x0b, 0x5b, 0xfe, 0x1f, 0x15, 0x78, 0x33, 0x9c, 0x53, 0xdf, 0x07, 0x02,
+	0xf6, 0x52, 0x26, 0xed, 0x45, 0x3c, 0x44, 0x98, 0xdc, 0xc5, 0x16, 0x2f, 0x63, 0x99, 0xdd, 0x57,
+	0xc5, 0x97, 0x86, 0xf8, 0x40, 0x36, 0x46, 0x48, 0xea, 0x6c, 0xd9, 0x5e, 0x8f, 0x47, 0xb1, 0x8c,
+	0x9e, 0x25, 0x90, 0x3a, 0x01, 0x90, 0x25, 0xd5, 0xd0, 0x6c, 0x7b, 0x23, 0x47, 0xd8, 0x5f, 0x34,
+	0x09, 0x17, 0x8e, 0x31, 0xe8, 0xd8, 0x7d, 0xd3, 0xc5, 0xdc, 0x0b, 0xc6, 0x00, 0xed, 0x3e, 0xa4,
+	0x18, 0xf7, 0xb4, 0xcc, 0x37, 0x0e, 0xb1, 0x25, 0x2a, 0x32, 0xda, 0x18, 0x7b, 0x15, 0x4f, 0x89,
+	0xb4, 0xa1, 0xfd, 0x7b, 0x0c, 0xae, 0x94, 0xed, 0x81, 0x67, 0xb4, 0x27, 0xc2, 0x42, 0x33, 0x24,
+	0x2c, 0x84, 0xd7, 0x69, 0x61, 0xe4, 0xaf, 0x2f, 0x32, 0x14, 0x7e, 0xae, 0x04, 0xec, 0xfb, 0x06,
+	0xc0, 0x91, 0xe9, 0xb8, 0x5e, 0x4b, 0xda, 0x1d, 0xc9, 0x52, 0x08, 0xdd, 0x85, 0xb8, 0x09, 0x59,
+	0xcb, 0x10, 0x5f, 0xf9, 0x1d, 0x00, 0xcb, 0xe0, 0x1f, 0x6f, 0xc3, 0x4a, 0x9b, 0x31, 0xdf, 0x1a,
+	0x8c, 0xfa, 0x87, 0xdc, 0x1a, 0x59, 0x7d, 0x99, 0x43, 0xf7, 0x29, 0x90, 0x68, 0x0e, 0xf7, 0x0d,
+	0xd3, 0x12, 0xb9, 0x85, 0x36, 0x88, 0x9d, 0x8c, 0x4e, 0xc7, 0xc1, 0xae, 0xcb, 0xf3, 0x8a, 0x68,
+	0x6a, 0xff, 0xa5, 0xc0, 0xf5, 0x09, 0xa5, 0xcc, 0xe9, 0x49, 0x41, 0x61, 0x98, 0x07, 0x45, 0x09,
+	0xc3, 0x1c, 0x67, 0x96, 0x30, 0xcc, 0x7d, 0xa2, 0x84, 0xe1, 0x61, 0x64, 0x4a, 0x18, 0x16, 0x45,
+	0x44, 0x93, 0x04, 0x18, 0x07, 0xbf, 0x1c, 0x99, 0x0e, 0xad, 0x25, 0x49, 0xf1, 0xe9, 0xb7, 0xb5,
+	0xff, 0x51, 0x00, 0xed, 0xe2, 0xae, 0x61, 0x05, 0x5d, 0x47, 0x0f, 0x71, 0x9d, 0xed, 0x50, 0x1b,
+	0x4f, 0x13, 0xbf, 0x46, 0xc7, 0x79, 0xbc, 0x58, 0x5c, 0xa0, 0xe5, 0xb5, 0x3d, 0xf0, 0x48, 0xfe,
+	0xe1, 0x69, 0x95, 0x37, 0xb5, 0x9f, 0x29, 0x70, 0x35, 0xc0, 0x7a, 0x43, 0x5a, 0xb9, 0xf1, 0x12,
+	0x60, 0xbc, 0xde, 0x0a, 0xe7, 0x72, 0xc7, 0x47, 0xd3, 0x25, 0x92, 0x49, 0xb6, 0x98, 0x0b, 0x04,
+	0xd8, 0x9a, 0x99, 0x3b, 0xb4, 0xbf, 0x89, 0xc1, 0xd5, 0x8a, 0x63, 0x0f, 0x3b, 0xf6, 0xc9, 0x20,
+	0x68, 0x95, 0x83, 0x10, 0xab, 0x3c, 0x08, 0x5f, 0xc8, 0x86, 0xd1, 0xbf, 0xc6, 0x5c, 0x2f, 0xe5,
+	0xa1, 0xf8, 0xfc, 0x79, 0xe8, 0x3c, 0xf6, 0xb4, 0x87, 0x63, 0x2e, 0xb3, 0xba, 0x68, 0x6a, 0xbf,
+	0x56, 0x20, 0x3f, 0x29, 0xf4, 0xfc, 0x33, 0x56, 0x0a, 0xe6, 0xb1, 0xc9, 0x60, 0x1e, 0x08, 0xd9,
+	0xf1, 0x89, 0x90, 0x4d, 0x12, 0x87, 0x61, 0x0d, 0x7b, 0xc6, 0x21, 0xf6, 0xcc, 0xb6, 0x61, 0xb5,
+	0xc6, 0xa5, 0x47, 0x46, 0x5f, 0x95, 0xbf, 0xd4, 0xc9, 0x87, 0x88, 0x3c, 0x93, 0x8c, 0xc8, 0x33,
+	0x74, 0xb9, 0xf7, 0xd0, 0xb4, 0xf0, 0x79, 0x97, 0x7b, 0x53, 0xb4, 0xbf, 0x4b, 0xcb, 0xbd, 0x3f,
+	0x84, 0x2b, 0xb2, 0x7c, 0x73, 0x5a, 0xfb, 0x31, 0xa8, 0x86, 0x65, 0xd9, 0x27, 0xb8, 0xd3, 0x3a,
+	0x32, 0x2d, 0xcc, 0xcb, 0x17, 0xb2, 0xc5, 0xf5, 0x46, 0xa4, 0x0a, 0xe9, 0x14, 0xbf, 0xcc, 0xc9,
+	0x1e, 0x72, 0x2a, 0xed, 0x67, 0x31, 0x58, 0xdb, 0x31, 0x07, 0x86, 0x73, 0x1a, 0x34, 0x4f, 0x23,
+	0xc4, 0x3c, 0x1f, 0x84, 0x07, 0x90, 0x69, 0xea, 0xdf, 0x66, 0x03, 0x6d, 0x06, 0x0c, 0x54, 0x80,
+	0x0c, 0xb3, 0xc6, 0xa1, 0xc8, 0xd9, 0x7e, 0x5b, 0xfb, 0x0b, 0x05, 0xae, 0x05, 0x85, 0x9b, 0xd3,
+	0x3a, 0x81, 0x15, 0xce, 0xf4, 0xba, 0x39, 0x2e, 0xaf, 0x9b, 0x83, 0x81, 0x3a, 0xb1, 0x70, 0xa0,
+	0xd6, 0x7e, 0x12, 0x87, 0xbc, 0x6e, 0x0c, 0xba, 0x7c, 0xc2, 0x05, 0xad, 0xf8, 0xc3, 0x10, 0x2b,
+	0x7e, 0x14, 0xda, 0x7b, 0x54, 0x17, 0xaf, 0x31, 0x0f, 0xfe, 0x5a, 0x59, 0x30, 0x70, 0x3e, 0x83,
+	0x14, 0x5d, 0x42, 0x8a, 0xc5, 0xfe, 0xe7, 0xe7, 0x95, 0xa4, 0xb8, 0x4b, 0xbb, 0xd1, 0x79, 0x77,
+	0x85, 0xef, 0x43, 0x8a, 0x41, 0xc8, 0xf6, 0xb6, 0x85, 0x8f, 0x3c, 0x71, 0xba, 0x45, 0x7e, 0x93,
+	0x4d, 0x85, 0x36, 0x1e, 0x78, 0x58, 0xec, 0xeb, 0xf1, 0x16, 0x31, 0xa8, 0x43, 0xf7, 0x1a, 0xf8,
+	0x16, 0x3a, 0x6d, 0x68, 0xff, 0xad, 0xc0, 0xcd, 0x10, 0x16, 0xe6, 0x77, 0x1d, 0xd7, 0xc3, 0x43,
+	0x97, 0x1f, 0x23, 0xb0, 0x06, 0x7a, 0x00, 0x49, 0xb6, 0x57, 0x19, 0x9f, 0xe1, 0x1f, 0x6c, 0x50,
+	0x82, 0xa6, 0x33, 0x6c, 0xf4, 0x29, 0xdc, 0x64, 0x39, 0x61, 0x60, 0x9d, 0xb6, 0x88, 0x28, 0x2d,
+	0xca, 0x62, 0x8b, 0x2b, 0x91, 0xc5, 0xf7, 0xeb, 0x34, 0x49, 0x0c, 0xac, 0xd3, 0x5d, 0x7c, 0xe4,
+	0xe9, 0xe4, 0x3b, 0x57, 0xc5, 0x5b, 0x90, 0xa3, 0xd4, 0x7d, 0xb3, 0xd3, 0xb1, 0xc4, 0x3a, 0x9f,
+	0x26, 0x99, 0x3d, 0x0a, 0xd1, 0x7e, 0x13, 0x83, 0xcb, 0xd2, 0x99, 0x0e, 0xd9, 0x7a, 0x9f, 0xda,
+	0x5b, 0x19, 0x5f, 0xe6, 0x8c, 0xcd, 0x7f, 0x99, 0xf3, 0x5d, 0x48, 0x8c, 0x5c, 0xbe, 0x08, 0x9e,
+	0xb1, 0xcd, 0x4f, 0x91, 0xd0, 0x47, 0xfe, 0xa6, 0x33, 0x9b, 0x3c, 0xeb, 0xe1, 0xdb, 0xf4, 0x94,
+	0xcf, 0xe0, 0x9e, 0xb3, 0x74, 0x38, 0x91, 0x0c, 0x1e, 0x4e, 0xdc, 0x87, 0x2c, 0x3d, 0x42, 0xa0,
+	0x27, 0xc6, 0xa9, 0xd9, 0x5c, 0x64, 0x18, 0xe6, 0x0e, 0xb5, 0x5d, 0xdb, 0x1e, 0xd1, 0x8d, 0x32,
+	0x6a, 0x3b, 0xda, 0x90, 0x0f, 0x47, 0x32, 0x53, 0x87, 0x23, 0xc7, 0xd8, 0x71, 0xc5, 0x51, 0x63,
+	0x5c, 0x17, 0x4d, 0xed, 0x23, 0x58, 0x7d, 0x84, 0xbd, 0xa6, 0x3d, 0x6c, 0x1a, 0xdd, 0xb3, 0x6e,
+	0x34, 0x2c, 0x81, 0x32, 0xe0, 0xde, 0xa2, 0x0c, 0xb4, 0x3f, 0x57, 0x00, 0xc9, 0xa4, 0xfc, 0x98,
+	0xeb, 0x8b, 0xc0, 0x31, 0xd7, 0x9d, 0x50, 0x23, 0x4c, 0x93, 0x9d, 0xff, 0x78, 0xab, 0x30, 0xbe,
+	0x0d, 0x4d, 0x4f, 0x8b, 0x94, 0xf1, 0x69, 0x91, 0x56, 0x81, 0xeb, 0xf2, 0x69, 0xd6, 0x1c, 0x22,
+	0x8a, 0x23, 0xe7, 0xd8, 0xf8, 0xc8, 0x59, 0xfb, 0x85, 0x02, 0xf9, 0xe9, 0x6e, 0xb8, 0xb8, 0x0f,
+	0x03, 0xe2, 0x6e, 0x9f, 0x79, 0xaa, 0xf7, 0xfa, 0x85, 0xee, 0x41, 0xfe, 0x99, 0xe1, 0xf4, 0x47,
+	0xc3, 0xb2, 0xd1, 0xee, 0x4d, 0x9c, 0xe4, 0x4e, 0x1f, 0x50, 0x2b, 0xf3, 0x1d, 0x50, 0xc7, 0xc2,
+	0x0e, 0xa8, 0x6f, 0xc2, 0x8d, 0x90, 0x91, 0xf8, 0x39, 0xe1, 0x4f, 0x15, 0x58, 0x6a, 0x3a, 0x86,
+	0xdb, 0x3b, 0xef, 0x35, 0x99, 0x75, 0xc8, 0xb5, 0x6d, 0x8b, 0xaf, 0xec, 0xd8, 0x32, 0x39, 0xab,
+	0xcb, 0xa0, 0xc8, 0x2b, 0x33, 0xfe, 0x99, 0x7f, 0x52, 0x3a, 0xf3, 0xd7, 0x3c, 0x58, 0xe6, 0xfc,
+	0x70, 0xd3, 0x3d, 0x08, 0x98, 0xee, 0x56, 0xe8, 0x64, 0xa6, 0x14, 0xaf, 0x72, 0xa4, 0x3e, 0x84,
+	0x15, 0x1d, 0xbb, 0x9e, 0xed, 0x44, 0x5e, 0x5d, 0x7e, 0x13, 0x60, 0x2c, 0x94, 0x38, 0x54, 0x19,
+	0x43, 0x16, 0xbe, 0x79, 0xe7, 0xc2, 0x65, 0x7f, 0x44, 0x2e, 0xe9, 0x76, 0x40, 0xd2, 0x37, 0xa3,
+	0x25, 0x3d, 0xb7, 0x98, 0x7f, 0xa4, 0xc0, 0xda, 0xae, 0xe9, 0x7a, 0x4f, 0x59, 0x58, 0x71, 0xbf,
+	0xae, 0x7b, 0xda, 0x8b, 0x99, 0xf8, 0xc7, 0x70, 0x25, 0xc8, 0x04, 0x97, 0xff, 0xc3, 0x80, 0xfc,
+	0x1b, 0xe1, 0x57, 0x7a, 0x18, 0xd1, 0xab, 0xd8, 0xfa, 0x0f, 0x20, 0xc7, 0x7b, 0xa1, 0x93, 0xf3,
+	0xbb, 0xe3, 0xa0, 0xab, 0xcc, 0x38, 0xd6, 0xe5, 0x24, 0x7e, 0x48, 0x3e, 0x57, 0x22, 0xd3, 0x06,
+	0x80, 0x74, 0x6c, 0x74, 0x44, 0x67, 0x11, 0xea, 0x97, 0xf2, 0x40, 0x2c, 0x90, 0x07, 0x16, 0xf6,
+	0xb2, 0x53, 0x58, 0x0b, 0x8c, 0xc7, 0x35, 0x7d, 0x3f, 0xa0, 0xe9, 0xf5, 0xf0, 0x45, 0xda, 0x58,
+	0x47, 0xe7, 0x52, 0xf3, 0x9f, 0x2a, 0x70, 0x4d, 0xc7, 0xc7, 0xd8, 0xf1, 0x9a, 0xf6, 0x45, 0xc9,
+	0x2b, 0xdf, 0x4e, 0x49, 0x06, 0xee, 0xde, 0xff, 0x18, 0xae, 0x4f, 0x31, 0x73, 0x71, 0xca, 0xd8,
+	0xfa, 0x12, 0xd4, 0xc9, 0x93, 0x63, 0x74, 0x03, 0xae, 0x4e, 0xc2, 0x5a, 0xfb, 0xf5, 0xfd, 0xaa,
+	0x7a, 0x09, 0x65, 0x20, 0xf1, 0xb0, 0xb6, 0xbb, 0xab, 0x2a, 0x68, 0x09, 0x32, 0xfb, 0x07, 0x7b,
+	0x3b, 0x55, 0xbd, 0x5a, 0x51, 0x63, 0x08, 0x20, 0x55, 0xa9, 0x37, 0x9b, 0xd5, 0x8a, 0x1a, 0xdf,
+	0xfa, 0x85, 0x02, 0x4b, 0xf2, 0x21, 0x14, 0xba, 0x0a, 0xab, 0x72, 0x5b, 0xf4, 0x95, 0x83, 0xf4,
+	0xb3, 0xea, 0x6e, 0xb9, 0xbe, 0x57, 0x65, 0xdd, 0x35, 0x1e, 0xd7, 0xf5, 0x66, 0xf5, 0x87, 0x4d,
+	0x35, 0x86, 0xd6, 0xe0, 0xf2, 0xde, 0xc1, 0x6e, 0xb3, 0xf6, 0x64, 0xb7, 0xda, 0x2a, 0x3f, 0xae,
+	0xd7, 0xca, 0x55, 0x35, 0x4e, 0xf0, 0xcb, 0xf5, 0xfd, 0x66, 0xa9, 0xdc, 0x54, 0x13, 0x28, 0x0b,
+	0xc9, 0xdd, 0xea, 0xa3, 0xd2, 0xae, 0x9a, 0x24, 0xa4, 0x15, 0xbd, 0xfe, 0xa4, 0x52, 0x7f, 0xb6,
+	0xaf, 0xa6, 0x38, 0x87, 0x55, 0x35, 0x4d, 0x78, 0xda, 0xa9, 0xed, 0x97, 0xf4, 0xe7, 0x6a, 0x86,
+	0xfc, 0xd6, 0x4b, 0xcd, 0xda, 0xfe, 0x23, 0x35, 0x8b, 0x96, 0x21, 0xdb, 0x7c, 0x5c, 0xda, 0xff,
+	0x41, 0xeb, 0x79, 0xfd, 0x40, 0x85, 0xad, 0xcf, 0x61, 0x25, 0x78, 0x90, 0x87, 0xae, 0xc3, 0x5a,
+	0x10, 0x22, 0x38, 0xce, 0x42, 0xb2, 0xb6, 0x57, 0x7a, 0x44, 0xf8, 0xcd, 0x42, 0xf2, 0x69, 0xad,
+	0x52, 0xad, 0xab, 0xb1, 0xad, 0xef, 0x91, 0x97, 0x2c, 0xd2, 0x35, 0xc0, 0xab, 0xb0, 0x2a, 0xb7,
+	0x05, 0x71, 0x1a, 0xe2, 0xcf, 0xaa, 0x3b, 0xaa, 0x42, 0x78, 0xd9, 0xab, 0xef, 0x10, 0x1e, 0x63,
+	0x5b, 0xfb, 0x00, 0xe3, 0x53, 0x64, 0x22, 0xf6, 0xb8, 0x25, 0xa9, 0x49, 0xaf, 0x56, 0x77, 0xeb,
+	0xa5, 0x0a, 0x53, 0x93, 0x5e, 0xad, 0xd4, 0xf4, 0x6a, 0x99, 0xa8, 0x49, 0x85, 0xa5, 0xc6, 0xe3,
+	0xfa, 0xb3, 0xd6, 0x5e, 0xb5, 0xd1, 0x20, 0x6c, 0xc5, 0xb7, 0x3a, 0xb0, 0x24, 0x5f, 0x5a, 0x20,
+	0xbc, 0xc8, 0x6d, 0x49, 0x90, 0x8a, 0x5e, 0x7a, 0xd8, 0x54, 0x15, 0xaa, 0x55, 0xbd, 0x5a, 0x6a,
+	0x0a, 0x33, 0x96, 0xca, 0xcd, 0xda, 0x53, 0xa2, 0xee, 0x25, 0xc8, 0xd4, 0xf6, 0x79, 0x2b, 0x41,
+	0xd0, 0x2a, 0xd5, 0xdd, 0x2a, 0x41, 0x4b, 0x6e, 0x39, 0x00, 0xe3, 0x12, 0x8f, 0x70, 0x3d, 0x6e,
+	0x89, 0x11, 0x00, 0x52, 0xcf, 0xab, 0x8d, 0xd6, 0x7e, 0x5d, 0x55, 0x10, 0x82, 0x95, 0x52, 0xb9,
+	0x5c, 0x7d, 0xd2, 0x6c, 0x55, 0xaa, 0xe5, 0xdd, 0xda, 0x7e, 0x55, 0x8d, 0x51, 0xd8, 0x23, 0xbd,
+	0x5a, 0x6d, 0x55, 0x6a, 0x0d, 0xfa, 0x43, 0x8d, 0x13, 0x9a, 0xe6, 0xe3, 0x83, 0xbd, 0x9d, 0x86,
+	0x9a, 0x40, 0x97, 0x21, 0xd7, 0xac, 0x95, 0x7f, 0xd0, 0x68, 0x95, 0xf5, 0x7a, 0xa3, 0xa1, 0x26,
+	0xb7, 0x7e, 0x04, 0x19, 0xb1, 0x35, 0x80, 0x56, 0x61, 0x59, 0xfc, 0x96, 0xc6, 0xa3, 0xa6, 0x69,
+	0x30, 0x05, 0x53, 0xdb, 0x34, 0xd4, 0x18, 0xd1, 0x7a, 0xa5, 0x5e, 0x56, 0xe3, 0xc4, 0x2f, 0xa8,
+	0x73, 0x25, 0x08, 0xe8, 0x49, 0xe5, 0xa1, 0x9a, 0x24, 0x3f, 0x4a, 0xfb, 0xcf, 0xd5, 0xd4, 0x56,
+	0x19, 0x60, 0x5c, 0x92, 0x10, 0x79, 0xc6, 0x2d, 0xa9, 0x7f, 0xe6, 0xee, 0xaa, 0x42, 0xba, 0x6a,
+	0x34, 0x4b, 0x3a, 0xd3, 0x57, 0x63, 0xaf, 0xb6, 0x5b, 0x7d, 0xae, 0xc6, 0xb7, 0xff, 0x6a, 0x0d,
+	0x96, 0xb9, 0xae, 0xb1, 0x73, 0x4c, 0x0e, 0x15, 0xbe, 0x0f, 0xf1, 0x92, 0x65, 0xa1, 0x88, 0x9a,
+	0xdf, 0x7f, 0x62, 0x59, 0x58, 0x8f, 0x46, 0xe0, 0x8b, 0xa1, 0x4b, 0xa4, 0xaf, 0x7d, 0x7c, 0x82,
+	0xde, 0x8a, 0x7e, 0x16, 0x38, 0xab, 0x2f, 0xe9, 0xdd, 0xa0, 0x76, 0x09, 0x35, 0x20, 0xc5, 0xee,
+	0xc7, 0x23, 0x6d, 0xc6, 0xe5, 0x79, 0xd1, 0xe3, 0xc6, 0x4c, 0x1c, 0xb9, 0x53, 0xf6, 0x1a, 0x2d,
+	0xa2, 0xd3, 0xc0, 0xcb, 0xb9, 0xc2, 0xc6, 0x4c, 0x1c, 0xbf, 0xd3, 0x3d, 0x48, 0x90, 0x2c, 0x81,
+	0xd6, 0x23, 0x6e, 0x43, 0xf9, 0xcf, 0xd1, 0x0a, 0xb7, 0x66, 0x60, 0xc8, 0x3c, 0xb2, 0xc7, 0x59,
+	0x11, 0x3c, 0x06, 0xde, 0x88, 0x15, 0x36, 0x66, 0xe2, 0xc8, 0x3c, 0x92, 0xc7, 0x53, 0x11, 0x3c,
+	0x4a, 0x8f, 0xb9, 0x0a, 0xb7, 0x66, 0x60, 0xf8, 0xdd, 0xfd, 0x3e, 0x64, 0xfd, 0x97, 0x40, 0xe8,
+	0xf6, 0x59, 0x2f, 0x85, 0x58, 0xc7, 0x6f, 0xcf, 0xf7, 0xa0, 0x48, 0xbb, 0x84, 0x0e, 0x21, 0x27,
+	0xbd, 0x45, 0x41, 0xdf, 0x39, 0xfb, 0xb5, 0x0a, 0x1b, 0x61, 0x73, 0xde, 0x67, 0x2d, 0xda, 0x25,
+	0xf4, 0x42, 0x3c, 0xcb, 0x14, 0x9f, 0xd1, 0xd6, 0x5c, 0x6f, 0x34, 0xd8, 0x48, 0xef, 0x2e, 0xf0,
+	0x9e, 0x83, 0xa9, 0xcb, 0x7f, 0x7b, 0x10, 0xa1, 0xae, 0xc9, 0x47, 0x17, 0x85, 0xb7, 0xcf, 0x42,
+	0x93, 0x6d, 0x4b, 0x6f, 0xf9, 0x87, 0xdb, 0x56, 0x7a, 0x7f, 0x50, 0xb8, 0x35, 0x03, 0x43, 0x66,
+	0xd6, 0xbf, 0x3d, 0x1f, 0xc1, 0xec, 0xe4, 0xeb, 0x80, 0xc2, 0xdb, 0x67, 0xa1, 0xc9, 0xde, 0xcd,
+	0xae, 0x5c, 0x47, 0x78, 0x77, 0xe0, 0x0a, 0x7d, 0x61, 0x63, 0x26, 0x8e, 0xdc, 0x29, 0xbb, 0x48,
+	0x1a, 0xd1, 0x69, 0xe0, 0x62, 0x6b, 0x61, 0x63, 0x26, 0x8e, 0xec, 0x85, 0xd2, 0x6e, 0x4c, 0x84,
+	0x17, 0x4e, 0x5f, 0x24, 0x2e, 0x6c, 0x9e, 0x8d, 0xe8, 0x8f, 0x71, 0x02, 0x68, 0xfa, 0x36, 0x2c,
+	0x2a, 0xce, 0x7d, 0x6d, 0x96, 0x8d, 0x78, 0x6f, 0xc1, 0x6b, 0xb6, 0xda, 0x25, 0xd4, 0x02, 0x18,
+	0xef, 0x4f, 0xa0, 0xb7, 0xcf, 0xdc, 0xc0, 0x60, 0x03, 0x7d, 0x67, 0xce, 0x8d, 0x0e, 0xed, 0x12,
+	0x7a, 0x09, 0xea, 0xe4, 0x8e, 0x00, 0xba, 0x33, 0xe7, 0xc6, 0x01, 0x1b, 0xec, 0xee, 0x42, 0xdb,
+	0x0c, 0xda, 0x25, 0xe4, 0xc1, 0xea, 0x54, 0xa5, 0x8e, 0xc2, 0x7b, 0x89, 0xda, 0x3b, 0x28, 0x14,
+	0xe7, 0x45, 0x97, 0x46, 0x5d, 0x7b, 0x84, 0x3d, 0x6a, 0x5e, 0x72, 0x39, 0xa3, 0xc1, 0xef, 0x85,
+	0xdf, 0x09, 0xd9, 0xdf, 0x9a, 0xc6, 0x8b, 0x90, 0x35, 0x12, 0xdb, 0x1f, 0xf5, 0x09, 0x24, 0x69,
+	0x2d, 0x8b, 0xc2, 0xa7, 0xb4, 0xbc, 0x27, 0x51, 0xd0, 0x66, 0xa1, 0xf8, 0x3d, 0x3e, 0x85, 0x34,
+	0xaf, 0xa8, 0xd1, 0x46, 0x44, 0x9a, 0x92, 0x2b, 0xfc, 0xc2, 0xb7, 0x67, 0x23, 0xf9, 0xfd, 0x62,
+	0x58, 0x92, 0xcb, 0x55, 0xb4, 0x19, 0x11, 0x83, 0xa6, 0xca, 0xea, 0xc2, 0x3b, 0x73, 0x60, 0xca,
+	0xb3, 0x55, 0x2a, 0xd5, 0x22, 0x66, 0xeb, 0x74, 0xf1, 0x58, 0xd8, 0x3c, 0x1b, 0xd1, 0x1f, 0x63,
+	0x00, 0x97, 0x27, 0xaa, 0x20, 0xf4, 0x6e, 0x04, 0x79, 0x58, 0xe1, 0x56, 0xb8, 0x33, 0x1f, 0xb2,
+	0x18, 0xef, 0x30, 0x45, 0x6f, 0x8f, 0x7d, 0xf0, 0x7f, 0x03, 0x00, 0xb4, 0xc8, 0x2f, 0xdb, 0x1d,
+	0x44, 0x00, 0x00,
 }
@@ -3,15 +3,11 @@ package handler
 import (
 	"context"
 	"server/common"
+	"server/common/dbtest"
 	"server/task-srv/db"
 	task_proto "server/task-srv/proto/task"
 	user_proto "server/user-srv/proto/user"
 	"testing"
-	"time"
-
-	"github.com/micro/go-micro/client"
-	nats_broker "github.com/micro/go-plugins/broker/nats"
-	nats_transport "github.com/micro/go-plugins/transport/nats"
 )
 
 var task = &task_proto.Task{
@@ -26,15 +22,7 @@ var task = &task_proto.Task{
 }
 
 func initDb() {
-	cl := client.NewClient(
-		client.Transport(nats_transport.NewTransport()),
-		client.Broker(nats_broker.NewBroker()),
-		client.RequestTimeout(4*time.Second),
-		client.Retries(5),
-	)
-	// ctx := common.NewTestContext(context.TODO())
-	// db.RemoveDb(ctx, cl)
-	db.Init(cl)
+	db.Init(dbtest.NewClient())
 }
 
 func createTask(ctx context.Context, hdlr *TaskService, t *testing.T) *task_proto.Task {
@@ -52,7 +40,7 @@ func createTask(ctx context.Context, hdlr *TaskService, t *testing.T) *task_prot
 func TestAll(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
@@ -74,7 +62,7 @@ func TestAll(t *testing.T) {
 func TestTaskIsCreated(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
@@ -86,7 +74,7 @@ func TestTaskIsCreated(t *testing.T) {
 func TestTaskRead(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
 		return
@@ -111,7 +99,7 @@ func TestTaskRead(t *testing.T) {
 func TestTaskDelete(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
 		return
@@ -128,7 +116,7 @@ func TestTaskDelete(t *testing.T) {
 func TestByCreator(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
 		return
@@ -154,7 +142,7 @@ func TestByCreator(t *testing.T) {
 func TestByAssign(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
 		return
@@ -180,7 +168,7 @@ func TestByAssign(t *testing.T) {
 func TestFilter(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
 		return
@@ -209,7 +197,7 @@ func TestFilter(t *testing.T) {
 func TestCountByUser(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
 		return
@@ -235,7 +223,7 @@ func TestCountByUser(t *testing.T) {
 func TestSearch(t *testing.T) {
 	initDb()
 	hdlr := new(TaskService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	task := createTask(ctx, hdlr, t)
 	if task == nil {
 		return
@@ -30,7 +30,7 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 
 	return &clientWrapper{
 		Db_client: cl,
@@ -697,6 +697,49 @@ func CreateEmpoyeeModuleAccess(ctx context.Context, userId, orgId string, module
 	return nil
 }
 
+// ReadEmployeeRoles reads the overall role of an employee and the roles of its team memberships in the organisation
+func ReadEmployeeRoles(ctx context.Context, userId, orgId, roleId string) (*static_proto.Role, []*team_proto.TeamRole, error) {
+	var role *static_proto.Role
+	if len(roleId) > 0 {
+		q := fmt.Sprintf(`
+			FOR doc IN %v
+			FILTER doc._key == "%v"
+			RETURN doc`, common.DbRoleTable, roleId)
+		resp, err := runQuery(ctx, q, common.DbRoleTable)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(resp.Records) > 0 {
+			role = &static_proto.Role{}
+			if err := jsonpb.Unmarshal(strings.NewReader(resp.Records[0].Parameter3), role); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	var teamRoles []*team_proto.TeamRole
+	q := fmt.Sprintf(`
+		FOR team, membership IN INBOUND "%v/%v" %v
+		FILTER team.parameter1 == "%v"
+		FOR doc IN %v FILTER doc._key == membership.parameter1
+		RETURN {data: {team_id: team._key, role: doc.data}}`,
+		common.DbUserTable, userId, common.DbTeamMembershipTable,
+		orgId,
+		common.DbRoleTable)
+	resp, err := runQuery(ctx, q, common.DbTeamMembershipTable)
+	if err != nil {
+		return nil, nil, err
+	}
+	// parsing
+	for _, r := range resp.Records {
+		var teamRole team_proto.TeamRole
+		if err := jsonpb.Unmarshal(strings.NewReader(r.Parameter3), &teamRole); err == nil {
+			teamRoles = append(teamRoles, &teamRole)
+		}
+	}
+	return role, teamRoles, nil
+}
+
 func GetAccessibleModulesByEmployee(ctx context.Context, userId, orgId string) ([]*static_proto.Module, error) {
 	var modules []*static_proto.Module
 	q := fmt.Sprintf(`
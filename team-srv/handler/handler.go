@@ -295,11 +295,23 @@ func (p *TeamService) PutEmployeeInfo(ctx context.Context, req *team_proto.PutEm
 
 	//only store into redis if the user is employee
 	if employee != nil {
+		// the permissions of the roles are checked by the api for every request of the employee
+		var roleId string
+		if employee.Role != nil {
+			roleId = employee.Role.Id
+		}
+		role, teamRoles, err := db.ReadEmployeeRoles(ctx, req.UserId, req.OrgId, roleId)
+		if err != nil {
+			return common.InternalServerError(common.TeamSrv, p.PutEmployeeInfo, err, "ReadEmployeeRoles query is failed")
+		}
+
 		// create employee info
 		ei := &team_proto.EmployeeInfo{
-			UserId:   req.UserId,
-			OrgId:    req.OrgId,
-			Employee: employee,
+			UserId:    req.UserId,
+			OrgId:     req.OrgId,
+			Employee:  employee,
+			Role:      role,
+			TeamRoles: teamRoles,
 		}
 		//FIXME:Using EnumAsInts for now as default of EnumAsString is not working for jsonpb unmarshal - 01/07/2018
 		marshaler := jsonpb.Marshaler{EmitDefaults: true, EnumsAsInts: true}
@@ -54,6 +54,7 @@ It has these top-level messages:
 	CheckValidEmployeeResponse
 	PutEmployeeInfoResponse
 	EmployeeInfo
+	TeamRole
 	EmployeeProfile
 	Qualification
 	Experience
@@ -1836,9 +1837,11 @@ func (m *PutEmployeeInfoResponse_Data) GetEmployee() *Employee {
 }
 
 type EmployeeInfo struct {
-	UserId   string    `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty"`
-	OrgId    string    `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
-	Employee *Employee `protobuf:"bytes,3,opt,name=employee" json:"employee,omitempty"`
+	UserId    string                    `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	OrgId     string                    `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	Employee  *Employee                 `protobuf:"bytes,3,opt,name=employee" json:"employee,omitempty"`
+	Role      *go_micro_srv_static.Role `protobuf:"bytes,5,opt,name=role" json:"role,omitempty"`
+	TeamRoles []*TeamRole               `protobuf:"bytes,6,rep,name=team_roles,json=teamRoles" json:"team_roles,omitempty"`
 }
 
 func (m *EmployeeInfo) Reset()                    { *m = EmployeeInfo{} }
@@ -1867,6 +1870,45 @@ func (m *EmployeeInfo) GetEmployee() *Employee {
 	return nil
 }
 
+func (m *EmployeeInfo) GetRole() *go_micro_srv_static.Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *EmployeeInfo) GetTeamRoles() []*TeamRole {
+	if m != nil {
+		return m.TeamRoles
+	}
+	return nil
+}
+
+// the role of a team membership, with its permissions
+type TeamRole struct {
+	TeamId string                    `protobuf:"bytes,1,opt,name=team_id,json=teamId" json:"team_id,omitempty"`
+	Role   *go_micro_srv_static.Role `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *TeamRole) Reset()                    { *m = TeamRole{} }
+func (m *TeamRole) String() string            { return proto.CompactTextString(m) }
+func (*TeamRole) ProtoMessage()               {}
+func (*TeamRole) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *TeamRole) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *TeamRole) GetRole() *go_micro_srv_static.Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
 type EmployeeProfile struct {
 	Id             string           `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	OrgId          string           `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
@@ -1879,7 +1921,7 @@ type EmployeeProfile struct {
 func (m *EmployeeProfile) Reset()                    { *m = EmployeeProfile{} }
 func (m *EmployeeProfile) String() string            { return proto.CompactTextString(m) }
 func (*EmployeeProfile) ProtoMessage()               {}
-func (*EmployeeProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*EmployeeProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *EmployeeProfile) GetId() string {
 	if m != nil {
@@ -1936,7 +1978,7 @@ type Qualification struct {
 func (m *Qualification) Reset()                    { *m = Qualification{} }
 func (m *Qualification) String() string            { return proto.CompactTextString(m) }
 func (*Qualification) ProtoMessage()               {}
-func (*Qualification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*Qualification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *Qualification) GetId() string {
 	if m != nil {
@@ -2001,7 +2043,7 @@ type Experience struct {
 func (m *Experience) Reset()                    { *m = Experience{} }
 func (m *Experience) String() string            { return proto.CompactTextString(m) }
 func (*Experience) ProtoMessage()               {}
-func (*Experience) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*Experience) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *Experience) GetId() string {
 	if m != nil {
@@ -2071,7 +2113,7 @@ type ModuleAuthorisation struct {
 func (m *ModuleAuthorisation) Reset()                    { *m = ModuleAuthorisation{} }
 func (m *ModuleAuthorisation) String() string            { return proto.CompactTextString(m) }
 func (*ModuleAuthorisation) ProtoMessage()               {}
-func (*ModuleAuthorisation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*ModuleAuthorisation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *ModuleAuthorisation) GetId() string {
 	if m != nil {
@@ -2170,6 +2212,7 @@ func init() {
 	proto.RegisterType((*PutEmployeeInfoResponse)(nil), "go.micro.srv.team.PutEmployeeInfoResponse")
 	proto.RegisterType((*PutEmployeeInfoResponse_Data)(nil), "go.micro.srv.team.PutEmployeeInfoResponse.Data")
 	proto.RegisterType((*EmployeeInfo)(nil), "go.micro.srv.team.EmployeeInfo")
+	proto.RegisterType((*TeamRole)(nil), "go.micro.srv.team.TeamRole")
 	proto.RegisterType((*EmployeeProfile)(nil), "go.micro.srv.team.EmployeeProfile")
 	proto.RegisterType((*Qualification)(nil), "go.micro.srv.team.Qualification")
 	proto.RegisterType((*Experience)(nil), "go.micro.srv.team.Experience")
@@ -2540,127 +2583,129 @@ func (h *TeamService) DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequ
 func init() { proto.RegisterFile("server/team-srv/proto/team/team.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1944 bytes of a gzipped FileDescriptorProto
+	// 1980 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4d, 0x73, 0xdc, 0x48,
-	0x75, 0x34, 0xdf, 0x7e, 0x63, 0xcf, 0x2e, 0x8d, 0x3f, 0xb4, 0x13, 0x1c, 0x7b, 0xbb, 0x2a, 0xac,
-	0x77, 0x8d, 0xc7, 0x5b, 0x0e, 0xae, 0xdd, 0x14, 0xec, 0x2e, 0x93, 0x38, 0x26, 0x2e, 0x08, 0x31,
-	0x4a, 0x42, 0x2e, 0x54, 0x05, 0x45, 0x6a, 0xdb, 0xc2, 0xd2, 0x68, 0x22, 0x69, 0x0c, 0xae, 0xe2,
-	0x06, 0x27, 0xf8, 0x01, 0x14, 0x27, 0x4e, 0x9c, 0xa9, 0xca, 0x81, 0xe2, 0xc0, 0x89, 0x2b, 0x27,
-	0x0a, 0x72, 0xe0, 0xc2, 0x19, 0x7e, 0x01, 0x70, 0xa3, 0xfa, 0x6b, 0x46, 0xd2, 0xb4, 0xc6, 0xd2,
-	0xb8, 0xec, 0x2a, 0x5f, 0x32, 0xee, 0xd6, 0x7b, 0xaf, 0xdf, 0x47, 0xbf, 0xcf, 0x0e, 0xdc, 0x09,
-	0x49, 0x70, 0x46, 0x82, 0xed, 0x88, 0x98, 0xde, 0x56, 0x18, 0x9c, 0x6d, 0x0f, 0x02, 0x3f, 0xf2,
-	0xd9, 0x92, 0xfd, 0xd3, 0x65, 0x6b, 0xf4, 0xa5, 0x63, 0xbf, 0xeb, 0x39, 0x56, 0xe0, 0x77, 0xc3,
-	0xe0, 0xac, 0x4b, 0x3f, 0x74, 0x36, 0x05, 0x66, 0x18, 0x99, 0x91, 0x63, 0xc5, 0x70, 0xf9, 0x86,
-	0xf8, 0xe1, 0xf8, 0x9d, 0xae, 0x00, 0x36, 0x2d, 0xcb, 0x1f, 0xf6, 0xa3, 0x18, 0xb4, 0xd8, 0x91,
-	0xbf, 0x02, 0xfe, 0x33, 0x01, 0xef, 0x07, 0xc7, 0x66, 0xdf, 0x09, 0xcd, 0xc8, 0xf1, 0xfb, 0x31,
-	0xa4, 0xf8, 0x76, 0x62, 0x21, 0xd0, 0xa5, 0x54, 0xc3, 0x90, 0x04, 0x31, 0x34, 0xba, 0x64, 0xff,
-	0xa4, 0xb8, 0x1a, 0x04, 0xbe, 0x3d, 0xb4, 0xe2, 0x5c, 0x89, 0x1d, 0xf9, 0xcb, 0xe1, 0xf1, 0x5d,
-	0xa8, 0xee, 0x99, 0x91, 0x89, 0x36, 0xa1, 0x4a, 0x55, 0xa0, 0x6b, 0xeb, 0xda, 0x46, 0x6b, 0x67,
-	0xa5, 0x3b, 0xa1, 0x9c, 0xee, 0x33, 0x62, 0x7a, 0x06, 0x03, 0xc2, 0x9f, 0x42, 0xa3, 0x17, 0x04,
-	0x0c, 0x6f, 0x0b, 0x6a, 0x74, 0x2b, 0xd4, 0xb5, 0xf5, 0xca, 0x34, 0x44, 0x0e, 0x85, 0xff, 0xa8,
-	0x01, 0xf4, 0x5c, 0xd7, 0x20, 0xaf, 0x87, 0x24, 0x8c, 0xd0, 0x12, 0xd4, 0xfd, 0xe0, 0xf8, 0xa5,
-	0x63, 0xb3, 0x73, 0xe7, 0x8c, 0x9a, 0x1f, 0x1c, 0x1f, 0xd8, 0x68, 0x05, 0x1a, 0x14, 0x9c, 0xee,
-	0x97, 0xd9, 0x7e, 0x9d, 0x2e, 0x0f, 0x6c, 0xb4, 0x08, 0x35, 0xd7, 0xf1, 0x9c, 0x48, 0xaf, 0xac,
-	0x6b, 0x1b, 0x15, 0x83, 0x2f, 0xd0, 0x32, 0xd4, 0xfd, 0xa3, 0xa3, 0x90, 0x44, 0x7a, 0x95, 0x6d,
-	0x8b, 0x15, 0xba, 0x03, 0xed, 0xd0, 0x0f, 0xa2, 0x97, 0x03, 0x33, 0x30, 0x3d, 0x12, 0x91, 0x40,
-	0xaf, 0x31, 0x6a, 0x0b, 0x74, 0xf7, 0x50, 0x6e, 0x8e, 0xc0, 0x6c, 0x27, 0x20, 0x16, 0xd5, 0xb8,
-	0x5e, 0x1f, 0x83, 0xed, 0xc9, 0x4d, 0x7c, 0x0a, 0x2d, 0xc6, 0x79, 0x38, 0xf0, 0xfb, 0x21, 0x41,
-	0x5d, 0xa8, 0xda, 0x66, 0x64, 0x0a, 0x85, 0x75, 0x14, 0x72, 0x0b, 0x15, 0x19, 0x0c, 0x0e, 0x21,
-	0xa8, 0x5a, 0xbe, 0x4d, 0x98, 0x40, 0x15, 0x83, 0xfd, 0x8d, 0x74, 0x68, 0x78, 0x24, 0x0c, 0xcd,
-	0x63, 0xc2, 0x04, 0x9a, 0x33, 0xe4, 0x12, 0xbb, 0xb0, 0xf0, 0x20, 0x20, 0x66, 0x44, 0xa4, 0xa6,
-	0x8a, 0xd8, 0x27, 0xa6, 0xd6, 0x72, 0x86, 0x5a, 0x2b, 0x71, 0xb5, 0xe2, 0x53, 0x68, 0xcb, 0xd3,
-	0x84, 0x74, 0x9b, 0x09, 0xe9, 0x54, 0xc7, 0x5d, 0x46, 0xb4, 0xe7, 0x03, 0xfb, 0x1a, 0x45, 0x93,
-	0xa7, 0x5d, 0xbd, 0x68, 0x8f, 0xa1, 0x65, 0x10, 0xd3, 0x96, 0x82, 0xb5, 0xa1, 0x3c, 0xba, 0xd9,
-	0x65, 0xc7, 0x2e, 0xcc, 0xbb, 0x03, 0xf3, 0x9c, 0xdc, 0xd5, 0x73, 0xfe, 0x04, 0x16, 0xf6, 0x88,
-	0x4b, 0xc6, 0x46, 0xb9, 0x2c, 0xef, 0x9f, 0x43, 0x5b, 0x12, 0x14, 0xdc, 0x17, 0x63, 0xe8, 0xad,
-	0x06, 0x0b, 0xfb, 0x8e, 0x1b, 0x91, 0xe0, 0x06, 0xc6, 0x0a, 0x2a, 0x97, 0x08, 0xb3, 0x7a, 0x63,
-	0xbd, 0x42, 0xe5, 0x12, 0x4b, 0xdc, 0x87, 0xb6, 0x14, 0xeb, 0x5a, 0x02, 0xc9, 0xff, 0x34, 0x58,
-	0x78, 0x4a, 0xcc, 0xc0, 0x3a, 0xb9, 0x1e, 0x3d, 0xde, 0x82, 0x39, 0x46, 0xa6, 0x6f, 0x7a, 0x44,
-	0xa8, 0xb0, 0x49, 0x37, 0xbe, 0x67, 0x7a, 0x04, 0xad, 0x41, 0x8b, 0x7d, 0xf4, 0x88, 0xf7, 0x8a,
-	0x04, 0x42, 0x75, 0x40, 0xb7, 0x1e, 0xb3, 0x1d, 0x85, 0x15, 0x1a, 0xf9, 0xac, 0xd0, 0x54, 0x45,
-	0xec, 0x3e, 0xb4, 0xa5, 0xe8, 0xd7, 0xa2, 0xeb, 0x3f, 0x6b, 0xb0, 0xd8, 0x73, 0xdd, 0x67, 0x23,
-	0x79, 0x6e, 0x62, 0x9a, 0xfb, 0x8b, 0x06, 0x4b, 0x29, 0x21, 0x84, 0xf2, 0x7a, 0x09, 0xe5, 0x6d,
-	0xa9, 0x94, 0xa7, 0xc2, 0x9b, 0x39, 0x28, 0x75, 0x7a, 0xa2, 0x36, 0xb9, 0x07, 0x73, 0xc4, 0x1b,
-	0xb8, 0xfe, 0x39, 0x21, 0xb2, 0xce, 0xb8, 0xa5, 0x38, 0xfd, 0xa1, 0x80, 0x31, 0xc6, 0xd0, 0xf8,
-	0x5f, 0x1a, 0xac, 0xf0, 0xd4, 0x36, 0x69, 0x95, 0x4d, 0xa8, 0xd2, 0xc2, 0x49, 0x1d, 0x4e, 0x59,
-	0x49, 0xf5, 0x3c, 0x24, 0x81, 0xc1, 0x80, 0xd0, 0x27, 0xd0, 0x10, 0xe5, 0x1c, 0x63, 0xbe, 0xb5,
-	0xb3, 0x9a, 0x84, 0x97, 0xb5, 0x5e, 0x8f, 0xff, 0x1a, 0x12, 0x1a, 0x7d, 0x02, 0x4d, 0xc9, 0x0e,
-	0x93, 0xef, 0x02, 0xde, 0x47, 0xc0, 0xb1, 0x4b, 0x53, 0xcd, 0xb8, 0x34, 0xb5, 0x44, 0xc4, 0xfd,
-	0x43, 0x19, 0xf4, 0x49, 0x51, 0x85, 0xed, 0xf6, 0x12, 0xb6, 0xfb, 0x58, 0xc1, 0x41, 0x16, 0xea,
-	0xec, 0xe6, 0xfb, 0x9d, 0x26, 0xec, 0x17, 0x57, 0x81, 0x56, 0x44, 0x05, 0xd2, 0x42, 0xe5, 0x82,
-	0x16, 0xaa, 0x14, 0xb1, 0x10, 0xfe, 0x11, 0x2c, 0xd1, 0x34, 0x3b, 0x79, 0x41, 0x56, 0xa0, 0x41,
-	0x29, 0x8f, 0xfd, 0xb6, 0x4e, 0x97, 0x07, 0xc5, 0x93, 0xe1, 0x7f, 0x35, 0x58, 0x4e, 0x1f, 0x21,
-	0x0c, 0x73, 0x3f, 0x61, 0x98, 0xae, 0x42, 0x2f, 0x6a, 0xc4, 0xd9, 0xcd, 0xe2, 0x8e, 0x2b, 0xfe,
-	0x22, 0xd7, 0x7f, 0x6c, 0xc2, 0x72, 0x01, 0x13, 0xe2, 0x7f, 0x68, 0xb0, 0xc2, 0x13, 0xde, 0x8d,
-	0x0e, 0x8b, 0x54, 0xc7, 0xac, 0x48, 0xe5, 0xe9, 0x9c, 0xb7, 0x41, 0x7f, 0xd5, 0x40, 0x9f, 0x94,
-	0x2d, 0xb7, 0xc7, 0x65, 0xa1, 0x66, 0x99, 0xb6, 0x76, 0x1d, 0x01, 0xf3, 0x17, 0x1a, 0xbc, 0xc7,
-	0x43, 0x81, 0xfc, 0xfa, 0xd0, 0x3e, 0x1e, 0x55, 0x85, 0x33, 0x7b, 0x72, 0xcc, 0x95, 0xca, 0x19,
-	0xae, 0x54, 0x89, 0xdd, 0x01, 0xfc, 0x37, 0x0d, 0x3a, 0x2a, 0x36, 0x84, 0x72, 0xf7, 0x13, 0xca,
-	0xdd, 0xc9, 0x0c, 0x67, 0x2a, 0xe4, 0xd9, 0x3d, 0xe7, 0x8b, 0x4b, 0xc6, 0x33, 0xfc, 0x43, 0xf8,
-	0x4a, 0x92, 0xaf, 0xc3, 0xc0, 0x3f, 0x72, 0xdc, 0x91, 0x7a, 0xbf, 0xc9, 0xca, 0x46, 0xba, 0x23,
-	0xe8, 0xe2, 0x29, 0x74, 0x25, 0xae, 0x44, 0xc1, 0xff, 0xd4, 0x60, 0x35, 0x83, 0xbc, 0x50, 0xdb,
-	0x41, 0x42, 0x6d, 0xbb, 0x17, 0xaa, 0x2d, 0x85, 0x3f, 0xbb, 0xe6, 0xf6, 0x84, 0xe6, 0x2e, 0x27,
-	0xe0, 0xcf, 0x35, 0xb8, 0x95, 0x4e, 0x53, 0xe1, 0x89, 0x33, 0xb8, 0xf4, 0xed, 0x2c, 0x92, 0x67,
-	0xf0, 0x77, 0xa5, 0x11, 0xd3, 0x4c, 0xa4, 0xfa, 0x1c, 0x4d, 0xad, 0x99, 0x72, 0xb2, 0x66, 0xfc,
-	0xa5, 0x06, 0xef, 0x27, 0x95, 0xfe, 0xd8, 0xb7, 0x87, 0x2e, 0xe9, 0x59, 0x16, 0x09, 0xc3, 0x0b,
-	0x33, 0xd1, 0x2e, 0x34, 0x3c, 0x06, 0x1f, 0xea, 0x65, 0x95, 0x9f, 0x8b, 0x89, 0x15, 0xa7, 0x69,
-	0x48, 0xd8, 0x2c, 0xaf, 0x33, 0x00, 0x4f, 0xe3, 0x65, 0x26, 0x01, 0x5f, 0xc0, 0x9d, 0x6f, 0x93,
-	0x88, 0x93, 0x70, 0x5e, 0xb9, 0x82, 0x64, 0x78, 0xff, 0x7c, 0x64, 0x87, 0xd9, 0xb2, 0x2d, 0xfe,
-	0xb7, 0x06, 0x5f, 0xbd, 0x88, 0xb2, 0xe0, 0xd8, 0x48, 0xdc, 0xfb, 0xcf, 0x15, 0x97, 0x22, 0x1f,
-	0xa1, 0xd9, 0x1d, 0xe0, 0x33, 0xe1, 0x00, 0x31, 0x7b, 0x69, 0xf9, 0xed, 0x85, 0x4f, 0x60, 0x89,
-	0x77, 0xd3, 0x69, 0xa5, 0xad, 0x41, 0x4b, 0xde, 0xe2, 0xb1, 0xe2, 0x40, 0x6e, 0xf1, 0x64, 0x5a,
-	0x28, 0xf0, 0xee, 0xc3, 0x72, 0xfa, 0xa4, 0x99, 0xcc, 0xfe, 0xa6, 0x02, 0x55, 0xea, 0x20, 0x79,
-	0x07, 0x09, 0x3a, 0x34, 0x2c, 0x76, 0xf5, 0x6c, 0x91, 0xdd, 0xe5, 0x92, 0x7e, 0x19, 0xb2, 0x09,
-	0x8e, 0x2d, 0x12, 0xbc, 0x5c, 0x52, 0x8e, 0x62, 0x6d, 0x26, 0xfb, 0x1b, 0xad, 0x43, 0xcb, 0x26,
-	0xa1, 0x15, 0x38, 0x83, 0x58, 0x2e, 0x8f, 0x6f, 0xd1, 0x2a, 0xc2, 0xf1, 0x28, 0xc7, 0xbc, 0xb5,
-	0xe4, 0x0b, 0xba, 0x6b, 0xf9, 0xae, 0x1f, 0x88, 0x4e, 0x92, 0x2f, 0xd0, 0xb7, 0x60, 0x3e, 0xd6,
-	0xb0, 0x86, 0xfa, 0x1c, 0xb3, 0xd9, 0x6a, 0xc6, 0x88, 0x4a, 0xa4, 0xf1, 0xd6, 0xb8, 0xa1, 0x0d,
-	0xd1, 0x3d, 0x68, 0x8a, 0xd6, 0x3f, 0xd4, 0x41, 0x85, 0x2d, 0xc7, 0xb1, 0x87, 0xfc, 0xd7, 0x18,
-	0x81, 0x53, 0xd4, 0x90, 0x04, 0x67, 0x8e, 0x45, 0x42, 0xbd, 0x35, 0x0d, 0xf5, 0x29, 0x87, 0x32,
-	0x46, 0xe0, 0x68, 0x17, 0xe6, 0x84, 0xfa, 0xee, 0x9f, 0xeb, 0xf3, 0xd3, 0xa3, 0xda, 0x18, 0x12,
-	0xff, 0x46, 0x83, 0x76, 0x32, 0xaa, 0x15, 0x1b, 0xce, 0x15, 0xaa, 0xd7, 0xb7, 0xa0, 0x1a, 0xf8,
-	0xae, 0x6c, 0x8a, 0xde, 0x53, 0xfa, 0x81, 0xe1, 0xbb, 0xc4, 0x60, 0x60, 0xf8, 0x04, 0x60, 0xcc,
-	0x5a, 0xb1, 0xe2, 0x55, 0x9e, 0x54, 0xce, 0x77, 0xd2, 0x9b, 0x0a, 0x34, 0xe5, 0xed, 0xbf, 0xca,
-	0xeb, 0x2b, 0xd9, 0xaa, 0xe5, 0x62, 0x2b, 0x9e, 0x3b, 0xeb, 0x85, 0x73, 0xe7, 0x78, 0x4e, 0xdf,
-	0xc8, 0x33, 0xa7, 0x1f, 0xe9, 0xb7, 0x99, 0x47, 0xbf, 0xdf, 0x81, 0xf9, 0xf8, 0x83, 0x85, 0x3e,
-	0xc7, 0x90, 0x3e, 0x48, 0x22, 0x25, 0x9e, 0x34, 0x9e, 0xc4, 0x16, 0x46, 0x02, 0x39, 0x1e, 0x21,
-	0xa1, 0x40, 0x84, 0xdc, 0x81, 0x15, 0xda, 0x28, 0x49, 0xf9, 0x0f, 0xfa, 0x47, 0xfe, 0x45, 0x89,
-	0x05, 0xbf, 0x00, 0x7d, 0x12, 0x47, 0x44, 0xbb, 0x6f, 0x4c, 0xd4, 0x12, 0x6b, 0x53, 0xd4, 0xcd,
-	0x50, 0xc7, 0x75, 0xde, 0x23, 0x58, 0x3e, 0x1c, 0x46, 0x45, 0x78, 0xc9, 0x4a, 0x72, 0xa7, 0xd0,
-	0x79, 0x70, 0x42, 0xac, 0xd3, 0x1f, 0x98, 0xae, 0x63, 0x4f, 0x84, 0xe4, 0x45, 0xa8, 0x9d, 0xd1,
-	0x0f, 0x8c, 0x56, 0xd3, 0xe0, 0x8b, 0x99, 0x27, 0x0e, 0xf8, 0xb7, 0x1a, 0xac, 0x4c, 0xf0, 0x2d,
-	0x8e, 0x7a, 0x90, 0x48, 0xa1, 0xdb, 0x0a, 0x82, 0x19, 0x98, 0xb1, 0x9c, 0x79, 0xf9, 0x02, 0xfa,
-	0x27, 0x30, 0x1f, 0x3f, 0xa3, 0x70, 0x87, 0x3e, 0xb3, 0x6a, 0xfe, 0xa3, 0xc1, 0x3b, 0x29, 0xdf,
-	0xba, 0xca, 0xd0, 0xf0, 0x08, 0xda, 0xaf, 0x87, 0xa6, 0xeb, 0x1c, 0x39, 0x16, 0xf3, 0x8a, 0x50,
-	0xaf, 0x31, 0x5f, 0x58, 0x57, 0x70, 0xfb, 0xfd, 0x38, 0xa0, 0x91, 0xc2, 0x43, 0x5f, 0x40, 0x8b,
-	0xfc, 0x74, 0x40, 0x02, 0x87, 0xf4, 0x69, 0x1e, 0xa9, 0x67, 0x26, 0xb0, 0x87, 0x23, 0x28, 0x23,
-	0x8e, 0x81, 0xff, 0xa4, 0xc1, 0x42, 0xe2, 0x88, 0xab, 0x94, 0x7b, 0x11, 0x6a, 0x91, 0x13, 0xb9,
-	0x32, 0xa5, 0xf3, 0x05, 0x85, 0x0f, 0x87, 0x9e, 0x67, 0x06, 0xe7, 0x22, 0x9f, 0xcb, 0x65, 0x3a,
-	0xdb, 0x37, 0x26, 0xb2, 0x3d, 0x7e, 0xab, 0x01, 0x8c, 0x45, 0xbb, 0x51, 0xbc, 0xa3, 0x0e, 0x34,
-	0xed, 0x61, 0x60, 0xc6, 0x06, 0xdc, 0xa3, 0x35, 0xfe, 0xbb, 0x06, 0x5f, 0x16, 0xd5, 0xf9, 0x30,
-	0x3a, 0xf1, 0x03, 0x19, 0x3e, 0xaf, 0x50, 0xc0, 0xbb, 0x50, 0xe7, 0xd1, 0x56, 0x64, 0xac, 0xa9,
-	0x81, 0x59, 0x80, 0x8e, 0x92, 0x5c, 0x3d, 0x57, 0x92, 0xdb, 0xf9, 0xfd, 0xbb, 0xd0, 0xa2, 0x79,
-	0x48, 0x94, 0x34, 0x68, 0x1f, 0x2a, 0x3d, 0xd7, 0x45, 0xab, 0xea, 0x61, 0xb3, 0x88, 0xaa, 0x9d,
-	0xdb, 0x59, 0x9f, 0x79, 0x08, 0xc2, 0x25, 0xf4, 0x04, 0xea, 0xbc, 0xb3, 0x41, 0xeb, 0x99, 0x5d,
-	0xaf, 0xa4, 0xf6, 0xfe, 0x14, 0x88, 0x38, 0x41, 0xfe, 0xae, 0xa8, 0x24, 0x98, 0x78, 0xe0, 0x54,
-	0x12, 0x4c, 0x3e, 0x4a, 0xe2, 0x12, 0xed, 0xcd, 0x69, 0x32, 0x42, 0xb7, 0x33, 0x46, 0x80, 0x92,
-	0xd8, 0x5a, 0xe6, 0xf7, 0x38, 0x6f, 0xbc, 0x86, 0x57, 0xf2, 0x96, 0x78, 0xe7, 0x53, 0xf2, 0x96,
-	0x7c, 0xb8, 0xe3, 0x04, 0xf9, 0xb0, 0x4a, 0x49, 0x30, 0xf1, 0x4c, 0xa7, 0x24, 0x98, 0x7c, 0xf1,
-	0xe2, 0x04, 0xf9, 0xcb, 0x8c, 0x92, 0x60, 0xe2, 0xbd, 0x4a, 0x49, 0x30, 0xf9, 0xac, 0x83, 0x4b,
-	0xc8, 0x86, 0x85, 0xc4, 0xe3, 0x03, 0xfa, 0xe0, 0xe2, 0xe7, 0x09, 0x4e, 0x7e, 0x23, 0xef, 0x3b,
-	0x06, 0x2e, 0x21, 0x0f, 0xde, 0x4d, 0xb7, 0xfe, 0xe8, 0xa3, 0x5c, 0xb3, 0x74, 0x7e, 0xd6, 0x66,
-	0x81, 0xb9, 0x3b, 0x2e, 0xa1, 0x63, 0x68, 0x27, 0x87, 0xbf, 0x68, 0x23, 0xc7, 0x7c, 0x98, 0x1f,
-	0xf5, 0x61, 0xee, 0x49, 0x32, 0x97, 0x2b, 0x3d, 0x8c, 0x54, 0xca, 0x95, 0x31, 0xc8, 0x55, 0xca,
-	0x95, 0x35, 0xdd, 0xc4, 0x25, 0x14, 0x02, 0x9a, 0x1c, 0xcf, 0xa1, 0xaf, 0xe5, 0x9c, 0xe2, 0xf1,
-	0x23, 0xb7, 0x0a, 0xcd, 0xfc, 0x70, 0x09, 0xfd, 0x0c, 0x96, 0x94, 0xc3, 0x2d, 0xb4, 0x9d, 0x7f,
-	0x0c, 0xc6, 0x8f, 0xfe, 0xb8, 0xe8, 0xdc, 0x0c, 0x97, 0xd0, 0x39, 0x2c, 0xaa, 0x86, 0x46, 0xa8,
-	0x9b, 0xe3, 0x46, 0xc4, 0x46, 0x5c, 0x9d, 0xed, 0xdc, 0xf0, 0xa3, 0xa3, 0x7f, 0x35, 0x31, 0x4a,
-	0x8d, 0x4f, 0x75, 0xd0, 0xd7, 0x2f, 0x94, 0x46, 0x31, 0x90, 0xea, 0xec, 0x16, 0xc4, 0x1a, 0x71,
-	0xf3, 0x6b, 0x0d, 0x6e, 0x4f, 0x1f, 0xb6, 0xa0, 0x4f, 0x67, 0x98, 0xcf, 0x70, 0xae, 0xee, 0xcd,
-	0x3c, 0xd9, 0xe1, 0x4e, 0x90, 0xee, 0x06, 0x94, 0x4e, 0x90, 0xd1, 0x66, 0x28, 0x9d, 0x20, 0xab,
-	0xbd, 0xc0, 0x25, 0xf4, 0x63, 0x78, 0x27, 0x55, 0x31, 0xa3, 0x0f, 0xf3, 0x54, 0xd5, 0xfc, 0xb0,
-	0x8f, 0xf2, 0x17, 0xe0, 0xb8, 0x84, 0x5e, 0x03, 0x9a, 0xec, 0x22, 0x0a, 0x09, 0xa7, 0x74, 0xb7,
-	0xcc, 0xc6, 0x84, 0xc7, 0xae, 0xe4, 0x1c, 0x49, 0x19, 0xbb, 0x94, 0x43, 0x2d, 0x65, 0xec, 0x52,
-	0x0f, 0xa5, 0x70, 0xe9, 0x55, 0x9d, 0xfd, 0x3f, 0xb6, 0xbb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0xf4, 0x23, 0x3e, 0xf6, 0x27, 0x00, 0x00,
+	0xd5, 0x9a, 0x6f, 0xbf, 0xb1, 0x67, 0x43, 0x63, 0xc7, 0x5a, 0x65, 0x93, 0xd8, 0xaa, 0x0a, 0x9b,
+	0xac, 0xf1, 0x78, 0xcb, 0x21, 0xb5, 0x1b, 0x60, 0x77, 0x71, 0xe2, 0x84, 0x35, 0x10, 0x12, 0xb4,
+	0xbb, 0xec, 0x85, 0xaa, 0xa0, 0x48, 0x6d, 0x5b, 0x44, 0x1a, 0x4d, 0xd4, 0x1a, 0x17, 0xae, 0xe2,
+	0x06, 0x27, 0xf8, 0x01, 0x14, 0x27, 0x4e, 0x9c, 0xa9, 0xda, 0x03, 0xc5, 0x81, 0x13, 0x57, 0x4e,
+	0x14, 0x70, 0xe0, 0xc2, 0x79, 0xf9, 0x05, 0xc0, 0x8d, 0xea, 0x2f, 0x4d, 0x6b, 0xa6, 0x35, 0x96,
+	0xc6, 0x6b, 0x57, 0xf9, 0x62, 0x4d, 0x77, 0xbf, 0xf7, 0xfa, 0xbd, 0xd7, 0xfd, 0x3e, 0xdb, 0x70,
+	0x8b, 0xe0, 0xe4, 0x18, 0x27, 0xdb, 0x29, 0x76, 0xa3, 0x2d, 0x92, 0x1c, 0x6f, 0x0f, 0x93, 0x38,
+	0x8d, 0xd9, 0x90, 0xfd, 0xe9, 0xb3, 0x31, 0xfa, 0xd2, 0x61, 0xdc, 0x8f, 0x02, 0x2f, 0x89, 0xfb,
+	0x24, 0x39, 0xee, 0xd3, 0x05, 0x6b, 0x53, 0x60, 0x92, 0xd4, 0x4d, 0x03, 0x4f, 0xc1, 0xe5, 0x13,
+	0xe2, 0xc3, 0xf1, 0xad, 0xbe, 0x00, 0x76, 0x3d, 0x2f, 0x1e, 0x0d, 0x52, 0x05, 0x5a, 0xcc, 0xc8,
+	0xaf, 0x80, 0x7f, 0x4f, 0xc0, 0xc7, 0xc9, 0xa1, 0x3b, 0x08, 0x88, 0x9b, 0x06, 0xf1, 0x40, 0x41,
+	0x52, 0xa7, 0x73, 0x03, 0x81, 0x2e, 0xa5, 0x1a, 0x11, 0x9c, 0x28, 0x68, 0x74, 0xc8, 0xfe, 0x4c,
+	0x70, 0x35, 0x4c, 0x62, 0x7f, 0xe4, 0xa9, 0x5c, 0x89, 0x19, 0xf9, 0xe5, 0xf0, 0xf6, 0x5d, 0x68,
+	0xec, 0xb9, 0xa9, 0x8b, 0x36, 0xa1, 0x41, 0x55, 0x60, 0x1a, 0xeb, 0xc6, 0xed, 0xee, 0xce, 0x5a,
+	0x7f, 0x4a, 0x39, 0xfd, 0x8f, 0xb1, 0x1b, 0x39, 0x0c, 0xc8, 0x7e, 0x17, 0xda, 0xbb, 0x49, 0xc2,
+	0xf0, 0xb6, 0xa0, 0x49, 0xa7, 0x88, 0x69, 0xac, 0xd7, 0x67, 0x21, 0x72, 0x28, 0xfb, 0x8f, 0x06,
+	0xc0, 0x6e, 0x18, 0x3a, 0xf8, 0xd5, 0x08, 0x93, 0x14, 0xad, 0x42, 0x2b, 0x4e, 0x0e, 0x9f, 0x07,
+	0x3e, 0xdb, 0x77, 0xd1, 0x69, 0xc6, 0xc9, 0xe1, 0xbe, 0x8f, 0xd6, 0xa0, 0x4d, 0xc1, 0xe9, 0x7c,
+	0x8d, 0xcd, 0xb7, 0xe8, 0x70, 0xdf, 0x47, 0x2b, 0xd0, 0x0c, 0x83, 0x28, 0x48, 0xcd, 0xfa, 0xba,
+	0x71, 0xbb, 0xee, 0xf0, 0x01, 0xba, 0x0a, 0xad, 0xf8, 0xe0, 0x80, 0xe0, 0xd4, 0x6c, 0xb0, 0x69,
+	0x31, 0x42, 0xb7, 0xa0, 0x47, 0xe2, 0x24, 0x7d, 0x3e, 0x74, 0x13, 0x37, 0xc2, 0x29, 0x4e, 0xcc,
+	0x26, 0xa3, 0xb6, 0x4c, 0x67, 0x9f, 0xc9, 0xc9, 0x0c, 0xcc, 0x0f, 0x12, 0xec, 0x51, 0x8d, 0x9b,
+	0xad, 0x31, 0xd8, 0x9e, 0x9c, 0xb4, 0x5f, 0x42, 0x97, 0x71, 0x4e, 0x86, 0xf1, 0x80, 0x60, 0xd4,
+	0x87, 0x86, 0xef, 0xa6, 0xae, 0x50, 0x98, 0xa5, 0x91, 0x5b, 0xa8, 0xc8, 0x61, 0x70, 0x08, 0x41,
+	0xc3, 0x8b, 0x7d, 0xcc, 0x04, 0xaa, 0x3b, 0xec, 0x37, 0x32, 0xa1, 0x1d, 0x61, 0x42, 0xdc, 0x43,
+	0xcc, 0x04, 0x5a, 0x74, 0xe4, 0xd0, 0x0e, 0x61, 0xf9, 0x61, 0x82, 0xdd, 0x14, 0x4b, 0x4d, 0x55,
+	0x39, 0x1f, 0x45, 0xad, 0xb5, 0x02, 0xb5, 0xd6, 0x55, 0xb5, 0xda, 0x2f, 0xa1, 0x27, 0x77, 0x13,
+	0xd2, 0x6d, 0xe6, 0xa4, 0xd3, 0x6d, 0x77, 0x16, 0xd1, 0x3e, 0x19, 0xfa, 0x17, 0x28, 0x9a, 0xdc,
+	0xed, 0xfc, 0x45, 0x7b, 0x02, 0x5d, 0x07, 0xbb, 0xbe, 0x14, 0xac, 0x07, 0xb5, 0xec, 0x66, 0xd7,
+	0x02, 0xbf, 0x32, 0xef, 0x01, 0x2c, 0x71, 0x72, 0xe7, 0xcf, 0xf9, 0x53, 0x58, 0xde, 0xc3, 0x21,
+	0x4e, 0xf1, 0x17, 0xc5, 0xfb, 0xfb, 0xd0, 0x93, 0x04, 0x05, 0xf7, 0xd5, 0x18, 0xfa, 0x87, 0x01,
+	0xcb, 0x8f, 0x83, 0x30, 0xc5, 0xc9, 0x25, 0xf4, 0x15, 0x54, 0x2e, 0xe1, 0x66, 0xcd, 0xf6, 0x7a,
+	0x9d, 0xca, 0x25, 0x86, 0xf6, 0x00, 0x7a, 0x52, 0xac, 0x0b, 0x71, 0x24, 0xff, 0x33, 0x60, 0xf9,
+	0x23, 0xec, 0x26, 0xde, 0xd1, 0xc5, 0xe8, 0xf1, 0x1a, 0x2c, 0x32, 0x32, 0x03, 0x37, 0xc2, 0x42,
+	0x85, 0x1d, 0x3a, 0xf1, 0x7d, 0x37, 0xc2, 0xe8, 0x26, 0x74, 0xd9, 0x62, 0x84, 0xa3, 0x17, 0x38,
+	0x11, 0xaa, 0x03, 0x3a, 0xf5, 0x84, 0xcd, 0x68, 0x4e, 0xa1, 0x5d, 0xee, 0x14, 0x3a, 0x3a, 0x8f,
+	0x3d, 0x80, 0x9e, 0x14, 0xfd, 0x42, 0x74, 0xfd, 0x67, 0x03, 0x56, 0x76, 0xc3, 0xf0, 0xe3, 0x4c,
+	0x9e, 0xcb, 0x18, 0xe6, 0xfe, 0x62, 0xc0, 0xea, 0x84, 0x10, 0x42, 0x79, 0xbb, 0x39, 0xe5, 0x6d,
+	0xe9, 0x94, 0xa7, 0xc3, 0x9b, 0xdb, 0x29, 0x59, 0xbb, 0x22, 0x37, 0xb9, 0x0f, 0x8b, 0x38, 0x1a,
+	0x86, 0xf1, 0x09, 0xc6, 0x32, 0xcf, 0xb8, 0xa6, 0xd9, 0xfd, 0x91, 0x80, 0x71, 0xc6, 0xd0, 0xf6,
+	0xe7, 0x06, 0xac, 0xf1, 0xd0, 0x36, 0x7d, 0x2a, 0x9b, 0xd0, 0x18, 0x11, 0x9c, 0xe8, 0xdd, 0x29,
+	0x5d, 0xe9, 0x7f, 0x42, 0x70, 0xe2, 0x30, 0x20, 0xf4, 0x0e, 0xb4, 0x45, 0x3a, 0xc7, 0x98, 0xef,
+	0xee, 0x5c, 0xcf, 0xc3, 0x8b, 0xc5, 0xfe, 0x2e, 0xff, 0x3a, 0x12, 0x1a, 0xbd, 0x03, 0x1d, 0xc9,
+	0x0e, 0x93, 0xef, 0x14, 0xde, 0x33, 0x60, 0xe5, 0xd2, 0x34, 0x0a, 0x2e, 0x4d, 0x33, 0xe7, 0x71,
+	0xff, 0x50, 0x03, 0x73, 0x5a, 0x54, 0x71, 0x76, 0x7b, 0xb9, 0xb3, 0x7b, 0x5b, 0xc3, 0x41, 0x11,
+	0xea, 0xfc, 0xc7, 0xf7, 0x3b, 0x43, 0x9c, 0x9f, 0xaa, 0x02, 0xa3, 0x8a, 0x0a, 0xe4, 0x09, 0xd5,
+	0x2a, 0x9e, 0x50, 0xbd, 0xca, 0x09, 0xd9, 0x3f, 0x86, 0x55, 0x1a, 0x66, 0xa7, 0x2f, 0xc8, 0x1a,
+	0xb4, 0x29, 0xe5, 0xb1, 0xdd, 0xb6, 0xe8, 0x70, 0xbf, 0x7a, 0x30, 0xfc, 0xaf, 0x01, 0x57, 0x27,
+	0xb7, 0x10, 0x07, 0xf3, 0x20, 0x77, 0x30, 0x7d, 0x8d, 0x5e, 0xf4, 0x88, 0xf3, 0x1f, 0x4b, 0x38,
+	0xce, 0xf8, 0xab, 0x5c, 0xff, 0xf1, 0x11, 0xd6, 0x2a, 0x1c, 0xa1, 0xfd, 0x4f, 0x03, 0xd6, 0x78,
+	0xc0, 0xbb, 0xd4, 0x6e, 0x91, 0xea, 0x98, 0x25, 0xa9, 0x3c, 0x9c, 0xb3, 0xdf, 0xf6, 0x5f, 0x0d,
+	0x30, 0xa7, 0x65, 0x2b, 0x6d, 0x71, 0x45, 0xa8, 0x45, 0x47, 0xdb, 0xbc, 0x08, 0x87, 0xf9, 0x0b,
+	0x03, 0x5e, 0xe7, 0xae, 0x40, 0xae, 0x3e, 0xf2, 0x0f, 0xb3, 0xac, 0x70, 0x6e, 0x4b, 0x56, 0x4c,
+	0xa9, 0x56, 0x60, 0x4a, 0x75, 0xe5, 0x0e, 0xd8, 0x7f, 0x33, 0xc0, 0xd2, 0xb1, 0x21, 0x94, 0xfb,
+	0x38, 0xa7, 0xdc, 0x9d, 0x42, 0x77, 0xa6, 0x43, 0x9e, 0xdf, 0x72, 0x3e, 0x38, 0xa3, 0x3f, 0xb3,
+	0x7f, 0x04, 0x6f, 0xe4, 0xf9, 0x7a, 0x96, 0xc4, 0x07, 0x41, 0x98, 0xa9, 0xf7, 0x9b, 0x2c, 0x6d,
+	0xa4, 0x33, 0x82, 0xae, 0x3d, 0x83, 0xae, 0xc4, 0x95, 0x28, 0xf6, 0xbf, 0x0c, 0xb8, 0x5e, 0x40,
+	0x5e, 0xa8, 0x6d, 0x3f, 0xa7, 0xb6, 0x7b, 0xa7, 0xaa, 0x6d, 0x02, 0x7f, 0x7e, 0xcd, 0xed, 0x09,
+	0xcd, 0x9d, 0x4d, 0xc0, 0x9f, 0x1b, 0x70, 0x6d, 0x32, 0x4c, 0x91, 0xa3, 0x60, 0x78, 0xe6, 0xdb,
+	0x59, 0x25, 0xce, 0xd8, 0xdf, 0x83, 0x37, 0xf4, 0x4c, 0x4c, 0xd4, 0x39, 0x86, 0x5e, 0x33, 0xb5,
+	0x7c, 0xce, 0xf8, 0x4b, 0x03, 0x36, 0xf2, 0x4a, 0x7f, 0x12, 0xfb, 0xa3, 0x10, 0xef, 0x7a, 0x1e,
+	0x26, 0xe4, 0xd4, 0x48, 0x74, 0x0f, 0xda, 0x11, 0x83, 0x27, 0x66, 0x4d, 0x67, 0xe7, 0xa2, 0x63,
+	0xc5, 0x69, 0x3a, 0x12, 0xb6, 0xc8, 0xea, 0x1c, 0xb0, 0x67, 0xf1, 0x32, 0x97, 0x80, 0x9f, 0xc2,
+	0xad, 0x6f, 0xe3, 0x94, 0x93, 0x08, 0x5e, 0x84, 0x82, 0x24, 0x79, 0x70, 0x92, 0x9d, 0xc3, 0x7c,
+	0xd1, 0xd6, 0xfe, 0xb7, 0x01, 0x5f, 0x39, 0x8d, 0xb2, 0xe0, 0xd8, 0xc9, 0xdd, 0xfb, 0xf7, 0x35,
+	0x97, 0xa2, 0x1c, 0xa1, 0xf9, 0x0d, 0xe0, 0x3d, 0x61, 0x00, 0xca, 0x79, 0x19, 0xe5, 0xcf, 0xcb,
+	0x3e, 0x82, 0x55, 0x5e, 0x4d, 0x4f, 0x2a, 0xed, 0x26, 0x74, 0xe5, 0x2d, 0x1e, 0x2b, 0x0e, 0xe4,
+	0x14, 0x0f, 0xa6, 0x95, 0x1c, 0xef, 0x63, 0xb8, 0x3a, 0xb9, 0xd3, 0x5c, 0xc7, 0xfe, 0x59, 0x1d,
+	0x1a, 0xd4, 0x40, 0xca, 0x36, 0x12, 0x4c, 0x68, 0x7b, 0xec, 0xea, 0xf9, 0x22, 0xba, 0xcb, 0x21,
+	0x5d, 0x19, 0xb1, 0x0e, 0x8e, 0x2f, 0x02, 0xbc, 0x1c, 0x52, 0x8e, 0x94, 0x32, 0x93, 0xfd, 0x46,
+	0xeb, 0xd0, 0xf5, 0x31, 0xf1, 0x92, 0x60, 0xa8, 0xc4, 0x72, 0x75, 0x8a, 0x66, 0x11, 0x41, 0x44,
+	0x39, 0xe6, 0xa5, 0x25, 0x1f, 0xd0, 0x59, 0x2f, 0x0e, 0xe3, 0x44, 0x54, 0x92, 0x7c, 0x80, 0xbe,
+	0x05, 0x4b, 0x4a, 0xc1, 0x4a, 0xcc, 0xc5, 0xf5, 0xfa, 0x74, 0x62, 0x99, 0xb5, 0xa8, 0x44, 0x18,
+	0xef, 0x8e, 0x0b, 0x5a, 0x82, 0xee, 0x43, 0x47, 0x94, 0xfe, 0xc4, 0x04, 0x1d, 0xb6, 0x58, 0xed,
+	0x3f, 0xe3, 0x5f, 0x27, 0x03, 0xa7, 0xa8, 0x04, 0x27, 0xc7, 0x81, 0x87, 0x89, 0xd9, 0x9d, 0x85,
+	0xfa, 0x11, 0x87, 0x72, 0x32, 0x70, 0x74, 0x0f, 0x16, 0x85, 0xfa, 0x1e, 0x9c, 0x98, 0x4b, 0xb3,
+	0xbd, 0xda, 0x18, 0xd2, 0xfe, 0x8d, 0x01, 0xbd, 0xbc, 0x57, 0xab, 0xd6, 0x9c, 0xab, 0x94, 0xaf,
+	0x6f, 0x41, 0x23, 0x89, 0x43, 0x59, 0x14, 0xbd, 0xae, 0xb5, 0x03, 0x27, 0x0e, 0xb1, 0xc3, 0xc0,
+	0xec, 0x23, 0x80, 0x31, 0x6b, 0xd5, 0x92, 0x57, 0xb9, 0x53, 0xad, 0xdc, 0x4e, 0x9f, 0xd5, 0xa1,
+	0x23, 0x6f, 0xff, 0x79, 0x5e, 0x5f, 0xc9, 0x56, 0xb3, 0x14, 0x5b, 0x6a, 0xec, 0x6c, 0x55, 0x8e,
+	0x9d, 0xe3, 0x3e, 0x7d, 0xbb, 0x4c, 0x9f, 0x3e, 0xd3, 0x6f, 0xa7, 0x8c, 0x7e, 0xbf, 0x0b, 0x4b,
+	0xea, 0x83, 0x85, 0xb9, 0xc8, 0x90, 0xde, 0xcc, 0x23, 0xa9, 0x10, 0xfd, 0xa7, 0xca, 0xc0, 0xc9,
+	0x21, 0xab, 0x1e, 0x12, 0x2a, 0x78, 0xc8, 0x1d, 0x58, 0xa3, 0x85, 0x92, 0x94, 0x7f, 0x7f, 0x70,
+	0x10, 0x9f, 0x16, 0x58, 0xec, 0x4f, 0xc1, 0x9c, 0xc6, 0x11, 0xde, 0xee, 0x1b, 0x53, 0xb9, 0xc4,
+	0xcd, 0x19, 0xea, 0x66, 0xa8, 0xe3, 0x3c, 0xef, 0x43, 0xb8, 0xfa, 0x6c, 0x94, 0x56, 0xe1, 0xa5,
+	0x28, 0xc8, 0xbd, 0x04, 0xeb, 0xe1, 0x11, 0xf6, 0x5e, 0xfe, 0xd0, 0x0d, 0x03, 0x7f, 0xca, 0x25,
+	0xaf, 0x40, 0xf3, 0xd8, 0x0d, 0x05, 0xad, 0x8e, 0xc3, 0x07, 0x73, 0x77, 0x1c, 0xec, 0xdf, 0x1a,
+	0xb0, 0x36, 0xc5, 0xb7, 0xd8, 0xea, 0x61, 0x2e, 0x84, 0x6e, 0x6b, 0x08, 0x16, 0x60, 0x2a, 0x31,
+	0xf3, 0xec, 0x09, 0xf4, 0xe7, 0x06, 0x2c, 0xa9, 0x9b, 0x54, 0x2e, 0xd1, 0xe7, 0xee, 0xc6, 0x54,
+	0x34, 0xd6, 0xaf, 0x03, 0x6b, 0x6b, 0x3e, 0xa7, 0x03, 0x62, 0xb6, 0x0a, 0x4b, 0x30, 0x66, 0x73,
+	0x14, 0x6d, 0x31, 0x15, 0xbf, 0xc8, 0x77, 0x1a, 0x9d, 0xc6, 0x95, 0xa6, 0xed, 0x40, 0x47, 0x2e,
+	0xaa, 0x15, 0xb1, 0x91, 0xab, 0x88, 0x2b, 0x7a, 0xb6, 0xff, 0x18, 0xf0, 0xda, 0x84, 0x87, 0x38,
+	0x4f, 0x07, 0xf7, 0x21, 0xf4, 0x5e, 0x8d, 0xdc, 0x30, 0x38, 0x08, 0x3c, 0x66, 0xdb, 0xc4, 0x6c,
+	0x32, 0x45, 0xac, 0x6b, 0x14, 0xf1, 0x03, 0x15, 0xd0, 0x99, 0xc0, 0x43, 0x1f, 0x40, 0x17, 0xff,
+	0x74, 0x88, 0x93, 0x00, 0x0f, 0xbc, 0x4c, 0x9f, 0xba, 0x30, 0xfc, 0x28, 0x83, 0x72, 0x54, 0x0c,
+	0xfb, 0x4f, 0x06, 0x2c, 0xe7, 0xb6, 0x38, 0x4f, 0xb9, 0x57, 0xa0, 0x99, 0x06, 0x69, 0x28, 0x13,
+	0x13, 0x3e, 0xa0, 0xf0, 0x64, 0x14, 0x45, 0x6e, 0x72, 0x22, 0xb2, 0x12, 0x39, 0x9c, 0xcc, 0x59,
+	0xda, 0x53, 0x39, 0x0b, 0x7d, 0x0d, 0x81, 0xb1, 0x68, 0x97, 0x8a, 0x77, 0x64, 0x41, 0xc7, 0x1f,
+	0x25, 0xae, 0xd2, 0xa6, 0xcf, 0xc6, 0xf6, 0xdf, 0x0d, 0xf8, 0xb2, 0xa8, 0x31, 0x46, 0xe9, 0x51,
+	0x9c, 0xc8, 0x20, 0x70, 0x8e, 0x02, 0xde, 0x85, 0x16, 0x8f, 0x19, 0xc2, 0x94, 0x67, 0x86, 0x17,
+	0x01, 0x9a, 0xd9, 0x59, 0xab, 0x94, 0x9d, 0xed, 0xfc, 0xfe, 0x0a, 0x74, 0xa9, 0xf1, 0x8a, 0xc4,
+	0x0c, 0x3d, 0x86, 0xfa, 0x6e, 0x18, 0xa2, 0xeb, 0xfa, 0x96, 0xb9, 0x88, 0x0d, 0xd6, 0x8d, 0xa2,
+	0x65, 0xee, 0x48, 0xed, 0x05, 0xf4, 0x14, 0x5a, 0xbc, 0x3e, 0x43, 0xeb, 0x85, 0xb5, 0xbb, 0xa4,
+	0xb6, 0x31, 0x03, 0x42, 0x25, 0xc8, 0x5f, 0x47, 0xb5, 0x04, 0x73, 0xcf, 0xb4, 0xd6, 0xc6, 0x0c,
+	0x88, 0x8c, 0xe0, 0x3e, 0x34, 0x68, 0x48, 0x45, 0x37, 0x0a, 0x1a, 0x99, 0x92, 0xd8, 0xcd, 0xc2,
+	0x75, 0x95, 0x37, 0x5e, 0x89, 0x68, 0x79, 0xcb, 0xbd, 0x56, 0x5a, 0x1b, 0x33, 0x20, 0x54, 0x82,
+	0xbc, 0xe5, 0xa6, 0x25, 0x98, 0x7b, 0x6c, 0xb4, 0x36, 0x66, 0x40, 0xa8, 0x04, 0xf9, 0xfb, 0x92,
+	0x96, 0x60, 0xee, 0xd5, 0xcd, 0xda, 0x98, 0x01, 0x91, 0x11, 0xf4, 0x61, 0x39, 0xf7, 0x84, 0x82,
+	0xde, 0x3c, 0xfd, 0x91, 0x85, 0x93, 0xbf, 0x5d, 0xf6, 0x35, 0xc6, 0x5e, 0x40, 0x11, 0x5c, 0x99,
+	0x6c, 0x60, 0xa0, 0xb7, 0x4a, 0xbd, 0x08, 0xf0, 0xbd, 0x36, 0x2b, 0xbc, 0x1e, 0xd8, 0x0b, 0xe8,
+	0x10, 0x7a, 0xf9, 0x16, 0x36, 0xba, 0x5d, 0xa2, 0xcb, 0xcd, 0xb7, 0xba, 0x53, 0xba, 0x1f, 0xce,
+	0xe5, 0x9a, 0x6c, 0xa9, 0x6a, 0xe5, 0x2a, 0x68, 0x47, 0x5b, 0x9b, 0xa5, 0x60, 0xb3, 0xed, 0x08,
+	0xa0, 0xe9, 0x26, 0x23, 0xfa, 0x6a, 0xc9, 0x5e, 0x24, 0xdf, 0x72, 0xab, 0x52, 0xe7, 0xd2, 0x5e,
+	0x40, 0x3f, 0x83, 0x55, 0x6d, 0x8b, 0x0e, 0x6d, 0x97, 0x6f, 0xe6, 0xf1, 0xad, 0xdf, 0xae, 0xda,
+	0xfd, 0xb3, 0x17, 0xd0, 0x09, 0xac, 0xe8, 0x5a, 0x5f, 0xa8, 0x5f, 0xe2, 0x46, 0x28, 0x8d, 0x3a,
+	0x6b, 0xbb, 0x34, 0x7c, 0xb6, 0xf5, 0xaf, 0xa6, 0x1a, 0xc2, 0x6a, 0x6f, 0x0a, 0x7d, 0xed, 0x54,
+	0x69, 0x34, 0x6d, 0x35, 0xeb, 0x5e, 0x45, 0xac, 0x8c, 0x9b, 0x5f, 0x1b, 0x70, 0x63, 0x76, 0xcb,
+	0x08, 0xbd, 0x3b, 0x47, 0x97, 0x89, 0x73, 0x75, 0x7f, 0xee, 0xfe, 0x14, 0x37, 0x82, 0xc9, 0x9a,
+	0x46, 0x6b, 0x04, 0x05, 0xc5, 0x92, 0xb5, 0x59, 0x0a, 0x36, 0xdb, 0xee, 0x27, 0xf0, 0xda, 0x44,
+	0xde, 0x8f, 0xee, 0x94, 0xa9, 0x0d, 0xf8, 0x66, 0x6f, 0x95, 0x2f, 0x23, 0xec, 0x05, 0xf4, 0x0a,
+	0xd0, 0x74, 0x2d, 0x54, 0x49, 0x38, 0xad, 0xb9, 0x15, 0x96, 0x57, 0xdc, 0x77, 0xe5, 0xbb, 0x61,
+	0x5a, 0xdf, 0xa5, 0x6d, 0xcd, 0x59, 0x77, 0x4a, 0x40, 0xca, 0x8d, 0x5e, 0xb4, 0xd8, 0x7f, 0xe3,
+	0xdd, 0xfd, 0xff, 0x00, 0xaa, 0x60, 0x6a, 0xd5, 0xbc, 0x28, 0x00, 0x00,
 }
@@ -3,27 +3,16 @@ package handler
 import (
 	"context"
 	"server/common"
+	"server/common/dbtest"
 	"server/todo-srv/db"
 	todo_proto "server/todo-srv/proto/todo"
 	user_proto "server/user-srv/proto/user"
 	"testing"
 	"time"
-
-	"github.com/micro/go-micro/client"
-	nats_broker "github.com/micro/go-plugins/broker/nats"
-	nats_transport "github.com/micro/go-plugins/transport/nats"
 )
 
 func initDb() {
-	cl := client.NewClient(
-		client.Transport(nats_transport.NewTransport()),
-		client.Broker(nats_broker.NewBroker()),
-		client.RequestTimeout(4*time.Second),
-		client.Retries(5),
-	)
-	// ctx := common.NewTestContext(context.TODO())
-	// db.RemoveDb(ctx, cl)
-	db.Init(cl)
+	db.Init(dbtest.NewClient())
 }
 
 var todo = &todo_proto.Todo{
@@ -47,7 +36,7 @@ func createTodo(ctx context.Context, hdlr *TodoService, t *testing.T) *todo_prot
 func TestAll(t *testing.T) {
 	initDb()
 	hdlr := new(TodoService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 
 	todo := createTodo(ctx, hdlr, t)
 	if todo == nil {
@@ -73,7 +62,7 @@ func TestAll(t *testing.T) {
 func TestTodoIsCreated(t *testing.T) {
 	initDb()
 	hdlr := new(TodoService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 
 	todo := createTodo(ctx, hdlr, t)
 	if todo == nil {
@@ -85,7 +74,7 @@ func TestTodoIsCreated(t *testing.T) {
 func TestTodoRead(t *testing.T) {
 	initDb()
 	hdlr := new(TodoService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 
 	todo := createTodo(ctx, hdlr, t)
 	if todo == nil {
@@ -112,7 +101,7 @@ func TestTodoRead(t *testing.T) {
 func TestTodoDelete(t *testing.T) {
 	initDb()
 	hdlr := new(TodoService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 
 	todo := createTodo(ctx, hdlr, t)
 	if todo == nil {
@@ -130,7 +119,7 @@ func TestTodoDelete(t *testing.T) {
 func TestByCreator(t *testing.T) {
 	initDb()
 	hdlr := new(TodoService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	todo := createTodo(ctx, hdlr, t)
 	if todo == nil {
 		t.Error("Create is failed")
@@ -162,7 +151,7 @@ func TestByCreator(t *testing.T) {
 func TestSearch(t *testing.T) {
 	initDb()
 	hdlr := new(TodoService)
-	ctx := common.NewTestContext(context.TODO())
+	ctx := common.NewTenantContext(common.NewTestContext(context.TODO()), "orgid")
 	todo := createTodo(ctx, hdlr, t)
 	if todo == nil {
 		t.Error("Create is failed")
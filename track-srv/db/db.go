@@ -19,6 +19,7 @@ import (
 
 type clientWrapper struct {
 	Db_client db_proto.DBClient
+	PII       *common.PII
 }
 
 var (
@@ -28,10 +29,12 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	pii := common.NewPII(serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, pii))
 
 	return &clientWrapper{
 		Db_client: cl,
+		PII:       pii,
 	}
 }
 
@@ -67,6 +70,24 @@ func runQuery(ctx context.Context, q string, table string) (*db_proto.RunQueryRe
 	})
 }
 
+// runQueryBind runs a query which references its parameters as bind variables
+func runQueryBind(ctx context.Context, q string, bindVars common.BindVars, table string) (*db_proto.RunQueryResponse, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return ClientWrapper.Db_client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{
+			Name:     common.DbHealumName,
+			Table:    table,
+			Driver:   common.DbHealumDriver,
+			Metadata: common.SearchableMetaMap,
+		},
+		Query:    q,
+		BindVars: vars,
+	})
+}
+
 func trackGoalToRecord(trackGoal *track_proto.TrackGoal) (string, string, error) {
 	data, err := common.MarhalToObject(trackGoal)
 	if err != nil {
@@ -211,7 +232,7 @@ func recordToTrackContent(r *db_proto.Record) (*track_proto.TrackContent, error)
 	return &p, nil
 }
 
-func trackMarkerToRecord(trackMarker *track_proto.TrackMarker) (string, error) {
+func trackMarkerToRecord(ctx context.Context, trackMarker *track_proto.TrackMarker) (string, error) {
 	data, err := common.MarhalToObject(trackMarker)
 	if err != nil {
 		return "", err
@@ -219,6 +240,13 @@ func trackMarkerToRecord(trackMarker *track_proto.TrackMarker) (string, error) {
 
 	common.FilterObject(data, "user", trackMarker.User)
 	common.FilterObject(data, "marker", trackMarker.Marker)
+	// the numeric values are kept in the marker series
+	if _, ok := seriesValue(trackMarker); ok {
+		delete(data, "value")
+	}
+	if err := ClientWrapper.PII.EncryptFields(ctx, trackMarker.OrgId, common.DbTrackMarkerTable, data); err != nil {
+		return "", err
+	}
 	var userId string
 	if trackMarker.User != nil {
 		userId = trackMarker.User.Id
@@ -638,8 +666,9 @@ func GetContentHistory(ctx context.Context, req *track_proto.GetContentHistoryRe
 	return contents, nil
 }
 
+// CreateTrackMarker writes the numeric value of a track marker in the marker series and the track marker without it
 func CreateTrackMarker(ctx context.Context, trackerMarker *track_proto.TrackMarker) error {
-	record, err := trackMarkerToRecord(trackerMarker)
+	record, err := trackMarkerToRecord(ctx, trackerMarker)
 	if err != nil {
 		return err
 	}
@@ -654,6 +683,17 @@ func CreateTrackMarker(ctx context.Context, trackerMarker *track_proto.TrackMark
 	if err != nil {
 		return err
 	}
+	// the point is written once the track marker exists, the track marker is removed if the point isn't written
+	if value, ok := seriesValue(trackerMarker); ok {
+		if err := writeMarkerValue(ctx, trackerMarker, value); err != nil {
+			bindVars := common.BindVars{}
+			q := fmt.Sprintf(`REMOVE %s IN %v`, bindVars.Add("id", trackerMarker.Id), common.DbTrackMarkerTable)
+			if _, rerr := runQueryBind(ctx, q, bindVars, common.DbTrackMarkerTable); rerr != nil {
+				log.Println("track marker remove error:", rerr)
+			}
+			return err
+		}
+	}
 	return nil
 }
 
@@ -676,7 +716,13 @@ func GetLastMarker(ctx context.Context, markerId, userId string) (*track_proto.T
 		return nil, err
 	}
 	data, err := recordToTrackMarker(resp.Records[0])
-	return data, err
+	if err != nil {
+		return nil, err
+	}
+	if err := fillSeriesValues(ctx, []*track_proto.TrackMarker{data}); err != nil {
+		return nil, err
+	}
+	return data, nil
 }
 
 func GetMarkerHistory(ctx context.Context, markerId string, from, to, offset, limit int64, sortParameter, sortDirection string) ([]*track_proto.TrackMarker, error) {
@@ -708,6 +754,9 @@ func GetMarkerHistory(ctx context.Context, markerId string, from, to, offset, li
 			markers = append(markers, marker)
 		}
 	}
+	if err := fillSeriesValues(ctx, markers); err != nil {
+		return nil, err
+	}
 	return markers, nil
 }
 
@@ -738,6 +787,9 @@ func GetAllMarkerHistory(ctx context.Context, from, to, offset, limit int64, sor
 			markers = append(markers, marker)
 		}
 	}
+	if err := fillSeriesValues(ctx, markers); err != nil {
+		return nil, err
+	}
 	return markers, nil
 }
 
@@ -779,6 +831,9 @@ func GetDefaultMarkerHistory(ctx context.Context, userId string, offset, limit,
 			log.Println(p, err)
 		}
 	}
+	if err := fillSeriesValues(ctx, response); err != nil {
+		return nil, err
+	}
 	return response, nil
 }
 
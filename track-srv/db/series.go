@@ -0,0 +1,344 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"server/common"
+	db_proto "server/db-srv/proto/db"
+	track_proto "server/track-srv/proto/track"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	google_protobuf1 "github.com/golang/protobuf/ptypes/struct"
+)
+
+// the numeric values of the track markers are points of the marker series with the marker_id, user_id and org_id
+// tags and the value, id and unit fields. The raw points are kept in rawPolicy, the continuous query of the series
+// downsamples them per day in dailyPolicy which is kept forever.
+const (
+	rawPolicy   = "raw"
+	dailyPolicy = "daily"
+)
+
+// SeriesRetention is how long the raw points are kept, they are kept forever if it's 0
+var SeriesRetention time.Duration
+
+var seriesIntervals = map[track_proto.SeriesInterval]string{
+	track_proto.SeriesInterval_HOUR: "1h",
+	track_proto.SeriesInterval_DAY:  "1d",
+	track_proto.SeriesInterval_WEEK: "1w",
+}
+
+func runSeriesQuery(ctx context.Context, q string, bindVars common.BindVars) ([]*db_proto.Record, error) {
+	vars, err := bindVars.Encode()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := ClientWrapper.Db_client.RunQuery(ctx, &db_proto.RunQueryRequest{
+		Database: &db_proto.Database{
+			Name:   common.DbSeriesName,
+			Table:  common.DbMarkerSeriesTable,
+			Driver: common.DbSeriesDriver,
+		},
+		Query:    q,
+		BindVars: vars,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Records, nil
+}
+
+// policyDuration returns the duration of a retention policy, influxdb keeps the points at least an hour
+func policyDuration(d time.Duration) string {
+	if d <= 0 {
+		return "INF"
+	}
+	if d < time.Hour {
+		d = time.Hour
+	}
+	return fmt.Sprintf("%dh", d/time.Hour)
+}
+
+// InitSeries creates the retention policies of the marker series and the continuous query downsampling the raw
+// points, a policy which exists already gets the configured duration
+func InitSeries(ctx context.Context) error {
+	policies := []struct {
+		name     string
+		duration string
+		options  string
+	}{
+		{rawPolicy, policyDuration(SeriesRetention), " DEFAULT"},
+		{dailyPolicy, "INF", ""},
+	}
+	for _, p := range policies {
+		q := fmt.Sprintf(`CREATE RETENTION POLICY "%v" ON "%v" DURATION %v REPLICATION 1%v`,
+			p.name, common.DbSeriesName, p.duration, p.options)
+		if _, err := runSeriesQuery(ctx, q, nil); err == nil {
+			continue
+		}
+		q = fmt.Sprintf(`ALTER RETENTION POLICY "%v" ON "%v" DURATION %v%v`, p.name, common.DbSeriesName, p.duration, p.options)
+		if _, err := runSeriesQuery(ctx, q, nil); err != nil {
+			return err
+		}
+	}
+
+	q := fmt.Sprintf(`
+		CREATE CONTINUOUS QUERY "%v_%v" ON "%v"
+		BEGIN
+			%v
+			GROUP BY time(1d), *
+		END`, common.DbMarkerSeriesTable, dailyPolicy, common.DbSeriesName, downsampleQuery())
+	_, err := runSeriesQuery(ctx, q, nil)
+	return err
+}
+
+// downsampleQuery returns the selection of the daily points from the raw points
+func downsampleQuery() string {
+	return fmt.Sprintf(`SELECT MIN(value) AS min, MAX(value) AS max, SUM(value) AS sum, COUNT(value) AS count
+			INTO "%v"."%v"
+			FROM "%v"."%v"`, dailyPolicy, common.DbMarkerSeriesTable, rawPolicy, common.DbMarkerSeriesTable)
+}
+
+// beforeRetention checks if the raw points at t are expired
+func beforeRetention(t int64) bool {
+	return SeriesRetention > 0 && t < time.Now().Add(-SeriesRetention).Unix()
+}
+
+// seriesValue returns the value of a track marker which is a point of the series
+func seriesValue(trackMarker *track_proto.TrackMarker) (float64, bool) {
+	if trackMarker.Value == nil {
+		return 0, false
+	}
+	n, ok := trackMarker.Value.Kind.(*google_protobuf1.Value_NumberValue)
+	if !ok {
+		return 0, false
+	}
+	return n.NumberValue, true
+}
+
+// markerPoint returns the point of the series of a track marker with fields
+func markerPoint(trackMarker *track_proto.TrackMarker, fields map[string]interface{}) map[string]interface{} {
+	tags := map[string]string{
+		"marker_id": trackMarker.Marker.Id,
+		"org_id":    trackMarker.OrgId,
+	}
+	if trackMarker.User != nil {
+		tags["user_id"] = trackMarker.User.Id
+	}
+	return map[string]interface{}{
+		"time":   trackMarker.Created,
+		"tags":   tags,
+		"fields": fields,
+	}
+}
+
+// rawFields returns the fields of the raw point of a track marker
+func rawFields(trackMarker *track_proto.TrackMarker, value float64) map[string]interface{} {
+	fields := map[string]interface{}{
+		"value": value,
+		"id":    trackMarker.Id,
+	}
+	if len(trackMarker.Unit) > 0 {
+		fields["unit"] = trackMarker.Unit
+	}
+	return fields
+}
+
+func writePoints(ctx context.Context, policy string, points []map[string]interface{}) error {
+	if len(points) == 0 {
+		return nil
+	}
+	bindVars := common.BindVars{}
+	q := fmt.Sprintf(`INSERT %s INTO "%v"`, bindVars.Add("points", points), policy)
+	_, err := runSeriesQuery(ctx, q, bindVars)
+	return err
+}
+
+// writeMarkerValue writes the value of a track marker in the raw points of the series
+func writeMarkerValue(ctx context.Context, trackMarker *track_proto.TrackMarker, value float64) error {
+	return writePoints(ctx, rawPolicy, []map[string]interface{}{markerPoint(trackMarker, rawFields(trackMarker, value))})
+}
+
+// backfillBatch is the number of track markers moved to the series per query
+const backfillBatch = 1000
+
+// BackfillSeries moves the numeric values of the track markers written before the marker series to its points and
+// removes them from the track markers, it returns the number of values moved. It does nothing once every value is
+// moved. The values before the retention of the raw points are written as daily points, the other ones as raw
+// points which are downsampled like the continuous query does.
+func BackfillSeries(ctx context.Context) (int, error) {
+	moved := 0
+	var from, to int64
+	for {
+		q := fmt.Sprintf(`
+			FOR doc IN %v
+			FILTER IS_NUMBER(doc.data.value) && doc.data.marker.id != null
+			LIMIT %d
+			RETURN doc`, common.DbTrackMarkerTable, backfillBatch)
+		resp, err := runQuery(ctx, q, common.DbTrackMarkerTable)
+		if err != nil {
+			return moved, err
+		}
+		raw := []map[string]interface{}{}
+		daily := []map[string]interface{}{}
+		keys := []string{}
+		for _, r := range resp.Records {
+			trackMarker, err := recordToTrackMarker(r)
+			if err != nil {
+				return moved, err
+			}
+			value, ok := seriesValue(trackMarker)
+			if !ok || trackMarker.Marker == nil {
+				continue
+			}
+			if len(trackMarker.Id) == 0 {
+				trackMarker.Id = r.Id
+			}
+			if len(trackMarker.OrgId) == 0 {
+				trackMarker.OrgId = r.Parameter1
+			}
+			if beforeRetention(trackMarker.Created) {
+				daily = append(daily, markerPoint(trackMarker, map[string]interface{}{
+					"min":   value,
+					"max":   value,
+					"sum":   value,
+					"count": 1,
+				}))
+			} else {
+				raw = append(raw, markerPoint(trackMarker, rawFields(trackMarker, value)))
+				if from == 0 || trackMarker.Created < from {
+					from = trackMarker.Created
+				}
+				if trackMarker.Created > to {
+					to = trackMarker.Created
+				}
+			}
+			keys = append(keys, r.Id)
+		}
+		if err := writePoints(ctx, rawPolicy, raw); err != nil {
+			return moved, err
+		}
+		if err := writePoints(ctx, dailyPolicy, daily); err != nil {
+			return moved, err
+		}
+		if len(keys) > 0 {
+			bindVars := common.BindVars{}
+			q = fmt.Sprintf(`
+				FOR doc IN %v
+				FILTER doc._key IN %s
+				UPDATE doc WITH {data: {value: null}} IN %v
+				OPTIONS {keepNull: false}`, common.DbTrackMarkerTable, bindVars.Add("keys", keys), common.DbTrackMarkerTable)
+			if _, err := runQueryBind(ctx, q, bindVars, common.DbTrackMarkerTable); err != nil {
+				return moved, err
+			}
+		}
+		moved += len(keys)
+		if len(resp.Records) < backfillBatch || len(keys) == 0 {
+			break
+		}
+	}
+	if to == 0 {
+		return moved, nil
+	}
+
+	// the continuous query only downsamples the current day, the days of the raw points written are downsampled
+	// as a whole
+	const day = int64(24 * time.Hour / time.Second)
+	q := fmt.Sprintf(`%v
+		WHERE time >= %ds AND time < %ds
+		GROUP BY time(1d), *`, downsampleQuery(), from-from%day, to-to%day+day)
+	_, err := runSeriesQuery(ctx, q, nil)
+	return moved, err
+}
+
+// fillSeriesValues sets the values of the track markers which are kept in the series
+func fillSeriesValues(ctx context.Context, markers []*track_proto.TrackMarker) error {
+	byId := map[string]*track_proto.TrackMarker{}
+	ids := []string{}
+	var from, to int64
+	bindVars := common.BindVars{}
+	for _, m := range markers {
+		if m.Value != nil {
+			continue
+		}
+		byId[m.Id] = m
+		ids = append(ids, "id = "+bindVars.Add(fmt.Sprintf("id%d", len(ids)), m.Id))
+		if from == 0 || m.Created < from {
+			from = m.Created
+		}
+		if m.Created > to {
+			to = m.Created
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	q := fmt.Sprintf(`SELECT value, id FROM "%v"."%v" WHERE time >= %ds AND time <= %ds AND (%v)`,
+		rawPolicy, common.DbMarkerSeriesTable, from, to, strings.Join(ids, " OR "))
+	records, err := runSeriesQuery(ctx, q, bindVars)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		row := struct {
+			Id    string  `json:"id"`
+			Value float64 `json:"value"`
+		}{}
+		if err := json.Unmarshal([]byte(r.Parameter3), &row); err != nil {
+			return err
+		}
+		if m, ok := byId[row.Id]; ok {
+			m.Value = &google_protobuf1.Value{Kind: &google_protobuf1.Value_NumberValue{NumberValue: row.Value}}
+		}
+	}
+	return nil
+}
+
+// GetMarkerSeries returns the min, max, mean and count of the values of a marker per interval, the oldest first.
+// The ranges starting before the retention of the raw points are read from the daily points.
+func GetMarkerSeries(ctx context.Context, req *track_proto.GetMarkerSeriesRequest) ([]*track_proto.SeriesPoint, error) {
+	bindVars := common.BindVars{}
+	query := fmt.Sprintf(`marker_id = %s`, bindVars.Add("marker_id", req.MarkerId))
+	if len(req.UserId) > 0 {
+		query += fmt.Sprintf(` AND user_id = %s`, bindVars.Add("user_id", req.UserId))
+	}
+	if len(req.OrgId) > 0 {
+		query += fmt.Sprintf(` AND org_id = %s`, bindVars.Add("org_id", req.OrgId))
+	}
+	to := req.To
+	if to == 0 {
+		to = time.Now().Unix()
+	}
+
+	policy := rawPolicy
+	aggregates := `MIN(value) AS min, MAX(value) AS max, MEAN(value) AS mean, COUNT(value) AS count`
+	if req.Interval != track_proto.SeriesInterval_HOUR && beforeRetention(req.From) {
+		policy = dailyPolicy
+		aggregates = `MIN(min) AS min, MAX(max) AS max, SUM(sum) / SUM(count) AS mean, SUM(count) AS count`
+	}
+
+	q := fmt.Sprintf(`
+		SELECT %v
+		FROM "%v"."%v"
+		WHERE %v AND time >= %ds AND time <= %ds
+		GROUP BY time(%v) fill(none)`,
+		aggregates, policy, common.DbMarkerSeriesTable, query, req.From, to, seriesIntervals[req.Interval])
+	records, err := runSeriesQuery(ctx, q, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	points := []*track_proto.SeriesPoint{}
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	for _, r := range records {
+		p := &track_proto.SeriesPoint{}
+		if err := u.Unmarshal(strings.NewReader(r.Parameter3), p); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	db_proto "server/db-srv/proto/db"
+	track_proto "server/track-srv/proto/track"
+
+	"github.com/micro/go-micro/client"
+)
+
+// seriesClient records the queries and returns the records of the first query containing a key of records
+type seriesClient struct {
+	db_proto.DBClient
+	queries []*db_proto.RunQueryRequest
+	records map[string][]*db_proto.Record
+}
+
+func (c *seriesClient) RunQuery(ctx context.Context, in *db_proto.RunQueryRequest, opts ...client.CallOption) (*db_proto.RunQueryResponse, error) {
+	c.queries = append(c.queries, in)
+	for k, records := range c.records {
+		if strings.Contains(in.Query, k) {
+			delete(c.records, k)
+			return &db_proto.RunQueryResponse{Records: records}, nil
+		}
+	}
+	return &db_proto.RunQueryResponse{}, nil
+}
+
+func withSeriesClient(t *testing.T, c *seriesClient, retention time.Duration) {
+	wrapper, r := ClientWrapper, SeriesRetention
+	ClientWrapper, SeriesRetention = &clientWrapper{Db_client: c}, retention
+	t.Cleanup(func() {
+		ClientWrapper, SeriesRetention = wrapper, r
+	})
+}
+
+func TestGetMarkerSeriesPolicy(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		retention time.Duration
+		from      time.Time
+		interval  track_proto.SeriesInterval
+		policy    string
+	}{
+		{0, now.Add(-1000 * time.Hour), track_proto.SeriesInterval_DAY, rawPolicy},
+		{48 * time.Hour, now.Add(-24 * time.Hour), track_proto.SeriesInterval_DAY, rawPolicy},
+		{48 * time.Hour, now.Add(-72 * time.Hour), track_proto.SeriesInterval_DAY, dailyPolicy},
+		{48 * time.Hour, now.Add(-72 * time.Hour), track_proto.SeriesInterval_WEEK, dailyPolicy},
+		{48 * time.Hour, now.Add(-72 * time.Hour), track_proto.SeriesInterval_HOUR, rawPolicy},
+	}
+	for _, c := range cases {
+		cl := &seriesClient{}
+		withSeriesClient(t, cl, c.retention)
+		if _, err := GetMarkerSeries(context.TODO(), &track_proto.GetMarkerSeriesRequest{
+			MarkerId: "m1",
+			From:     c.from.Unix(),
+			Interval: c.interval,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if len(cl.queries) != 1 || !strings.Contains(cl.queries[0].Query, `FROM "`+c.policy+`"`) {
+			t.Errorf("Series of %+v must be read from %v: %v", c, c.policy, cl.queries)
+		}
+	}
+}
+
+func TestBackfillSeries(t *testing.T) {
+	old := time.Now().Add(-72 * time.Hour).Unix()
+	recent := time.Now().Add(-time.Hour).Unix()
+	cl := &seriesClient{records: map[string][]*db_proto.Record{
+		"IS_NUMBER": {
+			{Id: "t1", Parameter1: "org1", Parameter3: `{"id": "t1", "marker": {"id": "m1"}, "value": 3, "created": ` + strconv.FormatInt(old, 10) + `}`},
+			{Id: "t2", Parameter1: "org1", Parameter3: `{"id": "t2", "marker": {"id": "m1"}, "value": 5, "created": ` + strconv.FormatInt(recent, 10) + `}`},
+		},
+	}}
+	withSeriesClient(t, cl, 48*time.Hour)
+
+	moved, err := BackfillSeries(context.TODO())
+	if err != nil || moved != 2 {
+		t.Fatalf("Values must be moved: %v, %v", moved, err)
+	}
+	queries := []string{}
+	for _, q := range cl.queries {
+		queries = append(queries, q.Query)
+	}
+	all := strings.Join(queries, "\n")
+	if !strings.Contains(all, `@points INTO "`+rawPolicy+`"`) || !strings.Contains(all, `@points INTO "`+dailyPolicy+`"`) {
+		t.Errorf("Recent value must be a raw point and old value a daily point: %v", all)
+	}
+	if !strings.Contains(all, "keepNull: false") || !strings.Contains(all, "GROUP BY time(1d)") {
+		t.Errorf("Values must be removed from the track markers and downsampled: %v", all)
+	}
+}
@@ -390,12 +390,13 @@ func (p *TrackService) CreateTrackMarker(ctx context.Context, req *track_proto.C
 		if _, err := p.KvClient.IncTrackCount(ctx, &kv_proto.IncTrackCountRequest{common.TRACK_INDEX, common.GetTrackKey(req.UserId, req.MarkerId, trackerMethod.NameSlug)}); err != nil {
 			return common.NotFound(common.TrackSrv, p.CreateTrackMarker, err, "track count inc error")
 		}
-		//create the request to store count value in the db for count mode
+		//create the request to store count value in the db for count mode, every track counts 1 in the series
 		trackMarker = &track_proto.TrackMarker{
 			Id:      uuid.NewUUID().String(),
 			User:    rsp_user.Data.User,
 			Marker:  rsp_marker.Data.Marker,
 			Created: time.Now().Unix(),
+			Value:   &google_protobuf1.Value{Kind: &google_protobuf1.Value_NumberValue{1}},
 			OrgId:   req.OrgId,
 		}
 	case "manual", "hcp":
@@ -548,3 +549,17 @@ func (p *TrackService) GetDefaultMarkerHistory(ctx context.Context, req *track_p
 	rsp.Data = &track_proto.GetDefaultMarkerHistoryResponse_Data{markers}
 	return nil
 }
+
+func (p *TrackService) GetMarkerSeries(ctx context.Context, req *track_proto.GetMarkerSeriesRequest, rsp *track_proto.GetMarkerSeriesResponse) error {
+	log.Info("Received Track.GetMarkerSeries request")
+
+	if len(req.MarkerId) == 0 {
+		return common.BadRequest(common.TrackSrv, p.GetMarkerSeries, nil, "marker_id empty")
+	}
+	points, err := db.GetMarkerSeries(ctx, req)
+	if err != nil {
+		return common.InternalServerError(common.TrackSrv, p.GetMarkerSeries, err, "series error")
+	}
+	rsp.Data = &track_proto.GetMarkerSeriesResponse_Data{points}
+	return nil
+}
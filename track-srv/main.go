@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	behaviour_proto "server/behaviour-srv/proto/behaviour"
 	"server/common"
 	content_proto "server/content-srv/proto/content"
@@ -83,6 +84,21 @@ func main() {
 
 	track_proto.RegisterTrackServiceHandler(service.Server(), trackService)
 	db.Init(service.Client())
+	db.SeriesRetention = conf.Get("series", "retention").Duration(0)
+	if err := db.InitSeries(context.TODO()); err != nil {
+		log.WithField("err", err).Error("marker series init error")
+	} else {
+		// the values of the track markers written before the marker series are moved in the background
+		go func() {
+			moved, err := db.BackfillSeries(context.TODO())
+			if err != nil {
+				log.WithField("err", err).Error("marker series backfill error")
+			}
+			if moved > 0 {
+				log.WithField("moved", moved).Info("Marker series backfilled")
+			}
+		}()
+	}
 
 	if err := service.Run(); err != nil {
 		log.Fatal(err)
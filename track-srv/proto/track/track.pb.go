@@ -45,6 +45,9 @@ It has these top-level messages:
 	GetAllMarkerHistoryResponse
 	GetDefaultMarkerHistoryRequest
 	GetDefaultMarkerHistoryResponse
+	GetMarkerSeriesRequest
+	SeriesPoint
+	GetMarkerSeriesResponse
 	TrackGoal
 	TrackChallenge
 	TrackHabit
@@ -80,6 +83,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type SeriesInterval int32
+
+const (
+	SeriesInterval_HOUR SeriesInterval = 0
+	SeriesInterval_DAY  SeriesInterval = 1
+	SeriesInterval_WEEK SeriesInterval = 2
+)
+
+var SeriesInterval_name = map[int32]string{
+	0: "HOUR",
+	1: "DAY",
+	2: "WEEK",
+}
+var SeriesInterval_value = map[string]int32{
+	"HOUR": 0,
+	"DAY":  1,
+	"WEEK": 2,
+}
+
+func (x SeriesInterval) String() string {
+	return proto.EnumName(SeriesInterval_name, int32(x))
+}
+func (SeriesInterval) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type SubscribeMarker struct {
 	Value *google_protobuf.Any `protobuf:"bytes,1,opt,name=value" json:"value,omitempty"`
 	Unit  string               `protobuf:"bytes,2,opt,name=unit" json:"unit,omitempty"`
@@ -2066,6 +2093,161 @@ func (m *GetDefaultMarkerHistoryResponse_Data) GetTrackMarkers() []*TrackMarker
 	return nil
 }
 
+type GetMarkerSeriesRequest struct {
+	MarkerId string         `protobuf:"bytes,1,opt,name=marker_id,json=markerId" json:"marker_id,omitempty"`
+	UserId   string         `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	OrgId    string         `protobuf:"bytes,3,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
+	From     int64          `protobuf:"varint,4,opt,name=from" json:"from,omitempty"`
+	To       int64          `protobuf:"varint,5,opt,name=to" json:"to,omitempty"`
+	Interval SeriesInterval `protobuf:"varint,6,opt,name=interval,enum=go.micro.srv.track.SeriesInterval" json:"interval,omitempty"`
+}
+
+func (m *GetMarkerSeriesRequest) Reset()                    { *m = GetMarkerSeriesRequest{} }
+func (m *GetMarkerSeriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMarkerSeriesRequest) ProtoMessage()               {}
+func (*GetMarkerSeriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *GetMarkerSeriesRequest) GetMarkerId() string {
+	if m != nil {
+		return m.MarkerId
+	}
+	return ""
+}
+
+func (m *GetMarkerSeriesRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *GetMarkerSeriesRequest) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *GetMarkerSeriesRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetMarkerSeriesRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *GetMarkerSeriesRequest) GetInterval() SeriesInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return SeriesInterval_HOUR
+}
+
+// aggregates of the values tracked in an interval starting at time
+type SeriesPoint struct {
+	Time  int64   `protobuf:"varint,1,opt,name=time" json:"time,omitempty"`
+	Min   float64 `protobuf:"fixed64,2,opt,name=min" json:"min,omitempty"`
+	Max   float64 `protobuf:"fixed64,3,opt,name=max" json:"max,omitempty"`
+	Mean  float64 `protobuf:"fixed64,4,opt,name=mean" json:"mean,omitempty"`
+	Count int64   `protobuf:"varint,5,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *SeriesPoint) Reset()                    { *m = SeriesPoint{} }
+func (m *SeriesPoint) String() string            { return proto.CompactTextString(m) }
+func (*SeriesPoint) ProtoMessage()               {}
+func (*SeriesPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *SeriesPoint) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *SeriesPoint) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *SeriesPoint) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *SeriesPoint) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *SeriesPoint) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetMarkerSeriesResponse struct {
+	Data    *GetMarkerSeriesResponse_Data `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	Code    int64                         `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
+	Message string                        `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *GetMarkerSeriesResponse) Reset()                    { *m = GetMarkerSeriesResponse{} }
+func (m *GetMarkerSeriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMarkerSeriesResponse) ProtoMessage()               {}
+func (*GetMarkerSeriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *GetMarkerSeriesResponse) GetData() *GetMarkerSeriesResponse_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetMarkerSeriesResponse) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetMarkerSeriesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type GetMarkerSeriesResponse_Data struct {
+	Points []*SeriesPoint `protobuf:"bytes,1,rep,name=points" json:"points,omitempty"`
+}
+
+func (m *GetMarkerSeriesResponse_Data) Reset()         { *m = GetMarkerSeriesResponse_Data{} }
+func (m *GetMarkerSeriesResponse_Data) String() string { return proto.CompactTextString(m) }
+func (*GetMarkerSeriesResponse_Data) ProtoMessage()    {}
+func (*GetMarkerSeriesResponse_Data) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{39, 0}
+}
+
+func (m *GetMarkerSeriesResponse_Data) GetPoints() []*SeriesPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
 type TrackGoal struct {
 	User    *go_micro_srv_user.User      `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
 	OrgId   string                       `protobuf:"bytes,2,opt,name=org_id,json=orgId" json:"org_id,omitempty"`
@@ -2076,7 +2258,7 @@ type TrackGoal struct {
 func (m *TrackGoal) Reset()                    { *m = TrackGoal{} }
 func (m *TrackGoal) String() string            { return proto.CompactTextString(m) }
 func (*TrackGoal) ProtoMessage()               {}
-func (*TrackGoal) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*TrackGoal) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *TrackGoal) GetUser() *go_micro_srv_user.User {
 	if m != nil {
@@ -2116,7 +2298,7 @@ type TrackChallenge struct {
 func (m *TrackChallenge) Reset()                    { *m = TrackChallenge{} }
 func (m *TrackChallenge) String() string            { return proto.CompactTextString(m) }
 func (*TrackChallenge) ProtoMessage()               {}
-func (*TrackChallenge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*TrackChallenge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *TrackChallenge) GetUser() *go_micro_srv_user.User {
 	if m != nil {
@@ -2156,7 +2338,7 @@ type TrackHabit struct {
 func (m *TrackHabit) Reset()                    { *m = TrackHabit{} }
 func (m *TrackHabit) String() string            { return proto.CompactTextString(m) }
 func (*TrackHabit) ProtoMessage()               {}
-func (*TrackHabit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*TrackHabit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *TrackHabit) GetUser() *go_micro_srv_user.User {
 	if m != nil {
@@ -2196,7 +2378,7 @@ type TrackContent struct {
 func (m *TrackContent) Reset()                    { *m = TrackContent{} }
 func (m *TrackContent) String() string            { return proto.CompactTextString(m) }
 func (*TrackContent) ProtoMessage()               {}
-func (*TrackContent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*TrackContent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *TrackContent) GetUser() *go_micro_srv_user.User {
 	if m != nil {
@@ -2239,7 +2421,7 @@ type TrackMarker struct {
 func (m *TrackMarker) Reset()                    { *m = TrackMarker{} }
 func (m *TrackMarker) String() string            { return proto.CompactTextString(m) }
 func (*TrackMarker) ProtoMessage()               {}
-func (*TrackMarker) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*TrackMarker) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *TrackMarker) GetId() string {
 	if m != nil {
@@ -2345,11 +2527,16 @@ func init() {
 	proto.RegisterType((*GetDefaultMarkerHistoryRequest)(nil), "go.micro.srv.track.GetDefaultMarkerHistoryRequest")
 	proto.RegisterType((*GetDefaultMarkerHistoryResponse)(nil), "go.micro.srv.track.GetDefaultMarkerHistoryResponse")
 	proto.RegisterType((*GetDefaultMarkerHistoryResponse_Data)(nil), "go.micro.srv.track.GetDefaultMarkerHistoryResponse.Data")
+	proto.RegisterType((*GetMarkerSeriesRequest)(nil), "go.micro.srv.track.GetMarkerSeriesRequest")
+	proto.RegisterType((*SeriesPoint)(nil), "go.micro.srv.track.SeriesPoint")
+	proto.RegisterType((*GetMarkerSeriesResponse)(nil), "go.micro.srv.track.GetMarkerSeriesResponse")
+	proto.RegisterType((*GetMarkerSeriesResponse_Data)(nil), "go.micro.srv.track.GetMarkerSeriesResponse.Data")
 	proto.RegisterType((*TrackGoal)(nil), "go.micro.srv.track.TrackGoal")
 	proto.RegisterType((*TrackChallenge)(nil), "go.micro.srv.track.TrackChallenge")
 	proto.RegisterType((*TrackHabit)(nil), "go.micro.srv.track.TrackHabit")
 	proto.RegisterType((*TrackContent)(nil), "go.micro.srv.track.TrackContent")
 	proto.RegisterType((*TrackMarker)(nil), "go.micro.srv.track.TrackMarker")
+	proto.RegisterEnum("go.micro.srv.track.SeriesInterval", SeriesInterval_name, SeriesInterval_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2378,6 +2565,7 @@ type TrackServiceClient interface {
 	GetAllMarkerHistory(ctx context.Context, in *GetAllMarkerHistoryRequest, opts ...client.CallOption) (*GetAllMarkerHistoryResponse, error)
 	SubscribeMarker(ctx context.Context, in *SubscribeMarkerRequest, opts ...client.CallOption) (*SubscribeMarkerResponse, error)
 	GetDefaultMarkerHistory(ctx context.Context, in *GetDefaultMarkerHistoryRequest, opts ...client.CallOption) (*GetDefaultMarkerHistoryResponse, error)
+	GetMarkerSeries(ctx context.Context, in *GetMarkerSeriesRequest, opts ...client.CallOption) (*GetMarkerSeriesResponse, error)
 }
 
 type trackServiceClient struct {
@@ -2578,6 +2766,16 @@ func (c *trackServiceClient) GetDefaultMarkerHistory(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *trackServiceClient) GetMarkerSeries(ctx context.Context, in *GetMarkerSeriesRequest, opts ...client.CallOption) (*GetMarkerSeriesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "TrackService.GetMarkerSeries", in)
+	out := new(GetMarkerSeriesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for TrackService service
 
 type TrackServiceHandler interface {
@@ -2599,6 +2797,7 @@ type TrackServiceHandler interface {
 	GetAllMarkerHistory(context.Context, *GetAllMarkerHistoryRequest, *GetAllMarkerHistoryResponse) error
 	SubscribeMarker(context.Context, *SubscribeMarkerRequest, *SubscribeMarkerResponse) error
 	GetDefaultMarkerHistory(context.Context, *GetDefaultMarkerHistoryRequest, *GetDefaultMarkerHistoryResponse) error
+	GetMarkerSeries(context.Context, *GetMarkerSeriesRequest, *GetMarkerSeriesResponse) error
 }
 
 func RegisterTrackServiceHandler(s server.Server, hdlr TrackServiceHandler, opts ...server.HandlerOption) {
@@ -2681,134 +2880,148 @@ func (h *TrackService) GetDefaultMarkerHistory(ctx context.Context, in *GetDefau
 	return h.TrackServiceHandler.GetDefaultMarkerHistory(ctx, in, out)
 }
 
+func (h *TrackService) GetMarkerSeries(ctx context.Context, in *GetMarkerSeriesRequest, out *GetMarkerSeriesResponse) error {
+	return h.TrackServiceHandler.GetMarkerSeries(ctx, in, out)
+}
+
 func init() { proto.RegisterFile("server/track-srv/proto/track/track.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
-	0x15, 0xdf, 0xe1, 0x7e, 0x69, 0x9f, 0xbe, 0xdc, 0xa9, 0x2c, 0xad, 0x24, 0x7f, 0x12, 0x30, 0x2a,
-	0xeb, 0x83, 0xeb, 0x4a, 0x36, 0xda, 0x43, 0x51, 0x43, 0x96, 0x6c, 0x59, 0xad, 0x8d, 0xb6, 0x6b,
-	0xbb, 0x87, 0x02, 0x85, 0x40, 0x71, 0x47, 0x2b, 0xc2, 0xbb, 0x4b, 0x95, 0xe4, 0x0a, 0x15, 0x7a,
-	0x29, 0xda, 0x5c, 0x72, 0x49, 0x10, 0xe4, 0xe0, 0x43, 0x10, 0xe4, 0x64, 0xe4, 0x90, 0x83, 0xff,
-	0x81, 0x00, 0xf9, 0x07, 0x72, 0x4a, 0x80, 0xc0, 0x39, 0xf8, 0x92, 0x9c, 0x82, 0x04, 0x39, 0x04,
-	0x39, 0xe4, 0x18, 0x70, 0x66, 0xb8, 0xe4, 0x90, 0x9c, 0x5d, 0x2e, 0xb3, 0x86, 0xe5, 0x8b, 0x96,
-	0x33, 0x7a, 0x8f, 0xf3, 0xe6, 0xfd, 0xe6, 0xfd, 0xf6, 0xcd, 0x7b, 0x0b, 0x4b, 0x0e, 0xb1, 0x8f,
-	0x89, 0x5d, 0x73, 0x6d, 0xdd, 0x78, 0xbc, 0xe6, 0xd8, 0xc7, 0xb5, 0x23, 0xdb, 0x72, 0x2d, 0x36,
-	0x66, 0x7f, 0x35, 0x3a, 0x83, 0x71, 0xd3, 0xd2, 0xda, 0xa6, 0x61, 0x5b, 0x9a, 0x63, 0x1f, 0x6b,
-	0xf4, 0x3f, 0x0b, 0xf3, 0x4d, 0xcb, 0x6a, 0xb6, 0x08, 0xd3, 0xd9, 0xef, 0x1e, 0xd4, 0xf4, 0xce,
-	0x09, 0x13, 0x5f, 0xb8, 0xd1, 0x34, 0xdd, 0xc3, 0xee, 0xbe, 0x66, 0x58, 0xed, 0x5a, 0xd3, 0x6a,
-	0xe9, 0x9d, 0x66, 0x20, 0x75, 0xe4, 0x9e, 0x1c, 0x11, 0xa7, 0xe6, 0xb8, 0x76, 0xd7, 0x70, 0xf9,
-	0x07, 0x57, 0xbb, 0xc2, 0xed, 0xe9, 0x3a, 0xc4, 0x0e, 0x99, 0xe3, 0x0d, 0xe9, 0x1f, 0x2e, 0x76,
-	0x9d, 0x8b, 0xed, 0x93, 0x43, 0xfd, 0xd8, 0xb4, 0xba, 0x61, 0xd9, 0xde, 0x5c, 0xf0, 0xc4, 0xb5,
-	0x34, 0xae, 0x65, 0x58, 0x1d, 0x97, 0x74, 0xdc, 0x90, 0x0e, 0x9f, 0xf1, 0x3f, 0xb9, 0xfc, 0x0a,
-	0x97, 0x77, 0x5c, 0xdd, 0x35, 0x8d, 0x90, 0x38, 0x9b, 0xe0, 0x1f, 0x4c, 0x58, 0xfd, 0x1b, 0x4c,
-	0x3f, 0xe8, 0xee, 0x3b, 0x86, 0x6d, 0xee, 0x93, 0xfb, 0xba, 0xfd, 0x98, 0xd8, 0x78, 0x19, 0x8a,
-	0xc7, 0x7a, 0xab, 0x4b, 0xaa, 0xe8, 0x12, 0x5a, 0x1a, 0x5f, 0x9f, 0xd1, 0x98, 0xbb, 0x34, 0xdf,
-	0x11, 0xda, 0x66, 0xe7, 0xa4, 0xce, 0x44, 0x30, 0x86, 0x42, 0xb7, 0x63, 0xba, 0x55, 0xe5, 0x12,
-	0x5a, 0xaa, 0xd4, 0xe9, 0xb3, 0xba, 0x0e, 0xb3, 0x91, 0x57, 0xd6, 0xc9, 0xbf, 0xba, 0xc4, 0x71,
-	0x71, 0x15, 0xca, 0xc6, 0xa1, 0xde, 0xe9, 0x90, 0x16, 0x7d, 0x77, 0xa5, 0xee, 0x0f, 0xd5, 0x0d,
-	0x98, 0x8b, 0xe9, 0x38, 0x47, 0x56, 0xc7, 0x21, 0x9e, 0x92, 0xd3, 0x35, 0x0c, 0xe2, 0x38, 0x54,
-	0x69, 0xac, 0xee, 0x0f, 0xd5, 0xb7, 0x10, 0xcc, 0x6e, 0xd9, 0x44, 0x77, 0xc9, 0x43, 0x0f, 0xd7,
-	0x1d, 0x4b, 0x6f, 0xf9, 0x2b, 0xad, 0x40, 0xc1, 0xf3, 0x3b, 0xdf, 0xc2, 0x9c, 0x26, 0x9c, 0x02,
-	0x8a, 0xc8, 0x23, 0x87, 0xd8, 0x75, 0x2a, 0x84, 0xe7, 0xa0, 0xdc, 0xb4, 0xf4, 0xd6, 0x9e, 0xd9,
-	0xe0, 0xfb, 0x28, 0x79, 0xc3, 0xdd, 0x06, 0x3e, 0x0b, 0x25, 0xcb, 0x6e, 0x7a, 0xf3, 0x79, 0x3a,
-	0x5f, 0xb4, 0xec, 0xe6, 0x6e, 0xc3, 0x93, 0x77, 0x89, 0xde, 0xf6, 0xe6, 0x0b, 0x4c, 0xde, 0x1b,
-	0xee, 0x36, 0xd4, 0x6f, 0x10, 0xcc, 0xc5, 0x0c, 0xe2, 0xdb, 0xd8, 0x86, 0x42, 0x43, 0x77, 0x75,
-	0x6e, 0xd1, 0x35, 0x2d, 0x7e, 0x2e, 0x35, 0x89, 0xaa, 0xb6, 0xad, 0xbb, 0x7a, 0x9d, 0x6a, 0x7b,
-	0xfe, 0x36, 0xac, 0x06, 0xa1, 0x76, 0xe6, 0xeb, 0xf4, 0xd9, 0x73, 0x50, 0x9b, 0x38, 0x8e, 0xde,
-	0x24, 0xdc, 0x4c, 0x7f, 0xb8, 0xf0, 0x0f, 0x28, 0x78, 0xba, 0xf8, 0x0f, 0x00, 0x74, 0x85, 0x3d,
-	0x6f, 0x5f, 0xdc, 0x82, 0xf3, 0x49, 0x16, 0x04, 0x6b, 0x57, 0x5c, 0xff, 0x11, 0xcf, 0x40, 0xd1,
-	0xb0, 0xba, 0x1d, 0x97, 0x2f, 0xca, 0x06, 0xea, 0x13, 0x05, 0x7e, 0xbd, 0x43, 0x5c, 0x4f, 0x62,
-	0xcb, 0x9b, 0xf0, 0x3d, 0x3f, 0x07, 0x65, 0xcf, 0xa9, 0x9e, 0x73, 0xc6, 0x98, 0x73, 0xbc, 0x21,
-	0xf3, 0x9a, 0xef, 0x65, 0x24, 0xf1, 0xb2, 0x22, 0xf1, 0x72, 0x3e, 0xec, 0x65, 0xcf, 0x9e, 0x96,
-	0xd9, 0x36, 0x5d, 0xea, 0xfc, 0x7c, 0x9d, 0x0d, 0xf0, 0x2c, 0x94, 0xac, 0x83, 0x03, 0x87, 0xb8,
-	0xd5, 0x22, 0x9d, 0xe6, 0x23, 0xcf, 0x63, 0x07, 0xb6, 0xd5, 0xae, 0x96, 0x98, 0xc7, 0xbc, 0x67,
-	0x3c, 0x05, 0x8a, 0x6b, 0x55, 0xcb, 0x74, 0x46, 0x71, 0x2d, 0x7c, 0x05, 0xa6, 0x1c, 0xcb, 0x76,
-	0xf7, 0x8e, 0x74, 0x5b, 0x6f, 0x13, 0x97, 0xd8, 0xd5, 0x0a, 0x5d, 0x71, 0xd2, 0x9b, 0xfd, 0xab,
-	0x3f, 0xd9, 0x13, 0x6b, 0x98, 0x36, 0x31, 0x5c, 0xd3, 0xea, 0x54, 0x21, 0x10, 0xdb, 0xf6, 0x27,
-	0xd5, 0xa7, 0x08, 0x66, 0x44, 0xcf, 0xf0, 0x23, 0xb0, 0x29, 0x1c, 0x81, 0xb5, 0x24, 0x00, 0x92,
-	0xf4, 0xb2, 0xe3, 0x7f, 0x8e, 0xe3, 0xdf, 0x43, 0x10, 0x85, 0x11, 0xfc, 0xaf, 0x02, 0x67, 0xf9,
-	0x7a, 0x77, 0x4d, 0xc7, 0xb5, 0xec, 0x93, 0x10, 0x86, 0xaf, 0x0f, 0x54, 0x63, 0xe9, 0xa0, 0xaa,
-	0x24, 0x41, 0xf5, 0x39, 0x82, 0xd9, 0xa8, 0x0b, 0x38, 0x58, 0x5b, 0x02, 0x58, 0xb5, 0x3e, 0x60,
-	0x45, 0x34, 0xb3, 0xc3, 0x75, 0x87, 0xc3, 0xf5, 0x47, 0x18, 0x0f, 0xc2, 0xd5, 0x63, 0xbd, 0xfc,
-	0xe0, 0x78, 0x85, 0x5e, 0xbc, 0x3a, 0xea, 0x13, 0x04, 0xf3, 0x21, 0x2e, 0xd9, 0x62, 0xdf, 0x0e,
-	0x99, 0xa8, 0xf1, 0x3c, 0x00, 0xff, 0x72, 0x09, 0x40, 0xaf, 0xf0, 0x99, 0x0c, 0x04, 0xf9, 0x13,
-	0x82, 0x85, 0x24, 0xcb, 0xb8, 0xcf, 0x77, 0x04, 0x9f, 0x6f, 0x0c, 0xe0, 0xc8, 0x88, 0x76, 0x76,
-	0xbf, 0x1b, 0xdc, 0xef, 0xb7, 0x61, 0x92, 0xf9, 0x9d, 0x6f, 0x90, 0xdb, 0x71, 0x49, 0xea, 0x79,
-	0xdf, 0x82, 0x09, 0x37, 0x34, 0x92, 0xf0, 0xe5, 0x67, 0xec, 0xa8, 0x71, 0xa1, 0x74, 0x94, 0x29,
-	0x7a, 0x1f, 0xc9, 0xbd, 0xff, 0x8a, 0xa2, 0x51, 0xfd, 0x08, 0xc1, 0x5c, 0x6c, 0x53, 0xe9, 0xbf,
-	0xf0, 0x24, 0xaa, 0x2f, 0x8b, 0xf0, 0xde, 0x54, 0xa0, 0x1a, 0x2c, 0x19, 0xe1, 0xbc, 0xd3, 0xee,
-	0xeb, 0x11, 0x33, 0xdf, 0x57, 0x08, 0xe6, 0x13, 0x7c, 0xc1, 0xb1, 0xbb, 0x23, 0x60, 0xb7, 0xde,
-	0x1f, 0xbb, 0x91, 0xf1, 0xdf, 0x5f, 0x38, 0x7a, 0x3b, 0x30, 0x25, 0xc4, 0xa1, 0x4f, 0x81, 0x83,
-	0x03, 0x71, 0x32, 0x1c, 0x88, 0x8e, 0xfa, 0x3e, 0x82, 0xc5, 0x30, 0x61, 0x1c, 0xea, 0xad, 0x16,
-	0xe9, 0x34, 0x49, 0x26, 0x2a, 0xbc, 0x0c, 0x13, 0x86, 0xff, 0x82, 0xe0, 0x1c, 0x8c, 0xf7, 0xe6,
-	0x32, 0xd0, 0xe1, 0xff, 0x14, 0x38, 0x97, 0x6c, 0x1f, 0xc7, 0x61, 0x57, 0xc0, 0xe1, 0xc6, 0x20,
-	0x42, 0x8c, 0xea, 0x67, 0x87, 0xc2, 0xe4, 0x50, 0xfc, 0x19, 0xa6, 0x39, 0x14, 0xfe, 0xbb, 0xb9,
-	0x2d, 0xaa, 0x1c, 0x8b, 0x9e, 0x15, 0x0c, 0xc5, 0xde, 0x58, 0x42, 0x8c, 0xcf, 0x11, 0x8b, 0x4a,
-	0x5f, 0x2c, 0x1d, 0x35, 0x46, 0xd1, 0x40, 0xfd, 0xd0, 0x78, 0x55, 0xf4, 0xf8, 0x8c, 0x07, 0x59,
-	0x64, 0x6b, 0xc3, 0x05, 0x59, 0xa2, 0xf2, 0xcb, 0xa2, 0xc8, 0xb7, 0x15, 0x58, 0x08, 0x2f, 0x1a,
-	0x21, 0xc9, 0xd7, 0xc1, 0xeb, 0x23, 0x26, 0xca, 0xef, 0x10, 0x2c, 0x26, 0x7a, 0x84, 0xa3, 0x78,
-	0x57, 0x40, 0xf1, 0xfa, 0x20, 0x14, 0x47, 0x46, 0x96, 0x8f, 0x38, 0x8e, 0xf7, 0xe1, 0x4c, 0x24,
-	0x42, 0x7d, 0xba, 0x4c, 0x13, 0xa2, 0xd3, 0x62, 0x88, 0x3a, 0xea, 0x3b, 0xe2, 0x15, 0xf6, 0xae,
-	0xbe, 0x6f, 0x66, 0xcb, 0x1c, 0xe7, 0x61, 0xec, 0xd0, 0x53, 0x0e, 0x4e, 0x42, 0x99, 0x8e, 0x33,
-	0xd0, 0xe4, 0xf7, 0x08, 0xaa, 0x71, 0x9b, 0xb8, 0xff, 0x6f, 0x0b, 0xfe, 0xff, 0xed, 0x00, 0x8a,
-	0x14, 0x74, 0xb3, 0x3b, 0xff, 0x9f, 0xdc, 0xf9, 0x37, 0xfd, 0x4c, 0x9d, 0x6e, 0x8d, 0xdb, 0x70,
-	0x41, 0xea, 0x77, 0xb6, 0x3a, 0x4b, 0xd5, 0xe9, 0xb3, 0x84, 0x12, 0x3f, 0x65, 0x37, 0x48, 0x2a,
-	0x92, 0x8e, 0x0e, 0xc3, 0xde, 0x46, 0x32, 0x6f, 0xbf, 0x2a, 0x1a, 0xfc, 0x10, 0xd1, 0x8b, 0x66,
-	0x78, 0x3b, 0x1c, 0xbc, 0x5b, 0x02, 0x78, 0x9a, 0x24, 0x78, 0xe2, 0x8a, 0x2f, 0x8b, 0xfe, 0xfe,
-	0xaf, 0xd0, 0x24, 0x9d, 0x2e, 0x18, 0xa1, 0xbe, 0xd3, 0xec, 0xe1, 0x11, 0x53, 0xde, 0x73, 0x96,
-	0xd5, 0x8b, 0x5e, 0x18, 0x2a, 0xab, 0x4f, 0x52, 0xcd, 0x8e, 0xd9, 0x2e, 0xc7, 0x6c, 0x13, 0x26,
-	0x42, 0xd1, 0xe6, 0xd3, 0xdc, 0xa0, 0x70, 0x1b, 0x0f, 0xc2, 0xcd, 0x51, 0x7f, 0x14, 0xa9, 0x44,
-	0x2c, 0x4f, 0xae, 0x8a, 0x85, 0xcf, 0xd9, 0x58, 0xe1, 0xf3, 0xef, 0xde, 0x7f, 0xfb, 0x94, 0x3e,
-	0xc3, 0xf1, 0x99, 0x17, 0xe2, 0x73, 0x11, 0x2a, 0x6d, 0xba, 0x56, 0xc0, 0x6e, 0x63, 0x6c, 0x42,
-	0x38, 0x3f, 0xc5, 0xf0, 0xf9, 0xd9, 0xe5, 0x69, 0x30, 0xb1, 0xf7, 0xda, 0xc4, 0x3d, 0xb4, 0x1a,
-	0xf4, 0x08, 0xc4, 0x78, 0x9d, 0x97, 0x73, 0x1f, 0x32, 0xd1, 0xfb, 0x54, 0x92, 0x27, 0xc2, 0xfe,
-	0x50, 0x7d, 0x21, 0x56, 0x04, 0x22, 0x15, 0xd6, 0x14, 0x89, 0x88, 0x54, 0x39, 0x3b, 0xaa, 0x7f,
-	0xe2, 0xa8, 0xde, 0xf2, 0x51, 0x65, 0xfe, 0xe0, 0x56, 0x5c, 0x94, 0xa2, 0xca, 0xd7, 0x67, 0xb0,
-	0xb2, 0x81, 0x6a, 0x50, 0xbe, 0xbc, 0xa7, 0x3b, 0xae, 0x88, 0xa8, 0xe0, 0x76, 0x14, 0x71, 0x7b,
-	0x08, 0x2c, 0x45, 0x00, 0x2b, 0xf9, 0xfb, 0x49, 0xfd, 0x84, 0xd1, 0x58, 0x78, 0x95, 0xa1, 0x68,
-	0x2c, 0xae, 0x98, 0xdd, 0x79, 0xd7, 0xb9, 0xf3, 0x86, 0x3a, 0xb2, 0xea, 0x17, 0x2c, 0xb0, 0x99,
-	0x11, 0x11, 0x7e, 0xeb, 0xeb, 0x2a, 0x9f, 0x83, 0x94, 0x18, 0x07, 0xe5, 0x7b, 0x1c, 0x14, 0xf0,
-	0x57, 0x41, 0xe0, 0xaf, 0x1e, 0xdb, 0x15, 0xc3, 0x6c, 0x17, 0x67, 0xac, 0x52, 0x3a, 0xc6, 0x2a,
-	0x27, 0x31, 0xd6, 0x0b, 0x76, 0x87, 0x88, 0x6c, 0x2c, 0x7d, 0x86, 0x20, 0xd3, 0xcd, 0x0e, 0xd0,
-	0x3d, 0x0e, 0xd0, 0xb6, 0x5f, 0x53, 0x62, 0xbe, 0xf4, 0x49, 0x6b, 0xe0, 0xf1, 0x9e, 0x08, 0x1d,
-	0x6f, 0x47, 0xfd, 0x18, 0xd1, 0xb4, 0x7c, 0xb3, 0xd5, 0x4a, 0xc4, 0xce, 0x87, 0x07, 0xc5, 0xe0,
-	0x51, 0x12, 0xe0, 0xc9, 0x27, 0xc3, 0x53, 0xe8, 0x0f, 0x4f, 0x31, 0x1d, 0x3c, 0xa5, 0x24, 0x78,
-	0xbe, 0x66, 0x39, 0x74, 0xdc, 0xfc, 0xa1, 0x72, 0x68, 0x99, 0xfa, 0x69, 0x01, 0xe9, 0x4b, 0x04,
-	0x17, 0x76, 0x88, 0xbb, 0x4d, 0x0e, 0xf4, 0x6e, 0x2b, 0x39, 0xc8, 0x42, 0x94, 0x83, 0x04, 0xca,
-	0x09, 0xd0, 0x51, 0x92, 0xd1, 0xc9, 0x87, 0xd1, 0xf1, 0xf1, 0x2e, 0xc4, 0xf0, 0x2e, 0xf6, 0x49,
-	0x09, 0x7e, 0x49, 0x80, 0x7d, 0x8b, 0xe0, 0xa2, 0x74, 0x6f, 0x1c, 0xc5, 0x7b, 0x02, 0x8a, 0xbf,
-	0x97, 0xa0, 0xd8, 0xef, 0x15, 0xa7, 0x05, 0xc9, 0xf7, 0x10, 0x54, 0x7a, 0xa5, 0xf5, 0xe1, 0xae,
-	0x3d, 0x92, 0x5c, 0xf0, 0x1a, 0x14, 0x68, 0xef, 0x2d, 0x4f, 0xdf, 0x71, 0x4e, 0x7c, 0x47, 0xd0,
-	0xf0, 0xa5, 0xa5, 0x7c, 0x2a, 0x49, 0x7b, 0xa5, 0xf4, 0x4b, 0xb7, 0xc1, 0x61, 0xf6, 0x87, 0xea,
-	0x33, 0x04, 0x53, 0xe2, 0x35, 0x6e, 0x24, 0x26, 0xde, 0x84, 0x4a, 0x50, 0xe4, 0x61, 0x76, 0x5e,
-	0x96, 0xd9, 0x19, 0x5c, 0x20, 0x03, 0x9d, 0x3e, 0x16, 0x7f, 0x80, 0x00, 0x82, 0x8c, 0x6c, 0x24,
-	0xd6, 0x6e, 0x40, 0x91, 0xdd, 0xb9, 0xf2, 0x49, 0xdd, 0xcc, 0xc0, 0x52, 0x96, 0x03, 0x32, 0xd9,
-	0x3e, 0x16, 0x3e, 0x45, 0x30, 0x11, 0xae, 0x24, 0x8e, 0xc4, 0xc6, 0xdf, 0x41, 0xd9, 0xef, 0x24,
-	0x24, 0x5a, 0xe9, 0xb7, 0xed, 0xfd, 0xea, 0xa5, 0x2f, 0xdd, 0xc7, 0xce, 0x1f, 0x10, 0x8c, 0x87,
-	0xce, 0xad, 0x17, 0xf5, 0x3d, 0x2e, 0x51, 0xcc, 0x46, 0xcf, 0x6c, 0x65, 0x38, 0xb3, 0xf3, 0xa2,
-	0x6b, 0x4b, 0x3c, 0x15, 0x2b, 0xd0, 0xb7, 0x2c, 0x26, 0xe6, 0x9b, 0x3c, 0x70, 0xb8, 0x68, 0xd8,
-	0xe4, 0xa2, 0x60, 0x72, 0x90, 0xa2, 0x94, 0x86, 0xc9, 0xaa, 0xcb, 0x41, 0x56, 0xbd, 0xfe, 0xee,
-	0x19, 0x0e, 0xce, 0x03, 0x62, 0x1f, 0x9b, 0x06, 0xc1, 0x2d, 0x98, 0x8e, 0xf4, 0xca, 0xf1, 0x72,
-	0xaa, 0x86, 0x3a, 0x65, 0xe1, 0x85, 0x95, 0x21, 0x9a, 0xef, 0x6a, 0x0e, 0x1b, 0x30, 0x11, 0x6e,
-	0xcb, 0xe2, 0xdf, 0x0c, 0x6e, 0xdc, 0xb2, 0x75, 0x96, 0xd2, 0x76, 0x78, 0xd5, 0x1c, 0x36, 0x61,
-	0x4a, 0x6c, 0x27, 0xe2, 0xab, 0x69, 0x5a, 0x8e, 0x6c, 0xa1, 0xe5, 0xf4, 0xdd, 0x49, 0x35, 0x87,
-	0xff, 0x03, 0x33, 0x49, 0x45, 0x63, 0x5c, 0x4b, 0x5f, 0x5e, 0x66, 0xcb, 0x5e, 0x1b, 0xb6, 0x1e,
-	0xad, 0xe6, 0xb0, 0x0d, 0xbf, 0x8a, 0x15, 0x35, 0xf1, 0x6a, 0xca, 0xda, 0x27, 0x5b, 0x76, 0x6d,
-	0xa8, 0x4a, 0xa9, 0x9a, 0xc3, 0xff, 0xa6, 0xbf, 0x54, 0x88, 0x96, 0xe0, 0xb0, 0x96, 0xba, 0x56,
-	0xc7, 0xd6, 0xad, 0x0d, 0x59, 0xdb, 0x53, 0x73, 0xd8, 0x82, 0x33, 0xd1, 0xe2, 0x13, 0x5e, 0x49,
-	0x57, 0xa2, 0x62, 0x6b, 0xae, 0x0e, 0x53, 0xcf, 0x52, 0x73, 0xf8, 0x00, 0x26, 0x85, 0x82, 0x09,
-	0x5e, 0x4a, 0x51, 0x53, 0x61, 0x4b, 0x5d, 0x4d, 0x5d, 0x7d, 0x51, 0x73, 0x5e, 0x04, 0x46, 0xae,
-	0xf9, 0x78, 0x39, 0x55, 0x2d, 0xa0, 0x4f, 0x04, 0x4a, 0xea, 0x06, 0x6a, 0x0e, 0x77, 0x01, 0xc7,
-	0xfb, 0xbe, 0x78, 0x2d, 0x6d, 0x7f, 0x98, 0xad, 0xa9, 0x0d, 0xd7, 0x4e, 0xee, 0x6d, 0x32, 0xdc,
-	0xa1, 0x94, 0x6e, 0x32, 0xa1, 0xad, 0x2b, 0xdd, 0x64, 0x52, 0xcb, 0x33, 0x88, 0x0c, 0xa1, 0xa7,
-	0x26, 0x8f, 0x8c, 0xa4, 0x1e, 0xa6, 0x3c, 0x32, 0x12, 0x1b, 0x75, 0x6c, 0xcd, 0xd8, 0xcd, 0x1e,
-	0xaf, 0xa6, 0x2c, 0x00, 0xf4, 0x59, 0x53, 0x5a, 0x2e, 0xe8, 0x1d, 0xd1, 0xe0, 0x32, 0x2c, 0x3d,
-	0xa2, 0xb1, 0xeb, 0xbc, 0xf4, 0x88, 0xc6, 0x6f, 0xd6, 0x2c, 0xf6, 0xa2, 0xd7, 0x3a, 0xbc, 0x92,
-	0xee, 0xf2, 0xd7, 0x27, 0xf6, 0x64, 0x37, 0xc5, 0x1e, 0xcd, 0x44, 0x6f, 0x29, 0x52, 0x9a, 0x91,
-	0x5c, 0xe6, 0xa4, 0x34, 0x23, 0xbb, 0xfe, 0xb0, 0x83, 0x1a, 0xfb, 0x11, 0x5f, 0xd2, 0x5b, 0x92,
-	0x7f, 0x96, 0x97, 0x7c, 0x50, 0x25, 0x3f, 0xc7, 0x53, 0x73, 0xf8, 0x0d, 0x56, 0x45, 0x48, 0x4a,
-	0xe4, 0xf1, 0xfa, 0x50, 0x59, 0x3f, 0x5b, 0x7e, 0x23, 0xc3, 0x4d, 0x41, 0xcd, 0xed, 0x97, 0x68,
-	0x02, 0xb1, 0xf1, 0x73, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xad, 0x26, 0x82, 0x0c, 0x2a, 0x00,
+	// 2161 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x9f, 0x9a, 0x4f, 0xcf, 0xb3, 0xe3, 0x78, 0x6b, 0x13, 0x7b, 0x32, 0xc9, 0xee, 0x66, 0x5b,
+	0x5a, 0xe1, 0xd8, 0xf1, 0x4c, 0xb0, 0xb3, 0x5a, 0x0e, 0x68, 0x23, 0x6f, 0x9c, 0x75, 0xcc, 0x26,
+	0xda, 0xa5, 0xb3, 0x01, 0x81, 0x84, 0xa2, 0x76, 0x4f, 0x79, 0xdc, 0xda, 0x99, 0x69, 0xd3, 0x5d,
+	0x63, 0x25, 0xe2, 0xc2, 0xd7, 0x85, 0x0b, 0x88, 0xd3, 0x1e, 0x10, 0xe2, 0xb4, 0xe2, 0xc0, 0x81,
+	0x7f, 0x00, 0x89, 0x33, 0x12, 0xe2, 0x00, 0x12, 0x5a, 0x0e, 0x7b, 0x81, 0x13, 0x02, 0x71, 0x40,
+	0x1c, 0x38, 0xa2, 0xfa, 0xe8, 0xee, 0xaa, 0xfe, 0x98, 0xe9, 0x6e, 0x1c, 0xc5, 0x7b, 0xb1, 0xbb,
+	0x6a, 0xde, 0xeb, 0xf7, 0xea, 0xfd, 0xea, 0xfd, 0xba, 0xea, 0x55, 0xc1, 0xba, 0x4f, 0xbc, 0x53,
+	0xe2, 0xf5, 0xa9, 0x67, 0xd9, 0x1f, 0x6d, 0xf9, 0xde, 0x69, 0xff, 0xc4, 0x73, 0xa9, 0x2b, 0xda,
+	0xe2, 0x6f, 0x8f, 0xf7, 0x60, 0x3c, 0x74, 0x7b, 0x63, 0xc7, 0xf6, 0xdc, 0x9e, 0xef, 0x9d, 0xf6,
+	0xf8, 0x2f, 0xdd, 0x2b, 0x43, 0xd7, 0x1d, 0x8e, 0x88, 0xd0, 0x39, 0x9c, 0x1e, 0xf5, 0xad, 0xc9,
+	0x33, 0x21, 0xde, 0x7d, 0x73, 0xe8, 0xd0, 0xe3, 0xe9, 0x61, 0xcf, 0x76, 0xc7, 0xfd, 0xa1, 0x3b,
+	0xb2, 0x26, 0xc3, 0x48, 0xea, 0x84, 0x3e, 0x3b, 0x21, 0x7e, 0xdf, 0xa7, 0xde, 0xd4, 0xa6, 0xf2,
+	0x9f, 0x54, 0x7b, 0x43, 0xfa, 0x33, 0xf5, 0x89, 0xa7, 0xb8, 0xc3, 0x9a, 0xfc, 0x8f, 0x14, 0xbb,
+	0x2d, 0xc5, 0x0e, 0xc9, 0xb1, 0x75, 0xea, 0xb8, 0x53, 0x55, 0x36, 0xec, 0x8b, 0x9e, 0xa4, 0x56,
+	0x4f, 0x6a, 0xd9, 0xee, 0x84, 0x92, 0x09, 0x55, 0x74, 0x64, 0x4f, 0xf0, 0x5f, 0xca, 0x6f, 0x4a,
+	0x79, 0x9f, 0x5a, 0xd4, 0xb1, 0x15, 0x71, 0xd1, 0x21, 0xff, 0x09, 0x61, 0xe3, 0xab, 0x70, 0xf1,
+	0xd1, 0xf4, 0xd0, 0xb7, 0x3d, 0xe7, 0x90, 0x3c, 0xb4, 0xbc, 0x8f, 0x88, 0x87, 0x37, 0xa0, 0x71,
+	0x6a, 0x8d, 0xa6, 0xa4, 0x83, 0xae, 0xa3, 0xf5, 0xc5, 0xed, 0x4b, 0x3d, 0x11, 0xae, 0x5e, 0x10,
+	0x88, 0xde, 0xee, 0xe4, 0x99, 0x29, 0x44, 0x30, 0x86, 0xfa, 0x74, 0xe2, 0xd0, 0x4e, 0xf5, 0x3a,
+	0x5a, 0x6f, 0x9b, 0xfc, 0xd9, 0xd8, 0x86, 0xd5, 0xd8, 0x2b, 0x4d, 0xf2, 0xed, 0x29, 0xf1, 0x29,
+	0xee, 0x40, 0xcb, 0x3e, 0xb6, 0x26, 0x13, 0x32, 0xe2, 0xef, 0x6e, 0x9b, 0x41, 0xd3, 0xd8, 0x81,
+	0xb5, 0x84, 0x8e, 0x7f, 0xe2, 0x4e, 0x7c, 0xc2, 0x94, 0xfc, 0xa9, 0x6d, 0x13, 0xdf, 0xe7, 0x4a,
+	0x0b, 0x66, 0xd0, 0x34, 0x7e, 0x8c, 0x60, 0xf5, 0xae, 0x47, 0x2c, 0x4a, 0x3e, 0x64, 0xb8, 0xee,
+	0xbb, 0xd6, 0x28, 0xb0, 0xb4, 0x09, 0x75, 0x16, 0x77, 0x39, 0x84, 0xb5, 0x9e, 0x36, 0x0b, 0x38,
+	0x22, 0x8f, 0x7d, 0xe2, 0x99, 0x5c, 0x08, 0xaf, 0x41, 0x6b, 0xe8, 0x5a, 0xa3, 0x27, 0xce, 0x40,
+	0x8e, 0xa3, 0xc9, 0x9a, 0x07, 0x03, 0x7c, 0x19, 0x9a, 0xae, 0x37, 0x64, 0xfd, 0x35, 0xde, 0xdf,
+	0x70, 0xbd, 0xe1, 0xc1, 0x80, 0xc9, 0x53, 0x62, 0x8d, 0x59, 0x7f, 0x5d, 0xc8, 0xb3, 0xe6, 0xc1,
+	0xc0, 0xf8, 0x3b, 0x82, 0xb5, 0x84, 0x43, 0x72, 0x18, 0x7b, 0x50, 0x1f, 0x58, 0xd4, 0x92, 0x1e,
+	0xdd, 0xea, 0x25, 0xe7, 0x65, 0x2f, 0x43, 0xb5, 0xb7, 0x67, 0x51, 0xcb, 0xe4, 0xda, 0x2c, 0xde,
+	0xb6, 0x3b, 0x20, 0xdc, 0xcf, 0x9a, 0xc9, 0x9f, 0x59, 0x80, 0xc6, 0xc4, 0xf7, 0xad, 0x21, 0x91,
+	0x6e, 0x06, 0xcd, 0xee, 0x37, 0xa1, 0xce, 0x74, 0xf1, 0x97, 0x01, 0xb8, 0x85, 0x27, 0x6c, 0x5c,
+	0xd2, 0x83, 0x57, 0xd2, 0x3c, 0x88, 0x6c, 0xb7, 0x69, 0xf0, 0x88, 0x2f, 0x41, 0xc3, 0x76, 0xa7,
+	0x13, 0x2a, 0x8d, 0x8a, 0x86, 0xf1, 0x71, 0x15, 0x5e, 0xde, 0x27, 0x94, 0x49, 0xdc, 0x65, 0x1d,
+	0x41, 0xe4, 0xd7, 0xa0, 0xc5, 0x82, 0xca, 0x82, 0xb3, 0x20, 0x82, 0xc3, 0x9a, 0x22, 0x6a, 0x41,
+	0x94, 0x51, 0x46, 0x94, 0xab, 0x19, 0x51, 0xae, 0xa9, 0x51, 0x66, 0xfe, 0x8c, 0x9c, 0xb1, 0x43,
+	0x79, 0xf0, 0x6b, 0xa6, 0x68, 0xe0, 0x55, 0x68, 0xba, 0x47, 0x47, 0x3e, 0xa1, 0x9d, 0x06, 0xef,
+	0x96, 0x2d, 0x16, 0xb1, 0x23, 0xcf, 0x1d, 0x77, 0x9a, 0x22, 0x62, 0xec, 0x19, 0x2f, 0x43, 0x95,
+	0xba, 0x9d, 0x16, 0xef, 0xa9, 0x52, 0x17, 0xbf, 0x01, 0xcb, 0xbe, 0xeb, 0xd1, 0x27, 0x27, 0x96,
+	0x67, 0x8d, 0x09, 0x25, 0x5e, 0xa7, 0xcd, 0x2d, 0x5e, 0x60, 0xbd, 0x1f, 0x04, 0x9d, 0xa1, 0xd8,
+	0xc0, 0xf1, 0x88, 0x4d, 0x1d, 0x77, 0xd2, 0x81, 0x48, 0x6c, 0x2f, 0xe8, 0x34, 0x3e, 0x41, 0x70,
+	0x49, 0x8f, 0x8c, 0x9c, 0x02, 0xbb, 0xda, 0x14, 0xd8, 0x4a, 0x03, 0x20, 0x4d, 0xaf, 0x3c, 0xfe,
+	0xd7, 0x24, 0xfe, 0x21, 0x82, 0x48, 0x45, 0xf0, 0xbb, 0x55, 0xb8, 0x2c, 0xed, 0xdd, 0x77, 0x7c,
+	0xea, 0x7a, 0xcf, 0x14, 0x0c, 0x3f, 0x3f, 0x50, 0x2d, 0xe4, 0x83, 0xaa, 0x9d, 0x06, 0xd5, 0x9f,
+	0x10, 0xac, 0xc6, 0x43, 0x20, 0xc1, 0xba, 0xab, 0x81, 0xd5, 0x9f, 0x01, 0x56, 0x4c, 0xb3, 0x3c,
+	0x5c, 0xef, 0x4a, 0xb8, 0xde, 0x86, 0xc5, 0x28, 0x5d, 0x19, 0xeb, 0xd5, 0xe6, 0xe7, 0x2b, 0x84,
+	0xf9, 0xea, 0x1b, 0x1f, 0x23, 0xb8, 0xa2, 0x70, 0xc9, 0x5d, 0xf1, 0x75, 0x28, 0x45, 0x8d, 0xaf,
+	0x00, 0xc8, 0x8f, 0x4b, 0x04, 0x7a, 0x5b, 0xf6, 0x94, 0x20, 0xc8, 0xff, 0x22, 0xe8, 0xa6, 0x79,
+	0x26, 0x63, 0xbe, 0xaf, 0xc5, 0x7c, 0x67, 0x0e, 0x47, 0xc6, 0xb4, 0xcb, 0xc7, 0xdd, 0x96, 0x71,
+	0xbf, 0x07, 0x17, 0x44, 0xdc, 0xe5, 0x00, 0xa5, 0x1f, 0xd7, 0x33, 0x23, 0x1f, 0x78, 0xb0, 0x44,
+	0x95, 0x56, 0x06, 0x5f, 0xfe, 0x51, 0x4c, 0x35, 0x29, 0x94, 0x8f, 0x32, 0xf5, 0xe8, 0xa3, 0xec,
+	0xe8, 0xbf, 0xa0, 0x6c, 0x34, 0x7e, 0x85, 0x60, 0x2d, 0x31, 0xa8, 0xfc, 0x1f, 0xbc, 0x0c, 0xd5,
+	0xe7, 0x45, 0x78, 0x3f, 0xaa, 0x42, 0x27, 0x32, 0x19, 0xe3, 0xbc, 0xf3, 0x1e, 0xeb, 0x33, 0x66,
+	0xbe, 0xbf, 0x22, 0xb8, 0x92, 0x12, 0x0b, 0x89, 0xdd, 0xbb, 0x1a, 0x76, 0xdb, 0xb3, 0xb1, 0x3b,
+	0x33, 0xfe, 0x7b, 0x5f, 0xa2, 0xb7, 0x0f, 0xcb, 0x5a, 0x1e, 0x06, 0x14, 0x38, 0x3f, 0x11, 0x2f,
+	0xa8, 0x89, 0xe8, 0x1b, 0x3f, 0x47, 0x70, 0x55, 0x25, 0x8c, 0x63, 0x6b, 0x34, 0x22, 0x93, 0x21,
+	0x29, 0x45, 0x85, 0xaf, 0xc3, 0x92, 0x1d, 0xbc, 0x20, 0x9a, 0x07, 0x8b, 0x61, 0x5f, 0x09, 0x3a,
+	0xfc, 0x7e, 0x15, 0xae, 0xa5, 0xfb, 0x27, 0x71, 0x38, 0xd0, 0x70, 0x78, 0x73, 0x1e, 0x21, 0xc6,
+	0xf5, 0xcb, 0x43, 0xe1, 0x48, 0x28, 0xde, 0x83, 0x8b, 0x12, 0x8a, 0xe0, 0xdd, 0xd2, 0x17, 0x23,
+	0x1b, 0x8b, 0xd0, 0x8b, 0x65, 0xaa, 0xb5, 0x33, 0x88, 0xf1, 0x53, 0x24, 0xb2, 0x32, 0x10, 0xcb,
+	0x47, 0x8d, 0x71, 0x34, 0xd0, 0x2c, 0x34, 0x5e, 0x14, 0x3d, 0xfe, 0x5a, 0x26, 0x59, 0x6c, 0x68,
+	0xc5, 0x92, 0x2c, 0x55, 0xf9, 0x79, 0x51, 0xe4, 0x4f, 0xaa, 0xd0, 0x55, 0x8d, 0xc6, 0x48, 0xf2,
+	0xf3, 0x10, 0xf5, 0x33, 0x26, 0xca, 0x7f, 0x22, 0xb8, 0x9a, 0x1a, 0x11, 0x89, 0xe2, 0x7d, 0x0d,
+	0xc5, 0xdb, 0xf3, 0x50, 0x3c, 0x33, 0xb2, 0x7c, 0x2c, 0x71, 0x7c, 0x08, 0x2b, 0xb1, 0x0c, 0x0d,
+	0xe8, 0x32, 0x4f, 0x8a, 0x5e, 0xd4, 0x53, 0xd4, 0x37, 0x7e, 0xaa, 0x6f, 0x61, 0xef, 0x5b, 0x87,
+	0x4e, 0xb9, 0x95, 0xe3, 0x15, 0x58, 0x38, 0x66, 0xca, 0xd1, 0x4c, 0x68, 0xf1, 0x76, 0x09, 0x9a,
+	0xfc, 0x17, 0x82, 0x4e, 0xd2, 0x27, 0x19, 0xff, 0x7b, 0x5a, 0xfc, 0xbf, 0x38, 0x87, 0x22, 0x35,
+	0xdd, 0xf2, 0xc1, 0xff, 0x96, 0x0c, 0xfe, 0x9d, 0x60, 0xa5, 0xce, 0x87, 0x26, 0x7d, 0x78, 0x35,
+	0x33, 0xee, 0xc2, 0x3a, 0xd0, 0xf0, 0x39, 0x83, 0x12, 0x7f, 0x2f, 0x76, 0x90, 0x5c, 0x24, 0x1f,
+	0x1d, 0xaa, 0xd1, 0x46, 0x59, 0xd1, 0x7e, 0x51, 0x34, 0xf8, 0x4b, 0x04, 0x97, 0x63, 0xc3, 0x91,
+	0xe0, 0xbd, 0xa3, 0x81, 0xd7, 0xcb, 0x48, 0x9e, 0xa4, 0xe2, 0xf3, 0xa2, 0xbf, 0x1f, 0x54, 0x61,
+	0x35, 0x30, 0x18, 0xa3, 0xbe, 0xf3, 0x1c, 0xe1, 0x33, 0xa6, 0xbc, 0x4f, 0xc5, 0xaa, 0x5e, 0x8f,
+	0x42, 0xa1, 0x55, 0x7d, 0x9a, 0x6a, 0x79, 0xcc, 0x0e, 0x24, 0x66, 0xbb, 0xb0, 0xa4, 0x64, 0x5b,
+	0x40, 0x73, 0xf3, 0xd2, 0x6d, 0x31, 0x4a, 0x37, 0xdf, 0xf8, 0x8f, 0x4e, 0x25, 0x7a, 0x79, 0xf2,
+	0xa6, 0x5e, 0xf8, 0x5c, 0x4d, 0x14, 0x3e, 0xbf, 0xc6, 0x7e, 0x9d, 0x51, 0xfa, 0x54, 0xf3, 0xb3,
+	0xa6, 0xe5, 0xe7, 0x55, 0x68, 0x8f, 0xb9, 0xad, 0x88, 0xdd, 0x16, 0x44, 0x87, 0x36, 0x7f, 0x1a,
+	0xea, 0xfc, 0x39, 0x90, 0xcb, 0x60, 0xe2, 0x3d, 0x19, 0x13, 0x7a, 0xec, 0x0e, 0xf8, 0x14, 0x48,
+	0xf0, 0xba, 0x2c, 0xe7, 0x7e, 0x28, 0x44, 0x1f, 0x72, 0x49, 0xb9, 0x10, 0x0e, 0x9a, 0xc6, 0x67,
+	0x7a, 0x45, 0x20, 0x56, 0x61, 0xcd, 0xb1, 0x10, 0xc9, 0x54, 0x2e, 0x8f, 0xea, 0x57, 0x24, 0xaa,
+	0xef, 0x04, 0xa8, 0x8a, 0x78, 0x48, 0x2f, 0x5e, 0xcb, 0x44, 0x55, 0xda, 0x5f, 0xa4, 0x51, 0xc3,
+	0xb0, 0x39, 0x5f, 0x3e, 0xb0, 0x7c, 0xaa, 0x23, 0xaa, 0x85, 0x1d, 0xc5, 0xc2, 0xae, 0x80, 0x55,
+	0xd5, 0xc0, 0x4a, 0xff, 0x3e, 0x19, 0xbf, 0x15, 0x34, 0xa6, 0x5a, 0x29, 0x44, 0x63, 0x49, 0xc5,
+	0xf2, 0xc1, 0xbb, 0x2d, 0x83, 0x57, 0x68, 0xca, 0x1a, 0x7f, 0x16, 0x89, 0x2d, 0x9c, 0x88, 0xf1,
+	0xdb, 0xcc, 0x50, 0x05, 0x1c, 0x54, 0x4d, 0x70, 0x50, 0x2d, 0xe4, 0xa0, 0x88, 0xbf, 0xea, 0x1a,
+	0x7f, 0x85, 0x6c, 0xd7, 0x50, 0xd9, 0x2e, 0xc9, 0x58, 0xcd, 0x7c, 0x8c, 0xd5, 0x4a, 0x63, 0xac,
+	0xcf, 0xc4, 0x1e, 0x22, 0x36, 0xb0, 0xfc, 0x2b, 0x84, 0x2c, 0xdd, 0xf2, 0x00, 0x3d, 0x90, 0x00,
+	0xed, 0x05, 0x35, 0x25, 0x11, 0xcb, 0x80, 0xb4, 0xe6, 0x4e, 0xef, 0x25, 0x65, 0x7a, 0xfb, 0xc6,
+	0x6f, 0x10, 0x5f, 0x96, 0xef, 0x8e, 0x46, 0xa9, 0xd8, 0x05, 0xf0, 0xa0, 0x04, 0x3c, 0xd5, 0x14,
+	0x78, 0x6a, 0xe9, 0xf0, 0xd4, 0x67, 0xc3, 0xd3, 0xc8, 0x07, 0x4f, 0x33, 0x0d, 0x9e, 0xbf, 0x89,
+	0x35, 0x74, 0xd2, 0xfd, 0x42, 0x6b, 0xe8, 0x2c, 0xf5, 0xf3, 0x02, 0xd2, 0x5f, 0x10, 0xbc, 0xba,
+	0x4f, 0xe8, 0x1e, 0x39, 0xb2, 0xa6, 0xa3, 0xf4, 0x24, 0x53, 0x28, 0x07, 0x69, 0x94, 0x13, 0xa1,
+	0x53, 0x4d, 0x47, 0xa7, 0xa6, 0xa2, 0x13, 0xe0, 0x5d, 0x4f, 0xe0, 0xdd, 0x98, 0xb1, 0x24, 0xf8,
+	0x7f, 0x12, 0xec, 0x1f, 0x08, 0x5e, 0xcb, 0x1c, 0x9b, 0x44, 0xf1, 0x81, 0x86, 0xe2, 0x97, 0x32,
+	0x50, 0x9c, 0xf5, 0x8a, 0xf3, 0x82, 0xe4, 0xef, 0x44, 0xad, 0x56, 0x34, 0x1f, 0x11, 0xcf, 0x21,
+	0xfe, 0xf3, 0xf8, 0xa2, 0xe4, 0xc2, 0xf1, 0x6d, 0x58, 0x70, 0x26, 0x94, 0x78, 0xa7, 0xd6, 0x88,
+	0x23, 0xb8, 0x9c, 0xbe, 0xaf, 0x13, 0x5e, 0x1e, 0x48, 0x49, 0x33, 0xd4, 0x31, 0x5c, 0x58, 0x14,
+	0xbf, 0x7d, 0xe0, 0x3a, 0x13, 0x3e, 0x75, 0xa8, 0x33, 0x26, 0x01, 0x55, 0xb0, 0x67, 0xbc, 0x02,
+	0xb5, 0xb1, 0x33, 0xe1, 0x2e, 0x23, 0x93, 0x3d, 0xf2, 0x1e, 0xeb, 0x69, 0xa7, 0x26, 0x7b, 0xac,
+	0xa7, 0x4c, 0x6f, 0x4c, 0xac, 0x09, 0x77, 0x15, 0x99, 0xfc, 0x39, 0x5a, 0x43, 0x37, 0xd4, 0x35,
+	0xf4, 0x1f, 0xd4, 0x8f, 0x4c, 0x10, 0xbc, 0x42, 0xab, 0xc7, 0x34, 0xd5, 0xf2, 0x53, 0xe3, 0x8e,
+	0x9c, 0x1a, 0x6f, 0x41, 0xf3, 0x84, 0x85, 0x60, 0xe6, 0x9c, 0x50, 0x42, 0x65, 0x4a, 0x71, 0xe3,
+	0x67, 0x08, 0xda, 0xe1, 0x41, 0x4b, 0xb1, 0x4d, 0x70, 0xc6, 0xce, 0xe0, 0x16, 0xd4, 0xf9, 0x49,
+	0x6c, 0x8d, 0xbf, 0xe3, 0x9a, 0xfe, 0x8e, 0xe8, 0xf8, 0x9f, 0xd9, 0x33, 0xb9, 0x24, 0x1b, 0x9e,
+	0xcd, 0x97, 0x60, 0x03, 0x39, 0x59, 0x82, 0x26, 0xab, 0x31, 0x2d, 0xeb, 0x9b, 0xfa, 0x33, 0x71,
+	0xf1, 0x0e, 0xb4, 0xa3, 0x92, 0x9f, 0xf0, 0xf3, 0xf5, 0x2c, 0x3f, 0x43, 0xcb, 0x66, 0xa4, 0x33,
+	0xc3, 0xe3, 0x5f, 0x20, 0x80, 0x68, 0x7d, 0x7e, 0x26, 0xde, 0xee, 0x40, 0x43, 0xec, 0xc0, 0x6b,
+	0x69, 0x67, 0xdb, 0x91, 0xa7, 0xdc, 0xa2, 0x29, 0x64, 0x67, 0x78, 0xf8, 0x09, 0x82, 0x25, 0xb5,
+	0xae, 0x7c, 0x26, 0x3e, 0xbe, 0x05, 0xad, 0xe0, 0x5c, 0x29, 0xd5, 0x4b, 0xf9, 0x63, 0x4f, 0xda,
+	0x34, 0x03, 0xe9, 0x19, 0x7e, 0xfe, 0x1b, 0xc1, 0xa2, 0xc2, 0x62, 0x8c, 0x3b, 0x42, 0x56, 0xaa,
+	0x3a, 0x83, 0xd0, 0xed, 0x6a, 0x31, 0xb7, 0x6b, 0x7a, 0x68, 0x9b, 0x72, 0x61, 0x5e, 0xe7, 0x6f,
+	0xb9, 0x9a, 0xba, 0xfb, 0x90, 0x34, 0x2a, 0x45, 0x55, 0x97, 0x1b, 0x9a, 0xcb, 0xd1, 0x82, 0xb5,
+	0x59, 0x64, 0x8f, 0xd5, 0x8a, 0xf6, 0x58, 0x1b, 0x5b, 0xb0, 0xac, 0x93, 0x1d, 0x5e, 0x80, 0xfa,
+	0xfd, 0xf7, 0x1f, 0x9b, 0x2b, 0x15, 0xdc, 0x82, 0xda, 0xde, 0xee, 0x37, 0x56, 0x10, 0xeb, 0xfa,
+	0xfa, 0xbd, 0x7b, 0xef, 0xad, 0x54, 0xb7, 0xbf, 0xf7, 0x92, 0xc4, 0xf2, 0x11, 0xf1, 0x4e, 0x1d,
+	0x9b, 0xe0, 0x11, 0x5c, 0x8c, 0x5d, 0xb4, 0xc0, 0x1b, 0xb9, 0x6e, 0x63, 0xf0, 0x0f, 0x40, 0x77,
+	0xb3, 0xc0, 0xcd, 0x0d, 0xa3, 0x82, 0x6d, 0x58, 0x52, 0xcf, 0xf4, 0xf1, 0x17, 0xe6, 0x9f, 0xfa,
+	0x0b, 0x3b, 0xeb, 0x79, 0xaf, 0x07, 0x18, 0x15, 0xec, 0xc0, 0xb2, 0x7e, 0x16, 0x8d, 0x6f, 0xe4,
+	0x39, 0xaf, 0x16, 0x86, 0x36, 0xf2, 0x1f, 0x6d, 0x1b, 0x15, 0xfc, 0x1d, 0xb8, 0x94, 0x76, 0xe2,
+	0x80, 0xfb, 0xf9, 0xcf, 0x26, 0x84, 0xd9, 0x5b, 0x45, 0x0f, 0x33, 0x8c, 0x0a, 0xf6, 0xe0, 0xa5,
+	0x44, 0x45, 0x1c, 0xdf, 0xcc, 0x59, 0x38, 0x17, 0x66, 0xb7, 0x0a, 0x95, 0xd9, 0x8d, 0x0a, 0x7e,
+	0x0a, 0x2f, 0xab, 0x3f, 0x07, 0x01, 0xee, 0xe5, 0x2e, 0xf4, 0x0a, 0xbb, 0xfd, 0x82, 0x85, 0x61,
+	0xa3, 0x82, 0x5d, 0x58, 0x89, 0x57, 0x2e, 0xf1, 0x66, 0xbe, 0xfa, 0xa6, 0xb0, 0x79, 0xb3, 0x48,
+	0x31, 0xd4, 0xa8, 0xe0, 0x23, 0xb8, 0xa0, 0x55, 0xdb, 0xf0, 0x7a, 0x8e, 0x82, 0x9c, 0x30, 0x75,
+	0x23, 0x77, 0xe9, 0xce, 0xa8, 0xb0, 0x0c, 0x8c, 0xd5, 0x88, 0xf0, 0x46, 0xae, 0x42, 0xd2, 0x8c,
+	0x0c, 0xcc, 0x28, 0x3a, 0x19, 0x15, 0x3c, 0x05, 0x9c, 0xbc, 0x34, 0x80, 0xb7, 0xf2, 0x5e, 0x2e,
+	0x10, 0x36, 0x7b, 0xc5, 0xee, 0x22, 0x84, 0x83, 0x54, 0x8f, 0xb7, 0x33, 0x07, 0x99, 0x72, 0x27,
+	0xa0, 0xbb, 0x99, 0x4b, 0x36, 0x9e, 0x19, 0xda, 0x81, 0x6c, 0x76, 0x66, 0xa4, 0x1d, 0x80, 0x77,
+	0xb7, 0x72, 0x4a, 0xab, 0x36, 0x13, 0x65, 0x21, 0x7c, 0x33, 0x67, 0xf5, 0x68, 0x86, 0xcd, 0xcc,
+	0x5a, 0x53, 0x38, 0x45, 0xa3, 0x4a, 0x4a, 0xe6, 0x14, 0x4d, 0xd4, 0x82, 0xba, 0x37, 0x72, 0x48,
+	0xaa, 0xb9, 0x17, 0xaf, 0x09, 0xe0, 0xcd, 0x7c, 0x95, 0x83, 0x19, 0xb9, 0x97, 0x55, 0x66, 0x08,
+	0x69, 0x26, 0xbe, 0xc5, 0xcd, 0xa4, 0x99, 0x8c, 0x4a, 0x40, 0xb7, 0x9f, 0x5b, 0x5e, 0x9d, 0xa8,
+	0x89, 0x1b, 0xa0, 0xa9, 0x4b, 0xe3, 0xd4, 0x3b, 0x9d, 0xdd, 0xcd, 0x5c, 0xb2, 0xa1, 0xb5, 0x1f,
+	0x8a, 0xdd, 0x41, 0xda, 0x2e, 0x10, 0x6f, 0x17, 0xda, 0x32, 0x0a, 0xf3, 0x3b, 0x25, 0xb6, 0x99,
+	0x61, 0x76, 0xaa, 0x1b, 0x8d, 0xcc, 0xec, 0x4c, 0xd9, 0x05, 0x76, 0x37, 0x0b, 0xec, 0x5c, 0x8c,
+	0xca, 0x61, 0x93, 0xaf, 0x6e, 0x76, 0xfe, 0x37, 0x00, 0x40, 0xa8, 0x99, 0x4d, 0xb7, 0x2c, 0x00,
 	0x00,
 }
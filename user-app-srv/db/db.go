@@ -24,7 +24,8 @@ import (
 )
 
 type clientWrapper struct {
-	Db_client db_proto.DBClient
+	Db_client   db_proto.DBClient
+	StaticCache *common.StaticCache
 }
 
 var (
@@ -34,10 +35,11 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, common.NewPII(serviceClient)))
 
 	return &clientWrapper{
-		Db_client: cl,
+		Db_client:   cl,
+		StaticCache: common.NewStaticCache(static_proto.NewStaticServiceClient(common.StaticSrv, serviceClient), nil),
 	}
 }
 
@@ -499,14 +501,6 @@ func userplanToRecord(userplan *userapp_proto.UserPlan) (string, error) {
 	return string(body), err
 }
 
-func recordToCategory(r *db_proto.Record) (*static_proto.BehaviourCategory, error) {
-	var p static_proto.BehaviourCategory
-	if err := jsonpb.Unmarshal(strings.NewReader(r.Parameter3), &p); err != nil {
-		return nil, err
-	}
-	return &p, nil
-}
-
 func recordToGoalDetail(r *db_proto.Record) (*userapp_proto.GoalDetail, error) {
 	var p userapp_proto.GoalDetail
 	if err := jsonpb.Unmarshal(strings.NewReader(r.Parameter3), &p); err != nil {
@@ -1264,140 +1258,56 @@ func ListMarkers(ctx context.Context, userId string) ([]*userapp_proto.MarkerRes
 	response := []*userapp_proto.MarkerResponse{}
 
 	query := fmt.Sprintf(`FILTER doc.data.status == "%v" || doc.data.status == "%v"`, userapp_proto.ActionStatus_STARTED, userapp_proto.ActionStatus_IN_PROGRESS)
-	trackerMethodQuery := fmt.Sprintf(`LET trackerMethods = (
-			FOR t IN OUTBOUND p %v
-			OPTIONS {
-				bfs: true,
-				uniqueVertices: "global"
-			}
-			RETURN t.data
-		)
-		LET pret =(MERGE_RECURSIVE(p, {data:{
-                trackerMethods: trackerMethods
-        }}))`, common.DbMarkerTrackerEdgeTable)
-	// for goals
+	// categories of the goals and challenges the user joined, their markers are resolved by the cache
 	q := fmt.Sprintf(`
-		FOR goal, doc IN OUTBOUND "%v/%v" %v
-		%v
-		FOR category IN %v FILTER goal.data.category.id == category._key
-		LET default = (
-			FOR p IN %v 
-			FILTER category.data.markerDefault.id == p._key 
-			%s
-			RETURN pret.data
+		FOR id IN UNION(
+			(FOR goal, doc IN OUTBOUND "%v/%v" %v %v RETURN goal.data.category.id),
+			(FOR challenge, doc IN OUTBOUND "%v/%v" %v %v RETURN challenge.data.category.id)
 		)
-		LET options = (
-			FOR m IN category.data.markerOptions
-			FOR p IN %v 
-			FILTER m.id == p._key 
-			%s
-			RETURN pret.data
-		)
-		RETURN MERGE_RECURSIVE(category, {data:{
-			markerDefault:default[0],
-			markerOptions:options
-			}})`,
-		common.DbUserTable, userId, common.DbJoinGoalEdgeTable,
-		query,
-		common.DbBehaviourCategoryTable,
-		common.DbMarkerTable, trackerMethodQuery, common.DbMarkerTable, trackerMethodQuery)
+		FILTER NOT_NULL(id)
+		RETURN {id: id}`,
+		common.DbUserTable, userId, common.DbJoinGoalEdgeTable, query,
+		common.DbUserTable, userId, common.DbJoinChallengeEdgeTable, query)
 	resp, err := runQuery(ctx, q, common.DbJoinGoalEdgeTable)
 	if err != nil {
 		return nil, err
 	}
-	// parsing
+	ids := []string{}
 	for _, r := range resp.Records {
-		if category, err := recordToCategory(r); err == nil {
-			// marker default
-			if category.MarkerDefault != nil {
-				marker := &userapp_proto.MarkerResponse{
-					IsDefault:      true,
-					MarkerId:       category.MarkerDefault.Id,
-					Name:           category.MarkerDefault.Name,
-					IconSlug:       category.MarkerDefault.IconSlug,
-					Unit:           category.MarkerDefault.Unit,
-					TrackerMethods: category.MarkerDefault.TrackerMethods,
-				}
-				response = append(response, marker)
-			}
-			// marker opetions
-			if category.MarkerOptions != nil {
-				for _, m := range category.MarkerOptions {
-					//log.Println(m)
-					marker := &userapp_proto.MarkerResponse{
-						IsDefault:      false,
-						MarkerId:       m.Id,
-						Name:           m.Name,
-						IconSlug:       m.IconSlug,
-						Unit:           m.Unit,
-						TrackerMethods: m.TrackerMethods,
-					}
-					response = append(response, marker)
-				}
-			}
-		} else {
-			common.ErrorLog(common.UserappSrv, ListMarkers, err, "Category unmarshale is failed")
+		ids = append(ids, r.Id)
+	}
+	categories, err := ClientWrapper.StaticCache.BehaviourCategories(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	markerIds := []string{}
+	for _, category := range categories {
+		if category.MarkerDefault != nil {
+			markerIds = append(markerIds, category.MarkerDefault.Id)
+		}
+		for _, m := range category.MarkerOptions {
+			markerIds = append(markerIds, m.Id)
 		}
 	}
-
-	// for challenge
-	q = fmt.Sprintf(`
-		FOR challenge, doc IN OUTBOUND "%v/%v" %v
-		%v
-		FOR category IN %v FILTER challenge.data.category.id == category._key
-		LET default = (
-			FOR p IN %v 
-			FILTER category.data.markerDefault.id == p._key 
-			%s
-			RETURN pret.data
-		)
-		LET options = (
-			FOR m IN category.data.markerOptions
-			FOR p IN %v 
-			FILTER m.id == p._key 
-			%s
-			RETURN pret.data
-		)
-		RETURN MERGE_RECURSIVE(category, {data:{
-			markerDefault:default[0],
-			markerOptions:options
-			}})`,
-		common.DbUserTable, userId, common.DbJoinChallengeEdgeTable,
-		query,
-		common.DbBehaviourCategoryTable,
-		common.DbMarkerTable, trackerMethodQuery, common.DbMarkerTable, trackerMethodQuery)
-	resp, err = runQuery(ctx, q, common.DbJoinChallengeEdgeTable)
+	markers, err := ClientWrapper.StaticCache.Markers(ctx, markerIds)
 	if err != nil {
 		return nil, err
 	}
-	// parsing
-	for _, r := range resp.Records {
-		if category, err := recordToCategory(r); err == nil {
-			// marker default
-			if category.MarkerDefault != nil {
-				marker := &userapp_proto.MarkerResponse{
-					IsDefault:      true,
-					MarkerId:       category.MarkerDefault.Id,
-					Name:           category.MarkerDefault.Name,
-					IconSlug:       category.MarkerDefault.IconSlug,
-					Unit:           category.MarkerDefault.Unit,
-					TrackerMethods: category.MarkerDefault.TrackerMethods,
-				}
-				response = append(response, marker)
+
+	// in the order of the categories, their default marker first
+	for _, id := range ids {
+		category, ok := categories[id]
+		if !ok {
+			continue
+		}
+		if category.MarkerDefault != nil {
+			if m, ok := markers[category.MarkerDefault.Id]; ok {
+				response = append(response, markerResponse(m, true))
 			}
-			// marker opetions
-			if category.MarkerOptions != nil {
-				for _, m := range category.MarkerOptions {
-					marker := &userapp_proto.MarkerResponse{
-						IsDefault:      false,
-						MarkerId:       m.Id,
-						Name:           m.Name,
-						IconSlug:       m.IconSlug,
-						Unit:           m.Unit,
-						TrackerMethods: m.TrackerMethods,
-					}
-					response = append(response, marker)
-				}
+		}
+		for _, option := range category.MarkerOptions {
+			if m, ok := markers[option.Id]; ok {
+				response = append(response, markerResponse(m, false))
 			}
 		}
 	}
@@ -1405,6 +1315,17 @@ func ListMarkers(ctx context.Context, userId string) ([]*userapp_proto.MarkerRes
 	return dedupResponse(response), nil
 }
 
+func markerResponse(m *static_proto.Marker, isDefault bool) *userapp_proto.MarkerResponse {
+	return &userapp_proto.MarkerResponse{
+		IsDefault:      isDefault,
+		MarkerId:       m.Id,
+		Name:           m.Name,
+		IconSlug:       m.IconSlug,
+		Unit:           m.Unit,
+		TrackerMethods: m.TrackerMethods,
+	}
+}
+
 //remove duplicate markers from the response
 func dedupResponse(response []*userapp_proto.MarkerResponse) []*userapp_proto.MarkerResponse {
 	u := make([]*userapp_proto.MarkerResponse, 0, len(response))
@@ -91,6 +91,12 @@ func main() {
 	userapp_proto.RegisterUserAppServiceHandler(service.Server(), userappService)
 	db.Init(service.Client())
 
+	// static references are resolved in-process, static-srv publishes their changes
+	db.ClientWrapper.StaticCache = common.NewStaticCache(userappService.StaticClient, m)
+	if _, err := db.ClientWrapper.StaticCache.Subscribe(brker); err != nil {
+		log.Fatal(err)
+	}
+
 	if err := service.Run(); err != nil {
 		log.Fatal(err)
 	}
@@ -22,6 +22,7 @@ import (
 
 type clientWrapper struct {
 	Db_client db_proto.DBClient
+	PII       *common.PII
 }
 
 var (
@@ -31,10 +32,12 @@ var (
 
 // Storage for a db microservice client
 func NewClientWrapper(serviceClient client.Client) *clientWrapper {
-	cl := db_proto.NewDBClient("", serviceClient)
+	pii := common.NewPII(serviceClient)
+	cl := db_proto.NewDBClient("", common.NewPIIClient(serviceClient, pii))
 
 	return &clientWrapper{
 		Db_client: cl,
+		PII:       pii,
 	}
 }
 
@@ -106,7 +109,7 @@ func querySharedResourceSharedBy(filter_query string, sharedBy []string) string
 	return common.QueryClean(filter_query)
 }
 
-func userToRecord(user *user_proto.User, options ...interface{}) (string, error) {
+func userToRecord(ctx context.Context, user *user_proto.User, options ...interface{}) (string, error) {
 	data, err := common.MarhalToObject(user, options)
 	if err != nil {
 		return "", err
@@ -114,13 +117,18 @@ func userToRecord(user *user_proto.User, options ...interface{}) (string, error)
 	common.FilterObject(data, "pointOfContact", user.PointOfContact)
 	delete(data, "currentBatch")
 	common.FilterObject(data, "preference", user.Preference)
+	if err := ClientWrapper.PII.EncryptFields(ctx, user.OrgId, common.DbUserTable, data); err != nil {
+		return "", err
+	}
+	// the name is the blind index of the encrypted lastname
+	name, _ := data["lastname_bidx"].(string)
 
 	d := map[string]interface{}{
 		"_key":       user.Id,
 		"id":         user.Id,
 		"created":    user.Created,
 		"updated":    user.Updated,
-		"name":       user.Lastname,
+		"name":       name,
 		"parameter1": user.OrgId,
 		"data":       data,
 	}
@@ -364,7 +372,7 @@ func Create(ctx context.Context, user *user_proto.User, account *account_proto.A
 	user.Preference.OrgId = user.OrgId
 	user.Preference.UserId = user.Id
 
-	record, err := userToRecord(user)
+	record, err := userToRecord(ctx, user)
 	if err != nil {
 		common.ErrorLog(common.UserSrv, Create, err, "Record parsing error")
 		return err
@@ -457,7 +465,7 @@ func Update(ctx context.Context, user *user_proto.User) error {
 	}
 	user.Updated = time.Now().Unix()
 	doNotEmitdefaults := true
-	record, err := userToRecord(user, doNotEmitdefaults)
+	record, err := userToRecord(ctx, user, doNotEmitdefaults)
 	if err != nil {
 		common.ErrorLog(common.UserSrv, Update, err, "Record parsing error")
 		return err
@@ -942,9 +950,13 @@ func SearchUser(ctx context.Context, req *user_proto.SearchUserRequest) ([]*user
 	search_query := `FILTER`
 	org_query := `FILTER`
 	org_query = common.QueryAuth(org_query, req.OrgId, "")
-	// add query of tags
+	// the names are encrypted, they are matched exactly by their blind indexes
 	if len(req.Name) > 0 {
-		search_query += fmt.Sprintf(` || (LIKE(doc.data.firstname, "%s",true) || LIKE(doc.data.lastname, "%s",true))`, `%`+req.Name+`%`, `%`+req.Name+`%`)
+		bidx, err := ClientWrapper.PII.BlindIndex(ctx, req.OrgId, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		search_query += fmt.Sprintf(` || doc.data.firstname_bidx == "%v" || doc.data.lastname_bidx == "%v"`, bidx, bidx)
 	}
 	// add query of preference
 	if req.Gender != 0 {
@@ -954,17 +966,32 @@ func SearchUser(ctx context.Context, req *user_proto.SearchUserRequest) ([]*user
 	if len(req.Addresses) > 0 {
 		addrs := []string{}
 		for _, s := range req.Addresses {
-			addrs = append(addrs, `"`+s.PostalCode+`"`)
+			bidx, err := ClientWrapper.PII.BlindIndex(ctx, req.OrgId, s.PostalCode)
+			if err != nil {
+				return nil, err
+			}
+			addrs = append(addrs, `"`+bidx+`"`)
 		}
-		search_query += fmt.Sprintf(" || doc.data.addresses[*].postalCode ANY IN [%v]", strings.Join(addrs[:], ","))
+		search_query += fmt.Sprintf(" || doc.data.addresses[*].postalCode_bidx ANY IN [%v]", strings.Join(addrs[:], ","))
 	}
 	// add query of contactdetail
 	if len(req.ContactDetails) != 0 {
 		contacts := []string{}
+		values := []string{}
 		for _, s := range req.ContactDetails {
 			contacts = append(contacts, `"`+s.Id+`"`)
+			if len(s.Value) > 0 {
+				bidx, err := ClientWrapper.PII.BlindIndex(ctx, req.OrgId, s.Value)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, `"`+bidx+`"`)
+			}
 		}
 		search_query += fmt.Sprintf(" || doc.data.contactDetails[*].id ANY IN [%v]", strings.Join(contacts[:], ","))
+		if len(values) > 0 {
+			search_query += fmt.Sprintf(" || doc.data.contact_details[*].value_bidx ANY IN [%v]", strings.Join(values[:], ","))
+		}
 	}
 	search_query = common.QueryAuth(search_query, req.OrgId, "")
 	limit_query := common.QueryPaginate(req.Offset, req.Limit)
@@ -1006,7 +1033,12 @@ func AutocompleteUser(ctx context.Context, req *user_proto.AutocompleteUserReque
 	search_query := `FILTER`
 	org_query := `FILTER`
 	org_query = common.QueryAuth(org_query, req.OrgId, "")
-	search_query = fmt.Sprintf(`FILTER LIKE(doc.data.firstname, "%s",true) || LIKE(doc.data.lastname, "%s",true)`, `%`+req.Name+`%`, `%`+req.Name+`%`)
+	// the names are encrypted, their prefixes are matched by the prefix indexes
+	pidx, err := ClientWrapper.PII.PrefixIndex(ctx, req.OrgId, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	search_query = fmt.Sprintf(`FILTER "%v" IN doc.data.firstname_prefix_bidx || "%v" IN doc.data.lastname_prefix_bidx`, pidx, pidx)
 	search_query = common.QueryAuth(search_query, req.OrgId, "")
 	limit_query := common.QueryPaginate(req.Offset, req.Limit)
 	sort_query := common.QuerySort(req.SortParameter, req.SortDirection)